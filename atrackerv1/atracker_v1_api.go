@@ -0,0 +1,274 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package atrackerv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// AtrackerV1API : The operations of the AtrackerV1 service, implemented by *AtrackerV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type AtrackerV1API interface {
+	// Clone makes a copy of "atracker" suitable for processing requests.
+	Clone() *AtrackerV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// CreateTarget : Create a target
+	CreateTarget(createTargetOptions *CreateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// CreateTargetWithContext is an alternate form of the CreateTarget method which supports a Context parameter
+	CreateTargetWithContext(ctx context.Context, createTargetOptions *CreateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// ListTargets : List targets
+	ListTargets(listTargetsOptions *ListTargetsOptions) (result *TargetList, response *core.DetailedResponse, err error)
+
+	// ListTargetsWithContext is an alternate form of the ListTargets method which supports a Context parameter
+	ListTargetsWithContext(ctx context.Context, listTargetsOptions *ListTargetsOptions) (result *TargetList, response *core.DetailedResponse, err error)
+
+	// GetTarget : Get details of a target
+	GetTarget(getTargetOptions *GetTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// GetTargetWithContext is an alternate form of the GetTarget method which supports a Context parameter
+	GetTargetWithContext(ctx context.Context, getTargetOptions *GetTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// ReplaceTarget : Update a target
+	ReplaceTarget(replaceTargetOptions *ReplaceTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// ReplaceTargetWithContext is an alternate form of the ReplaceTarget method which supports a Context parameter
+	ReplaceTargetWithContext(ctx context.Context, replaceTargetOptions *ReplaceTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// DeleteTarget : Delete a target
+	DeleteTarget(deleteTargetOptions *DeleteTargetOptions) (result *WarningReport, response *core.DetailedResponse, err error)
+
+	// DeleteTargetWithContext is an alternate form of the DeleteTarget method which supports a Context parameter
+	DeleteTargetWithContext(ctx context.Context, deleteTargetOptions *DeleteTargetOptions) (result *WarningReport, response *core.DetailedResponse, err error)
+
+	// ValidateTarget : Validate a target
+	ValidateTarget(validateTargetOptions *ValidateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// ValidateTargetWithContext is an alternate form of the ValidateTarget method which supports a Context parameter
+	ValidateTargetWithContext(ctx context.Context, validateTargetOptions *ValidateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// CreateRoute : Create a route
+	CreateRoute(createRouteOptions *CreateRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// CreateRouteWithContext is an alternate form of the CreateRoute method which supports a Context parameter
+	CreateRouteWithContext(ctx context.Context, createRouteOptions *CreateRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// ListRoutes : List routes
+	ListRoutes(listRoutesOptions *ListRoutesOptions) (result *RouteList, response *core.DetailedResponse, err error)
+
+	// ListRoutesWithContext is an alternate form of the ListRoutes method which supports a Context parameter
+	ListRoutesWithContext(ctx context.Context, listRoutesOptions *ListRoutesOptions) (result *RouteList, response *core.DetailedResponse, err error)
+
+	// GetRoute : Get details of a route
+	GetRoute(getRouteOptions *GetRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// GetRouteWithContext is an alternate form of the GetRoute method which supports a Context parameter
+	GetRouteWithContext(ctx context.Context, getRouteOptions *GetRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// ReplaceRoute : Update a route
+	ReplaceRoute(replaceRouteOptions *ReplaceRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// ReplaceRouteWithContext is an alternate form of the ReplaceRoute method which supports a Context parameter
+	ReplaceRouteWithContext(ctx context.Context, replaceRouteOptions *ReplaceRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// DeleteRoute : Delete a route
+	DeleteRoute(deleteRouteOptions *DeleteRouteOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteRouteWithContext is an alternate form of the DeleteRoute method which supports a Context parameter
+	DeleteRouteWithContext(ctx context.Context, deleteRouteOptions *DeleteRouteOptions) (response *core.DetailedResponse, err error)
+
+	// GetEndpoints : Get endpoints
+	GetEndpoints(getEndpointsOptions *GetEndpointsOptions) (result *Endpoints, response *core.DetailedResponse, err error)
+
+	// GetEndpointsWithContext is an alternate form of the GetEndpoints method which supports a Context parameter
+	GetEndpointsWithContext(ctx context.Context, getEndpointsOptions *GetEndpointsOptions) (result *Endpoints, response *core.DetailedResponse, err error)
+
+	// PatchEndpoints : Modify endpoints
+	PatchEndpoints(patchEndpointsOptions *PatchEndpointsOptions) (result *Endpoints, response *core.DetailedResponse, err error)
+
+	// PatchEndpointsWithContext is an alternate form of the PatchEndpoints method which supports a Context parameter
+	PatchEndpointsWithContext(ctx context.Context, patchEndpointsOptions *PatchEndpointsOptions) (result *Endpoints, response *core.DetailedResponse, err error)
+
+	// NewCreateRouteOptions : Instantiate CreateRouteOptions
+	NewCreateRouteOptions(name string, receiveGlobalEvents bool, rules []Rule) *CreateRouteOptions
+
+	// NewCreateTargetOptions : Instantiate CreateTargetOptions
+	NewCreateTargetOptions(name string, targetType string, cosEndpoint *CosEndpoint) *CreateTargetOptions
+
+	// NewDeleteRouteOptions : Instantiate DeleteRouteOptions
+	NewDeleteRouteOptions(id string) *DeleteRouteOptions
+
+	// NewDeleteTargetOptions : Instantiate DeleteTargetOptions
+	NewDeleteTargetOptions(id string) *DeleteTargetOptions
+
+	// NewGetEndpointsOptions : Instantiate GetEndpointsOptions
+	NewGetEndpointsOptions() *GetEndpointsOptions
+
+	// NewGetRouteOptions : Instantiate GetRouteOptions
+	NewGetRouteOptions(id string) *GetRouteOptions
+
+	// NewGetTargetOptions : Instantiate GetTargetOptions
+	NewGetTargetOptions(id string) *GetTargetOptions
+
+	// NewListRoutesOptions : Instantiate ListRoutesOptions
+	NewListRoutesOptions() *ListRoutesOptions
+
+	// NewListTargetsOptions : Instantiate ListTargetsOptions
+	NewListTargetsOptions() *ListTargetsOptions
+
+	// NewPatchEndpointsOptions : Instantiate PatchEndpointsOptions
+	NewPatchEndpointsOptions() *PatchEndpointsOptions
+
+	// NewReplaceRouteOptions : Instantiate ReplaceRouteOptions
+	NewReplaceRouteOptions(id string, name string, receiveGlobalEvents bool, rules []Rule) *ReplaceRouteOptions
+
+	// NewReplaceTargetOptions : Instantiate ReplaceTargetOptions
+	NewReplaceTargetOptions(id string, name string, targetType string, cosEndpoint *CosEndpoint) *ReplaceTargetOptions
+
+	// NewRule : Instantiate Rule (Generic Model Constructor)
+	NewRule(targetIds []string) (_model *Rule, err error)
+
+	// NewValidateTargetOptions : Instantiate ValidateTargetOptions
+	NewValidateTargetOptions(id string) *ValidateTargetOptions
+
+	// NewCosEndpoint : Instantiate CosEndpoint (Generic Model Constructor)
+	NewCosEndpoint(endpoint string, targetCRN string, bucket string, apiKey string) (_model *CosEndpoint, err error)
+
+	// NewCreateTargetOptionsWith returns CreateTargetOptions populated by applying "options" in order.
+	NewCreateTargetOptionsWith(options ...CreateTargetOption) *CreateTargetOptions
+
+	// CreateTargetWith is an alternate form of the CreateTargetWithContext method which takes functional options
+	// instead of a CreateTargetOptions struct.
+	CreateTargetWith(ctx context.Context, options ...CreateTargetOption) (result *Target, response *core.DetailedResponse, err error)
+
+	// NewListTargetsOptionsWith returns ListTargetsOptions populated by applying "options" in order.
+	NewListTargetsOptionsWith(options ...ListTargetsOption) *ListTargetsOptions
+
+	// ListTargetsWith is an alternate form of the ListTargetsWithContext method which takes functional options instead
+	// of a ListTargetsOptions struct.
+	ListTargetsWith(ctx context.Context, options ...ListTargetsOption) (result *TargetList, response *core.DetailedResponse, err error)
+
+	// NewGetTargetOptionsWith returns GetTargetOptions populated by applying "options" in order.
+	NewGetTargetOptionsWith(options ...GetTargetOption) *GetTargetOptions
+
+	// GetTargetWith is an alternate form of the GetTargetWithContext method which takes functional options instead of
+	// a GetTargetOptions struct.
+	GetTargetWith(ctx context.Context, options ...GetTargetOption) (result *Target, response *core.DetailedResponse, err error)
+
+	// NewReplaceTargetOptionsWith returns ReplaceTargetOptions populated by applying "options" in order.
+	NewReplaceTargetOptionsWith(options ...ReplaceTargetOption) *ReplaceTargetOptions
+
+	// ReplaceTargetWith is an alternate form of the ReplaceTargetWithContext method which takes functional options
+	// instead of a ReplaceTargetOptions struct.
+	ReplaceTargetWith(ctx context.Context, options ...ReplaceTargetOption) (result *Target, response *core.DetailedResponse, err error)
+
+	// NewDeleteTargetOptionsWith returns DeleteTargetOptions populated by applying "options" in order.
+	NewDeleteTargetOptionsWith(options ...DeleteTargetOption) *DeleteTargetOptions
+
+	// DeleteTargetWith is an alternate form of the DeleteTargetWithContext method which takes functional options
+	// instead of a DeleteTargetOptions struct.
+	DeleteTargetWith(ctx context.Context, options ...DeleteTargetOption) (result *WarningReport, response *core.DetailedResponse, err error)
+
+	// NewValidateTargetOptionsWith returns ValidateTargetOptions populated by applying "options" in order.
+	NewValidateTargetOptionsWith(options ...ValidateTargetOption) *ValidateTargetOptions
+
+	// ValidateTargetWith is an alternate form of the ValidateTargetWithContext method which takes functional options
+	// instead of a ValidateTargetOptions struct.
+	ValidateTargetWith(ctx context.Context, options ...ValidateTargetOption) (result *Target, response *core.DetailedResponse, err error)
+
+	// NewCreateRouteOptionsWith returns CreateRouteOptions populated by applying "options" in order.
+	NewCreateRouteOptionsWith(options ...CreateRouteOption) *CreateRouteOptions
+
+	// CreateRouteWith is an alternate form of the CreateRouteWithContext method which takes functional options instead
+	// of a CreateRouteOptions struct.
+	CreateRouteWith(ctx context.Context, options ...CreateRouteOption) (result *Route, response *core.DetailedResponse, err error)
+
+	// NewListRoutesOptionsWith returns ListRoutesOptions populated by applying "options" in order.
+	NewListRoutesOptionsWith(options ...ListRoutesOption) *ListRoutesOptions
+
+	// ListRoutesWith is an alternate form of the ListRoutesWithContext method which takes functional options instead
+	// of a ListRoutesOptions struct.
+	ListRoutesWith(ctx context.Context, options ...ListRoutesOption) (result *RouteList, response *core.DetailedResponse, err error)
+
+	// NewGetRouteOptionsWith returns GetRouteOptions populated by applying "options" in order.
+	NewGetRouteOptionsWith(options ...GetRouteOption) *GetRouteOptions
+
+	// GetRouteWith is an alternate form of the GetRouteWithContext method which takes functional options instead of a
+	// GetRouteOptions struct.
+	GetRouteWith(ctx context.Context, options ...GetRouteOption) (result *Route, response *core.DetailedResponse, err error)
+
+	// NewReplaceRouteOptionsWith returns ReplaceRouteOptions populated by applying "options" in order.
+	NewReplaceRouteOptionsWith(options ...ReplaceRouteOption) *ReplaceRouteOptions
+
+	// ReplaceRouteWith is an alternate form of the ReplaceRouteWithContext method which takes functional options
+	// instead of a ReplaceRouteOptions struct.
+	ReplaceRouteWith(ctx context.Context, options ...ReplaceRouteOption) (result *Route, response *core.DetailedResponse, err error)
+
+	// NewDeleteRouteOptionsWith returns DeleteRouteOptions populated by applying "options" in order.
+	NewDeleteRouteOptionsWith(options ...DeleteRouteOption) *DeleteRouteOptions
+
+	// DeleteRouteWith is an alternate form of the DeleteRouteWithContext method which takes functional options instead
+	// of a DeleteRouteOptions struct.
+	DeleteRouteWith(ctx context.Context, options ...DeleteRouteOption) (response *core.DetailedResponse, err error)
+
+	// NewGetEndpointsOptionsWith returns GetEndpointsOptions populated by applying "options" in order.
+	NewGetEndpointsOptionsWith(options ...GetEndpointsOption) *GetEndpointsOptions
+
+	// GetEndpointsWith is an alternate form of the GetEndpointsWithContext method which takes functional options
+	// instead of a GetEndpointsOptions struct.
+	GetEndpointsWith(ctx context.Context, options ...GetEndpointsOption) (result *Endpoints, response *core.DetailedResponse, err error)
+
+	// NewPatchEndpointsOptionsWith returns PatchEndpointsOptions populated by applying "options" in order.
+	NewPatchEndpointsOptionsWith(options ...PatchEndpointsOption) *PatchEndpointsOptions
+
+	// PatchEndpointsWith is an alternate form of the PatchEndpointsWithContext method which takes functional options
+	// instead of a PatchEndpointsOptions struct.
+	PatchEndpointsWith(ctx context.Context, options ...PatchEndpointsOption) (result *Endpoints, response *core.DetailedResponse, err error)
+}
+
+var _ AtrackerV1API = (*AtrackerV1)(nil)
@@ -0,0 +1,547 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package atrackerv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CreateTargetOption : A functional option that sets a property of CreateTargetOptions (see CreateTargetWith).
+type CreateTargetOption func(options *CreateTargetOptions)
+
+// CreateTargetName sets the Name property of CreateTargetOptions. The name of the target.
+func CreateTargetName(nameVar string) CreateTargetOption {
+	return func(options *CreateTargetOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateTargetTargetType sets the TargetType property of CreateTargetOptions. The type of the target.
+func CreateTargetTargetType(targetType string) CreateTargetOption {
+	return func(options *CreateTargetOptions) {
+		options.TargetType = core.StringPtr(targetType)
+	}
+}
+
+// CreateTargetCosEndpoint sets the CosEndpoint property of CreateTargetOptions. Property values for a Cloud Object
+// Storage Endpoint.
+func CreateTargetCosEndpoint(cosEndpoint *CosEndpoint) CreateTargetOption {
+	return func(options *CreateTargetOptions) {
+		options.CosEndpoint = cosEndpoint
+	}
+}
+
+// CreateTargetHeader sets the Headers property of CreateTargetOptions. Allows users to set headers on API
+// requests.
+func CreateTargetHeader(name string, value string) CreateTargetOption {
+	return func(options *CreateTargetOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateTargetOptionsWith returns CreateTargetOptions populated by applying "options" in order.
+func (*AtrackerV1) NewCreateTargetOptionsWith(options ...CreateTargetOption) *CreateTargetOptions {
+	createTargetOptions := &CreateTargetOptions{}
+	for _, option := range options {
+		option(createTargetOptions)
+	}
+	return createTargetOptions
+}
+
+// CreateTargetWith is an alternate form of the CreateTargetWithContext method which takes functional options
+// instead of a CreateTargetOptions struct. CreateTargetName, CreateTargetTargetType and CreateTargetCosEndpoint
+// are required.
+func (atracker *AtrackerV1) CreateTargetWith(ctx context.Context, options ...CreateTargetOption) (result *Target, response *core.DetailedResponse, err error) {
+	return atracker.CreateTargetWithContext(ctx, atracker.NewCreateTargetOptionsWith(options...))
+}
+
+// ListTargetsOption : A functional option that sets a property of ListTargetsOptions (see ListTargetsWith).
+type ListTargetsOption func(options *ListTargetsOptions)
+
+// ListTargetsHeader sets the Headers property of ListTargetsOptions. Allows users to set headers on API requests.
+func ListTargetsHeader(name string, value string) ListTargetsOption {
+	return func(options *ListTargetsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListTargetsOptionsWith returns ListTargetsOptions populated by applying "options" in order.
+func (*AtrackerV1) NewListTargetsOptionsWith(options ...ListTargetsOption) *ListTargetsOptions {
+	listTargetsOptions := &ListTargetsOptions{}
+	for _, option := range options {
+		option(listTargetsOptions)
+	}
+	return listTargetsOptions
+}
+
+// ListTargetsWith is an alternate form of the ListTargetsWithContext method which takes functional options instead
+// of a ListTargetsOptions struct.
+func (atracker *AtrackerV1) ListTargetsWith(ctx context.Context, options ...ListTargetsOption) (result *TargetList, response *core.DetailedResponse, err error) {
+	return atracker.ListTargetsWithContext(ctx, atracker.NewListTargetsOptionsWith(options...))
+}
+
+// GetTargetOption : A functional option that sets a property of GetTargetOptions (see GetTargetWith).
+type GetTargetOption func(options *GetTargetOptions)
+
+// GetTargetID sets the ID property of GetTargetOptions. The v4 UUID that uniquely identifies the target.
+func GetTargetID(id string) GetTargetOption {
+	return func(options *GetTargetOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetTargetHeader sets the Headers property of GetTargetOptions. Allows users to set headers on API requests.
+func GetTargetHeader(name string, value string) GetTargetOption {
+	return func(options *GetTargetOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetTargetOptionsWith returns GetTargetOptions populated by applying "options" in order.
+func (*AtrackerV1) NewGetTargetOptionsWith(options ...GetTargetOption) *GetTargetOptions {
+	getTargetOptions := &GetTargetOptions{}
+	for _, option := range options {
+		option(getTargetOptions)
+	}
+	return getTargetOptions
+}
+
+// GetTargetWith is an alternate form of the GetTargetWithContext method which takes functional options instead of
+// a GetTargetOptions struct. GetTargetID is required.
+func (atracker *AtrackerV1) GetTargetWith(ctx context.Context, options ...GetTargetOption) (result *Target, response *core.DetailedResponse, err error) {
+	return atracker.GetTargetWithContext(ctx, atracker.NewGetTargetOptionsWith(options...))
+}
+
+// ReplaceTargetOption : A functional option that sets a property of ReplaceTargetOptions (see ReplaceTargetWith).
+type ReplaceTargetOption func(options *ReplaceTargetOptions)
+
+// ReplaceTargetID sets the ID property of ReplaceTargetOptions. The v4 UUID that uniquely identifies the target.
+func ReplaceTargetID(id string) ReplaceTargetOption {
+	return func(options *ReplaceTargetOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// ReplaceTargetName sets the Name property of ReplaceTargetOptions. The name of the target.
+func ReplaceTargetName(nameVar string) ReplaceTargetOption {
+	return func(options *ReplaceTargetOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ReplaceTargetTargetType sets the TargetType property of ReplaceTargetOptions. The type of the target.
+func ReplaceTargetTargetType(targetType string) ReplaceTargetOption {
+	return func(options *ReplaceTargetOptions) {
+		options.TargetType = core.StringPtr(targetType)
+	}
+}
+
+// ReplaceTargetCosEndpoint sets the CosEndpoint property of ReplaceTargetOptions. Property values for a Cloud
+// Object Storage Endpoint.
+func ReplaceTargetCosEndpoint(cosEndpoint *CosEndpoint) ReplaceTargetOption {
+	return func(options *ReplaceTargetOptions) {
+		options.CosEndpoint = cosEndpoint
+	}
+}
+
+// ReplaceTargetHeader sets the Headers property of ReplaceTargetOptions. Allows users to set headers on API
+// requests.
+func ReplaceTargetHeader(name string, value string) ReplaceTargetOption {
+	return func(options *ReplaceTargetOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceTargetOptionsWith returns ReplaceTargetOptions populated by applying "options" in order.
+func (*AtrackerV1) NewReplaceTargetOptionsWith(options ...ReplaceTargetOption) *ReplaceTargetOptions {
+	replaceTargetOptions := &ReplaceTargetOptions{}
+	for _, option := range options {
+		option(replaceTargetOptions)
+	}
+	return replaceTargetOptions
+}
+
+// ReplaceTargetWith is an alternate form of the ReplaceTargetWithContext method which takes functional options
+// instead of a ReplaceTargetOptions struct. ReplaceTargetID, ReplaceTargetName, ReplaceTargetTargetType and
+// ReplaceTargetCosEndpoint are required.
+func (atracker *AtrackerV1) ReplaceTargetWith(ctx context.Context, options ...ReplaceTargetOption) (result *Target, response *core.DetailedResponse, err error) {
+	return atracker.ReplaceTargetWithContext(ctx, atracker.NewReplaceTargetOptionsWith(options...))
+}
+
+// DeleteTargetOption : A functional option that sets a property of DeleteTargetOptions (see DeleteTargetWith).
+type DeleteTargetOption func(options *DeleteTargetOptions)
+
+// DeleteTargetID sets the ID property of DeleteTargetOptions. The v4 UUID that uniquely identifies the target.
+func DeleteTargetID(id string) DeleteTargetOption {
+	return func(options *DeleteTargetOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// DeleteTargetHeader sets the Headers property of DeleteTargetOptions. Allows users to set headers on API
+// requests.
+func DeleteTargetHeader(name string, value string) DeleteTargetOption {
+	return func(options *DeleteTargetOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteTargetOptionsWith returns DeleteTargetOptions populated by applying "options" in order.
+func (*AtrackerV1) NewDeleteTargetOptionsWith(options ...DeleteTargetOption) *DeleteTargetOptions {
+	deleteTargetOptions := &DeleteTargetOptions{}
+	for _, option := range options {
+		option(deleteTargetOptions)
+	}
+	return deleteTargetOptions
+}
+
+// DeleteTargetWith is an alternate form of the DeleteTargetWithContext method which takes functional options
+// instead of a DeleteTargetOptions struct. DeleteTargetID is required.
+func (atracker *AtrackerV1) DeleteTargetWith(ctx context.Context, options ...DeleteTargetOption) (result *WarningReport, response *core.DetailedResponse, err error) {
+	return atracker.DeleteTargetWithContext(ctx, atracker.NewDeleteTargetOptionsWith(options...))
+}
+
+// ValidateTargetOption : A functional option that sets a property of ValidateTargetOptions (see ValidateTargetWith).
+type ValidateTargetOption func(options *ValidateTargetOptions)
+
+// ValidateTargetID sets the ID property of ValidateTargetOptions. The v4 UUID that uniquely identifies the target.
+func ValidateTargetID(id string) ValidateTargetOption {
+	return func(options *ValidateTargetOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// ValidateTargetHeader sets the Headers property of ValidateTargetOptions. Allows users to set headers on API
+// requests.
+func ValidateTargetHeader(name string, value string) ValidateTargetOption {
+	return func(options *ValidateTargetOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewValidateTargetOptionsWith returns ValidateTargetOptions populated by applying "options" in order.
+func (*AtrackerV1) NewValidateTargetOptionsWith(options ...ValidateTargetOption) *ValidateTargetOptions {
+	validateTargetOptions := &ValidateTargetOptions{}
+	for _, option := range options {
+		option(validateTargetOptions)
+	}
+	return validateTargetOptions
+}
+
+// ValidateTargetWith is an alternate form of the ValidateTargetWithContext method which takes functional options
+// instead of a ValidateTargetOptions struct. ValidateTargetID is required.
+func (atracker *AtrackerV1) ValidateTargetWith(ctx context.Context, options ...ValidateTargetOption) (result *Target, response *core.DetailedResponse, err error) {
+	return atracker.ValidateTargetWithContext(ctx, atracker.NewValidateTargetOptionsWith(options...))
+}
+
+// CreateRouteOption : A functional option that sets a property of CreateRouteOptions (see CreateRouteWith).
+type CreateRouteOption func(options *CreateRouteOptions)
+
+// CreateRouteName sets the Name property of CreateRouteOptions. The name of the route.
+func CreateRouteName(nameVar string) CreateRouteOption {
+	return func(options *CreateRouteOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateRouteReceiveGlobalEvents sets the ReceiveGlobalEvents property of CreateRouteOptions. Indicates whether or
+// not all global events should be forwarded to this region.
+func CreateRouteReceiveGlobalEvents(receiveGlobalEvents bool) CreateRouteOption {
+	return func(options *CreateRouteOptions) {
+		options.ReceiveGlobalEvents = core.BoolPtr(receiveGlobalEvents)
+	}
+}
+
+// CreateRouteRules sets the Rules property of CreateRouteOptions. Routing rules that will be evaluated in their
+// order of the array.
+func CreateRouteRules(rules ...Rule) CreateRouteOption {
+	return func(options *CreateRouteOptions) {
+		options.Rules = append(options.Rules, rules...)
+	}
+}
+
+// CreateRouteHeader sets the Headers property of CreateRouteOptions. Allows users to set headers on API requests.
+func CreateRouteHeader(name string, value string) CreateRouteOption {
+	return func(options *CreateRouteOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateRouteOptionsWith returns CreateRouteOptions populated by applying "options" in order.
+func (*AtrackerV1) NewCreateRouteOptionsWith(options ...CreateRouteOption) *CreateRouteOptions {
+	createRouteOptions := &CreateRouteOptions{}
+	for _, option := range options {
+		option(createRouteOptions)
+	}
+	return createRouteOptions
+}
+
+// CreateRouteWith is an alternate form of the CreateRouteWithContext method which takes functional options instead
+// of a CreateRouteOptions struct. CreateRouteName, CreateRouteReceiveGlobalEvents and CreateRouteRules are
+// required.
+func (atracker *AtrackerV1) CreateRouteWith(ctx context.Context, options ...CreateRouteOption) (result *Route, response *core.DetailedResponse, err error) {
+	return atracker.CreateRouteWithContext(ctx, atracker.NewCreateRouteOptionsWith(options...))
+}
+
+// ListRoutesOption : A functional option that sets a property of ListRoutesOptions (see ListRoutesWith).
+type ListRoutesOption func(options *ListRoutesOptions)
+
+// ListRoutesHeader sets the Headers property of ListRoutesOptions. Allows users to set headers on API requests.
+func ListRoutesHeader(name string, value string) ListRoutesOption {
+	return func(options *ListRoutesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListRoutesOptionsWith returns ListRoutesOptions populated by applying "options" in order.
+func (*AtrackerV1) NewListRoutesOptionsWith(options ...ListRoutesOption) *ListRoutesOptions {
+	listRoutesOptions := &ListRoutesOptions{}
+	for _, option := range options {
+		option(listRoutesOptions)
+	}
+	return listRoutesOptions
+}
+
+// ListRoutesWith is an alternate form of the ListRoutesWithContext method which takes functional options instead
+// of a ListRoutesOptions struct.
+func (atracker *AtrackerV1) ListRoutesWith(ctx context.Context, options ...ListRoutesOption) (result *RouteList, response *core.DetailedResponse, err error) {
+	return atracker.ListRoutesWithContext(ctx, atracker.NewListRoutesOptionsWith(options...))
+}
+
+// GetRouteOption : A functional option that sets a property of GetRouteOptions (see GetRouteWith).
+type GetRouteOption func(options *GetRouteOptions)
+
+// GetRouteID sets the ID property of GetRouteOptions. The v4 UUID that uniquely identifies the route.
+func GetRouteID(id string) GetRouteOption {
+	return func(options *GetRouteOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetRouteHeader sets the Headers property of GetRouteOptions. Allows users to set headers on API requests.
+func GetRouteHeader(name string, value string) GetRouteOption {
+	return func(options *GetRouteOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetRouteOptionsWith returns GetRouteOptions populated by applying "options" in order.
+func (*AtrackerV1) NewGetRouteOptionsWith(options ...GetRouteOption) *GetRouteOptions {
+	getRouteOptions := &GetRouteOptions{}
+	for _, option := range options {
+		option(getRouteOptions)
+	}
+	return getRouteOptions
+}
+
+// GetRouteWith is an alternate form of the GetRouteWithContext method which takes functional options instead of a
+// GetRouteOptions struct. GetRouteID is required.
+func (atracker *AtrackerV1) GetRouteWith(ctx context.Context, options ...GetRouteOption) (result *Route, response *core.DetailedResponse, err error) {
+	return atracker.GetRouteWithContext(ctx, atracker.NewGetRouteOptionsWith(options...))
+}
+
+// ReplaceRouteOption : A functional option that sets a property of ReplaceRouteOptions (see ReplaceRouteWith).
+type ReplaceRouteOption func(options *ReplaceRouteOptions)
+
+// ReplaceRouteID sets the ID property of ReplaceRouteOptions. The v4 UUID that uniquely identifies the route.
+func ReplaceRouteID(id string) ReplaceRouteOption {
+	return func(options *ReplaceRouteOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// ReplaceRouteName sets the Name property of ReplaceRouteOptions. The name of the route.
+func ReplaceRouteName(nameVar string) ReplaceRouteOption {
+	return func(options *ReplaceRouteOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ReplaceRouteReceiveGlobalEvents sets the ReceiveGlobalEvents property of ReplaceRouteOptions. Indicates whether
+// or not all global events should be forwarded to this region.
+func ReplaceRouteReceiveGlobalEvents(receiveGlobalEvents bool) ReplaceRouteOption {
+	return func(options *ReplaceRouteOptions) {
+		options.ReceiveGlobalEvents = core.BoolPtr(receiveGlobalEvents)
+	}
+}
+
+// ReplaceRouteRules sets the Rules property of ReplaceRouteOptions. Routing rules that will be evaluated in their
+// order of the array.
+func ReplaceRouteRules(rules ...Rule) ReplaceRouteOption {
+	return func(options *ReplaceRouteOptions) {
+		options.Rules = append(options.Rules, rules...)
+	}
+}
+
+// ReplaceRouteHeader sets the Headers property of ReplaceRouteOptions. Allows users to set headers on API
+// requests.
+func ReplaceRouteHeader(name string, value string) ReplaceRouteOption {
+	return func(options *ReplaceRouteOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceRouteOptionsWith returns ReplaceRouteOptions populated by applying "options" in order.
+func (*AtrackerV1) NewReplaceRouteOptionsWith(options ...ReplaceRouteOption) *ReplaceRouteOptions {
+	replaceRouteOptions := &ReplaceRouteOptions{}
+	for _, option := range options {
+		option(replaceRouteOptions)
+	}
+	return replaceRouteOptions
+}
+
+// ReplaceRouteWith is an alternate form of the ReplaceRouteWithContext method which takes functional options
+// instead of a ReplaceRouteOptions struct. ReplaceRouteID, ReplaceRouteName, ReplaceRouteReceiveGlobalEvents and
+// ReplaceRouteRules are required.
+func (atracker *AtrackerV1) ReplaceRouteWith(ctx context.Context, options ...ReplaceRouteOption) (result *Route, response *core.DetailedResponse, err error) {
+	return atracker.ReplaceRouteWithContext(ctx, atracker.NewReplaceRouteOptionsWith(options...))
+}
+
+// DeleteRouteOption : A functional option that sets a property of DeleteRouteOptions (see DeleteRouteWith).
+type DeleteRouteOption func(options *DeleteRouteOptions)
+
+// DeleteRouteID sets the ID property of DeleteRouteOptions. The v4 UUID that uniquely identifies the route.
+func DeleteRouteID(id string) DeleteRouteOption {
+	return func(options *DeleteRouteOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// DeleteRouteHeader sets the Headers property of DeleteRouteOptions. Allows users to set headers on API requests.
+func DeleteRouteHeader(name string, value string) DeleteRouteOption {
+	return func(options *DeleteRouteOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteRouteOptionsWith returns DeleteRouteOptions populated by applying "options" in order.
+func (*AtrackerV1) NewDeleteRouteOptionsWith(options ...DeleteRouteOption) *DeleteRouteOptions {
+	deleteRouteOptions := &DeleteRouteOptions{}
+	for _, option := range options {
+		option(deleteRouteOptions)
+	}
+	return deleteRouteOptions
+}
+
+// DeleteRouteWith is an alternate form of the DeleteRouteWithContext method which takes functional options instead
+// of a DeleteRouteOptions struct. DeleteRouteID is required.
+func (atracker *AtrackerV1) DeleteRouteWith(ctx context.Context, options ...DeleteRouteOption) (response *core.DetailedResponse, err error) {
+	return atracker.DeleteRouteWithContext(ctx, atracker.NewDeleteRouteOptionsWith(options...))
+}
+
+// GetEndpointsOption : A functional option that sets a property of GetEndpointsOptions (see GetEndpointsWith).
+type GetEndpointsOption func(options *GetEndpointsOptions)
+
+// GetEndpointsHeader sets the Headers property of GetEndpointsOptions. Allows users to set headers on API
+// requests.
+func GetEndpointsHeader(name string, value string) GetEndpointsOption {
+	return func(options *GetEndpointsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetEndpointsOptionsWith returns GetEndpointsOptions populated by applying "options" in order.
+func (*AtrackerV1) NewGetEndpointsOptionsWith(options ...GetEndpointsOption) *GetEndpointsOptions {
+	getEndpointsOptions := &GetEndpointsOptions{}
+	for _, option := range options {
+		option(getEndpointsOptions)
+	}
+	return getEndpointsOptions
+}
+
+// GetEndpointsWith is an alternate form of the GetEndpointsWithContext method which takes functional options
+// instead of a GetEndpointsOptions struct.
+func (atracker *AtrackerV1) GetEndpointsWith(ctx context.Context, options ...GetEndpointsOption) (result *Endpoints, response *core.DetailedResponse, err error) {
+	return atracker.GetEndpointsWithContext(ctx, atracker.NewGetEndpointsOptionsWith(options...))
+}
+
+// PatchEndpointsOption : A functional option that sets a property of PatchEndpointsOptions (see PatchEndpointsWith).
+type PatchEndpointsOption func(options *PatchEndpointsOptions)
+
+// PatchEndpointsAPIEndpoint sets the APIEndpoint property of PatchEndpointsOptions. Activity Tracker service API
+// endpoint.
+func PatchEndpointsAPIEndpoint(apiEndpoint *EndpointsRequestAPIEndpoint) PatchEndpointsOption {
+	return func(options *PatchEndpointsOptions) {
+		options.APIEndpoint = apiEndpoint
+	}
+}
+
+// PatchEndpointsHeader sets the Headers property of PatchEndpointsOptions. Allows users to set headers on API
+// requests.
+func PatchEndpointsHeader(name string, value string) PatchEndpointsOption {
+	return func(options *PatchEndpointsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewPatchEndpointsOptionsWith returns PatchEndpointsOptions populated by applying "options" in order.
+func (*AtrackerV1) NewPatchEndpointsOptionsWith(options ...PatchEndpointsOption) *PatchEndpointsOptions {
+	patchEndpointsOptions := &PatchEndpointsOptions{}
+	for _, option := range options {
+		option(patchEndpointsOptions)
+	}
+	return patchEndpointsOptions
+}
+
+// PatchEndpointsWith is an alternate form of the PatchEndpointsWithContext method which takes functional options
+// instead of a PatchEndpointsOptions struct.
+func (atracker *AtrackerV1) PatchEndpointsWith(ctx context.Context, options ...PatchEndpointsOption) (result *Endpoints, response *core.DetailedResponse, err error) {
+	return atracker.PatchEndpointsWithContext(ctx, atracker.NewPatchEndpointsOptionsWith(options...))
+}
@@ -0,0 +1,243 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genredact. DO NOT EDIT.
+
+package atrackerv1
+
+import "github.com/IBM/platform-services-go-sdk/common"
+
+//go:generate go run ../internal/genredact
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model APIEndpoint) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model APIEndpoint) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CosEndpoint) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CosEndpoint) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CosWriteStatus) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CosWriteStatus) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateRouteOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateRouteOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateTargetOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateTargetOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteRouteOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteRouteOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteTargetOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteTargetOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Endpoints) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Endpoints) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model EndpointsRequestAPIEndpoint) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model EndpointsRequestAPIEndpoint) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetRouteOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetRouteOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetTargetOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetTargetOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PatchEndpointsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PatchEndpointsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceRouteOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceRouteOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceTargetOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceTargetOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Route) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Route) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RouteList) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RouteList) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Rule) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Rule) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Target) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Target) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model TargetList) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model TargetList) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ValidateTargetOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ValidateTargetOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Warning) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Warning) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model WarningReport) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model WarningReport) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
@@ -0,0 +1,514 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atrackerv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the ConfigChange.Kind property.
+const (
+	ConfigChangeKindTargetConst = "target"
+	ConfigChangeKindRouteConst  = "route"
+)
+
+// Constants associated with the ConfigChange.Action property.
+const (
+	ConfigChangeActionCreateConst = "create"
+	ConfigChangeActionUpdateConst = "update"
+	ConfigChangeActionDeleteConst = "delete"
+)
+
+// ConfigApplyResult : The outcome of ApplyConfig: the plan, and which of its changes were made.
+type ConfigApplyResult struct {
+	// Whether this was a dry run, in which no change was made.
+	DryRun bool
+
+	// The changes of the plan, in the order in which they are made.
+	Changes []ConfigChange
+
+	// The workflow ID sent with every request of ApplyConfig (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// ConfigChange : A target or route that ApplyConfig creates, updates or deletes.
+type ConfigChange struct {
+	// The kind of the object (a ConfigChangeKind*Const value).
+	Kind string
+
+	// The name of the target or route.
+	Name string
+
+	// The ID of the target or route. For a target or route that is created, the ID is only known once it is applied.
+	ID string
+
+	// What is done (a ConfigChangeAction*Const value).
+	Action string
+
+	// The properties that are set or changed; none for a deletion.
+	Fields []ConfigFieldChange
+
+	// Whether the change was made.
+	Applied bool
+}
+
+// ConfigFieldChange : A property of a target or route whose live value differs from its desired value.
+type ConfigFieldChange struct {
+	// The path of the property, using the JSON property names (e.g. "cos_endpoint.bucket").
+	Field string
+
+	// The live value of the property decoded from JSON, or nil if it is not set.
+	Current interface{}
+
+	// The desired value of the property decoded from JSON, or nil if it is removed.
+	Desired interface{}
+}
+
+// WritePlan writes the changes to "w" in the manner of a Terraform plan: one line per object ("+" for a creation,
+// "~" for an update and "-" for a deletion) followed by its properties, and a summary line.
+func (result *ConfigApplyResult) WritePlan(w io.Writer) (err error) {
+	if len(result.Changes) == 0 {
+		_, err = fmt.Fprintln(w, "No changes. The live configuration matches the desired targets and routes.")
+		return
+	}
+	counts := make(map[string]int)
+	for _, change := range result.Changes {
+		counts[change.Action]++
+		symbol := map[string]string{ConfigChangeActionCreateConst: "+", ConfigChangeActionUpdateConst: "~",
+			ConfigChangeActionDeleteConst: "-"}[change.Action]
+		_, err = fmt.Fprintf(w, "%s %s %q will be %sd\n", symbol, change.Kind, change.Name, change.Action)
+		if err != nil {
+			return
+		}
+		for _, field := range change.Fields {
+			current, _ := json.Marshal(field.Current)
+			desired, _ := json.Marshal(field.Desired)
+			switch {
+			case field.Current == nil:
+				_, err = fmt.Fprintf(w, "    + %s: %s\n", field.Field, desired)
+			case field.Desired == nil:
+				_, err = fmt.Fprintf(w, "    - %s: %s\n", field.Field, current)
+			default:
+				_, err = fmt.Fprintf(w, "    ~ %s: %s -> %s\n", field.Field, current, desired)
+			}
+			if err != nil {
+				return
+			}
+		}
+	}
+	_, err = fmt.Fprintf(w, "\nPlan: %d to create, %d to update, %d to delete.\n", counts[ConfigChangeActionCreateConst],
+		counts[ConfigChangeActionUpdateConst], counts[ConfigChangeActionDeleteConst])
+	return
+}
+
+// ApplyConfig makes the live targets and routes match the desired ones, which are identified by their names: the
+// desired targets and routes that don't exist are created, those that differ are replaced, and the live targets and
+// routes that are not desired are deleted. The rules of the desired routes refer to the targets by name rather than by
+// ID, so that a configuration can be applied before its targets exist; each name must be that of a desired target.
+// The changes are made in dependency order: targets are created and updated first, then routes, and routes are
+// deleted before the targets they may refer to.
+// The credentials of the targets (API keys, passwords and ingestion keys) are not returned by the service, so they
+// are not compared: they are only sent when a target is created or updated for another reason. The type and region
+// of a target cannot be changed; such a change is reported as an error, and the target must be deleted first.
+// If "dryRun" is true, the plan is returned without making any change (see ConfigApplyResult.WritePlan). Otherwise,
+// the first change that fails stops the application, and the result describes the changes applied so far along with
+// the error.
+func (atracker *AtrackerV2) ApplyConfig(ctx context.Context, desiredTargets []*CreateTargetOptions, desiredRoutes []*CreateRouteOptions, dryRun bool) (result *ConfigApplyResult, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, atracker.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	err = validateConfig(desiredTargets, desiredRoutes)
+	if err != nil {
+		return
+	}
+	targetList, _, err := atracker.ListTargetsWithContext(ctx, atracker.NewListTargetsOptions())
+	if err != nil {
+		err = fmt.Errorf("error listing the targets: %w", err)
+		return
+	}
+	routeList, _, err := atracker.ListRoutesWithContext(ctx, atracker.NewListRoutesOptions())
+	if err != nil {
+		err = fmt.Errorf("error listing the routes: %w", err)
+		return
+	}
+
+	result = &ConfigApplyResult{DryRun: dryRun, WorkflowID: workflowID}
+	result.Changes, err = planConfig(desiredTargets, desiredRoutes, targetList.Targets, routeList.Routes)
+	if err != nil || dryRun {
+		return
+	}
+
+	targetIDs := make(map[string]string)
+	for _, target := range targetList.Targets {
+		targetIDs[*target.Name] = *target.ID
+	}
+	desiredTargetsByName := make(map[string]*CreateTargetOptions)
+	for _, target := range desiredTargets {
+		desiredTargetsByName[*target.Name] = target
+	}
+	desiredRoutesByName := make(map[string]*CreateRouteOptions)
+	for _, route := range desiredRoutes {
+		desiredRoutesByName[*route.Name] = route
+	}
+	for i := range result.Changes {
+		change := &result.Changes[i]
+		err = atracker.applyConfigChange(ctx, change, desiredTargetsByName[change.Name], desiredRoutesByName[change.Name], targetIDs)
+		if err != nil {
+			err = fmt.Errorf("error applying the %s of %s '%s': %w", change.Action, change.Kind, change.Name, err)
+			return
+		}
+		change.Applied = true
+	}
+	return
+}
+
+// applyConfigChange makes one change of the plan. The IDs of the targets created are added to "targetIDs", which maps
+// the names of the targets to their IDs.
+func (atracker *AtrackerV2) applyConfigChange(ctx context.Context, change *ConfigChange, desiredTarget *CreateTargetOptions, desiredRoute *CreateRouteOptions, targetIDs map[string]string) (err error) {
+	switch {
+	case change.Kind == ConfigChangeKindTargetConst && change.Action == ConfigChangeActionCreateConst:
+		var target *Target
+		target, _, err = atracker.CreateTargetWithContext(ctx, desiredTarget)
+		if err == nil {
+			change.ID = *target.ID
+			targetIDs[change.Name] = change.ID
+		}
+	case change.Kind == ConfigChangeKindTargetConst && change.Action == ConfigChangeActionUpdateConst:
+		_, _, err = atracker.ReplaceTargetWithContext(ctx, &ReplaceTargetOptions{
+			ID:                   core.StringPtr(change.ID),
+			Name:                 desiredTarget.Name,
+			CosEndpoint:          desiredTarget.CosEndpoint,
+			LogdnaEndpoint:       desiredTarget.LogdnaEndpoint,
+			EventstreamsEndpoint: desiredTarget.EventstreamsEndpoint,
+			Headers:              desiredTarget.Headers,
+		})
+	case change.Kind == ConfigChangeKindTargetConst:
+		_, _, err = atracker.DeleteTargetWithContext(ctx, atracker.NewDeleteTargetOptions(change.ID))
+	case change.Action == ConfigChangeActionCreateConst:
+		var route *Route
+		route, _, err = atracker.CreateRouteWithContext(ctx, &CreateRouteOptions{
+			Name:    desiredRoute.Name,
+			Rules:   resolveRuleTargets(desiredRoute.Rules, targetIDs),
+			Headers: desiredRoute.Headers,
+		})
+		if err == nil {
+			change.ID = *route.ID
+		}
+	case change.Action == ConfigChangeActionUpdateConst:
+		_, _, err = atracker.ReplaceRouteWithContext(ctx, &ReplaceRouteOptions{
+			ID:      core.StringPtr(change.ID),
+			Name:    desiredRoute.Name,
+			Rules:   resolveRuleTargets(desiredRoute.Rules, targetIDs),
+			Headers: desiredRoute.Headers,
+		})
+	default:
+		_, err = atracker.DeleteRouteWithContext(ctx, atracker.NewDeleteRouteOptions(change.ID))
+	}
+	return
+}
+
+// validateConfig checks that the desired targets and routes are complete, that their names are unique and that the
+// rules of the routes refer to desired targets.
+func validateConfig(desiredTargets []*CreateTargetOptions, desiredRoutes []*CreateRouteOptions) (err error) {
+	targetNames := make(map[string]bool)
+	for _, target := range desiredTargets {
+		err = core.ValidateStruct(target, "desiredTargets")
+		if err != nil {
+			return
+		}
+		if targetNames[*target.Name] {
+			return fmt.Errorf("several targets are named '%s'", *target.Name)
+		}
+		targetNames[*target.Name] = true
+	}
+	routeNames := make(map[string]bool)
+	for _, route := range desiredRoutes {
+		err = core.ValidateStruct(route, "desiredRoutes")
+		if err != nil {
+			return
+		}
+		if routeNames[*route.Name] {
+			return fmt.Errorf("several routes are named '%s'", *route.Name)
+		}
+		routeNames[*route.Name] = true
+		for _, rule := range route.Rules {
+			for _, targetName := range rule.TargetIds {
+				if !targetNames[targetName] {
+					return fmt.Errorf("route '%s' refers to target '%s', which is not a desired target", *route.Name, targetName)
+				}
+			}
+		}
+	}
+	return
+}
+
+// planConfig returns the changes that make the live targets and routes match the desired ones, in the order in which
+// they must be made.
+func planConfig(desiredTargets []*CreateTargetOptions, desiredRoutes []*CreateRouteOptions, liveTargets []Target, liveRoutes []Route) (changes []ConfigChange, err error) {
+	liveTargetsByName := make(map[string]*Target)
+	targetNames := make(map[string]string)
+	for i := range liveTargets {
+		target := &liveTargets[i]
+		if liveTargetsByName[*target.Name] != nil {
+			return nil, fmt.Errorf("several live targets are named '%s'", *target.Name)
+		}
+		liveTargetsByName[*target.Name] = target
+		targetNames[*target.ID] = *target.Name
+	}
+	liveRoutesByName := make(map[string]*Route)
+	for i := range liveRoutes {
+		route := &liveRoutes[i]
+		if liveRoutesByName[*route.Name] != nil {
+			return nil, fmt.Errorf("several live routes are named '%s'", *route.Name)
+		}
+		liveRoutesByName[*route.Name] = route
+	}
+
+	desiredTargetNames := make(map[string]bool)
+	for _, desired := range desiredTargets {
+		name := *desired.Name
+		desiredTargetNames[name] = true
+		change := ConfigChange{Kind: ConfigChangeKindTargetConst, Name: name, Action: ConfigChangeActionCreateConst}
+		var current *targetDocument
+		if live := liveTargetsByName[name]; live != nil {
+			change.ID = *live.ID
+			change.Action = ConfigChangeActionUpdateConst
+			current = newLiveTargetDocument(live)
+			if core.StringNilMapper(live.TargetType) != *desired.TargetType {
+				return nil, fmt.Errorf("the type of target '%s' cannot be changed from '%s' to '%s'", name,
+					core.StringNilMapper(live.TargetType), *desired.TargetType)
+			}
+			if desired.Region != nil && core.StringNilMapper(live.Region) != *desired.Region {
+				return nil, fmt.Errorf("the region of target '%s' cannot be changed from '%s' to '%s'", name,
+					core.StringNilMapper(live.Region), *desired.Region)
+			}
+		}
+		change.Fields, err = diffConfigDocuments(current, newDesiredTargetDocument(desired, current))
+		if err != nil {
+			return
+		}
+		if len(change.Fields) > 0 {
+			changes = append(changes, change)
+		}
+	}
+
+	desiredRouteNames := make(map[string]bool)
+	for _, desired := range desiredRoutes {
+		name := *desired.Name
+		desiredRouteNames[name] = true
+		change := ConfigChange{Kind: ConfigChangeKindRouteConst, Name: name, Action: ConfigChangeActionCreateConst}
+		var current *routeDocument
+		if live := liveRoutesByName[name]; live != nil {
+			change.ID = *live.ID
+			change.Action = ConfigChangeActionUpdateConst
+			current = newLiveRouteDocument(live, targetNames)
+		}
+		change.Fields, err = diffConfigDocuments(current, newDesiredRouteDocument(desired))
+		if err != nil {
+			return
+		}
+		if len(change.Fields) > 0 {
+			changes = append(changes, change)
+		}
+	}
+
+	for _, live := range liveRoutes {
+		if !desiredRouteNames[*live.Name] {
+			changes = append(changes, ConfigChange{Kind: ConfigChangeKindRouteConst, Name: *live.Name, ID: *live.ID,
+				Action: ConfigChangeActionDeleteConst})
+		}
+	}
+	for _, live := range liveTargets {
+		if !desiredTargetNames[*live.Name] {
+			changes = append(changes, ConfigChange{Kind: ConfigChangeKindTargetConst, Name: *live.Name, ID: *live.ID,
+				Action: ConfigChangeActionDeleteConst})
+		}
+	}
+	return
+}
+
+// targetDocument : The properties of a target that ApplyConfig compares, without credentials.
+type targetDocument struct {
+	TargetType           *string               `json:"target_type"`
+	Region               *string               `json:"region,omitempty"`
+	CosEndpoint          *CosEndpoint          `json:"cos_endpoint,omitempty"`
+	LogdnaEndpoint       *LogdnaEndpoint       `json:"logdna_endpoint,omitempty"`
+	EventstreamsEndpoint *eventstreamsDocument `json:"eventstreams_endpoint,omitempty"`
+}
+
+// eventstreamsDocument : The properties of an Event Streams endpoint that ApplyConfig compares.
+type eventstreamsDocument struct {
+	TargetCRN *string  `json:"target_crn"`
+	Brokers   []string `json:"brokers"`
+	Topic     *string  `json:"topic"`
+}
+
+// newLiveTargetDocument returns the properties of a live target.
+func newLiveTargetDocument(target *Target) *targetDocument {
+	document := &targetDocument{
+		TargetType:     target.TargetType,
+		Region:         target.Region,
+		CosEndpoint:    target.CosEndpoint,
+		LogdnaEndpoint: target.LogdnaEndpoint,
+	}
+	if endpoint := target.EventstreamsEndpoint; endpoint != nil {
+		document.EventstreamsEndpoint = &eventstreamsDocument{TargetCRN: endpoint.TargetCRN, Brokers: endpoint.Brokers, Topic: endpoint.Topic}
+	}
+	return document
+}
+
+// newDesiredTargetDocument returns the properties of a desired target. If the target doesn't set its region, the
+// region of the live target (if any) is kept.
+func newDesiredTargetDocument(target *CreateTargetOptions, current *targetDocument) *targetDocument {
+	document := &targetDocument{TargetType: target.TargetType, Region: target.Region}
+	if document.Region == nil && current != nil {
+		document.Region = current.Region
+	}
+	if endpoint := target.CosEndpoint; endpoint != nil {
+		document.CosEndpoint = &CosEndpoint{
+			Endpoint:                endpoint.Endpoint,
+			TargetCRN:               endpoint.TargetCRN,
+			Bucket:                  endpoint.Bucket,
+			ServiceToServiceEnabled: core.BoolPtr(endpoint.ServiceToServiceEnabled != nil && *endpoint.ServiceToServiceEnabled),
+		}
+	}
+	if endpoint := target.LogdnaEndpoint; endpoint != nil {
+		document.LogdnaEndpoint = &LogdnaEndpoint{TargetCRN: endpoint.TargetCRN}
+	}
+	if endpoint := target.EventstreamsEndpoint; endpoint != nil {
+		document.EventstreamsEndpoint = &eventstreamsDocument{TargetCRN: endpoint.TargetCRN, Brokers: endpoint.Brokers, Topic: endpoint.Topic}
+	}
+	return document
+}
+
+// routeDocument : The properties of a route that ApplyConfig compares, with its rules referring to targets by name.
+type routeDocument struct {
+	Rules []RulePrototype `json:"rules"`
+}
+
+// newLiveRouteDocument returns the properties of a live route. The IDs of the targets are replaced by their names.
+func newLiveRouteDocument(route *Route, targetNames map[string]string) *routeDocument {
+	document := &routeDocument{Rules: []RulePrototype{}}
+	for _, rule := range route.Rules {
+		names := make([]string, len(rule.TargetIds))
+		for i, targetID := range rule.TargetIds {
+			names[i] = targetID
+			if name, found := targetNames[targetID]; found {
+				names[i] = name
+			}
+		}
+		prototype := RulePrototype{TargetIds: names}
+		if len(rule.Locations) > 0 {
+			prototype.Locations = rule.Locations
+		}
+		document.Rules = append(document.Rules, prototype)
+	}
+	return document
+}
+
+// newDesiredRouteDocument returns the properties of a desired route.
+func newDesiredRouteDocument(route *CreateRouteOptions) *routeDocument {
+	document := &routeDocument{Rules: []RulePrototype{}}
+	for _, rule := range route.Rules {
+		prototype := RulePrototype{TargetIds: rule.TargetIds}
+		if len(rule.Locations) > 0 {
+			prototype.Locations = rule.Locations
+		}
+		document.Rules = append(document.Rules, prototype)
+	}
+	return document
+}
+
+// resolveRuleTargets returns the rules with the names of their targets replaced by their IDs.
+func resolveRuleTargets(rules []RulePrototype, targetIDs map[string]string) []RulePrototype {
+	resolved := make([]RulePrototype, len(rules))
+	for i, rule := range rules {
+		resolved[i] = RulePrototype{TargetIds: make([]string, len(rule.TargetIds)), Locations: rule.Locations}
+		for j, name := range rule.TargetIds {
+			resolved[i].TargetIds[j] = targetIDs[name]
+		}
+	}
+	return resolved
+}
+
+// diffConfigDocuments returns the properties that differ between the current document, which is nil if the object
+// doesn't exist, and the desired one, sorted by field.
+func diffConfigDocuments(current interface{}, desired interface{}) (changes []ConfigFieldChange, err error) {
+	var currentValue, desiredValue interface{}
+	if !reflect.ValueOf(current).IsNil() {
+		currentValue, err = toConfigValue(current)
+		if err != nil {
+			return
+		}
+	}
+	desiredValue, err = toConfigValue(desired)
+	if err != nil {
+		return
+	}
+	changes = diffConfigValues("", currentValue, desiredValue, nil)
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return
+}
+
+// toConfigValue returns "value" encoded to JSON and decoded into maps, slices and primitives.
+func toConfigValue(value interface{}) (result interface{}, err error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(data, &result)
+	return
+}
+
+// diffConfigValues compares two values. Objects are compared property by property; all other values are compared as
+// a whole.
+func diffConfigValues(field string, current interface{}, desired interface{}, changes []ConfigFieldChange) []ConfigFieldChange {
+	currentObject, currentIsObject := current.(map[string]interface{})
+	desiredObject, desiredIsObject := desired.(map[string]interface{})
+	if !currentIsObject && !desiredIsObject {
+		if !reflect.DeepEqual(current, desired) {
+			changes = append(changes, ConfigFieldChange{Field: field, Current: current, Desired: desired})
+		}
+		return changes
+	}
+
+	names := make(map[string]bool)
+	for name := range currentObject {
+		names[name] = true
+	}
+	for name := range desiredObject {
+		names[name] = true
+	}
+	for name := range names {
+		propertyField := name
+		if field != "" {
+			propertyField = field + "." + name
+		}
+		changes = diffConfigValues(propertyField, currentObject[name], desiredObject[name], changes)
+	}
+	return changes
+}
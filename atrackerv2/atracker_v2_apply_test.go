@@ -0,0 +1,215 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atrackerv2_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/IBM/platform-services-go-sdk/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AtrackerV2 ApplyConfig`, func() {
+	var testServer *httptest.Server
+	var atrackerService *atrackerv2.AtrackerV2
+	var mutations []string
+	var bodies map[string]map[string]interface{}
+	var failRequest string
+	BeforeEach(func() {
+		mutations = nil
+		bodies = make(map[string]map[string]interface{})
+		failRequest = ""
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			request := req.Method + " " + req.URL.EscapedPath()
+			res.Header().Set("Content-type", "application/json")
+			if req.Method != "GET" {
+				mutations = append(mutations, request)
+			}
+			if req.Method == "POST" || req.Method == "PUT" {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				bodies[request] = body
+			}
+			if request == failRequest {
+				res.WriteHeader(400)
+				fmt.Fprint(res, `{"errors": [{"message": "invalid request"}]}`)
+				return
+			}
+			switch request {
+			case "GET /api/v2/targets":
+				fmt.Fprint(res, `{"targets": [
+					{"id": "t-archive", "name": "archive", "target_type": "cloud_object_storage", "region": "us-south",
+					 "cos_endpoint": {"endpoint": "s3.us-south.example.com", "target_crn": "crn:cos", "bucket": "audit", "service_to_service_enabled": true}},
+					{"id": "t-old", "name": "old", "target_type": "logdna", "region": "us-south", "logdna_endpoint": {"target_crn": "crn:logdna"}},
+					{"id": "t-stream", "name": "stream", "target_type": "event_streams", "region": "us-east",
+					 "eventstreams_endpoint": {"target_crn": "crn:es", "brokers": ["broker-1:9093"], "topic": "a", "password": "xxxxxx"}}]}`)
+			case "GET /api/v2/routes":
+				fmt.Fprint(res, `{"routes": [
+					{"id": "r-main", "name": "main", "rules": [{"target_ids": ["t-archive", "t-old"], "locations": ["us-south"]}]},
+					{"id": "r-legacy", "name": "legacy", "rules": [{"target_ids": ["t-old"], "locations": ["global"]}]}]}`)
+			case "POST /api/v2/targets":
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "t-new", "name": "backup"}`)
+			case "POST /api/v2/routes":
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "r-new", "name": "extra"}`)
+			case "PUT /api/v2/targets/t-stream", "PUT /api/v2/routes/r-main":
+				fmt.Fprint(res, `{}`)
+			case "DELETE /api/v2/routes/r-legacy", "DELETE /api/v2/targets/t-old":
+				res.WriteHeader(204)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+			}
+		}))
+		var serviceErr error
+		atrackerService, serviceErr = atrackerv2.NewAtrackerV2(&atrackerv2.AtrackerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(atrackerService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newTargets := func() []*atrackerv2.CreateTargetOptions {
+		return []*atrackerv2.CreateTargetOptions{
+			atrackerService.NewCreateTargetOptions("archive", "cloud_object_storage").SetCosEndpoint(&atrackerv2.CosEndpointPrototype{
+				Endpoint: core.StringPtr("s3.us-south.example.com"), TargetCRN: core.StringPtr("crn:cos"), Bucket: core.StringPtr("audit"),
+				ServiceToServiceEnabled: core.BoolPtr(true)}),
+			atrackerService.NewCreateTargetOptions("stream", "event_streams").SetEventstreamsEndpoint(&atrackerv2.EventstreamsEndpointPrototype{
+				TargetCRN: core.StringPtr("crn:es"), Brokers: []string{"broker-1:9093"}, Topic: core.StringPtr("b"), Password: core.StringPtr("secret")}),
+			atrackerService.NewCreateTargetOptions("backup", "cloud_object_storage").SetRegion("eu-de").SetCosEndpoint(&atrackerv2.CosEndpointPrototype{
+				Endpoint: core.StringPtr("s3.eu-de.example.com"), TargetCRN: core.StringPtr("crn:cos2"), Bucket: core.StringPtr("audit-eu"),
+				APIKey: core.StringPtr("key")}),
+		}
+	}
+	newRoutes := func() []*atrackerv2.CreateRouteOptions {
+		return []*atrackerv2.CreateRouteOptions{
+			atrackerService.NewCreateRouteOptions("main", []atrackerv2.RulePrototype{{TargetIds: []string{"archive", "backup"}, Locations: []string{"us-south"}}}),
+			atrackerService.NewCreateRouteOptions("extra", []atrackerv2.RulePrototype{{TargetIds: []string{"stream"}, Locations: []string{"*"}}}),
+		}
+	}
+
+	It(`Plans the changes in a dry run`, func() {
+		result, err := atrackerService.ApplyConfig(context.Background(), newTargets(), newRoutes(), true)
+		Expect(err).To(BeNil())
+		Expect(result.DryRun).To(BeTrue())
+		Expect(result.WorkflowID).ToNot(BeEmpty())
+		Expect(mutations).To(BeEmpty())
+
+		var plan bytes.Buffer
+		Expect(result.WritePlan(&plan)).To(Succeed())
+		Expect(plan.String()).To(Equal(`~ target "stream" will be updated
+    ~ eventstreams_endpoint.topic: "a" -> "b"
++ target "backup" will be created
+    + cos_endpoint.bucket: "audit-eu"
+    + cos_endpoint.endpoint: "s3.eu-de.example.com"
+    + cos_endpoint.service_to_service_enabled: false
+    + cos_endpoint.target_crn: "crn:cos2"
+    + region: "eu-de"
+    + target_type: "cloud_object_storage"
+~ route "main" will be updated
+    ~ rules: [{"locations":["us-south"],"target_ids":["archive","old"]}] -> [{"locations":["us-south"],"target_ids":["archive","backup"]}]
++ route "extra" will be created
+    + rules: [{"locations":["*"],"target_ids":["stream"]}]
+- route "legacy" will be deleted
+- target "old" will be deleted
+
+Plan: 2 to create, 2 to update, 2 to delete.
+`))
+	})
+	It(`Applies the changes in dependency order`, func() {
+		result, err := atrackerService.ApplyConfig(context.Background(), newTargets(), newRoutes(), false)
+		Expect(err).To(BeNil())
+		Expect(mutations).To(Equal([]string{
+			"PUT /api/v2/targets/t-stream",
+			"POST /api/v2/targets",
+			"PUT /api/v2/routes/r-main",
+			"POST /api/v2/routes",
+			"DELETE /api/v2/routes/r-legacy",
+			"DELETE /api/v2/targets/t-old",
+		}))
+		for _, change := range result.Changes {
+			Expect(change.Applied).To(BeTrue())
+		}
+		Expect(result.Changes[1].ID).To(Equal("t-new"))
+		Expect(result.Changes[3].ID).To(Equal("r-new"))
+		Expect(bodies["PUT /api/v2/targets/t-stream"]["eventstreams_endpoint"]).To(HaveKeyWithValue("password", "secret"))
+		Expect(bodies["PUT /api/v2/routes/r-main"]["rules"]).To(Equal([]interface{}{
+			map[string]interface{}{"target_ids": []interface{}{"t-archive", "t-new"}, "locations": []interface{}{"us-south"}},
+		}))
+		Expect(bodies["POST /api/v2/routes"]["rules"]).To(Equal([]interface{}{
+			map[string]interface{}{"target_ids": []interface{}{"t-stream"}, "locations": []interface{}{"*"}},
+		}))
+	})
+	It(`Stops at the first change that fails`, func() {
+		failRequest = "PUT /api/v2/routes/r-main"
+		result, err := atrackerService.ApplyConfig(context.Background(), newTargets(), newRoutes(), false)
+		Expect(err.Error()).To(ContainSubstring("error applying the update of route 'main'"))
+		Expect(result.Changes).To(HaveLen(6))
+		Expect(result.Changes[1].Applied).To(BeTrue())
+		Expect(result.Changes[2].Applied).To(BeFalse())
+		Expect(mutations).To(HaveLen(3))
+	})
+	It(`Reports no changes when the configuration matches`, func() {
+		targets := newTargets()
+		targets[1].EventstreamsEndpoint.Topic = core.StringPtr("a")
+		targets[2] = atrackerService.NewCreateTargetOptions("old", "logdna").SetLogdnaEndpoint(&atrackerv2.LogdnaEndpointPrototype{
+			TargetCRN: core.StringPtr("crn:logdna"), IngestionKey: core.StringPtr("key")})
+		routes := []*atrackerv2.CreateRouteOptions{
+			atrackerService.NewCreateRouteOptions("main", []atrackerv2.RulePrototype{{TargetIds: []string{"archive", "old"}, Locations: []string{"us-south"}}}),
+			atrackerService.NewCreateRouteOptions("legacy", []atrackerv2.RulePrototype{{TargetIds: []string{"old"}, Locations: []string{"global"}}}),
+		}
+		result, err := atrackerService.ApplyConfig(context.Background(), targets, routes, false)
+		Expect(err).To(BeNil())
+		Expect(result.Changes).To(BeEmpty())
+		Expect(mutations).To(BeEmpty())
+		var plan bytes.Buffer
+		Expect(result.WritePlan(&plan)).To(Succeed())
+		Expect(plan.String()).To(HavePrefix("No changes."))
+	})
+	It(`Rejects invalid configurations`, func() {
+		routes := newRoutes()
+		routes[1].Rules[0].TargetIds = []string{"old"}
+		_, err := atrackerService.ApplyConfig(context.Background(), newTargets(), routes, true)
+		Expect(err.Error()).To(ContainSubstring("route 'extra' refers to target 'old', which is not a desired target"))
+
+		targets := newTargets()
+		targets[2].SetName("stream")
+		_, err = atrackerService.ApplyConfig(context.Background(), targets, nil, true)
+		Expect(err.Error()).To(ContainSubstring("several targets are named 'stream'"))
+
+		targets = newTargets()
+		targets[0].SetRegion("eu-gb")
+		_, err = atrackerService.ApplyConfig(context.Background(), targets, nil, true)
+		Expect(err.Error()).To(ContainSubstring("the region of target 'archive' cannot be changed from 'us-south' to 'eu-gb'"))
+		Expect(mutations).To(BeEmpty())
+	})
+})
@@ -0,0 +1,209 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atrackerv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the RouteDiagnostic.Kind property.
+const (
+	RouteDiagnosticNoRulesConst        = "no_rules"
+	RouteDiagnosticNoLocationsConst    = "no_locations"
+	RouteDiagnosticTargetNotFoundConst = "target_not_found"
+	RouteDiagnosticWriteFailedConst    = "write_failed"
+)
+
+// writeStatusSuccess is the WriteStatus.Status of a target that Activity Tracker can write to.
+const writeStatusSuccess = "success"
+
+// RouteVerification : The outcome of VerifyRoute.
+type RouteVerification struct {
+	// The route.
+	Route *Route
+
+	// The problems of the route itself, such as rules without locations.
+	Diagnostics []RouteDiagnostic
+
+	// The result for each target of the route, in the order in which the rules refer to them.
+	Targets []TargetVerification
+
+	// The workflow ID sent with every request of the verification (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// TargetVerification : The result of VerifyRoute for one target of the route.
+type TargetVerification struct {
+	// The ID of the target.
+	TargetID string
+
+	// The target, with the write status of its validation, or nil if it was not found or could not be validated.
+	Target *Target
+
+	// Whether Activity Tracker could write to the target with its configured credentials.
+	Writable bool
+
+	// The problems found with the target, if any.
+	Diagnostics []RouteDiagnostic
+
+	// The error that prevented the validation of the target, if any.
+	Err error
+}
+
+// RouteDiagnostic : A problem that prevents the events of a route from landing in a target.
+type RouteDiagnostic struct {
+	// The kind of the problem (a RouteDiagnostic*Const value).
+	Kind string
+
+	// A description of the problem.
+	Detail string
+
+	// What to change to fix the problem.
+	Remedy string
+}
+
+// Verified returns true if the route has no problems and every one of its targets is writable.
+func (verification *RouteVerification) Verified() bool {
+	return len(verification.Diagnostics) == 0 && len(verification.Failed()) == 0
+}
+
+// Failed returns the results of the targets that are not writable or could not be validated.
+func (verification *RouteVerification) Failed() (failed []TargetVerification) {
+	for _, target := range verification.Targets {
+		if !target.Writable {
+			failed = append(failed, target)
+		}
+	}
+	return
+}
+
+// VerifyRoute checks that the events of a route can land in its targets. Each target that the rules of the route
+// refer to is validated by the service, which attempts to write to the Cloud Object Storage bucket, Event Streams
+// topic or LogDNA instance with the credentials configured in the target; a target that can't be written to is
+// reported with the reason given by the service and a remedy for its type of target. The rules of the route are
+// checked too.
+// A target that can't be validated is reported with its error and the other targets are still verified; only an
+// error retrieving the route is returned.
+func (atracker *AtrackerV2) VerifyRoute(ctx context.Context, routeID string) (verification *RouteVerification, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, atracker.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	route, _, err := atracker.GetRouteWithContext(ctx, atracker.NewGetRouteOptions(routeID))
+	if err != nil {
+		err = fmt.Errorf("error retrieving route '%s': %w", routeID, err)
+		return
+	}
+	verification = &RouteVerification{Route: route, WorkflowID: workflowID}
+
+	if len(route.Rules) == 0 {
+		verification.Diagnostics = append(verification.Diagnostics, RouteDiagnostic{
+			Kind:   RouteDiagnosticNoRulesConst,
+			Detail: "the route has no rules, so no events are routed",
+			Remedy: "Add a rule with the IDs of the targets and the locations of the events to route.",
+		})
+	}
+	var targetIDs []string
+	seen := make(map[string]bool)
+	for i, rule := range route.Rules {
+		if len(rule.Locations) == 0 {
+			verification.Diagnostics = append(verification.Diagnostics, RouteDiagnostic{
+				Kind:   RouteDiagnosticNoLocationsConst,
+				Detail: fmt.Sprintf("rule %d of the route has no locations, so it routes no events", i+1),
+				Remedy: "Add the locations (regions, 'global' or '*') of the events that the rule routes.",
+			})
+		}
+		for _, targetID := range rule.TargetIds {
+			if !seen[targetID] {
+				seen[targetID] = true
+				targetIDs = append(targetIDs, targetID)
+			}
+		}
+	}
+
+	for _, targetID := range targetIDs {
+		verification.Targets = append(verification.Targets, atracker.verifyTarget(ctx, targetID))
+	}
+	return
+}
+
+// verifyTarget validates one target of a route.
+func (atracker *AtrackerV2) verifyTarget(ctx context.Context, targetID string) (result TargetVerification) {
+	result.TargetID = targetID
+	target, response, err := atracker.ValidateTargetWithContext(ctx, atracker.NewValidateTargetOptions(targetID))
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			result.Diagnostics = append(result.Diagnostics, RouteDiagnostic{
+				Kind:   RouteDiagnosticTargetNotFoundConst,
+				Detail: fmt.Sprintf("target '%s' does not exist, so the events routed to it are dropped", targetID),
+				Remedy: "Create the target again, or remove it from the rules of the route.",
+			})
+			return
+		}
+		result.Err = fmt.Errorf("error validating target '%s': %w", targetID, err)
+		return
+	}
+	result.Target = target
+	if target.WriteStatus != nil && core.StringNilMapper(target.WriteStatus.Status) == writeStatusSuccess {
+		result.Writable = true
+		return
+	}
+
+	detail := fmt.Sprintf("Activity Tracker cannot write to target '%s'", core.StringNilMapper(target.Name))
+	if target.WriteStatus != nil && target.WriteStatus.ReasonForLastFailure != nil {
+		detail += ": " + *target.WriteStatus.ReasonForLastFailure
+	}
+	result.Diagnostics = append(result.Diagnostics, RouteDiagnostic{
+		Kind:   RouteDiagnosticWriteFailedConst,
+		Detail: detail,
+		Remedy: writeFailureRemedy(target),
+	})
+	return
+}
+
+// writeFailureRemedy returns what to check when Activity Tracker cannot write to a target, depending on its type.
+func writeFailureRemedy(target *Target) string {
+	switch {
+	case target.CosEndpoint != nil:
+		endpoint := target.CosEndpoint
+		if endpoint.ServiceToServiceEnabled != nil && *endpoint.ServiceToServiceEnabled {
+			return fmt.Sprintf("Create an IAM authorization that grants the Activity Tracker service (atracker) the Object "+
+				"Writer role on Cloud Object Storage instance '%s', and make sure that bucket '%s' exists at endpoint '%s'.",
+				core.StringNilMapper(endpoint.TargetCRN), core.StringNilMapper(endpoint.Bucket),
+				core.StringNilMapper(endpoint.Endpoint))
+		}
+		return fmt.Sprintf("Make sure that the API key of the target is valid and has the Object Writer role on bucket "+
+			"'%s' of Cloud Object Storage instance '%s', and that the bucket exists at endpoint '%s'.",
+			core.StringNilMapper(endpoint.Bucket), core.StringNilMapper(endpoint.TargetCRN),
+			core.StringNilMapper(endpoint.Endpoint))
+	case target.EventstreamsEndpoint != nil:
+		endpoint := target.EventstreamsEndpoint
+		return fmt.Sprintf("Make sure that the password (API key) of the target is valid and can write to topic '%s' of "+
+			"Event Streams instance '%s', and that the brokers (%s) are reachable.",
+			core.StringNilMapper(endpoint.Topic), core.StringNilMapper(endpoint.TargetCRN),
+			strings.Join(endpoint.Brokers, ", "))
+	case target.LogdnaEndpoint != nil:
+		return fmt.Sprintf("Make sure that LogDNA instance '%s' exists and is active, and that its ingestion key is valid.",
+			core.StringNilMapper(target.LogdnaEndpoint.TargetCRN))
+	}
+	return "Check the endpoint and the credentials of the target."
+}
@@ -0,0 +1,133 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atrackerv2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/IBM/platform-services-go-sdk/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AtrackerV2 VerifyRoute`, func() {
+	var testServer *httptest.Server
+	var atrackerService *atrackerv2.AtrackerV2
+	var validated []string
+	BeforeEach(func() {
+		validated = nil
+		targets := map[string]string{
+			"cos-ok": `{"id": "cos-ok", "name": "archive", "target_type": "cloud_object_storage",
+				"cos_endpoint": {"endpoint": "s3.private.us-south.cloud-object-storage.appdomain.cloud", "target_crn": "crn:cos", "bucket": "audit", "service_to_service_enabled": true},
+				"write_status": {"status": "success"}}`,
+			"cos-s2s": `{"id": "cos-s2s", "name": "backup", "target_type": "cloud_object_storage",
+				"cos_endpoint": {"endpoint": "s3.private.eu-de.cloud-object-storage.appdomain.cloud", "target_crn": "crn:cos2", "bucket": "audit-eu", "service_to_service_enabled": true},
+				"write_status": {"status": "failed", "reason_for_last_failure": "Forbidden"}}`,
+			"es": `{"id": "es", "name": "stream", "target_type": "event_streams",
+				"eventstreams_endpoint": {"target_crn": "crn:es", "brokers": ["broker-1:9093", "broker-2:9093"], "topic": "audit", "password": "xxxxxx"},
+				"write_status": {"status": "failed", "reason_for_last_failure": "SASL authentication failed"}}`,
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/api/v2/routes/route-1":
+				fmt.Fprint(res, `{"id": "route-1", "name": "audit", "rules": [
+					{"target_ids": ["cos-ok", "es"], "locations": ["us-south", "global"]},
+					{"target_ids": ["es", "cos-s2s", "missing", "broken"], "locations": []}]}`)
+			case req.Method == "POST" && strings.HasSuffix(req.URL.EscapedPath(), "/validate"):
+				targetID := strings.TrimSuffix(strings.TrimPrefix(req.URL.EscapedPath(), "/api/v2/targets/"), "/validate")
+				validated = append(validated, targetID)
+				if targetID == "broken" {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"errors": [{"message": "internal error"}]}`)
+					return
+				}
+				target, found := targets[targetID]
+				if !found {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+					return
+				}
+				fmt.Fprint(res, target)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+			}
+		}))
+		var serviceErr error
+		atrackerService, serviceErr = atrackerv2.NewAtrackerV2(&atrackerv2.AtrackerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(atrackerService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Validates each target of the route`, func() {
+		verification, err := atrackerService.VerifyRoute(context.Background(), "route-1")
+		Expect(err).To(BeNil())
+		Expect(verification.WorkflowID).ToNot(BeEmpty())
+		Expect(*verification.Route.Name).To(Equal("audit"))
+		Expect(validated).To(Equal([]string{"cos-ok", "es", "cos-s2s", "missing", "broken"}))
+		Expect(verification.Verified()).To(BeFalse())
+		Expect(verification.Diagnostics).To(HaveLen(1))
+		Expect(verification.Diagnostics[0].Kind).To(Equal(atrackerv2.RouteDiagnosticNoLocationsConst))
+		Expect(verification.Diagnostics[0].Detail).To(ContainSubstring("rule 2"))
+
+		targets := verification.Targets
+		Expect(targets).To(HaveLen(5))
+		Expect(targets[0].Writable).To(BeTrue())
+		Expect(targets[0].Diagnostics).To(BeEmpty())
+		Expect(verification.Failed()).To(HaveLen(4))
+
+		Expect(targets[1].Writable).To(BeFalse())
+		Expect(targets[1].Diagnostics).To(HaveLen(1))
+		Expect(targets[1].Diagnostics[0].Kind).To(Equal(atrackerv2.RouteDiagnosticWriteFailedConst))
+		Expect(targets[1].Diagnostics[0].Detail).To(Equal("Activity Tracker cannot write to target 'stream': SASL authentication failed"))
+		Expect(targets[1].Diagnostics[0].Remedy).To(ContainSubstring("topic 'audit' of Event Streams instance 'crn:es', and that the brokers (broker-1:9093, broker-2:9093)"))
+
+		Expect(targets[2].Diagnostics[0].Remedy).To(ContainSubstring("IAM authorization"))
+		Expect(targets[2].Diagnostics[0].Remedy).To(ContainSubstring("bucket 'audit-eu'"))
+
+		Expect(targets[3].Target).To(BeNil())
+		Expect(targets[3].Err).To(BeNil())
+		Expect(targets[3].Diagnostics[0].Kind).To(Equal(atrackerv2.RouteDiagnosticTargetNotFoundConst))
+
+		Expect(targets[4].Diagnostics).To(BeEmpty())
+		Expect(targets[4].Err.Error()).To(ContainSubstring("error validating target 'broken'"))
+	})
+	It(`Returns an error if the route cannot be retrieved`, func() {
+		verification, err := atrackerService.VerifyRoute(context.Background(), "route-2")
+		Expect(verification).To(BeNil())
+		Expect(err.Error()).To(ContainSubstring("error retrieving route 'route-2'"))
+		var workflowErr *common.WorkflowError
+		Expect(errors.As(err, &workflowErr)).To(BeTrue())
+		Expect(validated).To(BeEmpty())
+	})
+})
@@ -0,0 +1,270 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package casemanagementv1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// CaseManagementV1API : The operations of the CaseManagementV1 service, implemented by *CaseManagementV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type CaseManagementV1API interface {
+	// Clone makes a copy of "caseManagement" suitable for processing requests.
+	Clone() *CaseManagementV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// GetCases : Get cases in account
+	GetCases(getCasesOptions *GetCasesOptions) (result *CaseList, response *core.DetailedResponse, err error)
+
+	// GetCasesWithContext is an alternate form of the GetCases method which supports a Context parameter
+	GetCasesWithContext(ctx context.Context, getCasesOptions *GetCasesOptions) (result *CaseList, response *core.DetailedResponse, err error)
+
+	// CreateCase : Create a case
+	CreateCase(createCaseOptions *CreateCaseOptions) (result *Case, response *core.DetailedResponse, err error)
+
+	// CreateCaseWithContext is an alternate form of the CreateCase method which supports a Context parameter
+	CreateCaseWithContext(ctx context.Context, createCaseOptions *CreateCaseOptions) (result *Case, response *core.DetailedResponse, err error)
+
+	// GetCase : Get a case in account
+	GetCase(getCaseOptions *GetCaseOptions) (result *Case, response *core.DetailedResponse, err error)
+
+	// GetCaseWithContext is an alternate form of the GetCase method which supports a Context parameter
+	GetCaseWithContext(ctx context.Context, getCaseOptions *GetCaseOptions) (result *Case, response *core.DetailedResponse, err error)
+
+	// UpdateCaseStatus : Update case status
+	UpdateCaseStatus(updateCaseStatusOptions *UpdateCaseStatusOptions) (result *Case, response *core.DetailedResponse, err error)
+
+	// UpdateCaseStatusWithContext is an alternate form of the UpdateCaseStatus method which supports a Context
+	// parameter
+	UpdateCaseStatusWithContext(ctx context.Context, updateCaseStatusOptions *UpdateCaseStatusOptions) (result *Case, response *core.DetailedResponse, err error)
+
+	// AddComment : Add comment to case
+	AddComment(addCommentOptions *AddCommentOptions) (result *Comment, response *core.DetailedResponse, err error)
+
+	// AddCommentWithContext is an alternate form of the AddComment method which supports a Context parameter
+	AddCommentWithContext(ctx context.Context, addCommentOptions *AddCommentOptions) (result *Comment, response *core.DetailedResponse, err error)
+
+	// AddWatchlist : Add users to watchlist of case
+	AddWatchlist(addWatchlistOptions *AddWatchlistOptions) (result *WatchlistAddResponse, response *core.DetailedResponse, err error)
+
+	// AddWatchlistWithContext is an alternate form of the AddWatchlist method which supports a Context parameter
+	AddWatchlistWithContext(ctx context.Context, addWatchlistOptions *AddWatchlistOptions) (result *WatchlistAddResponse, response *core.DetailedResponse, err error)
+
+	// RemoveWatchlist : Remove users from watchlist of case
+	RemoveWatchlist(removeWatchlistOptions *RemoveWatchlistOptions) (result *Watchlist, response *core.DetailedResponse, err error)
+
+	// RemoveWatchlistWithContext is an alternate form of the RemoveWatchlist method which supports a Context parameter
+	RemoveWatchlistWithContext(ctx context.Context, removeWatchlistOptions *RemoveWatchlistOptions) (result *Watchlist, response *core.DetailedResponse, err error)
+
+	// AddResource : Add a resource to case
+	AddResource(addResourceOptions *AddResourceOptions) (result *Resource, response *core.DetailedResponse, err error)
+
+	// AddResourceWithContext is an alternate form of the AddResource method which supports a Context parameter
+	AddResourceWithContext(ctx context.Context, addResourceOptions *AddResourceOptions) (result *Resource, response *core.DetailedResponse, err error)
+
+	// UploadFile : Add attachment(s) to case
+	UploadFile(uploadFileOptions *UploadFileOptions) (result *Attachment, response *core.DetailedResponse, err error)
+
+	// UploadFileWithContext is an alternate form of the UploadFile method which supports a Context parameter
+	UploadFileWithContext(ctx context.Context, uploadFileOptions *UploadFileOptions) (result *Attachment, response *core.DetailedResponse, err error)
+
+	// DownloadFile : Download an attachment
+	DownloadFile(downloadFileOptions *DownloadFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// DownloadFileWithContext is an alternate form of the DownloadFile method which supports a Context parameter
+	DownloadFileWithContext(ctx context.Context, downloadFileOptions *DownloadFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// DeleteFile : Remove attachment from case
+	DeleteFile(deleteFileOptions *DeleteFileOptions) (result *AttachmentList, response *core.DetailedResponse, err error)
+
+	// DeleteFileWithContext is an alternate form of the DeleteFile method which supports a Context parameter
+	DeleteFileWithContext(ctx context.Context, deleteFileOptions *DeleteFileOptions) (result *AttachmentList, response *core.DetailedResponse, err error)
+
+	// NewAddCommentOptions : Instantiate AddCommentOptions
+	NewAddCommentOptions(caseNumber string, comment string) *AddCommentOptions
+
+	// NewAddResourceOptions : Instantiate AddResourceOptions
+	NewAddResourceOptions(caseNumber string) *AddResourceOptions
+
+	// NewAddWatchlistOptions : Instantiate AddWatchlistOptions
+	NewAddWatchlistOptions(caseNumber string) *AddWatchlistOptions
+
+	// NewCreateCaseOptions : Instantiate CreateCaseOptions
+	NewCreateCaseOptions(typeVar string, subject string, description string) *CreateCaseOptions
+
+	// NewDeleteFileOptions : Instantiate DeleteFileOptions
+	NewDeleteFileOptions(caseNumber string, fileID string) *DeleteFileOptions
+
+	// NewDownloadFileOptions : Instantiate DownloadFileOptions
+	NewDownloadFileOptions(caseNumber string, fileID string) *DownloadFileOptions
+
+	// NewFileWithMetadata : Instantiate FileWithMetadata (Generic Model Constructor)
+	NewFileWithMetadata(data io.ReadCloser) (model *FileWithMetadata, err error)
+
+	// NewGetCaseOptions : Instantiate GetCaseOptions
+	NewGetCaseOptions(caseNumber string) *GetCaseOptions
+
+	// NewGetCasesOptions : Instantiate GetCasesOptions
+	NewGetCasesOptions() *GetCasesOptions
+
+	// NewOffering : Instantiate Offering (Generic Model Constructor)
+	NewOffering(name string, typeVar *OfferingType) (model *Offering, err error)
+
+	// NewOfferingType : Instantiate OfferingType (Generic Model Constructor)
+	NewOfferingType(group string, key string) (model *OfferingType, err error)
+
+	// NewRemoveWatchlistOptions : Instantiate RemoveWatchlistOptions
+	NewRemoveWatchlistOptions(caseNumber string) *RemoveWatchlistOptions
+
+	// NewUpdateCaseStatusOptions : Instantiate UpdateCaseStatusOptions
+	NewUpdateCaseStatusOptions(caseNumber string, statusPayload StatusPayloadIntf) *UpdateCaseStatusOptions
+
+	// NewUploadFileOptions : Instantiate UploadFileOptions
+	NewUploadFileOptions(caseNumber string, file []FileWithMetadata) *UploadFileOptions
+
+	// NewUser : Instantiate User (Generic Model Constructor)
+	NewUser(realm string, userID string) (model *User, err error)
+
+	// NewResolvePayload : Instantiate ResolvePayload (Generic Model Constructor)
+	NewResolvePayload(action string, resolutionCode int64) (model *ResolvePayload, err error)
+
+	// NewUnresolvePayload : Instantiate UnresolvePayload (Generic Model Constructor)
+	NewUnresolvePayload(action string, comment string) (model *UnresolvePayload, err error)
+
+	// NudgeStaleCases finds the open cases (see StaleCaseStatuses) that have not been updated for at least "olderThan"
+	// and posts a follow-up comment to each of them.
+	NudgeStaleCases(ctx context.Context, olderThan time.Duration, commentTemplate string, dryRun bool) (nudged []CaseNudge, err error)
+
+	// NewGetCasesOptionsWith returns GetCasesOptions populated by applying "options" in order.
+	NewGetCasesOptionsWith(options ...GetCasesOption) *GetCasesOptions
+
+	// GetCasesWith is an alternate form of the GetCasesWithContext method which takes functional options instead of a
+	// GetCasesOptions struct.
+	GetCasesWith(ctx context.Context, options ...GetCasesOption) (result *CaseList, response *core.DetailedResponse, err error)
+
+	// NewCreateCaseOptionsWith returns CreateCaseOptions populated by applying "options" in order.
+	NewCreateCaseOptionsWith(options ...CreateCaseOption) *CreateCaseOptions
+
+	// CreateCaseWith is an alternate form of the CreateCaseWithContext method which takes functional options instead
+	// of a CreateCaseOptions struct.
+	CreateCaseWith(ctx context.Context, options ...CreateCaseOption) (result *Case, response *core.DetailedResponse, err error)
+
+	// NewGetCaseOptionsWith returns GetCaseOptions populated by applying "options" in order.
+	NewGetCaseOptionsWith(options ...GetCaseOption) *GetCaseOptions
+
+	// GetCaseWith is an alternate form of the GetCaseWithContext method which takes functional options instead of a
+	// GetCaseOptions struct.
+	GetCaseWith(ctx context.Context, options ...GetCaseOption) (result *Case, response *core.DetailedResponse, err error)
+
+	// NewUpdateCaseStatusOptionsWith returns UpdateCaseStatusOptions populated by applying "options" in order.
+	NewUpdateCaseStatusOptionsWith(options ...UpdateCaseStatusOption) *UpdateCaseStatusOptions
+
+	// UpdateCaseStatusWith is an alternate form of the UpdateCaseStatusWithContext method which takes functional
+	// options instead of an UpdateCaseStatusOptions struct.
+	UpdateCaseStatusWith(ctx context.Context, options ...UpdateCaseStatusOption) (result *Case, response *core.DetailedResponse, err error)
+
+	// NewAddCommentOptionsWith returns AddCommentOptions populated by applying "options" in order.
+	NewAddCommentOptionsWith(options ...AddCommentOption) *AddCommentOptions
+
+	// AddCommentWith is an alternate form of the AddCommentWithContext method which takes functional options instead
+	// of an AddCommentOptions struct.
+	AddCommentWith(ctx context.Context, options ...AddCommentOption) (result *Comment, response *core.DetailedResponse, err error)
+
+	// NewAddWatchlistOptionsWith returns AddWatchlistOptions populated by applying "options" in order.
+	NewAddWatchlistOptionsWith(options ...AddWatchlistOption) *AddWatchlistOptions
+
+	// AddWatchlistWith is an alternate form of the AddWatchlistWithContext method which takes functional options
+	// instead of an AddWatchlistOptions struct.
+	AddWatchlistWith(ctx context.Context, options ...AddWatchlistOption) (result *WatchlistAddResponse, response *core.DetailedResponse, err error)
+
+	// NewRemoveWatchlistOptionsWith returns RemoveWatchlistOptions populated by applying "options" in order.
+	NewRemoveWatchlistOptionsWith(options ...RemoveWatchlistOption) *RemoveWatchlistOptions
+
+	// RemoveWatchlistWith is an alternate form of the RemoveWatchlistWithContext method which takes functional options
+	// instead of a RemoveWatchlistOptions struct.
+	RemoveWatchlistWith(ctx context.Context, options ...RemoveWatchlistOption) (result *Watchlist, response *core.DetailedResponse, err error)
+
+	// NewAddResourceOptionsWith returns AddResourceOptions populated by applying "options" in order.
+	NewAddResourceOptionsWith(options ...AddResourceOption) *AddResourceOptions
+
+	// AddResourceWith is an alternate form of the AddResourceWithContext method which takes functional options instead
+	// of an AddResourceOptions struct.
+	AddResourceWith(ctx context.Context, options ...AddResourceOption) (result *Resource, response *core.DetailedResponse, err error)
+
+	// NewUploadFileOptionsWith returns UploadFileOptions populated by applying "options" in order.
+	NewUploadFileOptionsWith(options ...UploadFileOption) *UploadFileOptions
+
+	// UploadFileWith is an alternate form of the UploadFileWithContext method which takes functional options instead
+	// of an UploadFileOptions struct.
+	UploadFileWith(ctx context.Context, options ...UploadFileOption) (result *Attachment, response *core.DetailedResponse, err error)
+
+	// NewDownloadFileOptionsWith returns DownloadFileOptions populated by applying "options" in order.
+	NewDownloadFileOptionsWith(options ...DownloadFileOption) *DownloadFileOptions
+
+	// DownloadFileWith is an alternate form of the DownloadFileWithContext method which takes functional options
+	// instead of a DownloadFileOptions struct.
+	DownloadFileWith(ctx context.Context, options ...DownloadFileOption) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// NewDeleteFileOptionsWith returns DeleteFileOptions populated by applying "options" in order.
+	NewDeleteFileOptionsWith(options ...DeleteFileOption) *DeleteFileOptions
+
+	// DeleteFileWith is an alternate form of the DeleteFileWithContext method which takes functional options instead
+	// of a DeleteFileOptions struct.
+	DeleteFileWith(ctx context.Context, options ...DeleteFileOption) (result *AttachmentList, response *core.DetailedResponse, err error)
+
+	// RequestSeverityChange asks IBM support to change the severity of a case by adding a comment in a standard format
+	// that records the current severity, the requested severity and the justification.
+	RequestSeverityChange(ctx context.Context, caseNumber string, newSeverity int64, justification string) (result *SeverityChangeRequest, err error)
+
+	// GetCaseSLA retrieves a case with the fields needed by MeasureCaseSLA and measures it at the current time.
+	GetCaseSLA(ctx context.Context, caseNumber string, statusHistory []CaseStatusChange) (result *CaseSLA, err error)
+
+	// GetCaseTimeline retrieves a case with the fields needed by BuildCaseTimeline and builds its timeline.
+	GetCaseTimeline(ctx context.Context, caseNumber string, statusHistory []CaseStatusChange) (timeline *CaseTimeline, err error)
+}
+
+var _ CaseManagementV1API = (*CaseManagementV1)(nil)
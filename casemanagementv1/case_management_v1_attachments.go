@@ -0,0 +1,205 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// attachmentTruncatedMarker is appended to text attachments that were truncated by an AttachmentPreprocessor.
+const attachmentTruncatedMarker = "\n[truncated by client: %d bytes removed]\n"
+
+var (
+	jpegSignature = []byte{0xFF, 0xD8}
+	pngSignature  = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n'}
+
+	// JPEG segments that carry EXIF, XMP, IPTC or comment metadata.
+	jpegMetadataMarkers = map[byte]bool{
+		0xE1: true, // APP1: EXIF and XMP
+		0xED: true, // APP13: Photoshop IPTC
+		0xFE: true, // COM
+	}
+
+	// PNG chunks that carry textual, EXIF or timestamp metadata.
+	pngMetadataChunks = map[string]bool{
+		"tEXt": true,
+		"zTXt": true,
+		"iTXt": true,
+		"eXIf": true,
+		"tIME": true,
+	}
+
+	textAttachmentExtensions = map[string]bool{
+		".log":  true,
+		".txt":  true,
+		".out":  true,
+		".json": true,
+		".csv":  true,
+	}
+)
+
+// AttachmentPreprocessor : Rewrites case attachments before they are uploaded.
+type AttachmentPreprocessor struct {
+	// If true, EXIF, XMP, IPTC, comment and text metadata (including GPS location) is removed from JPEG and PNG images.
+	StripImageMetadata bool
+
+	// If greater than zero, text attachments larger than this number of bytes are truncated to it.
+	MaxTextSize int
+}
+
+// Process returns a copy of "file" whose data has been rewritten according to the preprocessor settings.
+// The data of "file" is read in full and closed.
+func (preprocessor *AttachmentPreprocessor) Process(file FileWithMetadata) (processed FileWithMetadata, err error) {
+	processed = file
+	if preprocessor == nil || file.Data == nil {
+		return
+	}
+
+	data, err := ioutil.ReadAll(file.Data)
+	file.Data.Close()
+	if err != nil {
+		return
+	}
+
+	switch {
+	case preprocessor.StripImageMetadata && bytes.HasPrefix(data, jpegSignature):
+		data, err = stripJPEGMetadata(data)
+	case preprocessor.StripImageMetadata && bytes.HasPrefix(data, pngSignature):
+		data, err = stripPNGMetadata(data)
+	case preprocessor.MaxTextSize > 0 && len(data) > preprocessor.MaxTextSize && isTextAttachment(&file):
+		removed := len(data) - preprocessor.MaxTextSize
+		data = append(data[:preprocessor.MaxTextSize:preprocessor.MaxTextSize], fmt.Sprintf(attachmentTruncatedMarker, removed)...)
+	}
+	if err != nil {
+		err = fmt.Errorf("error preprocessing attachment '%s': %s", core.StringNilMapper(file.Filename), err.Error())
+		return
+	}
+
+	processed.Data = ioutil.NopCloser(bytes.NewReader(data))
+	return
+}
+
+// AttachmentPreprocessingClient : Wraps a CaseManagementV1 client so that attachments are passed through an
+// AttachmentPreprocessor before they are uploaded.
+// All other operations are passed through to the wrapped client unchanged.
+type AttachmentPreprocessingClient struct {
+	*CaseManagementV1
+
+	// The preprocessor applied to each uploaded file.
+	Preprocessor *AttachmentPreprocessor
+}
+
+// NewAttachmentPreprocessingClient returns a new AttachmentPreprocessingClient that applies "preprocessor" to the
+// attachments uploaded with "caseManagement".
+func NewAttachmentPreprocessingClient(caseManagement *CaseManagementV1, preprocessor *AttachmentPreprocessor) *AttachmentPreprocessingClient {
+	return &AttachmentPreprocessingClient{
+		CaseManagementV1: caseManagement,
+		Preprocessor:     preprocessor,
+	}
+}
+
+// UploadFile preprocesses each file and then adds the files as attachments to the case.
+func (client *AttachmentPreprocessingClient) UploadFile(uploadFileOptions *UploadFileOptions) (result *Attachment, response *core.DetailedResponse, err error) {
+	return client.UploadFileWithContext(context.Background(), uploadFileOptions)
+}
+
+// UploadFileWithContext is an alternate form of the UploadFile method which supports a Context parameter
+func (client *AttachmentPreprocessingClient) UploadFileWithContext(ctx context.Context, uploadFileOptions *UploadFileOptions) (result *Attachment, response *core.DetailedResponse, err error) {
+	if uploadFileOptions == nil {
+		return client.CaseManagementV1.UploadFileWithContext(ctx, uploadFileOptions)
+	}
+
+	processedOptions := *uploadFileOptions
+	processedOptions.File = make([]FileWithMetadata, len(uploadFileOptions.File))
+	for i, file := range uploadFileOptions.File {
+		processedOptions.File[i], err = client.Preprocessor.Process(file)
+		if err != nil {
+			return
+		}
+	}
+	return client.CaseManagementV1.UploadFileWithContext(ctx, &processedOptions)
+}
+
+func isTextAttachment(file *FileWithMetadata) bool {
+	if file.ContentType != nil {
+		return strings.HasPrefix(*file.ContentType, "text/")
+	}
+	if file.Filename != nil {
+		return textAttachmentExtensions[strings.ToLower(path.Ext(*file.Filename))]
+	}
+	return false
+}
+
+// stripJPEGMetadata removes the metadata segments that precede the image scan.
+func stripJPEGMetadata(data []byte) ([]byte, error) {
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(jpegSignature)
+	pos := len(jpegSignature)
+	for {
+		if pos+4 > len(data) || data[pos] != 0xFF {
+			return nil, fmt.Errorf("malformed JPEG segment at offset %d", pos)
+		}
+		marker := data[pos+1]
+		// Start of scan: the rest of the file is entropy-coded image data.
+		if marker == 0xDA {
+			out.Write(data[pos:])
+			return out.Bytes(), nil
+		}
+		end := pos + 2 + int(binary.BigEndian.Uint16(data[pos+2:]))
+		if end > len(data) {
+			return nil, fmt.Errorf("truncated JPEG segment at offset %d", pos)
+		}
+		if !jpegMetadataMarkers[marker] {
+			out.Write(data[pos:end])
+		}
+		pos = end
+	}
+}
+
+// stripPNGMetadata removes the ancillary chunks that carry metadata.
+func stripPNGMetadata(data []byte) ([]byte, error) {
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(pngSignature)
+	pos := len(pngSignature)
+	for pos < len(data) {
+		if pos+8 > len(data) {
+			return nil, fmt.Errorf("malformed PNG chunk at offset %d", pos)
+		}
+		// Each chunk is a 4-byte length, a 4-byte type, the data and a 4-byte CRC.
+		end := pos + 12 + int(binary.BigEndian.Uint32(data[pos:]))
+		if end > len(data) || end < pos {
+			return nil, fmt.Errorf("truncated PNG chunk at offset %d", pos)
+		}
+		chunkType := string(data[pos+4 : pos+8])
+		if !pngMetadataChunks[chunkType] {
+			out.Write(data[pos:end])
+		}
+		pos = end
+		if chunkType == "IEND" {
+			break
+		}
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CaseManagementV1 AttachmentPreprocessor`, func() {
+	jpegSegment := func(marker byte, payload string) []byte {
+		length := len(payload) + 2
+		return append([]byte{0xFF, marker, byte(length >> 8), byte(length)}, payload...)
+	}
+	pngChunk := func(chunkType string, payload string) []byte {
+		length := len(payload)
+		chunk := []byte{byte(length >> 24), byte(length >> 16), byte(length >> 8), byte(length)}
+		chunk = append(chunk, chunkType...)
+		chunk = append(chunk, payload...)
+		return append(chunk, 0, 0, 0, 0)
+	}
+	join := func(parts ...[]byte) []byte {
+		return bytes.Join(parts, nil)
+	}
+	newFile := func(data []byte, filename string, contentType string) casemanagementv1.FileWithMetadata {
+		file := casemanagementv1.FileWithMetadata{
+			Data:     ioutil.NopCloser(bytes.NewReader(data)),
+			Filename: core.StringPtr(filename),
+		}
+		if contentType != "" {
+			file.ContentType = core.StringPtr(contentType)
+		}
+		return file
+	}
+	process := func(preprocessor *casemanagementv1.AttachmentPreprocessor, file casemanagementv1.FileWithMetadata) []byte {
+		processed, err := preprocessor.Process(file)
+		Expect(err).To(BeNil())
+		data, err := ioutil.ReadAll(processed.Data)
+		Expect(err).To(BeNil())
+		return data
+	}
+
+	jfif := jpegSegment(0xE0, "JFIF\x00")
+	exif := jpegSegment(0xE1, "Exif\x00\x00GPS host.internal")
+	comment := jpegSegment(0xFE, "build-server-01")
+	quantization := jpegSegment(0xDB, "tables")
+	scan := append(jpegSegment(0xDA, "scan"), 0x12, 0xFF, 0x00, 0xE1, 0xFF, 0xD9)
+	jpeg := join([]byte{0xFF, 0xD8}, jfif, exif, comment, quantization, scan)
+
+	pngSignature := []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n'}
+	header := pngChunk("IHDR", "0123456789abc")
+	text := pngChunk("tEXt", "Comment\x00host.internal")
+	exifChunk := pngChunk("eXIf", "MM\x00*GPS")
+	image := pngChunk("IDAT", "pixels")
+	end := pngChunk("IEND", "")
+	png := join(pngSignature, header, text, exifChunk, image, end)
+
+	It(`Strips JPEG metadata segments`, func() {
+		preprocessor := &casemanagementv1.AttachmentPreprocessor{StripImageMetadata: true}
+		Expect(process(preprocessor, newFile(jpeg, "screen.jpg", "image/jpeg"))).To(Equal(join([]byte{0xFF, 0xD8}, jfif, quantization, scan)))
+	})
+	It(`Strips PNG metadata chunks`, func() {
+		preprocessor := &casemanagementv1.AttachmentPreprocessor{StripImageMetadata: true}
+		Expect(process(preprocessor, newFile(png, "screen.png", ""))).To(Equal(join(pngSignature, header, image, end)))
+	})
+	It(`Leaves images unchanged unless configured`, func() {
+		preprocessor := &casemanagementv1.AttachmentPreprocessor{MaxTextSize: 4}
+		Expect(process(preprocessor, newFile(jpeg, "screen.jpg", "image/jpeg"))).To(Equal(jpeg))
+		var nilPreprocessor *casemanagementv1.AttachmentPreprocessor
+		Expect(process(nilPreprocessor, newFile(png, "screen.png", "image/png"))).To(Equal(png))
+	})
+	It(`Reports malformed images`, func() {
+		preprocessor := &casemanagementv1.AttachmentPreprocessor{StripImageMetadata: true}
+		_, err := preprocessor.Process(newFile(jpeg[:10], "screen.jpg", "image/jpeg"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("screen.jpg"))
+		_, err = preprocessor.Process(newFile(png[:20], "screen.png", "image/png"))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Truncates oversized text attachments`, func() {
+		preprocessor := &casemanagementv1.AttachmentPreprocessor{MaxTextSize: 10}
+		log := []byte(strings.Repeat("x", 25))
+		Expect(string(process(preprocessor, newFile(log, "app.log", "")))).To(Equal("xxxxxxxxxx\n[truncated by client: 15 bytes removed]\n"))
+		Expect(string(process(preprocessor, newFile(log, "trace", "text/plain")))).To(HavePrefix("xxxxxxxxxx\n[truncated"))
+		Expect(process(preprocessor, newFile(log, "dump.bin", "application/octet-stream"))).To(Equal(log))
+		Expect(process(preprocessor, newFile(log[:10], "app.log", ""))).To(Equal(log[:10]))
+	})
+	It(`Preprocesses files uploaded through the client`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/cases/TS1234/attachments"))
+			Expect(req.ParseMultipartForm(1 << 20)).To(BeNil())
+			files := req.MultipartForm.File["file"]
+			Expect(files).To(HaveLen(2))
+			contents := []string{}
+			for _, fileHeader := range files {
+				file, err := fileHeader.Open()
+				Expect(err).To(BeNil())
+				data, err := ioutil.ReadAll(file)
+				Expect(err).To(BeNil())
+				contents = append(contents, string(data))
+			}
+			Expect(contents[0]).To(Equal(string(join(pngSignature, header, image, end))))
+			Expect(contents[1]).To(HavePrefix("xxxx\n[truncated"))
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "attachment1"}`)
+		}))
+		defer testServer.Close()
+
+		caseManagementService, serviceErr := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		client := casemanagementv1.NewAttachmentPreprocessingClient(caseManagementService, &casemanagementv1.AttachmentPreprocessor{
+			StripImageMetadata: true,
+			MaxTextSize:        4,
+		})
+
+		options := client.NewUploadFileOptions("TS1234", []casemanagementv1.FileWithMetadata{
+			newFile(png, "screen.png", "image/png"),
+			newFile([]byte("xxxxxxxx"), "app.log", "text/plain"),
+		})
+		result, _, err := client.UploadFile(options)
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("attachment1"))
+	})
+})
@@ -0,0 +1,363 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// DefaultCaseExportPageSize is the default value of CaseExporter.PageSize.
+const DefaultCaseExportPageSize = 100
+
+// caseExportSort is the order in which the cases are exported: by creation date, so that the cases created during an
+// export are added after the pages already written rather than shifting them.
+const caseExportSort = GetCasesOptionsFieldsCreatedAtConst
+
+// CaseExportColumns are the columns that ExportAllCases can write, in order; each is named after the case field it
+// is taken from. The users ("contact", "created_by", "updated_by" and "watchlist") are written as their user IDs,
+// "offering" is the name of the offering, and the lists ("resources", "attachments" and "watchlist") are separated by
+// ";": the CRNs of the resources and the file names of the attachments.
+var CaseExportColumns = []common.Column{
+	{Name: GetCasesOptionsFieldsNumberConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsShortDescriptionConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsDescriptionConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsStatusConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsSeverityConst, Type: common.ColumnTypeDouble},
+	{Name: GetCasesOptionsFieldsSupportTierConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsContactTypeConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsContactConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsCreatedAtConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsCreatedByConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsUpdatedAtConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsUpdatedByConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsResolutionConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsCloseNotesConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsOfferingConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsResourcesConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsAttachmentsConst, Type: common.ColumnTypeString},
+	{Name: GetCasesOptionsFieldsWatchlistConst, Type: common.ColumnTypeString},
+}
+
+// CaseExportCommentsColumn is the column added by CaseExportFilter.IncludeComments. It holds the comments of the
+// case as a JSON array, in chronological order.
+var CaseExportCommentsColumn = common.Column{Name: GetCasesOptionsFieldsCommentsConst, Type: common.ColumnTypeString}
+
+// CaseExportFilter : The cases exported by ExportAllCases, and the columns written for each of them.
+type CaseExportFilter struct {
+	// If not empty, only the cases with one of these statuses (see the GetCasesOptions.Status constants) are exported.
+	Status []string
+
+	// If not empty, only the cases that contain this string are exported.
+	Search string
+
+	// The names of the columns to write, in the order of CaseExportColumns. Only these fields of the cases are
+	// retrieved. If empty, all the columns are written. The "number" column is always written.
+	Fields []string
+
+	// Whether the comments of the cases are written too (see CaseExportCommentsColumn).
+	IncludeComments bool
+}
+
+// CaseExporter : Exports every case of the account to an io.Writer, for example to archive the support history
+// outside IBM Cloud. An export can be checkpointed, so that an export that is interrupted continues where it stopped.
+type CaseExporter struct {
+	*CaseManagementV1
+
+	// The number of cases retrieved per request. Defaults to DefaultCaseExportPageSize.
+	PageSize int64
+
+	// The ID of the export's checkpoint. Required if Checkpointer is set.
+	JobID string
+
+	// Stores the position of the export after each page. If nil, an interrupted export starts again from the first
+	// page.
+	Checkpointer common.Checkpointer
+}
+
+// NewCaseExporter returns a new CaseExporter that uses the specified client, without checkpoints.
+func NewCaseExporter(caseManagement *CaseManagementV1) *CaseExporter {
+	return &CaseExporter{
+		CaseManagementV1: caseManagement,
+		PageSize:         DefaultCaseExportPageSize,
+	}
+}
+
+// CaseExport : The outcome of ExportAllCases.
+type CaseExport struct {
+	// The columns written, in order.
+	Columns []common.Column
+
+	// The number of cases written by this run of the export.
+	Cases int64
+
+	// The number of pages retrieved by this run of the export.
+	Pages int64
+
+	// Whether this run continued from a saved checkpoint.
+	Resumed bool
+
+	// The number of cases exported so far, including those written by earlier runs of the export.
+	Offset int64
+
+	// Whether every case has been exported.
+	Done bool
+}
+
+// ExportAllCases writes the cases selected by "filter" (all the cases of the account if nil) to "w" in the specified
+// format (common.ExportFormatCSV or common.ExportFormatNDJSON), with the columns it selects. The cases are exported
+// in the order in which they were created, one page at a time, and each page is written and flushed to "w" before the
+// next one is requested.
+//
+// The export runs as a common.Job: if the exporter has a Checkpointer, the position of the export is saved after each
+// page has been written, and an export that finds a checkpoint for its JobID continues from it: the caller should then
+// direct the output to a new object, as a CSV export starts with a header row again. The checkpoint is cleared once the
+// export is complete. If the export fails, the cases written so far are described by the returned export along with the
+// error.
+func (exporter *CaseExporter) ExportAllCases(ctx context.Context, filter *CaseExportFilter, w io.Writer, format string) (export *CaseExport, err error) {
+	if format != common.ExportFormatCSV && format != common.ExportFormatNDJSON {
+		err = fmt.Errorf("unsupported case export format '%s'", format)
+		return
+	}
+	if exporter.Checkpointer != nil && exporter.JobID == "" {
+		err = fmt.Errorf("a job ID is required to checkpoint the export")
+		return
+	}
+	if filter == nil {
+		filter = &CaseExportFilter{}
+	}
+	columns, err := caseExportColumns(filter)
+	if err != nil {
+		return
+	}
+	pageSize := exporter.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultCaseExportPageSize
+	}
+	fields := make([]string, len(columns))
+	for i, column := range columns {
+		fields[i] = column.Name
+	}
+	options := exporter.NewGetCasesOptions().SetFields(fields).SetSort(caseExportSort).SetLimit(pageSize)
+	if len(filter.Status) > 0 {
+		options.SetStatus(filter.Status)
+	}
+	if filter.Search != "" {
+		options.SetSearch(filter.Search)
+	}
+
+	export = &CaseExport{Columns: columns}
+	tableWriter, err := common.NewTableWriter(w, format, columns)
+	if err != nil {
+		return
+	}
+
+	// The cursor of the job is the offset of the next page.
+	fetch := func(ctx context.Context, cursor string) (items []interface{}, nextCursor string, err error) {
+		offset, err := parseCaseExportCheckpoint(exporter.JobID, cursor)
+		if err != nil {
+			return
+		}
+		if export.Pages == 0 {
+			export.Resumed = cursor != ""
+			export.Offset = offset
+		}
+		options.SetOffset(offset)
+		caseList, _, err := exporter.GetCasesWithContext(ctx, options)
+		if err != nil {
+			err = fmt.Errorf("error retrieving the cases from offset %d: %w", offset, err)
+			return
+		}
+		for i := range caseList.Cases {
+			items = append(items, &caseList.Cases[i])
+		}
+		if len(caseList.Cases) > 0 && caseList.Next != nil && caseList.Next.Href != nil {
+			nextCursor = strconv.FormatInt(offset+int64(len(caseList.Cases)), 10)
+		}
+		return
+	}
+	job := &common.Job{
+		ID:           exporter.JobID,
+		Checkpointer: exporter.Checkpointer,
+		AfterPage: func(ctx context.Context) error {
+			if err := tableWriter.(common.TableFlusher).Flush(); err != nil {
+				return fmt.Errorf("error writing the cases: %w", err)
+			}
+			return nil
+		},
+		OnProgress: func(progress common.JobProgress) {
+			// The offset moves past the cases of the page.
+			export.Offset += progress.Items - export.Cases
+			export.Cases = progress.Items
+			export.Pages = progress.Pages
+			export.Done = progress.Done
+		},
+	}
+	err = job.Run(ctx, fetch, func(ctx context.Context, item interface{}) error {
+		return writeCaseExportRow(tableWriter, columns, item.(*Case))
+	})
+	var jobErr *common.JobError
+	if errors.As(err, &jobErr) {
+		err = jobErr.Err
+	}
+	if err != nil {
+		return
+	}
+	err = tableWriter.Close()
+	return
+}
+
+// caseExportColumns returns the columns selected by a filter.
+func caseExportColumns(filter *CaseExportFilter) (columns []common.Column, err error) {
+	selected := map[string]bool{GetCasesOptionsFieldsNumberConst: true}
+	for _, field := range filter.Fields {
+		selected[field] = true
+	}
+	for _, column := range CaseExportColumns {
+		if len(filter.Fields) == 0 || selected[column.Name] {
+			columns = append(columns, column)
+			delete(selected, column.Name)
+		}
+	}
+	for _, field := range filter.Fields {
+		if selected[field] {
+			err = fmt.Errorf("case field '%s' cannot be exported", field)
+			return
+		}
+	}
+	if filter.IncludeComments {
+		columns = append(columns, CaseExportCommentsColumn)
+	}
+	return
+}
+
+// parseCaseExportCheckpoint returns the offset saved as the checkpoint of an export, or zero if "checkpoint" is empty.
+func parseCaseExportCheckpoint(jobID string, checkpoint string) (offset int64, err error) {
+	if checkpoint == "" {
+		return
+	}
+	offset, err = strconv.ParseInt(checkpoint, 10, 64)
+	if err != nil || offset < 0 {
+		err = fmt.Errorf("invalid checkpoint '%s' for case export '%s'", checkpoint, jobID)
+	}
+	return
+}
+
+// writeCaseExportRow writes the row of a case.
+func writeCaseExportRow(tableWriter common.TableWriter, columns []common.Column, supportCase *Case) (err error) {
+	values := make([]interface{}, len(columns))
+	for i, column := range columns {
+		values[i], err = caseExportValue(supportCase, column.Name)
+		if err != nil {
+			err = fmt.Errorf("error exporting the %s of case '%s': %w", column.Name, core.StringNilMapper(supportCase.Number), err)
+			return
+		}
+	}
+	return tableWriter.WriteRow(values...)
+}
+
+// caseExportValue returns the value of a column for a case, or nil if the case doesn't have it.
+func caseExportValue(supportCase *Case, name string) (interface{}, error) {
+	switch name {
+	case GetCasesOptionsFieldsNumberConst:
+		return supportCase.Number, nil
+	case GetCasesOptionsFieldsShortDescriptionConst:
+		return supportCase.ShortDescription, nil
+	case GetCasesOptionsFieldsDescriptionConst:
+		return supportCase.Description, nil
+	case GetCasesOptionsFieldsStatusConst:
+		return supportCase.Status, nil
+	case GetCasesOptionsFieldsSeverityConst:
+		return supportCase.Severity, nil
+	case GetCasesOptionsFieldsSupportTierConst:
+		return supportCase.SupportTier, nil
+	case GetCasesOptionsFieldsContactTypeConst:
+		return supportCase.ContactType, nil
+	case GetCasesOptionsFieldsContactConst:
+		return caseExportUserID(supportCase.Contact), nil
+	case GetCasesOptionsFieldsCreatedAtConst:
+		return supportCase.CreatedAt, nil
+	case GetCasesOptionsFieldsCreatedByConst:
+		return caseExportUserID(supportCase.CreatedBy), nil
+	case GetCasesOptionsFieldsUpdatedAtConst:
+		return supportCase.UpdatedAt, nil
+	case GetCasesOptionsFieldsUpdatedByConst:
+		return caseExportUserID(supportCase.UpdatedBy), nil
+	case GetCasesOptionsFieldsResolutionConst:
+		return supportCase.Resolution, nil
+	case GetCasesOptionsFieldsCloseNotesConst:
+		return supportCase.CloseNotes, nil
+	case GetCasesOptionsFieldsOfferingConst:
+		if supportCase.Offering == nil {
+			return nil, nil
+		}
+		return supportCase.Offering.Name, nil
+	case GetCasesOptionsFieldsResourcesConst:
+		var crns []string
+		for _, resource := range supportCase.Resources {
+			crns = append(crns, core.StringNilMapper(resource.CRN))
+		}
+		return caseExportList(crns), nil
+	case GetCasesOptionsFieldsAttachmentsConst:
+		var filenames []string
+		for _, attachment := range supportCase.Attachments {
+			filenames = append(filenames, core.StringNilMapper(attachment.Filename))
+		}
+		return caseExportList(filenames), nil
+	case GetCasesOptionsFieldsWatchlistConst:
+		var userIDs []string
+		for i := range supportCase.Watchlist {
+			userIDs = append(userIDs, core.StringNilMapper(supportCase.Watchlist[i].UserID))
+		}
+		return caseExportList(userIDs), nil
+	case GetCasesOptionsFieldsCommentsConst:
+		comments := supportCase.Comments
+		if comments == nil {
+			comments = []Comment{}
+		}
+		data, err := json.Marshal(comments)
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+	return nil, fmt.Errorf("unknown column '%s'", name)
+}
+
+// caseExportUserID returns the ID of a user, or nil if there is no user.
+func caseExportUserID(user *User) *string {
+	if user == nil {
+		return nil
+	}
+	return user.UserID
+}
+
+// caseExportList returns the values of a list column separated by ";", or nil if the list is empty.
+func caseExportList(values []string) *string {
+	if len(values) == 0 {
+		return nil
+	}
+	return core.StringPtr(strings.Join(values, ";"))
+}
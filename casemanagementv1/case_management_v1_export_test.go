@@ -0,0 +1,163 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CaseManagementV1 CaseExporter`, func() {
+	var testServer *httptest.Server
+	var exporter *casemanagementv1.CaseExporter
+	var failOffset string
+	var offsets []string
+	var fields string
+	BeforeEach(func() {
+		failOffset = "none"
+		offsets = nil
+		pages := map[string]string{
+			"0": `{"total_count": 3, "next": {"href": "%s/cases?offset=2&limit=2"}, "cases": [
+				{"number": "TS0001", "short_description": "Outage, again", "status": "Resolved", "severity": 1,
+				 "created_at": "2022-01-02T03:04:05Z", "created_by": {"realm": "IBMid", "user_id": "IBMid-1"},
+				 "resources": [{"crn": "crn1"}, {"crn": "crn2"}],
+				 "comments": [{"value": "Fixed", "added_at": "2022-01-03T00:00:00Z", "added_by": {"realm": "IBMid", "user_id": "IBMid-2"}}]},
+				{"number": "TS0002", "status": "New"}]}`,
+			"2": `{"total_count": 3, "cases": [{"number": "TS0003", "status": "New", "offering": {"name": "Kubernetes", "type": {"group": "crn_service_name", "key": "containers-kubernetes"}}}]}`,
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/cases"))
+			Expect(req.URL.Query().Get("sort")).To(Equal("created_at"))
+			Expect(req.URL.Query().Get("limit")).To(Equal("2"))
+			Expect(req.URL.Query().Get("status")).To(Equal("new,resolved"))
+			fields = req.URL.Query().Get("fields")
+			offset := req.URL.Query().Get("offset")
+			offsets = append(offsets, offset)
+
+			res.Header().Set("Content-type", "application/json")
+			if offset == failOffset {
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"errors": [{"message": "unavailable"}]}`)
+				return
+			}
+			page, found := pages[offset]
+			if !found {
+				res.WriteHeader(404)
+				return
+			}
+			fmt.Fprintf(res, page, testServer.URL)
+		}))
+		caseManagementService, serviceErr := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		exporter = casemanagementv1.NewCaseExporter(caseManagementService)
+		exporter.PageSize = 2
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	statuses := []string{casemanagementv1.GetCasesOptionsStatusNewConst, casemanagementv1.GetCasesOptionsStatusResolvedConst}
+
+	It(`Exports the selected fields of every case as CSV`, func() {
+		var output bytes.Buffer
+		filter := &casemanagementv1.CaseExportFilter{
+			Status: statuses,
+			Fields: []string{"resources", "severity", "short_description", "offering", "created_by"},
+		}
+		export, err := exporter.ExportAllCases(context.Background(), filter, &output, common.ExportFormatCSV)
+		Expect(err).To(BeNil())
+		Expect(fields).To(Equal("number,short_description,severity,created_by,offering,resources"))
+		Expect(offsets).To(Equal([]string{"0", "2"}))
+		Expect(export.Cases).To(Equal(int64(3)))
+		Expect(export.Pages).To(Equal(int64(2)))
+		Expect(export.Offset).To(Equal(int64(3)))
+		Expect(export.Done).To(BeTrue())
+		Expect(export.Resumed).To(BeFalse())
+		Expect(output.String()).To(Equal("number,short_description,severity,created_by,offering,resources\n" +
+			"TS0001,\"Outage, again\",1,IBMid-1,,crn1;crn2\n" +
+			"TS0002,,,,,\n" +
+			"TS0003,,,,Kubernetes,\n"))
+	})
+	It(`Includes the comments of the cases`, func() {
+		var output bytes.Buffer
+		filter := &casemanagementv1.CaseExportFilter{Status: statuses, Fields: []string{"status"}, IncludeComments: true}
+		export, err := exporter.ExportAllCases(context.Background(), filter, &output, common.ExportFormatNDJSON)
+		Expect(err).To(BeNil())
+		Expect(fields).To(Equal("number,status,comments"))
+		Expect(export.Columns).To(HaveLen(3))
+		lines := bytes.Split(bytes.TrimSpace(output.Bytes()), []byte("\n"))
+		Expect(lines).To(HaveLen(3))
+		Expect(string(lines[0])).To(MatchJSON(`{"number": "TS0001", "status": "Resolved",
+			"comments": "[{\"value\":\"Fixed\",\"added_at\":\"2022-01-03T00:00:00Z\",\"added_by\":{\"realm\":\"IBMid\",\"user_id\":\"IBMid-2\"}}]"}`))
+		Expect(string(lines[1])).To(MatchJSON(`{"number": "TS0002", "status": "New", "comments": "[]"}`))
+	})
+	It(`Resumes an interrupted export from its checkpoint`, func() {
+		checkpointer := common.NewMemoryCheckpointer()
+		exporter.Checkpointer = checkpointer
+		exporter.JobID = "archive"
+		filter := &casemanagementv1.CaseExportFilter{Status: statuses, Fields: []string{"status"}}
+
+		failOffset = "2"
+		var first bytes.Buffer
+		export, err := exporter.ExportAllCases(context.Background(), filter, &first, common.ExportFormatCSV)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("error retrieving the cases from offset 2"))
+		Expect(export.Cases).To(Equal(int64(2)))
+		Expect(first.String()).To(Equal("number,status\nTS0001,Resolved\nTS0002,New\n"))
+		saved, found, _ := checkpointer.LoadCheckpoint(context.Background(), "archive")
+		Expect(found).To(BeTrue())
+		Expect(saved).To(Equal("2"))
+
+		failOffset = "none"
+		offsets = nil
+		var second bytes.Buffer
+		export, err = exporter.ExportAllCases(context.Background(), filter, &second, common.ExportFormatCSV)
+		Expect(err).To(BeNil())
+		Expect(export.Resumed).To(BeTrue())
+		Expect(export.Cases).To(Equal(int64(1)))
+		Expect(export.Offset).To(Equal(int64(3)))
+		Expect(offsets).To(Equal([]string{"2"}))
+		Expect(second.String()).To(Equal("number,status\nTS0003,New\n"))
+		_, found, _ = checkpointer.LoadCheckpoint(context.Background(), "archive")
+		Expect(found).To(BeFalse())
+	})
+	It(`Rejects invalid exports`, func() {
+		var output bytes.Buffer
+		_, err := exporter.ExportAllCases(context.Background(), nil, &output, common.ExportFormatParquet)
+		Expect(err).To(MatchError("unsupported case export format 'parquet'"))
+		_, err = exporter.ExportAllCases(context.Background(), &casemanagementv1.CaseExportFilter{Fields: []string{"eu"}}, &output, common.ExportFormatCSV)
+		Expect(err).To(MatchError("case field 'eu' cannot be exported"))
+		exporter.Checkpointer = common.NewMemoryCheckpointer()
+		_, err = exporter.ExportAllCases(context.Background(), nil, &output, common.ExportFormatCSV)
+		Expect(err).To(MatchError("a job ID is required to checkpoint the export"))
+		Expect(offsets).To(BeEmpty())
+	})
+})
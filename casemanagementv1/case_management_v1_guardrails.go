@@ -0,0 +1,209 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The default criteria of a GuardedClient.
+const (
+	DefaultApprovalSeverity    = 2
+	DefaultRecentCommentWindow = 24 * time.Hour
+)
+
+// ErrStatusChangeDenied is wrapped by the error returned by GuardedClient when a status change that requires approval
+// is not approved.
+var ErrStatusChangeDenied = errors.New("status change not approved")
+
+// GuardedStatusChange : A resolve or accept status change that requires approval, as passed to a
+// StatusChangeApprover.
+type GuardedStatusChange struct {
+	// The number of the case.
+	CaseNumber string
+
+	// The action of the change (StatusPayloadActionResolveConst or StatusPayloadActionAcceptConst).
+	Action string
+
+	// The payload that is sent if the change is approved.
+	StatusPayload StatusPayloadIntf
+
+	// The case before the change, with its severity, status, comments and users.
+	Case *Case
+
+	// The severity of the case, or zero if unknown.
+	Severity int64
+
+	// The comments added by the customer side of the case within GuardedClient.RecentCommentWindow.
+	RecentCustomerComments []Comment
+
+	// Why the change requires approval, suitable for display to an approver.
+	Reasons []string
+}
+
+// StatusChangeApprover : Decides whether a status change that requires approval may proceed, for example by asking a
+// person or by checking a change management system. It returns false (with a nil error) to deny the change.
+type StatusChangeApprover interface {
+	ApproveStatusChange(ctx context.Context, change *GuardedStatusChange) (approved bool, err error)
+}
+
+// StatusChangeApproverFunc : Adapts a function to the StatusChangeApprover interface.
+type StatusChangeApproverFunc func(ctx context.Context, change *GuardedStatusChange) (approved bool, err error)
+
+// ApproveStatusChange calls the function.
+func (approver StatusChangeApproverFunc) ApproveStatusChange(ctx context.Context, change *GuardedStatusChange) (approved bool, err error) {
+	return approver(ctx, change)
+}
+
+// GuardedClient : Wraps a CaseManagementV1 client so that the status changes that end a case (resolving it, or
+// accepting the resolution proposed by IBM support) require the approval of a StatusChangeApprover when the case is
+// severe or the customer commented on it recently. This lets automation pipelines resolve routine cases on their own
+// while a person confirms the others.
+// All other operations, including the other status changes, are passed through to the wrapped client unchanged.
+type GuardedClient struct {
+	*CaseManagementV1
+
+	// The approver of the guarded changes. If nil, the changes that require approval are denied.
+	Approver StatusChangeApprover
+
+	// The changes to cases of this severity or higher (a severity value lower or equal to it) require approval. Zero
+	// disables this criterion.
+	ApprovalSeverity int64
+
+	// The changes to cases with a comment from the customer side (the creator, the contact or a member of the
+	// watchlist) added within this period require approval. Zero disables this criterion.
+	RecentCommentWindow time.Duration
+}
+
+// NewGuardedClient returns a new GuardedClient that sends requests with "caseManagement" and asks "approver" to
+// approve guarded changes, using DefaultApprovalSeverity and DefaultRecentCommentWindow.
+func NewGuardedClient(caseManagement *CaseManagementV1, approver StatusChangeApprover) *GuardedClient {
+	return &GuardedClient{
+		CaseManagementV1:    caseManagement,
+		Approver:            approver,
+		ApprovalSeverity:    DefaultApprovalSeverity,
+		RecentCommentWindow: DefaultRecentCommentWindow,
+	}
+}
+
+// UpdateCaseStatus updates the status of a case, after approval if the change requires it.
+func (client *GuardedClient) UpdateCaseStatus(updateCaseStatusOptions *UpdateCaseStatusOptions) (result *Case, response *core.DetailedResponse, err error) {
+	return client.UpdateCaseStatusWithContext(context.Background(), updateCaseStatusOptions)
+}
+
+// UpdateCaseStatusWithContext is an alternate form of the UpdateCaseStatus method which supports a Context parameter
+func (client *GuardedClient) UpdateCaseStatusWithContext(ctx context.Context, updateCaseStatusOptions *UpdateCaseStatusOptions) (result *Case, response *core.DetailedResponse, err error) {
+	if updateCaseStatusOptions == nil || updateCaseStatusOptions.CaseNumber == nil {
+		return client.CaseManagementV1.UpdateCaseStatusWithContext(ctx, updateCaseStatusOptions)
+	}
+	action := statusPayloadAction(updateCaseStatusOptions.StatusPayload)
+	if action != StatusPayloadActionResolveConst && action != StatusPayloadActionAcceptConst {
+		return client.CaseManagementV1.UpdateCaseStatusWithContext(ctx, updateCaseStatusOptions)
+	}
+
+	caseNumber := *updateCaseStatusOptions.CaseNumber
+	change, err := client.guardedChange(ctx, caseNumber, action, time.Now())
+	if err != nil {
+		return
+	}
+	if change != nil {
+		change.StatusPayload = updateCaseStatusOptions.StatusPayload
+		approved := false
+		if client.Approver != nil {
+			approved, err = client.Approver.ApproveStatusChange(ctx, change)
+			if err != nil {
+				err = fmt.Errorf("error requesting approval to %s case '%s': %w", action, caseNumber, err)
+				return
+			}
+		}
+		if !approved {
+			err = fmt.Errorf("%w: %s case '%s'", ErrStatusChangeDenied, action, caseNumber)
+			return
+		}
+	}
+	return client.CaseManagementV1.UpdateCaseStatusWithContext(ctx, updateCaseStatusOptions)
+}
+
+// guardedChange retrieves a case and returns the description of the change if it requires approval, or nil if it
+// does not.
+func (client *GuardedClient) guardedChange(ctx context.Context, caseNumber string, action string, now time.Time) (change *GuardedStatusChange, err error) {
+	if client.ApprovalSeverity == 0 && client.RecentCommentWindow == 0 {
+		return
+	}
+	getCaseOptions := client.NewGetCaseOptions(caseNumber).SetFields([]string{
+		GetCaseOptionsFieldsNumberConst,
+		GetCaseOptionsFieldsSeverityConst,
+		GetCaseOptionsFieldsStatusConst,
+		GetCaseOptionsFieldsCommentsConst,
+		GetCaseOptionsFieldsCreatedByConst,
+		GetCaseOptionsFieldsContactConst,
+		GetCaseOptionsFieldsWatchlistConst,
+	})
+	supportCase, _, err := client.GetCaseWithContext(ctx, getCaseOptions)
+	if err != nil {
+		err = fmt.Errorf("error retrieving case '%s' to check whether the status change requires approval: %w", caseNumber, err)
+		return
+	}
+
+	candidate := &GuardedStatusChange{CaseNumber: caseNumber, Action: action, Case: supportCase}
+	if supportCase.Severity != nil {
+		candidate.Severity = int64(*supportCase.Severity)
+	}
+	if client.ApprovalSeverity != 0 && candidate.Severity != 0 && candidate.Severity <= client.ApprovalSeverity {
+		candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("the case has severity %d", candidate.Severity))
+	}
+	if client.RecentCommentWindow != 0 {
+		customers := caseCustomers(supportCase)
+		for _, comment := range supportCase.Comments {
+			if comment.AddedBy == nil || !customers[userKey(comment.AddedBy)] {
+				continue
+			}
+			addedAt, parseErr := time.Parse(time.RFC3339, core.StringNilMapper(comment.AddedAt))
+			if parseErr == nil && now.Sub(addedAt) <= client.RecentCommentWindow {
+				candidate.RecentCustomerComments = append(candidate.RecentCustomerComments, comment)
+			}
+		}
+		if len(candidate.RecentCustomerComments) > 0 {
+			candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("the customer commented on the case within %s",
+				client.RecentCommentWindow))
+		}
+	}
+	if len(candidate.Reasons) > 0 {
+		change = candidate
+	}
+	return
+}
+
+// statusPayloadAction returns the action of a status payload, or an empty string if it has none.
+func statusPayloadAction(statusPayload StatusPayloadIntf) string {
+	switch payload := statusPayload.(type) {
+	case *ResolvePayload:
+		return core.StringNilMapper(payload.Action)
+	case *AcceptPayload:
+		return core.StringNilMapper(payload.Action)
+	case *UnresolvePayload:
+		return core.StringNilMapper(payload.Action)
+	case *StatusPayload:
+		return core.StringNilMapper(payload.Action)
+	}
+	return ""
+}
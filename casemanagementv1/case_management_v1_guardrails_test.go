@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CaseManagementV1 GuardedClient`, func() {
+	var testServer *httptest.Server
+	var caseManagementService *casemanagementv1.CaseManagementV1
+	var severity int
+	var commentedBy string
+	var statusUpdates int
+	BeforeEach(func() {
+		severity = 3
+		commentedBy = "support@ibm.com"
+		statusUpdates = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /cases/CS1":
+				fmt.Fprintf(res, `{"number": "CS1", "severity": %d, "status": "in_progress",
+					"created_by": {"realm": "IBMid", "user_id": "customer@example.com"},
+					"comments": [
+						{"value": "old", "added_at": "2020-01-01T00:00:00Z", "added_by": {"realm": "IBMid", "user_id": "customer@example.com"}},
+						{"value": "recent", "added_at": "%s", "added_by": {"realm": "IBMid", "user_id": "%s"}}]}`,
+					severity, time.Now().Add(-time.Hour).UTC().Format(time.RFC3339), commentedBy)
+			case "PUT /cases/CS1/status":
+				statusUpdates++
+				fmt.Fprint(res, `{"number": "CS1", "status": "resolved"}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		caseManagementService, serviceErr = casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	resolve := func(client *casemanagementv1.GuardedClient) error {
+		payload, err := caseManagementService.NewResolvePayload(casemanagementv1.ResolvePayloadActionResolveConst, 6)
+		Expect(err).To(BeNil())
+		_, _, err = client.UpdateCaseStatus(caseManagementService.NewUpdateCaseStatusOptions("CS1", payload))
+		return err
+	}
+
+	It(`Resolves routine cases without approval`, func() {
+		client := casemanagementv1.NewGuardedClient(caseManagementService, nil)
+		Expect(resolve(client)).To(Succeed())
+		Expect(statusUpdates).To(Equal(1))
+	})
+	It(`Asks for approval to resolve severe or recently commented cases`, func() {
+		var changes []*casemanagementv1.GuardedStatusChange
+		approved := false
+		client := casemanagementv1.NewGuardedClient(caseManagementService, casemanagementv1.StatusChangeApproverFunc(
+			func(ctx context.Context, change *casemanagementv1.GuardedStatusChange) (bool, error) {
+				changes = append(changes, change)
+				return approved, nil
+			}))
+
+		severity = 1
+		err := resolve(client)
+		Expect(errors.Is(err, casemanagementv1.ErrStatusChangeDenied)).To(BeTrue())
+		Expect(statusUpdates).To(Equal(0))
+		Expect(changes[0].Severity).To(Equal(int64(1)))
+		Expect(changes[0].Action).To(Equal(casemanagementv1.StatusPayloadActionResolveConst))
+		Expect(changes[0].Reasons).To(HaveLen(1))
+		Expect(changes[0].RecentCustomerComments).To(BeEmpty())
+
+		severity = 3
+		commentedBy = "customer@example.com"
+		approved = true
+		Expect(resolve(client)).To(Succeed())
+		Expect(statusUpdates).To(Equal(1))
+		Expect(changes[1].RecentCustomerComments).To(HaveLen(1))
+		Expect(*changes[1].RecentCustomerComments[0].Value).To(Equal("recent"))
+	})
+	It(`Denies guarded changes without an approver and passes other changes through`, func() {
+		severity = 2
+		client := casemanagementv1.NewGuardedClient(caseManagementService, nil)
+		Expect(errors.Is(resolve(client), casemanagementv1.ErrStatusChangeDenied)).To(BeTrue())
+
+		payload, err := caseManagementService.NewUnresolvePayload(casemanagementv1.UnresolvePayloadActionUnresolveConst, "not fixed")
+		Expect(err).To(BeNil())
+		_, _, err = client.UpdateCaseStatus(caseManagementService.NewUpdateCaseStatusOptions("CS1", payload))
+		Expect(err).To(BeNil())
+		Expect(statusUpdates).To(Equal(1))
+
+		approverErr := errors.New("approval service down")
+		client.Approver = casemanagementv1.StatusChangeApproverFunc(func(context.Context, *casemanagementv1.GuardedStatusChange) (bool, error) {
+			return true, approverErr
+		})
+		Expect(errors.Is(resolve(client), approverErr)).To(BeTrue())
+		Expect(statusUpdates).To(Equal(1))
+	})
+})
@@ -108,10 +108,9 @@ var _ = Describe("Case Management - Integration Tests", func() {
 
 		goLogger := log.New(GinkgoWriter, "", log.LstdFlags)
 		core.SetLogger(core.NewLogger(core.LevelError, goLogger, goLogger))
-		service.EnableRetries(4, 30*time.Second)
 
-		// Set client timeout.
-		service.Service.Client.Timeout = 2 * time.Minute
+		// Apply the timeout and retry policy of the class of each operation.
+		common.EnableOperationTimeouts(service.Service, nil)
 
 		fmt.Fprintf(GinkgoWriter, "\nService URL: %s\n", service.Service.GetServiceURL())
 	})
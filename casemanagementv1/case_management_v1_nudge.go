@@ -0,0 +1,182 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// StaleCaseStatuses are the statuses of the cases considered by NudgeStaleCases: the cases that are waiting for
+// IBM support.
+var StaleCaseStatuses = []string{GetCasesOptionsStatusNewConst, GetCasesOptionsStatusInProgressConst}
+
+// DefaultNudgeInterval is the minimum interval between the comments posted by NudgeStaleCases.
+const DefaultNudgeInterval = time.Second
+
+const nudgePageLimit = 100
+
+// CaseNudge : A stale case found by NudgeStaleCases, and the follow-up comment posted to it.
+type CaseNudge struct {
+	// The number of the case.
+	CaseNumber string
+
+	// The short description of the case.
+	ShortDescription string
+
+	// The status of the case.
+	Status string
+
+	// When the case was last updated.
+	UpdatedAt time.Time
+
+	// The follow-up comment.
+	Comment string
+
+	// True if the comment was posted; false in a dry run.
+	Posted bool
+}
+
+// NudgeTemplateData : The data passed to the template of NudgeStaleCases.
+type NudgeTemplateData struct {
+	// The case, with its number, short description, status and creation and update times.
+	Case *Case
+
+	// When the case was last updated.
+	UpdatedAt time.Time
+
+	// The number of whole days since the case was last updated.
+	IdleDays int
+}
+
+// NudgeStaleCases finds the open cases (see StaleCaseStatuses) that have not been updated for at least "olderThan"
+// and posts a follow-up comment to each of them. The comment is produced by "commentTemplate", a text/template
+// executed with a NudgeTemplateData, e.g. "Any update? This case has been idle for {{.IdleDays}} days.".
+// Comments are posted at most once per DefaultNudgeInterval and retried while they are throttled. If "dryRun" is
+// true, the comments are produced but not posted.
+// The cases nudged are returned in the order in which they were found; if posting a comment fails, the cases nudged
+// so far are returned with the error.
+func (caseManagement *CaseManagementV1) NudgeStaleCases(ctx context.Context, olderThan time.Duration, commentTemplate string, dryRun bool) (nudged []CaseNudge, err error) {
+	tmpl, err := template.New("nudge").Option("missingkey=error").Parse(commentTemplate)
+	if err != nil {
+		err = fmt.Errorf("invalid comment template: %w", err)
+		return
+	}
+
+	stale, err := caseManagement.findStaleCases(ctx, time.Now().Add(-olderThan))
+	if err != nil {
+		return
+	}
+
+	client := NewThrottledClient(caseManagement, nil)
+	limiter := common.NewRateLimiter(1/DefaultNudgeInterval.Seconds(), 1)
+	now := time.Now()
+	for i := range stale {
+		supportCase := &stale[i]
+		updatedAt := caseUpdatedAt(supportCase)
+		var comment bytes.Buffer
+		err = tmpl.Execute(&comment, &NudgeTemplateData{
+			Case:      supportCase,
+			UpdatedAt: updatedAt,
+			IdleDays:  int(now.Sub(updatedAt) / (24 * time.Hour)),
+		})
+		if err != nil {
+			err = fmt.Errorf("error producing the comment for case '%s': %w", core.StringNilMapper(supportCase.Number), err)
+			return
+		}
+
+		nudge := CaseNudge{
+			CaseNumber:       core.StringNilMapper(supportCase.Number),
+			ShortDescription: core.StringNilMapper(supportCase.ShortDescription),
+			Status:           core.StringNilMapper(supportCase.Status),
+			UpdatedAt:        updatedAt,
+			Comment:          comment.String(),
+		}
+		if !dryRun {
+			err = limiter.Wait(ctx)
+			if err != nil {
+				return
+			}
+			_, _, err = client.AddCommentWithContext(ctx, caseManagement.NewAddCommentOptions(nudge.CaseNumber, nudge.Comment))
+			if err != nil {
+				err = fmt.Errorf("error adding a comment to case '%s': %w", nudge.CaseNumber, err)
+				return
+			}
+			nudge.Posted = true
+		}
+		nudged = append(nudged, nudge)
+	}
+	return
+}
+
+// findStaleCases returns the cases with one of the StaleCaseStatuses that were last updated before "cutoff".
+func (caseManagement *CaseManagementV1) findStaleCases(ctx context.Context, cutoff time.Time) (stale []Case, err error) {
+	options := caseManagement.NewGetCasesOptions().
+		SetStatus(StaleCaseStatuses).
+		SetFields([]string{GetCasesOptionsFieldsNumberConst, GetCasesOptionsFieldsShortDescriptionConst,
+			GetCasesOptionsFieldsStatusConst, GetCasesOptionsFieldsCreatedAtConst, GetCasesOptionsFieldsUpdatedAtConst}).
+		SetLimit(nudgePageLimit)
+	for {
+		var caseList *CaseList
+		caseList, _, err = caseManagement.GetCasesWithContext(ctx, options)
+		if err != nil {
+			return
+		}
+		for _, supportCase := range caseList.Cases {
+			updatedAt := caseUpdatedAt(&supportCase)
+			if !updatedAt.IsZero() && updatedAt.Before(cutoff) {
+				stale = append(stale, supportCase)
+			}
+		}
+
+		if caseList.Next == nil || caseList.Next.Href == nil || len(caseList.Cases) == 0 {
+			return
+		}
+		var offset *string
+		offset, err = core.GetQueryParam(caseList.Next.Href, "offset")
+		if err != nil || offset == nil {
+			return
+		}
+		var next int64
+		next, err = strconv.ParseInt(*offset, 10, 64)
+		if err != nil {
+			err = fmt.Errorf("invalid offset '%s' in the next page link: %w", *offset, err)
+			return
+		}
+		options.SetOffset(next)
+	}
+}
+
+// caseUpdatedAt returns when a case was last updated, or when it was created if it has never been updated. It is
+// zero if neither time is valid.
+func caseUpdatedAt(supportCase *Case) time.Time {
+	for _, value := range []*string{supportCase.UpdatedAt, supportCase.CreatedAt} {
+		if value != nil {
+			if parsed, err := time.Parse(time.RFC3339, *value); err == nil {
+				return parsed
+			}
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CaseManagementV1 NudgeStaleCases`, func() {
+	var testServer *httptest.Server
+	var caseManagementService *casemanagementv1.CaseManagementV1
+	var comments map[string]string
+	now := time.Now().UTC()
+	timestamp := func(age time.Duration) string {
+		return now.Add(-age).Format(time.RFC3339)
+	}
+	BeforeEach(func() {
+		comments = make(map[string]string)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/cases":
+				Expect(req.URL.Query().Get("status")).To(Equal("new,in_progress"))
+				switch req.URL.Query().Get("offset") {
+				case "":
+					fmt.Fprintf(res, `{"total_count": 3, "next": {"href": "%s/cases?offset=2&limit=100"}, "cases": [
+						{"number": "TS0001", "short_description": "Outage", "status": "In Progress", "updated_at": "%s"},
+						{"number": "TS0002", "status": "New", "updated_at": "%s"}]}`,
+						testServer.URL, timestamp(10*24*time.Hour), timestamp(time.Hour))
+				case "2":
+					fmt.Fprintf(res, `{"total_count": 3, "cases": [{"number": "TS0003", "status": "New", "created_at": "%s"}]}`,
+						timestamp(8*24*time.Hour+time.Hour))
+				default:
+					Fail("unexpected offset " + req.URL.RawQuery)
+				}
+			case req.Method == "PUT" && strings.HasSuffix(req.URL.Path, "/comments"):
+				var body map[string]string
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				comments[req.URL.Path] = body["comment"]
+				fmt.Fprint(res, `{"value": "ok"}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		caseManagementService, serviceErr = casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	template := `Any update on {{.Case.Number}}? It has been idle for {{.IdleDays}} days.`
+
+	It(`Posts a comment to each stale case`, func() {
+		nudged, err := caseManagementService.NudgeStaleCases(context.Background(), 7*24*time.Hour, template, false)
+		Expect(err).To(BeNil())
+		Expect(nudged).To(HaveLen(2))
+		Expect(nudged[0].CaseNumber).To(Equal("TS0001"))
+		Expect(nudged[0].ShortDescription).To(Equal("Outage"))
+		Expect(nudged[0].Posted).To(BeTrue())
+		Expect(nudged[1].CaseNumber).To(Equal("TS0003"))
+		Expect(nudged[1].UpdatedAt).To(Equal(now.Add(-8*24*time.Hour - time.Hour).Truncate(time.Second)))
+		Expect(comments).To(Equal(map[string]string{
+			"/cases/TS0001/comments": "Any update on TS0001? It has been idle for 10 days.",
+			"/cases/TS0003/comments": "Any update on TS0003? It has been idle for 8 days.",
+		}))
+	})
+	It(`Posts nothing in a dry run`, func() {
+		nudged, err := caseManagementService.NudgeStaleCases(context.Background(), 7*24*time.Hour, template, true)
+		Expect(err).To(BeNil())
+		Expect(nudged).To(HaveLen(2))
+		Expect(nudged[0].Posted).To(BeFalse())
+		Expect(nudged[0].Comment).To(Equal("Any update on TS0001? It has been idle for 10 days."))
+		Expect(comments).To(BeEmpty())
+	})
+	It(`Rejects invalid templates`, func() {
+		_, err := caseManagementService.NudgeStaleCases(context.Background(), time.Hour, "{{.Missing", true)
+		Expect(err).ToNot(BeNil())
+		_, err = caseManagementService.NudgeStaleCases(context.Background(), time.Hour, "{{.Missing}}", true)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,605 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package casemanagementv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GetCasesOption : A functional option that sets a property of GetCasesOptions (see GetCasesWith).
+type GetCasesOption func(options *GetCasesOptions)
+
+// GetCasesOffset sets the Offset property of GetCasesOptions. Number of cases should be skipped.
+func GetCasesOffset(offset int64) GetCasesOption {
+	return func(options *GetCasesOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// GetCasesLimit sets the Limit property of GetCasesOptions. Number of cases should be returned.
+func GetCasesLimit(limit int64) GetCasesOption {
+	return func(options *GetCasesOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// GetCasesSearch sets the Search property of GetCasesOptions. String that a case might contain.
+func GetCasesSearch(search string) GetCasesOption {
+	return func(options *GetCasesOptions) {
+		options.Search = core.StringPtr(search)
+	}
+}
+
+// GetCasesSort sets the Sort property of GetCasesOptions. Sort field and direction.
+func GetCasesSort(sort string) GetCasesOption {
+	return func(options *GetCasesOptions) {
+		options.Sort = core.StringPtr(sort)
+	}
+}
+
+// GetCasesStatus sets the Status property of GetCasesOptions. Case status filter.
+func GetCasesStatus(status ...string) GetCasesOption {
+	return func(options *GetCasesOptions) {
+		options.Status = append(options.Status, status...)
+	}
+}
+
+// GetCasesFields sets the Fields property of GetCasesOptions. Seleted fields of interest instead of the entire
+// case information.
+func GetCasesFields(fields ...string) GetCasesOption {
+	return func(options *GetCasesOptions) {
+		options.Fields = append(options.Fields, fields...)
+	}
+}
+
+// GetCasesHeader sets the Headers property of GetCasesOptions. Allows users to set headers on API requests.
+func GetCasesHeader(name string, value string) GetCasesOption {
+	return func(options *GetCasesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetCasesOptionsWith returns GetCasesOptions populated by applying "options" in order.
+func (*CaseManagementV1) NewGetCasesOptionsWith(options ...GetCasesOption) *GetCasesOptions {
+	getCasesOptions := &GetCasesOptions{}
+	for _, option := range options {
+		option(getCasesOptions)
+	}
+	return getCasesOptions
+}
+
+// GetCasesWith is an alternate form of the GetCasesWithContext method which takes functional options instead of a
+// GetCasesOptions struct.
+func (caseManagement *CaseManagementV1) GetCasesWith(ctx context.Context, options ...GetCasesOption) (result *CaseList, response *core.DetailedResponse, err error) {
+	return caseManagement.GetCasesWithContext(ctx, caseManagement.NewGetCasesOptionsWith(options...))
+}
+
+// CreateCaseOption : A functional option that sets a property of CreateCaseOptions (see CreateCaseWith).
+type CreateCaseOption func(options *CreateCaseOptions)
+
+// CreateCaseType sets the Type property of CreateCaseOptions. Case type.
+func CreateCaseType(typeVar string) CreateCaseOption {
+	return func(options *CreateCaseOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// CreateCaseSubject sets the Subject property of CreateCaseOptions. Subject of the case.
+func CreateCaseSubject(subject string) CreateCaseOption {
+	return func(options *CreateCaseOptions) {
+		options.Subject = core.StringPtr(subject)
+	}
+}
+
+// CreateCaseDescription sets the Description property of CreateCaseOptions. Detailed description of the issue.
+func CreateCaseDescription(description string) CreateCaseOption {
+	return func(options *CreateCaseOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// CreateCaseSeverity sets the Severity property of CreateCaseOptions. Severity of the case.
+func CreateCaseSeverity(severity int64) CreateCaseOption {
+	return func(options *CreateCaseOptions) {
+		options.Severity = core.Int64Ptr(severity)
+	}
+}
+
+// CreateCaseEu sets the Eu property of CreateCaseOptions. Specify if the case should be treated as EU regulated.
+func CreateCaseEu(eu *CasePayloadEu) CreateCaseOption {
+	return func(options *CreateCaseOptions) {
+		options.Eu = eu
+	}
+}
+
+// CreateCaseOffering sets the Offering property of CreateCaseOptions. Offering details.
+func CreateCaseOffering(offering *Offering) CreateCaseOption {
+	return func(options *CreateCaseOptions) {
+		options.Offering = offering
+	}
+}
+
+// CreateCaseResources sets the Resources property of CreateCaseOptions. List of resources to attach to case.
+func CreateCaseResources(resources ...ResourcePayload) CreateCaseOption {
+	return func(options *CreateCaseOptions) {
+		options.Resources = append(options.Resources, resources...)
+	}
+}
+
+// CreateCaseWatchlist sets the Watchlist property of CreateCaseOptions. Array of user IDs to add to the watchlist.
+func CreateCaseWatchlist(watchlist ...User) CreateCaseOption {
+	return func(options *CreateCaseOptions) {
+		options.Watchlist = append(options.Watchlist, watchlist...)
+	}
+}
+
+// CreateCaseInvoiceNumber sets the InvoiceNumber property of CreateCaseOptions. Invoice number of "Billing and
+// Invoice" case type.
+func CreateCaseInvoiceNumber(invoiceNumber string) CreateCaseOption {
+	return func(options *CreateCaseOptions) {
+		options.InvoiceNumber = core.StringPtr(invoiceNumber)
+	}
+}
+
+// CreateCaseSLACreditRequest sets the SLACreditRequest property of CreateCaseOptions. Flag to indicate if case is
+// for an Service Level Agreement (SLA) credit request.
+func CreateCaseSLACreditRequest(slaCreditRequest bool) CreateCaseOption {
+	return func(options *CreateCaseOptions) {
+		options.SLACreditRequest = core.BoolPtr(slaCreditRequest)
+	}
+}
+
+// CreateCaseHeader sets the Headers property of CreateCaseOptions. Allows users to set headers on API requests.
+func CreateCaseHeader(name string, value string) CreateCaseOption {
+	return func(options *CreateCaseOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateCaseOptionsWith returns CreateCaseOptions populated by applying "options" in order.
+func (*CaseManagementV1) NewCreateCaseOptionsWith(options ...CreateCaseOption) *CreateCaseOptions {
+	createCaseOptions := &CreateCaseOptions{}
+	for _, option := range options {
+		option(createCaseOptions)
+	}
+	return createCaseOptions
+}
+
+// CreateCaseWith is an alternate form of the CreateCaseWithContext method which takes functional options instead
+// of a CreateCaseOptions struct. CreateCaseType, CreateCaseSubject and CreateCaseDescription are required.
+func (caseManagement *CaseManagementV1) CreateCaseWith(ctx context.Context, options ...CreateCaseOption) (result *Case, response *core.DetailedResponse, err error) {
+	return caseManagement.CreateCaseWithContext(ctx, caseManagement.NewCreateCaseOptionsWith(options...))
+}
+
+// GetCaseOption : A functional option that sets a property of GetCaseOptions (see GetCaseWith).
+type GetCaseOption func(options *GetCaseOptions)
+
+// GetCaseCaseNumber sets the CaseNumber property of GetCaseOptions. Unique identifier of a case.
+func GetCaseCaseNumber(caseNumber string) GetCaseOption {
+	return func(options *GetCaseOptions) {
+		options.CaseNumber = core.StringPtr(caseNumber)
+	}
+}
+
+// GetCaseFields sets the Fields property of GetCaseOptions. Seleted fields of interest instead of the entire case
+// information.
+func GetCaseFields(fields ...string) GetCaseOption {
+	return func(options *GetCaseOptions) {
+		options.Fields = append(options.Fields, fields...)
+	}
+}
+
+// GetCaseHeader sets the Headers property of GetCaseOptions. Allows users to set headers on API requests.
+func GetCaseHeader(name string, value string) GetCaseOption {
+	return func(options *GetCaseOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetCaseOptionsWith returns GetCaseOptions populated by applying "options" in order.
+func (*CaseManagementV1) NewGetCaseOptionsWith(options ...GetCaseOption) *GetCaseOptions {
+	getCaseOptions := &GetCaseOptions{}
+	for _, option := range options {
+		option(getCaseOptions)
+	}
+	return getCaseOptions
+}
+
+// GetCaseWith is an alternate form of the GetCaseWithContext method which takes functional options instead of a
+// GetCaseOptions struct. GetCaseCaseNumber is required.
+func (caseManagement *CaseManagementV1) GetCaseWith(ctx context.Context, options ...GetCaseOption) (result *Case, response *core.DetailedResponse, err error) {
+	return caseManagement.GetCaseWithContext(ctx, caseManagement.NewGetCaseOptionsWith(options...))
+}
+
+// UpdateCaseStatusOption : A functional option that sets a property of UpdateCaseStatusOptions (see UpdateCaseStatusWith).
+type UpdateCaseStatusOption func(options *UpdateCaseStatusOptions)
+
+// UpdateCaseStatusCaseNumber sets the CaseNumber property of UpdateCaseStatusOptions. Unique identifier of a case.
+func UpdateCaseStatusCaseNumber(caseNumber string) UpdateCaseStatusOption {
+	return func(options *UpdateCaseStatusOptions) {
+		options.CaseNumber = core.StringPtr(caseNumber)
+	}
+}
+
+// UpdateCaseStatusStatusPayload sets the StatusPayload property of UpdateCaseStatusOptions. Payload to update
+// status of the case.
+func UpdateCaseStatusStatusPayload(statusPayload StatusPayloadIntf) UpdateCaseStatusOption {
+	return func(options *UpdateCaseStatusOptions) {
+		options.StatusPayload = statusPayload
+	}
+}
+
+// UpdateCaseStatusHeader sets the Headers property of UpdateCaseStatusOptions. Allows users to set headers on API
+// requests.
+func UpdateCaseStatusHeader(name string, value string) UpdateCaseStatusOption {
+	return func(options *UpdateCaseStatusOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateCaseStatusOptionsWith returns UpdateCaseStatusOptions populated by applying "options" in order.
+func (*CaseManagementV1) NewUpdateCaseStatusOptionsWith(options ...UpdateCaseStatusOption) *UpdateCaseStatusOptions {
+	updateCaseStatusOptions := &UpdateCaseStatusOptions{}
+	for _, option := range options {
+		option(updateCaseStatusOptions)
+	}
+	return updateCaseStatusOptions
+}
+
+// UpdateCaseStatusWith is an alternate form of the UpdateCaseStatusWithContext method which takes functional
+// options instead of an UpdateCaseStatusOptions struct. UpdateCaseStatusCaseNumber and
+// UpdateCaseStatusStatusPayload are required.
+func (caseManagement *CaseManagementV1) UpdateCaseStatusWith(ctx context.Context, options ...UpdateCaseStatusOption) (result *Case, response *core.DetailedResponse, err error) {
+	return caseManagement.UpdateCaseStatusWithContext(ctx, caseManagement.NewUpdateCaseStatusOptionsWith(options...))
+}
+
+// AddCommentOption : A functional option that sets a property of AddCommentOptions (see AddCommentWith).
+type AddCommentOption func(options *AddCommentOptions)
+
+// AddCommentCaseNumber sets the CaseNumber property of AddCommentOptions. Unique identifier of a case.
+func AddCommentCaseNumber(caseNumber string) AddCommentOption {
+	return func(options *AddCommentOptions) {
+		options.CaseNumber = core.StringPtr(caseNumber)
+	}
+}
+
+// AddCommentComment sets the Comment property of AddCommentOptions. Comment to add to the case.
+func AddCommentComment(comment string) AddCommentOption {
+	return func(options *AddCommentOptions) {
+		options.Comment = core.StringPtr(comment)
+	}
+}
+
+// AddCommentHeader sets the Headers property of AddCommentOptions. Allows users to set headers on API requests.
+func AddCommentHeader(name string, value string) AddCommentOption {
+	return func(options *AddCommentOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewAddCommentOptionsWith returns AddCommentOptions populated by applying "options" in order.
+func (*CaseManagementV1) NewAddCommentOptionsWith(options ...AddCommentOption) *AddCommentOptions {
+	addCommentOptions := &AddCommentOptions{}
+	for _, option := range options {
+		option(addCommentOptions)
+	}
+	return addCommentOptions
+}
+
+// AddCommentWith is an alternate form of the AddCommentWithContext method which takes functional options instead
+// of an AddCommentOptions struct. AddCommentCaseNumber and AddCommentComment are required.
+func (caseManagement *CaseManagementV1) AddCommentWith(ctx context.Context, options ...AddCommentOption) (result *Comment, response *core.DetailedResponse, err error) {
+	return caseManagement.AddCommentWithContext(ctx, caseManagement.NewAddCommentOptionsWith(options...))
+}
+
+// AddWatchlistOption : A functional option that sets a property of AddWatchlistOptions (see AddWatchlistWith).
+type AddWatchlistOption func(options *AddWatchlistOptions)
+
+// AddWatchlistCaseNumber sets the CaseNumber property of AddWatchlistOptions. Unique identifier of a case.
+func AddWatchlistCaseNumber(caseNumber string) AddWatchlistOption {
+	return func(options *AddWatchlistOptions) {
+		options.CaseNumber = core.StringPtr(caseNumber)
+	}
+}
+
+// AddWatchlistWatchlist sets the Watchlist property of AddWatchlistOptions. Array of user ID objects.
+func AddWatchlistWatchlist(watchlist ...User) AddWatchlistOption {
+	return func(options *AddWatchlistOptions) {
+		options.Watchlist = append(options.Watchlist, watchlist...)
+	}
+}
+
+// AddWatchlistHeader sets the Headers property of AddWatchlistOptions. Allows users to set headers on API
+// requests.
+func AddWatchlistHeader(name string, value string) AddWatchlistOption {
+	return func(options *AddWatchlistOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewAddWatchlistOptionsWith returns AddWatchlistOptions populated by applying "options" in order.
+func (*CaseManagementV1) NewAddWatchlistOptionsWith(options ...AddWatchlistOption) *AddWatchlistOptions {
+	addWatchlistOptions := &AddWatchlistOptions{}
+	for _, option := range options {
+		option(addWatchlistOptions)
+	}
+	return addWatchlistOptions
+}
+
+// AddWatchlistWith is an alternate form of the AddWatchlistWithContext method which takes functional options
+// instead of an AddWatchlistOptions struct. AddWatchlistCaseNumber is required.
+func (caseManagement *CaseManagementV1) AddWatchlistWith(ctx context.Context, options ...AddWatchlistOption) (result *WatchlistAddResponse, response *core.DetailedResponse, err error) {
+	return caseManagement.AddWatchlistWithContext(ctx, caseManagement.NewAddWatchlistOptionsWith(options...))
+}
+
+// RemoveWatchlistOption : A functional option that sets a property of RemoveWatchlistOptions (see RemoveWatchlistWith).
+type RemoveWatchlistOption func(options *RemoveWatchlistOptions)
+
+// RemoveWatchlistCaseNumber sets the CaseNumber property of RemoveWatchlistOptions. Unique identifier of a case.
+func RemoveWatchlistCaseNumber(caseNumber string) RemoveWatchlistOption {
+	return func(options *RemoveWatchlistOptions) {
+		options.CaseNumber = core.StringPtr(caseNumber)
+	}
+}
+
+// RemoveWatchlistWatchlist sets the Watchlist property of RemoveWatchlistOptions. Array of user ID objects.
+func RemoveWatchlistWatchlist(watchlist ...User) RemoveWatchlistOption {
+	return func(options *RemoveWatchlistOptions) {
+		options.Watchlist = append(options.Watchlist, watchlist...)
+	}
+}
+
+// RemoveWatchlistHeader sets the Headers property of RemoveWatchlistOptions. Allows users to set headers on API
+// requests.
+func RemoveWatchlistHeader(name string, value string) RemoveWatchlistOption {
+	return func(options *RemoveWatchlistOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewRemoveWatchlistOptionsWith returns RemoveWatchlistOptions populated by applying "options" in order.
+func (*CaseManagementV1) NewRemoveWatchlistOptionsWith(options ...RemoveWatchlistOption) *RemoveWatchlistOptions {
+	removeWatchlistOptions := &RemoveWatchlistOptions{}
+	for _, option := range options {
+		option(removeWatchlistOptions)
+	}
+	return removeWatchlistOptions
+}
+
+// RemoveWatchlistWith is an alternate form of the RemoveWatchlistWithContext method which takes functional options
+// instead of a RemoveWatchlistOptions struct. RemoveWatchlistCaseNumber is required.
+func (caseManagement *CaseManagementV1) RemoveWatchlistWith(ctx context.Context, options ...RemoveWatchlistOption) (result *Watchlist, response *core.DetailedResponse, err error) {
+	return caseManagement.RemoveWatchlistWithContext(ctx, caseManagement.NewRemoveWatchlistOptionsWith(options...))
+}
+
+// AddResourceOption : A functional option that sets a property of AddResourceOptions (see AddResourceWith).
+type AddResourceOption func(options *AddResourceOptions)
+
+// AddResourceCaseNumber sets the CaseNumber property of AddResourceOptions. Unique identifier of a case.
+func AddResourceCaseNumber(caseNumber string) AddResourceOption {
+	return func(options *AddResourceOptions) {
+		options.CaseNumber = core.StringPtr(caseNumber)
+	}
+}
+
+// AddResourceCRN sets the CRN property of AddResourceOptions. Cloud Resource Name of the resource.
+func AddResourceCRN(crn string) AddResourceOption {
+	return func(options *AddResourceOptions) {
+		options.CRN = core.StringPtr(crn)
+	}
+}
+
+// AddResourceType sets the Type property of AddResourceOptions. Only used to attach Classic IaaS devices which
+// have no CRN.
+func AddResourceType(typeVar string) AddResourceOption {
+	return func(options *AddResourceOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// AddResourceID sets the ID property of AddResourceOptions. Only used to attach Classic IaaS devices which have no
+// CRN.
+func AddResourceID(id float64) AddResourceOption {
+	return func(options *AddResourceOptions) {
+		options.ID = core.Float64Ptr(id)
+	}
+}
+
+// AddResourceNote sets the Note property of AddResourceOptions. A note about this resource.
+func AddResourceNote(note string) AddResourceOption {
+	return func(options *AddResourceOptions) {
+		options.Note = core.StringPtr(note)
+	}
+}
+
+// AddResourceHeader sets the Headers property of AddResourceOptions. Allows users to set headers on API requests.
+func AddResourceHeader(name string, value string) AddResourceOption {
+	return func(options *AddResourceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewAddResourceOptionsWith returns AddResourceOptions populated by applying "options" in order.
+func (*CaseManagementV1) NewAddResourceOptionsWith(options ...AddResourceOption) *AddResourceOptions {
+	addResourceOptions := &AddResourceOptions{}
+	for _, option := range options {
+		option(addResourceOptions)
+	}
+	return addResourceOptions
+}
+
+// AddResourceWith is an alternate form of the AddResourceWithContext method which takes functional options instead
+// of an AddResourceOptions struct. AddResourceCaseNumber is required.
+func (caseManagement *CaseManagementV1) AddResourceWith(ctx context.Context, options ...AddResourceOption) (result *Resource, response *core.DetailedResponse, err error) {
+	return caseManagement.AddResourceWithContext(ctx, caseManagement.NewAddResourceOptionsWith(options...))
+}
+
+// UploadFileOption : A functional option that sets a property of UploadFileOptions (see UploadFileWith).
+type UploadFileOption func(options *UploadFileOptions)
+
+// UploadFileCaseNumber sets the CaseNumber property of UploadFileOptions. Unique identifier of a case.
+func UploadFileCaseNumber(caseNumber string) UploadFileOption {
+	return func(options *UploadFileOptions) {
+		options.CaseNumber = core.StringPtr(caseNumber)
+	}
+}
+
+// UploadFileFile sets the File property of UploadFileOptions. file of supported types, 8MB in size limit.
+func UploadFileFile(file ...FileWithMetadata) UploadFileOption {
+	return func(options *UploadFileOptions) {
+		options.File = append(options.File, file...)
+	}
+}
+
+// UploadFileHeader sets the Headers property of UploadFileOptions. Allows users to set headers on API requests.
+func UploadFileHeader(name string, value string) UploadFileOption {
+	return func(options *UploadFileOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUploadFileOptionsWith returns UploadFileOptions populated by applying "options" in order.
+func (*CaseManagementV1) NewUploadFileOptionsWith(options ...UploadFileOption) *UploadFileOptions {
+	uploadFileOptions := &UploadFileOptions{}
+	for _, option := range options {
+		option(uploadFileOptions)
+	}
+	return uploadFileOptions
+}
+
+// UploadFileWith is an alternate form of the UploadFileWithContext method which takes functional options instead
+// of an UploadFileOptions struct. UploadFileCaseNumber and UploadFileFile are required.
+func (caseManagement *CaseManagementV1) UploadFileWith(ctx context.Context, options ...UploadFileOption) (result *Attachment, response *core.DetailedResponse, err error) {
+	return caseManagement.UploadFileWithContext(ctx, caseManagement.NewUploadFileOptionsWith(options...))
+}
+
+// DownloadFileOption : A functional option that sets a property of DownloadFileOptions (see DownloadFileWith).
+type DownloadFileOption func(options *DownloadFileOptions)
+
+// DownloadFileCaseNumber sets the CaseNumber property of DownloadFileOptions. Unique identifier of a case.
+func DownloadFileCaseNumber(caseNumber string) DownloadFileOption {
+	return func(options *DownloadFileOptions) {
+		options.CaseNumber = core.StringPtr(caseNumber)
+	}
+}
+
+// DownloadFileFileID sets the FileID property of DownloadFileOptions. Unique identifier of a file.
+func DownloadFileFileID(fileID string) DownloadFileOption {
+	return func(options *DownloadFileOptions) {
+		options.FileID = core.StringPtr(fileID)
+	}
+}
+
+// DownloadFileHeader sets the Headers property of DownloadFileOptions. Allows users to set headers on API
+// requests.
+func DownloadFileHeader(name string, value string) DownloadFileOption {
+	return func(options *DownloadFileOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDownloadFileOptionsWith returns DownloadFileOptions populated by applying "options" in order.
+func (*CaseManagementV1) NewDownloadFileOptionsWith(options ...DownloadFileOption) *DownloadFileOptions {
+	downloadFileOptions := &DownloadFileOptions{}
+	for _, option := range options {
+		option(downloadFileOptions)
+	}
+	return downloadFileOptions
+}
+
+// DownloadFileWith is an alternate form of the DownloadFileWithContext method which takes functional options
+// instead of a DownloadFileOptions struct. DownloadFileCaseNumber and DownloadFileFileID are required.
+func (caseManagement *CaseManagementV1) DownloadFileWith(ctx context.Context, options ...DownloadFileOption) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return caseManagement.DownloadFileWithContext(ctx, caseManagement.NewDownloadFileOptionsWith(options...))
+}
+
+// DeleteFileOption : A functional option that sets a property of DeleteFileOptions (see DeleteFileWith).
+type DeleteFileOption func(options *DeleteFileOptions)
+
+// DeleteFileCaseNumber sets the CaseNumber property of DeleteFileOptions. Unique identifier of a case.
+func DeleteFileCaseNumber(caseNumber string) DeleteFileOption {
+	return func(options *DeleteFileOptions) {
+		options.CaseNumber = core.StringPtr(caseNumber)
+	}
+}
+
+// DeleteFileFileID sets the FileID property of DeleteFileOptions. Unique identifier of a file.
+func DeleteFileFileID(fileID string) DeleteFileOption {
+	return func(options *DeleteFileOptions) {
+		options.FileID = core.StringPtr(fileID)
+	}
+}
+
+// DeleteFileHeader sets the Headers property of DeleteFileOptions. Allows users to set headers on API requests.
+func DeleteFileHeader(name string, value string) DeleteFileOption {
+	return func(options *DeleteFileOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteFileOptionsWith returns DeleteFileOptions populated by applying "options" in order.
+func (*CaseManagementV1) NewDeleteFileOptionsWith(options ...DeleteFileOption) *DeleteFileOptions {
+	deleteFileOptions := &DeleteFileOptions{}
+	for _, option := range options {
+		option(deleteFileOptions)
+	}
+	return deleteFileOptions
+}
+
+// DeleteFileWith is an alternate form of the DeleteFileWithContext method which takes functional options instead
+// of a DeleteFileOptions struct. DeleteFileCaseNumber and DeleteFileFileID are required.
+func (caseManagement *CaseManagementV1) DeleteFileWith(ctx context.Context, options ...DeleteFileOption) (result *AttachmentList, response *core.DetailedResponse, err error) {
+	return caseManagement.DeleteFileWithContext(ctx, caseManagement.NewDeleteFileOptionsWith(options...))
+}
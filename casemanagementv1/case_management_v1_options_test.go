@@ -0,0 +1,133 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CaseManagementV1 functional options`, func() {
+	var testServer *httptest.Server
+	var caseManagementService *casemanagementv1.CaseManagementV1
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method {
+			case "POST":
+				Expect(req.URL.EscapedPath()).To(Equal("/cases"))
+				Expect(req.Header.Get("X-Test")).To(Equal("1"))
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body).To(Equal(map[string]interface{}{
+					"type":        "technical",
+					"subject":     "Cannot reach my instance",
+					"description": "Connections time out.",
+					"severity":    float64(2),
+					"offering": map[string]interface{}{
+						"name": "Databases for Redis",
+						"type": map[string]interface{}{"group": "crn_service_name", "key": "databases-for-redis"},
+					},
+					"resources": []interface{}{
+						map[string]interface{}{"crn": "crn:v1:redis1", "note": "primary"},
+						map[string]interface{}{"crn": "crn:v1:redis2"},
+					},
+					"watchlist": []interface{}{map[string]interface{}{"realm": "IBMid", "user_id": "abc@ibm.com"}},
+					"eu":        map[string]interface{}{"supported": true},
+				}))
+				fmt.Fprint(res, `{"number": "TS0001"}`)
+			case "GET":
+				Expect(req.URL.Query().Get("limit")).To(Equal("5"))
+				Expect(req.URL.Query().Get("status")).To(Equal("new,in_progress"))
+				Expect(req.URL.Query().Get("sort")).To(Equal("~updated_at"))
+				fmt.Fprint(res, `{"total_count": 1, "cases": [{"number": "TS0001"}]}`)
+			}
+		}))
+		var serviceErr error
+		caseManagementService, serviceErr = casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Creates a case from functional options`, func() {
+		result, _, err := caseManagementService.CreateCaseWith(context.Background(),
+			casemanagementv1.CreateCaseType(casemanagementv1.CreateCaseOptionsTypeTechnicalConst),
+			casemanagementv1.CreateCaseSubject("Cannot reach my instance"),
+			casemanagementv1.CreateCaseDescription("Connections time out."),
+			casemanagementv1.CreateCaseSeverity(2),
+			casemanagementv1.CreateCaseOffering(&casemanagementv1.Offering{
+				Name: core.StringPtr("Databases for Redis"),
+				Type: &casemanagementv1.OfferingType{Group: core.StringPtr("crn_service_name"), Key: core.StringPtr("databases-for-redis")},
+			}),
+			casemanagementv1.CreateCaseResources(casemanagementv1.ResourcePayload{CRN: core.StringPtr("crn:v1:redis1"), Note: core.StringPtr("primary")}),
+			casemanagementv1.CreateCaseResources(casemanagementv1.ResourcePayload{CRN: core.StringPtr("crn:v1:redis2")}),
+			casemanagementv1.CreateCaseWatchlist(casemanagementv1.User{Realm: core.StringPtr("IBMid"), UserID: core.StringPtr("abc@ibm.com")}),
+			casemanagementv1.CreateCaseEu(&casemanagementv1.CasePayloadEu{Supported: core.BoolPtr(true)}),
+			casemanagementv1.CreateCaseHeader("X-Test", "1"),
+		)
+		Expect(err).To(BeNil())
+		Expect(*result.Number).To(Equal("TS0001"))
+	})
+	It(`Validates required options`, func() {
+		_, _, err := caseManagementService.CreateCaseWith(context.Background(), casemanagementv1.CreateCaseSubject("No type"))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Lists cases from functional options`, func() {
+		result, _, err := caseManagementService.GetCasesWith(context.Background(),
+			casemanagementv1.GetCasesLimit(5),
+			casemanagementv1.GetCasesStatus(casemanagementv1.GetCasesOptionsStatusNewConst),
+			casemanagementv1.GetCasesStatus(casemanagementv1.GetCasesOptionsStatusInProgressConst),
+			casemanagementv1.GetCasesSort("~updated_at"),
+		)
+		Expect(err).To(BeNil())
+		Expect(*result.TotalCount).To(Equal(int64(1)))
+	})
+	It(`Builds options without a request`, func() {
+		options := caseManagementService.NewCreateCaseOptionsWith(
+			casemanagementv1.CreateCaseInvoiceNumber("INV-1"),
+			casemanagementv1.CreateCaseSLACreditRequest(true),
+			casemanagementv1.CreateCaseEu(&casemanagementv1.CasePayloadEu{DataCenter: core.Int64Ptr(7)}),
+		)
+		Expect(*options.InvoiceNumber).To(Equal("INV-1"))
+		Expect(*options.SLACreditRequest).To(BeTrue())
+		Expect(*options.Eu.DataCenter).To(Equal(int64(7)))
+
+		getCasesOptions := caseManagementService.NewGetCasesOptionsWith(
+			casemanagementv1.GetCasesOffset(10),
+			casemanagementv1.GetCasesSearch("redis"),
+			casemanagementv1.GetCasesFields(casemanagementv1.GetCasesOptionsFieldsAttachmentsConst),
+		)
+		Expect(*getCasesOptions.Offset).To(Equal(int64(10)))
+		Expect(*getCasesOptions.Search).To(Equal("redis"))
+		Expect(getCasesOptions.Fields).To(Equal([]string{"attachments"}))
+	})
+})
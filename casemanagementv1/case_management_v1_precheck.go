@@ -0,0 +1,272 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// DefaultNotificationWindow is the default value of ResourcePreChecker.NotificationWindow.
+const DefaultNotificationWindow = 72 * time.Hour
+
+// Constants associated with the ResourcePreCheckFinding.Kind property.
+const (
+	ResourcePreCheckFindingNotFoundConst           = "not_found"
+	ResourcePreCheckFindingDeprovisionedConst      = "deprovisioned"
+	ResourcePreCheckFindingPendingReclamationConst = "pending_reclamation"
+	ResourcePreCheckFindingFailedConst             = "failed"
+	ResourcePreCheckFindingInactiveConst           = "inactive"
+	ResourcePreCheckFindingProvisioningConst       = "provisioning"
+	ResourcePreCheckFindingNotificationConst       = "platform_notification"
+)
+
+// PlatformNotification : A platform notification, such as an incident or a maintenance, as returned by a
+// NotificationSource.
+type PlatformNotification struct {
+	// The title of the notification.
+	Title string
+
+	// The type of the notification, for example "incident" or "maintenance".
+	Type string
+
+	// The region that the notification affects, or an empty string if it affects all regions.
+	Region string
+
+	// The names of the services (as used in CRNs) that the notification affects, or nil if it affects all services.
+	ServiceNames []string
+
+	// The start of the event, and its end (the zero time if it is ongoing).
+	Start time.Time
+	End   time.Time
+}
+
+// NotificationSource : Provides the recent platform notifications, for example from the IBM Cloud notifications
+// distribution list or a status page.
+type NotificationSource interface {
+	// Notifications returns the notifications of the events that were ongoing at any time since "since".
+	Notifications(ctx context.Context, since time.Time) (notifications []PlatformNotification, err error)
+}
+
+// ResourcePreCheck : The outcome of PreCheckResources.
+type ResourcePreCheck struct {
+	// The result for each resource, in the order of the CRNs passed to PreCheckResources.
+	Results []ResourcePreCheckResult
+
+	// The workflow ID sent with every request of the pre-check (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// ResourcePreCheckResult : The findings of PreCheckResources for one resource.
+type ResourcePreCheckResult struct {
+	// The CRN of the resource.
+	CRN string
+
+	// The resource instance, or nil if it was not found or could not be retrieved.
+	Instance *resourcecontrollerv2.ResourceInstance
+
+	// The findings about the resource, if any.
+	Findings []ResourcePreCheckFinding
+
+	// The error that prevented a check of the resource, if any. The findings of the other checks are still recorded.
+	Err error
+}
+
+// ResourcePreCheckFinding : A condition of a resource that may explain a problem without the need for a case.
+type ResourcePreCheckFinding struct {
+	// The kind of the finding (a ResourcePreCheckFinding*Const value).
+	Kind string
+
+	// A description of the finding, suitable for a case description.
+	Detail string
+}
+
+// Explained returns the results of the resources with at least one finding: the resources that are deprovisioned,
+// not found or in a state that explains a problem, and those affected by a platform notification. The conditions of
+// these resources should be reviewed before a case is opened for them.
+func (check *ResourcePreCheck) Explained() (explained []ResourcePreCheckResult) {
+	for _, result := range check.Results {
+		if len(result.Findings) > 0 {
+			explained = append(explained, result)
+		}
+	}
+	return
+}
+
+// AnnotateDescription returns the description of a case followed by the findings of the pre-check, so that the support
+// engineer sees the state of the resources when the case was opened. The description is returned unchanged if there
+// are no findings.
+func (check *ResourcePreCheck) AnnotateDescription(description string) string {
+	var lines []string
+	for _, result := range check.Results {
+		for _, finding := range result.Findings {
+			lines = append(lines, fmt.Sprintf("- %s: %s", result.CRN, finding.Detail))
+		}
+	}
+	if len(lines) == 0 {
+		return description
+	}
+	return strings.TrimRight(description, "\n") + "\n\nResource pre-check findings:\n" + strings.Join(lines, "\n")
+}
+
+// ResourcePreChecker : Checks the resources of a prospective case with the Resource Controller service and,
+// optionally, a NotificationSource.
+type ResourcePreChecker struct {
+	*CaseManagementV1
+
+	// The client used to retrieve the resource instances.
+	ResourceController *resourcecontrollerv2.ResourceControllerV2
+
+	// The source of the platform notifications. If nil, notifications are not checked.
+	Notifications NotificationSource
+
+	// The period before the check whose notifications are considered. Defaults to DefaultNotificationWindow.
+	NotificationWindow time.Duration
+}
+
+// NewResourcePreChecker returns a new ResourcePreChecker that retrieves resource instances with "resourceController",
+// without notifications.
+func NewResourcePreChecker(caseManagement *CaseManagementV1, resourceController *resourcecontrollerv2.ResourceControllerV2) *ResourcePreChecker {
+	return &ResourcePreChecker{
+		CaseManagementV1:   caseManagement,
+		ResourceController: resourceController,
+		NotificationWindow: DefaultNotificationWindow,
+	}
+}
+
+// PreCheckResources checks the state of the resource instances with the specified CRNs, and whether a recent platform
+// notification affects their service and region. Nothing is changed. A check that fails is recorded in the result of
+// its resource, so "err" is only returned if the notifications cannot be retrieved. Pass the returned pre-check to
+// AnnotateDescription to add its findings to the description of the case.
+func (checker *ResourcePreChecker) PreCheckResources(ctx context.Context, crns []string) (check *ResourcePreCheck, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, checker.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	var notifications []PlatformNotification
+	if checker.Notifications != nil {
+		window := checker.NotificationWindow
+		if window <= 0 {
+			window = DefaultNotificationWindow
+		}
+		notifications, err = checker.Notifications.Notifications(ctx, time.Now().Add(-window))
+		if err != nil {
+			err = fmt.Errorf("error retrieving the platform notifications: %w", err)
+			return
+		}
+	}
+
+	check = &ResourcePreCheck{Results: make([]ResourcePreCheckResult, len(crns)), WorkflowID: workflowID}
+	for i, crn := range crns {
+		result := &check.Results[i]
+		result.CRN = crn
+		parsed, parseErr := common.ParseCRN(crn)
+		if parseErr != nil {
+			result.Err = fmt.Errorf("invalid CRN '%s': %w", crn, parseErr)
+			continue
+		}
+		checker.checkInstance(ctx, result)
+		for _, notification := range notifications {
+			if notification.affects(parsed) {
+				result.Findings = append(result.Findings, ResourcePreCheckFinding{
+					Kind:   ResourcePreCheckFindingNotificationConst,
+					Detail: notification.describe(),
+				})
+			}
+		}
+	}
+	return
+}
+
+// checkInstance retrieves the resource instance of "result" and records the findings about its state.
+func (checker *ResourcePreChecker) checkInstance(ctx context.Context, result *ResourcePreCheckResult) {
+	options := checker.ResourceController.NewGetResourceInstanceOptions(result.CRN)
+	instance, response, err := checker.ResourceController.GetResourceInstanceWithContext(ctx, options)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			result.Findings = append(result.Findings, ResourcePreCheckFinding{
+				Kind:   ResourcePreCheckFindingNotFoundConst,
+				Detail: "the resource instance does not exist",
+			})
+			return
+		}
+		result.Err = fmt.Errorf("error retrieving resource instance '%s': %w", result.CRN, err)
+		return
+	}
+	result.Instance = instance
+
+	state := core.StringNilMapper(instance.State)
+	finding := ResourcePreCheckFinding{}
+	switch state {
+	case resourcecontrollerv2.ResourceInstanceStateRemovedConst:
+		finding.Kind = ResourcePreCheckFindingDeprovisionedConst
+		finding.Detail = "the resource instance was deleted"
+	case resourcecontrollerv2.ResourceInstanceStatePendingReclamationConst:
+		finding.Kind = ResourcePreCheckFindingPendingReclamationConst
+		finding.Detail = "the resource instance was deleted and is pending reclamation; it can be restored"
+	case resourcecontrollerv2.ResourceInstanceStateFailedConst:
+		finding.Kind = ResourcePreCheckFindingFailedConst
+		finding.Detail = "the provisioning of the resource instance failed"
+	case resourcecontrollerv2.ResourceInstanceStateInactiveConst:
+		finding.Kind = ResourcePreCheckFindingInactiveConst
+		finding.Detail = "the resource instance is inactive"
+	case resourcecontrollerv2.ResourceInstanceStateProvisioningConst, resourcecontrollerv2.ResourceInstanceStatePreProvisioningConst:
+		finding.Kind = ResourcePreCheckFindingProvisioningConst
+		finding.Detail = "the resource instance is still being provisioned"
+	default:
+		return
+	}
+	finding.Detail += fmt.Sprintf(" (state '%s')", state)
+	result.Findings = append(result.Findings, finding)
+}
+
+// affects returns true if the notification applies to the service and region of a resource.
+func (notification *PlatformNotification) affects(crn common.CRN) bool {
+	if notification.Region != "" && !strings.EqualFold(notification.Region, crn.Region()) {
+		return false
+	}
+	if len(notification.ServiceNames) == 0 {
+		return true
+	}
+	for _, serviceName := range notification.ServiceNames {
+		if strings.EqualFold(serviceName, crn.ServiceName()) {
+			return true
+		}
+	}
+	return false
+}
+
+// describe returns a description of the notification, suitable for a case description.
+func (notification *PlatformNotification) describe() string {
+	description := fmt.Sprintf("platform notification '%s'", notification.Title)
+	if notification.Type != "" {
+		description = fmt.Sprintf("%s %s", notification.Type, description)
+	}
+	if !notification.Start.IsZero() {
+		description += " since " + notification.Start.UTC().Format(time.RFC3339)
+	}
+	if !notification.End.IsZero() {
+		description += " until " + notification.End.UTC().Format(time.RFC3339)
+	}
+	return description
+}
@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeNotificationSource struct {
+	notifications []casemanagementv1.PlatformNotification
+	since         time.Time
+	err           error
+}
+
+func (source *fakeNotificationSource) Notifications(ctx context.Context, since time.Time) ([]casemanagementv1.PlatformNotification, error) {
+	source.since = since
+	return source.notifications, source.err
+}
+
+var _ = Describe(`CaseManagementV1 resource pre-check`, func() {
+	const activeCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/acct:active::"
+	const removedCRN = "crn:v1:bluemix:public:kms:us-south:a/acct:removed::"
+	const reclaimingCRN = "crn:v1:bluemix:public:kms:us-south:a/acct:reclaiming::"
+	const missingCRN = "crn:v1:bluemix:public:kms:eu-de:a/acct:missing::"
+	const brokenCRN = "crn:v1:bluemix:public:kms:eu-de:a/acct:broken::"
+	states := map[string]string{
+		activeCRN:     "active",
+		removedCRN:    "removed",
+		reclaimingCRN: "pending_reclamation",
+	}
+	var testServer *httptest.Server
+	var checker *casemanagementv1.ResourcePreChecker
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.URL.Path).To(HavePrefix("/v2/resource_instances/"))
+			crn, err := url.PathUnescape(strings.TrimPrefix(req.URL.EscapedPath(), "/v2/resource_instances/"))
+			Expect(err).To(BeNil())
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case crn == brokenCRN:
+				res.WriteHeader(400)
+				fmt.Fprint(res, `{"message": "bad request"}`)
+			case states[crn] == "":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			default:
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"crn": "%s", "state": "%s"}`, crn, states[crn])
+			}
+		}))
+		caseManagementService, err := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		common.EnableWorkflowIDs(caseManagementService.Service)
+		resourceControllerService, err := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		checker = casemanagementv1.NewResourcePreChecker(caseManagementService, resourceControllerService)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Records the findings about the state of the resources`, func() {
+		check, err := checker.PreCheckResources(context.Background(), []string{activeCRN, removedCRN, reclaimingCRN, missingCRN, brokenCRN, "not a crn"})
+		Expect(err).To(BeNil())
+		Expect(check.WorkflowID).ToNot(BeEmpty())
+		Expect(check.Results).To(HaveLen(6))
+
+		Expect(*check.Results[0].Instance.State).To(Equal("active"))
+		Expect(check.Results[0].Findings).To(BeEmpty())
+		Expect(check.Results[1].Findings[0].Kind).To(Equal(casemanagementv1.ResourcePreCheckFindingDeprovisionedConst))
+		Expect(check.Results[2].Findings[0].Kind).To(Equal(casemanagementv1.ResourcePreCheckFindingPendingReclamationConst))
+		Expect(check.Results[3].Instance).To(BeNil())
+		Expect(check.Results[3].Findings[0].Kind).To(Equal(casemanagementv1.ResourcePreCheckFindingNotFoundConst))
+		Expect(check.Results[4].Err.Error()).To(ContainSubstring("bad request"))
+		Expect(check.Results[5].Err.Error()).To(ContainSubstring("invalid CRN"))
+
+		explained := check.Explained()
+		Expect(explained).To(HaveLen(3))
+		Expect(explained[0].CRN).To(Equal(removedCRN))
+
+		description := check.AnnotateDescription("My keys are gone.\n")
+		Expect(description).To(HavePrefix("My keys are gone.\n\nResource pre-check findings:\n"))
+		Expect(description).To(ContainSubstring("- " + removedCRN + ": the resource instance was deleted (state 'removed')"))
+		Expect(description).To(ContainSubstring("- " + missingCRN + ": the resource instance does not exist"))
+	})
+	It(`Records the platform notifications that affect the resources`, func() {
+		start := time.Date(2022, time.May, 1, 10, 0, 0, 0, time.UTC)
+		source := &fakeNotificationSource{notifications: []casemanagementv1.PlatformNotification{
+			{Title: "Key Protect latency", Type: "incident", Region: "us-south", ServiceNames: []string{"kms"}, Start: start},
+			{Title: "Dallas network maintenance", Type: "maintenance", Region: "us-south"},
+			{Title: "Frankfurt outage", Region: "eu-de"},
+		}}
+		checker.Notifications = source
+		checker.NotificationWindow = time.Hour
+		check, err := checker.PreCheckResources(context.Background(), []string{activeCRN, removedCRN})
+		Expect(err).To(BeNil())
+		Expect(source.since).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+
+		Expect(check.Results[0].Findings).To(BeEmpty())
+		Expect(check.AnnotateDescription("unchanged")).To(Equal("unchanged\n\nResource pre-check findings:\n" +
+			"- " + removedCRN + ": the resource instance was deleted (state 'removed')\n" +
+			"- " + removedCRN + ": incident platform notification 'Key Protect latency' since 2022-05-01T10:00:00Z\n" +
+			"- " + removedCRN + ": maintenance platform notification 'Dallas network maintenance'"))
+		Expect(check.Results[1].Findings[1].Kind).To(Equal(casemanagementv1.ResourcePreCheckFindingNotificationConst))
+	})
+	It(`Leaves the description unchanged without findings`, func() {
+		check, err := checker.PreCheckResources(context.Background(), []string{activeCRN})
+		Expect(err).To(BeNil())
+		Expect(check.Explained()).To(BeEmpty())
+		Expect(check.AnnotateDescription("Help")).To(Equal("Help"))
+	})
+	It(`Returns the error of the notification source`, func() {
+		checker.Notifications = &fakeNotificationSource{err: errors.New("feed unavailable")}
+		_, err := checker.PreCheckResources(context.Background(), []string{activeCRN})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("feed unavailable"))
+	})
+})
@@ -0,0 +1,223 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genredact. DO NOT EDIT.
+
+package casemanagementv1
+
+import "github.com/IBM/platform-services-go-sdk/common"
+
+//go:generate go run ../internal/genredact
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AcceptPayload) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AcceptPayload) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Attachment) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Attachment) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AttachmentList) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AttachmentList) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Case) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Case) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CaseEu) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CaseEu) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CaseList) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CaseList) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CasePayloadEu) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CasePayloadEu) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Comment) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Comment) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model FileWithMetadata) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model FileWithMetadata) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Offering) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Offering) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model OfferingType) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model OfferingType) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PaginationLink) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PaginationLink) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ResolvePayload) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ResolvePayload) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Resource) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Resource) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ResourcePayload) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ResourcePayload) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model StatusPayload) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model StatusPayload) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model UnresolvePayload) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model UnresolvePayload) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model User) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model User) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Watchlist) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Watchlist) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model WatchlistAddResponse) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model WatchlistAddResponse) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
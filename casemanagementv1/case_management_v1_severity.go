@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// The range of case severities. Smaller values mean higher severity.
+const (
+	CaseSeverityMinimum = 1
+	CaseSeverityMaximum = 4
+)
+
+// SeverityChangeRequest : A request to change the severity of a case, as recorded by RequestSeverityChange.
+type SeverityChangeRequest struct {
+	// The number of the case.
+	CaseNumber string
+
+	// The severity of the case when the change was requested.
+	PreviousSeverity int64
+
+	// The requested severity.
+	NewSeverity int64
+
+	// The reason for the change.
+	Justification string
+
+	// The comment that records the request on the case.
+	Comment *Comment
+}
+
+// Escalation returns true if the request raises the severity of the case (lowers its severity value).
+func (request *SeverityChangeRequest) Escalation() bool {
+	return request.NewSeverity < request.PreviousSeverity
+}
+
+// RequestSeverityChange asks IBM support to change the severity of a case by adding a comment in a standard format
+// that records the current severity, the requested severity and the justification. The Case Management API does not
+// allow the severity of an existing case to be updated directly, so the comment is the auditable record of the
+// request and support applies the change. An error is returned if the severity is out of range, the justification is
+// empty, the case already has the requested severity or the case is closed.
+func (caseManagement *CaseManagementV1) RequestSeverityChange(ctx context.Context, caseNumber string, newSeverity int64, justification string) (result *SeverityChangeRequest, err error) {
+	justification = strings.TrimSpace(justification)
+	if newSeverity < CaseSeverityMinimum || newSeverity > CaseSeverityMaximum {
+		err = fmt.Errorf("severity must be between %d and %d, but was %d", CaseSeverityMinimum, CaseSeverityMaximum, newSeverity)
+		return
+	}
+	if justification == "" {
+		err = fmt.Errorf("a justification is required to change the severity of case '%s'", caseNumber)
+		return
+	}
+
+	getCaseOptions := caseManagement.NewGetCaseOptions(caseNumber).
+		SetFields([]string{GetCaseOptionsFieldsSeverityConst, GetCaseOptionsFieldsStatusConst})
+	supportCase, _, err := caseManagement.GetCaseWithContext(ctx, getCaseOptions)
+	if err != nil {
+		return
+	}
+	if supportCase.Status != nil && strings.EqualFold(*supportCase.Status, GetCasesOptionsStatusClosedConst) {
+		err = fmt.Errorf("cannot change the severity of closed case '%s'", caseNumber)
+		return
+	}
+
+	result = &SeverityChangeRequest{
+		CaseNumber:    caseNumber,
+		NewSeverity:   newSeverity,
+		Justification: justification,
+	}
+	if supportCase.Severity != nil {
+		result.PreviousSeverity = int64(*supportCase.Severity)
+	}
+	if result.PreviousSeverity == newSeverity {
+		err = fmt.Errorf("case '%s' already has severity %d", caseNumber, newSeverity)
+		result = nil
+		return
+	}
+
+	addCommentOptions := caseManagement.NewAddCommentOptions(caseNumber, severityChangeComment(result))
+	result.Comment, _, err = caseManagement.AddCommentWithContext(ctx, addCommentOptions)
+	if err != nil {
+		result = nil
+	}
+	return
+}
+
+// severityChangeComment formats the comment that records a severity change request.
+func severityChangeComment(request *SeverityChangeRequest) string {
+	action, previous := "change", "unknown"
+	if request.PreviousSeverity != 0 {
+		previous = fmt.Sprint(request.PreviousSeverity)
+		action = "downgrade"
+		if request.Escalation() {
+			action = "escalation"
+		}
+	}
+	return fmt.Sprintf("Severity change request (%s): severity %s -> %d\nJustification: %s",
+		action, previous, request.NewSeverity, request.Justification)
+}
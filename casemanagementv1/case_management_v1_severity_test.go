@@ -0,0 +1,94 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CaseManagementV1 RequestSeverityChange`, func() {
+	var testServer *httptest.Server
+	var caseManagementService *casemanagementv1.CaseManagementV1
+	var comments []string
+	BeforeEach(func() {
+		comments = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/cases/TS0001":
+				Expect(req.URL.Query().Get("fields")).To(Equal("severity,status"))
+				fmt.Fprint(res, `{"number": "TS0001", "severity": 3, "status": "In Progress"}`)
+			case req.Method == "GET" && req.URL.EscapedPath() == "/cases/TS0002":
+				fmt.Fprint(res, `{"number": "TS0002", "severity": 3, "status": "Closed"}`)
+			case req.Method == "PUT" && req.URL.EscapedPath() == "/cases/TS0001/comments":
+				var body map[string]string
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				comments = append(comments, body["comment"])
+				fmt.Fprintf(res, `{"value": %q, "added_at": "2022-01-01T00:00:00Z"}`, body["comment"])
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		caseManagementService, serviceErr = casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Records an escalation with its justification`, func() {
+		result, err := caseManagementService.RequestSeverityChange(context.Background(), "TS0001", 1, " Production outage affecting all users ")
+		Expect(err).To(BeNil())
+		Expect(result.PreviousSeverity).To(Equal(int64(3)))
+		Expect(result.NewSeverity).To(Equal(int64(1)))
+		Expect(result.Escalation()).To(BeTrue())
+		Expect(comments).To(Equal([]string{"Severity change request (escalation): severity 3 -> 1\nJustification: Production outage affecting all users"}))
+		Expect(*result.Comment.Value).To(Equal(comments[0]))
+	})
+	It(`Records a downgrade`, func() {
+		result, err := caseManagementService.RequestSeverityChange(context.Background(), "TS0001", 4, "Workaround in place")
+		Expect(err).To(BeNil())
+		Expect(result.Escalation()).To(BeFalse())
+		Expect(comments[0]).To(HavePrefix("Severity change request (downgrade): severity 3 -> 4"))
+	})
+	It(`Rejects invalid requests without commenting`, func() {
+		_, err := caseManagementService.RequestSeverityChange(context.Background(), "TS0001", 5, "Too high")
+		Expect(err).ToNot(BeNil())
+		_, err = caseManagementService.RequestSeverityChange(context.Background(), "TS0001", 2, "  ")
+		Expect(err.Error()).To(ContainSubstring("justification is required"))
+		_, err = caseManagementService.RequestSeverityChange(context.Background(), "TS0001", 3, "Same")
+		Expect(err.Error()).To(ContainSubstring("already has severity 3"))
+		_, err = caseManagementService.RequestSeverityChange(context.Background(), "TS0002", 1, "Reopen")
+		Expect(err.Error()).To(ContainSubstring("closed case 'TS0002'"))
+		Expect(comments).To(BeEmpty())
+	})
+})
@@ -0,0 +1,180 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CaseStatusChange : A change of the status of a case. The Case Management API only returns the current status of a
+// case, so the status history is recorded by the caller (e.g. when polling the case) and passed to MeasureCaseSLA.
+type CaseStatusChange struct {
+	// The new status of the case.
+	Status string
+
+	// The time of the change.
+	ChangedAt time.Time
+}
+
+// CaseSLA : The support response measurements of a case, as computed by MeasureCaseSLA.
+type CaseSLA struct {
+	// The number of the case.
+	CaseNumber string
+
+	// The severity of the case, or zero if unknown.
+	Severity int64
+
+	// The current status of the case.
+	Status string
+
+	// The time when the case was created.
+	CreatedAt time.Time
+
+	// The time of the first comment added by IBM support, or nil if support has not responded yet.
+	FirstResponseAt *time.Time
+
+	// The time from the creation of the case to the first response of IBM support. If support has not responded yet,
+	// it is the time elapsed so far.
+	TimeToFirstResponse time.Duration
+
+	// The total time spent in each status, according to the status history passed to MeasureCaseSLA. The time before
+	// the first recorded change is attributed to the "new" status. It is nil if no status history was passed.
+	TimeInStatus map[string]time.Duration
+}
+
+// Responded returns true if IBM support has responded to the case.
+func (sla *CaseSLA) Responded() bool {
+	return sla.FirstResponseAt != nil
+}
+
+// FirstResponseBreached returns true if the first response took, or has so far taken, longer than "target".
+func (sla *CaseSLA) FirstResponseBreached(target time.Duration) bool {
+	return sla.TimeToFirstResponse > target
+}
+
+// MeasureCaseSLA computes the time to the first response of IBM support and the time spent in each status for a
+// case retrieved with its comments, creation time and users (see GetCaseSLA). A comment is considered a support
+// response if it was added by a user other than the creator, the contact or a member of the watchlist of the case.
+// Open-ended measurements run until "now".
+func MeasureCaseSLA(supportCase *Case, statusHistory []CaseStatusChange, now time.Time) (result *CaseSLA, err error) {
+	err = core.ValidateNotNil(supportCase, "supportCase cannot be nil")
+	if err != nil {
+		return
+	}
+	caseNumber := core.StringNilMapper(supportCase.Number)
+	createdAt, err := time.Parse(time.RFC3339, core.StringNilMapper(supportCase.CreatedAt))
+	if err != nil {
+		err = fmt.Errorf("error parsing the creation time of case '%s': %w", caseNumber, err)
+		return
+	}
+
+	result = &CaseSLA{
+		CaseNumber: caseNumber,
+		Status:     core.StringNilMapper(supportCase.Status),
+		CreatedAt:  createdAt,
+	}
+	if supportCase.Severity != nil {
+		result.Severity = int64(*supportCase.Severity)
+	}
+
+	customers := caseCustomers(supportCase)
+	for _, comment := range supportCase.Comments {
+		if comment.AddedBy == nil || customers[userKey(comment.AddedBy)] {
+			continue
+		}
+		addedAt, parseErr := time.Parse(time.RFC3339, core.StringNilMapper(comment.AddedAt))
+		if parseErr != nil {
+			err = fmt.Errorf("error parsing the time of a comment of case '%s': %w", caseNumber, parseErr)
+			result = nil
+			return
+		}
+		if result.FirstResponseAt == nil || addedAt.Before(*result.FirstResponseAt) {
+			result.FirstResponseAt = &addedAt
+		}
+	}
+	if result.FirstResponseAt != nil {
+		result.TimeToFirstResponse = result.FirstResponseAt.Sub(createdAt)
+	} else {
+		result.TimeToFirstResponse = now.Sub(createdAt)
+	}
+
+	if len(statusHistory) > 0 {
+		result.TimeInStatus = timeInStatus(createdAt, statusHistory, now)
+	}
+	return
+}
+
+// caseCustomers returns the keys of the users on the customer side of a case.
+func caseCustomers(supportCase *Case) map[string]bool {
+	customers := make(map[string]bool)
+	for _, user := range []*User{supportCase.CreatedBy, supportCase.Contact} {
+		if user != nil {
+			customers[userKey(user)] = true
+		}
+	}
+	for i := range supportCase.Watchlist {
+		customers[userKey(&supportCase.Watchlist[i])] = true
+	}
+	return customers
+}
+
+func userKey(user *User) string {
+	return core.StringNilMapper(user.Realm) + "/" + core.StringNilMapper(user.UserID)
+}
+
+// timeInStatus sums the time between the status changes of a case, starting in the "new" status at "createdAt".
+func timeInStatus(createdAt time.Time, statusHistory []CaseStatusChange, now time.Time) map[string]time.Duration {
+	changes := append([]CaseStatusChange(nil), statusHistory...)
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].ChangedAt.Before(changes[j].ChangedAt)
+	})
+
+	result := make(map[string]time.Duration)
+	status, since := GetCasesOptionsStatusNewConst, createdAt
+	for _, change := range append(changes, CaseStatusChange{ChangedAt: now}) {
+		if change.ChangedAt.After(since) {
+			result[status] += change.ChangedAt.Sub(since)
+			since = change.ChangedAt
+		}
+		status = change.Status
+	}
+	return result
+}
+
+// GetCaseSLA retrieves a case with the fields needed by MeasureCaseSLA and measures it at the current time.
+func (caseManagement *CaseManagementV1) GetCaseSLA(ctx context.Context, caseNumber string, statusHistory []CaseStatusChange) (result *CaseSLA, err error) {
+	getCaseOptions := caseManagement.NewGetCaseOptions(caseNumber).SetFields([]string{
+		GetCaseOptionsFieldsNumberConst,
+		GetCaseOptionsFieldsCreatedAtConst,
+		GetCaseOptionsFieldsCreatedByConst,
+		GetCaseOptionsFieldsContactConst,
+		GetCaseOptionsFieldsWatchlistConst,
+		GetCaseOptionsFieldsStatusConst,
+		GetCaseOptionsFieldsSeverityConst,
+		GetCaseOptionsFieldsCommentsConst,
+	})
+	supportCase, _, err := caseManagement.GetCaseWithContext(ctx, getCaseOptions)
+	if err != nil {
+		return
+	}
+	return MeasureCaseSLA(supportCase, statusHistory, time.Now())
+}
@@ -0,0 +1,108 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CaseManagementV1 case SLA measurements`, func() {
+	var testServer *httptest.Server
+	var caseManagementService *casemanagementv1.CaseManagementV1
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/cases/TS0001":
+				Expect(req.URL.Query().Get("fields")).To(Equal("number,created_at,created_by,contact,watchlist,status,severity,comments"))
+				fmt.Fprint(res, `{"number": "TS0001", "created_at": "2022-05-02T08:00:00Z", "status": "In Progress", "severity": 2,
+					"created_by": {"realm": "IBMid", "user_id": "ann@example.com"},
+					"contact": {"realm": "IBMid", "user_id": "bob@example.com"},
+					"watchlist": [{"realm": "IBMid", "user_id": "cat@example.com"}],
+					"comments": [
+						{"value": "more details", "added_at": "2022-05-02T08:10:00Z", "added_by": {"realm": "IBMid", "user_id": "bob@example.com"}},
+						{"value": "looking", "added_at": "2022-05-02T09:30:00Z", "added_by": {"realm": "IBMid", "user_id": "agent@ibm.com"}},
+						{"value": "cc", "added_at": "2022-05-02T08:20:00Z", "added_by": {"realm": "IBMid", "user_id": "cat@example.com"}},
+						{"value": "update", "added_at": "2022-05-02T11:00:00Z", "added_by": {"realm": "IBMid", "user_id": "agent@ibm.com"}}]}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		caseManagementService, serviceErr = casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	created := time.Date(2022, 5, 2, 8, 0, 0, 0, time.UTC)
+
+	It(`Measures the first support response of a case`, func() {
+		sla, err := caseManagementService.GetCaseSLA(context.Background(), "TS0001", nil)
+		Expect(err).To(BeNil())
+		Expect(sla.CaseNumber).To(Equal("TS0001"))
+		Expect(sla.Severity).To(Equal(int64(2)))
+		Expect(sla.Responded()).To(BeTrue())
+		Expect(*sla.FirstResponseAt).To(Equal(created.Add(90 * time.Minute)))
+		Expect(sla.TimeToFirstResponse).To(Equal(90 * time.Minute))
+		Expect(sla.FirstResponseBreached(time.Hour)).To(BeTrue())
+		Expect(sla.FirstResponseBreached(2 * time.Hour)).To(BeFalse())
+		Expect(sla.TimeInStatus).To(BeNil())
+	})
+	It(`Measures a case awaiting a response and its time in each status`, func() {
+		supportCase := &casemanagementv1.Case{
+			Number:    core.StringPtr("TS0002"),
+			CreatedAt: core.StringPtr("2022-05-02T08:00:00Z"),
+			CreatedBy: &casemanagementv1.User{Realm: core.StringPtr("IBMid"), UserID: core.StringPtr("ann@example.com")},
+			Status:    core.StringPtr("waiting_on_client"),
+		}
+		history := []casemanagementv1.CaseStatusChange{
+			{Status: "waiting_on_client", ChangedAt: created.Add(3 * time.Hour)},
+			{Status: "in_progress", ChangedAt: created.Add(time.Hour)},
+		}
+		sla, err := casemanagementv1.MeasureCaseSLA(supportCase, history, created.Add(5*time.Hour))
+		Expect(err).To(BeNil())
+		Expect(sla.Responded()).To(BeFalse())
+		Expect(sla.TimeToFirstResponse).To(Equal(5 * time.Hour))
+		Expect(sla.TimeInStatus).To(Equal(map[string]time.Duration{
+			"new":               time.Hour,
+			"in_progress":       2 * time.Hour,
+			"waiting_on_client": 2 * time.Hour,
+		}))
+	})
+	It(`Rejects cases without a valid creation time`, func() {
+		_, err := casemanagementv1.MeasureCaseSLA(&casemanagementv1.Case{Number: core.StringPtr("TS0003")}, nil, time.Now())
+		Expect(err).ToNot(BeNil())
+		_, err = casemanagementv1.MeasureCaseSLA(nil, nil, time.Now())
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,377 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The operations whose throttling is handled by ThrottledClient, used as the keys of ThrottledClient.RetryConfigs.
+const (
+	ThrottledOperationAddCommentConst = "add_comment"
+	ThrottledOperationUploadFileConst = "upload_file"
+)
+
+// ThrottleRetryConfig : How a ThrottledClient retries an operation that is rejected with status 429 (Too Many
+// Requests).
+type ThrottleRetryConfig struct {
+	// The maximum number of retries after the first attempt.
+	MaxRetries int
+
+	// The delay before a retry when the response has no valid Retry-After header.
+	DefaultRetryAfter time.Duration
+
+	// If greater than zero, the maximum delay before a retry, regardless of the Retry-After header.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultThrottleRetryConfig is used for the operations that have no entry in ThrottledClient.RetryConfigs.
+var DefaultThrottleRetryConfig = ThrottleRetryConfig{
+	MaxRetries:        3,
+	DefaultRetryAfter: 5 * time.Second,
+	MaxRetryAfter:     time.Minute,
+}
+
+// ErrRequestQueued is wrapped by the error returned by ThrottledClient when a request is still throttled after its
+// retries and has been added to the client's CommentQueue.
+var ErrRequestQueued = errors.New("request throttled and queued for later delivery")
+
+// retryAfter returns the delay requested by the Retry-After header of "response" (in seconds or as an HTTP date),
+// limited according to the configuration.
+func (config ThrottleRetryConfig) retryAfter(response *core.DetailedResponse) (delay time.Duration) {
+	delay = config.DefaultRetryAfter
+	if response != nil {
+		header := response.Headers.Get("Retry-After")
+		if seconds, err := strconv.Atoi(header); err == nil {
+			delay = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(header); err == nil {
+			delay = time.Until(date)
+		}
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	if config.MaxRetryAfter > 0 && delay > config.MaxRetryAfter {
+		delay = config.MaxRetryAfter
+	}
+	return
+}
+
+func isThrottled(response *core.DetailedResponse) bool {
+	return response != nil && response.StatusCode == http.StatusTooManyRequests
+}
+
+// ThrottledClient : Wraps a CaseManagementV1 client so that comments and file uploads that are throttled (status 429)
+// are retried according to a per-operation configuration that honors the Retry-After header, independently of the
+// client's global retry settings. Requests that are still throttled after their retries can be deferred to a
+// CommentQueue.
+// All other operations are passed through to the wrapped client unchanged.
+type ThrottledClient struct {
+	*CaseManagementV1
+
+	// The retry configuration of each operation (see ThrottledOperationAddCommentConst and
+	// ThrottledOperationUploadFileConst). Operations without an entry use DefaultThrottleRetryConfig.
+	RetryConfigs map[string]ThrottleRetryConfig
+
+	// If not nil, requests that are still throttled after their retries are added to this queue, and an error
+	// wrapping ErrRequestQueued is returned.
+	Queue *CommentQueue
+}
+
+// NewThrottledClient returns a new ThrottledClient that sends requests with "caseManagement" and defers throttled
+// requests to "queue", which may be nil.
+func NewThrottledClient(caseManagement *CaseManagementV1, queue *CommentQueue) *ThrottledClient {
+	return &ThrottledClient{
+		CaseManagementV1: caseManagement,
+		RetryConfigs:     make(map[string]ThrottleRetryConfig),
+		Queue:            queue,
+	}
+}
+
+func (client *ThrottledClient) retryConfig(operation string) ThrottleRetryConfig {
+	if config, found := client.RetryConfigs[operation]; found {
+		return config
+	}
+	return DefaultThrottleRetryConfig
+}
+
+// AddComment adds a comment to a case, retrying while it is throttled.
+func (client *ThrottledClient) AddComment(addCommentOptions *AddCommentOptions) (result *Comment, response *core.DetailedResponse, err error) {
+	return client.AddCommentWithContext(context.Background(), addCommentOptions)
+}
+
+// AddCommentWithContext is an alternate form of the AddComment method which supports a Context parameter
+func (client *ThrottledClient) AddCommentWithContext(ctx context.Context, addCommentOptions *AddCommentOptions) (result *Comment, response *core.DetailedResponse, err error) {
+	request := &queuedRequest{
+		config:     client.retryConfig(ThrottledOperationAddCommentConst),
+		addComment: addCommentOptions,
+	}
+	response, err = client.sendWithRetries(ctx, request, func(ctx context.Context) (*core.DetailedResponse, error) {
+		var sendErr error
+		result, response, sendErr = client.CaseManagementV1.AddCommentWithContext(ctx, addCommentOptions)
+		return response, sendErr
+	})
+	return
+}
+
+// UploadFile adds files as attachments to a case, retrying while the upload is throttled. The data of the files is
+// read into memory so that it can be sent again.
+func (client *ThrottledClient) UploadFile(uploadFileOptions *UploadFileOptions) (result *Attachment, response *core.DetailedResponse, err error) {
+	return client.UploadFileWithContext(context.Background(), uploadFileOptions)
+}
+
+// UploadFileWithContext is an alternate form of the UploadFile method which supports a Context parameter
+func (client *ThrottledClient) UploadFileWithContext(ctx context.Context, uploadFileOptions *UploadFileOptions) (result *Attachment, response *core.DetailedResponse, err error) {
+	if uploadFileOptions == nil {
+		return client.CaseManagementV1.UploadFileWithContext(ctx, uploadFileOptions)
+	}
+	files, err := readFiles(uploadFileOptions.File)
+	if err != nil {
+		return
+	}
+
+	request := &queuedRequest{
+		config:     client.retryConfig(ThrottledOperationUploadFileConst),
+		uploadFile: uploadFileOptions,
+		files:      files,
+	}
+	response, err = client.sendWithRetries(ctx, request, func(ctx context.Context) (*core.DetailedResponse, error) {
+		var sendErr error
+		result, response, sendErr = client.CaseManagementV1.UploadFileWithContext(ctx, request.uploadFileOptions())
+		return response, sendErr
+	})
+	return
+}
+
+// sendWithRetries calls "send" until it is not throttled or the retries of the request are exhausted, and then
+// queues the request if it is still throttled.
+func (client *ThrottledClient) sendWithRetries(ctx context.Context, request *queuedRequest, send func(context.Context) (*core.DetailedResponse, error)) (response *core.DetailedResponse, err error) {
+	for attempt := 0; ; attempt++ {
+		response, err = send(ctx)
+		if !isThrottled(response) || attempt >= request.config.MaxRetries {
+			break
+		}
+		timer := time.NewTimer(request.config.retryAfter(response))
+		select {
+		case <-timer.C:
+			continue
+		case <-ctx.Done():
+			timer.Stop()
+		}
+		break
+	}
+
+	if isThrottled(response) && client.Queue != nil {
+		request.notBefore = time.Now().Add(request.config.retryAfter(response))
+		request.copyOptions()
+		client.Queue.push(request)
+		err = fmt.Errorf("%w: %s", ErrRequestQueued, err.Error())
+	}
+	return
+}
+
+// CommentQueue : Holds comments and file uploads that were throttled, so that they can be sent later with Flush.
+// It is safe for concurrent use.
+type CommentQueue struct {
+	mutex      sync.Mutex
+	flushMutex sync.Mutex
+	requests   []*queuedRequest
+}
+
+// NewCommentQueue returns a new, empty CommentQueue.
+func NewCommentQueue() *CommentQueue {
+	return &CommentQueue{}
+}
+
+// Len returns the number of queued requests.
+func (queue *CommentQueue) Len() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return len(queue.requests)
+}
+
+// NextAttempt returns the time at which the first queued request may be sent, or the zero time if the queue is empty.
+func (queue *CommentQueue) NextAttempt() time.Time {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	if len(queue.requests) == 0 {
+		return time.Time{}
+	}
+	return queue.requests[0].notBefore
+}
+
+func (queue *CommentQueue) push(request *queuedRequest) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	queue.requests = append(queue.requests, request)
+}
+
+// Flush sends the queued requests, in the order in which they were queued, with "caseManagement". It stops at the
+// first request that is not yet due or that is throttled again (which is rescheduled according to its Retry-After
+// header), and returns the number of requests sent. A request that fails for another reason is removed from the queue
+// and its error is returned.
+func (queue *CommentQueue) Flush(ctx context.Context, caseManagement *CaseManagementV1) (sent int, err error) {
+	queue.flushMutex.Lock()
+	defer queue.flushMutex.Unlock()
+
+	for {
+		queue.mutex.Lock()
+		if len(queue.requests) == 0 || time.Now().Before(queue.requests[0].notBefore) {
+			queue.mutex.Unlock()
+			return
+		}
+		request := queue.requests[0]
+		queue.mutex.Unlock()
+
+		response, sendErr := request.send(ctx, caseManagement)
+		if isThrottled(response) {
+			queue.mutex.Lock()
+			request.notBefore = time.Now().Add(request.config.retryAfter(response))
+			queue.mutex.Unlock()
+			return
+		}
+
+		queue.mutex.Lock()
+		queue.requests = queue.requests[1:]
+		queue.mutex.Unlock()
+		if sendErr != nil {
+			err = fmt.Errorf("error sending queued %s for case '%s': %w", request.operation(), request.caseNumber(), sendErr)
+			return
+		}
+		sent++
+	}
+}
+
+// queuedRequest : A comment or file upload, with the data of the files held in memory so that it can be resent.
+type queuedRequest struct {
+	config     ThrottleRetryConfig
+	notBefore  time.Time
+	addComment *AddCommentOptions
+	uploadFile *UploadFileOptions
+	files      []bufferedFile
+}
+
+type bufferedFile struct {
+	data        []byte
+	filename    *string
+	contentType *string
+}
+
+func (request *queuedRequest) send(ctx context.Context, caseManagement *CaseManagementV1) (response *core.DetailedResponse, err error) {
+	if request.addComment != nil {
+		_, response, err = caseManagement.AddCommentWithContext(ctx, request.addComment)
+	} else {
+		_, response, err = caseManagement.UploadFileWithContext(ctx, request.uploadFileOptions())
+	}
+	return
+}
+
+func (request *queuedRequest) operation() string {
+	if request.addComment != nil {
+		return ThrottledOperationAddCommentConst
+	}
+	return ThrottledOperationUploadFileConst
+}
+
+func (request *queuedRequest) caseNumber() string {
+	if request.addComment != nil {
+		return core.StringNilMapper(request.addComment.CaseNumber)
+	}
+	return core.StringNilMapper(request.uploadFile.CaseNumber)
+}
+
+// copyOptions replaces the options of the request with copies, so that the queued request does not share them with
+// the caller.
+func (request *queuedRequest) copyOptions() {
+	if request.addComment != nil {
+		options := *request.addComment
+		options.CaseNumber = copyString(options.CaseNumber)
+		options.Comment = copyString(options.Comment)
+		options.Headers = copyHeaders(options.Headers)
+		request.addComment = &options
+	}
+	if request.uploadFile != nil {
+		options := *request.uploadFile
+		options.CaseNumber = copyString(options.CaseNumber)
+		options.File = nil
+		options.Headers = copyHeaders(options.Headers)
+		request.uploadFile = &options
+	}
+}
+
+func copyString(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	copied := *value
+	return &copied
+}
+
+func copyHeaders(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+	copied := make(map[string]string, len(headers))
+	for name, value := range headers {
+		copied[name] = value
+	}
+	return copied
+}
+
+// uploadFileOptions returns a copy of the upload options with fresh readers over the buffered file data.
+func (request *queuedRequest) uploadFileOptions() *UploadFileOptions {
+	options := *request.uploadFile
+	options.File = make([]FileWithMetadata, len(request.files))
+	for i, file := range request.files {
+		options.File[i] = FileWithMetadata{
+			Filename:    file.filename,
+			ContentType: file.contentType,
+		}
+		if file.data != nil {
+			options.File[i].Data = ioutil.NopCloser(bytes.NewReader(file.data))
+		}
+	}
+	return &options
+}
+
+// readFiles reads and closes the data of each file.
+func readFiles(files []FileWithMetadata) (buffered []bufferedFile, err error) {
+	buffered = make([]bufferedFile, len(files))
+	for i, file := range files {
+		buffered[i] = bufferedFile{filename: file.Filename, contentType: file.ContentType}
+		if file.Data == nil {
+			continue
+		}
+		buffered[i].data, err = ioutil.ReadAll(file.Data)
+		file.Data.Close()
+		if err != nil {
+			err = fmt.Errorf("error reading file '%s': %s", core.StringNilMapper(file.Filename), err.Error())
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,153 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CaseManagementV1 ThrottledClient`, func() {
+	var testServer *httptest.Server
+	var caseManagementService *casemanagementv1.CaseManagementV1
+	var mutex sync.Mutex
+	var throttle int
+	var uploads []string
+	var comments int
+	BeforeEach(func() {
+		throttle = 0
+		uploads = nil
+		comments = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			if throttle > 0 {
+				throttle--
+				res.Header().Set("Retry-After", "0")
+				res.WriteHeader(429)
+				fmt.Fprint(res, `{"errors": [{"message": "Too many requests"}]}`)
+				return
+			}
+			switch req.URL.EscapedPath() {
+			case "/cases/CS1/comments":
+				comments++
+				fmt.Fprint(res, `{"value": "hello"}`)
+			case "/cases/CS1/attachments":
+				file, _, err := req.FormFile("file")
+				Expect(err).To(BeNil())
+				data, _ := ioutil.ReadAll(file)
+				uploads = append(uploads, string(data))
+				fmt.Fprint(res, `{"id": "att1", "filename": "log.txt"}`)
+			default:
+				Fail("unexpected request " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		caseManagementService, serviceErr = casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	newUploadFileOptions := func() *casemanagementv1.UploadFileOptions {
+		return caseManagementService.NewUploadFileOptions("CS1", []casemanagementv1.FileWithMetadata{{
+			Data:     ioutil.NopCloser(bytes.NewReader([]byte("log data"))),
+			Filename: core.StringPtr("log.txt"),
+		}})
+	}
+
+	It(`Retries throttled comments and uploads`, func() {
+		client := casemanagementv1.NewThrottledClient(caseManagementService, nil)
+		throttle = 2
+		result, response, err := client.AddComment(caseManagementService.NewAddCommentOptions("CS1", "hello"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(*result.Value).To(Equal("hello"))
+
+		throttle = 1
+		attachment, _, err := client.UploadFile(newUploadFileOptions())
+		Expect(err).To(BeNil())
+		Expect(*attachment.ID).To(Equal("att1"))
+		Expect(uploads).To(Equal([]string{"log data"}))
+	})
+	It(`Gives up after the configured retries`, func() {
+		client := casemanagementv1.NewThrottledClient(caseManagementService, nil)
+		client.RetryConfigs[casemanagementv1.ThrottledOperationAddCommentConst] = casemanagementv1.ThrottleRetryConfig{MaxRetries: 1}
+		throttle = 2
+		_, response, err := client.AddComment(caseManagementService.NewAddCommentOptions("CS1", "hello"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(429))
+		Expect(throttle).To(Equal(0))
+	})
+	It(`Queues requests that are still throttled and flushes them later`, func() {
+		queue := casemanagementv1.NewCommentQueue()
+		client := casemanagementv1.NewThrottledClient(caseManagementService, queue)
+		client.RetryConfigs[casemanagementv1.ThrottledOperationAddCommentConst] = casemanagementv1.ThrottleRetryConfig{}
+		client.RetryConfigs[casemanagementv1.ThrottledOperationUploadFileConst] = casemanagementv1.ThrottleRetryConfig{}
+
+		throttle = 2
+		addCommentOptions := caseManagementService.NewAddCommentOptions("CS1", "hello")
+		_, _, err := client.AddComment(addCommentOptions)
+		Expect(errors.Is(err, casemanagementv1.ErrRequestQueued)).To(BeTrue())
+		uploadFileOptions := newUploadFileOptions()
+		_, _, err = client.UploadFile(uploadFileOptions)
+		Expect(errors.Is(err, casemanagementv1.ErrRequestQueued)).To(BeTrue())
+		Expect(queue.Len()).To(Equal(2))
+		Expect(queue.NextAttempt().After(time.Now())).To(BeFalse())
+
+		// The queue holds copies of the options.
+		*addCommentOptions.CaseNumber = "CS2"
+		uploadFileOptions.SetCaseNumber("CS2")
+
+		throttle = 1
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			queue.NextAttempt()
+		}()
+		sent, err := queue.Flush(context.Background(), caseManagementService)
+		<-done
+		Expect(err).To(BeNil())
+		Expect(sent).To(Equal(0))
+		Expect(queue.Len()).To(Equal(2))
+
+		sent, err = queue.Flush(context.Background(), caseManagementService)
+		Expect(err).To(BeNil())
+		Expect(sent).To(Equal(2))
+		Expect(queue.Len()).To(Equal(0))
+		Expect(comments).To(Equal(1))
+		Expect(uploads).To(Equal([]string{"log data"}))
+	})
+})
@@ -0,0 +1,297 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the CaseTimelineEvent.Type property.
+const (
+	CaseTimelineEventTypeAttachmentConst   = "attachment"
+	CaseTimelineEventTypeCommentConst      = "comment"
+	CaseTimelineEventTypeCreatedConst      = "created"
+	CaseTimelineEventTypeStatusChangeConst = "status_change"
+)
+
+// Constants for the formats supported by CaseTimeline.Export.
+const (
+	// A Markdown document with one bullet per event, for pasting into a postmortem.
+	CaseTimelineFormatMarkdownConst = "markdown"
+	// JSON shaped like incident.io timeline items: {"timeline_items": [{"occurred_at", "title", "description", ...}]}.
+	CaseTimelineFormatIncidentIOConst = "incident_io"
+	// JSON shaped like PagerDuty log entries: {"log_entries": [{"type", "created_at", "summary", "agent", ...}]}.
+	CaseTimelineFormatPagerDutyConst = "pagerduty"
+)
+
+// CaseTimeline : The history of a support case in chronological order, as built by BuildCaseTimeline.
+type CaseTimeline struct {
+	// The number of the case.
+	CaseNumber string
+
+	// The short description of the case.
+	ShortDescription string
+
+	// The events of the case, oldest first.
+	Events []CaseTimelineEvent
+}
+
+// CaseTimelineEvent : An event in the history of a case.
+type CaseTimelineEvent struct {
+	// The time of the event.
+	Time time.Time
+
+	// The type of the event (a CaseTimelineEventType*Const value).
+	Type string
+
+	// The user who caused the event, or nil if unknown (status changes and attachments).
+	Author *User
+
+	// True if the event was caused by IBM support rather than by a user on the customer side of the case.
+	FromSupport bool
+
+	// A one-line summary of the event.
+	Summary string
+
+	// The text of a comment, or the URL of an attachment.
+	Details string
+}
+
+// AuthorName returns the name of the author of the event, or its user ID if the name is unknown, or an empty string.
+func (event *CaseTimelineEvent) AuthorName() string {
+	if event.Author == nil {
+		return ""
+	}
+	if name := core.StringNilMapper(event.Author.Name); name != "" {
+		return name
+	}
+	return core.StringNilMapper(event.Author.UserID)
+}
+
+// BuildCaseTimeline builds the timeline of a case retrieved with its creation time, users, comments and attachments
+// (see GetCaseTimeline). The Case Management API does not return the status history of a case, so the status
+// changes are taken from "statusHistory", as recorded by the caller (it may be nil).
+func BuildCaseTimeline(supportCase *Case, statusHistory []CaseStatusChange) (timeline *CaseTimeline, err error) {
+	err = core.ValidateNotNil(supportCase, "supportCase cannot be nil")
+	if err != nil {
+		return
+	}
+	caseNumber := core.StringNilMapper(supportCase.Number)
+	parseTime := func(value *string, what string) (t time.Time, err error) {
+		t, err = time.Parse(time.RFC3339, core.StringNilMapper(value))
+		if err != nil {
+			err = fmt.Errorf("error parsing the time of %s of case '%s': %w", what, caseNumber, err)
+		}
+		return
+	}
+
+	createdAt, err := parseTime(supportCase.CreatedAt, "the creation")
+	if err != nil {
+		return
+	}
+	timeline = &CaseTimeline{CaseNumber: caseNumber, ShortDescription: core.StringNilMapper(supportCase.ShortDescription)}
+	created := CaseTimelineEvent{Time: createdAt, Type: CaseTimelineEventTypeCreatedConst, Author: supportCase.CreatedBy}
+	created.Summary = "Case created"
+	if name := created.AuthorName(); name != "" {
+		created.Summary += " by " + name
+	}
+	created.Details = core.StringNilMapper(supportCase.Description)
+	timeline.Events = append(timeline.Events, created)
+
+	for _, change := range statusHistory {
+		timeline.Events = append(timeline.Events, CaseTimelineEvent{
+			Time:    change.ChangedAt,
+			Type:    CaseTimelineEventTypeStatusChangeConst,
+			Summary: "Status changed to " + change.Status,
+		})
+	}
+
+	customers := caseCustomers(supportCase)
+	for i := range supportCase.Comments {
+		comment := &supportCase.Comments[i]
+		addedAt, parseErr := parseTime(comment.AddedAt, "a comment")
+		if parseErr != nil {
+			err, timeline = parseErr, nil
+			return
+		}
+		event := CaseTimelineEvent{
+			Time:        addedAt,
+			Type:        CaseTimelineEventTypeCommentConst,
+			Author:      comment.AddedBy,
+			FromSupport: comment.AddedBy != nil && !customers[userKey(comment.AddedBy)],
+			Summary:     "Comment",
+			Details:     core.StringNilMapper(comment.Value),
+		}
+		if event.FromSupport {
+			event.Summary = "Comment from IBM support"
+		}
+		if name := event.AuthorName(); name != "" {
+			event.Summary += " by " + name
+		}
+		timeline.Events = append(timeline.Events, event)
+	}
+
+	for _, attachment := range supportCase.Attachments {
+		attachedAt, parseErr := parseTime(attachment.CreatedAt, "an attachment")
+		if parseErr != nil {
+			err, timeline = parseErr, nil
+			return
+		}
+		timeline.Events = append(timeline.Events, CaseTimelineEvent{
+			Time:    attachedAt,
+			Type:    CaseTimelineEventTypeAttachmentConst,
+			Summary: "Attachment added: " + core.StringNilMapper(attachment.Filename),
+			Details: core.StringNilMapper(attachment.URL),
+		})
+	}
+
+	sort.SliceStable(timeline.Events, func(i, j int) bool {
+		return timeline.Events[i].Time.Before(timeline.Events[j].Time)
+	})
+	return
+}
+
+// GetCaseTimeline retrieves a case with the fields needed by BuildCaseTimeline and builds its timeline.
+func (caseManagement *CaseManagementV1) GetCaseTimeline(ctx context.Context, caseNumber string, statusHistory []CaseStatusChange) (timeline *CaseTimeline, err error) {
+	getCaseOptions := caseManagement.NewGetCaseOptions(caseNumber).SetFields([]string{
+		GetCaseOptionsFieldsNumberConst,
+		GetCaseOptionsFieldsShortDescriptionConst,
+		GetCaseOptionsFieldsDescriptionConst,
+		GetCaseOptionsFieldsCreatedAtConst,
+		GetCaseOptionsFieldsCreatedByConst,
+		GetCaseOptionsFieldsContactConst,
+		GetCaseOptionsFieldsWatchlistConst,
+		GetCaseOptionsFieldsCommentsConst,
+		GetCaseOptionsFieldsAttachmentsConst,
+	})
+	supportCase, _, err := caseManagement.GetCaseWithContext(ctx, getCaseOptions)
+	if err != nil {
+		return
+	}
+	return BuildCaseTimeline(supportCase, statusHistory)
+}
+
+// Export writes the timeline to "w" in the specified format (a CaseTimelineFormat*Const value). Times are written in
+// UTC.
+func (timeline *CaseTimeline) Export(w io.Writer, format string) (err error) {
+	switch format {
+	case CaseTimelineFormatMarkdownConst:
+		return timeline.writeMarkdown(w)
+	case CaseTimelineFormatIncidentIOConst:
+		return writeJSON(w, timeline.incidentIOItems())
+	case CaseTimelineFormatPagerDutyConst:
+		return writeJSON(w, timeline.pagerDutyLogEntries())
+	default:
+		return fmt.Errorf("unsupported case timeline format '%s'", format)
+	}
+}
+
+func (timeline *CaseTimeline) writeMarkdown(w io.Writer) (err error) {
+	title := "# Timeline of case " + timeline.CaseNumber
+	if timeline.ShortDescription != "" {
+		title += ": " + timeline.ShortDescription
+	}
+	if _, err = fmt.Fprintf(w, "%s\n\n", title); err != nil {
+		return
+	}
+	for _, event := range timeline.Events {
+		if _, err = fmt.Fprintf(w, "- **%s** %s\n", event.Time.UTC().Format("2006-01-02 15:04:05 UTC"), event.Summary); err != nil {
+			return
+		}
+		if event.Details == "" {
+			continue
+		}
+		// Each line of the details is quoted, so that Markdown in comments cannot break the list.
+		for _, line := range strings.Split(strings.TrimRight(event.Details, "\n"), "\n") {
+			if _, err = fmt.Fprintf(w, "  > %s\n", line); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+func (timeline *CaseTimeline) incidentIOItems() interface{} {
+	type item struct {
+		OccurredAt  string `json:"occurred_at"`
+		Title       string `json:"title"`
+		Description string `json:"description,omitempty"`
+		ItemType    string `json:"item_type"`
+		Author      string `json:"author,omitempty"`
+		Source      string `json:"source"`
+		ExternalID  string `json:"external_id"`
+	}
+	items := make([]item, 0, len(timeline.Events))
+	for i, event := range timeline.Events {
+		items = append(items, item{
+			OccurredAt:  event.Time.UTC().Format(time.RFC3339),
+			Title:       event.Summary,
+			Description: event.Details,
+			ItemType:    event.Type,
+			Author:      event.AuthorName(),
+			Source:      "ibm_cloud_support",
+			ExternalID:  fmt.Sprintf("%s-%d", timeline.CaseNumber, i+1),
+		})
+	}
+	return map[string]interface{}{"timeline_items": items}
+}
+
+func (timeline *CaseTimeline) pagerDutyLogEntries() interface{} {
+	type reference struct {
+		Type    string `json:"type"`
+		Summary string `json:"summary"`
+	}
+	type logEntry struct {
+		Type      string     `json:"type"`
+		CreatedAt string     `json:"created_at"`
+		Summary   string     `json:"summary"`
+		Agent     *reference `json:"agent,omitempty"`
+		Channel   struct {
+			Type    string `json:"type"`
+			Summary string `json:"summary,omitempty"`
+		} `json:"channel"`
+	}
+	entries := make([]logEntry, 0, len(timeline.Events))
+	for _, event := range timeline.Events {
+		entry := logEntry{
+			Type:      "annotate_log_entry",
+			CreatedAt: event.Time.UTC().Format(time.RFC3339),
+			Summary:   event.Summary,
+		}
+		if name := event.AuthorName(); name != "" {
+			entry.Agent = &reference{Type: "user_reference", Summary: name}
+		}
+		entry.Channel.Type = "note"
+		entry.Channel.Summary = event.Details
+		entries = append(entries, entry)
+	}
+	return map[string]interface{}{"log_entries": entries}
+}
+
+func writeJSON(w io.Writer, value interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CaseManagementV1 case timeline export`, func() {
+	var testServer *httptest.Server
+	var caseManagementService *casemanagementv1.CaseManagementV1
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/cases/TS0001":
+				Expect(req.URL.Query().Get("fields")).To(ContainSubstring("attachments"))
+				fmt.Fprint(res, `{"number": "TS0001", "short_description": "Database down", "description": "It is down",
+					"created_at": "2022-05-02T08:00:00Z",
+					"created_by": {"name": "Ann", "realm": "IBMid", "user_id": "ann@example.com"},
+					"comments": [
+						{"value": "restarted\nall good", "added_at": "2022-05-02T09:30:00Z", "added_by": {"name": "Agent", "realm": "IBMid", "user_id": "agent@ibm.com"}},
+						{"value": "more details", "added_at": "2022-05-02T08:10:00Z", "added_by": {"realm": "IBMid", "user_id": "ann@example.com"}}],
+					"attachments": [{"id": "a1", "filename": "logs.txt", "created_at": "2022-05-02T08:15:00Z", "url": "https://example.com/logs.txt"}]}`)
+			case req.Method == "GET" && req.URL.EscapedPath() == "/cases/TS0002":
+				fmt.Fprint(res, `{"number": "TS0002", "created_at": "yesterday"}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		caseManagementService, serviceErr = casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	statusHistory := []casemanagementv1.CaseStatusChange{
+		{Status: "In Progress", ChangedAt: time.Date(2022, 5, 2, 9, 0, 0, 0, time.UTC)},
+	}
+
+	It(`Builds the timeline of a case in chronological order`, func() {
+		timeline, err := caseManagementService.GetCaseTimeline(context.Background(), "TS0001", statusHistory)
+		Expect(err).To(BeNil())
+		var summaries []string
+		for _, event := range timeline.Events {
+			summaries = append(summaries, event.Summary)
+		}
+		Expect(summaries).To(Equal([]string{
+			"Case created by Ann",
+			"Comment by ann@example.com",
+			"Attachment added: logs.txt",
+			"Status changed to In Progress",
+			"Comment from IBM support by Agent",
+		}))
+		Expect(timeline.Events[4].FromSupport).To(BeTrue())
+		Expect(timeline.Events[4].Type).To(Equal(casemanagementv1.CaseTimelineEventTypeCommentConst))
+	})
+	It(`Exports the timeline in postmortem formats`, func() {
+		timeline, err := caseManagementService.GetCaseTimeline(context.Background(), "TS0001", statusHistory)
+		Expect(err).To(BeNil())
+
+		var markdown bytes.Buffer
+		Expect(timeline.Export(&markdown, casemanagementv1.CaseTimelineFormatMarkdownConst)).To(Succeed())
+		Expect(markdown.String()).To(HavePrefix("# Timeline of case TS0001: Database down\n\n- **2022-05-02 08:00:00 UTC** Case created by Ann\n  > It is down\n"))
+		Expect(markdown.String()).To(ContainSubstring("  > restarted\n  > all good\n"))
+
+		var incidentIO bytes.Buffer
+		Expect(timeline.Export(&incidentIO, casemanagementv1.CaseTimelineFormatIncidentIOConst)).To(Succeed())
+		var items struct {
+			TimelineItems []map[string]string `json:"timeline_items"`
+		}
+		Expect(json.Unmarshal(incidentIO.Bytes(), &items)).To(Succeed())
+		Expect(items.TimelineItems).To(HaveLen(5))
+		Expect(items.TimelineItems[2]["occurred_at"]).To(Equal("2022-05-02T08:15:00Z"))
+		Expect(items.TimelineItems[2]["description"]).To(Equal("https://example.com/logs.txt"))
+
+		var pagerDuty bytes.Buffer
+		Expect(timeline.Export(&pagerDuty, casemanagementv1.CaseTimelineFormatPagerDutyConst)).To(Succeed())
+		var entries struct {
+			LogEntries []struct {
+				Summary string            `json:"summary"`
+				Agent   map[string]string `json:"agent"`
+			} `json:"log_entries"`
+		}
+		Expect(json.Unmarshal(pagerDuty.Bytes(), &entries)).To(Succeed())
+		Expect(entries.LogEntries).To(HaveLen(5))
+		Expect(entries.LogEntries[4].Agent["summary"]).To(Equal("Agent"))
+		Expect(entries.LogEntries[3].Agent).To(BeNil())
+
+		Expect(timeline.Export(&pagerDuty, "csv")).ToNot(Succeed())
+	})
+	It(`Fails on an invalid time`, func() {
+		_, err := caseManagementService.GetCaseTimeline(context.Background(), "TS0002", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("error parsing the time of the creation of case 'TS0002'"))
+	})
+})
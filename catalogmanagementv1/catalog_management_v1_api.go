@@ -0,0 +1,1657 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package catalogmanagementv1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// CatalogManagementV1API : The operations of the CatalogManagementV1 service, implemented by *CatalogManagementV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type CatalogManagementV1API interface {
+	// Clone makes a copy of "catalogManagement" suitable for processing requests.
+	Clone() *CatalogManagementV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// GetCatalogAccount : Get catalog account settings
+	GetCatalogAccount(getCatalogAccountOptions *GetCatalogAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+
+	// GetCatalogAccountWithContext is an alternate form of the GetCatalogAccount method which supports a Context
+	// parameter
+	GetCatalogAccountWithContext(ctx context.Context, getCatalogAccountOptions *GetCatalogAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+
+	// UpdateCatalogAccount : Update account settings
+	UpdateCatalogAccount(updateCatalogAccountOptions *UpdateCatalogAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+
+	// UpdateCatalogAccountWithContext is an alternate form of the UpdateCatalogAccount method which supports a Context
+	// parameter
+	UpdateCatalogAccountWithContext(ctx context.Context, updateCatalogAccountOptions *UpdateCatalogAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+
+	// ListCatalogAccountAudits : Get catalog account audit logs
+	ListCatalogAccountAudits(listCatalogAccountAuditsOptions *ListCatalogAccountAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// ListCatalogAccountAuditsWithContext is an alternate form of the ListCatalogAccountAudits method which supports a
+	// Context parameter
+	ListCatalogAccountAuditsWithContext(ctx context.Context, listCatalogAccountAuditsOptions *ListCatalogAccountAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetCatalogAccountAudit : Get a catalog account audit log entry
+	GetCatalogAccountAudit(getCatalogAccountAuditOptions *GetCatalogAccountAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// GetCatalogAccountAuditWithContext is an alternate form of the GetCatalogAccountAudit method which supports a
+	// Context parameter
+	GetCatalogAccountAuditWithContext(ctx context.Context, getCatalogAccountAuditOptions *GetCatalogAccountAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// GetCatalogAccountFilters : Get catalog account filters
+	GetCatalogAccountFilters(getCatalogAccountFiltersOptions *GetCatalogAccountFiltersOptions) (result *AccumulatedFilters, response *core.DetailedResponse, err error)
+
+	// GetCatalogAccountFiltersWithContext is an alternate form of the GetCatalogAccountFilters method which supports a
+	// Context parameter
+	GetCatalogAccountFiltersWithContext(ctx context.Context, getCatalogAccountFiltersOptions *GetCatalogAccountFiltersOptions) (result *AccumulatedFilters, response *core.DetailedResponse, err error)
+
+	// ListCatalogs : Get list of catalogs
+	ListCatalogs(listCatalogsOptions *ListCatalogsOptions) (result *CatalogSearchResult, response *core.DetailedResponse, err error)
+
+	// ListCatalogsWithContext is an alternate form of the ListCatalogs method which supports a Context parameter
+	ListCatalogsWithContext(ctx context.Context, listCatalogsOptions *ListCatalogsOptions) (result *CatalogSearchResult, response *core.DetailedResponse, err error)
+
+	// CreateCatalog : Create a catalog
+	CreateCatalog(createCatalogOptions *CreateCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// CreateCatalogWithContext is an alternate form of the CreateCatalog method which supports a Context parameter
+	CreateCatalogWithContext(ctx context.Context, createCatalogOptions *CreateCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// GetCatalog : Get catalog
+	GetCatalog(getCatalogOptions *GetCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// GetCatalogWithContext is an alternate form of the GetCatalog method which supports a Context parameter
+	GetCatalogWithContext(ctx context.Context, getCatalogOptions *GetCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// ReplaceCatalog : Update catalog
+	ReplaceCatalog(replaceCatalogOptions *ReplaceCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// ReplaceCatalogWithContext is an alternate form of the ReplaceCatalog method which supports a Context parameter
+	ReplaceCatalogWithContext(ctx context.Context, replaceCatalogOptions *ReplaceCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// DeleteCatalog : Delete catalog
+	DeleteCatalog(deleteCatalogOptions *DeleteCatalogOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteCatalogWithContext is an alternate form of the DeleteCatalog method which supports a Context parameter
+	DeleteCatalogWithContext(ctx context.Context, deleteCatalogOptions *DeleteCatalogOptions) (response *core.DetailedResponse, err error)
+
+	// ListCatalogAudits : Get catalog audit logs
+	ListCatalogAudits(listCatalogAuditsOptions *ListCatalogAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// ListCatalogAuditsWithContext is an alternate form of the ListCatalogAudits method which supports a Context
+	// parameter
+	ListCatalogAuditsWithContext(ctx context.Context, listCatalogAuditsOptions *ListCatalogAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetCatalogAudit : Get a catalog audit log entry
+	GetCatalogAudit(getCatalogAuditOptions *GetCatalogAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// GetCatalogAuditWithContext is an alternate form of the GetCatalogAudit method which supports a Context parameter
+	GetCatalogAuditWithContext(ctx context.Context, getCatalogAuditOptions *GetCatalogAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// ListEnterpriseAudits : Get enterprise audit logs
+	ListEnterpriseAudits(listEnterpriseAuditsOptions *ListEnterpriseAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// ListEnterpriseAuditsWithContext is an alternate form of the ListEnterpriseAudits method which supports a Context
+	// parameter
+	ListEnterpriseAuditsWithContext(ctx context.Context, listEnterpriseAuditsOptions *ListEnterpriseAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetEnterpriseAudit : Get an enterprise audit log entry
+	GetEnterpriseAudit(getEnterpriseAuditOptions *GetEnterpriseAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// GetEnterpriseAuditWithContext is an alternate form of the GetEnterpriseAudit method which supports a Context
+	// parameter
+	GetEnterpriseAuditWithContext(ctx context.Context, getEnterpriseAuditOptions *GetEnterpriseAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// GetConsumptionOfferings : Get consumption offerings
+	GetConsumptionOfferings(getConsumptionOfferingsOptions *GetConsumptionOfferingsOptions) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+
+	// GetConsumptionOfferingsWithContext is an alternate form of the GetConsumptionOfferings method which supports a
+	// Context parameter
+	GetConsumptionOfferingsWithContext(ctx context.Context, getConsumptionOfferingsOptions *GetConsumptionOfferingsOptions) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+
+	// ListOfferings : Get list of offerings
+	ListOfferings(listOfferingsOptions *ListOfferingsOptions) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+
+	// ListOfferingsWithContext is an alternate form of the ListOfferings method which supports a Context parameter
+	ListOfferingsWithContext(ctx context.Context, listOfferingsOptions *ListOfferingsOptions) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+
+	// CreateOffering : Create offering
+	CreateOffering(createOfferingOptions *CreateOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// CreateOfferingWithContext is an alternate form of the CreateOffering method which supports a Context parameter
+	CreateOfferingWithContext(ctx context.Context, createOfferingOptions *CreateOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ImportOfferingVersion : Import offering version
+	ImportOfferingVersion(importOfferingVersionOptions *ImportOfferingVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ImportOfferingVersionWithContext is an alternate form of the ImportOfferingVersion method which supports a
+	// Context parameter
+	ImportOfferingVersionWithContext(ctx context.Context, importOfferingVersionOptions *ImportOfferingVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ImportOffering : Import offering
+	ImportOffering(importOfferingOptions *ImportOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ImportOfferingWithContext is an alternate form of the ImportOffering method which supports a Context parameter
+	ImportOfferingWithContext(ctx context.Context, importOfferingOptions *ImportOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ReloadOffering : Reload offering
+	ReloadOffering(reloadOfferingOptions *ReloadOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ReloadOfferingWithContext is an alternate form of the ReloadOffering method which supports a Context parameter
+	ReloadOfferingWithContext(ctx context.Context, reloadOfferingOptions *ReloadOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// GetOffering : Get offering
+	GetOffering(getOfferingOptions *GetOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// GetOfferingWithContext is an alternate form of the GetOffering method which supports a Context parameter
+	GetOfferingWithContext(ctx context.Context, getOfferingOptions *GetOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ReplaceOffering : Update offering
+	ReplaceOffering(replaceOfferingOptions *ReplaceOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ReplaceOfferingWithContext is an alternate form of the ReplaceOffering method which supports a Context parameter
+	ReplaceOfferingWithContext(ctx context.Context, replaceOfferingOptions *ReplaceOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// UpdateOffering : Update offering
+	UpdateOffering(updateOfferingOptions *UpdateOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// UpdateOfferingWithContext is an alternate form of the UpdateOffering method which supports a Context parameter
+	UpdateOfferingWithContext(ctx context.Context, updateOfferingOptions *UpdateOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// DeleteOffering : Delete offering
+	DeleteOffering(deleteOfferingOptions *DeleteOfferingOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteOfferingWithContext is an alternate form of the DeleteOffering method which supports a Context parameter
+	DeleteOfferingWithContext(ctx context.Context, deleteOfferingOptions *DeleteOfferingOptions) (response *core.DetailedResponse, err error)
+
+	// ListOfferingAudits : Get offering audit logs
+	ListOfferingAudits(listOfferingAuditsOptions *ListOfferingAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// ListOfferingAuditsWithContext is an alternate form of the ListOfferingAudits method which supports a Context
+	// parameter
+	ListOfferingAuditsWithContext(ctx context.Context, listOfferingAuditsOptions *ListOfferingAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetOfferingAudit : Get an offering audit log entry
+	GetOfferingAudit(getOfferingAuditOptions *GetOfferingAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// GetOfferingAuditWithContext is an alternate form of the GetOfferingAudit method which supports a Context
+	// parameter
+	GetOfferingAuditWithContext(ctx context.Context, getOfferingAuditOptions *GetOfferingAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// SetOfferingPublish : Set offering publish approval settings
+	SetOfferingPublish(setOfferingPublishOptions *SetOfferingPublishOptions) (result *ApprovalResult, response *core.DetailedResponse, err error)
+
+	// SetOfferingPublishWithContext is an alternate form of the SetOfferingPublish method which supports a Context
+	// parameter
+	SetOfferingPublishWithContext(ctx context.Context, setOfferingPublishOptions *SetOfferingPublishOptions) (result *ApprovalResult, response *core.DetailedResponse, err error)
+
+	// DeprecateOffering : Allows offering to be deprecated
+	DeprecateOffering(deprecateOfferingOptions *DeprecateOfferingOptions) (response *core.DetailedResponse, err error)
+
+	// DeprecateOfferingWithContext is an alternate form of the DeprecateOffering method which supports a Context
+	// parameter
+	DeprecateOfferingWithContext(ctx context.Context, deprecateOfferingOptions *DeprecateOfferingOptions) (response *core.DetailedResponse, err error)
+
+	// ShareOffering : Allows offering to be shared
+	ShareOffering(shareOfferingOptions *ShareOfferingOptions) (result *ShareSetting, response *core.DetailedResponse, err error)
+
+	// ShareOfferingWithContext is an alternate form of the ShareOffering method which supports a Context parameter
+	ShareOfferingWithContext(ctx context.Context, shareOfferingOptions *ShareOfferingOptions) (result *ShareSetting, response *core.DetailedResponse, err error)
+
+	// GetOfferingAccess : Check for account ID in offering access list
+	GetOfferingAccess(getOfferingAccessOptions *GetOfferingAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+
+	// GetOfferingAccessWithContext is an alternate form of the GetOfferingAccess method which supports a Context
+	// parameter
+	GetOfferingAccessWithContext(ctx context.Context, getOfferingAccessOptions *GetOfferingAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+
+	// GetOfferingAccessList : Get offering access list
+	GetOfferingAccessList(getOfferingAccessListOptions *GetOfferingAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// GetOfferingAccessListWithContext is an alternate form of the GetOfferingAccessList method which supports a
+	// Context parameter
+	GetOfferingAccessListWithContext(ctx context.Context, getOfferingAccessListOptions *GetOfferingAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// DeleteOfferingAccessList : Delete accesses from offering access list
+	DeleteOfferingAccessList(deleteOfferingAccessListOptions *DeleteOfferingAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// DeleteOfferingAccessListWithContext is an alternate form of the DeleteOfferingAccessList method which supports a
+	// Context parameter
+	DeleteOfferingAccessListWithContext(ctx context.Context, deleteOfferingAccessListOptions *DeleteOfferingAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// AddOfferingAccessList : Add accesses to offering access list
+	AddOfferingAccessList(addOfferingAccessListOptions *AddOfferingAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// AddOfferingAccessListWithContext is an alternate form of the AddOfferingAccessList method which supports a
+	// Context parameter
+	AddOfferingAccessListWithContext(ctx context.Context, addOfferingAccessListOptions *AddOfferingAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// GetOfferingUpdates : Get version updates
+	GetOfferingUpdates(getOfferingUpdatesOptions *GetOfferingUpdatesOptions) (result []VersionUpdateDescriptor, response *core.DetailedResponse, err error)
+
+	// GetOfferingUpdatesWithContext is an alternate form of the GetOfferingUpdates method which supports a Context
+	// parameter
+	GetOfferingUpdatesWithContext(ctx context.Context, getOfferingUpdatesOptions *GetOfferingUpdatesOptions) (result []VersionUpdateDescriptor, response *core.DetailedResponse, err error)
+
+	// GetOfferingSource : Get offering source
+	GetOfferingSource(getOfferingSourceOptions *GetOfferingSourceOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// GetOfferingSourceWithContext is an alternate form of the GetOfferingSource method which supports a Context
+	// parameter
+	GetOfferingSourceWithContext(ctx context.Context, getOfferingSourceOptions *GetOfferingSourceOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// GetOfferingSourceURL : Get offering source URL
+	GetOfferingSourceURL(getOfferingSourceURLOptions *GetOfferingSourceURLOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// GetOfferingSourceURLWithContext is an alternate form of the GetOfferingSourceURL method which supports a Context
+	// parameter
+	GetOfferingSourceURLWithContext(ctx context.Context, getOfferingSourceURLOptions *GetOfferingSourceURLOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// GetOfferingAbout : Get version about information
+	GetOfferingAbout(getOfferingAboutOptions *GetOfferingAboutOptions) (result *string, response *core.DetailedResponse, err error)
+
+	// GetOfferingAboutWithContext is an alternate form of the GetOfferingAbout method which supports a Context
+	// parameter
+	GetOfferingAboutWithContext(ctx context.Context, getOfferingAboutOptions *GetOfferingAboutOptions) (result *string, response *core.DetailedResponse, err error)
+
+	// GetOfferingLicense : Get version license content
+	GetOfferingLicense(getOfferingLicenseOptions *GetOfferingLicenseOptions) (result *string, response *core.DetailedResponse, err error)
+
+	// GetOfferingLicenseWithContext is an alternate form of the GetOfferingLicense method which supports a Context
+	// parameter
+	GetOfferingLicenseWithContext(ctx context.Context, getOfferingLicenseOptions *GetOfferingLicenseOptions) (result *string, response *core.DetailedResponse, err error)
+
+	// GetOfferingContainerImages : Get version's container images
+	GetOfferingContainerImages(getOfferingContainerImagesOptions *GetOfferingContainerImagesOptions) (result *ImageManifest, response *core.DetailedResponse, err error)
+
+	// GetOfferingContainerImagesWithContext is an alternate form of the GetOfferingContainerImages method which
+	// supports a Context parameter
+	GetOfferingContainerImagesWithContext(ctx context.Context, getOfferingContainerImagesOptions *GetOfferingContainerImagesOptions) (result *ImageManifest, response *core.DetailedResponse, err error)
+
+	// ArchiveVersion : Archive version immediately
+	ArchiveVersion(archiveVersionOptions *ArchiveVersionOptions) (response *core.DetailedResponse, err error)
+
+	// ArchiveVersionWithContext is an alternate form of the ArchiveVersion method which supports a Context parameter
+	ArchiveVersionWithContext(ctx context.Context, archiveVersionOptions *ArchiveVersionOptions) (response *core.DetailedResponse, err error)
+
+	// SetDeprecateVersion : Sets version to be deprecated in a certain time period
+	SetDeprecateVersion(setDeprecateVersionOptions *SetDeprecateVersionOptions) (response *core.DetailedResponse, err error)
+
+	// SetDeprecateVersionWithContext is an alternate form of the SetDeprecateVersion method which supports a Context
+	// parameter
+	SetDeprecateVersionWithContext(ctx context.Context, setDeprecateVersionOptions *SetDeprecateVersionOptions) (response *core.DetailedResponse, err error)
+
+	// ConsumableVersion : Make version consumable for sharing
+	ConsumableVersion(consumableVersionOptions *ConsumableVersionOptions) (response *core.DetailedResponse, err error)
+
+	// ConsumableVersionWithContext is an alternate form of the ConsumableVersion method which supports a Context
+	// parameter
+	ConsumableVersionWithContext(ctx context.Context, consumableVersionOptions *ConsumableVersionOptions) (response *core.DetailedResponse, err error)
+
+	// SuspendVersion : Suspend a version
+	SuspendVersion(suspendVersionOptions *SuspendVersionOptions) (response *core.DetailedResponse, err error)
+
+	// SuspendVersionWithContext is an alternate form of the SuspendVersion method which supports a Context parameter
+	SuspendVersionWithContext(ctx context.Context, suspendVersionOptions *SuspendVersionOptions) (response *core.DetailedResponse, err error)
+
+	// CommitVersion : Commit version
+	CommitVersion(commitVersionOptions *CommitVersionOptions) (response *core.DetailedResponse, err error)
+
+	// CommitVersionWithContext is an alternate form of the CommitVersion method which supports a Context parameter
+	CommitVersionWithContext(ctx context.Context, commitVersionOptions *CommitVersionOptions) (response *core.DetailedResponse, err error)
+
+	// CopyVersion : Copy version to new target kind
+	CopyVersion(copyVersionOptions *CopyVersionOptions) (response *core.DetailedResponse, err error)
+
+	// CopyVersionWithContext is an alternate form of the CopyVersion method which supports a Context parameter
+	CopyVersionWithContext(ctx context.Context, copyVersionOptions *CopyVersionOptions) (response *core.DetailedResponse, err error)
+
+	// GetOfferingWorkingCopy : Create working copy of version
+	GetOfferingWorkingCopy(getOfferingWorkingCopyOptions *GetOfferingWorkingCopyOptions) (result *Version, response *core.DetailedResponse, err error)
+
+	// GetOfferingWorkingCopyWithContext is an alternate form of the GetOfferingWorkingCopy method which supports a
+	// Context parameter
+	GetOfferingWorkingCopyWithContext(ctx context.Context, getOfferingWorkingCopyOptions *GetOfferingWorkingCopyOptions) (result *Version, response *core.DetailedResponse, err error)
+
+	// CopyFromPreviousVersion : Copy values from a previous version
+	CopyFromPreviousVersion(copyFromPreviousVersionOptions *CopyFromPreviousVersionOptions) (response *core.DetailedResponse, err error)
+
+	// CopyFromPreviousVersionWithContext is an alternate form of the CopyFromPreviousVersion method which supports a
+	// Context parameter
+	CopyFromPreviousVersionWithContext(ctx context.Context, copyFromPreviousVersionOptions *CopyFromPreviousVersionOptions) (response *core.DetailedResponse, err error)
+
+	// GetVersion : Get offering/kind/version 'branch'
+	GetVersion(getVersionOptions *GetVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// GetVersionWithContext is an alternate form of the GetVersion method which supports a Context parameter
+	GetVersionWithContext(ctx context.Context, getVersionOptions *GetVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// DeleteVersion : Delete version
+	DeleteVersion(deleteVersionOptions *DeleteVersionOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteVersionWithContext is an alternate form of the DeleteVersion method which supports a Context parameter
+	DeleteVersionWithContext(ctx context.Context, deleteVersionOptions *DeleteVersionOptions) (response *core.DetailedResponse, err error)
+
+	// DeprecateVersion : Deprecate version immediately - use /archive instead
+	DeprecateVersion(deprecateVersionOptions *DeprecateVersionOptions) (response *core.DetailedResponse, err error)
+
+	// DeprecateVersionWithContext is an alternate form of the DeprecateVersion method which supports a Context
+	// parameter
+	DeprecateVersionWithContext(ctx context.Context, deprecateVersionOptions *DeprecateVersionOptions) (response *core.DetailedResponse, err error)
+
+	// AccountPublishVersion : Publish version to account members
+	AccountPublishVersion(accountPublishVersionOptions *AccountPublishVersionOptions) (response *core.DetailedResponse, err error)
+
+	// AccountPublishVersionWithContext is an alternate form of the AccountPublishVersion method which supports a
+	// Context parameter
+	AccountPublishVersionWithContext(ctx context.Context, accountPublishVersionOptions *AccountPublishVersionOptions) (response *core.DetailedResponse, err error)
+
+	// IBMPublishVersion : Publish version to IBMers in public catalog
+	IBMPublishVersion(ibmPublishVersionOptions *IBMPublishVersionOptions) (response *core.DetailedResponse, err error)
+
+	// IBMPublishVersionWithContext is an alternate form of the IBMPublishVersion method which supports a Context
+	// parameter
+	IBMPublishVersionWithContext(ctx context.Context, ibmPublishVersionOptions *IBMPublishVersionOptions) (response *core.DetailedResponse, err error)
+
+	// PublicPublishVersion : Publish version to all users in public catalog
+	PublicPublishVersion(publicPublishVersionOptions *PublicPublishVersionOptions) (response *core.DetailedResponse, err error)
+
+	// PublicPublishVersionWithContext is an alternate form of the PublicPublishVersion method which supports a Context
+	// parameter
+	PublicPublishVersionWithContext(ctx context.Context, publicPublishVersionOptions *PublicPublishVersionOptions) (response *core.DetailedResponse, err error)
+
+	// GetCluster : Get kubernetes cluster
+	GetCluster(getClusterOptions *GetClusterOptions) (result *ClusterInfo, response *core.DetailedResponse, err error)
+
+	// GetClusterWithContext is an alternate form of the GetCluster method which supports a Context parameter
+	GetClusterWithContext(ctx context.Context, getClusterOptions *GetClusterOptions) (result *ClusterInfo, response *core.DetailedResponse, err error)
+
+	// GetNamespaces : Get cluster namespaces
+	GetNamespaces(getNamespacesOptions *GetNamespacesOptions) (result *NamespaceSearchResult, response *core.DetailedResponse, err error)
+
+	// GetNamespacesWithContext is an alternate form of the GetNamespaces method which supports a Context parameter
+	GetNamespacesWithContext(ctx context.Context, getNamespacesOptions *GetNamespacesOptions) (result *NamespaceSearchResult, response *core.DetailedResponse, err error)
+
+	// DeployOperators : Deploy operators
+	DeployOperators(deployOperatorsOptions *DeployOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// DeployOperatorsWithContext is an alternate form of the DeployOperators method which supports a Context parameter
+	DeployOperatorsWithContext(ctx context.Context, deployOperatorsOptions *DeployOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// ListOperators : List operators
+	ListOperators(listOperatorsOptions *ListOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// ListOperatorsWithContext is an alternate form of the ListOperators method which supports a Context parameter
+	ListOperatorsWithContext(ctx context.Context, listOperatorsOptions *ListOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// ReplaceOperators : Update operators
+	ReplaceOperators(replaceOperatorsOptions *ReplaceOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// ReplaceOperatorsWithContext is an alternate form of the ReplaceOperators method which supports a Context
+	// parameter
+	ReplaceOperatorsWithContext(ctx context.Context, replaceOperatorsOptions *ReplaceOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// DeleteOperators : Delete operators
+	DeleteOperators(deleteOperatorsOptions *DeleteOperatorsOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteOperatorsWithContext is an alternate form of the DeleteOperators method which supports a Context parameter
+	DeleteOperatorsWithContext(ctx context.Context, deleteOperatorsOptions *DeleteOperatorsOptions) (response *core.DetailedResponse, err error)
+
+	// InstallVersion : Install version
+	InstallVersion(installVersionOptions *InstallVersionOptions) (response *core.DetailedResponse, err error)
+
+	// InstallVersionWithContext is an alternate form of the InstallVersion method which supports a Context parameter
+	InstallVersionWithContext(ctx context.Context, installVersionOptions *InstallVersionOptions) (response *core.DetailedResponse, err error)
+
+	// PreinstallVersion : Pre-install version
+	PreinstallVersion(preinstallVersionOptions *PreinstallVersionOptions) (response *core.DetailedResponse, err error)
+
+	// PreinstallVersionWithContext is an alternate form of the PreinstallVersion method which supports a Context
+	// parameter
+	PreinstallVersionWithContext(ctx context.Context, preinstallVersionOptions *PreinstallVersionOptions) (response *core.DetailedResponse, err error)
+
+	// GetPreinstall : Get version pre-install status
+	GetPreinstall(getPreinstallOptions *GetPreinstallOptions) (result *InstallStatus, response *core.DetailedResponse, err error)
+
+	// GetPreinstallWithContext is an alternate form of the GetPreinstall method which supports a Context parameter
+	GetPreinstallWithContext(ctx context.Context, getPreinstallOptions *GetPreinstallOptions) (result *InstallStatus, response *core.DetailedResponse, err error)
+
+	// ValidateInstall : Validate offering
+	ValidateInstall(validateInstallOptions *ValidateInstallOptions) (response *core.DetailedResponse, err error)
+
+	// ValidateInstallWithContext is an alternate form of the ValidateInstall method which supports a Context parameter
+	ValidateInstallWithContext(ctx context.Context, validateInstallOptions *ValidateInstallOptions) (response *core.DetailedResponse, err error)
+
+	// GetValidationStatus : Get offering install status
+	GetValidationStatus(getValidationStatusOptions *GetValidationStatusOptions) (result *Validation, response *core.DetailedResponse, err error)
+
+	// GetValidationStatusWithContext is an alternate form of the GetValidationStatus method which supports a Context
+	// parameter
+	GetValidationStatusWithContext(ctx context.Context, getValidationStatusOptions *GetValidationStatusOptions) (result *Validation, response *core.DetailedResponse, err error)
+
+	// GetOverrideValues : Get override values
+	GetOverrideValues(getOverrideValuesOptions *GetOverrideValuesOptions) (result map[string]interface{}, response *core.DetailedResponse, err error)
+
+	// GetOverrideValuesWithContext is an alternate form of the GetOverrideValues method which supports a Context
+	// parameter
+	GetOverrideValuesWithContext(ctx context.Context, getOverrideValuesOptions *GetOverrideValuesOptions) (result map[string]interface{}, response *core.DetailedResponse, err error)
+
+	// SearchObjects : List objects across catalogs
+	SearchObjects(searchObjectsOptions *SearchObjectsOptions) (result *ObjectSearchResult, response *core.DetailedResponse, err error)
+
+	// SearchObjectsWithContext is an alternate form of the SearchObjects method which supports a Context parameter
+	SearchObjectsWithContext(ctx context.Context, searchObjectsOptions *SearchObjectsOptions) (result *ObjectSearchResult, response *core.DetailedResponse, err error)
+
+	// ListObjects : List objects within a catalog
+	ListObjects(listObjectsOptions *ListObjectsOptions) (result *ObjectListResult, response *core.DetailedResponse, err error)
+
+	// ListObjectsWithContext is an alternate form of the ListObjects method which supports a Context parameter
+	ListObjectsWithContext(ctx context.Context, listObjectsOptions *ListObjectsOptions) (result *ObjectListResult, response *core.DetailedResponse, err error)
+
+	// CreateObject : Create catalog object
+	CreateObject(createObjectOptions *CreateObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// CreateObjectWithContext is an alternate form of the CreateObject method which supports a Context parameter
+	CreateObjectWithContext(ctx context.Context, createObjectOptions *CreateObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// GetObject : Get catalog object
+	GetObject(getObjectOptions *GetObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// GetObjectWithContext is an alternate form of the GetObject method which supports a Context parameter
+	GetObjectWithContext(ctx context.Context, getObjectOptions *GetObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// ReplaceObject : Update catalog object
+	ReplaceObject(replaceObjectOptions *ReplaceObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// ReplaceObjectWithContext is an alternate form of the ReplaceObject method which supports a Context parameter
+	ReplaceObjectWithContext(ctx context.Context, replaceObjectOptions *ReplaceObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// DeleteObject : Delete catalog object
+	DeleteObject(deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteObjectWithContext is an alternate form of the DeleteObject method which supports a Context parameter
+	DeleteObjectWithContext(ctx context.Context, deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error)
+
+	// ListObjectAudits : Get object audit logs
+	ListObjectAudits(listObjectAuditsOptions *ListObjectAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// ListObjectAuditsWithContext is an alternate form of the ListObjectAudits method which supports a Context
+	// parameter
+	ListObjectAuditsWithContext(ctx context.Context, listObjectAuditsOptions *ListObjectAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetObjectAudit : Get an object audit log entry
+	GetObjectAudit(getObjectAuditOptions *GetObjectAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// GetObjectAuditWithContext is an alternate form of the GetObjectAudit method which supports a Context parameter
+	GetObjectAuditWithContext(ctx context.Context, getObjectAuditOptions *GetObjectAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// ConsumableShareObject : Make object consumable for sharing
+	ConsumableShareObject(consumableShareObjectOptions *ConsumableShareObjectOptions) (response *core.DetailedResponse, err error)
+
+	// ConsumableShareObjectWithContext is an alternate form of the ConsumableShareObject method which supports a
+	// Context parameter
+	ConsumableShareObjectWithContext(ctx context.Context, consumableShareObjectOptions *ConsumableShareObjectOptions) (response *core.DetailedResponse, err error)
+
+	// ShareObject : Allows object to be shared
+	ShareObject(shareObjectOptions *ShareObjectOptions) (result *ShareSetting, response *core.DetailedResponse, err error)
+
+	// ShareObjectWithContext is an alternate form of the ShareObject method which supports a Context parameter
+	ShareObjectWithContext(ctx context.Context, shareObjectOptions *ShareObjectOptions) (result *ShareSetting, response *core.DetailedResponse, err error)
+
+	// GetObjectAccessList : Get object access list
+	GetObjectAccessList(getObjectAccessListOptions *GetObjectAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// GetObjectAccessListWithContext is an alternate form of the GetObjectAccessList method which supports a Context
+	// parameter
+	GetObjectAccessListWithContext(ctx context.Context, getObjectAccessListOptions *GetObjectAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// GetObjectAccess : Check for account ID in object access list
+	GetObjectAccess(getObjectAccessOptions *GetObjectAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+
+	// GetObjectAccessWithContext is an alternate form of the GetObjectAccess method which supports a Context parameter
+	GetObjectAccessWithContext(ctx context.Context, getObjectAccessOptions *GetObjectAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+
+	// CreateObjectAccess : Add account ID to object access list
+	CreateObjectAccess(createObjectAccessOptions *CreateObjectAccessOptions) (response *core.DetailedResponse, err error)
+
+	// CreateObjectAccessWithContext is an alternate form of the CreateObjectAccess method which supports a Context
+	// parameter
+	CreateObjectAccessWithContext(ctx context.Context, createObjectAccessOptions *CreateObjectAccessOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteObjectAccess : Remove account ID from object access list
+	DeleteObjectAccess(deleteObjectAccessOptions *DeleteObjectAccessOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteObjectAccessWithContext is an alternate form of the DeleteObjectAccess method which supports a Context
+	// parameter
+	DeleteObjectAccessWithContext(ctx context.Context, deleteObjectAccessOptions *DeleteObjectAccessOptions) (response *core.DetailedResponse, err error)
+
+	// GetObjectAccessListDeprecated : Get object access list
+	GetObjectAccessListDeprecated(getObjectAccessListDeprecatedOptions *GetObjectAccessListDeprecatedOptions) (result *ObjectAccessListResult, response *core.DetailedResponse, err error)
+
+	// GetObjectAccessListDeprecatedWithContext is an alternate form of the GetObjectAccessListDeprecated method which
+	// supports a Context parameter
+	GetObjectAccessListDeprecatedWithContext(ctx context.Context, getObjectAccessListDeprecatedOptions *GetObjectAccessListDeprecatedOptions) (result *ObjectAccessListResult, response *core.DetailedResponse, err error)
+
+	// DeleteObjectAccessList : Delete accesses from object access list
+	DeleteObjectAccessList(deleteObjectAccessListOptions *DeleteObjectAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// DeleteObjectAccessListWithContext is an alternate form of the DeleteObjectAccessList method which supports a
+	// Context parameter
+	DeleteObjectAccessListWithContext(ctx context.Context, deleteObjectAccessListOptions *DeleteObjectAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// AddObjectAccessList : Add accesses to object access list
+	AddObjectAccessList(addObjectAccessListOptions *AddObjectAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// AddObjectAccessListWithContext is an alternate form of the AddObjectAccessList method which supports a Context
+	// parameter
+	AddObjectAccessListWithContext(ctx context.Context, addObjectAccessListOptions *AddObjectAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// AccountPublishObject : Publish object to account
+	AccountPublishObject(accountPublishObjectOptions *AccountPublishObjectOptions) (response *core.DetailedResponse, err error)
+
+	// AccountPublishObjectWithContext is an alternate form of the AccountPublishObject method which supports a Context
+	// parameter
+	AccountPublishObjectWithContext(ctx context.Context, accountPublishObjectOptions *AccountPublishObjectOptions) (response *core.DetailedResponse, err error)
+
+	// SharedPublishObject : Publish object to share with allow list
+	SharedPublishObject(sharedPublishObjectOptions *SharedPublishObjectOptions) (response *core.DetailedResponse, err error)
+
+	// SharedPublishObjectWithContext is an alternate form of the SharedPublishObject method which supports a Context
+	// parameter
+	SharedPublishObjectWithContext(ctx context.Context, sharedPublishObjectOptions *SharedPublishObjectOptions) (response *core.DetailedResponse, err error)
+
+	// IBMPublishObject : Publish object to share with IBMers
+	IBMPublishObject(ibmPublishObjectOptions *IBMPublishObjectOptions) (response *core.DetailedResponse, err error)
+
+	// IBMPublishObjectWithContext is an alternate form of the IBMPublishObject method which supports a Context
+	// parameter
+	IBMPublishObjectWithContext(ctx context.Context, ibmPublishObjectOptions *IBMPublishObjectOptions) (response *core.DetailedResponse, err error)
+
+	// PublicPublishObject : Publish object to share with all users
+	PublicPublishObject(publicPublishObjectOptions *PublicPublishObjectOptions) (response *core.DetailedResponse, err error)
+
+	// PublicPublishObjectWithContext is an alternate form of the PublicPublishObject method which supports a Context
+	// parameter
+	PublicPublishObjectWithContext(ctx context.Context, publicPublishObjectOptions *PublicPublishObjectOptions) (response *core.DetailedResponse, err error)
+
+	// CreateOfferingInstance : Create an offering resource instance
+	CreateOfferingInstance(createOfferingInstanceOptions *CreateOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// CreateOfferingInstanceWithContext is an alternate form of the CreateOfferingInstance method which supports a
+	// Context parameter
+	CreateOfferingInstanceWithContext(ctx context.Context, createOfferingInstanceOptions *CreateOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// GetOfferingInstance : Get Offering Instance
+	GetOfferingInstance(getOfferingInstanceOptions *GetOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// GetOfferingInstanceWithContext is an alternate form of the GetOfferingInstance method which supports a Context
+	// parameter
+	GetOfferingInstanceWithContext(ctx context.Context, getOfferingInstanceOptions *GetOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// PutOfferingInstance : Update Offering Instance
+	PutOfferingInstance(putOfferingInstanceOptions *PutOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// PutOfferingInstanceWithContext is an alternate form of the PutOfferingInstance method which supports a Context
+	// parameter
+	PutOfferingInstanceWithContext(ctx context.Context, putOfferingInstanceOptions *PutOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// DeleteOfferingInstance : Delete a version instance
+	DeleteOfferingInstance(deleteOfferingInstanceOptions *DeleteOfferingInstanceOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteOfferingInstanceWithContext is an alternate form of the DeleteOfferingInstance method which supports a
+	// Context parameter
+	DeleteOfferingInstanceWithContext(ctx context.Context, deleteOfferingInstanceOptions *DeleteOfferingInstanceOptions) (response *core.DetailedResponse, err error)
+
+	// ListOfferingInstanceAudits : Get offering instance audit logs
+	ListOfferingInstanceAudits(listOfferingInstanceAuditsOptions *ListOfferingInstanceAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// ListOfferingInstanceAuditsWithContext is an alternate form of the ListOfferingInstanceAudits method which
+	// supports a Context parameter
+	ListOfferingInstanceAuditsWithContext(ctx context.Context, listOfferingInstanceAuditsOptions *ListOfferingInstanceAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetOfferingInstanceAudit : Get an offering instance audit log entry
+	GetOfferingInstanceAudit(getOfferingInstanceAuditOptions *GetOfferingInstanceAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// GetOfferingInstanceAuditWithContext is an alternate form of the GetOfferingInstanceAudit method which supports a
+	// Context parameter
+	GetOfferingInstanceAuditWithContext(ctx context.Context, getOfferingInstanceAuditOptions *GetOfferingInstanceAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// NewAccountPublishObjectOptions : Instantiate AccountPublishObjectOptions
+	NewAccountPublishObjectOptions(catalogIdentifier string, objectIdentifier string) *AccountPublishObjectOptions
+
+	// NewAccountPublishVersionOptions : Instantiate AccountPublishVersionOptions
+	NewAccountPublishVersionOptions(versionLocID string) *AccountPublishVersionOptions
+
+	// NewAddObjectAccessListOptions : Instantiate AddObjectAccessListOptions
+	NewAddObjectAccessListOptions(catalogIdentifier string, objectIdentifier string, accesses []string) *AddObjectAccessListOptions
+
+	// NewAddOfferingAccessListOptions : Instantiate AddOfferingAccessListOptions
+	NewAddOfferingAccessListOptions(catalogIdentifier string, offeringID string, accesses []string) *AddOfferingAccessListOptions
+
+	// NewArchiveVersionOptions : Instantiate ArchiveVersionOptions
+	NewArchiveVersionOptions(versionLocID string) *ArchiveVersionOptions
+
+	// NewCommitVersionOptions : Instantiate CommitVersionOptions
+	NewCommitVersionOptions(versionLocID string) *CommitVersionOptions
+
+	// NewConsumableShareObjectOptions : Instantiate ConsumableShareObjectOptions
+	NewConsumableShareObjectOptions(catalogIdentifier string, objectIdentifier string) *ConsumableShareObjectOptions
+
+	// NewConsumableVersionOptions : Instantiate ConsumableVersionOptions
+	NewConsumableVersionOptions(versionLocID string) *ConsumableVersionOptions
+
+	// NewCopyFromPreviousVersionOptions : Instantiate CopyFromPreviousVersionOptions
+	NewCopyFromPreviousVersionOptions(versionLocID string, typeVar string, versionLocIDToCopyFrom string) *CopyFromPreviousVersionOptions
+
+	// NewCopyVersionOptions : Instantiate CopyVersionOptions
+	NewCopyVersionOptions(versionLocID string) *CopyVersionOptions
+
+	// NewCreateCatalogOptions : Instantiate CreateCatalogOptions
+	NewCreateCatalogOptions() *CreateCatalogOptions
+
+	// NewCreateObjectAccessOptions : Instantiate CreateObjectAccessOptions
+	NewCreateObjectAccessOptions(catalogIdentifier string, objectIdentifier string, accessIdentifier string) *CreateObjectAccessOptions
+
+	// NewCreateObjectOptions : Instantiate CreateObjectOptions
+	NewCreateObjectOptions(catalogIdentifier string) *CreateObjectOptions
+
+	// NewCreateOfferingInstanceOptions : Instantiate CreateOfferingInstanceOptions
+	NewCreateOfferingInstanceOptions(xAuthRefreshToken string) *CreateOfferingInstanceOptions
+
+	// NewCreateOfferingOptions : Instantiate CreateOfferingOptions
+	NewCreateOfferingOptions(catalogIdentifier string) *CreateOfferingOptions
+
+	// NewDeleteCatalogOptions : Instantiate DeleteCatalogOptions
+	NewDeleteCatalogOptions(catalogIdentifier string) *DeleteCatalogOptions
+
+	// NewDeleteObjectAccessListOptions : Instantiate DeleteObjectAccessListOptions
+	NewDeleteObjectAccessListOptions(catalogIdentifier string, objectIdentifier string, accesses []string) *DeleteObjectAccessListOptions
+
+	// NewDeleteObjectAccessOptions : Instantiate DeleteObjectAccessOptions
+	NewDeleteObjectAccessOptions(catalogIdentifier string, objectIdentifier string, accessIdentifier string) *DeleteObjectAccessOptions
+
+	// NewDeleteObjectOptions : Instantiate DeleteObjectOptions
+	NewDeleteObjectOptions(catalogIdentifier string, objectIdentifier string) *DeleteObjectOptions
+
+	// NewDeleteOfferingAccessListOptions : Instantiate DeleteOfferingAccessListOptions
+	NewDeleteOfferingAccessListOptions(catalogIdentifier string, offeringID string, accesses []string) *DeleteOfferingAccessListOptions
+
+	// NewDeleteOfferingInstanceOptions : Instantiate DeleteOfferingInstanceOptions
+	NewDeleteOfferingInstanceOptions(instanceIdentifier string, xAuthRefreshToken string) *DeleteOfferingInstanceOptions
+
+	// NewDeleteOfferingOptions : Instantiate DeleteOfferingOptions
+	NewDeleteOfferingOptions(catalogIdentifier string, offeringID string) *DeleteOfferingOptions
+
+	// NewDeleteOperatorsOptions : Instantiate DeleteOperatorsOptions
+	NewDeleteOperatorsOptions(xAuthRefreshToken string, clusterID string, region string, versionLocatorID string) *DeleteOperatorsOptions
+
+	// NewDeleteVersionOptions : Instantiate DeleteVersionOptions
+	NewDeleteVersionOptions(versionLocID string) *DeleteVersionOptions
+
+	// NewDeployOperatorsOptions : Instantiate DeployOperatorsOptions
+	NewDeployOperatorsOptions(xAuthRefreshToken string) *DeployOperatorsOptions
+
+	// NewDeprecateOfferingOptions : Instantiate DeprecateOfferingOptions
+	NewDeprecateOfferingOptions(catalogIdentifier string, offeringID string, setting string) *DeprecateOfferingOptions
+
+	// NewDeprecateVersionOptions : Instantiate DeprecateVersionOptions
+	NewDeprecateVersionOptions(versionLocID string) *DeprecateVersionOptions
+
+	// NewGetCatalogAccountAuditOptions : Instantiate GetCatalogAccountAuditOptions
+	NewGetCatalogAccountAuditOptions(auditlogIdentifier string) *GetCatalogAccountAuditOptions
+
+	// NewGetCatalogAccountFiltersOptions : Instantiate GetCatalogAccountFiltersOptions
+	NewGetCatalogAccountFiltersOptions() *GetCatalogAccountFiltersOptions
+
+	// NewGetCatalogAccountOptions : Instantiate GetCatalogAccountOptions
+	NewGetCatalogAccountOptions() *GetCatalogAccountOptions
+
+	// NewGetCatalogAuditOptions : Instantiate GetCatalogAuditOptions
+	NewGetCatalogAuditOptions(catalogIdentifier string, auditlogIdentifier string) *GetCatalogAuditOptions
+
+	// NewGetCatalogOptions : Instantiate GetCatalogOptions
+	NewGetCatalogOptions(catalogIdentifier string) *GetCatalogOptions
+
+	// NewGetClusterOptions : Instantiate GetClusterOptions
+	NewGetClusterOptions(clusterID string, region string, xAuthRefreshToken string) *GetClusterOptions
+
+	// NewGetConsumptionOfferingsOptions : Instantiate GetConsumptionOfferingsOptions
+	NewGetConsumptionOfferingsOptions() *GetConsumptionOfferingsOptions
+
+	// NewGetEnterpriseAuditOptions : Instantiate GetEnterpriseAuditOptions
+	NewGetEnterpriseAuditOptions(enterpriseIdentifier string, auditlogIdentifier string) *GetEnterpriseAuditOptions
+
+	// NewGetNamespacesOptions : Instantiate GetNamespacesOptions
+	NewGetNamespacesOptions(clusterID string, region string, xAuthRefreshToken string) *GetNamespacesOptions
+
+	// NewGetObjectAccessListDeprecatedOptions : Instantiate GetObjectAccessListDeprecatedOptions
+	NewGetObjectAccessListDeprecatedOptions(catalogIdentifier string, objectIdentifier string) *GetObjectAccessListDeprecatedOptions
+
+	// NewGetObjectAccessListOptions : Instantiate GetObjectAccessListOptions
+	NewGetObjectAccessListOptions(catalogIdentifier string, objectIdentifier string) *GetObjectAccessListOptions
+
+	// NewGetObjectAccessOptions : Instantiate GetObjectAccessOptions
+	NewGetObjectAccessOptions(catalogIdentifier string, objectIdentifier string, accessIdentifier string) *GetObjectAccessOptions
+
+	// NewGetObjectAuditOptions : Instantiate GetObjectAuditOptions
+	NewGetObjectAuditOptions(catalogIdentifier string, objectIdentifier string, auditlogIdentifier string) *GetObjectAuditOptions
+
+	// NewGetObjectOptions : Instantiate GetObjectOptions
+	NewGetObjectOptions(catalogIdentifier string, objectIdentifier string) *GetObjectOptions
+
+	// NewGetOfferingAboutOptions : Instantiate GetOfferingAboutOptions
+	NewGetOfferingAboutOptions(versionLocID string) *GetOfferingAboutOptions
+
+	// NewGetOfferingAccessListOptions : Instantiate GetOfferingAccessListOptions
+	NewGetOfferingAccessListOptions(catalogIdentifier string, offeringID string) *GetOfferingAccessListOptions
+
+	// NewGetOfferingAccessOptions : Instantiate GetOfferingAccessOptions
+	NewGetOfferingAccessOptions(catalogIdentifier string, offeringID string, accessIdentifier string) *GetOfferingAccessOptions
+
+	// NewGetOfferingAuditOptions : Instantiate GetOfferingAuditOptions
+	NewGetOfferingAuditOptions(catalogIdentifier string, offeringID string, auditlogIdentifier string) *GetOfferingAuditOptions
+
+	// NewGetOfferingContainerImagesOptions : Instantiate GetOfferingContainerImagesOptions
+	NewGetOfferingContainerImagesOptions(versionLocID string) *GetOfferingContainerImagesOptions
+
+	// NewGetOfferingInstanceAuditOptions : Instantiate GetOfferingInstanceAuditOptions
+	NewGetOfferingInstanceAuditOptions(instanceIdentifier string, auditlogIdentifier string) *GetOfferingInstanceAuditOptions
+
+	// NewGetOfferingInstanceOptions : Instantiate GetOfferingInstanceOptions
+	NewGetOfferingInstanceOptions(instanceIdentifier string) *GetOfferingInstanceOptions
+
+	// NewGetOfferingLicenseOptions : Instantiate GetOfferingLicenseOptions
+	NewGetOfferingLicenseOptions(versionLocID string, licenseID string) *GetOfferingLicenseOptions
+
+	// NewGetOfferingOptions : Instantiate GetOfferingOptions
+	NewGetOfferingOptions(catalogIdentifier string, offeringID string) *GetOfferingOptions
+
+	// NewGetOfferingSourceOptions : Instantiate GetOfferingSourceOptions
+	NewGetOfferingSourceOptions(version string) *GetOfferingSourceOptions
+
+	// NewGetOfferingSourceURLOptions : Instantiate GetOfferingSourceURLOptions
+	NewGetOfferingSourceURLOptions(keyIdentifier string) *GetOfferingSourceURLOptions
+
+	// NewGetOfferingUpdatesOptions : Instantiate GetOfferingUpdatesOptions
+	NewGetOfferingUpdatesOptions(catalogIdentifier string, offeringID string, kind string, xAuthRefreshToken string) *GetOfferingUpdatesOptions
+
+	// NewGetOfferingWorkingCopyOptions : Instantiate GetOfferingWorkingCopyOptions
+	NewGetOfferingWorkingCopyOptions(versionLocID string) *GetOfferingWorkingCopyOptions
+
+	// NewGetOverrideValuesOptions : Instantiate GetOverrideValuesOptions
+	NewGetOverrideValuesOptions(versionLocID string) *GetOverrideValuesOptions
+
+	// NewGetPreinstallOptions : Instantiate GetPreinstallOptions
+	NewGetPreinstallOptions(versionLocID string, xAuthRefreshToken string) *GetPreinstallOptions
+
+	// NewGetValidationStatusOptions : Instantiate GetValidationStatusOptions
+	NewGetValidationStatusOptions(versionLocID string, xAuthRefreshToken string) *GetValidationStatusOptions
+
+	// NewGetVersionOptions : Instantiate GetVersionOptions
+	NewGetVersionOptions(versionLocID string) *GetVersionOptions
+
+	// NewIBMPublishObjectOptions : Instantiate IBMPublishObjectOptions
+	NewIBMPublishObjectOptions(catalogIdentifier string, objectIdentifier string) *IBMPublishObjectOptions
+
+	// NewIBMPublishVersionOptions : Instantiate IBMPublishVersionOptions
+	NewIBMPublishVersionOptions(versionLocID string) *IBMPublishVersionOptions
+
+	// NewImportOfferingOptions : Instantiate ImportOfferingOptions
+	NewImportOfferingOptions(catalogIdentifier string) *ImportOfferingOptions
+
+	// NewImportOfferingVersionOptions : Instantiate ImportOfferingVersionOptions
+	NewImportOfferingVersionOptions(catalogIdentifier string, offeringID string) *ImportOfferingVersionOptions
+
+	// NewInstallVersionOptions : Instantiate InstallVersionOptions
+	NewInstallVersionOptions(versionLocID string, xAuthRefreshToken string) *InstallVersionOptions
+
+	// NewJSONPatchOperation : Instantiate JSONPatchOperation (Generic Model Constructor)
+	NewJSONPatchOperation(op string, path string) (_model *JSONPatchOperation, err error)
+
+	// NewListCatalogAccountAuditsOptions : Instantiate ListCatalogAccountAuditsOptions
+	NewListCatalogAccountAuditsOptions() *ListCatalogAccountAuditsOptions
+
+	// NewListCatalogAuditsOptions : Instantiate ListCatalogAuditsOptions
+	NewListCatalogAuditsOptions(catalogIdentifier string) *ListCatalogAuditsOptions
+
+	// NewListCatalogsOptions : Instantiate ListCatalogsOptions
+	NewListCatalogsOptions() *ListCatalogsOptions
+
+	// NewListEnterpriseAuditsOptions : Instantiate ListEnterpriseAuditsOptions
+	NewListEnterpriseAuditsOptions(enterpriseIdentifier string) *ListEnterpriseAuditsOptions
+
+	// NewListObjectAuditsOptions : Instantiate ListObjectAuditsOptions
+	NewListObjectAuditsOptions(catalogIdentifier string, objectIdentifier string) *ListObjectAuditsOptions
+
+	// NewListObjectsOptions : Instantiate ListObjectsOptions
+	NewListObjectsOptions(catalogIdentifier string) *ListObjectsOptions
+
+	// NewListOfferingAuditsOptions : Instantiate ListOfferingAuditsOptions
+	NewListOfferingAuditsOptions(catalogIdentifier string, offeringID string) *ListOfferingAuditsOptions
+
+	// NewListOfferingInstanceAuditsOptions : Instantiate ListOfferingInstanceAuditsOptions
+	NewListOfferingInstanceAuditsOptions(instanceIdentifier string) *ListOfferingInstanceAuditsOptions
+
+	// NewListOfferingsOptions : Instantiate ListOfferingsOptions
+	NewListOfferingsOptions(catalogIdentifier string) *ListOfferingsOptions
+
+	// NewListOperatorsOptions : Instantiate ListOperatorsOptions
+	NewListOperatorsOptions(xAuthRefreshToken string, clusterID string, region string, versionLocatorID string) *ListOperatorsOptions
+
+	// NewPreinstallVersionOptions : Instantiate PreinstallVersionOptions
+	NewPreinstallVersionOptions(versionLocID string, xAuthRefreshToken string) *PreinstallVersionOptions
+
+	// NewPublicPublishObjectOptions : Instantiate PublicPublishObjectOptions
+	NewPublicPublishObjectOptions(catalogIdentifier string, objectIdentifier string) *PublicPublishObjectOptions
+
+	// NewPublicPublishVersionOptions : Instantiate PublicPublishVersionOptions
+	NewPublicPublishVersionOptions(versionLocID string) *PublicPublishVersionOptions
+
+	// NewPutOfferingInstanceOptions : Instantiate PutOfferingInstanceOptions
+	NewPutOfferingInstanceOptions(instanceIdentifier string, xAuthRefreshToken string) *PutOfferingInstanceOptions
+
+	// NewReloadOfferingOptions : Instantiate ReloadOfferingOptions
+	NewReloadOfferingOptions(catalogIdentifier string, offeringID string, targetVersion string) *ReloadOfferingOptions
+
+	// NewReplaceCatalogOptions : Instantiate ReplaceCatalogOptions
+	NewReplaceCatalogOptions(catalogIdentifier string) *ReplaceCatalogOptions
+
+	// NewReplaceObjectOptions : Instantiate ReplaceObjectOptions
+	NewReplaceObjectOptions(catalogIdentifier string, objectIdentifier string) *ReplaceObjectOptions
+
+	// NewReplaceOfferingOptions : Instantiate ReplaceOfferingOptions
+	NewReplaceOfferingOptions(catalogIdentifier string, offeringID string) *ReplaceOfferingOptions
+
+	// NewReplaceOperatorsOptions : Instantiate ReplaceOperatorsOptions
+	NewReplaceOperatorsOptions(xAuthRefreshToken string) *ReplaceOperatorsOptions
+
+	// NewSearchObjectsOptions : Instantiate SearchObjectsOptions
+	NewSearchObjectsOptions(query string) *SearchObjectsOptions
+
+	// NewSetDeprecateVersionOptions : Instantiate SetDeprecateVersionOptions
+	NewSetDeprecateVersionOptions(versionLocID string, setting string) *SetDeprecateVersionOptions
+
+	// NewSetOfferingPublishOptions : Instantiate SetOfferingPublishOptions
+	NewSetOfferingPublishOptions(catalogIdentifier string, offeringID string, approvalType string, approved string) *SetOfferingPublishOptions
+
+	// NewShareObjectOptions : Instantiate ShareObjectOptions
+	NewShareObjectOptions(catalogIdentifier string, objectIdentifier string) *ShareObjectOptions
+
+	// NewShareOfferingOptions : Instantiate ShareOfferingOptions
+	NewShareOfferingOptions(catalogIdentifier string, offeringID string) *ShareOfferingOptions
+
+	// NewSharedPublishObjectOptions : Instantiate SharedPublishObjectOptions
+	NewSharedPublishObjectOptions(catalogIdentifier string, objectIdentifier string) *SharedPublishObjectOptions
+
+	// NewSuspendVersionOptions : Instantiate SuspendVersionOptions
+	NewSuspendVersionOptions(versionLocID string) *SuspendVersionOptions
+
+	// NewUpdateCatalogAccountOptions : Instantiate UpdateCatalogAccountOptions
+	NewUpdateCatalogAccountOptions() *UpdateCatalogAccountOptions
+
+	// NewUpdateOfferingOptions : Instantiate UpdateOfferingOptions
+	NewUpdateOfferingOptions(catalogIdentifier string, offeringID string, ifMatch string) *UpdateOfferingOptions
+
+	// NewValidateInstallOptions : Instantiate ValidateInstallOptions
+	NewValidateInstallOptions(versionLocID string, xAuthRefreshToken string) *ValidateInstallOptions
+
+	// NewCatalogAccountAuditsPager returns a new CatalogAccountAuditsPager instance.
+	NewCatalogAccountAuditsPager(options *ListCatalogAccountAuditsOptions) (pager *CatalogAccountAuditsPager, err error)
+
+	// NewCatalogAuditsPager returns a new CatalogAuditsPager instance.
+	NewCatalogAuditsPager(options *ListCatalogAuditsOptions) (pager *CatalogAuditsPager, err error)
+
+	// NewEnterpriseAuditsPager returns a new EnterpriseAuditsPager instance.
+	NewEnterpriseAuditsPager(options *ListEnterpriseAuditsOptions) (pager *EnterpriseAuditsPager, err error)
+
+	// NewOfferingAuditsPager returns a new OfferingAuditsPager instance.
+	NewOfferingAuditsPager(options *ListOfferingAuditsOptions) (pager *OfferingAuditsPager, err error)
+
+	// NewGetOfferingAccessListPager returns a new GetOfferingAccessListPager instance.
+	NewGetOfferingAccessListPager(options *GetOfferingAccessListOptions) (pager *GetOfferingAccessListPager, err error)
+
+	// NewObjectAuditsPager returns a new ObjectAuditsPager instance.
+	NewObjectAuditsPager(options *ListObjectAuditsOptions) (pager *ObjectAuditsPager, err error)
+
+	// NewGetObjectAccessListPager returns a new GetObjectAccessListPager instance.
+	NewGetObjectAccessListPager(options *GetObjectAccessListOptions) (pager *GetObjectAccessListPager, err error)
+
+	// NewOfferingInstanceAuditsPager returns a new OfferingInstanceAuditsPager instance.
+	NewOfferingInstanceAuditsPager(options *ListOfferingInstanceAuditsOptions) (pager *OfferingInstanceAuditsPager, err error)
+
+	// ExportOfferingBundle writes to "w" a gzip-compressed tar archive holding the offering version with the specified
+	// version locator, so that it can be restored with ImportOfferingBundle into a catalog that cannot reach this one.
+	ExportOfferingBundle(ctx context.Context, versionLocator string, w io.Writer) (manifest *OfferingBundleManifest, err error)
+
+	// ImportOfferingBundle restores an offering version from an archive written by ExportOfferingBundle into the
+	// catalog with the specified ID.
+	ImportOfferingBundle(ctx context.Context, catalogID string, r io.Reader) (result *Offering, err error)
+
+	// DiffOfferingVersions retrieves two offering versions and compares their configuration items, licenses and kinds.
+	DiffOfferingVersions(ctx context.Context, fromVersionLocator string, toVersionLocator string) (diff *VersionDiff, err error)
+
+	// ImportOfferingFromArchive imports an offering version from an archive into the catalog with the specified ID,
+	// then optionally validates and publishes it, replacing the import, get version, validate, poll and publish
+	// sequence.
+	ImportOfferingFromArchive(ctx context.Context, catalogID string, archive string, options *ImportOfferingFromArchiveOptions) (result *ImportedOffering, err error)
+
+	// NewGetCatalogAccountOptionsWith returns GetCatalogAccountOptions populated by applying "options" in order.
+	NewGetCatalogAccountOptionsWith(options ...GetCatalogAccountOption) *GetCatalogAccountOptions
+
+	// GetCatalogAccountWith is an alternate form of the GetCatalogAccountWithContext method which takes functional
+	// options instead of a GetCatalogAccountOptions struct.
+	GetCatalogAccountWith(ctx context.Context, options ...GetCatalogAccountOption) (result *Account, response *core.DetailedResponse, err error)
+
+	// NewUpdateCatalogAccountOptionsWith returns UpdateCatalogAccountOptions populated by applying "options" in order.
+	NewUpdateCatalogAccountOptionsWith(options ...UpdateCatalogAccountOption) *UpdateCatalogAccountOptions
+
+	// UpdateCatalogAccountWith is an alternate form of the UpdateCatalogAccountWithContext method which takes
+	// functional options instead of an UpdateCatalogAccountOptions struct.
+	UpdateCatalogAccountWith(ctx context.Context, options ...UpdateCatalogAccountOption) (result *Account, response *core.DetailedResponse, err error)
+
+	// NewListCatalogAccountAuditsOptionsWith returns ListCatalogAccountAuditsOptions populated by applying "options"
+	// in order.
+	NewListCatalogAccountAuditsOptionsWith(options ...ListCatalogAccountAuditsOption) *ListCatalogAccountAuditsOptions
+
+	// ListCatalogAccountAuditsWith is an alternate form of the ListCatalogAccountAuditsWithContext method which takes
+	// functional options instead of a ListCatalogAccountAuditsOptions struct.
+	ListCatalogAccountAuditsWith(ctx context.Context, options ...ListCatalogAccountAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// NewGetCatalogAccountAuditOptionsWith returns GetCatalogAccountAuditOptions populated by applying "options" in
+	// order.
+	NewGetCatalogAccountAuditOptionsWith(options ...GetCatalogAccountAuditOption) *GetCatalogAccountAuditOptions
+
+	// GetCatalogAccountAuditWith is an alternate form of the GetCatalogAccountAuditWithContext method which takes
+	// functional options instead of a GetCatalogAccountAuditOptions struct.
+	GetCatalogAccountAuditWith(ctx context.Context, options ...GetCatalogAccountAuditOption) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// NewGetCatalogAccountFiltersOptionsWith returns GetCatalogAccountFiltersOptions populated by applying "options"
+	// in order.
+	NewGetCatalogAccountFiltersOptionsWith(options ...GetCatalogAccountFiltersOption) *GetCatalogAccountFiltersOptions
+
+	// GetCatalogAccountFiltersWith is an alternate form of the GetCatalogAccountFiltersWithContext method which takes
+	// functional options instead of a GetCatalogAccountFiltersOptions struct.
+	GetCatalogAccountFiltersWith(ctx context.Context, options ...GetCatalogAccountFiltersOption) (result *AccumulatedFilters, response *core.DetailedResponse, err error)
+
+	// NewListCatalogsOptionsWith returns ListCatalogsOptions populated by applying "options" in order.
+	NewListCatalogsOptionsWith(options ...ListCatalogsOption) *ListCatalogsOptions
+
+	// ListCatalogsWith is an alternate form of the ListCatalogsWithContext method which takes functional options
+	// instead of a ListCatalogsOptions struct.
+	ListCatalogsWith(ctx context.Context, options ...ListCatalogsOption) (result *CatalogSearchResult, response *core.DetailedResponse, err error)
+
+	// NewCreateCatalogOptionsWith returns CreateCatalogOptions populated by applying "options" in order.
+	NewCreateCatalogOptionsWith(options ...CreateCatalogOption) *CreateCatalogOptions
+
+	// CreateCatalogWith is an alternate form of the CreateCatalogWithContext method which takes functional options
+	// instead of a CreateCatalogOptions struct.
+	CreateCatalogWith(ctx context.Context, options ...CreateCatalogOption) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// NewGetCatalogOptionsWith returns GetCatalogOptions populated by applying "options" in order.
+	NewGetCatalogOptionsWith(options ...GetCatalogOption) *GetCatalogOptions
+
+	// GetCatalogWith is an alternate form of the GetCatalogWithContext method which takes functional options instead
+	// of a GetCatalogOptions struct.
+	GetCatalogWith(ctx context.Context, options ...GetCatalogOption) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// NewReplaceCatalogOptionsWith returns ReplaceCatalogOptions populated by applying "options" in order.
+	NewReplaceCatalogOptionsWith(options ...ReplaceCatalogOption) *ReplaceCatalogOptions
+
+	// ReplaceCatalogWith is an alternate form of the ReplaceCatalogWithContext method which takes functional options
+	// instead of a ReplaceCatalogOptions struct.
+	ReplaceCatalogWith(ctx context.Context, options ...ReplaceCatalogOption) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// NewDeleteCatalogOptionsWith returns DeleteCatalogOptions populated by applying "options" in order.
+	NewDeleteCatalogOptionsWith(options ...DeleteCatalogOption) *DeleteCatalogOptions
+
+	// DeleteCatalogWith is an alternate form of the DeleteCatalogWithContext method which takes functional options
+	// instead of a DeleteCatalogOptions struct.
+	DeleteCatalogWith(ctx context.Context, options ...DeleteCatalogOption) (response *core.DetailedResponse, err error)
+
+	// NewListCatalogAuditsOptionsWith returns ListCatalogAuditsOptions populated by applying "options" in order.
+	NewListCatalogAuditsOptionsWith(options ...ListCatalogAuditsOption) *ListCatalogAuditsOptions
+
+	// ListCatalogAuditsWith is an alternate form of the ListCatalogAuditsWithContext method which takes functional
+	// options instead of a ListCatalogAuditsOptions struct.
+	ListCatalogAuditsWith(ctx context.Context, options ...ListCatalogAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// NewGetCatalogAuditOptionsWith returns GetCatalogAuditOptions populated by applying "options" in order.
+	NewGetCatalogAuditOptionsWith(options ...GetCatalogAuditOption) *GetCatalogAuditOptions
+
+	// GetCatalogAuditWith is an alternate form of the GetCatalogAuditWithContext method which takes functional options
+	// instead of a GetCatalogAuditOptions struct.
+	GetCatalogAuditWith(ctx context.Context, options ...GetCatalogAuditOption) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// NewListEnterpriseAuditsOptionsWith returns ListEnterpriseAuditsOptions populated by applying "options" in order.
+	NewListEnterpriseAuditsOptionsWith(options ...ListEnterpriseAuditsOption) *ListEnterpriseAuditsOptions
+
+	// ListEnterpriseAuditsWith is an alternate form of the ListEnterpriseAuditsWithContext method which takes
+	// functional options instead of a ListEnterpriseAuditsOptions struct.
+	ListEnterpriseAuditsWith(ctx context.Context, options ...ListEnterpriseAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// NewGetEnterpriseAuditOptionsWith returns GetEnterpriseAuditOptions populated by applying "options" in order.
+	NewGetEnterpriseAuditOptionsWith(options ...GetEnterpriseAuditOption) *GetEnterpriseAuditOptions
+
+	// GetEnterpriseAuditWith is an alternate form of the GetEnterpriseAuditWithContext method which takes functional
+	// options instead of a GetEnterpriseAuditOptions struct.
+	GetEnterpriseAuditWith(ctx context.Context, options ...GetEnterpriseAuditOption) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// NewGetConsumptionOfferingsOptionsWith returns GetConsumptionOfferingsOptions populated by applying "options" in
+	// order.
+	NewGetConsumptionOfferingsOptionsWith(options ...GetConsumptionOfferingsOption) *GetConsumptionOfferingsOptions
+
+	// GetConsumptionOfferingsWith is an alternate form of the GetConsumptionOfferingsWithContext method which takes
+	// functional options instead of a GetConsumptionOfferingsOptions struct.
+	GetConsumptionOfferingsWith(ctx context.Context, options ...GetConsumptionOfferingsOption) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+
+	// NewListOfferingsOptionsWith returns ListOfferingsOptions populated by applying "options" in order.
+	NewListOfferingsOptionsWith(options ...ListOfferingsOption) *ListOfferingsOptions
+
+	// ListOfferingsWith is an alternate form of the ListOfferingsWithContext method which takes functional options
+	// instead of a ListOfferingsOptions struct.
+	ListOfferingsWith(ctx context.Context, options ...ListOfferingsOption) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+
+	// NewCreateOfferingOptionsWith returns CreateOfferingOptions populated by applying "options" in order.
+	NewCreateOfferingOptionsWith(options ...CreateOfferingOption) *CreateOfferingOptions
+
+	// CreateOfferingWith is an alternate form of the CreateOfferingWithContext method which takes functional options
+	// instead of a CreateOfferingOptions struct.
+	CreateOfferingWith(ctx context.Context, options ...CreateOfferingOption) (result *Offering, response *core.DetailedResponse, err error)
+
+	// NewImportOfferingVersionOptionsWith returns ImportOfferingVersionOptions populated by applying "options" in
+	// order.
+	NewImportOfferingVersionOptionsWith(options ...ImportOfferingVersionOption) *ImportOfferingVersionOptions
+
+	// ImportOfferingVersionWith is an alternate form of the ImportOfferingVersionWithContext method which takes
+	// functional options instead of an ImportOfferingVersionOptions struct.
+	ImportOfferingVersionWith(ctx context.Context, options ...ImportOfferingVersionOption) (result *Offering, response *core.DetailedResponse, err error)
+
+	// NewImportOfferingOptionsWith returns ImportOfferingOptions populated by applying "options" in order.
+	NewImportOfferingOptionsWith(options ...ImportOfferingOption) *ImportOfferingOptions
+
+	// ImportOfferingWith is an alternate form of the ImportOfferingWithContext method which takes functional options
+	// instead of an ImportOfferingOptions struct.
+	ImportOfferingWith(ctx context.Context, options ...ImportOfferingOption) (result *Offering, response *core.DetailedResponse, err error)
+
+	// NewReloadOfferingOptionsWith returns ReloadOfferingOptions populated by applying "options" in order.
+	NewReloadOfferingOptionsWith(options ...ReloadOfferingOption) *ReloadOfferingOptions
+
+	// ReloadOfferingWith is an alternate form of the ReloadOfferingWithContext method which takes functional options
+	// instead of a ReloadOfferingOptions struct.
+	ReloadOfferingWith(ctx context.Context, options ...ReloadOfferingOption) (result *Offering, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingOptionsWith returns GetOfferingOptions populated by applying "options" in order.
+	NewGetOfferingOptionsWith(options ...GetOfferingOption) *GetOfferingOptions
+
+	// GetOfferingWith is an alternate form of the GetOfferingWithContext method which takes functional options instead
+	// of a GetOfferingOptions struct.
+	GetOfferingWith(ctx context.Context, options ...GetOfferingOption) (result *Offering, response *core.DetailedResponse, err error)
+
+	// NewReplaceOfferingOptionsWith returns ReplaceOfferingOptions populated by applying "options" in order.
+	NewReplaceOfferingOptionsWith(options ...ReplaceOfferingOption) *ReplaceOfferingOptions
+
+	// ReplaceOfferingWith is an alternate form of the ReplaceOfferingWithContext method which takes functional options
+	// instead of a ReplaceOfferingOptions struct.
+	ReplaceOfferingWith(ctx context.Context, options ...ReplaceOfferingOption) (result *Offering, response *core.DetailedResponse, err error)
+
+	// NewUpdateOfferingOptionsWith returns UpdateOfferingOptions populated by applying "options" in order.
+	NewUpdateOfferingOptionsWith(options ...UpdateOfferingOption) *UpdateOfferingOptions
+
+	// UpdateOfferingWith is an alternate form of the UpdateOfferingWithContext method which takes functional options
+	// instead of an UpdateOfferingOptions struct.
+	UpdateOfferingWith(ctx context.Context, options ...UpdateOfferingOption) (result *Offering, response *core.DetailedResponse, err error)
+
+	// NewDeleteOfferingOptionsWith returns DeleteOfferingOptions populated by applying "options" in order.
+	NewDeleteOfferingOptionsWith(options ...DeleteOfferingOption) *DeleteOfferingOptions
+
+	// DeleteOfferingWith is an alternate form of the DeleteOfferingWithContext method which takes functional options
+	// instead of a DeleteOfferingOptions struct.
+	DeleteOfferingWith(ctx context.Context, options ...DeleteOfferingOption) (response *core.DetailedResponse, err error)
+
+	// NewListOfferingAuditsOptionsWith returns ListOfferingAuditsOptions populated by applying "options" in order.
+	NewListOfferingAuditsOptionsWith(options ...ListOfferingAuditsOption) *ListOfferingAuditsOptions
+
+	// ListOfferingAuditsWith is an alternate form of the ListOfferingAuditsWithContext method which takes functional
+	// options instead of a ListOfferingAuditsOptions struct.
+	ListOfferingAuditsWith(ctx context.Context, options ...ListOfferingAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingAuditOptionsWith returns GetOfferingAuditOptions populated by applying "options" in order.
+	NewGetOfferingAuditOptionsWith(options ...GetOfferingAuditOption) *GetOfferingAuditOptions
+
+	// GetOfferingAuditWith is an alternate form of the GetOfferingAuditWithContext method which takes functional
+	// options instead of a GetOfferingAuditOptions struct.
+	GetOfferingAuditWith(ctx context.Context, options ...GetOfferingAuditOption) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// NewSetOfferingPublishOptionsWith returns SetOfferingPublishOptions populated by applying "options" in order.
+	NewSetOfferingPublishOptionsWith(options ...SetOfferingPublishOption) *SetOfferingPublishOptions
+
+	// SetOfferingPublishWith is an alternate form of the SetOfferingPublishWithContext method which takes functional
+	// options instead of a SetOfferingPublishOptions struct.
+	SetOfferingPublishWith(ctx context.Context, options ...SetOfferingPublishOption) (result *ApprovalResult, response *core.DetailedResponse, err error)
+
+	// NewDeprecateOfferingOptionsWith returns DeprecateOfferingOptions populated by applying "options" in order.
+	NewDeprecateOfferingOptionsWith(options ...DeprecateOfferingOption) *DeprecateOfferingOptions
+
+	// DeprecateOfferingWith is an alternate form of the DeprecateOfferingWithContext method which takes functional
+	// options instead of a DeprecateOfferingOptions struct.
+	DeprecateOfferingWith(ctx context.Context, options ...DeprecateOfferingOption) (response *core.DetailedResponse, err error)
+
+	// NewShareOfferingOptionsWith returns ShareOfferingOptions populated by applying "options" in order.
+	NewShareOfferingOptionsWith(options ...ShareOfferingOption) *ShareOfferingOptions
+
+	// ShareOfferingWith is an alternate form of the ShareOfferingWithContext method which takes functional options
+	// instead of a ShareOfferingOptions struct.
+	ShareOfferingWith(ctx context.Context, options ...ShareOfferingOption) (result *ShareSetting, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingAccessOptionsWith returns GetOfferingAccessOptions populated by applying "options" in order.
+	NewGetOfferingAccessOptionsWith(options ...GetOfferingAccessOption) *GetOfferingAccessOptions
+
+	// GetOfferingAccessWith is an alternate form of the GetOfferingAccessWithContext method which takes functional
+	// options instead of a GetOfferingAccessOptions struct.
+	GetOfferingAccessWith(ctx context.Context, options ...GetOfferingAccessOption) (result *Access, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingAccessListOptionsWith returns GetOfferingAccessListOptions populated by applying "options" in
+	// order.
+	NewGetOfferingAccessListOptionsWith(options ...GetOfferingAccessListOption) *GetOfferingAccessListOptions
+
+	// GetOfferingAccessListWith is an alternate form of the GetOfferingAccessListWithContext method which takes
+	// functional options instead of a GetOfferingAccessListOptions struct.
+	GetOfferingAccessListWith(ctx context.Context, options ...GetOfferingAccessListOption) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// NewDeleteOfferingAccessListOptionsWith returns DeleteOfferingAccessListOptions populated by applying "options"
+	// in order.
+	NewDeleteOfferingAccessListOptionsWith(options ...DeleteOfferingAccessListOption) *DeleteOfferingAccessListOptions
+
+	// DeleteOfferingAccessListWith is an alternate form of the DeleteOfferingAccessListWithContext method which takes
+	// functional options instead of a DeleteOfferingAccessListOptions struct.
+	DeleteOfferingAccessListWith(ctx context.Context, options ...DeleteOfferingAccessListOption) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// NewAddOfferingAccessListOptionsWith returns AddOfferingAccessListOptions populated by applying "options" in
+	// order.
+	NewAddOfferingAccessListOptionsWith(options ...AddOfferingAccessListOption) *AddOfferingAccessListOptions
+
+	// AddOfferingAccessListWith is an alternate form of the AddOfferingAccessListWithContext method which takes
+	// functional options instead of an AddOfferingAccessListOptions struct.
+	AddOfferingAccessListWith(ctx context.Context, options ...AddOfferingAccessListOption) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingUpdatesOptionsWith returns GetOfferingUpdatesOptions populated by applying "options" in order.
+	NewGetOfferingUpdatesOptionsWith(options ...GetOfferingUpdatesOption) *GetOfferingUpdatesOptions
+
+	// GetOfferingUpdatesWith is an alternate form of the GetOfferingUpdatesWithContext method which takes functional
+	// options instead of a GetOfferingUpdatesOptions struct.
+	GetOfferingUpdatesWith(ctx context.Context, options ...GetOfferingUpdatesOption) (result []VersionUpdateDescriptor, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingSourceOptionsWith returns GetOfferingSourceOptions populated by applying "options" in order.
+	NewGetOfferingSourceOptionsWith(options ...GetOfferingSourceOption) *GetOfferingSourceOptions
+
+	// GetOfferingSourceWith is an alternate form of the GetOfferingSourceWithContext method which takes functional
+	// options instead of a GetOfferingSourceOptions struct.
+	GetOfferingSourceWith(ctx context.Context, options ...GetOfferingSourceOption) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingSourceURLOptionsWith returns GetOfferingSourceURLOptions populated by applying "options" in order.
+	NewGetOfferingSourceURLOptionsWith(options ...GetOfferingSourceURLOption) *GetOfferingSourceURLOptions
+
+	// GetOfferingSourceURLWith is an alternate form of the GetOfferingSourceURLWithContext method which takes
+	// functional options instead of a GetOfferingSourceURLOptions struct.
+	GetOfferingSourceURLWith(ctx context.Context, options ...GetOfferingSourceURLOption) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingAboutOptionsWith returns GetOfferingAboutOptions populated by applying "options" in order.
+	NewGetOfferingAboutOptionsWith(options ...GetOfferingAboutOption) *GetOfferingAboutOptions
+
+	// GetOfferingAboutWith is an alternate form of the GetOfferingAboutWithContext method which takes functional
+	// options instead of a GetOfferingAboutOptions struct.
+	GetOfferingAboutWith(ctx context.Context, options ...GetOfferingAboutOption) (result *string, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingLicenseOptionsWith returns GetOfferingLicenseOptions populated by applying "options" in order.
+	NewGetOfferingLicenseOptionsWith(options ...GetOfferingLicenseOption) *GetOfferingLicenseOptions
+
+	// GetOfferingLicenseWith is an alternate form of the GetOfferingLicenseWithContext method which takes functional
+	// options instead of a GetOfferingLicenseOptions struct.
+	GetOfferingLicenseWith(ctx context.Context, options ...GetOfferingLicenseOption) (result *string, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingContainerImagesOptionsWith returns GetOfferingContainerImagesOptions populated by applying
+	// "options" in order.
+	NewGetOfferingContainerImagesOptionsWith(options ...GetOfferingContainerImagesOption) *GetOfferingContainerImagesOptions
+
+	// GetOfferingContainerImagesWith is an alternate form of the GetOfferingContainerImagesWithContext method which
+	// takes functional options instead of a GetOfferingContainerImagesOptions struct.
+	GetOfferingContainerImagesWith(ctx context.Context, options ...GetOfferingContainerImagesOption) (result *ImageManifest, response *core.DetailedResponse, err error)
+
+	// NewArchiveVersionOptionsWith returns ArchiveVersionOptions populated by applying "options" in order.
+	NewArchiveVersionOptionsWith(options ...ArchiveVersionOption) *ArchiveVersionOptions
+
+	// ArchiveVersionWith is an alternate form of the ArchiveVersionWithContext method which takes functional options
+	// instead of an ArchiveVersionOptions struct.
+	ArchiveVersionWith(ctx context.Context, options ...ArchiveVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewSetDeprecateVersionOptionsWith returns SetDeprecateVersionOptions populated by applying "options" in order.
+	NewSetDeprecateVersionOptionsWith(options ...SetDeprecateVersionOption) *SetDeprecateVersionOptions
+
+	// SetDeprecateVersionWith is an alternate form of the SetDeprecateVersionWithContext method which takes functional
+	// options instead of a SetDeprecateVersionOptions struct.
+	SetDeprecateVersionWith(ctx context.Context, options ...SetDeprecateVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewConsumableVersionOptionsWith returns ConsumableVersionOptions populated by applying "options" in order.
+	NewConsumableVersionOptionsWith(options ...ConsumableVersionOption) *ConsumableVersionOptions
+
+	// ConsumableVersionWith is an alternate form of the ConsumableVersionWithContext method which takes functional
+	// options instead of a ConsumableVersionOptions struct.
+	ConsumableVersionWith(ctx context.Context, options ...ConsumableVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewSuspendVersionOptionsWith returns SuspendVersionOptions populated by applying "options" in order.
+	NewSuspendVersionOptionsWith(options ...SuspendVersionOption) *SuspendVersionOptions
+
+	// SuspendVersionWith is an alternate form of the SuspendVersionWithContext method which takes functional options
+	// instead of a SuspendVersionOptions struct.
+	SuspendVersionWith(ctx context.Context, options ...SuspendVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewCommitVersionOptionsWith returns CommitVersionOptions populated by applying "options" in order.
+	NewCommitVersionOptionsWith(options ...CommitVersionOption) *CommitVersionOptions
+
+	// CommitVersionWith is an alternate form of the CommitVersionWithContext method which takes functional options
+	// instead of a CommitVersionOptions struct.
+	CommitVersionWith(ctx context.Context, options ...CommitVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewCopyVersionOptionsWith returns CopyVersionOptions populated by applying "options" in order.
+	NewCopyVersionOptionsWith(options ...CopyVersionOption) *CopyVersionOptions
+
+	// CopyVersionWith is an alternate form of the CopyVersionWithContext method which takes functional options instead
+	// of a CopyVersionOptions struct.
+	CopyVersionWith(ctx context.Context, options ...CopyVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewGetOfferingWorkingCopyOptionsWith returns GetOfferingWorkingCopyOptions populated by applying "options" in
+	// order.
+	NewGetOfferingWorkingCopyOptionsWith(options ...GetOfferingWorkingCopyOption) *GetOfferingWorkingCopyOptions
+
+	// GetOfferingWorkingCopyWith is an alternate form of the GetOfferingWorkingCopyWithContext method which takes
+	// functional options instead of a GetOfferingWorkingCopyOptions struct.
+	GetOfferingWorkingCopyWith(ctx context.Context, options ...GetOfferingWorkingCopyOption) (result *Version, response *core.DetailedResponse, err error)
+
+	// NewCopyFromPreviousVersionOptionsWith returns CopyFromPreviousVersionOptions populated by applying "options" in
+	// order.
+	NewCopyFromPreviousVersionOptionsWith(options ...CopyFromPreviousVersionOption) *CopyFromPreviousVersionOptions
+
+	// CopyFromPreviousVersionWith is an alternate form of the CopyFromPreviousVersionWithContext method which takes
+	// functional options instead of a CopyFromPreviousVersionOptions struct.
+	CopyFromPreviousVersionWith(ctx context.Context, options ...CopyFromPreviousVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewGetVersionOptionsWith returns GetVersionOptions populated by applying "options" in order.
+	NewGetVersionOptionsWith(options ...GetVersionOption) *GetVersionOptions
+
+	// GetVersionWith is an alternate form of the GetVersionWithContext method which takes functional options instead
+	// of a GetVersionOptions struct.
+	GetVersionWith(ctx context.Context, options ...GetVersionOption) (result *Offering, response *core.DetailedResponse, err error)
+
+	// NewDeleteVersionOptionsWith returns DeleteVersionOptions populated by applying "options" in order.
+	NewDeleteVersionOptionsWith(options ...DeleteVersionOption) *DeleteVersionOptions
+
+	// DeleteVersionWith is an alternate form of the DeleteVersionWithContext method which takes functional options
+	// instead of a DeleteVersionOptions struct.
+	DeleteVersionWith(ctx context.Context, options ...DeleteVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewDeprecateVersionOptionsWith returns DeprecateVersionOptions populated by applying "options" in order.
+	NewDeprecateVersionOptionsWith(options ...DeprecateVersionOption) *DeprecateVersionOptions
+
+	// DeprecateVersionWith is an alternate form of the DeprecateVersionWithContext method which takes functional
+	// options instead of a DeprecateVersionOptions struct.
+	DeprecateVersionWith(ctx context.Context, options ...DeprecateVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewAccountPublishVersionOptionsWith returns AccountPublishVersionOptions populated by applying "options" in
+	// order.
+	NewAccountPublishVersionOptionsWith(options ...AccountPublishVersionOption) *AccountPublishVersionOptions
+
+	// AccountPublishVersionWith is an alternate form of the AccountPublishVersionWithContext method which takes
+	// functional options instead of an AccountPublishVersionOptions struct.
+	AccountPublishVersionWith(ctx context.Context, options ...AccountPublishVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewIBMPublishVersionOptionsWith returns IBMPublishVersionOptions populated by applying "options" in order.
+	NewIBMPublishVersionOptionsWith(options ...IBMPublishVersionOption) *IBMPublishVersionOptions
+
+	// IBMPublishVersionWith is an alternate form of the IBMPublishVersionWithContext method which takes functional
+	// options instead of an IBMPublishVersionOptions struct.
+	IBMPublishVersionWith(ctx context.Context, options ...IBMPublishVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewPublicPublishVersionOptionsWith returns PublicPublishVersionOptions populated by applying "options" in order.
+	NewPublicPublishVersionOptionsWith(options ...PublicPublishVersionOption) *PublicPublishVersionOptions
+
+	// PublicPublishVersionWith is an alternate form of the PublicPublishVersionWithContext method which takes
+	// functional options instead of a PublicPublishVersionOptions struct.
+	PublicPublishVersionWith(ctx context.Context, options ...PublicPublishVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewGetClusterOptionsWith returns GetClusterOptions populated by applying "options" in order.
+	NewGetClusterOptionsWith(options ...GetClusterOption) *GetClusterOptions
+
+	// GetClusterWith is an alternate form of the GetClusterWithContext method which takes functional options instead
+	// of a GetClusterOptions struct.
+	GetClusterWith(ctx context.Context, options ...GetClusterOption) (result *ClusterInfo, response *core.DetailedResponse, err error)
+
+	// NewGetNamespacesOptionsWith returns GetNamespacesOptions populated by applying "options" in order.
+	NewGetNamespacesOptionsWith(options ...GetNamespacesOption) *GetNamespacesOptions
+
+	// GetNamespacesWith is an alternate form of the GetNamespacesWithContext method which takes functional options
+	// instead of a GetNamespacesOptions struct.
+	GetNamespacesWith(ctx context.Context, options ...GetNamespacesOption) (result *NamespaceSearchResult, response *core.DetailedResponse, err error)
+
+	// NewDeployOperatorsOptionsWith returns DeployOperatorsOptions populated by applying "options" in order.
+	NewDeployOperatorsOptionsWith(options ...DeployOperatorsOption) *DeployOperatorsOptions
+
+	// DeployOperatorsWith is an alternate form of the DeployOperatorsWithContext method which takes functional options
+	// instead of a DeployOperatorsOptions struct.
+	DeployOperatorsWith(ctx context.Context, options ...DeployOperatorsOption) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// NewListOperatorsOptionsWith returns ListOperatorsOptions populated by applying "options" in order.
+	NewListOperatorsOptionsWith(options ...ListOperatorsOption) *ListOperatorsOptions
+
+	// ListOperatorsWith is an alternate form of the ListOperatorsWithContext method which takes functional options
+	// instead of a ListOperatorsOptions struct.
+	ListOperatorsWith(ctx context.Context, options ...ListOperatorsOption) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// NewReplaceOperatorsOptionsWith returns ReplaceOperatorsOptions populated by applying "options" in order.
+	NewReplaceOperatorsOptionsWith(options ...ReplaceOperatorsOption) *ReplaceOperatorsOptions
+
+	// ReplaceOperatorsWith is an alternate form of the ReplaceOperatorsWithContext method which takes functional
+	// options instead of a ReplaceOperatorsOptions struct.
+	ReplaceOperatorsWith(ctx context.Context, options ...ReplaceOperatorsOption) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// NewDeleteOperatorsOptionsWith returns DeleteOperatorsOptions populated by applying "options" in order.
+	NewDeleteOperatorsOptionsWith(options ...DeleteOperatorsOption) *DeleteOperatorsOptions
+
+	// DeleteOperatorsWith is an alternate form of the DeleteOperatorsWithContext method which takes functional options
+	// instead of a DeleteOperatorsOptions struct.
+	DeleteOperatorsWith(ctx context.Context, options ...DeleteOperatorsOption) (response *core.DetailedResponse, err error)
+
+	// NewInstallVersionOptionsWith returns InstallVersionOptions populated by applying "options" in order.
+	NewInstallVersionOptionsWith(options ...InstallVersionOption) *InstallVersionOptions
+
+	// InstallVersionWith is an alternate form of the InstallVersionWithContext method which takes functional options
+	// instead of an InstallVersionOptions struct.
+	InstallVersionWith(ctx context.Context, options ...InstallVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewPreinstallVersionOptionsWith returns PreinstallVersionOptions populated by applying "options" in order.
+	NewPreinstallVersionOptionsWith(options ...PreinstallVersionOption) *PreinstallVersionOptions
+
+	// PreinstallVersionWith is an alternate form of the PreinstallVersionWithContext method which takes functional
+	// options instead of a PreinstallVersionOptions struct.
+	PreinstallVersionWith(ctx context.Context, options ...PreinstallVersionOption) (response *core.DetailedResponse, err error)
+
+	// NewGetPreinstallOptionsWith returns GetPreinstallOptions populated by applying "options" in order.
+	NewGetPreinstallOptionsWith(options ...GetPreinstallOption) *GetPreinstallOptions
+
+	// GetPreinstallWith is an alternate form of the GetPreinstallWithContext method which takes functional options
+	// instead of a GetPreinstallOptions struct.
+	GetPreinstallWith(ctx context.Context, options ...GetPreinstallOption) (result *InstallStatus, response *core.DetailedResponse, err error)
+
+	// NewValidateInstallOptionsWith returns ValidateInstallOptions populated by applying "options" in order.
+	NewValidateInstallOptionsWith(options ...ValidateInstallOption) *ValidateInstallOptions
+
+	// ValidateInstallWith is an alternate form of the ValidateInstallWithContext method which takes functional options
+	// instead of a ValidateInstallOptions struct.
+	ValidateInstallWith(ctx context.Context, options ...ValidateInstallOption) (response *core.DetailedResponse, err error)
+
+	// NewGetValidationStatusOptionsWith returns GetValidationStatusOptions populated by applying "options" in order.
+	NewGetValidationStatusOptionsWith(options ...GetValidationStatusOption) *GetValidationStatusOptions
+
+	// GetValidationStatusWith is an alternate form of the GetValidationStatusWithContext method which takes functional
+	// options instead of a GetValidationStatusOptions struct.
+	GetValidationStatusWith(ctx context.Context, options ...GetValidationStatusOption) (result *Validation, response *core.DetailedResponse, err error)
+
+	// NewGetOverrideValuesOptionsWith returns GetOverrideValuesOptions populated by applying "options" in order.
+	NewGetOverrideValuesOptionsWith(options ...GetOverrideValuesOption) *GetOverrideValuesOptions
+
+	// GetOverrideValuesWith is an alternate form of the GetOverrideValuesWithContext method which takes functional
+	// options instead of a GetOverrideValuesOptions struct.
+	GetOverrideValuesWith(ctx context.Context, options ...GetOverrideValuesOption) (result map[string]interface{}, response *core.DetailedResponse, err error)
+
+	// NewSearchObjectsOptionsWith returns SearchObjectsOptions populated by applying "options" in order.
+	NewSearchObjectsOptionsWith(options ...SearchObjectsOption) *SearchObjectsOptions
+
+	// SearchObjectsWith is an alternate form of the SearchObjectsWithContext method which takes functional options
+	// instead of a SearchObjectsOptions struct.
+	SearchObjectsWith(ctx context.Context, options ...SearchObjectsOption) (result *ObjectSearchResult, response *core.DetailedResponse, err error)
+
+	// NewListObjectsOptionsWith returns ListObjectsOptions populated by applying "options" in order.
+	NewListObjectsOptionsWith(options ...ListObjectsOption) *ListObjectsOptions
+
+	// ListObjectsWith is an alternate form of the ListObjectsWithContext method which takes functional options instead
+	// of a ListObjectsOptions struct.
+	ListObjectsWith(ctx context.Context, options ...ListObjectsOption) (result *ObjectListResult, response *core.DetailedResponse, err error)
+
+	// NewCreateObjectOptionsWith returns CreateObjectOptions populated by applying "options" in order.
+	NewCreateObjectOptionsWith(options ...CreateObjectOption) *CreateObjectOptions
+
+	// CreateObjectWith is an alternate form of the CreateObjectWithContext method which takes functional options
+	// instead of a CreateObjectOptions struct.
+	CreateObjectWith(ctx context.Context, options ...CreateObjectOption) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// NewGetObjectOptionsWith returns GetObjectOptions populated by applying "options" in order.
+	NewGetObjectOptionsWith(options ...GetObjectOption) *GetObjectOptions
+
+	// GetObjectWith is an alternate form of the GetObjectWithContext method which takes functional options instead of
+	// a GetObjectOptions struct.
+	GetObjectWith(ctx context.Context, options ...GetObjectOption) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// NewReplaceObjectOptionsWith returns ReplaceObjectOptions populated by applying "options" in order.
+	NewReplaceObjectOptionsWith(options ...ReplaceObjectOption) *ReplaceObjectOptions
+
+	// ReplaceObjectWith is an alternate form of the ReplaceObjectWithContext method which takes functional options
+	// instead of a ReplaceObjectOptions struct.
+	ReplaceObjectWith(ctx context.Context, options ...ReplaceObjectOption) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// NewDeleteObjectOptionsWith returns DeleteObjectOptions populated by applying "options" in order.
+	NewDeleteObjectOptionsWith(options ...DeleteObjectOption) *DeleteObjectOptions
+
+	// DeleteObjectWith is an alternate form of the DeleteObjectWithContext method which takes functional options
+	// instead of a DeleteObjectOptions struct.
+	DeleteObjectWith(ctx context.Context, options ...DeleteObjectOption) (response *core.DetailedResponse, err error)
+
+	// NewListObjectAuditsOptionsWith returns ListObjectAuditsOptions populated by applying "options" in order.
+	NewListObjectAuditsOptionsWith(options ...ListObjectAuditsOption) *ListObjectAuditsOptions
+
+	// ListObjectAuditsWith is an alternate form of the ListObjectAuditsWithContext method which takes functional
+	// options instead of a ListObjectAuditsOptions struct.
+	ListObjectAuditsWith(ctx context.Context, options ...ListObjectAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// NewGetObjectAuditOptionsWith returns GetObjectAuditOptions populated by applying "options" in order.
+	NewGetObjectAuditOptionsWith(options ...GetObjectAuditOption) *GetObjectAuditOptions
+
+	// GetObjectAuditWith is an alternate form of the GetObjectAuditWithContext method which takes functional options
+	// instead of a GetObjectAuditOptions struct.
+	GetObjectAuditWith(ctx context.Context, options ...GetObjectAuditOption) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// NewConsumableShareObjectOptionsWith returns ConsumableShareObjectOptions populated by applying "options" in
+	// order.
+	NewConsumableShareObjectOptionsWith(options ...ConsumableShareObjectOption) *ConsumableShareObjectOptions
+
+	// ConsumableShareObjectWith is an alternate form of the ConsumableShareObjectWithContext method which takes
+	// functional options instead of a ConsumableShareObjectOptions struct.
+	ConsumableShareObjectWith(ctx context.Context, options ...ConsumableShareObjectOption) (response *core.DetailedResponse, err error)
+
+	// NewShareObjectOptionsWith returns ShareObjectOptions populated by applying "options" in order.
+	NewShareObjectOptionsWith(options ...ShareObjectOption) *ShareObjectOptions
+
+	// ShareObjectWith is an alternate form of the ShareObjectWithContext method which takes functional options instead
+	// of a ShareObjectOptions struct.
+	ShareObjectWith(ctx context.Context, options ...ShareObjectOption) (result *ShareSetting, response *core.DetailedResponse, err error)
+
+	// NewGetObjectAccessListOptionsWith returns GetObjectAccessListOptions populated by applying "options" in order.
+	NewGetObjectAccessListOptionsWith(options ...GetObjectAccessListOption) *GetObjectAccessListOptions
+
+	// GetObjectAccessListWith is an alternate form of the GetObjectAccessListWithContext method which takes functional
+	// options instead of a GetObjectAccessListOptions struct.
+	GetObjectAccessListWith(ctx context.Context, options ...GetObjectAccessListOption) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// NewGetObjectAccessOptionsWith returns GetObjectAccessOptions populated by applying "options" in order.
+	NewGetObjectAccessOptionsWith(options ...GetObjectAccessOption) *GetObjectAccessOptions
+
+	// GetObjectAccessWith is an alternate form of the GetObjectAccessWithContext method which takes functional options
+	// instead of a GetObjectAccessOptions struct.
+	GetObjectAccessWith(ctx context.Context, options ...GetObjectAccessOption) (result *Access, response *core.DetailedResponse, err error)
+
+	// NewCreateObjectAccessOptionsWith returns CreateObjectAccessOptions populated by applying "options" in order.
+	NewCreateObjectAccessOptionsWith(options ...CreateObjectAccessOption) *CreateObjectAccessOptions
+
+	// CreateObjectAccessWith is an alternate form of the CreateObjectAccessWithContext method which takes functional
+	// options instead of a CreateObjectAccessOptions struct.
+	CreateObjectAccessWith(ctx context.Context, options ...CreateObjectAccessOption) (response *core.DetailedResponse, err error)
+
+	// NewDeleteObjectAccessOptionsWith returns DeleteObjectAccessOptions populated by applying "options" in order.
+	NewDeleteObjectAccessOptionsWith(options ...DeleteObjectAccessOption) *DeleteObjectAccessOptions
+
+	// DeleteObjectAccessWith is an alternate form of the DeleteObjectAccessWithContext method which takes functional
+	// options instead of a DeleteObjectAccessOptions struct.
+	DeleteObjectAccessWith(ctx context.Context, options ...DeleteObjectAccessOption) (response *core.DetailedResponse, err error)
+
+	// NewGetObjectAccessListDeprecatedOptionsWith returns GetObjectAccessListDeprecatedOptions populated by applying
+	// "options" in order.
+	NewGetObjectAccessListDeprecatedOptionsWith(options ...GetObjectAccessListDeprecatedOption) *GetObjectAccessListDeprecatedOptions
+
+	// GetObjectAccessListDeprecatedWith is an alternate form of the GetObjectAccessListDeprecatedWithContext method
+	// which takes functional options instead of a GetObjectAccessListDeprecatedOptions struct.
+	GetObjectAccessListDeprecatedWith(ctx context.Context, options ...GetObjectAccessListDeprecatedOption) (result *ObjectAccessListResult, response *core.DetailedResponse, err error)
+
+	// NewDeleteObjectAccessListOptionsWith returns DeleteObjectAccessListOptions populated by applying "options" in
+	// order.
+	NewDeleteObjectAccessListOptionsWith(options ...DeleteObjectAccessListOption) *DeleteObjectAccessListOptions
+
+	// DeleteObjectAccessListWith is an alternate form of the DeleteObjectAccessListWithContext method which takes
+	// functional options instead of a DeleteObjectAccessListOptions struct.
+	DeleteObjectAccessListWith(ctx context.Context, options ...DeleteObjectAccessListOption) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// NewAddObjectAccessListOptionsWith returns AddObjectAccessListOptions populated by applying "options" in order.
+	NewAddObjectAccessListOptionsWith(options ...AddObjectAccessListOption) *AddObjectAccessListOptions
+
+	// AddObjectAccessListWith is an alternate form of the AddObjectAccessListWithContext method which takes functional
+	// options instead of an AddObjectAccessListOptions struct.
+	AddObjectAccessListWith(ctx context.Context, options ...AddObjectAccessListOption) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// NewAccountPublishObjectOptionsWith returns AccountPublishObjectOptions populated by applying "options" in order.
+	NewAccountPublishObjectOptionsWith(options ...AccountPublishObjectOption) *AccountPublishObjectOptions
+
+	// AccountPublishObjectWith is an alternate form of the AccountPublishObjectWithContext method which takes
+	// functional options instead of an AccountPublishObjectOptions struct.
+	AccountPublishObjectWith(ctx context.Context, options ...AccountPublishObjectOption) (response *core.DetailedResponse, err error)
+
+	// NewSharedPublishObjectOptionsWith returns SharedPublishObjectOptions populated by applying "options" in order.
+	NewSharedPublishObjectOptionsWith(options ...SharedPublishObjectOption) *SharedPublishObjectOptions
+
+	// SharedPublishObjectWith is an alternate form of the SharedPublishObjectWithContext method which takes functional
+	// options instead of a SharedPublishObjectOptions struct.
+	SharedPublishObjectWith(ctx context.Context, options ...SharedPublishObjectOption) (response *core.DetailedResponse, err error)
+
+	// NewIBMPublishObjectOptionsWith returns IBMPublishObjectOptions populated by applying "options" in order.
+	NewIBMPublishObjectOptionsWith(options ...IBMPublishObjectOption) *IBMPublishObjectOptions
+
+	// IBMPublishObjectWith is an alternate form of the IBMPublishObjectWithContext method which takes functional
+	// options instead of an IBMPublishObjectOptions struct.
+	IBMPublishObjectWith(ctx context.Context, options ...IBMPublishObjectOption) (response *core.DetailedResponse, err error)
+
+	// NewPublicPublishObjectOptionsWith returns PublicPublishObjectOptions populated by applying "options" in order.
+	NewPublicPublishObjectOptionsWith(options ...PublicPublishObjectOption) *PublicPublishObjectOptions
+
+	// PublicPublishObjectWith is an alternate form of the PublicPublishObjectWithContext method which takes functional
+	// options instead of a PublicPublishObjectOptions struct.
+	PublicPublishObjectWith(ctx context.Context, options ...PublicPublishObjectOption) (response *core.DetailedResponse, err error)
+
+	// NewCreateOfferingInstanceOptionsWith returns CreateOfferingInstanceOptions populated by applying "options" in
+	// order.
+	NewCreateOfferingInstanceOptionsWith(options ...CreateOfferingInstanceOption) *CreateOfferingInstanceOptions
+
+	// CreateOfferingInstanceWith is an alternate form of the CreateOfferingInstanceWithContext method which takes
+	// functional options instead of a CreateOfferingInstanceOptions struct.
+	CreateOfferingInstanceWith(ctx context.Context, options ...CreateOfferingInstanceOption) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingInstanceOptionsWith returns GetOfferingInstanceOptions populated by applying "options" in order.
+	NewGetOfferingInstanceOptionsWith(options ...GetOfferingInstanceOption) *GetOfferingInstanceOptions
+
+	// GetOfferingInstanceWith is an alternate form of the GetOfferingInstanceWithContext method which takes functional
+	// options instead of a GetOfferingInstanceOptions struct.
+	GetOfferingInstanceWith(ctx context.Context, options ...GetOfferingInstanceOption) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// NewPutOfferingInstanceOptionsWith returns PutOfferingInstanceOptions populated by applying "options" in order.
+	NewPutOfferingInstanceOptionsWith(options ...PutOfferingInstanceOption) *PutOfferingInstanceOptions
+
+	// PutOfferingInstanceWith is an alternate form of the PutOfferingInstanceWithContext method which takes functional
+	// options instead of a PutOfferingInstanceOptions struct.
+	PutOfferingInstanceWith(ctx context.Context, options ...PutOfferingInstanceOption) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// NewDeleteOfferingInstanceOptionsWith returns DeleteOfferingInstanceOptions populated by applying "options" in
+	// order.
+	NewDeleteOfferingInstanceOptionsWith(options ...DeleteOfferingInstanceOption) *DeleteOfferingInstanceOptions
+
+	// DeleteOfferingInstanceWith is an alternate form of the DeleteOfferingInstanceWithContext method which takes
+	// functional options instead of a DeleteOfferingInstanceOptions struct.
+	DeleteOfferingInstanceWith(ctx context.Context, options ...DeleteOfferingInstanceOption) (response *core.DetailedResponse, err error)
+
+	// NewListOfferingInstanceAuditsOptionsWith returns ListOfferingInstanceAuditsOptions populated by applying
+	// "options" in order.
+	NewListOfferingInstanceAuditsOptionsWith(options ...ListOfferingInstanceAuditsOption) *ListOfferingInstanceAuditsOptions
+
+	// ListOfferingInstanceAuditsWith is an alternate form of the ListOfferingInstanceAuditsWithContext method which
+	// takes functional options instead of a ListOfferingInstanceAuditsOptions struct.
+	ListOfferingInstanceAuditsWith(ctx context.Context, options ...ListOfferingInstanceAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// NewGetOfferingInstanceAuditOptionsWith returns GetOfferingInstanceAuditOptions populated by applying "options"
+	// in order.
+	NewGetOfferingInstanceAuditOptionsWith(options ...GetOfferingInstanceAuditOption) *GetOfferingInstanceAuditOptions
+
+	// GetOfferingInstanceAuditWith is an alternate form of the GetOfferingInstanceAuditWithContext method which takes
+	// functional options instead of a GetOfferingInstanceAuditOptions struct.
+	GetOfferingInstanceAuditWith(ctx context.Context, options ...GetOfferingInstanceAuditOption) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// SyncCatalogObjects copies the objects of a catalog selected by "filter" (which may be nil) to another catalog,
+	// for example from a staging catalog to a production one.
+	SyncCatalogObjects(ctx context.Context, sourceCatalogID string, targetCatalogID string, filter *CatalogObjectFilter, dryRun bool, concurrency int) (report *CatalogObjectSyncReport, err error)
+
+	// ListAvailableUpgrades retrieves an offering instance and the updates that the catalog offers for its installed
+	// version and target, and returns the newer versions ordered as an upgrade path.
+	ListAvailableUpgrades(ctx context.Context, offeringInstanceID string, xAuthRefreshToken string) (result *AvailableUpgrades, err error)
+}
+
+var _ CatalogManagementV1API = (*CatalogManagementV1)(nil)
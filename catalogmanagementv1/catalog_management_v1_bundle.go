@@ -0,0 +1,375 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// OfferingBundleFormatVersion is the version of the archive format written by ExportOfferingBundle.
+const OfferingBundleFormatVersion = 1
+
+// Names of the files in an offering bundle.
+const (
+	offeringBundleManifestFile = "bundle.json"
+	offeringBundleContentFile  = "content.tgz"
+	offeringBundleAboutFile    = "about.md"
+	offeringBundleImagesFile   = "images.json"
+	offeringBundleLicensesDir  = "licenses/"
+)
+
+// OfferingBundleManifest : The description of an offering version stored in an offering bundle.
+type OfferingBundleManifest struct {
+	// The version of the format of the bundle.
+	FormatVersion int `json:"format_version"`
+
+	// The version locator of the exported version.
+	VersionLocator string `json:"version_locator"`
+
+	// When the bundle was exported.
+	ExportedAt time.Time `json:"exported_at"`
+
+	// The offering, with only the kind and the version that were exported.
+	Offering *Offering `json:"offering"`
+
+	// The IDs of the licenses whose content is included in the bundle.
+	Licenses []string `json:"licenses,omitempty"`
+}
+
+// Version returns the exported version.
+func (manifest *OfferingBundleManifest) Version() *Version {
+	kind := manifest.Kind()
+	if kind == nil || len(kind.Versions) == 0 {
+		return nil
+	}
+	return &kind.Versions[0]
+}
+
+// Kind returns the kind of the exported version.
+func (manifest *OfferingBundleManifest) Kind() *Kind {
+	if manifest.Offering == nil || len(manifest.Offering.Kinds) == 0 {
+		return nil
+	}
+	return &manifest.Offering.Kinds[0]
+}
+
+// ExportOfferingBundle writes to "w" a gzip-compressed tar archive holding the offering version with the specified
+// version locator, so that it can be restored with ImportOfferingBundle into a catalog that cannot reach this one.
+// The archive contains the offering and version metadata, the package of the version (downloaded from its tgz URL),
+// its readme, the content of its licenses and its container image manifest.
+// The package is downloaded with the HTTP client of the service; the service's authenticator is only used if the
+// package is hosted by the service itself.
+func (catalogManagement *CatalogManagementV1) ExportOfferingBundle(ctx context.Context, versionLocator string, w io.Writer) (manifest *OfferingBundleManifest, err error) {
+	offering, _, err := catalogManagement.GetVersionWithContext(ctx, catalogManagement.NewGetVersionOptions(versionLocator))
+	if err != nil {
+		err = fmt.Errorf("error retrieving version '%s': %w", versionLocator, err)
+		return
+	}
+	kind, version := findOfferingVersion(offering, versionLocator)
+	if version == nil {
+		err = fmt.Errorf("version '%s' was not found in offering '%s'", versionLocator, core.StringNilMapper(offering.ID))
+		return
+	}
+	if version.TgzURL == nil || *version.TgzURL == "" {
+		err = fmt.Errorf("version '%s' has no package to export", versionLocator)
+		return
+	}
+
+	exported := *offering
+	exportedKind := *kind
+	exportedKind.Versions = []Version{*version}
+	exported.Kinds = []Kind{exportedKind}
+	manifest = &OfferingBundleManifest{
+		FormatVersion:  OfferingBundleFormatVersion,
+		VersionLocator: versionLocator,
+		ExportedAt:     time.Now().UTC(),
+		Offering:       &exported,
+	}
+
+	content, err := catalogManagement.downloadPackage(ctx, *version.TgzURL)
+	if err != nil {
+		return
+	}
+	about, found, err := optionalString(catalogManagement.GetOfferingAboutWithContext(ctx, catalogManagement.NewGetOfferingAboutOptions(versionLocator)))
+	if err != nil {
+		err = fmt.Errorf("error retrieving the readme of version '%s': %w", versionLocator, err)
+		return
+	}
+	files := map[string][]byte{offeringBundleContentFile: content}
+	if found {
+		files[offeringBundleAboutFile] = []byte(about)
+	}
+	for _, license := range version.Licenses {
+		if license.ID == nil {
+			continue
+		}
+		var text string
+		text, found, err = optionalString(catalogManagement.GetOfferingLicenseWithContext(ctx, catalogManagement.NewGetOfferingLicenseOptions(versionLocator, *license.ID)))
+		if err != nil {
+			err = fmt.Errorf("error retrieving license '%s' of version '%s': %w", *license.ID, versionLocator, err)
+			return
+		}
+		if found {
+			files[offeringBundleLicensesDir+url.PathEscape(*license.ID)] = []byte(text)
+			manifest.Licenses = append(manifest.Licenses, *license.ID)
+		}
+	}
+	images, response, err := catalogManagement.GetOfferingContainerImagesWithContext(ctx, catalogManagement.NewGetOfferingContainerImagesOptions(versionLocator))
+	if err != nil {
+		if response == nil || response.StatusCode != http.StatusNotFound {
+			err = fmt.Errorf("error retrieving the container images of version '%s': %w", versionLocator, err)
+			return
+		}
+		err = nil
+	} else if images != nil {
+		files[offeringBundleImagesFile], err = json.MarshalIndent(images, "", "  ")
+		if err != nil {
+			return
+		}
+	}
+	files[offeringBundleManifestFile], err = json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return
+	}
+
+	err = writeOfferingBundle(w, files, manifest.ExportedAt)
+	if err != nil {
+		err = fmt.Errorf("error writing the bundle of version '%s': %w", versionLocator, err)
+	}
+	return
+}
+
+// ImportOfferingBundle restores an offering version from an archive written by ExportOfferingBundle into the catalog
+// with the specified ID. The version is added to the offering with the same name if the catalog has one; otherwise a
+// new offering is created. The version is imported from the package in the archive, so the catalog does not need to
+// reach the original location of the package. The returned offering is the one to which the version was added.
+func (catalogManagement *CatalogManagementV1) ImportOfferingBundle(ctx context.Context, catalogID string, r io.Reader) (result *Offering, err error) {
+	files, err := readOfferingBundle(r)
+	if err != nil {
+		err = fmt.Errorf("error reading the bundle: %w", err)
+		return
+	}
+	manifest := &OfferingBundleManifest{}
+	err = json.Unmarshal(files[offeringBundleManifestFile], manifest)
+	if err != nil {
+		err = fmt.Errorf("error reading the manifest of the bundle: %w", err)
+		return
+	}
+	if manifest.FormatVersion != OfferingBundleFormatVersion {
+		err = fmt.Errorf("unsupported bundle format version %d", manifest.FormatVersion)
+		return
+	}
+	kind, version := manifest.Kind(), manifest.Version()
+	content, found := files[offeringBundleContentFile]
+	if version == nil || !found {
+		err = fmt.Errorf("the bundle of version '%s' does not contain a version and its package", manifest.VersionLocator)
+		return
+	}
+	name := core.StringNilMapper(manifest.Offering.Name)
+
+	var existing *Offering
+	if name != "" {
+		listOptions := catalogManagement.NewListOfferingsOptions(catalogID)
+		listOptions.Name = core.StringPtr(name)
+		var offerings *OfferingSearchResult
+		offerings, _, err = catalogManagement.ListOfferingsWithContext(ctx, listOptions)
+		if err != nil {
+			err = fmt.Errorf("error listing the offerings of catalog '%s': %w", catalogID, err)
+			return
+		}
+		for i := range offerings.Resources {
+			if core.StringNilMapper(offerings.Resources[i].Name) == name {
+				existing = &offerings.Resources[i]
+				break
+			}
+		}
+	}
+
+	var targetKinds []string
+	if kind.TargetKind != nil {
+		targetKinds = []string{*kind.TargetKind}
+	}
+	if existing != nil {
+		options := catalogManagement.NewImportOfferingVersionOptions(catalogID, core.StringNilMapper(existing.ID))
+		options.Content = &content
+		options.Tags = version.Tags
+		options.TargetKinds = targetKinds
+		options.FormatKind = kind.FormatKind
+		options.InstallKind = kind.InstallKind
+		options.ProductKind = manifest.Offering.ProductKind
+		options.Version = version.Version
+		options.Flavor = version.Flavor
+		result, _, err = catalogManagement.ImportOfferingVersionWithContext(ctx, options)
+	} else {
+		options := catalogManagement.NewImportOfferingOptions(catalogID)
+		options.Content = &content
+		options.Name = manifest.Offering.Name
+		options.Label = manifest.Offering.Label
+		options.Tags = manifest.Offering.Tags
+		options.TargetKinds = targetKinds
+		options.FormatKind = kind.FormatKind
+		options.InstallKind = kind.InstallKind
+		options.ProductKind = manifest.Offering.ProductKind
+		options.Version = version.Version
+		options.Flavor = version.Flavor
+		result, _, err = catalogManagement.ImportOfferingWithContext(ctx, options)
+	}
+	if err != nil {
+		err = fmt.Errorf("error importing version '%s' into catalog '%s': %w", manifest.VersionLocator, catalogID, err)
+	}
+	return
+}
+
+// findOfferingVersion returns the kind and the version of an offering with the specified version locator.
+func findOfferingVersion(offering *Offering, versionLocator string) (*Kind, *Version) {
+	for i := range offering.Kinds {
+		kind := &offering.Kinds[i]
+		for j := range kind.Versions {
+			if core.StringNilMapper(kind.Versions[j].VersionLocator) == versionLocator {
+				return kind, &kind.Versions[j]
+			}
+		}
+	}
+	return nil, nil
+}
+
+// optionalString returns the text returned by an operation; "found" is false if the operation returned a 404 status.
+func optionalString(result *string, response *core.DetailedResponse, err error) (text string, found bool, resultErr error) {
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return core.StringNilMapper(result), true, nil
+}
+
+// downloadPackage returns the content of the package at "packageURL".
+func (catalogManagement *CatalogManagementV1) downloadPackage(ctx context.Context, packageURL string) (content []byte, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, packageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the request for package '%s': %w", packageURL, err)
+	}
+	serviceURL, parseErr := url.Parse(catalogManagement.Service.GetServiceURL())
+	if parseErr == nil && strings.EqualFold(serviceURL.Host, req.URL.Host) {
+		err = catalogManagement.Service.Options.Authenticator.Authenticate(req)
+		if err != nil {
+			return nil, fmt.Errorf("error authenticating the request for package '%s': %w", packageURL, err)
+		}
+	}
+	client := catalogManagement.Service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	response, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading package '%s': %w", packageURL, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, fmt.Errorf("error downloading package '%s': %s", packageURL, response.Status)
+	}
+	content, err = ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading package '%s': %w", packageURL, err)
+	}
+	return
+}
+
+// writeOfferingBundle writes "files" to a gzip-compressed tar archive, with the manifest first.
+func writeOfferingBundle(w io.Writer, files map[string][]byte, modTime time.Time) (err error) {
+	names := []string{offeringBundleManifestFile, offeringBundleContentFile, offeringBundleAboutFile, offeringBundleImagesFile}
+	for name := range files {
+		if strings.HasPrefix(name, offeringBundleLicensesDir) {
+			names = append(names, name)
+		}
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, name := range names {
+		data, found := files[name]
+		if !found {
+			continue
+		}
+		err = tarWriter.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(len(data)),
+			ModTime: modTime,
+		})
+		if err != nil {
+			return
+		}
+		_, err = tarWriter.Write(data)
+		if err != nil {
+			return
+		}
+	}
+	err = tarWriter.Close()
+	if err != nil {
+		return
+	}
+	return gzipWriter.Close()
+}
+
+// readOfferingBundle returns the files of a gzip-compressed tar archive by name.
+func readOfferingBundle(r io.Reader) (files map[string][]byte, err error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return
+	}
+	defer gzipReader.Close()
+
+	files = make(map[string][]byte)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, nextErr := tarReader.Next()
+		if nextErr == io.EOF {
+			break
+		}
+		if nextErr != nil {
+			return nil, nextErr
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		var data []byte
+		data, err = ioutil.ReadAll(tarReader)
+		if err != nil {
+			return nil, err
+		}
+		files[path.Clean(header.Name)] = data
+	}
+	if _, found := files[offeringBundleManifestFile]; !found {
+		return nil, fmt.Errorf("the archive has no %s file", offeringBundleManifestFile)
+	}
+	return
+}
@@ -0,0 +1,130 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CatalogManagementV1 offering bundles`, func() {
+	const packageContent = "package-bytes"
+	var testServer *httptest.Server
+	var catalogManagementService *catalogmanagementv1.CatalogManagementV1
+	var existingOfferings string
+	var imports []map[string]interface{}
+	var importPaths []string
+	BeforeEach(func() {
+		existingOfferings = `[]`
+		imports = nil
+		importPaths = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /versions/cat.ver", "GET /versions/cat.old":
+				fmt.Fprintf(res, `{"id": "offering", "name": "my-offering", "label": "My offering", "tags": ["dev"],
+					"kinds": [{"format_kind": "terraform", "install_kind": "instance", "target_kind": "terraform", "versions": [
+						{"version": "1.0.0", "version_locator": "cat.old"},
+						{"version": "1.1.0", "version_locator": "cat.ver", "tgz_url": "%s/package.tgz",
+							"licenses": [{"id": "apache"}, {"id": "gone"}]}]}]}`, testServer.URL)
+			case "GET /package.tgz":
+				res.Header().Set("Content-type", "application/octet-stream")
+				fmt.Fprint(res, packageContent)
+			case "GET /versions/cat.ver/about":
+				res.Header().Set("Content-type", "text/markdown")
+				fmt.Fprint(res, `"# Readme"`)
+			case "GET /versions/cat.ver/licenses/apache":
+				res.Header().Set("Content-type", "text/plain")
+				fmt.Fprint(res, `"Apache License"`)
+			case "GET /versions/cat.ver/licenses/gone", "GET /versions/cat.ver/containerImages":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			case "GET /catalogs/target/offerings":
+				Expect(req.URL.Query().Get("name")).To(Equal("my-offering"))
+				fmt.Fprintf(res, `{"offset": 0, "limit": 100, "resources": %s}`, existingOfferings)
+			case "POST /catalogs/target/import/offerings", "POST /catalogs/target/offerings/existing/version":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				imports = append(imports, body)
+				importPaths = append(importPaths, req.URL.EscapedPath())
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "imported"}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		catalogManagementService, serviceErr = catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	export := func() *bytes.Buffer {
+		var bundle bytes.Buffer
+		manifest, err := catalogManagementService.ExportOfferingBundle(context.Background(), "cat.ver", &bundle)
+		Expect(err).To(BeNil())
+		Expect(manifest.Licenses).To(Equal([]string{"apache"}))
+		Expect(*manifest.Version().Version).To(Equal("1.1.0"))
+		Expect(manifest.Offering.Kinds).To(HaveLen(1))
+		return &bundle
+	}
+
+	It(`Imports an exported version as a new offering`, func() {
+		offering, err := catalogManagementService.ImportOfferingBundle(context.Background(), "target", export())
+		Expect(err).To(BeNil())
+		Expect(*offering.ID).To(Equal("imported"))
+
+		Expect(importPaths).To(Equal([]string{"/catalogs/target/import/offerings"}))
+		Expect(imports[0]["content"]).To(Equal(base64.StdEncoding.EncodeToString([]byte(packageContent))))
+		Expect(imports[0]["name"]).To(Equal("my-offering"))
+		Expect(imports[0]["label"]).To(Equal("My offering"))
+		Expect(imports[0]["version"]).To(Equal("1.1.0"))
+		Expect(imports[0]["target_kinds"]).To(Equal([]interface{}{"terraform"}))
+		Expect(imports[0]).ToNot(HaveKey("zipurl"))
+	})
+	It(`Adds the version to an existing offering`, func() {
+		existingOfferings = `[{"id": "other", "name": "my-offering-2"}, {"id": "existing", "name": "my-offering"}]`
+		_, err := catalogManagementService.ImportOfferingBundle(context.Background(), "target", export())
+		Expect(err).To(BeNil())
+		Expect(importPaths).To(Equal([]string{"/catalogs/target/offerings/existing/version"}))
+		Expect(imports[0]["version"]).To(Equal("1.1.0"))
+	})
+	It(`Rejects invalid bundles`, func() {
+		_, err := catalogManagementService.ImportOfferingBundle(context.Background(), "target", bytes.NewBufferString("not a bundle"))
+		Expect(err).ToNot(BeNil())
+		var bundle bytes.Buffer
+		_, err = catalogManagementService.ExportOfferingBundle(context.Background(), "cat.old", &bundle)
+		Expect(err).ToNot(BeNil())
+	})
+})
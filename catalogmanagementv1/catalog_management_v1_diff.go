@@ -0,0 +1,371 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the VersionChange.Section property.
+const (
+	VersionChangeSectionConfigurationConst = "configuration"
+	VersionChangeSectionLicensesConst      = "licenses"
+	VersionChangeSectionKindConst          = "kind"
+)
+
+// Constants associated with the VersionChange.Type property.
+const (
+	VersionChangeTypeAddedConst    = "added"
+	VersionChangeTypeRemovedConst  = "removed"
+	VersionChangeTypeModifiedConst = "modified"
+)
+
+// VersionDiff : The changes between two offering versions, as returned by DiffOfferingVersions.
+type VersionDiff struct {
+	// The locators of the versions that are compared.
+	FromLocator string
+	ToLocator   string
+
+	// The semantic versions of the versions that are compared.
+	FromVersion string
+	ToVersion   string
+
+	// The changes from the first version to the second one, ordered by section (configuration, licenses, kind), then
+	// by name and attribute.
+	Changes []VersionChange
+}
+
+// VersionChange : One change between two offering versions.
+type VersionChange struct {
+	// The part of the version that changed (a VersionChangeSection*Const value).
+	Section string
+
+	// The type of the change (a VersionChangeType*Const value).
+	Type string
+
+	// The configuration key, the license ID (or name, if it has no ID) or the kind property that changed.
+	Name string
+
+	// For a modification of a configuration item or a license, the JSON name of the attribute that changed.
+	Attribute string
+
+	// The value before and after the change, rendered as text. From is empty for an addition and To is empty for a
+	// removal; a configuration item or license that is added or removed is rendered as JSON.
+	From string
+	To   string
+
+	// It is true if the change can break the deployments of the first version that are upgraded to the second one:
+	// a configuration item that is removed, that becomes required, that is added as required without a default
+	// value, or whose type changes, and any change of the kind.
+	Breaking bool
+}
+
+// String returns a one-line description of the change, suitable for a changelog.
+func (change VersionChange) String() string {
+	subject := fmt.Sprintf("%s '%s'", change.Section, change.Name)
+	if change.Attribute != "" {
+		subject += " " + change.Attribute
+	}
+	description := ""
+	switch change.Type {
+	case VersionChangeTypeAddedConst:
+		description = fmt.Sprintf("%s added", subject)
+	case VersionChangeTypeRemovedConst:
+		description = fmt.Sprintf("%s removed", subject)
+	default:
+		description = fmt.Sprintf("%s changed from %s to %s", subject, change.From, change.To)
+	}
+	if change.Breaking {
+		description += " (breaking)"
+	}
+	return description
+}
+
+// HasChanges returns true if the versions differ in the compared sections.
+func (diff *VersionDiff) HasChanges() bool {
+	return len(diff.Changes) > 0
+}
+
+// Breaking returns the changes that can break upgraded deployments.
+func (diff *VersionDiff) Breaking() (breaking []VersionChange) {
+	for _, change := range diff.Changes {
+		if change.Breaking {
+			breaking = append(breaking, change)
+		}
+	}
+	return
+}
+
+// Section returns the changes of the specified section (a VersionChangeSection*Const value).
+func (diff *VersionDiff) Section(section string) (changes []VersionChange) {
+	for _, change := range diff.Changes {
+		if change.Section == section {
+			changes = append(changes, change)
+		}
+	}
+	return
+}
+
+// DiffOfferingVersions retrieves two offering versions and compares their configuration items, licenses and kinds.
+// The versions are typically consecutive versions of the same offering, compared to review a release; the changes
+// are reported from "fromVersionLocator" to "toVersionLocator".
+func (catalogManagement *CatalogManagementV1) DiffOfferingVersions(ctx context.Context, fromVersionLocator string, toVersionLocator string) (diff *VersionDiff, err error) {
+	fromKind, from, err := catalogManagement.getOfferingVersion(ctx, fromVersionLocator)
+	if err != nil {
+		return
+	}
+	toKind, to, err := catalogManagement.getOfferingVersion(ctx, toVersionLocator)
+	if err != nil {
+		return
+	}
+
+	diff = &VersionDiff{
+		FromLocator: fromVersionLocator,
+		ToLocator:   toVersionLocator,
+		FromVersion: core.StringNilMapper(from.Version),
+		ToVersion:   core.StringNilMapper(to.Version),
+	}
+	diff.Changes = append(diff.Changes, diffConfiguration(from.Configuration, to.Configuration)...)
+	diff.Changes = append(diff.Changes, diffLicenses(from.Licenses, to.Licenses)...)
+	diff.Changes = append(diff.Changes, diffKinds(fromKind, toKind)...)
+	return
+}
+
+// getOfferingVersion retrieves a version and the kind that contains it.
+func (catalogManagement *CatalogManagementV1) getOfferingVersion(ctx context.Context, versionLocator string) (kind *Kind, version *Version, err error) {
+	offering, _, err := catalogManagement.GetVersionWithContext(ctx, catalogManagement.NewGetVersionOptions(versionLocator))
+	if err != nil {
+		err = fmt.Errorf("error retrieving version '%s': %w", versionLocator, err)
+		return
+	}
+	kind, version = findOfferingVersion(offering, versionLocator)
+	if version == nil {
+		err = fmt.Errorf("the offering returned for version '%s' does not contain the version", versionLocator)
+	}
+	return
+}
+
+// diffConfiguration compares configuration items by key.
+func diffConfiguration(from []Configuration, to []Configuration) (changes []VersionChange) {
+	fromItems := make(map[string]*Configuration)
+	for i := range from {
+		fromItems[core.StringNilMapper(from[i].Key)] = &from[i]
+	}
+	toItems := make(map[string]*Configuration)
+	for i := range to {
+		toItems[core.StringNilMapper(to[i].Key)] = &to[i]
+	}
+
+	for key, item := range fromItems {
+		if _, found := toItems[key]; !found {
+			changes = append(changes, VersionChange{
+				Section:  VersionChangeSectionConfigurationConst,
+				Type:     VersionChangeTypeRemovedConst,
+				Name:     key,
+				From:     renderValue(item),
+				Breaking: true,
+			})
+		}
+	}
+	for key, toItem := range toItems {
+		fromItem, found := fromItems[key]
+		if !found {
+			changes = append(changes, VersionChange{
+				Section:  VersionChangeSectionConfigurationConst,
+				Type:     VersionChangeTypeAddedConst,
+				Name:     key,
+				To:       renderValue(toItem),
+				Breaking: isTrue(toItem.Required) && toItem.DefaultValue == nil,
+			})
+			continue
+		}
+		attributes := []struct {
+			name     string
+			from     interface{}
+			to       interface{}
+			breaking bool
+		}{
+			{"type", fromItem.Type, toItem.Type, true},
+			{"default_value", fromItem.DefaultValue, toItem.DefaultValue, false},
+			{"required", isTrue(fromItem.Required), isTrue(toItem.Required), isTrue(toItem.Required)},
+			{"value_constraint", fromItem.ValueConstraint, toItem.ValueConstraint, false},
+			{"options", fromItem.Options, toItem.Options, false},
+			{"hidden", isTrue(fromItem.Hidden), isTrue(toItem.Hidden), false},
+			{"display_name", fromItem.DisplayName, toItem.DisplayName, false},
+			{"description", fromItem.Description, toItem.Description, false},
+			{"type_metadata", fromItem.TypeMetadata, toItem.TypeMetadata, false},
+			{"custom_config", fromItem.CustomConfig, toItem.CustomConfig, false},
+		}
+		for _, attribute := range attributes {
+			fromValue, toValue := renderValue(attribute.from), renderValue(attribute.to)
+			if fromValue != toValue {
+				changes = append(changes, VersionChange{
+					Section:   VersionChangeSectionConfigurationConst,
+					Type:      VersionChangeTypeModifiedConst,
+					Name:      key,
+					Attribute: attribute.name,
+					From:      fromValue,
+					To:        toValue,
+					Breaking:  attribute.breaking,
+				})
+			}
+		}
+	}
+	sortVersionChanges(changes)
+	return
+}
+
+// diffLicenses compares licenses by ID, or by name if they have no ID.
+func diffLicenses(from []License, to []License) (changes []VersionChange) {
+	licenseName := func(license *License) string {
+		if id := core.StringNilMapper(license.ID); id != "" {
+			return id
+		}
+		return core.StringNilMapper(license.Name)
+	}
+	fromLicenses := make(map[string]*License)
+	for i := range from {
+		fromLicenses[licenseName(&from[i])] = &from[i]
+	}
+	toLicenses := make(map[string]*License)
+	for i := range to {
+		toLicenses[licenseName(&to[i])] = &to[i]
+	}
+
+	for name, license := range fromLicenses {
+		if _, found := toLicenses[name]; !found {
+			changes = append(changes, VersionChange{
+				Section: VersionChangeSectionLicensesConst,
+				Type:    VersionChangeTypeRemovedConst,
+				Name:    name,
+				From:    renderValue(license),
+			})
+		}
+	}
+	for name, toLicense := range toLicenses {
+		fromLicense, found := fromLicenses[name]
+		if !found {
+			changes = append(changes, VersionChange{
+				Section: VersionChangeSectionLicensesConst,
+				Type:    VersionChangeTypeAddedConst,
+				Name:    name,
+				To:      renderValue(toLicense),
+			})
+			continue
+		}
+		attributes := []struct {
+			name string
+			from *string
+			to   *string
+		}{
+			{"name", fromLicense.Name, toLicense.Name},
+			{"type", fromLicense.Type, toLicense.Type},
+			{"url", fromLicense.URL, toLicense.URL},
+			{"description", fromLicense.Description, toLicense.Description},
+		}
+		for _, attribute := range attributes {
+			fromValue, toValue := core.StringNilMapper(attribute.from), core.StringNilMapper(attribute.to)
+			if fromValue != toValue {
+				changes = append(changes, VersionChange{
+					Section:   VersionChangeSectionLicensesConst,
+					Type:      VersionChangeTypeModifiedConst,
+					Name:      name,
+					Attribute: attribute.name,
+					From:      fromValue,
+					To:        toValue,
+				})
+			}
+		}
+	}
+	sortVersionChanges(changes)
+	return
+}
+
+// diffKinds compares the properties of the kinds that contain the versions.
+func diffKinds(from *Kind, to *Kind) (changes []VersionChange) {
+	if from == nil || to == nil {
+		return
+	}
+	properties := []struct {
+		name string
+		from *string
+		to   *string
+	}{
+		{"format_kind", from.FormatKind, to.FormatKind},
+		{"install_kind", from.InstallKind, to.InstallKind},
+		{"target_kind", from.TargetKind, to.TargetKind},
+	}
+	for _, property := range properties {
+		fromValue, toValue := core.StringNilMapper(property.from), core.StringNilMapper(property.to)
+		if fromValue == toValue {
+			continue
+		}
+		change := VersionChange{
+			Section:  VersionChangeSectionKindConst,
+			Type:     VersionChangeTypeModifiedConst,
+			Name:     property.name,
+			From:     fromValue,
+			To:       toValue,
+			Breaking: true,
+		}
+		if fromValue == "" {
+			change.Type = VersionChangeTypeAddedConst
+		} else if toValue == "" {
+			change.Type = VersionChangeTypeRemovedConst
+		}
+		changes = append(changes, change)
+	}
+	return
+}
+
+// sortVersionChanges orders the changes of a section by name, then by attribute.
+func sortVersionChanges(changes []VersionChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		return changes[i].Attribute < changes[j].Attribute
+	})
+}
+
+// renderValue renders a value of a version as text: strings as is, nil pointers as an empty string, and other values
+// as JSON.
+func renderValue(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case *string:
+		return core.StringNilMapper(value)
+	case string:
+		return value
+	}
+	b, err := json.Marshal(value)
+	if err != nil || string(b) == "null" {
+		return ""
+	}
+	return string(b)
+}
+
+// isTrue returns the value of an optional boolean, false if it is nil.
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
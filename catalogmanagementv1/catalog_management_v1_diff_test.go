@@ -0,0 +1,123 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CatalogManagementV1 version diff`, func() {
+	var testServer *httptest.Server
+	var catalogManagementService *catalogmanagementv1.CatalogManagementV1
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/versions/cat.v1", "/versions/cat.v2":
+				fmt.Fprint(res, `{"id": "offering", "kinds": [
+					{"format_kind": "terraform", "install_kind": "instance", "target_kind": "terraform", "versions": [
+						{"version": "1.0.0", "version_locator": "cat.v1",
+							"configuration": [
+								{"key": "region", "type": "string", "default_value": "us-south"},
+								{"key": "size", "type": "string", "default_value": "small", "required": false},
+								{"key": "legacy", "type": "boolean"}],
+							"licenses": [{"id": "apache", "name": "Apache 2.0"}, {"id": "mit", "name": "MIT"}]}]},
+					{"format_kind": "helm", "install_kind": "helm", "target_kind": "iks", "versions": [
+						{"version": "2.0.0", "version_locator": "cat.v2",
+							"configuration": [
+								{"key": "region", "type": "string", "default_value": "us-east"},
+								{"key": "size", "type": "number", "default_value": 2, "required": true},
+								{"key": "zone", "type": "string", "required": true},
+								{"key": "tags", "type": "array", "default_value": []}],
+							"licenses": [{"id": "apache", "name": "Apache License 2.0"}, {"name": "Custom"}]}]}]}`)
+			case "/versions/cat.missing":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			default:
+				Fail("unexpected request " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		catalogManagementService, serviceErr = catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Compares the configuration, licenses and kinds of two versions`, func() {
+		diff, err := catalogManagementService.DiffOfferingVersions(context.Background(), "cat.v1", "cat.v2")
+		Expect(err).To(BeNil())
+		Expect(diff.FromVersion).To(Equal("1.0.0"))
+		Expect(diff.ToVersion).To(Equal("2.0.0"))
+		Expect(diff.HasChanges()).To(BeTrue())
+
+		var descriptions []string
+		for _, change := range diff.Section(catalogmanagementv1.VersionChangeSectionConfigurationConst) {
+			descriptions = append(descriptions, change.String())
+		}
+		Expect(descriptions).To(Equal([]string{
+			"configuration 'legacy' removed (breaking)",
+			"configuration 'region' default_value changed from us-south to us-east",
+			"configuration 'size' default_value changed from small to 2",
+			"configuration 'size' required changed from false to true (breaking)",
+			"configuration 'size' type changed from string to number (breaking)",
+			"configuration 'tags' added",
+			"configuration 'zone' added (breaking)",
+		}))
+
+		licenses := diff.Section(catalogmanagementv1.VersionChangeSectionLicensesConst)
+		Expect(licenses).To(HaveLen(3))
+		Expect(licenses[0]).To(Equal(catalogmanagementv1.VersionChange{
+			Section: "licenses", Type: "added", Name: "Custom", To: `{"name":"Custom"}`,
+		}))
+		Expect(licenses[1].Attribute).To(Equal("name"))
+		Expect(licenses[1].To).To(Equal("Apache License 2.0"))
+		Expect(licenses[2].Type).To(Equal(catalogmanagementv1.VersionChangeTypeRemovedConst))
+		Expect(licenses[2].Name).To(Equal("mit"))
+
+		kinds := diff.Section(catalogmanagementv1.VersionChangeSectionKindConst)
+		Expect(kinds).To(HaveLen(3))
+		Expect(kinds[2].Name).To(Equal("target_kind"))
+		Expect(kinds[2].From).To(Equal("terraform"))
+		Expect(kinds[2].To).To(Equal("iks"))
+		Expect(diff.Breaking()).To(HaveLen(7))
+	})
+	It(`Reports no changes for the same version`, func() {
+		diff, err := catalogManagementService.DiffOfferingVersions(context.Background(), "cat.v1", "cat.v1")
+		Expect(err).To(BeNil())
+		Expect(diff.HasChanges()).To(BeFalse())
+	})
+	It(`Fails if a version cannot be retrieved`, func() {
+		_, err := catalogManagementService.DiffOfferingVersions(context.Background(), "cat.v1", "cat.missing")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("cat.missing"))
+	})
+})
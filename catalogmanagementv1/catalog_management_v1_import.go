@@ -0,0 +1,284 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// DefaultImportOfferingPollInterval is the interval at which ImportOfferingFromArchive polls the validation status of
+// the imported version when no interval is specified.
+const DefaultImportOfferingPollInterval = 10 * time.Second
+
+// Constants associated with the Validation.State property.
+const (
+	ValidationStateInProgressConst = "in_progress"
+	ValidationStateValidConst      = "valid"
+	ValidationStateInvalidConst    = "invalid"
+	ValidationStateExpiredConst    = "expired"
+)
+
+// Constants associated with the ImportOfferingFromArchiveOptions.Publish property.
+const (
+	VersionPublishAccountConst = "account"
+	VersionPublishIBMConst     = "ibm"
+	VersionPublishPublicConst  = "public"
+)
+
+// ErrVersionValidationFailed is returned (wrapped) by ImportOfferingFromArchive when the validation of the imported
+// version completes in a state other than ValidationStateValidConst.
+var ErrVersionValidationFailed = errors.New("version validation failed")
+
+// ImportOfferingFromArchiveOptions : Controls how ImportOfferingFromArchive imports, validates and publishes a
+// version. The zero value imports a new offering without validating or publishing it.
+type ImportOfferingFromArchiveOptions struct {
+	// The ID of the offering to which the version is added. If empty, a new offering is created.
+	OfferingID string
+
+	// The name and display name of a new offering. Required for virtual server images for VPC.
+	Name  *string
+	Label *string
+
+	// The tags of the offering or version.
+	Tags []string
+
+	// The properties of the version being imported; see ImportOfferingOptions. Properties that are nil are derived
+	// from the archive by the catalog.
+	TargetKinds      []string
+	FormatKind       *string
+	InstallKind      *string
+	ProductKind      *string
+	Version          *string
+	Flavor           *Flavor
+	WorkingDirectory *string
+	Repotype         *string
+
+	// The token used by the catalog to download an archive specified by URL, if it is not public.
+	XAuthToken *string
+
+	// If set, the imported version is validated with these options, whose VersionLocID is set to the locator of the
+	// imported version, and ImportOfferingFromArchive waits for the validation to complete. The XAuthRefreshToken of
+	// the options is required.
+	Validation *ValidateInstallOptions
+
+	// The interval at which the validation status is polled. Defaults to DefaultImportOfferingPollInterval.
+	PollInterval time.Duration
+
+	// The maximum time to wait for the validation to complete. If zero, ImportOfferingFromArchive waits until the
+	// context is done.
+	ValidationTimeout time.Duration
+
+	// If set, the version is published once it is imported (and validated, if Validation is set): to the account
+	// (VersionPublishAccountConst), to IBM (VersionPublishIBMConst) or publicly (VersionPublishPublicConst).
+	Publish string
+}
+
+// ImportedOffering : The outcome of ImportOfferingFromArchive.
+type ImportedOffering struct {
+	// The offering to which the version was added, as returned by the import.
+	Offering *Offering
+
+	// The locator of the imported version (a dotted value of the catalog ID and the version ID).
+	VersionLocator string
+
+	// The imported version, as retrieved once the pipeline completed.
+	Version *Version
+
+	// The final validation status of the version, or nil if it was not validated.
+	Validation *Validation
+
+	// The level to which the version was published (ImportOfferingFromArchiveOptions.Publish), or empty if it was not
+	// published.
+	Published string
+
+	// The workflow ID sent with every request of the pipeline (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// ImportOfferingFromArchive imports an offering version from an archive into the catalog with the specified ID, then
+// optionally validates and publishes it, replacing the import, get version, validate, poll and publish sequence.
+// "archive" is either the http(s) URL of the archive, which the catalog downloads, or the path of a local file whose
+// content is uploaded. If a step fails, "result" holds the outcome of the steps that completed, so that the pipeline
+// can be resumed with the individual operations; for example, the version of a failed validation is not deleted.
+func (catalogManagement *CatalogManagementV1) ImportOfferingFromArchive(ctx context.Context, catalogID string, archive string, options *ImportOfferingFromArchiveOptions) (result *ImportedOffering, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, catalogManagement.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	if options == nil {
+		options = &ImportOfferingFromArchiveOptions{}
+	}
+	if options.Validation != nil && options.Validation.XAuthRefreshToken == nil {
+		err = fmt.Errorf("a refresh token is required to validate the imported version")
+		return
+	}
+	switch options.Publish {
+	case "", VersionPublishAccountConst, VersionPublishIBMConst, VersionPublishPublicConst:
+	default:
+		err = fmt.Errorf("unsupported publish level '%s'", options.Publish)
+		return
+	}
+
+	var zipURL *string
+	var content *[]byte
+	if lower := strings.ToLower(archive); strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "http://") {
+		zipURL = core.StringPtr(archive)
+	} else {
+		var data []byte
+		data, err = ioutil.ReadFile(archive)
+		if err != nil {
+			err = fmt.Errorf("error reading archive '%s': %w", archive, err)
+			return
+		}
+		content = &data
+	}
+
+	var offering *Offering
+	if options.OfferingID != "" {
+		importOptions := catalogManagement.NewImportOfferingVersionOptions(catalogID, options.OfferingID)
+		importOptions.Zipurl = zipURL
+		importOptions.Content = content
+		importOptions.Name = options.Name
+		importOptions.Label = options.Label
+		importOptions.Tags = options.Tags
+		importOptions.TargetKinds = options.TargetKinds
+		importOptions.FormatKind = options.FormatKind
+		importOptions.InstallKind = options.InstallKind
+		importOptions.ProductKind = options.ProductKind
+		importOptions.Version = options.Version
+		importOptions.Flavor = options.Flavor
+		importOptions.WorkingDirectory = options.WorkingDirectory
+		importOptions.Repotype = options.Repotype
+		importOptions.XAuthToken = options.XAuthToken
+		offering, _, err = catalogManagement.ImportOfferingVersionWithContext(ctx, importOptions)
+	} else {
+		importOptions := catalogManagement.NewImportOfferingOptions(catalogID)
+		importOptions.Zipurl = zipURL
+		importOptions.Content = content
+		importOptions.Name = options.Name
+		importOptions.Label = options.Label
+		importOptions.Tags = options.Tags
+		importOptions.TargetKinds = options.TargetKinds
+		importOptions.FormatKind = options.FormatKind
+		importOptions.InstallKind = options.InstallKind
+		importOptions.ProductKind = options.ProductKind
+		importOptions.Version = options.Version
+		importOptions.Flavor = options.Flavor
+		importOptions.WorkingDirectory = options.WorkingDirectory
+		importOptions.Repotype = options.Repotype
+		importOptions.XAuthToken = options.XAuthToken
+		offering, _, err = catalogManagement.ImportOfferingWithContext(ctx, importOptions)
+	}
+	if err != nil {
+		err = fmt.Errorf("error importing archive '%s' into catalog '%s': %w", archive, catalogID, err)
+		return
+	}
+
+	result = &ImportedOffering{Offering: offering, WorkflowID: workflowID}
+	version := importedVersion(offering, core.StringNilMapper(options.Version))
+	if version == nil || version.VersionLocator == nil {
+		err = fmt.Errorf("the offering returned by the import of archive '%s' does not contain the imported version", archive)
+		return
+	}
+	result.VersionLocator = *version.VersionLocator
+	result.Version = version
+
+	if options.Validation != nil {
+		result.Validation, err = catalogManagement.validateImportedVersion(ctx, result.VersionLocator, options)
+		if err != nil {
+			return
+		}
+	}
+
+	if options.Publish != "" {
+		switch options.Publish {
+		case VersionPublishAccountConst:
+			_, err = catalogManagement.AccountPublishVersionWithContext(ctx, catalogManagement.NewAccountPublishVersionOptions(result.VersionLocator))
+		case VersionPublishIBMConst:
+			_, err = catalogManagement.IBMPublishVersionWithContext(ctx, catalogManagement.NewIBMPublishVersionOptions(result.VersionLocator))
+		case VersionPublishPublicConst:
+			_, err = catalogManagement.PublicPublishVersionWithContext(ctx, catalogManagement.NewPublicPublishVersionOptions(result.VersionLocator))
+		}
+		if err != nil {
+			err = fmt.Errorf("error publishing version '%s': %w", result.VersionLocator, err)
+			return
+		}
+		result.Published = options.Publish
+	}
+
+	refreshed, _, err := catalogManagement.GetVersionWithContext(ctx, catalogManagement.NewGetVersionOptions(result.VersionLocator))
+	if err != nil {
+		err = fmt.Errorf("error retrieving version '%s': %w", result.VersionLocator, err)
+		return
+	}
+	if _, refreshedVersion := findOfferingVersion(refreshed, result.VersionLocator); refreshedVersion != nil {
+		result.Version = refreshedVersion
+	}
+	return
+}
+
+// validateImportedVersion starts the validation of a version and waits for it to complete.
+func (catalogManagement *CatalogManagementV1) validateImportedVersion(ctx context.Context, versionLocator string, options *ImportOfferingFromArchiveOptions) (validation *Validation, err error) {
+	validateOptions := *options.Validation
+	validateOptions.VersionLocID = core.StringPtr(versionLocator)
+	_, err = catalogManagement.ValidateInstallWithContext(ctx, &validateOptions)
+	if err != nil {
+		err = fmt.Errorf("error starting the validation of version '%s': %w", versionLocator, err)
+		return
+	}
+
+	pollInterval := options.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultImportOfferingPollInterval
+	}
+	validation, err = catalogManagement.waitForValidation(ctx, versionLocator, *options.Validation.XAuthRefreshToken, pollInterval,
+		options.ValidationTimeout, nil)
+	if err != nil {
+		return
+	}
+	if state := core.StringNilMapper(validation.State); state != ValidationStateValidConst {
+		err = fmt.Errorf("%w: version '%s' is %s: %s", ErrVersionValidationFailed, versionLocator, state, core.StringNilMapper(validation.Message))
+	}
+	return
+}
+
+// importedVersion returns the version of an offering returned by an import: the one with the specified semantic
+// version if it is not empty, otherwise the newest one.
+func importedVersion(offering *Offering, semver string) (imported *Version) {
+	for i := range offering.Kinds {
+		kind := &offering.Kinds[i]
+		for j := range kind.Versions {
+			version := &kind.Versions[j]
+			versionNumber := core.StringNilMapper(version.Version)
+			if semver != "" {
+				if versionNumber == semver {
+					return version
+				}
+			} else if imported == nil || compareVersions(versionNumber, core.StringNilMapper(imported.Version)) > 0 {
+				imported = version
+			}
+		}
+	}
+	return
+}
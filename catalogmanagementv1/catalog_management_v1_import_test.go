@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/IBM/platform-services-go-sdk/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CatalogManagementV1 offering import pipeline`, func() {
+	var testServer *httptest.Server
+	var catalogManagementService *catalogmanagementv1.CatalogManagementV1
+	var requests []string
+	var importBody map[string]interface{}
+	var importQuery map[string][]string
+	var validationStates []string
+	BeforeEach(func() {
+		requests = nil
+		importBody = nil
+		importQuery = nil
+		validationStates = []string{"", "in_progress", "valid"}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			request := req.Method + " " + req.URL.EscapedPath()
+			requests = append(requests, request)
+			res.Header().Set("Content-type", "application/json")
+			switch request {
+			case "POST /catalogs/cat/import/offerings", "POST /catalogs/cat/offerings/existing/version":
+				Expect(json.NewDecoder(req.Body).Decode(&importBody)).To(Succeed())
+				importQuery = req.URL.Query()
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "offering", "kinds": [{"versions": [
+					{"version": "1.2.0", "version_locator": "cat.v120"},
+					{"version": "1.10.0", "version_locator": "cat.v1100"},
+					{"version": "1.9.0", "version_locator": "cat.v190"}]}]}`)
+			case "POST /versions/cat.v1100/validation/install":
+				Expect(req.Header.Get("X-Auth-Refresh-Token")).To(Equal("refresh"))
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body["region"]).To(Equal("us-south"))
+				res.WriteHeader(202)
+			case "GET /versions/cat.v1100/validation/install":
+				state := validationStates[0]
+				if len(validationStates) > 1 {
+					validationStates = validationStates[1:]
+				}
+				fmt.Fprintf(res, `{"state": "%s", "message": "plan failed"}`, state)
+			case "POST /versions/cat.v1100/account-publish", "POST /versions/cat.v1100/public-publish":
+				res.WriteHeader(202)
+			case "GET /versions/cat.v1100":
+				fmt.Fprint(res, `{"id": "offering", "kinds": [{"versions": [
+					{"version": "1.10.0", "version_locator": "cat.v1100", "state": {"current": "published"}}]}]}`)
+			default:
+				Fail("unexpected request " + request)
+			}
+		}))
+		var serviceErr error
+		catalogManagementService, serviceErr = catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(catalogManagementService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	validation := func() *catalogmanagementv1.ImportOfferingFromArchiveOptions {
+		validateOptions := catalogManagementService.NewValidateInstallOptions("", "refresh").SetRegion("us-south")
+		return &catalogmanagementv1.ImportOfferingFromArchiveOptions{
+			Validation:   validateOptions,
+			PollInterval: time.Millisecond,
+			Publish:      catalogmanagementv1.VersionPublishAccountConst,
+		}
+	}
+
+	It(`Imports, validates and publishes a version from a URL`, func() {
+		result, err := catalogManagementService.ImportOfferingFromArchive(context.Background(), "cat", "https://example.com/offering.tgz", validation())
+		Expect(err).To(BeNil())
+		Expect(result.WorkflowID).ToNot(BeEmpty())
+		Expect(result.VersionLocator).To(Equal("cat.v1100"))
+		Expect(*result.Validation.State).To(Equal(catalogmanagementv1.ValidationStateValidConst))
+		Expect(result.Published).To(Equal(catalogmanagementv1.VersionPublishAccountConst))
+		Expect(*result.Version.State.Current).To(Equal("published"))
+		Expect(importQuery["zipurl"]).To(Equal([]string{"https://example.com/offering.tgz"}))
+		Expect(importBody).ToNot(HaveKey("content"))
+		Expect(requests).To(Equal([]string{
+			"POST /catalogs/cat/import/offerings",
+			"POST /versions/cat.v1100/validation/install",
+			"GET /versions/cat.v1100/validation/install",
+			"GET /versions/cat.v1100/validation/install",
+			"GET /versions/cat.v1100/validation/install",
+			"POST /versions/cat.v1100/account-publish",
+			"GET /versions/cat.v1100",
+		}))
+	})
+	It(`Uploads a local archive as a version of an existing offering`, func() {
+		dir, err := ioutil.TempDir("", "import")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		archive := filepath.Join(dir, "offering.tgz")
+		Expect(ioutil.WriteFile(archive, []byte("archive-bytes"), 0600)).To(Succeed())
+
+		options := &catalogmanagementv1.ImportOfferingFromArchiveOptions{
+			OfferingID: "existing",
+			Version:    core.StringPtr("1.10.0"),
+			Publish:    catalogmanagementv1.VersionPublishPublicConst,
+		}
+		result, err := catalogManagementService.ImportOfferingFromArchive(context.Background(), "cat", archive, options)
+		Expect(err).To(BeNil())
+		Expect(result.Validation).To(BeNil())
+		Expect(importBody["content"]).To(Equal(base64.StdEncoding.EncodeToString([]byte("archive-bytes"))))
+		Expect(importBody["version"]).To(Equal("1.10.0"))
+		Expect(requests).To(Equal([]string{
+			"POST /catalogs/cat/offerings/existing/version",
+			"POST /versions/cat.v1100/public-publish",
+			"GET /versions/cat.v1100",
+		}))
+	})
+	It(`Stops before publishing when the validation fails`, func() {
+		validationStates = []string{"in_progress", "invalid"}
+		result, err := catalogManagementService.ImportOfferingFromArchive(context.Background(), "cat", "https://example.com/offering.tgz", validation())
+		Expect(errors.Is(err, catalogmanagementv1.ErrVersionValidationFailed)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("plan failed"))
+		Expect(result.VersionLocator).To(Equal("cat.v1100"))
+		Expect(result.Published).To(BeEmpty())
+		Expect(requests).ToNot(ContainElement("POST /versions/cat.v1100/account-publish"))
+	})
+	It(`Rejects invalid options`, func() {
+		options := validation()
+		options.Validation.XAuthRefreshToken = nil
+		_, err := catalogManagementService.ImportOfferingFromArchive(context.Background(), "cat", "https://example.com/offering.tgz", options)
+		Expect(err).ToNot(BeNil())
+		_, err = catalogManagementService.ImportOfferingFromArchive(context.Background(), "cat", "https://example.com/offering.tgz",
+			&catalogmanagementv1.ImportOfferingFromArchiveOptions{Publish: "everywhere"})
+		Expect(err).ToNot(BeNil())
+		Expect(requests).To(BeEmpty())
+	})
+})
@@ -0,0 +1,7219 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package catalogmanagementv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// GetCatalogAccountOption : A functional option that sets a property of GetCatalogAccountOptions (see GetCatalogAccountWith).
+type GetCatalogAccountOption func(options *GetCatalogAccountOptions)
+
+// GetCatalogAccountHeader sets the Headers property of GetCatalogAccountOptions. Allows users to set headers on
+// API requests.
+func GetCatalogAccountHeader(name string, value string) GetCatalogAccountOption {
+	return func(options *GetCatalogAccountOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetCatalogAccountOptionsWith returns GetCatalogAccountOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetCatalogAccountOptionsWith(options ...GetCatalogAccountOption) *GetCatalogAccountOptions {
+	getCatalogAccountOptions := &GetCatalogAccountOptions{}
+	for _, option := range options {
+		option(getCatalogAccountOptions)
+	}
+	return getCatalogAccountOptions
+}
+
+// GetCatalogAccountWith is an alternate form of the GetCatalogAccountWithContext method which takes functional
+// options instead of a GetCatalogAccountOptions struct.
+func (catalogManagement *CatalogManagementV1) GetCatalogAccountWith(ctx context.Context, options ...GetCatalogAccountOption) (result *Account, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetCatalogAccountWithContext(ctx, catalogManagement.NewGetCatalogAccountOptionsWith(options...))
+}
+
+// UpdateCatalogAccountOption : A functional option that sets a property of UpdateCatalogAccountOptions (see UpdateCatalogAccountWith).
+type UpdateCatalogAccountOption func(options *UpdateCatalogAccountOptions)
+
+// UpdateCatalogAccountID sets the ID property of UpdateCatalogAccountOptions. Account identification.
+func UpdateCatalogAccountID(id string) UpdateCatalogAccountOption {
+	return func(options *UpdateCatalogAccountOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UpdateCatalogAccountRev sets the Rev property of UpdateCatalogAccountOptions. Cloudant revision.
+func UpdateCatalogAccountRev(rev string) UpdateCatalogAccountOption {
+	return func(options *UpdateCatalogAccountOptions) {
+		options.Rev = core.StringPtr(rev)
+	}
+}
+
+// UpdateCatalogAccountHideIBMCloudCatalog sets the HideIBMCloudCatalog property of UpdateCatalogAccountOptions.
+// Hide the public catalog in this account.
+func UpdateCatalogAccountHideIBMCloudCatalog(hideIBMCloudCatalog bool) UpdateCatalogAccountOption {
+	return func(options *UpdateCatalogAccountOptions) {
+		options.HideIBMCloudCatalog = core.BoolPtr(hideIBMCloudCatalog)
+	}
+}
+
+// UpdateCatalogAccountAccountFilters sets the AccountFilters property of UpdateCatalogAccountOptions. Filters for
+// account and catalog filters.
+func UpdateCatalogAccountAccountFilters(accountFilters *Filters) UpdateCatalogAccountOption {
+	return func(options *UpdateCatalogAccountOptions) {
+		options.AccountFilters = accountFilters
+	}
+}
+
+// UpdateCatalogAccountHeader sets the Headers property of UpdateCatalogAccountOptions. Allows users to set headers
+// on API requests.
+func UpdateCatalogAccountHeader(name string, value string) UpdateCatalogAccountOption {
+	return func(options *UpdateCatalogAccountOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateCatalogAccountOptionsWith returns UpdateCatalogAccountOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewUpdateCatalogAccountOptionsWith(options ...UpdateCatalogAccountOption) *UpdateCatalogAccountOptions {
+	updateCatalogAccountOptions := &UpdateCatalogAccountOptions{}
+	for _, option := range options {
+		option(updateCatalogAccountOptions)
+	}
+	return updateCatalogAccountOptions
+}
+
+// UpdateCatalogAccountWith is an alternate form of the UpdateCatalogAccountWithContext method which takes
+// functional options instead of an UpdateCatalogAccountOptions struct.
+func (catalogManagement *CatalogManagementV1) UpdateCatalogAccountWith(ctx context.Context, options ...UpdateCatalogAccountOption) (result *Account, response *core.DetailedResponse, err error) {
+	return catalogManagement.UpdateCatalogAccountWithContext(ctx, catalogManagement.NewUpdateCatalogAccountOptionsWith(options...))
+}
+
+// ListCatalogAccountAuditsOption : A functional option that sets a property of ListCatalogAccountAuditsOptions (see ListCatalogAccountAuditsWith).
+type ListCatalogAccountAuditsOption func(options *ListCatalogAccountAuditsOptions)
+
+// ListCatalogAccountAuditsStart sets the Start property of ListCatalogAccountAuditsOptions. Start token for a
+// query.
+func ListCatalogAccountAuditsStart(start string) ListCatalogAccountAuditsOption {
+	return func(options *ListCatalogAccountAuditsOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListCatalogAccountAuditsLimit sets the Limit property of ListCatalogAccountAuditsOptions. number or results to
+// return in the query.
+func ListCatalogAccountAuditsLimit(limit int64) ListCatalogAccountAuditsOption {
+	return func(options *ListCatalogAccountAuditsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListCatalogAccountAuditsLookupnames sets the Lookupnames property of ListCatalogAccountAuditsOptions. Auditlog
+// Lookup Names - by default names are not returned in auditlog.
+func ListCatalogAccountAuditsLookupnames(lookupnames bool) ListCatalogAccountAuditsOption {
+	return func(options *ListCatalogAccountAuditsOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// ListCatalogAccountAuditsHeader sets the Headers property of ListCatalogAccountAuditsOptions. Allows users to set
+// headers on API requests.
+func ListCatalogAccountAuditsHeader(name string, value string) ListCatalogAccountAuditsOption {
+	return func(options *ListCatalogAccountAuditsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListCatalogAccountAuditsOptionsWith returns ListCatalogAccountAuditsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewListCatalogAccountAuditsOptionsWith(options ...ListCatalogAccountAuditsOption) *ListCatalogAccountAuditsOptions {
+	listCatalogAccountAuditsOptions := &ListCatalogAccountAuditsOptions{}
+	for _, option := range options {
+		option(listCatalogAccountAuditsOptions)
+	}
+	return listCatalogAccountAuditsOptions
+}
+
+// ListCatalogAccountAuditsWith is an alternate form of the ListCatalogAccountAuditsWithContext method which takes
+// functional options instead of a ListCatalogAccountAuditsOptions struct.
+func (catalogManagement *CatalogManagementV1) ListCatalogAccountAuditsWith(ctx context.Context, options ...ListCatalogAccountAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error) {
+	return catalogManagement.ListCatalogAccountAuditsWithContext(ctx, catalogManagement.NewListCatalogAccountAuditsOptionsWith(options...))
+}
+
+// GetCatalogAccountAuditOption : A functional option that sets a property of GetCatalogAccountAuditOptions (see GetCatalogAccountAuditWith).
+type GetCatalogAccountAuditOption func(options *GetCatalogAccountAuditOptions)
+
+// GetCatalogAccountAuditAuditlogIdentifier sets the AuditlogIdentifier property of GetCatalogAccountAuditOptions.
+// Auditlog ID.
+func GetCatalogAccountAuditAuditlogIdentifier(auditlogIdentifier string) GetCatalogAccountAuditOption {
+	return func(options *GetCatalogAccountAuditOptions) {
+		options.AuditlogIdentifier = core.StringPtr(auditlogIdentifier)
+	}
+}
+
+// GetCatalogAccountAuditLookupnames sets the Lookupnames property of GetCatalogAccountAuditOptions. Auditlog
+// Lookup Names - by default names are not returned in auditlog.
+func GetCatalogAccountAuditLookupnames(lookupnames bool) GetCatalogAccountAuditOption {
+	return func(options *GetCatalogAccountAuditOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// GetCatalogAccountAuditHeader sets the Headers property of GetCatalogAccountAuditOptions. Allows users to set
+// headers on API requests.
+func GetCatalogAccountAuditHeader(name string, value string) GetCatalogAccountAuditOption {
+	return func(options *GetCatalogAccountAuditOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetCatalogAccountAuditOptionsWith returns GetCatalogAccountAuditOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetCatalogAccountAuditOptionsWith(options ...GetCatalogAccountAuditOption) *GetCatalogAccountAuditOptions {
+	getCatalogAccountAuditOptions := &GetCatalogAccountAuditOptions{}
+	for _, option := range options {
+		option(getCatalogAccountAuditOptions)
+	}
+	return getCatalogAccountAuditOptions
+}
+
+// GetCatalogAccountAuditWith is an alternate form of the GetCatalogAccountAuditWithContext method which takes
+// functional options instead of a GetCatalogAccountAuditOptions struct. GetCatalogAccountAuditAuditlogIdentifier
+// is required.
+func (catalogManagement *CatalogManagementV1) GetCatalogAccountAuditWith(ctx context.Context, options ...GetCatalogAccountAuditOption) (result *AuditLog, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetCatalogAccountAuditWithContext(ctx, catalogManagement.NewGetCatalogAccountAuditOptionsWith(options...))
+}
+
+// GetCatalogAccountFiltersOption : A functional option that sets a property of GetCatalogAccountFiltersOptions (see GetCatalogAccountFiltersWith).
+type GetCatalogAccountFiltersOption func(options *GetCatalogAccountFiltersOptions)
+
+// GetCatalogAccountFiltersCatalog sets the Catalog property of GetCatalogAccountFiltersOptions. catalog id.
+func GetCatalogAccountFiltersCatalog(catalog string) GetCatalogAccountFiltersOption {
+	return func(options *GetCatalogAccountFiltersOptions) {
+		options.Catalog = core.StringPtr(catalog)
+	}
+}
+
+// GetCatalogAccountFiltersHeader sets the Headers property of GetCatalogAccountFiltersOptions. Allows users to set
+// headers on API requests.
+func GetCatalogAccountFiltersHeader(name string, value string) GetCatalogAccountFiltersOption {
+	return func(options *GetCatalogAccountFiltersOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetCatalogAccountFiltersOptionsWith returns GetCatalogAccountFiltersOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetCatalogAccountFiltersOptionsWith(options ...GetCatalogAccountFiltersOption) *GetCatalogAccountFiltersOptions {
+	getCatalogAccountFiltersOptions := &GetCatalogAccountFiltersOptions{}
+	for _, option := range options {
+		option(getCatalogAccountFiltersOptions)
+	}
+	return getCatalogAccountFiltersOptions
+}
+
+// GetCatalogAccountFiltersWith is an alternate form of the GetCatalogAccountFiltersWithContext method which takes
+// functional options instead of a GetCatalogAccountFiltersOptions struct.
+func (catalogManagement *CatalogManagementV1) GetCatalogAccountFiltersWith(ctx context.Context, options ...GetCatalogAccountFiltersOption) (result *AccumulatedFilters, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetCatalogAccountFiltersWithContext(ctx, catalogManagement.NewGetCatalogAccountFiltersOptionsWith(options...))
+}
+
+// ListCatalogsOption : A functional option that sets a property of ListCatalogsOptions (see ListCatalogsWith).
+type ListCatalogsOption func(options *ListCatalogsOptions)
+
+// ListCatalogsHeader sets the Headers property of ListCatalogsOptions. Allows users to set headers on API
+// requests.
+func ListCatalogsHeader(name string, value string) ListCatalogsOption {
+	return func(options *ListCatalogsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListCatalogsOptionsWith returns ListCatalogsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewListCatalogsOptionsWith(options ...ListCatalogsOption) *ListCatalogsOptions {
+	listCatalogsOptions := &ListCatalogsOptions{}
+	for _, option := range options {
+		option(listCatalogsOptions)
+	}
+	return listCatalogsOptions
+}
+
+// ListCatalogsWith is an alternate form of the ListCatalogsWithContext method which takes functional options
+// instead of a ListCatalogsOptions struct.
+func (catalogManagement *CatalogManagementV1) ListCatalogsWith(ctx context.Context, options ...ListCatalogsOption) (result *CatalogSearchResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.ListCatalogsWithContext(ctx, catalogManagement.NewListCatalogsOptionsWith(options...))
+}
+
+// CreateCatalogOption : A functional option that sets a property of CreateCatalogOptions (see CreateCatalogWith).
+type CreateCatalogOption func(options *CreateCatalogOptions)
+
+// CreateCatalogID sets the ID property of CreateCatalogOptions. Unique ID.
+func CreateCatalogID(id string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// CreateCatalogRev sets the Rev property of CreateCatalogOptions. Cloudant revision.
+func CreateCatalogRev(rev string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.Rev = core.StringPtr(rev)
+	}
+}
+
+// CreateCatalogLabel sets the Label property of CreateCatalogOptions. Display Name in the requested language.
+func CreateCatalogLabel(label string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.Label = core.StringPtr(label)
+	}
+}
+
+// CreateCatalogLabelI18n sets the LabelI18n property of CreateCatalogOptions. A map of translated strings, by
+// language code.
+func CreateCatalogLabelI18n(labelI18n map[string]string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.LabelI18n = labelI18n
+	}
+}
+
+// CreateCatalogShortDescription sets the ShortDescription property of CreateCatalogOptions. Description in the
+// requested language.
+func CreateCatalogShortDescription(shortDescription string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.ShortDescription = core.StringPtr(shortDescription)
+	}
+}
+
+// CreateCatalogShortDescriptionI18n sets the ShortDescriptionI18n property of CreateCatalogOptions. A map of
+// translated strings, by language code.
+func CreateCatalogShortDescriptionI18n(shortDescriptionI18n map[string]string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.ShortDescriptionI18n = shortDescriptionI18n
+	}
+}
+
+// CreateCatalogCatalogIconURL sets the CatalogIconURL property of CreateCatalogOptions. URL for an icon associated
+// with this catalog.
+func CreateCatalogCatalogIconURL(catalogIconURL string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.CatalogIconURL = core.StringPtr(catalogIconURL)
+	}
+}
+
+// CreateCatalogTags sets the Tags property of CreateCatalogOptions. List of tags associated with this catalog.
+func CreateCatalogTags(tags ...string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// CreateCatalogFeatures sets the Features property of CreateCatalogOptions. List of features associated with this
+// catalog.
+func CreateCatalogFeatures(features ...Feature) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.Features = append(options.Features, features...)
+	}
+}
+
+// CreateCatalogDisabled sets the Disabled property of CreateCatalogOptions. Denotes whether a catalog is disabled.
+func CreateCatalogDisabled(disabled bool) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.Disabled = core.BoolPtr(disabled)
+	}
+}
+
+// CreateCatalogResourceGroupID sets the ResourceGroupID property of CreateCatalogOptions. Resource group id the
+// catalog is owned by.
+func CreateCatalogResourceGroupID(resourceGroupID string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// CreateCatalogOwningAccount sets the OwningAccount property of CreateCatalogOptions. Account that owns catalog.
+func CreateCatalogOwningAccount(owningAccount string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.OwningAccount = core.StringPtr(owningAccount)
+	}
+}
+
+// CreateCatalogCatalogFilters sets the CatalogFilters property of CreateCatalogOptions. Filters for account and
+// catalog filters.
+func CreateCatalogCatalogFilters(catalogFilters *Filters) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.CatalogFilters = catalogFilters
+	}
+}
+
+// CreateCatalogSyndicationSettings sets the SyndicationSettings property of CreateCatalogOptions. Feature
+// information.
+func CreateCatalogSyndicationSettings(syndicationSettings *SyndicationResource) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.SyndicationSettings = syndicationSettings
+	}
+}
+
+// CreateCatalogKind sets the Kind property of CreateCatalogOptions. Kind of catalog.
+func CreateCatalogKind(kind string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.Kind = core.StringPtr(kind)
+	}
+}
+
+// CreateCatalogMetadata sets the Metadata property of CreateCatalogOptions. Catalog specific metadata.
+func CreateCatalogMetadata(metadata map[string]interface{}) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		options.Metadata = metadata
+	}
+}
+
+// CreateCatalogHeader sets the Headers property of CreateCatalogOptions. Allows users to set headers on API
+// requests.
+func CreateCatalogHeader(name string, value string) CreateCatalogOption {
+	return func(options *CreateCatalogOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateCatalogOptionsWith returns CreateCatalogOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewCreateCatalogOptionsWith(options ...CreateCatalogOption) *CreateCatalogOptions {
+	createCatalogOptions := &CreateCatalogOptions{}
+	for _, option := range options {
+		option(createCatalogOptions)
+	}
+	return createCatalogOptions
+}
+
+// CreateCatalogWith is an alternate form of the CreateCatalogWithContext method which takes functional options
+// instead of a CreateCatalogOptions struct.
+func (catalogManagement *CatalogManagementV1) CreateCatalogWith(ctx context.Context, options ...CreateCatalogOption) (result *Catalog, response *core.DetailedResponse, err error) {
+	return catalogManagement.CreateCatalogWithContext(ctx, catalogManagement.NewCreateCatalogOptionsWith(options...))
+}
+
+// GetCatalogOption : A functional option that sets a property of GetCatalogOptions (see GetCatalogWith).
+type GetCatalogOption func(options *GetCatalogOptions)
+
+// GetCatalogCatalogIdentifier sets the CatalogIdentifier property of GetCatalogOptions. Catalog identifier.
+func GetCatalogCatalogIdentifier(catalogIdentifier string) GetCatalogOption {
+	return func(options *GetCatalogOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetCatalogHeader sets the Headers property of GetCatalogOptions. Allows users to set headers on API requests.
+func GetCatalogHeader(name string, value string) GetCatalogOption {
+	return func(options *GetCatalogOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetCatalogOptionsWith returns GetCatalogOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetCatalogOptionsWith(options ...GetCatalogOption) *GetCatalogOptions {
+	getCatalogOptions := &GetCatalogOptions{}
+	for _, option := range options {
+		option(getCatalogOptions)
+	}
+	return getCatalogOptions
+}
+
+// GetCatalogWith is an alternate form of the GetCatalogWithContext method which takes functional options instead
+// of a GetCatalogOptions struct. GetCatalogCatalogIdentifier is required.
+func (catalogManagement *CatalogManagementV1) GetCatalogWith(ctx context.Context, options ...GetCatalogOption) (result *Catalog, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetCatalogWithContext(ctx, catalogManagement.NewGetCatalogOptionsWith(options...))
+}
+
+// ReplaceCatalogOption : A functional option that sets a property of ReplaceCatalogOptions (see ReplaceCatalogWith).
+type ReplaceCatalogOption func(options *ReplaceCatalogOptions)
+
+// ReplaceCatalogCatalogIdentifier sets the CatalogIdentifier property of ReplaceCatalogOptions. Catalog
+// identifier.
+func ReplaceCatalogCatalogIdentifier(catalogIdentifier string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ReplaceCatalogID sets the ID property of ReplaceCatalogOptions. Unique ID.
+func ReplaceCatalogID(id string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// ReplaceCatalogRev sets the Rev property of ReplaceCatalogOptions. Cloudant revision.
+func ReplaceCatalogRev(rev string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.Rev = core.StringPtr(rev)
+	}
+}
+
+// ReplaceCatalogLabel sets the Label property of ReplaceCatalogOptions. Display Name in the requested language.
+func ReplaceCatalogLabel(label string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.Label = core.StringPtr(label)
+	}
+}
+
+// ReplaceCatalogLabelI18n sets the LabelI18n property of ReplaceCatalogOptions. A map of translated strings, by
+// language code.
+func ReplaceCatalogLabelI18n(labelI18n map[string]string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.LabelI18n = labelI18n
+	}
+}
+
+// ReplaceCatalogShortDescription sets the ShortDescription property of ReplaceCatalogOptions. Description in the
+// requested language.
+func ReplaceCatalogShortDescription(shortDescription string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.ShortDescription = core.StringPtr(shortDescription)
+	}
+}
+
+// ReplaceCatalogShortDescriptionI18n sets the ShortDescriptionI18n property of ReplaceCatalogOptions. A map of
+// translated strings, by language code.
+func ReplaceCatalogShortDescriptionI18n(shortDescriptionI18n map[string]string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.ShortDescriptionI18n = shortDescriptionI18n
+	}
+}
+
+// ReplaceCatalogCatalogIconURL sets the CatalogIconURL property of ReplaceCatalogOptions. URL for an icon
+// associated with this catalog.
+func ReplaceCatalogCatalogIconURL(catalogIconURL string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.CatalogIconURL = core.StringPtr(catalogIconURL)
+	}
+}
+
+// ReplaceCatalogTags sets the Tags property of ReplaceCatalogOptions. List of tags associated with this catalog.
+func ReplaceCatalogTags(tags ...string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// ReplaceCatalogFeatures sets the Features property of ReplaceCatalogOptions. List of features associated with
+// this catalog.
+func ReplaceCatalogFeatures(features ...Feature) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.Features = append(options.Features, features...)
+	}
+}
+
+// ReplaceCatalogDisabled sets the Disabled property of ReplaceCatalogOptions. Denotes whether a catalog is
+// disabled.
+func ReplaceCatalogDisabled(disabled bool) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.Disabled = core.BoolPtr(disabled)
+	}
+}
+
+// ReplaceCatalogResourceGroupID sets the ResourceGroupID property of ReplaceCatalogOptions. Resource group id the
+// catalog is owned by.
+func ReplaceCatalogResourceGroupID(resourceGroupID string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// ReplaceCatalogOwningAccount sets the OwningAccount property of ReplaceCatalogOptions. Account that owns catalog.
+func ReplaceCatalogOwningAccount(owningAccount string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.OwningAccount = core.StringPtr(owningAccount)
+	}
+}
+
+// ReplaceCatalogCatalogFilters sets the CatalogFilters property of ReplaceCatalogOptions. Filters for account and
+// catalog filters.
+func ReplaceCatalogCatalogFilters(catalogFilters *Filters) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.CatalogFilters = catalogFilters
+	}
+}
+
+// ReplaceCatalogSyndicationSettings sets the SyndicationSettings property of ReplaceCatalogOptions. Feature
+// information.
+func ReplaceCatalogSyndicationSettings(syndicationSettings *SyndicationResource) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.SyndicationSettings = syndicationSettings
+	}
+}
+
+// ReplaceCatalogKind sets the Kind property of ReplaceCatalogOptions. Kind of catalog.
+func ReplaceCatalogKind(kind string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.Kind = core.StringPtr(kind)
+	}
+}
+
+// ReplaceCatalogMetadata sets the Metadata property of ReplaceCatalogOptions. Catalog specific metadata.
+func ReplaceCatalogMetadata(metadata map[string]interface{}) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		options.Metadata = metadata
+	}
+}
+
+// ReplaceCatalogHeader sets the Headers property of ReplaceCatalogOptions. Allows users to set headers on API
+// requests.
+func ReplaceCatalogHeader(name string, value string) ReplaceCatalogOption {
+	return func(options *ReplaceCatalogOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceCatalogOptionsWith returns ReplaceCatalogOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewReplaceCatalogOptionsWith(options ...ReplaceCatalogOption) *ReplaceCatalogOptions {
+	replaceCatalogOptions := &ReplaceCatalogOptions{}
+	for _, option := range options {
+		option(replaceCatalogOptions)
+	}
+	return replaceCatalogOptions
+}
+
+// ReplaceCatalogWith is an alternate form of the ReplaceCatalogWithContext method which takes functional options
+// instead of a ReplaceCatalogOptions struct. ReplaceCatalogCatalogIdentifier is required.
+func (catalogManagement *CatalogManagementV1) ReplaceCatalogWith(ctx context.Context, options ...ReplaceCatalogOption) (result *Catalog, response *core.DetailedResponse, err error) {
+	return catalogManagement.ReplaceCatalogWithContext(ctx, catalogManagement.NewReplaceCatalogOptionsWith(options...))
+}
+
+// DeleteCatalogOption : A functional option that sets a property of DeleteCatalogOptions (see DeleteCatalogWith).
+type DeleteCatalogOption func(options *DeleteCatalogOptions)
+
+// DeleteCatalogCatalogIdentifier sets the CatalogIdentifier property of DeleteCatalogOptions. Catalog identifier.
+func DeleteCatalogCatalogIdentifier(catalogIdentifier string) DeleteCatalogOption {
+	return func(options *DeleteCatalogOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// DeleteCatalogHeader sets the Headers property of DeleteCatalogOptions. Allows users to set headers on API
+// requests.
+func DeleteCatalogHeader(name string, value string) DeleteCatalogOption {
+	return func(options *DeleteCatalogOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteCatalogOptionsWith returns DeleteCatalogOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeleteCatalogOptionsWith(options ...DeleteCatalogOption) *DeleteCatalogOptions {
+	deleteCatalogOptions := &DeleteCatalogOptions{}
+	for _, option := range options {
+		option(deleteCatalogOptions)
+	}
+	return deleteCatalogOptions
+}
+
+// DeleteCatalogWith is an alternate form of the DeleteCatalogWithContext method which takes functional options
+// instead of a DeleteCatalogOptions struct. DeleteCatalogCatalogIdentifier is required.
+func (catalogManagement *CatalogManagementV1) DeleteCatalogWith(ctx context.Context, options ...DeleteCatalogOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.DeleteCatalogWithContext(ctx, catalogManagement.NewDeleteCatalogOptionsWith(options...))
+}
+
+// ListCatalogAuditsOption : A functional option that sets a property of ListCatalogAuditsOptions (see ListCatalogAuditsWith).
+type ListCatalogAuditsOption func(options *ListCatalogAuditsOptions)
+
+// ListCatalogAuditsCatalogIdentifier sets the CatalogIdentifier property of ListCatalogAuditsOptions. Catalog
+// identifier.
+func ListCatalogAuditsCatalogIdentifier(catalogIdentifier string) ListCatalogAuditsOption {
+	return func(options *ListCatalogAuditsOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ListCatalogAuditsStart sets the Start property of ListCatalogAuditsOptions. Start token for a query.
+func ListCatalogAuditsStart(start string) ListCatalogAuditsOption {
+	return func(options *ListCatalogAuditsOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListCatalogAuditsLimit sets the Limit property of ListCatalogAuditsOptions. number or results to return in the
+// query.
+func ListCatalogAuditsLimit(limit int64) ListCatalogAuditsOption {
+	return func(options *ListCatalogAuditsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListCatalogAuditsLookupnames sets the Lookupnames property of ListCatalogAuditsOptions. Auditlog Lookup Names -
+// by default names are not returned in auditlog.
+func ListCatalogAuditsLookupnames(lookupnames bool) ListCatalogAuditsOption {
+	return func(options *ListCatalogAuditsOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// ListCatalogAuditsHeader sets the Headers property of ListCatalogAuditsOptions. Allows users to set headers on
+// API requests.
+func ListCatalogAuditsHeader(name string, value string) ListCatalogAuditsOption {
+	return func(options *ListCatalogAuditsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListCatalogAuditsOptionsWith returns ListCatalogAuditsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewListCatalogAuditsOptionsWith(options ...ListCatalogAuditsOption) *ListCatalogAuditsOptions {
+	listCatalogAuditsOptions := &ListCatalogAuditsOptions{}
+	for _, option := range options {
+		option(listCatalogAuditsOptions)
+	}
+	return listCatalogAuditsOptions
+}
+
+// ListCatalogAuditsWith is an alternate form of the ListCatalogAuditsWithContext method which takes functional
+// options instead of a ListCatalogAuditsOptions struct. ListCatalogAuditsCatalogIdentifier is required.
+func (catalogManagement *CatalogManagementV1) ListCatalogAuditsWith(ctx context.Context, options ...ListCatalogAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error) {
+	return catalogManagement.ListCatalogAuditsWithContext(ctx, catalogManagement.NewListCatalogAuditsOptionsWith(options...))
+}
+
+// GetCatalogAuditOption : A functional option that sets a property of GetCatalogAuditOptions (see GetCatalogAuditWith).
+type GetCatalogAuditOption func(options *GetCatalogAuditOptions)
+
+// GetCatalogAuditCatalogIdentifier sets the CatalogIdentifier property of GetCatalogAuditOptions. Catalog
+// identifier.
+func GetCatalogAuditCatalogIdentifier(catalogIdentifier string) GetCatalogAuditOption {
+	return func(options *GetCatalogAuditOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetCatalogAuditAuditlogIdentifier sets the AuditlogIdentifier property of GetCatalogAuditOptions. Auditlog ID.
+func GetCatalogAuditAuditlogIdentifier(auditlogIdentifier string) GetCatalogAuditOption {
+	return func(options *GetCatalogAuditOptions) {
+		options.AuditlogIdentifier = core.StringPtr(auditlogIdentifier)
+	}
+}
+
+// GetCatalogAuditLookupnames sets the Lookupnames property of GetCatalogAuditOptions. Auditlog Lookup Names - by
+// default names are not returned in auditlog.
+func GetCatalogAuditLookupnames(lookupnames bool) GetCatalogAuditOption {
+	return func(options *GetCatalogAuditOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// GetCatalogAuditHeader sets the Headers property of GetCatalogAuditOptions. Allows users to set headers on API
+// requests.
+func GetCatalogAuditHeader(name string, value string) GetCatalogAuditOption {
+	return func(options *GetCatalogAuditOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetCatalogAuditOptionsWith returns GetCatalogAuditOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetCatalogAuditOptionsWith(options ...GetCatalogAuditOption) *GetCatalogAuditOptions {
+	getCatalogAuditOptions := &GetCatalogAuditOptions{}
+	for _, option := range options {
+		option(getCatalogAuditOptions)
+	}
+	return getCatalogAuditOptions
+}
+
+// GetCatalogAuditWith is an alternate form of the GetCatalogAuditWithContext method which takes functional options
+// instead of a GetCatalogAuditOptions struct. GetCatalogAuditCatalogIdentifier and
+// GetCatalogAuditAuditlogIdentifier are required.
+func (catalogManagement *CatalogManagementV1) GetCatalogAuditWith(ctx context.Context, options ...GetCatalogAuditOption) (result *AuditLog, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetCatalogAuditWithContext(ctx, catalogManagement.NewGetCatalogAuditOptionsWith(options...))
+}
+
+// ListEnterpriseAuditsOption : A functional option that sets a property of ListEnterpriseAuditsOptions (see ListEnterpriseAuditsWith).
+type ListEnterpriseAuditsOption func(options *ListEnterpriseAuditsOptions)
+
+// ListEnterpriseAuditsEnterpriseIdentifier sets the EnterpriseIdentifier property of ListEnterpriseAuditsOptions.
+// Enterprise ID.
+func ListEnterpriseAuditsEnterpriseIdentifier(enterpriseIdentifier string) ListEnterpriseAuditsOption {
+	return func(options *ListEnterpriseAuditsOptions) {
+		options.EnterpriseIdentifier = core.StringPtr(enterpriseIdentifier)
+	}
+}
+
+// ListEnterpriseAuditsStart sets the Start property of ListEnterpriseAuditsOptions. Start token for a query.
+func ListEnterpriseAuditsStart(start string) ListEnterpriseAuditsOption {
+	return func(options *ListEnterpriseAuditsOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListEnterpriseAuditsLimit sets the Limit property of ListEnterpriseAuditsOptions. number or results to return in
+// the query.
+func ListEnterpriseAuditsLimit(limit int64) ListEnterpriseAuditsOption {
+	return func(options *ListEnterpriseAuditsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListEnterpriseAuditsLookupnames sets the Lookupnames property of ListEnterpriseAuditsOptions. Auditlog Lookup
+// Names - by default names are not returned in auditlog.
+func ListEnterpriseAuditsLookupnames(lookupnames bool) ListEnterpriseAuditsOption {
+	return func(options *ListEnterpriseAuditsOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// ListEnterpriseAuditsHeader sets the Headers property of ListEnterpriseAuditsOptions. Allows users to set headers
+// on API requests.
+func ListEnterpriseAuditsHeader(name string, value string) ListEnterpriseAuditsOption {
+	return func(options *ListEnterpriseAuditsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListEnterpriseAuditsOptionsWith returns ListEnterpriseAuditsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewListEnterpriseAuditsOptionsWith(options ...ListEnterpriseAuditsOption) *ListEnterpriseAuditsOptions {
+	listEnterpriseAuditsOptions := &ListEnterpriseAuditsOptions{}
+	for _, option := range options {
+		option(listEnterpriseAuditsOptions)
+	}
+	return listEnterpriseAuditsOptions
+}
+
+// ListEnterpriseAuditsWith is an alternate form of the ListEnterpriseAuditsWithContext method which takes
+// functional options instead of a ListEnterpriseAuditsOptions struct. ListEnterpriseAuditsEnterpriseIdentifier is
+// required.
+func (catalogManagement *CatalogManagementV1) ListEnterpriseAuditsWith(ctx context.Context, options ...ListEnterpriseAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error) {
+	return catalogManagement.ListEnterpriseAuditsWithContext(ctx, catalogManagement.NewListEnterpriseAuditsOptionsWith(options...))
+}
+
+// GetEnterpriseAuditOption : A functional option that sets a property of GetEnterpriseAuditOptions (see GetEnterpriseAuditWith).
+type GetEnterpriseAuditOption func(options *GetEnterpriseAuditOptions)
+
+// GetEnterpriseAuditEnterpriseIdentifier sets the EnterpriseIdentifier property of GetEnterpriseAuditOptions.
+// Enterprise ID.
+func GetEnterpriseAuditEnterpriseIdentifier(enterpriseIdentifier string) GetEnterpriseAuditOption {
+	return func(options *GetEnterpriseAuditOptions) {
+		options.EnterpriseIdentifier = core.StringPtr(enterpriseIdentifier)
+	}
+}
+
+// GetEnterpriseAuditAuditlogIdentifier sets the AuditlogIdentifier property of GetEnterpriseAuditOptions. Auditlog
+// ID.
+func GetEnterpriseAuditAuditlogIdentifier(auditlogIdentifier string) GetEnterpriseAuditOption {
+	return func(options *GetEnterpriseAuditOptions) {
+		options.AuditlogIdentifier = core.StringPtr(auditlogIdentifier)
+	}
+}
+
+// GetEnterpriseAuditLookupnames sets the Lookupnames property of GetEnterpriseAuditOptions. Auditlog Lookup Names
+// - by default names are not returned in auditlog.
+func GetEnterpriseAuditLookupnames(lookupnames bool) GetEnterpriseAuditOption {
+	return func(options *GetEnterpriseAuditOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// GetEnterpriseAuditHeader sets the Headers property of GetEnterpriseAuditOptions. Allows users to set headers on
+// API requests.
+func GetEnterpriseAuditHeader(name string, value string) GetEnterpriseAuditOption {
+	return func(options *GetEnterpriseAuditOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetEnterpriseAuditOptionsWith returns GetEnterpriseAuditOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetEnterpriseAuditOptionsWith(options ...GetEnterpriseAuditOption) *GetEnterpriseAuditOptions {
+	getEnterpriseAuditOptions := &GetEnterpriseAuditOptions{}
+	for _, option := range options {
+		option(getEnterpriseAuditOptions)
+	}
+	return getEnterpriseAuditOptions
+}
+
+// GetEnterpriseAuditWith is an alternate form of the GetEnterpriseAuditWithContext method which takes functional
+// options instead of a GetEnterpriseAuditOptions struct. GetEnterpriseAuditEnterpriseIdentifier and
+// GetEnterpriseAuditAuditlogIdentifier are required.
+func (catalogManagement *CatalogManagementV1) GetEnterpriseAuditWith(ctx context.Context, options ...GetEnterpriseAuditOption) (result *AuditLog, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetEnterpriseAuditWithContext(ctx, catalogManagement.NewGetEnterpriseAuditOptionsWith(options...))
+}
+
+// GetConsumptionOfferingsOption : A functional option that sets a property of GetConsumptionOfferingsOptions (see GetConsumptionOfferingsWith).
+type GetConsumptionOfferingsOption func(options *GetConsumptionOfferingsOptions)
+
+// GetConsumptionOfferingsDigest sets the Digest property of GetConsumptionOfferingsOptions. true - Strip down the
+// content of what is returned.
+func GetConsumptionOfferingsDigest(digest bool) GetConsumptionOfferingsOption {
+	return func(options *GetConsumptionOfferingsOptions) {
+		options.Digest = core.BoolPtr(digest)
+	}
+}
+
+// GetConsumptionOfferingsCatalog sets the Catalog property of GetConsumptionOfferingsOptions. catalog id.
+func GetConsumptionOfferingsCatalog(catalog string) GetConsumptionOfferingsOption {
+	return func(options *GetConsumptionOfferingsOptions) {
+		options.Catalog = core.StringPtr(catalog)
+	}
+}
+
+// GetConsumptionOfferingsSelect sets the Select property of GetConsumptionOfferingsOptions. What should be
+// selected.
+func GetConsumptionOfferingsSelect(selectVar string) GetConsumptionOfferingsOption {
+	return func(options *GetConsumptionOfferingsOptions) {
+		options.Select = core.StringPtr(selectVar)
+	}
+}
+
+// GetConsumptionOfferingsIncludeHidden sets the IncludeHidden property of GetConsumptionOfferingsOptions. true -
+// include offerings which have been marked as hidden.
+func GetConsumptionOfferingsIncludeHidden(includeHidden bool) GetConsumptionOfferingsOption {
+	return func(options *GetConsumptionOfferingsOptions) {
+		options.IncludeHidden = core.BoolPtr(includeHidden)
+	}
+}
+
+// GetConsumptionOfferingsLimit sets the Limit property of GetConsumptionOfferingsOptions. number or results to
+// return.
+func GetConsumptionOfferingsLimit(limit int64) GetConsumptionOfferingsOption {
+	return func(options *GetConsumptionOfferingsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// GetConsumptionOfferingsOffset sets the Offset property of GetConsumptionOfferingsOptions. number of results to
+// skip before returning values.
+func GetConsumptionOfferingsOffset(offset int64) GetConsumptionOfferingsOption {
+	return func(options *GetConsumptionOfferingsOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// GetConsumptionOfferingsHeader sets the Headers property of GetConsumptionOfferingsOptions. Allows users to set
+// headers on API requests.
+func GetConsumptionOfferingsHeader(name string, value string) GetConsumptionOfferingsOption {
+	return func(options *GetConsumptionOfferingsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetConsumptionOfferingsOptionsWith returns GetConsumptionOfferingsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetConsumptionOfferingsOptionsWith(options ...GetConsumptionOfferingsOption) *GetConsumptionOfferingsOptions {
+	getConsumptionOfferingsOptions := &GetConsumptionOfferingsOptions{}
+	for _, option := range options {
+		option(getConsumptionOfferingsOptions)
+	}
+	return getConsumptionOfferingsOptions
+}
+
+// GetConsumptionOfferingsWith is an alternate form of the GetConsumptionOfferingsWithContext method which takes
+// functional options instead of a GetConsumptionOfferingsOptions struct.
+func (catalogManagement *CatalogManagementV1) GetConsumptionOfferingsWith(ctx context.Context, options ...GetConsumptionOfferingsOption) (result *OfferingSearchResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetConsumptionOfferingsWithContext(ctx, catalogManagement.NewGetConsumptionOfferingsOptionsWith(options...))
+}
+
+// ListOfferingsOption : A functional option that sets a property of ListOfferingsOptions (see ListOfferingsWith).
+type ListOfferingsOption func(options *ListOfferingsOptions)
+
+// ListOfferingsCatalogIdentifier sets the CatalogIdentifier property of ListOfferingsOptions. Catalog identifier.
+func ListOfferingsCatalogIdentifier(catalogIdentifier string) ListOfferingsOption {
+	return func(options *ListOfferingsOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ListOfferingsDigest sets the Digest property of ListOfferingsOptions. true - Strip down the content of what is
+// returned.
+func ListOfferingsDigest(digest bool) ListOfferingsOption {
+	return func(options *ListOfferingsOptions) {
+		options.Digest = core.BoolPtr(digest)
+	}
+}
+
+// ListOfferingsLimit sets the Limit property of ListOfferingsOptions. The maximum number of results to return.
+func ListOfferingsLimit(limit int64) ListOfferingsOption {
+	return func(options *ListOfferingsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListOfferingsOffset sets the Offset property of ListOfferingsOptions. The number of results to skip before
+// returning values.
+func ListOfferingsOffset(offset int64) ListOfferingsOption {
+	return func(options *ListOfferingsOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// ListOfferingsName sets the Name property of ListOfferingsOptions. Only return results that contain the specified
+// string.
+func ListOfferingsName(nameVar string) ListOfferingsOption {
+	return func(options *ListOfferingsOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListOfferingsSort sets the Sort property of ListOfferingsOptions. The field on which the output is sorted.
+func ListOfferingsSort(sort string) ListOfferingsOption {
+	return func(options *ListOfferingsOptions) {
+		options.Sort = core.StringPtr(sort)
+	}
+}
+
+// ListOfferingsIncludeHidden sets the IncludeHidden property of ListOfferingsOptions. true - include offerings
+// which have been marked as hidden.
+func ListOfferingsIncludeHidden(includeHidden bool) ListOfferingsOption {
+	return func(options *ListOfferingsOptions) {
+		options.IncludeHidden = core.BoolPtr(includeHidden)
+	}
+}
+
+// ListOfferingsHeader sets the Headers property of ListOfferingsOptions. Allows users to set headers on API
+// requests.
+func ListOfferingsHeader(name string, value string) ListOfferingsOption {
+	return func(options *ListOfferingsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListOfferingsOptionsWith returns ListOfferingsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewListOfferingsOptionsWith(options ...ListOfferingsOption) *ListOfferingsOptions {
+	listOfferingsOptions := &ListOfferingsOptions{}
+	for _, option := range options {
+		option(listOfferingsOptions)
+	}
+	return listOfferingsOptions
+}
+
+// ListOfferingsWith is an alternate form of the ListOfferingsWithContext method which takes functional options
+// instead of a ListOfferingsOptions struct. ListOfferingsCatalogIdentifier is required.
+func (catalogManagement *CatalogManagementV1) ListOfferingsWith(ctx context.Context, options ...ListOfferingsOption) (result *OfferingSearchResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.ListOfferingsWithContext(ctx, catalogManagement.NewListOfferingsOptionsWith(options...))
+}
+
+// CreateOfferingOption : A functional option that sets a property of CreateOfferingOptions (see CreateOfferingWith).
+type CreateOfferingOption func(options *CreateOfferingOptions)
+
+// CreateOfferingCatalogIdentifier sets the CatalogIdentifier property of CreateOfferingOptions. Catalog
+// identifier.
+func CreateOfferingCatalogIdentifier(catalogIdentifier string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// CreateOfferingID sets the ID property of CreateOfferingOptions. unique id.
+func CreateOfferingID(id string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// CreateOfferingRev sets the Rev property of CreateOfferingOptions. Cloudant revision.
+func CreateOfferingRev(rev string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Rev = core.StringPtr(rev)
+	}
+}
+
+// CreateOfferingURL sets the URL property of CreateOfferingOptions. The url for this specific offering.
+func CreateOfferingURL(url string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.URL = core.StringPtr(url)
+	}
+}
+
+// CreateOfferingCRN sets the CRN property of CreateOfferingOptions. The crn for this specific offering.
+func CreateOfferingCRN(crn string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.CRN = core.StringPtr(crn)
+	}
+}
+
+// CreateOfferingLabel sets the Label property of CreateOfferingOptions. Display Name in the requested language.
+func CreateOfferingLabel(label string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Label = core.StringPtr(label)
+	}
+}
+
+// CreateOfferingLabelI18n sets the LabelI18n property of CreateOfferingOptions. A map of translated strings, by
+// language code.
+func CreateOfferingLabelI18n(labelI18n map[string]string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.LabelI18n = labelI18n
+	}
+}
+
+// CreateOfferingName sets the Name property of CreateOfferingOptions. The programmatic name of this offering.
+func CreateOfferingName(nameVar string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateOfferingOfferingIconURL sets the OfferingIconURL property of CreateOfferingOptions. URL for an icon
+// associated with this offering.
+func CreateOfferingOfferingIconURL(offeringIconURL string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.OfferingIconURL = core.StringPtr(offeringIconURL)
+	}
+}
+
+// CreateOfferingOfferingDocsURL sets the OfferingDocsURL property of CreateOfferingOptions. URL for an additional
+// docs with this offering.
+func CreateOfferingOfferingDocsURL(offeringDocsURL string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.OfferingDocsURL = core.StringPtr(offeringDocsURL)
+	}
+}
+
+// CreateOfferingOfferingSupportURL sets the OfferingSupportURL property of CreateOfferingOptions. [deprecated] -
+// Use offering.support instead.
+func CreateOfferingOfferingSupportURL(offeringSupportURL string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.OfferingSupportURL = core.StringPtr(offeringSupportURL)
+	}
+}
+
+// CreateOfferingTags sets the Tags property of CreateOfferingOptions. List of tags associated with this catalog.
+func CreateOfferingTags(tags ...string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// CreateOfferingKeywords sets the Keywords property of CreateOfferingOptions. List of keywords associated with
+// offering, typically used to search for it.
+func CreateOfferingKeywords(keywords ...string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Keywords = append(options.Keywords, keywords...)
+	}
+}
+
+// CreateOfferingRating sets the Rating property of CreateOfferingOptions. Repository info for offerings.
+func CreateOfferingRating(rating *Rating) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Rating = rating
+	}
+}
+
+// CreateOfferingCreated sets the Created property of CreateOfferingOptions. The date and time this catalog was
+// created.
+func CreateOfferingCreated(created *strfmt.DateTime) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Created = created
+	}
+}
+
+// CreateOfferingUpdated sets the Updated property of CreateOfferingOptions. The date and time this catalog was
+// last updated.
+func CreateOfferingUpdated(updated *strfmt.DateTime) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Updated = updated
+	}
+}
+
+// CreateOfferingShortDescription sets the ShortDescription property of CreateOfferingOptions. Short description in
+// the requested language.
+func CreateOfferingShortDescription(shortDescription string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.ShortDescription = core.StringPtr(shortDescription)
+	}
+}
+
+// CreateOfferingShortDescriptionI18n sets the ShortDescriptionI18n property of CreateOfferingOptions. A map of
+// translated strings, by language code.
+func CreateOfferingShortDescriptionI18n(shortDescriptionI18n map[string]string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.ShortDescriptionI18n = shortDescriptionI18n
+	}
+}
+
+// CreateOfferingLongDescription sets the LongDescription property of CreateOfferingOptions. Long description in
+// the requested language.
+func CreateOfferingLongDescription(longDescription string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.LongDescription = core.StringPtr(longDescription)
+	}
+}
+
+// CreateOfferingLongDescriptionI18n sets the LongDescriptionI18n property of CreateOfferingOptions. A map of
+// translated strings, by language code.
+func CreateOfferingLongDescriptionI18n(longDescriptionI18n map[string]string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.LongDescriptionI18n = longDescriptionI18n
+	}
+}
+
+// CreateOfferingFeatures sets the Features property of CreateOfferingOptions. list of features associated with
+// this offering.
+func CreateOfferingFeatures(features ...Feature) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Features = append(options.Features, features...)
+	}
+}
+
+// CreateOfferingKinds sets the Kinds property of CreateOfferingOptions. Array of kind.
+func CreateOfferingKinds(kinds ...Kind) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Kinds = append(options.Kinds, kinds...)
+	}
+}
+
+// CreateOfferingPcManaged sets the PcManaged property of CreateOfferingOptions. Offering is managed by Partner
+// Center.
+func CreateOfferingPcManaged(pcManaged bool) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.PcManaged = core.BoolPtr(pcManaged)
+	}
+}
+
+// CreateOfferingPublishApproved sets the PublishApproved property of CreateOfferingOptions. Offering has been
+// approved to publish to permitted to IBM or Public Catalog.
+func CreateOfferingPublishApproved(publishApproved bool) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.PublishApproved = core.BoolPtr(publishApproved)
+	}
+}
+
+// CreateOfferingShareWithAll sets the ShareWithAll property of CreateOfferingOptions. Denotes public availability
+// of an Offering - if share_enabled is true.
+func CreateOfferingShareWithAll(shareWithAll bool) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.ShareWithAll = core.BoolPtr(shareWithAll)
+	}
+}
+
+// CreateOfferingShareWithIBM sets the ShareWithIBM property of CreateOfferingOptions. Denotes IBM employee
+// availability of an Offering - if share_enabled is true.
+func CreateOfferingShareWithIBM(shareWithIBM bool) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.ShareWithIBM = core.BoolPtr(shareWithIBM)
+	}
+}
+
+// CreateOfferingShareEnabled sets the ShareEnabled property of CreateOfferingOptions. Denotes sharing including
+// access list availability of an Offering is enabled.
+func CreateOfferingShareEnabled(shareEnabled bool) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.ShareEnabled = core.BoolPtr(shareEnabled)
+	}
+}
+
+// CreateOfferingPermitRequestIBMPublicPublish sets the PermitRequestIBMPublicPublish property of
+// CreateOfferingOptions. Is it permitted to request publishing to IBM or Public.
+func CreateOfferingPermitRequestIBMPublicPublish(permitRequestIBMPublicPublish bool) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.PermitRequestIBMPublicPublish = core.BoolPtr(permitRequestIBMPublicPublish)
+	}
+}
+
+// CreateOfferingIBMPublishApproved sets the IBMPublishApproved property of CreateOfferingOptions. Indicates if
+// this offering has been approved for use by all IBMers.
+func CreateOfferingIBMPublishApproved(ibmPublishApproved bool) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.IBMPublishApproved = core.BoolPtr(ibmPublishApproved)
+	}
+}
+
+// CreateOfferingPublicPublishApproved sets the PublicPublishApproved property of CreateOfferingOptions. Indicates
+// if this offering has been approved for use by all IBM Cloud users.
+func CreateOfferingPublicPublishApproved(publicPublishApproved bool) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.PublicPublishApproved = core.BoolPtr(publicPublishApproved)
+	}
+}
+
+// CreateOfferingPublicOriginalCRN sets the PublicOriginalCRN property of CreateOfferingOptions. The original
+// offering CRN that this publish entry came from.
+func CreateOfferingPublicOriginalCRN(publicOriginalCRN string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.PublicOriginalCRN = core.StringPtr(publicOriginalCRN)
+	}
+}
+
+// CreateOfferingPublishPublicCRN sets the PublishPublicCRN property of CreateOfferingOptions. The crn of the
+// public catalog entry of this offering.
+func CreateOfferingPublishPublicCRN(publishPublicCRN string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.PublishPublicCRN = core.StringPtr(publishPublicCRN)
+	}
+}
+
+// CreateOfferingPortalApprovalRecord sets the PortalApprovalRecord property of CreateOfferingOptions. The portal's
+// approval record ID.
+func CreateOfferingPortalApprovalRecord(portalApprovalRecord string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.PortalApprovalRecord = core.StringPtr(portalApprovalRecord)
+	}
+}
+
+// CreateOfferingPortalUIURL sets the PortalUIURL property of CreateOfferingOptions. The portal UI URL.
+func CreateOfferingPortalUIURL(portalUIURL string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.PortalUIURL = core.StringPtr(portalUIURL)
+	}
+}
+
+// CreateOfferingCatalogID sets the CatalogID property of CreateOfferingOptions. The id of the catalog containing
+// this offering.
+func CreateOfferingCatalogID(catalogID string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.CatalogID = core.StringPtr(catalogID)
+	}
+}
+
+// CreateOfferingCatalogName sets the CatalogName property of CreateOfferingOptions. The name of the catalog.
+func CreateOfferingCatalogName(catalogName string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.CatalogName = core.StringPtr(catalogName)
+	}
+}
+
+// CreateOfferingMetadata sets the Metadata property of CreateOfferingOptions. Map of metadata values for this
+// offering.
+func CreateOfferingMetadata(metadata map[string]interface{}) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Metadata = metadata
+	}
+}
+
+// CreateOfferingDisclaimer sets the Disclaimer property of CreateOfferingOptions. A disclaimer for this offering.
+func CreateOfferingDisclaimer(disclaimer string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Disclaimer = core.StringPtr(disclaimer)
+	}
+}
+
+// CreateOfferingHidden sets the Hidden property of CreateOfferingOptions. Determine if this offering should be
+// displayed in the Consumption UI.
+func CreateOfferingHidden(hidden bool) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Hidden = core.BoolPtr(hidden)
+	}
+}
+
+// CreateOfferingProvider sets the Provider property of CreateOfferingOptions. Deprecated - Provider of this
+// offering.
+func CreateOfferingProvider(provider string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Provider = core.StringPtr(provider)
+	}
+}
+
+// CreateOfferingProviderInfo sets the ProviderInfo property of CreateOfferingOptions. Information on the provider
+// for this offering, or omitted if no provider information is given.
+func CreateOfferingProviderInfo(providerInfo *ProviderInfo) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.ProviderInfo = providerInfo
+	}
+}
+
+// CreateOfferingRepoInfo sets the RepoInfo property of CreateOfferingOptions. Repository info for offerings.
+func CreateOfferingRepoInfo(repoInfo *RepoInfo) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.RepoInfo = repoInfo
+	}
+}
+
+// CreateOfferingImagePullKeys sets the ImagePullKeys property of CreateOfferingOptions. Image pull keys for this
+// offering.
+func CreateOfferingImagePullKeys(imagePullKeys ...ImagePullKey) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.ImagePullKeys = append(options.ImagePullKeys, imagePullKeys...)
+	}
+}
+
+// CreateOfferingSupport sets the Support property of CreateOfferingOptions. Offering Support information.
+func CreateOfferingSupport(support *Support) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Support = support
+	}
+}
+
+// CreateOfferingMedia sets the Media property of CreateOfferingOptions. A list of media items related to this
+// offering.
+func CreateOfferingMedia(media ...MediaItem) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Media = append(options.Media, media...)
+	}
+}
+
+// CreateOfferingDeprecatePending sets the DeprecatePending property of CreateOfferingOptions. Deprecation
+// information for an Offering.
+func CreateOfferingDeprecatePending(deprecatePending *DeprecatePending) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.DeprecatePending = deprecatePending
+	}
+}
+
+// CreateOfferingProductKind sets the ProductKind property of CreateOfferingOptions. The product kind.
+func CreateOfferingProductKind(productKind string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.ProductKind = core.StringPtr(productKind)
+	}
+}
+
+// CreateOfferingBadges sets the Badges property of CreateOfferingOptions. A list of badges for this offering.
+func CreateOfferingBadges(badges ...Badge) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		options.Badges = append(options.Badges, badges...)
+	}
+}
+
+// CreateOfferingHeader sets the Headers property of CreateOfferingOptions. Allows users to set headers on API
+// requests.
+func CreateOfferingHeader(name string, value string) CreateOfferingOption {
+	return func(options *CreateOfferingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateOfferingOptionsWith returns CreateOfferingOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewCreateOfferingOptionsWith(options ...CreateOfferingOption) *CreateOfferingOptions {
+	createOfferingOptions := &CreateOfferingOptions{}
+	for _, option := range options {
+		option(createOfferingOptions)
+	}
+	return createOfferingOptions
+}
+
+// CreateOfferingWith is an alternate form of the CreateOfferingWithContext method which takes functional options
+// instead of a CreateOfferingOptions struct. CreateOfferingCatalogIdentifier is required.
+func (catalogManagement *CatalogManagementV1) CreateOfferingWith(ctx context.Context, options ...CreateOfferingOption) (result *Offering, response *core.DetailedResponse, err error) {
+	return catalogManagement.CreateOfferingWithContext(ctx, catalogManagement.NewCreateOfferingOptionsWith(options...))
+}
+
+// ImportOfferingVersionOption : A functional option that sets a property of ImportOfferingVersionOptions (see ImportOfferingVersionWith).
+type ImportOfferingVersionOption func(options *ImportOfferingVersionOptions)
+
+// ImportOfferingVersionCatalogIdentifier sets the CatalogIdentifier property of ImportOfferingVersionOptions.
+// Catalog identifier.
+func ImportOfferingVersionCatalogIdentifier(catalogIdentifier string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ImportOfferingVersionOfferingID sets the OfferingID property of ImportOfferingVersionOptions. Offering
+// identification.
+func ImportOfferingVersionOfferingID(offeringID string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// ImportOfferingVersionTags sets the Tags property of ImportOfferingVersionOptions. Tags array.
+func ImportOfferingVersionTags(tags ...string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// ImportOfferingVersionContent sets the Content property of ImportOfferingVersionOptions. Byte array representing
+// the content to be imported.
+func ImportOfferingVersionContent(content *[]byte) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.Content = content
+	}
+}
+
+// ImportOfferingVersionName sets the Name property of ImportOfferingVersionOptions. Name of version.
+func ImportOfferingVersionName(nameVar string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ImportOfferingVersionLabel sets the Label property of ImportOfferingVersionOptions. Display name of version.
+func ImportOfferingVersionLabel(label string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.Label = core.StringPtr(label)
+	}
+}
+
+// ImportOfferingVersionInstallKind sets the InstallKind property of ImportOfferingVersionOptions. Install type.
+func ImportOfferingVersionInstallKind(installKind string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.InstallKind = core.StringPtr(installKind)
+	}
+}
+
+// ImportOfferingVersionTargetKinds sets the TargetKinds property of ImportOfferingVersionOptions. Deployment
+// target of the content being onboarded.
+func ImportOfferingVersionTargetKinds(targetKinds ...string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.TargetKinds = append(options.TargetKinds, targetKinds...)
+	}
+}
+
+// ImportOfferingVersionFormatKind sets the FormatKind property of ImportOfferingVersionOptions. Format of content
+// being onboarded.
+func ImportOfferingVersionFormatKind(formatKind string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.FormatKind = core.StringPtr(formatKind)
+	}
+}
+
+// ImportOfferingVersionProductKind sets the ProductKind property of ImportOfferingVersionOptions. Optional product
+// kind for the software being onboarded.
+func ImportOfferingVersionProductKind(productKind string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.ProductKind = core.StringPtr(productKind)
+	}
+}
+
+// ImportOfferingVersionSha sets the Sha property of ImportOfferingVersionOptions. SHA256 fingerprint of the image
+// file.
+func ImportOfferingVersionSha(sha string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.Sha = core.StringPtr(sha)
+	}
+}
+
+// ImportOfferingVersionVersion sets the Version property of ImportOfferingVersionOptions. Semantic version of the
+// software being onboarded.
+func ImportOfferingVersionVersion(version string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.Version = core.StringPtr(version)
+	}
+}
+
+// ImportOfferingVersionFlavor sets the Flavor property of ImportOfferingVersionOptions. Version Flavor
+// Information.
+func ImportOfferingVersionFlavor(flavor *Flavor) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.Flavor = flavor
+	}
+}
+
+// ImportOfferingVersionMetadata sets the Metadata property of ImportOfferingVersionOptions. Generic data to be
+// included with content being onboarded.
+func ImportOfferingVersionMetadata(metadata *ImportOfferingBodyMetadata) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.Metadata = metadata
+	}
+}
+
+// ImportOfferingVersionWorkingDirectory sets the WorkingDirectory property of ImportOfferingVersionOptions.
+// Optional - The sub-folder within the specified tgz file that contains the software being onboarded.
+func ImportOfferingVersionWorkingDirectory(workingDirectory string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.WorkingDirectory = core.StringPtr(workingDirectory)
+	}
+}
+
+// ImportOfferingVersionZipurl sets the Zipurl property of ImportOfferingVersionOptions. URL path to zip location.
+func ImportOfferingVersionZipurl(zipurl string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.Zipurl = core.StringPtr(zipurl)
+	}
+}
+
+// ImportOfferingVersionTargetVersion sets the TargetVersion property of ImportOfferingVersionOptions. The semver
+// value for this new version, if not found in the zip url package content.
+func ImportOfferingVersionTargetVersion(targetVersion string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.TargetVersion = core.StringPtr(targetVersion)
+	}
+}
+
+// ImportOfferingVersionIncludeConfig sets the IncludeConfig property of ImportOfferingVersionOptions. Add all
+// possible configuration values to this version when importing.
+func ImportOfferingVersionIncludeConfig(includeConfig bool) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.IncludeConfig = core.BoolPtr(includeConfig)
+	}
+}
+
+// ImportOfferingVersionIsVsi sets the IsVsi property of ImportOfferingVersionOptions. Indicates that the current
+// terraform template is used to install a virtual server image.
+func ImportOfferingVersionIsVsi(isVsi bool) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.IsVsi = core.BoolPtr(isVsi)
+	}
+}
+
+// ImportOfferingVersionRepotype sets the Repotype property of ImportOfferingVersionOptions. The type of repository
+// containing this version.
+func ImportOfferingVersionRepotype(repotype string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.Repotype = core.StringPtr(repotype)
+	}
+}
+
+// ImportOfferingVersionXAuthToken sets the XAuthToken property of ImportOfferingVersionOptions. Authentication
+// token used to access the specified zip file.
+func ImportOfferingVersionXAuthToken(xAuthToken string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		options.XAuthToken = core.StringPtr(xAuthToken)
+	}
+}
+
+// ImportOfferingVersionHeader sets the Headers property of ImportOfferingVersionOptions. Allows users to set
+// headers on API requests.
+func ImportOfferingVersionHeader(name string, value string) ImportOfferingVersionOption {
+	return func(options *ImportOfferingVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewImportOfferingVersionOptionsWith returns ImportOfferingVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewImportOfferingVersionOptionsWith(options ...ImportOfferingVersionOption) *ImportOfferingVersionOptions {
+	importOfferingVersionOptions := &ImportOfferingVersionOptions{}
+	for _, option := range options {
+		option(importOfferingVersionOptions)
+	}
+	return importOfferingVersionOptions
+}
+
+// ImportOfferingVersionWith is an alternate form of the ImportOfferingVersionWithContext method which takes
+// functional options instead of an ImportOfferingVersionOptions struct. ImportOfferingVersionCatalogIdentifier and
+// ImportOfferingVersionOfferingID are required.
+func (catalogManagement *CatalogManagementV1) ImportOfferingVersionWith(ctx context.Context, options ...ImportOfferingVersionOption) (result *Offering, response *core.DetailedResponse, err error) {
+	return catalogManagement.ImportOfferingVersionWithContext(ctx, catalogManagement.NewImportOfferingVersionOptionsWith(options...))
+}
+
+// ImportOfferingOption : A functional option that sets a property of ImportOfferingOptions (see ImportOfferingWith).
+type ImportOfferingOption func(options *ImportOfferingOptions)
+
+// ImportOfferingCatalogIdentifier sets the CatalogIdentifier property of ImportOfferingOptions. Catalog
+// identifier.
+func ImportOfferingCatalogIdentifier(catalogIdentifier string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ImportOfferingTags sets the Tags property of ImportOfferingOptions. Tags array.
+func ImportOfferingTags(tags ...string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// ImportOfferingContent sets the Content property of ImportOfferingOptions. Byte array representing the content to
+// be imported.
+func ImportOfferingContent(content *[]byte) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.Content = content
+	}
+}
+
+// ImportOfferingName sets the Name property of ImportOfferingOptions. Name of version.
+func ImportOfferingName(nameVar string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ImportOfferingLabel sets the Label property of ImportOfferingOptions. Display name of version.
+func ImportOfferingLabel(label string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.Label = core.StringPtr(label)
+	}
+}
+
+// ImportOfferingInstallKind sets the InstallKind property of ImportOfferingOptions. Install type.
+func ImportOfferingInstallKind(installKind string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.InstallKind = core.StringPtr(installKind)
+	}
+}
+
+// ImportOfferingTargetKinds sets the TargetKinds property of ImportOfferingOptions. Deployment target of the
+// content being onboarded.
+func ImportOfferingTargetKinds(targetKinds ...string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.TargetKinds = append(options.TargetKinds, targetKinds...)
+	}
+}
+
+// ImportOfferingFormatKind sets the FormatKind property of ImportOfferingOptions. Format of content being
+// onboarded.
+func ImportOfferingFormatKind(formatKind string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.FormatKind = core.StringPtr(formatKind)
+	}
+}
+
+// ImportOfferingProductKind sets the ProductKind property of ImportOfferingOptions. Optional product kind for the
+// software being onboarded.
+func ImportOfferingProductKind(productKind string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.ProductKind = core.StringPtr(productKind)
+	}
+}
+
+// ImportOfferingSha sets the Sha property of ImportOfferingOptions. SHA256 fingerprint of the image file.
+func ImportOfferingSha(sha string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.Sha = core.StringPtr(sha)
+	}
+}
+
+// ImportOfferingVersion sets the Version property of ImportOfferingOptions. Semantic version of the software being
+// onboarded.
+func ImportOfferingVersion(version string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.Version = core.StringPtr(version)
+	}
+}
+
+// ImportOfferingFlavor sets the Flavor property of ImportOfferingOptions. Version Flavor Information.
+func ImportOfferingFlavor(flavor *Flavor) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.Flavor = flavor
+	}
+}
+
+// ImportOfferingMetadata sets the Metadata property of ImportOfferingOptions. Generic data to be included with
+// content being onboarded.
+func ImportOfferingMetadata(metadata *ImportOfferingBodyMetadata) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.Metadata = metadata
+	}
+}
+
+// ImportOfferingWorkingDirectory sets the WorkingDirectory property of ImportOfferingOptions. Optional - The
+// sub-folder within the specified tgz file that contains the software being onboarded.
+func ImportOfferingWorkingDirectory(workingDirectory string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.WorkingDirectory = core.StringPtr(workingDirectory)
+	}
+}
+
+// ImportOfferingZipurl sets the Zipurl property of ImportOfferingOptions. URL path to zip location.
+func ImportOfferingZipurl(zipurl string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.Zipurl = core.StringPtr(zipurl)
+	}
+}
+
+// ImportOfferingOfferingID sets the OfferingID property of ImportOfferingOptions. Re-use the specified offeringID
+// during import.
+func ImportOfferingOfferingID(offeringID string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// ImportOfferingTargetVersion sets the TargetVersion property of ImportOfferingOptions. The semver value for this
+// new version.
+func ImportOfferingTargetVersion(targetVersion string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.TargetVersion = core.StringPtr(targetVersion)
+	}
+}
+
+// ImportOfferingIncludeConfig sets the IncludeConfig property of ImportOfferingOptions. Add all possible
+// configuration items when creating this version.
+func ImportOfferingIncludeConfig(includeConfig bool) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.IncludeConfig = core.BoolPtr(includeConfig)
+	}
+}
+
+// ImportOfferingIsVsi sets the IsVsi property of ImportOfferingOptions. Indicates that the current terraform
+// template is used to install a virtual server image.
+func ImportOfferingIsVsi(isVsi bool) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.IsVsi = core.BoolPtr(isVsi)
+	}
+}
+
+// ImportOfferingRepotype sets the Repotype property of ImportOfferingOptions. The type of repository containing
+// this version.
+func ImportOfferingRepotype(repotype string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.Repotype = core.StringPtr(repotype)
+	}
+}
+
+// ImportOfferingXAuthToken sets the XAuthToken property of ImportOfferingOptions. Authentication token used to
+// access the specified zip file.
+func ImportOfferingXAuthToken(xAuthToken string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		options.XAuthToken = core.StringPtr(xAuthToken)
+	}
+}
+
+// ImportOfferingHeader sets the Headers property of ImportOfferingOptions. Allows users to set headers on API
+// requests.
+func ImportOfferingHeader(name string, value string) ImportOfferingOption {
+	return func(options *ImportOfferingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewImportOfferingOptionsWith returns ImportOfferingOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewImportOfferingOptionsWith(options ...ImportOfferingOption) *ImportOfferingOptions {
+	importOfferingOptions := &ImportOfferingOptions{}
+	for _, option := range options {
+		option(importOfferingOptions)
+	}
+	return importOfferingOptions
+}
+
+// ImportOfferingWith is an alternate form of the ImportOfferingWithContext method which takes functional options
+// instead of an ImportOfferingOptions struct. ImportOfferingCatalogIdentifier is required.
+func (catalogManagement *CatalogManagementV1) ImportOfferingWith(ctx context.Context, options ...ImportOfferingOption) (result *Offering, response *core.DetailedResponse, err error) {
+	return catalogManagement.ImportOfferingWithContext(ctx, catalogManagement.NewImportOfferingOptionsWith(options...))
+}
+
+// ReloadOfferingOption : A functional option that sets a property of ReloadOfferingOptions (see ReloadOfferingWith).
+type ReloadOfferingOption func(options *ReloadOfferingOptions)
+
+// ReloadOfferingCatalogIdentifier sets the CatalogIdentifier property of ReloadOfferingOptions. Catalog
+// identifier.
+func ReloadOfferingCatalogIdentifier(catalogIdentifier string) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ReloadOfferingOfferingID sets the OfferingID property of ReloadOfferingOptions. Offering identification.
+func ReloadOfferingOfferingID(offeringID string) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// ReloadOfferingTargetVersion sets the TargetVersion property of ReloadOfferingOptions. The semver value for this
+// new version.
+func ReloadOfferingTargetVersion(targetVersion string) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		options.TargetVersion = core.StringPtr(targetVersion)
+	}
+}
+
+// ReloadOfferingTags sets the Tags property of ReloadOfferingOptions. Tags array.
+func ReloadOfferingTags(tags ...string) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// ReloadOfferingContent sets the Content property of ReloadOfferingOptions. byte array representing the content to
+// be imported.
+func ReloadOfferingContent(content *[]byte) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		options.Content = content
+	}
+}
+
+// ReloadOfferingTargetKinds sets the TargetKinds property of ReloadOfferingOptions. Target kinds.
+func ReloadOfferingTargetKinds(targetKinds ...string) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		options.TargetKinds = append(options.TargetKinds, targetKinds...)
+	}
+}
+
+// ReloadOfferingFormatKind sets the FormatKind property of ReloadOfferingOptions. Format of content being
+// onboarded.
+func ReloadOfferingFormatKind(formatKind string) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		options.FormatKind = core.StringPtr(formatKind)
+	}
+}
+
+// ReloadOfferingFlavor sets the Flavor property of ReloadOfferingOptions. Version Flavor Information.
+func ReloadOfferingFlavor(flavor *Flavor) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		options.Flavor = flavor
+	}
+}
+
+// ReloadOfferingWorkingDirectory sets the WorkingDirectory property of ReloadOfferingOptions. Optional - The
+// sub-folder within the specified tgz file that contains the software being onboarded.
+func ReloadOfferingWorkingDirectory(workingDirectory string) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		options.WorkingDirectory = core.StringPtr(workingDirectory)
+	}
+}
+
+// ReloadOfferingZipurl sets the Zipurl property of ReloadOfferingOptions. URL path to zip location.
+func ReloadOfferingZipurl(zipurl string) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		options.Zipurl = core.StringPtr(zipurl)
+	}
+}
+
+// ReloadOfferingRepoType sets the RepoType property of ReloadOfferingOptions. The type of repository containing
+// this version.
+func ReloadOfferingRepoType(repoType string) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		options.RepoType = core.StringPtr(repoType)
+	}
+}
+
+// ReloadOfferingHeader sets the Headers property of ReloadOfferingOptions. Allows users to set headers on API
+// requests.
+func ReloadOfferingHeader(name string, value string) ReloadOfferingOption {
+	return func(options *ReloadOfferingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReloadOfferingOptionsWith returns ReloadOfferingOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewReloadOfferingOptionsWith(options ...ReloadOfferingOption) *ReloadOfferingOptions {
+	reloadOfferingOptions := &ReloadOfferingOptions{}
+	for _, option := range options {
+		option(reloadOfferingOptions)
+	}
+	return reloadOfferingOptions
+}
+
+// ReloadOfferingWith is an alternate form of the ReloadOfferingWithContext method which takes functional options
+// instead of a ReloadOfferingOptions struct. ReloadOfferingCatalogIdentifier, ReloadOfferingOfferingID and
+// ReloadOfferingTargetVersion are required.
+func (catalogManagement *CatalogManagementV1) ReloadOfferingWith(ctx context.Context, options ...ReloadOfferingOption) (result *Offering, response *core.DetailedResponse, err error) {
+	return catalogManagement.ReloadOfferingWithContext(ctx, catalogManagement.NewReloadOfferingOptionsWith(options...))
+}
+
+// GetOfferingOption : A functional option that sets a property of GetOfferingOptions (see GetOfferingWith).
+type GetOfferingOption func(options *GetOfferingOptions)
+
+// GetOfferingCatalogIdentifier sets the CatalogIdentifier property of GetOfferingOptions. Catalog identifier.
+func GetOfferingCatalogIdentifier(catalogIdentifier string) GetOfferingOption {
+	return func(options *GetOfferingOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetOfferingOfferingID sets the OfferingID property of GetOfferingOptions. Offering identification.
+func GetOfferingOfferingID(offeringID string) GetOfferingOption {
+	return func(options *GetOfferingOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// GetOfferingType sets the Type property of GetOfferingOptions. Offering Parameter Type.
+func GetOfferingType(typeVar string) GetOfferingOption {
+	return func(options *GetOfferingOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// GetOfferingDigest sets the Digest property of GetOfferingOptions. Return the digest format of the specified
+// offering.
+func GetOfferingDigest(digest bool) GetOfferingOption {
+	return func(options *GetOfferingOptions) {
+		options.Digest = core.BoolPtr(digest)
+	}
+}
+
+// GetOfferingHeader sets the Headers property of GetOfferingOptions. Allows users to set headers on API requests.
+func GetOfferingHeader(name string, value string) GetOfferingOption {
+	return func(options *GetOfferingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingOptionsWith returns GetOfferingOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingOptionsWith(options ...GetOfferingOption) *GetOfferingOptions {
+	getOfferingOptions := &GetOfferingOptions{}
+	for _, option := range options {
+		option(getOfferingOptions)
+	}
+	return getOfferingOptions
+}
+
+// GetOfferingWith is an alternate form of the GetOfferingWithContext method which takes functional options instead
+// of a GetOfferingOptions struct. GetOfferingCatalogIdentifier and GetOfferingOfferingID are required.
+func (catalogManagement *CatalogManagementV1) GetOfferingWith(ctx context.Context, options ...GetOfferingOption) (result *Offering, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingWithContext(ctx, catalogManagement.NewGetOfferingOptionsWith(options...))
+}
+
+// ReplaceOfferingOption : A functional option that sets a property of ReplaceOfferingOptions (see ReplaceOfferingWith).
+type ReplaceOfferingOption func(options *ReplaceOfferingOptions)
+
+// ReplaceOfferingCatalogIdentifier sets the CatalogIdentifier property of ReplaceOfferingOptions. Catalog
+// identifier.
+func ReplaceOfferingCatalogIdentifier(catalogIdentifier string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ReplaceOfferingOfferingID sets the OfferingID property of ReplaceOfferingOptions. Offering identification.
+func ReplaceOfferingOfferingID(offeringID string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// ReplaceOfferingID sets the ID property of ReplaceOfferingOptions. unique id.
+func ReplaceOfferingID(id string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// ReplaceOfferingRev sets the Rev property of ReplaceOfferingOptions. Cloudant revision.
+func ReplaceOfferingRev(rev string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Rev = core.StringPtr(rev)
+	}
+}
+
+// ReplaceOfferingURL sets the URL property of ReplaceOfferingOptions. The url for this specific offering.
+func ReplaceOfferingURL(url string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.URL = core.StringPtr(url)
+	}
+}
+
+// ReplaceOfferingCRN sets the CRN property of ReplaceOfferingOptions. The crn for this specific offering.
+func ReplaceOfferingCRN(crn string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.CRN = core.StringPtr(crn)
+	}
+}
+
+// ReplaceOfferingLabel sets the Label property of ReplaceOfferingOptions. Display Name in the requested language.
+func ReplaceOfferingLabel(label string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Label = core.StringPtr(label)
+	}
+}
+
+// ReplaceOfferingLabelI18n sets the LabelI18n property of ReplaceOfferingOptions. A map of translated strings, by
+// language code.
+func ReplaceOfferingLabelI18n(labelI18n map[string]string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.LabelI18n = labelI18n
+	}
+}
+
+// ReplaceOfferingName sets the Name property of ReplaceOfferingOptions. The programmatic name of this offering.
+func ReplaceOfferingName(nameVar string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ReplaceOfferingOfferingIconURL sets the OfferingIconURL property of ReplaceOfferingOptions. URL for an icon
+// associated with this offering.
+func ReplaceOfferingOfferingIconURL(offeringIconURL string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.OfferingIconURL = core.StringPtr(offeringIconURL)
+	}
+}
+
+// ReplaceOfferingOfferingDocsURL sets the OfferingDocsURL property of ReplaceOfferingOptions. URL for an
+// additional docs with this offering.
+func ReplaceOfferingOfferingDocsURL(offeringDocsURL string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.OfferingDocsURL = core.StringPtr(offeringDocsURL)
+	}
+}
+
+// ReplaceOfferingOfferingSupportURL sets the OfferingSupportURL property of ReplaceOfferingOptions. [deprecated] -
+// Use offering.support instead.
+func ReplaceOfferingOfferingSupportURL(offeringSupportURL string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.OfferingSupportURL = core.StringPtr(offeringSupportURL)
+	}
+}
+
+// ReplaceOfferingTags sets the Tags property of ReplaceOfferingOptions. List of tags associated with this catalog.
+func ReplaceOfferingTags(tags ...string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// ReplaceOfferingKeywords sets the Keywords property of ReplaceOfferingOptions. List of keywords associated with
+// offering, typically used to search for it.
+func ReplaceOfferingKeywords(keywords ...string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Keywords = append(options.Keywords, keywords...)
+	}
+}
+
+// ReplaceOfferingRating sets the Rating property of ReplaceOfferingOptions. Repository info for offerings.
+func ReplaceOfferingRating(rating *Rating) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Rating = rating
+	}
+}
+
+// ReplaceOfferingCreated sets the Created property of ReplaceOfferingOptions. The date and time this catalog was
+// created.
+func ReplaceOfferingCreated(created *strfmt.DateTime) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Created = created
+	}
+}
+
+// ReplaceOfferingUpdated sets the Updated property of ReplaceOfferingOptions. The date and time this catalog was
+// last updated.
+func ReplaceOfferingUpdated(updated *strfmt.DateTime) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Updated = updated
+	}
+}
+
+// ReplaceOfferingShortDescription sets the ShortDescription property of ReplaceOfferingOptions. Short description
+// in the requested language.
+func ReplaceOfferingShortDescription(shortDescription string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.ShortDescription = core.StringPtr(shortDescription)
+	}
+}
+
+// ReplaceOfferingShortDescriptionI18n sets the ShortDescriptionI18n property of ReplaceOfferingOptions. A map of
+// translated strings, by language code.
+func ReplaceOfferingShortDescriptionI18n(shortDescriptionI18n map[string]string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.ShortDescriptionI18n = shortDescriptionI18n
+	}
+}
+
+// ReplaceOfferingLongDescription sets the LongDescription property of ReplaceOfferingOptions. Long description in
+// the requested language.
+func ReplaceOfferingLongDescription(longDescription string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.LongDescription = core.StringPtr(longDescription)
+	}
+}
+
+// ReplaceOfferingLongDescriptionI18n sets the LongDescriptionI18n property of ReplaceOfferingOptions. A map of
+// translated strings, by language code.
+func ReplaceOfferingLongDescriptionI18n(longDescriptionI18n map[string]string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.LongDescriptionI18n = longDescriptionI18n
+	}
+}
+
+// ReplaceOfferingFeatures sets the Features property of ReplaceOfferingOptions. list of features associated with
+// this offering.
+func ReplaceOfferingFeatures(features ...Feature) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Features = append(options.Features, features...)
+	}
+}
+
+// ReplaceOfferingKinds sets the Kinds property of ReplaceOfferingOptions. Array of kind.
+func ReplaceOfferingKinds(kinds ...Kind) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Kinds = append(options.Kinds, kinds...)
+	}
+}
+
+// ReplaceOfferingPcManaged sets the PcManaged property of ReplaceOfferingOptions. Offering is managed by Partner
+// Center.
+func ReplaceOfferingPcManaged(pcManaged bool) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.PcManaged = core.BoolPtr(pcManaged)
+	}
+}
+
+// ReplaceOfferingPublishApproved sets the PublishApproved property of ReplaceOfferingOptions. Offering has been
+// approved to publish to permitted to IBM or Public Catalog.
+func ReplaceOfferingPublishApproved(publishApproved bool) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.PublishApproved = core.BoolPtr(publishApproved)
+	}
+}
+
+// ReplaceOfferingShareWithAll sets the ShareWithAll property of ReplaceOfferingOptions. Denotes public
+// availability of an Offering - if share_enabled is true.
+func ReplaceOfferingShareWithAll(shareWithAll bool) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.ShareWithAll = core.BoolPtr(shareWithAll)
+	}
+}
+
+// ReplaceOfferingShareWithIBM sets the ShareWithIBM property of ReplaceOfferingOptions. Denotes IBM employee
+// availability of an Offering - if share_enabled is true.
+func ReplaceOfferingShareWithIBM(shareWithIBM bool) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.ShareWithIBM = core.BoolPtr(shareWithIBM)
+	}
+}
+
+// ReplaceOfferingShareEnabled sets the ShareEnabled property of ReplaceOfferingOptions. Denotes sharing including
+// access list availability of an Offering is enabled.
+func ReplaceOfferingShareEnabled(shareEnabled bool) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.ShareEnabled = core.BoolPtr(shareEnabled)
+	}
+}
+
+// ReplaceOfferingPermitRequestIBMPublicPublish sets the PermitRequestIBMPublicPublish property of
+// ReplaceOfferingOptions. Is it permitted to request publishing to IBM or Public.
+func ReplaceOfferingPermitRequestIBMPublicPublish(permitRequestIBMPublicPublish bool) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.PermitRequestIBMPublicPublish = core.BoolPtr(permitRequestIBMPublicPublish)
+	}
+}
+
+// ReplaceOfferingIBMPublishApproved sets the IBMPublishApproved property of ReplaceOfferingOptions. Indicates if
+// this offering has been approved for use by all IBMers.
+func ReplaceOfferingIBMPublishApproved(ibmPublishApproved bool) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.IBMPublishApproved = core.BoolPtr(ibmPublishApproved)
+	}
+}
+
+// ReplaceOfferingPublicPublishApproved sets the PublicPublishApproved property of ReplaceOfferingOptions.
+// Indicates if this offering has been approved for use by all IBM Cloud users.
+func ReplaceOfferingPublicPublishApproved(publicPublishApproved bool) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.PublicPublishApproved = core.BoolPtr(publicPublishApproved)
+	}
+}
+
+// ReplaceOfferingPublicOriginalCRN sets the PublicOriginalCRN property of ReplaceOfferingOptions. The original
+// offering CRN that this publish entry came from.
+func ReplaceOfferingPublicOriginalCRN(publicOriginalCRN string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.PublicOriginalCRN = core.StringPtr(publicOriginalCRN)
+	}
+}
+
+// ReplaceOfferingPublishPublicCRN sets the PublishPublicCRN property of ReplaceOfferingOptions. The crn of the
+// public catalog entry of this offering.
+func ReplaceOfferingPublishPublicCRN(publishPublicCRN string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.PublishPublicCRN = core.StringPtr(publishPublicCRN)
+	}
+}
+
+// ReplaceOfferingPortalApprovalRecord sets the PortalApprovalRecord property of ReplaceOfferingOptions. The
+// portal's approval record ID.
+func ReplaceOfferingPortalApprovalRecord(portalApprovalRecord string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.PortalApprovalRecord = core.StringPtr(portalApprovalRecord)
+	}
+}
+
+// ReplaceOfferingPortalUIURL sets the PortalUIURL property of ReplaceOfferingOptions. The portal UI URL.
+func ReplaceOfferingPortalUIURL(portalUIURL string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.PortalUIURL = core.StringPtr(portalUIURL)
+	}
+}
+
+// ReplaceOfferingCatalogID sets the CatalogID property of ReplaceOfferingOptions. The id of the catalog containing
+// this offering.
+func ReplaceOfferingCatalogID(catalogID string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.CatalogID = core.StringPtr(catalogID)
+	}
+}
+
+// ReplaceOfferingCatalogName sets the CatalogName property of ReplaceOfferingOptions. The name of the catalog.
+func ReplaceOfferingCatalogName(catalogName string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.CatalogName = core.StringPtr(catalogName)
+	}
+}
+
+// ReplaceOfferingMetadata sets the Metadata property of ReplaceOfferingOptions. Map of metadata values for this
+// offering.
+func ReplaceOfferingMetadata(metadata map[string]interface{}) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Metadata = metadata
+	}
+}
+
+// ReplaceOfferingDisclaimer sets the Disclaimer property of ReplaceOfferingOptions. A disclaimer for this
+// offering.
+func ReplaceOfferingDisclaimer(disclaimer string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Disclaimer = core.StringPtr(disclaimer)
+	}
+}
+
+// ReplaceOfferingHidden sets the Hidden property of ReplaceOfferingOptions. Determine if this offering should be
+// displayed in the Consumption UI.
+func ReplaceOfferingHidden(hidden bool) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Hidden = core.BoolPtr(hidden)
+	}
+}
+
+// ReplaceOfferingProvider sets the Provider property of ReplaceOfferingOptions. Deprecated - Provider of this
+// offering.
+func ReplaceOfferingProvider(provider string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Provider = core.StringPtr(provider)
+	}
+}
+
+// ReplaceOfferingProviderInfo sets the ProviderInfo property of ReplaceOfferingOptions. Information on the
+// provider for this offering, or omitted if no provider information is given.
+func ReplaceOfferingProviderInfo(providerInfo *ProviderInfo) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.ProviderInfo = providerInfo
+	}
+}
+
+// ReplaceOfferingRepoInfo sets the RepoInfo property of ReplaceOfferingOptions. Repository info for offerings.
+func ReplaceOfferingRepoInfo(repoInfo *RepoInfo) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.RepoInfo = repoInfo
+	}
+}
+
+// ReplaceOfferingImagePullKeys sets the ImagePullKeys property of ReplaceOfferingOptions. Image pull keys for this
+// offering.
+func ReplaceOfferingImagePullKeys(imagePullKeys ...ImagePullKey) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.ImagePullKeys = append(options.ImagePullKeys, imagePullKeys...)
+	}
+}
+
+// ReplaceOfferingSupport sets the Support property of ReplaceOfferingOptions. Offering Support information.
+func ReplaceOfferingSupport(support *Support) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Support = support
+	}
+}
+
+// ReplaceOfferingMedia sets the Media property of ReplaceOfferingOptions. A list of media items related to this
+// offering.
+func ReplaceOfferingMedia(media ...MediaItem) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Media = append(options.Media, media...)
+	}
+}
+
+// ReplaceOfferingDeprecatePending sets the DeprecatePending property of ReplaceOfferingOptions. Deprecation
+// information for an Offering.
+func ReplaceOfferingDeprecatePending(deprecatePending *DeprecatePending) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.DeprecatePending = deprecatePending
+	}
+}
+
+// ReplaceOfferingProductKind sets the ProductKind property of ReplaceOfferingOptions. The product kind.
+func ReplaceOfferingProductKind(productKind string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.ProductKind = core.StringPtr(productKind)
+	}
+}
+
+// ReplaceOfferingBadges sets the Badges property of ReplaceOfferingOptions. A list of badges for this offering.
+func ReplaceOfferingBadges(badges ...Badge) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		options.Badges = append(options.Badges, badges...)
+	}
+}
+
+// ReplaceOfferingHeader sets the Headers property of ReplaceOfferingOptions. Allows users to set headers on API
+// requests.
+func ReplaceOfferingHeader(name string, value string) ReplaceOfferingOption {
+	return func(options *ReplaceOfferingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceOfferingOptionsWith returns ReplaceOfferingOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewReplaceOfferingOptionsWith(options ...ReplaceOfferingOption) *ReplaceOfferingOptions {
+	replaceOfferingOptions := &ReplaceOfferingOptions{}
+	for _, option := range options {
+		option(replaceOfferingOptions)
+	}
+	return replaceOfferingOptions
+}
+
+// ReplaceOfferingWith is an alternate form of the ReplaceOfferingWithContext method which takes functional options
+// instead of a ReplaceOfferingOptions struct. ReplaceOfferingCatalogIdentifier and ReplaceOfferingOfferingID are
+// required.
+func (catalogManagement *CatalogManagementV1) ReplaceOfferingWith(ctx context.Context, options ...ReplaceOfferingOption) (result *Offering, response *core.DetailedResponse, err error) {
+	return catalogManagement.ReplaceOfferingWithContext(ctx, catalogManagement.NewReplaceOfferingOptionsWith(options...))
+}
+
+// UpdateOfferingOption : A functional option that sets a property of UpdateOfferingOptions (see UpdateOfferingWith).
+type UpdateOfferingOption func(options *UpdateOfferingOptions)
+
+// UpdateOfferingCatalogIdentifier sets the CatalogIdentifier property of UpdateOfferingOptions. Catalog
+// identifier.
+func UpdateOfferingCatalogIdentifier(catalogIdentifier string) UpdateOfferingOption {
+	return func(options *UpdateOfferingOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// UpdateOfferingOfferingID sets the OfferingID property of UpdateOfferingOptions. Offering identification.
+func UpdateOfferingOfferingID(offeringID string) UpdateOfferingOption {
+	return func(options *UpdateOfferingOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// UpdateOfferingIfMatch sets the IfMatch property of UpdateOfferingOptions. Offering etag contained in quotes.
+func UpdateOfferingIfMatch(ifMatch string) UpdateOfferingOption {
+	return func(options *UpdateOfferingOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// UpdateOfferingUpdates sets the Updates property of UpdateOfferingOptions.
+func UpdateOfferingUpdates(updates ...JSONPatchOperation) UpdateOfferingOption {
+	return func(options *UpdateOfferingOptions) {
+		options.Updates = append(options.Updates, updates...)
+	}
+}
+
+// UpdateOfferingHeader sets the Headers property of UpdateOfferingOptions. Allows users to set headers on API
+// requests.
+func UpdateOfferingHeader(name string, value string) UpdateOfferingOption {
+	return func(options *UpdateOfferingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateOfferingOptionsWith returns UpdateOfferingOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewUpdateOfferingOptionsWith(options ...UpdateOfferingOption) *UpdateOfferingOptions {
+	updateOfferingOptions := &UpdateOfferingOptions{}
+	for _, option := range options {
+		option(updateOfferingOptions)
+	}
+	return updateOfferingOptions
+}
+
+// UpdateOfferingWith is an alternate form of the UpdateOfferingWithContext method which takes functional options
+// instead of an UpdateOfferingOptions struct. UpdateOfferingCatalogIdentifier, UpdateOfferingOfferingID and
+// UpdateOfferingIfMatch are required.
+func (catalogManagement *CatalogManagementV1) UpdateOfferingWith(ctx context.Context, options ...UpdateOfferingOption) (result *Offering, response *core.DetailedResponse, err error) {
+	return catalogManagement.UpdateOfferingWithContext(ctx, catalogManagement.NewUpdateOfferingOptionsWith(options...))
+}
+
+// DeleteOfferingOption : A functional option that sets a property of DeleteOfferingOptions (see DeleteOfferingWith).
+type DeleteOfferingOption func(options *DeleteOfferingOptions)
+
+// DeleteOfferingCatalogIdentifier sets the CatalogIdentifier property of DeleteOfferingOptions. Catalog
+// identifier.
+func DeleteOfferingCatalogIdentifier(catalogIdentifier string) DeleteOfferingOption {
+	return func(options *DeleteOfferingOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// DeleteOfferingOfferingID sets the OfferingID property of DeleteOfferingOptions. Offering identification.
+func DeleteOfferingOfferingID(offeringID string) DeleteOfferingOption {
+	return func(options *DeleteOfferingOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// DeleteOfferingHeader sets the Headers property of DeleteOfferingOptions. Allows users to set headers on API
+// requests.
+func DeleteOfferingHeader(name string, value string) DeleteOfferingOption {
+	return func(options *DeleteOfferingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteOfferingOptionsWith returns DeleteOfferingOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeleteOfferingOptionsWith(options ...DeleteOfferingOption) *DeleteOfferingOptions {
+	deleteOfferingOptions := &DeleteOfferingOptions{}
+	for _, option := range options {
+		option(deleteOfferingOptions)
+	}
+	return deleteOfferingOptions
+}
+
+// DeleteOfferingWith is an alternate form of the DeleteOfferingWithContext method which takes functional options
+// instead of a DeleteOfferingOptions struct. DeleteOfferingCatalogIdentifier and DeleteOfferingOfferingID are
+// required.
+func (catalogManagement *CatalogManagementV1) DeleteOfferingWith(ctx context.Context, options ...DeleteOfferingOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.DeleteOfferingWithContext(ctx, catalogManagement.NewDeleteOfferingOptionsWith(options...))
+}
+
+// ListOfferingAuditsOption : A functional option that sets a property of ListOfferingAuditsOptions (see ListOfferingAuditsWith).
+type ListOfferingAuditsOption func(options *ListOfferingAuditsOptions)
+
+// ListOfferingAuditsCatalogIdentifier sets the CatalogIdentifier property of ListOfferingAuditsOptions. Catalog
+// identifier.
+func ListOfferingAuditsCatalogIdentifier(catalogIdentifier string) ListOfferingAuditsOption {
+	return func(options *ListOfferingAuditsOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ListOfferingAuditsOfferingID sets the OfferingID property of ListOfferingAuditsOptions. Offering identification.
+func ListOfferingAuditsOfferingID(offeringID string) ListOfferingAuditsOption {
+	return func(options *ListOfferingAuditsOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// ListOfferingAuditsStart sets the Start property of ListOfferingAuditsOptions. Start token for a query.
+func ListOfferingAuditsStart(start string) ListOfferingAuditsOption {
+	return func(options *ListOfferingAuditsOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListOfferingAuditsLimit sets the Limit property of ListOfferingAuditsOptions. number or results to return in the
+// query.
+func ListOfferingAuditsLimit(limit int64) ListOfferingAuditsOption {
+	return func(options *ListOfferingAuditsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListOfferingAuditsLookupnames sets the Lookupnames property of ListOfferingAuditsOptions. Auditlog Lookup Names
+// - by default names are not returned in auditlog.
+func ListOfferingAuditsLookupnames(lookupnames bool) ListOfferingAuditsOption {
+	return func(options *ListOfferingAuditsOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// ListOfferingAuditsHeader sets the Headers property of ListOfferingAuditsOptions. Allows users to set headers on
+// API requests.
+func ListOfferingAuditsHeader(name string, value string) ListOfferingAuditsOption {
+	return func(options *ListOfferingAuditsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListOfferingAuditsOptionsWith returns ListOfferingAuditsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewListOfferingAuditsOptionsWith(options ...ListOfferingAuditsOption) *ListOfferingAuditsOptions {
+	listOfferingAuditsOptions := &ListOfferingAuditsOptions{}
+	for _, option := range options {
+		option(listOfferingAuditsOptions)
+	}
+	return listOfferingAuditsOptions
+}
+
+// ListOfferingAuditsWith is an alternate form of the ListOfferingAuditsWithContext method which takes functional
+// options instead of a ListOfferingAuditsOptions struct. ListOfferingAuditsCatalogIdentifier and
+// ListOfferingAuditsOfferingID are required.
+func (catalogManagement *CatalogManagementV1) ListOfferingAuditsWith(ctx context.Context, options ...ListOfferingAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error) {
+	return catalogManagement.ListOfferingAuditsWithContext(ctx, catalogManagement.NewListOfferingAuditsOptionsWith(options...))
+}
+
+// GetOfferingAuditOption : A functional option that sets a property of GetOfferingAuditOptions (see GetOfferingAuditWith).
+type GetOfferingAuditOption func(options *GetOfferingAuditOptions)
+
+// GetOfferingAuditCatalogIdentifier sets the CatalogIdentifier property of GetOfferingAuditOptions. Catalog
+// identifier.
+func GetOfferingAuditCatalogIdentifier(catalogIdentifier string) GetOfferingAuditOption {
+	return func(options *GetOfferingAuditOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetOfferingAuditOfferingID sets the OfferingID property of GetOfferingAuditOptions. Offering identification.
+func GetOfferingAuditOfferingID(offeringID string) GetOfferingAuditOption {
+	return func(options *GetOfferingAuditOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// GetOfferingAuditAuditlogIdentifier sets the AuditlogIdentifier property of GetOfferingAuditOptions. Auditlog ID.
+func GetOfferingAuditAuditlogIdentifier(auditlogIdentifier string) GetOfferingAuditOption {
+	return func(options *GetOfferingAuditOptions) {
+		options.AuditlogIdentifier = core.StringPtr(auditlogIdentifier)
+	}
+}
+
+// GetOfferingAuditLookupnames sets the Lookupnames property of GetOfferingAuditOptions. Auditlog Lookup Names - by
+// default names are not returned in auditlog.
+func GetOfferingAuditLookupnames(lookupnames bool) GetOfferingAuditOption {
+	return func(options *GetOfferingAuditOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// GetOfferingAuditHeader sets the Headers property of GetOfferingAuditOptions. Allows users to set headers on API
+// requests.
+func GetOfferingAuditHeader(name string, value string) GetOfferingAuditOption {
+	return func(options *GetOfferingAuditOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingAuditOptionsWith returns GetOfferingAuditOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingAuditOptionsWith(options ...GetOfferingAuditOption) *GetOfferingAuditOptions {
+	getOfferingAuditOptions := &GetOfferingAuditOptions{}
+	for _, option := range options {
+		option(getOfferingAuditOptions)
+	}
+	return getOfferingAuditOptions
+}
+
+// GetOfferingAuditWith is an alternate form of the GetOfferingAuditWithContext method which takes functional
+// options instead of a GetOfferingAuditOptions struct. GetOfferingAuditCatalogIdentifier,
+// GetOfferingAuditOfferingID and GetOfferingAuditAuditlogIdentifier are required.
+func (catalogManagement *CatalogManagementV1) GetOfferingAuditWith(ctx context.Context, options ...GetOfferingAuditOption) (result *AuditLog, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingAuditWithContext(ctx, catalogManagement.NewGetOfferingAuditOptionsWith(options...))
+}
+
+// SetOfferingPublishOption : A functional option that sets a property of SetOfferingPublishOptions (see SetOfferingPublishWith).
+type SetOfferingPublishOption func(options *SetOfferingPublishOptions)
+
+// SetOfferingPublishCatalogIdentifier sets the CatalogIdentifier property of SetOfferingPublishOptions. Catalog
+// identifier.
+func SetOfferingPublishCatalogIdentifier(catalogIdentifier string) SetOfferingPublishOption {
+	return func(options *SetOfferingPublishOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// SetOfferingPublishOfferingID sets the OfferingID property of SetOfferingPublishOptions. Offering identification.
+func SetOfferingPublishOfferingID(offeringID string) SetOfferingPublishOption {
+	return func(options *SetOfferingPublishOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// SetOfferingPublishApprovalType sets the ApprovalType property of SetOfferingPublishOptions. Type of approval.
+func SetOfferingPublishApprovalType(approvalType string) SetOfferingPublishOption {
+	return func(options *SetOfferingPublishOptions) {
+		options.ApprovalType = core.StringPtr(approvalType)
+	}
+}
+
+// SetOfferingPublishApproved sets the Approved property of SetOfferingPublishOptions. Approve (true) or disapprove
+// (false).
+func SetOfferingPublishApproved(approved string) SetOfferingPublishOption {
+	return func(options *SetOfferingPublishOptions) {
+		options.Approved = core.StringPtr(approved)
+	}
+}
+
+// SetOfferingPublishPortalRecord sets the PortalRecord property of SetOfferingPublishOptions. Partner Center
+// identifier for this offering.
+func SetOfferingPublishPortalRecord(portalRecord string) SetOfferingPublishOption {
+	return func(options *SetOfferingPublishOptions) {
+		options.PortalRecord = core.StringPtr(portalRecord)
+	}
+}
+
+// SetOfferingPublishPortalURL sets the PortalURL property of SetOfferingPublishOptions. Partner Center url for
+// this offering.
+func SetOfferingPublishPortalURL(portalURL string) SetOfferingPublishOption {
+	return func(options *SetOfferingPublishOptions) {
+		options.PortalURL = core.StringPtr(portalURL)
+	}
+}
+
+// SetOfferingPublishXApproverToken sets the XApproverToken property of SetOfferingPublishOptions. IAM token of
+// partner center.
+func SetOfferingPublishXApproverToken(xApproverToken string) SetOfferingPublishOption {
+	return func(options *SetOfferingPublishOptions) {
+		options.XApproverToken = core.StringPtr(xApproverToken)
+	}
+}
+
+// SetOfferingPublishHeader sets the Headers property of SetOfferingPublishOptions. Allows users to set headers on
+// API requests.
+func SetOfferingPublishHeader(name string, value string) SetOfferingPublishOption {
+	return func(options *SetOfferingPublishOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewSetOfferingPublishOptionsWith returns SetOfferingPublishOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewSetOfferingPublishOptionsWith(options ...SetOfferingPublishOption) *SetOfferingPublishOptions {
+	setOfferingPublishOptions := &SetOfferingPublishOptions{}
+	for _, option := range options {
+		option(setOfferingPublishOptions)
+	}
+	return setOfferingPublishOptions
+}
+
+// SetOfferingPublishWith is an alternate form of the SetOfferingPublishWithContext method which takes functional
+// options instead of a SetOfferingPublishOptions struct. SetOfferingPublishCatalogIdentifier,
+// SetOfferingPublishOfferingID, SetOfferingPublishApprovalType and SetOfferingPublishApproved are required.
+func (catalogManagement *CatalogManagementV1) SetOfferingPublishWith(ctx context.Context, options ...SetOfferingPublishOption) (result *ApprovalResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.SetOfferingPublishWithContext(ctx, catalogManagement.NewSetOfferingPublishOptionsWith(options...))
+}
+
+// DeprecateOfferingOption : A functional option that sets a property of DeprecateOfferingOptions (see DeprecateOfferingWith).
+type DeprecateOfferingOption func(options *DeprecateOfferingOptions)
+
+// DeprecateOfferingCatalogIdentifier sets the CatalogIdentifier property of DeprecateOfferingOptions. Catalog
+// identifier.
+func DeprecateOfferingCatalogIdentifier(catalogIdentifier string) DeprecateOfferingOption {
+	return func(options *DeprecateOfferingOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// DeprecateOfferingOfferingID sets the OfferingID property of DeprecateOfferingOptions. Offering identification.
+func DeprecateOfferingOfferingID(offeringID string) DeprecateOfferingOption {
+	return func(options *DeprecateOfferingOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// DeprecateOfferingSetting sets the Setting property of DeprecateOfferingOptions. Set deprecation (true) or cancel
+// deprecation (false).
+func DeprecateOfferingSetting(setting string) DeprecateOfferingOption {
+	return func(options *DeprecateOfferingOptions) {
+		options.Setting = core.StringPtr(setting)
+	}
+}
+
+// DeprecateOfferingDescription sets the Description property of DeprecateOfferingOptions. Additional information
+// that users can provide to be displayed in deprecation notification.
+func DeprecateOfferingDescription(description string) DeprecateOfferingOption {
+	return func(options *DeprecateOfferingOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// DeprecateOfferingDaysUntilDeprecate sets the DaysUntilDeprecate property of DeprecateOfferingOptions. Specifies
+// the amount of days until product is not available in catalog.
+func DeprecateOfferingDaysUntilDeprecate(daysUntilDeprecate int64) DeprecateOfferingOption {
+	return func(options *DeprecateOfferingOptions) {
+		options.DaysUntilDeprecate = core.Int64Ptr(daysUntilDeprecate)
+	}
+}
+
+// DeprecateOfferingHeader sets the Headers property of DeprecateOfferingOptions. Allows users to set headers on
+// API requests.
+func DeprecateOfferingHeader(name string, value string) DeprecateOfferingOption {
+	return func(options *DeprecateOfferingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeprecateOfferingOptionsWith returns DeprecateOfferingOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeprecateOfferingOptionsWith(options ...DeprecateOfferingOption) *DeprecateOfferingOptions {
+	deprecateOfferingOptions := &DeprecateOfferingOptions{}
+	for _, option := range options {
+		option(deprecateOfferingOptions)
+	}
+	return deprecateOfferingOptions
+}
+
+// DeprecateOfferingWith is an alternate form of the DeprecateOfferingWithContext method which takes functional
+// options instead of a DeprecateOfferingOptions struct. DeprecateOfferingCatalogIdentifier,
+// DeprecateOfferingOfferingID and DeprecateOfferingSetting are required.
+func (catalogManagement *CatalogManagementV1) DeprecateOfferingWith(ctx context.Context, options ...DeprecateOfferingOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.DeprecateOfferingWithContext(ctx, catalogManagement.NewDeprecateOfferingOptionsWith(options...))
+}
+
+// ShareOfferingOption : A functional option that sets a property of ShareOfferingOptions (see ShareOfferingWith).
+type ShareOfferingOption func(options *ShareOfferingOptions)
+
+// ShareOfferingCatalogIdentifier sets the CatalogIdentifier property of ShareOfferingOptions. Catalog identifier.
+func ShareOfferingCatalogIdentifier(catalogIdentifier string) ShareOfferingOption {
+	return func(options *ShareOfferingOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ShareOfferingOfferingID sets the OfferingID property of ShareOfferingOptions. Offering identification.
+func ShareOfferingOfferingID(offeringID string) ShareOfferingOption {
+	return func(options *ShareOfferingOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// ShareOfferingIBM sets the IBM property of ShareOfferingOptions. Visible to IBM employees.
+func ShareOfferingIBM(ibm bool) ShareOfferingOption {
+	return func(options *ShareOfferingOptions) {
+		options.IBM = core.BoolPtr(ibm)
+	}
+}
+
+// ShareOfferingPublic sets the Public property of ShareOfferingOptions. Visible to everyone in the public catalog.
+func ShareOfferingPublic(public bool) ShareOfferingOption {
+	return func(options *ShareOfferingOptions) {
+		options.Public = core.BoolPtr(public)
+	}
+}
+
+// ShareOfferingEnabled sets the Enabled property of ShareOfferingOptions. Visible to access list.
+func ShareOfferingEnabled(enabled bool) ShareOfferingOption {
+	return func(options *ShareOfferingOptions) {
+		options.Enabled = core.BoolPtr(enabled)
+	}
+}
+
+// ShareOfferingHeader sets the Headers property of ShareOfferingOptions. Allows users to set headers on API
+// requests.
+func ShareOfferingHeader(name string, value string) ShareOfferingOption {
+	return func(options *ShareOfferingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewShareOfferingOptionsWith returns ShareOfferingOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewShareOfferingOptionsWith(options ...ShareOfferingOption) *ShareOfferingOptions {
+	shareOfferingOptions := &ShareOfferingOptions{}
+	for _, option := range options {
+		option(shareOfferingOptions)
+	}
+	return shareOfferingOptions
+}
+
+// ShareOfferingWith is an alternate form of the ShareOfferingWithContext method which takes functional options
+// instead of a ShareOfferingOptions struct. ShareOfferingCatalogIdentifier and ShareOfferingOfferingID are
+// required.
+func (catalogManagement *CatalogManagementV1) ShareOfferingWith(ctx context.Context, options ...ShareOfferingOption) (result *ShareSetting, response *core.DetailedResponse, err error) {
+	return catalogManagement.ShareOfferingWithContext(ctx, catalogManagement.NewShareOfferingOptionsWith(options...))
+}
+
+// GetOfferingAccessOption : A functional option that sets a property of GetOfferingAccessOptions (see GetOfferingAccessWith).
+type GetOfferingAccessOption func(options *GetOfferingAccessOptions)
+
+// GetOfferingAccessCatalogIdentifier sets the CatalogIdentifier property of GetOfferingAccessOptions. Catalog
+// identifier.
+func GetOfferingAccessCatalogIdentifier(catalogIdentifier string) GetOfferingAccessOption {
+	return func(options *GetOfferingAccessOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetOfferingAccessOfferingID sets the OfferingID property of GetOfferingAccessOptions. Offering identification.
+func GetOfferingAccessOfferingID(offeringID string) GetOfferingAccessOption {
+	return func(options *GetOfferingAccessOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// GetOfferingAccessAccessIdentifier sets the AccessIdentifier property of GetOfferingAccessOptions. Identifier for
+// access.
+func GetOfferingAccessAccessIdentifier(accessIdentifier string) GetOfferingAccessOption {
+	return func(options *GetOfferingAccessOptions) {
+		options.AccessIdentifier = core.StringPtr(accessIdentifier)
+	}
+}
+
+// GetOfferingAccessHeader sets the Headers property of GetOfferingAccessOptions. Allows users to set headers on
+// API requests.
+func GetOfferingAccessHeader(name string, value string) GetOfferingAccessOption {
+	return func(options *GetOfferingAccessOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingAccessOptionsWith returns GetOfferingAccessOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingAccessOptionsWith(options ...GetOfferingAccessOption) *GetOfferingAccessOptions {
+	getOfferingAccessOptions := &GetOfferingAccessOptions{}
+	for _, option := range options {
+		option(getOfferingAccessOptions)
+	}
+	return getOfferingAccessOptions
+}
+
+// GetOfferingAccessWith is an alternate form of the GetOfferingAccessWithContext method which takes functional
+// options instead of a GetOfferingAccessOptions struct. GetOfferingAccessCatalogIdentifier,
+// GetOfferingAccessOfferingID and GetOfferingAccessAccessIdentifier are required.
+func (catalogManagement *CatalogManagementV1) GetOfferingAccessWith(ctx context.Context, options ...GetOfferingAccessOption) (result *Access, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingAccessWithContext(ctx, catalogManagement.NewGetOfferingAccessOptionsWith(options...))
+}
+
+// GetOfferingAccessListOption : A functional option that sets a property of GetOfferingAccessListOptions (see GetOfferingAccessListWith).
+type GetOfferingAccessListOption func(options *GetOfferingAccessListOptions)
+
+// GetOfferingAccessListCatalogIdentifier sets the CatalogIdentifier property of GetOfferingAccessListOptions.
+// Catalog identifier.
+func GetOfferingAccessListCatalogIdentifier(catalogIdentifier string) GetOfferingAccessListOption {
+	return func(options *GetOfferingAccessListOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetOfferingAccessListOfferingID sets the OfferingID property of GetOfferingAccessListOptions. Offering
+// identification.
+func GetOfferingAccessListOfferingID(offeringID string) GetOfferingAccessListOption {
+	return func(options *GetOfferingAccessListOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// GetOfferingAccessListStart sets the Start property of GetOfferingAccessListOptions. Start token for a query.
+func GetOfferingAccessListStart(start string) GetOfferingAccessListOption {
+	return func(options *GetOfferingAccessListOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// GetOfferingAccessListLimit sets the Limit property of GetOfferingAccessListOptions. number or results to return
+// in the query.
+func GetOfferingAccessListLimit(limit int64) GetOfferingAccessListOption {
+	return func(options *GetOfferingAccessListOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// GetOfferingAccessListHeader sets the Headers property of GetOfferingAccessListOptions. Allows users to set
+// headers on API requests.
+func GetOfferingAccessListHeader(name string, value string) GetOfferingAccessListOption {
+	return func(options *GetOfferingAccessListOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingAccessListOptionsWith returns GetOfferingAccessListOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingAccessListOptionsWith(options ...GetOfferingAccessListOption) *GetOfferingAccessListOptions {
+	getOfferingAccessListOptions := &GetOfferingAccessListOptions{}
+	for _, option := range options {
+		option(getOfferingAccessListOptions)
+	}
+	return getOfferingAccessListOptions
+}
+
+// GetOfferingAccessListWith is an alternate form of the GetOfferingAccessListWithContext method which takes
+// functional options instead of a GetOfferingAccessListOptions struct. GetOfferingAccessListCatalogIdentifier and
+// GetOfferingAccessListOfferingID are required.
+func (catalogManagement *CatalogManagementV1) GetOfferingAccessListWith(ctx context.Context, options ...GetOfferingAccessListOption) (result *AccessListResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingAccessListWithContext(ctx, catalogManagement.NewGetOfferingAccessListOptionsWith(options...))
+}
+
+// DeleteOfferingAccessListOption : A functional option that sets a property of DeleteOfferingAccessListOptions (see DeleteOfferingAccessListWith).
+type DeleteOfferingAccessListOption func(options *DeleteOfferingAccessListOptions)
+
+// DeleteOfferingAccessListCatalogIdentifier sets the CatalogIdentifier property of
+// DeleteOfferingAccessListOptions. Catalog identifier.
+func DeleteOfferingAccessListCatalogIdentifier(catalogIdentifier string) DeleteOfferingAccessListOption {
+	return func(options *DeleteOfferingAccessListOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// DeleteOfferingAccessListOfferingID sets the OfferingID property of DeleteOfferingAccessListOptions. Offering
+// identification.
+func DeleteOfferingAccessListOfferingID(offeringID string) DeleteOfferingAccessListOption {
+	return func(options *DeleteOfferingAccessListOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// DeleteOfferingAccessListAccesses sets the Accesses property of DeleteOfferingAccessListOptions. A list of
+// accesses to delete.
+func DeleteOfferingAccessListAccesses(accesses ...string) DeleteOfferingAccessListOption {
+	return func(options *DeleteOfferingAccessListOptions) {
+		options.Accesses = append(options.Accesses, accesses...)
+	}
+}
+
+// DeleteOfferingAccessListHeader sets the Headers property of DeleteOfferingAccessListOptions. Allows users to set
+// headers on API requests.
+func DeleteOfferingAccessListHeader(name string, value string) DeleteOfferingAccessListOption {
+	return func(options *DeleteOfferingAccessListOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteOfferingAccessListOptionsWith returns DeleteOfferingAccessListOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeleteOfferingAccessListOptionsWith(options ...DeleteOfferingAccessListOption) *DeleteOfferingAccessListOptions {
+	deleteOfferingAccessListOptions := &DeleteOfferingAccessListOptions{}
+	for _, option := range options {
+		option(deleteOfferingAccessListOptions)
+	}
+	return deleteOfferingAccessListOptions
+}
+
+// DeleteOfferingAccessListWith is an alternate form of the DeleteOfferingAccessListWithContext method which takes
+// functional options instead of a DeleteOfferingAccessListOptions struct.
+// DeleteOfferingAccessListCatalogIdentifier, DeleteOfferingAccessListOfferingID and
+// DeleteOfferingAccessListAccesses are required.
+func (catalogManagement *CatalogManagementV1) DeleteOfferingAccessListWith(ctx context.Context, options ...DeleteOfferingAccessListOption) (result *AccessListBulkResponse, response *core.DetailedResponse, err error) {
+	return catalogManagement.DeleteOfferingAccessListWithContext(ctx, catalogManagement.NewDeleteOfferingAccessListOptionsWith(options...))
+}
+
+// AddOfferingAccessListOption : A functional option that sets a property of AddOfferingAccessListOptions (see AddOfferingAccessListWith).
+type AddOfferingAccessListOption func(options *AddOfferingAccessListOptions)
+
+// AddOfferingAccessListCatalogIdentifier sets the CatalogIdentifier property of AddOfferingAccessListOptions.
+// Catalog identifier.
+func AddOfferingAccessListCatalogIdentifier(catalogIdentifier string) AddOfferingAccessListOption {
+	return func(options *AddOfferingAccessListOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// AddOfferingAccessListOfferingID sets the OfferingID property of AddOfferingAccessListOptions. Offering
+// identification.
+func AddOfferingAccessListOfferingID(offeringID string) AddOfferingAccessListOption {
+	return func(options *AddOfferingAccessListOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// AddOfferingAccessListAccesses sets the Accesses property of AddOfferingAccessListOptions. A list of accesses to
+// add.
+func AddOfferingAccessListAccesses(accesses ...string) AddOfferingAccessListOption {
+	return func(options *AddOfferingAccessListOptions) {
+		options.Accesses = append(options.Accesses, accesses...)
+	}
+}
+
+// AddOfferingAccessListHeader sets the Headers property of AddOfferingAccessListOptions. Allows users to set
+// headers on API requests.
+func AddOfferingAccessListHeader(name string, value string) AddOfferingAccessListOption {
+	return func(options *AddOfferingAccessListOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewAddOfferingAccessListOptionsWith returns AddOfferingAccessListOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewAddOfferingAccessListOptionsWith(options ...AddOfferingAccessListOption) *AddOfferingAccessListOptions {
+	addOfferingAccessListOptions := &AddOfferingAccessListOptions{}
+	for _, option := range options {
+		option(addOfferingAccessListOptions)
+	}
+	return addOfferingAccessListOptions
+}
+
+// AddOfferingAccessListWith is an alternate form of the AddOfferingAccessListWithContext method which takes
+// functional options instead of an AddOfferingAccessListOptions struct. AddOfferingAccessListCatalogIdentifier,
+// AddOfferingAccessListOfferingID and AddOfferingAccessListAccesses are required.
+func (catalogManagement *CatalogManagementV1) AddOfferingAccessListWith(ctx context.Context, options ...AddOfferingAccessListOption) (result *AccessListResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.AddOfferingAccessListWithContext(ctx, catalogManagement.NewAddOfferingAccessListOptionsWith(options...))
+}
+
+// GetOfferingUpdatesOption : A functional option that sets a property of GetOfferingUpdatesOptions (see GetOfferingUpdatesWith).
+type GetOfferingUpdatesOption func(options *GetOfferingUpdatesOptions)
+
+// GetOfferingUpdatesCatalogIdentifier sets the CatalogIdentifier property of GetOfferingUpdatesOptions. Catalog
+// identifier.
+func GetOfferingUpdatesCatalogIdentifier(catalogIdentifier string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetOfferingUpdatesOfferingID sets the OfferingID property of GetOfferingUpdatesOptions. Offering identification.
+func GetOfferingUpdatesOfferingID(offeringID string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// GetOfferingUpdatesKind sets the Kind property of GetOfferingUpdatesOptions. The kind of offering (e.g, helm,
+// ova, terraform ...).
+func GetOfferingUpdatesKind(kind string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.Kind = core.StringPtr(kind)
+	}
+}
+
+// GetOfferingUpdatesXAuthRefreshToken sets the XAuthRefreshToken property of GetOfferingUpdatesOptions. IAM
+// Refresh token.
+func GetOfferingUpdatesXAuthRefreshToken(xAuthRefreshToken string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// GetOfferingUpdatesTarget sets the Target property of GetOfferingUpdatesOptions. The target kind of the currently
+// installed version (e.g.
+func GetOfferingUpdatesTarget(target string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.Target = core.StringPtr(target)
+	}
+}
+
+// GetOfferingUpdatesVersion sets the Version property of GetOfferingUpdatesOptions. optionaly provide an existing
+// version to check updates for if one is not given, all version will be returned.
+func GetOfferingUpdatesVersion(version string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.Version = core.StringPtr(version)
+	}
+}
+
+// GetOfferingUpdatesClusterID sets the ClusterID property of GetOfferingUpdatesOptions. The id of the cluster
+// where this version was installed.
+func GetOfferingUpdatesClusterID(clusterID string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// GetOfferingUpdatesRegion sets the Region property of GetOfferingUpdatesOptions. The region of the cluster where
+// this version was installed.
+func GetOfferingUpdatesRegion(region string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// GetOfferingUpdatesResourceGroupID sets the ResourceGroupID property of GetOfferingUpdatesOptions. The resource
+// group id of the cluster where this version was installed.
+func GetOfferingUpdatesResourceGroupID(resourceGroupID string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// GetOfferingUpdatesNamespace sets the Namespace property of GetOfferingUpdatesOptions. The namespace of the
+// cluster where this version was installed.
+func GetOfferingUpdatesNamespace(namespace string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.Namespace = core.StringPtr(namespace)
+	}
+}
+
+// GetOfferingUpdatesSha sets the Sha property of GetOfferingUpdatesOptions. The sha value of the currently
+// installed version.
+func GetOfferingUpdatesSha(sha string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.Sha = core.StringPtr(sha)
+	}
+}
+
+// GetOfferingUpdatesChannel sets the Channel property of GetOfferingUpdatesOptions. Optionally provide the channel
+// value of the currently installed version.
+func GetOfferingUpdatesChannel(channel string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.Channel = core.StringPtr(channel)
+	}
+}
+
+// GetOfferingUpdatesNamespaces sets the Namespaces property of GetOfferingUpdatesOptions. Optionally provide a
+// list of namespaces used for the currently installed version.
+func GetOfferingUpdatesNamespaces(namespaces ...string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.Namespaces = append(options.Namespaces, namespaces...)
+	}
+}
+
+// GetOfferingUpdatesAllNamespaces sets the AllNamespaces property of GetOfferingUpdatesOptions. Optionally
+// indicate that the current version was installed in all namespaces.
+func GetOfferingUpdatesAllNamespaces(allNamespaces bool) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		options.AllNamespaces = core.BoolPtr(allNamespaces)
+	}
+}
+
+// GetOfferingUpdatesHeader sets the Headers property of GetOfferingUpdatesOptions. Allows users to set headers on
+// API requests.
+func GetOfferingUpdatesHeader(name string, value string) GetOfferingUpdatesOption {
+	return func(options *GetOfferingUpdatesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingUpdatesOptionsWith returns GetOfferingUpdatesOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingUpdatesOptionsWith(options ...GetOfferingUpdatesOption) *GetOfferingUpdatesOptions {
+	getOfferingUpdatesOptions := &GetOfferingUpdatesOptions{}
+	for _, option := range options {
+		option(getOfferingUpdatesOptions)
+	}
+	return getOfferingUpdatesOptions
+}
+
+// GetOfferingUpdatesWith is an alternate form of the GetOfferingUpdatesWithContext method which takes functional
+// options instead of a GetOfferingUpdatesOptions struct. GetOfferingUpdatesCatalogIdentifier,
+// GetOfferingUpdatesOfferingID, GetOfferingUpdatesKind and GetOfferingUpdatesXAuthRefreshToken are required.
+func (catalogManagement *CatalogManagementV1) GetOfferingUpdatesWith(ctx context.Context, options ...GetOfferingUpdatesOption) (result []VersionUpdateDescriptor, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingUpdatesWithContext(ctx, catalogManagement.NewGetOfferingUpdatesOptionsWith(options...))
+}
+
+// GetOfferingSourceOption : A functional option that sets a property of GetOfferingSourceOptions (see GetOfferingSourceWith).
+type GetOfferingSourceOption func(options *GetOfferingSourceOptions)
+
+// GetOfferingSourceVersion sets the Version property of GetOfferingSourceOptions. The version being requested.
+func GetOfferingSourceVersion(version string) GetOfferingSourceOption {
+	return func(options *GetOfferingSourceOptions) {
+		options.Version = core.StringPtr(version)
+	}
+}
+
+// GetOfferingSourceAccept sets the Accept property of GetOfferingSourceOptions. The type of the response:
+// application/yaml, application/json, or application/x-gzip.
+func GetOfferingSourceAccept(accept string) GetOfferingSourceOption {
+	return func(options *GetOfferingSourceOptions) {
+		options.Accept = core.StringPtr(accept)
+	}
+}
+
+// GetOfferingSourceCatalogID sets the CatalogID property of GetOfferingSourceOptions. Catalog ID.
+func GetOfferingSourceCatalogID(catalogID string) GetOfferingSourceOption {
+	return func(options *GetOfferingSourceOptions) {
+		options.CatalogID = core.StringPtr(catalogID)
+	}
+}
+
+// GetOfferingSourceName sets the Name property of GetOfferingSourceOptions. Offering name.
+func GetOfferingSourceName(nameVar string) GetOfferingSourceOption {
+	return func(options *GetOfferingSourceOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// GetOfferingSourceID sets the ID property of GetOfferingSourceOptions. Offering id.
+func GetOfferingSourceID(id string) GetOfferingSourceOption {
+	return func(options *GetOfferingSourceOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetOfferingSourceKind sets the Kind property of GetOfferingSourceOptions. The kind of offering (e.g.
+func GetOfferingSourceKind(kind string) GetOfferingSourceOption {
+	return func(options *GetOfferingSourceOptions) {
+		options.Kind = core.StringPtr(kind)
+	}
+}
+
+// GetOfferingSourceChannel sets the Channel property of GetOfferingSourceOptions. The channel value of the
+// specified version.
+func GetOfferingSourceChannel(channel string) GetOfferingSourceOption {
+	return func(options *GetOfferingSourceOptions) {
+		options.Channel = core.StringPtr(channel)
+	}
+}
+
+// GetOfferingSourceHeader sets the Headers property of GetOfferingSourceOptions. Allows users to set headers on
+// API requests.
+func GetOfferingSourceHeader(name string, value string) GetOfferingSourceOption {
+	return func(options *GetOfferingSourceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingSourceOptionsWith returns GetOfferingSourceOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingSourceOptionsWith(options ...GetOfferingSourceOption) *GetOfferingSourceOptions {
+	getOfferingSourceOptions := &GetOfferingSourceOptions{}
+	for _, option := range options {
+		option(getOfferingSourceOptions)
+	}
+	return getOfferingSourceOptions
+}
+
+// GetOfferingSourceWith is an alternate form of the GetOfferingSourceWithContext method which takes functional
+// options instead of a GetOfferingSourceOptions struct. GetOfferingSourceVersion is required.
+func (catalogManagement *CatalogManagementV1) GetOfferingSourceWith(ctx context.Context, options ...GetOfferingSourceOption) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingSourceWithContext(ctx, catalogManagement.NewGetOfferingSourceOptionsWith(options...))
+}
+
+// GetOfferingSourceURLOption : A functional option that sets a property of GetOfferingSourceURLOptions (see GetOfferingSourceURLWith).
+type GetOfferingSourceURLOption func(options *GetOfferingSourceURLOptions)
+
+// GetOfferingSourceURLKeyIdentifier sets the KeyIdentifier property of GetOfferingSourceURLOptions. Unique key
+// identifying an image.
+func GetOfferingSourceURLKeyIdentifier(keyIdentifier string) GetOfferingSourceURLOption {
+	return func(options *GetOfferingSourceURLOptions) {
+		options.KeyIdentifier = core.StringPtr(keyIdentifier)
+	}
+}
+
+// GetOfferingSourceURLAccept sets the Accept property of GetOfferingSourceURLOptions. The type of the response:
+// application/yaml, application/json, or application/x-gzip.
+func GetOfferingSourceURLAccept(accept string) GetOfferingSourceURLOption {
+	return func(options *GetOfferingSourceURLOptions) {
+		options.Accept = core.StringPtr(accept)
+	}
+}
+
+// GetOfferingSourceURLCatalogID sets the CatalogID property of GetOfferingSourceURLOptions. Catalog ID.
+func GetOfferingSourceURLCatalogID(catalogID string) GetOfferingSourceURLOption {
+	return func(options *GetOfferingSourceURLOptions) {
+		options.CatalogID = core.StringPtr(catalogID)
+	}
+}
+
+// GetOfferingSourceURLName sets the Name property of GetOfferingSourceURLOptions. Offering name.
+func GetOfferingSourceURLName(nameVar string) GetOfferingSourceURLOption {
+	return func(options *GetOfferingSourceURLOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// GetOfferingSourceURLID sets the ID property of GetOfferingSourceURLOptions. Offering id.
+func GetOfferingSourceURLID(id string) GetOfferingSourceURLOption {
+	return func(options *GetOfferingSourceURLOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetOfferingSourceURLHeader sets the Headers property of GetOfferingSourceURLOptions. Allows users to set headers
+// on API requests.
+func GetOfferingSourceURLHeader(name string, value string) GetOfferingSourceURLOption {
+	return func(options *GetOfferingSourceURLOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingSourceURLOptionsWith returns GetOfferingSourceURLOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingSourceURLOptionsWith(options ...GetOfferingSourceURLOption) *GetOfferingSourceURLOptions {
+	getOfferingSourceURLOptions := &GetOfferingSourceURLOptions{}
+	for _, option := range options {
+		option(getOfferingSourceURLOptions)
+	}
+	return getOfferingSourceURLOptions
+}
+
+// GetOfferingSourceURLWith is an alternate form of the GetOfferingSourceURLWithContext method which takes
+// functional options instead of a GetOfferingSourceURLOptions struct. GetOfferingSourceURLKeyIdentifier is
+// required.
+func (catalogManagement *CatalogManagementV1) GetOfferingSourceURLWith(ctx context.Context, options ...GetOfferingSourceURLOption) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingSourceURLWithContext(ctx, catalogManagement.NewGetOfferingSourceURLOptionsWith(options...))
+}
+
+// GetOfferingAboutOption : A functional option that sets a property of GetOfferingAboutOptions (see GetOfferingAboutWith).
+type GetOfferingAboutOption func(options *GetOfferingAboutOptions)
+
+// GetOfferingAboutVersionLocID sets the VersionLocID property of GetOfferingAboutOptions. A dotted value of
+// `catalogID`.`versionID`.
+func GetOfferingAboutVersionLocID(versionLocID string) GetOfferingAboutOption {
+	return func(options *GetOfferingAboutOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// GetOfferingAboutHeader sets the Headers property of GetOfferingAboutOptions. Allows users to set headers on API
+// requests.
+func GetOfferingAboutHeader(name string, value string) GetOfferingAboutOption {
+	return func(options *GetOfferingAboutOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingAboutOptionsWith returns GetOfferingAboutOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingAboutOptionsWith(options ...GetOfferingAboutOption) *GetOfferingAboutOptions {
+	getOfferingAboutOptions := &GetOfferingAboutOptions{}
+	for _, option := range options {
+		option(getOfferingAboutOptions)
+	}
+	return getOfferingAboutOptions
+}
+
+// GetOfferingAboutWith is an alternate form of the GetOfferingAboutWithContext method which takes functional
+// options instead of a GetOfferingAboutOptions struct. GetOfferingAboutVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) GetOfferingAboutWith(ctx context.Context, options ...GetOfferingAboutOption) (result *string, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingAboutWithContext(ctx, catalogManagement.NewGetOfferingAboutOptionsWith(options...))
+}
+
+// GetOfferingLicenseOption : A functional option that sets a property of GetOfferingLicenseOptions (see GetOfferingLicenseWith).
+type GetOfferingLicenseOption func(options *GetOfferingLicenseOptions)
+
+// GetOfferingLicenseVersionLocID sets the VersionLocID property of GetOfferingLicenseOptions. A dotted value of
+// `catalogID`.`versionID`.
+func GetOfferingLicenseVersionLocID(versionLocID string) GetOfferingLicenseOption {
+	return func(options *GetOfferingLicenseOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// GetOfferingLicenseLicenseID sets the LicenseID property of GetOfferingLicenseOptions. The ID of the license,
+// which maps to the file name in the 'licenses' directory of this verions tgz file.
+func GetOfferingLicenseLicenseID(licenseID string) GetOfferingLicenseOption {
+	return func(options *GetOfferingLicenseOptions) {
+		options.LicenseID = core.StringPtr(licenseID)
+	}
+}
+
+// GetOfferingLicenseHeader sets the Headers property of GetOfferingLicenseOptions. Allows users to set headers on
+// API requests.
+func GetOfferingLicenseHeader(name string, value string) GetOfferingLicenseOption {
+	return func(options *GetOfferingLicenseOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingLicenseOptionsWith returns GetOfferingLicenseOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingLicenseOptionsWith(options ...GetOfferingLicenseOption) *GetOfferingLicenseOptions {
+	getOfferingLicenseOptions := &GetOfferingLicenseOptions{}
+	for _, option := range options {
+		option(getOfferingLicenseOptions)
+	}
+	return getOfferingLicenseOptions
+}
+
+// GetOfferingLicenseWith is an alternate form of the GetOfferingLicenseWithContext method which takes functional
+// options instead of a GetOfferingLicenseOptions struct. GetOfferingLicenseVersionLocID and
+// GetOfferingLicenseLicenseID are required.
+func (catalogManagement *CatalogManagementV1) GetOfferingLicenseWith(ctx context.Context, options ...GetOfferingLicenseOption) (result *string, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingLicenseWithContext(ctx, catalogManagement.NewGetOfferingLicenseOptionsWith(options...))
+}
+
+// GetOfferingContainerImagesOption : A functional option that sets a property of GetOfferingContainerImagesOptions (see GetOfferingContainerImagesWith).
+type GetOfferingContainerImagesOption func(options *GetOfferingContainerImagesOptions)
+
+// GetOfferingContainerImagesVersionLocID sets the VersionLocID property of GetOfferingContainerImagesOptions. A
+// dotted value of `catalogID`.`versionID`.
+func GetOfferingContainerImagesVersionLocID(versionLocID string) GetOfferingContainerImagesOption {
+	return func(options *GetOfferingContainerImagesOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// GetOfferingContainerImagesHeader sets the Headers property of GetOfferingContainerImagesOptions. Allows users to
+// set headers on API requests.
+func GetOfferingContainerImagesHeader(name string, value string) GetOfferingContainerImagesOption {
+	return func(options *GetOfferingContainerImagesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingContainerImagesOptionsWith returns GetOfferingContainerImagesOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingContainerImagesOptionsWith(options ...GetOfferingContainerImagesOption) *GetOfferingContainerImagesOptions {
+	getOfferingContainerImagesOptions := &GetOfferingContainerImagesOptions{}
+	for _, option := range options {
+		option(getOfferingContainerImagesOptions)
+	}
+	return getOfferingContainerImagesOptions
+}
+
+// GetOfferingContainerImagesWith is an alternate form of the GetOfferingContainerImagesWithContext method which
+// takes functional options instead of a GetOfferingContainerImagesOptions struct.
+// GetOfferingContainerImagesVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) GetOfferingContainerImagesWith(ctx context.Context, options ...GetOfferingContainerImagesOption) (result *ImageManifest, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingContainerImagesWithContext(ctx, catalogManagement.NewGetOfferingContainerImagesOptionsWith(options...))
+}
+
+// ArchiveVersionOption : A functional option that sets a property of ArchiveVersionOptions (see ArchiveVersionWith).
+type ArchiveVersionOption func(options *ArchiveVersionOptions)
+
+// ArchiveVersionVersionLocID sets the VersionLocID property of ArchiveVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func ArchiveVersionVersionLocID(versionLocID string) ArchiveVersionOption {
+	return func(options *ArchiveVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// ArchiveVersionHeader sets the Headers property of ArchiveVersionOptions. Allows users to set headers on API
+// requests.
+func ArchiveVersionHeader(name string, value string) ArchiveVersionOption {
+	return func(options *ArchiveVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewArchiveVersionOptionsWith returns ArchiveVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewArchiveVersionOptionsWith(options ...ArchiveVersionOption) *ArchiveVersionOptions {
+	archiveVersionOptions := &ArchiveVersionOptions{}
+	for _, option := range options {
+		option(archiveVersionOptions)
+	}
+	return archiveVersionOptions
+}
+
+// ArchiveVersionWith is an alternate form of the ArchiveVersionWithContext method which takes functional options
+// instead of an ArchiveVersionOptions struct. ArchiveVersionVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) ArchiveVersionWith(ctx context.Context, options ...ArchiveVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.ArchiveVersionWithContext(ctx, catalogManagement.NewArchiveVersionOptionsWith(options...))
+}
+
+// SetDeprecateVersionOption : A functional option that sets a property of SetDeprecateVersionOptions (see SetDeprecateVersionWith).
+type SetDeprecateVersionOption func(options *SetDeprecateVersionOptions)
+
+// SetDeprecateVersionVersionLocID sets the VersionLocID property of SetDeprecateVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func SetDeprecateVersionVersionLocID(versionLocID string) SetDeprecateVersionOption {
+	return func(options *SetDeprecateVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// SetDeprecateVersionSetting sets the Setting property of SetDeprecateVersionOptions. Set deprecation (true) or
+// cancel deprecation (false).
+func SetDeprecateVersionSetting(setting string) SetDeprecateVersionOption {
+	return func(options *SetDeprecateVersionOptions) {
+		options.Setting = core.StringPtr(setting)
+	}
+}
+
+// SetDeprecateVersionDescription sets the Description property of SetDeprecateVersionOptions. Additional
+// information that users can provide to be displayed in deprecation notification.
+func SetDeprecateVersionDescription(description string) SetDeprecateVersionOption {
+	return func(options *SetDeprecateVersionOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// SetDeprecateVersionDaysUntilDeprecate sets the DaysUntilDeprecate property of SetDeprecateVersionOptions.
+// Specifies the amount of days until product is not available in catalog.
+func SetDeprecateVersionDaysUntilDeprecate(daysUntilDeprecate int64) SetDeprecateVersionOption {
+	return func(options *SetDeprecateVersionOptions) {
+		options.DaysUntilDeprecate = core.Int64Ptr(daysUntilDeprecate)
+	}
+}
+
+// SetDeprecateVersionHeader sets the Headers property of SetDeprecateVersionOptions. Allows users to set headers
+// on API requests.
+func SetDeprecateVersionHeader(name string, value string) SetDeprecateVersionOption {
+	return func(options *SetDeprecateVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewSetDeprecateVersionOptionsWith returns SetDeprecateVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewSetDeprecateVersionOptionsWith(options ...SetDeprecateVersionOption) *SetDeprecateVersionOptions {
+	setDeprecateVersionOptions := &SetDeprecateVersionOptions{}
+	for _, option := range options {
+		option(setDeprecateVersionOptions)
+	}
+	return setDeprecateVersionOptions
+}
+
+// SetDeprecateVersionWith is an alternate form of the SetDeprecateVersionWithContext method which takes functional
+// options instead of a SetDeprecateVersionOptions struct. SetDeprecateVersionVersionLocID and
+// SetDeprecateVersionSetting are required.
+func (catalogManagement *CatalogManagementV1) SetDeprecateVersionWith(ctx context.Context, options ...SetDeprecateVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.SetDeprecateVersionWithContext(ctx, catalogManagement.NewSetDeprecateVersionOptionsWith(options...))
+}
+
+// ConsumableVersionOption : A functional option that sets a property of ConsumableVersionOptions (see ConsumableVersionWith).
+type ConsumableVersionOption func(options *ConsumableVersionOptions)
+
+// ConsumableVersionVersionLocID sets the VersionLocID property of ConsumableVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func ConsumableVersionVersionLocID(versionLocID string) ConsumableVersionOption {
+	return func(options *ConsumableVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// ConsumableVersionHeader sets the Headers property of ConsumableVersionOptions. Allows users to set headers on
+// API requests.
+func ConsumableVersionHeader(name string, value string) ConsumableVersionOption {
+	return func(options *ConsumableVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewConsumableVersionOptionsWith returns ConsumableVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewConsumableVersionOptionsWith(options ...ConsumableVersionOption) *ConsumableVersionOptions {
+	consumableVersionOptions := &ConsumableVersionOptions{}
+	for _, option := range options {
+		option(consumableVersionOptions)
+	}
+	return consumableVersionOptions
+}
+
+// ConsumableVersionWith is an alternate form of the ConsumableVersionWithContext method which takes functional
+// options instead of a ConsumableVersionOptions struct. ConsumableVersionVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) ConsumableVersionWith(ctx context.Context, options ...ConsumableVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.ConsumableVersionWithContext(ctx, catalogManagement.NewConsumableVersionOptionsWith(options...))
+}
+
+// SuspendVersionOption : A functional option that sets a property of SuspendVersionOptions (see SuspendVersionWith).
+type SuspendVersionOption func(options *SuspendVersionOptions)
+
+// SuspendVersionVersionLocID sets the VersionLocID property of SuspendVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func SuspendVersionVersionLocID(versionLocID string) SuspendVersionOption {
+	return func(options *SuspendVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// SuspendVersionHeader sets the Headers property of SuspendVersionOptions. Allows users to set headers on API
+// requests.
+func SuspendVersionHeader(name string, value string) SuspendVersionOption {
+	return func(options *SuspendVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewSuspendVersionOptionsWith returns SuspendVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewSuspendVersionOptionsWith(options ...SuspendVersionOption) *SuspendVersionOptions {
+	suspendVersionOptions := &SuspendVersionOptions{}
+	for _, option := range options {
+		option(suspendVersionOptions)
+	}
+	return suspendVersionOptions
+}
+
+// SuspendVersionWith is an alternate form of the SuspendVersionWithContext method which takes functional options
+// instead of a SuspendVersionOptions struct. SuspendVersionVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) SuspendVersionWith(ctx context.Context, options ...SuspendVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.SuspendVersionWithContext(ctx, catalogManagement.NewSuspendVersionOptionsWith(options...))
+}
+
+// CommitVersionOption : A functional option that sets a property of CommitVersionOptions (see CommitVersionWith).
+type CommitVersionOption func(options *CommitVersionOptions)
+
+// CommitVersionVersionLocID sets the VersionLocID property of CommitVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func CommitVersionVersionLocID(versionLocID string) CommitVersionOption {
+	return func(options *CommitVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// CommitVersionHeader sets the Headers property of CommitVersionOptions. Allows users to set headers on API
+// requests.
+func CommitVersionHeader(name string, value string) CommitVersionOption {
+	return func(options *CommitVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCommitVersionOptionsWith returns CommitVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewCommitVersionOptionsWith(options ...CommitVersionOption) *CommitVersionOptions {
+	commitVersionOptions := &CommitVersionOptions{}
+	for _, option := range options {
+		option(commitVersionOptions)
+	}
+	return commitVersionOptions
+}
+
+// CommitVersionWith is an alternate form of the CommitVersionWithContext method which takes functional options
+// instead of a CommitVersionOptions struct. CommitVersionVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) CommitVersionWith(ctx context.Context, options ...CommitVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.CommitVersionWithContext(ctx, catalogManagement.NewCommitVersionOptionsWith(options...))
+}
+
+// CopyVersionOption : A functional option that sets a property of CopyVersionOptions (see CopyVersionWith).
+type CopyVersionOption func(options *CopyVersionOptions)
+
+// CopyVersionVersionLocID sets the VersionLocID property of CopyVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func CopyVersionVersionLocID(versionLocID string) CopyVersionOption {
+	return func(options *CopyVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// CopyVersionTags sets the Tags property of CopyVersionOptions. Tags array.
+func CopyVersionTags(tags ...string) CopyVersionOption {
+	return func(options *CopyVersionOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// CopyVersionContent sets the Content property of CopyVersionOptions. byte array representing the content to be
+// imported.
+func CopyVersionContent(content *[]byte) CopyVersionOption {
+	return func(options *CopyVersionOptions) {
+		options.Content = content
+	}
+}
+
+// CopyVersionTargetKinds sets the TargetKinds property of CopyVersionOptions. Target kinds.
+func CopyVersionTargetKinds(targetKinds ...string) CopyVersionOption {
+	return func(options *CopyVersionOptions) {
+		options.TargetKinds = append(options.TargetKinds, targetKinds...)
+	}
+}
+
+// CopyVersionFormatKind sets the FormatKind property of CopyVersionOptions. Format of content being onboarded.
+func CopyVersionFormatKind(formatKind string) CopyVersionOption {
+	return func(options *CopyVersionOptions) {
+		options.FormatKind = core.StringPtr(formatKind)
+	}
+}
+
+// CopyVersionFlavor sets the Flavor property of CopyVersionOptions. Version Flavor Information.
+func CopyVersionFlavor(flavor *Flavor) CopyVersionOption {
+	return func(options *CopyVersionOptions) {
+		options.Flavor = flavor
+	}
+}
+
+// CopyVersionWorkingDirectory sets the WorkingDirectory property of CopyVersionOptions. Optional - The sub-folder
+// within the specified tgz file that contains the software being onboarded.
+func CopyVersionWorkingDirectory(workingDirectory string) CopyVersionOption {
+	return func(options *CopyVersionOptions) {
+		options.WorkingDirectory = core.StringPtr(workingDirectory)
+	}
+}
+
+// CopyVersionHeader sets the Headers property of CopyVersionOptions. Allows users to set headers on API requests.
+func CopyVersionHeader(name string, value string) CopyVersionOption {
+	return func(options *CopyVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCopyVersionOptionsWith returns CopyVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewCopyVersionOptionsWith(options ...CopyVersionOption) *CopyVersionOptions {
+	copyVersionOptions := &CopyVersionOptions{}
+	for _, option := range options {
+		option(copyVersionOptions)
+	}
+	return copyVersionOptions
+}
+
+// CopyVersionWith is an alternate form of the CopyVersionWithContext method which takes functional options instead
+// of a CopyVersionOptions struct. CopyVersionVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) CopyVersionWith(ctx context.Context, options ...CopyVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.CopyVersionWithContext(ctx, catalogManagement.NewCopyVersionOptionsWith(options...))
+}
+
+// GetOfferingWorkingCopyOption : A functional option that sets a property of GetOfferingWorkingCopyOptions (see GetOfferingWorkingCopyWith).
+type GetOfferingWorkingCopyOption func(options *GetOfferingWorkingCopyOptions)
+
+// GetOfferingWorkingCopyVersionLocID sets the VersionLocID property of GetOfferingWorkingCopyOptions. A dotted
+// value of `catalogID`.`versionID`.
+func GetOfferingWorkingCopyVersionLocID(versionLocID string) GetOfferingWorkingCopyOption {
+	return func(options *GetOfferingWorkingCopyOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// GetOfferingWorkingCopyHeader sets the Headers property of GetOfferingWorkingCopyOptions. Allows users to set
+// headers on API requests.
+func GetOfferingWorkingCopyHeader(name string, value string) GetOfferingWorkingCopyOption {
+	return func(options *GetOfferingWorkingCopyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingWorkingCopyOptionsWith returns GetOfferingWorkingCopyOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingWorkingCopyOptionsWith(options ...GetOfferingWorkingCopyOption) *GetOfferingWorkingCopyOptions {
+	getOfferingWorkingCopyOptions := &GetOfferingWorkingCopyOptions{}
+	for _, option := range options {
+		option(getOfferingWorkingCopyOptions)
+	}
+	return getOfferingWorkingCopyOptions
+}
+
+// GetOfferingWorkingCopyWith is an alternate form of the GetOfferingWorkingCopyWithContext method which takes
+// functional options instead of a GetOfferingWorkingCopyOptions struct. GetOfferingWorkingCopyVersionLocID is
+// required.
+func (catalogManagement *CatalogManagementV1) GetOfferingWorkingCopyWith(ctx context.Context, options ...GetOfferingWorkingCopyOption) (result *Version, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingWorkingCopyWithContext(ctx, catalogManagement.NewGetOfferingWorkingCopyOptionsWith(options...))
+}
+
+// CopyFromPreviousVersionOption : A functional option that sets a property of CopyFromPreviousVersionOptions (see CopyFromPreviousVersionWith).
+type CopyFromPreviousVersionOption func(options *CopyFromPreviousVersionOptions)
+
+// CopyFromPreviousVersionVersionLocID sets the VersionLocID property of CopyFromPreviousVersionOptions. A dotted
+// value of `catalogID`.`versionID`.
+func CopyFromPreviousVersionVersionLocID(versionLocID string) CopyFromPreviousVersionOption {
+	return func(options *CopyFromPreviousVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// CopyFromPreviousVersionType sets the Type property of CopyFromPreviousVersionOptions. The type of data you would
+// like to copy from a previous version.
+func CopyFromPreviousVersionType(typeVar string) CopyFromPreviousVersionOption {
+	return func(options *CopyFromPreviousVersionOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// CopyFromPreviousVersionVersionLocIDToCopyFrom sets the VersionLocIDToCopyFrom property of
+// CopyFromPreviousVersionOptions. The version locator id of the version you wish to copy data from.
+func CopyFromPreviousVersionVersionLocIDToCopyFrom(versionLocIDToCopyFrom string) CopyFromPreviousVersionOption {
+	return func(options *CopyFromPreviousVersionOptions) {
+		options.VersionLocIDToCopyFrom = core.StringPtr(versionLocIDToCopyFrom)
+	}
+}
+
+// CopyFromPreviousVersionHeader sets the Headers property of CopyFromPreviousVersionOptions. Allows users to set
+// headers on API requests.
+func CopyFromPreviousVersionHeader(name string, value string) CopyFromPreviousVersionOption {
+	return func(options *CopyFromPreviousVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCopyFromPreviousVersionOptionsWith returns CopyFromPreviousVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewCopyFromPreviousVersionOptionsWith(options ...CopyFromPreviousVersionOption) *CopyFromPreviousVersionOptions {
+	copyFromPreviousVersionOptions := &CopyFromPreviousVersionOptions{}
+	for _, option := range options {
+		option(copyFromPreviousVersionOptions)
+	}
+	return copyFromPreviousVersionOptions
+}
+
+// CopyFromPreviousVersionWith is an alternate form of the CopyFromPreviousVersionWithContext method which takes
+// functional options instead of a CopyFromPreviousVersionOptions struct. CopyFromPreviousVersionVersionLocID,
+// CopyFromPreviousVersionType and CopyFromPreviousVersionVersionLocIDToCopyFrom are required.
+func (catalogManagement *CatalogManagementV1) CopyFromPreviousVersionWith(ctx context.Context, options ...CopyFromPreviousVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.CopyFromPreviousVersionWithContext(ctx, catalogManagement.NewCopyFromPreviousVersionOptionsWith(options...))
+}
+
+// GetVersionOption : A functional option that sets a property of GetVersionOptions (see GetVersionWith).
+type GetVersionOption func(options *GetVersionOptions)
+
+// GetVersionVersionLocID sets the VersionLocID property of GetVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func GetVersionVersionLocID(versionLocID string) GetVersionOption {
+	return func(options *GetVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// GetVersionHeader sets the Headers property of GetVersionOptions. Allows users to set headers on API requests.
+func GetVersionHeader(name string, value string) GetVersionOption {
+	return func(options *GetVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetVersionOptionsWith returns GetVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetVersionOptionsWith(options ...GetVersionOption) *GetVersionOptions {
+	getVersionOptions := &GetVersionOptions{}
+	for _, option := range options {
+		option(getVersionOptions)
+	}
+	return getVersionOptions
+}
+
+// GetVersionWith is an alternate form of the GetVersionWithContext method which takes functional options instead
+// of a GetVersionOptions struct. GetVersionVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) GetVersionWith(ctx context.Context, options ...GetVersionOption) (result *Offering, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetVersionWithContext(ctx, catalogManagement.NewGetVersionOptionsWith(options...))
+}
+
+// DeleteVersionOption : A functional option that sets a property of DeleteVersionOptions (see DeleteVersionWith).
+type DeleteVersionOption func(options *DeleteVersionOptions)
+
+// DeleteVersionVersionLocID sets the VersionLocID property of DeleteVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func DeleteVersionVersionLocID(versionLocID string) DeleteVersionOption {
+	return func(options *DeleteVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// DeleteVersionHeader sets the Headers property of DeleteVersionOptions. Allows users to set headers on API
+// requests.
+func DeleteVersionHeader(name string, value string) DeleteVersionOption {
+	return func(options *DeleteVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteVersionOptionsWith returns DeleteVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeleteVersionOptionsWith(options ...DeleteVersionOption) *DeleteVersionOptions {
+	deleteVersionOptions := &DeleteVersionOptions{}
+	for _, option := range options {
+		option(deleteVersionOptions)
+	}
+	return deleteVersionOptions
+}
+
+// DeleteVersionWith is an alternate form of the DeleteVersionWithContext method which takes functional options
+// instead of a DeleteVersionOptions struct. DeleteVersionVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) DeleteVersionWith(ctx context.Context, options ...DeleteVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.DeleteVersionWithContext(ctx, catalogManagement.NewDeleteVersionOptionsWith(options...))
+}
+
+// DeprecateVersionOption : A functional option that sets a property of DeprecateVersionOptions (see DeprecateVersionWith).
+type DeprecateVersionOption func(options *DeprecateVersionOptions)
+
+// DeprecateVersionVersionLocID sets the VersionLocID property of DeprecateVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func DeprecateVersionVersionLocID(versionLocID string) DeprecateVersionOption {
+	return func(options *DeprecateVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// DeprecateVersionHeader sets the Headers property of DeprecateVersionOptions. Allows users to set headers on API
+// requests.
+func DeprecateVersionHeader(name string, value string) DeprecateVersionOption {
+	return func(options *DeprecateVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeprecateVersionOptionsWith returns DeprecateVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeprecateVersionOptionsWith(options ...DeprecateVersionOption) *DeprecateVersionOptions {
+	deprecateVersionOptions := &DeprecateVersionOptions{}
+	for _, option := range options {
+		option(deprecateVersionOptions)
+	}
+	return deprecateVersionOptions
+}
+
+// DeprecateVersionWith is an alternate form of the DeprecateVersionWithContext method which takes functional
+// options instead of a DeprecateVersionOptions struct. DeprecateVersionVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) DeprecateVersionWith(ctx context.Context, options ...DeprecateVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.DeprecateVersionWithContext(ctx, catalogManagement.NewDeprecateVersionOptionsWith(options...))
+}
+
+// AccountPublishVersionOption : A functional option that sets a property of AccountPublishVersionOptions (see AccountPublishVersionWith).
+type AccountPublishVersionOption func(options *AccountPublishVersionOptions)
+
+// AccountPublishVersionVersionLocID sets the VersionLocID property of AccountPublishVersionOptions. A dotted value
+// of `catalogID`.`versionID`.
+func AccountPublishVersionVersionLocID(versionLocID string) AccountPublishVersionOption {
+	return func(options *AccountPublishVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// AccountPublishVersionHeader sets the Headers property of AccountPublishVersionOptions. Allows users to set
+// headers on API requests.
+func AccountPublishVersionHeader(name string, value string) AccountPublishVersionOption {
+	return func(options *AccountPublishVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewAccountPublishVersionOptionsWith returns AccountPublishVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewAccountPublishVersionOptionsWith(options ...AccountPublishVersionOption) *AccountPublishVersionOptions {
+	accountPublishVersionOptions := &AccountPublishVersionOptions{}
+	for _, option := range options {
+		option(accountPublishVersionOptions)
+	}
+	return accountPublishVersionOptions
+}
+
+// AccountPublishVersionWith is an alternate form of the AccountPublishVersionWithContext method which takes
+// functional options instead of an AccountPublishVersionOptions struct. AccountPublishVersionVersionLocID is
+// required.
+func (catalogManagement *CatalogManagementV1) AccountPublishVersionWith(ctx context.Context, options ...AccountPublishVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.AccountPublishVersionWithContext(ctx, catalogManagement.NewAccountPublishVersionOptionsWith(options...))
+}
+
+// IBMPublishVersionOption : A functional option that sets a property of IBMPublishVersionOptions (see IBMPublishVersionWith).
+type IBMPublishVersionOption func(options *IBMPublishVersionOptions)
+
+// IBMPublishVersionVersionLocID sets the VersionLocID property of IBMPublishVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func IBMPublishVersionVersionLocID(versionLocID string) IBMPublishVersionOption {
+	return func(options *IBMPublishVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// IBMPublishVersionHeader sets the Headers property of IBMPublishVersionOptions. Allows users to set headers on
+// API requests.
+func IBMPublishVersionHeader(name string, value string) IBMPublishVersionOption {
+	return func(options *IBMPublishVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewIBMPublishVersionOptionsWith returns IBMPublishVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewIBMPublishVersionOptionsWith(options ...IBMPublishVersionOption) *IBMPublishVersionOptions {
+	ibmPublishVersionOptions := &IBMPublishVersionOptions{}
+	for _, option := range options {
+		option(ibmPublishVersionOptions)
+	}
+	return ibmPublishVersionOptions
+}
+
+// IBMPublishVersionWith is an alternate form of the IBMPublishVersionWithContext method which takes functional
+// options instead of an IBMPublishVersionOptions struct. IBMPublishVersionVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) IBMPublishVersionWith(ctx context.Context, options ...IBMPublishVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.IBMPublishVersionWithContext(ctx, catalogManagement.NewIBMPublishVersionOptionsWith(options...))
+}
+
+// PublicPublishVersionOption : A functional option that sets a property of PublicPublishVersionOptions (see PublicPublishVersionWith).
+type PublicPublishVersionOption func(options *PublicPublishVersionOptions)
+
+// PublicPublishVersionVersionLocID sets the VersionLocID property of PublicPublishVersionOptions. A dotted value
+// of `catalogID`.`versionID`.
+func PublicPublishVersionVersionLocID(versionLocID string) PublicPublishVersionOption {
+	return func(options *PublicPublishVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// PublicPublishVersionHeader sets the Headers property of PublicPublishVersionOptions. Allows users to set headers
+// on API requests.
+func PublicPublishVersionHeader(name string, value string) PublicPublishVersionOption {
+	return func(options *PublicPublishVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewPublicPublishVersionOptionsWith returns PublicPublishVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewPublicPublishVersionOptionsWith(options ...PublicPublishVersionOption) *PublicPublishVersionOptions {
+	publicPublishVersionOptions := &PublicPublishVersionOptions{}
+	for _, option := range options {
+		option(publicPublishVersionOptions)
+	}
+	return publicPublishVersionOptions
+}
+
+// PublicPublishVersionWith is an alternate form of the PublicPublishVersionWithContext method which takes
+// functional options instead of a PublicPublishVersionOptions struct. PublicPublishVersionVersionLocID is
+// required.
+func (catalogManagement *CatalogManagementV1) PublicPublishVersionWith(ctx context.Context, options ...PublicPublishVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.PublicPublishVersionWithContext(ctx, catalogManagement.NewPublicPublishVersionOptionsWith(options...))
+}
+
+// GetClusterOption : A functional option that sets a property of GetClusterOptions (see GetClusterWith).
+type GetClusterOption func(options *GetClusterOptions)
+
+// GetClusterClusterID sets the ClusterID property of GetClusterOptions. ID of the cluster.
+func GetClusterClusterID(clusterID string) GetClusterOption {
+	return func(options *GetClusterOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// GetClusterRegion sets the Region property of GetClusterOptions. Region of the cluster.
+func GetClusterRegion(region string) GetClusterOption {
+	return func(options *GetClusterOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// GetClusterXAuthRefreshToken sets the XAuthRefreshToken property of GetClusterOptions. IAM Refresh token.
+func GetClusterXAuthRefreshToken(xAuthRefreshToken string) GetClusterOption {
+	return func(options *GetClusterOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// GetClusterHeader sets the Headers property of GetClusterOptions. Allows users to set headers on API requests.
+func GetClusterHeader(name string, value string) GetClusterOption {
+	return func(options *GetClusterOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetClusterOptionsWith returns GetClusterOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetClusterOptionsWith(options ...GetClusterOption) *GetClusterOptions {
+	getClusterOptions := &GetClusterOptions{}
+	for _, option := range options {
+		option(getClusterOptions)
+	}
+	return getClusterOptions
+}
+
+// GetClusterWith is an alternate form of the GetClusterWithContext method which takes functional options instead
+// of a GetClusterOptions struct. GetClusterClusterID, GetClusterRegion and GetClusterXAuthRefreshToken are
+// required.
+func (catalogManagement *CatalogManagementV1) GetClusterWith(ctx context.Context, options ...GetClusterOption) (result *ClusterInfo, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetClusterWithContext(ctx, catalogManagement.NewGetClusterOptionsWith(options...))
+}
+
+// GetNamespacesOption : A functional option that sets a property of GetNamespacesOptions (see GetNamespacesWith).
+type GetNamespacesOption func(options *GetNamespacesOptions)
+
+// GetNamespacesClusterID sets the ClusterID property of GetNamespacesOptions. ID of the cluster.
+func GetNamespacesClusterID(clusterID string) GetNamespacesOption {
+	return func(options *GetNamespacesOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// GetNamespacesRegion sets the Region property of GetNamespacesOptions. Cluster region.
+func GetNamespacesRegion(region string) GetNamespacesOption {
+	return func(options *GetNamespacesOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// GetNamespacesXAuthRefreshToken sets the XAuthRefreshToken property of GetNamespacesOptions. IAM Refresh token.
+func GetNamespacesXAuthRefreshToken(xAuthRefreshToken string) GetNamespacesOption {
+	return func(options *GetNamespacesOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// GetNamespacesLimit sets the Limit property of GetNamespacesOptions. The maximum number of results to return.
+func GetNamespacesLimit(limit int64) GetNamespacesOption {
+	return func(options *GetNamespacesOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// GetNamespacesOffset sets the Offset property of GetNamespacesOptions. The number of results to skip before
+// returning values.
+func GetNamespacesOffset(offset int64) GetNamespacesOption {
+	return func(options *GetNamespacesOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// GetNamespacesHeader sets the Headers property of GetNamespacesOptions. Allows users to set headers on API
+// requests.
+func GetNamespacesHeader(name string, value string) GetNamespacesOption {
+	return func(options *GetNamespacesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetNamespacesOptionsWith returns GetNamespacesOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetNamespacesOptionsWith(options ...GetNamespacesOption) *GetNamespacesOptions {
+	getNamespacesOptions := &GetNamespacesOptions{}
+	for _, option := range options {
+		option(getNamespacesOptions)
+	}
+	return getNamespacesOptions
+}
+
+// GetNamespacesWith is an alternate form of the GetNamespacesWithContext method which takes functional options
+// instead of a GetNamespacesOptions struct. GetNamespacesClusterID, GetNamespacesRegion and
+// GetNamespacesXAuthRefreshToken are required.
+func (catalogManagement *CatalogManagementV1) GetNamespacesWith(ctx context.Context, options ...GetNamespacesOption) (result *NamespaceSearchResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetNamespacesWithContext(ctx, catalogManagement.NewGetNamespacesOptionsWith(options...))
+}
+
+// DeployOperatorsOption : A functional option that sets a property of DeployOperatorsOptions (see DeployOperatorsWith).
+type DeployOperatorsOption func(options *DeployOperatorsOptions)
+
+// DeployOperatorsXAuthRefreshToken sets the XAuthRefreshToken property of DeployOperatorsOptions. IAM Refresh
+// token.
+func DeployOperatorsXAuthRefreshToken(xAuthRefreshToken string) DeployOperatorsOption {
+	return func(options *DeployOperatorsOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// DeployOperatorsClusterID sets the ClusterID property of DeployOperatorsOptions. Cluster ID.
+func DeployOperatorsClusterID(clusterID string) DeployOperatorsOption {
+	return func(options *DeployOperatorsOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// DeployOperatorsRegion sets the Region property of DeployOperatorsOptions. Cluster region.
+func DeployOperatorsRegion(region string) DeployOperatorsOption {
+	return func(options *DeployOperatorsOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// DeployOperatorsNamespaces sets the Namespaces property of DeployOperatorsOptions. Kube namespaces to deploy
+// Operator(s) to.
+func DeployOperatorsNamespaces(namespaces ...string) DeployOperatorsOption {
+	return func(options *DeployOperatorsOptions) {
+		options.Namespaces = append(options.Namespaces, namespaces...)
+	}
+}
+
+// DeployOperatorsAllNamespaces sets the AllNamespaces property of DeployOperatorsOptions. Denotes whether to
+// install Operator(s) globally.
+func DeployOperatorsAllNamespaces(allNamespaces bool) DeployOperatorsOption {
+	return func(options *DeployOperatorsOptions) {
+		options.AllNamespaces = core.BoolPtr(allNamespaces)
+	}
+}
+
+// DeployOperatorsVersionLocatorID sets the VersionLocatorID property of DeployOperatorsOptions. A dotted value of
+// `catalogID`.`versionID`.
+func DeployOperatorsVersionLocatorID(versionLocatorID string) DeployOperatorsOption {
+	return func(options *DeployOperatorsOptions) {
+		options.VersionLocatorID = core.StringPtr(versionLocatorID)
+	}
+}
+
+// DeployOperatorsChannel sets the Channel property of DeployOperatorsOptions. Operator channel.
+func DeployOperatorsChannel(channel string) DeployOperatorsOption {
+	return func(options *DeployOperatorsOptions) {
+		options.Channel = core.StringPtr(channel)
+	}
+}
+
+// DeployOperatorsInstallPlan sets the InstallPlan property of DeployOperatorsOptions. Plan.
+func DeployOperatorsInstallPlan(installPlan string) DeployOperatorsOption {
+	return func(options *DeployOperatorsOptions) {
+		options.InstallPlan = core.StringPtr(installPlan)
+	}
+}
+
+// DeployOperatorsHeader sets the Headers property of DeployOperatorsOptions. Allows users to set headers on API
+// requests.
+func DeployOperatorsHeader(name string, value string) DeployOperatorsOption {
+	return func(options *DeployOperatorsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeployOperatorsOptionsWith returns DeployOperatorsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeployOperatorsOptionsWith(options ...DeployOperatorsOption) *DeployOperatorsOptions {
+	deployOperatorsOptions := &DeployOperatorsOptions{}
+	for _, option := range options {
+		option(deployOperatorsOptions)
+	}
+	return deployOperatorsOptions
+}
+
+// DeployOperatorsWith is an alternate form of the DeployOperatorsWithContext method which takes functional options
+// instead of a DeployOperatorsOptions struct. DeployOperatorsXAuthRefreshToken is required.
+func (catalogManagement *CatalogManagementV1) DeployOperatorsWith(ctx context.Context, options ...DeployOperatorsOption) (result []OperatorDeployResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.DeployOperatorsWithContext(ctx, catalogManagement.NewDeployOperatorsOptionsWith(options...))
+}
+
+// ListOperatorsOption : A functional option that sets a property of ListOperatorsOptions (see ListOperatorsWith).
+type ListOperatorsOption func(options *ListOperatorsOptions)
+
+// ListOperatorsXAuthRefreshToken sets the XAuthRefreshToken property of ListOperatorsOptions. IAM Refresh token.
+func ListOperatorsXAuthRefreshToken(xAuthRefreshToken string) ListOperatorsOption {
+	return func(options *ListOperatorsOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// ListOperatorsClusterID sets the ClusterID property of ListOperatorsOptions. Cluster identification.
+func ListOperatorsClusterID(clusterID string) ListOperatorsOption {
+	return func(options *ListOperatorsOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// ListOperatorsRegion sets the Region property of ListOperatorsOptions. Cluster region.
+func ListOperatorsRegion(region string) ListOperatorsOption {
+	return func(options *ListOperatorsOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// ListOperatorsVersionLocatorID sets the VersionLocatorID property of ListOperatorsOptions. A dotted value of
+// `catalogID`.`versionID`.
+func ListOperatorsVersionLocatorID(versionLocatorID string) ListOperatorsOption {
+	return func(options *ListOperatorsOptions) {
+		options.VersionLocatorID = core.StringPtr(versionLocatorID)
+	}
+}
+
+// ListOperatorsHeader sets the Headers property of ListOperatorsOptions. Allows users to set headers on API
+// requests.
+func ListOperatorsHeader(name string, value string) ListOperatorsOption {
+	return func(options *ListOperatorsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListOperatorsOptionsWith returns ListOperatorsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewListOperatorsOptionsWith(options ...ListOperatorsOption) *ListOperatorsOptions {
+	listOperatorsOptions := &ListOperatorsOptions{}
+	for _, option := range options {
+		option(listOperatorsOptions)
+	}
+	return listOperatorsOptions
+}
+
+// ListOperatorsWith is an alternate form of the ListOperatorsWithContext method which takes functional options
+// instead of a ListOperatorsOptions struct. ListOperatorsXAuthRefreshToken, ListOperatorsClusterID,
+// ListOperatorsRegion and ListOperatorsVersionLocatorID are required.
+func (catalogManagement *CatalogManagementV1) ListOperatorsWith(ctx context.Context, options ...ListOperatorsOption) (result []OperatorDeployResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.ListOperatorsWithContext(ctx, catalogManagement.NewListOperatorsOptionsWith(options...))
+}
+
+// ReplaceOperatorsOption : A functional option that sets a property of ReplaceOperatorsOptions (see ReplaceOperatorsWith).
+type ReplaceOperatorsOption func(options *ReplaceOperatorsOptions)
+
+// ReplaceOperatorsXAuthRefreshToken sets the XAuthRefreshToken property of ReplaceOperatorsOptions. IAM Refresh
+// token.
+func ReplaceOperatorsXAuthRefreshToken(xAuthRefreshToken string) ReplaceOperatorsOption {
+	return func(options *ReplaceOperatorsOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// ReplaceOperatorsClusterID sets the ClusterID property of ReplaceOperatorsOptions. Cluster ID.
+func ReplaceOperatorsClusterID(clusterID string) ReplaceOperatorsOption {
+	return func(options *ReplaceOperatorsOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// ReplaceOperatorsRegion sets the Region property of ReplaceOperatorsOptions. Cluster region.
+func ReplaceOperatorsRegion(region string) ReplaceOperatorsOption {
+	return func(options *ReplaceOperatorsOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// ReplaceOperatorsNamespaces sets the Namespaces property of ReplaceOperatorsOptions. Kube namespaces to deploy
+// Operator(s) to.
+func ReplaceOperatorsNamespaces(namespaces ...string) ReplaceOperatorsOption {
+	return func(options *ReplaceOperatorsOptions) {
+		options.Namespaces = append(options.Namespaces, namespaces...)
+	}
+}
+
+// ReplaceOperatorsAllNamespaces sets the AllNamespaces property of ReplaceOperatorsOptions. Denotes whether to
+// install Operator(s) globally.
+func ReplaceOperatorsAllNamespaces(allNamespaces bool) ReplaceOperatorsOption {
+	return func(options *ReplaceOperatorsOptions) {
+		options.AllNamespaces = core.BoolPtr(allNamespaces)
+	}
+}
+
+// ReplaceOperatorsVersionLocatorID sets the VersionLocatorID property of ReplaceOperatorsOptions. A dotted value
+// of `catalogID`.`versionID`.
+func ReplaceOperatorsVersionLocatorID(versionLocatorID string) ReplaceOperatorsOption {
+	return func(options *ReplaceOperatorsOptions) {
+		options.VersionLocatorID = core.StringPtr(versionLocatorID)
+	}
+}
+
+// ReplaceOperatorsChannel sets the Channel property of ReplaceOperatorsOptions. Operator channel.
+func ReplaceOperatorsChannel(channel string) ReplaceOperatorsOption {
+	return func(options *ReplaceOperatorsOptions) {
+		options.Channel = core.StringPtr(channel)
+	}
+}
+
+// ReplaceOperatorsInstallPlan sets the InstallPlan property of ReplaceOperatorsOptions. Plan.
+func ReplaceOperatorsInstallPlan(installPlan string) ReplaceOperatorsOption {
+	return func(options *ReplaceOperatorsOptions) {
+		options.InstallPlan = core.StringPtr(installPlan)
+	}
+}
+
+// ReplaceOperatorsHeader sets the Headers property of ReplaceOperatorsOptions. Allows users to set headers on API
+// requests.
+func ReplaceOperatorsHeader(name string, value string) ReplaceOperatorsOption {
+	return func(options *ReplaceOperatorsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceOperatorsOptionsWith returns ReplaceOperatorsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewReplaceOperatorsOptionsWith(options ...ReplaceOperatorsOption) *ReplaceOperatorsOptions {
+	replaceOperatorsOptions := &ReplaceOperatorsOptions{}
+	for _, option := range options {
+		option(replaceOperatorsOptions)
+	}
+	return replaceOperatorsOptions
+}
+
+// ReplaceOperatorsWith is an alternate form of the ReplaceOperatorsWithContext method which takes functional
+// options instead of a ReplaceOperatorsOptions struct. ReplaceOperatorsXAuthRefreshToken is required.
+func (catalogManagement *CatalogManagementV1) ReplaceOperatorsWith(ctx context.Context, options ...ReplaceOperatorsOption) (result []OperatorDeployResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.ReplaceOperatorsWithContext(ctx, catalogManagement.NewReplaceOperatorsOptionsWith(options...))
+}
+
+// DeleteOperatorsOption : A functional option that sets a property of DeleteOperatorsOptions (see DeleteOperatorsWith).
+type DeleteOperatorsOption func(options *DeleteOperatorsOptions)
+
+// DeleteOperatorsXAuthRefreshToken sets the XAuthRefreshToken property of DeleteOperatorsOptions. IAM Refresh
+// token.
+func DeleteOperatorsXAuthRefreshToken(xAuthRefreshToken string) DeleteOperatorsOption {
+	return func(options *DeleteOperatorsOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// DeleteOperatorsClusterID sets the ClusterID property of DeleteOperatorsOptions. Cluster identification.
+func DeleteOperatorsClusterID(clusterID string) DeleteOperatorsOption {
+	return func(options *DeleteOperatorsOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// DeleteOperatorsRegion sets the Region property of DeleteOperatorsOptions. Cluster region.
+func DeleteOperatorsRegion(region string) DeleteOperatorsOption {
+	return func(options *DeleteOperatorsOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// DeleteOperatorsVersionLocatorID sets the VersionLocatorID property of DeleteOperatorsOptions. A dotted value of
+// `catalogID`.`versionID`.
+func DeleteOperatorsVersionLocatorID(versionLocatorID string) DeleteOperatorsOption {
+	return func(options *DeleteOperatorsOptions) {
+		options.VersionLocatorID = core.StringPtr(versionLocatorID)
+	}
+}
+
+// DeleteOperatorsHeader sets the Headers property of DeleteOperatorsOptions. Allows users to set headers on API
+// requests.
+func DeleteOperatorsHeader(name string, value string) DeleteOperatorsOption {
+	return func(options *DeleteOperatorsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteOperatorsOptionsWith returns DeleteOperatorsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeleteOperatorsOptionsWith(options ...DeleteOperatorsOption) *DeleteOperatorsOptions {
+	deleteOperatorsOptions := &DeleteOperatorsOptions{}
+	for _, option := range options {
+		option(deleteOperatorsOptions)
+	}
+	return deleteOperatorsOptions
+}
+
+// DeleteOperatorsWith is an alternate form of the DeleteOperatorsWithContext method which takes functional options
+// instead of a DeleteOperatorsOptions struct. DeleteOperatorsXAuthRefreshToken, DeleteOperatorsClusterID,
+// DeleteOperatorsRegion and DeleteOperatorsVersionLocatorID are required.
+func (catalogManagement *CatalogManagementV1) DeleteOperatorsWith(ctx context.Context, options ...DeleteOperatorsOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.DeleteOperatorsWithContext(ctx, catalogManagement.NewDeleteOperatorsOptionsWith(options...))
+}
+
+// InstallVersionOption : A functional option that sets a property of InstallVersionOptions (see InstallVersionWith).
+type InstallVersionOption func(options *InstallVersionOptions)
+
+// InstallVersionVersionLocID sets the VersionLocID property of InstallVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func InstallVersionVersionLocID(versionLocID string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// InstallVersionXAuthRefreshToken sets the XAuthRefreshToken property of InstallVersionOptions. IAM Refresh token.
+func InstallVersionXAuthRefreshToken(xAuthRefreshToken string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// InstallVersionClusterID sets the ClusterID property of InstallVersionOptions. Cluster ID.
+func InstallVersionClusterID(clusterID string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// InstallVersionRegion sets the Region property of InstallVersionOptions. Cluster region.
+func InstallVersionRegion(region string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// InstallVersionNamespace sets the Namespace property of InstallVersionOptions. Kube namespace.
+func InstallVersionNamespace(namespace string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.Namespace = core.StringPtr(namespace)
+	}
+}
+
+// InstallVersionOverrideValues sets the OverrideValues property of InstallVersionOptions. Validation override
+// values.
+func InstallVersionOverrideValues(overrideValues *DeployRequestBodyOverrideValues) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.OverrideValues = overrideValues
+	}
+}
+
+// InstallVersionEnvironmentVariables sets the EnvironmentVariables property of InstallVersionOptions. Schematics
+// environment variables to use with this workspace.
+func InstallVersionEnvironmentVariables(environmentVariables ...DeployRequestBodyEnvironmentVariablesItem) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.EnvironmentVariables = append(options.EnvironmentVariables, environmentVariables...)
+	}
+}
+
+// InstallVersionEntitlementApikey sets the EntitlementApikey property of InstallVersionOptions. Entitlement API
+// Key for this offering.
+func InstallVersionEntitlementApikey(entitlementApikey string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.EntitlementApikey = core.StringPtr(entitlementApikey)
+	}
+}
+
+// InstallVersionSchematics sets the Schematics property of InstallVersionOptions. Schematics workspace
+// configuration.
+func InstallVersionSchematics(schematics *DeployRequestBodySchematics) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.Schematics = schematics
+	}
+}
+
+// InstallVersionScript sets the Script property of InstallVersionOptions. Script.
+func InstallVersionScript(script string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.Script = core.StringPtr(script)
+	}
+}
+
+// InstallVersionScriptID sets the ScriptID property of InstallVersionOptions. Script ID.
+func InstallVersionScriptID(scriptID string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.ScriptID = core.StringPtr(scriptID)
+	}
+}
+
+// InstallVersionVersionLocatorID sets the VersionLocatorID property of InstallVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func InstallVersionVersionLocatorID(versionLocatorID string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.VersionLocatorID = core.StringPtr(versionLocatorID)
+	}
+}
+
+// InstallVersionVcenterID sets the VcenterID property of InstallVersionOptions. VCenter ID.
+func InstallVersionVcenterID(vcenterID string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.VcenterID = core.StringPtr(vcenterID)
+	}
+}
+
+// InstallVersionVcenterLocation sets the VcenterLocation property of InstallVersionOptions. VCenter Location.
+func InstallVersionVcenterLocation(vcenterLocation string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.VcenterLocation = core.StringPtr(vcenterLocation)
+	}
+}
+
+// InstallVersionVcenterUser sets the VcenterUser property of InstallVersionOptions. VCenter User.
+func InstallVersionVcenterUser(vcenterUser string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.VcenterUser = core.StringPtr(vcenterUser)
+	}
+}
+
+// InstallVersionVcenterPassword sets the VcenterPassword property of InstallVersionOptions. VCenter Password.
+func InstallVersionVcenterPassword(vcenterPassword string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.VcenterPassword = core.StringPtr(vcenterPassword)
+	}
+}
+
+// InstallVersionVcenterDatastore sets the VcenterDatastore property of InstallVersionOptions. VCenter Datastore.
+func InstallVersionVcenterDatastore(vcenterDatastore string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		options.VcenterDatastore = core.StringPtr(vcenterDatastore)
+	}
+}
+
+// InstallVersionHeader sets the Headers property of InstallVersionOptions. Allows users to set headers on API
+// requests.
+func InstallVersionHeader(name string, value string) InstallVersionOption {
+	return func(options *InstallVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewInstallVersionOptionsWith returns InstallVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewInstallVersionOptionsWith(options ...InstallVersionOption) *InstallVersionOptions {
+	installVersionOptions := &InstallVersionOptions{}
+	for _, option := range options {
+		option(installVersionOptions)
+	}
+	return installVersionOptions
+}
+
+// InstallVersionWith is an alternate form of the InstallVersionWithContext method which takes functional options
+// instead of an InstallVersionOptions struct. InstallVersionVersionLocID and InstallVersionXAuthRefreshToken are
+// required.
+func (catalogManagement *CatalogManagementV1) InstallVersionWith(ctx context.Context, options ...InstallVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.InstallVersionWithContext(ctx, catalogManagement.NewInstallVersionOptionsWith(options...))
+}
+
+// PreinstallVersionOption : A functional option that sets a property of PreinstallVersionOptions (see PreinstallVersionWith).
+type PreinstallVersionOption func(options *PreinstallVersionOptions)
+
+// PreinstallVersionVersionLocID sets the VersionLocID property of PreinstallVersionOptions. A dotted value of
+// `catalogID`.`versionID`.
+func PreinstallVersionVersionLocID(versionLocID string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// PreinstallVersionXAuthRefreshToken sets the XAuthRefreshToken property of PreinstallVersionOptions. IAM Refresh
+// token.
+func PreinstallVersionXAuthRefreshToken(xAuthRefreshToken string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// PreinstallVersionClusterID sets the ClusterID property of PreinstallVersionOptions. Cluster ID.
+func PreinstallVersionClusterID(clusterID string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// PreinstallVersionRegion sets the Region property of PreinstallVersionOptions. Cluster region.
+func PreinstallVersionRegion(region string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// PreinstallVersionNamespace sets the Namespace property of PreinstallVersionOptions. Kube namespace.
+func PreinstallVersionNamespace(namespace string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.Namespace = core.StringPtr(namespace)
+	}
+}
+
+// PreinstallVersionOverrideValues sets the OverrideValues property of PreinstallVersionOptions. Validation
+// override values.
+func PreinstallVersionOverrideValues(overrideValues *DeployRequestBodyOverrideValues) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.OverrideValues = overrideValues
+	}
+}
+
+// PreinstallVersionEnvironmentVariables sets the EnvironmentVariables property of PreinstallVersionOptions.
+// Schematics environment variables to use with this workspace.
+func PreinstallVersionEnvironmentVariables(environmentVariables ...DeployRequestBodyEnvironmentVariablesItem) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.EnvironmentVariables = append(options.EnvironmentVariables, environmentVariables...)
+	}
+}
+
+// PreinstallVersionEntitlementApikey sets the EntitlementApikey property of PreinstallVersionOptions. Entitlement
+// API Key for this offering.
+func PreinstallVersionEntitlementApikey(entitlementApikey string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.EntitlementApikey = core.StringPtr(entitlementApikey)
+	}
+}
+
+// PreinstallVersionSchematics sets the Schematics property of PreinstallVersionOptions. Schematics workspace
+// configuration.
+func PreinstallVersionSchematics(schematics *DeployRequestBodySchematics) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.Schematics = schematics
+	}
+}
+
+// PreinstallVersionScript sets the Script property of PreinstallVersionOptions. Script.
+func PreinstallVersionScript(script string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.Script = core.StringPtr(script)
+	}
+}
+
+// PreinstallVersionScriptID sets the ScriptID property of PreinstallVersionOptions. Script ID.
+func PreinstallVersionScriptID(scriptID string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.ScriptID = core.StringPtr(scriptID)
+	}
+}
+
+// PreinstallVersionVersionLocatorID sets the VersionLocatorID property of PreinstallVersionOptions. A dotted value
+// of `catalogID`.`versionID`.
+func PreinstallVersionVersionLocatorID(versionLocatorID string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.VersionLocatorID = core.StringPtr(versionLocatorID)
+	}
+}
+
+// PreinstallVersionVcenterID sets the VcenterID property of PreinstallVersionOptions. VCenter ID.
+func PreinstallVersionVcenterID(vcenterID string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.VcenterID = core.StringPtr(vcenterID)
+	}
+}
+
+// PreinstallVersionVcenterLocation sets the VcenterLocation property of PreinstallVersionOptions. VCenter
+// Location.
+func PreinstallVersionVcenterLocation(vcenterLocation string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.VcenterLocation = core.StringPtr(vcenterLocation)
+	}
+}
+
+// PreinstallVersionVcenterUser sets the VcenterUser property of PreinstallVersionOptions. VCenter User.
+func PreinstallVersionVcenterUser(vcenterUser string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.VcenterUser = core.StringPtr(vcenterUser)
+	}
+}
+
+// PreinstallVersionVcenterPassword sets the VcenterPassword property of PreinstallVersionOptions. VCenter
+// Password.
+func PreinstallVersionVcenterPassword(vcenterPassword string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.VcenterPassword = core.StringPtr(vcenterPassword)
+	}
+}
+
+// PreinstallVersionVcenterDatastore sets the VcenterDatastore property of PreinstallVersionOptions. VCenter
+// Datastore.
+func PreinstallVersionVcenterDatastore(vcenterDatastore string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		options.VcenterDatastore = core.StringPtr(vcenterDatastore)
+	}
+}
+
+// PreinstallVersionHeader sets the Headers property of PreinstallVersionOptions. Allows users to set headers on
+// API requests.
+func PreinstallVersionHeader(name string, value string) PreinstallVersionOption {
+	return func(options *PreinstallVersionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewPreinstallVersionOptionsWith returns PreinstallVersionOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewPreinstallVersionOptionsWith(options ...PreinstallVersionOption) *PreinstallVersionOptions {
+	preinstallVersionOptions := &PreinstallVersionOptions{}
+	for _, option := range options {
+		option(preinstallVersionOptions)
+	}
+	return preinstallVersionOptions
+}
+
+// PreinstallVersionWith is an alternate form of the PreinstallVersionWithContext method which takes functional
+// options instead of a PreinstallVersionOptions struct. PreinstallVersionVersionLocID and
+// PreinstallVersionXAuthRefreshToken are required.
+func (catalogManagement *CatalogManagementV1) PreinstallVersionWith(ctx context.Context, options ...PreinstallVersionOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.PreinstallVersionWithContext(ctx, catalogManagement.NewPreinstallVersionOptionsWith(options...))
+}
+
+// GetPreinstallOption : A functional option that sets a property of GetPreinstallOptions (see GetPreinstallWith).
+type GetPreinstallOption func(options *GetPreinstallOptions)
+
+// GetPreinstallVersionLocID sets the VersionLocID property of GetPreinstallOptions. A dotted value of
+// `catalogID`.`versionID`.
+func GetPreinstallVersionLocID(versionLocID string) GetPreinstallOption {
+	return func(options *GetPreinstallOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// GetPreinstallXAuthRefreshToken sets the XAuthRefreshToken property of GetPreinstallOptions. IAM Refresh token.
+func GetPreinstallXAuthRefreshToken(xAuthRefreshToken string) GetPreinstallOption {
+	return func(options *GetPreinstallOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// GetPreinstallClusterID sets the ClusterID property of GetPreinstallOptions. ID of the cluster.
+func GetPreinstallClusterID(clusterID string) GetPreinstallOption {
+	return func(options *GetPreinstallOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// GetPreinstallRegion sets the Region property of GetPreinstallOptions. Cluster region.
+func GetPreinstallRegion(region string) GetPreinstallOption {
+	return func(options *GetPreinstallOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// GetPreinstallNamespace sets the Namespace property of GetPreinstallOptions. Required if the version's
+// pre-install scope is `namespace`.
+func GetPreinstallNamespace(namespace string) GetPreinstallOption {
+	return func(options *GetPreinstallOptions) {
+		options.Namespace = core.StringPtr(namespace)
+	}
+}
+
+// GetPreinstallHeader sets the Headers property of GetPreinstallOptions. Allows users to set headers on API
+// requests.
+func GetPreinstallHeader(name string, value string) GetPreinstallOption {
+	return func(options *GetPreinstallOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetPreinstallOptionsWith returns GetPreinstallOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetPreinstallOptionsWith(options ...GetPreinstallOption) *GetPreinstallOptions {
+	getPreinstallOptions := &GetPreinstallOptions{}
+	for _, option := range options {
+		option(getPreinstallOptions)
+	}
+	return getPreinstallOptions
+}
+
+// GetPreinstallWith is an alternate form of the GetPreinstallWithContext method which takes functional options
+// instead of a GetPreinstallOptions struct. GetPreinstallVersionLocID and GetPreinstallXAuthRefreshToken are
+// required.
+func (catalogManagement *CatalogManagementV1) GetPreinstallWith(ctx context.Context, options ...GetPreinstallOption) (result *InstallStatus, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetPreinstallWithContext(ctx, catalogManagement.NewGetPreinstallOptionsWith(options...))
+}
+
+// ValidateInstallOption : A functional option that sets a property of ValidateInstallOptions (see ValidateInstallWith).
+type ValidateInstallOption func(options *ValidateInstallOptions)
+
+// ValidateInstallVersionLocID sets the VersionLocID property of ValidateInstallOptions. A dotted value of
+// `catalogID`.`versionID`.
+func ValidateInstallVersionLocID(versionLocID string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// ValidateInstallXAuthRefreshToken sets the XAuthRefreshToken property of ValidateInstallOptions. IAM Refresh
+// token.
+func ValidateInstallXAuthRefreshToken(xAuthRefreshToken string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// ValidateInstallClusterID sets the ClusterID property of ValidateInstallOptions. Cluster ID.
+func ValidateInstallClusterID(clusterID string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// ValidateInstallRegion sets the Region property of ValidateInstallOptions. Cluster region.
+func ValidateInstallRegion(region string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// ValidateInstallNamespace sets the Namespace property of ValidateInstallOptions. Kube namespace.
+func ValidateInstallNamespace(namespace string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.Namespace = core.StringPtr(namespace)
+	}
+}
+
+// ValidateInstallOverrideValues sets the OverrideValues property of ValidateInstallOptions. Validation override
+// values.
+func ValidateInstallOverrideValues(overrideValues *DeployRequestBodyOverrideValues) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.OverrideValues = overrideValues
+	}
+}
+
+// ValidateInstallEnvironmentVariables sets the EnvironmentVariables property of ValidateInstallOptions. Schematics
+// environment variables to use with this workspace.
+func ValidateInstallEnvironmentVariables(environmentVariables ...DeployRequestBodyEnvironmentVariablesItem) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.EnvironmentVariables = append(options.EnvironmentVariables, environmentVariables...)
+	}
+}
+
+// ValidateInstallEntitlementApikey sets the EntitlementApikey property of ValidateInstallOptions. Entitlement API
+// Key for this offering.
+func ValidateInstallEntitlementApikey(entitlementApikey string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.EntitlementApikey = core.StringPtr(entitlementApikey)
+	}
+}
+
+// ValidateInstallSchematics sets the Schematics property of ValidateInstallOptions. Schematics workspace
+// configuration.
+func ValidateInstallSchematics(schematics *DeployRequestBodySchematics) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.Schematics = schematics
+	}
+}
+
+// ValidateInstallScript sets the Script property of ValidateInstallOptions. Script.
+func ValidateInstallScript(script string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.Script = core.StringPtr(script)
+	}
+}
+
+// ValidateInstallScriptID sets the ScriptID property of ValidateInstallOptions. Script ID.
+func ValidateInstallScriptID(scriptID string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.ScriptID = core.StringPtr(scriptID)
+	}
+}
+
+// ValidateInstallVersionLocatorID sets the VersionLocatorID property of ValidateInstallOptions. A dotted value of
+// `catalogID`.`versionID`.
+func ValidateInstallVersionLocatorID(versionLocatorID string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.VersionLocatorID = core.StringPtr(versionLocatorID)
+	}
+}
+
+// ValidateInstallVcenterID sets the VcenterID property of ValidateInstallOptions. VCenter ID.
+func ValidateInstallVcenterID(vcenterID string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.VcenterID = core.StringPtr(vcenterID)
+	}
+}
+
+// ValidateInstallVcenterLocation sets the VcenterLocation property of ValidateInstallOptions. VCenter Location.
+func ValidateInstallVcenterLocation(vcenterLocation string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.VcenterLocation = core.StringPtr(vcenterLocation)
+	}
+}
+
+// ValidateInstallVcenterUser sets the VcenterUser property of ValidateInstallOptions. VCenter User.
+func ValidateInstallVcenterUser(vcenterUser string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.VcenterUser = core.StringPtr(vcenterUser)
+	}
+}
+
+// ValidateInstallVcenterPassword sets the VcenterPassword property of ValidateInstallOptions. VCenter Password.
+func ValidateInstallVcenterPassword(vcenterPassword string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.VcenterPassword = core.StringPtr(vcenterPassword)
+	}
+}
+
+// ValidateInstallVcenterDatastore sets the VcenterDatastore property of ValidateInstallOptions. VCenter Datastore.
+func ValidateInstallVcenterDatastore(vcenterDatastore string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		options.VcenterDatastore = core.StringPtr(vcenterDatastore)
+	}
+}
+
+// ValidateInstallHeader sets the Headers property of ValidateInstallOptions. Allows users to set headers on API
+// requests.
+func ValidateInstallHeader(name string, value string) ValidateInstallOption {
+	return func(options *ValidateInstallOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewValidateInstallOptionsWith returns ValidateInstallOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewValidateInstallOptionsWith(options ...ValidateInstallOption) *ValidateInstallOptions {
+	validateInstallOptions := &ValidateInstallOptions{}
+	for _, option := range options {
+		option(validateInstallOptions)
+	}
+	return validateInstallOptions
+}
+
+// ValidateInstallWith is an alternate form of the ValidateInstallWithContext method which takes functional options
+// instead of a ValidateInstallOptions struct. ValidateInstallVersionLocID and ValidateInstallXAuthRefreshToken are
+// required.
+func (catalogManagement *CatalogManagementV1) ValidateInstallWith(ctx context.Context, options ...ValidateInstallOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.ValidateInstallWithContext(ctx, catalogManagement.NewValidateInstallOptionsWith(options...))
+}
+
+// GetValidationStatusOption : A functional option that sets a property of GetValidationStatusOptions (see GetValidationStatusWith).
+type GetValidationStatusOption func(options *GetValidationStatusOptions)
+
+// GetValidationStatusVersionLocID sets the VersionLocID property of GetValidationStatusOptions. A dotted value of
+// `catalogID`.`versionID`.
+func GetValidationStatusVersionLocID(versionLocID string) GetValidationStatusOption {
+	return func(options *GetValidationStatusOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// GetValidationStatusXAuthRefreshToken sets the XAuthRefreshToken property of GetValidationStatusOptions. IAM
+// Refresh token.
+func GetValidationStatusXAuthRefreshToken(xAuthRefreshToken string) GetValidationStatusOption {
+	return func(options *GetValidationStatusOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// GetValidationStatusHeader sets the Headers property of GetValidationStatusOptions. Allows users to set headers
+// on API requests.
+func GetValidationStatusHeader(name string, value string) GetValidationStatusOption {
+	return func(options *GetValidationStatusOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetValidationStatusOptionsWith returns GetValidationStatusOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetValidationStatusOptionsWith(options ...GetValidationStatusOption) *GetValidationStatusOptions {
+	getValidationStatusOptions := &GetValidationStatusOptions{}
+	for _, option := range options {
+		option(getValidationStatusOptions)
+	}
+	return getValidationStatusOptions
+}
+
+// GetValidationStatusWith is an alternate form of the GetValidationStatusWithContext method which takes functional
+// options instead of a GetValidationStatusOptions struct. GetValidationStatusVersionLocID and
+// GetValidationStatusXAuthRefreshToken are required.
+func (catalogManagement *CatalogManagementV1) GetValidationStatusWith(ctx context.Context, options ...GetValidationStatusOption) (result *Validation, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetValidationStatusWithContext(ctx, catalogManagement.NewGetValidationStatusOptionsWith(options...))
+}
+
+// GetOverrideValuesOption : A functional option that sets a property of GetOverrideValuesOptions (see GetOverrideValuesWith).
+type GetOverrideValuesOption func(options *GetOverrideValuesOptions)
+
+// GetOverrideValuesVersionLocID sets the VersionLocID property of GetOverrideValuesOptions. A dotted value of
+// `catalogID`.`versionID`.
+func GetOverrideValuesVersionLocID(versionLocID string) GetOverrideValuesOption {
+	return func(options *GetOverrideValuesOptions) {
+		options.VersionLocID = core.StringPtr(versionLocID)
+	}
+}
+
+// GetOverrideValuesHeader sets the Headers property of GetOverrideValuesOptions. Allows users to set headers on
+// API requests.
+func GetOverrideValuesHeader(name string, value string) GetOverrideValuesOption {
+	return func(options *GetOverrideValuesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOverrideValuesOptionsWith returns GetOverrideValuesOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOverrideValuesOptionsWith(options ...GetOverrideValuesOption) *GetOverrideValuesOptions {
+	getOverrideValuesOptions := &GetOverrideValuesOptions{}
+	for _, option := range options {
+		option(getOverrideValuesOptions)
+	}
+	return getOverrideValuesOptions
+}
+
+// GetOverrideValuesWith is an alternate form of the GetOverrideValuesWithContext method which takes functional
+// options instead of a GetOverrideValuesOptions struct. GetOverrideValuesVersionLocID is required.
+func (catalogManagement *CatalogManagementV1) GetOverrideValuesWith(ctx context.Context, options ...GetOverrideValuesOption) (result map[string]interface{}, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOverrideValuesWithContext(ctx, catalogManagement.NewGetOverrideValuesOptionsWith(options...))
+}
+
+// SearchObjectsOption : A functional option that sets a property of SearchObjectsOptions (see SearchObjectsWith).
+type SearchObjectsOption func(options *SearchObjectsOptions)
+
+// SearchObjectsQuery sets the Query property of SearchObjectsOptions. Lucene query string.
+func SearchObjectsQuery(query string) SearchObjectsOption {
+	return func(options *SearchObjectsOptions) {
+		options.Query = core.StringPtr(query)
+	}
+}
+
+// SearchObjectsKind sets the Kind property of SearchObjectsOptions. The kind of the object.
+func SearchObjectsKind(kind string) SearchObjectsOption {
+	return func(options *SearchObjectsOptions) {
+		options.Kind = core.StringPtr(kind)
+	}
+}
+
+// SearchObjectsLimit sets the Limit property of SearchObjectsOptions. The maximum number of results to return.
+func SearchObjectsLimit(limit int64) SearchObjectsOption {
+	return func(options *SearchObjectsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// SearchObjectsOffset sets the Offset property of SearchObjectsOptions. The number of results to skip before
+// returning values.
+func SearchObjectsOffset(offset int64) SearchObjectsOption {
+	return func(options *SearchObjectsOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// SearchObjectsCollapse sets the Collapse property of SearchObjectsOptions. When true, hide private objects that
+// correspond to public or IBM published objects.
+func SearchObjectsCollapse(collapse bool) SearchObjectsOption {
+	return func(options *SearchObjectsOptions) {
+		options.Collapse = core.BoolPtr(collapse)
+	}
+}
+
+// SearchObjectsDigest sets the Digest property of SearchObjectsOptions. Display a digests of search results, has
+// default value of true.
+func SearchObjectsDigest(digest bool) SearchObjectsOption {
+	return func(options *SearchObjectsOptions) {
+		options.Digest = core.BoolPtr(digest)
+	}
+}
+
+// SearchObjectsHeader sets the Headers property of SearchObjectsOptions. Allows users to set headers on API
+// requests.
+func SearchObjectsHeader(name string, value string) SearchObjectsOption {
+	return func(options *SearchObjectsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewSearchObjectsOptionsWith returns SearchObjectsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewSearchObjectsOptionsWith(options ...SearchObjectsOption) *SearchObjectsOptions {
+	searchObjectsOptions := &SearchObjectsOptions{}
+	for _, option := range options {
+		option(searchObjectsOptions)
+	}
+	return searchObjectsOptions
+}
+
+// SearchObjectsWith is an alternate form of the SearchObjectsWithContext method which takes functional options
+// instead of a SearchObjectsOptions struct. SearchObjectsQuery is required.
+func (catalogManagement *CatalogManagementV1) SearchObjectsWith(ctx context.Context, options ...SearchObjectsOption) (result *ObjectSearchResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.SearchObjectsWithContext(ctx, catalogManagement.NewSearchObjectsOptionsWith(options...))
+}
+
+// ListObjectsOption : A functional option that sets a property of ListObjectsOptions (see ListObjectsWith).
+type ListObjectsOption func(options *ListObjectsOptions)
+
+// ListObjectsCatalogIdentifier sets the CatalogIdentifier property of ListObjectsOptions. Catalog identifier.
+func ListObjectsCatalogIdentifier(catalogIdentifier string) ListObjectsOption {
+	return func(options *ListObjectsOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ListObjectsLimit sets the Limit property of ListObjectsOptions. The number of results to return.
+func ListObjectsLimit(limit int64) ListObjectsOption {
+	return func(options *ListObjectsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListObjectsOffset sets the Offset property of ListObjectsOptions. The number of results to skip before returning
+// values.
+func ListObjectsOffset(offset int64) ListObjectsOption {
+	return func(options *ListObjectsOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// ListObjectsName sets the Name property of ListObjectsOptions. Only return results that contain the specified
+// string.
+func ListObjectsName(nameVar string) ListObjectsOption {
+	return func(options *ListObjectsOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListObjectsSort sets the Sort property of ListObjectsOptions. The field on which the output is sorted.
+func ListObjectsSort(sort string) ListObjectsOption {
+	return func(options *ListObjectsOptions) {
+		options.Sort = core.StringPtr(sort)
+	}
+}
+
+// ListObjectsHeader sets the Headers property of ListObjectsOptions. Allows users to set headers on API requests.
+func ListObjectsHeader(name string, value string) ListObjectsOption {
+	return func(options *ListObjectsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListObjectsOptionsWith returns ListObjectsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewListObjectsOptionsWith(options ...ListObjectsOption) *ListObjectsOptions {
+	listObjectsOptions := &ListObjectsOptions{}
+	for _, option := range options {
+		option(listObjectsOptions)
+	}
+	return listObjectsOptions
+}
+
+// ListObjectsWith is an alternate form of the ListObjectsWithContext method which takes functional options instead
+// of a ListObjectsOptions struct. ListObjectsCatalogIdentifier is required.
+func (catalogManagement *CatalogManagementV1) ListObjectsWith(ctx context.Context, options ...ListObjectsOption) (result *ObjectListResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.ListObjectsWithContext(ctx, catalogManagement.NewListObjectsOptionsWith(options...))
+}
+
+// CreateObjectOption : A functional option that sets a property of CreateObjectOptions (see CreateObjectWith).
+type CreateObjectOption func(options *CreateObjectOptions)
+
+// CreateObjectCatalogIdentifier sets the CatalogIdentifier property of CreateObjectOptions. Catalog identifier.
+func CreateObjectCatalogIdentifier(catalogIdentifier string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// CreateObjectID sets the ID property of CreateObjectOptions. unique id.
+func CreateObjectID(id string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// CreateObjectName sets the Name property of CreateObjectOptions. The programmatic name of this object.
+func CreateObjectName(nameVar string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateObjectRev sets the Rev property of CreateObjectOptions. Cloudant revision.
+func CreateObjectRev(rev string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.Rev = core.StringPtr(rev)
+	}
+}
+
+// CreateObjectCRN sets the CRN property of CreateObjectOptions. The crn for this specific object.
+func CreateObjectCRN(crn string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.CRN = core.StringPtr(crn)
+	}
+}
+
+// CreateObjectURL sets the URL property of CreateObjectOptions. The url for this specific object.
+func CreateObjectURL(url string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.URL = core.StringPtr(url)
+	}
+}
+
+// CreateObjectParentID sets the ParentID property of CreateObjectOptions. The parent for this specific object.
+func CreateObjectParentID(parentID string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.ParentID = core.StringPtr(parentID)
+	}
+}
+
+// CreateObjectLabelI18n sets the LabelI18n property of CreateObjectOptions. A map of translated strings, by
+// language code.
+func CreateObjectLabelI18n(labelI18n map[string]string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.LabelI18n = labelI18n
+	}
+}
+
+// CreateObjectLabel sets the Label property of CreateObjectOptions. Display name in the requested language.
+func CreateObjectLabel(label string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.Label = core.StringPtr(label)
+	}
+}
+
+// CreateObjectTags sets the Tags property of CreateObjectOptions. List of tags associated with this catalog.
+func CreateObjectTags(tags ...string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// CreateObjectCreated sets the Created property of CreateObjectOptions. The date and time this catalog was
+// created.
+func CreateObjectCreated(created *strfmt.DateTime) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.Created = created
+	}
+}
+
+// CreateObjectUpdated sets the Updated property of CreateObjectOptions. The date and time this catalog was last
+// updated.
+func CreateObjectUpdated(updated *strfmt.DateTime) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.Updated = updated
+	}
+}
+
+// CreateObjectShortDescription sets the ShortDescription property of CreateObjectOptions. Short description in the
+// requested language.
+func CreateObjectShortDescription(shortDescription string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.ShortDescription = core.StringPtr(shortDescription)
+	}
+}
+
+// CreateObjectShortDescriptionI18n sets the ShortDescriptionI18n property of CreateObjectOptions. A map of
+// translated strings, by language code.
+func CreateObjectShortDescriptionI18n(shortDescriptionI18n map[string]string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.ShortDescriptionI18n = shortDescriptionI18n
+	}
+}
+
+// CreateObjectKind sets the Kind property of CreateObjectOptions. Kind of object.
+func CreateObjectKind(kind string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.Kind = core.StringPtr(kind)
+	}
+}
+
+// CreateObjectPublish sets the Publish property of CreateObjectOptions. Publish information.
+func CreateObjectPublish(publish *PublishObject) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.Publish = publish
+	}
+}
+
+// CreateObjectState sets the State property of CreateObjectOptions. Offering state.
+func CreateObjectState(state *State) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.State = state
+	}
+}
+
+// CreateObjectCatalogID sets the CatalogID property of CreateObjectOptions. The id of the catalog containing this
+// offering.
+func CreateObjectCatalogID(catalogID string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.CatalogID = core.StringPtr(catalogID)
+	}
+}
+
+// CreateObjectCatalogName sets the CatalogName property of CreateObjectOptions. The name of the catalog.
+func CreateObjectCatalogName(catalogName string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.CatalogName = core.StringPtr(catalogName)
+	}
+}
+
+// CreateObjectData sets the Data property of CreateObjectOptions. Map of data values for this object.
+func CreateObjectData(data map[string]interface{}) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		options.Data = data
+	}
+}
+
+// CreateObjectHeader sets the Headers property of CreateObjectOptions. Allows users to set headers on API
+// requests.
+func CreateObjectHeader(name string, value string) CreateObjectOption {
+	return func(options *CreateObjectOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateObjectOptionsWith returns CreateObjectOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewCreateObjectOptionsWith(options ...CreateObjectOption) *CreateObjectOptions {
+	createObjectOptions := &CreateObjectOptions{}
+	for _, option := range options {
+		option(createObjectOptions)
+	}
+	return createObjectOptions
+}
+
+// CreateObjectWith is an alternate form of the CreateObjectWithContext method which takes functional options
+// instead of a CreateObjectOptions struct. CreateObjectCatalogIdentifier is required.
+func (catalogManagement *CatalogManagementV1) CreateObjectWith(ctx context.Context, options ...CreateObjectOption) (result *CatalogObject, response *core.DetailedResponse, err error) {
+	return catalogManagement.CreateObjectWithContext(ctx, catalogManagement.NewCreateObjectOptionsWith(options...))
+}
+
+// GetObjectOption : A functional option that sets a property of GetObjectOptions (see GetObjectWith).
+type GetObjectOption func(options *GetObjectOptions)
+
+// GetObjectCatalogIdentifier sets the CatalogIdentifier property of GetObjectOptions. Catalog identifier.
+func GetObjectCatalogIdentifier(catalogIdentifier string) GetObjectOption {
+	return func(options *GetObjectOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetObjectObjectIdentifier sets the ObjectIdentifier property of GetObjectOptions. Object identifier.
+func GetObjectObjectIdentifier(objectIdentifier string) GetObjectOption {
+	return func(options *GetObjectOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// GetObjectHeader sets the Headers property of GetObjectOptions. Allows users to set headers on API requests.
+func GetObjectHeader(name string, value string) GetObjectOption {
+	return func(options *GetObjectOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetObjectOptionsWith returns GetObjectOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetObjectOptionsWith(options ...GetObjectOption) *GetObjectOptions {
+	getObjectOptions := &GetObjectOptions{}
+	for _, option := range options {
+		option(getObjectOptions)
+	}
+	return getObjectOptions
+}
+
+// GetObjectWith is an alternate form of the GetObjectWithContext method which takes functional options instead of
+// a GetObjectOptions struct. GetObjectCatalogIdentifier and GetObjectObjectIdentifier are required.
+func (catalogManagement *CatalogManagementV1) GetObjectWith(ctx context.Context, options ...GetObjectOption) (result *CatalogObject, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetObjectWithContext(ctx, catalogManagement.NewGetObjectOptionsWith(options...))
+}
+
+// ReplaceObjectOption : A functional option that sets a property of ReplaceObjectOptions (see ReplaceObjectWith).
+type ReplaceObjectOption func(options *ReplaceObjectOptions)
+
+// ReplaceObjectCatalogIdentifier sets the CatalogIdentifier property of ReplaceObjectOptions. Catalog identifier.
+func ReplaceObjectCatalogIdentifier(catalogIdentifier string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ReplaceObjectObjectIdentifier sets the ObjectIdentifier property of ReplaceObjectOptions. Object identifier.
+func ReplaceObjectObjectIdentifier(objectIdentifier string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// ReplaceObjectID sets the ID property of ReplaceObjectOptions. unique id.
+func ReplaceObjectID(id string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// ReplaceObjectName sets the Name property of ReplaceObjectOptions. The programmatic name of this object.
+func ReplaceObjectName(nameVar string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ReplaceObjectRev sets the Rev property of ReplaceObjectOptions. Cloudant revision.
+func ReplaceObjectRev(rev string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.Rev = core.StringPtr(rev)
+	}
+}
+
+// ReplaceObjectCRN sets the CRN property of ReplaceObjectOptions. The crn for this specific object.
+func ReplaceObjectCRN(crn string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.CRN = core.StringPtr(crn)
+	}
+}
+
+// ReplaceObjectURL sets the URL property of ReplaceObjectOptions. The url for this specific object.
+func ReplaceObjectURL(url string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.URL = core.StringPtr(url)
+	}
+}
+
+// ReplaceObjectParentID sets the ParentID property of ReplaceObjectOptions. The parent for this specific object.
+func ReplaceObjectParentID(parentID string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.ParentID = core.StringPtr(parentID)
+	}
+}
+
+// ReplaceObjectLabelI18n sets the LabelI18n property of ReplaceObjectOptions. A map of translated strings, by
+// language code.
+func ReplaceObjectLabelI18n(labelI18n map[string]string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.LabelI18n = labelI18n
+	}
+}
+
+// ReplaceObjectLabel sets the Label property of ReplaceObjectOptions. Display name in the requested language.
+func ReplaceObjectLabel(label string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.Label = core.StringPtr(label)
+	}
+}
+
+// ReplaceObjectTags sets the Tags property of ReplaceObjectOptions. List of tags associated with this catalog.
+func ReplaceObjectTags(tags ...string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// ReplaceObjectCreated sets the Created property of ReplaceObjectOptions. The date and time this catalog was
+// created.
+func ReplaceObjectCreated(created *strfmt.DateTime) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.Created = created
+	}
+}
+
+// ReplaceObjectUpdated sets the Updated property of ReplaceObjectOptions. The date and time this catalog was last
+// updated.
+func ReplaceObjectUpdated(updated *strfmt.DateTime) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.Updated = updated
+	}
+}
+
+// ReplaceObjectShortDescription sets the ShortDescription property of ReplaceObjectOptions. Short description in
+// the requested language.
+func ReplaceObjectShortDescription(shortDescription string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.ShortDescription = core.StringPtr(shortDescription)
+	}
+}
+
+// ReplaceObjectShortDescriptionI18n sets the ShortDescriptionI18n property of ReplaceObjectOptions. A map of
+// translated strings, by language code.
+func ReplaceObjectShortDescriptionI18n(shortDescriptionI18n map[string]string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.ShortDescriptionI18n = shortDescriptionI18n
+	}
+}
+
+// ReplaceObjectKind sets the Kind property of ReplaceObjectOptions. Kind of object.
+func ReplaceObjectKind(kind string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.Kind = core.StringPtr(kind)
+	}
+}
+
+// ReplaceObjectPublish sets the Publish property of ReplaceObjectOptions. Publish information.
+func ReplaceObjectPublish(publish *PublishObject) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.Publish = publish
+	}
+}
+
+// ReplaceObjectState sets the State property of ReplaceObjectOptions. Offering state.
+func ReplaceObjectState(state *State) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.State = state
+	}
+}
+
+// ReplaceObjectCatalogID sets the CatalogID property of ReplaceObjectOptions. The id of the catalog containing
+// this offering.
+func ReplaceObjectCatalogID(catalogID string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.CatalogID = core.StringPtr(catalogID)
+	}
+}
+
+// ReplaceObjectCatalogName sets the CatalogName property of ReplaceObjectOptions. The name of the catalog.
+func ReplaceObjectCatalogName(catalogName string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.CatalogName = core.StringPtr(catalogName)
+	}
+}
+
+// ReplaceObjectData sets the Data property of ReplaceObjectOptions. Map of data values for this object.
+func ReplaceObjectData(data map[string]interface{}) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		options.Data = data
+	}
+}
+
+// ReplaceObjectHeader sets the Headers property of ReplaceObjectOptions. Allows users to set headers on API
+// requests.
+func ReplaceObjectHeader(name string, value string) ReplaceObjectOption {
+	return func(options *ReplaceObjectOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceObjectOptionsWith returns ReplaceObjectOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewReplaceObjectOptionsWith(options ...ReplaceObjectOption) *ReplaceObjectOptions {
+	replaceObjectOptions := &ReplaceObjectOptions{}
+	for _, option := range options {
+		option(replaceObjectOptions)
+	}
+	return replaceObjectOptions
+}
+
+// ReplaceObjectWith is an alternate form of the ReplaceObjectWithContext method which takes functional options
+// instead of a ReplaceObjectOptions struct. ReplaceObjectCatalogIdentifier and ReplaceObjectObjectIdentifier are
+// required.
+func (catalogManagement *CatalogManagementV1) ReplaceObjectWith(ctx context.Context, options ...ReplaceObjectOption) (result *CatalogObject, response *core.DetailedResponse, err error) {
+	return catalogManagement.ReplaceObjectWithContext(ctx, catalogManagement.NewReplaceObjectOptionsWith(options...))
+}
+
+// DeleteObjectOption : A functional option that sets a property of DeleteObjectOptions (see DeleteObjectWith).
+type DeleteObjectOption func(options *DeleteObjectOptions)
+
+// DeleteObjectCatalogIdentifier sets the CatalogIdentifier property of DeleteObjectOptions. Catalog identifier.
+func DeleteObjectCatalogIdentifier(catalogIdentifier string) DeleteObjectOption {
+	return func(options *DeleteObjectOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// DeleteObjectObjectIdentifier sets the ObjectIdentifier property of DeleteObjectOptions. Object identifier.
+func DeleteObjectObjectIdentifier(objectIdentifier string) DeleteObjectOption {
+	return func(options *DeleteObjectOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// DeleteObjectHeader sets the Headers property of DeleteObjectOptions. Allows users to set headers on API
+// requests.
+func DeleteObjectHeader(name string, value string) DeleteObjectOption {
+	return func(options *DeleteObjectOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteObjectOptionsWith returns DeleteObjectOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeleteObjectOptionsWith(options ...DeleteObjectOption) *DeleteObjectOptions {
+	deleteObjectOptions := &DeleteObjectOptions{}
+	for _, option := range options {
+		option(deleteObjectOptions)
+	}
+	return deleteObjectOptions
+}
+
+// DeleteObjectWith is an alternate form of the DeleteObjectWithContext method which takes functional options
+// instead of a DeleteObjectOptions struct. DeleteObjectCatalogIdentifier and DeleteObjectObjectIdentifier are
+// required.
+func (catalogManagement *CatalogManagementV1) DeleteObjectWith(ctx context.Context, options ...DeleteObjectOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.DeleteObjectWithContext(ctx, catalogManagement.NewDeleteObjectOptionsWith(options...))
+}
+
+// ListObjectAuditsOption : A functional option that sets a property of ListObjectAuditsOptions (see ListObjectAuditsWith).
+type ListObjectAuditsOption func(options *ListObjectAuditsOptions)
+
+// ListObjectAuditsCatalogIdentifier sets the CatalogIdentifier property of ListObjectAuditsOptions. Catalog
+// identifier.
+func ListObjectAuditsCatalogIdentifier(catalogIdentifier string) ListObjectAuditsOption {
+	return func(options *ListObjectAuditsOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ListObjectAuditsObjectIdentifier sets the ObjectIdentifier property of ListObjectAuditsOptions. Object
+// identifier.
+func ListObjectAuditsObjectIdentifier(objectIdentifier string) ListObjectAuditsOption {
+	return func(options *ListObjectAuditsOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// ListObjectAuditsStart sets the Start property of ListObjectAuditsOptions. Start token for a query.
+func ListObjectAuditsStart(start string) ListObjectAuditsOption {
+	return func(options *ListObjectAuditsOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListObjectAuditsLimit sets the Limit property of ListObjectAuditsOptions. number or results to return in the
+// query.
+func ListObjectAuditsLimit(limit int64) ListObjectAuditsOption {
+	return func(options *ListObjectAuditsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListObjectAuditsLookupnames sets the Lookupnames property of ListObjectAuditsOptions. Auditlog Lookup Names - by
+// default names are not returned in auditlog.
+func ListObjectAuditsLookupnames(lookupnames bool) ListObjectAuditsOption {
+	return func(options *ListObjectAuditsOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// ListObjectAuditsHeader sets the Headers property of ListObjectAuditsOptions. Allows users to set headers on API
+// requests.
+func ListObjectAuditsHeader(name string, value string) ListObjectAuditsOption {
+	return func(options *ListObjectAuditsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListObjectAuditsOptionsWith returns ListObjectAuditsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewListObjectAuditsOptionsWith(options ...ListObjectAuditsOption) *ListObjectAuditsOptions {
+	listObjectAuditsOptions := &ListObjectAuditsOptions{}
+	for _, option := range options {
+		option(listObjectAuditsOptions)
+	}
+	return listObjectAuditsOptions
+}
+
+// ListObjectAuditsWith is an alternate form of the ListObjectAuditsWithContext method which takes functional
+// options instead of a ListObjectAuditsOptions struct. ListObjectAuditsCatalogIdentifier and
+// ListObjectAuditsObjectIdentifier are required.
+func (catalogManagement *CatalogManagementV1) ListObjectAuditsWith(ctx context.Context, options ...ListObjectAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error) {
+	return catalogManagement.ListObjectAuditsWithContext(ctx, catalogManagement.NewListObjectAuditsOptionsWith(options...))
+}
+
+// GetObjectAuditOption : A functional option that sets a property of GetObjectAuditOptions (see GetObjectAuditWith).
+type GetObjectAuditOption func(options *GetObjectAuditOptions)
+
+// GetObjectAuditCatalogIdentifier sets the CatalogIdentifier property of GetObjectAuditOptions. Catalog
+// identifier.
+func GetObjectAuditCatalogIdentifier(catalogIdentifier string) GetObjectAuditOption {
+	return func(options *GetObjectAuditOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetObjectAuditObjectIdentifier sets the ObjectIdentifier property of GetObjectAuditOptions. Object identifier.
+func GetObjectAuditObjectIdentifier(objectIdentifier string) GetObjectAuditOption {
+	return func(options *GetObjectAuditOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// GetObjectAuditAuditlogIdentifier sets the AuditlogIdentifier property of GetObjectAuditOptions. Auditlog ID.
+func GetObjectAuditAuditlogIdentifier(auditlogIdentifier string) GetObjectAuditOption {
+	return func(options *GetObjectAuditOptions) {
+		options.AuditlogIdentifier = core.StringPtr(auditlogIdentifier)
+	}
+}
+
+// GetObjectAuditLookupnames sets the Lookupnames property of GetObjectAuditOptions. Auditlog Lookup Names - by
+// default names are not returned in auditlog.
+func GetObjectAuditLookupnames(lookupnames bool) GetObjectAuditOption {
+	return func(options *GetObjectAuditOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// GetObjectAuditHeader sets the Headers property of GetObjectAuditOptions. Allows users to set headers on API
+// requests.
+func GetObjectAuditHeader(name string, value string) GetObjectAuditOption {
+	return func(options *GetObjectAuditOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetObjectAuditOptionsWith returns GetObjectAuditOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetObjectAuditOptionsWith(options ...GetObjectAuditOption) *GetObjectAuditOptions {
+	getObjectAuditOptions := &GetObjectAuditOptions{}
+	for _, option := range options {
+		option(getObjectAuditOptions)
+	}
+	return getObjectAuditOptions
+}
+
+// GetObjectAuditWith is an alternate form of the GetObjectAuditWithContext method which takes functional options
+// instead of a GetObjectAuditOptions struct. GetObjectAuditCatalogIdentifier, GetObjectAuditObjectIdentifier and
+// GetObjectAuditAuditlogIdentifier are required.
+func (catalogManagement *CatalogManagementV1) GetObjectAuditWith(ctx context.Context, options ...GetObjectAuditOption) (result *AuditLog, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetObjectAuditWithContext(ctx, catalogManagement.NewGetObjectAuditOptionsWith(options...))
+}
+
+// ConsumableShareObjectOption : A functional option that sets a property of ConsumableShareObjectOptions (see ConsumableShareObjectWith).
+type ConsumableShareObjectOption func(options *ConsumableShareObjectOptions)
+
+// ConsumableShareObjectCatalogIdentifier sets the CatalogIdentifier property of ConsumableShareObjectOptions.
+// Catalog identifier.
+func ConsumableShareObjectCatalogIdentifier(catalogIdentifier string) ConsumableShareObjectOption {
+	return func(options *ConsumableShareObjectOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ConsumableShareObjectObjectIdentifier sets the ObjectIdentifier property of ConsumableShareObjectOptions. Object
+// identifier.
+func ConsumableShareObjectObjectIdentifier(objectIdentifier string) ConsumableShareObjectOption {
+	return func(options *ConsumableShareObjectOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// ConsumableShareObjectHeader sets the Headers property of ConsumableShareObjectOptions. Allows users to set
+// headers on API requests.
+func ConsumableShareObjectHeader(name string, value string) ConsumableShareObjectOption {
+	return func(options *ConsumableShareObjectOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewConsumableShareObjectOptionsWith returns ConsumableShareObjectOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewConsumableShareObjectOptionsWith(options ...ConsumableShareObjectOption) *ConsumableShareObjectOptions {
+	consumableShareObjectOptions := &ConsumableShareObjectOptions{}
+	for _, option := range options {
+		option(consumableShareObjectOptions)
+	}
+	return consumableShareObjectOptions
+}
+
+// ConsumableShareObjectWith is an alternate form of the ConsumableShareObjectWithContext method which takes
+// functional options instead of a ConsumableShareObjectOptions struct. ConsumableShareObjectCatalogIdentifier and
+// ConsumableShareObjectObjectIdentifier are required.
+func (catalogManagement *CatalogManagementV1) ConsumableShareObjectWith(ctx context.Context, options ...ConsumableShareObjectOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.ConsumableShareObjectWithContext(ctx, catalogManagement.NewConsumableShareObjectOptionsWith(options...))
+}
+
+// ShareObjectOption : A functional option that sets a property of ShareObjectOptions (see ShareObjectWith).
+type ShareObjectOption func(options *ShareObjectOptions)
+
+// ShareObjectCatalogIdentifier sets the CatalogIdentifier property of ShareObjectOptions. Catalog identifier.
+func ShareObjectCatalogIdentifier(catalogIdentifier string) ShareObjectOption {
+	return func(options *ShareObjectOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// ShareObjectObjectIdentifier sets the ObjectIdentifier property of ShareObjectOptions. Object identifier.
+func ShareObjectObjectIdentifier(objectIdentifier string) ShareObjectOption {
+	return func(options *ShareObjectOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// ShareObjectIBM sets the IBM property of ShareObjectOptions. Visible to IBM employees.
+func ShareObjectIBM(ibm bool) ShareObjectOption {
+	return func(options *ShareObjectOptions) {
+		options.IBM = core.BoolPtr(ibm)
+	}
+}
+
+// ShareObjectPublic sets the Public property of ShareObjectOptions. Visible to everyone in the public catalog.
+func ShareObjectPublic(public bool) ShareObjectOption {
+	return func(options *ShareObjectOptions) {
+		options.Public = core.BoolPtr(public)
+	}
+}
+
+// ShareObjectEnabled sets the Enabled property of ShareObjectOptions. Visible to access list.
+func ShareObjectEnabled(enabled bool) ShareObjectOption {
+	return func(options *ShareObjectOptions) {
+		options.Enabled = core.BoolPtr(enabled)
+	}
+}
+
+// ShareObjectHeader sets the Headers property of ShareObjectOptions. Allows users to set headers on API requests.
+func ShareObjectHeader(name string, value string) ShareObjectOption {
+	return func(options *ShareObjectOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewShareObjectOptionsWith returns ShareObjectOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewShareObjectOptionsWith(options ...ShareObjectOption) *ShareObjectOptions {
+	shareObjectOptions := &ShareObjectOptions{}
+	for _, option := range options {
+		option(shareObjectOptions)
+	}
+	return shareObjectOptions
+}
+
+// ShareObjectWith is an alternate form of the ShareObjectWithContext method which takes functional options instead
+// of a ShareObjectOptions struct. ShareObjectCatalogIdentifier and ShareObjectObjectIdentifier are required.
+func (catalogManagement *CatalogManagementV1) ShareObjectWith(ctx context.Context, options ...ShareObjectOption) (result *ShareSetting, response *core.DetailedResponse, err error) {
+	return catalogManagement.ShareObjectWithContext(ctx, catalogManagement.NewShareObjectOptionsWith(options...))
+}
+
+// GetObjectAccessListOption : A functional option that sets a property of GetObjectAccessListOptions (see GetObjectAccessListWith).
+type GetObjectAccessListOption func(options *GetObjectAccessListOptions)
+
+// GetObjectAccessListCatalogIdentifier sets the CatalogIdentifier property of GetObjectAccessListOptions. Catalog
+// identifier.
+func GetObjectAccessListCatalogIdentifier(catalogIdentifier string) GetObjectAccessListOption {
+	return func(options *GetObjectAccessListOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetObjectAccessListObjectIdentifier sets the ObjectIdentifier property of GetObjectAccessListOptions. Object
+// identifier.
+func GetObjectAccessListObjectIdentifier(objectIdentifier string) GetObjectAccessListOption {
+	return func(options *GetObjectAccessListOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// GetObjectAccessListStart sets the Start property of GetObjectAccessListOptions. Start token for a query.
+func GetObjectAccessListStart(start string) GetObjectAccessListOption {
+	return func(options *GetObjectAccessListOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// GetObjectAccessListLimit sets the Limit property of GetObjectAccessListOptions. number or results to return in
+// the query.
+func GetObjectAccessListLimit(limit int64) GetObjectAccessListOption {
+	return func(options *GetObjectAccessListOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// GetObjectAccessListHeader sets the Headers property of GetObjectAccessListOptions. Allows users to set headers
+// on API requests.
+func GetObjectAccessListHeader(name string, value string) GetObjectAccessListOption {
+	return func(options *GetObjectAccessListOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetObjectAccessListOptionsWith returns GetObjectAccessListOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetObjectAccessListOptionsWith(options ...GetObjectAccessListOption) *GetObjectAccessListOptions {
+	getObjectAccessListOptions := &GetObjectAccessListOptions{}
+	for _, option := range options {
+		option(getObjectAccessListOptions)
+	}
+	return getObjectAccessListOptions
+}
+
+// GetObjectAccessListWith is an alternate form of the GetObjectAccessListWithContext method which takes functional
+// options instead of a GetObjectAccessListOptions struct. GetObjectAccessListCatalogIdentifier and
+// GetObjectAccessListObjectIdentifier are required.
+func (catalogManagement *CatalogManagementV1) GetObjectAccessListWith(ctx context.Context, options ...GetObjectAccessListOption) (result *AccessListResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetObjectAccessListWithContext(ctx, catalogManagement.NewGetObjectAccessListOptionsWith(options...))
+}
+
+// GetObjectAccessOption : A functional option that sets a property of GetObjectAccessOptions (see GetObjectAccessWith).
+type GetObjectAccessOption func(options *GetObjectAccessOptions)
+
+// GetObjectAccessCatalogIdentifier sets the CatalogIdentifier property of GetObjectAccessOptions. Catalog
+// identifier.
+func GetObjectAccessCatalogIdentifier(catalogIdentifier string) GetObjectAccessOption {
+	return func(options *GetObjectAccessOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetObjectAccessObjectIdentifier sets the ObjectIdentifier property of GetObjectAccessOptions. Object identifier.
+func GetObjectAccessObjectIdentifier(objectIdentifier string) GetObjectAccessOption {
+	return func(options *GetObjectAccessOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// GetObjectAccessAccessIdentifier sets the AccessIdentifier property of GetObjectAccessOptions. Identifier for
+// access.
+func GetObjectAccessAccessIdentifier(accessIdentifier string) GetObjectAccessOption {
+	return func(options *GetObjectAccessOptions) {
+		options.AccessIdentifier = core.StringPtr(accessIdentifier)
+	}
+}
+
+// GetObjectAccessHeader sets the Headers property of GetObjectAccessOptions. Allows users to set headers on API
+// requests.
+func GetObjectAccessHeader(name string, value string) GetObjectAccessOption {
+	return func(options *GetObjectAccessOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetObjectAccessOptionsWith returns GetObjectAccessOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetObjectAccessOptionsWith(options ...GetObjectAccessOption) *GetObjectAccessOptions {
+	getObjectAccessOptions := &GetObjectAccessOptions{}
+	for _, option := range options {
+		option(getObjectAccessOptions)
+	}
+	return getObjectAccessOptions
+}
+
+// GetObjectAccessWith is an alternate form of the GetObjectAccessWithContext method which takes functional options
+// instead of a GetObjectAccessOptions struct. GetObjectAccessCatalogIdentifier, GetObjectAccessObjectIdentifier
+// and GetObjectAccessAccessIdentifier are required.
+func (catalogManagement *CatalogManagementV1) GetObjectAccessWith(ctx context.Context, options ...GetObjectAccessOption) (result *Access, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetObjectAccessWithContext(ctx, catalogManagement.NewGetObjectAccessOptionsWith(options...))
+}
+
+// CreateObjectAccessOption : A functional option that sets a property of CreateObjectAccessOptions (see CreateObjectAccessWith).
+type CreateObjectAccessOption func(options *CreateObjectAccessOptions)
+
+// CreateObjectAccessCatalogIdentifier sets the CatalogIdentifier property of CreateObjectAccessOptions. Catalog
+// identifier.
+func CreateObjectAccessCatalogIdentifier(catalogIdentifier string) CreateObjectAccessOption {
+	return func(options *CreateObjectAccessOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// CreateObjectAccessObjectIdentifier sets the ObjectIdentifier property of CreateObjectAccessOptions. Object
+// identifier.
+func CreateObjectAccessObjectIdentifier(objectIdentifier string) CreateObjectAccessOption {
+	return func(options *CreateObjectAccessOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// CreateObjectAccessAccessIdentifier sets the AccessIdentifier property of CreateObjectAccessOptions. Identifier
+// for access.
+func CreateObjectAccessAccessIdentifier(accessIdentifier string) CreateObjectAccessOption {
+	return func(options *CreateObjectAccessOptions) {
+		options.AccessIdentifier = core.StringPtr(accessIdentifier)
+	}
+}
+
+// CreateObjectAccessHeader sets the Headers property of CreateObjectAccessOptions. Allows users to set headers on
+// API requests.
+func CreateObjectAccessHeader(name string, value string) CreateObjectAccessOption {
+	return func(options *CreateObjectAccessOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateObjectAccessOptionsWith returns CreateObjectAccessOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewCreateObjectAccessOptionsWith(options ...CreateObjectAccessOption) *CreateObjectAccessOptions {
+	createObjectAccessOptions := &CreateObjectAccessOptions{}
+	for _, option := range options {
+		option(createObjectAccessOptions)
+	}
+	return createObjectAccessOptions
+}
+
+// CreateObjectAccessWith is an alternate form of the CreateObjectAccessWithContext method which takes functional
+// options instead of a CreateObjectAccessOptions struct. CreateObjectAccessCatalogIdentifier,
+// CreateObjectAccessObjectIdentifier and CreateObjectAccessAccessIdentifier are required.
+func (catalogManagement *CatalogManagementV1) CreateObjectAccessWith(ctx context.Context, options ...CreateObjectAccessOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.CreateObjectAccessWithContext(ctx, catalogManagement.NewCreateObjectAccessOptionsWith(options...))
+}
+
+// DeleteObjectAccessOption : A functional option that sets a property of DeleteObjectAccessOptions (see DeleteObjectAccessWith).
+type DeleteObjectAccessOption func(options *DeleteObjectAccessOptions)
+
+// DeleteObjectAccessCatalogIdentifier sets the CatalogIdentifier property of DeleteObjectAccessOptions. Catalog
+// identifier.
+func DeleteObjectAccessCatalogIdentifier(catalogIdentifier string) DeleteObjectAccessOption {
+	return func(options *DeleteObjectAccessOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// DeleteObjectAccessObjectIdentifier sets the ObjectIdentifier property of DeleteObjectAccessOptions. Object
+// identifier.
+func DeleteObjectAccessObjectIdentifier(objectIdentifier string) DeleteObjectAccessOption {
+	return func(options *DeleteObjectAccessOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// DeleteObjectAccessAccessIdentifier sets the AccessIdentifier property of DeleteObjectAccessOptions. Identifier
+// for access.
+func DeleteObjectAccessAccessIdentifier(accessIdentifier string) DeleteObjectAccessOption {
+	return func(options *DeleteObjectAccessOptions) {
+		options.AccessIdentifier = core.StringPtr(accessIdentifier)
+	}
+}
+
+// DeleteObjectAccessHeader sets the Headers property of DeleteObjectAccessOptions. Allows users to set headers on
+// API requests.
+func DeleteObjectAccessHeader(name string, value string) DeleteObjectAccessOption {
+	return func(options *DeleteObjectAccessOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteObjectAccessOptionsWith returns DeleteObjectAccessOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeleteObjectAccessOptionsWith(options ...DeleteObjectAccessOption) *DeleteObjectAccessOptions {
+	deleteObjectAccessOptions := &DeleteObjectAccessOptions{}
+	for _, option := range options {
+		option(deleteObjectAccessOptions)
+	}
+	return deleteObjectAccessOptions
+}
+
+// DeleteObjectAccessWith is an alternate form of the DeleteObjectAccessWithContext method which takes functional
+// options instead of a DeleteObjectAccessOptions struct. DeleteObjectAccessCatalogIdentifier,
+// DeleteObjectAccessObjectIdentifier and DeleteObjectAccessAccessIdentifier are required.
+func (catalogManagement *CatalogManagementV1) DeleteObjectAccessWith(ctx context.Context, options ...DeleteObjectAccessOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.DeleteObjectAccessWithContext(ctx, catalogManagement.NewDeleteObjectAccessOptionsWith(options...))
+}
+
+// GetObjectAccessListDeprecatedOption : A functional option that sets a property of GetObjectAccessListDeprecatedOptions (see GetObjectAccessListDeprecatedWith).
+type GetObjectAccessListDeprecatedOption func(options *GetObjectAccessListDeprecatedOptions)
+
+// GetObjectAccessListDeprecatedCatalogIdentifier sets the CatalogIdentifier property of
+// GetObjectAccessListDeprecatedOptions. Catalog identifier.
+func GetObjectAccessListDeprecatedCatalogIdentifier(catalogIdentifier string) GetObjectAccessListDeprecatedOption {
+	return func(options *GetObjectAccessListDeprecatedOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// GetObjectAccessListDeprecatedObjectIdentifier sets the ObjectIdentifier property of
+// GetObjectAccessListDeprecatedOptions. Object identifier.
+func GetObjectAccessListDeprecatedObjectIdentifier(objectIdentifier string) GetObjectAccessListDeprecatedOption {
+	return func(options *GetObjectAccessListDeprecatedOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// GetObjectAccessListDeprecatedLimit sets the Limit property of GetObjectAccessListDeprecatedOptions. The maximum
+// number of results to return.
+func GetObjectAccessListDeprecatedLimit(limit int64) GetObjectAccessListDeprecatedOption {
+	return func(options *GetObjectAccessListDeprecatedOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// GetObjectAccessListDeprecatedOffset sets the Offset property of GetObjectAccessListDeprecatedOptions. The number
+// of results to skip before returning values.
+func GetObjectAccessListDeprecatedOffset(offset int64) GetObjectAccessListDeprecatedOption {
+	return func(options *GetObjectAccessListDeprecatedOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// GetObjectAccessListDeprecatedHeader sets the Headers property of GetObjectAccessListDeprecatedOptions. Allows
+// users to set headers on API requests.
+func GetObjectAccessListDeprecatedHeader(name string, value string) GetObjectAccessListDeprecatedOption {
+	return func(options *GetObjectAccessListDeprecatedOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetObjectAccessListDeprecatedOptionsWith returns GetObjectAccessListDeprecatedOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetObjectAccessListDeprecatedOptionsWith(options ...GetObjectAccessListDeprecatedOption) *GetObjectAccessListDeprecatedOptions {
+	getObjectAccessListDeprecatedOptions := &GetObjectAccessListDeprecatedOptions{}
+	for _, option := range options {
+		option(getObjectAccessListDeprecatedOptions)
+	}
+	return getObjectAccessListDeprecatedOptions
+}
+
+// GetObjectAccessListDeprecatedWith is an alternate form of the GetObjectAccessListDeprecatedWithContext method
+// which takes functional options instead of a GetObjectAccessListDeprecatedOptions struct.
+// GetObjectAccessListDeprecatedCatalogIdentifier and GetObjectAccessListDeprecatedObjectIdentifier are required.
+func (catalogManagement *CatalogManagementV1) GetObjectAccessListDeprecatedWith(ctx context.Context, options ...GetObjectAccessListDeprecatedOption) (result *ObjectAccessListResult, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetObjectAccessListDeprecatedWithContext(ctx, catalogManagement.NewGetObjectAccessListDeprecatedOptionsWith(options...))
+}
+
+// DeleteObjectAccessListOption : A functional option that sets a property of DeleteObjectAccessListOptions (see DeleteObjectAccessListWith).
+type DeleteObjectAccessListOption func(options *DeleteObjectAccessListOptions)
+
+// DeleteObjectAccessListCatalogIdentifier sets the CatalogIdentifier property of DeleteObjectAccessListOptions.
+// Catalog identifier.
+func DeleteObjectAccessListCatalogIdentifier(catalogIdentifier string) DeleteObjectAccessListOption {
+	return func(options *DeleteObjectAccessListOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// DeleteObjectAccessListObjectIdentifier sets the ObjectIdentifier property of DeleteObjectAccessListOptions.
+// Object identifier.
+func DeleteObjectAccessListObjectIdentifier(objectIdentifier string) DeleteObjectAccessListOption {
+	return func(options *DeleteObjectAccessListOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// DeleteObjectAccessListAccesses sets the Accesses property of DeleteObjectAccessListOptions. A list of accesses
+// to delete.
+func DeleteObjectAccessListAccesses(accesses ...string) DeleteObjectAccessListOption {
+	return func(options *DeleteObjectAccessListOptions) {
+		options.Accesses = append(options.Accesses, accesses...)
+	}
+}
+
+// DeleteObjectAccessListHeader sets the Headers property of DeleteObjectAccessListOptions. Allows users to set
+// headers on API requests.
+func DeleteObjectAccessListHeader(name string, value string) DeleteObjectAccessListOption {
+	return func(options *DeleteObjectAccessListOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteObjectAccessListOptionsWith returns DeleteObjectAccessListOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeleteObjectAccessListOptionsWith(options ...DeleteObjectAccessListOption) *DeleteObjectAccessListOptions {
+	deleteObjectAccessListOptions := &DeleteObjectAccessListOptions{}
+	for _, option := range options {
+		option(deleteObjectAccessListOptions)
+	}
+	return deleteObjectAccessListOptions
+}
+
+// DeleteObjectAccessListWith is an alternate form of the DeleteObjectAccessListWithContext method which takes
+// functional options instead of a DeleteObjectAccessListOptions struct. DeleteObjectAccessListCatalogIdentifier,
+// DeleteObjectAccessListObjectIdentifier and DeleteObjectAccessListAccesses are required.
+func (catalogManagement *CatalogManagementV1) DeleteObjectAccessListWith(ctx context.Context, options ...DeleteObjectAccessListOption) (result *AccessListBulkResponse, response *core.DetailedResponse, err error) {
+	return catalogManagement.DeleteObjectAccessListWithContext(ctx, catalogManagement.NewDeleteObjectAccessListOptionsWith(options...))
+}
+
+// AddObjectAccessListOption : A functional option that sets a property of AddObjectAccessListOptions (see AddObjectAccessListWith).
+type AddObjectAccessListOption func(options *AddObjectAccessListOptions)
+
+// AddObjectAccessListCatalogIdentifier sets the CatalogIdentifier property of AddObjectAccessListOptions. Catalog
+// identifier.
+func AddObjectAccessListCatalogIdentifier(catalogIdentifier string) AddObjectAccessListOption {
+	return func(options *AddObjectAccessListOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// AddObjectAccessListObjectIdentifier sets the ObjectIdentifier property of AddObjectAccessListOptions. Object
+// identifier.
+func AddObjectAccessListObjectIdentifier(objectIdentifier string) AddObjectAccessListOption {
+	return func(options *AddObjectAccessListOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// AddObjectAccessListAccesses sets the Accesses property of AddObjectAccessListOptions. A list of accesses to add.
+func AddObjectAccessListAccesses(accesses ...string) AddObjectAccessListOption {
+	return func(options *AddObjectAccessListOptions) {
+		options.Accesses = append(options.Accesses, accesses...)
+	}
+}
+
+// AddObjectAccessListHeader sets the Headers property of AddObjectAccessListOptions. Allows users to set headers
+// on API requests.
+func AddObjectAccessListHeader(name string, value string) AddObjectAccessListOption {
+	return func(options *AddObjectAccessListOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewAddObjectAccessListOptionsWith returns AddObjectAccessListOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewAddObjectAccessListOptionsWith(options ...AddObjectAccessListOption) *AddObjectAccessListOptions {
+	addObjectAccessListOptions := &AddObjectAccessListOptions{}
+	for _, option := range options {
+		option(addObjectAccessListOptions)
+	}
+	return addObjectAccessListOptions
+}
+
+// AddObjectAccessListWith is an alternate form of the AddObjectAccessListWithContext method which takes functional
+// options instead of an AddObjectAccessListOptions struct. AddObjectAccessListCatalogIdentifier,
+// AddObjectAccessListObjectIdentifier and AddObjectAccessListAccesses are required.
+func (catalogManagement *CatalogManagementV1) AddObjectAccessListWith(ctx context.Context, options ...AddObjectAccessListOption) (result *AccessListBulkResponse, response *core.DetailedResponse, err error) {
+	return catalogManagement.AddObjectAccessListWithContext(ctx, catalogManagement.NewAddObjectAccessListOptionsWith(options...))
+}
+
+// AccountPublishObjectOption : A functional option that sets a property of AccountPublishObjectOptions (see AccountPublishObjectWith).
+type AccountPublishObjectOption func(options *AccountPublishObjectOptions)
+
+// AccountPublishObjectCatalogIdentifier sets the CatalogIdentifier property of AccountPublishObjectOptions.
+// Catalog identifier.
+func AccountPublishObjectCatalogIdentifier(catalogIdentifier string) AccountPublishObjectOption {
+	return func(options *AccountPublishObjectOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// AccountPublishObjectObjectIdentifier sets the ObjectIdentifier property of AccountPublishObjectOptions. Object
+// identifier.
+func AccountPublishObjectObjectIdentifier(objectIdentifier string) AccountPublishObjectOption {
+	return func(options *AccountPublishObjectOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// AccountPublishObjectHeader sets the Headers property of AccountPublishObjectOptions. Allows users to set headers
+// on API requests.
+func AccountPublishObjectHeader(name string, value string) AccountPublishObjectOption {
+	return func(options *AccountPublishObjectOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewAccountPublishObjectOptionsWith returns AccountPublishObjectOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewAccountPublishObjectOptionsWith(options ...AccountPublishObjectOption) *AccountPublishObjectOptions {
+	accountPublishObjectOptions := &AccountPublishObjectOptions{}
+	for _, option := range options {
+		option(accountPublishObjectOptions)
+	}
+	return accountPublishObjectOptions
+}
+
+// AccountPublishObjectWith is an alternate form of the AccountPublishObjectWithContext method which takes
+// functional options instead of an AccountPublishObjectOptions struct. AccountPublishObjectCatalogIdentifier and
+// AccountPublishObjectObjectIdentifier are required.
+func (catalogManagement *CatalogManagementV1) AccountPublishObjectWith(ctx context.Context, options ...AccountPublishObjectOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.AccountPublishObjectWithContext(ctx, catalogManagement.NewAccountPublishObjectOptionsWith(options...))
+}
+
+// SharedPublishObjectOption : A functional option that sets a property of SharedPublishObjectOptions (see SharedPublishObjectWith).
+type SharedPublishObjectOption func(options *SharedPublishObjectOptions)
+
+// SharedPublishObjectCatalogIdentifier sets the CatalogIdentifier property of SharedPublishObjectOptions. Catalog
+// identifier.
+func SharedPublishObjectCatalogIdentifier(catalogIdentifier string) SharedPublishObjectOption {
+	return func(options *SharedPublishObjectOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// SharedPublishObjectObjectIdentifier sets the ObjectIdentifier property of SharedPublishObjectOptions. Object
+// identifier.
+func SharedPublishObjectObjectIdentifier(objectIdentifier string) SharedPublishObjectOption {
+	return func(options *SharedPublishObjectOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// SharedPublishObjectHeader sets the Headers property of SharedPublishObjectOptions. Allows users to set headers
+// on API requests.
+func SharedPublishObjectHeader(name string, value string) SharedPublishObjectOption {
+	return func(options *SharedPublishObjectOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewSharedPublishObjectOptionsWith returns SharedPublishObjectOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewSharedPublishObjectOptionsWith(options ...SharedPublishObjectOption) *SharedPublishObjectOptions {
+	sharedPublishObjectOptions := &SharedPublishObjectOptions{}
+	for _, option := range options {
+		option(sharedPublishObjectOptions)
+	}
+	return sharedPublishObjectOptions
+}
+
+// SharedPublishObjectWith is an alternate form of the SharedPublishObjectWithContext method which takes functional
+// options instead of a SharedPublishObjectOptions struct. SharedPublishObjectCatalogIdentifier and
+// SharedPublishObjectObjectIdentifier are required.
+func (catalogManagement *CatalogManagementV1) SharedPublishObjectWith(ctx context.Context, options ...SharedPublishObjectOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.SharedPublishObjectWithContext(ctx, catalogManagement.NewSharedPublishObjectOptionsWith(options...))
+}
+
+// IBMPublishObjectOption : A functional option that sets a property of IBMPublishObjectOptions (see IBMPublishObjectWith).
+type IBMPublishObjectOption func(options *IBMPublishObjectOptions)
+
+// IBMPublishObjectCatalogIdentifier sets the CatalogIdentifier property of IBMPublishObjectOptions. Catalog
+// identifier.
+func IBMPublishObjectCatalogIdentifier(catalogIdentifier string) IBMPublishObjectOption {
+	return func(options *IBMPublishObjectOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// IBMPublishObjectObjectIdentifier sets the ObjectIdentifier property of IBMPublishObjectOptions. Object
+// identifier.
+func IBMPublishObjectObjectIdentifier(objectIdentifier string) IBMPublishObjectOption {
+	return func(options *IBMPublishObjectOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// IBMPublishObjectHeader sets the Headers property of IBMPublishObjectOptions. Allows users to set headers on API
+// requests.
+func IBMPublishObjectHeader(name string, value string) IBMPublishObjectOption {
+	return func(options *IBMPublishObjectOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewIBMPublishObjectOptionsWith returns IBMPublishObjectOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewIBMPublishObjectOptionsWith(options ...IBMPublishObjectOption) *IBMPublishObjectOptions {
+	ibmPublishObjectOptions := &IBMPublishObjectOptions{}
+	for _, option := range options {
+		option(ibmPublishObjectOptions)
+	}
+	return ibmPublishObjectOptions
+}
+
+// IBMPublishObjectWith is an alternate form of the IBMPublishObjectWithContext method which takes functional
+// options instead of an IBMPublishObjectOptions struct. IBMPublishObjectCatalogIdentifier and
+// IBMPublishObjectObjectIdentifier are required.
+func (catalogManagement *CatalogManagementV1) IBMPublishObjectWith(ctx context.Context, options ...IBMPublishObjectOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.IBMPublishObjectWithContext(ctx, catalogManagement.NewIBMPublishObjectOptionsWith(options...))
+}
+
+// PublicPublishObjectOption : A functional option that sets a property of PublicPublishObjectOptions (see PublicPublishObjectWith).
+type PublicPublishObjectOption func(options *PublicPublishObjectOptions)
+
+// PublicPublishObjectCatalogIdentifier sets the CatalogIdentifier property of PublicPublishObjectOptions. Catalog
+// identifier.
+func PublicPublishObjectCatalogIdentifier(catalogIdentifier string) PublicPublishObjectOption {
+	return func(options *PublicPublishObjectOptions) {
+		options.CatalogIdentifier = core.StringPtr(catalogIdentifier)
+	}
+}
+
+// PublicPublishObjectObjectIdentifier sets the ObjectIdentifier property of PublicPublishObjectOptions. Object
+// identifier.
+func PublicPublishObjectObjectIdentifier(objectIdentifier string) PublicPublishObjectOption {
+	return func(options *PublicPublishObjectOptions) {
+		options.ObjectIdentifier = core.StringPtr(objectIdentifier)
+	}
+}
+
+// PublicPublishObjectHeader sets the Headers property of PublicPublishObjectOptions. Allows users to set headers
+// on API requests.
+func PublicPublishObjectHeader(name string, value string) PublicPublishObjectOption {
+	return func(options *PublicPublishObjectOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewPublicPublishObjectOptionsWith returns PublicPublishObjectOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewPublicPublishObjectOptionsWith(options ...PublicPublishObjectOption) *PublicPublishObjectOptions {
+	publicPublishObjectOptions := &PublicPublishObjectOptions{}
+	for _, option := range options {
+		option(publicPublishObjectOptions)
+	}
+	return publicPublishObjectOptions
+}
+
+// PublicPublishObjectWith is an alternate form of the PublicPublishObjectWithContext method which takes functional
+// options instead of a PublicPublishObjectOptions struct. PublicPublishObjectCatalogIdentifier and
+// PublicPublishObjectObjectIdentifier are required.
+func (catalogManagement *CatalogManagementV1) PublicPublishObjectWith(ctx context.Context, options ...PublicPublishObjectOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.PublicPublishObjectWithContext(ctx, catalogManagement.NewPublicPublishObjectOptionsWith(options...))
+}
+
+// CreateOfferingInstanceOption : A functional option that sets a property of CreateOfferingInstanceOptions (see CreateOfferingInstanceWith).
+type CreateOfferingInstanceOption func(options *CreateOfferingInstanceOptions)
+
+// CreateOfferingInstanceXAuthRefreshToken sets the XAuthRefreshToken property of CreateOfferingInstanceOptions.
+// IAM Refresh token.
+func CreateOfferingInstanceXAuthRefreshToken(xAuthRefreshToken string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// CreateOfferingInstanceID sets the ID property of CreateOfferingInstanceOptions. provisioned instance ID (part of
+// the CRN).
+func CreateOfferingInstanceID(id string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// CreateOfferingInstanceRev sets the Rev property of CreateOfferingInstanceOptions. Cloudant revision.
+func CreateOfferingInstanceRev(rev string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.Rev = core.StringPtr(rev)
+	}
+}
+
+// CreateOfferingInstanceURL sets the URL property of CreateOfferingInstanceOptions. url reference to this object.
+func CreateOfferingInstanceURL(url string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.URL = core.StringPtr(url)
+	}
+}
+
+// CreateOfferingInstanceCRN sets the CRN property of CreateOfferingInstanceOptions. platform CRN for this
+// instance.
+func CreateOfferingInstanceCRN(crn string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.CRN = core.StringPtr(crn)
+	}
+}
+
+// CreateOfferingInstanceLabel sets the Label property of CreateOfferingInstanceOptions. the label for this
+// instance.
+func CreateOfferingInstanceLabel(label string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.Label = core.StringPtr(label)
+	}
+}
+
+// CreateOfferingInstanceCatalogID sets the CatalogID property of CreateOfferingInstanceOptions. Catalog ID this
+// instance was created from.
+func CreateOfferingInstanceCatalogID(catalogID string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.CatalogID = core.StringPtr(catalogID)
+	}
+}
+
+// CreateOfferingInstanceOfferingID sets the OfferingID property of CreateOfferingInstanceOptions. Offering ID this
+// instance was created from.
+func CreateOfferingInstanceOfferingID(offeringID string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// CreateOfferingInstanceKindFormat sets the KindFormat property of CreateOfferingInstanceOptions. the format this
+// instance has (helm, operator, ova...).
+func CreateOfferingInstanceKindFormat(kindFormat string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.KindFormat = core.StringPtr(kindFormat)
+	}
+}
+
+// CreateOfferingInstanceVersion sets the Version property of CreateOfferingInstanceOptions. The version this
+// instance was installed from (semver - not version id).
+func CreateOfferingInstanceVersion(version string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.Version = core.StringPtr(version)
+	}
+}
+
+// CreateOfferingInstanceVersionID sets the VersionID property of CreateOfferingInstanceOptions. The version id
+// this instance was installed from (version id - not semver).
+func CreateOfferingInstanceVersionID(versionID string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.VersionID = core.StringPtr(versionID)
+	}
+}
+
+// CreateOfferingInstanceClusterID sets the ClusterID property of CreateOfferingInstanceOptions. Cluster ID.
+func CreateOfferingInstanceClusterID(clusterID string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// CreateOfferingInstanceClusterRegion sets the ClusterRegion property of CreateOfferingInstanceOptions. Cluster
+// region (e.g., us-south).
+func CreateOfferingInstanceClusterRegion(clusterRegion string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.ClusterRegion = core.StringPtr(clusterRegion)
+	}
+}
+
+// CreateOfferingInstanceClusterNamespaces sets the ClusterNamespaces property of CreateOfferingInstanceOptions.
+// List of target namespaces to install into.
+func CreateOfferingInstanceClusterNamespaces(clusterNamespaces ...string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.ClusterNamespaces = append(options.ClusterNamespaces, clusterNamespaces...)
+	}
+}
+
+// CreateOfferingInstanceClusterAllNamespaces sets the ClusterAllNamespaces property of
+// CreateOfferingInstanceOptions. designate to install into all namespaces.
+func CreateOfferingInstanceClusterAllNamespaces(clusterAllNamespaces bool) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.ClusterAllNamespaces = core.BoolPtr(clusterAllNamespaces)
+	}
+}
+
+// CreateOfferingInstanceSchematicsWorkspaceID sets the SchematicsWorkspaceID property of
+// CreateOfferingInstanceOptions. Id of the schematics workspace, for offering instances provisioned through
+// schematics.
+func CreateOfferingInstanceSchematicsWorkspaceID(schematicsWorkspaceID string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.SchematicsWorkspaceID = core.StringPtr(schematicsWorkspaceID)
+	}
+}
+
+// CreateOfferingInstanceInstallPlan sets the InstallPlan property of CreateOfferingInstanceOptions. Type of
+// install plan (also known as approval strategy) for operator subscriptions.
+func CreateOfferingInstanceInstallPlan(installPlan string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.InstallPlan = core.StringPtr(installPlan)
+	}
+}
+
+// CreateOfferingInstanceChannel sets the Channel property of CreateOfferingInstanceOptions. Channel to pin the
+// operator subscription to.
+func CreateOfferingInstanceChannel(channel string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.Channel = core.StringPtr(channel)
+	}
+}
+
+// CreateOfferingInstanceCreated sets the Created property of CreateOfferingInstanceOptions. date and time create.
+func CreateOfferingInstanceCreated(created *strfmt.DateTime) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.Created = created
+	}
+}
+
+// CreateOfferingInstanceUpdated sets the Updated property of CreateOfferingInstanceOptions. date and time updated.
+func CreateOfferingInstanceUpdated(updated *strfmt.DateTime) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.Updated = updated
+	}
+}
+
+// CreateOfferingInstanceMetadata sets the Metadata property of CreateOfferingInstanceOptions. Map of metadata
+// values for this offering instance.
+func CreateOfferingInstanceMetadata(metadata map[string]interface{}) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.Metadata = metadata
+	}
+}
+
+// CreateOfferingInstanceResourceGroupID sets the ResourceGroupID property of CreateOfferingInstanceOptions. Id of
+// the resource group to provision the offering instance into.
+func CreateOfferingInstanceResourceGroupID(resourceGroupID string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// CreateOfferingInstanceLocation sets the Location property of CreateOfferingInstanceOptions. String location of
+// OfferingInstance deployment.
+func CreateOfferingInstanceLocation(location string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.Location = core.StringPtr(location)
+	}
+}
+
+// CreateOfferingInstanceDisabled sets the Disabled property of CreateOfferingInstanceOptions. Indicates if
+// Resource Controller has disabled this instance.
+func CreateOfferingInstanceDisabled(disabled bool) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.Disabled = core.BoolPtr(disabled)
+	}
+}
+
+// CreateOfferingInstanceAccount sets the Account property of CreateOfferingInstanceOptions. The account this
+// instance is owned by.
+func CreateOfferingInstanceAccount(account string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// CreateOfferingInstanceLastOperation sets the LastOperation property of CreateOfferingInstanceOptions. the last
+// operation performed and status.
+func CreateOfferingInstanceLastOperation(lastOperation *OfferingInstanceLastOperation) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.LastOperation = lastOperation
+	}
+}
+
+// CreateOfferingInstanceKindTarget sets the KindTarget property of CreateOfferingInstanceOptions. The target kind
+// for the installed software version.
+func CreateOfferingInstanceKindTarget(kindTarget string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.KindTarget = core.StringPtr(kindTarget)
+	}
+}
+
+// CreateOfferingInstanceSha sets the Sha property of CreateOfferingInstanceOptions. The digest value of the
+// installed software version.
+func CreateOfferingInstanceSha(sha string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		options.Sha = core.StringPtr(sha)
+	}
+}
+
+// CreateOfferingInstanceHeader sets the Headers property of CreateOfferingInstanceOptions. Allows users to set
+// headers on API requests.
+func CreateOfferingInstanceHeader(name string, value string) CreateOfferingInstanceOption {
+	return func(options *CreateOfferingInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateOfferingInstanceOptionsWith returns CreateOfferingInstanceOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewCreateOfferingInstanceOptionsWith(options ...CreateOfferingInstanceOption) *CreateOfferingInstanceOptions {
+	createOfferingInstanceOptions := &CreateOfferingInstanceOptions{}
+	for _, option := range options {
+		option(createOfferingInstanceOptions)
+	}
+	return createOfferingInstanceOptions
+}
+
+// CreateOfferingInstanceWith is an alternate form of the CreateOfferingInstanceWithContext method which takes
+// functional options instead of a CreateOfferingInstanceOptions struct. CreateOfferingInstanceXAuthRefreshToken is
+// required.
+func (catalogManagement *CatalogManagementV1) CreateOfferingInstanceWith(ctx context.Context, options ...CreateOfferingInstanceOption) (result *OfferingInstance, response *core.DetailedResponse, err error) {
+	return catalogManagement.CreateOfferingInstanceWithContext(ctx, catalogManagement.NewCreateOfferingInstanceOptionsWith(options...))
+}
+
+// GetOfferingInstanceOption : A functional option that sets a property of GetOfferingInstanceOptions (see GetOfferingInstanceWith).
+type GetOfferingInstanceOption func(options *GetOfferingInstanceOptions)
+
+// GetOfferingInstanceInstanceIdentifier sets the InstanceIdentifier property of GetOfferingInstanceOptions.
+// Version Instance identifier.
+func GetOfferingInstanceInstanceIdentifier(instanceIdentifier string) GetOfferingInstanceOption {
+	return func(options *GetOfferingInstanceOptions) {
+		options.InstanceIdentifier = core.StringPtr(instanceIdentifier)
+	}
+}
+
+// GetOfferingInstanceHeader sets the Headers property of GetOfferingInstanceOptions. Allows users to set headers
+// on API requests.
+func GetOfferingInstanceHeader(name string, value string) GetOfferingInstanceOption {
+	return func(options *GetOfferingInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingInstanceOptionsWith returns GetOfferingInstanceOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingInstanceOptionsWith(options ...GetOfferingInstanceOption) *GetOfferingInstanceOptions {
+	getOfferingInstanceOptions := &GetOfferingInstanceOptions{}
+	for _, option := range options {
+		option(getOfferingInstanceOptions)
+	}
+	return getOfferingInstanceOptions
+}
+
+// GetOfferingInstanceWith is an alternate form of the GetOfferingInstanceWithContext method which takes functional
+// options instead of a GetOfferingInstanceOptions struct. GetOfferingInstanceInstanceIdentifier is required.
+func (catalogManagement *CatalogManagementV1) GetOfferingInstanceWith(ctx context.Context, options ...GetOfferingInstanceOption) (result *OfferingInstance, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingInstanceWithContext(ctx, catalogManagement.NewGetOfferingInstanceOptionsWith(options...))
+}
+
+// PutOfferingInstanceOption : A functional option that sets a property of PutOfferingInstanceOptions (see PutOfferingInstanceWith).
+type PutOfferingInstanceOption func(options *PutOfferingInstanceOptions)
+
+// PutOfferingInstanceInstanceIdentifier sets the InstanceIdentifier property of PutOfferingInstanceOptions.
+// Version Instance identifier.
+func PutOfferingInstanceInstanceIdentifier(instanceIdentifier string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.InstanceIdentifier = core.StringPtr(instanceIdentifier)
+	}
+}
+
+// PutOfferingInstanceXAuthRefreshToken sets the XAuthRefreshToken property of PutOfferingInstanceOptions. IAM
+// Refresh token.
+func PutOfferingInstanceXAuthRefreshToken(xAuthRefreshToken string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// PutOfferingInstanceID sets the ID property of PutOfferingInstanceOptions. provisioned instance ID (part of the
+// CRN).
+func PutOfferingInstanceID(id string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// PutOfferingInstanceRev sets the Rev property of PutOfferingInstanceOptions. Cloudant revision.
+func PutOfferingInstanceRev(rev string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.Rev = core.StringPtr(rev)
+	}
+}
+
+// PutOfferingInstanceURL sets the URL property of PutOfferingInstanceOptions. url reference to this object.
+func PutOfferingInstanceURL(url string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.URL = core.StringPtr(url)
+	}
+}
+
+// PutOfferingInstanceCRN sets the CRN property of PutOfferingInstanceOptions. platform CRN for this instance.
+func PutOfferingInstanceCRN(crn string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.CRN = core.StringPtr(crn)
+	}
+}
+
+// PutOfferingInstanceLabel sets the Label property of PutOfferingInstanceOptions. the label for this instance.
+func PutOfferingInstanceLabel(label string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.Label = core.StringPtr(label)
+	}
+}
+
+// PutOfferingInstanceCatalogID sets the CatalogID property of PutOfferingInstanceOptions. Catalog ID this instance
+// was created from.
+func PutOfferingInstanceCatalogID(catalogID string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.CatalogID = core.StringPtr(catalogID)
+	}
+}
+
+// PutOfferingInstanceOfferingID sets the OfferingID property of PutOfferingInstanceOptions. Offering ID this
+// instance was created from.
+func PutOfferingInstanceOfferingID(offeringID string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.OfferingID = core.StringPtr(offeringID)
+	}
+}
+
+// PutOfferingInstanceKindFormat sets the KindFormat property of PutOfferingInstanceOptions. the format this
+// instance has (helm, operator, ova...).
+func PutOfferingInstanceKindFormat(kindFormat string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.KindFormat = core.StringPtr(kindFormat)
+	}
+}
+
+// PutOfferingInstanceVersion sets the Version property of PutOfferingInstanceOptions. The version this instance
+// was installed from (semver - not version id).
+func PutOfferingInstanceVersion(version string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.Version = core.StringPtr(version)
+	}
+}
+
+// PutOfferingInstanceVersionID sets the VersionID property of PutOfferingInstanceOptions. The version id this
+// instance was installed from (version id - not semver).
+func PutOfferingInstanceVersionID(versionID string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.VersionID = core.StringPtr(versionID)
+	}
+}
+
+// PutOfferingInstanceClusterID sets the ClusterID property of PutOfferingInstanceOptions. Cluster ID.
+func PutOfferingInstanceClusterID(clusterID string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.ClusterID = core.StringPtr(clusterID)
+	}
+}
+
+// PutOfferingInstanceClusterRegion sets the ClusterRegion property of PutOfferingInstanceOptions. Cluster region
+// (e.g., us-south).
+func PutOfferingInstanceClusterRegion(clusterRegion string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.ClusterRegion = core.StringPtr(clusterRegion)
+	}
+}
+
+// PutOfferingInstanceClusterNamespaces sets the ClusterNamespaces property of PutOfferingInstanceOptions. List of
+// target namespaces to install into.
+func PutOfferingInstanceClusterNamespaces(clusterNamespaces ...string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.ClusterNamespaces = append(options.ClusterNamespaces, clusterNamespaces...)
+	}
+}
+
+// PutOfferingInstanceClusterAllNamespaces sets the ClusterAllNamespaces property of PutOfferingInstanceOptions.
+// designate to install into all namespaces.
+func PutOfferingInstanceClusterAllNamespaces(clusterAllNamespaces bool) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.ClusterAllNamespaces = core.BoolPtr(clusterAllNamespaces)
+	}
+}
+
+// PutOfferingInstanceSchematicsWorkspaceID sets the SchematicsWorkspaceID property of PutOfferingInstanceOptions.
+// Id of the schematics workspace, for offering instances provisioned through schematics.
+func PutOfferingInstanceSchematicsWorkspaceID(schematicsWorkspaceID string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.SchematicsWorkspaceID = core.StringPtr(schematicsWorkspaceID)
+	}
+}
+
+// PutOfferingInstanceInstallPlan sets the InstallPlan property of PutOfferingInstanceOptions. Type of install plan
+// (also known as approval strategy) for operator subscriptions.
+func PutOfferingInstanceInstallPlan(installPlan string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.InstallPlan = core.StringPtr(installPlan)
+	}
+}
+
+// PutOfferingInstanceChannel sets the Channel property of PutOfferingInstanceOptions. Channel to pin the operator
+// subscription to.
+func PutOfferingInstanceChannel(channel string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.Channel = core.StringPtr(channel)
+	}
+}
+
+// PutOfferingInstanceCreated sets the Created property of PutOfferingInstanceOptions. date and time create.
+func PutOfferingInstanceCreated(created *strfmt.DateTime) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.Created = created
+	}
+}
+
+// PutOfferingInstanceUpdated sets the Updated property of PutOfferingInstanceOptions. date and time updated.
+func PutOfferingInstanceUpdated(updated *strfmt.DateTime) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.Updated = updated
+	}
+}
+
+// PutOfferingInstanceMetadata sets the Metadata property of PutOfferingInstanceOptions. Map of metadata values for
+// this offering instance.
+func PutOfferingInstanceMetadata(metadata map[string]interface{}) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.Metadata = metadata
+	}
+}
+
+// PutOfferingInstanceResourceGroupID sets the ResourceGroupID property of PutOfferingInstanceOptions. Id of the
+// resource group to provision the offering instance into.
+func PutOfferingInstanceResourceGroupID(resourceGroupID string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// PutOfferingInstanceLocation sets the Location property of PutOfferingInstanceOptions. String location of
+// OfferingInstance deployment.
+func PutOfferingInstanceLocation(location string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.Location = core.StringPtr(location)
+	}
+}
+
+// PutOfferingInstanceDisabled sets the Disabled property of PutOfferingInstanceOptions. Indicates if Resource
+// Controller has disabled this instance.
+func PutOfferingInstanceDisabled(disabled bool) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.Disabled = core.BoolPtr(disabled)
+	}
+}
+
+// PutOfferingInstanceAccount sets the Account property of PutOfferingInstanceOptions. The account this instance is
+// owned by.
+func PutOfferingInstanceAccount(account string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// PutOfferingInstanceLastOperation sets the LastOperation property of PutOfferingInstanceOptions. the last
+// operation performed and status.
+func PutOfferingInstanceLastOperation(lastOperation *OfferingInstanceLastOperation) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.LastOperation = lastOperation
+	}
+}
+
+// PutOfferingInstanceKindTarget sets the KindTarget property of PutOfferingInstanceOptions. The target kind for
+// the installed software version.
+func PutOfferingInstanceKindTarget(kindTarget string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.KindTarget = core.StringPtr(kindTarget)
+	}
+}
+
+// PutOfferingInstanceSha sets the Sha property of PutOfferingInstanceOptions. The digest value of the installed
+// software version.
+func PutOfferingInstanceSha(sha string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		options.Sha = core.StringPtr(sha)
+	}
+}
+
+// PutOfferingInstanceHeader sets the Headers property of PutOfferingInstanceOptions. Allows users to set headers
+// on API requests.
+func PutOfferingInstanceHeader(name string, value string) PutOfferingInstanceOption {
+	return func(options *PutOfferingInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewPutOfferingInstanceOptionsWith returns PutOfferingInstanceOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewPutOfferingInstanceOptionsWith(options ...PutOfferingInstanceOption) *PutOfferingInstanceOptions {
+	putOfferingInstanceOptions := &PutOfferingInstanceOptions{}
+	for _, option := range options {
+		option(putOfferingInstanceOptions)
+	}
+	return putOfferingInstanceOptions
+}
+
+// PutOfferingInstanceWith is an alternate form of the PutOfferingInstanceWithContext method which takes functional
+// options instead of a PutOfferingInstanceOptions struct. PutOfferingInstanceInstanceIdentifier and
+// PutOfferingInstanceXAuthRefreshToken are required.
+func (catalogManagement *CatalogManagementV1) PutOfferingInstanceWith(ctx context.Context, options ...PutOfferingInstanceOption) (result *OfferingInstance, response *core.DetailedResponse, err error) {
+	return catalogManagement.PutOfferingInstanceWithContext(ctx, catalogManagement.NewPutOfferingInstanceOptionsWith(options...))
+}
+
+// DeleteOfferingInstanceOption : A functional option that sets a property of DeleteOfferingInstanceOptions (see DeleteOfferingInstanceWith).
+type DeleteOfferingInstanceOption func(options *DeleteOfferingInstanceOptions)
+
+// DeleteOfferingInstanceInstanceIdentifier sets the InstanceIdentifier property of DeleteOfferingInstanceOptions.
+// Version Instance identifier.
+func DeleteOfferingInstanceInstanceIdentifier(instanceIdentifier string) DeleteOfferingInstanceOption {
+	return func(options *DeleteOfferingInstanceOptions) {
+		options.InstanceIdentifier = core.StringPtr(instanceIdentifier)
+	}
+}
+
+// DeleteOfferingInstanceXAuthRefreshToken sets the XAuthRefreshToken property of DeleteOfferingInstanceOptions.
+// IAM Refresh token.
+func DeleteOfferingInstanceXAuthRefreshToken(xAuthRefreshToken string) DeleteOfferingInstanceOption {
+	return func(options *DeleteOfferingInstanceOptions) {
+		options.XAuthRefreshToken = core.StringPtr(xAuthRefreshToken)
+	}
+}
+
+// DeleteOfferingInstanceHeader sets the Headers property of DeleteOfferingInstanceOptions. Allows users to set
+// headers on API requests.
+func DeleteOfferingInstanceHeader(name string, value string) DeleteOfferingInstanceOption {
+	return func(options *DeleteOfferingInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteOfferingInstanceOptionsWith returns DeleteOfferingInstanceOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewDeleteOfferingInstanceOptionsWith(options ...DeleteOfferingInstanceOption) *DeleteOfferingInstanceOptions {
+	deleteOfferingInstanceOptions := &DeleteOfferingInstanceOptions{}
+	for _, option := range options {
+		option(deleteOfferingInstanceOptions)
+	}
+	return deleteOfferingInstanceOptions
+}
+
+// DeleteOfferingInstanceWith is an alternate form of the DeleteOfferingInstanceWithContext method which takes
+// functional options instead of a DeleteOfferingInstanceOptions struct. DeleteOfferingInstanceInstanceIdentifier
+// and DeleteOfferingInstanceXAuthRefreshToken are required.
+func (catalogManagement *CatalogManagementV1) DeleteOfferingInstanceWith(ctx context.Context, options ...DeleteOfferingInstanceOption) (response *core.DetailedResponse, err error) {
+	return catalogManagement.DeleteOfferingInstanceWithContext(ctx, catalogManagement.NewDeleteOfferingInstanceOptionsWith(options...))
+}
+
+// ListOfferingInstanceAuditsOption : A functional option that sets a property of ListOfferingInstanceAuditsOptions (see ListOfferingInstanceAuditsWith).
+type ListOfferingInstanceAuditsOption func(options *ListOfferingInstanceAuditsOptions)
+
+// ListOfferingInstanceAuditsInstanceIdentifier sets the InstanceIdentifier property of
+// ListOfferingInstanceAuditsOptions. Version Instance identifier.
+func ListOfferingInstanceAuditsInstanceIdentifier(instanceIdentifier string) ListOfferingInstanceAuditsOption {
+	return func(options *ListOfferingInstanceAuditsOptions) {
+		options.InstanceIdentifier = core.StringPtr(instanceIdentifier)
+	}
+}
+
+// ListOfferingInstanceAuditsStart sets the Start property of ListOfferingInstanceAuditsOptions. Start token for a
+// query.
+func ListOfferingInstanceAuditsStart(start string) ListOfferingInstanceAuditsOption {
+	return func(options *ListOfferingInstanceAuditsOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListOfferingInstanceAuditsLimit sets the Limit property of ListOfferingInstanceAuditsOptions. number or results
+// to return in the query.
+func ListOfferingInstanceAuditsLimit(limit int64) ListOfferingInstanceAuditsOption {
+	return func(options *ListOfferingInstanceAuditsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListOfferingInstanceAuditsLookupnames sets the Lookupnames property of ListOfferingInstanceAuditsOptions.
+// Auditlog Lookup Names - by default names are not returned in auditlog.
+func ListOfferingInstanceAuditsLookupnames(lookupnames bool) ListOfferingInstanceAuditsOption {
+	return func(options *ListOfferingInstanceAuditsOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// ListOfferingInstanceAuditsHeader sets the Headers property of ListOfferingInstanceAuditsOptions. Allows users to
+// set headers on API requests.
+func ListOfferingInstanceAuditsHeader(name string, value string) ListOfferingInstanceAuditsOption {
+	return func(options *ListOfferingInstanceAuditsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListOfferingInstanceAuditsOptionsWith returns ListOfferingInstanceAuditsOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewListOfferingInstanceAuditsOptionsWith(options ...ListOfferingInstanceAuditsOption) *ListOfferingInstanceAuditsOptions {
+	listOfferingInstanceAuditsOptions := &ListOfferingInstanceAuditsOptions{}
+	for _, option := range options {
+		option(listOfferingInstanceAuditsOptions)
+	}
+	return listOfferingInstanceAuditsOptions
+}
+
+// ListOfferingInstanceAuditsWith is an alternate form of the ListOfferingInstanceAuditsWithContext method which
+// takes functional options instead of a ListOfferingInstanceAuditsOptions struct.
+// ListOfferingInstanceAuditsInstanceIdentifier is required.
+func (catalogManagement *CatalogManagementV1) ListOfferingInstanceAuditsWith(ctx context.Context, options ...ListOfferingInstanceAuditsOption) (result *AuditLogs, response *core.DetailedResponse, err error) {
+	return catalogManagement.ListOfferingInstanceAuditsWithContext(ctx, catalogManagement.NewListOfferingInstanceAuditsOptionsWith(options...))
+}
+
+// GetOfferingInstanceAuditOption : A functional option that sets a property of GetOfferingInstanceAuditOptions (see GetOfferingInstanceAuditWith).
+type GetOfferingInstanceAuditOption func(options *GetOfferingInstanceAuditOptions)
+
+// GetOfferingInstanceAuditInstanceIdentifier sets the InstanceIdentifier property of
+// GetOfferingInstanceAuditOptions. Version Instance identifier.
+func GetOfferingInstanceAuditInstanceIdentifier(instanceIdentifier string) GetOfferingInstanceAuditOption {
+	return func(options *GetOfferingInstanceAuditOptions) {
+		options.InstanceIdentifier = core.StringPtr(instanceIdentifier)
+	}
+}
+
+// GetOfferingInstanceAuditAuditlogIdentifier sets the AuditlogIdentifier property of
+// GetOfferingInstanceAuditOptions. Auditlog ID.
+func GetOfferingInstanceAuditAuditlogIdentifier(auditlogIdentifier string) GetOfferingInstanceAuditOption {
+	return func(options *GetOfferingInstanceAuditOptions) {
+		options.AuditlogIdentifier = core.StringPtr(auditlogIdentifier)
+	}
+}
+
+// GetOfferingInstanceAuditLookupnames sets the Lookupnames property of GetOfferingInstanceAuditOptions. Auditlog
+// Lookup Names - by default names are not returned in auditlog.
+func GetOfferingInstanceAuditLookupnames(lookupnames bool) GetOfferingInstanceAuditOption {
+	return func(options *GetOfferingInstanceAuditOptions) {
+		options.Lookupnames = core.BoolPtr(lookupnames)
+	}
+}
+
+// GetOfferingInstanceAuditHeader sets the Headers property of GetOfferingInstanceAuditOptions. Allows users to set
+// headers on API requests.
+func GetOfferingInstanceAuditHeader(name string, value string) GetOfferingInstanceAuditOption {
+	return func(options *GetOfferingInstanceAuditOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOfferingInstanceAuditOptionsWith returns GetOfferingInstanceAuditOptions populated by applying "options" in order.
+func (*CatalogManagementV1) NewGetOfferingInstanceAuditOptionsWith(options ...GetOfferingInstanceAuditOption) *GetOfferingInstanceAuditOptions {
+	getOfferingInstanceAuditOptions := &GetOfferingInstanceAuditOptions{}
+	for _, option := range options {
+		option(getOfferingInstanceAuditOptions)
+	}
+	return getOfferingInstanceAuditOptions
+}
+
+// GetOfferingInstanceAuditWith is an alternate form of the GetOfferingInstanceAuditWithContext method which takes
+// functional options instead of a GetOfferingInstanceAuditOptions struct.
+// GetOfferingInstanceAuditInstanceIdentifier and GetOfferingInstanceAuditAuditlogIdentifier are required.
+func (catalogManagement *CatalogManagementV1) GetOfferingInstanceAuditWith(ctx context.Context, options ...GetOfferingInstanceAuditOption) (result *AuditLog, response *core.DetailedResponse, err error) {
+	return catalogManagement.GetOfferingInstanceAuditWithContext(ctx, catalogManagement.NewGetOfferingInstanceAuditOptionsWith(options...))
+}
@@ -0,0 +1,1863 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genredact. DO NOT EDIT.
+
+package catalogmanagementv1
+
+import "github.com/IBM/platform-services-go-sdk/common"
+
+//go:generate go run ../internal/genredact
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Access) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Access) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccessListBulkResponse) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccessListBulkResponse) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccessListResult) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccessListResult) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Account) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Account) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccountPublishObjectOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccountPublishObjectOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccountPublishVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccountPublishVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccumulatedFilters) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccumulatedFilters) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccumulatedFiltersCatalogFiltersItem) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccumulatedFiltersCatalogFiltersItem) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccumulatedFiltersCatalogFiltersItemCatalog) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AccumulatedFiltersCatalogFiltersItemCatalog) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AddObjectAccessListOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AddObjectAccessListOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AddOfferingAccessListOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AddOfferingAccessListOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ApprovalResult) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ApprovalResult) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ArchitectureDiagram) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ArchitectureDiagram) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ArchiveVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ArchiveVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AuditLog) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AuditLog) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AuditLogDigest) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AuditLogDigest) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AuditLogs) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AuditLogs) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Badge) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Badge) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Catalog) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Catalog) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CatalogObject) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CatalogObject) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CatalogSearchResult) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CatalogSearchResult) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CategoryFilter) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CategoryFilter) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ClusterInfo) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ClusterInfo) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CommitVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CommitVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Configuration) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Configuration) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Constraint) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Constraint) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ConsumableShareObjectOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ConsumableShareObjectOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ConsumableVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ConsumableVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CopyFromPreviousVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CopyFromPreviousVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CopyVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CopyVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CostBreakdown) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CostBreakdown) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CostComponent) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CostComponent) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CostEstimate) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CostEstimate) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CostResource) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CostResource) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CostSummary) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CostSummary) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateCatalogOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateCatalogOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateObjectAccessOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateObjectAccessOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateObjectOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateObjectOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateOfferingInstanceOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateOfferingInstanceOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateOfferingOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateOfferingOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteCatalogOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteCatalogOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteObjectAccessListOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteObjectAccessListOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteObjectAccessOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteObjectAccessOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteObjectOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteObjectOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteOfferingAccessListOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteOfferingAccessListOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteOfferingInstanceOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteOfferingInstanceOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteOfferingOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteOfferingOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteOperatorsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteOperatorsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeleteVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Dependency) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Dependency) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeployOperatorsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeployOperatorsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeployRequestBodyEnvironmentVariablesItem) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeployRequestBodyEnvironmentVariablesItem) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeployRequestBodyOverrideValues) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeployRequestBodyOverrideValues) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeployRequestBodySchematics) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeployRequestBodySchematics) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Deployment) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Deployment) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeprecateOfferingOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeprecateOfferingOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeprecatePending) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeprecatePending) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeprecateVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model DeprecateVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Feature) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Feature) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model FilterTerms) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model FilterTerms) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Filters) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Filters) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Flavor) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Flavor) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetCatalogAccountAuditOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetCatalogAccountAuditOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetCatalogAccountFiltersOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetCatalogAccountFiltersOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetCatalogAuditOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetCatalogAuditOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetCatalogOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetCatalogOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetClusterOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetClusterOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetConsumptionOfferingsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetConsumptionOfferingsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetEnterpriseAuditOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetEnterpriseAuditOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetNamespacesOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetNamespacesOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetObjectAccessListDeprecatedOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetObjectAccessListDeprecatedOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetObjectAccessListOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetObjectAccessListOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetObjectAccessOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetObjectAccessOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetObjectAuditOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetObjectAuditOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetObjectOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetObjectOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingAboutOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingAboutOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingAccessListOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingAccessListOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingAccessOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingAccessOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingAuditOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingAuditOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingContainerImagesOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingContainerImagesOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingInstanceAuditOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingInstanceAuditOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingInstanceOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingInstanceOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingLicenseOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingLicenseOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingSourceOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingSourceOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingSourceURLOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingSourceURLOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingUpdatesOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingUpdatesOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingWorkingCopyOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOfferingWorkingCopyOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOverrideValuesOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetOverrideValuesOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetPreinstallOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetPreinstallOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetValidationStatusOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetValidationStatusOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model IBMPublishObjectOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model IBMPublishObjectOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model IBMPublishVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model IBMPublishVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model IDFilter) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model IDFilter) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model IamPermission) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model IamPermission) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model IamResource) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model IamResource) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Image) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Image) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImageManifest) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImageManifest) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImagePullKey) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImagePullKey) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingBodyMetadata) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingBodyMetadata) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingBodyMetadataFile) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingBodyMetadataFile) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingBodyMetadataImagesItem) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingBodyMetadataImagesItem) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingBodyMetadataOperatingSystem) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingBodyMetadataOperatingSystem) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ImportOfferingVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model InstallStatus) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model InstallStatus) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model InstallStatusContentMgmt) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model InstallStatusContentMgmt) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model InstallStatusMetadata) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model InstallStatusMetadata) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model InstallStatusRelease) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model InstallStatusRelease) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model InstallVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model InstallVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model JSONPatchOperation) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model JSONPatchOperation) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Kind) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Kind) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model LearnMoreLinks) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model LearnMoreLinks) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model License) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model License) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListCatalogAccountAuditsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListCatalogAccountAuditsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListCatalogAuditsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListCatalogAuditsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListEnterpriseAuditsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListEnterpriseAuditsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListObjectAuditsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListObjectAuditsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListObjectsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListObjectsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListOfferingAuditsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListOfferingAuditsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListOfferingInstanceAuditsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListOfferingInstanceAuditsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListOfferingsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListOfferingsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListOperatorsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListOperatorsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model MediaItem) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model MediaItem) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model NamespaceSearchResult) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model NamespaceSearchResult) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ObjectAccessListResult) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ObjectAccessListResult) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ObjectListResult) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ObjectListResult) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ObjectSearchResult) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ObjectSearchResult) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Offering) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Offering) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model OfferingInstance) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model OfferingInstance) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model OfferingInstanceLastOperation) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model OfferingInstanceLastOperation) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model OfferingSearchResult) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model OfferingSearchResult) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model OperatorDeployResult) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model OperatorDeployResult) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Output) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Output) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PaginationTokenLink) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PaginationTokenLink) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Plan) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Plan) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PreinstallVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PreinstallVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Project) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Project) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ProviderInfo) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ProviderInfo) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PublicPublishObjectOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PublicPublishObjectOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PublicPublishVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PublicPublishVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PublishObject) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PublishObject) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PutOfferingInstanceOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model PutOfferingInstanceOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Rating) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Rating) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReloadOfferingOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReloadOfferingOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RenderType) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RenderType) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RenderTypeAssociations) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RenderTypeAssociations) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RenderTypeAssociationsParametersItem) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RenderTypeAssociationsParametersItem) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceCatalogOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceCatalogOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceObjectOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceObjectOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceOfferingOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceOfferingOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceOperatorsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ReplaceOperatorsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RepoInfo) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RepoInfo) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Resource) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Resource) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Script) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Script) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SearchObjectsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SearchObjectsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SetDeprecateVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SetDeprecateVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SetOfferingPublishOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SetOfferingPublishOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ShareObjectOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ShareObjectOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ShareOfferingOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ShareOfferingOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ShareSetting) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ShareSetting) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SharedPublishObjectOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SharedPublishObjectOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SolutionInfo) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SolutionInfo) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model State) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model State) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Support) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Support) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SupportAvailability) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SupportAvailability) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SupportDetail) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SupportDetail) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SupportEscalation) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SupportEscalation) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SupportTime) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SupportTime) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SupportWaitTime) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SupportWaitTime) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SuspendVersionOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SuspendVersionOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SyndicationAuthorization) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SyndicationAuthorization) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SyndicationCluster) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SyndicationCluster) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SyndicationHistory) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SyndicationHistory) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SyndicationResource) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model SyndicationResource) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model URLProxy) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model URLProxy) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model UpdateCatalogAccountOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model UpdateCatalogAccountOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model UpdateOfferingOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model UpdateOfferingOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ValidateInstallOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ValidateInstallOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Validation) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Validation) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Version) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Version) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model VersionEntitlement) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model VersionEntitlement) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model VersionUpdateDescriptor) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model VersionUpdateDescriptor) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
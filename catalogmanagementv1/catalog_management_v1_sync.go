@@ -0,0 +1,403 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// DefaultCatalogSyncConcurrency is the number of objects that SyncCatalogObjects writes concurrently when no
+// concurrency is specified.
+const DefaultCatalogSyncConcurrency = 4
+
+// The number of objects retrieved per page when the objects of a catalog are listed.
+const catalogObjectsPageLimit = 100
+
+// Constants associated with the CatalogObjectSyncResult.Action property.
+const (
+	CatalogObjectSyncActionCreateConst    = "create"
+	CatalogObjectSyncActionUpdateConst    = "update"
+	CatalogObjectSyncActionUnchangedConst = "unchanged"
+	CatalogObjectSyncActionConflictConst  = "conflict"
+)
+
+// CatalogObjectFilter : Selects the objects of the source catalog that SyncCatalogObjects copies. The zero value
+// selects all objects.
+type CatalogObjectFilter struct {
+	// If set, only the objects with this name are selected (the name filter of ListObjects).
+	Name string
+
+	// If not empty, only the objects of these kinds are selected.
+	Kinds []string
+
+	// If set, only the objects for which it returns true are selected.
+	Match func(object *CatalogObject) bool
+}
+
+// CatalogObjectSyncReport : The outcome of SyncCatalogObjects.
+type CatalogObjectSyncReport struct {
+	// The IDs of the catalogs.
+	SourceCatalogID string
+	TargetCatalogID string
+
+	// If true, nothing was written: the actions are the ones that a sync would take.
+	DryRun bool
+
+	// The result for each selected object of the source catalog, in the order in which it was listed.
+	Results []CatalogObjectSyncResult
+
+	// The workflow ID sent with every request of the sync (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// CatalogObjectSyncResult : The result of the sync of one object.
+type CatalogObjectSyncResult struct {
+	// The object of the source catalog.
+	Source *CatalogObject
+
+	// The matching object of the target catalog: the object as written by the sync, or as found in the target
+	// catalog if it was not written. It is nil for an object that is not created (dry run, or failure).
+	Target *CatalogObject
+
+	// The action for the object (a CatalogObjectSyncAction*Const value), or an empty string if the object was not
+	// synced because the context was done. A conflict means that the object of the target catalog was changed after
+	// the object of the source catalog, or that it was changed during the sync; it is not overwritten.
+	Action string
+
+	// The reason why the action failed or was not taken, or nil if it succeeded (or was not attempted in a dry run).
+	Err error
+}
+
+// ResultsFor returns the results with the specified action (a CatalogObjectSyncAction*Const value).
+func (report *CatalogObjectSyncReport) ResultsFor(action string) (results []CatalogObjectSyncResult) {
+	for _, result := range report.Results {
+		if result.Action == action {
+			results = append(results, result)
+		}
+	}
+	return
+}
+
+// Conflicts returns the results of the objects that were not synced because of a conflict.
+func (report *CatalogObjectSyncReport) Conflicts() []CatalogObjectSyncResult {
+	return report.ResultsFor(CatalogObjectSyncActionConflictConst)
+}
+
+// Failed returns the results of the objects whose write failed.
+func (report *CatalogObjectSyncReport) Failed() (failed []CatalogObjectSyncResult) {
+	for _, result := range report.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return
+}
+
+// SyncCatalogObjects copies the objects of a catalog selected by "filter" (which may be nil) to another catalog, for
+// example from a staging catalog to a production one. Objects are matched by kind and name: an object that is not in
+// the target catalog is created, and one that differs (in label, description, tags, parent or data) is replaced, unless
+// the object of the target catalog was updated after the object of the source catalog, which is reported as a conflict.
+// The parent of an object is mapped to the matching object of the target catalog; parents are created before their
+// children. Up to "concurrency" objects are written concurrently (DefaultCatalogSyncConcurrency if "concurrency" is not
+// positive). If "dryRun" is true, nothing is written and the report describes the actions that a sync would take. A
+// write that fails is recorded in the result of its object, so "err" is only returned if the objects of the catalogs
+// cannot be listed or the context is done. If the context is done once the objects are being synced, the report is
+// still returned with a *common.PartialError, and the objects that were not synced have no action and the error of the
+// context.
+func (catalogManagement *CatalogManagementV1) SyncCatalogObjects(ctx context.Context, sourceCatalogID string, targetCatalogID string, filter *CatalogObjectFilter, dryRun bool, concurrency int) (report *CatalogObjectSyncReport, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, catalogManagement.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	if filter == nil {
+		filter = &CatalogObjectFilter{}
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultCatalogSyncConcurrency
+	}
+	sourceObjects, err := catalogManagement.listAllObjects(ctx, sourceCatalogID, filter.Name)
+	if err != nil {
+		return
+	}
+	targetObjects, err := catalogManagement.listAllObjects(ctx, targetCatalogID, "")
+	if err != nil {
+		return
+	}
+
+	syncer := &catalogObjectSyncer{
+		catalogManagement: catalogManagement,
+		targetCatalogID:   targetCatalogID,
+		sourceByID:        make(map[string]*CatalogObject),
+		targetByKey:       make(map[string]*CatalogObject),
+		results:           make(map[string]*CatalogObjectSyncResult),
+	}
+	for i := range sourceObjects {
+		syncer.sourceByID[core.StringNilMapper(sourceObjects[i].ID)] = &sourceObjects[i]
+	}
+	for i := range targetObjects {
+		syncer.targetByKey[catalogObjectKey(&targetObjects[i])] = &targetObjects[i]
+	}
+
+	report = &CatalogObjectSyncReport{
+		SourceCatalogID: sourceCatalogID,
+		TargetCatalogID: targetCatalogID,
+		DryRun:          dryRun,
+		WorkflowID:      workflowID,
+	}
+	for i := range sourceObjects {
+		if filter.matches(&sourceObjects[i]) {
+			report.Results = append(report.Results, CatalogObjectSyncResult{Source: &sourceObjects[i]})
+		}
+	}
+	for i := range report.Results {
+		syncer.results[core.StringNilMapper(report.Results[i].Source.ID)] = &report.Results[i]
+	}
+
+	// Objects are written in waves, so that the parents created by the sync exist when their children are written.
+	pending := make([]*CatalogObjectSyncResult, len(report.Results))
+	for i := range report.Results {
+		pending[i] = &report.Results[i]
+	}
+	for len(pending) > 0 {
+		var wave, next []*CatalogObjectSyncResult
+		for _, result := range pending {
+			if syncer.waitsForParent(result.Source) {
+				next = append(next, result)
+			} else {
+				wave = append(wave, result)
+			}
+		}
+		if len(wave) == 0 {
+			// The parents form a cycle; the remaining objects are written with their parents unmapped.
+			wave, next = next, nil
+		}
+		err = common.ForEachConcurrently(ctx, len(wave), concurrency, func(ctx context.Context, i int) {
+			syncer.syncObject(ctx, wave[i], dryRun)
+		})
+		if err != nil {
+			break
+		}
+		pending = next
+	}
+
+	var partialErr *common.PartialError
+	if errors.As(err, &partialErr) {
+		partialErr.Processed, partialErr.Total = 0, len(report.Results)
+		for i := range report.Results {
+			if report.Results[i].Action != "" {
+				partialErr.Processed++
+			} else {
+				report.Results[i].Err = partialErr.Err
+			}
+		}
+	}
+	return
+}
+
+// catalogObjectSyncer : The state of a SyncCatalogObjects call.
+type catalogObjectSyncer struct {
+	catalogManagement *CatalogManagementV1
+	targetCatalogID   string
+
+	// The objects of the source catalog by ID, and those of the target catalog by kind and name.
+	sourceByID  map[string]*CatalogObject
+	targetByKey map[string]*CatalogObject
+
+	// The results of the selected objects of the source catalog, by ID.
+	results map[string]*CatalogObjectSyncResult
+
+	// Guards the Target and Action of the results while a wave is written.
+	mutex sync.Mutex
+}
+
+// waitsForParent returns true if the parent of a source object is selected and has not been synced yet.
+func (syncer *catalogObjectSyncer) waitsForParent(object *CatalogObject) bool {
+	parent, found := syncer.results[core.StringNilMapper(object.ParentID)]
+	return found && parent.Action == ""
+}
+
+// syncObject determines the action for an object, and takes it unless "dryRun" is true.
+func (syncer *catalogObjectSyncer) syncObject(ctx context.Context, result *CatalogObjectSyncResult, dryRun bool) {
+	source := result.Source
+	syncer.mutex.Lock()
+	target := syncer.targetByKey[catalogObjectKey(source)]
+	parentID := syncer.targetParentID(source)
+	syncer.mutex.Unlock()
+
+	action := CatalogObjectSyncActionCreateConst
+	if target != nil {
+		switch {
+		case catalogObjectsEqual(source, target, parentID):
+			action = CatalogObjectSyncActionUnchangedConst
+		case isUpdatedAfter(target, source):
+			action = CatalogObjectSyncActionConflictConst
+		default:
+			action = CatalogObjectSyncActionUpdateConst
+		}
+	}
+
+	written := target
+	var err error
+	if !dryRun {
+		switch action {
+		case CatalogObjectSyncActionCreateConst:
+			options := syncer.catalogManagement.NewCreateObjectOptions(syncer.targetCatalogID)
+			options.Name = source.Name
+			options.Kind = source.Kind
+			options.Label = source.Label
+			options.ShortDescription = source.ShortDescription
+			options.Tags = source.Tags
+			options.Data = source.Data
+			options.ParentID = parentID
+			written, _, err = syncer.catalogManagement.CreateObjectWithContext(ctx, options)
+		case CatalogObjectSyncActionUpdateConst:
+			options := syncer.catalogManagement.NewReplaceObjectOptions(syncer.targetCatalogID, core.StringNilMapper(target.ID))
+			options.ID = target.ID
+			options.Rev = target.Rev
+			options.CatalogID = target.CatalogID
+			options.Name = source.Name
+			options.Kind = source.Kind
+			options.Label = source.Label
+			options.ShortDescription = source.ShortDescription
+			options.Tags = source.Tags
+			options.Data = source.Data
+			options.ParentID = parentID
+			var response *core.DetailedResponse
+			written, response, err = syncer.catalogManagement.ReplaceObjectWithContext(ctx, options)
+			if err != nil && response != nil && response.StatusCode == http.StatusConflict {
+				// The revision of the object changed since it was listed.
+				action, written, err = CatalogObjectSyncActionConflictConst, target, nil
+			}
+		}
+		if err != nil {
+			err = fmt.Errorf("error writing object '%s' to catalog '%s': %w", core.StringNilMapper(source.Name), syncer.targetCatalogID, err)
+			written = target
+		}
+	}
+
+	syncer.mutex.Lock()
+	defer syncer.mutex.Unlock()
+	result.Action = action
+	result.Target = written
+	result.Err = err
+	if written != nil {
+		syncer.targetByKey[catalogObjectKey(source)] = written
+	}
+}
+
+// targetParentID returns the ID of the object of the target catalog that matches the parent of a source object, or
+// the parent ID of the source object if there is none. The mutex must be held.
+func (syncer *catalogObjectSyncer) targetParentID(source *CatalogObject) *string {
+	parent, found := syncer.sourceByID[core.StringNilMapper(source.ParentID)]
+	if !found {
+		return source.ParentID
+	}
+	if target := syncer.targetByKey[catalogObjectKey(parent)]; target != nil && target.ID != nil {
+		return target.ID
+	}
+	return source.ParentID
+}
+
+// listAllObjects lists the objects of a catalog, optionally with the specified name.
+func (catalogManagement *CatalogManagementV1) listAllObjects(ctx context.Context, catalogID string, name string) (objects []CatalogObject, err error) {
+	options := catalogManagement.NewListObjectsOptions(catalogID)
+	options.Limit = core.Int64Ptr(catalogObjectsPageLimit)
+	if name != "" {
+		options.Name = core.StringPtr(name)
+	}
+	for offset := int64(0); ; {
+		options.Offset = core.Int64Ptr(offset)
+		var page *ObjectListResult
+		page, _, err = catalogManagement.ListObjectsWithContext(ctx, options)
+		if err != nil {
+			err = fmt.Errorf("error listing the objects of catalog '%s': %w", catalogID, err)
+			return
+		}
+		objects = append(objects, page.Resources...)
+		offset += int64(len(page.Resources))
+		if len(page.Resources) == 0 || page.TotalCount == nil || offset >= *page.TotalCount {
+			return
+		}
+	}
+}
+
+// matches returns true if the filter selects the object.
+func (filter *CatalogObjectFilter) matches(object *CatalogObject) bool {
+	if filter.Name != "" && core.StringNilMapper(object.Name) != filter.Name {
+		return false
+	}
+	if len(filter.Kinds) > 0 {
+		found := false
+		for _, kind := range filter.Kinds {
+			if kind == core.StringNilMapper(object.Kind) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return filter.Match == nil || filter.Match(object)
+}
+
+// catalogObjectKey returns the kind and name by which the objects of two catalogs are matched.
+func catalogObjectKey(object *CatalogObject) string {
+	return core.StringNilMapper(object.Kind) + "/" + core.StringNilMapper(object.Name)
+}
+
+// catalogObjectsEqual returns true if the synced properties of a source object and a target object are equal, given
+// the parent ID that the target object should have.
+func catalogObjectsEqual(source *CatalogObject, target *CatalogObject, parentID *string) bool {
+	return core.StringNilMapper(source.Label) == core.StringNilMapper(target.Label) &&
+		core.StringNilMapper(source.ShortDescription) == core.StringNilMapper(target.ShortDescription) &&
+		core.StringNilMapper(parentID) == core.StringNilMapper(target.ParentID) &&
+		reflect.DeepEqual(nonEmptyStrings(source.Tags), nonEmptyStrings(target.Tags)) &&
+		reflect.DeepEqual(nonEmptyMap(source.Data), nonEmptyMap(target.Data))
+}
+
+// isUpdatedAfter returns true if "object" was updated after "other". It is false if either has no update time.
+func isUpdatedAfter(object *CatalogObject, other *CatalogObject) bool {
+	if object.Updated == nil || other.Updated == nil {
+		return false
+	}
+	return time.Time(*object.Updated).After(time.Time(*other.Updated))
+}
+
+// nonEmptyStrings returns nil for an empty slice, so that a nil and an empty slice compare equal.
+func nonEmptyStrings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// nonEmptyMap returns nil for an empty map, so that a nil and an empty map compare equal.
+func nonEmptyMap(values map[string]interface{}) map[string]interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
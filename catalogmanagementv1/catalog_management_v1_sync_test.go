@@ -0,0 +1,165 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/IBM/platform-services-go-sdk/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CatalogManagementV1 catalog object sync`, func() {
+	var testServer *httptest.Server
+	var catalogManagementService *catalogmanagementv1.CatalogManagementV1
+	var mutex sync.Mutex
+	var writes []map[string]interface{}
+	var onWrite func(name string)
+	BeforeEach(func() {
+		writes = nil
+		onWrite = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.Path {
+			case "GET /catalogs/src/objects":
+				Expect(req.URL.Query().Get("offset")).To(Equal("0"))
+				fmt.Fprint(res, `{"offset": 0, "limit": 100, "total_count": 6, "resources": [
+					{"id": "s-child", "name": "child", "kind": "vpe", "parent_id": "s-ns", "updated": "2022-03-01T00:00:00.000Z"},
+					{"id": "s-ns", "name": "ns", "kind": "vpe", "label": "Namespace", "updated": "2022-03-01T00:00:00.000Z"},
+					{"id": "s-same", "name": "same", "kind": "vpe", "label": "Same", "tags": ["a"], "data": {"k": "v"}, "updated": "2022-03-01T00:00:00.000Z"},
+					{"id": "s-changed", "name": "changed", "kind": "vpe", "label": "New", "updated": "2022-03-01T00:00:00.000Z"},
+					{"id": "s-edited", "name": "edited", "kind": "vpe", "label": "New", "updated": "2022-03-01T00:00:00.000Z"},
+					{"id": "s-other", "name": "other", "kind": "preset", "updated": "2022-03-01T00:00:00.000Z"}]}`)
+			case "GET /catalogs/dst/objects":
+				fmt.Fprint(res, `{"offset": 0, "limit": 100, "total_count": 3, "resources": [
+					{"id": "t-same", "_rev": "1", "name": "same", "kind": "vpe", "label": "Same", "tags": ["a"], "data": {"k": "v"}, "updated": "2022-04-01T00:00:00.000Z"},
+					{"id": "t-changed", "_rev": "2", "name": "changed", "kind": "vpe", "label": "Old", "updated": "2022-02-01T00:00:00.000Z"},
+					{"id": "t-edited", "_rev": "3", "name": "edited", "kind": "vpe", "label": "Edited", "updated": "2022-04-01T00:00:00.000Z"}]}`)
+			case "POST /catalogs/dst/objects", "PUT /catalogs/dst/objects/t-changed":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				mutex.Lock()
+				writes = append(writes, body)
+				mutex.Unlock()
+				if onWrite != nil {
+					onWrite(body["name"].(string))
+				}
+				if req.Method == http.MethodPost {
+					body["id"] = "t-" + body["name"].(string)
+					res.WriteHeader(201)
+				}
+				Expect(json.NewEncoder(res).Encode(body)).To(Succeed())
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		catalogManagementService, serviceErr = catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(catalogManagementService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	actions := func(report *catalogmanagementv1.CatalogObjectSyncReport) map[string]string {
+		actions := make(map[string]string)
+		for _, result := range report.Results {
+			actions[*result.Source.Name] = result.Action
+		}
+		return actions
+	}
+
+	It(`Creates missing objects, replaces changed ones and reports conflicts`, func() {
+		filter := &catalogmanagementv1.CatalogObjectFilter{Kinds: []string{"vpe"}}
+		report, err := catalogManagementService.SyncCatalogObjects(context.Background(), "src", "dst", filter, false, 0)
+		Expect(err).To(BeNil())
+		Expect(report.WorkflowID).ToNot(BeEmpty())
+		Expect(report.Failed()).To(BeEmpty())
+		Expect(actions(report)).To(Equal(map[string]string{
+			"child":   catalogmanagementv1.CatalogObjectSyncActionCreateConst,
+			"ns":      catalogmanagementv1.CatalogObjectSyncActionCreateConst,
+			"same":    catalogmanagementv1.CatalogObjectSyncActionUnchangedConst,
+			"changed": catalogmanagementv1.CatalogObjectSyncActionUpdateConst,
+			"edited":  catalogmanagementv1.CatalogObjectSyncActionConflictConst,
+		}))
+		Expect(report.Conflicts()).To(HaveLen(1))
+		Expect(*report.Conflicts()[0].Target.Label).To(Equal("Edited"))
+
+		// The parent is written in the first wave, and its child in the second one.
+		Expect(writes).To(HaveLen(3))
+		Expect(writes[2]["name"]).To(Equal("child"))
+		Expect(writes[2]["parent_id"]).To(Equal("t-ns"))
+		written := map[string]map[string]interface{}{}
+		for _, body := range writes[:2] {
+			written[body["name"].(string)] = body
+		}
+		Expect(written["ns"]["label"]).To(Equal("Namespace"))
+		Expect(written["changed"]["_rev"]).To(Equal("2"))
+		Expect(written["changed"]["label"]).To(Equal("New"))
+		Expect(*report.Results[0].Target.ID).To(Equal("t-child"))
+	})
+	It(`Writes nothing in a dry run`, func() {
+		filter := &catalogmanagementv1.CatalogObjectFilter{Match: func(object *catalogmanagementv1.CatalogObject) bool {
+			return *object.Name != "child"
+		}}
+		report, err := catalogManagementService.SyncCatalogObjects(context.Background(), "src", "dst", filter, true, 0)
+		Expect(err).To(BeNil())
+		Expect(report.DryRun).To(BeTrue())
+		Expect(writes).To(BeEmpty())
+		Expect(actions(report)).To(Equal(map[string]string{
+			"ns":      catalogmanagementv1.CatalogObjectSyncActionCreateConst,
+			"same":    catalogmanagementv1.CatalogObjectSyncActionUnchangedConst,
+			"changed": catalogmanagementv1.CatalogObjectSyncActionUpdateConst,
+			"edited":  catalogmanagementv1.CatalogObjectSyncActionConflictConst,
+			"other":   catalogmanagementv1.CatalogObjectSyncActionCreateConst,
+		}))
+		Expect(report.ResultsFor(catalogmanagementv1.CatalogObjectSyncActionCreateConst)[0].Target).To(BeNil())
+	})
+	It(`Returns the partial report when the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		onWrite = func(name string) {
+			if name == "ns" {
+				cancel()
+			}
+		}
+		filter := &catalogmanagementv1.CatalogObjectFilter{Kinds: []string{"vpe"}}
+		report, err := catalogManagementService.SyncCatalogObjects(ctx, "src", "dst", filter, false, 0)
+		var partialErr *common.PartialError
+		Expect(errors.As(err, &partialErr)).To(BeTrue())
+		Expect(partialErr.Processed).To(Equal(4))
+		Expect(partialErr.Total).To(Equal(5))
+		Expect(report.Results[0].Action).To(BeEmpty())
+		Expect(report.Results[0].Err).To(MatchError(context.Canceled))
+		Expect(report.Results[2].Action).To(Equal(catalogmanagementv1.CatalogObjectSyncActionUnchangedConst))
+	})
+})
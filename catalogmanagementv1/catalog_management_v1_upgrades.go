@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AvailableUpgrades : The versions of an offering to which an installed offering instance can be upgraded.
+type AvailableUpgrades struct {
+	// The installed offering instance.
+	Instance *OfferingInstance
+
+	// The versions newer than the installed version that the instance can be upgraded to, oldest first. Upgrading
+	// through the versions in this order is the upgrade path; the last version is the latest available upgrade.
+	Upgrades []VersionUpdateDescriptor
+
+	// The versions newer than the installed version that the instance cannot be upgraded to, oldest first. The
+	// Messages of each version describe the compatibility checks that failed.
+	Incompatible []VersionUpdateDescriptor
+}
+
+// Latest returns the newest version that the instance can be upgraded to, or nil if it is up to date.
+func (upgrades *AvailableUpgrades) Latest() *VersionUpdateDescriptor {
+	if len(upgrades.Upgrades) == 0 {
+		return nil
+	}
+	return &upgrades.Upgrades[len(upgrades.Upgrades)-1]
+}
+
+// ListAvailableUpgrades retrieves an offering instance and the updates that the catalog offers for its installed
+// version and target, and returns the newer versions ordered as an upgrade path. Versions that fail the catalog's
+// compatibility checks (resources, target version, permissions) are reported separately.
+// "xAuthRefreshToken" is the IAM refresh token required by the GetOfferingUpdates operation.
+func (catalogManagement *CatalogManagementV1) ListAvailableUpgrades(ctx context.Context, offeringInstanceID string, xAuthRefreshToken string) (result *AvailableUpgrades, err error) {
+	instance, _, err := catalogManagement.GetOfferingInstanceWithContext(ctx, catalogManagement.NewGetOfferingInstanceOptions(offeringInstanceID))
+	if err != nil {
+		return
+	}
+	if instance.CatalogID == nil || instance.OfferingID == nil || instance.KindFormat == nil || instance.Version == nil {
+		err = fmt.Errorf("offering instance '%s' does not identify an installed catalog version", offeringInstanceID)
+		return
+	}
+
+	options := catalogManagement.NewGetOfferingUpdatesOptions(*instance.CatalogID, *instance.OfferingID, *instance.KindFormat, xAuthRefreshToken)
+	options.Target = instance.KindTarget
+	options.Version = instance.Version
+	options.ClusterID = instance.ClusterID
+	options.Region = instance.ClusterRegion
+	options.ResourceGroupID = instance.ResourceGroupID
+	options.Channel = instance.Channel
+	options.Sha = instance.Sha
+	options.Namespaces = instance.ClusterNamespaces
+	options.AllNamespaces = instance.ClusterAllNamespaces
+	updates, _, err := catalogManagement.GetOfferingUpdatesWithContext(ctx, options)
+	if err != nil {
+		return
+	}
+
+	result = &AvailableUpgrades{Instance: instance}
+	for _, update := range updates {
+		if update.Version == nil || compareVersions(*update.Version, *instance.Version) <= 0 {
+			continue
+		}
+		if update.CanUpdate != nil && *update.CanUpdate {
+			result.Upgrades = append(result.Upgrades, update)
+		} else {
+			result.Incompatible = append(result.Incompatible, update)
+		}
+	}
+	sortVersionUpdates(result.Upgrades)
+	sortVersionUpdates(result.Incompatible)
+	return
+}
+
+func sortVersionUpdates(updates []VersionUpdateDescriptor) {
+	sort.SliceStable(updates, func(i, j int) bool {
+		return compareVersions(core.StringNilMapper(updates[i].Version), core.StringNilMapper(updates[j].Version)) < 0
+	})
+}
+
+// compareVersions compares two semantic versions (an optional "v" prefix, dot-separated numbers and an optional
+// "-prerelease" suffix) and returns -1, 0 or 1. Non-numeric parts are compared as strings.
+func compareVersions(a string, b string) int {
+	aCore, aPre := splitVersion(a)
+	bCore, bPre := splitVersion(b)
+
+	aParts := strings.Split(aCore, ".")
+	bParts := strings.Split(bCore, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		aPart, bPart := "0", "0"
+		if i < len(aParts) {
+			aPart = aParts[i]
+		}
+		if i < len(bParts) {
+			bPart = bParts[i]
+		}
+		if c := compareVersionPart(aPart, bPart); c != 0 {
+			return c
+		}
+	}
+
+	// A pre-release sorts before the corresponding release.
+	switch {
+	case aPre == bPre:
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	}
+	aIDs := strings.Split(aPre, ".")
+	bIDs := strings.Split(bPre, ".")
+	for i := 0; i < len(aIDs) && i < len(bIDs); i++ {
+		if c := compareVersionPart(aIDs[i], bIDs[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInts(len(aIDs), len(bIDs))
+}
+
+func splitVersion(version string) (versionCore string, prerelease string) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexByte(version, '+'); i >= 0 {
+		version = version[:i]
+	}
+	if i := strings.IndexByte(version, '-'); i >= 0 {
+		return version[:i], version[i+1:]
+	}
+	return version, ""
+}
+
+func compareVersionPart(a string, b string) int {
+	aNum, aErr := strconv.Atoi(a)
+	bNum, bErr := strconv.Atoi(b)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareInts(aNum, bNum)
+	case aErr == nil:
+		// Numeric identifiers sort before alphanumeric ones.
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
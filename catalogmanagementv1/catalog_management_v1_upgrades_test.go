@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CatalogManagementV1 ListAvailableUpgrades`, func() {
+	var testServer *httptest.Server
+	var catalogManagementService *catalogmanagementv1.CatalogManagementV1
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/instances/offerings/instance1":
+				fmt.Fprint(res, `{"id": "instance1", "catalog_id": "catalog1", "offering_id": "offering1",
+					"kind_format": "helm", "kind_target": "roks", "version": "1.2.0", "cluster_id": "cluster1",
+					"cluster_region": "us-south", "cluster_namespaces": ["ns1", "ns2"], "channel": "stable"}`)
+			case "/instances/offerings/broken":
+				fmt.Fprint(res, `{"id": "broken"}`)
+			case "/catalogs/catalog1/offerings/offering1/updates":
+				query := req.URL.Query()
+				Expect(req.Header.Get("X-Auth-Refresh-Token")).To(Equal("refresh"))
+				Expect(query.Get("kind")).To(Equal("helm"))
+				Expect(query.Get("target")).To(Equal("roks"))
+				Expect(query.Get("version")).To(Equal("1.2.0"))
+				Expect(query.Get("cluster_id")).To(Equal("cluster1"))
+				Expect(query.Get("region")).To(Equal("us-south"))
+				Expect(query.Get("namespaces")).To(Equal("ns1,ns2"))
+				Expect(query.Get("channel")).To(Equal("stable"))
+				fmt.Fprint(res, `[
+					{"version": "1.10.0", "can_update": true},
+					{"version": "1.1.0", "can_update": true},
+					{"version": "1.2.0", "can_update": true},
+					{"version": "2.0.0", "can_update": false, "messages": {"targetVersion": "requires OpenShift 4.10"}},
+					{"version": "1.3.0-rc.1", "can_update": true},
+					{"version": "1.3.0", "can_update": true}
+				]`)
+			default:
+				Fail("unexpected request " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		catalogManagementService, serviceErr = catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	versions := func(updates []catalogmanagementv1.VersionUpdateDescriptor) (result []string) {
+		for _, update := range updates {
+			result = append(result, *update.Version)
+		}
+		return
+	}
+
+	It(`Returns newer compatible versions as an ordered upgrade path`, func() {
+		upgrades, err := catalogManagementService.ListAvailableUpgrades(context.Background(), "instance1", "refresh")
+		Expect(err).To(BeNil())
+		Expect(*upgrades.Instance.ID).To(Equal("instance1"))
+		Expect(versions(upgrades.Upgrades)).To(Equal([]string{"1.3.0-rc.1", "1.3.0", "1.10.0"}))
+		Expect(*upgrades.Latest().Version).To(Equal("1.10.0"))
+		Expect(versions(upgrades.Incompatible)).To(Equal([]string{"2.0.0"}))
+		Expect(upgrades.Incompatible[0].Messages).To(HaveKeyWithValue("targetVersion", "requires OpenShift 4.10"))
+	})
+	It(`Requires an installed catalog version`, func() {
+		_, err := catalogManagementService.ListAvailableUpgrades(context.Background(), "broken", "refresh")
+		Expect(err).ToNot(BeNil())
+		Expect((&catalogmanagementv1.AvailableUpgrades{}).Latest()).To(BeNil())
+	})
+})
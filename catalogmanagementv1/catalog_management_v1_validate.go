@@ -0,0 +1,248 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// DefaultValidationPollInterval is the interval at which an OfferingValidator polls the validation status of a version
+// when no interval is specified.
+const DefaultValidationPollInterval = 10 * time.Second
+
+// ValidationTarget : The cluster (or other target) on which ValidateOfferingVersion installs a version to validate
+// it. Which fields are required depends on the kind of the offering.
+type ValidationTarget struct {
+	// The ID of the cluster.
+	ClusterID string
+
+	// The region of the cluster.
+	Region string
+
+	// The Kubernetes namespace in which the version is installed.
+	Namespace string
+
+	// The override values of the validation. Required for virtual server images for VPC.
+	OverrideValues *DeployRequestBodyOverrideValues
+
+	// The environment variables of the Schematics workspace used by the validation.
+	EnvironmentVariables []DeployRequestBodyEnvironmentVariablesItem
+}
+
+// ValidationStatusChange : A validation status observed by ValidateOfferingVersion, which differs from the previous
+// one.
+type ValidationStatusChange struct {
+	// When the status was retrieved.
+	Time time.Time
+
+	// The state of the validation (a ValidationState*Const value, or empty until the validation request is
+	// processed).
+	State string
+
+	// The last operation of the validation (for example install_offering).
+	LastOperation string
+
+	// The message of the validation, if any.
+	Message string
+}
+
+// VersionValidation : The outcome of ValidateOfferingVersion.
+type VersionValidation struct {
+	// The locator of the validated version.
+	VersionLocator string
+
+	// Whether the validation completed in the ValidationStateValidConst state.
+	Passed bool
+
+	// The final validation status of the version, or the last one retrieved if the validation did not complete.
+	Validation *Validation
+
+	// The distinct statuses observed while polling, oldest first: the log of the validation.
+	Log []ValidationStatusChange
+
+	// Why the validation did not pass, or nil if it passed or did not complete.
+	Failure *ValidationFailure
+
+	// The workflow ID sent with every request of the validation (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// ValidationFailure : The details of a validation that completed without passing.
+type ValidationFailure struct {
+	// The final state of the validation (ValidationStateInvalidConst or ValidationStateExpiredConst).
+	State string
+
+	// The operation during which the validation failed.
+	LastOperation string
+
+	// The message describing the failure.
+	Message string
+
+	// The target of the validation, as reported by the catalog.
+	Target map[string]interface{}
+}
+
+// Error returns a description of the failure, so that it can be returned or wrapped as an error.
+func (failure *ValidationFailure) Error() string {
+	message := fmt.Sprintf("validation is %s", failure.State)
+	if failure.LastOperation != "" {
+		message += fmt.Sprintf(" after operation '%s'", failure.LastOperation)
+	}
+	if failure.Message != "" {
+		message += ": " + failure.Message
+	}
+	return message
+}
+
+// OfferingValidator : Validates offering versions by installing them on a target, waiting for the outcome.
+type OfferingValidator struct {
+	*CatalogManagementV1
+
+	// The IAM refresh token required by the validation operations.
+	XAuthRefreshToken string
+
+	// The interval at which the validation status is polled. Defaults to DefaultValidationPollInterval.
+	PollInterval time.Duration
+
+	// The maximum time to wait for a validation to complete. If zero, the validator waits until the context is done.
+	Timeout time.Duration
+
+	// If set, called with each status change as it is observed, for example to stream the progress to a CI log.
+	OnStatusChange func(change ValidationStatusChange)
+}
+
+// NewOfferingValidator returns a new OfferingValidator that uses the specified client and refresh token, with the
+// default poll interval and no timeout.
+func NewOfferingValidator(catalogManagement *CatalogManagementV1, xAuthRefreshToken string) *OfferingValidator {
+	return &OfferingValidator{
+		CatalogManagementV1: catalogManagement,
+		XAuthRefreshToken:   xAuthRefreshToken,
+		PollInterval:        DefaultValidationPollInterval,
+	}
+}
+
+// ValidateOfferingVersion starts the validation of the version with the specified locator on "target", then polls the
+// validation status until the validation completes, recording each status change in the log of the result. A
+// validation that completes without passing is not an error: Passed is false and Failure describes the failure. "err"
+// is returned if the validation cannot be started or its status retrieved, or if the timeout expires (or the context
+// is done) first, along with the result so far.
+func (validator *OfferingValidator) ValidateOfferingVersion(ctx context.Context, versionLocator string, target *ValidationTarget) (result *VersionValidation, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, validator.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	if validator.XAuthRefreshToken == "" {
+		err = fmt.Errorf("a refresh token is required to validate version '%s'", versionLocator)
+		return
+	}
+	validateOptions := validator.NewValidateInstallOptions(versionLocator, validator.XAuthRefreshToken)
+	if target != nil {
+		if target.ClusterID != "" {
+			validateOptions.SetClusterID(target.ClusterID)
+		}
+		if target.Region != "" {
+			validateOptions.SetRegion(target.Region)
+		}
+		if target.Namespace != "" {
+			validateOptions.SetNamespace(target.Namespace)
+		}
+		validateOptions.OverrideValues = target.OverrideValues
+		validateOptions.EnvironmentVariables = target.EnvironmentVariables
+	}
+	_, err = validator.ValidateInstallWithContext(ctx, validateOptions)
+	if err != nil {
+		err = fmt.Errorf("error starting the validation of version '%s': %w", versionLocator, err)
+		return
+	}
+
+	result = &VersionValidation{VersionLocator: versionLocator, WorkflowID: workflowID}
+	result.Validation, err = validator.waitForValidation(ctx, versionLocator, validator.XAuthRefreshToken, validator.PollInterval,
+		validator.Timeout, func(change ValidationStatusChange) {
+			result.Log = append(result.Log, change)
+			if validator.OnStatusChange != nil {
+				validator.OnStatusChange(change)
+			}
+		})
+	if result.Validation == nil || err != nil {
+		return
+	}
+	state := core.StringNilMapper(result.Validation.State)
+	result.Passed = state == ValidationStateValidConst
+	if !result.Passed {
+		result.Failure = &ValidationFailure{
+			State:         state,
+			LastOperation: core.StringNilMapper(result.Validation.LastOperation),
+			Message:       core.StringNilMapper(result.Validation.Message),
+			Target:        result.Validation.Target,
+		}
+	}
+	return
+}
+
+// waitForValidation polls the validation status of a version until the validation completes, and returns the final
+// status. "onChange", if not nil, is called with each distinct status. If the validation does not complete in time,
+// the last status retrieved is returned along with the error.
+func (catalogManagement *CatalogManagementV1) waitForValidation(ctx context.Context, versionLocator string, xAuthRefreshToken string,
+	pollInterval time.Duration, timeout time.Duration, onChange func(change ValidationStatusChange)) (validation *Validation, err error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if pollInterval <= 0 {
+		pollInterval = DefaultValidationPollInterval
+	}
+	statusOptions := catalogManagement.NewGetValidationStatusOptions(versionLocator, xAuthRefreshToken)
+	var previous *ValidationStatusChange
+	for {
+		var status *Validation
+		status, _, err = catalogManagement.GetValidationStatusWithContext(ctx, statusOptions)
+		if err != nil {
+			err = fmt.Errorf("error retrieving the validation status of version '%s': %w", versionLocator, err)
+			return
+		}
+		validation = status
+
+		change := ValidationStatusChange{
+			Time:          time.Now(),
+			State:         core.StringNilMapper(status.State),
+			LastOperation: core.StringNilMapper(status.LastOperation),
+			Message:       core.StringNilMapper(status.Message),
+		}
+		if onChange != nil && (previous == nil || change.State != previous.State ||
+			change.LastOperation != previous.LastOperation || change.Message != previous.Message) {
+			onChange(change)
+		}
+		previous = &change
+
+		// The state is empty until the validation request is processed.
+		if change.State != "" && change.State != ValidationStateInProgressConst {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			err = fmt.Errorf("error waiting for the validation of version '%s': %w", versionLocator, ctx.Err())
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
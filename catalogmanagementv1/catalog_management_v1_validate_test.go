@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/IBM/platform-services-go-sdk/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CatalogManagementV1 OfferingValidator`, func() {
+	var testServer *httptest.Server
+	var validator *catalogmanagementv1.OfferingValidator
+	var requests []string
+	var installBody map[string]interface{}
+	var statuses []string
+	BeforeEach(func() {
+		requests = nil
+		installBody = nil
+		statuses = []string{
+			`{"state": ""}`,
+			`{"state": "in_progress", "last_operation": "submit_deployment"}`,
+			`{"state": "in_progress", "last_operation": "submit_deployment"}`,
+			`{"state": "in_progress", "last_operation": "install_offering"}`,
+			`{"state": "valid", "last_operation": "install_offering"}`,
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			request := req.Method + " " + req.URL.EscapedPath()
+			requests = append(requests, request)
+			Expect(req.Header.Get("X-Auth-Refresh-Token")).To(Equal("refresh"))
+			res.Header().Set("Content-type", "application/json")
+			switch request {
+			case "POST /versions/cat.v1/validation/install":
+				Expect(json.NewDecoder(req.Body).Decode(&installBody)).To(Succeed())
+				res.WriteHeader(202)
+			case "GET /versions/cat.v1/validation/install":
+				status := statuses[0]
+				if len(statuses) > 1 {
+					statuses = statuses[1:]
+				}
+				fmt.Fprint(res, status)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			}
+		}))
+		catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(catalogManagementService.Service)
+		validator = catalogmanagementv1.NewOfferingValidator(catalogManagementService, "refresh")
+		validator.PollInterval = time.Millisecond
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	target := &catalogmanagementv1.ValidationTarget{ClusterID: "cluster", Region: "us-south", Namespace: "default"}
+
+	It(`Validates a version and records the status changes`, func() {
+		var streamed []string
+		validator.OnStatusChange = func(change catalogmanagementv1.ValidationStatusChange) {
+			streamed = append(streamed, change.State+"/"+change.LastOperation)
+		}
+		result, err := validator.ValidateOfferingVersion(context.Background(), "cat.v1", target)
+		Expect(err).To(BeNil())
+		Expect(result.Passed).To(BeTrue())
+		Expect(result.Failure).To(BeNil())
+		Expect(result.WorkflowID).ToNot(BeEmpty())
+		Expect(installBody).To(Equal(map[string]interface{}{"cluster_id": "cluster", "region": "us-south", "namespace": "default"}))
+		Expect(requests).To(HaveLen(6))
+		Expect(result.Log).To(HaveLen(4))
+		Expect(streamed).To(Equal([]string{"/", "in_progress/submit_deployment", "in_progress/install_offering", "valid/install_offering"}))
+	})
+	It(`Returns the details of a failed validation`, func() {
+		statuses = []string{`{"state": "invalid", "last_operation": "install_offering", "message": "pods did not start",
+			"target": {"cluster_id": "cluster"}}`}
+		result, err := validator.ValidateOfferingVersion(context.Background(), "cat.v1", target)
+		Expect(err).To(BeNil())
+		Expect(result.Passed).To(BeFalse())
+		Expect(result.Failure.State).To(Equal(catalogmanagementv1.ValidationStateInvalidConst))
+		Expect(result.Failure.Target).To(HaveKeyWithValue("cluster_id", "cluster"))
+		Expect(result.Failure.Error()).To(Equal("validation is invalid after operation 'install_offering': pods did not start"))
+	})
+	It(`Times out with the status so far`, func() {
+		statuses = []string{`{"state": "in_progress", "last_operation": "submit_deployment"}`}
+		validator.Timeout = 20 * time.Millisecond
+		result, err := validator.ValidateOfferingVersion(context.Background(), "cat.v1", target)
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(result.Passed).To(BeFalse())
+		Expect(result.Failure).To(BeNil())
+		Expect(*result.Validation.State).To(Equal(catalogmanagementv1.ValidationStateInProgressConst))
+		Expect(result.Log).To(HaveLen(1))
+	})
+	It(`Returns an error if the validation cannot be started`, func() {
+		_, err := validator.ValidateOfferingVersion(context.Background(), "cat.missing", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("error starting the validation of version 'cat.missing'"))
+
+		validator.XAuthRefreshToken = ""
+		_, err = validator.ValidateOfferingVersion(context.Background(), "cat.v1", target)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,148 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// AccountContext : The account that a tool works in. Carried by a context (see WithAccountContext), it fills in the
+// account ID of the options of any service (see Apply) and of the composite helpers that take an account ID, and it
+// rejects options that name another account, so that a tool working in several accounts cannot send a request built
+// for one account with the context of another.
+type AccountContext struct {
+	// The ID of the account.
+	AccountID string
+
+	// The ID of the enterprise that the account belongs to, if any.
+	EnterpriseID string
+
+	// The ID of the resource group used when options do not specify one.
+	ResourceGroupID string
+}
+
+// AccountMismatchError : The error returned when options or arguments name an account (or enterprise) other than the
+// one of the AccountContext.
+type AccountMismatchError struct {
+	// The name of the field or argument, for example "AccountID".
+	Field string
+
+	// The value required by the AccountContext.
+	Expected string
+
+	// The value found.
+	Actual string
+}
+
+// Error returns a description of the mismatch.
+func (e *AccountMismatchError) Error() string {
+	return fmt.Sprintf("%s '%s' does not match the account context, which requires '%s'", e.Field, e.Actual, e.Expected)
+}
+
+type accountContextKey struct{}
+
+// WithAccountContext returns a copy of "ctx" that carries the specified account context.
+func WithAccountContext(ctx context.Context, accountContext *AccountContext) context.Context {
+	return context.WithValue(ctx, accountContextKey{}, accountContext)
+}
+
+// GetAccountContext returns the account context carried by "ctx", or nil.
+func GetAccountContext(ctx context.Context) *AccountContext {
+	accountContext, _ := ctx.Value(accountContextKey{}).(*AccountContext)
+	return accountContext
+}
+
+// Apply fills in the AccountID, EnterpriseID and ResourceGroupID fields of "options" (a pointer to the options struct
+// of an operation, whose fields may be strings or string pointers) that are unset or empty. An AccountID or
+// EnterpriseID field that is already set to another value is an error (*AccountMismatchError); a ResourceGroupID field
+// that is already set is kept, since the resource group of the account context is only a default. Fields that the
+// options do not have, and fields of the account context that are empty, are ignored.
+func (accountContext *AccountContext) Apply(options interface{}) error {
+	value := reflect.ValueOf(options)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("options must be a non-nil pointer to a struct, not %T", options)
+	}
+	fields := []struct {
+		name    string
+		value   string
+		enforce bool
+	}{
+		{"AccountID", accountContext.AccountID, true},
+		{"EnterpriseID", accountContext.EnterpriseID, true},
+		{"ResourceGroupID", accountContext.ResourceGroupID, false},
+	}
+	for _, field := range fields {
+		target := value.Elem().FieldByName(field.name)
+		if field.value == "" || !target.IsValid() || !target.CanSet() {
+			continue
+		}
+		var current string
+		switch {
+		case target.Kind() == reflect.String:
+			current = target.String()
+		case target.Kind() == reflect.Ptr && target.Type().Elem().Kind() == reflect.String:
+			if !target.IsNil() {
+				current = target.Elem().String()
+			}
+		default:
+			continue
+		}
+
+		if current != "" {
+			if field.enforce && current != field.value {
+				return &AccountMismatchError{Field: field.name, Expected: field.value, Actual: current}
+			}
+			continue
+		}
+		if target.Kind() == reflect.String {
+			target.SetString(field.value)
+		} else {
+			fieldValue := field.value
+			target.Set(reflect.ValueOf(&fieldValue))
+		}
+	}
+	return nil
+}
+
+// ApplyAccountContext applies the account context carried by "ctx", if any, to "options" (see AccountContext.Apply).
+// Composite helpers that take the options of an operation call it before sending the request.
+func ApplyAccountContext(ctx context.Context, options interface{}) error {
+	accountContext := GetAccountContext(ctx)
+	if accountContext == nil {
+		return nil
+	}
+	return accountContext.Apply(options)
+}
+
+// ResolveAccountID returns the account ID that a composite helper called with "ctx" and "accountID" works in: the
+// account ID of the account context carried by "ctx" if "accountID" is empty, and "accountID" otherwise. An account
+// ID that does not match the account context is an error (*AccountMismatchError).
+func ResolveAccountID(ctx context.Context, accountID string) (string, error) {
+	accountContext := GetAccountContext(ctx)
+	if accountContext == nil || accountContext.AccountID == "" {
+		return accountID, nil
+	}
+	if accountID == "" {
+		return accountContext.AccountID, nil
+	}
+	if accountID != accountContext.AccountID {
+		return "", &AccountMismatchError{Field: "accountID", Expected: accountContext.AccountID, Actual: accountID}
+	}
+	return accountID, nil
+}
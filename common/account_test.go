@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+type accountTestOptions struct {
+	AccountID       *string
+	EnterpriseID    string
+	ResourceGroupID *string
+	Name            *string
+}
+
+func TestAccountContextApply(t *testing.T) {
+	accountContext := &AccountContext{AccountID: "acct-1", EnterpriseID: "ent-1", ResourceGroupID: "rg-1"}
+
+	options := &accountTestOptions{AccountID: core.StringPtr(""), Name: core.StringPtr("name")}
+	assert.Nil(t, accountContext.Apply(options))
+	assert.Equal(t, "acct-1", *options.AccountID)
+	assert.Equal(t, "ent-1", options.EnterpriseID)
+	assert.Equal(t, "rg-1", *options.ResourceGroupID)
+	assert.Equal(t, "name", *options.Name)
+
+	options = &accountTestOptions{AccountID: core.StringPtr("acct-1"), ResourceGroupID: core.StringPtr("rg-2")}
+	assert.Nil(t, accountContext.Apply(options))
+	assert.Equal(t, "rg-2", *options.ResourceGroupID)
+
+	err := accountContext.Apply(&accountTestOptions{AccountID: core.StringPtr("acct-2")})
+	var mismatchErr *AccountMismatchError
+	assert.True(t, errors.As(err, &mismatchErr))
+	assert.Equal(t, "AccountID 'acct-2' does not match the account context, which requires 'acct-1'", err.Error())
+	err = accountContext.Apply(&accountTestOptions{EnterpriseID: "ent-2"})
+	assert.Equal(t, &AccountMismatchError{Field: "EnterpriseID", Expected: "ent-1", Actual: "ent-2"}, err)
+
+	// Empty fields of the account context are ignored.
+	options = &accountTestOptions{AccountID: core.StringPtr("acct-2")}
+	assert.Nil(t, (&AccountContext{ResourceGroupID: "rg-1"}).Apply(options))
+	assert.Equal(t, "acct-2", *options.AccountID)
+
+	assert.NotNil(t, accountContext.Apply(nil))
+	assert.NotNil(t, accountContext.Apply(accountTestOptions{}))
+	assert.NotNil(t, accountContext.Apply(core.StringPtr("acct-1")))
+}
+
+func TestAccountContextFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, GetAccountContext(ctx))
+	options := &accountTestOptions{}
+	assert.Nil(t, ApplyAccountContext(ctx, options))
+	assert.Nil(t, options.AccountID)
+	accountID, err := ResolveAccountID(ctx, "acct-2")
+	assert.Nil(t, err)
+	assert.Equal(t, "acct-2", accountID)
+
+	accountContext := &AccountContext{AccountID: "acct-1"}
+	ctx = WithAccountContext(ctx, accountContext)
+	assert.Equal(t, accountContext, GetAccountContext(ctx))
+	assert.Nil(t, ApplyAccountContext(ctx, options))
+	assert.Equal(t, "acct-1", *options.AccountID)
+
+	accountID, err = ResolveAccountID(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "acct-1", accountID)
+	accountID, err = ResolveAccountID(ctx, "acct-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "acct-1", accountID)
+	_, err = ResolveAccountID(ctx, "acct-2")
+	assert.Equal(t, &AccountMismatchError{Field: "accountID", Expected: "acct-1", Actual: "acct-2"}, err)
+}
@@ -0,0 +1,85 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// PartialError : The error returned by a bulk helper (one that processes many items, such as resources to tag or
+// accounts to import) whose context is done before all of its items have been processed. The helper still returns
+// its results: the outcome of the items that were processed is kept, and the documentation of each helper describes
+// how the items that were not processed are recorded. Callers can detect it with errors.As, and errors.Is matches the
+// error of the context.
+type PartialError struct {
+	// The number of items whose processing was started. Items in flight when the context was done are included.
+	Processed int
+
+	// The total number of items.
+	Total int
+
+	// The error of the context.
+	Err error
+}
+
+// Error returns a description of the interruption.
+func (e *PartialError) Error() string {
+	return fmt.Sprintf("interrupted after processing %d of %d items: %s", e.Processed, e.Total, e.Err.Error())
+}
+
+// Unwrap returns the error of the context.
+func (e *PartialError) Unwrap() error {
+	return e.Err
+}
+
+// ForEachConcurrently calls "task" for each index from 0 to count-1, with up to "concurrency" calls running
+// concurrently (one at a time if "concurrency" is not positive). Indexes are started in ascending order. Once the
+// context is done no further index is started; the calls in flight are waited for, and a *PartialError whose
+// Processed field is the number of indexes started (so the indexes from Processed onwards were not) is returned.
+// Tasks record their outcome in the results of the calling helper, each writing only the result of its own index.
+func ForEachConcurrently(ctx context.Context, count int, concurrency int, task func(ctx context.Context, i int)) error {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	var waitGroup sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	started := 0
+	for ; started < count; started++ {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		// Both cases may be ready at once, so the context is checked whichever was selected.
+		if ctx.Err() != nil {
+			break
+		}
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+			defer func() { <-semaphore }()
+			task(ctx, i)
+		}(started)
+	}
+	waitGroup.Wait()
+
+	if ctx.Err() != nil {
+		return &PartialError{Processed: started, Total: count, Err: ctx.Err()}
+	}
+	return nil
+}
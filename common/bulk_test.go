@@ -0,0 +1,79 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	var mutex sync.Mutex
+	active, maxActive := 0, 0
+	done := make([]bool, 10)
+	err := ForEachConcurrently(context.Background(), len(done), 3, func(ctx context.Context, i int) {
+		mutex.Lock()
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+		mutex.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mutex.Lock()
+		active--
+		mutex.Unlock()
+		done[i] = true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, maxActive)
+	for i := range done {
+		assert.True(t, done[i])
+	}
+
+	assert.Nil(t, ForEachConcurrently(context.Background(), 0, 0, func(ctx context.Context, i int) {
+		t.Fail()
+	}))
+}
+
+func TestForEachConcurrentlyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make([]bool, 10)
+	err := ForEachConcurrently(ctx, len(done), 0, func(ctx context.Context, i int) {
+		done[i] = true
+		if i == 3 {
+			cancel()
+		}
+	})
+
+	var partialErr *PartialError
+	assert.True(t, errors.As(err, &partialErr))
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, 4, partialErr.Processed)
+	assert.Equal(t, 10, partialErr.Total)
+	assert.Equal(t, "interrupted after processing 4 of 10 items: context canceled", err.Error())
+	assert.Equal(t, []bool{true, true, true, true, false, false, false, false, false, false}, done)
+
+	// The error is still detected once annotated with a workflow ID.
+	err = WrapWorkflowError("workflow", err)
+	assert.True(t, errors.As(err, &partialErr))
+}
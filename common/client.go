@@ -0,0 +1,66 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ClientOptions : The HTTP client settings shared by the services of an application, configured once and applied to
+// each service with Apply instead of calling EnableRetries, ConfigureTransport, EnableRateLimiting, EnableWorkflowIDs
+// and EnableOperationTimeouts on each of them.
+type ClientOptions struct {
+	// The network settings of the services. If nil, the transport of each service is left unchanged.
+	Transport *TransportConfig
+
+	// The rate limiters that apply to every request of each service, such as a limiter shared by all the services.
+	// The limiters of the external configuration are not added (see ConfigureRateLimiting).
+	RateLimiters []*RateLimiter
+
+	// The rate limiters of specific operations.
+	EndpointRateLimits []EndpointRateLimit
+
+	// If true, the services send the workflow ID of the context of their requests (see EnableWorkflowIDs).
+	WorkflowIDs bool
+
+	// The timeout and retry policies of the services: each service gets a copy of it, with its Policies, Rules and
+	// OnRetry hook (see EnableOperationTimeouts). Use the MaxRetryInterval, Jitter and MaxElapsed fields of the
+	// policies for adaptive retries that honor the Retry-After header. If nil, the timeout and retries of each service
+	// are left unchanged.
+	Retries *OperationTimeouts
+}
+
+// Apply configures the HTTP client of "service" with the options. The transports are installed so that each retry of
+// a request is rate limited and carries the workflow ID.
+func (options *ClientOptions) Apply(service *core.BaseService) (err error) {
+	if options.Transport != nil {
+		if err = ConfigureTransport(service, options.Transport); err != nil {
+			return
+		}
+	}
+	if len(options.RateLimiters) > 0 || len(options.EndpointRateLimits) > 0 {
+		EnableRateLimiting(service, options.RateLimiters, options.EndpointRateLimits...)
+	}
+	if options.WorkflowIDs {
+		EnableWorkflowIDs(service)
+	}
+	if options.Retries != nil {
+		timeouts := *options.Retries
+		EnableOperationTimeouts(service, &timeouts)
+	}
+	return
+}
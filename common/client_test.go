@@ -0,0 +1,82 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientOptions(t *testing.T) {
+	var workflowIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		workflowIDs = append(workflowIDs, req.Header.Get(WorkflowIDHeader))
+		if len(workflowIDs) == 1 {
+			res.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		res.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	retries := 0
+	options := &ClientOptions{
+		Transport:    &TransportConfig{NoProxy: []string{"*"}},
+		RateLimiters: []*RateLimiter{{MaxConcurrent: 1}},
+		WorkflowIDs:  true,
+		Retries: &OperationTimeouts{
+			Policies: map[OperationClass]OperationPolicy{
+				OperationClassFastRead: {MaxRetries: 1, RetryInterval: time.Millisecond, Jitter: 0.5},
+			},
+			OnRetry: func(event RetryEvent) { retries++ },
+		},
+	}
+	var services []*core.BaseService
+	for i := 0; i < 2; i++ {
+		service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+		assert.Nil(t, err)
+		assert.Nil(t, options.Apply(service))
+		services = append(services, service)
+	}
+
+	// Each service has its own copy of the retries, wrapping the workflow ID and rate limiting transports.
+	timeouts := services[0].GetHTTPClient().Transport.(*OperationTimeouts)
+	assert.NotEqual(t, timeouts, services[1].GetHTTPClient().Transport)
+	workflow := timeouts.Transport.(*WorkflowTransport)
+	limiter := workflow.Transport.(*RateLimitTransport)
+	assert.Equal(t, options.RateLimiters[0], limiter.Limiters[0])
+	_, ok := limiter.Transport.(*http.Transport)
+	assert.True(t, ok)
+	assert.Nil(t, options.Retries.Transport)
+
+	req, err := http.NewRequestWithContext(WithWorkflowID(context.Background(), "wf-1"), http.MethodGet, server.URL, nil)
+	assert.Nil(t, err)
+	res, err := services[0].GetHTTPClient().Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, 200, res.StatusCode)
+	assert.Equal(t, []string{"wf-1", "wf-1"}, workflowIDs)
+	assert.Equal(t, 1, retries)
+
+	assert.NotNil(t, (&ClientOptions{Transport: &TransportConfig{ProxyURL: "::"}}).Apply(services[1]))
+}
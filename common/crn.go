@@ -0,0 +1,227 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Default values of the CRN segments for resources in the IBM public cloud.
+const (
+	CRNVersionConst     = "v1"
+	CRNCNamePublicConst = "bluemix"
+	CRNCTypePublicConst = "public"
+)
+
+const (
+	crnPrefix         = "crn"
+	crnSegmentCount   = 10
+	crnScopeSeparator = "/"
+)
+
+// Constants associated with the scope segment of a CRN, which is "<type>/<id>".
+const (
+	CRNScopeTypeAccountConst      = "a"
+	CRNScopeTypeOrganizationConst = "o"
+	CRNScopeTypeSpaceConst        = "s"
+	CRNScopeTypeProjectConst      = "p"
+)
+
+// CRN : A Cloud Resource Name, which identifies a resource in IBM Cloud. Its string form is
+// "crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource".
+// A CRN is immutable: use ParseCRN or NewCRN to create one, and the With methods to derive a modified copy.
+type CRN struct {
+	version         string
+	cname           string
+	ctype           string
+	serviceName     string
+	location        string
+	scope           string
+	serviceInstance string
+	resourceType    string
+	resource        string
+}
+
+// ParseCRN parses the string form of a CRN. Empty segments are allowed except for the version, cname, ctype and
+// service name. The resource segment is the remainder of the string, so it may contain colons.
+func ParseCRN(s string) (crn CRN, err error) {
+	segments := strings.SplitN(s, ":", crnSegmentCount)
+	if len(segments) != crnSegmentCount || segments[0] != crnPrefix {
+		err = fmt.Errorf("invalid CRN '%s': expected %d segments starting with '%s'", s, crnSegmentCount, crnPrefix)
+		return
+	}
+	parsed := CRN{
+		version:         segments[1],
+		cname:           segments[2],
+		ctype:           segments[3],
+		serviceName:     segments[4],
+		location:        segments[5],
+		scope:           segments[6],
+		serviceInstance: segments[7],
+		resourceType:    segments[8],
+		resource:        segments[9],
+	}
+	if parsed.version == "" || parsed.cname == "" || parsed.ctype == "" || parsed.serviceName == "" {
+		err = fmt.Errorf("invalid CRN '%s': the version, cname, ctype and service name are required", s)
+		return
+	}
+	if parsed.scope != "" && !strings.Contains(parsed.scope, crnScopeSeparator) {
+		err = fmt.Errorf("invalid CRN '%s': the scope must have the form '<type>/<id>'", s)
+		return
+	}
+	crn = parsed
+	return
+}
+
+// NewCRN returns the CRN of a service instance in the IBM public cloud. "scope" has the form "<type>/<id>" (see
+// AccountScope) and may be empty, as may "location" and "serviceInstance".
+func NewCRN(serviceName string, location string, scope string, serviceInstance string) CRN {
+	return CRN{
+		version:         CRNVersionConst,
+		cname:           CRNCNamePublicConst,
+		ctype:           CRNCTypePublicConst,
+		serviceName:     serviceName,
+		location:        location,
+		scope:           scope,
+		serviceInstance: serviceInstance,
+	}
+}
+
+// AccountScope returns the scope segment of a CRN for resources owned by the specified account.
+func AccountScope(accountID string) string {
+	return CRNScopeTypeAccountConst + crnScopeSeparator + accountID
+}
+
+// WithCloud returns a copy of the CRN in the specified cloud (e.g. a dedicated or local environment).
+func (crn CRN) WithCloud(cname string, ctype string) CRN {
+	crn.cname = cname
+	crn.ctype = ctype
+	return crn
+}
+
+// WithResource returns a copy of the CRN that identifies a resource within the service instance.
+func (crn CRN) WithResource(resourceType string, resource string) CRN {
+	crn.resourceType = resourceType
+	crn.resource = resource
+	return crn
+}
+
+// Version returns the version of the CRN format.
+func (crn CRN) Version() string {
+	return crn.version
+}
+
+// CName returns the name of the cloud environment (e.g. "bluemix").
+func (crn CRN) CName() string {
+	return crn.cname
+}
+
+// CType returns the type of the cloud environment (e.g. "public").
+func (crn CRN) CType() string {
+	return crn.ctype
+}
+
+// ServiceName returns the name of the service that owns the resource (e.g. "cloud-object-storage").
+func (crn CRN) ServiceName() string {
+	return crn.serviceName
+}
+
+// Region returns the location of the resource: a region (e.g. "us-south"), a zone, or "global". It is empty for
+// resources that have no location.
+func (crn CRN) Region() string {
+	return crn.location
+}
+
+// Scope returns the owner of the resource in the form "<type>/<id>" (e.g. "a/<account ID>").
+func (crn CRN) Scope() string {
+	return crn.scope
+}
+
+// ScopeType returns the type of the scope (e.g. CRNScopeTypeAccountConst), or "" if the CRN has no scope.
+func (crn CRN) ScopeType() string {
+	scopeType, _ := crn.splitScope()
+	return scopeType
+}
+
+// ScopeID returns the ID of the scope (e.g. an account ID), or "" if the CRN has no scope.
+func (crn CRN) ScopeID() string {
+	_, scopeID := crn.splitScope()
+	return scopeID
+}
+
+// AccountID returns the ID of the account that owns the resource, or "" if the resource is not scoped to an account.
+func (crn CRN) AccountID() string {
+	scopeType, scopeID := crn.splitScope()
+	if scopeType != CRNScopeTypeAccountConst {
+		return ""
+	}
+	return scopeID
+}
+
+func (crn CRN) splitScope() (scopeType string, scopeID string) {
+	parts := strings.SplitN(crn.scope, crnScopeSeparator, 2)
+	if len(parts) != 2 {
+		return
+	}
+	return parts[0], parts[1]
+}
+
+// ServiceInstance returns the ID of the service instance, or "" if the CRN identifies a service-level resource.
+func (crn CRN) ServiceInstance() string {
+	return crn.serviceInstance
+}
+
+// ResourceType returns the type of the resource within the service instance, or "".
+func (crn CRN) ResourceType() string {
+	return crn.resourceType
+}
+
+// Resource returns the ID of the resource within the service instance, or "".
+func (crn CRN) Resource() string {
+	return crn.resource
+}
+
+// IsZero returns true if the CRN has not been set.
+func (crn CRN) IsZero() bool {
+	return crn == CRN{}
+}
+
+// String returns the string form of the CRN, which is accepted wherever a service expects a CRN.
+func (crn CRN) String() string {
+	return strings.Join([]string{crnPrefix, crn.version, crn.cname, crn.ctype, crn.serviceName, crn.location,
+		crn.scope, crn.serviceInstance, crn.resourceType, crn.resource}, ":")
+}
+
+// MarshalText returns the string form of the CRN, so that CRNs are encoded as strings in JSON. A zero CRN is encoded
+// as an empty string.
+func (crn CRN) MarshalText() ([]byte, error) {
+	if crn.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(crn.String()), nil
+}
+
+// UnmarshalText parses the string form of a CRN. An empty string is decoded as a zero CRN.
+func (crn *CRN) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*crn = CRN{}
+		return
+	}
+	*crn, err = ParseCRN(string(text))
+	return
+}
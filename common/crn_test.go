@@ -0,0 +1,81 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCRN(t *testing.T) {
+	crn, err := ParseCRN("crn:v1:bluemix:public:cloud-object-storage:global:a/acct1:inst1:bucket:my:bucket")
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", crn.Version())
+	assert.Equal(t, "bluemix", crn.CName())
+	assert.Equal(t, "public", crn.CType())
+	assert.Equal(t, "cloud-object-storage", crn.ServiceName())
+	assert.Equal(t, "global", crn.Region())
+	assert.Equal(t, "a/acct1", crn.Scope())
+	assert.Equal(t, "a", crn.ScopeType())
+	assert.Equal(t, "acct1", crn.ScopeID())
+	assert.Equal(t, "acct1", crn.AccountID())
+	assert.Equal(t, "inst1", crn.ServiceInstance())
+	assert.Equal(t, "bucket", crn.ResourceType())
+	assert.Equal(t, "my:bucket", crn.Resource())
+	assert.Equal(t, "crn:v1:bluemix:public:cloud-object-storage:global:a/acct1:inst1:bucket:my:bucket", crn.String())
+
+	crn, err = ParseCRN("crn:v1:bluemix:public:iam::::serviceRole:Writer")
+	assert.Nil(t, err)
+	assert.Equal(t, "", crn.AccountID())
+	assert.Equal(t, "Writer", crn.Resource())
+
+	for _, invalid := range []string{"", "crn:v1:bluemix:public:iam", "urn:v1:bluemix:public:iam::::x:y",
+		"crn:v1:bluemix:public:::::x:y", "crn:v1:bluemix:public:iam::acct1:::"} {
+		_, err = ParseCRN(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestNewCRN(t *testing.T) {
+	crn := NewCRN("kms", "us-south", AccountScope("acct1"), "inst1").WithResource("key", "k1")
+	assert.Equal(t, "crn:v1:bluemix:public:kms:us-south:a/acct1:inst1:key:k1", crn.String())
+	assert.Equal(t, "crn:v1:staging:public:kms:us-south:a/acct1:inst1::", NewCRN("kms", "us-south", AccountScope("acct1"), "inst1").WithCloud("staging", "public").String())
+	assert.True(t, CRN{}.IsZero())
+	assert.False(t, crn.IsZero())
+
+	parsed, err := ParseCRN(crn.String())
+	assert.Nil(t, err)
+	assert.Equal(t, crn, parsed)
+}
+
+func TestCRNJSON(t *testing.T) {
+	type model struct {
+		Source CRN `json:"source"`
+		Target CRN `json:"target"`
+	}
+	data, err := json.Marshal(model{Source: NewCRN("kms", "us-south", AccountScope("acct1"), "inst1")})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"source":"crn:v1:bluemix:public:kms:us-south:a/acct1:inst1::","target":""}`, string(data))
+
+	var decoded model
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "inst1", decoded.Source.ServiceInstance())
+	assert.True(t, decoded.Target.IsZero())
+	assert.NotNil(t, json.Unmarshal([]byte(`{"source":"not-a-crn"}`), &decoded))
+}
@@ -0,0 +1,224 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Formats supported by NewTableWriter.
+const (
+	ExportFormatCSV     = "csv"
+	ExportFormatNDJSON  = "ndjson"
+	ExportFormatParquet = "parquet"
+)
+
+// Column types supported by NewTableWriter.
+const (
+	ColumnTypeString = "string"
+	ColumnTypeDouble = "double"
+	ColumnTypeInt64  = "int64"
+)
+
+// Column describes one column of a table written by a TableWriter.
+type Column struct {
+	Name string
+	Type string
+}
+
+// TableWriter writes rows with a fixed set of columns to an underlying io.Writer.
+//
+// Each value passed to WriteRow must be nil (a missing value) or match the type of its column:
+// a string or *string for ColumnTypeString, a float64 or *float64 for ColumnTypeDouble, and
+// an int64 or *int64 for ColumnTypeInt64.
+// Close must be called to flush buffered rows; it does not close the underlying io.Writer.
+type TableWriter interface {
+	WriteRow(values ...interface{}) error
+	Close() error
+}
+
+// TableFlusher is implemented by the TableWriters of the streaming formats (CSV and NDJSON). Flush writes the rows
+// buffered so far to the underlying io.Writer, for example before the progress of an export is checkpointed.
+type TableFlusher interface {
+	Flush() error
+}
+
+// NewTableWriter returns a TableWriter that writes rows in the specified format ("csv", "ndjson" or "parquet").
+// CSV output starts with a header row containing the column names.
+func NewTableWriter(w io.Writer, format string, columns []Column) (TableWriter, error) {
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("at least one column is required")
+	}
+	for _, column := range columns {
+		switch column.Type {
+		case ColumnTypeString, ColumnTypeDouble, ColumnTypeInt64:
+		default:
+			return nil, fmt.Errorf("column '%s' has unsupported type '%s'", column.Name, column.Type)
+		}
+	}
+
+	switch format {
+	case ExportFormatCSV:
+		return newCSVTableWriter(w, columns)
+	case ExportFormatNDJSON:
+		return &ndjsonTableWriter{writer: w, columns: columns}, nil
+	case ExportFormatParquet:
+		return newParquetTableWriter(w, columns), nil
+	default:
+		return nil, fmt.Errorf("unsupported export format '%s'", format)
+	}
+}
+
+// normalizeRow checks that "values" matches "columns" and dereferences any pointer values.
+func normalizeRow(columns []Column, values []interface{}) ([]interface{}, error) {
+	if len(values) != len(columns) {
+		return nil, fmt.Errorf("expected %d values but got %d", len(columns), len(values))
+	}
+	row := make([]interface{}, len(values))
+	for i, value := range values {
+		switch v := value.(type) {
+		case *string:
+			if v != nil {
+				value = *v
+			} else {
+				value = nil
+			}
+		case *float64:
+			if v != nil {
+				value = *v
+			} else {
+				value = nil
+			}
+		case *int64:
+			if v != nil {
+				value = *v
+			} else {
+				value = nil
+			}
+		}
+
+		ok := value == nil
+		switch value.(type) {
+		case string:
+			ok = columns[i].Type == ColumnTypeString
+		case float64:
+			ok = columns[i].Type == ColumnTypeDouble
+		case int64:
+			ok = columns[i].Type == ColumnTypeInt64
+		}
+		if !ok {
+			return nil, fmt.Errorf("value of type %T is not valid for %s column '%s'", value, columns[i].Type, columns[i].Name)
+		}
+		row[i] = value
+	}
+	return row, nil
+}
+
+type csvTableWriter struct {
+	writer  *csv.Writer
+	columns []Column
+}
+
+func newCSVTableWriter(w io.Writer, columns []Column) (*csvTableWriter, error) {
+	writer := csv.NewWriter(w)
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.Name
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+	return &csvTableWriter{writer: writer, columns: columns}, nil
+}
+
+func (tableWriter *csvTableWriter) WriteRow(values ...interface{}) error {
+	row, err := normalizeRow(tableWriter.columns, values)
+	if err != nil {
+		return err
+	}
+	record := make([]string, len(row))
+	for i, value := range row {
+		switch v := value.(type) {
+		case string:
+			record[i] = v
+		case float64:
+			record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		case int64:
+			record[i] = strconv.FormatInt(v, 10)
+		}
+	}
+	return tableWriter.writer.Write(record)
+}
+
+func (tableWriter *csvTableWriter) Flush() error {
+	tableWriter.writer.Flush()
+	return tableWriter.writer.Error()
+}
+
+func (tableWriter *csvTableWriter) Close() error {
+	return tableWriter.Flush()
+}
+
+type ndjsonTableWriter struct {
+	writer  io.Writer
+	columns []Column
+}
+
+// WriteRow writes the row as a JSON object with the keys in the order of the columns. Missing values are omitted.
+func (tableWriter *ndjsonTableWriter) WriteRow(values ...interface{}) error {
+	row, err := normalizeRow(tableWriter.columns, values)
+	if err != nil {
+		return err
+	}
+	line := bytes.NewBufferString("{")
+	for i, value := range row {
+		if value == nil {
+			continue
+		}
+		name, err := json.Marshal(tableWriter.columns[i].Name)
+		if err != nil {
+			return err
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if line.Len() > 1 {
+			line.WriteByte(',')
+		}
+		line.Write(name)
+		line.WriteByte(':')
+		line.Write(encoded)
+	}
+	line.WriteString("}\n")
+	_, err = tableWriter.writer.Write(line.Bytes())
+	return err
+}
+
+// Flush does nothing: every row is written to the underlying io.Writer as soon as it is encoded.
+func (tableWriter *ndjsonTableWriter) Flush() error {
+	return nil
+}
+
+func (tableWriter *ndjsonTableWriter) Close() error {
+	return nil
+}
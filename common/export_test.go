@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testColumns = []Column{
+	{Name: "name", Type: ColumnTypeString},
+	{Name: "cost", Type: ColumnTypeDouble},
+	{Name: "count", Type: ColumnTypeInt64},
+}
+
+func TestNewTableWriterErrors(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	_, err := NewTableWriter(buffer, ExportFormatCSV, nil)
+	assert.NotNil(t, err)
+	_, err = NewTableWriter(buffer, ExportFormatCSV, []Column{{Name: "flag", Type: "boolean"}})
+	assert.NotNil(t, err)
+	_, err = NewTableWriter(buffer, "xlsx", testColumns)
+	assert.NotNil(t, err)
+}
+
+func TestCSVTableWriter(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	writer, err := NewTableWriter(buffer, ExportFormatCSV, testColumns)
+	assert.Nil(t, err)
+
+	name := "b,c"
+	assert.Nil(t, writer.WriteRow("a", 1.5, int64(2)))
+	assert.Empty(t, buffer.String())
+	assert.Nil(t, writer.(TableFlusher).Flush())
+	assert.Equal(t, "name,cost,count\na,1.5,2\n", buffer.String())
+	assert.Nil(t, writer.WriteRow(&name, nil, (*int64)(nil)))
+	assert.NotNil(t, writer.WriteRow("a", 1.5))
+	assert.NotNil(t, writer.WriteRow("a", "1.5", int64(2)))
+	assert.Nil(t, writer.Close())
+	assert.Equal(t, "name,cost,count\na,1.5,2\n\"b,c\",,\n", buffer.String())
+}
+
+func TestNDJSONTableWriter(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	writer, err := NewTableWriter(buffer, ExportFormatNDJSON, testColumns)
+	assert.Nil(t, err)
+
+	assert.Nil(t, writer.WriteRow("a", 1.5, int64(2)))
+	assert.Nil(t, writer.WriteRow("b", nil, nil))
+	assert.Nil(t, writer.WriteRow(nil, nil, int64(3)))
+	assert.Nil(t, writer.Close())
+	// The keys are written in the order of the columns, not sorted.
+	assert.Equal(t, "{\"name\":\"a\",\"cost\":1.5,\"count\":2}\n{\"name\":\"b\"}\n{\"count\":3}\n", buffer.String())
+}
+
+func TestParquetTableWriter(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	writer, err := NewTableWriter(buffer, ExportFormatParquet, testColumns)
+	assert.Nil(t, err)
+
+	for i := 0; i < parquetRowGroupSize+5; i++ {
+		assert.Nil(t, writer.WriteRow("row", float64(i), nil))
+	}
+	assert.Nil(t, writer.Close())
+
+	output := buffer.Bytes()
+	assert.Equal(t, parquetMagic, string(output[:4]))
+	assert.Equal(t, parquetMagic, string(output[len(output)-4:]))
+	footerLength := int(binary.LittleEndian.Uint32(output[len(output)-8 : len(output)-4]))
+	assert.True(t, footerLength > 0 && footerLength < len(output)-12)
+
+	parquetWriter := writer.(*parquetTableWriter)
+	assert.Len(t, parquetWriter.rowGroups, 2)
+	assert.Equal(t, int64(parquetRowGroupSize), parquetWriter.rowGroups[0].numRows)
+	assert.Equal(t, int64(5), parquetWriter.rowGroups[1].numRows)
+	assert.Equal(t, int64(4), parquetWriter.rowGroups[0].columns[0].offset)
+}
+
+func TestParquetTableWriterEmpty(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	writer, err := NewTableWriter(buffer, ExportFormatParquet, testColumns)
+	assert.Nil(t, err)
+	assert.Nil(t, writer.Close())
+	assert.Equal(t, parquetMagic, buffer.String()[:4])
+	assert.Equal(t, parquetMagic, buffer.String()[buffer.Len()-4:])
+}
+
+func TestThriftCompactEncoder(t *testing.T) {
+	encoder := newThriftCompactEncoder()
+	encoder.fieldI32(1, 1)
+	encoder.fieldI64(20, -1)
+	encoder.fieldBinary(21, "ab")
+	encoder.endStruct()
+	// field 1 (i32, delta 1) = zigzag(1); field 20 (long form) = zigzag(-1); field 21 (binary, delta 1); stop
+	assert.Equal(t, []byte{0x15, 0x02, 0x06, 0x28, 0x01, 0x18, 0x02, 'a', 'b', 0x00}, encoder.buffer.Bytes())
+}
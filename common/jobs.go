@@ -0,0 +1,266 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpointer persists the progress of a long-running job so that it can be resumed after a restart.
+// A checkpoint is an opaque string, typically the pagination cursor of the next page to be processed.
+type Checkpointer interface {
+	// LoadCheckpoint returns the last checkpoint saved for the job, or found=false if there is none.
+	LoadCheckpoint(ctx context.Context, jobID string) (checkpoint string, found bool, err error)
+
+	// SaveCheckpoint records the progress of the job.
+	SaveCheckpoint(ctx context.Context, jobID string, checkpoint string) error
+
+	// ClearCheckpoint removes the checkpoint of a job that has completed.
+	ClearCheckpoint(ctx context.Context, jobID string) error
+}
+
+// MemoryCheckpointer is a Checkpointer that keeps checkpoints in memory.
+// It allows a job to be retried within a process, but checkpoints do not survive a restart.
+type MemoryCheckpointer struct {
+	mutex       sync.Mutex
+	checkpoints map[string]string
+}
+
+// NewMemoryCheckpointer returns a new, empty MemoryCheckpointer.
+func NewMemoryCheckpointer() *MemoryCheckpointer {
+	return &MemoryCheckpointer{checkpoints: make(map[string]string)}
+}
+
+// LoadCheckpoint returns the last checkpoint saved for the job.
+func (checkpointer *MemoryCheckpointer) LoadCheckpoint(ctx context.Context, jobID string) (string, bool, error) {
+	checkpointer.mutex.Lock()
+	defer checkpointer.mutex.Unlock()
+	checkpoint, found := checkpointer.checkpoints[jobID]
+	return checkpoint, found, nil
+}
+
+// SaveCheckpoint records the progress of the job.
+func (checkpointer *MemoryCheckpointer) SaveCheckpoint(ctx context.Context, jobID string, checkpoint string) error {
+	checkpointer.mutex.Lock()
+	defer checkpointer.mutex.Unlock()
+	checkpointer.checkpoints[jobID] = checkpoint
+	return nil
+}
+
+// ClearCheckpoint removes the checkpoint of the job.
+func (checkpointer *MemoryCheckpointer) ClearCheckpoint(ctx context.Context, jobID string) error {
+	checkpointer.mutex.Lock()
+	defer checkpointer.mutex.Unlock()
+	delete(checkpointer.checkpoints, jobID)
+	return nil
+}
+
+// FileCheckpointer is a Checkpointer that stores each job's checkpoint in a file named after the job ID
+// within a directory.
+type FileCheckpointer struct {
+	Dir string
+}
+
+// NewFileCheckpointer returns a new FileCheckpointer that stores checkpoints in "dir", creating it if necessary.
+func NewFileCheckpointer(dir string) (*FileCheckpointer, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileCheckpointer{Dir: dir}, nil
+}
+
+// LoadCheckpoint returns the last checkpoint saved for the job.
+func (checkpointer *FileCheckpointer) LoadCheckpoint(ctx context.Context, jobID string) (string, bool, error) {
+	data, err := ioutil.ReadFile(checkpointer.path(jobID))
+	if os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return string(data), true, nil
+}
+
+// SaveCheckpoint records the progress of the job. The checkpoint file is replaced atomically.
+func (checkpointer *FileCheckpointer) SaveCheckpoint(ctx context.Context, jobID string, checkpoint string) error {
+	tmpFile := checkpointer.path(jobID) + ".tmp"
+	if err := ioutil.WriteFile(tmpFile, []byte(checkpoint), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, checkpointer.path(jobID))
+}
+
+// ClearCheckpoint removes the checkpoint file of the job.
+func (checkpointer *FileCheckpointer) ClearCheckpoint(ctx context.Context, jobID string) error {
+	err := os.Remove(checkpointer.path(jobID))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (checkpointer *FileCheckpointer) path(jobID string) string {
+	return filepath.Join(checkpointer.Dir, filepath.Base(jobID)+".checkpoint")
+}
+
+// Limiter limits the rate at which a job issues requests. It is implemented by RateLimiter.
+type Limiter interface {
+	// Wait blocks until the next request may be issued or the context is done.
+	Wait(ctx context.Context) error
+}
+
+// JobProgress describes the progress of a Job. It is passed to the job's OnProgress callback after each page.
+type JobProgress struct {
+	// The ID of the job.
+	JobID string
+
+	// The number of pages processed by this run of the job.
+	Pages int64
+
+	// The number of items processed by this run of the job.
+	Items int64
+
+	// The checkpoint from which the job will continue.
+	Checkpoint string
+
+	// Whether this run resumed from a previously saved checkpoint.
+	Resumed bool
+
+	// Whether all pages have been processed.
+	Done bool
+}
+
+// PageFetcher retrieves the page of items that starts at "cursor" (the empty string for the first page) and returns
+// the cursor of the next page, or the empty string if it is the last page.
+// Service pagers can be adapted to a PageFetcher by passing the cursor as the "start" (or equivalent) option.
+type PageFetcher func(ctx context.Context, cursor string) (items []interface{}, nextCursor string, err error)
+
+// ItemProcessor processes one item of a page.
+type ItemProcessor func(ctx context.Context, item interface{}) error
+
+// JobError is returned by Job.Run when a page cannot be fetched or an item cannot be processed.
+// The job's checkpoint still refers to the failed page, so running the job again resumes from there.
+type JobError struct {
+	JobID      string
+	Checkpoint string
+	Err        error
+}
+
+// Error returns a description of the failure.
+func (jobErr *JobError) Error() string {
+	return fmt.Sprintf("job '%s' failed at checkpoint '%s': %s", jobErr.JobID, jobErr.Checkpoint, jobErr.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (jobErr *JobError) Unwrap() error {
+	return jobErr.Err
+}
+
+// Job is a resumable iteration over all pages of a list operation.
+// After each page has been processed its cursor is saved through the Checkpointer, so a job that is interrupted
+// (by an error, a cancelled context, or a restart of the process) continues from the first unprocessed page when it
+// is run again with the same ID.
+// Items within a page are processed at least once; a page that was partially processed before an interruption is
+// processed again in full.
+type Job struct {
+	// The ID that identifies the job's checkpoint.
+	ID string
+
+	// Stores the job's checkpoints. If nil, the job cannot be resumed.
+	Checkpointer Checkpointer
+
+	// Limits the rate at which pages are fetched. If nil, pages are fetched without delay.
+	Limiter Limiter
+
+	// If set, called after the items of each page have been processed and before the checkpoint of the page is
+	// saved, for example to flush the output written by the ItemProcessor so that a checkpoint never refers to items
+	// that have not been written.
+	AfterPage func(ctx context.Context) error
+
+	// If set, called after each page has been processed.
+	OnProgress func(JobProgress)
+}
+
+// NewJob returns a new Job with the specified ID and checkpointer.
+func NewJob(id string, checkpointer Checkpointer) *Job {
+	return &Job{ID: id, Checkpointer: checkpointer}
+}
+
+// Run fetches every page with "fetch" and passes each item to "process", saving a checkpoint after each page.
+// When all pages have been processed the checkpoint is cleared.
+func (job *Job) Run(ctx context.Context, fetch PageFetcher, process ItemProcessor) error {
+	progress := JobProgress{JobID: job.ID}
+	if job.Checkpointer != nil {
+		checkpoint, found, err := job.Checkpointer.LoadCheckpoint(ctx, job.ID)
+		if err != nil {
+			return &JobError{JobID: job.ID, Err: fmt.Errorf("error loading the checkpoint: %w", err)}
+		}
+		progress.Checkpoint = checkpoint
+		progress.Resumed = found
+	}
+
+	for {
+		if job.Limiter != nil {
+			if err := job.Limiter.Wait(ctx); err != nil {
+				return &JobError{JobID: job.ID, Checkpoint: progress.Checkpoint, Err: err}
+			}
+		} else if err := ctx.Err(); err != nil {
+			return &JobError{JobID: job.ID, Checkpoint: progress.Checkpoint, Err: err}
+		}
+
+		items, nextCursor, err := fetch(ctx, progress.Checkpoint)
+		if err != nil {
+			return &JobError{JobID: job.ID, Checkpoint: progress.Checkpoint, Err: err}
+		}
+		for _, item := range items {
+			if err = process(ctx, item); err != nil {
+				return &JobError{JobID: job.ID, Checkpoint: progress.Checkpoint, Err: err}
+			}
+			progress.Items++
+		}
+		if job.AfterPage != nil {
+			if err = job.AfterPage(ctx); err != nil {
+				return &JobError{JobID: job.ID, Checkpoint: progress.Checkpoint, Err: err}
+			}
+		}
+		progress.Pages++
+		progress.Checkpoint = nextCursor
+		progress.Done = nextCursor == ""
+
+		if job.Checkpointer != nil {
+			if progress.Done {
+				err = job.Checkpointer.ClearCheckpoint(ctx, job.ID)
+			} else {
+				err = job.Checkpointer.SaveCheckpoint(ctx, job.ID, nextCursor)
+			}
+			if err != nil {
+				return &JobError{JobID: job.ID, Checkpoint: progress.Checkpoint, Err: fmt.Errorf("error saving the checkpoint: %w", err)}
+			}
+		}
+		if job.OnProgress != nil {
+			job.OnProgress(progress)
+		}
+		if progress.Done {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,149 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testPages returns a PageFetcher over three pages ("", "p2", "p3") of two items each.
+func testPages(fetched *[]string, failAt string) PageFetcher {
+	next := map[string]string{"": "p2", "p2": "p3", "p3": ""}
+	return func(ctx context.Context, cursor string) ([]interface{}, string, error) {
+		*fetched = append(*fetched, cursor)
+		if cursor == failAt {
+			return nil, "", errors.New("service unavailable")
+		}
+		return []interface{}{cursor + "-a", cursor + "-b"}, next[cursor], nil
+	}
+}
+
+func TestJobRunResumesFromCheckpoint(t *testing.T) {
+	checkpointer := NewMemoryCheckpointer()
+	job := NewJob("job1", checkpointer)
+
+	var fetched []string
+	var processed []interface{}
+	process := func(ctx context.Context, item interface{}) error {
+		processed = append(processed, item)
+		return nil
+	}
+
+	err := job.Run(context.Background(), testPages(&fetched, "p3"), process)
+	assert.NotNil(t, err)
+	var jobErr *JobError
+	assert.True(t, errors.As(err, &jobErr))
+	assert.Equal(t, "p3", jobErr.Checkpoint)
+	checkpoint, found, _ := checkpointer.LoadCheckpoint(context.Background(), "job1")
+	assert.True(t, found)
+	assert.Equal(t, "p3", checkpoint)
+
+	var progress []JobProgress
+	job.OnProgress = func(p JobProgress) { progress = append(progress, p) }
+	fetched = nil
+	err = job.Run(context.Background(), testPages(&fetched, "none"), process)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"p3"}, fetched)
+	assert.Equal(t, []interface{}{"-a", "-b", "p2-a", "p2-b", "p3-a", "p3-b"}, processed)
+	assert.Len(t, progress, 1)
+	assert.True(t, progress[0].Resumed)
+	assert.True(t, progress[0].Done)
+	assert.Equal(t, int64(2), progress[0].Items)
+
+	_, found, _ = checkpointer.LoadCheckpoint(context.Background(), "job1")
+	assert.False(t, found)
+}
+
+func TestJobRunProcessError(t *testing.T) {
+	job := NewJob("job2", NewMemoryCheckpointer())
+	var fetched []string
+	err := job.Run(context.Background(), testPages(&fetched, "none"), func(ctx context.Context, item interface{}) error {
+		if item == "p2-b" {
+			return fmt.Errorf("cannot process %v", item)
+		}
+		return nil
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "job 'job2' failed at checkpoint 'p2'")
+}
+
+func TestJobRunCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var fetched []string
+	err := NewJob("job3", nil).Run(ctx, testPages(&fetched, "none"), func(ctx context.Context, item interface{}) error { return nil })
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Empty(t, fetched)
+}
+
+func TestFileCheckpointer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoints")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	checkpointer, err := NewFileCheckpointer(dir)
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	_, found, err := checkpointer.LoadCheckpoint(ctx, "job")
+	assert.Nil(t, err)
+	assert.False(t, found)
+
+	assert.Nil(t, checkpointer.SaveCheckpoint(ctx, "job", "cursor-1"))
+	assert.Nil(t, checkpointer.SaveCheckpoint(ctx, "job", "cursor-2"))
+	checkpoint, found, err := checkpointer.LoadCheckpoint(ctx, "job")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "cursor-2", checkpoint)
+
+	assert.Nil(t, checkpointer.ClearCheckpoint(ctx, "job"))
+	assert.Nil(t, checkpointer.ClearCheckpoint(ctx, "job"))
+	_, found, _ = checkpointer.LoadCheckpoint(ctx, "job")
+	assert.False(t, found)
+}
+
+func TestJobRunAfterPage(t *testing.T) {
+	checkpointer := NewMemoryCheckpointer()
+	job := NewJob("job4", checkpointer)
+	var flushed []string
+	var pending []string
+	job.AfterPage = func(ctx context.Context) error {
+		if len(pending) > 0 && pending[0] == "p2-a" {
+			return errors.New("cannot flush")
+		}
+		flushed = append(flushed, pending...)
+		pending = nil
+		return nil
+	}
+	var fetched []string
+	err := job.Run(context.Background(), testPages(&fetched, "none"), func(ctx context.Context, item interface{}) error {
+		pending = append(pending, item.(string))
+		return nil
+	})
+	assert.Contains(t, err.Error(), "job 'job4' failed at checkpoint 'p2': cannot flush")
+	assert.Equal(t, []string{"-a", "-b"}, flushed)
+	// The page that was not flushed is processed again when the job is resumed.
+	checkpoint, _, _ := checkpointer.LoadCheckpoint(context.Background(), "job4")
+	assert.Equal(t, "p2", checkpoint)
+}
@@ -0,0 +1,49 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"time"
+)
+
+// BillingMonthFormat is the layout of the billing month ("yyyy-mm") used by the usage reporting services.
+const BillingMonthFormat = "2006-01"
+
+// MonthRange returns the billing months from "fromMonth" through "toMonth" inclusive, in ascending order.
+// Both months must be in "yyyy-mm" format.
+func MonthRange(fromMonth string, toMonth string) (months []string, err error) {
+	from, err := time.Parse(BillingMonthFormat, fromMonth)
+	if err != nil {
+		err = fmt.Errorf("invalid month '%s': expected format yyyy-mm", fromMonth)
+		return
+	}
+	to, err := time.Parse(BillingMonthFormat, toMonth)
+	if err != nil {
+		err = fmt.Errorf("invalid month '%s': expected format yyyy-mm", toMonth)
+		return
+	}
+	if to.Before(from) {
+		err = fmt.Errorf("month '%s' is before month '%s'", toMonth, fromMonth)
+		return
+	}
+
+	for month := from; !month.After(to); month = month.AddDate(0, 1, 0) {
+		months = append(months, month.Format(BillingMonthFormat))
+	}
+	return
+}
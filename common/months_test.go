@@ -0,0 +1,40 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonthRange(t *testing.T) {
+	months, err := MonthRange("2021-11", "2022-02")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"2021-11", "2021-12", "2022-01", "2022-02"}, months)
+
+	months, err = MonthRange("2022-02", "2022-02")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"2022-02"}, months)
+
+	_, err = MonthRange("2022-03", "2022-02")
+	assert.NotNil(t, err)
+	_, err = MonthRange("2022/03", "2022-04")
+	assert.NotNil(t, err)
+	_, err = MonthRange("2022-03", "April")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,366 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+// This file contains a minimal Apache Parquet writer used by NewTableWriter.
+// It writes a flat schema of optional columns, one uncompressed PLAIN-encoded data page per column chunk,
+// and starts a new row group every parquetRowGroupSize rows so that memory use stays bounded.
+// See https://github.com/apache/parquet-format for the file format specification.
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+const parquetRowGroupSize = 10000
+
+const parquetMagic = "PAR1"
+
+// Parquet physical types, repetition types, converted types and encodings.
+const (
+	parquetTypeInt64      = 2
+	parquetTypeDouble     = 5
+	parquetTypeByteArray  = 6
+	parquetOptional       = 1
+	parquetConvertedUTF8  = 0
+	parquetEncodingPlain  = 0
+	parquetEncodingRLE    = 3
+	parquetPageTypeData   = 0
+	parquetCodecNone      = 0
+	parquetFormatVersion  = 1
+	parquetCreatedBy      = sdkName
+	thriftTypeI32         = 5
+	thriftTypeI64         = 6
+	thriftTypeBinary      = 8
+	thriftTypeList        = 9
+	thriftTypeStruct      = 12
+	thriftCompactStopByte = 0
+)
+
+type parquetRowGroup struct {
+	numRows   int64
+	totalSize int64
+	columns   []parquetColumnChunk
+}
+
+type parquetColumnChunk struct {
+	offset    int64
+	size      int64
+	numValues int64
+}
+
+type parquetTableWriter struct {
+	writer    io.Writer
+	columns   []Column
+	offset    int64
+	rows      [][]interface{}
+	rowGroups []parquetRowGroup
+	err       error
+}
+
+func newParquetTableWriter(w io.Writer, columns []Column) *parquetTableWriter {
+	return &parquetTableWriter{writer: w, columns: columns}
+}
+
+func (tableWriter *parquetTableWriter) WriteRow(values ...interface{}) error {
+	if tableWriter.err != nil {
+		return tableWriter.err
+	}
+	row, err := normalizeRow(tableWriter.columns, values)
+	if err != nil {
+		return err
+	}
+	tableWriter.rows = append(tableWriter.rows, row)
+	if len(tableWriter.rows) >= parquetRowGroupSize {
+		return tableWriter.flushRowGroup()
+	}
+	return nil
+}
+
+func (tableWriter *parquetTableWriter) Close() error {
+	if err := tableWriter.flushRowGroup(); err != nil {
+		return err
+	}
+	if tableWriter.offset == 0 {
+		if err := tableWriter.write([]byte(parquetMagic)); err != nil {
+			return err
+		}
+	}
+
+	footer := tableWriter.fileMetadata()
+	length := make([]byte, 4)
+	binary.LittleEndian.PutUint32(length, uint32(len(footer)))
+	if err := tableWriter.write(footer); err != nil {
+		return err
+	}
+	if err := tableWriter.write(length); err != nil {
+		return err
+	}
+	return tableWriter.write([]byte(parquetMagic))
+}
+
+func (tableWriter *parquetTableWriter) write(b []byte) error {
+	if tableWriter.err != nil {
+		return tableWriter.err
+	}
+	n, err := tableWriter.writer.Write(b)
+	tableWriter.offset += int64(n)
+	tableWriter.err = err
+	return err
+}
+
+func (tableWriter *parquetTableWriter) flushRowGroup() error {
+	if len(tableWriter.rows) == 0 {
+		return tableWriter.err
+	}
+	if tableWriter.offset == 0 {
+		if err := tableWriter.write([]byte(parquetMagic)); err != nil {
+			return err
+		}
+	}
+
+	rowGroup := parquetRowGroup{numRows: int64(len(tableWriter.rows))}
+	for i, column := range tableWriter.columns {
+		page := tableWriter.encodePage(i, column)
+		header := encodeDataPageHeader(len(page), len(tableWriter.rows))
+		chunk := parquetColumnChunk{
+			offset:    tableWriter.offset,
+			size:      int64(len(header) + len(page)),
+			numValues: int64(len(tableWriter.rows)),
+		}
+		if err := tableWriter.write(header); err != nil {
+			return err
+		}
+		if err := tableWriter.write(page); err != nil {
+			return err
+		}
+		rowGroup.columns = append(rowGroup.columns, chunk)
+		rowGroup.totalSize += chunk.size
+	}
+
+	tableWriter.rowGroups = append(tableWriter.rowGroups, rowGroup)
+	tableWriter.rows = tableWriter.rows[:0]
+	return nil
+}
+
+// encodePage returns the definition levels and PLAIN-encoded non-null values of column "index".
+func (tableWriter *parquetTableWriter) encodePage(index int, column Column) []byte {
+	levels := make([]byte, (len(tableWriter.rows)+7)/8)
+	values := new(bytes.Buffer)
+	scratch := make([]byte, 8)
+	for r, row := range tableWriter.rows {
+		value := row[index]
+		if value == nil {
+			continue
+		}
+		levels[r/8] |= 1 << uint(r%8)
+		switch v := value.(type) {
+		case string:
+			binary.LittleEndian.PutUint32(scratch, uint32(len(v)))
+			values.Write(scratch[:4])
+			values.WriteString(v)
+		case float64:
+			binary.LittleEndian.PutUint64(scratch, math.Float64bits(v))
+			values.Write(scratch)
+		case int64:
+			binary.LittleEndian.PutUint64(scratch, uint64(v))
+			values.Write(scratch)
+		}
+	}
+
+	// Definition levels use the RLE/bit-packed hybrid encoding with a bit width of 1,
+	// written as a single bit-packed run and prefixed with its length.
+	run := new(bytes.Buffer)
+	writeUvarint(run, uint64(len(levels))<<1|1)
+	run.Write(levels)
+
+	page := new(bytes.Buffer)
+	binary.LittleEndian.PutUint32(scratch, uint32(run.Len()))
+	page.Write(scratch[:4])
+	page.Write(run.Bytes())
+	page.Write(values.Bytes())
+	return page.Bytes()
+}
+
+func (tableWriter *parquetTableWriter) fileMetadata() []byte {
+	encoder := newThriftCompactEncoder()
+	var numRows int64
+	for _, rowGroup := range tableWriter.rowGroups {
+		numRows += rowGroup.numRows
+	}
+
+	encoder.fieldI32(1, parquetFormatVersion)
+
+	encoder.fieldListHeader(2, thriftTypeStruct, len(tableWriter.columns)+1)
+	encoder.beginStruct()
+	encoder.fieldBinary(4, "schema")
+	encoder.fieldI32(5, int32(len(tableWriter.columns)))
+	encoder.endStruct()
+	for _, column := range tableWriter.columns {
+		encoder.beginStruct()
+		encoder.fieldI32(1, parquetPhysicalType(column))
+		encoder.fieldI32(3, parquetOptional)
+		encoder.fieldBinary(4, column.Name)
+		if column.Type == ColumnTypeString {
+			encoder.fieldI32(6, parquetConvertedUTF8)
+		}
+		encoder.endStruct()
+	}
+
+	encoder.fieldI64(3, numRows)
+
+	encoder.fieldListHeader(4, thriftTypeStruct, len(tableWriter.rowGroups))
+	for _, rowGroup := range tableWriter.rowGroups {
+		encoder.beginStruct()
+		encoder.fieldListHeader(1, thriftTypeStruct, len(rowGroup.columns))
+		for i, chunk := range rowGroup.columns {
+			column := tableWriter.columns[i]
+			encoder.beginStruct()
+			encoder.fieldI64(2, chunk.offset)
+			encoder.fieldStructBegin(3)
+			encoder.fieldI32(1, parquetPhysicalType(column))
+			encoder.fieldListHeader(2, thriftTypeI32, 2)
+			encoder.i32(parquetEncodingPlain)
+			encoder.i32(parquetEncodingRLE)
+			encoder.fieldListHeader(3, thriftTypeBinary, 1)
+			encoder.binary(column.Name)
+			encoder.fieldI32(4, parquetCodecNone)
+			encoder.fieldI64(5, chunk.numValues)
+			encoder.fieldI64(6, chunk.size)
+			encoder.fieldI64(7, chunk.size)
+			encoder.fieldI64(9, chunk.offset)
+			encoder.endStruct()
+			encoder.endStruct()
+		}
+		encoder.fieldI64(2, rowGroup.totalSize)
+		encoder.fieldI64(3, rowGroup.numRows)
+		encoder.endStruct()
+	}
+
+	encoder.fieldBinary(6, parquetCreatedBy)
+	encoder.endStruct()
+	return encoder.buffer.Bytes()
+}
+
+func encodeDataPageHeader(pageSize int, numValues int) []byte {
+	encoder := newThriftCompactEncoder()
+	encoder.fieldI32(1, parquetPageTypeData)
+	encoder.fieldI32(2, int32(pageSize))
+	encoder.fieldI32(3, int32(pageSize))
+	encoder.fieldStructBegin(5)
+	encoder.fieldI32(1, int32(numValues))
+	encoder.fieldI32(2, parquetEncodingPlain)
+	encoder.fieldI32(3, parquetEncodingRLE)
+	encoder.fieldI32(4, parquetEncodingRLE)
+	encoder.endStruct()
+	encoder.endStruct()
+	return encoder.buffer.Bytes()
+}
+
+func parquetPhysicalType(column Column) int32 {
+	switch column.Type {
+	case ColumnTypeDouble:
+		return parquetTypeDouble
+	case ColumnTypeInt64:
+		return parquetTypeInt64
+	default:
+		return parquetTypeByteArray
+	}
+}
+
+// thriftCompactEncoder implements the subset of the Thrift compact protocol needed for Parquet metadata.
+// The encoder starts inside an implicit top-level struct which must be terminated with endStruct().
+type thriftCompactEncoder struct {
+	buffer       *bytes.Buffer
+	lastFieldIDs []int16
+}
+
+func newThriftCompactEncoder() *thriftCompactEncoder {
+	return &thriftCompactEncoder{buffer: new(bytes.Buffer), lastFieldIDs: []int16{0}}
+}
+
+func (encoder *thriftCompactEncoder) fieldHeader(id int16, fieldType byte) {
+	last := &encoder.lastFieldIDs[len(encoder.lastFieldIDs)-1]
+	delta := id - *last
+	if delta > 0 && delta <= 15 {
+		encoder.buffer.WriteByte(byte(delta)<<4 | fieldType)
+	} else {
+		encoder.buffer.WriteByte(fieldType)
+		writeUvarint(encoder.buffer, zigzag(int64(id)))
+	}
+	*last = id
+}
+
+func (encoder *thriftCompactEncoder) beginStruct() {
+	encoder.lastFieldIDs = append(encoder.lastFieldIDs, 0)
+}
+
+func (encoder *thriftCompactEncoder) endStruct() {
+	encoder.buffer.WriteByte(thriftCompactStopByte)
+	encoder.lastFieldIDs = encoder.lastFieldIDs[:len(encoder.lastFieldIDs)-1]
+}
+
+func (encoder *thriftCompactEncoder) fieldStructBegin(id int16) {
+	encoder.fieldHeader(id, thriftTypeStruct)
+	encoder.beginStruct()
+}
+
+func (encoder *thriftCompactEncoder) fieldI32(id int16, value int32) {
+	encoder.fieldHeader(id, thriftTypeI32)
+	encoder.i32(value)
+}
+
+func (encoder *thriftCompactEncoder) fieldI64(id int16, value int64) {
+	encoder.fieldHeader(id, thriftTypeI64)
+	writeUvarint(encoder.buffer, zigzag(value))
+}
+
+func (encoder *thriftCompactEncoder) fieldBinary(id int16, value string) {
+	encoder.fieldHeader(id, thriftTypeBinary)
+	encoder.binary(value)
+}
+
+func (encoder *thriftCompactEncoder) fieldListHeader(id int16, elementType byte, size int) {
+	encoder.fieldHeader(id, thriftTypeList)
+	if size < 15 {
+		encoder.buffer.WriteByte(byte(size)<<4 | elementType)
+	} else {
+		encoder.buffer.WriteByte(0xf0 | elementType)
+		writeUvarint(encoder.buffer, uint64(size))
+	}
+}
+
+func (encoder *thriftCompactEncoder) i32(value int32) {
+	writeUvarint(encoder.buffer, zigzag(int64(value)))
+}
+
+func (encoder *thriftCompactEncoder) binary(value string) {
+	writeUvarint(encoder.buffer, uint64(len(value)))
+	encoder.buffer.WriteString(value)
+}
+
+func zigzag(value int64) uint64 {
+	return uint64((value << 1) ^ (value >> 63))
+}
+
+func writeUvarint(buffer *bytes.Buffer, value uint64) {
+	scratch := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(scratch, value)
+	buffer.Write(scratch[:n])
+}
@@ -0,0 +1,348 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SharedRateLimitServiceName is the name under which the external configuration of the rate limiter shared by all
+// the services is looked up by ConfigureRateLimiting (e.g. the PLATFORM_SERVICES_RATE_LIMIT environment variable).
+const SharedRateLimitServiceName = "platform_services"
+
+// The properties of the external configuration of a rate limiter (see RateLimiterFromConfig). For example, the
+// CASE_MANAGEMENT_RATE_LIMIT environment variable sets the rate of the requests of a Case Management service
+// configured with ConfigureRateLimiting. The service constructors, including the New...UsingExternalConfig ones, do
+// not read these properties: rate limiting is opt-in, and applies only to the services passed to
+// ConfigureRateLimiting, EnableRateLimiting or ClientOptions.Apply.
+const (
+	// The maximum rate of requests, in requests per second (e.g. "5" or "0.5").
+	PropRateLimit = "RATE_LIMIT"
+
+	// The number of requests that may be sent at once after a period of inactivity.
+	PropRateLimitBurst = "RATE_LIMIT_BURST"
+
+	// The maximum number of requests in flight.
+	PropMaxConcurrentRequests = "MAX_CONCURRENT_REQUESTS"
+)
+
+// RateLimiter : A Limiter that bounds the rate of requests with a token bucket, and optionally the number of requests
+// in flight. A RateLimiter can be shared by several services, or by the operations of a job and a service, to bound
+// their combined traffic. It is safe for concurrent use; its fields must not be changed after its first use.
+type RateLimiter struct {
+	// The maximum sustained rate of requests, in requests per second. If zero or negative, the rate is not limited.
+	Rate float64
+
+	// The number of requests that may be sent at once after a period of inactivity (the capacity of the bucket).
+	// Defaults to 1.
+	Burst int
+
+	// The maximum number of requests in flight, enforced by Acquire. If zero or negative, it is not limited.
+	MaxConcurrent int
+
+	mutex    sync.Mutex
+	tokens   float64
+	last     time.Time
+	inFlight chan struct{}
+}
+
+// NewRateLimiter returns a new RateLimiter that allows "rate" requests per second, with bursts of "burst" requests.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{Rate: rate, Burst: burst}
+}
+
+// Wait blocks until the rate allows another request or the context is done.
+func (limiter *RateLimiter) Wait(ctx context.Context) error {
+	if limiter.Rate <= 0 {
+		return ctx.Err()
+	}
+	wait := limiter.reserve()
+	if wait <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		limiter.cancelReservation()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Acquire blocks until a request may be sent, both by the number of requests in flight and by the rate, or the
+// context is done. The returned function must be called once the request is complete; it is nil if "err" is not
+// nil.
+func (limiter *RateLimiter) Acquire(ctx context.Context) (release func(), err error) {
+	release = func() {}
+	if limiter.MaxConcurrent > 0 {
+		slots := limiter.slots()
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		var once sync.Once
+		release = func() { once.Do(func() { <-slots }) }
+	}
+	if err = limiter.Wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return
+}
+
+// reserve takes a token from the bucket and returns the time to wait until it is available. The bucket may go into
+// debt, so that concurrent callers are spaced by the rate.
+func (limiter *RateLimiter) reserve() time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	burst := float64(limiter.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	now := time.Now()
+	if limiter.last.IsZero() {
+		limiter.tokens = burst
+	} else {
+		limiter.tokens = math.Min(burst, limiter.tokens+now.Sub(limiter.last).Seconds()*limiter.Rate)
+	}
+	limiter.last = now
+	limiter.tokens--
+	if limiter.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-limiter.tokens / limiter.Rate * float64(time.Second))
+}
+
+// cancelReservation returns the token of a reservation that was not used.
+func (limiter *RateLimiter) cancelReservation() {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	limiter.tokens++
+}
+
+// slots returns the semaphore that bounds the requests in flight.
+func (limiter *RateLimiter) slots() chan struct{} {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	if limiter.inFlight == nil {
+		limiter.inFlight = make(chan struct{}, limiter.MaxConcurrent)
+	}
+	return limiter.inFlight
+}
+
+// RateLimiterFromConfig returns the rate limiter described by the external configuration of a service (credential
+// file, environment variables or VCAP_SERVICES; see core.GetServiceProperties), from the PropRateLimit,
+// PropRateLimitBurst and PropMaxConcurrentRequests properties. It returns nil if none of them is set.
+func RateLimiterFromConfig(serviceName string) (limiter *RateLimiter, err error) {
+	props, err := core.GetServiceProperties(serviceName)
+	if err != nil || props == nil {
+		return nil, err
+	}
+	if props[PropRateLimit] == "" && props[PropRateLimitBurst] == "" && props[PropMaxConcurrentRequests] == "" {
+		return nil, nil
+	}
+	limiter = &RateLimiter{}
+	if value := props[PropRateLimit]; value != "" {
+		limiter.Rate, err = strconv.ParseFloat(value, 64)
+		if err != nil || limiter.Rate < 0 {
+			return nil, fmt.Errorf("invalid %s property '%s' of service '%s'", PropRateLimit, value, serviceName)
+		}
+	}
+	if value := props[PropRateLimitBurst]; value != "" {
+		limiter.Burst, err = strconv.Atoi(value)
+		if err != nil || limiter.Burst < 0 {
+			return nil, fmt.Errorf("invalid %s property '%s' of service '%s'", PropRateLimitBurst, value, serviceName)
+		}
+	}
+	if value := props[PropMaxConcurrentRequests]; value != "" {
+		limiter.MaxConcurrent, err = strconv.Atoi(value)
+		if err != nil || limiter.MaxConcurrent < 0 {
+			return nil, fmt.Errorf("invalid %s property '%s' of service '%s'", PropMaxConcurrentRequests, value, serviceName)
+		}
+	}
+	return
+}
+
+var sharedRateLimiter struct {
+	once    sync.Once
+	limiter *RateLimiter
+	err     error
+}
+
+// SharedRateLimiter returns the rate limiter shared by the services configured with ConfigureRateLimiting, which
+// is described by the external configuration of SharedRateLimitServiceName (e.g. the PLATFORM_SERVICES_RATE_LIMIT
+// environment variable). The configuration is read once; the result is nil if it is not set.
+func SharedRateLimiter() (*RateLimiter, error) {
+	sharedRateLimiter.once.Do(func() {
+		sharedRateLimiter.limiter, sharedRateLimiter.err = RateLimiterFromConfig(SharedRateLimitServiceName)
+	})
+	return sharedRateLimiter.limiter, sharedRateLimiter.err
+}
+
+// EndpointRateLimit : Assigns a rate limiter to the operations with a method and path, in addition to the rate
+// limiters of the service.
+type EndpointRateLimit struct {
+	// The HTTP method of the operation. If empty, the limit applies to all methods.
+	Method string
+
+	// The path of the operation, in which parameters are written in braces (e.g. "/v3/tags/attach"). A request
+	// matches if the trailing segments of its path match.
+	Path string
+
+	// The rate limiter of the operation.
+	Limiter *RateLimiter
+}
+
+// RateLimitTransport : An http.RoundTripper that holds each request until its rate limiters allow it: the limiter of
+// the most specific endpoint that matches it, if any, then each of the service-wide Limiters. The slot of a request
+// in flight is released when its response body is closed, or when it fails.
+type RateLimitTransport struct {
+	// The transport used to send requests. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// The limiters that apply to every request, such as one for the service and one shared with other services.
+	Limiters []*RateLimiter
+
+	// The limiters of specific operations.
+	Endpoints []EndpointRateLimit
+}
+
+// RoundTrip sends the request once its rate limiters allow it.
+func (transport *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	var releases []func()
+	release := func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			releases[i]()
+		}
+	}
+	limiters := transport.Limiters
+	if endpoint := transport.matchEndpoint(req); endpoint != nil {
+		limiters = append([]*RateLimiter{endpoint}, limiters...)
+	}
+	for _, limiter := range limiters {
+		if limiter == nil {
+			continue
+		}
+		limiterRelease, err := limiter.Acquire(req.Context())
+		if err != nil {
+			release()
+			return nil, err
+		}
+		releases = append(releases, limiterRelease)
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// matchEndpoint returns the limiter of the most specific endpoint that matches a request, or nil.
+func (transport *RateLimitTransport) matchEndpoint(req *http.Request) (limiter *RateLimiter) {
+	pathSegments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	longest := 0
+	for _, endpoint := range transport.Endpoints {
+		if endpoint.Method != "" && !strings.EqualFold(endpoint.Method, req.Method) {
+			continue
+		}
+		endpointSegments := strings.Split(strings.Trim(endpoint.Path, "/"), "/")
+		if len(endpointSegments) > longest && matchPathSegments(endpointSegments, pathSegments) {
+			limiter, longest = endpoint.Limiter, len(endpointSegments)
+		}
+	}
+	return
+}
+
+// releaseOnClose : A response body that releases the rate limiters of its request once it is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (body *releaseOnClose) Close() error {
+	err := body.ReadCloser.Close()
+	body.once.Do(body.release)
+	return err
+}
+
+// EnableRateLimiting installs a RateLimitTransport with the specified limiters in the HTTP client of "service", and
+// returns it so that its limiters can be adjusted. Pass the same RateLimiter to several services to bound their
+// combined traffic. The transport wraps the transport of the service's current HTTP client, so it should be enabled
+// after the client has been configured, and before EnableOperationTimeouts so that each retry is also limited.
+func EnableRateLimiting(service *core.BaseService, limiters []*RateLimiter, endpoints ...EndpointRateLimit) *RateLimitTransport {
+	client := service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	transport := &RateLimitTransport{Transport: client.Transport, Limiters: limiters, Endpoints: endpoints}
+	limitedClient := *client
+	limitedClient.Transport = transport
+	service.SetHTTPClient(&limitedClient)
+	return transport
+}
+
+// ConfigureRateLimiting enables rate limiting for "service" as described by the external configuration: the limiter
+// of the service (see RateLimiterFromConfig with "serviceName", e.g. DefaultServiceName of the service package) and
+// the SharedRateLimiter. It returns nil, and leaves the service unchanged, if neither is configured. It must be
+// called for each service, after its construction, for example:
+//
+//	caseManagementService, err := casemanagementv1.NewCaseManagementV1UsingExternalConfig(options)
+//	...
+//	_, err = common.ConfigureRateLimiting(caseManagementService.Service, casemanagementv1.DefaultServiceName)
+func ConfigureRateLimiting(service *core.BaseService, serviceName string) (transport *RateLimitTransport, err error) {
+	var limiters []*RateLimiter
+	limiter, err := RateLimiterFromConfig(serviceName)
+	if err != nil {
+		return
+	}
+	if limiter != nil {
+		limiters = append(limiters, limiter)
+	}
+	shared, err := SharedRateLimiter()
+	if err != nil {
+		return
+	}
+	if shared != nil {
+		limiters = append(limiters, shared)
+	}
+	if len(limiters) == 0 {
+		return
+	}
+	return EnableRateLimiting(service, limiters), nil
+}
@@ -0,0 +1,153 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	limiter := NewRateLimiter(50, 2)
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		assert.Nil(t, limiter.Wait(ctx))
+	}
+	// The burst is sent at once, then the requests are spaced by 20ms.
+	elapsed := time.Since(start)
+	assert.True(t, elapsed >= 35*time.Millisecond, "elapsed %s", elapsed)
+	assert.True(t, elapsed < 500*time.Millisecond, "elapsed %s", elapsed)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Equal(t, context.Canceled, limiter.Wait(cancelled))
+	assert.Nil(t, (&RateLimiter{}).Wait(ctx))
+
+	limiter = &RateLimiter{MaxConcurrent: 1}
+	release, err := limiter.Acquire(ctx)
+	assert.Nil(t, err)
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = limiter.Acquire(timeout)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	release()
+	release()
+	release, err = limiter.Acquire(ctx)
+	assert.Nil(t, err)
+	release()
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		res.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	shared := &RateLimiter{MaxConcurrent: 2}
+	attachLimiter := NewRateLimiter(1, 1)
+	transport := EnableRateLimiting(service, []*RateLimiter{shared},
+		EndpointRateLimit{Method: http.MethodPost, Path: "/v3/tags/attach", Limiter: attachLimiter})
+	assert.Equal(t, transport, service.GetHTTPClient().Transport)
+
+	get := func(method string, path string) {
+		req, err := http.NewRequest(method, server.URL+path, nil)
+		assert.Nil(t, err)
+		res, err := service.GetHTTPClient().Do(req)
+		assert.Nil(t, err)
+		_, _ = ioutil.ReadAll(res.Body)
+		res.Body.Close()
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			get(http.MethodGet, "/v3/tags")
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+
+	// The endpoint limiter allows one request, and holds the next one.
+	get(http.MethodPost, "/v3/tags/attach")
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v3/tags/attach", nil)
+	assert.Nil(t, err)
+	_, err = service.GetHTTPClient().Do(req)
+	assert.NotNil(t, err)
+	get(http.MethodPost, "/v3/tags/detach")
+
+	// The limiters are preserved when the transport is configured.
+	assert.Nil(t, ConfigureTransport(service, &TransportConfig{NoProxy: []string{"*"}}))
+	assert.Equal(t, transport, service.GetHTTPClient().Transport)
+	_, ok := transport.Transport.(*http.Transport)
+	assert.True(t, ok)
+}
+
+func TestRateLimiterFromConfig(t *testing.T) {
+	os.Setenv("RATE_LIMIT_TEST_RATE_LIMIT", "2.5")
+	os.Setenv("RATE_LIMIT_TEST_MAX_CONCURRENT_REQUESTS", "4")
+	os.Setenv("RATE_LIMIT_INVALID_RATE_LIMIT_BURST", "many")
+	defer os.Unsetenv("RATE_LIMIT_TEST_RATE_LIMIT")
+	defer os.Unsetenv("RATE_LIMIT_TEST_MAX_CONCURRENT_REQUESTS")
+	defer os.Unsetenv("RATE_LIMIT_INVALID_RATE_LIMIT_BURST")
+
+	limiter, err := RateLimiterFromConfig("rate_limit_test")
+	assert.Nil(t, err)
+	assert.Equal(t, 2.5, limiter.Rate)
+	assert.Equal(t, 0, limiter.Burst)
+	assert.Equal(t, 4, limiter.MaxConcurrent)
+
+	_, err = RateLimiterFromConfig("rate_limit_invalid")
+	assert.Equal(t, "invalid RATE_LIMIT_BURST property 'many' of service 'rate_limit_invalid'", err.Error())
+
+	limiter, err = RateLimiterFromConfig("rate_limit_unset")
+	assert.Nil(t, err)
+	assert.Nil(t, limiter)
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: "https://example.com", Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	transport, err := ConfigureRateLimiting(service, "rate_limit_test")
+	assert.Nil(t, err)
+	assert.Len(t, transport.Limiters, 1)
+	assert.Equal(t, 2.5, transport.Limiters[0].Rate)
+	assert.Equal(t, transport, service.GetHTTPClient().Transport)
+}
@@ -0,0 +1,106 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// RedactedValue replaces the value of each sensitive field in the output of MarshalRedactedJSON.
+const RedactedValue = "[REDACTED]"
+
+// SensitiveFieldSuffixes are the suffixes of the names of the fields that MarshalRedactedJSON masks. Names are
+// compared in lower case and without "_" and "-", so "apikey" matches "apikey", "api_key" and "entitlement_apikey".
+// Applications can append suffixes for fields of their own, before any model is logged.
+var SensitiveFieldSuffixes = []string{
+	"apikey",
+	"authorization",
+	"credentials",
+	"encryptkey",
+	"ingestionkey",
+	"passphrase",
+	"password",
+	"privatekey",
+	"secret",
+	"token",
+}
+
+// IsSensitiveField returns true if a field with the specified (JSON or Go) name is masked by MarshalRedactedJSON.
+func IsSensitiveField(name string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	for _, suffix := range SensitiveFieldSuffixes {
+		if strings.HasSuffix(normalized, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalRedactedJSON marshals "obj" like json.Marshal, then replaces the value of each sensitive field (see
+// IsSensitiveField) with RedactedValue, at any depth. Null and boolean values are kept, since they cannot carry a
+// secret. The keys of maps, such as the Headers of an options model, are checked like field names.
+func MarshalRedactedJSON(obj interface{}) ([]byte, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var value interface{}
+	if err = decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(redact(value))
+}
+
+// RedactedString returns the output of MarshalRedactedJSON as a string, prefixed with the type of "obj" (or of the
+// value it points to). It is used by the String methods of the models, so that printing a model with fmt (even with
+// "%+v") does not reveal secrets.
+func RedactedString(obj interface{}) string {
+	b, err := MarshalRedactedJSON(obj)
+	typeName := strings.TrimPrefix(fmt.Sprintf("%T", obj), "*")
+	if err != nil {
+		return typeName + "(" + RedactedValue + ")"
+	}
+	return typeName + string(b)
+}
+
+// redact masks the sensitive fields of a decoded JSON value.
+func redact(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			switch {
+			case field == nil:
+			case IsSensitiveField(key):
+				if _, isBool := field.(bool); !isBool {
+					value[key] = RedactedValue
+				}
+			default:
+				value[key] = redact(field)
+			}
+		}
+	case []interface{}:
+		for i := range value {
+			value[i] = redact(value[i])
+		}
+	}
+	return value
+}
@@ -0,0 +1,72 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+type redactTestCredentials struct {
+	Apikey   *string `json:"apikey,omitempty"`
+	Endpoint *string `json:"endpoint,omitempty"`
+}
+
+type redactTestModel struct {
+	Name              *string                `json:"name,omitempty"`
+	EntitlementApikey *string                `json:"entitlement_apikey,omitempty"`
+	Locked            *bool                  `json:"locked_token,omitempty"`
+	Credentials       *redactTestCredentials `json:"credentials,omitempty"`
+	Keys              []redactTestModel      `json:"keys,omitempty"`
+	Headers           map[string]string
+}
+
+func TestIsSensitiveField(t *testing.T) {
+	for _, name := range []string{"apikey", "api_key", "APIKey", "entitlement_apikey", "client-secret", "IamToken", "Authorization"} {
+		assert.True(t, IsSensitiveField(name), name)
+	}
+	for _, name := range []string{"name", "apikey_id", "key", "description"} {
+		assert.False(t, IsSensitiveField(name), name)
+	}
+}
+
+func TestMarshalRedactedJSON(t *testing.T) {
+	model := &redactTestModel{
+		Name:              core.StringPtr("my-model"),
+		EntitlementApikey: core.StringPtr("secret-1"),
+		Locked:            core.BoolPtr(true),
+		Credentials:       &redactTestCredentials{Apikey: core.StringPtr("secret-2")},
+		Keys:              []redactTestModel{{Name: core.StringPtr("nested"), EntitlementApikey: core.StringPtr("secret-3")}},
+		Headers:           map[string]string{"Authorization": "Bearer secret-4", "X-Test": "1"},
+	}
+	b, err := MarshalRedactedJSON(model)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"name": "my-model",
+		"entitlement_apikey": "[REDACTED]",
+		"locked_token": true,
+		"credentials": "[REDACTED]",
+		"keys": [{"name": "nested", "entitlement_apikey": "[REDACTED]", "Headers": null}],
+		"Headers": {"Authorization": "[REDACTED]", "X-Test": "1"}
+	}`, string(b))
+
+	s := RedactedString(model)
+	assert.Contains(t, s, `common.redactTestModel{"Headers":`)
+	assert.NotContains(t, s, "secret-")
+}
@@ -0,0 +1,359 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the Schema.Type property.
+const (
+	SchemaTypeArrayConst   = "array"
+	SchemaTypeBooleanConst = "boolean"
+	SchemaTypeIntegerConst = "integer"
+	SchemaTypeNumberConst  = "number"
+	SchemaTypeObjectConst  = "object"
+	SchemaTypeStringConst  = "string"
+)
+
+// Schema : A lightweight subset of an OpenAPI schema object that describes the shape of a JSON value.
+// Properties that are not described by the schema are allowed, so a schema only needs to describe the fields that
+// callers rely on.
+type Schema struct {
+	// The type of the value. If empty, any type is allowed.
+	Type string `json:"type,omitempty"`
+
+	// Whether the value may be null.
+	Nullable bool `json:"nullable,omitempty"`
+
+	// The properties that an object must contain.
+	Required []string `json:"required,omitempty"`
+
+	// The schemas of the properties of an object.
+	Properties map[string]*Schema `json:"properties,omitempty"`
+
+	// The schema of the items of an array.
+	Items *Schema `json:"items,omitempty"`
+}
+
+// OperationSchema : The schema of the successful response of an operation.
+type OperationSchema struct {
+	// The operation ID as defined in the API definition (e.g. "get_resource_instance").
+	OperationID string `json:"operation_id"`
+
+	// The HTTP method of the operation.
+	Method string `json:"method"`
+
+	// The path of the operation, with path parameters enclosed in braces (e.g. "/v2/resource_instances/{id}").
+	Path string `json:"path"`
+
+	// The schema of the response body.
+	Response *Schema `json:"response"`
+}
+
+// SchemaViolation : A difference between a response and the schema of its operation.
+type SchemaViolation struct {
+	// The operation ID of the request.
+	OperationID string
+
+	// The location of the offending value within the response body (e.g. "resources[0].id"), or "" for the body
+	// itself.
+	Field string
+
+	// A description of the difference.
+	Message string
+}
+
+// Error returns a description of the violation.
+func (violation SchemaViolation) Error() string {
+	field := violation.Field
+	if field == "" {
+		field = "response body"
+	}
+	return fmt.Sprintf("%s: %s: %s", violation.OperationID, field, violation.Message)
+}
+
+// Validate checks "value", a value decoded from JSON by the encoding/json package, against the schema and returns
+// every violation found. The OperationID of the violations is not set.
+func (schema *Schema) Validate(value interface{}) []SchemaViolation {
+	return schema.validate("", value, nil)
+}
+
+func (schema *Schema) validate(field string, value interface{}, violations []SchemaViolation) []SchemaViolation {
+	if schema == nil {
+		return violations
+	}
+	if value == nil {
+		if !schema.Nullable && schema.Type != "" {
+			violations = append(violations, SchemaViolation{Field: field, Message: fmt.Sprintf("expected %s, found null", schema.Type)})
+		}
+		return violations
+	}
+
+	var ok bool
+	switch schema.Type {
+	case "":
+		ok = true
+	case SchemaTypeArrayConst:
+		var items []interface{}
+		if items, ok = value.([]interface{}); ok {
+			for i, item := range items {
+				violations = schema.Items.validate(fmt.Sprintf("%s[%d]", field, i), item, violations)
+			}
+		}
+	case SchemaTypeBooleanConst:
+		_, ok = value.(bool)
+	case SchemaTypeIntegerConst:
+		var number float64
+		if number, ok = value.(float64); ok {
+			ok = number == math.Trunc(number)
+		}
+	case SchemaTypeNumberConst:
+		_, ok = value.(float64)
+	case SchemaTypeObjectConst:
+		var object map[string]interface{}
+		if object, ok = value.(map[string]interface{}); ok {
+			violations = schema.validateProperties(field, object, violations)
+		}
+	case SchemaTypeStringConst:
+		_, ok = value.(string)
+	default:
+		violations = append(violations, SchemaViolation{Field: field, Message: fmt.Sprintf("unsupported schema type '%s'", schema.Type)})
+		return violations
+	}
+	if !ok {
+		violations = append(violations, SchemaViolation{Field: field, Message: fmt.Sprintf("expected %s, found %s", schema.Type, jsonTypeName(value))})
+	}
+	return violations
+}
+
+func (schema *Schema) validateProperties(field string, object map[string]interface{}, violations []SchemaViolation) []SchemaViolation {
+	prefix := field
+	if prefix != "" {
+		prefix += "."
+	}
+	for _, name := range schema.Required {
+		if _, found := object[name]; !found {
+			violations = append(violations, SchemaViolation{Field: prefix + name, Message: "required property is missing"})
+		}
+	}
+
+	// Check the properties in a fixed order so that the violations are reported consistently.
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if propertyValue, found := object[name]; found {
+			violations = schema.Properties[name].validate(prefix+name, propertyValue, violations)
+		}
+	}
+	return violations
+}
+
+// jsonTypeName returns the schema type name of a value decoded from JSON.
+func jsonTypeName(value interface{}) string {
+	switch value := value.(type) {
+	case []interface{}:
+		return SchemaTypeArrayConst
+	case bool:
+		return SchemaTypeBooleanConst
+	case float64:
+		if value == math.Trunc(value) {
+			return SchemaTypeIntegerConst
+		}
+		return SchemaTypeNumberConst
+	case map[string]interface{}:
+		return SchemaTypeObjectConst
+	case string:
+		return SchemaTypeStringConst
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// SchemaRegistry : The response schemas of the operations of a service, looked up by HTTP method and path.
+type SchemaRegistry struct {
+	operations []OperationSchema
+}
+
+// NewSchemaRegistry returns a SchemaRegistry containing the operation schemas of "fragment", a JSON array of
+// OperationSchema objects.
+func NewSchemaRegistry(fragment []byte) (registry *SchemaRegistry, err error) {
+	registry = &SchemaRegistry{}
+	err = json.Unmarshal(fragment, &registry.operations)
+	if err != nil {
+		err = fmt.Errorf("error parsing schema fragment: %s", err.Error())
+		registry = nil
+		return
+	}
+	for _, operation := range registry.operations {
+		if operation.OperationID == "" || operation.Method == "" || operation.Path == "" {
+			err = fmt.Errorf("error parsing schema fragment: operation_id, method and path are required")
+			registry = nil
+			return
+		}
+	}
+	return
+}
+
+// Lookup returns the schema of the operation that matches the HTTP method and request path, or nil if there is none.
+// The request path may contain a prefix (e.g. a base path from the service URL) before the operation's path. If
+// several operations match, the one with the longest path wins.
+func (registry *SchemaRegistry) Lookup(method string, path string) (result *OperationSchema) {
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := range registry.operations {
+		operation := &registry.operations[i]
+		if !strings.EqualFold(operation.Method, method) {
+			continue
+		}
+		operationSegments := strings.Split(strings.Trim(operation.Path, "/"), "/")
+		if !matchPathSegments(operationSegments, pathSegments) {
+			continue
+		}
+		if result == nil || len(operationSegments) > len(strings.Split(strings.Trim(result.Path, "/"), "/")) {
+			result = operation
+		}
+	}
+	return
+}
+
+// matchPathSegments returns true if the trailing segments of a request path match the segments of an operation path.
+func matchPathSegments(operationSegments []string, pathSegments []string) bool {
+	if len(operationSegments) > len(pathSegments) {
+		return false
+	}
+	pathSegments = pathSegments[len(pathSegments)-len(operationSegments):]
+	for i, segment := range operationSegments {
+		isParameter := strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+		if !isParameter && segment != pathSegments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ResponseValidator : An http.RoundTripper that checks the bodies of successful JSON responses against the schemas of
+// a SchemaRegistry. It is intended for tests, to detect API drift early: responses are passed through unchanged
+// and violations are only recorded.
+type ResponseValidator struct {
+	// The schemas to validate against. Responses of operations that are not in the registry are not checked.
+	Registry *SchemaRegistry
+
+	// The transport used to send requests. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// If not nil, called for each violation as it is found.
+	OnViolation func(SchemaViolation)
+
+	mutex      sync.Mutex
+	violations []SchemaViolation
+}
+
+// RoundTrip sends the request and validates the response.
+func (validator *ResponseValidator) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := validator.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode >= 300 || resp.Body == nil {
+		return resp, err
+	}
+	if !core.IsJSONMimeType(resp.Header.Get("Content-Type")) {
+		return resp, err
+	}
+	operation := validator.Registry.Lookup(req.Method, req.URL.Path)
+	if operation == nil || operation.Response == nil {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	var violations []SchemaViolation
+	var value interface{}
+	if len(bytes.TrimSpace(body)) == 0 {
+		violations = []SchemaViolation{{Message: "response body is empty"}}
+	} else if decodeErr := json.Unmarshal(body, &value); decodeErr != nil {
+		violations = []SchemaViolation{{Message: fmt.Sprintf("invalid JSON: %s", decodeErr.Error())}}
+	} else {
+		violations = operation.Response.Validate(value)
+	}
+	validator.record(operation.OperationID, violations)
+	return resp, nil
+}
+
+func (validator *ResponseValidator) record(operationID string, violations []SchemaViolation) {
+	for i := range violations {
+		violations[i].OperationID = operationID
+		if validator.OnViolation != nil {
+			validator.OnViolation(violations[i])
+		}
+	}
+	validator.mutex.Lock()
+	defer validator.mutex.Unlock()
+	validator.violations = append(validator.violations, violations...)
+}
+
+// Violations returns the violations found so far.
+func (validator *ResponseValidator) Violations() []SchemaViolation {
+	validator.mutex.Lock()
+	defer validator.mutex.Unlock()
+	return append([]SchemaViolation(nil), validator.violations...)
+}
+
+// Reset discards the violations found so far.
+func (validator *ResponseValidator) Reset() {
+	validator.mutex.Lock()
+	defer validator.mutex.Unlock()
+	validator.violations = nil
+}
+
+// EnableResponseValidation configures "service" to validate the responses of the operations in "registry" and returns
+// the validator, from which the violations can be retrieved.
+// The validator wraps the transport of the service's current HTTP client, so it should be enabled after the client
+// has been configured (e.g. after DisableSSLVerification).
+func EnableResponseValidation(service *core.BaseService, registry *SchemaRegistry) *ResponseValidator {
+	client := service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	validatingClient := *client
+	validator := &ResponseValidator{
+		Registry:  registry,
+		Transport: client.Transport,
+	}
+	validatingClient.Transport = validator
+	service.SetHTTPClient(&validatingClient)
+	return validator
+}
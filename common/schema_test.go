@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSchemaFragment = `[
+	{"operation_id": "list_things", "method": "GET", "path": "/v1/things", "response": {
+		"type": "object", "required": ["resources"], "properties": {
+			"resources": {"type": "array", "items": {
+				"type": "object", "required": ["id"], "properties": {
+					"id": {"type": "string"}, "count": {"type": "integer"}, "note": {"type": "string", "nullable": true}}}}}}},
+	{"operation_id": "get_thing", "method": "GET", "path": "/v1/things/{id}", "response": {"type": "object", "required": ["id"]}},
+	{"operation_id": "get_thing_parts", "method": "GET", "path": "/v1/things/{id}/parts", "response": {"type": "array"}}
+]`
+
+func TestSchemaValidate(t *testing.T) {
+	registry, err := NewSchemaRegistry([]byte(testSchemaFragment))
+	assert.Nil(t, err)
+	schema := registry.Lookup("GET", "/v1/things").Response
+
+	var value interface{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"resources": [{"id": "a", "count": 1, "note": null}, {"count": 1.5, "note": 3}], "extra": true}`), &value))
+	violations := schema.Validate(value)
+	assert.Equal(t, []SchemaViolation{
+		{Field: "resources[1].id", Message: "required property is missing"},
+		{Field: "resources[1].count", Message: "expected integer, found number"},
+		{Field: "resources[1].note", Message: "expected string, found integer"},
+	}, violations)
+
+	assert.Nil(t, json.Unmarshal([]byte(`[]`), &value))
+	violations = schema.Validate(value)
+	assert.Equal(t, "expected object, found array", violations[0].Message)
+	assert.Equal(t, "list_things: response body: expected object, found array",
+		SchemaViolation{OperationID: "list_things", Message: violations[0].Message}.Error())
+}
+
+func TestSchemaRegistryLookup(t *testing.T) {
+	registry, err := NewSchemaRegistry([]byte(testSchemaFragment))
+	assert.Nil(t, err)
+	assert.Equal(t, "get_thing", registry.Lookup("get", "/api/v1/things/t1").OperationID)
+	assert.Equal(t, "get_thing_parts", registry.Lookup("GET", "/v1/things/t1/parts").OperationID)
+	assert.Nil(t, registry.Lookup("DELETE", "/v1/things/t1"))
+	assert.Nil(t, registry.Lookup("GET", "/v1/other"))
+
+	_, err = NewSchemaRegistry([]byte(`[{"operation_id": "x"}]`))
+	assert.NotNil(t, err)
+	_, err = NewSchemaRegistry([]byte(`{`))
+	assert.NotNil(t, err)
+}
+
+func TestEnableResponseValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/v1/things/good":
+			fmt.Fprint(res, `{"id": "good"}`)
+		case "/v1/things/missing":
+			res.WriteHeader(404)
+			fmt.Fprint(res, `{"errors": []}`)
+		default:
+			fmt.Fprint(res, `{"name": "bad"}`)
+		}
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	registry, err := NewSchemaRegistry([]byte(testSchemaFragment))
+	assert.Nil(t, err)
+	validator := EnableResponseValidation(service, registry)
+	var reported []SchemaViolation
+	validator.OnViolation = func(violation SchemaViolation) {
+		reported = append(reported, violation)
+	}
+
+	for _, id := range []string{"good", "missing", "bad"} {
+		resp, err := service.GetHTTPClient().Get(server.URL + "/v1/things/" + id)
+		assert.Nil(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, body)
+		resp.Body.Close()
+	}
+
+	expected := []SchemaViolation{{OperationID: "get_thing", Field: "id", Message: "required property is missing"}}
+	assert.Equal(t, expected, validator.Violations())
+	assert.Equal(t, expected, reported)
+	validator.Reset()
+	assert.Empty(t, validator.Violations())
+}
@@ -0,0 +1,237 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// StaleResponseWarning is the value of the "Warning" header of a response served by a StaleCache instead of the live
+// response. It is the "Response is Stale" warning defined by RFC 7234.
+const StaleResponseWarning = "110 - \"Response is Stale\""
+
+const (
+	headerNameAge     = "Age"
+	headerNameWarning = "Warning"
+)
+
+// DefaultStaleCacheMaxEntries is the number of responses retained by a StaleCache when MaxEntries is not set.
+const DefaultStaleCacheMaxEntries = 256
+
+// StaleCache : An http.RoundTripper that retains the most recent successful response of each GET request and
+// returns it, marked as stale, when the live request fails with a 5xx status code, a timeout or a connection error.
+// Requests cancelled by the caller are not answered from the cache.
+// It lets read-only clients such as dashboards degrade gracefully during a service outage.
+// Stale responses carry a "Warning" header set to StaleResponseWarning and an "Age" header with the age of the
+// response in seconds; use IsStaleResponse to detect them.
+type StaleCache struct {
+	// The transport used to send requests. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// The maximum age of a response that may be served when the live request fails. If zero, responses never expire.
+	MaxAge time.Duration
+
+	// The maximum number of responses retained; the oldest response is discarded to make room for a new one.
+	// Defaults to DefaultStaleCacheMaxEntries.
+	MaxEntries int
+
+	// If not nil, called for each request answered with a stale response, with the error or 5xx status code that
+	// caused the live request to fail.
+	OnStale func(req *http.Request, cause error)
+
+	mutex   sync.Mutex
+	entries map[string]*staleCacheEntry
+}
+
+type staleCacheEntry struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+	storedAt   time.Time
+}
+
+// RoundTrip sends the request and, for GET requests, either retains the successful response or replaces a failed
+// response with a retained one.
+func (cache *StaleCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := cache.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if req.Method != http.MethodGet {
+		return transport.RoundTrip(req)
+	}
+
+	key := req.URL.String()
+	resp, err := transport.RoundTrip(req)
+	switch {
+	case err != nil:
+		if !isTransientError(req, err) {
+			return resp, err
+		}
+		if stale := cache.staleResponse(req, key, err); stale != nil {
+			return stale, nil
+		}
+	case resp.StatusCode >= 500:
+		cause := fmt.Errorf("%s", resp.Status)
+		if stale := cache.staleResponse(req, key, cause); stale != nil {
+			resp.Body.Close()
+			return stale, nil
+		}
+	case resp.StatusCode == http.StatusOK && resp.Body != nil:
+		body, readErr := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			return nil, readErr
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		cache.store(key, resp, body)
+	}
+	return resp, err
+}
+
+// isTransientError returns true if a transport error may be caused by an outage (e.g. a timeout or a refused
+// connection) rather than by the caller cancelling the request.
+func isTransientError(req *http.Request, err error) bool {
+	return !errors.Is(err, context.Canceled) || errors.Is(req.Context().Err(), context.DeadlineExceeded)
+}
+
+func (cache *StaleCache) store(key string, resp *http.Response, body []byte) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.entries == nil {
+		cache.entries = make(map[string]*staleCacheEntry)
+	}
+	maxEntries := cache.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = DefaultStaleCacheMaxEntries
+	}
+	if _, found := cache.entries[key]; !found && len(cache.entries) >= maxEntries {
+		cache.evictOldest()
+	}
+	cache.entries[key] = &staleCacheEntry{
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+		storedAt:   time.Now(),
+	}
+}
+
+func (cache *StaleCache) evictOldest() {
+	var oldestKey string
+	var oldest *staleCacheEntry
+	for key, entry := range cache.entries {
+		if oldest == nil || entry.storedAt.Before(oldest.storedAt) {
+			oldestKey, oldest = key, entry
+		}
+	}
+	delete(cache.entries, oldestKey)
+}
+
+// staleResponse returns the retained response for the request, or nil if there is none or it has expired.
+func (cache *StaleCache) staleResponse(req *http.Request, key string, cause error) *http.Response {
+	cache.mutex.Lock()
+	entry, found := cache.entries[key]
+	cache.mutex.Unlock()
+	if !found {
+		return nil
+	}
+	age := time.Since(entry.storedAt)
+	if cache.MaxAge > 0 && age > cache.MaxAge {
+		return nil
+	}
+	if cache.OnStale != nil {
+		cache.OnStale(req, cause)
+	}
+
+	header := entry.header.Clone()
+	header.Set(headerNameWarning, StaleResponseWarning)
+	header.Set(headerNameAge, strconv.FormatInt(int64(age/time.Second), 10))
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.statusCode, http.StatusText(entry.statusCode)),
+		StatusCode:    entry.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(entry.body)),
+		ContentLength: int64(len(entry.body)),
+		Request:       req,
+	}
+}
+
+// Len returns the number of responses retained.
+func (cache *StaleCache) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return len(cache.entries)
+}
+
+// Clear discards the retained responses.
+func (cache *StaleCache) Clear() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.entries = nil
+}
+
+// EnableServeStaleOnError configures "service" to serve the most recent successful response of a GET request,
+// marked as stale, when the live request fails with a 5xx status code or a timeout, and returns the cache. Responses
+// older than "maxAge" are not served; a "maxAge" of zero means that responses never expire.
+// Responses are cached by URL, so the service should not be shared by callers with different access rights. The
+// cache wraps the transport of the service's current HTTP client, so it should be enabled after the client has been
+// configured (e.g. after EnableRetries, so that stale responses are only served once the retries are exhausted).
+func EnableServeStaleOnError(service *core.BaseService, maxAge time.Duration) *StaleCache {
+	client := service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	cachingClient := *client
+	cache := &StaleCache{
+		Transport: client.Transport,
+		MaxAge:    maxAge,
+	}
+	cachingClient.Transport = cache
+	service.SetHTTPClient(&cachingClient)
+	return cache
+}
+
+// IsStaleResponse returns true if the response was served by a StaleCache because the live request failed.
+func IsStaleResponse(response *core.DetailedResponse) bool {
+	if response == nil {
+		return false
+	}
+	return strings.HasPrefix(response.GetHeaders().Get(headerNameWarning), "110 ")
+}
+
+// StaleResponseAge returns the age of a response served by a StaleCache, or zero if the response is not stale.
+func StaleResponseAge(response *core.DetailedResponse) time.Duration {
+	if !IsStaleResponse(response) {
+		return 0
+	}
+	seconds, _ := strconv.ParseInt(response.GetHeaders().Get(headerNameAge), 10, 64)
+	return time.Duration(seconds) * time.Second
+}
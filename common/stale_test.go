@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServeStaleOnError(t *testing.T) {
+	var mutex sync.Mutex
+	var status int
+	var delay time.Duration
+	var calls int
+	respond := func(newStatus int, newDelay time.Duration) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		status, delay = newStatus, newDelay
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		mutex.Lock()
+		calls++
+		call, currentStatus, currentDelay := calls, status, delay
+		mutex.Unlock()
+		time.Sleep(currentDelay)
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(currentStatus)
+		fmt.Fprintf(res, `{"path": "%s", "call": %d}`, req.URL.Path, call)
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	service.GetHTTPClient().Timeout = 200 * time.Millisecond
+	cache := EnableServeStaleOnError(service, time.Hour)
+	var causes []string
+	cache.OnStale = func(req *http.Request, cause error) {
+		causes = append(causes, req.URL.Path)
+	}
+
+	get := func(path string) (result map[string]interface{}, response *core.DetailedResponse, err error) {
+		builder := core.NewRequestBuilder(core.GET)
+		_, err = builder.ResolveRequestURL(server.URL, path, nil)
+		assert.Nil(t, err)
+		req, err := builder.Build()
+		assert.Nil(t, err)
+		response, err = service.Request(req, &result)
+		return
+	}
+
+	respond(200, 0)
+	result, response, err := get("/v1/things")
+	assert.Nil(t, err)
+	assert.False(t, IsStaleResponse(response))
+	assert.Equal(t, float64(1), result["call"])
+	assert.Equal(t, 1, cache.Len())
+
+	// A server error is replaced by the retained response.
+	respond(503, 0)
+	result, response, err = get("/v1/things")
+	assert.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.True(t, IsStaleResponse(response))
+	assert.Equal(t, time.Duration(0), StaleResponseAge(response))
+	assert.Equal(t, float64(1), result["call"])
+
+	// So is a timeout.
+	respond(200, 500*time.Millisecond)
+	result, response, err = get("/v1/things")
+	assert.Nil(t, err)
+	assert.True(t, IsStaleResponse(response))
+	assert.Equal(t, float64(1), result["call"])
+
+	// Requests without a retained response fail as usual, as do client errors.
+	respond(503, 0)
+	_, response, err = get("/v1/other")
+	assert.NotNil(t, err)
+	assert.Equal(t, 503, response.StatusCode)
+	respond(404, 0)
+	_, _, err = get("/v1/things")
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"/v1/things", "/v1/things"}, causes)
+
+	// Expired responses are not served.
+	cache.MaxAge = time.Nanosecond
+	respond(500, 0)
+	_, _, err = get("/v1/things")
+	assert.NotNil(t, err)
+
+	cache.Clear()
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestStaleCacheMaxEntries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(res, "ok")
+	}))
+	defer server.Close()
+
+	cache := &StaleCache{MaxEntries: 2}
+	client := &http.Client{Transport: cache}
+	for _, path := range []string{"/a", "/b", "/a", "/c"} {
+		resp, err := client.Get(server.URL + path)
+		assert.Nil(t, err)
+		resp.Body.Close()
+	}
+	assert.Equal(t, 2, cache.Len())
+	assert.NotContains(t, cache.entries, server.URL+"/b")
+
+	resp, err := client.Post(server.URL+"/d", "text/plain", nil)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 2, cache.Len())
+	assert.False(t, IsStaleResponse(nil))
+}
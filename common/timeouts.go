@@ -0,0 +1,375 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// OperationClass : The class of an operation, which determines its timeout and retry policy.
+type OperationClass string
+
+// The classes of operations.
+const (
+	// An operation that reads a single resource or a short list.
+	OperationClassFastRead OperationClass = "fast_read"
+
+	// An operation that reads a large amount of data, such as a search or a usage report.
+	OperationClassSlowRead OperationClass = "slow_read"
+
+	// An operation that creates, updates or deletes a resource.
+	OperationClassMutate OperationClass = "mutate"
+
+	// An operation that takes minutes to respond, such as an upload or an import.
+	OperationClassLongRunning OperationClass = "long_running"
+)
+
+// OperationPolicy : The timeout and retry policy of a class of operations.
+type OperationPolicy struct {
+	// The maximum time to wait for a response to each attempt, including the time to read its body. If zero, an
+	// attempt is only bounded by the context of the request.
+	Timeout time.Duration
+
+	// The number of times a failed attempt is retried. Attempts are retried after a connection error or a timeout, or
+	// a status code of 429, 502, 503 or 504; the attempts of a non-idempotent request (POST or PATCH) are only retried
+	// after a status code of 429, since the others may have been processed.
+	MaxRetries int
+
+	// The interval before the first retry, doubled before each subsequent retry. A Retry-After header with a longer
+	// interval takes precedence. Defaults to 1 second.
+	RetryInterval time.Duration
+
+	// The maximum interval between retries computed from RetryInterval; a longer Retry-After interval still takes
+	// precedence. If zero, the interval is not capped.
+	MaxRetryInterval time.Duration
+
+	// The fraction of the interval between retries, from 0 to 1, that is randomly removed so that the clients that
+	// failed at the same time do not retry at the same time. The Retry-After interval is not shortened.
+	Jitter float64
+
+	// The maximum time from the first attempt until the start of a retry: a retry that would start later is not
+	// attempted, and the response or error of the last attempt is returned. If zero, only MaxRetries bounds the
+	// retries.
+	MaxElapsed time.Duration
+}
+
+// RetryEvent : Describes a retry of a request by an OperationTimeouts transport, for the OnRetry hook.
+type RetryEvent struct {
+	// The request.
+	Request *http.Request
+
+	// The class of the request.
+	Class OperationClass
+
+	// The number of the retry, starting at 1.
+	Retry int
+
+	// The status code of the failed attempt, or 0 if it failed with an error.
+	StatusCode int
+
+	// The error of the failed attempt.
+	Err error
+
+	// The interval before the retry, and whether it was set by the Retry-After header of the failed attempt.
+	Wait           time.Duration
+	FromRetryAfter bool
+
+	// The time elapsed since the first attempt.
+	Elapsed time.Duration
+}
+
+// DefaultOperationPolicies are the policies of the classes of operations, used when an OperationTimeouts does not
+// override them.
+var DefaultOperationPolicies = map[OperationClass]OperationPolicy{
+	OperationClassFastRead:    {Timeout: 30 * time.Second, MaxRetries: 3, RetryInterval: time.Second},
+	OperationClassSlowRead:    {Timeout: 2 * time.Minute, MaxRetries: 2, RetryInterval: 2 * time.Second},
+	OperationClassMutate:      {Timeout: time.Minute, MaxRetries: 2, RetryInterval: time.Second},
+	OperationClassLongRunning: {Timeout: 10 * time.Minute},
+}
+
+// OperationClassRule : Assigns a class to the operations with a method and path.
+type OperationClassRule struct {
+	// The HTTP method of the operation.
+	Method string
+
+	// The path of the operation, in which parameters are written in braces (e.g. "/v2/accounts/{account_id}/users").
+	// A request matches if the trailing segments of its path match.
+	Path string
+
+	// The class of the operation.
+	Class OperationClass
+}
+
+// DefaultOperationClassRules are the rules that classify the operations of the services whose class cannot be
+// derived from their method: the operations that read through a POST, and the slowest reads and mutations.
+var DefaultOperationClassRules = []OperationClassRule{
+	{Method: http.MethodPost, Path: "/v3/resources/search", Class: OperationClassSlowRead},
+	{Method: http.MethodGet, Path: "/v4/accounts/{account_id}/resource_instances/usage/{billingmonth}", Class: OperationClassSlowRead},
+	{Method: http.MethodGet, Path: "/v4/accounts/{account_id}/usage/{billingmonth}", Class: OperationClassSlowRead},
+	{Method: http.MethodPost, Path: "/catalogs/{catalog_identifier}/import/offerings", Class: OperationClassLongRunning},
+	{Method: http.MethodPost, Path: "/catalogs/{catalog_identifier}/offerings/{offering_id}/version", Class: OperationClassLongRunning},
+	{Method: http.MethodPut, Path: "/cases/{case_number}/attachments", Class: OperationClassLongRunning},
+}
+
+type operationClassKey struct{}
+
+// WithOperationClass returns a copy of "ctx" that assigns the specified class to the requests sent with it, whatever
+// their method and path.
+func WithOperationClass(ctx context.Context, class OperationClass) context.Context {
+	return context.WithValue(ctx, operationClassKey{}, class)
+}
+
+// OperationTimeouts : An http.RoundTripper that applies the timeout and retry policy of the class of each request.
+// The class of a request is the one carried by its context (see WithOperationClass), otherwise the one assigned by the
+// most specific rule that matches it, otherwise OperationClassFastRead for a GET or HEAD request and
+// OperationClassMutate for other requests.
+type OperationTimeouts struct {
+	// The transport used to send requests. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// The policies that replace the entries of DefaultOperationPolicies for this client.
+	Policies map[OperationClass]OperationPolicy
+
+	// The rules that classify the operations of this client, which take precedence over DefaultOperationClassRules.
+	Rules []OperationClassRule
+
+	// If set, called before each retry, for example to count or log the retries. It must not modify the request.
+	OnRetry func(event RetryEvent)
+}
+
+// Classify returns the class of a request.
+func (timeouts *OperationTimeouts) Classify(req *http.Request) OperationClass {
+	if class, ok := req.Context().Value(operationClassKey{}).(OperationClass); ok && class != "" {
+		return class
+	}
+	if class := matchOperationClassRule(timeouts.Rules, req); class != "" {
+		return class
+	}
+	if class := matchOperationClassRule(DefaultOperationClassRules, req); class != "" {
+		return class
+	}
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return OperationClassFastRead
+	}
+	return OperationClassMutate
+}
+
+// Policy returns the policy of a class of operations.
+func (timeouts *OperationTimeouts) Policy(class OperationClass) OperationPolicy {
+	if policy, ok := timeouts.Policies[class]; ok {
+		return policy
+	}
+	return DefaultOperationPolicies[class]
+}
+
+// RoundTrip sends the request, retrying it as specified by the policy of its class.
+func (timeouts *OperationTimeouts) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := timeouts.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	class := timeouts.Classify(req)
+	policy := timeouts.Policy(class)
+	interval := policy.RetryInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	idempotent := req.Method != http.MethodPost && req.Method != http.MethodPatch
+	// A request whose body cannot be sent again is not retried.
+	maxRetries := policy.MaxRetries
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		maxRetries = 0
+	}
+
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+		cancel := context.CancelFunc(func() {})
+		if policy.Timeout > 0 {
+			var ctx context.Context
+			ctx, cancel = context.WithTimeout(req.Context(), policy.Timeout)
+			attemptReq = attemptReq.WithContext(ctx)
+		}
+
+		resp, err := transport.RoundTrip(attemptReq)
+		retry := attempt < maxRetries && req.Context().Err() == nil && shouldRetryAttempt(resp, err, idempotent)
+		var wait time.Duration
+		var fromRetryAfter bool
+		if retry {
+			wait = policy.backoff(interval, attempt)
+			if resp != nil {
+				if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok && retryAfter > wait {
+					wait, fromRetryAfter = retryAfter, true
+				}
+			}
+			retry = policy.MaxElapsed <= 0 || time.Since(start)+wait <= policy.MaxElapsed
+		}
+		if !retry {
+			if err != nil || resp.Body == nil {
+				cancel()
+				return resp, err
+			}
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		if timeouts.OnRetry != nil {
+			event := RetryEvent{
+				Request:        req,
+				Class:          class,
+				Retry:          attempt + 1,
+				Err:            err,
+				Wait:           wait,
+				FromRetryAfter: fromRetryAfter,
+				Elapsed:        time.Since(start),
+			}
+			if resp != nil {
+				event.StatusCode = resp.StatusCode
+			}
+			timeouts.OnRetry(event)
+		}
+		if resp != nil {
+			if resp.Body != nil {
+				_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+				resp.Body.Close()
+			}
+		}
+		cancel()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// backoff returns the interval before the retry that follows attempt number "attempt" (starting at 0): "interval"
+// doubled for each previous retry, capped by MaxRetryInterval and reduced by a random part of up to Jitter.
+func (policy OperationPolicy) backoff(interval time.Duration, attempt int) time.Duration {
+	wait := interval << uint(attempt)
+	if wait <= 0 || (policy.MaxRetryInterval > 0 && wait > policy.MaxRetryInterval) {
+		wait = policy.MaxRetryInterval
+	}
+	if jitter := math.Min(math.Max(policy.Jitter, 0), 1); jitter > 0 {
+		wait -= time.Duration(rand.Float64() * jitter * float64(wait)) // #nosec G404
+	}
+	return wait
+}
+
+// parseRetryAfter returns the interval specified by a Retry-After header, which is either a number of seconds or an
+// HTTP date, and whether the header is valid.
+func parseRetryAfter(header string, now time.Time) (wait time.Duration, ok bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, seconds >= 0
+	}
+	date, err := http.ParseTime(header)
+	if err != nil {
+		return 0, false
+	}
+	if wait = date.Sub(now); wait < 0 {
+		wait = 0
+	}
+	return wait, true
+}
+
+// shouldRetryAttempt returns true if an attempt failed in a way that another attempt may not.
+func shouldRetryAttempt(resp *http.Response, err error, idempotent bool) bool {
+	if err != nil {
+		return idempotent
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent
+	}
+	return false
+}
+
+// matchOperationClassRule returns the class assigned by the most specific rule that matches a request, or an empty
+// class.
+func matchOperationClassRule(rules []OperationClassRule, req *http.Request) (class OperationClass) {
+	pathSegments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	longest := 0
+	for _, rule := range rules {
+		if !strings.EqualFold(rule.Method, req.Method) {
+			continue
+		}
+		ruleSegments := strings.Split(strings.Trim(rule.Path, "/"), "/")
+		if len(ruleSegments) > longest && matchPathSegments(ruleSegments, pathSegments) {
+			class, longest = rule.Class, len(ruleSegments)
+		}
+	}
+	return
+}
+
+// cancelOnClose : A response body that releases the context of its attempt once it is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (body *cancelOnClose) Close() error {
+	err := body.ReadCloser.Close()
+	body.cancel()
+	return err
+}
+
+// EnableOperationTimeouts configures "service" to apply the timeout and retry policy of the class of each operation,
+// and returns the transport so that its policies and rules can be adjusted. If "timeouts" is nil, the default policies
+// and rules are used. The timeout of the service's HTTP client is removed, since the policies bound each attempt, and
+// the retries of EnableRetries are disabled, since the policies include retries. The transport wraps the transport of
+// the service's current HTTP client, so it should be enabled after the client has been configured (e.g. after
+// DisableSSLVerification).
+func EnableOperationTimeouts(service *core.BaseService, timeouts *OperationTimeouts) *OperationTimeouts {
+	if timeouts == nil {
+		timeouts = &OperationTimeouts{}
+	}
+	service.DisableRetries()
+	client := service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	timeoutClient := *client
+	timeoutClient.Timeout = 0
+	timeouts.Transport = client.Transport
+	timeoutClient.Transport = timeouts
+	service.SetHTTPClient(&timeoutClient)
+	return timeouts
+}
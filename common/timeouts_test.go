@@ -0,0 +1,244 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyOperations(t *testing.T) {
+	timeouts := &OperationTimeouts{
+		Rules: []OperationClassRule{{Method: http.MethodGet, Path: "/v2/accounts/{account_id}/users", Class: OperationClassSlowRead}},
+	}
+	classify := func(ctx context.Context, method string, url string) OperationClass {
+		req, err := http.NewRequestWithContext(ctx, method, url, nil)
+		assert.Nil(t, err)
+		return timeouts.Classify(req)
+	}
+	ctx := context.Background()
+	assert.Equal(t, OperationClassFastRead, classify(ctx, http.MethodGet, "https://example.com/v2/accounts/acct"))
+	assert.Equal(t, OperationClassMutate, classify(ctx, http.MethodDelete, "https://example.com/v2/accounts/acct"))
+	assert.Equal(t, OperationClassSlowRead, classify(ctx, http.MethodGet, "https://example.com/v2/accounts/acct/users"))
+	assert.Equal(t, OperationClassSlowRead, classify(ctx, http.MethodPost, "https://example.com/v3/resources/search?limit=10"))
+	assert.Equal(t, OperationClassLongRunning, classify(ctx, http.MethodPost, "https://example.com/api/v1-beta/catalogs/cat/import/offerings"))
+	assert.Equal(t, OperationClassLongRunning, classify(WithOperationClass(ctx, OperationClassLongRunning), http.MethodGet, "https://example.com/v2/accounts/acct"))
+
+	timeouts.Policies = map[OperationClass]OperationPolicy{OperationClassMutate: {Timeout: time.Second}}
+	assert.Equal(t, OperationPolicy{Timeout: time.Second}, timeouts.Policy(OperationClassMutate))
+	assert.Equal(t, DefaultOperationPolicies[OperationClassFastRead], timeouts.Policy(OperationClassFastRead))
+}
+
+func TestOperationTimeouts(t *testing.T) {
+	var mutex sync.Mutex
+	var statuses []int
+	var delay time.Duration
+	var calls []string
+	respond := func(newStatuses []int, newDelay time.Duration) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		statuses, delay = newStatuses, newDelay
+	}
+	recordedCalls := func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]string(nil), calls...)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		mutex.Lock()
+		calls = append(calls, req.Method+" "+strings.TrimSpace(string(body)))
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+		currentDelay := delay
+		mutex.Unlock()
+		time.Sleep(currentDelay)
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(status)
+		fmt.Fprintf(res, `{"status": %d}`, status)
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	service.EnableRetries(4, time.Second)
+	service.GetHTTPClient().Timeout = 10 * time.Millisecond
+	timeouts := EnableOperationTimeouts(service, &OperationTimeouts{Policies: map[OperationClass]OperationPolicy{
+		OperationClassFastRead: {Timeout: 50 * time.Millisecond, MaxRetries: 2, RetryInterval: time.Millisecond},
+		OperationClassMutate:   {Timeout: 50 * time.Millisecond, MaxRetries: 2, RetryInterval: time.Millisecond},
+	}})
+	assert.Equal(t, time.Duration(0), service.GetHTTPClient().Timeout)
+	assert.Equal(t, timeouts, service.GetHTTPClient().Transport)
+
+	send := func(ctx context.Context, method string, body interface{}) (result map[string]interface{}, response *core.DetailedResponse, err error) {
+		mutex.Lock()
+		calls = nil
+		mutex.Unlock()
+		builder := core.NewRequestBuilder(method).WithContext(ctx)
+		_, err = builder.ResolveRequestURL(server.URL, "/v1/things", nil)
+		assert.Nil(t, err)
+		if body != nil {
+			_, err = builder.SetBodyContentJSON(body)
+			assert.Nil(t, err)
+		}
+		req, err := builder.Build()
+		assert.Nil(t, err)
+		response, err = service.Request(req, &result)
+		return
+	}
+
+	// Transient failures are retried, with the body of the request sent again.
+	respond([]int{503, 429, 200}, 0)
+	result, _, err := send(context.Background(), core.PUT, map[string]string{"name": "thing"})
+	assert.Nil(t, err)
+	assert.Equal(t, float64(200), result["status"])
+	assert.Equal(t, []string{`PUT {"name":"thing"}`, `PUT {"name":"thing"}`, `PUT {"name":"thing"}`}, recordedCalls())
+
+	// A non-idempotent request is only retried after a 429.
+	respond([]int{503, 200}, 0)
+	_, response, err := send(context.Background(), core.POST, map[string]string{"name": "thing"})
+	assert.NotNil(t, err)
+	assert.Equal(t, 503, response.StatusCode)
+	assert.Len(t, recordedCalls(), 1)
+
+	// The retries are bounded by the policy.
+	respond([]int{502}, 0)
+	_, response, err = send(context.Background(), core.GET, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 502, response.StatusCode)
+	assert.Len(t, recordedCalls(), 3)
+
+	// Each attempt is bounded by the timeout of its class, which replaces the timeout of the client.
+	respond([]int{200}, 20*time.Millisecond)
+	_, _, err = send(context.Background(), core.GET, nil)
+	assert.Nil(t, err)
+	respond([]int{200}, 80*time.Millisecond)
+	_, _, err = send(context.Background(), core.GET, nil)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Len(t, recordedCalls(), 3)
+
+	// A cancelled request is not retried.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	_, _, err = send(ctx, core.GET, nil)
+	assert.NotNil(t, err)
+	assert.Len(t, recordedCalls(), 1)
+}
+
+func TestAdaptiveRetries(t *testing.T) {
+	var retryAfter []string
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		calls++
+		if len(retryAfter) > 0 {
+			res.Header().Set("Retry-After", retryAfter[0])
+			retryAfter = retryAfter[1:]
+			res.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		res.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var events []RetryEvent
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	timeouts := EnableOperationTimeouts(service, &OperationTimeouts{
+		Policies: map[OperationClass]OperationPolicy{
+			OperationClassFastRead: {MaxRetries: 5, RetryInterval: time.Millisecond, MaxElapsed: 500 * time.Millisecond},
+		},
+		OnRetry: func(event RetryEvent) {
+			events = append(events, event)
+		},
+	})
+	get := func() int {
+		calls = 0
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/v1/things", nil)
+		assert.Nil(t, err)
+		res, err := service.GetHTTPClient().Do(req)
+		assert.Nil(t, err)
+		res.Body.Close()
+		return res.StatusCode
+	}
+
+	// The Retry-After header is honored, and each retry is reported.
+	retryAfter = []string{"invalid", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)}
+	assert.Equal(t, 200, get())
+	assert.Equal(t, 3, calls)
+	assert.Len(t, events, 2)
+	assert.Equal(t, 1, events[0].Retry)
+	assert.Equal(t, OperationClassFastRead, events[0].Class)
+	assert.Equal(t, 429, events[0].StatusCode)
+	assert.Equal(t, time.Millisecond, events[0].Wait)
+	assert.False(t, events[0].FromRetryAfter)
+	assert.Equal(t, 2, events[1].Retry)
+	assert.Equal(t, 2*time.Millisecond, events[1].Wait)
+	assert.Equal(t, "/v1/things", events[1].Request.URL.Path)
+
+	// A retry that would start after MaxElapsed is not attempted.
+	events = nil
+	retryAfter = []string{"1"}
+	start := time.Now()
+	assert.Equal(t, 429, get())
+	assert.Equal(t, 1, calls)
+	assert.Empty(t, events)
+	assert.True(t, time.Since(start) < 500*time.Millisecond)
+
+	timeouts.Policies[OperationClassFastRead] = OperationPolicy{MaxRetries: 1, RetryInterval: time.Millisecond}
+	retryAfter = []string{"0"}
+	assert.Equal(t, 200, get())
+	assert.Equal(t, 2, calls)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := OperationPolicy{MaxRetryInterval: 5 * time.Second}
+	assert.Equal(t, time.Second, policy.backoff(time.Second, 0))
+	assert.Equal(t, 4*time.Second, policy.backoff(time.Second, 2))
+	assert.Equal(t, 5*time.Second, policy.backoff(time.Second, 3))
+	assert.Equal(t, 5*time.Second, policy.backoff(time.Second, 80))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		wait := policy.backoff(time.Second, 1)
+		assert.True(t, wait > time.Second && wait <= 2*time.Second, "wait %s", wait)
+	}
+
+	now := time.Date(2022, time.June, 1, 12, 0, 0, 0, time.UTC)
+	wait, ok := parseRetryAfter("120", now)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, wait)
+	wait, ok = parseRetryAfter("Wed, 01 Jun 2022 12:00:30 GMT", now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, wait)
+	_, ok = parseRetryAfter("-1", now)
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("", now)
+	assert.False(t, ok)
+}
@@ -0,0 +1,314 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// TransportConfig : The network settings of the HTTP clients used by services in an enterprise network: an HTTP(S)
+// proxy, the hosts that bypass it, and the certificates needed when TLS connections are inspected by a proxy or
+// require client authentication. Apply it to a service with ConfigureTransport.
+type TransportConfig struct {
+	// The URL of the proxy used for HTTP and HTTPS requests (e.g. "http://proxy.example.com:3128"), which may include
+	// credentials. If empty, the proxy is taken from the HTTP_PROXY and HTTPS_PROXY environment variables.
+	ProxyURL string
+
+	// The hosts that are contacted directly rather than through the proxy. An entry is a host name, which also
+	// matches its subdomains; a domain with a leading "." (e.g. ".example.com"), which matches only subdomains; an IP
+	// address; a CIDR block; or "*", which disables the proxy. An entry may include a port. If nil, the NO_PROXY
+	// environment variable is used.
+	NoProxy []string
+
+	// The path of a PEM file containing CA certificates to trust in addition to the system's, such as the
+	// certificate of a TLS-inspecting proxy.
+	CABundleFile string
+
+	// PEM-encoded CA certificates to trust in addition to the system's.
+	CABundlePEM []byte
+
+	// The paths of the PEM-encoded certificate and private key presented to servers that require TLS client
+	// authentication. Both or neither must be set.
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+// NewTransport returns a new transport with the settings of the configuration, based on http.DefaultTransport.
+func (config *TransportConfig) NewTransport() (transport *http.Transport, err error) {
+	return config.configure(nil)
+}
+
+// configure returns a copy of "base" (or of http.DefaultTransport if "base" is nil) with the settings of the
+// configuration.
+func (config *TransportConfig) configure(base *http.Transport) (transport *http.Transport, err error) {
+	if base == nil {
+		base = http.DefaultTransport.(*http.Transport)
+	}
+	transport = base.Clone()
+
+	proxy, err := config.proxyFunc()
+	if err != nil {
+		return nil, err
+	}
+	transport.Proxy = proxy
+
+	if config.CABundleFile == "" && len(config.CABundlePEM) == 0 && config.ClientCertFile == "" && config.ClientKeyFile == "" {
+		return
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12} // #nosec G402
+	}
+
+	if config.CABundleFile != "" || len(config.CABundlePEM) > 0 {
+		var pool *x509.CertPool
+		pool, err = config.certPool()
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if config.ClientCertFile != "" || config.ClientKeyFile != "" {
+		if config.ClientCertFile == "" || config.ClientKeyFile == "" {
+			return nil, fmt.Errorf("both the client certificate and the client key must be specified")
+		}
+		var certificate tls.Certificate
+		certificate, err = tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading the client certificate: %w", err)
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return
+}
+
+// certPool returns the system's certificate pool with the configured CA certificates added.
+func (config *TransportConfig) certPool() (pool *x509.CertPool, err error) {
+	pool, err = x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+		err = nil
+	}
+	if config.CABundleFile != "" {
+		var data []byte
+		data, err = ioutil.ReadFile(config.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the CA bundle: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("the CA bundle '%s' contains no PEM-encoded certificates", config.CABundleFile)
+		}
+	}
+	if len(config.CABundlePEM) > 0 && !pool.AppendCertsFromPEM(config.CABundlePEM) {
+		return nil, fmt.Errorf("the CA bundle contains no PEM-encoded certificates")
+	}
+	return
+}
+
+// proxyFunc returns the function that selects the proxy for a request, for use as http.Transport.Proxy.
+func (config *TransportConfig) proxyFunc() (proxy func(*http.Request) (*url.URL, error), err error) {
+	var proxyURL *url.URL
+	if config.ProxyURL != "" {
+		proxyURL, err = url.Parse(config.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL '%s'", config.ProxyURL)
+		}
+	}
+	noProxy := config.NoProxy
+	if noProxy == nil {
+		noProxy = strings.Split(getenv("NO_PROXY", "no_proxy"), ",")
+	}
+
+	proxy = func(req *http.Request) (*url.URL, error) {
+		if bypassProxy(req.URL, noProxy) {
+			return nil, nil
+		}
+		if proxyURL != nil {
+			return proxyURL, nil
+		}
+		return http.ProxyFromEnvironment(req)
+	}
+	return
+}
+
+func getenv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// bypassProxy returns true if the host of "target" matches an entry of "noProxy" (see TransportConfig.NoProxy).
+func bypassProxy(target *url.URL, noProxy []string) bool {
+	host := strings.ToLower(target.Hostname())
+	port := target.Port()
+	if port == "" {
+		port = map[string]string{"http": "80", "https": "443"}[target.Scheme]
+	}
+	ip := net.ParseIP(host)
+
+	for _, entry := range noProxy {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+		if _, block, err := net.ParseCIDR(entry); err == nil {
+			if ip != nil && block.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if entryHost, entryPort, err := net.SplitHostPort(entry); err == nil {
+			if entryPort != port {
+				continue
+			}
+			entry = entryHost
+		}
+		if ip != nil {
+			if entryIP := net.ParseIP(entry); entryIP != nil && entryIP.Equal(ip) {
+				return true
+			}
+			continue
+		}
+		if strings.HasPrefix(entry, ".") {
+			if strings.HasSuffix(host, entry) {
+				return true
+			}
+			continue
+		}
+		if host == entry || strings.HasSuffix(host, "."+entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigureTransport applies "config" to the HTTP client of "service" and to the client used by its authenticator
+// to obtain tokens, so that every request made on behalf of the service uses the same proxy and certificates.
+// Retries and the transports installed by EnableServeStaleOnError, EnableResponseValidation, EnableWorkflowIDs,
+// EnableOperationTimeouts and EnableRateLimiting are preserved; a transport installed by other means must be an
+// *http.Transport. Settings made earlier with DisableSSLVerification are also preserved.
+func ConfigureTransport(service *core.BaseService, config *TransportConfig) (err error) {
+	err = core.ValidateNotNil(config, "config cannot be nil")
+	if err != nil {
+		return
+	}
+
+	client := service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	configuredClient := *client
+	configuredClient.Transport, err = config.wrap(client.Transport)
+	if err != nil {
+		return
+	}
+	service.SetHTTPClient(&configuredClient)
+
+	if service.Options == nil {
+		return
+	}
+	switch authenticator := service.Options.Authenticator.(type) {
+	case *core.IamAuthenticator:
+		authenticator.Client, err = config.tokenClient(authenticator.Client)
+	case *core.ContainerAuthenticator:
+		authenticator.Client, err = config.tokenClient(authenticator.Client)
+	case *core.VpcInstanceAuthenticator:
+		authenticator.Client, err = config.tokenClient(authenticator.Client)
+	case *core.CloudPakForDataAuthenticator:
+		authenticator.Client, err = config.tokenClient(authenticator.Client)
+	}
+	return
+}
+
+// wrap returns "transport" with the configuration applied to the *http.Transport at its base.
+func (config *TransportConfig) wrap(transport http.RoundTripper) (configured http.RoundTripper, err error) {
+	switch base := transport.(type) {
+	case nil:
+		return config.configure(nil)
+	case *http.Transport:
+		return config.configure(base)
+	case *StaleCache:
+		base.Transport, err = config.wrap(base.Transport)
+		return base, err
+	case *ResponseValidator:
+		base.Transport, err = config.wrap(base.Transport)
+		return base, err
+	case *WorkflowTransport:
+		base.Transport, err = config.wrap(base.Transport)
+		return base, err
+	case *OperationTimeouts:
+		base.Transport, err = config.wrap(base.Transport)
+		return base, err
+	case *RateLimitTransport:
+		base.Transport, err = config.wrap(base.Transport)
+		return base, err
+	default:
+		return nil, fmt.Errorf("unable to configure a transport of type %T", transport)
+	}
+}
+
+// BaseTransport returns the transport at the base of "transport", without the wrappers of this package
+// (OperationTimeouts, StaleCache, ResponseValidator, WorkflowTransport and RateLimitTransport), or nil if the base is
+// the default transport. It carries the proxy and TLS settings of a client, and can be used for requests that the
+// wrappers do not apply to, such as the download of a large object from a presigned URL.
+func BaseTransport(transport http.RoundTripper) http.RoundTripper {
+	for {
+		switch wrapper := transport.(type) {
+		case *StaleCache:
+			transport = wrapper.Transport
+		case *ResponseValidator:
+			transport = wrapper.Transport
+		case *WorkflowTransport:
+			transport = wrapper.Transport
+		case *OperationTimeouts:
+			transport = wrapper.Transport
+		case *RateLimitTransport:
+			transport = wrapper.Transport
+		default:
+			return transport
+		}
+	}
+}
+
+// tokenClient returns a copy of the HTTP client of an authenticator with the configuration applied.
+func (config *TransportConfig) tokenClient(client *http.Client) (configured *http.Client, err error) {
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	copied := *client
+	copied.Transport, err = config.wrap(client.Transport)
+	if err != nil {
+		return client, err
+	}
+	return &copied, nil
+}
@@ -0,0 +1,195 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigureTransportProxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		proxied = append(proxied, req.URL.String())
+		fmt.Fprint(res, "{}")
+	}))
+	defer proxy.Close()
+
+	authenticator := &core.IamAuthenticator{ApiKey: "apikey"}
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: "http://api.example.test", Authenticator: authenticator})
+	assert.Nil(t, err)
+	service.EnableRetries(2, time.Second)
+	cache := EnableServeStaleOnError(service, time.Hour)
+
+	config := &TransportConfig{ProxyURL: proxy.URL, NoProxy: []string{"internal.example.test"}}
+	assert.Nil(t, ConfigureTransport(service, config))
+	assert.Same(t, cache, service.GetHTTPClient().Transport)
+	assert.IsType(t, &http.Transport{}, cache.Transport)
+
+	response, err := service.Client.Get("http://api.example.test/v1/things")
+	assert.Nil(t, err)
+	response.Body.Close()
+	assert.Equal(t, []string{"http://api.example.test/v1/things"}, proxied)
+
+	// The authenticator obtains tokens through the same proxy.
+	assert.NotNil(t, authenticator.Client)
+	proxyFunc := authenticator.Client.Transport.(*http.Transport).Proxy
+	req, _ := http.NewRequest(http.MethodPost, "https://iam.cloud.ibm.com/identity/token", nil)
+	proxyURL, err := proxyFunc(req)
+	assert.Nil(t, err)
+	assert.Equal(t, proxy.URL, proxyURL.String())
+	req, _ = http.NewRequest(http.MethodGet, "http://api.internal.example.test/", nil)
+	proxyURL, err = proxyFunc(req)
+	assert.Nil(t, err)
+	assert.Nil(t, proxyURL)
+}
+
+func TestBypassProxy(t *testing.T) {
+	noProxy := []string{"example.com", ".corp.test", "10.0.0.0/8", "192.168.1.1", "api.test:8443", " "}
+	for target, expected := range map[string]bool{
+		"https://example.com/":          true,
+		"https://api.EXAMPLE.com/":      true,
+		"https://notexample.com/":       false,
+		"https://corp.test/":            false,
+		"https://a.corp.test/":          true,
+		"http://10.1.2.3/":              true,
+		"http://11.1.2.3/":              false,
+		"http://192.168.1.1:8080/":      true,
+		"https://api.test:8443/":        true,
+		"https://api.test/":             false,
+		"https://cloud.ibm.com/":        false,
+		"http://[::1]/":                 false,
+		"https://sub.example.com:8443/": true,
+	} {
+		parsed, err := url.Parse(target)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, bypassProxy(parsed, noProxy), target)
+	}
+	parsed, _ := url.Parse("https://cloud.ibm.com/")
+	assert.True(t, bypassProxy(parsed, []string{"*"}))
+}
+
+func TestConfigureTransportCertificates(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(res, "%d", len(req.TLS.PeerCertificates))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	assert.Nil(t, ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+	certFile, keyFile := writeClientCertificate(t, dir)
+
+	get := func(config *TransportConfig) (body string, err error) {
+		service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+		assert.Nil(t, err)
+		err = ConfigureTransport(service, config)
+		if err != nil {
+			return
+		}
+		response, err := service.Client.Get(server.URL)
+		if err != nil {
+			return
+		}
+		defer response.Body.Close()
+		data, err := ioutil.ReadAll(response.Body)
+		return string(data), err
+	}
+
+	_, err := get(&TransportConfig{NoProxy: []string{"*"}})
+	assert.NotNil(t, err)
+	_, err = get(&TransportConfig{NoProxy: []string{"*"}, CABundleFile: caFile})
+	assert.NotNil(t, err)
+	body, err := get(&TransportConfig{NoProxy: []string{"*"}, CABundleFile: caFile, ClientCertFile: certFile, ClientKeyFile: keyFile})
+	assert.Nil(t, err)
+	assert.Equal(t, "1", body)
+}
+
+func TestConfigureTransportErrors(t *testing.T) {
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: "https://api.example.test", Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+
+	assert.NotNil(t, ConfigureTransport(service, nil))
+	assert.NotNil(t, ConfigureTransport(service, &TransportConfig{ProxyURL: "not a url"}))
+	assert.NotNil(t, ConfigureTransport(service, &TransportConfig{CABundlePEM: []byte("not a certificate")}))
+	assert.NotNil(t, ConfigureTransport(service, &TransportConfig{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")}))
+	assert.NotNil(t, ConfigureTransport(service, &TransportConfig{ClientCertFile: "cert.pem"}))
+
+	service.Client.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) { return nil, nil })
+	assert.NotNil(t, ConfigureTransport(service, &TransportConfig{}))
+}
+
+func TestBaseTransport(t *testing.T) {
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: "https://api.example.test", Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	assert.Nil(t, ConfigureTransport(service, &TransportConfig{ProxyURL: "http://proxy.example.test:3128"}))
+	base := service.Client.Transport
+	EnableOperationTimeouts(service, &OperationTimeouts{})
+	EnableServeStaleOnError(service, time.Minute)
+	EnableWorkflowIDs(service)
+	assert.NotEqual(t, base, service.Client.Transport)
+	assert.Equal(t, base, BaseTransport(service.Client.Transport))
+
+	assert.Nil(t, BaseTransport(&StaleCache{}))
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func writeClientCertificate(t *testing.T, dir string) (certFile string, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+
+	certFile = filepath.Join(dir, "client.pem")
+	keyFile = filepath.Join(dir, "client-key.pem")
+	assert.Nil(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.Nil(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return
+}
@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/google/uuid"
+)
+
+// WorkflowIDHeader is the header that carries the workflow ID of a request. IBM Cloud services record its value as
+// the transaction ID of the request, so it appears in Activity Tracker events and can be quoted in support cases.
+const WorkflowIDHeader = "Transaction-Id"
+
+type workflowIDKey struct{}
+
+// NewWorkflowID returns a new, random workflow ID.
+func NewWorkflowID() string {
+	return uuid.New().String()
+}
+
+// WithWorkflowID returns a copy of "ctx" that carries the specified workflow ID.
+func WithWorkflowID(ctx context.Context, workflowID string) context.Context {
+	return context.WithValue(ctx, workflowIDKey{}, workflowID)
+}
+
+// WorkflowID returns the workflow ID carried by "ctx", or an empty string.
+func WorkflowID(ctx context.Context) string {
+	workflowID, _ := ctx.Value(workflowIDKey{}).(string)
+	return workflowID
+}
+
+// EnsureWorkflowID returns "ctx" and its workflow ID if it carries one, and otherwise a copy of "ctx" that carries a
+// new workflow ID. Composite helpers call it so that all the requests they make share one ID, and so that a helper
+// called by another one keeps the ID of the outer helper.
+func EnsureWorkflowID(ctx context.Context) (context.Context, string) {
+	if workflowID := WorkflowID(ctx); workflowID != "" {
+		return ctx, workflowID
+	}
+	workflowID := NewWorkflowID()
+	return WithWorkflowID(ctx, workflowID), workflowID
+}
+
+// EnsureWorkflowIDFor is EnsureWorkflowID for a composite helper that makes its requests with "services": the
+// returned context always carries a workflow ID, so that nested helpers share it, but the returned ID is empty unless
+// the requests of one of the services carry it (see WorkflowIDsEnabled). Helpers report the returned ID in their
+// errors and results, so that they never quote an ID that was not sent to, and recorded by, IBM Cloud.
+func EnsureWorkflowIDFor(ctx context.Context, services ...*core.BaseService) (context.Context, string) {
+	ctx, workflowID := EnsureWorkflowID(ctx)
+	for _, service := range services {
+		if WorkflowIDsEnabled(service) {
+			return ctx, workflowID
+		}
+	}
+	return ctx, ""
+}
+
+// WorkflowError : An error returned by a composite helper, annotated with the ID of its workflow.
+type WorkflowError struct {
+	// The ID of the workflow in which the error occurred.
+	WorkflowID string
+
+	// The error.
+	Err error
+}
+
+// Error returns the message of the error followed by the workflow ID.
+func (e *WorkflowError) Error() string {
+	return fmt.Sprintf("%s (workflow ID: %s)", e.Err.Error(), e.WorkflowID)
+}
+
+// Unwrap returns the annotated error.
+func (e *WorkflowError) Unwrap() error {
+	return e.Err
+}
+
+// WrapWorkflowError returns "err" annotated with the workflow ID, or nil if "err" is nil. An error that is already
+// annotated, such as one returned by a nested helper, is returned unchanged.
+func WrapWorkflowError(workflowID string, err error) error {
+	var workflowErr *WorkflowError
+	if err == nil || workflowID == "" || errors.As(err, &workflowErr) {
+		return err
+	}
+	return &WorkflowError{WorkflowID: workflowID, Err: err}
+}
+
+// WorkflowTransport : An http.RoundTripper that sets the WorkflowIDHeader header of each request whose context
+// carries a workflow ID. Requests that already have the header are sent unchanged.
+type WorkflowTransport struct {
+	// The transport used to send requests. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+}
+
+// RoundTrip sends the request with its workflow ID header.
+func (transport *WorkflowTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if workflowID := WorkflowID(req.Context()); workflowID != "" && req.Header.Get(WorkflowIDHeader) == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(WorkflowIDHeader, workflowID)
+	}
+	return next.RoundTrip(req)
+}
+
+// WorkflowIDsEnabled returns true if the HTTP client of "service" has a WorkflowTransport (see EnableWorkflowIDs),
+// possibly wrapped by the transports installed by the other helpers of this package.
+func WorkflowIDsEnabled(service *core.BaseService) bool {
+	if service == nil || service.GetHTTPClient() == nil {
+		return false
+	}
+	transport := service.GetHTTPClient().Transport
+	for {
+		switch wrapper := transport.(type) {
+		case *WorkflowTransport:
+			return true
+		case *StaleCache:
+			transport = wrapper.Transport
+		case *ResponseValidator:
+			transport = wrapper.Transport
+		case *OperationTimeouts:
+			transport = wrapper.Transport
+		case *RateLimitTransport:
+			transport = wrapper.Transport
+		default:
+			return false
+		}
+	}
+}
+
+// EnableWorkflowIDs installs a WorkflowTransport in the HTTP client of "service", so that the requests made by the
+// composite helpers of the service (and by any operation called with a context from WithWorkflowID) carry their
+// workflow ID. It has no effect if the client already has one.
+func EnableWorkflowIDs(service *core.BaseService) {
+	if WorkflowIDsEnabled(service) {
+		return
+	}
+	client := service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	workflowClient := *client
+	workflowClient.Transport = &WorkflowTransport{Transport: client.Transport}
+	service.SetHTTPClient(&workflowClient)
+}
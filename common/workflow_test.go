@@ -0,0 +1,103 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowIDs(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		received = append(received, req.Header.Get(WorkflowIDHeader))
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(res, `{}`)
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	EnableWorkflowIDs(service)
+	EnableWorkflowIDs(service)
+	// The second call does not install another transport.
+	transport := service.GetHTTPClient().Transport.(*WorkflowTransport)
+	assert.IsType(t, &http.Transport{}, transport.Transport)
+
+	get := func(ctx context.Context, headers map[string]string) {
+		builder := core.NewRequestBuilder(core.GET).WithContext(ctx)
+		_, err := builder.ResolveRequestURL(server.URL, "/", nil)
+		assert.Nil(t, err)
+		for name, value := range headers {
+			builder.AddHeader(name, value)
+		}
+		req, err := builder.Build()
+		assert.Nil(t, err)
+		_, err = service.Request(req, nil)
+		assert.Nil(t, err)
+	}
+
+	ctx, workflowID := EnsureWorkflowID(context.Background())
+	assert.NotEmpty(t, workflowID)
+	nested, nestedID := EnsureWorkflowID(ctx)
+	assert.Equal(t, workflowID, nestedID)
+	get(nested, nil)
+	get(ctx, map[string]string{WorkflowIDHeader: "explicit"})
+	get(context.Background(), nil)
+	assert.Equal(t, []string{workflowID, "explicit", ""}, received)
+}
+
+func TestWrapWorkflowError(t *testing.T) {
+	assert.Nil(t, WrapWorkflowError("id", nil))
+	cause := errors.New("failed")
+	assert.Equal(t, cause, WrapWorkflowError("", cause))
+
+	err := WrapWorkflowError("id", cause)
+	assert.Equal(t, "failed (workflow ID: id)", err.Error())
+	assert.True(t, errors.Is(err, cause))
+	wrapped := fmt.Errorf("outer: %w", err)
+	assert.Equal(t, wrapped, WrapWorkflowError("other", wrapped))
+}
+
+func TestEnsureWorkflowIDFor(t *testing.T) {
+	disabled, err := core.NewBaseService(&core.ServiceOptions{URL: "https://example.com", Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	enabled, err := core.NewBaseService(&core.ServiceOptions{URL: "https://example.com", Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	EnableWorkflowIDs(enabled)
+	// The workflow transport is found beneath the transports installed after it.
+	EnableRateLimiting(enabled, []*RateLimiter{NewRateLimiter(10, 1)})
+	assert.False(t, WorkflowIDsEnabled(nil))
+	assert.False(t, WorkflowIDsEnabled(disabled))
+	assert.True(t, WorkflowIDsEnabled(enabled))
+
+	// The context carries a workflow ID for nested helpers, but it is only reported if it is sent.
+	ctx, workflowID := EnsureWorkflowIDFor(context.Background(), disabled)
+	assert.Empty(t, workflowID)
+	_, contextID := EnsureWorkflowID(ctx)
+	assert.NotEmpty(t, contextID)
+
+	_, workflowID = EnsureWorkflowIDFor(ctx, disabled, enabled)
+	assert.Equal(t, contextID, workflowID)
+}
@@ -0,0 +1,267 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package configurationgovernancev1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// ConfigurationGovernanceV1API : The operations of the ConfigurationGovernanceV1 service, implemented by *ConfigurationGovernanceV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type ConfigurationGovernanceV1API interface {
+	// Clone makes a copy of "configurationGovernance" suitable for processing requests.
+	Clone() *ConfigurationGovernanceV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// CreateRules : Create rules
+	CreateRules(createRulesOptions *CreateRulesOptions) (result *CreateRulesResponse, response *core.DetailedResponse, err error)
+
+	// CreateRulesWithContext is an alternate form of the CreateRules method which supports a Context parameter
+	CreateRulesWithContext(ctx context.Context, createRulesOptions *CreateRulesOptions) (result *CreateRulesResponse, response *core.DetailedResponse, err error)
+
+	// ListRules : List rules
+	ListRules(listRulesOptions *ListRulesOptions) (result *RuleList, response *core.DetailedResponse, err error)
+
+	// ListRulesWithContext is an alternate form of the ListRules method which supports a Context parameter
+	ListRulesWithContext(ctx context.Context, listRulesOptions *ListRulesOptions) (result *RuleList, response *core.DetailedResponse, err error)
+
+	// GetRule : Get a rule
+	GetRule(getRuleOptions *GetRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// GetRuleWithContext is an alternate form of the GetRule method which supports a Context parameter
+	GetRuleWithContext(ctx context.Context, getRuleOptions *GetRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// UpdateRule : Update a rule
+	UpdateRule(updateRuleOptions *UpdateRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// UpdateRuleWithContext is an alternate form of the UpdateRule method which supports a Context parameter
+	UpdateRuleWithContext(ctx context.Context, updateRuleOptions *UpdateRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// DeleteRule : Delete a rule
+	DeleteRule(deleteRuleOptions *DeleteRuleOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteRuleWithContext is an alternate form of the DeleteRule method which supports a Context parameter
+	DeleteRuleWithContext(ctx context.Context, deleteRuleOptions *DeleteRuleOptions) (response *core.DetailedResponse, err error)
+
+	// CreateAttachments : Create attachments
+	CreateAttachments(createAttachmentsOptions *CreateAttachmentsOptions) (result *CreateAttachmentsResponse, response *core.DetailedResponse, err error)
+
+	// CreateAttachmentsWithContext is an alternate form of the CreateAttachments method which supports a Context
+	// parameter
+	CreateAttachmentsWithContext(ctx context.Context, createAttachmentsOptions *CreateAttachmentsOptions) (result *CreateAttachmentsResponse, response *core.DetailedResponse, err error)
+
+	// ListAttachments : List attachments
+	ListAttachments(listAttachmentsOptions *ListAttachmentsOptions) (result *AttachmentList, response *core.DetailedResponse, err error)
+
+	// ListAttachmentsWithContext is an alternate form of the ListAttachments method which supports a Context parameter
+	ListAttachmentsWithContext(ctx context.Context, listAttachmentsOptions *ListAttachmentsOptions) (result *AttachmentList, response *core.DetailedResponse, err error)
+
+	// GetAttachment : Get an attachment
+	GetAttachment(getAttachmentOptions *GetAttachmentOptions) (result *Attachment, response *core.DetailedResponse, err error)
+
+	// GetAttachmentWithContext is an alternate form of the GetAttachment method which supports a Context parameter
+	GetAttachmentWithContext(ctx context.Context, getAttachmentOptions *GetAttachmentOptions) (result *Attachment, response *core.DetailedResponse, err error)
+
+	// UpdateAttachment : Update an attachment
+	UpdateAttachment(updateAttachmentOptions *UpdateAttachmentOptions) (result *Attachment, response *core.DetailedResponse, err error)
+
+	// UpdateAttachmentWithContext is an alternate form of the UpdateAttachment method which supports a Context
+	// parameter
+	UpdateAttachmentWithContext(ctx context.Context, updateAttachmentOptions *UpdateAttachmentOptions) (result *Attachment, response *core.DetailedResponse, err error)
+
+	// DeleteAttachment : Delete an attachment
+	DeleteAttachment(deleteAttachmentOptions *DeleteAttachmentOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteAttachmentWithContext is an alternate form of the DeleteAttachment method which supports a Context
+	// parameter
+	DeleteAttachmentWithContext(ctx context.Context, deleteAttachmentOptions *DeleteAttachmentOptions) (response *core.DetailedResponse, err error)
+
+	// NewAttachmentRequest : Instantiate AttachmentRequest (Generic Model Constructor)
+	NewAttachmentRequest(accountID string, includedScope *RuleScope) (model *AttachmentRequest, err error)
+
+	// NewCreateAttachmentsOptions : Instantiate CreateAttachmentsOptions
+	NewCreateAttachmentsOptions(ruleID string, attachments []AttachmentRequest) *CreateAttachmentsOptions
+
+	// NewCreateRuleRequest : Instantiate CreateRuleRequest (Generic Model Constructor)
+	NewCreateRuleRequest(rule *RuleRequest) (model *CreateRuleRequest, err error)
+
+	// NewCreateRulesOptions : Instantiate CreateRulesOptions
+	NewCreateRulesOptions(rules []CreateRuleRequest) *CreateRulesOptions
+
+	// NewDeleteAttachmentOptions : Instantiate DeleteAttachmentOptions
+	NewDeleteAttachmentOptions(ruleID string, attachmentID string) *DeleteAttachmentOptions
+
+	// NewDeleteRuleOptions : Instantiate DeleteRuleOptions
+	NewDeleteRuleOptions(ruleID string) *DeleteRuleOptions
+
+	// NewEnforcementAction : Instantiate EnforcementAction (Generic Model Constructor)
+	NewEnforcementAction(action string) (model *EnforcementAction, err error)
+
+	// NewGetAttachmentOptions : Instantiate GetAttachmentOptions
+	NewGetAttachmentOptions(ruleID string, attachmentID string) *GetAttachmentOptions
+
+	// NewGetRuleOptions : Instantiate GetRuleOptions
+	NewGetRuleOptions(ruleID string) *GetRuleOptions
+
+	// NewListAttachmentsOptions : Instantiate ListAttachmentsOptions
+	NewListAttachmentsOptions(ruleID string) *ListAttachmentsOptions
+
+	// NewListRulesOptions : Instantiate ListRulesOptions
+	NewListRulesOptions(accountID string) *ListRulesOptions
+
+	// NewRuleRequest : Instantiate RuleRequest (Generic Model Constructor)
+	NewRuleRequest(name string, description string, target *TargetResource, requiredConfig RuleRequiredConfigIntf, enforcementActions []EnforcementAction) (model *RuleRequest, err error)
+
+	// NewRuleScope : Instantiate RuleScope (Generic Model Constructor)
+	NewRuleScope(scopeID string, scopeType string) (model *RuleScope, err error)
+
+	// NewRuleSingleProperty : Instantiate RuleSingleProperty (Generic Model Constructor)
+	NewRuleSingleProperty(property string, operator string) (model *RuleSingleProperty, err error)
+
+	// NewRuleTargetAttribute : Instantiate RuleTargetAttribute (Generic Model Constructor)
+	NewRuleTargetAttribute(name string, operator string) (model *RuleTargetAttribute, err error)
+
+	// NewTargetResource : Instantiate TargetResource (Generic Model Constructor)
+	NewTargetResource(serviceName string, resourceKind string) (model *TargetResource, err error)
+
+	// NewUpdateAttachmentOptions : Instantiate UpdateAttachmentOptions
+	NewUpdateAttachmentOptions(ruleID string, attachmentID string, ifMatch string, accountID string, includedScope *RuleScope) *UpdateAttachmentOptions
+
+	// NewUpdateRuleOptions : Instantiate UpdateRuleOptions
+	NewUpdateRuleOptions(ruleID string, ifMatch string, name string, description string, target *TargetResource, requiredConfig RuleRequiredConfigIntf, enforcementActions []EnforcementAction) *UpdateRuleOptions
+
+	// NewRuleConditionAndLvl2 : Instantiate RuleConditionAndLvl2 (Generic Model Constructor)
+	NewRuleConditionAndLvl2(and []RuleSingleProperty) (model *RuleConditionAndLvl2, err error)
+
+	// NewRuleConditionOrLvl2 : Instantiate RuleConditionOrLvl2 (Generic Model Constructor)
+	NewRuleConditionOrLvl2(or []RuleSingleProperty) (model *RuleConditionOrLvl2, err error)
+
+	// NewRuleConditionSingleProperty : Instantiate RuleConditionSingleProperty (Generic Model Constructor)
+	NewRuleConditionSingleProperty(property string, operator string) (model *RuleConditionSingleProperty, err error)
+
+	// NewRuleRequiredConfigSingleProperty : Instantiate RuleRequiredConfigSingleProperty (Generic Model Constructor)
+	NewRuleRequiredConfigSingleProperty(property string, operator string) (model *RuleRequiredConfigSingleProperty, err error)
+
+	// NewRuleRequiredConfigMultiplePropertiesConditionAnd : Instantiate
+	// RuleRequiredConfigMultiplePropertiesConditionAnd (Generic Model Constructor)
+	NewRuleRequiredConfigMultiplePropertiesConditionAnd(and []RuleConditionIntf) (model *RuleRequiredConfigMultiplePropertiesConditionAnd, err error)
+
+	// NewRuleRequiredConfigMultiplePropertiesConditionOr : Instantiate RuleRequiredConfigMultiplePropertiesConditionOr
+	// (Generic Model Constructor)
+	NewRuleRequiredConfigMultiplePropertiesConditionOr(or []RuleConditionIntf) (model *RuleRequiredConfigMultiplePropertiesConditionOr, err error)
+
+	// NewCreateRulesOptionsWith returns CreateRulesOptions populated by applying "options" in order.
+	NewCreateRulesOptionsWith(options ...CreateRulesOption) *CreateRulesOptions
+
+	// CreateRulesWith is an alternate form of the CreateRulesWithContext method which takes functional options instead
+	// of a CreateRulesOptions struct.
+	CreateRulesWith(ctx context.Context, options ...CreateRulesOption) (result *CreateRulesResponse, response *core.DetailedResponse, err error)
+
+	// NewListRulesOptionsWith returns ListRulesOptions populated by applying "options" in order.
+	NewListRulesOptionsWith(options ...ListRulesOption) *ListRulesOptions
+
+	// ListRulesWith is an alternate form of the ListRulesWithContext method which takes functional options instead of
+	// a ListRulesOptions struct.
+	ListRulesWith(ctx context.Context, options ...ListRulesOption) (result *RuleList, response *core.DetailedResponse, err error)
+
+	// NewGetRuleOptionsWith returns GetRuleOptions populated by applying "options" in order.
+	NewGetRuleOptionsWith(options ...GetRuleOption) *GetRuleOptions
+
+	// GetRuleWith is an alternate form of the GetRuleWithContext method which takes functional options instead of a
+	// GetRuleOptions struct.
+	GetRuleWith(ctx context.Context, options ...GetRuleOption) (result *Rule, response *core.DetailedResponse, err error)
+
+	// NewUpdateRuleOptionsWith returns UpdateRuleOptions populated by applying "options" in order.
+	NewUpdateRuleOptionsWith(options ...UpdateRuleOption) *UpdateRuleOptions
+
+	// UpdateRuleWith is an alternate form of the UpdateRuleWithContext method which takes functional options instead
+	// of an UpdateRuleOptions struct.
+	UpdateRuleWith(ctx context.Context, options ...UpdateRuleOption) (result *Rule, response *core.DetailedResponse, err error)
+
+	// NewDeleteRuleOptionsWith returns DeleteRuleOptions populated by applying "options" in order.
+	NewDeleteRuleOptionsWith(options ...DeleteRuleOption) *DeleteRuleOptions
+
+	// DeleteRuleWith is an alternate form of the DeleteRuleWithContext method which takes functional options instead
+	// of a DeleteRuleOptions struct.
+	DeleteRuleWith(ctx context.Context, options ...DeleteRuleOption) (response *core.DetailedResponse, err error)
+
+	// NewCreateAttachmentsOptionsWith returns CreateAttachmentsOptions populated by applying "options" in order.
+	NewCreateAttachmentsOptionsWith(options ...CreateAttachmentsOption) *CreateAttachmentsOptions
+
+	// CreateAttachmentsWith is an alternate form of the CreateAttachmentsWithContext method which takes functional
+	// options instead of a CreateAttachmentsOptions struct.
+	CreateAttachmentsWith(ctx context.Context, options ...CreateAttachmentsOption) (result *CreateAttachmentsResponse, response *core.DetailedResponse, err error)
+
+	// NewListAttachmentsOptionsWith returns ListAttachmentsOptions populated by applying "options" in order.
+	NewListAttachmentsOptionsWith(options ...ListAttachmentsOption) *ListAttachmentsOptions
+
+	// ListAttachmentsWith is an alternate form of the ListAttachmentsWithContext method which takes functional options
+	// instead of a ListAttachmentsOptions struct.
+	ListAttachmentsWith(ctx context.Context, options ...ListAttachmentsOption) (result *AttachmentList, response *core.DetailedResponse, err error)
+
+	// NewGetAttachmentOptionsWith returns GetAttachmentOptions populated by applying "options" in order.
+	NewGetAttachmentOptionsWith(options ...GetAttachmentOption) *GetAttachmentOptions
+
+	// GetAttachmentWith is an alternate form of the GetAttachmentWithContext method which takes functional options
+	// instead of a GetAttachmentOptions struct.
+	GetAttachmentWith(ctx context.Context, options ...GetAttachmentOption) (result *Attachment, response *core.DetailedResponse, err error)
+
+	// NewUpdateAttachmentOptionsWith returns UpdateAttachmentOptions populated by applying "options" in order.
+	NewUpdateAttachmentOptionsWith(options ...UpdateAttachmentOption) *UpdateAttachmentOptions
+
+	// UpdateAttachmentWith is an alternate form of the UpdateAttachmentWithContext method which takes functional
+	// options instead of an UpdateAttachmentOptions struct.
+	UpdateAttachmentWith(ctx context.Context, options ...UpdateAttachmentOption) (result *Attachment, response *core.DetailedResponse, err error)
+
+	// NewDeleteAttachmentOptionsWith returns DeleteAttachmentOptions populated by applying "options" in order.
+	NewDeleteAttachmentOptionsWith(options ...DeleteAttachmentOption) *DeleteAttachmentOptions
+
+	// DeleteAttachmentWith is an alternate form of the DeleteAttachmentWithContext method which takes functional
+	// options instead of a DeleteAttachmentOptions struct.
+	DeleteAttachmentWith(ctx context.Context, options ...DeleteAttachmentOption) (response *core.DetailedResponse, err error)
+}
+
+var _ ConfigurationGovernanceV1API = (*ConfigurationGovernanceV1)(nil)
@@ -0,0 +1,651 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package configurationgovernancev1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CreateRulesOption : A functional option that sets a property of CreateRulesOptions (see CreateRulesWith).
+type CreateRulesOption func(options *CreateRulesOptions)
+
+// CreateRulesRules sets the Rules property of CreateRulesOptions. A list of rules to be created.
+func CreateRulesRules(rules ...CreateRuleRequest) CreateRulesOption {
+	return func(options *CreateRulesOptions) {
+		options.Rules = append(options.Rules, rules...)
+	}
+}
+
+// CreateRulesTransactionID sets the TransactionID property of CreateRulesOptions. The unique identifier that is
+// used to trace an entire request.
+func CreateRulesTransactionID(transactionID string) CreateRulesOption {
+	return func(options *CreateRulesOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// CreateRulesHeader sets the Headers property of CreateRulesOptions. Allows users to set headers on API requests.
+func CreateRulesHeader(name string, value string) CreateRulesOption {
+	return func(options *CreateRulesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateRulesOptionsWith returns CreateRulesOptions populated by applying "options" in order.
+func (*ConfigurationGovernanceV1) NewCreateRulesOptionsWith(options ...CreateRulesOption) *CreateRulesOptions {
+	createRulesOptions := &CreateRulesOptions{}
+	for _, option := range options {
+		option(createRulesOptions)
+	}
+	return createRulesOptions
+}
+
+// CreateRulesWith is an alternate form of the CreateRulesWithContext method which takes functional options instead
+// of a CreateRulesOptions struct. CreateRulesRules is required.
+func (configurationGovernance *ConfigurationGovernanceV1) CreateRulesWith(ctx context.Context, options ...CreateRulesOption) (result *CreateRulesResponse, response *core.DetailedResponse, err error) {
+	return configurationGovernance.CreateRulesWithContext(ctx, configurationGovernance.NewCreateRulesOptionsWith(options...))
+}
+
+// ListRulesOption : A functional option that sets a property of ListRulesOptions (see ListRulesWith).
+type ListRulesOption func(options *ListRulesOptions)
+
+// ListRulesAccountID sets the AccountID property of ListRulesOptions. Your IBM Cloud account ID.
+func ListRulesAccountID(accountID string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListRulesTransactionID sets the TransactionID property of ListRulesOptions. The unique identifier that is used
+// to trace an entire request.
+func ListRulesTransactionID(transactionID string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ListRulesAttached sets the Attached property of ListRulesOptions. Retrieves a list of rules that have scope
+// attachments.
+func ListRulesAttached(attached bool) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.Attached = core.BoolPtr(attached)
+	}
+}
+
+// ListRulesLabels sets the Labels property of ListRulesOptions. Retrieves a list of rules that match the labels
+// that you specify.
+func ListRulesLabels(labels string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.Labels = core.StringPtr(labels)
+	}
+}
+
+// ListRulesScopes sets the Scopes property of ListRulesOptions. Retrieves a list of rules that match the scope ID
+// that you specify.
+func ListRulesScopes(scopes string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.Scopes = core.StringPtr(scopes)
+	}
+}
+
+// ListRulesLimit sets the Limit property of ListRulesOptions. The number of resources to retrieve.
+func ListRulesLimit(limit int64) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListRulesOffset sets the Offset property of ListRulesOptions. The number of resources to skip.
+func ListRulesOffset(offset int64) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// ListRulesHeader sets the Headers property of ListRulesOptions. Allows users to set headers on API requests.
+func ListRulesHeader(name string, value string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListRulesOptionsWith returns ListRulesOptions populated by applying "options" in order.
+func (*ConfigurationGovernanceV1) NewListRulesOptionsWith(options ...ListRulesOption) *ListRulesOptions {
+	listRulesOptions := &ListRulesOptions{}
+	for _, option := range options {
+		option(listRulesOptions)
+	}
+	return listRulesOptions
+}
+
+// ListRulesWith is an alternate form of the ListRulesWithContext method which takes functional options instead of
+// a ListRulesOptions struct. ListRulesAccountID is required.
+func (configurationGovernance *ConfigurationGovernanceV1) ListRulesWith(ctx context.Context, options ...ListRulesOption) (result *RuleList, response *core.DetailedResponse, err error) {
+	return configurationGovernance.ListRulesWithContext(ctx, configurationGovernance.NewListRulesOptionsWith(options...))
+}
+
+// GetRuleOption : A functional option that sets a property of GetRuleOptions (see GetRuleWith).
+type GetRuleOption func(options *GetRuleOptions)
+
+// GetRuleRuleID sets the RuleID property of GetRuleOptions. The UUID that uniquely identifies the rule.
+func GetRuleRuleID(ruleID string) GetRuleOption {
+	return func(options *GetRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// GetRuleTransactionID sets the TransactionID property of GetRuleOptions. The unique identifier that is used to
+// trace an entire request.
+func GetRuleTransactionID(transactionID string) GetRuleOption {
+	return func(options *GetRuleOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// GetRuleHeader sets the Headers property of GetRuleOptions. Allows users to set headers on API requests.
+func GetRuleHeader(name string, value string) GetRuleOption {
+	return func(options *GetRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetRuleOptionsWith returns GetRuleOptions populated by applying "options" in order.
+func (*ConfigurationGovernanceV1) NewGetRuleOptionsWith(options ...GetRuleOption) *GetRuleOptions {
+	getRuleOptions := &GetRuleOptions{}
+	for _, option := range options {
+		option(getRuleOptions)
+	}
+	return getRuleOptions
+}
+
+// GetRuleWith is an alternate form of the GetRuleWithContext method which takes functional options instead of a
+// GetRuleOptions struct. GetRuleRuleID is required.
+func (configurationGovernance *ConfigurationGovernanceV1) GetRuleWith(ctx context.Context, options ...GetRuleOption) (result *Rule, response *core.DetailedResponse, err error) {
+	return configurationGovernance.GetRuleWithContext(ctx, configurationGovernance.NewGetRuleOptionsWith(options...))
+}
+
+// UpdateRuleOption : A functional option that sets a property of UpdateRuleOptions (see UpdateRuleWith).
+type UpdateRuleOption func(options *UpdateRuleOptions)
+
+// UpdateRuleRuleID sets the RuleID property of UpdateRuleOptions. The UUID that uniquely identifies the rule.
+func UpdateRuleRuleID(ruleID string) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// UpdateRuleIfMatch sets the IfMatch property of UpdateRuleOptions. Compares a supplied `Etag` value with the
+// version that is stored for the requested resource.
+func UpdateRuleIfMatch(ifMatch string) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// UpdateRuleName sets the Name property of UpdateRuleOptions. A human-readable alias to assign to your rule.
+func UpdateRuleName(nameVar string) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateRuleDescription sets the Description property of UpdateRuleOptions. An extended description of your rule.
+func UpdateRuleDescription(description string) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// UpdateRuleTarget sets the Target property of UpdateRuleOptions. The properties that describe the resource that
+// you want to target with the rule.
+func UpdateRuleTarget(target *TargetResource) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		options.Target = target
+	}
+}
+
+// UpdateRuleRequiredConfig sets the RequiredConfig property of UpdateRuleOptions.
+func UpdateRuleRequiredConfig(requiredConfig RuleRequiredConfigIntf) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		options.RequiredConfig = requiredConfig
+	}
+}
+
+// UpdateRuleEnforcementActions sets the EnforcementActions property of UpdateRuleOptions. The actions that the
+// service must run on your behalf when a request to create or modify the target resource does not comply with your
+// conditions.
+func UpdateRuleEnforcementActions(enforcementActions ...EnforcementAction) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		options.EnforcementActions = append(options.EnforcementActions, enforcementActions...)
+	}
+}
+
+// UpdateRuleAccountID sets the AccountID property of UpdateRuleOptions. Your IBM Cloud account ID.
+func UpdateRuleAccountID(accountID string) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// UpdateRuleRuleType sets the RuleType property of UpdateRuleOptions. The type of rule.
+func UpdateRuleRuleType(ruleType string) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		options.RuleType = core.StringPtr(ruleType)
+	}
+}
+
+// UpdateRuleLabels sets the Labels property of UpdateRuleOptions. Labels that you can use to group and search for
+// similar rules, such as those that help you to meet a specific organization guideline.
+func UpdateRuleLabels(labels ...string) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		options.Labels = append(options.Labels, labels...)
+	}
+}
+
+// UpdateRuleTransactionID sets the TransactionID property of UpdateRuleOptions. The unique identifier that is used
+// to trace an entire request.
+func UpdateRuleTransactionID(transactionID string) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// UpdateRuleHeader sets the Headers property of UpdateRuleOptions. Allows users to set headers on API requests.
+func UpdateRuleHeader(name string, value string) UpdateRuleOption {
+	return func(options *UpdateRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateRuleOptionsWith returns UpdateRuleOptions populated by applying "options" in order.
+func (*ConfigurationGovernanceV1) NewUpdateRuleOptionsWith(options ...UpdateRuleOption) *UpdateRuleOptions {
+	updateRuleOptions := &UpdateRuleOptions{}
+	for _, option := range options {
+		option(updateRuleOptions)
+	}
+	return updateRuleOptions
+}
+
+// UpdateRuleWith is an alternate form of the UpdateRuleWithContext method which takes functional options instead
+// of an UpdateRuleOptions struct. UpdateRuleRuleID, UpdateRuleIfMatch, UpdateRuleName, UpdateRuleDescription,
+// UpdateRuleTarget, UpdateRuleRequiredConfig and UpdateRuleEnforcementActions are required.
+func (configurationGovernance *ConfigurationGovernanceV1) UpdateRuleWith(ctx context.Context, options ...UpdateRuleOption) (result *Rule, response *core.DetailedResponse, err error) {
+	return configurationGovernance.UpdateRuleWithContext(ctx, configurationGovernance.NewUpdateRuleOptionsWith(options...))
+}
+
+// DeleteRuleOption : A functional option that sets a property of DeleteRuleOptions (see DeleteRuleWith).
+type DeleteRuleOption func(options *DeleteRuleOptions)
+
+// DeleteRuleRuleID sets the RuleID property of DeleteRuleOptions. The UUID that uniquely identifies the rule.
+func DeleteRuleRuleID(ruleID string) DeleteRuleOption {
+	return func(options *DeleteRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// DeleteRuleTransactionID sets the TransactionID property of DeleteRuleOptions. The unique identifier that is used
+// to trace an entire request.
+func DeleteRuleTransactionID(transactionID string) DeleteRuleOption {
+	return func(options *DeleteRuleOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// DeleteRuleHeader sets the Headers property of DeleteRuleOptions. Allows users to set headers on API requests.
+func DeleteRuleHeader(name string, value string) DeleteRuleOption {
+	return func(options *DeleteRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteRuleOptionsWith returns DeleteRuleOptions populated by applying "options" in order.
+func (*ConfigurationGovernanceV1) NewDeleteRuleOptionsWith(options ...DeleteRuleOption) *DeleteRuleOptions {
+	deleteRuleOptions := &DeleteRuleOptions{}
+	for _, option := range options {
+		option(deleteRuleOptions)
+	}
+	return deleteRuleOptions
+}
+
+// DeleteRuleWith is an alternate form of the DeleteRuleWithContext method which takes functional options instead
+// of a DeleteRuleOptions struct. DeleteRuleRuleID is required.
+func (configurationGovernance *ConfigurationGovernanceV1) DeleteRuleWith(ctx context.Context, options ...DeleteRuleOption) (response *core.DetailedResponse, err error) {
+	return configurationGovernance.DeleteRuleWithContext(ctx, configurationGovernance.NewDeleteRuleOptionsWith(options...))
+}
+
+// CreateAttachmentsOption : A functional option that sets a property of CreateAttachmentsOptions (see CreateAttachmentsWith).
+type CreateAttachmentsOption func(options *CreateAttachmentsOptions)
+
+// CreateAttachmentsRuleID sets the RuleID property of CreateAttachmentsOptions. The UUID that uniquely identifies
+// the rule.
+func CreateAttachmentsRuleID(ruleID string) CreateAttachmentsOption {
+	return func(options *CreateAttachmentsOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// CreateAttachmentsAttachments sets the Attachments property of CreateAttachmentsOptions.
+func CreateAttachmentsAttachments(attachments ...AttachmentRequest) CreateAttachmentsOption {
+	return func(options *CreateAttachmentsOptions) {
+		options.Attachments = append(options.Attachments, attachments...)
+	}
+}
+
+// CreateAttachmentsTransactionID sets the TransactionID property of CreateAttachmentsOptions. The unique
+// identifier that is used to trace an entire request.
+func CreateAttachmentsTransactionID(transactionID string) CreateAttachmentsOption {
+	return func(options *CreateAttachmentsOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// CreateAttachmentsHeader sets the Headers property of CreateAttachmentsOptions. Allows users to set headers on
+// API requests.
+func CreateAttachmentsHeader(name string, value string) CreateAttachmentsOption {
+	return func(options *CreateAttachmentsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateAttachmentsOptionsWith returns CreateAttachmentsOptions populated by applying "options" in order.
+func (*ConfigurationGovernanceV1) NewCreateAttachmentsOptionsWith(options ...CreateAttachmentsOption) *CreateAttachmentsOptions {
+	createAttachmentsOptions := &CreateAttachmentsOptions{}
+	for _, option := range options {
+		option(createAttachmentsOptions)
+	}
+	return createAttachmentsOptions
+}
+
+// CreateAttachmentsWith is an alternate form of the CreateAttachmentsWithContext method which takes functional
+// options instead of a CreateAttachmentsOptions struct. CreateAttachmentsRuleID and CreateAttachmentsAttachments
+// are required.
+func (configurationGovernance *ConfigurationGovernanceV1) CreateAttachmentsWith(ctx context.Context, options ...CreateAttachmentsOption) (result *CreateAttachmentsResponse, response *core.DetailedResponse, err error) {
+	return configurationGovernance.CreateAttachmentsWithContext(ctx, configurationGovernance.NewCreateAttachmentsOptionsWith(options...))
+}
+
+// ListAttachmentsOption : A functional option that sets a property of ListAttachmentsOptions (see ListAttachmentsWith).
+type ListAttachmentsOption func(options *ListAttachmentsOptions)
+
+// ListAttachmentsRuleID sets the RuleID property of ListAttachmentsOptions. The UUID that uniquely identifies the
+// rule.
+func ListAttachmentsRuleID(ruleID string) ListAttachmentsOption {
+	return func(options *ListAttachmentsOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// ListAttachmentsTransactionID sets the TransactionID property of ListAttachmentsOptions. The unique identifier
+// that is used to trace an entire request.
+func ListAttachmentsTransactionID(transactionID string) ListAttachmentsOption {
+	return func(options *ListAttachmentsOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ListAttachmentsLimit sets the Limit property of ListAttachmentsOptions. The number of resources to retrieve.
+func ListAttachmentsLimit(limit int64) ListAttachmentsOption {
+	return func(options *ListAttachmentsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListAttachmentsOffset sets the Offset property of ListAttachmentsOptions. The number of resources to skip.
+func ListAttachmentsOffset(offset int64) ListAttachmentsOption {
+	return func(options *ListAttachmentsOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// ListAttachmentsHeader sets the Headers property of ListAttachmentsOptions. Allows users to set headers on API
+// requests.
+func ListAttachmentsHeader(name string, value string) ListAttachmentsOption {
+	return func(options *ListAttachmentsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListAttachmentsOptionsWith returns ListAttachmentsOptions populated by applying "options" in order.
+func (*ConfigurationGovernanceV1) NewListAttachmentsOptionsWith(options ...ListAttachmentsOption) *ListAttachmentsOptions {
+	listAttachmentsOptions := &ListAttachmentsOptions{}
+	for _, option := range options {
+		option(listAttachmentsOptions)
+	}
+	return listAttachmentsOptions
+}
+
+// ListAttachmentsWith is an alternate form of the ListAttachmentsWithContext method which takes functional options
+// instead of a ListAttachmentsOptions struct. ListAttachmentsRuleID is required.
+func (configurationGovernance *ConfigurationGovernanceV1) ListAttachmentsWith(ctx context.Context, options ...ListAttachmentsOption) (result *AttachmentList, response *core.DetailedResponse, err error) {
+	return configurationGovernance.ListAttachmentsWithContext(ctx, configurationGovernance.NewListAttachmentsOptionsWith(options...))
+}
+
+// GetAttachmentOption : A functional option that sets a property of GetAttachmentOptions (see GetAttachmentWith).
+type GetAttachmentOption func(options *GetAttachmentOptions)
+
+// GetAttachmentRuleID sets the RuleID property of GetAttachmentOptions. The UUID that uniquely identifies the
+// rule.
+func GetAttachmentRuleID(ruleID string) GetAttachmentOption {
+	return func(options *GetAttachmentOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// GetAttachmentAttachmentID sets the AttachmentID property of GetAttachmentOptions. The UUID that uniquely
+// identifies the attachment.
+func GetAttachmentAttachmentID(attachmentID string) GetAttachmentOption {
+	return func(options *GetAttachmentOptions) {
+		options.AttachmentID = core.StringPtr(attachmentID)
+	}
+}
+
+// GetAttachmentTransactionID sets the TransactionID property of GetAttachmentOptions. The unique identifier that
+// is used to trace an entire request.
+func GetAttachmentTransactionID(transactionID string) GetAttachmentOption {
+	return func(options *GetAttachmentOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// GetAttachmentHeader sets the Headers property of GetAttachmentOptions. Allows users to set headers on API
+// requests.
+func GetAttachmentHeader(name string, value string) GetAttachmentOption {
+	return func(options *GetAttachmentOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAttachmentOptionsWith returns GetAttachmentOptions populated by applying "options" in order.
+func (*ConfigurationGovernanceV1) NewGetAttachmentOptionsWith(options ...GetAttachmentOption) *GetAttachmentOptions {
+	getAttachmentOptions := &GetAttachmentOptions{}
+	for _, option := range options {
+		option(getAttachmentOptions)
+	}
+	return getAttachmentOptions
+}
+
+// GetAttachmentWith is an alternate form of the GetAttachmentWithContext method which takes functional options
+// instead of a GetAttachmentOptions struct. GetAttachmentRuleID and GetAttachmentAttachmentID are required.
+func (configurationGovernance *ConfigurationGovernanceV1) GetAttachmentWith(ctx context.Context, options ...GetAttachmentOption) (result *Attachment, response *core.DetailedResponse, err error) {
+	return configurationGovernance.GetAttachmentWithContext(ctx, configurationGovernance.NewGetAttachmentOptionsWith(options...))
+}
+
+// UpdateAttachmentOption : A functional option that sets a property of UpdateAttachmentOptions (see UpdateAttachmentWith).
+type UpdateAttachmentOption func(options *UpdateAttachmentOptions)
+
+// UpdateAttachmentRuleID sets the RuleID property of UpdateAttachmentOptions. The UUID that uniquely identifies
+// the rule.
+func UpdateAttachmentRuleID(ruleID string) UpdateAttachmentOption {
+	return func(options *UpdateAttachmentOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// UpdateAttachmentAttachmentID sets the AttachmentID property of UpdateAttachmentOptions. The UUID that uniquely
+// identifies the attachment.
+func UpdateAttachmentAttachmentID(attachmentID string) UpdateAttachmentOption {
+	return func(options *UpdateAttachmentOptions) {
+		options.AttachmentID = core.StringPtr(attachmentID)
+	}
+}
+
+// UpdateAttachmentIfMatch sets the IfMatch property of UpdateAttachmentOptions. Compares a supplied `Etag` value
+// with the version that is stored for the requested resource.
+func UpdateAttachmentIfMatch(ifMatch string) UpdateAttachmentOption {
+	return func(options *UpdateAttachmentOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// UpdateAttachmentAccountID sets the AccountID property of UpdateAttachmentOptions. Your IBM Cloud account ID.
+func UpdateAttachmentAccountID(accountID string) UpdateAttachmentOption {
+	return func(options *UpdateAttachmentOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// UpdateAttachmentIncludedScope sets the IncludedScope property of UpdateAttachmentOptions. The extent at which
+// the rule can be attached across your accounts.
+func UpdateAttachmentIncludedScope(includedScope *RuleScope) UpdateAttachmentOption {
+	return func(options *UpdateAttachmentOptions) {
+		options.IncludedScope = includedScope
+	}
+}
+
+// UpdateAttachmentExcludedScopes sets the ExcludedScopes property of UpdateAttachmentOptions. The extent at which
+// the rule can be excluded from the included scope.
+func UpdateAttachmentExcludedScopes(excludedScopes ...RuleScope) UpdateAttachmentOption {
+	return func(options *UpdateAttachmentOptions) {
+		options.ExcludedScopes = append(options.ExcludedScopes, excludedScopes...)
+	}
+}
+
+// UpdateAttachmentTransactionID sets the TransactionID property of UpdateAttachmentOptions. The unique identifier
+// that is used to trace an entire request.
+func UpdateAttachmentTransactionID(transactionID string) UpdateAttachmentOption {
+	return func(options *UpdateAttachmentOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// UpdateAttachmentHeader sets the Headers property of UpdateAttachmentOptions. Allows users to set headers on API
+// requests.
+func UpdateAttachmentHeader(name string, value string) UpdateAttachmentOption {
+	return func(options *UpdateAttachmentOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateAttachmentOptionsWith returns UpdateAttachmentOptions populated by applying "options" in order.
+func (*ConfigurationGovernanceV1) NewUpdateAttachmentOptionsWith(options ...UpdateAttachmentOption) *UpdateAttachmentOptions {
+	updateAttachmentOptions := &UpdateAttachmentOptions{}
+	for _, option := range options {
+		option(updateAttachmentOptions)
+	}
+	return updateAttachmentOptions
+}
+
+// UpdateAttachmentWith is an alternate form of the UpdateAttachmentWithContext method which takes functional
+// options instead of an UpdateAttachmentOptions struct. UpdateAttachmentRuleID, UpdateAttachmentAttachmentID,
+// UpdateAttachmentIfMatch, UpdateAttachmentAccountID and UpdateAttachmentIncludedScope are required.
+func (configurationGovernance *ConfigurationGovernanceV1) UpdateAttachmentWith(ctx context.Context, options ...UpdateAttachmentOption) (result *Attachment, response *core.DetailedResponse, err error) {
+	return configurationGovernance.UpdateAttachmentWithContext(ctx, configurationGovernance.NewUpdateAttachmentOptionsWith(options...))
+}
+
+// DeleteAttachmentOption : A functional option that sets a property of DeleteAttachmentOptions (see DeleteAttachmentWith).
+type DeleteAttachmentOption func(options *DeleteAttachmentOptions)
+
+// DeleteAttachmentRuleID sets the RuleID property of DeleteAttachmentOptions. The UUID that uniquely identifies
+// the rule.
+func DeleteAttachmentRuleID(ruleID string) DeleteAttachmentOption {
+	return func(options *DeleteAttachmentOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// DeleteAttachmentAttachmentID sets the AttachmentID property of DeleteAttachmentOptions. The UUID that uniquely
+// identifies the attachment.
+func DeleteAttachmentAttachmentID(attachmentID string) DeleteAttachmentOption {
+	return func(options *DeleteAttachmentOptions) {
+		options.AttachmentID = core.StringPtr(attachmentID)
+	}
+}
+
+// DeleteAttachmentTransactionID sets the TransactionID property of DeleteAttachmentOptions. The unique identifier
+// that is used to trace an entire request.
+func DeleteAttachmentTransactionID(transactionID string) DeleteAttachmentOption {
+	return func(options *DeleteAttachmentOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// DeleteAttachmentHeader sets the Headers property of DeleteAttachmentOptions. Allows users to set headers on API
+// requests.
+func DeleteAttachmentHeader(name string, value string) DeleteAttachmentOption {
+	return func(options *DeleteAttachmentOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteAttachmentOptionsWith returns DeleteAttachmentOptions populated by applying "options" in order.
+func (*ConfigurationGovernanceV1) NewDeleteAttachmentOptionsWith(options ...DeleteAttachmentOption) *DeleteAttachmentOptions {
+	deleteAttachmentOptions := &DeleteAttachmentOptions{}
+	for _, option := range options {
+		option(deleteAttachmentOptions)
+	}
+	return deleteAttachmentOptions
+}
+
+// DeleteAttachmentWith is an alternate form of the DeleteAttachmentWithContext method which takes functional
+// options instead of a DeleteAttachmentOptions struct. DeleteAttachmentRuleID and DeleteAttachmentAttachmentID are
+// required.
+func (configurationGovernance *ConfigurationGovernanceV1) DeleteAttachmentWith(ctx context.Context, options ...DeleteAttachmentOption) (response *core.DetailedResponse, err error) {
+	return configurationGovernance.DeleteAttachmentWithContext(ctx, configurationGovernance.NewDeleteAttachmentOptionsWith(options...))
+}
@@ -0,0 +1,283 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genredact. DO NOT EDIT.
+
+package configurationgovernancev1
+
+import "github.com/IBM/platform-services-go-sdk/common"
+
+//go:generate go run ../internal/genredact
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Attachment) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Attachment) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AttachmentList) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AttachmentList) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AttachmentRequest) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model AttachmentRequest) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateAttachmentsResponse) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateAttachmentsResponse) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateRuleRequest) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateRuleRequest) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateRuleResponse) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateRuleResponse) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateRulesResponse) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreateRulesResponse) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model EnforcementAction) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model EnforcementAction) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Link) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Link) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Rule) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model Rule) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleCondition) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleCondition) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleConditionAndLvl2) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleConditionAndLvl2) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleConditionOrLvl2) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleConditionOrLvl2) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleConditionSingleProperty) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleConditionSingleProperty) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleList) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleList) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequest) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequest) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequiredConfig) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequiredConfig) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequiredConfigMultipleProperties) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequiredConfigMultipleProperties) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequiredConfigMultiplePropertiesConditionAnd) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequiredConfigMultiplePropertiesConditionAnd) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequiredConfigMultiplePropertiesConditionOr) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequiredConfigMultiplePropertiesConditionOr) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequiredConfigSingleProperty) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleRequiredConfigSingleProperty) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleResponseError) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleResponseError) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleScope) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleScope) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleSingleProperty) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleSingleProperty) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleTargetAttribute) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model RuleTargetAttribute) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model TargetResource) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model TargetResource) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
@@ -0,0 +1,325 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// ContextBasedRestrictionsV1API : The operations of the ContextBasedRestrictionsV1 service, implemented by *ContextBasedRestrictionsV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type ContextBasedRestrictionsV1API interface {
+	// Clone makes a copy of "contextBasedRestrictions" suitable for processing requests.
+	Clone() *ContextBasedRestrictionsV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// CreateZone : Create a network zone
+	CreateZone(createZoneOptions *CreateZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+
+	// CreateZoneWithContext is an alternate form of the CreateZone method which supports a Context parameter
+	CreateZoneWithContext(ctx context.Context, createZoneOptions *CreateZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+
+	// ListZones : List network zones
+	ListZones(listZonesOptions *ListZonesOptions) (result *ZoneList, response *core.DetailedResponse, err error)
+
+	// ListZonesWithContext is an alternate form of the ListZones method which supports a Context parameter
+	ListZonesWithContext(ctx context.Context, listZonesOptions *ListZonesOptions) (result *ZoneList, response *core.DetailedResponse, err error)
+
+	// GetZone : Get a network zone
+	GetZone(getZoneOptions *GetZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+
+	// GetZoneWithContext is an alternate form of the GetZone method which supports a Context parameter
+	GetZoneWithContext(ctx context.Context, getZoneOptions *GetZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+
+	// ReplaceZone : Replace a network zone
+	ReplaceZone(replaceZoneOptions *ReplaceZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+
+	// ReplaceZoneWithContext is an alternate form of the ReplaceZone method which supports a Context parameter
+	ReplaceZoneWithContext(ctx context.Context, replaceZoneOptions *ReplaceZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+
+	// DeleteZone : Delete a network zone
+	DeleteZone(deleteZoneOptions *DeleteZoneOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteZoneWithContext is an alternate form of the DeleteZone method which supports a Context parameter
+	DeleteZoneWithContext(ctx context.Context, deleteZoneOptions *DeleteZoneOptions) (response *core.DetailedResponse, err error)
+
+	// ListAvailableServicerefTargets : List available service reference targets
+	ListAvailableServicerefTargets(listAvailableServicerefTargetsOptions *ListAvailableServicerefTargetsOptions) (result *ServiceRefTargetList, response *core.DetailedResponse, err error)
+
+	// ListAvailableServicerefTargetsWithContext is an alternate form of the ListAvailableServicerefTargets method
+	// which supports a Context parameter
+	ListAvailableServicerefTargetsWithContext(ctx context.Context, listAvailableServicerefTargetsOptions *ListAvailableServicerefTargetsOptions) (result *ServiceRefTargetList, response *core.DetailedResponse, err error)
+
+	// CreateRule : Create a rule
+	CreateRule(createRuleOptions *CreateRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// CreateRuleWithContext is an alternate form of the CreateRule method which supports a Context parameter
+	CreateRuleWithContext(ctx context.Context, createRuleOptions *CreateRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// ListRules : List rules
+	ListRules(listRulesOptions *ListRulesOptions) (result *RuleList, response *core.DetailedResponse, err error)
+
+	// ListRulesWithContext is an alternate form of the ListRules method which supports a Context parameter
+	ListRulesWithContext(ctx context.Context, listRulesOptions *ListRulesOptions) (result *RuleList, response *core.DetailedResponse, err error)
+
+	// GetRule : Get a rule
+	GetRule(getRuleOptions *GetRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// GetRuleWithContext is an alternate form of the GetRule method which supports a Context parameter
+	GetRuleWithContext(ctx context.Context, getRuleOptions *GetRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// ReplaceRule : Replace a rule
+	ReplaceRule(replaceRuleOptions *ReplaceRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// ReplaceRuleWithContext is an alternate form of the ReplaceRule method which supports a Context parameter
+	ReplaceRuleWithContext(ctx context.Context, replaceRuleOptions *ReplaceRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// DeleteRule : Delete a rule
+	DeleteRule(deleteRuleOptions *DeleteRuleOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteRuleWithContext is an alternate form of the DeleteRule method which supports a Context parameter
+	DeleteRuleWithContext(ctx context.Context, deleteRuleOptions *DeleteRuleOptions) (response *core.DetailedResponse, err error)
+
+	// GetAccountSettings : Get account settings
+	GetAccountSettings(getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// GetAccountSettingsWithContext is an alternate form of the GetAccountSettings method which supports a Context
+	// parameter
+	GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// ListAvailableServiceOperations : List available service operations
+	ListAvailableServiceOperations(listAvailableServiceOperationsOptions *ListAvailableServiceOperationsOptions) (result *OperationsList, response *core.DetailedResponse, err error)
+
+	// ListAvailableServiceOperationsWithContext is an alternate form of the ListAvailableServiceOperations method
+	// which supports a Context parameter
+	ListAvailableServiceOperationsWithContext(ctx context.Context, listAvailableServiceOperationsOptions *ListAvailableServiceOperationsOptions) (result *OperationsList, response *core.DetailedResponse, err error)
+
+	// NewCreateRuleOptions : Instantiate CreateRuleOptions
+	NewCreateRuleOptions() *CreateRuleOptions
+
+	// NewCreateZoneOptions : Instantiate CreateZoneOptions
+	NewCreateZoneOptions() *CreateZoneOptions
+
+	// NewDeleteRuleOptions : Instantiate DeleteRuleOptions
+	NewDeleteRuleOptions(ruleID string) *DeleteRuleOptions
+
+	// NewDeleteZoneOptions : Instantiate DeleteZoneOptions
+	NewDeleteZoneOptions(zoneID string) *DeleteZoneOptions
+
+	// NewGetAccountSettingsOptions : Instantiate GetAccountSettingsOptions
+	NewGetAccountSettingsOptions(accountID string) *GetAccountSettingsOptions
+
+	// NewGetRuleOptions : Instantiate GetRuleOptions
+	NewGetRuleOptions(ruleID string) *GetRuleOptions
+
+	// NewGetZoneOptions : Instantiate GetZoneOptions
+	NewGetZoneOptions(zoneID string) *GetZoneOptions
+
+	// NewListAvailableServiceOperationsOptions : Instantiate ListAvailableServiceOperationsOptions
+	NewListAvailableServiceOperationsOptions(serviceName string) *ListAvailableServiceOperationsOptions
+
+	// NewListAvailableServicerefTargetsOptions : Instantiate ListAvailableServicerefTargetsOptions
+	NewListAvailableServicerefTargetsOptions() *ListAvailableServicerefTargetsOptions
+
+	// NewListRulesOptions : Instantiate ListRulesOptions
+	NewListRulesOptions(accountID string) *ListRulesOptions
+
+	// NewListZonesOptions : Instantiate ListZonesOptions
+	NewListZonesOptions(accountID string) *ListZonesOptions
+
+	// NewNewRuleOperations : Instantiate NewRuleOperations (Generic Model Constructor)
+	NewNewRuleOperations(apiTypes []NewRuleOperationsAPITypesItem) (_model *NewRuleOperations, err error)
+
+	// NewNewRuleOperationsAPITypesItem : Instantiate NewRuleOperationsAPITypesItem (Generic Model Constructor)
+	NewNewRuleOperationsAPITypesItem(apiTypeID string) (_model *NewRuleOperationsAPITypesItem, err error)
+
+	// NewReplaceRuleOptions : Instantiate ReplaceRuleOptions
+	NewReplaceRuleOptions(ruleID string, ifMatch string) *ReplaceRuleOptions
+
+	// NewReplaceZoneOptions : Instantiate ReplaceZoneOptions
+	NewReplaceZoneOptions(zoneID string, ifMatch string) *ReplaceZoneOptions
+
+	// NewResource : Instantiate Resource (Generic Model Constructor)
+	NewResource(attributes []ResourceAttribute) (_model *Resource, err error)
+
+	// NewResourceAttribute : Instantiate ResourceAttribute (Generic Model Constructor)
+	NewResourceAttribute(name string, value string) (_model *ResourceAttribute, err error)
+
+	// NewResourceTagAttribute : Instantiate ResourceTagAttribute (Generic Model Constructor)
+	NewResourceTagAttribute(name string, value string) (_model *ResourceTagAttribute, err error)
+
+	// NewRuleContext : Instantiate RuleContext (Generic Model Constructor)
+	NewRuleContext(attributes []RuleContextAttribute) (_model *RuleContext, err error)
+
+	// NewRuleContextAttribute : Instantiate RuleContextAttribute (Generic Model Constructor)
+	NewRuleContextAttribute(name string, value string) (_model *RuleContextAttribute, err error)
+
+	// NewServiceRefValue : Instantiate ServiceRefValue (Generic Model Constructor)
+	NewServiceRefValue(accountID string) (_model *ServiceRefValue, err error)
+
+	// NewAddressIPAddress : Instantiate AddressIPAddress (Generic Model Constructor)
+	NewAddressIPAddress(typeVar string, value string) (_model *AddressIPAddress, err error)
+
+	// NewAddressIPAddressRange : Instantiate AddressIPAddressRange (Generic Model Constructor)
+	NewAddressIPAddressRange(typeVar string, value string) (_model *AddressIPAddressRange, err error)
+
+	// NewAddressServiceRef : Instantiate AddressServiceRef (Generic Model Constructor)
+	NewAddressServiceRef(typeVar string, ref *ServiceRefValue) (_model *AddressServiceRef, err error)
+
+	// NewAddressSubnet : Instantiate AddressSubnet (Generic Model Constructor)
+	NewAddressSubnet(typeVar string, value string) (_model *AddressSubnet, err error)
+
+	// NewAddressVPC : Instantiate AddressVPC (Generic Model Constructor)
+	NewAddressVPC(typeVar string, value string) (_model *AddressVPC, err error)
+
+	// ExportConfiguration retrieves the zones and rules of an account and returns them as a bundle, which can be
+	// applied to another account with ApplyConfiguration.
+	ExportConfiguration(ctx context.Context, accountID string) (bundle *ConfigurationBundle, err error)
+
+	// ApplyConfiguration reconciles the zones and rules of the account of "bundle" with the bundle: zones and rules of
+	// the bundle that do not exist are created, those that differ are replaced, and the zones and rules of the account
+	// that are not in the bundle are deleted.
+	ApplyConfiguration(ctx context.Context, bundle *ConfigurationBundle, dryRun bool) (result *ConfigurationApplyResult, err error)
+
+	// EvaluateAccess evaluates locally whether a request with the specified context would be allowed by "rules", so
+	// that rules can be tested before their enforcement is enabled.
+	EvaluateAccess(ctx context.Context, rules []Rule, access AccessContext) (evaluation *AccessEvaluation, err error)
+
+	// NewCreateZoneOptionsWith returns CreateZoneOptions populated by applying "options" in order.
+	NewCreateZoneOptionsWith(options ...CreateZoneOption) *CreateZoneOptions
+
+	// CreateZoneWith is an alternate form of the CreateZoneWithContext method which takes functional options instead
+	// of a CreateZoneOptions struct.
+	CreateZoneWith(ctx context.Context, options ...CreateZoneOption) (result *Zone, response *core.DetailedResponse, err error)
+
+	// NewListZonesOptionsWith returns ListZonesOptions populated by applying "options" in order.
+	NewListZonesOptionsWith(options ...ListZonesOption) *ListZonesOptions
+
+	// ListZonesWith is an alternate form of the ListZonesWithContext method which takes functional options instead of
+	// a ListZonesOptions struct.
+	ListZonesWith(ctx context.Context, options ...ListZonesOption) (result *ZoneList, response *core.DetailedResponse, err error)
+
+	// NewGetZoneOptionsWith returns GetZoneOptions populated by applying "options" in order.
+	NewGetZoneOptionsWith(options ...GetZoneOption) *GetZoneOptions
+
+	// GetZoneWith is an alternate form of the GetZoneWithContext method which takes functional options instead of a
+	// GetZoneOptions struct.
+	GetZoneWith(ctx context.Context, options ...GetZoneOption) (result *Zone, response *core.DetailedResponse, err error)
+
+	// NewReplaceZoneOptionsWith returns ReplaceZoneOptions populated by applying "options" in order.
+	NewReplaceZoneOptionsWith(options ...ReplaceZoneOption) *ReplaceZoneOptions
+
+	// ReplaceZoneWith is an alternate form of the ReplaceZoneWithContext method which takes functional options instead
+	// of a ReplaceZoneOptions struct.
+	ReplaceZoneWith(ctx context.Context, options ...ReplaceZoneOption) (result *Zone, response *core.DetailedResponse, err error)
+
+	// NewDeleteZoneOptionsWith returns DeleteZoneOptions populated by applying "options" in order.
+	NewDeleteZoneOptionsWith(options ...DeleteZoneOption) *DeleteZoneOptions
+
+	// DeleteZoneWith is an alternate form of the DeleteZoneWithContext method which takes functional options instead
+	// of a DeleteZoneOptions struct.
+	DeleteZoneWith(ctx context.Context, options ...DeleteZoneOption) (response *core.DetailedResponse, err error)
+
+	// NewListAvailableServicerefTargetsOptionsWith returns ListAvailableServicerefTargetsOptions populated by applying
+	// "options" in order.
+	NewListAvailableServicerefTargetsOptionsWith(options ...ListAvailableServicerefTargetsOption) *ListAvailableServicerefTargetsOptions
+
+	// ListAvailableServicerefTargetsWith is an alternate form of the ListAvailableServicerefTargetsWithContext method
+	// which takes functional options instead of a ListAvailableServicerefTargetsOptions struct.
+	ListAvailableServicerefTargetsWith(ctx context.Context, options ...ListAvailableServicerefTargetsOption) (result *ServiceRefTargetList, response *core.DetailedResponse, err error)
+
+	// NewCreateRuleOptionsWith returns CreateRuleOptions populated by applying "options" in order.
+	NewCreateRuleOptionsWith(options ...CreateRuleOption) *CreateRuleOptions
+
+	// CreateRuleWith is an alternate form of the CreateRuleWithContext method which takes functional options instead
+	// of a CreateRuleOptions struct.
+	CreateRuleWith(ctx context.Context, options ...CreateRuleOption) (result *Rule, response *core.DetailedResponse, err error)
+
+	// NewListRulesOptionsWith returns ListRulesOptions populated by applying "options" in order.
+	NewListRulesOptionsWith(options ...ListRulesOption) *ListRulesOptions
+
+	// ListRulesWith is an alternate form of the ListRulesWithContext method which takes functional options instead of
+	// a ListRulesOptions struct.
+	ListRulesWith(ctx context.Context, options ...ListRulesOption) (result *RuleList, response *core.DetailedResponse, err error)
+
+	// NewGetRuleOptionsWith returns GetRuleOptions populated by applying "options" in order.
+	NewGetRuleOptionsWith(options ...GetRuleOption) *GetRuleOptions
+
+	// GetRuleWith is an alternate form of the GetRuleWithContext method which takes functional options instead of a
+	// GetRuleOptions struct.
+	GetRuleWith(ctx context.Context, options ...GetRuleOption) (result *Rule, response *core.DetailedResponse, err error)
+
+	// NewReplaceRuleOptionsWith returns ReplaceRuleOptions populated by applying "options" in order.
+	NewReplaceRuleOptionsWith(options ...ReplaceRuleOption) *ReplaceRuleOptions
+
+	// ReplaceRuleWith is an alternate form of the ReplaceRuleWithContext method which takes functional options instead
+	// of a ReplaceRuleOptions struct.
+	ReplaceRuleWith(ctx context.Context, options ...ReplaceRuleOption) (result *Rule, response *core.DetailedResponse, err error)
+
+	// NewDeleteRuleOptionsWith returns DeleteRuleOptions populated by applying "options" in order.
+	NewDeleteRuleOptionsWith(options ...DeleteRuleOption) *DeleteRuleOptions
+
+	// DeleteRuleWith is an alternate form of the DeleteRuleWithContext method which takes functional options instead
+	// of a DeleteRuleOptions struct.
+	DeleteRuleWith(ctx context.Context, options ...DeleteRuleOption) (response *core.DetailedResponse, err error)
+
+	// NewGetAccountSettingsOptionsWith returns GetAccountSettingsOptions populated by applying "options" in order.
+	NewGetAccountSettingsOptionsWith(options ...GetAccountSettingsOption) *GetAccountSettingsOptions
+
+	// GetAccountSettingsWith is an alternate form of the GetAccountSettingsWithContext method which takes functional
+	// options instead of a GetAccountSettingsOptions struct.
+	GetAccountSettingsWith(ctx context.Context, options ...GetAccountSettingsOption) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// NewListAvailableServiceOperationsOptionsWith returns ListAvailableServiceOperationsOptions populated by applying
+	// "options" in order.
+	NewListAvailableServiceOperationsOptionsWith(options ...ListAvailableServiceOperationsOption) *ListAvailableServiceOperationsOptions
+
+	// ListAvailableServiceOperationsWith is an alternate form of the ListAvailableServiceOperationsWithContext method
+	// which takes functional options instead of a ListAvailableServiceOperationsOptions struct.
+	ListAvailableServiceOperationsWith(ctx context.Context, options ...ListAvailableServiceOperationsOption) (result *OperationsList, response *core.DetailedResponse, err error)
+}
+
+var _ ContextBasedRestrictionsV1API = (*ContextBasedRestrictionsV1)(nil)
@@ -0,0 +1,331 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// ZoneAddressOverlap : Two addresses of a zone that overlap, detected by ZoneBuilder.
+type ZoneAddressOverlap struct {
+	// The normalized value of the address.
+	Address string
+
+	// The normalized value of the address that it overlaps, which was added before it or contains it.
+	Other string
+
+	// Whether Address is entirely within Other (or equal to it), in which case it was left out of the zone.
+	Redundant bool
+}
+
+// ZoneBuilder : Assembles a validated CreateZoneOptions instance from IP addresses, CIDR blocks, IP ranges, VPC CRNs
+// and service references, so that malformed addresses are reported before the zone is sent to the service. Each
+// address is validated and normalized when it is added: IP addresses are written in their canonical form (e.g.
+// "2001:db8::1" rather than "2001:0db8:0:0:0:0:0:1"), and a CIDR block with host bits set is rejected. For example:
+//
+//	options, err := contextbasedrestrictionsv1.NewZoneBuilder("office", accountID).
+//	  Address("169.23.56.234", "169.23.22.0-169.23.22.255", "10.0.0.0/16").
+//	  Exclude("10.0.0.13").
+//	  Build()
+//
+// Duplicate addresses, and addresses contained in another address of the zone, are left out by Build() and reported
+// by Overlaps(), along with the addresses that only partly overlap. The first error encountered while building is
+// retained and returned by Build().
+type ZoneBuilder struct {
+	name        string
+	accountID   string
+	description *string
+	addresses   []zoneBuilderAddress
+	excluded    []zoneBuilderAddress
+	overlaps    []ZoneAddressOverlap
+	err         error
+}
+
+// zoneBuilderAddress is a normalized address. The addresses of type ipAddress, ipRange and subnet also have the
+// bounds of the range of IP addresses they cover, as 16-byte addresses.
+type zoneBuilderAddress struct {
+	addressType string
+	value       string
+	ref         *ServiceRefValue
+	first       net.IP
+	last        net.IP
+}
+
+// NewZoneBuilder returns a new ZoneBuilder for a zone with the specified name in the specified account.
+func NewZoneBuilder(name string, accountID string) *ZoneBuilder {
+	return &ZoneBuilder{
+		name:      name,
+		accountID: accountID,
+	}
+}
+
+// Description sets the description of the zone.
+func (builder *ZoneBuilder) Description(description string) *ZoneBuilder {
+	builder.description = core.StringPtr(description)
+	return builder
+}
+
+// Address adds one or more addresses to the zone. Each value is an IP address, a CIDR block (e.g. "10.0.0.0/16"), an
+// IP range (e.g. "10.0.0.1-10.0.0.20") or the CRN of a VPC, detected by its format.
+func (builder *ZoneBuilder) Address(values ...string) *ZoneBuilder {
+	for _, value := range values {
+		address, err := parseZoneAddress(value)
+		if err != nil {
+			builder.fail(err)
+			continue
+		}
+		builder.addresses = append(builder.addresses, address)
+	}
+	return builder
+}
+
+// ServiceRef adds a service reference to the zone. The service name is required.
+func (builder *ZoneBuilder) ServiceRef(ref ServiceRefValue) *ZoneBuilder {
+	if core.StringNilMapper(ref.ServiceName) == "" {
+		builder.fail(fmt.Errorf("a service reference must include a service name"))
+		return builder
+	}
+	builder.addresses = append(builder.addresses, zoneBuilderAddress{
+		addressType: AddressTypeServicerefConst,
+		value:       formatServiceRef(&ref),
+		ref:         &ref,
+	})
+	return builder
+}
+
+// Exclude excludes one or more addresses from the zone. Each value is an IP address, a CIDR block or an IP range, and
+// must be within one of the addresses of the zone.
+func (builder *ZoneBuilder) Exclude(values ...string) *ZoneBuilder {
+	for _, value := range values {
+		address, err := parseZoneAddress(value)
+		if err != nil {
+			builder.fail(err)
+			continue
+		}
+		if address.first == nil {
+			builder.fail(fmt.Errorf("address '%s' cannot be excluded: only IP addresses, IP ranges and subnets can be excluded", value))
+			continue
+		}
+		builder.excluded = append(builder.excluded, address)
+	}
+	return builder
+}
+
+// Overlaps returns the overlapping addresses detected by the last call to Build(), in the order in which the
+// addresses were added.
+func (builder *ZoneBuilder) Overlaps() []ZoneAddressOverlap {
+	return append([]ZoneAddressOverlap(nil), builder.overlaps...)
+}
+
+// Build validates the zone and returns the corresponding CreateZoneOptions.
+func (builder *ZoneBuilder) Build() (options *CreateZoneOptions, err error) {
+	builder.overlaps = nil
+	if builder.err != nil {
+		err = builder.err
+		return
+	}
+	if builder.name == "" {
+		err = fmt.Errorf("zone must have a name")
+		return
+	}
+	if builder.accountID == "" {
+		err = fmt.Errorf("zone must have an account ID")
+		return
+	}
+	if len(builder.addresses) == 0 {
+		err = fmt.Errorf("zone must have at least one address")
+		return
+	}
+
+	addresses := builder.dedup(builder.addresses)
+	excluded := builder.dedup(builder.excluded)
+	for _, exclusion := range excluded {
+		if !withinAnyAddress(exclusion, addresses) {
+			err = fmt.Errorf("excluded address '%s' is not within any address of the zone", exclusion.value)
+			return
+		}
+	}
+
+	options = &CreateZoneOptions{
+		Name:        core.StringPtr(builder.name),
+		AccountID:   core.StringPtr(builder.accountID),
+		Description: builder.description,
+	}
+	for _, address := range addresses {
+		options.Addresses = append(options.Addresses, address.model())
+	}
+	for _, exclusion := range excluded {
+		options.Excluded = append(options.Excluded, exclusion.model())
+	}
+	return
+}
+
+// dedup returns the addresses that are not redundant, and records the overlaps between the addresses. An address is
+// redundant if it is within another address, or equal to an address added before it.
+func (builder *ZoneBuilder) dedup(addresses []zoneBuilderAddress) (kept []zoneBuilderAddress) {
+	for i, address := range addresses {
+		redundant := false
+		for j, other := range addresses {
+			if i == j {
+				continue
+			}
+			switch {
+			case address.equal(other):
+				if j < i {
+					builder.overlaps = append(builder.overlaps, ZoneAddressOverlap{Address: address.value, Other: other.value, Redundant: true})
+					redundant = true
+				}
+			case address.within(other):
+				builder.overlaps = append(builder.overlaps, ZoneAddressOverlap{Address: address.value, Other: other.value, Redundant: true})
+				redundant = true
+			case j < i && address.overlaps(other) && !other.within(address):
+				builder.overlaps = append(builder.overlaps, ZoneAddressOverlap{Address: address.value, Other: other.value})
+			}
+			if redundant {
+				break
+			}
+		}
+		if !redundant {
+			kept = append(kept, address)
+		}
+	}
+	return
+}
+
+// model returns the address as an AddressIntf.
+func (address zoneBuilderAddress) model() AddressIntf {
+	if address.addressType == AddressTypeServicerefConst {
+		return &AddressServiceRef{Type: core.StringPtr(address.addressType), Ref: address.ref}
+	}
+	return &Address{Type: core.StringPtr(address.addressType), Value: core.StringPtr(address.value)}
+}
+
+// equal returns true if both addresses cover the same IP addresses, or have the same value.
+func (address zoneBuilderAddress) equal(other zoneBuilderAddress) bool {
+	if address.first != nil && other.first != nil {
+		return address.first.Equal(other.first) && address.last.Equal(other.last)
+	}
+	return address.addressType == other.addressType && address.value == other.value
+}
+
+// within returns true if the IP addresses covered by "address" are a strict subset of those covered by "other".
+func (address zoneBuilderAddress) within(other zoneBuilderAddress) bool {
+	if address.first == nil || other.first == nil || !sameIPFamily(address.first, other.first) || address.equal(other) {
+		return false
+	}
+	return bytes.Compare(address.first, other.first) >= 0 && bytes.Compare(address.last, other.last) <= 0
+}
+
+// overlaps returns true if some IP addresses are covered by both addresses.
+func (address zoneBuilderAddress) overlaps(other zoneBuilderAddress) bool {
+	if address.first == nil || other.first == nil || !sameIPFamily(address.first, other.first) {
+		return false
+	}
+	return bytes.Compare(address.first, other.last) <= 0 && bytes.Compare(other.first, address.last) <= 0
+}
+
+// withinAnyAddress returns true if an excluded address is within, or equal to, one of the addresses of a zone.
+func withinAnyAddress(exclusion zoneBuilderAddress, addresses []zoneBuilderAddress) bool {
+	for _, address := range addresses {
+		if exclusion.equal(address) || exclusion.within(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseZoneAddress validates and normalizes an IP address, CIDR block, IP range or VPC CRN.
+func parseZoneAddress(value string) (address zoneBuilderAddress, err error) {
+	value = strings.TrimSpace(value)
+	switch {
+	case strings.HasPrefix(value, "crn:"):
+		var crn common.CRN
+		crn, err = common.ParseCRN(value)
+		if err != nil {
+			err = fmt.Errorf("invalid VPC CRN '%s': %w", value, err)
+			return
+		}
+		if crn.ServiceName() != "is" || crn.ResourceType() != "vpc" || crn.Resource() == "" {
+			err = fmt.Errorf("invalid VPC CRN '%s': not the CRN of a VPC", value)
+			return
+		}
+		address = zoneBuilderAddress{addressType: AddressTypeVPCConst, value: crn.String()}
+	case strings.Contains(value, "/"):
+		ip, subnet, parseErr := net.ParseCIDR(value)
+		if parseErr != nil {
+			err = fmt.Errorf("invalid subnet '%s'", value)
+			return
+		}
+		if !ip.Equal(subnet.IP) {
+			err = fmt.Errorf("invalid subnet '%s': host bits are set (the subnet is '%s')", value, subnet.String())
+			return
+		}
+		last := make(net.IP, len(subnet.IP))
+		for i := range subnet.IP {
+			last[i] = subnet.IP[i] | ^subnet.Mask[i]
+		}
+		address = zoneBuilderAddress{addressType: AddressTypeSubnetConst, value: subnet.String(), first: subnet.IP.To16(), last: last.To16()}
+	case strings.Contains(value, "-"):
+		bounds := strings.SplitN(value, "-", 2)
+		first, last := parseZoneIP(bounds[0]), parseZoneIP(bounds[1])
+		if first == nil || last == nil {
+			err = fmt.Errorf("invalid IP range '%s'", value)
+			return
+		}
+		if !sameIPFamily(first, last) {
+			err = fmt.Errorf("invalid IP range '%s': the bounds are not of the same IP version", value)
+			return
+		}
+		if bytes.Compare(first.To16(), last.To16()) > 0 {
+			err = fmt.Errorf("invalid IP range '%s': the first address is after the last one", value)
+			return
+		}
+		address = zoneBuilderAddress{addressType: AddressTypeIprangeConst, value: first.String() + "-" + last.String(), first: first.To16(), last: last.To16()}
+	default:
+		ip := parseZoneIP(value)
+		if ip == nil {
+			err = fmt.Errorf("invalid address '%s': not an IP address, subnet, IP range or VPC CRN", value)
+			return
+		}
+		address = zoneBuilderAddress{addressType: AddressTypeIpaddressConst, value: ip.String(), first: ip.To16(), last: ip.To16()}
+	}
+	return
+}
+
+// parseZoneIP parses an IP address, ignoring surrounding spaces.
+func parseZoneIP(value string) net.IP {
+	return net.ParseIP(strings.TrimSpace(value))
+}
+
+// formatServiceRef returns a description of a service reference, which identifies it among the addresses of a zone.
+func formatServiceRef(ref *ServiceRefValue) string {
+	fields := []string{"serviceRef", core.StringNilMapper(ref.AccountID), core.StringNilMapper(ref.ServiceType),
+		core.StringNilMapper(ref.ServiceName), core.StringNilMapper(ref.ServiceInstance), core.StringNilMapper(ref.Location)}
+	return strings.Join(fields, ":")
+}
+
+func (builder *ZoneBuilder) fail(err error) {
+	if builder.err == nil {
+		builder.err = err
+	}
+}
@@ -0,0 +1,101 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ContextBasedRestrictionsV1 ZoneBuilder`, func() {
+	toJSON := func(value interface{}) string {
+		data, err := json.Marshal(value)
+		Expect(err).To(BeNil())
+		return string(data)
+	}
+
+	It(`Builds a zone with normalized addresses`, func() {
+		builder := contextbasedrestrictionsv1.NewZoneBuilder("office", "acct").
+			Description("Office network").
+			Address(" 169.23.56.234 ", "2001:0db8:0:0:0:0:0:1", "169.23.22.0 - 169.23.22.255", "10.0.0.0/16").
+			Address("crn:v1:bluemix:public:is:us-south:a/acct::vpc:vpc-1").
+			ServiceRef(contextbasedrestrictionsv1.ServiceRefValue{AccountID: core.StringPtr("acct"), ServiceName: core.StringPtr("cloud-object-storage")}).
+			Exclude("10.0.0.13")
+		options, err := builder.Build()
+		Expect(err).To(BeNil())
+		Expect(*options.Name).To(Equal("office"))
+		Expect(*options.AccountID).To(Equal("acct"))
+		Expect(*options.Description).To(Equal("Office network"))
+		Expect(toJSON(options.Addresses)).To(Equal(`[` +
+			`{"type":"ipAddress","value":"169.23.56.234"},` +
+			`{"type":"ipAddress","value":"2001:db8::1"},` +
+			`{"type":"ipRange","value":"169.23.22.0-169.23.22.255"},` +
+			`{"type":"subnet","value":"10.0.0.0/16"},` +
+			`{"type":"vpc","value":"crn:v1:bluemix:public:is:us-south:a/acct::vpc:vpc-1"},` +
+			`{"type":"serviceRef","ref":{"account_id":"acct","service_name":"cloud-object-storage"}}]`))
+		Expect(toJSON(options.Excluded)).To(Equal(`[{"type":"ipAddress","value":"10.0.0.13"}]`))
+		Expect(builder.Overlaps()).To(BeEmpty())
+	})
+	It(`Leaves out duplicate and contained addresses`, func() {
+		builder := contextbasedrestrictionsv1.NewZoneBuilder("office", "acct").
+			Address("10.0.1.5", "10.0.0.0/16", "10.0.0.0-10.0.255.255", "192.168.0.0/24", "192.168.0.128-192.168.1.10", "10.0.1.5")
+		options, err := builder.Build()
+		Expect(err).To(BeNil())
+		Expect(toJSON(options.Addresses)).To(Equal(`[` +
+			`{"type":"subnet","value":"10.0.0.0/16"},` +
+			`{"type":"subnet","value":"192.168.0.0/24"},` +
+			`{"type":"ipRange","value":"192.168.0.128-192.168.1.10"}]`))
+		Expect(builder.Overlaps()).To(Equal([]contextbasedrestrictionsv1.ZoneAddressOverlap{
+			{Address: "10.0.1.5", Other: "10.0.0.0/16", Redundant: true},
+			{Address: "10.0.0.0-10.0.255.255", Other: "10.0.0.0/16", Redundant: true},
+			{Address: "192.168.0.128-192.168.1.10", Other: "192.168.0.0/24"},
+			{Address: "10.0.1.5", Other: "10.0.1.5", Redundant: true},
+		}))
+	})
+	It(`Rejects malformed addresses`, func() {
+		invalid := map[string]string{
+			"10.0.0.300":           "invalid address '10.0.0.300'",
+			"10.0.0.5/24":          "host bits are set (the subnet is '10.0.0.0/24')",
+			"10.0.0.0/33":          "invalid subnet '10.0.0.0/33'",
+			"10.0.0.9-10.0.0.1":    "the first address is after the last one",
+			"10.0.0.1-2001:db8::1": "not of the same IP version",
+			"crn:v1:bluemix:public:cloud-object-storage:global:a/acct:instance::": "not the CRN of a VPC",
+		}
+		for value, message := range invalid {
+			_, err := contextbasedrestrictionsv1.NewZoneBuilder("office", "acct").Address(value).Build()
+			Expect(err).ToNot(BeNil(), value)
+			Expect(err.Error()).To(ContainSubstring(message))
+		}
+	})
+	It(`Rejects invalid zones`, func() {
+		_, err := contextbasedrestrictionsv1.NewZoneBuilder("office", "acct").Build()
+		Expect(err).To(MatchError("zone must have at least one address"))
+		_, err = contextbasedrestrictionsv1.NewZoneBuilder("", "acct").Address("10.0.0.1").Build()
+		Expect(err).To(MatchError("zone must have a name"))
+		_, err = contextbasedrestrictionsv1.NewZoneBuilder("office", "acct").Address("10.0.0.0/16").Exclude("10.1.0.1").Build()
+		Expect(err).To(MatchError("excluded address '10.1.0.1' is not within any address of the zone"))
+		_, err = contextbasedrestrictionsv1.NewZoneBuilder("office", "acct").Address("10.0.0.0/16").
+			Exclude("crn:v1:bluemix:public:is:us-south:a/acct::vpc:vpc-1").Build()
+		Expect(err.Error()).To(ContainSubstring("only IP addresses, IP ranges and subnets can be excluded"))
+		_, err = contextbasedrestrictionsv1.NewZoneBuilder("office", "acct").ServiceRef(contextbasedrestrictionsv1.ServiceRefValue{}).Build()
+		Expect(err).To(MatchError("a service reference must include a service name"))
+	})
+})
@@ -0,0 +1,643 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// ResourceAttributeAccountIDConst is the name of the resource attribute that holds the account of a rule's resources.
+const ResourceAttributeAccountIDConst = "accountId"
+
+// Constants associated with the ConfigurationChange.Kind property.
+const (
+	ConfigurationChangeKindZoneConst = "zone"
+	ConfigurationChangeKindRuleConst = "rule"
+)
+
+// Constants associated with the ConfigurationChange.Action property.
+const (
+	ConfigurationChangeActionCreateConst = "create"
+	ConfigurationChangeActionUpdateConst = "update"
+	ConfigurationChangeActionDeleteConst = "delete"
+)
+
+// ConfigurationBundle : The zones and rules of an account, in a form that does not depend on the account, so that it
+// can be stored (as JSON, or as YAML with a library that honors the JSON field names) and applied to another account
+// with ApplyConfiguration. Zones are identified by their names and rules by their descriptions, which must therefore be
+// unique. The networkZoneId attributes of the rule contexts hold zone names rather than zone IDs, and the accountId
+// attribute of the rule resources is omitted: it is set to the account to which the bundle is applied.
+type ConfigurationBundle struct {
+	// The account that the bundle was exported from, and that ApplyConfiguration applies it to. Set it to the target
+	// account to promote the configuration of one account to another.
+	AccountID string `json:"account_id"`
+
+	// The zones, sorted by name.
+	Zones []ConfigurationZone `json:"zones"`
+
+	// The rules, sorted by description.
+	Rules []ConfigurationRule `json:"rules"`
+}
+
+// ConfigurationZone : A zone of a ConfigurationBundle.
+type ConfigurationZone struct {
+	// The name of the zone, which identifies it in the bundle.
+	Name string `json:"name"`
+
+	// The description of the zone.
+	Description string `json:"description,omitempty"`
+
+	// The addresses of the zone.
+	Addresses []Address `json:"addresses"`
+
+	// The excluded addresses of the zone.
+	Excluded []Address `json:"excluded,omitempty"`
+}
+
+// ConfigurationRule : A rule of a ConfigurationBundle.
+type ConfigurationRule struct {
+	// The description of the rule, which identifies it in the bundle.
+	Description string `json:"description"`
+
+	// The contexts of the rule, in which the networkZoneId attributes hold comma-separated zone names.
+	Contexts []RuleContext `json:"contexts"`
+
+	// The resources of the rule, without their accountId attribute.
+	Resources []Resource `json:"resources"`
+
+	// The operations of the rule, if any.
+	Operations *NewRuleOperations `json:"operations,omitempty"`
+
+	// The enforcement mode of the rule (a RuleEnforcementMode*Const value). Defaults to enabled.
+	EnforcementMode string `json:"enforcement_mode,omitempty"`
+}
+
+// ConfigurationChange : A change made (or, in a dry run, that would be made) by ApplyConfiguration.
+type ConfigurationChange struct {
+	// Whether a zone or a rule is changed (a ConfigurationChangeKind*Const value).
+	Kind string
+
+	// The name of the zone, or the description of the rule.
+	Name string
+
+	// The ID of the zone or rule in the account. It is empty for a zone or rule that is not created yet.
+	ID string
+
+	// The change (a ConfigurationChangeAction*Const value).
+	Action string
+
+	// The error that prevented the change, if any.
+	Err error
+}
+
+// ConfigurationApplyResult : The outcome of ApplyConfiguration.
+type ConfigurationApplyResult struct {
+	// The account to which the bundle was applied.
+	AccountID string
+
+	// Whether the changes were only planned.
+	DryRun bool
+
+	// The changes, in the order in which they were made: zones are created and updated first, then rules are
+	// created, updated and deleted, and finally zones are deleted. Zones and rules that already match the bundle are
+	// not listed.
+	Changes []ConfigurationChange
+
+	// The workflow ID sent with every request of the apply (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// Failed returns the changes that could not be made.
+func (result *ConfigurationApplyResult) Failed() (failed []ConfigurationChange) {
+	for _, change := range result.Changes {
+		if change.Err != nil {
+			failed = append(failed, change)
+		}
+	}
+	return
+}
+
+// HasErrors returns true if any change could not be made.
+func (result *ConfigurationApplyResult) HasErrors() bool {
+	return len(result.Failed()) > 0
+}
+
+// accountZone is a zone of an account, with the ETag required to replace it.
+type accountZone struct {
+	zone *Zone
+	etag string
+}
+
+// ExportConfiguration retrieves the zones and rules of an account and returns them as a bundle, which can be applied
+// to another account with ApplyConfiguration.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ExportConfiguration(ctx context.Context, accountID string) (bundle *ConfigurationBundle, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, contextBasedRestrictions.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	zones, err := contextBasedRestrictions.listAccountZones(ctx, accountID)
+	if err != nil {
+		return
+	}
+	rules, err := contextBasedRestrictions.listAccountRules(ctx, accountID)
+	if err != nil {
+		return
+	}
+
+	zoneNames := make(map[string]string)
+	bundle = &ConfigurationBundle{AccountID: accountID, Zones: []ConfigurationZone{}, Rules: []ConfigurationRule{}}
+	for _, current := range zones {
+		zoneNames[core.StringNilMapper(current.zone.ID)] = core.StringNilMapper(current.zone.Name)
+		bundle.Zones = append(bundle.Zones, exportZone(current.zone))
+	}
+	for i := range rules {
+		var rule ConfigurationRule
+		rule, err = exportRule(&rules[i], zoneNames)
+		if err != nil {
+			bundle = nil
+			return
+		}
+		bundle.Rules = append(bundle.Rules, rule)
+	}
+	sort.SliceStable(bundle.Zones, func(i, j int) bool { return bundle.Zones[i].Name < bundle.Zones[j].Name })
+	sort.SliceStable(bundle.Rules, func(i, j int) bool { return bundle.Rules[i].Description < bundle.Rules[j].Description })
+	return
+}
+
+// ApplyConfiguration reconciles the zones and rules of the account of "bundle" with the bundle: zones and rules of
+// the bundle that do not exist are created, those that differ are replaced, and the zones and rules of the account
+// that are not in the bundle are deleted. If "dryRun" is true, the account is not modified and the result lists the
+// changes that would be made.
+//
+// "err" is returned if the bundle is invalid or the configuration of the account cannot be retrieved. Otherwise
+// ApplyConfiguration continues after a failed change, and the failed changes are reported with their error: a rule
+// that refers to a zone that could not be created is not applied, and a zone that is still used by a rule that could
+// not be deleted cannot be deleted.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ApplyConfiguration(ctx context.Context, bundle *ConfigurationBundle, dryRun bool) (result *ConfigurationApplyResult, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, contextBasedRestrictions.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	err = core.ValidateNotNil(bundle, "bundle cannot be nil")
+	if err != nil {
+		return
+	}
+	err = validateBundle(bundle)
+	if err != nil {
+		return
+	}
+	accountID := bundle.AccountID
+	zones, err := contextBasedRestrictions.listAccountZones(ctx, accountID)
+	if err != nil {
+		return
+	}
+	rules, err := contextBasedRestrictions.listAccountRules(ctx, accountID)
+	if err != nil {
+		return
+	}
+
+	result = &ConfigurationApplyResult{AccountID: accountID, DryRun: dryRun, WorkflowID: workflowID}
+	currentZones := make(map[string]*accountZone)
+	for i := range zones {
+		currentZones[core.StringNilMapper(zones[i].zone.Name)] = &zones[i]
+	}
+	zoneIDs := make(map[string]string)
+	for _, desired := range bundle.Zones {
+		change := contextBasedRestrictions.applyZone(ctx, accountID, desired, currentZones[desired.Name], dryRun)
+		switch {
+		case change.Err == nil && change.ID != "":
+			zoneIDs[desired.Name] = change.ID
+		case change.Err == nil:
+			// A zone that is not created in a dry run is referred to by its name.
+			zoneIDs[desired.Name] = desired.Name
+		}
+		if change.Action != "" {
+			result.Changes = append(result.Changes, change)
+		}
+	}
+
+	currentRules := make(map[string]*Rule)
+	var extraRules []*Rule
+	for i := range rules {
+		description := core.StringNilMapper(rules[i].Description)
+		if _, duplicate := currentRules[description]; duplicate || !bundle.hasRule(description) {
+			extraRules = append(extraRules, &rules[i])
+			continue
+		}
+		currentRules[description] = &rules[i]
+	}
+	for _, desired := range bundle.Rules {
+		change := contextBasedRestrictions.applyRule(ctx, accountID, desired, currentRules[desired.Description], zoneIDs, dryRun)
+		if change.Action != "" {
+			result.Changes = append(result.Changes, change)
+		}
+	}
+	for _, rule := range extraRules {
+		change := ConfigurationChange{
+			Kind:   ConfigurationChangeKindRuleConst,
+			Name:   core.StringNilMapper(rule.Description),
+			ID:     core.StringNilMapper(rule.ID),
+			Action: ConfigurationChangeActionDeleteConst,
+		}
+		if !dryRun {
+			_, change.Err = contextBasedRestrictions.DeleteRuleWithContext(ctx, contextBasedRestrictions.NewDeleteRuleOptions(change.ID))
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	for _, current := range zones {
+		name := core.StringNilMapper(current.zone.Name)
+		if bundle.hasZone(name) {
+			continue
+		}
+		change := ConfigurationChange{
+			Kind:   ConfigurationChangeKindZoneConst,
+			Name:   name,
+			ID:     core.StringNilMapper(current.zone.ID),
+			Action: ConfigurationChangeActionDeleteConst,
+		}
+		if !dryRun {
+			_, change.Err = contextBasedRestrictions.DeleteZoneWithContext(ctx, contextBasedRestrictions.NewDeleteZoneOptions(change.ID))
+		}
+		result.Changes = append(result.Changes, change)
+	}
+	return
+}
+
+// applyZone creates or replaces a zone of the bundle if it does not match the zone of the account. The action of the
+// returned change is empty if the zone matches.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) applyZone(ctx context.Context, accountID string, desired ConfigurationZone,
+	current *accountZone, dryRun bool) (change ConfigurationChange) {
+	change = ConfigurationChange{Kind: ConfigurationChangeKindZoneConst, Name: desired.Name}
+	if current != nil {
+		change.ID = core.StringNilMapper(current.zone.ID)
+		if zonesEqual(exportZone(current.zone), desired) {
+			return
+		}
+		change.Action = ConfigurationChangeActionUpdateConst
+		if dryRun {
+			return
+		}
+		options := contextBasedRestrictions.NewReplaceZoneOptions(change.ID, current.etag)
+		options.Name = core.StringPtr(desired.Name)
+		options.AccountID = core.StringPtr(accountID)
+		options.Description = core.StringPtr(desired.Description)
+		options.Addresses, options.Excluded = addressModels(desired.Addresses), addressModels(desired.Excluded)
+		_, _, change.Err = contextBasedRestrictions.ReplaceZoneWithContext(ctx, options)
+		return
+	}
+
+	change.Action = ConfigurationChangeActionCreateConst
+	if dryRun {
+		return
+	}
+	options := contextBasedRestrictions.NewCreateZoneOptions()
+	options.Name = core.StringPtr(desired.Name)
+	options.AccountID = core.StringPtr(accountID)
+	options.Description = core.StringPtr(desired.Description)
+	options.Addresses, options.Excluded = addressModels(desired.Addresses), addressModels(desired.Excluded)
+	zone, _, err := contextBasedRestrictions.CreateZoneWithContext(ctx, options)
+	if err != nil {
+		change.Err = err
+		return
+	}
+	change.ID = core.StringNilMapper(zone.ID)
+	return
+}
+
+// applyRule creates or replaces a rule of the bundle if it does not match the rule of the account. The action of the
+// returned change is empty if the rule matches.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) applyRule(ctx context.Context, accountID string, desired ConfigurationRule,
+	current *Rule, zoneIDs map[string]string, dryRun bool) (change ConfigurationChange) {
+	change = ConfigurationChange{Kind: ConfigurationChangeKindRuleConst, Name: desired.Description, Action: ConfigurationChangeActionCreateConst}
+	if current != nil {
+		change.ID = core.StringNilMapper(current.ID)
+		change.Action = ConfigurationChangeActionUpdateConst
+	}
+	contexts, err := importRuleContexts(desired.Contexts, zoneIDs)
+	if err != nil {
+		change.Err = err
+		return
+	}
+	resources := importRuleResources(desired.Resources, accountID)
+	enforcementMode := desired.EnforcementMode
+	if enforcementMode == "" {
+		enforcementMode = RuleEnforcementModeEnabledConst
+	}
+
+	if current != nil {
+		target := &Rule{Description: current.Description, Contexts: contexts, Resources: resources, Operations: desired.Operations,
+			EnforcementMode: core.StringPtr(enforcementMode)}
+		if rulesEqual(current, target) {
+			change.Action = ""
+			return
+		}
+		if dryRun {
+			return
+		}
+		var response *core.DetailedResponse
+		_, response, err = contextBasedRestrictions.GetRuleWithContext(ctx, contextBasedRestrictions.NewGetRuleOptions(change.ID))
+		if err != nil {
+			change.Err = fmt.Errorf("error retrieving rule '%s': %w", change.ID, err)
+			return
+		}
+		options := contextBasedRestrictions.NewReplaceRuleOptions(change.ID, response.GetHeaders().Get("ETag"))
+		options.Description = core.StringPtr(desired.Description)
+		options.Contexts, options.Resources, options.Operations = contexts, resources, desired.Operations
+		options.EnforcementMode = core.StringPtr(enforcementMode)
+		_, _, change.Err = contextBasedRestrictions.ReplaceRuleWithContext(ctx, options)
+		return
+	}
+
+	if dryRun {
+		return
+	}
+	options := contextBasedRestrictions.NewCreateRuleOptions()
+	options.Description = core.StringPtr(desired.Description)
+	options.Contexts, options.Resources, options.Operations = contexts, resources, desired.Operations
+	options.EnforcementMode = core.StringPtr(enforcementMode)
+	rule, _, err := contextBasedRestrictions.CreateRuleWithContext(ctx, options)
+	if err != nil {
+		change.Err = err
+		return
+	}
+	change.ID = core.StringNilMapper(rule.ID)
+	return
+}
+
+// listAccountZones retrieves the zones of an account with their addresses.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) listAccountZones(ctx context.Context, accountID string) (zones []accountZone, err error) {
+	list, _, err := contextBasedRestrictions.ListZonesWithContext(ctx, contextBasedRestrictions.NewListZonesOptions(accountID))
+	if err != nil {
+		err = fmt.Errorf("error listing the zones of account '%s': %w", accountID, err)
+		return
+	}
+	for _, summary := range list.Zones {
+		zoneID := core.StringNilMapper(summary.ID)
+		zone, response, getErr := contextBasedRestrictions.GetZoneWithContext(ctx, contextBasedRestrictions.NewGetZoneOptions(zoneID))
+		if getErr != nil {
+			err = fmt.Errorf("error retrieving zone '%s': %w", zoneID, getErr)
+			return
+		}
+		zones = append(zones, accountZone{zone: zone, etag: response.GetHeaders().Get("ETag")})
+	}
+	return
+}
+
+// listAccountRules retrieves the rules of an account.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) listAccountRules(ctx context.Context, accountID string) (rules []Rule, err error) {
+	list, _, err := contextBasedRestrictions.ListRulesWithContext(ctx, contextBasedRestrictions.NewListRulesOptions(accountID))
+	if err != nil {
+		err = fmt.Errorf("error listing the rules of account '%s': %w", accountID, err)
+		return
+	}
+	return list.Rules, nil
+}
+
+// validateBundle checks that the zones and rules of a bundle can be identified, and that the rules refer to zones of
+// the bundle.
+func validateBundle(bundle *ConfigurationBundle) error {
+	if bundle.AccountID == "" {
+		return fmt.Errorf("the bundle has no account ID")
+	}
+	zoneNames := make(map[string]bool)
+	for i, zone := range bundle.Zones {
+		if zone.Name == "" {
+			return fmt.Errorf("zone %d of the bundle has no name", i)
+		}
+		if zoneNames[zone.Name] {
+			return fmt.Errorf("the bundle contains several zones named '%s'", zone.Name)
+		}
+		zoneNames[zone.Name] = true
+	}
+	descriptions := make(map[string]bool)
+	for i, rule := range bundle.Rules {
+		if rule.Description == "" {
+			return fmt.Errorf("rule %d of the bundle has no description, which identifies it", i)
+		}
+		if descriptions[rule.Description] {
+			return fmt.Errorf("the bundle contains several rules described as '%s'", rule.Description)
+		}
+		descriptions[rule.Description] = true
+		for _, ruleContext := range rule.Contexts {
+			for _, attribute := range ruleContext.Attributes {
+				if core.StringNilMapper(attribute.Name) != RuleContextAttributeNetworkZoneIDConst {
+					continue
+				}
+				for _, name := range strings.Split(core.StringNilMapper(attribute.Value), ",") {
+					if !zoneNames[strings.TrimSpace(name)] {
+						return fmt.Errorf("rule '%s' refers to zone '%s', which is not in the bundle", rule.Description, name)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (bundle *ConfigurationBundle) hasZone(name string) bool {
+	for _, zone := range bundle.Zones {
+		if zone.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (bundle *ConfigurationBundle) hasRule(description string) bool {
+	for _, rule := range bundle.Rules {
+		if rule.Description == description {
+			return true
+		}
+	}
+	return false
+}
+
+// exportZone returns a zone of an account as a zone of a bundle.
+func exportZone(zone *Zone) ConfigurationZone {
+	exported := ConfigurationZone{
+		Name:        core.StringNilMapper(zone.Name),
+		Description: core.StringNilMapper(zone.Description),
+		Addresses:   []Address{},
+	}
+	for _, address := range zone.Addresses {
+		exported.Addresses = append(exported.Addresses, exportAddress(address))
+	}
+	for _, address := range zone.Excluded {
+		exported.Excluded = append(exported.Excluded, exportAddress(address))
+	}
+	return exported
+}
+
+// exportAddress returns an address of any concrete type as an Address.
+func exportAddress(address AddressIntf) Address {
+	addressType, value, ref := addressFields(address)
+	exported := Address{Type: core.StringPtr(addressType), Ref: ref}
+	if value != "" {
+		exported.Value = core.StringPtr(value)
+	}
+	return exported
+}
+
+// exportRule returns a rule of an account as a rule of a bundle, replacing the zone IDs with the zone names.
+func exportRule(rule *Rule, zoneNames map[string]string) (exported ConfigurationRule, err error) {
+	exported = ConfigurationRule{
+		Description:     core.StringNilMapper(rule.Description),
+		Contexts:        []RuleContext{},
+		Resources:       []Resource{},
+		Operations:      rule.Operations,
+		EnforcementMode: core.StringNilMapper(rule.EnforcementMode),
+	}
+	for _, ruleContext := range rule.Contexts {
+		var attributes []RuleContextAttribute
+		for _, attribute := range ruleContext.Attributes {
+			value := core.StringNilMapper(attribute.Value)
+			if core.StringNilMapper(attribute.Name) == RuleContextAttributeNetworkZoneIDConst {
+				var names []string
+				for _, zoneID := range strings.Split(value, ",") {
+					name, found := zoneNames[strings.TrimSpace(zoneID)]
+					if !found {
+						err = fmt.Errorf("rule '%s' refers to zone '%s', which is not in the account", core.StringNilMapper(rule.ID), zoneID)
+						return
+					}
+					names = append(names, name)
+				}
+				value = strings.Join(names, ",")
+			}
+			attributes = append(attributes, RuleContextAttribute{Name: attribute.Name, Value: core.StringPtr(value)})
+		}
+		exported.Contexts = append(exported.Contexts, RuleContext{Attributes: attributes})
+	}
+	for _, resource := range rule.Resources {
+		var attributes []ResourceAttribute
+		for _, attribute := range resource.Attributes {
+			if core.StringNilMapper(attribute.Name) != ResourceAttributeAccountIDConst {
+				attributes = append(attributes, attribute)
+			}
+		}
+		exported.Resources = append(exported.Resources, Resource{Attributes: attributes, Tags: resource.Tags})
+	}
+	return
+}
+
+// importRuleContexts returns the contexts of a rule of a bundle with the zone names replaced by the zone IDs.
+func importRuleContexts(contexts []RuleContext, zoneIDs map[string]string) (imported []RuleContext, err error) {
+	for _, ruleContext := range contexts {
+		var attributes []RuleContextAttribute
+		for _, attribute := range ruleContext.Attributes {
+			value := core.StringNilMapper(attribute.Value)
+			if core.StringNilMapper(attribute.Name) == RuleContextAttributeNetworkZoneIDConst {
+				var ids []string
+				for _, name := range strings.Split(value, ",") {
+					zoneID, found := zoneIDs[strings.TrimSpace(name)]
+					if !found {
+						err = fmt.Errorf("zone '%s' was not applied", strings.TrimSpace(name))
+						return
+					}
+					ids = append(ids, zoneID)
+				}
+				value = strings.Join(ids, ",")
+			}
+			attributes = append(attributes, RuleContextAttribute{Name: attribute.Name, Value: core.StringPtr(value)})
+		}
+		imported = append(imported, RuleContext{Attributes: attributes})
+	}
+	return
+}
+
+// importRuleResources returns the resources of a rule of a bundle with the accountId attribute of the account.
+func importRuleResources(resources []Resource, accountID string) (imported []Resource) {
+	for _, resource := range resources {
+		attributes := []ResourceAttribute{{Name: core.StringPtr(ResourceAttributeAccountIDConst), Value: core.StringPtr(accountID)}}
+		for _, attribute := range resource.Attributes {
+			if core.StringNilMapper(attribute.Name) != ResourceAttributeAccountIDConst {
+				attributes = append(attributes, attribute)
+			}
+		}
+		imported = append(imported, Resource{Attributes: attributes, Tags: resource.Tags})
+	}
+	return
+}
+
+// addressModels returns addresses as AddressIntf values.
+func addressModels(addresses []Address) (models []AddressIntf) {
+	for i := range addresses {
+		address := addresses[i]
+		models = append(models, &address)
+	}
+	return
+}
+
+// zonesEqual returns true if two zones have the same description and the same addresses, in any order.
+func zonesEqual(a ConfigurationZone, b ConfigurationZone) bool {
+	return a.Description == b.Description &&
+		canonicalJSON(a.Addresses) == canonicalJSON(b.Addresses) && canonicalJSON(a.Excluded) == canonicalJSON(b.Excluded)
+}
+
+// rulesEqual returns true if two rules have the same description, contexts, resources, operations and enforcement
+// mode, ignoring the order of the attributes of each context and resource.
+func rulesEqual(a *Rule, b *Rule) bool {
+	mode := func(rule *Rule) string {
+		if rule.EnforcementMode == nil {
+			return RuleEnforcementModeEnabledConst
+		}
+		return *rule.EnforcementMode
+	}
+	if core.StringNilMapper(a.Description) != core.StringNilMapper(b.Description) || mode(a) != mode(b) {
+		return false
+	}
+	if len(a.Contexts) != len(b.Contexts) || len(a.Resources) != len(b.Resources) {
+		return false
+	}
+	for i := range a.Contexts {
+		if canonicalJSON(a.Contexts[i].Attributes) != canonicalJSON(b.Contexts[i].Attributes) {
+			return false
+		}
+	}
+	for i := range a.Resources {
+		if canonicalJSON(a.Resources[i].Attributes) != canonicalJSON(b.Resources[i].Attributes) ||
+			canonicalJSON(a.Resources[i].Tags) != canonicalJSON(b.Resources[i].Tags) {
+			return false
+		}
+	}
+	operations := func(rule *Rule) []NewRuleOperationsAPITypesItem {
+		if rule.Operations == nil {
+			return nil
+		}
+		return rule.Operations.APITypes
+	}
+	return canonicalJSON(operations(a)) == canonicalJSON(operations(b))
+}
+
+// canonicalJSON returns the JSON encodings of the elements of a slice, sorted, so that slices with the same elements in
+// a different order have the same canonical form.
+func canonicalJSON(slice interface{}) string {
+	data, _ := json.Marshal(slice)
+	var elements []json.RawMessage
+	_ = json.Unmarshal(data, &elements)
+	encoded := make([]string, len(elements))
+	for i, element := range elements {
+		encoded[i] = string(element)
+	}
+	sort.Strings(encoded)
+	return strings.Join(encoded, ",")
+}
@@ -0,0 +1,261 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ContextBasedRestrictionsV1 configuration bundles`, func() {
+	var testServer *httptest.Server
+	var cbrService *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
+	var zones, rules map[string]map[string]interface{}
+	var mutations []string
+	var nextID int
+
+	decode := func(data string) map[string]interface{} {
+		var value map[string]interface{}
+		Expect(json.Unmarshal([]byte(data), &value)).To(Succeed())
+		return value
+	}
+	BeforeEach(func() {
+		mutations = nil
+		nextID = 0
+		zones = map[string]map[string]interface{}{
+			"src-office": decode(`{"id": "src-office", "name": "office", "account_id": "src", "description": "Office",
+				"addresses": [{"type": "subnet", "value": "10.0.0.0/16"}, {"type": "ipAddress", "value": "169.23.56.234"}],
+				"excluded": [{"type": "ipAddress", "value": "10.0.0.13"}]}`),
+			"src-vpn": decode(`{"id": "src-vpn", "name": "vpn", "account_id": "src", "description": "",
+				"addresses": [{"type": "ipRange", "value": "172.16.0.1-172.16.0.20"}], "excluded": []}`),
+			"dst-office": decode(`{"id": "dst-office", "name": "office", "account_id": "dst", "description": "Office",
+				"addresses": [{"type": "subnet", "value": "10.0.0.0/16"}], "excluded": []}`),
+			"dst-legacy": decode(`{"id": "dst-legacy", "name": "legacy", "account_id": "dst", "description": "",
+				"addresses": [{"type": "ipAddress", "value": "192.168.1.1"}], "excluded": []}`),
+		}
+		rules = map[string]map[string]interface{}{
+			"src-cos": decode(`{"id": "src-cos", "account_id": "src", "description": "Protect COS", "enforcement_mode": "report",
+				"contexts": [{"attributes": [{"name": "networkZoneId", "value": "src-office,src-vpn"}, {"name": "endpointType", "value": "private"}]}],
+				"resources": [{"attributes": [{"name": "accountId", "value": "src"}, {"name": "serviceName", "value": "cloud-object-storage"}]}]}`),
+			"dst-legacy": decode(`{"id": "dst-legacy", "account_id": "dst", "description": "Legacy",
+				"contexts": [{"attributes": [{"name": "networkZoneId", "value": "dst-legacy"}]}],
+				"resources": [{"attributes": [{"name": "accountId", "value": "dst"}, {"name": "serviceName", "value": "kms"}]}]}`),
+		}
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+			Expect(segments[0]).To(Equal("v1"))
+			store := map[string]map[string]map[string]interface{}{"zones": zones, "rules": rules}[segments[1]]
+			Expect(store).ToNot(BeNil())
+			if req.Method != "GET" {
+				mutations = append(mutations, req.Method+" "+req.URL.Path)
+			}
+
+			var body map[string]interface{}
+			if req.Method == "POST" || req.Method == "PUT" {
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				if segments[1] == "rules" {
+					resource := body["resources"].([]interface{})[0].(map[string]interface{})
+					account := resource["attributes"].([]interface{})[0].(map[string]interface{})
+					Expect(account["name"]).To(Equal("accountId"))
+					body["account_id"] = account["value"]
+				}
+			}
+			switch {
+			case req.Method == "GET" && len(segments) == 2:
+				accountID := req.URL.Query().Get("account_id")
+				var ids []string
+				for id, item := range store {
+					if item["account_id"] == accountID {
+						ids = append(ids, id)
+					}
+				}
+				sort.Strings(ids)
+				var items []map[string]interface{}
+				for _, id := range ids {
+					items = append(items, store[id])
+				}
+				list, _ := json.Marshal(items)
+				fmt.Fprintf(res, `{"count": %d, "%s": %s}`, len(items), segments[1], list)
+			case req.Method == "POST":
+				nextID++
+				id := fmt.Sprintf("new-%d", nextID)
+				body["id"] = id
+				store[id] = body
+				res.WriteHeader(201)
+				Expect(json.NewEncoder(res).Encode(body)).To(Succeed())
+			case store[segments[2]] == nil:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			case req.Method == "GET":
+				res.Header().Set("ETag", "etag-"+segments[2])
+				Expect(json.NewEncoder(res).Encode(store[segments[2]])).To(Succeed())
+			case req.Method == "PUT":
+				Expect(req.Header.Get("If-Match")).To(Equal("etag-" + segments[2]))
+				body["id"] = segments[2]
+				store[segments[2]] = body
+				Expect(json.NewEncoder(res).Encode(body)).To(Succeed())
+			case req.Method == "DELETE":
+				delete(store, segments[2])
+				res.WriteHeader(204)
+			}
+		}))
+		var serviceErr error
+		cbrService, serviceErr = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(cbrService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	exportSource := func() *contextbasedrestrictionsv1.ConfigurationBundle {
+		bundle, err := cbrService.ExportConfiguration(context.Background(), "src")
+		Expect(err).To(BeNil())
+		return bundle
+	}
+	summarize := func(changes []contextbasedrestrictionsv1.ConfigurationChange) (summary []string) {
+		for _, change := range changes {
+			Expect(change.Err).To(BeNil())
+			summary = append(summary, change.Action+" "+change.Kind+" "+change.Name)
+		}
+		return
+	}
+
+	It(`Exports the zones and rules of an account`, func() {
+		data, err := json.Marshal(exportSource())
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(MatchJSON(`{"account_id": "src",
+			"zones": [
+				{"name": "office", "description": "Office",
+				 "addresses": [{"type": "subnet", "value": "10.0.0.0/16"}, {"type": "ipAddress", "value": "169.23.56.234"}],
+				 "excluded": [{"type": "ipAddress", "value": "10.0.0.13"}]},
+				{"name": "vpn", "addresses": [{"type": "ipRange", "value": "172.16.0.1-172.16.0.20"}]}],
+			"rules": [
+				{"description": "Protect COS", "enforcement_mode": "report",
+				 "contexts": [{"attributes": [{"name": "networkZoneId", "value": "office,vpn"}, {"name": "endpointType", "value": "private"}]}],
+				 "resources": [{"attributes": [{"name": "serviceName", "value": "cloud-object-storage"}]}]}]}`))
+	})
+	It(`Plans the changes in a dry run`, func() {
+		bundle := exportSource()
+		bundle.AccountID = "dst"
+		result, err := cbrService.ApplyConfiguration(context.Background(), bundle, true)
+		Expect(err).To(BeNil())
+		Expect(result.DryRun).To(BeTrue())
+		Expect(result.WorkflowID).ToNot(BeEmpty())
+		Expect(summarize(result.Changes)).To(Equal([]string{
+			"update zone office",
+			"create zone vpn",
+			"create rule Protect COS",
+			"delete rule Legacy",
+			"delete zone legacy",
+		}))
+		Expect(result.Changes[0].ID).To(Equal("dst-office"))
+		Expect(result.Changes[1].ID).To(BeEmpty())
+		Expect(mutations).To(BeEmpty())
+	})
+	It(`Reconciles another account with the bundle`, func() {
+		bundle := exportSource()
+		bundle.AccountID = "dst"
+		result, err := cbrService.ApplyConfiguration(context.Background(), bundle, false)
+		Expect(err).To(BeNil())
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(mutations).To(Equal([]string{
+			"PUT /v1/zones/dst-office",
+			"POST /v1/zones",
+			"POST /v1/rules",
+			"DELETE /v1/rules/dst-legacy",
+			"DELETE /v1/zones/dst-legacy",
+		}))
+		Expect(zones["dst-office"]["addresses"]).To(HaveLen(2))
+		Expect(zones["new-1"]["account_id"]).To(Equal("dst"))
+		rule := rules["new-2"]
+		Expect(rule["enforcement_mode"]).To(Equal("report"))
+		ruleData, _ := json.Marshal(rule["contexts"])
+		Expect(string(ruleData)).To(ContainSubstring(`"value":"dst-office,new-1"`))
+		Expect(rule["account_id"]).To(Equal("dst"))
+
+		mutations = nil
+		result, err = cbrService.ApplyConfiguration(context.Background(), bundle, false)
+		Expect(err).To(BeNil())
+		Expect(result.Changes).To(BeEmpty())
+		Expect(mutations).To(BeEmpty())
+
+		bundle.Rules[0].EnforcementMode = contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst
+		result, err = cbrService.ApplyConfiguration(context.Background(), bundle, false)
+		Expect(err).To(BeNil())
+		Expect(summarize(result.Changes)).To(Equal([]string{"update rule Protect COS"}))
+		Expect(mutations).To(Equal([]string{"PUT /v1/rules/new-2"}))
+	})
+	It(`Reports the changes that fail`, func() {
+		bundle := exportSource()
+		bundle.AccountID = "dst"
+		bundle.Zones[1].Addresses = nil
+		testServer.Config.Handler = func(handler http.Handler) http.Handler {
+			return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				if req.Method == "POST" && req.URL.Path == "/v1/zones" {
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(400)
+					fmt.Fprint(res, `{"message": "the zone has no addresses"}`)
+					return
+				}
+				handler.ServeHTTP(res, req)
+			})
+		}(testServer.Config.Handler)
+		result, err := cbrService.ApplyConfiguration(context.Background(), bundle, false)
+		Expect(err).To(BeNil())
+		failed := result.Failed()
+		Expect(failed).To(HaveLen(2))
+		Expect(failed[0].Name).To(Equal("vpn"))
+		Expect(failed[0].Err.Error()).To(ContainSubstring("the zone has no addresses"))
+		Expect(failed[1].Name).To(Equal("Protect COS"))
+		Expect(failed[1].Err).To(MatchError("zone 'vpn' was not applied"))
+	})
+	It(`Rejects invalid bundles`, func() {
+		bundle := exportSource()
+		bundle.AccountID = ""
+		_, err := cbrService.ApplyConfiguration(context.Background(), bundle, true)
+		Expect(err).ToNot(BeNil())
+
+		bundle = exportSource()
+		bundle.Rules = append(bundle.Rules, bundle.Rules[0])
+		_, err = cbrService.ApplyConfiguration(context.Background(), bundle, true)
+		Expect(err.Error()).To(ContainSubstring("several rules described as 'Protect COS'"))
+
+		bundle = exportSource()
+		bundle.Zones = bundle.Zones[:1]
+		_, err = cbrService.ApplyConfiguration(context.Background(), bundle, true)
+		Expect(err.Error()).To(ContainSubstring("refers to zone 'vpn', which is not in the bundle"))
+	})
+})
@@ -0,0 +1,338 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Names of the rule context attributes.
+const (
+	RuleContextAttributeNetworkZoneIDConst = "networkZoneId"
+	RuleContextAttributeEndpointTypeConst  = "endpointType"
+)
+
+// Constants associated with the AccessContext.EndpointType property.
+const (
+	AccessContextEndpointTypePublicConst  = "public"
+	AccessContextEndpointTypePrivateConst = "private"
+	AccessContextEndpointTypeDirectConst  = "direct"
+)
+
+// AccessContext : The context of a hypothetical request, evaluated by EvaluateAccess. A request comes from an IP
+// address (optionally within a VPC) or from a service.
+type AccessContext struct {
+	// The IP address that the request comes from.
+	IP string
+
+	// The CRN of the VPC that the request comes from, if any.
+	VPC string
+
+	// The service that the request comes from, if any. A zone address of type serviceRef matches it if every
+	// field that the address sets has the same value.
+	ServiceRef *ServiceRefValue
+
+	// The type of the endpoint that the request is sent to (an AccessContextEndpointType*Const value).
+	EndpointType string
+}
+
+// AccessEvaluation : The outcome of EvaluateAccess.
+type AccessEvaluation struct {
+	// Whether the request is allowed by the rules as they are enforced: true if no enabled rule applies, or if at
+	// least one enabled rule allows it.
+	Allowed bool
+
+	// Whether the request would be allowed if the rules in report mode were enforced too.
+	AllowedIfEnforced bool
+
+	// The evaluation of each rule, in the order of the rules passed to EvaluateAccess.
+	Rules []RuleEvaluation
+}
+
+// RuleEvaluation : The evaluation of one rule by EvaluateAccess.
+type RuleEvaluation struct {
+	// The ID of the rule.
+	RuleID string
+
+	// The enforcement mode of the rule (a RuleEnforcementMode*Const value).
+	EnforcementMode string
+
+	// Whether the request matches one of the contexts of the rule. It is false for a disabled rule.
+	Allowed bool
+
+	// The index of the first context of the rule that the request matches, or -1 if it matches none.
+	MatchedContext int
+
+	// Why the request does not match each context of the rule, in order, if it matches none.
+	Reasons []string
+}
+
+// EvaluateAccess evaluates locally whether a request with the specified context would be allowed by "rules", so that
+// rules can be tested before their enforcement is enabled. The rules are assumed to apply to the resource and
+// operation of the request. A request is allowed by a rule if it matches every attribute of at least one of its
+// contexts, and a request to which several rules apply is allowed if at least one of them allows it. Disabled rules
+// are ignored, and rules in report mode only affect AccessEvaluation.AllowedIfEnforced.
+//
+// The zones referred to by the rules are retrieved with GetZone, once each; "err" is returned if a zone cannot be
+// retrieved or one of its addresses is invalid.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) EvaluateAccess(ctx context.Context, rules []Rule, access AccessContext) (evaluation *AccessEvaluation, err error) {
+	evaluator := accessEvaluator{
+		client: contextBasedRestrictions,
+		access: access,
+		ip:     net.ParseIP(access.IP),
+		zones:  make(map[string]*Zone),
+	}
+	if access.IP != "" && evaluator.ip == nil {
+		err = fmt.Errorf("invalid IP address '%s'", access.IP)
+		return
+	}
+
+	evaluation = &AccessEvaluation{Allowed: true, AllowedIfEnforced: true}
+	enforced, allowedByEnforced := false, false
+	reported, allowedByReported := false, false
+	for i := range rules {
+		var ruleEvaluation RuleEvaluation
+		ruleEvaluation, err = evaluator.evaluateRule(ctx, &rules[i])
+		if err != nil {
+			evaluation = nil
+			return
+		}
+		evaluation.Rules = append(evaluation.Rules, ruleEvaluation)
+		switch ruleEvaluation.EnforcementMode {
+		case RuleEnforcementModeEnabledConst:
+			enforced = true
+			allowedByEnforced = allowedByEnforced || ruleEvaluation.Allowed
+		case RuleEnforcementModeReportConst:
+			reported = true
+			allowedByReported = allowedByReported || ruleEvaluation.Allowed
+		}
+	}
+	if enforced {
+		evaluation.Allowed = allowedByEnforced
+	}
+	if enforced || reported {
+		evaluation.AllowedIfEnforced = allowedByEnforced || allowedByReported
+	}
+	return
+}
+
+type accessEvaluator struct {
+	client *ContextBasedRestrictionsV1
+	access AccessContext
+	ip     net.IP
+
+	// The zones retrieved so far, by ID.
+	zones map[string]*Zone
+}
+
+// evaluateRule evaluates the contexts of a rule.
+func (evaluator *accessEvaluator) evaluateRule(ctx context.Context, rule *Rule) (evaluation RuleEvaluation, err error) {
+	evaluation = RuleEvaluation{
+		RuleID:          core.StringNilMapper(rule.ID),
+		EnforcementMode: core.StringNilMapper(rule.EnforcementMode),
+		MatchedContext:  -1,
+	}
+	if evaluation.EnforcementMode == "" {
+		evaluation.EnforcementMode = RuleEnforcementModeEnabledConst
+	}
+	if evaluation.EnforcementMode == RuleEnforcementModeDisabledConst {
+		return
+	}
+
+	for i, ruleContext := range rule.Contexts {
+		var reason string
+		reason, err = evaluator.evaluateContext(ctx, &ruleContext)
+		if err != nil {
+			err = fmt.Errorf("error evaluating rule '%s': %w", evaluation.RuleID, err)
+			return
+		}
+		if reason == "" {
+			evaluation.Allowed = true
+			evaluation.MatchedContext = i
+			evaluation.Reasons = nil
+			return
+		}
+		evaluation.Reasons = append(evaluation.Reasons, fmt.Sprintf("context %d: %s", i, reason))
+	}
+	if len(rule.Contexts) == 0 {
+		evaluation.Reasons = []string{"the rule has no contexts"}
+	}
+	return
+}
+
+// evaluateContext returns why the request does not match a rule context, or an empty string if it matches.
+func (evaluator *accessEvaluator) evaluateContext(ctx context.Context, ruleContext *RuleContext) (reason string, err error) {
+	for _, attribute := range ruleContext.Attributes {
+		name := core.StringNilMapper(attribute.Name)
+		values := strings.Split(core.StringNilMapper(attribute.Value), ",")
+		switch name {
+		case RuleContextAttributeEndpointTypeConst:
+			if !containsValue(values, evaluator.access.EndpointType) {
+				return fmt.Sprintf("endpoint type '%s' is not one of '%s'", evaluator.access.EndpointType, strings.Join(values, ",")), nil
+			}
+		case RuleContextAttributeNetworkZoneIDConst:
+			matched := false
+			for _, zoneID := range values {
+				matched, err = evaluator.inZone(ctx, strings.TrimSpace(zoneID))
+				if err != nil || matched {
+					break
+				}
+			}
+			if err != nil {
+				return
+			}
+			if !matched {
+				return fmt.Sprintf("the request does not come from network zone '%s'", strings.Join(values, ",")), nil
+			}
+		default:
+			return fmt.Sprintf("attribute '%s' cannot be evaluated", name), nil
+		}
+	}
+	return
+}
+
+// inZone returns true if the request comes from one of the addresses of a zone, and not from one of its excluded
+// addresses.
+func (evaluator *accessEvaluator) inZone(ctx context.Context, zoneID string) (matched bool, err error) {
+	zone, ok := evaluator.zones[zoneID]
+	if !ok {
+		zone, _, err = evaluator.client.GetZoneWithContext(ctx, evaluator.client.NewGetZoneOptions(zoneID))
+		if err != nil {
+			err = fmt.Errorf("error retrieving zone '%s': %w", zoneID, err)
+			return
+		}
+		evaluator.zones[zoneID] = zone
+	}
+
+	for _, address := range zone.Addresses {
+		matched, err = evaluator.matchesAddress(address)
+		if err != nil || matched {
+			break
+		}
+	}
+	if err != nil || !matched {
+		return
+	}
+	for _, address := range zone.Excluded {
+		var excluded bool
+		excluded, err = evaluator.matchesAddress(address)
+		if err != nil || excluded {
+			matched = false
+			return
+		}
+	}
+	return
+}
+
+// matchesAddress returns true if the request comes from a zone address.
+func (evaluator *accessEvaluator) matchesAddress(address AddressIntf) (matched bool, err error) {
+	addressType, value, ref := addressFields(address)
+	switch addressType {
+	case AddressTypeIpaddressConst:
+		ip := net.ParseIP(value)
+		if ip == nil {
+			err = fmt.Errorf("invalid IP address '%s'", value)
+			return
+		}
+		matched = evaluator.ip != nil && ip.Equal(evaluator.ip)
+	case AddressTypeIprangeConst:
+		bounds := strings.SplitN(value, "-", 2)
+		var first, last net.IP
+		if len(bounds) == 2 {
+			first, last = net.ParseIP(bounds[0]), net.ParseIP(bounds[1])
+		}
+		if first == nil || last == nil {
+			err = fmt.Errorf("invalid IP range '%s'", value)
+			return
+		}
+		matched = evaluator.ip != nil && sameIPFamily(first, evaluator.ip) &&
+			bytes.Compare(evaluator.ip.To16(), first.To16()) >= 0 && bytes.Compare(evaluator.ip.To16(), last.To16()) <= 0
+	case AddressTypeSubnetConst:
+		var subnet *net.IPNet
+		_, subnet, err = net.ParseCIDR(value)
+		if err != nil {
+			err = fmt.Errorf("invalid subnet '%s': %w", value, err)
+			return
+		}
+		matched = evaluator.ip != nil && subnet.Contains(evaluator.ip)
+	case AddressTypeVPCConst:
+		matched = evaluator.access.VPC != "" && evaluator.access.VPC == value
+	case AddressTypeServicerefConst:
+		matched = ref != nil && evaluator.access.ServiceRef != nil && serviceRefMatches(ref, evaluator.access.ServiceRef)
+	default:
+		err = fmt.Errorf("unsupported address type '%s'", addressType)
+	}
+	return
+}
+
+// addressFields returns the type, value and service reference of a zone address, whatever its concrete type.
+func addressFields(address AddressIntf) (addressType string, value string, ref *ServiceRefValue) {
+	switch address := address.(type) {
+	case *Address:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), address.Ref
+	case *AddressIPAddress:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressIPAddressRange:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressSubnet:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressVPC:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressServiceRef:
+		return core.StringNilMapper(address.Type), "", address.Ref
+	}
+	return
+}
+
+// serviceRefMatches returns true if every field set by the zone's service reference has the same value in the
+// request's.
+func serviceRefMatches(zoneRef *ServiceRefValue, requestRef *ServiceRefValue) bool {
+	fields := [][2]*string{
+		{zoneRef.AccountID, requestRef.AccountID},
+		{zoneRef.ServiceType, requestRef.ServiceType},
+		{zoneRef.ServiceName, requestRef.ServiceName},
+		{zoneRef.ServiceInstance, requestRef.ServiceInstance},
+		{zoneRef.Location, requestRef.Location},
+	}
+	for _, field := range fields {
+		if field[0] != nil && *field[0] != core.StringNilMapper(field[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameIPFamily returns true if both addresses are IPv4 addresses or both are IPv6 addresses.
+func sameIPFamily(a net.IP, b net.IP) bool {
+	return (a.To4() == nil) == (b.To4() == nil)
+}
+
+// containsValue returns true if "values" contains "value", ignoring surrounding spaces.
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if strings.TrimSpace(v) == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ContextBasedRestrictionsV1 EvaluateAccess`, func() {
+	zones := map[string]string{
+		"office": `{"id": "office", "addresses": [
+			{"type": "subnet", "value": "10.0.0.0/16"},
+			{"type": "ipRange", "value": "169.23.22.0-169.23.22.255"},
+			{"type": "ipAddress", "value": "2001:db8::1"}],
+			"excluded": [{"type": "ipAddress", "value": "10.0.0.13"}]}`,
+		"services": `{"id": "services", "addresses": [
+			{"type": "vpc", "value": "crn:v1:bluemix:public:is:us-south:a/acct::vpc:vpc-1"},
+			{"type": "serviceRef", "ref": {"account_id": "acct", "service_name": "cloud-object-storage"}}],
+			"excluded": []}`,
+		"broken": `{"id": "broken", "addresses": [{"type": "ipRange", "value": "10.0.0.1"}], "excluded": []}`,
+	}
+	var testServer *httptest.Server
+	var cbrService *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
+	var zoneRequests []string
+	BeforeEach(func() {
+		zoneRequests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			zoneID := strings.TrimPrefix(req.URL.Path, "/v1/zones/")
+			zoneRequests = append(zoneRequests, zoneID)
+			res.Header().Set("Content-type", "application/json")
+			if zones[zoneID] == "" {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "zone not found"}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, zones[zoneID])
+		}))
+		var serviceErr error
+		cbrService, serviceErr = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(cbrService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newRule := func(id string, enforcementMode string, contexts ...map[string]string) contextbasedrestrictionsv1.Rule {
+		rule := contextbasedrestrictionsv1.Rule{ID: core.StringPtr(id)}
+		if enforcementMode != "" {
+			rule.EnforcementMode = core.StringPtr(enforcementMode)
+		}
+		for _, attributes := range contexts {
+			ruleContext := contextbasedrestrictionsv1.RuleContext{}
+			for name, value := range attributes {
+				ruleContext.Attributes = append(ruleContext.Attributes, contextbasedrestrictionsv1.RuleContextAttribute{
+					Name:  core.StringPtr(name),
+					Value: core.StringPtr(value),
+				})
+			}
+			rule.Contexts = append(rule.Contexts, ruleContext)
+		}
+		return rule
+	}
+	evaluate := func(rules []contextbasedrestrictionsv1.Rule, access contextbasedrestrictionsv1.AccessContext) *contextbasedrestrictionsv1.AccessEvaluation {
+		evaluation, err := cbrService.EvaluateAccess(context.Background(), rules, access)
+		Expect(err).To(BeNil())
+		return evaluation
+	}
+
+	officeRule := newRule("office-private", "",
+		map[string]string{"networkZoneId": "office", "endpointType": "private,direct"})
+
+	It(`Matches IP addresses against the addresses of the zones`, func() {
+		rules := []contextbasedrestrictionsv1.Rule{officeRule}
+		private := func(ip string) contextbasedrestrictionsv1.AccessContext {
+			return contextbasedrestrictionsv1.AccessContext{IP: ip, EndpointType: "private"}
+		}
+		Expect(evaluate(rules, private("10.0.3.4")).Allowed).To(BeTrue())
+		Expect(evaluate(rules, private("169.23.22.200")).Allowed).To(BeTrue())
+		Expect(evaluate(rules, private("2001:db8::1")).Allowed).To(BeTrue())
+		Expect(evaluate(rules, private("169.23.23.1")).Allowed).To(BeFalse())
+
+		evaluation := evaluate(rules, private("10.0.0.13"))
+		Expect(evaluation.Allowed).To(BeFalse())
+		Expect(evaluation.Rules[0].MatchedContext).To(Equal(-1))
+		Expect(evaluation.Rules[0].Reasons).To(Equal([]string{"context 0: the request does not come from network zone 'office'"}))
+
+		evaluation = evaluate(rules, contextbasedrestrictionsv1.AccessContext{IP: "10.0.3.4", EndpointType: "public"})
+		Expect(evaluation.Allowed).To(BeFalse())
+		Expect(evaluation.Rules[0].Reasons[0]).To(ContainSubstring("endpoint type 'public' is not one of 'private,direct'"))
+		Expect(zoneRequests).To(Equal([]string{"office", "office", "office", "office", "office", "office"}))
+	})
+	It(`Matches VPCs and services`, func() {
+		rules := []contextbasedrestrictionsv1.Rule{newRule("services", "", map[string]string{"networkZoneId": "office,services"})}
+		Expect(evaluate(rules, contextbasedrestrictionsv1.AccessContext{
+			IP:  "192.168.0.1",
+			VPC: "crn:v1:bluemix:public:is:us-south:a/acct::vpc:vpc-1",
+		}).Allowed).To(BeTrue())
+		Expect(evaluate(rules, contextbasedrestrictionsv1.AccessContext{ServiceRef: &contextbasedrestrictionsv1.ServiceRefValue{
+			AccountID:   core.StringPtr("acct"),
+			ServiceName: core.StringPtr("cloud-object-storage"),
+			Location:    core.StringPtr("us-south"),
+		}}).Allowed).To(BeTrue())
+		Expect(evaluate(rules, contextbasedrestrictionsv1.AccessContext{ServiceRef: &contextbasedrestrictionsv1.ServiceRefValue{
+			AccountID:   core.StringPtr("acct"),
+			ServiceName: core.StringPtr("kms"),
+		}}).Allowed).To(BeFalse())
+	})
+	It(`Combines the rules according to their enforcement mode`, func() {
+		access := contextbasedrestrictionsv1.AccessContext{IP: "192.168.0.1", EndpointType: "public"}
+		publicRule := newRule("public", "", map[string]string{"endpointType": "public"})
+
+		evaluation := evaluate([]contextbasedrestrictionsv1.Rule{officeRule, publicRule}, access)
+		Expect(evaluation.Allowed).To(BeTrue())
+		Expect(evaluation.Rules[1].MatchedContext).To(Equal(0))
+
+		evaluation = evaluate([]contextbasedrestrictionsv1.Rule{newRule("report", "report", map[string]string{"endpointType": "private"})}, access)
+		Expect(evaluation.Allowed).To(BeTrue())
+		Expect(evaluation.AllowedIfEnforced).To(BeFalse())
+
+		evaluation = evaluate([]contextbasedrestrictionsv1.Rule{newRule("disabled", "disabled"), newRule("empty", "")}, access)
+		Expect(evaluation.Allowed).To(BeFalse())
+		Expect(evaluation.Rules[0].Allowed).To(BeFalse())
+		Expect(evaluation.Rules[1].Reasons).To(Equal([]string{"the rule has no contexts"}))
+
+		Expect(evaluate(nil, access).Allowed).To(BeTrue())
+	})
+	It(`Returns an error for invalid input`, func() {
+		_, err := cbrService.EvaluateAccess(context.Background(), []contextbasedrestrictionsv1.Rule{officeRule},
+			contextbasedrestrictionsv1.AccessContext{IP: "not an ip"})
+		Expect(err).ToNot(BeNil())
+		_, err = cbrService.EvaluateAccess(context.Background(), []contextbasedrestrictionsv1.Rule{newRule("missing", "", map[string]string{"networkZoneId": "missing"})},
+			contextbasedrestrictionsv1.AccessContext{IP: "10.0.0.1"})
+		Expect(err.Error()).To(ContainSubstring("error retrieving zone 'missing'"))
+		_, err = cbrService.EvaluateAccess(context.Background(), []contextbasedrestrictionsv1.Rule{newRule("broken", "", map[string]string{"networkZoneId": "broken"})},
+			contextbasedrestrictionsv1.AccessContext{IP: "10.0.0.1"})
+		Expect(err.Error()).To(ContainSubstring("invalid IP range '10.0.0.1'"))
+	})
+})
@@ -0,0 +1,923 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CreateZoneOption : A functional option that sets a property of CreateZoneOptions (see CreateZoneWith).
+type CreateZoneOption func(options *CreateZoneOptions)
+
+// CreateZoneName sets the Name property of CreateZoneOptions. The name of the zone.
+func CreateZoneName(nameVar string) CreateZoneOption {
+	return func(options *CreateZoneOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateZoneAccountID sets the AccountID property of CreateZoneOptions. The id of the account owning this zone.
+func CreateZoneAccountID(accountID string) CreateZoneOption {
+	return func(options *CreateZoneOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// CreateZoneDescription sets the Description property of CreateZoneOptions. The description of the zone.
+func CreateZoneDescription(description string) CreateZoneOption {
+	return func(options *CreateZoneOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// CreateZoneAddresses sets the Addresses property of CreateZoneOptions. The list of addresses in the zone.
+func CreateZoneAddresses(addresses ...AddressIntf) CreateZoneOption {
+	return func(options *CreateZoneOptions) {
+		options.Addresses = append(options.Addresses, addresses...)
+	}
+}
+
+// CreateZoneExcluded sets the Excluded property of CreateZoneOptions. The list of excluded addresses in the zone.
+func CreateZoneExcluded(excluded ...AddressIntf) CreateZoneOption {
+	return func(options *CreateZoneOptions) {
+		options.Excluded = append(options.Excluded, excluded...)
+	}
+}
+
+// CreateZoneXCorrelationID sets the XCorrelationID property of CreateZoneOptions. The supplied or generated value
+// of this header is logged for a request and repeated in a response header for the corresponding response.
+func CreateZoneXCorrelationID(xCorrelationID string) CreateZoneOption {
+	return func(options *CreateZoneOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// CreateZoneTransactionID sets the TransactionID property of CreateZoneOptions. The `Transaction-Id` header
+// behaves as the `X-Correlation-Id` header.
+func CreateZoneTransactionID(transactionID string) CreateZoneOption {
+	return func(options *CreateZoneOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// CreateZoneHeader sets the Headers property of CreateZoneOptions. Allows users to set headers on API requests.
+func CreateZoneHeader(name string, value string) CreateZoneOption {
+	return func(options *CreateZoneOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateZoneOptionsWith returns CreateZoneOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewCreateZoneOptionsWith(options ...CreateZoneOption) *CreateZoneOptions {
+	createZoneOptions := &CreateZoneOptions{}
+	for _, option := range options {
+		option(createZoneOptions)
+	}
+	return createZoneOptions
+}
+
+// CreateZoneWith is an alternate form of the CreateZoneWithContext method which takes functional options instead
+// of a CreateZoneOptions struct.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) CreateZoneWith(ctx context.Context, options ...CreateZoneOption) (result *Zone, response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.CreateZoneWithContext(ctx, contextBasedRestrictions.NewCreateZoneOptionsWith(options...))
+}
+
+// ListZonesOption : A functional option that sets a property of ListZonesOptions (see ListZonesWith).
+type ListZonesOption func(options *ListZonesOptions)
+
+// ListZonesAccountID sets the AccountID property of ListZonesOptions. The ID of the managing account.
+func ListZonesAccountID(accountID string) ListZonesOption {
+	return func(options *ListZonesOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListZonesXCorrelationID sets the XCorrelationID property of ListZonesOptions. The supplied or generated value of
+// this header is logged for a request and repeated in a response header for the corresponding response.
+func ListZonesXCorrelationID(xCorrelationID string) ListZonesOption {
+	return func(options *ListZonesOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// ListZonesTransactionID sets the TransactionID property of ListZonesOptions. The `Transaction-Id` header behaves
+// as the `X-Correlation-Id` header.
+func ListZonesTransactionID(transactionID string) ListZonesOption {
+	return func(options *ListZonesOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ListZonesName sets the Name property of ListZonesOptions. The name of the zone.
+func ListZonesName(nameVar string) ListZonesOption {
+	return func(options *ListZonesOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListZonesSort sets the Sort property of ListZonesOptions. Sorts results by using a valid sort field.
+func ListZonesSort(sort string) ListZonesOption {
+	return func(options *ListZonesOptions) {
+		options.Sort = core.StringPtr(sort)
+	}
+}
+
+// ListZonesHeader sets the Headers property of ListZonesOptions. Allows users to set headers on API requests.
+func ListZonesHeader(name string, value string) ListZonesOption {
+	return func(options *ListZonesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListZonesOptionsWith returns ListZonesOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewListZonesOptionsWith(options ...ListZonesOption) *ListZonesOptions {
+	listZonesOptions := &ListZonesOptions{}
+	for _, option := range options {
+		option(listZonesOptions)
+	}
+	return listZonesOptions
+}
+
+// ListZonesWith is an alternate form of the ListZonesWithContext method which takes functional options instead of
+// a ListZonesOptions struct. ListZonesAccountID is required.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListZonesWith(ctx context.Context, options ...ListZonesOption) (result *ZoneList, response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.ListZonesWithContext(ctx, contextBasedRestrictions.NewListZonesOptionsWith(options...))
+}
+
+// GetZoneOption : A functional option that sets a property of GetZoneOptions (see GetZoneWith).
+type GetZoneOption func(options *GetZoneOptions)
+
+// GetZoneZoneID sets the ZoneID property of GetZoneOptions. The ID of a zone.
+func GetZoneZoneID(zoneID string) GetZoneOption {
+	return func(options *GetZoneOptions) {
+		options.ZoneID = core.StringPtr(zoneID)
+	}
+}
+
+// GetZoneXCorrelationID sets the XCorrelationID property of GetZoneOptions. The supplied or generated value of
+// this header is logged for a request and repeated in a response header for the corresponding response.
+func GetZoneXCorrelationID(xCorrelationID string) GetZoneOption {
+	return func(options *GetZoneOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// GetZoneTransactionID sets the TransactionID property of GetZoneOptions. The `Transaction-Id` header behaves as
+// the `X-Correlation-Id` header.
+func GetZoneTransactionID(transactionID string) GetZoneOption {
+	return func(options *GetZoneOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// GetZoneHeader sets the Headers property of GetZoneOptions. Allows users to set headers on API requests.
+func GetZoneHeader(name string, value string) GetZoneOption {
+	return func(options *GetZoneOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetZoneOptionsWith returns GetZoneOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewGetZoneOptionsWith(options ...GetZoneOption) *GetZoneOptions {
+	getZoneOptions := &GetZoneOptions{}
+	for _, option := range options {
+		option(getZoneOptions)
+	}
+	return getZoneOptions
+}
+
+// GetZoneWith is an alternate form of the GetZoneWithContext method which takes functional options instead of a
+// GetZoneOptions struct. GetZoneZoneID is required.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetZoneWith(ctx context.Context, options ...GetZoneOption) (result *Zone, response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.GetZoneWithContext(ctx, contextBasedRestrictions.NewGetZoneOptionsWith(options...))
+}
+
+// ReplaceZoneOption : A functional option that sets a property of ReplaceZoneOptions (see ReplaceZoneWith).
+type ReplaceZoneOption func(options *ReplaceZoneOptions)
+
+// ReplaceZoneZoneID sets the ZoneID property of ReplaceZoneOptions. The ID of a zone.
+func ReplaceZoneZoneID(zoneID string) ReplaceZoneOption {
+	return func(options *ReplaceZoneOptions) {
+		options.ZoneID = core.StringPtr(zoneID)
+	}
+}
+
+// ReplaceZoneIfMatch sets the IfMatch property of ReplaceZoneOptions. The current revision of the resource being
+// updated.
+func ReplaceZoneIfMatch(ifMatch string) ReplaceZoneOption {
+	return func(options *ReplaceZoneOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// ReplaceZoneName sets the Name property of ReplaceZoneOptions. The name of the zone.
+func ReplaceZoneName(nameVar string) ReplaceZoneOption {
+	return func(options *ReplaceZoneOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ReplaceZoneAccountID sets the AccountID property of ReplaceZoneOptions. The id of the account owning this zone.
+func ReplaceZoneAccountID(accountID string) ReplaceZoneOption {
+	return func(options *ReplaceZoneOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ReplaceZoneDescription sets the Description property of ReplaceZoneOptions. The description of the zone.
+func ReplaceZoneDescription(description string) ReplaceZoneOption {
+	return func(options *ReplaceZoneOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// ReplaceZoneAddresses sets the Addresses property of ReplaceZoneOptions. The list of addresses in the zone.
+func ReplaceZoneAddresses(addresses ...AddressIntf) ReplaceZoneOption {
+	return func(options *ReplaceZoneOptions) {
+		options.Addresses = append(options.Addresses, addresses...)
+	}
+}
+
+// ReplaceZoneExcluded sets the Excluded property of ReplaceZoneOptions. The list of excluded addresses in the
+// zone.
+func ReplaceZoneExcluded(excluded ...AddressIntf) ReplaceZoneOption {
+	return func(options *ReplaceZoneOptions) {
+		options.Excluded = append(options.Excluded, excluded...)
+	}
+}
+
+// ReplaceZoneXCorrelationID sets the XCorrelationID property of ReplaceZoneOptions. The supplied or generated
+// value of this header is logged for a request and repeated in a response header for the corresponding response.
+func ReplaceZoneXCorrelationID(xCorrelationID string) ReplaceZoneOption {
+	return func(options *ReplaceZoneOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// ReplaceZoneTransactionID sets the TransactionID property of ReplaceZoneOptions. The `Transaction-Id` header
+// behaves as the `X-Correlation-Id` header.
+func ReplaceZoneTransactionID(transactionID string) ReplaceZoneOption {
+	return func(options *ReplaceZoneOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ReplaceZoneHeader sets the Headers property of ReplaceZoneOptions. Allows users to set headers on API requests.
+func ReplaceZoneHeader(name string, value string) ReplaceZoneOption {
+	return func(options *ReplaceZoneOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceZoneOptionsWith returns ReplaceZoneOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewReplaceZoneOptionsWith(options ...ReplaceZoneOption) *ReplaceZoneOptions {
+	replaceZoneOptions := &ReplaceZoneOptions{}
+	for _, option := range options {
+		option(replaceZoneOptions)
+	}
+	return replaceZoneOptions
+}
+
+// ReplaceZoneWith is an alternate form of the ReplaceZoneWithContext method which takes functional options instead
+// of a ReplaceZoneOptions struct. ReplaceZoneZoneID and ReplaceZoneIfMatch are required.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ReplaceZoneWith(ctx context.Context, options ...ReplaceZoneOption) (result *Zone, response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.ReplaceZoneWithContext(ctx, contextBasedRestrictions.NewReplaceZoneOptionsWith(options...))
+}
+
+// DeleteZoneOption : A functional option that sets a property of DeleteZoneOptions (see DeleteZoneWith).
+type DeleteZoneOption func(options *DeleteZoneOptions)
+
+// DeleteZoneZoneID sets the ZoneID property of DeleteZoneOptions. The ID of a zone.
+func DeleteZoneZoneID(zoneID string) DeleteZoneOption {
+	return func(options *DeleteZoneOptions) {
+		options.ZoneID = core.StringPtr(zoneID)
+	}
+}
+
+// DeleteZoneXCorrelationID sets the XCorrelationID property of DeleteZoneOptions. The supplied or generated value
+// of this header is logged for a request and repeated in a response header for the corresponding response.
+func DeleteZoneXCorrelationID(xCorrelationID string) DeleteZoneOption {
+	return func(options *DeleteZoneOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// DeleteZoneTransactionID sets the TransactionID property of DeleteZoneOptions. The `Transaction-Id` header
+// behaves as the `X-Correlation-Id` header.
+func DeleteZoneTransactionID(transactionID string) DeleteZoneOption {
+	return func(options *DeleteZoneOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// DeleteZoneHeader sets the Headers property of DeleteZoneOptions. Allows users to set headers on API requests.
+func DeleteZoneHeader(name string, value string) DeleteZoneOption {
+	return func(options *DeleteZoneOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteZoneOptionsWith returns DeleteZoneOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewDeleteZoneOptionsWith(options ...DeleteZoneOption) *DeleteZoneOptions {
+	deleteZoneOptions := &DeleteZoneOptions{}
+	for _, option := range options {
+		option(deleteZoneOptions)
+	}
+	return deleteZoneOptions
+}
+
+// DeleteZoneWith is an alternate form of the DeleteZoneWithContext method which takes functional options instead
+// of a DeleteZoneOptions struct. DeleteZoneZoneID is required.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) DeleteZoneWith(ctx context.Context, options ...DeleteZoneOption) (response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.DeleteZoneWithContext(ctx, contextBasedRestrictions.NewDeleteZoneOptionsWith(options...))
+}
+
+// ListAvailableServicerefTargetsOption : A functional option that sets a property of ListAvailableServicerefTargetsOptions (see ListAvailableServicerefTargetsWith).
+type ListAvailableServicerefTargetsOption func(options *ListAvailableServicerefTargetsOptions)
+
+// ListAvailableServicerefTargetsXCorrelationID sets the XCorrelationID property of
+// ListAvailableServicerefTargetsOptions. The supplied or generated value of this header is logged for a request
+// and repeated in a response header for the corresponding response.
+func ListAvailableServicerefTargetsXCorrelationID(xCorrelationID string) ListAvailableServicerefTargetsOption {
+	return func(options *ListAvailableServicerefTargetsOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// ListAvailableServicerefTargetsTransactionID sets the TransactionID property of
+// ListAvailableServicerefTargetsOptions. The `Transaction-Id` header behaves as the `X-Correlation-Id` header.
+func ListAvailableServicerefTargetsTransactionID(transactionID string) ListAvailableServicerefTargetsOption {
+	return func(options *ListAvailableServicerefTargetsOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ListAvailableServicerefTargetsType sets the Type property of ListAvailableServicerefTargetsOptions. Specifies
+// the types of services to retrieve.
+func ListAvailableServicerefTargetsType(typeVar string) ListAvailableServicerefTargetsOption {
+	return func(options *ListAvailableServicerefTargetsOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// ListAvailableServicerefTargetsHeader sets the Headers property of ListAvailableServicerefTargetsOptions. Allows
+// users to set headers on API requests.
+func ListAvailableServicerefTargetsHeader(name string, value string) ListAvailableServicerefTargetsOption {
+	return func(options *ListAvailableServicerefTargetsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListAvailableServicerefTargetsOptionsWith returns ListAvailableServicerefTargetsOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewListAvailableServicerefTargetsOptionsWith(options ...ListAvailableServicerefTargetsOption) *ListAvailableServicerefTargetsOptions {
+	listAvailableServicerefTargetsOptions := &ListAvailableServicerefTargetsOptions{}
+	for _, option := range options {
+		option(listAvailableServicerefTargetsOptions)
+	}
+	return listAvailableServicerefTargetsOptions
+}
+
+// ListAvailableServicerefTargetsWith is an alternate form of the ListAvailableServicerefTargetsWithContext method
+// which takes functional options instead of a ListAvailableServicerefTargetsOptions struct.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListAvailableServicerefTargetsWith(ctx context.Context, options ...ListAvailableServicerefTargetsOption) (result *ServiceRefTargetList, response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.ListAvailableServicerefTargetsWithContext(ctx, contextBasedRestrictions.NewListAvailableServicerefTargetsOptionsWith(options...))
+}
+
+// CreateRuleOption : A functional option that sets a property of CreateRuleOptions (see CreateRuleWith).
+type CreateRuleOption func(options *CreateRuleOptions)
+
+// CreateRuleDescription sets the Description property of CreateRuleOptions. The description of the rule.
+func CreateRuleDescription(description string) CreateRuleOption {
+	return func(options *CreateRuleOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// CreateRuleContexts sets the Contexts property of CreateRuleOptions. The contexts this rule applies to.
+func CreateRuleContexts(contexts ...RuleContext) CreateRuleOption {
+	return func(options *CreateRuleOptions) {
+		options.Contexts = append(options.Contexts, contexts...)
+	}
+}
+
+// CreateRuleResources sets the Resources property of CreateRuleOptions. The resources this rule apply to.
+func CreateRuleResources(resources ...Resource) CreateRuleOption {
+	return func(options *CreateRuleOptions) {
+		options.Resources = append(options.Resources, resources...)
+	}
+}
+
+// CreateRuleOperations sets the Operations property of CreateRuleOptions. The operations this rule applies to.
+func CreateRuleOperations(operations *NewRuleOperations) CreateRuleOption {
+	return func(options *CreateRuleOptions) {
+		options.Operations = operations
+	}
+}
+
+// CreateRuleEnforcementMode sets the EnforcementMode property of CreateRuleOptions. The rule enforcement mode: *
+// `enabled` - The restrictions are enforced and reported.
+func CreateRuleEnforcementMode(enforcementMode string) CreateRuleOption {
+	return func(options *CreateRuleOptions) {
+		options.EnforcementMode = core.StringPtr(enforcementMode)
+	}
+}
+
+// CreateRuleXCorrelationID sets the XCorrelationID property of CreateRuleOptions. The supplied or generated value
+// of this header is logged for a request and repeated in a response header for the corresponding response.
+func CreateRuleXCorrelationID(xCorrelationID string) CreateRuleOption {
+	return func(options *CreateRuleOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// CreateRuleTransactionID sets the TransactionID property of CreateRuleOptions. The `Transaction-Id` header
+// behaves as the `X-Correlation-Id` header.
+func CreateRuleTransactionID(transactionID string) CreateRuleOption {
+	return func(options *CreateRuleOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// CreateRuleHeader sets the Headers property of CreateRuleOptions. Allows users to set headers on API requests.
+func CreateRuleHeader(name string, value string) CreateRuleOption {
+	return func(options *CreateRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateRuleOptionsWith returns CreateRuleOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewCreateRuleOptionsWith(options ...CreateRuleOption) *CreateRuleOptions {
+	createRuleOptions := &CreateRuleOptions{}
+	for _, option := range options {
+		option(createRuleOptions)
+	}
+	return createRuleOptions
+}
+
+// CreateRuleWith is an alternate form of the CreateRuleWithContext method which takes functional options instead
+// of a CreateRuleOptions struct.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) CreateRuleWith(ctx context.Context, options ...CreateRuleOption) (result *Rule, response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.CreateRuleWithContext(ctx, contextBasedRestrictions.NewCreateRuleOptionsWith(options...))
+}
+
+// ListRulesOption : A functional option that sets a property of ListRulesOptions (see ListRulesWith).
+type ListRulesOption func(options *ListRulesOptions)
+
+// ListRulesAccountID sets the AccountID property of ListRulesOptions. The ID of the managing account.
+func ListRulesAccountID(accountID string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListRulesXCorrelationID sets the XCorrelationID property of ListRulesOptions. The supplied or generated value of
+// this header is logged for a request and repeated in a response header for the corresponding response.
+func ListRulesXCorrelationID(xCorrelationID string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// ListRulesTransactionID sets the TransactionID property of ListRulesOptions. The `Transaction-Id` header behaves
+// as the `X-Correlation-Id` header.
+func ListRulesTransactionID(transactionID string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ListRulesRegion sets the Region property of ListRulesOptions. The `region` resource attribute.
+func ListRulesRegion(region string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// ListRulesResource sets the Resource property of ListRulesOptions. The `resource` resource attribute.
+func ListRulesResource(resource string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.Resource = core.StringPtr(resource)
+	}
+}
+
+// ListRulesResourceType sets the ResourceType property of ListRulesOptions. The `resourceType` resource attribute.
+func ListRulesResourceType(resourceType string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.ResourceType = core.StringPtr(resourceType)
+	}
+}
+
+// ListRulesServiceInstance sets the ServiceInstance property of ListRulesOptions. The `serviceInstance` resource
+// attribute.
+func ListRulesServiceInstance(serviceInstance string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.ServiceInstance = core.StringPtr(serviceInstance)
+	}
+}
+
+// ListRulesServiceName sets the ServiceName property of ListRulesOptions. The `serviceName` resource attribute.
+func ListRulesServiceName(serviceName string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.ServiceName = core.StringPtr(serviceName)
+	}
+}
+
+// ListRulesServiceType sets the ServiceType property of ListRulesOptions. The rule's `serviceType` resource
+// attribute.
+func ListRulesServiceType(serviceType string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.ServiceType = core.StringPtr(serviceType)
+	}
+}
+
+// ListRulesZoneID sets the ZoneID property of ListRulesOptions. The globally unique ID of the zone.
+func ListRulesZoneID(zoneID string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.ZoneID = core.StringPtr(zoneID)
+	}
+}
+
+// ListRulesSort sets the Sort property of ListRulesOptions. Sorts results by using a valid sort field.
+func ListRulesSort(sort string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.Sort = core.StringPtr(sort)
+	}
+}
+
+// ListRulesEnforcementMode sets the EnforcementMode property of ListRulesOptions. The rule's `enforcement_mode`
+// attribute.
+func ListRulesEnforcementMode(enforcementMode string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		options.EnforcementMode = core.StringPtr(enforcementMode)
+	}
+}
+
+// ListRulesHeader sets the Headers property of ListRulesOptions. Allows users to set headers on API requests.
+func ListRulesHeader(name string, value string) ListRulesOption {
+	return func(options *ListRulesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListRulesOptionsWith returns ListRulesOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewListRulesOptionsWith(options ...ListRulesOption) *ListRulesOptions {
+	listRulesOptions := &ListRulesOptions{}
+	for _, option := range options {
+		option(listRulesOptions)
+	}
+	return listRulesOptions
+}
+
+// ListRulesWith is an alternate form of the ListRulesWithContext method which takes functional options instead of
+// a ListRulesOptions struct. ListRulesAccountID is required.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListRulesWith(ctx context.Context, options ...ListRulesOption) (result *RuleList, response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.ListRulesWithContext(ctx, contextBasedRestrictions.NewListRulesOptionsWith(options...))
+}
+
+// GetRuleOption : A functional option that sets a property of GetRuleOptions (see GetRuleWith).
+type GetRuleOption func(options *GetRuleOptions)
+
+// GetRuleRuleID sets the RuleID property of GetRuleOptions. The ID of a rule.
+func GetRuleRuleID(ruleID string) GetRuleOption {
+	return func(options *GetRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// GetRuleXCorrelationID sets the XCorrelationID property of GetRuleOptions. The supplied or generated value of
+// this header is logged for a request and repeated in a response header for the corresponding response.
+func GetRuleXCorrelationID(xCorrelationID string) GetRuleOption {
+	return func(options *GetRuleOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// GetRuleTransactionID sets the TransactionID property of GetRuleOptions. The `Transaction-Id` header behaves as
+// the `X-Correlation-Id` header.
+func GetRuleTransactionID(transactionID string) GetRuleOption {
+	return func(options *GetRuleOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// GetRuleHeader sets the Headers property of GetRuleOptions. Allows users to set headers on API requests.
+func GetRuleHeader(name string, value string) GetRuleOption {
+	return func(options *GetRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetRuleOptionsWith returns GetRuleOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewGetRuleOptionsWith(options ...GetRuleOption) *GetRuleOptions {
+	getRuleOptions := &GetRuleOptions{}
+	for _, option := range options {
+		option(getRuleOptions)
+	}
+	return getRuleOptions
+}
+
+// GetRuleWith is an alternate form of the GetRuleWithContext method which takes functional options instead of a
+// GetRuleOptions struct. GetRuleRuleID is required.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetRuleWith(ctx context.Context, options ...GetRuleOption) (result *Rule, response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.GetRuleWithContext(ctx, contextBasedRestrictions.NewGetRuleOptionsWith(options...))
+}
+
+// ReplaceRuleOption : A functional option that sets a property of ReplaceRuleOptions (see ReplaceRuleWith).
+type ReplaceRuleOption func(options *ReplaceRuleOptions)
+
+// ReplaceRuleRuleID sets the RuleID property of ReplaceRuleOptions. The ID of a rule.
+func ReplaceRuleRuleID(ruleID string) ReplaceRuleOption {
+	return func(options *ReplaceRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// ReplaceRuleIfMatch sets the IfMatch property of ReplaceRuleOptions. The current revision of the resource being
+// updated.
+func ReplaceRuleIfMatch(ifMatch string) ReplaceRuleOption {
+	return func(options *ReplaceRuleOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// ReplaceRuleDescription sets the Description property of ReplaceRuleOptions. The description of the rule.
+func ReplaceRuleDescription(description string) ReplaceRuleOption {
+	return func(options *ReplaceRuleOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// ReplaceRuleContexts sets the Contexts property of ReplaceRuleOptions. The contexts this rule applies to.
+func ReplaceRuleContexts(contexts ...RuleContext) ReplaceRuleOption {
+	return func(options *ReplaceRuleOptions) {
+		options.Contexts = append(options.Contexts, contexts...)
+	}
+}
+
+// ReplaceRuleResources sets the Resources property of ReplaceRuleOptions. The resources this rule apply to.
+func ReplaceRuleResources(resources ...Resource) ReplaceRuleOption {
+	return func(options *ReplaceRuleOptions) {
+		options.Resources = append(options.Resources, resources...)
+	}
+}
+
+// ReplaceRuleOperations sets the Operations property of ReplaceRuleOptions. The operations this rule applies to.
+func ReplaceRuleOperations(operations *NewRuleOperations) ReplaceRuleOption {
+	return func(options *ReplaceRuleOptions) {
+		options.Operations = operations
+	}
+}
+
+// ReplaceRuleEnforcementMode sets the EnforcementMode property of ReplaceRuleOptions. The rule enforcement mode: *
+// `enabled` - The restrictions are enforced and reported.
+func ReplaceRuleEnforcementMode(enforcementMode string) ReplaceRuleOption {
+	return func(options *ReplaceRuleOptions) {
+		options.EnforcementMode = core.StringPtr(enforcementMode)
+	}
+}
+
+// ReplaceRuleXCorrelationID sets the XCorrelationID property of ReplaceRuleOptions. The supplied or generated
+// value of this header is logged for a request and repeated in a response header for the corresponding response.
+func ReplaceRuleXCorrelationID(xCorrelationID string) ReplaceRuleOption {
+	return func(options *ReplaceRuleOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// ReplaceRuleTransactionID sets the TransactionID property of ReplaceRuleOptions. The `Transaction-Id` header
+// behaves as the `X-Correlation-Id` header.
+func ReplaceRuleTransactionID(transactionID string) ReplaceRuleOption {
+	return func(options *ReplaceRuleOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ReplaceRuleHeader sets the Headers property of ReplaceRuleOptions. Allows users to set headers on API requests.
+func ReplaceRuleHeader(name string, value string) ReplaceRuleOption {
+	return func(options *ReplaceRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceRuleOptionsWith returns ReplaceRuleOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewReplaceRuleOptionsWith(options ...ReplaceRuleOption) *ReplaceRuleOptions {
+	replaceRuleOptions := &ReplaceRuleOptions{}
+	for _, option := range options {
+		option(replaceRuleOptions)
+	}
+	return replaceRuleOptions
+}
+
+// ReplaceRuleWith is an alternate form of the ReplaceRuleWithContext method which takes functional options instead
+// of a ReplaceRuleOptions struct. ReplaceRuleRuleID and ReplaceRuleIfMatch are required.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ReplaceRuleWith(ctx context.Context, options ...ReplaceRuleOption) (result *Rule, response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.ReplaceRuleWithContext(ctx, contextBasedRestrictions.NewReplaceRuleOptionsWith(options...))
+}
+
+// DeleteRuleOption : A functional option that sets a property of DeleteRuleOptions (see DeleteRuleWith).
+type DeleteRuleOption func(options *DeleteRuleOptions)
+
+// DeleteRuleRuleID sets the RuleID property of DeleteRuleOptions. The ID of a rule.
+func DeleteRuleRuleID(ruleID string) DeleteRuleOption {
+	return func(options *DeleteRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// DeleteRuleXCorrelationID sets the XCorrelationID property of DeleteRuleOptions. The supplied or generated value
+// of this header is logged for a request and repeated in a response header for the corresponding response.
+func DeleteRuleXCorrelationID(xCorrelationID string) DeleteRuleOption {
+	return func(options *DeleteRuleOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// DeleteRuleTransactionID sets the TransactionID property of DeleteRuleOptions. The `Transaction-Id` header
+// behaves as the `X-Correlation-Id` header.
+func DeleteRuleTransactionID(transactionID string) DeleteRuleOption {
+	return func(options *DeleteRuleOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// DeleteRuleHeader sets the Headers property of DeleteRuleOptions. Allows users to set headers on API requests.
+func DeleteRuleHeader(name string, value string) DeleteRuleOption {
+	return func(options *DeleteRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteRuleOptionsWith returns DeleteRuleOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewDeleteRuleOptionsWith(options ...DeleteRuleOption) *DeleteRuleOptions {
+	deleteRuleOptions := &DeleteRuleOptions{}
+	for _, option := range options {
+		option(deleteRuleOptions)
+	}
+	return deleteRuleOptions
+}
+
+// DeleteRuleWith is an alternate form of the DeleteRuleWithContext method which takes functional options instead
+// of a DeleteRuleOptions struct. DeleteRuleRuleID is required.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) DeleteRuleWith(ctx context.Context, options ...DeleteRuleOption) (response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.DeleteRuleWithContext(ctx, contextBasedRestrictions.NewDeleteRuleOptionsWith(options...))
+}
+
+// GetAccountSettingsOption : A functional option that sets a property of GetAccountSettingsOptions (see GetAccountSettingsWith).
+type GetAccountSettingsOption func(options *GetAccountSettingsOptions)
+
+// GetAccountSettingsAccountID sets the AccountID property of GetAccountSettingsOptions. The ID of the account the
+// settings are for.
+func GetAccountSettingsAccountID(accountID string) GetAccountSettingsOption {
+	return func(options *GetAccountSettingsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetAccountSettingsXCorrelationID sets the XCorrelationID property of GetAccountSettingsOptions. The supplied or
+// generated value of this header is logged for a request and repeated in a response header for the corresponding
+// response.
+func GetAccountSettingsXCorrelationID(xCorrelationID string) GetAccountSettingsOption {
+	return func(options *GetAccountSettingsOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// GetAccountSettingsTransactionID sets the TransactionID property of GetAccountSettingsOptions. The
+// `Transaction-Id` header behaves as the `X-Correlation-Id` header.
+func GetAccountSettingsTransactionID(transactionID string) GetAccountSettingsOption {
+	return func(options *GetAccountSettingsOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// GetAccountSettingsHeader sets the Headers property of GetAccountSettingsOptions. Allows users to set headers on
+// API requests.
+func GetAccountSettingsHeader(name string, value string) GetAccountSettingsOption {
+	return func(options *GetAccountSettingsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAccountSettingsOptionsWith returns GetAccountSettingsOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewGetAccountSettingsOptionsWith(options ...GetAccountSettingsOption) *GetAccountSettingsOptions {
+	getAccountSettingsOptions := &GetAccountSettingsOptions{}
+	for _, option := range options {
+		option(getAccountSettingsOptions)
+	}
+	return getAccountSettingsOptions
+}
+
+// GetAccountSettingsWith is an alternate form of the GetAccountSettingsWithContext method which takes functional
+// options instead of a GetAccountSettingsOptions struct. GetAccountSettingsAccountID is required.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetAccountSettingsWith(ctx context.Context, options ...GetAccountSettingsOption) (result *AccountSettings, response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.GetAccountSettingsWithContext(ctx, contextBasedRestrictions.NewGetAccountSettingsOptionsWith(options...))
+}
+
+// ListAvailableServiceOperationsOption : A functional option that sets a property of ListAvailableServiceOperationsOptions (see ListAvailableServiceOperationsWith).
+type ListAvailableServiceOperationsOption func(options *ListAvailableServiceOperationsOptions)
+
+// ListAvailableServiceOperationsServiceName sets the ServiceName property of
+// ListAvailableServiceOperationsOptions. The name of the service.
+func ListAvailableServiceOperationsServiceName(serviceName string) ListAvailableServiceOperationsOption {
+	return func(options *ListAvailableServiceOperationsOptions) {
+		options.ServiceName = core.StringPtr(serviceName)
+	}
+}
+
+// ListAvailableServiceOperationsXCorrelationID sets the XCorrelationID property of
+// ListAvailableServiceOperationsOptions. The supplied or generated value of this header is logged for a request
+// and repeated in a response header for the corresponding response.
+func ListAvailableServiceOperationsXCorrelationID(xCorrelationID string) ListAvailableServiceOperationsOption {
+	return func(options *ListAvailableServiceOperationsOptions) {
+		options.XCorrelationID = core.StringPtr(xCorrelationID)
+	}
+}
+
+// ListAvailableServiceOperationsTransactionID sets the TransactionID property of
+// ListAvailableServiceOperationsOptions. The `Transaction-Id` header behaves as the `X-Correlation-Id` header.
+func ListAvailableServiceOperationsTransactionID(transactionID string) ListAvailableServiceOperationsOption {
+	return func(options *ListAvailableServiceOperationsOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ListAvailableServiceOperationsHeader sets the Headers property of ListAvailableServiceOperationsOptions. Allows
+// users to set headers on API requests.
+func ListAvailableServiceOperationsHeader(name string, value string) ListAvailableServiceOperationsOption {
+	return func(options *ListAvailableServiceOperationsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListAvailableServiceOperationsOptionsWith returns ListAvailableServiceOperationsOptions populated by applying "options" in order.
+func (*ContextBasedRestrictionsV1) NewListAvailableServiceOperationsOptionsWith(options ...ListAvailableServiceOperationsOption) *ListAvailableServiceOperationsOptions {
+	listAvailableServiceOperationsOptions := &ListAvailableServiceOperationsOptions{}
+	for _, option := range options {
+		option(listAvailableServiceOperationsOptions)
+	}
+	return listAvailableServiceOperationsOptions
+}
+
+// ListAvailableServiceOperationsWith is an alternate form of the ListAvailableServiceOperationsWithContext method
+// which takes functional options instead of a ListAvailableServiceOperationsOptions struct.
+// ListAvailableServiceOperationsServiceName is required.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListAvailableServiceOperationsWith(ctx context.Context, options ...ListAvailableServiceOperationsOption) (result *OperationsList, response *core.DetailedResponse, err error) {
+	return contextBasedRestrictions.ListAvailableServiceOperationsWithContext(ctx, contextBasedRestrictions.NewListAvailableServiceOperationsOptionsWith(options...))
+}
@@ -0,0 +1,131 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package enterprisebillingunitsv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// EnterpriseBillingUnitsV1API : The operations of the EnterpriseBillingUnitsV1 service, implemented by *EnterpriseBillingUnitsV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type EnterpriseBillingUnitsV1API interface {
+	// Clone makes a copy of "enterpriseBillingUnits" suitable for processing requests.
+	Clone() *EnterpriseBillingUnitsV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// GetBillingUnit : Get billing unit by ID
+	GetBillingUnit(getBillingUnitOptions *GetBillingUnitOptions) (result *BillingUnit, response *core.DetailedResponse, err error)
+
+	// GetBillingUnitWithContext is an alternate form of the GetBillingUnit method which supports a Context parameter
+	GetBillingUnitWithContext(ctx context.Context, getBillingUnitOptions *GetBillingUnitOptions) (result *BillingUnit, response *core.DetailedResponse, err error)
+
+	// ListBillingUnits : List billing units
+	ListBillingUnits(listBillingUnitsOptions *ListBillingUnitsOptions) (result *BillingUnitsList, response *core.DetailedResponse, err error)
+
+	// ListBillingUnitsWithContext is an alternate form of the ListBillingUnits method which supports a Context
+	// parameter
+	ListBillingUnitsWithContext(ctx context.Context, listBillingUnitsOptions *ListBillingUnitsOptions) (result *BillingUnitsList, response *core.DetailedResponse, err error)
+
+	// ListBillingOptions : List billing options
+	ListBillingOptions(listBillingOptionsOptions *ListBillingOptionsOptions) (result *BillingOptionsList, response *core.DetailedResponse, err error)
+
+	// ListBillingOptionsWithContext is an alternate form of the ListBillingOptions method which supports a Context
+	// parameter
+	ListBillingOptionsWithContext(ctx context.Context, listBillingOptionsOptions *ListBillingOptionsOptions) (result *BillingOptionsList, response *core.DetailedResponse, err error)
+
+	// GetCreditPools : Get credit pools
+	GetCreditPools(getCreditPoolsOptions *GetCreditPoolsOptions) (result *CreditPoolsList, response *core.DetailedResponse, err error)
+
+	// GetCreditPoolsWithContext is an alternate form of the GetCreditPools method which supports a Context parameter
+	GetCreditPoolsWithContext(ctx context.Context, getCreditPoolsOptions *GetCreditPoolsOptions) (result *CreditPoolsList, response *core.DetailedResponse, err error)
+
+	// NewGetBillingUnitOptions : Instantiate GetBillingUnitOptions
+	NewGetBillingUnitOptions(billingUnitID string) *GetBillingUnitOptions
+
+	// NewGetCreditPoolsOptions : Instantiate GetCreditPoolsOptions
+	NewGetCreditPoolsOptions(billingUnitID string) *GetCreditPoolsOptions
+
+	// NewListBillingOptionsOptions : Instantiate ListBillingOptionsOptions
+	NewListBillingOptionsOptions(billingUnitID string) *ListBillingOptionsOptions
+
+	// NewListBillingUnitsOptions : Instantiate ListBillingUnitsOptions
+	NewListBillingUnitsOptions() *ListBillingUnitsOptions
+
+	// NewGetBillingUnitOptionsWith returns GetBillingUnitOptions populated by applying "options" in order.
+	NewGetBillingUnitOptionsWith(options ...GetBillingUnitOption) *GetBillingUnitOptions
+
+	// GetBillingUnitWith is an alternate form of the GetBillingUnitWithContext method which takes functional options
+	// instead of a GetBillingUnitOptions struct.
+	GetBillingUnitWith(ctx context.Context, options ...GetBillingUnitOption) (result *BillingUnit, response *core.DetailedResponse, err error)
+
+	// NewListBillingUnitsOptionsWith returns ListBillingUnitsOptions populated by applying "options" in order.
+	NewListBillingUnitsOptionsWith(options ...ListBillingUnitsOption) *ListBillingUnitsOptions
+
+	// ListBillingUnitsWith is an alternate form of the ListBillingUnitsWithContext method which takes functional
+	// options instead of a ListBillingUnitsOptions struct.
+	ListBillingUnitsWith(ctx context.Context, options ...ListBillingUnitsOption) (result *BillingUnitsList, response *core.DetailedResponse, err error)
+
+	// NewListBillingOptionsOptionsWith returns ListBillingOptionsOptions populated by applying "options" in order.
+	NewListBillingOptionsOptionsWith(options ...ListBillingOptionsOption) *ListBillingOptionsOptions
+
+	// ListBillingOptionsWith is an alternate form of the ListBillingOptionsWithContext method which takes functional
+	// options instead of a ListBillingOptionsOptions struct.
+	ListBillingOptionsWith(ctx context.Context, options ...ListBillingOptionsOption) (result *BillingOptionsList, response *core.DetailedResponse, err error)
+
+	// NewGetCreditPoolsOptionsWith returns GetCreditPoolsOptions populated by applying "options" in order.
+	NewGetCreditPoolsOptionsWith(options ...GetCreditPoolsOption) *GetCreditPoolsOptions
+
+	// GetCreditPoolsWith is an alternate form of the GetCreditPoolsWithContext method which takes functional options
+	// instead of a GetCreditPoolsOptions struct.
+	GetCreditPoolsWith(ctx context.Context, options ...GetCreditPoolsOption) (result *CreditPoolsList, response *core.DetailedResponse, err error)
+
+	// GetCreditPoolProjection retrieves the credit pools of a billing unit for the current month and for the previous
+	// "months" months (DefaultCreditPoolProjectionMonths if "months" is not positive), and projects when the balance
+	// of each pool is exhausted if credits keep being used at the average rate of those months.
+	GetCreditPoolProjection(ctx context.Context, billingUnitID string, months int) (result *CreditPoolProjection, err error)
+}
+
+var _ EnterpriseBillingUnitsV1API = (*EnterpriseBillingUnitsV1)(nil)
@@ -0,0 +1,219 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisebillingunitsv1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// DefaultCreditPoolProjectionMonths is the number of complete months before the current one whose credit usage
+// GetCreditPoolProjection averages to project the burn rate of each credit pool when no number of months is specified.
+const DefaultCreditPoolProjectionMonths = 3
+
+// The average number of days in a month, used to convert a monthly burn rate into a daily one.
+const daysPerMonth = 365.25 / 12
+
+// CreditPoolProjection : The projected burn-down of the credit pools of a billing unit, as returned by
+// GetCreditPoolProjection.
+type CreditPoolProjection struct {
+	// The ID of the billing unit.
+	BillingUnitID string
+
+	// The time of the projection.
+	ProjectedAt time.Time
+
+	// The projection of each credit pool of the billing unit, in the order returned by the service.
+	Pools []CreditPoolBurnDown
+
+	// The workflow ID sent with every request of the projection (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// CreditPoolBurnDown : The projected burn-down of one credit pool.
+type CreditPoolBurnDown struct {
+	// The type of the credit pool (CreditPoolTypePlatformConst or CreditPoolTypeSupportConst).
+	Type string
+
+	// The currency code of the credits.
+	CurrencyCode string
+
+	// The remaining balance of the terms of the pool that have not ended.
+	CurrentBalance float64
+
+	// The credits used so far in the current month.
+	CurrentMonthUsage float64
+
+	// The credits used in each of the complete months that the projection is based on, oldest first. Months for
+	// which the pool did not exist are omitted.
+	MonthlyUsage []CreditPoolMonthlyUsage
+
+	// The average of MonthlyUsage.
+	AverageMonthlyBurn float64
+
+	// The date on which the balance is projected to be exhausted at the average burn rate, or nil if no credits were
+	// used in the months of the projection.
+	ExhaustionDate *time.Time
+
+	// The earliest end date of the terms of the pool that still have a balance, or nil if none has an end date. The
+	// remaining credits of a term are lost when it ends.
+	TermEndDate *time.Time
+
+	// The credits used as overage in the current month.
+	Overage float64
+}
+
+// CreditPoolMonthlyUsage : The credits used from a credit pool in one month.
+type CreditPoolMonthlyUsage struct {
+	// The month, in "yyyy-mm" format.
+	Month string
+
+	// The credits used.
+	UsedCredits float64
+}
+
+// ExhaustsBefore returns true if the balance of the pool is projected to be exhausted before "t", for example to
+// alert when a pool will run out within the next 90 days.
+func (pool *CreditPoolBurnDown) ExhaustsBefore(t time.Time) bool {
+	return pool.ExhaustionDate != nil && pool.ExhaustionDate.Before(t)
+}
+
+// ExpiresBeforeExhaustion returns true if a term of the pool ends before the balance is projected to be exhausted,
+// in which case part of the credits are not used.
+func (pool *CreditPoolBurnDown) ExpiresBeforeExhaustion() bool {
+	if pool.TermEndDate == nil {
+		return false
+	}
+	return pool.ExhaustionDate == nil || pool.TermEndDate.Before(*pool.ExhaustionDate)
+}
+
+// GetCreditPoolProjection retrieves the credit pools of a billing unit for the current month and for the previous
+// "months" months (DefaultCreditPoolProjectionMonths if "months" is not positive), and projects when the balance of
+// each pool is exhausted if credits keep being used at the average rate of those months.
+func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) GetCreditPoolProjection(ctx context.Context, billingUnitID string, months int) (result *CreditPoolProjection, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, enterpriseBillingUnits.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	if months <= 0 {
+		months = DefaultCreditPoolProjectionMonths
+	}
+	now := time.Now().UTC()
+	getMonth := func(month string) (pools []CreditPool, err error) {
+		options := enterpriseBillingUnits.NewGetCreditPoolsOptions(billingUnitID).SetDate(month)
+		creditPools, _, err := enterpriseBillingUnits.GetCreditPoolsWithContext(ctx, options)
+		if err != nil {
+			err = fmt.Errorf("error retrieving the credit pools of billing unit '%s' for %s: %w", billingUnitID, month, err)
+			return
+		}
+		return creditPools.Resources, nil
+	}
+
+	current, err := getMonth(now.Format("2006-01"))
+	if err != nil {
+		return
+	}
+	result = &CreditPoolProjection{BillingUnitID: billingUnitID, ProjectedAt: now, WorkflowID: workflowID}
+	for _, pool := range current {
+		result.Pools = append(result.Pools, newCreditPoolBurnDown(&pool, now))
+	}
+
+	for i := months; i > 0; i-- {
+		month := time.Date(now.Year(), now.Month()-time.Month(i), 1, 0, 0, 0, 0, time.UTC).Format("2006-01")
+		var pools []CreditPool
+		pools, err = getMonth(month)
+		if err != nil {
+			result = nil
+			return
+		}
+		for _, pool := range pools {
+			for j := range result.Pools {
+				if result.Pools[j].Type == core.StringNilMapper(pool.Type) {
+					result.Pools[j].MonthlyUsage = append(result.Pools[j].MonthlyUsage, CreditPoolMonthlyUsage{
+						Month:       month,
+						UsedCredits: usedCredits(pool.TermCredits),
+					})
+				}
+			}
+		}
+	}
+
+	for i := range result.Pools {
+		result.Pools[i].project(now)
+	}
+	return
+}
+
+// newCreditPoolBurnDown returns the burn-down of a pool with the balances of the current month.
+func newCreditPoolBurnDown(pool *CreditPool, now time.Time) (burnDown CreditPoolBurnDown) {
+	burnDown.Type = core.StringNilMapper(pool.Type)
+	burnDown.CurrencyCode = core.StringNilMapper(pool.CurrencyCode)
+	if pool.Overage != nil && pool.Overage.Cost != nil {
+		burnDown.Overage = *pool.Overage.Cost
+	}
+	burnDown.CurrentMonthUsage = usedCredits(pool.TermCredits)
+	for i := range pool.TermCredits {
+		term := &pool.TermCredits[i]
+		if term.EndDate != nil && !time.Time(*term.EndDate).After(now) {
+			continue
+		}
+		if term.CurrentBalance == nil || *term.CurrentBalance <= 0 {
+			continue
+		}
+		burnDown.CurrentBalance += *term.CurrentBalance
+		if term.EndDate != nil {
+			endDate := time.Time(*term.EndDate)
+			if burnDown.TermEndDate == nil || endDate.Before(*burnDown.TermEndDate) {
+				burnDown.TermEndDate = &endDate
+			}
+		}
+	}
+	return
+}
+
+// project computes the average burn rate and the exhaustion date from the monthly usage.
+func (pool *CreditPoolBurnDown) project(now time.Time) {
+	if len(pool.MonthlyUsage) == 0 {
+		return
+	}
+	total := 0.0
+	for _, usage := range pool.MonthlyUsage {
+		total += usage.UsedCredits
+	}
+	pool.AverageMonthlyBurn = total / float64(len(pool.MonthlyUsage))
+	if pool.AverageMonthlyBurn <= 0 {
+		return
+	}
+	// The projection is capped to a century so that a negligible burn rate cannot overflow the duration.
+	days := math.Min(pool.CurrentBalance/(pool.AverageMonthlyBurn/daysPerMonth), 100*365.25)
+	exhaustionDate := now.Add(time.Duration(days * float64(24*time.Hour)))
+	pool.ExhaustionDate = &exhaustionDate
+}
+
+// usedCredits returns the credits used from the terms of a pool in a month.
+func usedCredits(terms []TermCredits) (sum float64) {
+	for _, term := range terms {
+		if term.UsedCredits != nil {
+			sum += *term.UsedCredits
+		}
+	}
+	return
+}
@@ -0,0 +1,104 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisebillingunitsv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EnterpriseBillingUnitsV1 credit pool projection`, func() {
+	var testServer *httptest.Server
+	var enterpriseBillingUnitsService *enterprisebillingunitsv1.EnterpriseBillingUnitsV1
+	var requestedMonths []string
+	now := time.Now().UTC()
+	date := func(t time.Time) string {
+		return t.Format(time.RFC3339)
+	}
+	BeforeEach(func() {
+		requestedMonths = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v1/credit-pools"))
+			Expect(req.URL.Query().Get("billing_unit_id")).To(Equal("bu"))
+			month := req.URL.Query().Get("date")
+			requestedMonths = append(requestedMonths, month)
+			res.Header().Set("Content-type", "application/json")
+			if month != now.Format("2006-01") {
+				fmt.Fprint(res, `{"rows_count": 1, "resources": [{"type": "PLATFORM", "term_credits": [{"used_credits": 300}]}]}`)
+				return
+			}
+			fmt.Fprintf(res, `{"rows_count": 2, "resources": [
+				{"type": "PLATFORM", "currency_code": "USD", "overage": {"cost": 0}, "term_credits": [
+					{"end_date": "%s", "current_balance": 900, "used_credits": 50},
+					{"end_date": "%s", "current_balance": 100, "used_credits": 0}]},
+				{"type": "SUPPORT", "currency_code": "USD", "term_credits": [{"end_date": "%s", "current_balance": 100}]}]}`,
+				date(now.AddDate(2, 0, 0)), date(now.AddDate(0, 0, -1)), date(now.AddDate(0, 0, 30)))
+		}))
+		var serviceErr error
+		enterpriseBillingUnitsService, serviceErr = enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(&enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(enterpriseBillingUnitsService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Projects the exhaustion of each credit pool`, func() {
+		projection, err := enterpriseBillingUnitsService.GetCreditPoolProjection(context.Background(), "bu", 0)
+		Expect(err).To(BeNil())
+		Expect(projection.WorkflowID).ToNot(BeEmpty())
+		Expect(requestedMonths).To(HaveLen(4))
+		Expect(requestedMonths[1]).To(Equal(time.Date(now.Year(), now.Month()-3, 1, 0, 0, 0, 0, time.UTC).Format("2006-01")))
+		Expect(projection.Pools).To(HaveLen(2))
+
+		platform := projection.Pools[0]
+		Expect(platform.Type).To(Equal(enterprisebillingunitsv1.CreditPoolTypePlatformConst))
+		Expect(platform.CurrentBalance).To(Equal(900.0))
+		Expect(platform.CurrentMonthUsage).To(Equal(50.0))
+		Expect(platform.MonthlyUsage).To(HaveLen(3))
+		Expect(platform.AverageMonthlyBurn).To(Equal(300.0))
+		Expect(*platform.ExhaustionDate).To(BeTemporally("~", now.Add(time.Duration(3*365.25/12*24*float64(time.Hour))), time.Hour))
+		Expect(platform.ExhaustsBefore(now.AddDate(0, 0, 100))).To(BeTrue())
+		Expect(platform.ExhaustsBefore(now.AddDate(0, 0, 80))).To(BeFalse())
+		Expect(platform.ExpiresBeforeExhaustion()).To(BeFalse())
+
+		support := projection.Pools[1]
+		Expect(support.ExhaustionDate).To(BeNil())
+		Expect(support.ExhaustsBefore(now.AddDate(10, 0, 0))).To(BeFalse())
+		Expect(support.ExpiresBeforeExhaustion()).To(BeTrue())
+	})
+	It(`Averages the requested number of months`, func() {
+		projection, err := enterpriseBillingUnitsService.GetCreditPoolProjection(context.Background(), "bu", 1)
+		Expect(err).To(BeNil())
+		Expect(requestedMonths).To(HaveLen(2))
+		Expect(projection.Pools[0].MonthlyUsage).To(HaveLen(1))
+	})
+})
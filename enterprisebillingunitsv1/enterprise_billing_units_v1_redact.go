@@ -0,0 +1,143 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genredact. DO NOT EDIT.
+
+package enterprisebillingunitsv1
+
+import "github.com/IBM/platform-services-go-sdk/common"
+
+//go:generate go run ../internal/genredact
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model BillingOption) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model BillingOption) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model BillingOptionsList) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model BillingOptionsList) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model BillingUnit) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model BillingUnit) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model BillingUnitsList) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model BillingUnitsList) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreditPool) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreditPool) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreditPoolOverage) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreditPoolOverage) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreditPoolsList) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model CreditPoolsList) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetBillingUnitOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetBillingUnitOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetCreditPoolsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model GetCreditPoolsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListBillingOptionsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListBillingOptionsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListBillingUnitsOptions) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model ListBillingUnitsOptions) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model TermCredits) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model TermCredits) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
@@ -0,0 +1,288 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package enterprisemanagementv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// EnterpriseManagementV1API : The operations of the EnterpriseManagementV1 service, implemented by *EnterpriseManagementV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type EnterpriseManagementV1API interface {
+	// Clone makes a copy of "enterpriseManagement" suitable for processing requests.
+	Clone() *EnterpriseManagementV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// CreateEnterprise : Create an enterprise
+	CreateEnterprise(createEnterpriseOptions *CreateEnterpriseOptions) (result *CreateEnterpriseResponse, response *core.DetailedResponse, err error)
+
+	// CreateEnterpriseWithContext is an alternate form of the CreateEnterprise method which supports a Context
+	// parameter
+	CreateEnterpriseWithContext(ctx context.Context, createEnterpriseOptions *CreateEnterpriseOptions) (result *CreateEnterpriseResponse, response *core.DetailedResponse, err error)
+
+	// ListEnterprises : List enterprises
+	ListEnterprises(listEnterprisesOptions *ListEnterprisesOptions) (result *ListEnterprisesResponse, response *core.DetailedResponse, err error)
+
+	// ListEnterprisesWithContext is an alternate form of the ListEnterprises method which supports a Context parameter
+	ListEnterprisesWithContext(ctx context.Context, listEnterprisesOptions *ListEnterprisesOptions) (result *ListEnterprisesResponse, response *core.DetailedResponse, err error)
+
+	// GetEnterprise : Get enterprise by ID
+	GetEnterprise(getEnterpriseOptions *GetEnterpriseOptions) (result *Enterprise, response *core.DetailedResponse, err error)
+
+	// GetEnterpriseWithContext is an alternate form of the GetEnterprise method which supports a Context parameter
+	GetEnterpriseWithContext(ctx context.Context, getEnterpriseOptions *GetEnterpriseOptions) (result *Enterprise, response *core.DetailedResponse, err error)
+
+	// UpdateEnterprise : Update an enterprise
+	UpdateEnterprise(updateEnterpriseOptions *UpdateEnterpriseOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateEnterpriseWithContext is an alternate form of the UpdateEnterprise method which supports a Context
+	// parameter
+	UpdateEnterpriseWithContext(ctx context.Context, updateEnterpriseOptions *UpdateEnterpriseOptions) (response *core.DetailedResponse, err error)
+
+	// ImportAccountToEnterprise : Import an account into an enterprise
+	ImportAccountToEnterprise(importAccountToEnterpriseOptions *ImportAccountToEnterpriseOptions) (response *core.DetailedResponse, err error)
+
+	// ImportAccountToEnterpriseWithContext is an alternate form of the ImportAccountToEnterprise method which supports
+	// a Context parameter
+	ImportAccountToEnterpriseWithContext(ctx context.Context, importAccountToEnterpriseOptions *ImportAccountToEnterpriseOptions) (response *core.DetailedResponse, err error)
+
+	// CreateAccount : Create a new account in an enterprise
+	CreateAccount(createAccountOptions *CreateAccountOptions) (result *CreateAccountResponse, response *core.DetailedResponse, err error)
+
+	// CreateAccountWithContext is an alternate form of the CreateAccount method which supports a Context parameter
+	CreateAccountWithContext(ctx context.Context, createAccountOptions *CreateAccountOptions) (result *CreateAccountResponse, response *core.DetailedResponse, err error)
+
+	// ListAccounts : List accounts
+	ListAccounts(listAccountsOptions *ListAccountsOptions) (result *ListAccountsResponse, response *core.DetailedResponse, err error)
+
+	// ListAccountsWithContext is an alternate form of the ListAccounts method which supports a Context parameter
+	ListAccountsWithContext(ctx context.Context, listAccountsOptions *ListAccountsOptions) (result *ListAccountsResponse, response *core.DetailedResponse, err error)
+
+	// GetAccount : Get account by ID
+	GetAccount(getAccountOptions *GetAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+
+	// GetAccountWithContext is an alternate form of the GetAccount method which supports a Context parameter
+	GetAccountWithContext(ctx context.Context, getAccountOptions *GetAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+
+	// UpdateAccount : Move an account within the enterprise
+	UpdateAccount(updateAccountOptions *UpdateAccountOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateAccountWithContext is an alternate form of the UpdateAccount method which supports a Context parameter
+	UpdateAccountWithContext(ctx context.Context, updateAccountOptions *UpdateAccountOptions) (response *core.DetailedResponse, err error)
+
+	// CreateAccountGroup : Create an account group
+	CreateAccountGroup(createAccountGroupOptions *CreateAccountGroupOptions) (result *CreateAccountGroupResponse, response *core.DetailedResponse, err error)
+
+	// CreateAccountGroupWithContext is an alternate form of the CreateAccountGroup method which supports a Context
+	// parameter
+	CreateAccountGroupWithContext(ctx context.Context, createAccountGroupOptions *CreateAccountGroupOptions) (result *CreateAccountGroupResponse, response *core.DetailedResponse, err error)
+
+	// ListAccountGroups : List account groups
+	ListAccountGroups(listAccountGroupsOptions *ListAccountGroupsOptions) (result *ListAccountGroupsResponse, response *core.DetailedResponse, err error)
+
+	// ListAccountGroupsWithContext is an alternate form of the ListAccountGroups method which supports a Context
+	// parameter
+	ListAccountGroupsWithContext(ctx context.Context, listAccountGroupsOptions *ListAccountGroupsOptions) (result *ListAccountGroupsResponse, response *core.DetailedResponse, err error)
+
+	// GetAccountGroup : Get account group by ID
+	GetAccountGroup(getAccountGroupOptions *GetAccountGroupOptions) (result *AccountGroup, response *core.DetailedResponse, err error)
+
+	// GetAccountGroupWithContext is an alternate form of the GetAccountGroup method which supports a Context parameter
+	GetAccountGroupWithContext(ctx context.Context, getAccountGroupOptions *GetAccountGroupOptions) (result *AccountGroup, response *core.DetailedResponse, err error)
+
+	// UpdateAccountGroup : Update an account group
+	UpdateAccountGroup(updateAccountGroupOptions *UpdateAccountGroupOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateAccountGroupWithContext is an alternate form of the UpdateAccountGroup method which supports a Context
+	// parameter
+	UpdateAccountGroupWithContext(ctx context.Context, updateAccountGroupOptions *UpdateAccountGroupOptions) (response *core.DetailedResponse, err error)
+
+	// NewCreateAccountGroupOptions : Instantiate CreateAccountGroupOptions
+	NewCreateAccountGroupOptions(parent string, name string, primaryContactIamID string) *CreateAccountGroupOptions
+
+	// NewCreateAccountOptions : Instantiate CreateAccountOptions
+	NewCreateAccountOptions(parent string, name string, ownerIamID string) *CreateAccountOptions
+
+	// NewCreateEnterpriseOptions : Instantiate CreateEnterpriseOptions
+	NewCreateEnterpriseOptions(sourceAccountID string, name string, primaryContactIamID string) *CreateEnterpriseOptions
+
+	// NewGetAccountGroupOptions : Instantiate GetAccountGroupOptions
+	NewGetAccountGroupOptions(accountGroupID string) *GetAccountGroupOptions
+
+	// NewGetAccountOptions : Instantiate GetAccountOptions
+	NewGetAccountOptions(accountID string) *GetAccountOptions
+
+	// NewGetEnterpriseOptions : Instantiate GetEnterpriseOptions
+	NewGetEnterpriseOptions(enterpriseID string) *GetEnterpriseOptions
+
+	// NewImportAccountToEnterpriseOptions : Instantiate ImportAccountToEnterpriseOptions
+	NewImportAccountToEnterpriseOptions(enterpriseID string, accountID string) *ImportAccountToEnterpriseOptions
+
+	// NewListAccountGroupsOptions : Instantiate ListAccountGroupsOptions
+	NewListAccountGroupsOptions() *ListAccountGroupsOptions
+
+	// NewListAccountsOptions : Instantiate ListAccountsOptions
+	NewListAccountsOptions() *ListAccountsOptions
+
+	// NewListEnterprisesOptions : Instantiate ListEnterprisesOptions
+	NewListEnterprisesOptions() *ListEnterprisesOptions
+
+	// NewUpdateAccountGroupOptions : Instantiate UpdateAccountGroupOptions
+	NewUpdateAccountGroupOptions(accountGroupID string) *UpdateAccountGroupOptions
+
+	// NewUpdateAccountOptions : Instantiate UpdateAccountOptions
+	NewUpdateAccountOptions(accountID string, parent string) *UpdateAccountOptions
+
+	// NewUpdateEnterpriseOptions : Instantiate UpdateEnterpriseOptions
+	NewUpdateEnterpriseOptions(enterpriseID string) *UpdateEnterpriseOptions
+
+	// ImportAccounts imports stand-alone accounts into an enterprise.
+	ImportAccounts(ctx context.Context, enterpriseID string, accountIDs []string, options *ImportAccountsOptions) (report *ImportAccountsReport, err error)
+
+	// MoveAccount moves an account of an enterprise to the account group with the specified ID and waits until the
+	// enterprise hierarchy reflects the move, polling the account every "pollInterval" (DefaultAccountMovePollInterval
+	// if zero); use a context with a deadline to limit the wait.
+	MoveAccount(ctx context.Context, accountID string, targetGroupID string, pollInterval time.Duration) (result *Account, err error)
+
+	// NewCreateEnterpriseOptionsWith returns CreateEnterpriseOptions populated by applying "options" in order.
+	NewCreateEnterpriseOptionsWith(options ...CreateEnterpriseOption) *CreateEnterpriseOptions
+
+	// CreateEnterpriseWith is an alternate form of the CreateEnterpriseWithContext method which takes functional
+	// options instead of a CreateEnterpriseOptions struct.
+	CreateEnterpriseWith(ctx context.Context, options ...CreateEnterpriseOption) (result *CreateEnterpriseResponse, response *core.DetailedResponse, err error)
+
+	// NewListEnterprisesOptionsWith returns ListEnterprisesOptions populated by applying "options" in order.
+	NewListEnterprisesOptionsWith(options ...ListEnterprisesOption) *ListEnterprisesOptions
+
+	// ListEnterprisesWith is an alternate form of the ListEnterprisesWithContext method which takes functional options
+	// instead of a ListEnterprisesOptions struct.
+	ListEnterprisesWith(ctx context.Context, options ...ListEnterprisesOption) (result *ListEnterprisesResponse, response *core.DetailedResponse, err error)
+
+	// NewGetEnterpriseOptionsWith returns GetEnterpriseOptions populated by applying "options" in order.
+	NewGetEnterpriseOptionsWith(options ...GetEnterpriseOption) *GetEnterpriseOptions
+
+	// GetEnterpriseWith is an alternate form of the GetEnterpriseWithContext method which takes functional options
+	// instead of a GetEnterpriseOptions struct.
+	GetEnterpriseWith(ctx context.Context, options ...GetEnterpriseOption) (result *Enterprise, response *core.DetailedResponse, err error)
+
+	// NewUpdateEnterpriseOptionsWith returns UpdateEnterpriseOptions populated by applying "options" in order.
+	NewUpdateEnterpriseOptionsWith(options ...UpdateEnterpriseOption) *UpdateEnterpriseOptions
+
+	// UpdateEnterpriseWith is an alternate form of the UpdateEnterpriseWithContext method which takes functional
+	// options instead of an UpdateEnterpriseOptions struct.
+	UpdateEnterpriseWith(ctx context.Context, options ...UpdateEnterpriseOption) (response *core.DetailedResponse, err error)
+
+	// NewImportAccountToEnterpriseOptionsWith returns ImportAccountToEnterpriseOptions populated by applying "options"
+	// in order.
+	NewImportAccountToEnterpriseOptionsWith(options ...ImportAccountToEnterpriseOption) *ImportAccountToEnterpriseOptions
+
+	// ImportAccountToEnterpriseWith is an alternate form of the ImportAccountToEnterpriseWithContext method which
+	// takes functional options instead of an ImportAccountToEnterpriseOptions struct.
+	ImportAccountToEnterpriseWith(ctx context.Context, options ...ImportAccountToEnterpriseOption) (response *core.DetailedResponse, err error)
+
+	// NewCreateAccountOptionsWith returns CreateAccountOptions populated by applying "options" in order.
+	NewCreateAccountOptionsWith(options ...CreateAccountOption) *CreateAccountOptions
+
+	// CreateAccountWith is an alternate form of the CreateAccountWithContext method which takes functional options
+	// instead of a CreateAccountOptions struct.
+	CreateAccountWith(ctx context.Context, options ...CreateAccountOption) (result *CreateAccountResponse, response *core.DetailedResponse, err error)
+
+	// NewListAccountsOptionsWith returns ListAccountsOptions populated by applying "options" in order.
+	NewListAccountsOptionsWith(options ...ListAccountsOption) *ListAccountsOptions
+
+	// ListAccountsWith is an alternate form of the ListAccountsWithContext method which takes functional options
+	// instead of a ListAccountsOptions struct.
+	ListAccountsWith(ctx context.Context, options ...ListAccountsOption) (result *ListAccountsResponse, response *core.DetailedResponse, err error)
+
+	// NewGetAccountOptionsWith returns GetAccountOptions populated by applying "options" in order.
+	NewGetAccountOptionsWith(options ...GetAccountOption) *GetAccountOptions
+
+	// GetAccountWith is an alternate form of the GetAccountWithContext method which takes functional options instead
+	// of a GetAccountOptions struct.
+	GetAccountWith(ctx context.Context, options ...GetAccountOption) (result *Account, response *core.DetailedResponse, err error)
+
+	// NewUpdateAccountOptionsWith returns UpdateAccountOptions populated by applying "options" in order.
+	NewUpdateAccountOptionsWith(options ...UpdateAccountOption) *UpdateAccountOptions
+
+	// UpdateAccountWith is an alternate form of the UpdateAccountWithContext method which takes functional options
+	// instead of an UpdateAccountOptions struct.
+	UpdateAccountWith(ctx context.Context, options ...UpdateAccountOption) (response *core.DetailedResponse, err error)
+
+	// NewCreateAccountGroupOptionsWith returns CreateAccountGroupOptions populated by applying "options" in order.
+	NewCreateAccountGroupOptionsWith(options ...CreateAccountGroupOption) *CreateAccountGroupOptions
+
+	// CreateAccountGroupWith is an alternate form of the CreateAccountGroupWithContext method which takes functional
+	// options instead of a CreateAccountGroupOptions struct.
+	CreateAccountGroupWith(ctx context.Context, options ...CreateAccountGroupOption) (result *CreateAccountGroupResponse, response *core.DetailedResponse, err error)
+
+	// NewListAccountGroupsOptionsWith returns ListAccountGroupsOptions populated by applying "options" in order.
+	NewListAccountGroupsOptionsWith(options ...ListAccountGroupsOption) *ListAccountGroupsOptions
+
+	// ListAccountGroupsWith is an alternate form of the ListAccountGroupsWithContext method which takes functional
+	// options instead of a ListAccountGroupsOptions struct.
+	ListAccountGroupsWith(ctx context.Context, options ...ListAccountGroupsOption) (result *ListAccountGroupsResponse, response *core.DetailedResponse, err error)
+
+	// NewGetAccountGroupOptionsWith returns GetAccountGroupOptions populated by applying "options" in order.
+	NewGetAccountGroupOptionsWith(options ...GetAccountGroupOption) *GetAccountGroupOptions
+
+	// GetAccountGroupWith is an alternate form of the GetAccountGroupWithContext method which takes functional options
+	// instead of a GetAccountGroupOptions struct.
+	GetAccountGroupWith(ctx context.Context, options ...GetAccountGroupOption) (result *AccountGroup, response *core.DetailedResponse, err error)
+
+	// NewUpdateAccountGroupOptionsWith returns UpdateAccountGroupOptions populated by applying "options" in order.
+	NewUpdateAccountGroupOptionsWith(options ...UpdateAccountGroupOption) *UpdateAccountGroupOptions
+
+	// UpdateAccountGroupWith is an alternate form of the UpdateAccountGroupWithContext method which takes functional
+	// options instead of an UpdateAccountGroupOptions struct.
+	UpdateAccountGroupWith(ctx context.Context, options ...UpdateAccountGroupOption) (response *core.DetailedResponse, err error)
+
+	// WalkEnterprise visits the account groups and accounts of an enterprise depth-first: each account group is
+	// visited before the account groups and accounts that it contains, and at each level the account groups are
+	// visited (with their contents) before the accounts.
+	WalkEnterprise(ctx context.Context, enterpriseID string, visitFunc EnterpriseVisitFunc) (err error)
+}
+
+var _ EnterpriseManagementV1API = (*EnterpriseManagementV1)(nil)
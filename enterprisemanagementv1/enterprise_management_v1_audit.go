@@ -0,0 +1,190 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
+	"github.com/IBM/platform-services-go-sdk/usermanagementv1"
+)
+
+// DefaultEnterpriseAuditConcurrency is the number of accounts that an EnterpriseAuditor checks concurrently when no
+// concurrency is specified.
+const DefaultEnterpriseAuditConcurrency = 4
+
+// Constants associated with the AccountAuditResult.Findings property.
+const (
+	AccountAuditFindingNoBillingUnitConst = "no_billing_unit"
+	AccountAuditFindingSuspendedConst     = "suspended"
+	AccountAuditFindingNoActiveUsersConst = "no_active_users"
+)
+
+// Constants associated with the Account.State property.
+const (
+	AccountStateSuspendedConst = "SUSPENDED"
+)
+
+// The state of the active users of an account, as used by the User Management service.
+const userStateActive = "ACTIVE"
+
+// EnterpriseAccountAudit : The outcome of AuditEnterpriseAccounts.
+type EnterpriseAccountAudit struct {
+	// The ID of the enterprise.
+	EnterpriseID string
+
+	// The result for each account of the enterprise (other than the enterprise account), in the order in which
+	// WalkEnterprise visits them.
+	Results []AccountAuditResult
+
+	// The workflow ID sent with every request of the audit (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// AccountAuditResult : The findings of AuditEnterpriseAccounts for one account.
+type AccountAuditResult struct {
+	// The account.
+	Account *Account
+
+	// The reasons why the account is a cleanup candidate (AccountAuditFinding*Const values), if any.
+	Findings []string
+
+	// The error that prevented a check of the account, if any. The findings of the other checks are still recorded.
+	Err error
+}
+
+// Candidates returns the results of the accounts with at least one finding.
+func (audit *EnterpriseAccountAudit) Candidates() (candidates []AccountAuditResult) {
+	for _, result := range audit.Results {
+		if len(result.Findings) > 0 {
+			candidates = append(candidates, result)
+		}
+	}
+	return
+}
+
+// Failed returns the results of the accounts that could not be fully checked.
+func (audit *EnterpriseAccountAudit) Failed() (failed []AccountAuditResult) {
+	for _, result := range audit.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return
+}
+
+// EnterpriseAuditor : Finds the accounts of an enterprise that may be orphaned: suspended accounts, and (using the
+// Enterprise Billing Units and User Management services) accounts that are not linked to a billing unit or that have
+// no active users.
+type EnterpriseAuditor struct {
+	*EnterpriseManagementV1
+
+	// The client used to look up the billing units of each account. If nil, billing unit linkage is not checked.
+	BillingUnits *enterprisebillingunitsv1.EnterpriseBillingUnitsV1
+
+	// The client used to look up the active users of each account. If nil, users are not checked.
+	UserManagement *usermanagementv1.UserManagementV1
+
+	// The number of accounts checked concurrently. Defaults to DefaultEnterpriseAuditConcurrency.
+	Concurrency int
+}
+
+// NewEnterpriseAuditor returns a new EnterpriseAuditor that walks enterprises with "enterpriseManagement" and checks
+// their accounts with "billingUnits" and "userManagement", either of which may be nil.
+func NewEnterpriseAuditor(enterpriseManagement *EnterpriseManagementV1, billingUnits *enterprisebillingunitsv1.EnterpriseBillingUnitsV1, userManagement *usermanagementv1.UserManagementV1) *EnterpriseAuditor {
+	return &EnterpriseAuditor{
+		EnterpriseManagementV1: enterpriseManagement,
+		BillingUnits:           billingUnits,
+		UserManagement:         userManagement,
+		Concurrency:            DefaultEnterpriseAuditConcurrency,
+	}
+}
+
+// AuditEnterpriseAccounts walks the account hierarchy of an enterprise and checks each of its accounts, except the
+// enterprise account itself. Nothing is changed. A check that fails is recorded in the result of its account, so
+// "err" is only returned if the hierarchy cannot be walked or the context is done. If the context is done once the
+// accounts are being checked, the audit is still returned with a *common.PartialError, and the accounts that were not
+// checked have the error of the context.
+func (auditor *EnterpriseAuditor) AuditEnterpriseAccounts(ctx context.Context, enterpriseID string) (audit *EnterpriseAccountAudit, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, auditor.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	audit = &EnterpriseAccountAudit{EnterpriseID: enterpriseID, WorkflowID: workflowID}
+	err = auditor.WalkEnterprise(ctx, enterpriseID, func(node *EnterpriseNode) error {
+		if node.Account != nil && (node.Account.IsEnterpriseAccount == nil || !*node.Account.IsEnterpriseAccount) {
+			audit.Results = append(audit.Results, AccountAuditResult{Account: node.Account})
+		}
+		return nil
+	})
+	if err != nil {
+		audit = nil
+		return
+	}
+
+	concurrency := auditor.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultEnterpriseAuditConcurrency
+	}
+	err = common.ForEachConcurrently(ctx, len(audit.Results), concurrency, func(ctx context.Context, i int) {
+		// Each call only writes its own result, so no locking is needed.
+		auditor.auditAccount(ctx, &audit.Results[i])
+	})
+	var partialErr *common.PartialError
+	if errors.As(err, &partialErr) {
+		for i := partialErr.Processed; i < len(audit.Results); i++ {
+			audit.Results[i].Err = partialErr.Err
+		}
+	}
+	return
+}
+
+// auditAccount records the findings of the checks of the account of "result".
+func (auditor *EnterpriseAuditor) auditAccount(ctx context.Context, result *AccountAuditResult) {
+	accountID := core.StringNilMapper(result.Account.ID)
+	if strings.EqualFold(core.StringNilMapper(result.Account.State), AccountStateSuspendedConst) {
+		result.Findings = append(result.Findings, AccountAuditFindingSuspendedConst)
+	}
+
+	if auditor.BillingUnits != nil {
+		options := auditor.BillingUnits.NewListBillingUnitsOptions().SetAccountID(accountID)
+		billingUnits, _, err := auditor.BillingUnits.ListBillingUnitsWithContext(ctx, options)
+		if err != nil {
+			result.Err = fmt.Errorf("error listing the billing units of account '%s': %w", accountID, err)
+		} else if len(billingUnits.Resources) == 0 {
+			result.Findings = append(result.Findings, AccountAuditFindingNoBillingUnitConst)
+		}
+	}
+
+	if auditor.UserManagement != nil {
+		options := auditor.UserManagement.NewListUsersOptions(accountID)
+		options.State = core.StringPtr(userStateActive)
+		options.Limit = core.Int64Ptr(1)
+		users, _, err := auditor.UserManagement.ListUsersWithContext(ctx, options)
+		if err != nil {
+			if result.Err == nil {
+				result.Err = fmt.Errorf("error listing the users of account '%s': %w", accountID, err)
+			}
+		} else if len(users.Resources) == 0 {
+			result.Findings = append(result.Findings, AccountAuditFindingNoActiveUsersConst)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/usermanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EnterpriseManagementV1 account audit`, func() {
+	var testServer *httptest.Server
+	var auditor *enterprisemanagementv1.EnterpriseAuditor
+	var onBillingUnits func(accountID string)
+	BeforeEach(func() {
+		onBillingUnits = nil
+		// The enterprise contains the enterprise account "ent-acct", the linked and active account "ok", the suspended
+		// account "susp", the account "orphan" with no billing unit and no active users, and the account "broken" whose
+		// billing units cannot be listed.
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/enterprises/ent":
+				fmt.Fprint(res, `{"id": "ent", "crn": "crn:ent"}`)
+			case "/account-groups":
+				fmt.Fprint(res, `{"rows_count": 0, "resources": []}`)
+			case "/accounts":
+				fmt.Fprint(res, `{"rows_count": 5, "resources": [
+					{"id": "ent-acct", "state": "ACTIVE", "is_enterprise_account": true},
+					{"id": "ok", "state": "ACTIVE"},
+					{"id": "susp", "state": "SUSPENDED"},
+					{"id": "orphan", "state": "ACTIVE"},
+					{"id": "broken", "state": "ACTIVE"}]}`)
+			case "/v1/billing-units":
+				if onBillingUnits != nil {
+					onBillingUnits(req.URL.Query().Get("account_id"))
+				}
+				switch req.URL.Query().Get("account_id") {
+				case "orphan":
+					fmt.Fprint(res, `{"rows_count": 0, "resources": []}`)
+				case "broken":
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"errors": [{"message": "internal error"}]}`)
+				default:
+					fmt.Fprint(res, `{"rows_count": 1, "resources": [{"id": "bu"}]}`)
+				}
+			case "/v2/accounts/ok/users", "/v2/accounts/susp/users", "/v2/accounts/broken/users":
+				Expect(req.URL.Query().Get("state")).To(Equal("ACTIVE"))
+				fmt.Fprint(res, `{"total_results": 1, "resources": [{"id": "user"}]}`)
+			case "/v2/accounts/orphan/users":
+				fmt.Fprint(res, `{"total_results": 0, "resources": []}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(enterpriseManagementService.Service)
+		billingUnitsService, serviceErr := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(&enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		userManagementService, serviceErr := usermanagementv1.NewUserManagementV1(&usermanagementv1.UserManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		auditor = enterprisemanagementv1.NewEnterpriseAuditor(enterpriseManagementService, billingUnitsService, userManagementService)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Reports the accounts that are cleanup candidates`, func() {
+		audit, err := auditor.AuditEnterpriseAccounts(context.Background(), "ent")
+		Expect(err).To(BeNil())
+		Expect(audit.WorkflowID).ToNot(BeEmpty())
+		Expect(audit.Results).To(HaveLen(4))
+
+		candidates := audit.Candidates()
+		Expect(candidates).To(HaveLen(2))
+		Expect(*candidates[0].Account.ID).To(Equal("susp"))
+		Expect(candidates[0].Findings).To(Equal([]string{enterprisemanagementv1.AccountAuditFindingSuspendedConst}))
+		Expect(*candidates[1].Account.ID).To(Equal("orphan"))
+		Expect(candidates[1].Findings).To(Equal([]string{
+			enterprisemanagementv1.AccountAuditFindingNoBillingUnitConst,
+			enterprisemanagementv1.AccountAuditFindingNoActiveUsersConst,
+		}))
+
+		failed := audit.Failed()
+		Expect(failed).To(HaveLen(1))
+		Expect(*failed[0].Account.ID).To(Equal("broken"))
+		Expect(failed[0].Err.Error()).To(ContainSubstring("billing units of account 'broken'"))
+	})
+	It(`Skips the checks of the services without a client`, func() {
+		auditor.BillingUnits = nil
+		auditor.UserManagement = nil
+		audit, err := auditor.AuditEnterpriseAccounts(context.Background(), "ent")
+		Expect(err).To(BeNil())
+		Expect(audit.Candidates()).To(HaveLen(1))
+		Expect(audit.Failed()).To(BeEmpty())
+	})
+	It(`Returns the partial audit when the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		onBillingUnits = func(accountID string) {
+			if accountID == "susp" {
+				cancel()
+			}
+		}
+		auditor.Concurrency = 1
+		audit, err := auditor.AuditEnterpriseAccounts(ctx, "ent")
+		var partialErr *common.PartialError
+		Expect(errors.As(err, &partialErr)).To(BeTrue())
+		Expect(partialErr.Processed).To(Equal(2))
+		Expect(partialErr.Total).To(Equal(4))
+		Expect(audit.Results[0].Err).To(BeNil())
+		Expect(audit.Results[3].Err).To(MatchError(context.Canceled))
+		Expect(audit.Failed()).To(HaveLen(3))
+	})
+})
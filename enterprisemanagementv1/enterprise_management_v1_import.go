@@ -0,0 +1,312 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the Account.State property.
+const (
+	AccountStateActiveConst = "ACTIVE"
+)
+
+// Defaults of ImportAccountsOptions.
+const (
+	DefaultImportAccountsConcurrency   = 4
+	DefaultImportAccountsMaxRetries    = 3
+	DefaultImportAccountsRetryInterval = 5 * time.Second
+	DefaultImportAccountsPollInterval  = 10 * time.Second
+)
+
+// ImportAccountsOptions : Controls how ImportAccounts imports accounts. The zero value uses the defaults.
+type ImportAccountsOptions struct {
+	// The CRN of the account group (or enterprise) that the accounts are added to. If empty, they are added to the
+	// enterprise itself.
+	Parent string
+
+	// The ID of the billing unit used to bill the accounts in the enterprise.
+	BillingUnitID string
+
+	// The number of accounts imported concurrently. Defaults to DefaultImportAccountsConcurrency.
+	Concurrency int
+
+	// The number of times an import request that fails with a transient error (a network error, status code 429 or a
+	// 5xx status code) is retried. Defaults to DefaultImportAccountsMaxRetries; a negative value disables retries.
+	MaxRetries int
+
+	// The interval before the first retry of an import request, which doubles after each retry. Defaults to
+	// DefaultImportAccountsRetryInterval.
+	RetryInterval time.Duration
+
+	// The interval at which an account is retrieved to find out whether its import is complete. Defaults to
+	// DefaultImportAccountsPollInterval.
+	PollInterval time.Duration
+
+	// The maximum time to wait for the import of each account to complete after it is accepted. If zero, ImportAccounts
+	// waits until the context is done.
+	Timeout time.Duration
+
+	// If set, it is called after the import of each account succeeds or fails. Calls are not concurrent.
+	OnProgress func(ImportAccountsProgress)
+}
+
+// ImportAccountsProgress : The progress of ImportAccounts, passed to ImportAccountsOptions.OnProgress.
+type ImportAccountsProgress struct {
+	// The number of accounts to import.
+	Total int
+
+	// The number of accounts whose import succeeded.
+	Succeeded int
+
+	// The number of accounts whose import failed.
+	Failed int
+
+	// The result of the account that completed last.
+	Last ImportAccountResult
+}
+
+// ImportAccountsReport : The outcome of ImportAccounts.
+type ImportAccountsReport struct {
+	// The result for each account, in the order of the account IDs passed to ImportAccounts.
+	Results []ImportAccountResult
+
+	// The workflow ID sent with every request of the import (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// ImportAccountResult : The result of the import of one account.
+type ImportAccountResult struct {
+	// The ID of the account.
+	AccountID string
+
+	// The account as retrieved once its import completed, or nil if the import failed.
+	Account *Account
+
+	// It is true if the account was already part of the enterprise, in which case no import request was sent.
+	AlreadyImported bool
+
+	// The number of import requests sent.
+	Attempts int
+
+	// The reason why the import failed, or nil if it succeeded.
+	Err error
+}
+
+// Succeeded returns the IDs of the accounts that are part of the enterprise, including those that already were.
+func (report *ImportAccountsReport) Succeeded() (accountIDs []string) {
+	for _, result := range report.Results {
+		if result.Err == nil {
+			accountIDs = append(accountIDs, result.AccountID)
+		}
+	}
+	return
+}
+
+// Failed returns the results of the accounts whose import failed. The IDs of these accounts can be passed to
+// ImportAccounts again once the cause of the failures is resolved.
+func (report *ImportAccountsReport) Failed() (failed []ImportAccountResult) {
+	for _, result := range report.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return
+}
+
+// HasErrors returns true if the import of any account failed.
+func (report *ImportAccountsReport) HasErrors() bool {
+	return len(report.Failed()) > 0
+}
+
+// ImportAccounts imports stand-alone accounts into an enterprise. Up to ImportAccountsOptions.Concurrency accounts are
+// imported concurrently: the import request of each account is sent (and retried if it fails with a transient error),
+// then the account is retrieved until it is active in the enterprise. Accounts that are already part of the enterprise
+// are not imported again, so a partial import can be completed by calling ImportAccounts again with the same IDs.
+//
+// The import of an account that fails does not stop the others: its error is recorded in the report, so "err" is only
+// returned if the arguments are invalid or the context is done. In the latter case the report is still returned with
+// a *common.PartialError, and the accounts that were not imported have the error of the context.
+func (enterpriseManagement *EnterpriseManagementV1) ImportAccounts(ctx context.Context, enterpriseID string, accountIDs []string, options *ImportAccountsOptions) (report *ImportAccountsReport, err error) {
+	if enterpriseID == "" {
+		err = fmt.Errorf("enterpriseID cannot be empty")
+		return
+	}
+	importer := accountImporter{enterpriseManagement: enterpriseManagement, enterpriseID: enterpriseID}
+	if options != nil {
+		importer.options = *options
+	}
+	importer.setDefaults()
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, enterpriseManagement.Service)
+
+	report = &ImportAccountsReport{Results: make([]ImportAccountResult, len(accountIDs)), WorkflowID: workflowID}
+	progress := ImportAccountsProgress{Total: len(accountIDs)}
+	var mutex sync.Mutex
+	for i, accountID := range accountIDs {
+		report.Results[i].AccountID = accountID
+	}
+	err = common.ForEachConcurrently(ctx, len(accountIDs), importer.options.Concurrency, func(ctx context.Context, i int) {
+		// Each call only writes its own result; the progress is shared.
+		result := &report.Results[i]
+		importer.importAccount(ctx, result)
+		result.Err = common.WrapWorkflowError(workflowID, result.Err)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		if result.Err == nil {
+			progress.Succeeded++
+		} else {
+			progress.Failed++
+		}
+		progress.Last = *result
+		if importer.options.OnProgress != nil {
+			importer.options.OnProgress(progress)
+		}
+	})
+
+	var partialErr *common.PartialError
+	if errors.As(err, &partialErr) {
+		for i := partialErr.Processed; i < len(accountIDs); i++ {
+			report.Results[i].Err = common.WrapWorkflowError(workflowID, partialErr.Err)
+		}
+	}
+	err = common.WrapWorkflowError(workflowID, err)
+	return
+}
+
+type accountImporter struct {
+	enterpriseManagement *EnterpriseManagementV1
+	enterpriseID         string
+	options              ImportAccountsOptions
+}
+
+func (importer *accountImporter) setDefaults() {
+	options := &importer.options
+	if options.Concurrency <= 0 {
+		options.Concurrency = DefaultImportAccountsConcurrency
+	}
+	if options.MaxRetries == 0 {
+		options.MaxRetries = DefaultImportAccountsMaxRetries
+	} else if options.MaxRetries < 0 {
+		options.MaxRetries = 0
+	}
+	if options.RetryInterval <= 0 {
+		options.RetryInterval = DefaultImportAccountsRetryInterval
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultImportAccountsPollInterval
+	}
+}
+
+// importAccount imports the account of "result" and waits for the import to complete, recording the outcome in
+// "result".
+func (importer *accountImporter) importAccount(ctx context.Context, result *ImportAccountResult) {
+	result.Account, result.Err = importer.getImportedAccount(ctx, result.AccountID)
+	if result.Err != nil || result.Account != nil {
+		result.AlreadyImported = result.Account != nil
+		return
+	}
+
+	importOptions := importer.enterpriseManagement.NewImportAccountToEnterpriseOptions(importer.enterpriseID, result.AccountID)
+	if importer.options.Parent != "" {
+		importOptions.Parent = core.StringPtr(importer.options.Parent)
+	}
+	if importer.options.BillingUnitID != "" {
+		importOptions.BillingUnitID = core.StringPtr(importer.options.BillingUnitID)
+	}
+	retryInterval := importer.options.RetryInterval
+	for {
+		result.Attempts++
+		response, err := importer.enterpriseManagement.ImportAccountToEnterpriseWithContext(ctx, importOptions)
+		if err == nil {
+			break
+		}
+		if !isTransientEnterpriseResponse(response) || result.Attempts > importer.options.MaxRetries || ctx.Err() != nil {
+			result.Err = fmt.Errorf("error importing account '%s': %w", result.AccountID, err)
+			return
+		}
+		select {
+		case <-ctx.Done():
+			result.Err = ctx.Err()
+			return
+		case <-time.After(retryInterval):
+		}
+		retryInterval *= 2
+	}
+
+	waitCtx := ctx
+	if importer.options.Timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, importer.options.Timeout)
+		defer cancel()
+	}
+	// Only an expired deadline is reported as a timeout; a cancelled context is returned as is.
+	waitError := func() error {
+		if errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("timed out waiting for the import of account '%s' to complete: %w", result.AccountID, waitCtx.Err())
+		}
+		return waitCtx.Err()
+	}
+	for {
+		select {
+		case <-waitCtx.Done():
+			result.Err = waitError()
+			return
+		case <-time.After(importer.options.PollInterval):
+		}
+		result.Account, result.Err = importer.getImportedAccount(waitCtx, result.AccountID)
+		if result.Err != nil && waitCtx.Err() != nil {
+			result.Err = waitError()
+		}
+		if result.Err != nil || result.Account != nil {
+			return
+		}
+	}
+}
+
+// getImportedAccount returns the account if it is active in the enterprise, or nil if it is not (yet) part of it.
+// Transient errors, and the 403 and 404 status codes returned for an account outside the enterprise, are treated as
+// the account not being part of the enterprise, so that the caller tries again.
+func (importer *accountImporter) getImportedAccount(ctx context.Context, accountID string) (account *Account, err error) {
+	account, response, err := importer.enterpriseManagement.GetAccountWithContext(ctx, importer.enterpriseManagement.NewGetAccountOptions(accountID))
+	if err != nil {
+		if ctx.Err() == nil && (isTransientEnterpriseResponse(response) ||
+			response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusForbidden) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error retrieving account '%s': %w", accountID, err)
+	}
+	if core.StringNilMapper(account.EnterpriseID) != importer.enterpriseID ||
+		!strings.EqualFold(core.StringNilMapper(account.State), AccountStateActiveConst) {
+		return nil, nil
+	}
+	return account, nil
+}
+
+// isTransientEnterpriseResponse returns true if a request that failed with "response" may succeed when sent again.
+func isTransientEnterpriseResponse(response *core.DetailedResponse) bool {
+	return response == nil || response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500
+}
@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EnterpriseManagementV1 account imports`, func() {
+	var testServer *httptest.Server
+	var enterpriseManagementService *enterprisemanagementv1.EnterpriseManagementV1
+	var mutex sync.Mutex
+	var imported map[string]bool
+	var importRequests map[string]int
+	BeforeEach(func() {
+		imported = map[string]bool{"existing": true}
+		importRequests = map[string]int{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			path := req.URL.EscapedPath()
+			switch {
+			case req.Method == http.MethodGet && strings.HasPrefix(path, "/accounts/"):
+				accountID := strings.TrimPrefix(path, "/accounts/")
+				if !imported[accountID] {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+					return
+				}
+				fmt.Fprintf(res, `{"id": "%s", "enterprise_id": "ent", "state": "ACTIVE"}`, accountID)
+			case req.Method == http.MethodPut && strings.HasPrefix(path, "/enterprises/ent/import/accounts/"):
+				accountID := strings.TrimPrefix(path, "/enterprises/ent/import/accounts/")
+				importRequests[accountID]++
+				switch {
+				case accountID == "flaky" && importRequests[accountID] == 1:
+					res.WriteHeader(503)
+					fmt.Fprint(res, `{"errors": [{"message": "unavailable"}]}`)
+				case accountID == "invalid":
+					res.WriteHeader(400)
+					fmt.Fprint(res, `{"errors": [{"message": "account cannot be imported"}]}`)
+				default:
+					imported[accountID] = true
+					res.WriteHeader(202)
+				}
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		enterpriseManagementService, serviceErr = enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(enterpriseManagementService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Imports accounts and reports the outcome of each`, func() {
+		var progress []enterprisemanagementv1.ImportAccountsProgress
+		report, err := enterpriseManagementService.ImportAccounts(context.Background(), "ent",
+			[]string{"new", "existing", "flaky", "invalid"}, &enterprisemanagementv1.ImportAccountsOptions{
+				Concurrency:   2,
+				RetryInterval: time.Millisecond,
+				PollInterval:  time.Millisecond,
+				OnProgress: func(p enterprisemanagementv1.ImportAccountsProgress) {
+					progress = append(progress, p)
+				},
+			})
+		Expect(err).To(BeNil())
+		Expect(report.WorkflowID).ToNot(BeEmpty())
+		Expect(report.Succeeded()).To(Equal([]string{"new", "existing", "flaky"}))
+		Expect(report.HasErrors()).To(BeTrue())
+
+		Expect(report.Results[0].Attempts).To(Equal(1))
+		Expect(*report.Results[0].Account.ID).To(Equal("new"))
+		Expect(report.Results[1].AlreadyImported).To(BeTrue())
+		Expect(report.Results[1].Attempts).To(Equal(0))
+		Expect(report.Results[2].Attempts).To(Equal(2))
+		failed := report.Failed()
+		Expect(failed).To(HaveLen(1))
+		Expect(failed[0].AccountID).To(Equal("invalid"))
+		Expect(failed[0].Attempts).To(Equal(1))
+		Expect(failed[0].Err.Error()).To(ContainSubstring("account cannot be imported"))
+		Expect(failed[0].Err.Error()).To(ContainSubstring(report.WorkflowID))
+
+		Expect(progress).To(HaveLen(4))
+		Expect(progress[3].Total).To(Equal(4))
+		Expect(progress[3].Succeeded).To(Equal(3))
+		Expect(progress[3].Failed).To(Equal(1))
+		Expect(importRequests).ToNot(HaveKey("existing"))
+	})
+	It(`Does not retry when retries are disabled`, func() {
+		report, err := enterpriseManagementService.ImportAccounts(context.Background(), "ent", []string{"flaky"},
+			&enterprisemanagementv1.ImportAccountsOptions{MaxRetries: -1})
+		Expect(err).To(BeNil())
+		Expect(report.Failed()).To(HaveLen(1))
+		Expect(importRequests["flaky"]).To(Equal(1))
+	})
+	It(`Returns the partial report when the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		report, err := enterpriseManagementService.ImportAccounts(ctx, "ent", []string{"new"}, nil)
+		Expect(err).To(MatchError(context.Canceled))
+		var partialErr *common.PartialError
+		Expect(errors.As(err, &partialErr)).To(BeTrue())
+		Expect(partialErr.Processed).To(Equal(0))
+		Expect(report.Results).To(HaveLen(1))
+		Expect(report.Results[0].Err).To(MatchError(context.Canceled))
+	})
+	It(`Reports a timeout only when the wait expires`, func() {
+		report, _ := enterpriseManagementService.ImportAccounts(context.Background(), "ent", []string{"new"},
+			&enterprisemanagementv1.ImportAccountsOptions{Timeout: 20 * time.Millisecond, PollInterval: time.Hour})
+		Expect(report.Results[0].Err).To(MatchError(context.DeadlineExceeded))
+		Expect(report.Results[0].Err.Error()).To(ContainSubstring("timed out waiting for the import of account 'new'"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+		report, _ = enterpriseManagementService.ImportAccounts(ctx, "ent", []string{"other"},
+			&enterprisemanagementv1.ImportAccountsOptions{PollInterval: time.Hour})
+		Expect(report.Results[0].Err).To(MatchError(context.Canceled))
+		Expect(report.Results[0].Err.Error()).ToNot(ContainSubstring("timed out"))
+	})
+})
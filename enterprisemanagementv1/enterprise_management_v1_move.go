@@ -0,0 +1,178 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// DefaultAccountMovePollInterval is the interval at which MoveAccount polls the account until its move is reflected in
+// the enterprise hierarchy, if no interval is specified.
+const DefaultAccountMovePollInterval = 2 * time.Second
+
+// Constants associated with the AccountMoveError.Reason property.
+const (
+	AccountMoveReasonAccountNotFoundConst     = "account_not_found"
+	AccountMoveReasonTargetNotFoundConst      = "target_not_found"
+	AccountMoveReasonDifferentEnterpriseConst = "different_enterprise"
+	AccountMoveReasonBillingOwnershipConst    = "billing_ownership"
+	AccountMoveReasonNestingDepthConst        = "nesting_depth"
+	AccountMoveReasonPermissionDeniedConst    = "permission_denied"
+	AccountMoveReasonUnknownConst             = "unknown"
+)
+
+// AccountMoveError : Describes why MoveAccount could not move an account.
+type AccountMoveError struct {
+	// The ID of the account.
+	AccountID string
+
+	// The ID of the target account group.
+	TargetGroupID string
+
+	// The cause of the failure (one of the AccountMoveReason*Const values).
+	Reason string
+
+	// The status code of the failed request, or zero if the failure was detected before a request failed.
+	StatusCode int
+
+	// The error returned by the failed request, if any.
+	Err error
+}
+
+// Error returns a description of the failure.
+func (moveErr *AccountMoveError) Error() string {
+	message := fmt.Sprintf("cannot move account '%s' to account group '%s'", moveErr.AccountID, moveErr.TargetGroupID)
+	switch moveErr.Reason {
+	case AccountMoveReasonAccountNotFoundConst:
+		message += ": the account does not exist"
+	case AccountMoveReasonTargetNotFoundConst:
+		message += ": the account group does not exist"
+	case AccountMoveReasonDifferentEnterpriseConst:
+		message += ": the account and the account group belong to different enterprises"
+	case AccountMoveReasonBillingOwnershipConst:
+		message += ": the billing ownership of the account does not allow the move"
+	case AccountMoveReasonNestingDepthConst:
+		message += ": the move exceeds the maximum nesting depth of the enterprise"
+	case AccountMoveReasonPermissionDeniedConst:
+		message += ": permission denied"
+	}
+	if moveErr.Err != nil {
+		message += ": " + moveErr.Err.Error()
+	}
+	return message
+}
+
+// Unwrap returns the error of the failed request.
+func (moveErr *AccountMoveError) Unwrap() error {
+	return moveErr.Err
+}
+
+// MoveAccount moves an account of an enterprise to the account group with the specified ID and waits until the
+// enterprise hierarchy reflects the move, polling the account every "pollInterval" (DefaultAccountMovePollInterval if
+// zero); use a context with a deadline to limit the wait. The target account group is validated first. Failures with a
+// known cause are returned as an *AccountMoveError. If the account is already in the account group, it is returned
+// without being updated.
+func (enterpriseManagement *EnterpriseManagementV1) MoveAccount(ctx context.Context, accountID string, targetGroupID string, pollInterval time.Duration) (result *Account, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, enterpriseManagement.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+	moveError := func(reason string, response *core.DetailedResponse, requestErr error) error {
+		moveErr := &AccountMoveError{AccountID: accountID, TargetGroupID: targetGroupID, Reason: reason, Err: requestErr}
+		if response != nil {
+			moveErr.StatusCode = response.StatusCode
+		}
+		return moveErr
+	}
+
+	group, response, err := enterpriseManagement.GetAccountGroupWithContext(ctx, enterpriseManagement.NewGetAccountGroupOptions(targetGroupID))
+	if err != nil {
+		err = moveError(accountMoveReason(response, err, AccountMoveReasonTargetNotFoundConst), response, err)
+		return
+	}
+	account, response, err := enterpriseManagement.GetAccountWithContext(ctx, enterpriseManagement.NewGetAccountOptions(accountID))
+	if err != nil {
+		err = moveError(accountMoveReason(response, err, AccountMoveReasonAccountNotFoundConst), response, err)
+		return
+	}
+	if core.StringNilMapper(account.EnterpriseID) != core.StringNilMapper(group.EnterpriseID) {
+		err = moveError(AccountMoveReasonDifferentEnterpriseConst, nil, nil)
+		return
+	}
+	targetCRN := core.StringNilMapper(group.CRN)
+	if core.StringNilMapper(account.Parent) == targetCRN {
+		result = account
+		return
+	}
+
+	response, err = enterpriseManagement.UpdateAccountWithContext(ctx, enterpriseManagement.NewUpdateAccountOptions(accountID, targetCRN))
+	if err != nil {
+		err = moveError(accountMoveReason(response, err, AccountMoveReasonAccountNotFoundConst), response, err)
+		return
+	}
+
+	if pollInterval <= 0 {
+		pollInterval = DefaultAccountMovePollInterval
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			if errors.Is(err, context.DeadlineExceeded) {
+				err = fmt.Errorf("timed out waiting for the move of account '%s' to account group '%s': %w", accountID, targetGroupID, err)
+			}
+			return
+		case <-time.After(pollInterval):
+		}
+		account, response, err = enterpriseManagement.GetAccountWithContext(ctx, enterpriseManagement.NewGetAccountOptions(accountID))
+		if err != nil {
+			if ctx.Err() == nil && isTransientEnterpriseResponse(response) {
+				continue
+			}
+			err = fmt.Errorf("error retrieving account '%s' after its move: %w", accountID, err)
+			return
+		}
+		if core.StringNilMapper(account.Parent) == targetCRN {
+			result = account
+			return
+		}
+	}
+}
+
+// accountMoveReason returns the cause of a failed request, using "notFoundReason" for status code 404.
+func accountMoveReason(response *core.DetailedResponse, err error, notFoundReason string) string {
+	message := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(message, "billing"):
+		return AccountMoveReasonBillingOwnershipConst
+	case strings.Contains(message, "depth") || strings.Contains(message, "nesting"):
+		return AccountMoveReasonNestingDepthConst
+	case response == nil:
+		return AccountMoveReasonUnknownConst
+	case response.StatusCode == http.StatusNotFound:
+		return notFoundReason
+	case response.StatusCode == http.StatusForbidden:
+		return AccountMoveReasonPermissionDeniedConst
+	}
+	return AccountMoveReasonUnknownConst
+}
@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EnterpriseManagementV1 account moves`, func() {
+	var testServer *httptest.Server
+	var enterpriseManagementService *enterprisemanagementv1.EnterpriseManagementV1
+	var mutex sync.Mutex
+	var parent string
+	var pendingPolls int
+	var updateStatus int
+	var updateMessage string
+	var updates int
+	BeforeEach(func() {
+		parent = "crn:ent"
+		pendingPolls = 0
+		updateStatus = 202
+		updateMessage = ""
+		updates = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /account-groups/group":
+				fmt.Fprint(res, `{"id": "group", "crn": "crn:group", "enterprise_id": "ent"}`)
+			case "GET /account-groups/other":
+				fmt.Fprint(res, `{"id": "other", "crn": "crn:other", "enterprise_id": "other-ent"}`)
+			case "GET /account-groups/missing":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"message": "account group not found"}]}`)
+			case "GET /accounts/acc":
+				current := parent
+				if pendingPolls > 0 {
+					pendingPolls--
+					current = "crn:ent"
+				}
+				fmt.Fprintf(res, `{"id": "acc", "enterprise_id": "ent", "parent": "%s"}`, current)
+			case "PATCH /accounts/acc":
+				updates++
+				var body map[string]string
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				res.WriteHeader(updateStatus)
+				if updateStatus >= 400 {
+					fmt.Fprintf(res, `{"errors": [{"message": "%s"}]}`, updateMessage)
+					return
+				}
+				parent = body["parent"]
+				pendingPolls = 2
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		enterpriseManagementService, serviceErr = enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(enterpriseManagementService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	moveReason := func(err error) string {
+		var moveErr *enterprisemanagementv1.AccountMoveError
+		Expect(errors.As(err, &moveErr)).To(BeTrue())
+		return moveErr.Reason
+	}
+
+	It(`Moves an account and waits for the move to be reflected`, func() {
+		account, err := enterpriseManagementService.MoveAccount(context.Background(), "acc", "group", time.Millisecond)
+		Expect(err).To(BeNil())
+		Expect(*account.Parent).To(Equal("crn:group"))
+		Expect(pendingPolls).To(Equal(0))
+
+		account, err = enterpriseManagementService.MoveAccount(context.Background(), "acc", "group", time.Millisecond)
+		Expect(err).To(BeNil())
+		Expect(*account.Parent).To(Equal("crn:group"))
+		Expect(updates).To(Equal(1))
+	})
+	It(`Validates the target account group`, func() {
+		_, err := enterpriseManagementService.MoveAccount(context.Background(), "acc", "missing", time.Millisecond)
+		Expect(moveReason(err)).To(Equal(enterprisemanagementv1.AccountMoveReasonTargetNotFoundConst))
+		_, err = enterpriseManagementService.MoveAccount(context.Background(), "acc", "other", time.Millisecond)
+		Expect(moveReason(err)).To(Equal(enterprisemanagementv1.AccountMoveReasonDifferentEnterpriseConst))
+		Expect(updates).To(Equal(0))
+	})
+	It(`Classifies rejected moves`, func() {
+		updateStatus = 400
+		updateMessage = "The account cannot be moved because its billing is owned by another account"
+		_, err := enterpriseManagementService.MoveAccount(context.Background(), "acc", "group", time.Millisecond)
+		Expect(moveReason(err)).To(Equal(enterprisemanagementv1.AccountMoveReasonBillingOwnershipConst))
+
+		updateMessage = "Maximum depth of the enterprise hierarchy exceeded"
+		_, err = enterpriseManagementService.MoveAccount(context.Background(), "acc", "group", time.Millisecond)
+		Expect(moveReason(err)).To(Equal(enterprisemanagementv1.AccountMoveReasonNestingDepthConst))
+		Expect(err.Error()).To(ContainSubstring("workflow ID"))
+
+		updateStatus = 403
+		updateMessage = "Forbidden"
+		_, err = enterpriseManagementService.MoveAccount(context.Background(), "acc", "group", time.Millisecond)
+		Expect(moveReason(err)).To(Equal(enterprisemanagementv1.AccountMoveReasonPermissionDeniedConst))
+	})
+	It(`Reports a timeout only when the deadline of the context is exceeded`, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := enterpriseManagementService.MoveAccount(ctx, "acc", "group", time.Hour)
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("timed out waiting for the move of account 'acc'"))
+
+		ctx, cancel = context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+		_, err = enterpriseManagementService.MoveAccount(ctx, "acc", "group", time.Hour)
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		Expect(err.Error()).ToNot(ContainSubstring("timed out"))
+	})
+})
@@ -0,0 +1,723 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package enterprisemanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CreateEnterpriseOption : A functional option that sets a property of CreateEnterpriseOptions (see CreateEnterpriseWith).
+type CreateEnterpriseOption func(options *CreateEnterpriseOptions)
+
+// CreateEnterpriseSourceAccountID sets the SourceAccountID property of CreateEnterpriseOptions. The ID of the
+// account that is used to create the enterprise.
+func CreateEnterpriseSourceAccountID(sourceAccountID string) CreateEnterpriseOption {
+	return func(options *CreateEnterpriseOptions) {
+		options.SourceAccountID = core.StringPtr(sourceAccountID)
+	}
+}
+
+// CreateEnterpriseName sets the Name property of CreateEnterpriseOptions. The name of the enterprise.
+func CreateEnterpriseName(nameVar string) CreateEnterpriseOption {
+	return func(options *CreateEnterpriseOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateEnterprisePrimaryContactIamID sets the PrimaryContactIamID property of CreateEnterpriseOptions. The IAM ID
+// of the enterprise primary contact, such as `IBMid-0123ABC`.
+func CreateEnterprisePrimaryContactIamID(primaryContactIamID string) CreateEnterpriseOption {
+	return func(options *CreateEnterpriseOptions) {
+		options.PrimaryContactIamID = core.StringPtr(primaryContactIamID)
+	}
+}
+
+// CreateEnterpriseDomain sets the Domain property of CreateEnterpriseOptions. A domain or subdomain for the
+// enterprise, such as `example.com` or `my.example.com`.
+func CreateEnterpriseDomain(domain string) CreateEnterpriseOption {
+	return func(options *CreateEnterpriseOptions) {
+		options.Domain = core.StringPtr(domain)
+	}
+}
+
+// CreateEnterpriseHeader sets the Headers property of CreateEnterpriseOptions. Allows users to set headers on API
+// requests.
+func CreateEnterpriseHeader(name string, value string) CreateEnterpriseOption {
+	return func(options *CreateEnterpriseOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateEnterpriseOptionsWith returns CreateEnterpriseOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewCreateEnterpriseOptionsWith(options ...CreateEnterpriseOption) *CreateEnterpriseOptions {
+	createEnterpriseOptions := &CreateEnterpriseOptions{}
+	for _, option := range options {
+		option(createEnterpriseOptions)
+	}
+	return createEnterpriseOptions
+}
+
+// CreateEnterpriseWith is an alternate form of the CreateEnterpriseWithContext method which takes functional
+// options instead of a CreateEnterpriseOptions struct. CreateEnterpriseSourceAccountID, CreateEnterpriseName and
+// CreateEnterprisePrimaryContactIamID are required.
+func (enterpriseManagement *EnterpriseManagementV1) CreateEnterpriseWith(ctx context.Context, options ...CreateEnterpriseOption) (result *CreateEnterpriseResponse, response *core.DetailedResponse, err error) {
+	return enterpriseManagement.CreateEnterpriseWithContext(ctx, enterpriseManagement.NewCreateEnterpriseOptionsWith(options...))
+}
+
+// ListEnterprisesOption : A functional option that sets a property of ListEnterprisesOptions (see ListEnterprisesWith).
+type ListEnterprisesOption func(options *ListEnterprisesOptions)
+
+// ListEnterprisesEnterpriseAccountID sets the EnterpriseAccountID property of ListEnterprisesOptions. Get
+// enterprises for a given enterprise account ID.
+func ListEnterprisesEnterpriseAccountID(enterpriseAccountID string) ListEnterprisesOption {
+	return func(options *ListEnterprisesOptions) {
+		options.EnterpriseAccountID = core.StringPtr(enterpriseAccountID)
+	}
+}
+
+// ListEnterprisesAccountGroupID sets the AccountGroupID property of ListEnterprisesOptions. Get enterprises for a
+// given account group ID.
+func ListEnterprisesAccountGroupID(accountGroupID string) ListEnterprisesOption {
+	return func(options *ListEnterprisesOptions) {
+		options.AccountGroupID = core.StringPtr(accountGroupID)
+	}
+}
+
+// ListEnterprisesAccountID sets the AccountID property of ListEnterprisesOptions. Get enterprises for a given
+// account ID.
+func ListEnterprisesAccountID(accountID string) ListEnterprisesOption {
+	return func(options *ListEnterprisesOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListEnterprisesNextDocid sets the NextDocid property of ListEnterprisesOptions. The first item to be returned in
+// the page of results.
+func ListEnterprisesNextDocid(nextDocid string) ListEnterprisesOption {
+	return func(options *ListEnterprisesOptions) {
+		options.NextDocid = core.StringPtr(nextDocid)
+	}
+}
+
+// ListEnterprisesLimit sets the Limit property of ListEnterprisesOptions. Return results up to this limit.
+func ListEnterprisesLimit(limit int64) ListEnterprisesOption {
+	return func(options *ListEnterprisesOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListEnterprisesHeader sets the Headers property of ListEnterprisesOptions. Allows users to set headers on API
+// requests.
+func ListEnterprisesHeader(name string, value string) ListEnterprisesOption {
+	return func(options *ListEnterprisesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListEnterprisesOptionsWith returns ListEnterprisesOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewListEnterprisesOptionsWith(options ...ListEnterprisesOption) *ListEnterprisesOptions {
+	listEnterprisesOptions := &ListEnterprisesOptions{}
+	for _, option := range options {
+		option(listEnterprisesOptions)
+	}
+	return listEnterprisesOptions
+}
+
+// ListEnterprisesWith is an alternate form of the ListEnterprisesWithContext method which takes functional options
+// instead of a ListEnterprisesOptions struct.
+func (enterpriseManagement *EnterpriseManagementV1) ListEnterprisesWith(ctx context.Context, options ...ListEnterprisesOption) (result *ListEnterprisesResponse, response *core.DetailedResponse, err error) {
+	return enterpriseManagement.ListEnterprisesWithContext(ctx, enterpriseManagement.NewListEnterprisesOptionsWith(options...))
+}
+
+// GetEnterpriseOption : A functional option that sets a property of GetEnterpriseOptions (see GetEnterpriseWith).
+type GetEnterpriseOption func(options *GetEnterpriseOptions)
+
+// GetEnterpriseEnterpriseID sets the EnterpriseID property of GetEnterpriseOptions. The ID of the enterprise to
+// retrieve.
+func GetEnterpriseEnterpriseID(enterpriseID string) GetEnterpriseOption {
+	return func(options *GetEnterpriseOptions) {
+		options.EnterpriseID = core.StringPtr(enterpriseID)
+	}
+}
+
+// GetEnterpriseHeader sets the Headers property of GetEnterpriseOptions. Allows users to set headers on API
+// requests.
+func GetEnterpriseHeader(name string, value string) GetEnterpriseOption {
+	return func(options *GetEnterpriseOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetEnterpriseOptionsWith returns GetEnterpriseOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewGetEnterpriseOptionsWith(options ...GetEnterpriseOption) *GetEnterpriseOptions {
+	getEnterpriseOptions := &GetEnterpriseOptions{}
+	for _, option := range options {
+		option(getEnterpriseOptions)
+	}
+	return getEnterpriseOptions
+}
+
+// GetEnterpriseWith is an alternate form of the GetEnterpriseWithContext method which takes functional options
+// instead of a GetEnterpriseOptions struct. GetEnterpriseEnterpriseID is required.
+func (enterpriseManagement *EnterpriseManagementV1) GetEnterpriseWith(ctx context.Context, options ...GetEnterpriseOption) (result *Enterprise, response *core.DetailedResponse, err error) {
+	return enterpriseManagement.GetEnterpriseWithContext(ctx, enterpriseManagement.NewGetEnterpriseOptionsWith(options...))
+}
+
+// UpdateEnterpriseOption : A functional option that sets a property of UpdateEnterpriseOptions (see UpdateEnterpriseWith).
+type UpdateEnterpriseOption func(options *UpdateEnterpriseOptions)
+
+// UpdateEnterpriseEnterpriseID sets the EnterpriseID property of UpdateEnterpriseOptions. The ID of the enterprise
+// to retrieve.
+func UpdateEnterpriseEnterpriseID(enterpriseID string) UpdateEnterpriseOption {
+	return func(options *UpdateEnterpriseOptions) {
+		options.EnterpriseID = core.StringPtr(enterpriseID)
+	}
+}
+
+// UpdateEnterpriseName sets the Name property of UpdateEnterpriseOptions. The new name of the enterprise.
+func UpdateEnterpriseName(nameVar string) UpdateEnterpriseOption {
+	return func(options *UpdateEnterpriseOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateEnterpriseDomain sets the Domain property of UpdateEnterpriseOptions. The new domain of the enterprise.
+func UpdateEnterpriseDomain(domain string) UpdateEnterpriseOption {
+	return func(options *UpdateEnterpriseOptions) {
+		options.Domain = core.StringPtr(domain)
+	}
+}
+
+// UpdateEnterprisePrimaryContactIamID sets the PrimaryContactIamID property of UpdateEnterpriseOptions. The IAM ID
+// of the user to be the new primary contact for the enterprise.
+func UpdateEnterprisePrimaryContactIamID(primaryContactIamID string) UpdateEnterpriseOption {
+	return func(options *UpdateEnterpriseOptions) {
+		options.PrimaryContactIamID = core.StringPtr(primaryContactIamID)
+	}
+}
+
+// UpdateEnterpriseHeader sets the Headers property of UpdateEnterpriseOptions. Allows users to set headers on API
+// requests.
+func UpdateEnterpriseHeader(name string, value string) UpdateEnterpriseOption {
+	return func(options *UpdateEnterpriseOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateEnterpriseOptionsWith returns UpdateEnterpriseOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewUpdateEnterpriseOptionsWith(options ...UpdateEnterpriseOption) *UpdateEnterpriseOptions {
+	updateEnterpriseOptions := &UpdateEnterpriseOptions{}
+	for _, option := range options {
+		option(updateEnterpriseOptions)
+	}
+	return updateEnterpriseOptions
+}
+
+// UpdateEnterpriseWith is an alternate form of the UpdateEnterpriseWithContext method which takes functional
+// options instead of an UpdateEnterpriseOptions struct. UpdateEnterpriseEnterpriseID is required.
+func (enterpriseManagement *EnterpriseManagementV1) UpdateEnterpriseWith(ctx context.Context, options ...UpdateEnterpriseOption) (response *core.DetailedResponse, err error) {
+	return enterpriseManagement.UpdateEnterpriseWithContext(ctx, enterpriseManagement.NewUpdateEnterpriseOptionsWith(options...))
+}
+
+// ImportAccountToEnterpriseOption : A functional option that sets a property of ImportAccountToEnterpriseOptions (see ImportAccountToEnterpriseWith).
+type ImportAccountToEnterpriseOption func(options *ImportAccountToEnterpriseOptions)
+
+// ImportAccountToEnterpriseEnterpriseID sets the EnterpriseID property of ImportAccountToEnterpriseOptions. The ID
+// of the enterprise to import the stand-alone account into.
+func ImportAccountToEnterpriseEnterpriseID(enterpriseID string) ImportAccountToEnterpriseOption {
+	return func(options *ImportAccountToEnterpriseOptions) {
+		options.EnterpriseID = core.StringPtr(enterpriseID)
+	}
+}
+
+// ImportAccountToEnterpriseAccountID sets the AccountID property of ImportAccountToEnterpriseOptions. The ID of
+// the existing stand-alone account to be imported.
+func ImportAccountToEnterpriseAccountID(accountID string) ImportAccountToEnterpriseOption {
+	return func(options *ImportAccountToEnterpriseOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ImportAccountToEnterpriseParent sets the Parent property of ImportAccountToEnterpriseOptions. The CRN of the
+// expected parent of the imported account.
+func ImportAccountToEnterpriseParent(parent string) ImportAccountToEnterpriseOption {
+	return func(options *ImportAccountToEnterpriseOptions) {
+		options.Parent = core.StringPtr(parent)
+	}
+}
+
+// ImportAccountToEnterpriseBillingUnitID sets the BillingUnitID property of ImportAccountToEnterpriseOptions. The
+// ID of the [billing unit](/apidocs/enterprise-apis/billing-unit) to use for billing this account in the
+// enterprise.
+func ImportAccountToEnterpriseBillingUnitID(billingUnitID string) ImportAccountToEnterpriseOption {
+	return func(options *ImportAccountToEnterpriseOptions) {
+		options.BillingUnitID = core.StringPtr(billingUnitID)
+	}
+}
+
+// ImportAccountToEnterpriseHeader sets the Headers property of ImportAccountToEnterpriseOptions. Allows users to
+// set headers on API requests.
+func ImportAccountToEnterpriseHeader(name string, value string) ImportAccountToEnterpriseOption {
+	return func(options *ImportAccountToEnterpriseOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewImportAccountToEnterpriseOptionsWith returns ImportAccountToEnterpriseOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewImportAccountToEnterpriseOptionsWith(options ...ImportAccountToEnterpriseOption) *ImportAccountToEnterpriseOptions {
+	importAccountToEnterpriseOptions := &ImportAccountToEnterpriseOptions{}
+	for _, option := range options {
+		option(importAccountToEnterpriseOptions)
+	}
+	return importAccountToEnterpriseOptions
+}
+
+// ImportAccountToEnterpriseWith is an alternate form of the ImportAccountToEnterpriseWithContext method which
+// takes functional options instead of an ImportAccountToEnterpriseOptions struct.
+// ImportAccountToEnterpriseEnterpriseID and ImportAccountToEnterpriseAccountID are required.
+func (enterpriseManagement *EnterpriseManagementV1) ImportAccountToEnterpriseWith(ctx context.Context, options ...ImportAccountToEnterpriseOption) (response *core.DetailedResponse, err error) {
+	return enterpriseManagement.ImportAccountToEnterpriseWithContext(ctx, enterpriseManagement.NewImportAccountToEnterpriseOptionsWith(options...))
+}
+
+// CreateAccountOption : A functional option that sets a property of CreateAccountOptions (see CreateAccountWith).
+type CreateAccountOption func(options *CreateAccountOptions)
+
+// CreateAccountParent sets the Parent property of CreateAccountOptions. The CRN of the parent under which the
+// account will be created.
+func CreateAccountParent(parent string) CreateAccountOption {
+	return func(options *CreateAccountOptions) {
+		options.Parent = core.StringPtr(parent)
+	}
+}
+
+// CreateAccountName sets the Name property of CreateAccountOptions. The name of the account.
+func CreateAccountName(nameVar string) CreateAccountOption {
+	return func(options *CreateAccountOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateAccountOwnerIamID sets the OwnerIamID property of CreateAccountOptions. The IAM ID of the account owner,
+// such as `IBMid-0123ABC`.
+func CreateAccountOwnerIamID(ownerIamID string) CreateAccountOption {
+	return func(options *CreateAccountOptions) {
+		options.OwnerIamID = core.StringPtr(ownerIamID)
+	}
+}
+
+// CreateAccountHeader sets the Headers property of CreateAccountOptions. Allows users to set headers on API
+// requests.
+func CreateAccountHeader(name string, value string) CreateAccountOption {
+	return func(options *CreateAccountOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateAccountOptionsWith returns CreateAccountOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewCreateAccountOptionsWith(options ...CreateAccountOption) *CreateAccountOptions {
+	createAccountOptions := &CreateAccountOptions{}
+	for _, option := range options {
+		option(createAccountOptions)
+	}
+	return createAccountOptions
+}
+
+// CreateAccountWith is an alternate form of the CreateAccountWithContext method which takes functional options
+// instead of a CreateAccountOptions struct. CreateAccountParent, CreateAccountName and CreateAccountOwnerIamID are
+// required.
+func (enterpriseManagement *EnterpriseManagementV1) CreateAccountWith(ctx context.Context, options ...CreateAccountOption) (result *CreateAccountResponse, response *core.DetailedResponse, err error) {
+	return enterpriseManagement.CreateAccountWithContext(ctx, enterpriseManagement.NewCreateAccountOptionsWith(options...))
+}
+
+// ListAccountsOption : A functional option that sets a property of ListAccountsOptions (see ListAccountsWith).
+type ListAccountsOption func(options *ListAccountsOptions)
+
+// ListAccountsEnterpriseID sets the EnterpriseID property of ListAccountsOptions. Get accounts that are either
+// immediate children or are a part of the hierarchy for a given enterprise ID.
+func ListAccountsEnterpriseID(enterpriseID string) ListAccountsOption {
+	return func(options *ListAccountsOptions) {
+		options.EnterpriseID = core.StringPtr(enterpriseID)
+	}
+}
+
+// ListAccountsAccountGroupID sets the AccountGroupID property of ListAccountsOptions. Get accounts that are either
+// immediate children or are a part of the hierarchy for a given account group ID.
+func ListAccountsAccountGroupID(accountGroupID string) ListAccountsOption {
+	return func(options *ListAccountsOptions) {
+		options.AccountGroupID = core.StringPtr(accountGroupID)
+	}
+}
+
+// ListAccountsNextDocid sets the NextDocid property of ListAccountsOptions. The first item to be returned in the
+// page of results.
+func ListAccountsNextDocid(nextDocid string) ListAccountsOption {
+	return func(options *ListAccountsOptions) {
+		options.NextDocid = core.StringPtr(nextDocid)
+	}
+}
+
+// ListAccountsParent sets the Parent property of ListAccountsOptions. Get accounts that are either immediate
+// children or are a part of the hierarchy for a given parent CRN.
+func ListAccountsParent(parent string) ListAccountsOption {
+	return func(options *ListAccountsOptions) {
+		options.Parent = core.StringPtr(parent)
+	}
+}
+
+// ListAccountsLimit sets the Limit property of ListAccountsOptions. Return results up to this limit.
+func ListAccountsLimit(limit int64) ListAccountsOption {
+	return func(options *ListAccountsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListAccountsHeader sets the Headers property of ListAccountsOptions. Allows users to set headers on API
+// requests.
+func ListAccountsHeader(name string, value string) ListAccountsOption {
+	return func(options *ListAccountsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListAccountsOptionsWith returns ListAccountsOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewListAccountsOptionsWith(options ...ListAccountsOption) *ListAccountsOptions {
+	listAccountsOptions := &ListAccountsOptions{}
+	for _, option := range options {
+		option(listAccountsOptions)
+	}
+	return listAccountsOptions
+}
+
+// ListAccountsWith is an alternate form of the ListAccountsWithContext method which takes functional options
+// instead of a ListAccountsOptions struct.
+func (enterpriseManagement *EnterpriseManagementV1) ListAccountsWith(ctx context.Context, options ...ListAccountsOption) (result *ListAccountsResponse, response *core.DetailedResponse, err error) {
+	return enterpriseManagement.ListAccountsWithContext(ctx, enterpriseManagement.NewListAccountsOptionsWith(options...))
+}
+
+// GetAccountOption : A functional option that sets a property of GetAccountOptions (see GetAccountWith).
+type GetAccountOption func(options *GetAccountOptions)
+
+// GetAccountAccountID sets the AccountID property of GetAccountOptions. The ID of the account to retrieve.
+func GetAccountAccountID(accountID string) GetAccountOption {
+	return func(options *GetAccountOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetAccountHeader sets the Headers property of GetAccountOptions. Allows users to set headers on API requests.
+func GetAccountHeader(name string, value string) GetAccountOption {
+	return func(options *GetAccountOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAccountOptionsWith returns GetAccountOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewGetAccountOptionsWith(options ...GetAccountOption) *GetAccountOptions {
+	getAccountOptions := &GetAccountOptions{}
+	for _, option := range options {
+		option(getAccountOptions)
+	}
+	return getAccountOptions
+}
+
+// GetAccountWith is an alternate form of the GetAccountWithContext method which takes functional options instead
+// of a GetAccountOptions struct. GetAccountAccountID is required.
+func (enterpriseManagement *EnterpriseManagementV1) GetAccountWith(ctx context.Context, options ...GetAccountOption) (result *Account, response *core.DetailedResponse, err error) {
+	return enterpriseManagement.GetAccountWithContext(ctx, enterpriseManagement.NewGetAccountOptionsWith(options...))
+}
+
+// UpdateAccountOption : A functional option that sets a property of UpdateAccountOptions (see UpdateAccountWith).
+type UpdateAccountOption func(options *UpdateAccountOptions)
+
+// UpdateAccountAccountID sets the AccountID property of UpdateAccountOptions. The ID of the account to retrieve.
+func UpdateAccountAccountID(accountID string) UpdateAccountOption {
+	return func(options *UpdateAccountOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// UpdateAccountParent sets the Parent property of UpdateAccountOptions. The CRN of the new parent within the
+// enterprise.
+func UpdateAccountParent(parent string) UpdateAccountOption {
+	return func(options *UpdateAccountOptions) {
+		options.Parent = core.StringPtr(parent)
+	}
+}
+
+// UpdateAccountHeader sets the Headers property of UpdateAccountOptions. Allows users to set headers on API
+// requests.
+func UpdateAccountHeader(name string, value string) UpdateAccountOption {
+	return func(options *UpdateAccountOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateAccountOptionsWith returns UpdateAccountOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewUpdateAccountOptionsWith(options ...UpdateAccountOption) *UpdateAccountOptions {
+	updateAccountOptions := &UpdateAccountOptions{}
+	for _, option := range options {
+		option(updateAccountOptions)
+	}
+	return updateAccountOptions
+}
+
+// UpdateAccountWith is an alternate form of the UpdateAccountWithContext method which takes functional options
+// instead of an UpdateAccountOptions struct. UpdateAccountAccountID and UpdateAccountParent are required.
+func (enterpriseManagement *EnterpriseManagementV1) UpdateAccountWith(ctx context.Context, options ...UpdateAccountOption) (response *core.DetailedResponse, err error) {
+	return enterpriseManagement.UpdateAccountWithContext(ctx, enterpriseManagement.NewUpdateAccountOptionsWith(options...))
+}
+
+// CreateAccountGroupOption : A functional option that sets a property of CreateAccountGroupOptions (see CreateAccountGroupWith).
+type CreateAccountGroupOption func(options *CreateAccountGroupOptions)
+
+// CreateAccountGroupParent sets the Parent property of CreateAccountGroupOptions. The CRN of the parent under
+// which the account group will be created.
+func CreateAccountGroupParent(parent string) CreateAccountGroupOption {
+	return func(options *CreateAccountGroupOptions) {
+		options.Parent = core.StringPtr(parent)
+	}
+}
+
+// CreateAccountGroupName sets the Name property of CreateAccountGroupOptions. The name of the account group.
+func CreateAccountGroupName(nameVar string) CreateAccountGroupOption {
+	return func(options *CreateAccountGroupOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateAccountGroupPrimaryContactIamID sets the PrimaryContactIamID property of CreateAccountGroupOptions. The
+// IAM ID of the primary contact for this account group, such as `IBMid-0123ABC`.
+func CreateAccountGroupPrimaryContactIamID(primaryContactIamID string) CreateAccountGroupOption {
+	return func(options *CreateAccountGroupOptions) {
+		options.PrimaryContactIamID = core.StringPtr(primaryContactIamID)
+	}
+}
+
+// CreateAccountGroupHeader sets the Headers property of CreateAccountGroupOptions. Allows users to set headers on
+// API requests.
+func CreateAccountGroupHeader(name string, value string) CreateAccountGroupOption {
+	return func(options *CreateAccountGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateAccountGroupOptionsWith returns CreateAccountGroupOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewCreateAccountGroupOptionsWith(options ...CreateAccountGroupOption) *CreateAccountGroupOptions {
+	createAccountGroupOptions := &CreateAccountGroupOptions{}
+	for _, option := range options {
+		option(createAccountGroupOptions)
+	}
+	return createAccountGroupOptions
+}
+
+// CreateAccountGroupWith is an alternate form of the CreateAccountGroupWithContext method which takes functional
+// options instead of a CreateAccountGroupOptions struct. CreateAccountGroupParent, CreateAccountGroupName and
+// CreateAccountGroupPrimaryContactIamID are required.
+func (enterpriseManagement *EnterpriseManagementV1) CreateAccountGroupWith(ctx context.Context, options ...CreateAccountGroupOption) (result *CreateAccountGroupResponse, response *core.DetailedResponse, err error) {
+	return enterpriseManagement.CreateAccountGroupWithContext(ctx, enterpriseManagement.NewCreateAccountGroupOptionsWith(options...))
+}
+
+// ListAccountGroupsOption : A functional option that sets a property of ListAccountGroupsOptions (see ListAccountGroupsWith).
+type ListAccountGroupsOption func(options *ListAccountGroupsOptions)
+
+// ListAccountGroupsEnterpriseID sets the EnterpriseID property of ListAccountGroupsOptions. Get account groups
+// that are either immediate children or are a part of the hierarchy for a given enterprise ID.
+func ListAccountGroupsEnterpriseID(enterpriseID string) ListAccountGroupsOption {
+	return func(options *ListAccountGroupsOptions) {
+		options.EnterpriseID = core.StringPtr(enterpriseID)
+	}
+}
+
+// ListAccountGroupsParentAccountGroupID sets the ParentAccountGroupID property of ListAccountGroupsOptions. Get
+// account groups that are either immediate children or are a part of the hierarchy for a given account group ID.
+func ListAccountGroupsParentAccountGroupID(parentAccountGroupID string) ListAccountGroupsOption {
+	return func(options *ListAccountGroupsOptions) {
+		options.ParentAccountGroupID = core.StringPtr(parentAccountGroupID)
+	}
+}
+
+// ListAccountGroupsNextDocid sets the NextDocid property of ListAccountGroupsOptions. The first item to be
+// returned in the page of results.
+func ListAccountGroupsNextDocid(nextDocid string) ListAccountGroupsOption {
+	return func(options *ListAccountGroupsOptions) {
+		options.NextDocid = core.StringPtr(nextDocid)
+	}
+}
+
+// ListAccountGroupsParent sets the Parent property of ListAccountGroupsOptions. Get account groups that are either
+// immediate children or are a part of the hierarchy for a given parent CRN.
+func ListAccountGroupsParent(parent string) ListAccountGroupsOption {
+	return func(options *ListAccountGroupsOptions) {
+		options.Parent = core.StringPtr(parent)
+	}
+}
+
+// ListAccountGroupsLimit sets the Limit property of ListAccountGroupsOptions. Return results up to this limit.
+func ListAccountGroupsLimit(limit int64) ListAccountGroupsOption {
+	return func(options *ListAccountGroupsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListAccountGroupsHeader sets the Headers property of ListAccountGroupsOptions. Allows users to set headers on
+// API requests.
+func ListAccountGroupsHeader(name string, value string) ListAccountGroupsOption {
+	return func(options *ListAccountGroupsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListAccountGroupsOptionsWith returns ListAccountGroupsOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewListAccountGroupsOptionsWith(options ...ListAccountGroupsOption) *ListAccountGroupsOptions {
+	listAccountGroupsOptions := &ListAccountGroupsOptions{}
+	for _, option := range options {
+		option(listAccountGroupsOptions)
+	}
+	return listAccountGroupsOptions
+}
+
+// ListAccountGroupsWith is an alternate form of the ListAccountGroupsWithContext method which takes functional
+// options instead of a ListAccountGroupsOptions struct.
+func (enterpriseManagement *EnterpriseManagementV1) ListAccountGroupsWith(ctx context.Context, options ...ListAccountGroupsOption) (result *ListAccountGroupsResponse, response *core.DetailedResponse, err error) {
+	return enterpriseManagement.ListAccountGroupsWithContext(ctx, enterpriseManagement.NewListAccountGroupsOptionsWith(options...))
+}
+
+// GetAccountGroupOption : A functional option that sets a property of GetAccountGroupOptions (see GetAccountGroupWith).
+type GetAccountGroupOption func(options *GetAccountGroupOptions)
+
+// GetAccountGroupAccountGroupID sets the AccountGroupID property of GetAccountGroupOptions. The ID of the account
+// group to retrieve.
+func GetAccountGroupAccountGroupID(accountGroupID string) GetAccountGroupOption {
+	return func(options *GetAccountGroupOptions) {
+		options.AccountGroupID = core.StringPtr(accountGroupID)
+	}
+}
+
+// GetAccountGroupHeader sets the Headers property of GetAccountGroupOptions. Allows users to set headers on API
+// requests.
+func GetAccountGroupHeader(name string, value string) GetAccountGroupOption {
+	return func(options *GetAccountGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAccountGroupOptionsWith returns GetAccountGroupOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewGetAccountGroupOptionsWith(options ...GetAccountGroupOption) *GetAccountGroupOptions {
+	getAccountGroupOptions := &GetAccountGroupOptions{}
+	for _, option := range options {
+		option(getAccountGroupOptions)
+	}
+	return getAccountGroupOptions
+}
+
+// GetAccountGroupWith is an alternate form of the GetAccountGroupWithContext method which takes functional options
+// instead of a GetAccountGroupOptions struct. GetAccountGroupAccountGroupID is required.
+func (enterpriseManagement *EnterpriseManagementV1) GetAccountGroupWith(ctx context.Context, options ...GetAccountGroupOption) (result *AccountGroup, response *core.DetailedResponse, err error) {
+	return enterpriseManagement.GetAccountGroupWithContext(ctx, enterpriseManagement.NewGetAccountGroupOptionsWith(options...))
+}
+
+// UpdateAccountGroupOption : A functional option that sets a property of UpdateAccountGroupOptions (see UpdateAccountGroupWith).
+type UpdateAccountGroupOption func(options *UpdateAccountGroupOptions)
+
+// UpdateAccountGroupAccountGroupID sets the AccountGroupID property of UpdateAccountGroupOptions. The ID of the
+// account group to retrieve.
+func UpdateAccountGroupAccountGroupID(accountGroupID string) UpdateAccountGroupOption {
+	return func(options *UpdateAccountGroupOptions) {
+		options.AccountGroupID = core.StringPtr(accountGroupID)
+	}
+}
+
+// UpdateAccountGroupName sets the Name property of UpdateAccountGroupOptions. The new name of the account group.
+func UpdateAccountGroupName(nameVar string) UpdateAccountGroupOption {
+	return func(options *UpdateAccountGroupOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateAccountGroupPrimaryContactIamID sets the PrimaryContactIamID property of UpdateAccountGroupOptions. The
+// IAM ID of the user to be the new primary contact for the account group.
+func UpdateAccountGroupPrimaryContactIamID(primaryContactIamID string) UpdateAccountGroupOption {
+	return func(options *UpdateAccountGroupOptions) {
+		options.PrimaryContactIamID = core.StringPtr(primaryContactIamID)
+	}
+}
+
+// UpdateAccountGroupHeader sets the Headers property of UpdateAccountGroupOptions. Allows users to set headers on
+// API requests.
+func UpdateAccountGroupHeader(name string, value string) UpdateAccountGroupOption {
+	return func(options *UpdateAccountGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateAccountGroupOptionsWith returns UpdateAccountGroupOptions populated by applying "options" in order.
+func (*EnterpriseManagementV1) NewUpdateAccountGroupOptionsWith(options ...UpdateAccountGroupOption) *UpdateAccountGroupOptions {
+	updateAccountGroupOptions := &UpdateAccountGroupOptions{}
+	for _, option := range options {
+		option(updateAccountGroupOptions)
+	}
+	return updateAccountGroupOptions
+}
+
+// UpdateAccountGroupWith is an alternate form of the UpdateAccountGroupWithContext method which takes functional
+// options instead of an UpdateAccountGroupOptions struct. UpdateAccountGroupAccountGroupID is required.
+func (enterpriseManagement *EnterpriseManagementV1) UpdateAccountGroupWith(ctx context.Context, options ...UpdateAccountGroupOption) (response *core.DetailedResponse, err error) {
+	return enterpriseManagement.UpdateAccountGroupWithContext(ctx, enterpriseManagement.NewUpdateAccountGroupOptionsWith(options...))
+}
@@ -0,0 +1,196 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The page size used to list the account groups and accounts of a parent.
+const enterpriseWalkPageSize = 100
+
+// SkipAccountGroup can be returned by an EnterpriseVisitFunc called for an account group to skip the account groups
+// and accounts that it contains. It is not returned as an error by WalkEnterprise.
+var SkipAccountGroup = errors.New("skip this account group")
+
+// EnterpriseNode : An account group or an account visited by WalkEnterprise. Exactly one of AccountGroup and Account
+// is set.
+type EnterpriseNode struct {
+	// The account group, if the node is an account group.
+	AccountGroup *AccountGroup
+
+	// The account, if the node is an account.
+	Account *Account
+
+	// The account groups that contain the node, from the top of the enterprise down. It is empty for the direct
+	// children of the enterprise.
+	Ancestors []*AccountGroup
+}
+
+// IsAccountGroup returns true if the node is an account group.
+func (node *EnterpriseNode) IsAccountGroup() bool {
+	return node.AccountGroup != nil
+}
+
+// Depth returns the number of account groups that contain the node.
+func (node *EnterpriseNode) Depth() int {
+	return len(node.Ancestors)
+}
+
+// ID returns the ID of the account group or account.
+func (node *EnterpriseNode) ID() string {
+	if node.AccountGroup != nil {
+		return core.StringNilMapper(node.AccountGroup.ID)
+	}
+	return core.StringNilMapper(node.Account.ID)
+}
+
+// Name returns the name of the account group or account.
+func (node *EnterpriseNode) Name() string {
+	if node.AccountGroup != nil {
+		return core.StringNilMapper(node.AccountGroup.Name)
+	}
+	return core.StringNilMapper(node.Account.Name)
+}
+
+// EnterpriseVisitFunc is called by WalkEnterprise for each account group and account. If it returns an error, the
+// walk stops and the error is returned, except for SkipAccountGroup.
+type EnterpriseVisitFunc func(node *EnterpriseNode) error
+
+// WalkEnterprise visits the account groups and accounts of an enterprise depth-first: each account group is visited
+// before the account groups and accounts that it contains, and at each level the account groups are visited (with
+// their contents) before the accounts. The children of each parent are listed one page at a time as the walk reaches
+// them. The node passed to "visitFunc" must not be retained after it returns, except for its AccountGroup and Account.
+func (enterpriseManagement *EnterpriseManagementV1) WalkEnterprise(ctx context.Context, enterpriseID string, visitFunc EnterpriseVisitFunc) (err error) {
+	err = core.ValidateNotNil(visitFunc, "visitFunc cannot be nil")
+	if err != nil {
+		return
+	}
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, enterpriseManagement.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	enterprise, _, err := enterpriseManagement.GetEnterpriseWithContext(ctx, enterpriseManagement.NewGetEnterpriseOptions(enterpriseID))
+	if err != nil {
+		err = fmt.Errorf("error retrieving enterprise '%s': %w", enterpriseID, err)
+		return
+	}
+	if enterprise.CRN == nil {
+		err = fmt.Errorf("enterprise '%s' has no CRN", enterpriseID)
+		return
+	}
+	walker := &enterpriseWalker{
+		enterpriseManagement: enterpriseManagement,
+		visitFunc:            visitFunc,
+		visited:              map[string]bool{},
+	}
+	return walker.walk(ctx, *enterprise.CRN, nil)
+}
+
+type enterpriseWalker struct {
+	enterpriseManagement *EnterpriseManagementV1
+	visitFunc            EnterpriseVisitFunc
+
+	// The CRNs of the account groups visited, which guard against a cycle in inconsistent data.
+	visited map[string]bool
+}
+
+// walk visits the children of the parent with the specified CRN.
+func (walker *enterpriseWalker) walk(ctx context.Context, parentCRN string, ancestors []*AccountGroup) (err error) {
+	enterpriseManagement := walker.enterpriseManagement
+
+	groupsOptions := enterpriseManagement.NewListAccountGroupsOptions()
+	groupsOptions.Parent = core.StringPtr(parentCRN)
+	groupsOptions.Limit = core.Int64Ptr(enterpriseWalkPageSize)
+	for {
+		var groups *ListAccountGroupsResponse
+		groups, _, err = enterpriseManagement.ListAccountGroupsWithContext(ctx, groupsOptions)
+		if err != nil {
+			err = fmt.Errorf("error listing the account groups of '%s': %w", parentCRN, err)
+			return
+		}
+		for i := range groups.Resources {
+			group := &groups.Resources[i]
+			groupCRN := core.StringNilMapper(group.CRN)
+			if walker.visited[groupCRN] {
+				continue
+			}
+			walker.visited[groupCRN] = true
+
+			visitErr := walker.visitFunc(&EnterpriseNode{AccountGroup: group, Ancestors: ancestors})
+			if visitErr == SkipAccountGroup {
+				continue
+			}
+			if visitErr != nil {
+				return visitErr
+			}
+			if groupCRN == "" {
+				continue
+			}
+			children := append(append([]*AccountGroup(nil), ancestors...), group)
+			err = walker.walk(ctx, groupCRN, children)
+			if err != nil {
+				return
+			}
+		}
+		groupsOptions.NextDocid, err = nextDocID(groups.NextURL)
+		if err != nil || groupsOptions.NextDocid == nil {
+			break
+		}
+	}
+	if err != nil {
+		return
+	}
+
+	accountsOptions := enterpriseManagement.NewListAccountsOptions()
+	accountsOptions.Parent = core.StringPtr(parentCRN)
+	accountsOptions.Limit = core.Int64Ptr(enterpriseWalkPageSize)
+	for {
+		var accounts *ListAccountsResponse
+		accounts, _, err = enterpriseManagement.ListAccountsWithContext(ctx, accountsOptions)
+		if err != nil {
+			err = fmt.Errorf("error listing the accounts of '%s': %w", parentCRN, err)
+			return
+		}
+		for i := range accounts.Resources {
+			visitErr := walker.visitFunc(&EnterpriseNode{Account: &accounts.Resources[i], Ancestors: ancestors})
+			if visitErr != nil && visitErr != SkipAccountGroup {
+				return visitErr
+			}
+		}
+		accountsOptions.NextDocid, err = nextDocID(accounts.NextURL)
+		if err != nil || accountsOptions.NextDocid == nil {
+			return
+		}
+	}
+}
+
+// nextDocID returns the "next_docid" query parameter of the URL of the next page, or nil if there is no next page.
+func nextDocID(nextURL *string) (*string, error) {
+	if nextURL == nil || *nextURL == "" {
+		return nil, nil
+	}
+	docID, err := core.GetQueryParam(nextURL, "next_docid")
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the URL of the next page: %w", err)
+	}
+	return docID, nil
+}
@@ -0,0 +1,145 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EnterpriseManagementV1 enterprise walk`, func() {
+	var testServer *httptest.Server
+	var enterpriseManagementService *enterprisemanagementv1.EnterpriseManagementV1
+	BeforeEach(func() {
+		// The enterprise contains the groups "a" (which contains the group "b" and the account "a1") and "c", and the
+		// account "root1". The groups of the enterprise and the accounts of "a" are returned in two pages.
+		groups := map[string][]string{
+			"crn:ent": {`{"id": "a", "crn": "crn:a", "name": "A"}`, `{"id": "c", "crn": "crn:c", "name": "C"}`},
+			"crn:a":   {`{"id": "b", "crn": "crn:b", "name": "B"}`},
+		}
+		accounts := map[string][]string{
+			"crn:ent": {`{"id": "root1", "name": "Root 1"}`},
+			"crn:a":   {`{"id": "a1", "name": "A 1"}`, `{"id": "a2", "name": "A 2"}`},
+			"crn:b":   {`{"id": "b1", "name": "B 1"}`},
+		}
+		page := func(res http.ResponseWriter, req *http.Request, path string, resources []string) {
+			if len(resources) > 1 && req.URL.Query().Get("next_docid") == "" {
+				fmt.Fprintf(res, `{"rows_count": 1, "next_url": "%s?next_docid=2", "resources": [%s]}`, path, resources[0])
+				return
+			}
+			if len(resources) > 1 {
+				resources = resources[1:]
+			}
+			fmt.Fprintf(res, `{"rows_count": %d, "resources": [%s]}`, len(resources), strings.Join(resources, ","))
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			parent := req.URL.Query().Get("parent")
+			switch req.URL.EscapedPath() {
+			case "/enterprises/ent":
+				fmt.Fprint(res, `{"id": "ent", "crn": "crn:ent", "name": "Enterprise"}`)
+			case "/enterprises/missing":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+			case "/account-groups":
+				Expect(req.URL.Query().Get("limit")).To(Equal("100"))
+				page(res, req, "/account-groups", groups[parent])
+			case "/accounts":
+				page(res, req, "/accounts", accounts[parent])
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		enterpriseManagementService, serviceErr = enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	walk := func(visit func(node *enterprisemanagementv1.EnterpriseNode) error) (visited []string, err error) {
+		err = enterpriseManagementService.WalkEnterprise(context.Background(), "ent", func(node *enterprisemanagementv1.EnterpriseNode) error {
+			kind := "account"
+			if node.IsAccountGroup() {
+				kind = "group"
+			}
+			visited = append(visited, fmt.Sprintf("%d %s %s", node.Depth(), kind, node.ID()))
+			if visit != nil {
+				return visit(node)
+			}
+			return nil
+		})
+		return
+	}
+
+	It(`Visits the hierarchy depth-first across pages`, func() {
+		visited, err := walk(nil)
+		Expect(err).To(BeNil())
+		Expect(visited).To(Equal([]string{
+			"0 group a",
+			"1 group b",
+			"2 account b1",
+			"1 account a1",
+			"1 account a2",
+			"0 group c",
+			"0 account root1",
+		}))
+	})
+	It(`Skips the contents of an account group`, func() {
+		visited, err := walk(func(node *enterprisemanagementv1.EnterpriseNode) error {
+			if node.ID() == "a" {
+				return enterprisemanagementv1.SkipAccountGroup
+			}
+			return nil
+		})
+		Expect(err).To(BeNil())
+		Expect(visited).To(Equal([]string{"0 group a", "0 group c", "0 account root1"}))
+	})
+	It(`Stops at the first error`, func() {
+		stop := errors.New("stop")
+		visited, err := walk(func(node *enterprisemanagementv1.EnterpriseNode) error {
+			if node.ID() == "b1" {
+				Expect(node.Ancestors).To(HaveLen(2))
+				Expect(*node.Ancestors[0].Name).To(Equal("A"))
+				return stop
+			}
+			return nil
+		})
+		Expect(errors.Is(err, stop)).To(BeTrue())
+		Expect(visited).To(HaveLen(3))
+
+		err = enterpriseManagementService.WalkEnterprise(context.Background(), "missing", func(*enterprisemanagementv1.EnterpriseNode) error {
+			return nil
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,87 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package enterpriseusagereportsv1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// EnterpriseUsageReportsV1API : The operations of the EnterpriseUsageReportsV1 service, implemented by *EnterpriseUsageReportsV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type EnterpriseUsageReportsV1API interface {
+	// Clone makes a copy of "enterpriseUsageReports" suitable for processing requests.
+	Clone() *EnterpriseUsageReportsV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// GetResourceUsageReport : Get usage reports for enterprise entities
+	GetResourceUsageReport(getResourceUsageReportOptions *GetResourceUsageReportOptions) (result *Reports, response *core.DetailedResponse, err error)
+
+	// GetResourceUsageReportWithContext is an alternate form of the GetResourceUsageReport method which supports a
+	// Context parameter
+	GetResourceUsageReportWithContext(ctx context.Context, getResourceUsageReportOptions *GetResourceUsageReportOptions) (result *Reports, response *core.DetailedResponse, err error)
+
+	// NewGetResourceUsageReportOptions : Instantiate GetResourceUsageReportOptions
+	NewGetResourceUsageReportOptions() *GetResourceUsageReportOptions
+
+	// ExportUsageRange writes the resource usage of the enterprise and its immediate child entities (account groups
+	// and accounts) for each month from "fromMonth" through "toMonth" (inclusive, in "yyyy-mm" format) to "w".
+	ExportUsageRange(ctx context.Context, enterpriseID string, fromMonth string, toMonth string, w io.Writer, format string) (err error)
+
+	// NewGetResourceUsageReportOptionsWith returns GetResourceUsageReportOptions populated by applying "options" in
+	// order.
+	NewGetResourceUsageReportOptionsWith(options ...GetResourceUsageReportOption) *GetResourceUsageReportOptions
+
+	// GetResourceUsageReportWith is an alternate form of the GetResourceUsageReportWithContext method which takes
+	// functional options instead of a GetResourceUsageReportOptions struct.
+	GetResourceUsageReportWith(ctx context.Context, options ...GetResourceUsageReportOption) (result *Reports, response *core.DetailedResponse, err error)
+
+	// GetUsageTree retrieves the usage reports of an enterprise and of all its account groups and accounts for a month
+	// ("yyyy-mm"), and rolls the costs of the accounts up the hierarchy.
+	GetUsageTree(ctx context.Context, enterpriseID string, month string, concurrency int) (result *UsageTree, err error)
+}
+
+var _ EnterpriseUsageReportsV1API = (*EnterpriseUsageReportsV1)(nil)
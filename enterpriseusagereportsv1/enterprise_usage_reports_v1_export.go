@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterpriseusagereportsv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// UsageExportColumns are the columns written by ExportUsageRange, in order.
+// Each row describes the usage of one metric of one plan of one resource, for one entity and month.
+var UsageExportColumns = []common.Column{
+	{Name: "month", Type: common.ColumnTypeString},
+	{Name: "entity_id", Type: common.ColumnTypeString},
+	{Name: "entity_type", Type: common.ColumnTypeString},
+	{Name: "entity_crn", Type: common.ColumnTypeString},
+	{Name: "entity_name", Type: common.ColumnTypeString},
+	{Name: "billing_unit_id", Type: common.ColumnTypeString},
+	{Name: "currency_code", Type: common.ColumnTypeString},
+	{Name: "resource_id", Type: common.ColumnTypeString},
+	{Name: "plan_id", Type: common.ColumnTypeString},
+	{Name: "pricing_region", Type: common.ColumnTypeString},
+	{Name: "metric", Type: common.ColumnTypeString},
+	{Name: "unit", Type: common.ColumnTypeString},
+	{Name: "quantity", Type: common.ColumnTypeDouble},
+	{Name: "rateable_quantity", Type: common.ColumnTypeDouble},
+	{Name: "cost", Type: common.ColumnTypeDouble},
+	{Name: "rated_cost", Type: common.ColumnTypeDouble},
+}
+
+// ExportUsageRange writes the resource usage of the enterprise and its immediate child entities (account groups and
+// accounts) for each month from "fromMonth" through "toMonth" (inclusive, in "yyyy-mm" format) to "w".
+// The output format is one of common.ExportFormatCSV, common.ExportFormatNDJSON or common.ExportFormatParquet,
+// and the columns are described by UsageExportColumns.
+// Reports are fetched one page at a time and written as they are received.
+func (enterpriseUsageReports *EnterpriseUsageReportsV1) ExportUsageRange(ctx context.Context, enterpriseID string, fromMonth string, toMonth string, w io.Writer, format string) (err error) {
+	months, err := common.MonthRange(fromMonth, toMonth)
+	if err != nil {
+		return
+	}
+
+	tableWriter, err := common.NewTableWriter(w, format, UsageExportColumns)
+	if err != nil {
+		return
+	}
+
+	for _, month := range months {
+		options := &GetResourceUsageReportOptions{
+			EnterpriseID: core.StringPtr(enterpriseID),
+			Children:     core.BoolPtr(true),
+			Month:        core.StringPtr(month),
+		}
+		err = enterpriseUsageReports.forEachUsageReport(ctx, options, func(report *ResourceUsageReport) error {
+			return writeUsageReportRows(tableWriter, report)
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	return tableWriter.Close()
+}
+
+// forEachUsageReport invokes "visit" for every report returned by GetResourceUsageReport, following the "next"
+// links until all pages have been retrieved.
+func (enterpriseUsageReports *EnterpriseUsageReportsV1) forEachUsageReport(ctx context.Context, options *GetResourceUsageReportOptions, visit func(*ResourceUsageReport) error) (err error) {
+	pageOptions := *options
+	for {
+		var result *Reports
+		result, _, err = enterpriseUsageReports.GetResourceUsageReportWithContext(ctx, &pageOptions)
+		if err != nil {
+			return
+		}
+		for i := range result.Reports {
+			err = visit(&result.Reports[i])
+			if err != nil {
+				return
+			}
+		}
+
+		if result.Next == nil {
+			return
+		}
+		var offset *string
+		offset, err = core.GetQueryParam(result.Next.Href, "offset")
+		if err != nil || offset == nil {
+			return
+		}
+		pageOptions.Offset = offset
+	}
+}
+
+func writeUsageReportRows(tableWriter common.TableWriter, report *ResourceUsageReport) error {
+	for _, resource := range report.Resources {
+		for _, plan := range resource.Plans {
+			for _, metric := range plan.Usage {
+				err := tableWriter.WriteRow(
+					report.Month, report.EntityID, report.EntityType, report.EntityCRN, report.EntityName,
+					report.BillingUnitID, report.CurrencyCode,
+					resource.ResourceID, plan.PlanID, plan.PricingRegion,
+					metric.Metric, metric.Unit, metric.Quantity, metric.RateableQuantity, metric.Cost, metric.RatedCost,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
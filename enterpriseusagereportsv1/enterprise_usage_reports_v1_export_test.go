@@ -0,0 +1,104 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterpriseusagereportsv1_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EnterpriseUsageReportsV1 ExportUsageRange`, func() {
+	var testServer *httptest.Server
+	var requestedMonths []string
+
+	reportJSON := func(month string, entityID string) string {
+		return fmt.Sprintf(`{"entity_id": "%s", "entity_type": "account", "entity_crn": "crn-%s", "entity_name": "Name", "billing_unit_id": "BU", "billing_unit_crn": "BUCRN", "billing_unit_name": "BUName", "country_code": "USA", "currency_code": "USD", "month": "%s", "billable_cost": 1, "non_billable_cost": 0, "billable_rated_cost": 1, "non_billable_rated_cost": 0, "resources": [{"resource_id": "cloud-object-storage", "billable_cost": 1, "billable_rated_cost": 1, "non_billable_cost": 0, "non_billable_rated_cost": 0, "plans": [{"plan_id": "lite", "pricing_region": "us-south", "billable": true, "cost": 1, "rated_cost": 1, "usage": [{"metric": "STORAGE", "unit": "GB", "quantity": 10, "rateable_quantity": 10, "cost": 0.5, "rated_cost": 0.5}, {"metric": "REQUESTS", "unit": "COUNT", "quantity": 100, "rateable_quantity": 100, "cost": 0.5, "rated_cost": 0.5}]}]}]}`, entityID, entityID, month)
+	}
+
+	BeforeEach(func() {
+		requestedMonths = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v1/resource-usage-reports"))
+			Expect(req.URL.Query().Get("enterprise_id")).To(Equal("ent"))
+			Expect(req.URL.Query().Get("children")).To(Equal("true"))
+			month := req.URL.Query().Get("month")
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			if req.URL.Query().Get("offset") == "" {
+				requestedMonths = append(requestedMonths, month)
+				fmt.Fprintf(res, `{"limit": 1, "next": {"href": "/v1/resource-usage-reports?offset=2"}, "reports": [%s]}`, reportJSON(month, "acct1"))
+			} else {
+				fmt.Fprintf(res, `{"limit": 1, "reports": [%s]}`, reportJSON(month, "acct2"))
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *enterpriseusagereportsv1.EnterpriseUsageReportsV1 {
+		enterpriseUsageReportsService, serviceErr := enterpriseusagereportsv1.NewEnterpriseUsageReportsV1(&enterpriseusagereportsv1.EnterpriseUsageReportsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return enterpriseUsageReportsService
+	}
+
+	It(`Exports every month and page as CSV`, func() {
+		buffer := new(bytes.Buffer)
+		err := newService().ExportUsageRange(context.Background(), "ent", "2022-11", "2023-01", buffer, common.ExportFormatCSV)
+		Expect(err).To(BeNil())
+		Expect(requestedMonths).To(Equal([]string{"2022-11", "2022-12", "2023-01"}))
+
+		lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+		// header + 3 months * 2 pages * 2 metrics
+		Expect(lines).To(HaveLen(13))
+		Expect(lines[0]).To(HavePrefix("month,entity_id,entity_type"))
+		Expect(lines[1]).To(Equal("2022-11,acct1,account,crn-acct1,Name,BU,USD,cloud-object-storage,lite,us-south,STORAGE,GB,10,10,0.5,0.5"))
+		Expect(lines[3]).To(HavePrefix("2022-11,acct2,"))
+	})
+	It(`Exports as Parquet`, func() {
+		buffer := new(bytes.Buffer)
+		err := newService().ExportUsageRange(context.Background(), "ent", "2022-11", "2022-11", buffer, common.ExportFormatParquet)
+		Expect(err).To(BeNil())
+		Expect(buffer.Len()).To(BeNumerically(">", 8))
+		Expect(buffer.String()).To(HavePrefix("PAR1"))
+		Expect(buffer.String()).To(HaveSuffix("PAR1"))
+	})
+	It(`Rejects invalid arguments`, func() {
+		buffer := new(bytes.Buffer)
+		err := newService().ExportUsageRange(context.Background(), "ent", "2023-01", "2022-11", buffer, common.ExportFormatCSV)
+		Expect(err).ToNot(BeNil())
+		err = newService().ExportUsageRange(context.Background(), "ent", "2022-11", "2022-12", buffer, "xlsx")
+		Expect(err).ToNot(BeNil())
+		Expect(requestedMonths).To(BeEmpty())
+	})
+})
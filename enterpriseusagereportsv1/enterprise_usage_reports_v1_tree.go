@@ -0,0 +1,256 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterpriseusagereportsv1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// DefaultUsageTreeConcurrency is the number of account groups whose children GetUsageTree retrieves concurrently
+// when no concurrency is specified.
+const DefaultUsageTreeConcurrency = 4
+
+// UsageTree : The usage of an enterprise for one month, as a tree of its account groups and accounts (see
+// GetUsageTree).
+type UsageTree struct {
+	// The billing month, in "yyyy-mm" format.
+	Month string
+
+	// The enterprise.
+	Root *UsageTreeNode
+
+	// The workflow ID sent with every request for the tree (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// UsageTreeNode : The enterprise, an account group or an account in a UsageTree.
+type UsageTreeNode struct {
+	// The ID of the entity.
+	EntityID string
+
+	// The entity type (one of the ResourceUsageReportEntityType*Const values).
+	EntityType string
+
+	// The CRN of the entity.
+	EntityCRN string
+
+	// The name of the entity.
+	EntityName string
+
+	// The reports of the entity, one for each billing unit that it was charged to.
+	Reports []*ResourceUsageReport
+
+	// The costs of the entity: for an account, the sum of its reports; for the enterprise or an account group, the sum
+	// of the totals of its children.
+	Totals UsageTotals
+
+	// The account groups and accounts that the enterprise or account group contains, in the order of the reports.
+	Children []*UsageTreeNode
+}
+
+// UsageTotals : The costs of an entity.
+type UsageTotals struct {
+	// Billable charges.
+	BillableCost float64
+
+	// Non-billable charges.
+	NonBillableCost float64
+
+	// Billable charges before discounts.
+	BillableRatedCost float64
+
+	// Non-billable charges before discounts.
+	NonBillableRatedCost float64
+}
+
+// Add adds "other" to the totals.
+func (totals *UsageTotals) Add(other UsageTotals) {
+	totals.BillableCost += other.BillableCost
+	totals.NonBillableCost += other.NonBillableCost
+	totals.BillableRatedCost += other.BillableRatedCost
+	totals.NonBillableRatedCost += other.NonBillableRatedCost
+}
+
+// addReport adds the costs of a report to the totals.
+func (totals *UsageTotals) addReport(report *ResourceUsageReport) {
+	totals.Add(UsageTotals{
+		BillableCost:         floatNilMapper(report.BillableCost),
+		NonBillableCost:      floatNilMapper(report.NonBillableCost),
+		BillableRatedCost:    floatNilMapper(report.BillableRatedCost),
+		NonBillableRatedCost: floatNilMapper(report.NonBillableRatedCost),
+	})
+}
+
+// Walk calls "visit" for the node and each of its descendants, parents before their children. The depth of the node
+// is zero.
+func (node *UsageTreeNode) Walk(visit func(node *UsageTreeNode, depth int)) {
+	node.walk(visit, 0)
+}
+
+func (node *UsageTreeNode) walk(visit func(node *UsageTreeNode, depth int), depth int) {
+	visit(node, depth)
+	for _, child := range node.Children {
+		child.walk(visit, depth+1)
+	}
+}
+
+// GetUsageTree retrieves the usage reports of an enterprise and of all its account groups and accounts for a month
+// ("yyyy-mm"), and rolls the costs of the accounts up the hierarchy. The children of up to "concurrency" account
+// groups are retrieved concurrently (DefaultUsageTreeConcurrency if "concurrency" is not positive).
+func (enterpriseUsageReports *EnterpriseUsageReportsV1) GetUsageTree(ctx context.Context, enterpriseID string, month string, concurrency int) (result *UsageTree, err error) {
+	if concurrency <= 0 {
+		concurrency = DefaultUsageTreeConcurrency
+	}
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, enterpriseUsageReports.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	root := &UsageTreeNode{EntityID: enterpriseID, EntityType: ResourceUsageReportEntityTypeEnterpriseConst}
+	rootOptions := &GetResourceUsageReportOptions{EnterpriseID: core.StringPtr(enterpriseID), Month: core.StringPtr(month)}
+	err = enterpriseUsageReports.forEachUsageReport(ctx, rootOptions, func(report *ResourceUsageReport) error {
+		root.addReport(report)
+		return nil
+	})
+	if err != nil {
+		err = fmt.Errorf("error retrieving the usage report of enterprise '%s': %w", enterpriseID, err)
+		return
+	}
+
+	builder := &usageTreeBuilder{
+		enterpriseUsageReports: enterpriseUsageReports,
+		month:                  month,
+		semaphore:              make(chan struct{}, concurrency),
+	}
+	ctx, builder.cancel = context.WithCancel(ctx)
+	defer builder.cancel()
+	builder.waitGroup.Add(1)
+	go builder.addChildren(ctx, root)
+	builder.waitGroup.Wait()
+	if builder.err != nil {
+		err = builder.err
+		return
+	}
+
+	rollUpUsage(root)
+	result = &UsageTree{Month: month, Root: root, WorkflowID: workflowID}
+	return
+}
+
+type usageTreeBuilder struct {
+	enterpriseUsageReports *EnterpriseUsageReportsV1
+	month                  string
+	semaphore              chan struct{}
+	waitGroup              sync.WaitGroup
+	cancel                 context.CancelFunc
+
+	mutex sync.Mutex
+	err   error
+}
+
+// addChildren retrieves the children of the enterprise or account group "node", then retrieves the children of its
+// account groups concurrently. The semaphore is only held while retrieving, so that waiting for the children of
+// nested account groups cannot exhaust it.
+func (builder *usageTreeBuilder) addChildren(ctx context.Context, node *UsageTreeNode) {
+	defer builder.waitGroup.Done()
+	select {
+	case builder.semaphore <- struct{}{}:
+	case <-ctx.Done():
+		builder.fail(ctx.Err())
+		return
+	}
+
+	options := &GetResourceUsageReportOptions{Children: core.BoolPtr(true), Month: core.StringPtr(builder.month)}
+	if node.EntityType == ResourceUsageReportEntityTypeEnterpriseConst {
+		options.EnterpriseID = core.StringPtr(node.EntityID)
+	} else {
+		options.AccountGroupID = core.StringPtr(node.EntityID)
+	}
+	children := make(map[string]*UsageTreeNode)
+	err := builder.enterpriseUsageReports.forEachUsageReport(ctx, options, func(report *ResourceUsageReport) error {
+		entityID := core.StringNilMapper(report.EntityID)
+		child, found := children[entityID]
+		if !found {
+			child = &UsageTreeNode{EntityID: entityID}
+			children[entityID] = child
+			node.Children = append(node.Children, child)
+		}
+		child.addReport(report)
+		return nil
+	})
+	<-builder.semaphore
+	if err != nil {
+		builder.fail(fmt.Errorf("error retrieving the usage reports of the children of %s '%s': %w", node.EntityType, node.EntityID, err))
+		return
+	}
+
+	for _, child := range node.Children {
+		if child.EntityType == ResourceUsageReportEntityTypeAccountGroupConst {
+			builder.waitGroup.Add(1)
+			go builder.addChildren(ctx, child)
+		}
+	}
+}
+
+// fail records the first error and stops the retrieval of the other children.
+func (builder *usageTreeBuilder) fail(err error) {
+	builder.mutex.Lock()
+	defer builder.mutex.Unlock()
+	if builder.err == nil {
+		builder.err = err
+		builder.cancel()
+	}
+}
+
+// addReport adds a report of the entity to the node.
+func (node *UsageTreeNode) addReport(report *ResourceUsageReport) {
+	node.Reports = append(node.Reports, report)
+	if node.EntityType == "" {
+		node.EntityType = core.StringNilMapper(report.EntityType)
+	}
+	if node.EntityCRN == "" {
+		node.EntityCRN = core.StringNilMapper(report.EntityCRN)
+	}
+	if node.EntityName == "" {
+		node.EntityName = core.StringNilMapper(report.EntityName)
+	}
+}
+
+// rollUpUsage computes the totals of "node" and its descendants.
+func rollUpUsage(node *UsageTreeNode) {
+	node.Totals = UsageTotals{}
+	if node.EntityType == ResourceUsageReportEntityTypeAccountConst {
+		for _, report := range node.Reports {
+			node.Totals.addReport(report)
+		}
+		return
+	}
+	for _, child := range node.Children {
+		rollUpUsage(child)
+		node.Totals.Add(child.Totals)
+	}
+}
+
+func floatNilMapper(value *float64) float64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
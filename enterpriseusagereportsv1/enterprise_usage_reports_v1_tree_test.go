@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterpriseusagereportsv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EnterpriseUsageReportsV1 GetUsageTree`, func() {
+	var testServer *httptest.Server
+	var enterpriseUsageReportsService *enterpriseusagereportsv1.EnterpriseUsageReportsV1
+	BeforeEach(func() {
+		report := func(entityType string, entityID string, billingUnitID string, cost float64) string {
+			return fmt.Sprintf(`{"entity_id": "%s", "entity_type": "%s", "entity_crn": "crn:%s", "entity_name": "Name %s",
+				"billing_unit_id": "%s", "currency_code": "USD", "month": "2022-10", "billable_cost": %g, "non_billable_cost": 1,
+				"billable_rated_cost": %g, "non_billable_rated_cost": 1, "resources": []}`,
+				entityID, entityType, entityID, entityID, billingUnitID, cost, cost*2)
+		}
+		// The enterprise contains the account group "g1" (which contains the account "a1" and the account group "g2",
+		// which contains the account "a2") and the account "a3", which is charged to two billing units.
+		reports := map[string][]string{
+			"enterprise_id=ent":            {report("enterprise", "ent", "bu1", 999)},
+			"enterprise_id=ent&children":   {report("account-group", "g1", "bu1", 0), report("account", "a3", "bu1", 5), report("account", "a3", "bu2", 7)},
+			"account_group_id=g1&children": {report("account", "a1", "bu1", 10), report("account-group", "g2", "bu1", 0)},
+			"account_group_id=g2&children": {report("account", "a2", "bu1", 20)},
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v1/resource-usage-reports"))
+			query := req.URL.Query()
+			Expect(query.Get("month")).To(Equal("2022-10"))
+			key := "enterprise_id=" + query.Get("enterprise_id")
+			if query.Get("account_group_id") != "" {
+				key = "account_group_id=" + query.Get("account_group_id")
+			}
+			if query.Get("children") == "true" {
+				key += "&children"
+			}
+			found, ok := reports[key]
+			Expect(ok).To(BeTrue(), key)
+
+			res.Header().Set("Content-type", "application/json")
+			if len(found) > 1 && query.Get("offset") == "" {
+				fmt.Fprintf(res, `{"limit": 1, "next": {"href": "/v1/resource-usage-reports?offset=1"}, "reports": [%s]}`, found[0])
+				return
+			}
+			if len(found) > 1 {
+				found = found[1:]
+			}
+			fmt.Fprintf(res, `{"limit": 10, "reports": [%s]}`, strings.Join(found, ","))
+		}))
+		var serviceErr error
+		enterpriseUsageReportsService, serviceErr = enterpriseusagereportsv1.NewEnterpriseUsageReportsV1(&enterpriseusagereportsv1.EnterpriseUsageReportsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(enterpriseUsageReportsService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Builds the hierarchy and rolls up the costs`, func() {
+		tree, err := enterpriseUsageReportsService.GetUsageTree(context.Background(), "ent", "2022-10", 1)
+		Expect(err).To(BeNil())
+		Expect(tree.WorkflowID).ToNot(BeEmpty())
+		Expect(tree.Month).To(Equal("2022-10"))
+
+		var visited []string
+		tree.Root.Walk(func(node *enterpriseusagereportsv1.UsageTreeNode, depth int) {
+			visited = append(visited, fmt.Sprintf("%d %s %s %g", depth, node.EntityType, node.EntityID, node.Totals.BillableCost))
+		})
+		Expect(visited).To(Equal([]string{
+			"0 enterprise ent 42",
+			"1 account-group g1 30",
+			"2 account a1 10",
+			"2 account-group g2 20",
+			"3 account a2 20",
+			"1 account a3 12",
+		}))
+
+		root := tree.Root
+		Expect(root.EntityName).To(Equal("Name ent"))
+		Expect(root.Reports).To(HaveLen(1))
+		Expect(root.Totals.BillableRatedCost).To(Equal(84.0))
+		Expect(root.Totals.NonBillableCost).To(Equal(4.0))
+		Expect(root.Children[1].Reports).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,348 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package globalcatalogv1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// GlobalCatalogV1API : The operations of the GlobalCatalogV1 service, implemented by *GlobalCatalogV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type GlobalCatalogV1API interface {
+	// Clone makes a copy of "globalCatalog" suitable for processing requests.
+	Clone() *GlobalCatalogV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// ListCatalogEntries : Returns parent catalog entries
+	ListCatalogEntries(listCatalogEntriesOptions *ListCatalogEntriesOptions) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+
+	// ListCatalogEntriesWithContext is an alternate form of the ListCatalogEntries method which supports a Context
+	// parameter
+	ListCatalogEntriesWithContext(ctx context.Context, listCatalogEntriesOptions *ListCatalogEntriesOptions) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+
+	// CreateCatalogEntry : Create a catalog entry
+	CreateCatalogEntry(createCatalogEntryOptions *CreateCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// CreateCatalogEntryWithContext is an alternate form of the CreateCatalogEntry method which supports a Context
+	// parameter
+	CreateCatalogEntryWithContext(ctx context.Context, createCatalogEntryOptions *CreateCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// GetCatalogEntry : Get a specific catalog object
+	GetCatalogEntry(getCatalogEntryOptions *GetCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// GetCatalogEntryWithContext is an alternate form of the GetCatalogEntry method which supports a Context parameter
+	GetCatalogEntryWithContext(ctx context.Context, getCatalogEntryOptions *GetCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// UpdateCatalogEntry : Update a catalog entry
+	UpdateCatalogEntry(updateCatalogEntryOptions *UpdateCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// UpdateCatalogEntryWithContext is an alternate form of the UpdateCatalogEntry method which supports a Context
+	// parameter
+	UpdateCatalogEntryWithContext(ctx context.Context, updateCatalogEntryOptions *UpdateCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// DeleteCatalogEntry : Delete a catalog entry
+	DeleteCatalogEntry(deleteCatalogEntryOptions *DeleteCatalogEntryOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteCatalogEntryWithContext is an alternate form of the DeleteCatalogEntry method which supports a Context
+	// parameter
+	DeleteCatalogEntryWithContext(ctx context.Context, deleteCatalogEntryOptions *DeleteCatalogEntryOptions) (response *core.DetailedResponse, err error)
+
+	// GetChildObjects : Get child catalog entries of a specific kind
+	GetChildObjects(getChildObjectsOptions *GetChildObjectsOptions) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+
+	// GetChildObjectsWithContext is an alternate form of the GetChildObjects method which supports a Context parameter
+	GetChildObjectsWithContext(ctx context.Context, getChildObjectsOptions *GetChildObjectsOptions) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+
+	// RestoreCatalogEntry : Restore archived catalog entry
+	RestoreCatalogEntry(restoreCatalogEntryOptions *RestoreCatalogEntryOptions) (response *core.DetailedResponse, err error)
+
+	// RestoreCatalogEntryWithContext is an alternate form of the RestoreCatalogEntry method which supports a Context
+	// parameter
+	RestoreCatalogEntryWithContext(ctx context.Context, restoreCatalogEntryOptions *RestoreCatalogEntryOptions) (response *core.DetailedResponse, err error)
+
+	// GetVisibility : Get the visibility constraints for an object
+	GetVisibility(getVisibilityOptions *GetVisibilityOptions) (result *Visibility, response *core.DetailedResponse, err error)
+
+	// GetVisibilityWithContext is an alternate form of the GetVisibility method which supports a Context parameter
+	GetVisibilityWithContext(ctx context.Context, getVisibilityOptions *GetVisibilityOptions) (result *Visibility, response *core.DetailedResponse, err error)
+
+	// UpdateVisibility : Update visibility
+	UpdateVisibility(updateVisibilityOptions *UpdateVisibilityOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateVisibilityWithContext is an alternate form of the UpdateVisibility method which supports a Context
+	// parameter
+	UpdateVisibilityWithContext(ctx context.Context, updateVisibilityOptions *UpdateVisibilityOptions) (response *core.DetailedResponse, err error)
+
+	// GetPricing : Get the pricing for an object
+	GetPricing(getPricingOptions *GetPricingOptions) (result *PricingGet, response *core.DetailedResponse, err error)
+
+	// GetPricingWithContext is an alternate form of the GetPricing method which supports a Context parameter
+	GetPricingWithContext(ctx context.Context, getPricingOptions *GetPricingOptions) (result *PricingGet, response *core.DetailedResponse, err error)
+
+	// GetAuditLogs : Get the audit logs for an object
+	GetAuditLogs(getAuditLogsOptions *GetAuditLogsOptions) (result *AuditSearchResult, response *core.DetailedResponse, err error)
+
+	// GetAuditLogsWithContext is an alternate form of the GetAuditLogs method which supports a Context parameter
+	GetAuditLogsWithContext(ctx context.Context, getAuditLogsOptions *GetAuditLogsOptions) (result *AuditSearchResult, response *core.DetailedResponse, err error)
+
+	// ListArtifacts : Get artifacts
+	ListArtifacts(listArtifactsOptions *ListArtifactsOptions) (result *Artifacts, response *core.DetailedResponse, err error)
+
+	// ListArtifactsWithContext is an alternate form of the ListArtifacts method which supports a Context parameter
+	ListArtifactsWithContext(ctx context.Context, listArtifactsOptions *ListArtifactsOptions) (result *Artifacts, response *core.DetailedResponse, err error)
+
+	// GetArtifact : Get artifact
+	GetArtifact(getArtifactOptions *GetArtifactOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// GetArtifactWithContext is an alternate form of the GetArtifact method which supports a Context parameter
+	GetArtifactWithContext(ctx context.Context, getArtifactOptions *GetArtifactOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// UploadArtifact : Upload artifact
+	UploadArtifact(uploadArtifactOptions *UploadArtifactOptions) (response *core.DetailedResponse, err error)
+
+	// UploadArtifactWithContext is an alternate form of the UploadArtifact method which supports a Context parameter
+	UploadArtifactWithContext(ctx context.Context, uploadArtifactOptions *UploadArtifactOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteArtifact : Delete artifact
+	DeleteArtifact(deleteArtifactOptions *DeleteArtifactOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteArtifactWithContext is an alternate form of the DeleteArtifact method which supports a Context parameter
+	DeleteArtifactWithContext(ctx context.Context, deleteArtifactOptions *DeleteArtifactOptions) (response *core.DetailedResponse, err error)
+
+	// NewCreateCatalogEntryOptions : Instantiate CreateCatalogEntryOptions
+	NewCreateCatalogEntryOptions(name string, kind string, overviewUI map[string]Overview, images *Image, disabled bool, tags []string, provider *Provider, id string) *CreateCatalogEntryOptions
+
+	// NewDeleteArtifactOptions : Instantiate DeleteArtifactOptions
+	NewDeleteArtifactOptions(objectID string, artifactID string) *DeleteArtifactOptions
+
+	// NewDeleteCatalogEntryOptions : Instantiate DeleteCatalogEntryOptions
+	NewDeleteCatalogEntryOptions(id string) *DeleteCatalogEntryOptions
+
+	// NewGetArtifactOptions : Instantiate GetArtifactOptions
+	NewGetArtifactOptions(objectID string, artifactID string) *GetArtifactOptions
+
+	// NewGetAuditLogsOptions : Instantiate GetAuditLogsOptions
+	NewGetAuditLogsOptions(id string) *GetAuditLogsOptions
+
+	// NewGetCatalogEntryOptions : Instantiate GetCatalogEntryOptions
+	NewGetCatalogEntryOptions(id string) *GetCatalogEntryOptions
+
+	// NewGetChildObjectsOptions : Instantiate GetChildObjectsOptions
+	NewGetChildObjectsOptions(id string, kind string) *GetChildObjectsOptions
+
+	// NewGetPricingOptions : Instantiate GetPricingOptions
+	NewGetPricingOptions(id string) *GetPricingOptions
+
+	// NewGetVisibilityOptions : Instantiate GetVisibilityOptions
+	NewGetVisibilityOptions(id string) *GetVisibilityOptions
+
+	// NewImage : Instantiate Image (Generic Model Constructor)
+	NewImage(image string) (model *Image, err error)
+
+	// NewListArtifactsOptions : Instantiate ListArtifactsOptions
+	NewListArtifactsOptions(objectID string) *ListArtifactsOptions
+
+	// NewListCatalogEntriesOptions : Instantiate ListCatalogEntriesOptions
+	NewListCatalogEntriesOptions() *ListCatalogEntriesOptions
+
+	// NewOverview : Instantiate Overview (Generic Model Constructor)
+	NewOverview(displayName string, longDescription string, description string) (model *Overview, err error)
+
+	// NewProvider : Instantiate Provider (Generic Model Constructor)
+	NewProvider(email string, name string) (model *Provider, err error)
+
+	// NewRestoreCatalogEntryOptions : Instantiate RestoreCatalogEntryOptions
+	NewRestoreCatalogEntryOptions(id string) *RestoreCatalogEntryOptions
+
+	// NewUpdateCatalogEntryOptions : Instantiate UpdateCatalogEntryOptions
+	NewUpdateCatalogEntryOptions(id string, name string, kind string, overviewUI map[string]Overview, images *Image, disabled bool, tags []string, provider *Provider) *UpdateCatalogEntryOptions
+
+	// NewUpdateVisibilityOptions : Instantiate UpdateVisibilityOptions
+	NewUpdateVisibilityOptions(id string) *UpdateVisibilityOptions
+
+	// NewUploadArtifactOptions : Instantiate UploadArtifactOptions
+	NewUploadArtifactOptions(objectID string, artifactID string) *UploadArtifactOptions
+
+	// NewVisibilityDetail : Instantiate VisibilityDetail (Generic Model Constructor)
+	NewVisibilityDetail(accounts *VisibilityDetailAccounts) (model *VisibilityDetail, err error)
+
+	// ApplyCatalogEntry makes the catalog entry identified by desiredEntry.ID match "desiredEntry": it creates the
+	// entry if it does not exist, updates it if any field set in "desiredEntry" differs from the current entry, and
+	// otherwise leaves it unchanged.
+	ApplyCatalogEntry(ctx context.Context, desiredEntry *CreateCatalogEntryOptions, retries int) (result *CatalogEntryApplyResult, err error)
+
+	// PlanCatalogEntry is a dry run of ApplyCatalogEntry: it returns the action that ApplyCatalogEntry would take and
+	// the changes it would make, without modifying the catalog.
+	PlanCatalogEntry(ctx context.Context, desiredEntry *CreateCatalogEntryOptions) (result *CatalogEntryApplyResult, err error)
+
+	// UploadArtifactStream uploads an artifact from "artifact" without reading it into memory: it is sent with chunked
+	// transfer encoding as it is read, and its size and SHA-256 digest are computed on the way.
+	UploadArtifactStream(ctx context.Context, objectID string, artifactID string, contentType string, artifact io.Reader) (result *ArtifactTransfer, err error)
+
+	// DownloadArtifactStream downloads an artifact into "w" without reading it into memory, and checks its size
+	// against the Content-Length of the response and, if "expectedSHA256" is not empty, its SHA-256 digest (in
+	// hexadecimal) against "expectedSHA256".
+	DownloadArtifactStream(ctx context.Context, objectID string, artifactID string, w io.Writer, expectedSHA256 string) (result *ArtifactTransfer, err error)
+
+	// NewListCatalogEntriesOptionsWith returns ListCatalogEntriesOptions populated by applying "options" in order.
+	NewListCatalogEntriesOptionsWith(options ...ListCatalogEntriesOption) *ListCatalogEntriesOptions
+
+	// ListCatalogEntriesWith is an alternate form of the ListCatalogEntriesWithContext method which takes functional
+	// options instead of a ListCatalogEntriesOptions struct.
+	ListCatalogEntriesWith(ctx context.Context, options ...ListCatalogEntriesOption) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+
+	// NewCreateCatalogEntryOptionsWith returns CreateCatalogEntryOptions populated by applying "options" in order.
+	NewCreateCatalogEntryOptionsWith(options ...CreateCatalogEntryOption) *CreateCatalogEntryOptions
+
+	// CreateCatalogEntryWith is an alternate form of the CreateCatalogEntryWithContext method which takes functional
+	// options instead of a CreateCatalogEntryOptions struct.
+	CreateCatalogEntryWith(ctx context.Context, options ...CreateCatalogEntryOption) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// NewGetCatalogEntryOptionsWith returns GetCatalogEntryOptions populated by applying "options" in order.
+	NewGetCatalogEntryOptionsWith(options ...GetCatalogEntryOption) *GetCatalogEntryOptions
+
+	// GetCatalogEntryWith is an alternate form of the GetCatalogEntryWithContext method which takes functional options
+	// instead of a GetCatalogEntryOptions struct.
+	GetCatalogEntryWith(ctx context.Context, options ...GetCatalogEntryOption) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// NewUpdateCatalogEntryOptionsWith returns UpdateCatalogEntryOptions populated by applying "options" in order.
+	NewUpdateCatalogEntryOptionsWith(options ...UpdateCatalogEntryOption) *UpdateCatalogEntryOptions
+
+	// UpdateCatalogEntryWith is an alternate form of the UpdateCatalogEntryWithContext method which takes functional
+	// options instead of an UpdateCatalogEntryOptions struct.
+	UpdateCatalogEntryWith(ctx context.Context, options ...UpdateCatalogEntryOption) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// NewDeleteCatalogEntryOptionsWith returns DeleteCatalogEntryOptions populated by applying "options" in order.
+	NewDeleteCatalogEntryOptionsWith(options ...DeleteCatalogEntryOption) *DeleteCatalogEntryOptions
+
+	// DeleteCatalogEntryWith is an alternate form of the DeleteCatalogEntryWithContext method which takes functional
+	// options instead of a DeleteCatalogEntryOptions struct.
+	DeleteCatalogEntryWith(ctx context.Context, options ...DeleteCatalogEntryOption) (response *core.DetailedResponse, err error)
+
+	// NewGetChildObjectsOptionsWith returns GetChildObjectsOptions populated by applying "options" in order.
+	NewGetChildObjectsOptionsWith(options ...GetChildObjectsOption) *GetChildObjectsOptions
+
+	// GetChildObjectsWith is an alternate form of the GetChildObjectsWithContext method which takes functional options
+	// instead of a GetChildObjectsOptions struct.
+	GetChildObjectsWith(ctx context.Context, options ...GetChildObjectsOption) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+
+	// NewRestoreCatalogEntryOptionsWith returns RestoreCatalogEntryOptions populated by applying "options" in order.
+	NewRestoreCatalogEntryOptionsWith(options ...RestoreCatalogEntryOption) *RestoreCatalogEntryOptions
+
+	// RestoreCatalogEntryWith is an alternate form of the RestoreCatalogEntryWithContext method which takes functional
+	// options instead of a RestoreCatalogEntryOptions struct.
+	RestoreCatalogEntryWith(ctx context.Context, options ...RestoreCatalogEntryOption) (response *core.DetailedResponse, err error)
+
+	// NewGetVisibilityOptionsWith returns GetVisibilityOptions populated by applying "options" in order.
+	NewGetVisibilityOptionsWith(options ...GetVisibilityOption) *GetVisibilityOptions
+
+	// GetVisibilityWith is an alternate form of the GetVisibilityWithContext method which takes functional options
+	// instead of a GetVisibilityOptions struct.
+	GetVisibilityWith(ctx context.Context, options ...GetVisibilityOption) (result *Visibility, response *core.DetailedResponse, err error)
+
+	// NewUpdateVisibilityOptionsWith returns UpdateVisibilityOptions populated by applying "options" in order.
+	NewUpdateVisibilityOptionsWith(options ...UpdateVisibilityOption) *UpdateVisibilityOptions
+
+	// UpdateVisibilityWith is an alternate form of the UpdateVisibilityWithContext method which takes functional
+	// options instead of an UpdateVisibilityOptions struct.
+	UpdateVisibilityWith(ctx context.Context, options ...UpdateVisibilityOption) (response *core.DetailedResponse, err error)
+
+	// NewGetPricingOptionsWith returns GetPricingOptions populated by applying "options" in order.
+	NewGetPricingOptionsWith(options ...GetPricingOption) *GetPricingOptions
+
+	// GetPricingWith is an alternate form of the GetPricingWithContext method which takes functional options instead
+	// of a GetPricingOptions struct.
+	GetPricingWith(ctx context.Context, options ...GetPricingOption) (result *PricingGet, response *core.DetailedResponse, err error)
+
+	// NewGetAuditLogsOptionsWith returns GetAuditLogsOptions populated by applying "options" in order.
+	NewGetAuditLogsOptionsWith(options ...GetAuditLogsOption) *GetAuditLogsOptions
+
+	// GetAuditLogsWith is an alternate form of the GetAuditLogsWithContext method which takes functional options
+	// instead of a GetAuditLogsOptions struct.
+	GetAuditLogsWith(ctx context.Context, options ...GetAuditLogsOption) (result *AuditSearchResult, response *core.DetailedResponse, err error)
+
+	// NewListArtifactsOptionsWith returns ListArtifactsOptions populated by applying "options" in order.
+	NewListArtifactsOptionsWith(options ...ListArtifactsOption) *ListArtifactsOptions
+
+	// ListArtifactsWith is an alternate form of the ListArtifactsWithContext method which takes functional options
+	// instead of a ListArtifactsOptions struct.
+	ListArtifactsWith(ctx context.Context, options ...ListArtifactsOption) (result *Artifacts, response *core.DetailedResponse, err error)
+
+	// NewGetArtifactOptionsWith returns GetArtifactOptions populated by applying "options" in order.
+	NewGetArtifactOptionsWith(options ...GetArtifactOption) *GetArtifactOptions
+
+	// GetArtifactWith is an alternate form of the GetArtifactWithContext method which takes functional options instead
+	// of a GetArtifactOptions struct.
+	GetArtifactWith(ctx context.Context, options ...GetArtifactOption) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// NewUploadArtifactOptionsWith returns UploadArtifactOptions populated by applying "options" in order.
+	NewUploadArtifactOptionsWith(options ...UploadArtifactOption) *UploadArtifactOptions
+
+	// UploadArtifactWith is an alternate form of the UploadArtifactWithContext method which takes functional options
+	// instead of an UploadArtifactOptions struct.
+	UploadArtifactWith(ctx context.Context, options ...UploadArtifactOption) (response *core.DetailedResponse, err error)
+
+	// NewDeleteArtifactOptionsWith returns DeleteArtifactOptions populated by applying "options" in order.
+	NewDeleteArtifactOptionsWith(options ...DeleteArtifactOption) *DeleteArtifactOptions
+
+	// DeleteArtifactWith is an alternate form of the DeleteArtifactWithContext method which takes functional options
+	// instead of a DeleteArtifactOptions struct.
+	DeleteArtifactWith(ctx context.Context, options ...DeleteArtifactOption) (response *core.DetailedResponse, err error)
+
+	// GetPlanPricing retrieves the pricing of a plan (see GetPricing) and converts it into a PlanPricing.
+	GetPlanPricing(ctx context.Context, planID string) (result *PlanPricing, err error)
+
+	// GetPriceFor returns the price of "quantity" units of a metric of a plan in a region, such as the estimated
+	// monthly cost of 500 GB of storage.
+	GetPriceFor(ctx context.Context, planID string, metric string, region string, quantity float64) (result *PriceQuote, err error)
+
+	// GetCatalogTree retrieves a catalog entry and its descendants down to "depth" levels (all levels if "depth" is
+	// negative), for example a service with its plans and their deployments for a depth of 2.
+	GetCatalogTree(ctx context.Context, rootID string, depth int, concurrency int) (result *CatalogTreeNode, err error)
+}
+
+var _ GlobalCatalogV1API = (*GlobalCatalogV1)(nil)
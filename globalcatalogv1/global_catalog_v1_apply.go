@@ -0,0 +1,292 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the CatalogEntryApplyResult.Action property.
+const (
+	CatalogEntryApplyActionCreateConst = "create"
+	CatalogEntryApplyActionNoneConst   = "none"
+	CatalogEntryApplyActionUpdateConst = "update"
+)
+
+// DefaultApplyCatalogEntryRetries is the number of retries that callers of ApplyCatalogEntry can pass for the default
+// behavior.
+const DefaultApplyCatalogEntryRetries = 3
+
+// CatalogEntryChange : A field of a catalog entry whose current value differs from its desired value.
+type CatalogEntryChange struct {
+	// The path of the field, using the JSON property names (e.g. "metadata.pricing.url").
+	Field string
+
+	// The current value of the field decoded from JSON, or nil if the field is not set.
+	Current interface{}
+
+	// The desired value of the field decoded from JSON.
+	Desired interface{}
+}
+
+// CatalogEntryApplyResult : The outcome of ApplyCatalogEntry or PlanCatalogEntry.
+type CatalogEntryApplyResult struct {
+	// The action taken (or, for PlanCatalogEntry, the action that would be taken): CatalogEntryApplyActionCreateConst,
+	// CatalogEntryApplyActionUpdateConst or CatalogEntryApplyActionNoneConst.
+	Action string
+
+	// The fields that differ from the desired entry, sorted by field.
+	Changes []CatalogEntryChange
+
+	// The entry after the action. For PlanCatalogEntry this is the current entry, or nil if it does not exist.
+	Entry *CatalogEntry
+
+	// The workflow ID sent with the requests made by ApplyCatalogEntry or PlanCatalogEntry (see
+	// common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// WriteDiff writes the changes to "w", one per line: "+ field: value" for fields that are not set and
+// "~ field: current -> desired" for fields that differ.
+func (result *CatalogEntryApplyResult) WriteDiff(w io.Writer) (err error) {
+	for _, change := range result.Changes {
+		desired, _ := json.Marshal(change.Desired)
+		if change.Current == nil {
+			_, err = fmt.Fprintf(w, "+ %s: %s\n", change.Field, desired)
+		} else {
+			current, _ := json.Marshal(change.Current)
+			_, err = fmt.Fprintf(w, "~ %s: %s -> %s\n", change.Field, current, desired)
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// ApplyCatalogEntry makes the catalog entry identified by desiredEntry.ID match "desiredEntry": it creates the entry
+// if it does not exist, updates it if any field set in "desiredEntry" differs from the current entry, and otherwise
+// leaves it unchanged. Fields that are not set in "desiredEntry", and fields maintained by the catalog, are not
+// compared. The update is conditional on the ETag of the fetched entry, so a concurrent modification is detected and
+// the entry is fetched and compared again. This is retried up to "retries" times when the update is rejected because
+// the entry was modified concurrently (the ETag no longer matches), or its creation is rejected because the entry was
+// created concurrently; pass 0 to return such failures immediately.
+func (globalCatalog *GlobalCatalogV1) ApplyCatalogEntry(ctx context.Context, desiredEntry *CreateCatalogEntryOptions, retries int) (result *CatalogEntryApplyResult, err error) {
+	return globalCatalog.applyCatalogEntry(ctx, desiredEntry, false, retries)
+}
+
+// PlanCatalogEntry is a dry run of ApplyCatalogEntry: it returns the action that ApplyCatalogEntry would take and the
+// changes it would make, without modifying the catalog.
+func (globalCatalog *GlobalCatalogV1) PlanCatalogEntry(ctx context.Context, desiredEntry *CreateCatalogEntryOptions) (result *CatalogEntryApplyResult, err error) {
+	return globalCatalog.applyCatalogEntry(ctx, desiredEntry, true, 0)
+}
+
+func (globalCatalog *GlobalCatalogV1) applyCatalogEntry(ctx context.Context, desiredEntry *CreateCatalogEntryOptions, dryRun bool, retries int) (result *CatalogEntryApplyResult, err error) {
+	err = core.ValidateNotNil(desiredEntry, "desiredEntry cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(desiredEntry, "desiredEntry")
+	if err != nil {
+		return
+	}
+	id := *desiredEntry.ID
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, globalCatalog.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	for attempt := 0; ; attempt++ {
+		getCatalogEntryOptions := globalCatalog.NewGetCatalogEntryOptions(id).SetInclude("*")
+		getCatalogEntryOptions.Account = desiredEntry.Account
+		current, response, getErr := globalCatalog.GetCatalogEntryWithContext(ctx, getCatalogEntryOptions)
+		notFound := getErr != nil && response != nil && response.StatusCode == http.StatusNotFound
+		if getErr != nil && !notFound {
+			err = fmt.Errorf("error getting catalog entry '%s': %w", id, getErr)
+			return
+		}
+
+		result = &CatalogEntryApplyResult{Action: CatalogEntryApplyActionNoneConst, WorkflowID: workflowID}
+		if notFound {
+			current = nil
+			result.Action = CatalogEntryApplyActionCreateConst
+		} else {
+			result.Entry = current
+		}
+		result.Changes, err = diffCatalogEntry(current, desiredEntry)
+		if err != nil {
+			return
+		}
+		if len(result.Changes) > 0 && !notFound {
+			result.Action = CatalogEntryApplyActionUpdateConst
+		}
+		if dryRun || result.Action == CatalogEntryApplyActionNoneConst {
+			return
+		}
+
+		var conflictStatus int
+		if notFound {
+			conflictStatus = http.StatusConflict
+			result.Entry, response, err = globalCatalog.CreateCatalogEntryWithContext(ctx, desiredEntry)
+		} else {
+			conflictStatus = http.StatusPreconditionFailed
+			updateCatalogEntryOptions := newUpdateCatalogEntryOptions(desiredEntry)
+			if etag := response.Headers.Get("ETag"); etag != "" {
+				updateCatalogEntryOptions.Headers["If-Match"] = etag
+			}
+			result.Entry, response, err = globalCatalog.UpdateCatalogEntryWithContext(ctx, updateCatalogEntryOptions)
+		}
+		if err == nil {
+			return
+		}
+		if response == nil || response.StatusCode != conflictStatus || attempt >= retries {
+			err = fmt.Errorf("error applying catalog entry '%s': %w", id, err)
+			result = nil
+			return
+		}
+	}
+}
+
+// newUpdateCatalogEntryOptions returns the options that replace an entry with the desired entry.
+func newUpdateCatalogEntryOptions(desiredEntry *CreateCatalogEntryOptions) *UpdateCatalogEntryOptions {
+	headers := make(map[string]string, len(desiredEntry.Headers)+1)
+	for name, value := range desiredEntry.Headers {
+		headers[name] = value
+	}
+	return &UpdateCatalogEntryOptions{
+		ID:         desiredEntry.ID,
+		Name:       desiredEntry.Name,
+		Kind:       desiredEntry.Kind,
+		OverviewUI: desiredEntry.OverviewUI,
+		Images:     desiredEntry.Images,
+		Disabled:   desiredEntry.Disabled,
+		Tags:       desiredEntry.Tags,
+		Provider:   desiredEntry.Provider,
+		ParentID:   desiredEntry.ParentID,
+		Group:      desiredEntry.Group,
+		Active:     desiredEntry.Active,
+		Metadata:   desiredEntry.Metadata,
+		Account:    desiredEntry.Account,
+		Headers:    headers,
+	}
+}
+
+// catalogEntryDocument : The writable fields of a catalog entry, used to compare a CatalogEntry with the options that
+// create it.
+type catalogEntryDocument struct {
+	Name       *string             `json:"name,omitempty"`
+	Kind       *string             `json:"kind,omitempty"`
+	OverviewUI map[string]Overview `json:"overview_ui,omitempty"`
+	Images     *Image              `json:"images,omitempty"`
+	ParentID   *string             `json:"parent_id,omitempty"`
+	Disabled   *bool               `json:"disabled,omitempty"`
+	Tags       []string            `json:"tags,omitempty"`
+	Group      *bool               `json:"group,omitempty"`
+	Provider   *Provider           `json:"provider,omitempty"`
+	Active     *bool               `json:"active,omitempty"`
+	Metadata   interface{}         `json:"metadata,omitempty"`
+}
+
+// diffCatalogEntry returns the fields set in "desired" whose values differ from "current", which may be nil.
+func diffCatalogEntry(current *CatalogEntry, desired *CreateCatalogEntryOptions) (changes []CatalogEntryChange, err error) {
+	desiredDocument := catalogEntryDocument{
+		Name:       desired.Name,
+		Kind:       desired.Kind,
+		OverviewUI: desired.OverviewUI,
+		Images:     desired.Images,
+		ParentID:   desired.ParentID,
+		Disabled:   desired.Disabled,
+		Tags:       desired.Tags,
+		Group:      desired.Group,
+		Provider:   desired.Provider,
+		Active:     desired.Active,
+	}
+	if desired.Metadata != nil {
+		desiredDocument.Metadata = desired.Metadata
+	}
+	currentDocument := catalogEntryDocument{}
+	if current != nil {
+		currentDocument = catalogEntryDocument{
+			Name:       current.Name,
+			Kind:       current.Kind,
+			OverviewUI: current.OverviewUI,
+			Images:     current.Images,
+			ParentID:   current.ParentID,
+			Disabled:   current.Disabled,
+			Tags:       current.Tags,
+			Group:      current.Group,
+			Provider:   current.Provider,
+			Active:     current.Active,
+		}
+		if current.Metadata != nil {
+			currentDocument.Metadata = current.Metadata
+		}
+	}
+
+	desiredValue, err := toJSONValue(desiredDocument)
+	if err != nil {
+		return
+	}
+	currentValue, err := toJSONValue(currentDocument)
+	if err != nil {
+		return
+	}
+	changes = diffJSONValues("", currentValue, desiredValue, nil)
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return
+}
+
+// toJSONValue returns "value" encoded to JSON and decoded into maps, slices and primitives.
+func toJSONValue(value interface{}) (result interface{}, err error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(data, &result)
+	return
+}
+
+// diffJSONValues compares the properties set in "desired" with "current". Objects are compared property by property,
+// so properties that are only set in "current" are ignored; all other values are compared as a whole.
+func diffJSONValues(field string, current interface{}, desired interface{}, changes []CatalogEntryChange) []CatalogEntryChange {
+	desiredObject, desiredIsObject := desired.(map[string]interface{})
+	currentObject, currentIsObject := current.(map[string]interface{})
+	if !desiredIsObject || !currentIsObject {
+		if !reflect.DeepEqual(current, desired) {
+			changes = append(changes, CatalogEntryChange{Field: field, Current: current, Desired: desired})
+		}
+		return changes
+	}
+
+	for name, desiredProperty := range desiredObject {
+		propertyField := name
+		if field != "" {
+			propertyField = field + "." + name
+		}
+		changes = diffJSONValues(propertyField, currentObject[name], desiredProperty, changes)
+	}
+	return changes
+}
@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalCatalogV1 ApplyCatalogEntry`, func() {
+	var testServer *httptest.Server
+	var globalCatalogService *globalcatalogv1.GlobalCatalogV1
+	var entry map[string]interface{}
+	var version int
+	var concurrentUpdates int
+	var writes []string
+	BeforeEach(func() {
+		entry = nil
+		version = 1
+		concurrentUpdates = 0
+		writes = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/my-service":
+				Expect(req.URL.Query().Get("include")).To(Equal("*"))
+				if entry == nil {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"message": "not found"}`)
+					return
+				}
+				res.Header().Set("ETag", fmt.Sprintf(`"%d"`, version))
+				Expect(json.NewEncoder(res).Encode(entry)).To(Succeed())
+			case req.Method == "PUT" && req.URL.EscapedPath() == "/my-service":
+				writes = append(writes, "PUT")
+				if concurrentUpdates > 0 {
+					concurrentUpdates--
+					version++
+				}
+				if req.Header.Get("If-Match") != fmt.Sprintf(`"%d"`, version) {
+					res.WriteHeader(412)
+					fmt.Fprint(res, `{"message": "precondition failed"}`)
+					return
+				}
+				body, _ := ioutil.ReadAll(req.Body)
+				Expect(json.Unmarshal(body, &entry)).To(Succeed())
+				version++
+				res.Write(body)
+			case req.Method == "POST" && req.URL.EscapedPath() == "/":
+				writes = append(writes, "POST")
+				body, _ := ioutil.ReadAll(req.Body)
+				Expect(json.Unmarshal(body, &entry)).To(Succeed())
+				res.WriteHeader(201)
+				res.Write(body)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		globalCatalogService, serviceErr = globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	desiredEntry := func(image string, pricingOrigin string) *globalcatalogv1.CreateCatalogEntryOptions {
+		overviewUI := map[string]globalcatalogv1.Overview{
+			"en": {DisplayName: core.StringPtr("My Service"), Description: core.StringPtr("d"), LongDescription: core.StringPtr("ld")},
+		}
+		provider := &globalcatalogv1.Provider{Email: core.StringPtr("me@example.com"), Name: core.StringPtr("Me")}
+		options := globalCatalogService.NewCreateCatalogEntryOptions("my-service", globalcatalogv1.CreateCatalogEntryOptionsKindServiceConst,
+			overviewUI, &globalcatalogv1.Image{Image: core.StringPtr(image)}, false, []string{"db"}, provider, "my-service")
+		options.SetMetadata(&globalcatalogv1.ObjectMetadataSet{Pricing: &globalcatalogv1.PricingSet{Origin: core.StringPtr(pricingOrigin)}})
+		return options
+	}
+
+	It(`Creates, updates and leaves entries unchanged`, func() {
+		plan, err := globalCatalogService.PlanCatalogEntry(context.Background(), desiredEntry("a.svg", "global_catalog"))
+		Expect(err).To(BeNil())
+		Expect(plan.Action).To(Equal(globalcatalogv1.CatalogEntryApplyActionCreateConst))
+		Expect(plan.Entry).To(BeNil())
+		Expect(writes).To(BeEmpty())
+
+		result, err := globalCatalogService.ApplyCatalogEntry(context.Background(), desiredEntry("a.svg", "global_catalog"), globalcatalogv1.DefaultApplyCatalogEntryRetries)
+		Expect(err).To(BeNil())
+		Expect(result.Action).To(Equal(globalcatalogv1.CatalogEntryApplyActionCreateConst))
+		Expect(*result.Entry.Images.Image).To(Equal("a.svg"))
+
+		// The catalog adds fields of its own, which are not compared.
+		entry["url"] = "https://globalcatalog.cloud.ibm.com/api/v1/my-service"
+		entry["metadata"].(map[string]interface{})["version"] = "1.0"
+		result, err = globalCatalogService.ApplyCatalogEntry(context.Background(), desiredEntry("a.svg", "global_catalog"), globalcatalogv1.DefaultApplyCatalogEntryRetries)
+		Expect(err).To(BeNil())
+		Expect(result.Action).To(Equal(globalcatalogv1.CatalogEntryApplyActionNoneConst))
+		Expect(result.Changes).To(BeEmpty())
+		Expect(writes).To(Equal([]string{"POST"}))
+
+		plan, err = globalCatalogService.PlanCatalogEntry(context.Background(), desiredEntry("b.svg", "pricing_catalog"))
+		Expect(err).To(BeNil())
+		Expect(plan.Action).To(Equal(globalcatalogv1.CatalogEntryApplyActionUpdateConst))
+		var diff bytes.Buffer
+		Expect(plan.WriteDiff(&diff)).To(Succeed())
+		Expect(diff.String()).To(Equal(
+			"~ images.image: \"a.svg\" -> \"b.svg\"\n" +
+				"~ metadata.pricing.origin: \"global_catalog\" -> \"pricing_catalog\"\n"))
+		Expect(writes).To(Equal([]string{"POST"}))
+
+		result, err = globalCatalogService.ApplyCatalogEntry(context.Background(), desiredEntry("b.svg", "pricing_catalog"), globalcatalogv1.DefaultApplyCatalogEntryRetries)
+		Expect(err).To(BeNil())
+		Expect(result.Action).To(Equal(globalcatalogv1.CatalogEntryApplyActionUpdateConst))
+		Expect(result.Changes).To(HaveLen(2))
+		Expect(writes).To(Equal([]string{"POST", "PUT"}))
+	})
+	It(`Retries when the entry is modified concurrently`, func() {
+		_, err := globalCatalogService.ApplyCatalogEntry(context.Background(), desiredEntry("a.svg", "global_catalog"), globalcatalogv1.DefaultApplyCatalogEntryRetries)
+		Expect(err).To(BeNil())
+
+		concurrentUpdates = 1
+		result, err := globalCatalogService.ApplyCatalogEntry(context.Background(), desiredEntry("b.svg", "global_catalog"), globalcatalogv1.DefaultApplyCatalogEntryRetries)
+		Expect(err).To(BeNil())
+		Expect(result.Action).To(Equal(globalcatalogv1.CatalogEntryApplyActionUpdateConst))
+		Expect(writes).To(Equal([]string{"POST", "PUT", "PUT"}))
+
+		concurrentUpdates = 1
+		_, err = globalCatalogService.ApplyCatalogEntry(context.Background(), desiredEntry("c.svg", "global_catalog"), 0)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(HavePrefix("error applying catalog entry 'my-service'"))
+	})
+})
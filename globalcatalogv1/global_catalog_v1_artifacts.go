@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrArtifactChecksumMismatch is returned (wrapped) by UploadArtifactStream and DownloadArtifactStream when the
+// artifact received or stored differs from the one sent or expected.
+var ErrArtifactChecksumMismatch = errors.New("artifact checksum mismatch")
+
+// ArtifactTransfer : The outcome of UploadArtifactStream or DownloadArtifactStream.
+type ArtifactTransfer struct {
+	// The ID of the catalog entry.
+	ObjectID string
+
+	// The ID of the artifact.
+	ArtifactID string
+
+	// The number of bytes transferred.
+	Size int64
+
+	// The SHA-256 digest of the bytes transferred, in lower-case hexadecimal.
+	SHA256 string
+}
+
+// artifactDigest counts and hashes the bytes written to it.
+type artifactDigest struct {
+	hash hash.Hash
+	size int64
+}
+
+func newArtifactDigest() *artifactDigest {
+	return &artifactDigest{hash: sha256.New()}
+}
+
+func (digest *artifactDigest) Write(p []byte) (int, error) {
+	digest.size += int64(len(p))
+	return digest.hash.Write(p)
+}
+
+func (digest *artifactDigest) transfer(objectID string, artifactID string) *ArtifactTransfer {
+	return &ArtifactTransfer{
+		ObjectID:   objectID,
+		ArtifactID: artifactID,
+		Size:       digest.size,
+		SHA256:     hex.EncodeToString(digest.hash.Sum(nil)),
+	}
+}
+
+// UploadArtifactStream uploads an artifact from "artifact" without reading it into memory: it is sent with chunked
+// transfer encoding as it is read, and its size and SHA-256 digest are computed on the way. Once uploaded, the size
+// of the stored artifact (see ListArtifacts) is checked against the number of bytes sent.
+func (globalCatalog *GlobalCatalogV1) UploadArtifactStream(ctx context.Context, objectID string, artifactID string, contentType string, artifact io.Reader) (result *ArtifactTransfer, err error) {
+	err = core.ValidateNotNil(artifact, "artifact cannot be nil")
+	if err != nil {
+		return
+	}
+	digest := newArtifactDigest()
+	options := globalCatalog.NewUploadArtifactOptions(objectID, artifactID)
+	// The reader is wrapped so that the HTTP client cannot detect its length, which would disable chunked transfer
+	// for readers such as *bytes.Reader.
+	options.Artifact = ioutil.NopCloser(io.TeeReader(artifact, digest))
+	if contentType != "" {
+		options.ContentType = core.StringPtr(contentType)
+	}
+	_, err = globalCatalog.UploadArtifactWithContext(ctx, options)
+	if err != nil {
+		err = fmt.Errorf("error uploading artifact '%s' of catalog entry '%s': %w", artifactID, objectID, err)
+		return
+	}
+	sent := digest.transfer(objectID, artifactID)
+
+	artifacts, _, err := globalCatalog.ListArtifactsWithContext(ctx, globalCatalog.NewListArtifactsOptions(objectID))
+	if err != nil {
+		err = fmt.Errorf("error verifying artifact '%s' of catalog entry '%s': %w", artifactID, objectID, err)
+		return
+	}
+	for _, stored := range artifacts.Resources {
+		if core.StringNilMapper(stored.Name) == artifactID && stored.Size != nil && *stored.Size != sent.Size {
+			err = fmt.Errorf("artifact '%s' of catalog entry '%s' is stored with %d bytes, but %d were sent: %w",
+				artifactID, objectID, *stored.Size, sent.Size, ErrArtifactChecksumMismatch)
+			return
+		}
+	}
+	result = sent
+	return
+}
+
+// DownloadArtifactStream downloads an artifact into "w" without reading it into memory, and checks its size against
+// the Content-Length of the response and, if "expectedSHA256" is not empty, its SHA-256 digest (in hexadecimal)
+// against "expectedSHA256". Bytes are written to "w" as they are received, so "w" should be discarded if an error is
+// returned.
+func (globalCatalog *GlobalCatalogV1) DownloadArtifactStream(ctx context.Context, objectID string, artifactID string, w io.Writer, expectedSHA256 string) (result *ArtifactTransfer, err error) {
+	err = core.ValidateNotNil(w, "w cannot be nil")
+	if err != nil {
+		return
+	}
+	body, response, err := globalCatalog.GetArtifactWithContext(ctx, globalCatalog.NewGetArtifactOptions(objectID, artifactID))
+	if err != nil {
+		err = fmt.Errorf("error downloading artifact '%s' of catalog entry '%s': %w", artifactID, objectID, err)
+		return
+	}
+	defer body.Close()
+
+	digest := newArtifactDigest()
+	_, err = io.Copy(io.MultiWriter(w, digest), body)
+	if err != nil {
+		err = fmt.Errorf("error downloading artifact '%s' of catalog entry '%s': %w", artifactID, objectID, err)
+		return
+	}
+	received := digest.transfer(objectID, artifactID)
+
+	if length, parseErr := strconv.ParseInt(response.GetHeaders().Get("Content-Length"), 10, 64); parseErr == nil && length != received.Size {
+		err = fmt.Errorf("artifact '%s' of catalog entry '%s' has %d bytes, but %d were received: %w",
+			artifactID, objectID, length, received.Size, ErrArtifactChecksumMismatch)
+		return
+	}
+	if expectedSHA256 != "" && !strings.EqualFold(expectedSHA256, received.SHA256) {
+		err = fmt.Errorf("artifact '%s' of catalog entry '%s' has SHA-256 digest %s, but %s was expected: %w",
+			artifactID, objectID, received.SHA256, expectedSHA256, ErrArtifactChecksumMismatch)
+		return
+	}
+	result = received
+	return
+}
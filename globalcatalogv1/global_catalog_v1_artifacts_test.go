@@ -0,0 +1,105 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalCatalogV1 artifact streaming`, func() {
+	var testServer *httptest.Server
+	var globalCatalogService *globalcatalogv1.GlobalCatalogV1
+	var stored map[string][]byte
+	var storedSize int
+	content := strings.Repeat("artifact data ", 10000)
+	digest := sha256.Sum256([]byte(content))
+	checksum := hex.EncodeToString(digest[:])
+	BeforeEach(func() {
+		stored = map[string][]byte{}
+		storedSize = -1
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			switch {
+			case req.Method == "PUT" && strings.HasPrefix(req.URL.Path, "/entry/artifacts/"):
+				Expect(req.TransferEncoding).To(Equal([]string{"chunked"}))
+				Expect(req.Header.Get("Content-Type")).To(Equal("application/zip"))
+				body, err := ioutil.ReadAll(req.Body)
+				Expect(err).To(BeNil())
+				stored[strings.TrimPrefix(req.URL.Path, "/entry/artifacts/")] = body
+				res.WriteHeader(200)
+			case req.Method == "GET" && req.URL.Path == "/entry/artifacts":
+				res.Header().Set("Content-type", "application/json")
+				size := len(stored["bundle.zip"])
+				if storedSize >= 0 {
+					size = storedSize
+				}
+				fmt.Fprintf(res, `{"count": 1, "resources": [{"name": "bundle.zip", "size": %d}]}`, size)
+			case req.Method == "GET" && req.URL.Path == "/entry/artifacts/bundle.zip":
+				res.Header().Set("Content-type", "application/zip")
+				fmt.Fprint(res, content)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		globalCatalogService, serviceErr = globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Uploads an artifact with chunked transfer and verifies its size`, func() {
+		transfer, err := globalCatalogService.UploadArtifactStream(context.Background(), "entry", "bundle.zip", "application/zip", strings.NewReader(content))
+		Expect(err).To(BeNil())
+		Expect(string(stored["bundle.zip"])).To(Equal(content))
+		Expect(transfer.Size).To(Equal(int64(len(content))))
+		Expect(transfer.SHA256).To(Equal(checksum))
+
+		storedSize = 10
+		_, err = globalCatalogService.UploadArtifactStream(context.Background(), "entry", "bundle.zip", "application/zip", strings.NewReader(content))
+		Expect(errors.Is(err, globalcatalogv1.ErrArtifactChecksumMismatch)).To(BeTrue())
+	})
+	It(`Downloads an artifact into a writer and verifies its checksum`, func() {
+		var downloaded bytes.Buffer
+		transfer, err := globalCatalogService.DownloadArtifactStream(context.Background(), "entry", "bundle.zip", &downloaded, strings.ToUpper(checksum))
+		Expect(err).To(BeNil())
+		Expect(downloaded.String()).To(Equal(content))
+		Expect(transfer.Size).To(Equal(int64(len(content))))
+
+		_, err = globalCatalogService.DownloadArtifactStream(context.Background(), "entry", "bundle.zip", ioutil.Discard, "0123")
+		Expect(errors.Is(err, globalcatalogv1.ErrArtifactChecksumMismatch)).To(BeTrue())
+	})
+})
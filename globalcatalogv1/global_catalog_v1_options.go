@@ -0,0 +1,1137 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package globalcatalogv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ListCatalogEntriesOption : A functional option that sets a property of ListCatalogEntriesOptions (see ListCatalogEntriesWith).
+type ListCatalogEntriesOption func(options *ListCatalogEntriesOptions)
+
+// ListCatalogEntriesAccount sets the Account property of ListCatalogEntriesOptions. This changes the scope of the
+// request regardless of the authorization header.
+func ListCatalogEntriesAccount(account string) ListCatalogEntriesOption {
+	return func(options *ListCatalogEntriesOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// ListCatalogEntriesInclude sets the Include property of ListCatalogEntriesOptions. A GET call by default returns
+// a basic set of properties.
+func ListCatalogEntriesInclude(include string) ListCatalogEntriesOption {
+	return func(options *ListCatalogEntriesOptions) {
+		options.Include = core.StringPtr(include)
+	}
+}
+
+// ListCatalogEntriesQ sets the Q property of ListCatalogEntriesOptions. Searches the catalog entries for keywords.
+func ListCatalogEntriesQ(q string) ListCatalogEntriesOption {
+	return func(options *ListCatalogEntriesOptions) {
+		options.Q = core.StringPtr(q)
+	}
+}
+
+// ListCatalogEntriesSortBy sets the SortBy property of ListCatalogEntriesOptions. The field on which the output is
+// sorted.
+func ListCatalogEntriesSortBy(sortBy string) ListCatalogEntriesOption {
+	return func(options *ListCatalogEntriesOptions) {
+		options.SortBy = core.StringPtr(sortBy)
+	}
+}
+
+// ListCatalogEntriesDescending sets the Descending property of ListCatalogEntriesOptions. Sets the sort order.
+func ListCatalogEntriesDescending(descending string) ListCatalogEntriesOption {
+	return func(options *ListCatalogEntriesOptions) {
+		options.Descending = core.StringPtr(descending)
+	}
+}
+
+// ListCatalogEntriesLanguages sets the Languages property of ListCatalogEntriesOptions. Return the data strings in
+// a specified langauge.
+func ListCatalogEntriesLanguages(languages string) ListCatalogEntriesOption {
+	return func(options *ListCatalogEntriesOptions) {
+		options.Languages = core.StringPtr(languages)
+	}
+}
+
+// ListCatalogEntriesCatalog sets the Catalog property of ListCatalogEntriesOptions. Checks to see if a catalog's
+// object is visible, or if it's filtered by service, plan, deployment, or region.
+func ListCatalogEntriesCatalog(catalog bool) ListCatalogEntriesOption {
+	return func(options *ListCatalogEntriesOptions) {
+		options.Catalog = core.BoolPtr(catalog)
+	}
+}
+
+// ListCatalogEntriesComplete sets the Complete property of ListCatalogEntriesOptions. Returns all available fields
+// for all languages.
+func ListCatalogEntriesComplete(complete bool) ListCatalogEntriesOption {
+	return func(options *ListCatalogEntriesOptions) {
+		options.Complete = core.BoolPtr(complete)
+	}
+}
+
+// ListCatalogEntriesOffset sets the Offset property of ListCatalogEntriesOptions. Useful for pagination, specifies
+// index (origin 0) of first item to return in response.
+func ListCatalogEntriesOffset(offset int64) ListCatalogEntriesOption {
+	return func(options *ListCatalogEntriesOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// ListCatalogEntriesLimit sets the Limit property of ListCatalogEntriesOptions. Useful for pagination, specifies
+// the maximum number of items to return in the response.
+func ListCatalogEntriesLimit(limit int64) ListCatalogEntriesOption {
+	return func(options *ListCatalogEntriesOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListCatalogEntriesHeader sets the Headers property of ListCatalogEntriesOptions. Allows users to set headers on
+// API requests.
+func ListCatalogEntriesHeader(name string, value string) ListCatalogEntriesOption {
+	return func(options *ListCatalogEntriesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListCatalogEntriesOptionsWith returns ListCatalogEntriesOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewListCatalogEntriesOptionsWith(options ...ListCatalogEntriesOption) *ListCatalogEntriesOptions {
+	listCatalogEntriesOptions := &ListCatalogEntriesOptions{}
+	for _, option := range options {
+		option(listCatalogEntriesOptions)
+	}
+	return listCatalogEntriesOptions
+}
+
+// ListCatalogEntriesWith is an alternate form of the ListCatalogEntriesWithContext method which takes functional
+// options instead of a ListCatalogEntriesOptions struct.
+func (globalCatalog *GlobalCatalogV1) ListCatalogEntriesWith(ctx context.Context, options ...ListCatalogEntriesOption) (result *EntrySearchResult, response *core.DetailedResponse, err error) {
+	return globalCatalog.ListCatalogEntriesWithContext(ctx, globalCatalog.NewListCatalogEntriesOptionsWith(options...))
+}
+
+// CreateCatalogEntryOption : A functional option that sets a property of CreateCatalogEntryOptions (see CreateCatalogEntryWith).
+type CreateCatalogEntryOption func(options *CreateCatalogEntryOptions)
+
+// CreateCatalogEntryName sets the Name property of CreateCatalogEntryOptions. Programmatic name for this catalog
+// entry, which must be formatted like a CRN segment.
+func CreateCatalogEntryName(nameVar string) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateCatalogEntryKind sets the Kind property of CreateCatalogEntryOptions. The type of catalog entry,
+// **service**, **template**, **dashboard**, which determines the type and shape of the object.
+func CreateCatalogEntryKind(kind string) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.Kind = core.StringPtr(kind)
+	}
+}
+
+// CreateCatalogEntryOverviewUI sets the OverviewUI property of CreateCatalogEntryOptions. Overview is nested in
+// the top level.
+func CreateCatalogEntryOverviewUI(overviewUI map[string]Overview) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.OverviewUI = overviewUI
+	}
+}
+
+// CreateCatalogEntryImages sets the Images property of CreateCatalogEntryOptions. Image annotation for this
+// catalog entry.
+func CreateCatalogEntryImages(images *Image) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.Images = images
+	}
+}
+
+// CreateCatalogEntryDisabled sets the Disabled property of CreateCatalogEntryOptions. Boolean value that
+// determines the global visibility for the catalog entry, and its children.
+func CreateCatalogEntryDisabled(disabled bool) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.Disabled = core.BoolPtr(disabled)
+	}
+}
+
+// CreateCatalogEntryTags sets the Tags property of CreateCatalogEntryOptions. A list of tags.
+func CreateCatalogEntryTags(tags ...string) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// CreateCatalogEntryProvider sets the Provider property of CreateCatalogEntryOptions. Information related to the
+// provider associated with a catalog entry.
+func CreateCatalogEntryProvider(provider *Provider) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.Provider = provider
+	}
+}
+
+// CreateCatalogEntryID sets the ID property of CreateCatalogEntryOptions. Catalog entry's unique ID.
+func CreateCatalogEntryID(id string) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// CreateCatalogEntryParentID sets the ParentID property of CreateCatalogEntryOptions. The ID of the parent catalog
+// entry if it exists.
+func CreateCatalogEntryParentID(parentID string) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.ParentID = core.StringPtr(parentID)
+	}
+}
+
+// CreateCatalogEntryGroup sets the Group property of CreateCatalogEntryOptions. Boolean value that determines
+// whether the catalog entry is a group.
+func CreateCatalogEntryGroup(group bool) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.Group = core.BoolPtr(group)
+	}
+}
+
+// CreateCatalogEntryActive sets the Active property of CreateCatalogEntryOptions. Boolean value that describes
+// whether the service is active.
+func CreateCatalogEntryActive(active bool) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.Active = core.BoolPtr(active)
+	}
+}
+
+// CreateCatalogEntryMetadata sets the Metadata property of CreateCatalogEntryOptions. Model used to describe
+// metadata object that can be set.
+func CreateCatalogEntryMetadata(metadata *ObjectMetadataSet) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.Metadata = metadata
+	}
+}
+
+// CreateCatalogEntryAccount sets the Account property of CreateCatalogEntryOptions. This changes the scope of the
+// request regardless of the authorization header.
+func CreateCatalogEntryAccount(account string) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// CreateCatalogEntryHeader sets the Headers property of CreateCatalogEntryOptions. Allows users to set headers on
+// API requests.
+func CreateCatalogEntryHeader(name string, value string) CreateCatalogEntryOption {
+	return func(options *CreateCatalogEntryOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateCatalogEntryOptionsWith returns CreateCatalogEntryOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewCreateCatalogEntryOptionsWith(options ...CreateCatalogEntryOption) *CreateCatalogEntryOptions {
+	createCatalogEntryOptions := &CreateCatalogEntryOptions{}
+	for _, option := range options {
+		option(createCatalogEntryOptions)
+	}
+	return createCatalogEntryOptions
+}
+
+// CreateCatalogEntryWith is an alternate form of the CreateCatalogEntryWithContext method which takes functional
+// options instead of a CreateCatalogEntryOptions struct. CreateCatalogEntryName, CreateCatalogEntryKind,
+// CreateCatalogEntryOverviewUI, CreateCatalogEntryImages, CreateCatalogEntryDisabled, CreateCatalogEntryTags,
+// CreateCatalogEntryProvider and CreateCatalogEntryID are required.
+func (globalCatalog *GlobalCatalogV1) CreateCatalogEntryWith(ctx context.Context, options ...CreateCatalogEntryOption) (result *CatalogEntry, response *core.DetailedResponse, err error) {
+	return globalCatalog.CreateCatalogEntryWithContext(ctx, globalCatalog.NewCreateCatalogEntryOptionsWith(options...))
+}
+
+// GetCatalogEntryOption : A functional option that sets a property of GetCatalogEntryOptions (see GetCatalogEntryWith).
+type GetCatalogEntryOption func(options *GetCatalogEntryOptions)
+
+// GetCatalogEntryID sets the ID property of GetCatalogEntryOptions. The catalog entry's unqiue ID.
+func GetCatalogEntryID(id string) GetCatalogEntryOption {
+	return func(options *GetCatalogEntryOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetCatalogEntryAccount sets the Account property of GetCatalogEntryOptions. This changes the scope of the
+// request regardless of the authorization header.
+func GetCatalogEntryAccount(account string) GetCatalogEntryOption {
+	return func(options *GetCatalogEntryOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// GetCatalogEntryInclude sets the Include property of GetCatalogEntryOptions. A GET call by default returns a
+// basic set of properties.
+func GetCatalogEntryInclude(include string) GetCatalogEntryOption {
+	return func(options *GetCatalogEntryOptions) {
+		options.Include = core.StringPtr(include)
+	}
+}
+
+// GetCatalogEntryLanguages sets the Languages property of GetCatalogEntryOptions. Return the data strings in the
+// specified langauge.
+func GetCatalogEntryLanguages(languages string) GetCatalogEntryOption {
+	return func(options *GetCatalogEntryOptions) {
+		options.Languages = core.StringPtr(languages)
+	}
+}
+
+// GetCatalogEntryComplete sets the Complete property of GetCatalogEntryOptions. Returns all available fields for
+// all languages.
+func GetCatalogEntryComplete(complete bool) GetCatalogEntryOption {
+	return func(options *GetCatalogEntryOptions) {
+		options.Complete = core.BoolPtr(complete)
+	}
+}
+
+// GetCatalogEntryDepth sets the Depth property of GetCatalogEntryOptions. Return the children down to the
+// requested depth.
+func GetCatalogEntryDepth(depth int64) GetCatalogEntryOption {
+	return func(options *GetCatalogEntryOptions) {
+		options.Depth = core.Int64Ptr(depth)
+	}
+}
+
+// GetCatalogEntryHeader sets the Headers property of GetCatalogEntryOptions. Allows users to set headers on API
+// requests.
+func GetCatalogEntryHeader(name string, value string) GetCatalogEntryOption {
+	return func(options *GetCatalogEntryOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetCatalogEntryOptionsWith returns GetCatalogEntryOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewGetCatalogEntryOptionsWith(options ...GetCatalogEntryOption) *GetCatalogEntryOptions {
+	getCatalogEntryOptions := &GetCatalogEntryOptions{}
+	for _, option := range options {
+		option(getCatalogEntryOptions)
+	}
+	return getCatalogEntryOptions
+}
+
+// GetCatalogEntryWith is an alternate form of the GetCatalogEntryWithContext method which takes functional options
+// instead of a GetCatalogEntryOptions struct. GetCatalogEntryID is required.
+func (globalCatalog *GlobalCatalogV1) GetCatalogEntryWith(ctx context.Context, options ...GetCatalogEntryOption) (result *CatalogEntry, response *core.DetailedResponse, err error) {
+	return globalCatalog.GetCatalogEntryWithContext(ctx, globalCatalog.NewGetCatalogEntryOptionsWith(options...))
+}
+
+// UpdateCatalogEntryOption : A functional option that sets a property of UpdateCatalogEntryOptions (see UpdateCatalogEntryWith).
+type UpdateCatalogEntryOption func(options *UpdateCatalogEntryOptions)
+
+// UpdateCatalogEntryID sets the ID property of UpdateCatalogEntryOptions. The object's unique ID.
+func UpdateCatalogEntryID(id string) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UpdateCatalogEntryName sets the Name property of UpdateCatalogEntryOptions. Programmatic name for this catalog
+// entry, which must be formatted like a CRN segment.
+func UpdateCatalogEntryName(nameVar string) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateCatalogEntryKind sets the Kind property of UpdateCatalogEntryOptions. The type of catalog entry,
+// **service**, **template**, **dashboard**, which determines the type and shape of the object.
+func UpdateCatalogEntryKind(kind string) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.Kind = core.StringPtr(kind)
+	}
+}
+
+// UpdateCatalogEntryOverviewUI sets the OverviewUI property of UpdateCatalogEntryOptions. Overview is nested in
+// the top level.
+func UpdateCatalogEntryOverviewUI(overviewUI map[string]Overview) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.OverviewUI = overviewUI
+	}
+}
+
+// UpdateCatalogEntryImages sets the Images property of UpdateCatalogEntryOptions. Image annotation for this
+// catalog entry.
+func UpdateCatalogEntryImages(images *Image) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.Images = images
+	}
+}
+
+// UpdateCatalogEntryDisabled sets the Disabled property of UpdateCatalogEntryOptions. Boolean value that
+// determines the global visibility for the catalog entry, and its children.
+func UpdateCatalogEntryDisabled(disabled bool) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.Disabled = core.BoolPtr(disabled)
+	}
+}
+
+// UpdateCatalogEntryTags sets the Tags property of UpdateCatalogEntryOptions. A list of tags.
+func UpdateCatalogEntryTags(tags ...string) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// UpdateCatalogEntryProvider sets the Provider property of UpdateCatalogEntryOptions. Information related to the
+// provider associated with a catalog entry.
+func UpdateCatalogEntryProvider(provider *Provider) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.Provider = provider
+	}
+}
+
+// UpdateCatalogEntryParentID sets the ParentID property of UpdateCatalogEntryOptions. The ID of the parent catalog
+// entry if it exists.
+func UpdateCatalogEntryParentID(parentID string) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.ParentID = core.StringPtr(parentID)
+	}
+}
+
+// UpdateCatalogEntryGroup sets the Group property of UpdateCatalogEntryOptions. Boolean value that determines
+// whether the catalog entry is a group.
+func UpdateCatalogEntryGroup(group bool) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.Group = core.BoolPtr(group)
+	}
+}
+
+// UpdateCatalogEntryActive sets the Active property of UpdateCatalogEntryOptions. Boolean value that describes
+// whether the service is active.
+func UpdateCatalogEntryActive(active bool) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.Active = core.BoolPtr(active)
+	}
+}
+
+// UpdateCatalogEntryMetadata sets the Metadata property of UpdateCatalogEntryOptions. Model used to describe
+// metadata object that can be set.
+func UpdateCatalogEntryMetadata(metadata *ObjectMetadataSet) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.Metadata = metadata
+	}
+}
+
+// UpdateCatalogEntryAccount sets the Account property of UpdateCatalogEntryOptions. This changes the scope of the
+// request regardless of the authorization header.
+func UpdateCatalogEntryAccount(account string) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// UpdateCatalogEntryMove sets the Move property of UpdateCatalogEntryOptions. Reparenting object.
+func UpdateCatalogEntryMove(move string) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		options.Move = core.StringPtr(move)
+	}
+}
+
+// UpdateCatalogEntryHeader sets the Headers property of UpdateCatalogEntryOptions. Allows users to set headers on
+// API requests.
+func UpdateCatalogEntryHeader(name string, value string) UpdateCatalogEntryOption {
+	return func(options *UpdateCatalogEntryOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateCatalogEntryOptionsWith returns UpdateCatalogEntryOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewUpdateCatalogEntryOptionsWith(options ...UpdateCatalogEntryOption) *UpdateCatalogEntryOptions {
+	updateCatalogEntryOptions := &UpdateCatalogEntryOptions{}
+	for _, option := range options {
+		option(updateCatalogEntryOptions)
+	}
+	return updateCatalogEntryOptions
+}
+
+// UpdateCatalogEntryWith is an alternate form of the UpdateCatalogEntryWithContext method which takes functional
+// options instead of an UpdateCatalogEntryOptions struct. UpdateCatalogEntryID, UpdateCatalogEntryName,
+// UpdateCatalogEntryKind, UpdateCatalogEntryOverviewUI, UpdateCatalogEntryImages, UpdateCatalogEntryDisabled,
+// UpdateCatalogEntryTags and UpdateCatalogEntryProvider are required.
+func (globalCatalog *GlobalCatalogV1) UpdateCatalogEntryWith(ctx context.Context, options ...UpdateCatalogEntryOption) (result *CatalogEntry, response *core.DetailedResponse, err error) {
+	return globalCatalog.UpdateCatalogEntryWithContext(ctx, globalCatalog.NewUpdateCatalogEntryOptionsWith(options...))
+}
+
+// DeleteCatalogEntryOption : A functional option that sets a property of DeleteCatalogEntryOptions (see DeleteCatalogEntryWith).
+type DeleteCatalogEntryOption func(options *DeleteCatalogEntryOptions)
+
+// DeleteCatalogEntryID sets the ID property of DeleteCatalogEntryOptions. The object's unique ID.
+func DeleteCatalogEntryID(id string) DeleteCatalogEntryOption {
+	return func(options *DeleteCatalogEntryOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// DeleteCatalogEntryAccount sets the Account property of DeleteCatalogEntryOptions. This changes the scope of the
+// request regardless of the authorization header.
+func DeleteCatalogEntryAccount(account string) DeleteCatalogEntryOption {
+	return func(options *DeleteCatalogEntryOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// DeleteCatalogEntryForce sets the Force property of DeleteCatalogEntryOptions. This will cause entry to be
+// deleted fully.
+func DeleteCatalogEntryForce(force bool) DeleteCatalogEntryOption {
+	return func(options *DeleteCatalogEntryOptions) {
+		options.Force = core.BoolPtr(force)
+	}
+}
+
+// DeleteCatalogEntryHeader sets the Headers property of DeleteCatalogEntryOptions. Allows users to set headers on
+// API requests.
+func DeleteCatalogEntryHeader(name string, value string) DeleteCatalogEntryOption {
+	return func(options *DeleteCatalogEntryOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteCatalogEntryOptionsWith returns DeleteCatalogEntryOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewDeleteCatalogEntryOptionsWith(options ...DeleteCatalogEntryOption) *DeleteCatalogEntryOptions {
+	deleteCatalogEntryOptions := &DeleteCatalogEntryOptions{}
+	for _, option := range options {
+		option(deleteCatalogEntryOptions)
+	}
+	return deleteCatalogEntryOptions
+}
+
+// DeleteCatalogEntryWith is an alternate form of the DeleteCatalogEntryWithContext method which takes functional
+// options instead of a DeleteCatalogEntryOptions struct. DeleteCatalogEntryID is required.
+func (globalCatalog *GlobalCatalogV1) DeleteCatalogEntryWith(ctx context.Context, options ...DeleteCatalogEntryOption) (response *core.DetailedResponse, err error) {
+	return globalCatalog.DeleteCatalogEntryWithContext(ctx, globalCatalog.NewDeleteCatalogEntryOptionsWith(options...))
+}
+
+// GetChildObjectsOption : A functional option that sets a property of GetChildObjectsOptions (see GetChildObjectsWith).
+type GetChildObjectsOption func(options *GetChildObjectsOptions)
+
+// GetChildObjectsID sets the ID property of GetChildObjectsOptions. The parent catalog entry's ID.
+func GetChildObjectsID(id string) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetChildObjectsKind sets the Kind property of GetChildObjectsOptions. The **kind** of child catalog entries to
+// search for.
+func GetChildObjectsKind(kind string) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		options.Kind = core.StringPtr(kind)
+	}
+}
+
+// GetChildObjectsAccount sets the Account property of GetChildObjectsOptions. This changes the scope of the
+// request regardless of the authorization header.
+func GetChildObjectsAccount(account string) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// GetChildObjectsInclude sets the Include property of GetChildObjectsOptions. A colon (:) separated list of
+// properties to include.
+func GetChildObjectsInclude(include string) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		options.Include = core.StringPtr(include)
+	}
+}
+
+// GetChildObjectsQ sets the Q property of GetChildObjectsOptions. A query filter, for example, `q=kind:iaas IBM`
+// will filter on entries of **kind** iaas that has `IBM` in their name, display name, or description.
+func GetChildObjectsQ(q string) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		options.Q = core.StringPtr(q)
+	}
+}
+
+// GetChildObjectsSortBy sets the SortBy property of GetChildObjectsOptions. The field on which to sort the output.
+func GetChildObjectsSortBy(sortBy string) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		options.SortBy = core.StringPtr(sortBy)
+	}
+}
+
+// GetChildObjectsDescending sets the Descending property of GetChildObjectsOptions. The sort order.
+func GetChildObjectsDescending(descending string) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		options.Descending = core.StringPtr(descending)
+	}
+}
+
+// GetChildObjectsLanguages sets the Languages property of GetChildObjectsOptions. Return the data strings in the
+// specified langauge.
+func GetChildObjectsLanguages(languages string) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		options.Languages = core.StringPtr(languages)
+	}
+}
+
+// GetChildObjectsComplete sets the Complete property of GetChildObjectsOptions. Use the value `?complete=true` as
+// shortcut for ?include=*&languages=*.
+func GetChildObjectsComplete(complete bool) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		options.Complete = core.BoolPtr(complete)
+	}
+}
+
+// GetChildObjectsOffset sets the Offset property of GetChildObjectsOptions. Useful for pagination, specifies index
+// (origin 0) of first item to return in response.
+func GetChildObjectsOffset(offset int64) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// GetChildObjectsLimit sets the Limit property of GetChildObjectsOptions. Useful for pagination, specifies the
+// maximum number of items to return in the response.
+func GetChildObjectsLimit(limit int64) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// GetChildObjectsHeader sets the Headers property of GetChildObjectsOptions. Allows users to set headers on API
+// requests.
+func GetChildObjectsHeader(name string, value string) GetChildObjectsOption {
+	return func(options *GetChildObjectsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetChildObjectsOptionsWith returns GetChildObjectsOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewGetChildObjectsOptionsWith(options ...GetChildObjectsOption) *GetChildObjectsOptions {
+	getChildObjectsOptions := &GetChildObjectsOptions{}
+	for _, option := range options {
+		option(getChildObjectsOptions)
+	}
+	return getChildObjectsOptions
+}
+
+// GetChildObjectsWith is an alternate form of the GetChildObjectsWithContext method which takes functional options
+// instead of a GetChildObjectsOptions struct. GetChildObjectsID and GetChildObjectsKind are required.
+func (globalCatalog *GlobalCatalogV1) GetChildObjectsWith(ctx context.Context, options ...GetChildObjectsOption) (result *EntrySearchResult, response *core.DetailedResponse, err error) {
+	return globalCatalog.GetChildObjectsWithContext(ctx, globalCatalog.NewGetChildObjectsOptionsWith(options...))
+}
+
+// RestoreCatalogEntryOption : A functional option that sets a property of RestoreCatalogEntryOptions (see RestoreCatalogEntryWith).
+type RestoreCatalogEntryOption func(options *RestoreCatalogEntryOptions)
+
+// RestoreCatalogEntryID sets the ID property of RestoreCatalogEntryOptions. The catalog entry's unique ID.
+func RestoreCatalogEntryID(id string) RestoreCatalogEntryOption {
+	return func(options *RestoreCatalogEntryOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// RestoreCatalogEntryAccount sets the Account property of RestoreCatalogEntryOptions. This changes the scope of
+// the request regardless of the authorization header.
+func RestoreCatalogEntryAccount(account string) RestoreCatalogEntryOption {
+	return func(options *RestoreCatalogEntryOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// RestoreCatalogEntryHeader sets the Headers property of RestoreCatalogEntryOptions. Allows users to set headers
+// on API requests.
+func RestoreCatalogEntryHeader(name string, value string) RestoreCatalogEntryOption {
+	return func(options *RestoreCatalogEntryOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewRestoreCatalogEntryOptionsWith returns RestoreCatalogEntryOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewRestoreCatalogEntryOptionsWith(options ...RestoreCatalogEntryOption) *RestoreCatalogEntryOptions {
+	restoreCatalogEntryOptions := &RestoreCatalogEntryOptions{}
+	for _, option := range options {
+		option(restoreCatalogEntryOptions)
+	}
+	return restoreCatalogEntryOptions
+}
+
+// RestoreCatalogEntryWith is an alternate form of the RestoreCatalogEntryWithContext method which takes functional
+// options instead of a RestoreCatalogEntryOptions struct. RestoreCatalogEntryID is required.
+func (globalCatalog *GlobalCatalogV1) RestoreCatalogEntryWith(ctx context.Context, options ...RestoreCatalogEntryOption) (response *core.DetailedResponse, err error) {
+	return globalCatalog.RestoreCatalogEntryWithContext(ctx, globalCatalog.NewRestoreCatalogEntryOptionsWith(options...))
+}
+
+// GetVisibilityOption : A functional option that sets a property of GetVisibilityOptions (see GetVisibilityWith).
+type GetVisibilityOption func(options *GetVisibilityOptions)
+
+// GetVisibilityID sets the ID property of GetVisibilityOptions. The object's unique ID.
+func GetVisibilityID(id string) GetVisibilityOption {
+	return func(options *GetVisibilityOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetVisibilityAccount sets the Account property of GetVisibilityOptions. This changes the scope of the request
+// regardless of the authorization header.
+func GetVisibilityAccount(account string) GetVisibilityOption {
+	return func(options *GetVisibilityOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// GetVisibilityHeader sets the Headers property of GetVisibilityOptions. Allows users to set headers on API
+// requests.
+func GetVisibilityHeader(name string, value string) GetVisibilityOption {
+	return func(options *GetVisibilityOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetVisibilityOptionsWith returns GetVisibilityOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewGetVisibilityOptionsWith(options ...GetVisibilityOption) *GetVisibilityOptions {
+	getVisibilityOptions := &GetVisibilityOptions{}
+	for _, option := range options {
+		option(getVisibilityOptions)
+	}
+	return getVisibilityOptions
+}
+
+// GetVisibilityWith is an alternate form of the GetVisibilityWithContext method which takes functional options
+// instead of a GetVisibilityOptions struct. GetVisibilityID is required.
+func (globalCatalog *GlobalCatalogV1) GetVisibilityWith(ctx context.Context, options ...GetVisibilityOption) (result *Visibility, response *core.DetailedResponse, err error) {
+	return globalCatalog.GetVisibilityWithContext(ctx, globalCatalog.NewGetVisibilityOptionsWith(options...))
+}
+
+// UpdateVisibilityOption : A functional option that sets a property of UpdateVisibilityOptions (see UpdateVisibilityWith).
+type UpdateVisibilityOption func(options *UpdateVisibilityOptions)
+
+// UpdateVisibilityID sets the ID property of UpdateVisibilityOptions. The object's unique ID.
+func UpdateVisibilityID(id string) UpdateVisibilityOption {
+	return func(options *UpdateVisibilityOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UpdateVisibilityExtendable sets the Extendable property of UpdateVisibilityOptions. Allows the visibility to be
+// extenable.
+func UpdateVisibilityExtendable(extendable bool) UpdateVisibilityOption {
+	return func(options *UpdateVisibilityOptions) {
+		options.Extendable = core.BoolPtr(extendable)
+	}
+}
+
+// UpdateVisibilityInclude sets the Include property of UpdateVisibilityOptions. Visibility details related to a
+// catalog entry.
+func UpdateVisibilityInclude(include *VisibilityDetail) UpdateVisibilityOption {
+	return func(options *UpdateVisibilityOptions) {
+		options.Include = include
+	}
+}
+
+// UpdateVisibilityExclude sets the Exclude property of UpdateVisibilityOptions. Visibility details related to a
+// catalog entry.
+func UpdateVisibilityExclude(exclude *VisibilityDetail) UpdateVisibilityOption {
+	return func(options *UpdateVisibilityOptions) {
+		options.Exclude = exclude
+	}
+}
+
+// UpdateVisibilityAccount sets the Account property of UpdateVisibilityOptions. This changes the scope of the
+// request regardless of the authorization header.
+func UpdateVisibilityAccount(account string) UpdateVisibilityOption {
+	return func(options *UpdateVisibilityOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// UpdateVisibilityHeader sets the Headers property of UpdateVisibilityOptions. Allows users to set headers on API
+// requests.
+func UpdateVisibilityHeader(name string, value string) UpdateVisibilityOption {
+	return func(options *UpdateVisibilityOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateVisibilityOptionsWith returns UpdateVisibilityOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewUpdateVisibilityOptionsWith(options ...UpdateVisibilityOption) *UpdateVisibilityOptions {
+	updateVisibilityOptions := &UpdateVisibilityOptions{}
+	for _, option := range options {
+		option(updateVisibilityOptions)
+	}
+	return updateVisibilityOptions
+}
+
+// UpdateVisibilityWith is an alternate form of the UpdateVisibilityWithContext method which takes functional
+// options instead of an UpdateVisibilityOptions struct. UpdateVisibilityID is required.
+func (globalCatalog *GlobalCatalogV1) UpdateVisibilityWith(ctx context.Context, options ...UpdateVisibilityOption) (response *core.DetailedResponse, err error) {
+	return globalCatalog.UpdateVisibilityWithContext(ctx, globalCatalog.NewUpdateVisibilityOptionsWith(options...))
+}
+
+// GetPricingOption : A functional option that sets a property of GetPricingOptions (see GetPricingWith).
+type GetPricingOption func(options *GetPricingOptions)
+
+// GetPricingID sets the ID property of GetPricingOptions. The object's unique ID.
+func GetPricingID(id string) GetPricingOption {
+	return func(options *GetPricingOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetPricingAccount sets the Account property of GetPricingOptions. This changes the scope of the request
+// regardless of the authorization header.
+func GetPricingAccount(account string) GetPricingOption {
+	return func(options *GetPricingOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// GetPricingHeader sets the Headers property of GetPricingOptions. Allows users to set headers on API requests.
+func GetPricingHeader(name string, value string) GetPricingOption {
+	return func(options *GetPricingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetPricingOptionsWith returns GetPricingOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewGetPricingOptionsWith(options ...GetPricingOption) *GetPricingOptions {
+	getPricingOptions := &GetPricingOptions{}
+	for _, option := range options {
+		option(getPricingOptions)
+	}
+	return getPricingOptions
+}
+
+// GetPricingWith is an alternate form of the GetPricingWithContext method which takes functional options instead
+// of a GetPricingOptions struct. GetPricingID is required.
+func (globalCatalog *GlobalCatalogV1) GetPricingWith(ctx context.Context, options ...GetPricingOption) (result *PricingGet, response *core.DetailedResponse, err error) {
+	return globalCatalog.GetPricingWithContext(ctx, globalCatalog.NewGetPricingOptionsWith(options...))
+}
+
+// GetAuditLogsOption : A functional option that sets a property of GetAuditLogsOptions (see GetAuditLogsWith).
+type GetAuditLogsOption func(options *GetAuditLogsOptions)
+
+// GetAuditLogsID sets the ID property of GetAuditLogsOptions. The object's unique ID.
+func GetAuditLogsID(id string) GetAuditLogsOption {
+	return func(options *GetAuditLogsOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetAuditLogsAccount sets the Account property of GetAuditLogsOptions. This changes the scope of the request
+// regardless of the authorization header.
+func GetAuditLogsAccount(account string) GetAuditLogsOption {
+	return func(options *GetAuditLogsOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// GetAuditLogsAscending sets the Ascending property of GetAuditLogsOptions. Sets the sort order.
+func GetAuditLogsAscending(ascending string) GetAuditLogsOption {
+	return func(options *GetAuditLogsOptions) {
+		options.Ascending = core.StringPtr(ascending)
+	}
+}
+
+// GetAuditLogsStartat sets the Startat property of GetAuditLogsOptions. Starting time for the logs.
+func GetAuditLogsStartat(startat string) GetAuditLogsOption {
+	return func(options *GetAuditLogsOptions) {
+		options.Startat = core.StringPtr(startat)
+	}
+}
+
+// GetAuditLogsOffset sets the Offset property of GetAuditLogsOptions. Count of number of log entries to skip
+// before returning logs.
+func GetAuditLogsOffset(offset int64) GetAuditLogsOption {
+	return func(options *GetAuditLogsOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// GetAuditLogsLimit sets the Limit property of GetAuditLogsOptions. Count of number of entries to return.
+func GetAuditLogsLimit(limit int64) GetAuditLogsOption {
+	return func(options *GetAuditLogsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// GetAuditLogsHeader sets the Headers property of GetAuditLogsOptions. Allows users to set headers on API
+// requests.
+func GetAuditLogsHeader(name string, value string) GetAuditLogsOption {
+	return func(options *GetAuditLogsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAuditLogsOptionsWith returns GetAuditLogsOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewGetAuditLogsOptionsWith(options ...GetAuditLogsOption) *GetAuditLogsOptions {
+	getAuditLogsOptions := &GetAuditLogsOptions{}
+	for _, option := range options {
+		option(getAuditLogsOptions)
+	}
+	return getAuditLogsOptions
+}
+
+// GetAuditLogsWith is an alternate form of the GetAuditLogsWithContext method which takes functional options
+// instead of a GetAuditLogsOptions struct. GetAuditLogsID is required.
+func (globalCatalog *GlobalCatalogV1) GetAuditLogsWith(ctx context.Context, options ...GetAuditLogsOption) (result *AuditSearchResult, response *core.DetailedResponse, err error) {
+	return globalCatalog.GetAuditLogsWithContext(ctx, globalCatalog.NewGetAuditLogsOptionsWith(options...))
+}
+
+// ListArtifactsOption : A functional option that sets a property of ListArtifactsOptions (see ListArtifactsWith).
+type ListArtifactsOption func(options *ListArtifactsOptions)
+
+// ListArtifactsObjectID sets the ObjectID property of ListArtifactsOptions. The object's unique ID.
+func ListArtifactsObjectID(objectID string) ListArtifactsOption {
+	return func(options *ListArtifactsOptions) {
+		options.ObjectID = core.StringPtr(objectID)
+	}
+}
+
+// ListArtifactsAccount sets the Account property of ListArtifactsOptions. This changes the scope of the request
+// regardless of the authorization header.
+func ListArtifactsAccount(account string) ListArtifactsOption {
+	return func(options *ListArtifactsOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// ListArtifactsHeader sets the Headers property of ListArtifactsOptions. Allows users to set headers on API
+// requests.
+func ListArtifactsHeader(name string, value string) ListArtifactsOption {
+	return func(options *ListArtifactsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListArtifactsOptionsWith returns ListArtifactsOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewListArtifactsOptionsWith(options ...ListArtifactsOption) *ListArtifactsOptions {
+	listArtifactsOptions := &ListArtifactsOptions{}
+	for _, option := range options {
+		option(listArtifactsOptions)
+	}
+	return listArtifactsOptions
+}
+
+// ListArtifactsWith is an alternate form of the ListArtifactsWithContext method which takes functional options
+// instead of a ListArtifactsOptions struct. ListArtifactsObjectID is required.
+func (globalCatalog *GlobalCatalogV1) ListArtifactsWith(ctx context.Context, options ...ListArtifactsOption) (result *Artifacts, response *core.DetailedResponse, err error) {
+	return globalCatalog.ListArtifactsWithContext(ctx, globalCatalog.NewListArtifactsOptionsWith(options...))
+}
+
+// GetArtifactOption : A functional option that sets a property of GetArtifactOptions (see GetArtifactWith).
+type GetArtifactOption func(options *GetArtifactOptions)
+
+// GetArtifactObjectID sets the ObjectID property of GetArtifactOptions. The object's unique ID.
+func GetArtifactObjectID(objectID string) GetArtifactOption {
+	return func(options *GetArtifactOptions) {
+		options.ObjectID = core.StringPtr(objectID)
+	}
+}
+
+// GetArtifactArtifactID sets the ArtifactID property of GetArtifactOptions. The artifact's ID.
+func GetArtifactArtifactID(artifactID string) GetArtifactOption {
+	return func(options *GetArtifactOptions) {
+		options.ArtifactID = core.StringPtr(artifactID)
+	}
+}
+
+// GetArtifactAccept sets the Accept property of GetArtifactOptions. The type of the response: or *_/_*.
+func GetArtifactAccept(accept string) GetArtifactOption {
+	return func(options *GetArtifactOptions) {
+		options.Accept = core.StringPtr(accept)
+	}
+}
+
+// GetArtifactAccount sets the Account property of GetArtifactOptions. This changes the scope of the request
+// regardless of the authorization header.
+func GetArtifactAccount(account string) GetArtifactOption {
+	return func(options *GetArtifactOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// GetArtifactHeader sets the Headers property of GetArtifactOptions. Allows users to set headers on API requests.
+func GetArtifactHeader(name string, value string) GetArtifactOption {
+	return func(options *GetArtifactOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetArtifactOptionsWith returns GetArtifactOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewGetArtifactOptionsWith(options ...GetArtifactOption) *GetArtifactOptions {
+	getArtifactOptions := &GetArtifactOptions{}
+	for _, option := range options {
+		option(getArtifactOptions)
+	}
+	return getArtifactOptions
+}
+
+// GetArtifactWith is an alternate form of the GetArtifactWithContext method which takes functional options instead
+// of a GetArtifactOptions struct. GetArtifactObjectID and GetArtifactArtifactID are required.
+func (globalCatalog *GlobalCatalogV1) GetArtifactWith(ctx context.Context, options ...GetArtifactOption) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return globalCatalog.GetArtifactWithContext(ctx, globalCatalog.NewGetArtifactOptionsWith(options...))
+}
+
+// UploadArtifactOption : A functional option that sets a property of UploadArtifactOptions (see UploadArtifactWith).
+type UploadArtifactOption func(options *UploadArtifactOptions)
+
+// UploadArtifactObjectID sets the ObjectID property of UploadArtifactOptions. The object's unique ID.
+func UploadArtifactObjectID(objectID string) UploadArtifactOption {
+	return func(options *UploadArtifactOptions) {
+		options.ObjectID = core.StringPtr(objectID)
+	}
+}
+
+// UploadArtifactArtifactID sets the ArtifactID property of UploadArtifactOptions. The artifact's ID.
+func UploadArtifactArtifactID(artifactID string) UploadArtifactOption {
+	return func(options *UploadArtifactOptions) {
+		options.ArtifactID = core.StringPtr(artifactID)
+	}
+}
+
+// UploadArtifactArtifact sets the Artifact property of UploadArtifactOptions.
+func UploadArtifactArtifact(artifact io.ReadCloser) UploadArtifactOption {
+	return func(options *UploadArtifactOptions) {
+		options.Artifact = artifact
+	}
+}
+
+// UploadArtifactContentType sets the ContentType property of UploadArtifactOptions. The type of the input.
+func UploadArtifactContentType(contentType string) UploadArtifactOption {
+	return func(options *UploadArtifactOptions) {
+		options.ContentType = core.StringPtr(contentType)
+	}
+}
+
+// UploadArtifactAccount sets the Account property of UploadArtifactOptions. This changes the scope of the request
+// regardless of the authorization header.
+func UploadArtifactAccount(account string) UploadArtifactOption {
+	return func(options *UploadArtifactOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// UploadArtifactHeader sets the Headers property of UploadArtifactOptions. Allows users to set headers on API
+// requests.
+func UploadArtifactHeader(name string, value string) UploadArtifactOption {
+	return func(options *UploadArtifactOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUploadArtifactOptionsWith returns UploadArtifactOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewUploadArtifactOptionsWith(options ...UploadArtifactOption) *UploadArtifactOptions {
+	uploadArtifactOptions := &UploadArtifactOptions{}
+	for _, option := range options {
+		option(uploadArtifactOptions)
+	}
+	return uploadArtifactOptions
+}
+
+// UploadArtifactWith is an alternate form of the UploadArtifactWithContext method which takes functional options
+// instead of an UploadArtifactOptions struct. UploadArtifactObjectID and UploadArtifactArtifactID are required.
+func (globalCatalog *GlobalCatalogV1) UploadArtifactWith(ctx context.Context, options ...UploadArtifactOption) (response *core.DetailedResponse, err error) {
+	return globalCatalog.UploadArtifactWithContext(ctx, globalCatalog.NewUploadArtifactOptionsWith(options...))
+}
+
+// DeleteArtifactOption : A functional option that sets a property of DeleteArtifactOptions (see DeleteArtifactWith).
+type DeleteArtifactOption func(options *DeleteArtifactOptions)
+
+// DeleteArtifactObjectID sets the ObjectID property of DeleteArtifactOptions. The object's unique ID.
+func DeleteArtifactObjectID(objectID string) DeleteArtifactOption {
+	return func(options *DeleteArtifactOptions) {
+		options.ObjectID = core.StringPtr(objectID)
+	}
+}
+
+// DeleteArtifactArtifactID sets the ArtifactID property of DeleteArtifactOptions. The artifact's ID.
+func DeleteArtifactArtifactID(artifactID string) DeleteArtifactOption {
+	return func(options *DeleteArtifactOptions) {
+		options.ArtifactID = core.StringPtr(artifactID)
+	}
+}
+
+// DeleteArtifactAccount sets the Account property of DeleteArtifactOptions. This changes the scope of the request
+// regardless of the authorization header.
+func DeleteArtifactAccount(account string) DeleteArtifactOption {
+	return func(options *DeleteArtifactOptions) {
+		options.Account = core.StringPtr(account)
+	}
+}
+
+// DeleteArtifactHeader sets the Headers property of DeleteArtifactOptions. Allows users to set headers on API
+// requests.
+func DeleteArtifactHeader(name string, value string) DeleteArtifactOption {
+	return func(options *DeleteArtifactOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteArtifactOptionsWith returns DeleteArtifactOptions populated by applying "options" in order.
+func (*GlobalCatalogV1) NewDeleteArtifactOptionsWith(options ...DeleteArtifactOption) *DeleteArtifactOptions {
+	deleteArtifactOptions := &DeleteArtifactOptions{}
+	for _, option := range options {
+		option(deleteArtifactOptions)
+	}
+	return deleteArtifactOptions
+}
+
+// DeleteArtifactWith is an alternate form of the DeleteArtifactWithContext method which takes functional options
+// instead of a DeleteArtifactOptions struct. DeleteArtifactObjectID and DeleteArtifactArtifactID are required.
+func (globalCatalog *GlobalCatalogV1) DeleteArtifactWith(ctx context.Context, options ...DeleteArtifactOption) (response *core.DetailedResponse, err error) {
+	return globalCatalog.DeleteArtifactWithContext(ctx, globalCatalog.NewDeleteArtifactOptionsWith(options...))
+}
@@ -0,0 +1,292 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the MetricPricing.TierModel property.
+// How the price of a quantity is computed from the tiers of a metric.
+const (
+	// Every unit is charged the price of the first tier.
+	PricingTierModelLinearConst = "Linear"
+	// Every unit is charged the price of the tier that the whole quantity falls into.
+	PricingTierModelStepTierConst = "Step Tier"
+	// The units in each tier are charged the price of that tier.
+	PricingTierModelGranularTierConst = "Granular Tier"
+	// The whole quantity is charged the (flat) price of the tier that it falls into.
+	PricingTierModelBlockTierConst = "Block Tier"
+)
+
+// ErrPriceNotFound is returned (wrapped) by GetPriceFor and MetricPricing.PriceFor when the plan has no price for the
+// metric or the region.
+var ErrPriceNotFound = errors.New("price not found")
+
+// PlanPricing : The pricing of a plan, with the loosely typed fields of PricingGet converted, as returned by
+// GetPlanPricing.
+type PlanPricing struct {
+	// The ID of the plan.
+	PlanID string
+
+	// The type of the plan (e.g. "paygo").
+	Type string
+
+	// Where the pricing originates.
+	Origin string
+
+	// The pricing of each metric of the plan.
+	Metrics []MetricPricing
+}
+
+// MetricPricing : The pricing of one metric of a plan.
+type MetricPricing struct {
+	// The metric ID or part number.
+	MetricID string
+
+	// The part reference.
+	PartRef string
+
+	// The unit that is charged (e.g. "GIGABYTE_HOURS").
+	ChargeUnit string
+
+	// The name of the charge unit.
+	ChargeUnitName string
+
+	// The number of units that a price applies to (e.g. 1000 for a price per thousand API calls). Defaults to 1.
+	ChargeUnitQuantity float64
+
+	// How the tiers are applied (a PricingTierModel*Const value).
+	TierModel string
+
+	// The period during which the pricing is effective. Nil times are unbounded.
+	EffectiveFrom  *time.Time
+	EffectiveUntil *time.Time
+
+	// The prices of the metric in each country.
+	Amounts []MetricAmount
+}
+
+// MetricAmount : The prices of a metric in one country.
+type MetricAmount struct {
+	// The country (e.g. "USA").
+	Country string
+
+	// The currency of the prices (e.g. "USD").
+	Currency string
+
+	// The tiers, sorted by their upper bound.
+	Tiers []PriceTier
+}
+
+// PriceTier : One tier of the prices of a metric.
+type PriceTier struct {
+	// The upper bound of the tier, in charge units (see MetricPricing.ChargeUnitQuantity). The last tier is unbounded.
+	UpTo float64
+
+	// The price of a charge unit in the tier, or the flat price of the tier for PricingTierModelBlockTierConst.
+	Price float64
+}
+
+// PriceQuote : The price of a quantity of a metric, as returned by GetPriceFor.
+type PriceQuote struct {
+	// The ID of the plan.
+	PlanID string
+
+	// The metric that was priced.
+	Metric *MetricPricing
+
+	// The country and currency of the price.
+	Country  string
+	Currency string
+
+	// The quantity that was priced, in units of the metric.
+	Quantity float64
+
+	// The price of the quantity.
+	Amount float64
+}
+
+// IsEffective returns true if the pricing of the metric is effective at time "t".
+func (metric *MetricPricing) IsEffective(t time.Time) bool {
+	return (metric.EffectiveFrom == nil || !t.Before(*metric.EffectiveFrom)) &&
+		(metric.EffectiveUntil == nil || t.Before(*metric.EffectiveUntil))
+}
+
+// AmountFor returns the prices of the metric in a country, matched case-insensitively, or nil.
+func (metric *MetricPricing) AmountFor(country string) *MetricAmount {
+	for i := range metric.Amounts {
+		if strings.EqualFold(metric.Amounts[i].Country, country) {
+			return &metric.Amounts[i]
+		}
+	}
+	return nil
+}
+
+// PriceFor returns the price of "quantity" units of the metric in a country, applying the tiers of the metric as
+// defined by its tier model. An unknown tier model is applied like PricingTierModelStepTierConst.
+func (metric *MetricPricing) PriceFor(country string, quantity float64) (amount float64, err error) {
+	prices := metric.AmountFor(country)
+	if prices == nil || len(prices.Tiers) == 0 {
+		err = fmt.Errorf("metric '%s' has no price in '%s': %w", metric.MetricID, country, ErrPriceNotFound)
+		return
+	}
+	unitQuantity := metric.ChargeUnitQuantity
+	if unitQuantity <= 0 {
+		unitQuantity = 1
+	}
+	units := quantity / unitQuantity
+	tiers := prices.Tiers
+
+	switch metric.TierModel {
+	case PricingTierModelLinearConst:
+		amount = units * tiers[0].Price
+	case PricingTierModelGranularTierConst:
+		lower := 0.0
+		for i, tier := range tiers {
+			upper := tier.UpTo
+			if i == len(tiers)-1 || upper > units {
+				upper = units
+			}
+			if upper > lower {
+				amount += (upper - lower) * tier.Price
+				lower = upper
+			}
+		}
+	case PricingTierModelBlockTierConst:
+		amount = tierFor(tiers, units).Price
+	default:
+		amount = units * tierFor(tiers, units).Price
+	}
+	return
+}
+
+// tierFor returns the first tier whose upper bound is not below "units", or the last tier.
+func tierFor(tiers []PriceTier, units float64) PriceTier {
+	for _, tier := range tiers {
+		if units <= tier.UpTo {
+			return tier
+		}
+	}
+	return tiers[len(tiers)-1]
+}
+
+// GetPlanPricing retrieves the pricing of a plan (see GetPricing) and converts it into a PlanPricing.
+func (globalCatalog *GlobalCatalogV1) GetPlanPricing(ctx context.Context, planID string) (result *PlanPricing, err error) {
+	pricing, _, err := globalCatalog.GetPricingWithContext(ctx, globalCatalog.NewGetPricingOptions(planID))
+	if err != nil {
+		err = fmt.Errorf("error retrieving the pricing of plan '%s': %w", planID, err)
+		return
+	}
+	result = &PlanPricing{
+		PlanID: planID,
+		Type:   core.StringNilMapper(pricing.Type),
+		Origin: core.StringNilMapper(pricing.Origin),
+	}
+	for _, metrics := range pricing.Metrics {
+		result.Metrics = append(result.Metrics, newMetricPricing(&metrics))
+	}
+	return
+}
+
+// GetPriceFor returns the price of "quantity" units of a metric of a plan in a region, such as the estimated monthly
+// cost of 500 GB of storage. The metric is matched by its metric ID or charge unit, and the region by the country of
+// the prices (e.g. "USA"); both are case-insensitive. If the plan has several pricings for the metric, the one
+// effective now is used.
+func (globalCatalog *GlobalCatalogV1) GetPriceFor(ctx context.Context, planID string, metric string, region string, quantity float64) (result *PriceQuote, err error) {
+	pricing, err := globalCatalog.GetPlanPricing(ctx, planID)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	for i := range pricing.Metrics {
+		candidate := &pricing.Metrics[i]
+		if !strings.EqualFold(candidate.MetricID, metric) && !strings.EqualFold(candidate.ChargeUnit, metric) {
+			continue
+		}
+		if !candidate.IsEffective(now) {
+			continue
+		}
+		var amount float64
+		amount, err = candidate.PriceFor(region, quantity)
+		if err != nil {
+			return
+		}
+		prices := candidate.AmountFor(region)
+		result = &PriceQuote{
+			PlanID:   planID,
+			Metric:   candidate,
+			Country:  prices.Country,
+			Currency: prices.Currency,
+			Quantity: quantity,
+			Amount:   amount,
+		}
+		return
+	}
+	err = fmt.Errorf("plan '%s' has no effective pricing for metric '%s': %w", planID, metric, ErrPriceNotFound)
+	return
+}
+
+// newMetricPricing converts the pricing of a metric.
+func newMetricPricing(metrics *Metrics) (metric MetricPricing) {
+	metric.MetricID = core.StringNilMapper(metrics.MetricID)
+	metric.PartRef = core.StringNilMapper(metrics.PartRef)
+	metric.ChargeUnit = core.StringNilMapper(metrics.ChargeUnit)
+	metric.ChargeUnitName = core.StringNilMapper(metrics.ChargeUnitName)
+	metric.TierModel = core.StringNilMapper(metrics.TierModel)
+	metric.ChargeUnitQuantity = 1
+	if quantity, err := strconv.ParseFloat(core.StringNilMapper(metrics.ChargeUnitQuantity), 64); err == nil && quantity > 0 {
+		metric.ChargeUnitQuantity = quantity
+	}
+	if metrics.EffectiveFrom != nil {
+		from := time.Time(*metrics.EffectiveFrom)
+		metric.EffectiveFrom = &from
+	}
+	if metrics.EffectiveUntil != nil {
+		until := time.Time(*metrics.EffectiveUntil)
+		metric.EffectiveUntil = &until
+	}
+	for _, amount := range metrics.Amounts {
+		prices := MetricAmount{
+			Country:  core.StringNilMapper(amount.Country),
+			Currency: core.StringNilMapper(amount.Currency),
+		}
+		for _, price := range amount.Prices {
+			tier := PriceTier{}
+			if price.QuantityTier != nil {
+				tier.UpTo = float64(*price.QuantityTier)
+			}
+			if price.Price != nil {
+				tier.Price = *price.Price
+			}
+			prices.Tiers = append(prices.Tiers, tier)
+		}
+		sort.SliceStable(prices.Tiers, func(i, j int) bool {
+			return prices.Tiers[i].UpTo < prices.Tiers[j].UpTo
+		})
+		metric.Amounts = append(metric.Amounts, prices)
+	}
+	return
+}
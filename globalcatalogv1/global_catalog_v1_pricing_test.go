@@ -0,0 +1,98 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalCatalogV1 price lookup`, func() {
+	var testServer *httptest.Server
+	var globalCatalogService *globalcatalogv1.GlobalCatalogV1
+	BeforeEach(func() {
+		tiers := `[{"country": "USA", "currency": "USD", "prices": [{"quantity_tier": 1000, "Price": 2}, {"quantity_tier": 100, "Price": 3}, {"quantity_tier": 999999999, "Price": 1}]},
+			{"country": "DEU", "currency": "EUR", "prices": [{"quantity_tier": 999999999, "Price": 4}]}]`
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/plan/pricing"))
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprintf(res, `{"type": "paygo", "origin": "pricing_catalog", "metrics": [
+				{"metric_id": "expired", "charge_unit": "INSTANCES", "effective_until": "2020-01-01T00:00:00.000Z", "tier_model": "Linear", "amounts": %[1]s},
+				{"metric_id": "part-instances", "charge_unit": "INSTANCES", "tier_model": "Linear", "amounts": %[1]s},
+				{"metric_id": "part-gb", "charge_unit": "GIGABYTE_MONTHS", "tier_model": "Granular Tier", "amounts": %[1]s},
+				{"metric_id": "part-step", "charge_unit": "STEPS", "tier_model": "Step Tier", "amounts": %[1]s},
+				{"metric_id": "part-block", "charge_unit": "BLOCKS", "tier_model": "Block Tier", "amounts": %[1]s},
+				{"metric_id": "part-calls", "charge_unit": "API_CALLS", "charge_unit_quantity": "1000", "tier_model": "Linear", "amounts": %[1]s}]}`, tiers)
+		}))
+		var serviceErr error
+		globalCatalogService, serviceErr = globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Converts the pricing of a plan`, func() {
+		pricing, err := globalCatalogService.GetPlanPricing(context.Background(), "plan")
+		Expect(err).To(BeNil())
+		Expect(pricing.Type).To(Equal("paygo"))
+		Expect(pricing.Metrics).To(HaveLen(6))
+		Expect(pricing.Metrics[0].EffectiveUntil).ToNot(BeNil())
+		Expect(pricing.Metrics[5].ChargeUnitQuantity).To(Equal(1000.0))
+		Expect(pricing.Metrics[1].AmountFor("usa").Tiers).To(Equal([]globalcatalogv1.PriceTier{
+			{UpTo: 100, Price: 3}, {UpTo: 1000, Price: 2}, {UpTo: 999999999, Price: 1},
+		}))
+	})
+	It(`Resolves tiered pricing into an amount`, func() {
+		price := func(metric string, region string, quantity float64) float64 {
+			quote, err := globalCatalogService.GetPriceFor(context.Background(), "plan", metric, region, quantity)
+			Expect(err).To(BeNil())
+			return quote.Amount
+		}
+		Expect(price("instances", "USA", 10)).To(Equal(30.0))
+		Expect(price("part-gb", "USA", 1500)).To(Equal(100*3.0 + 900*2.0 + 500*1.0))
+		Expect(price("part-step", "USA", 1500)).To(Equal(1500.0))
+		Expect(price("part-step", "USA", 50)).To(Equal(150.0))
+		Expect(price("part-block", "USA", 500)).To(Equal(2.0))
+		Expect(price("API_CALLS", "USA", 5000)).To(Equal(15.0))
+
+		quote, err := globalCatalogService.GetPriceFor(context.Background(), "plan", "part-gb", "deu", 10)
+		Expect(err).To(BeNil())
+		Expect(quote.Currency).To(Equal("EUR"))
+		Expect(quote.Amount).To(Equal(40.0))
+		Expect(quote.Metric.MetricID).To(Equal("part-gb"))
+	})
+	It(`Fails when the plan has no price for the metric or region`, func() {
+		_, err := globalCatalogService.GetPriceFor(context.Background(), "plan", "expired", "USA", 1)
+		Expect(errors.Is(err, globalcatalogv1.ErrPriceNotFound)).To(BeTrue())
+		_, err = globalCatalogService.GetPriceFor(context.Background(), "plan", "part-gb", "JPN", 1)
+		Expect(errors.Is(err, globalcatalogv1.ErrPriceNotFound)).To(BeTrue())
+	})
+})
@@ -0,0 +1,209 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the CatalogEntry.Kind property, for the kinds of the children of a service.
+const (
+	CatalogEntryKindDeploymentConst = "deployment"
+	CatalogEntryKindPlanConst       = "plan"
+)
+
+// DefaultCatalogTreeConcurrency is the number of entries whose children GetCatalogTree retrieves concurrently when no
+// concurrency is specified.
+const DefaultCatalogTreeConcurrency = 4
+
+// The number of children retrieved per request by GetCatalogTree.
+const catalogTreePageSize = 100
+
+// CatalogTreeNode : A catalog entry and its children, as returned by GetCatalogTree.
+type CatalogTreeNode struct {
+	// The catalog entry.
+	Entry *CatalogEntry
+
+	// The children of the entry, in the order returned by the service. Nil if the children were not retrieved because
+	// of the depth of the tree.
+	Children []*CatalogTreeNode
+}
+
+// ID returns the ID of the entry.
+func (node *CatalogTreeNode) ID() string {
+	return core.StringNilMapper(node.Entry.ID)
+}
+
+// Kind returns the kind of the entry (e.g. CatalogEntryKindPlanConst).
+func (node *CatalogTreeNode) Kind() string {
+	return core.StringNilMapper(node.Entry.Kind)
+}
+
+// ChildrenOfKind returns the children of the entry of the specified kind, for example the plans of a service.
+func (node *CatalogTreeNode) ChildrenOfKind(kind string) (children []*CatalogTreeNode) {
+	for _, child := range node.Children {
+		if child.Kind() == kind {
+			children = append(children, child)
+		}
+	}
+	return
+}
+
+// Find returns the node of the entry with the specified ID in the tree rooted at the node, or nil.
+func (node *CatalogTreeNode) Find(id string) (found *CatalogTreeNode) {
+	node.Walk(func(descendant *CatalogTreeNode, depth int) {
+		if found == nil && descendant.ID() == id {
+			found = descendant
+		}
+	})
+	return
+}
+
+// Walk calls "visit" for the node and each of its descendants, parents before their children. The depth of the node
+// is zero.
+func (node *CatalogTreeNode) Walk(visit func(node *CatalogTreeNode, depth int)) {
+	node.walk(visit, 0)
+}
+
+func (node *CatalogTreeNode) walk(visit func(node *CatalogTreeNode, depth int), depth int) {
+	visit(node, depth)
+	for _, child := range node.Children {
+		child.walk(visit, depth+1)
+	}
+}
+
+// GetCatalogTree retrieves a catalog entry and its descendants down to "depth" levels (all levels if "depth" is
+// negative), for example a service with its plans and their deployments for a depth of 2. The children of up to
+// "concurrency" entries are retrieved concurrently (DefaultCatalogTreeConcurrency if "concurrency" is not positive),
+// across as many pages as needed.
+func (globalCatalog *GlobalCatalogV1) GetCatalogTree(ctx context.Context, rootID string, depth int, concurrency int) (result *CatalogTreeNode, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, globalCatalog.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	entry, _, err := globalCatalog.GetCatalogEntryWithContext(ctx, globalCatalog.NewGetCatalogEntryOptions(rootID))
+	if err != nil {
+		err = fmt.Errorf("error retrieving catalog entry '%s': %w", rootID, err)
+		return
+	}
+	root := &CatalogTreeNode{Entry: entry}
+	if depth == 0 {
+		result = root
+		return
+	}
+
+	if concurrency <= 0 {
+		concurrency = DefaultCatalogTreeConcurrency
+	}
+	builder := &catalogTreeBuilder{
+		globalCatalog: globalCatalog,
+		semaphore:     make(chan struct{}, concurrency),
+		visited:       map[string]bool{rootID: true},
+	}
+	ctx, builder.cancel = context.WithCancel(ctx)
+	defer builder.cancel()
+	builder.waitGroup.Add(1)
+	go builder.addChildren(ctx, root, depth)
+	builder.waitGroup.Wait()
+	if builder.err != nil {
+		err = builder.err
+		return
+	}
+	result = root
+	return
+}
+
+type catalogTreeBuilder struct {
+	globalCatalog *GlobalCatalogV1
+	semaphore     chan struct{}
+	waitGroup     sync.WaitGroup
+	cancel        context.CancelFunc
+
+	mutex   sync.Mutex
+	visited map[string]bool
+	err     error
+}
+
+// addChildren retrieves the children of "node", then the children of its children concurrently while "depth" allows.
+// The semaphore is only held while retrieving, so that waiting for the children of nested entries cannot exhaust it.
+func (builder *catalogTreeBuilder) addChildren(ctx context.Context, node *CatalogTreeNode, depth int) {
+	defer builder.waitGroup.Done()
+	select {
+	case builder.semaphore <- struct{}{}:
+	case <-ctx.Done():
+		builder.fail(ctx.Err())
+		return
+	}
+	children, err := builder.listChildren(ctx, node.ID())
+	<-builder.semaphore
+	if err != nil {
+		builder.fail(fmt.Errorf("error retrieving the children of catalog entry '%s': %w", node.ID(), err))
+		return
+	}
+
+	node.Children = make([]*CatalogTreeNode, 0, len(children))
+	for i := range children {
+		node.Children = append(node.Children, &CatalogTreeNode{Entry: &children[i]})
+	}
+	if depth == 1 {
+		return
+	}
+	for _, child := range node.Children {
+		// An entry that appears more than once (which would otherwise make the walk endless) is only expanded once.
+		builder.mutex.Lock()
+		expand := !builder.visited[child.ID()]
+		builder.visited[child.ID()] = true
+		builder.mutex.Unlock()
+		if expand {
+			builder.waitGroup.Add(1)
+			go builder.addChildren(ctx, child, depth-1)
+		}
+	}
+}
+
+// listChildren retrieves the children of all kinds of an entry, across pages.
+func (builder *catalogTreeBuilder) listChildren(ctx context.Context, id string) (children []CatalogEntry, err error) {
+	options := builder.globalCatalog.NewGetChildObjectsOptions(id, "*")
+	options.Limit = core.Int64Ptr(catalogTreePageSize)
+	for offset := int64(0); ; {
+		options.Offset = core.Int64Ptr(offset)
+		page, _, err := builder.globalCatalog.GetChildObjectsWithContext(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, page.Resources...)
+		offset += int64(len(page.Resources))
+		if len(page.Resources) == 0 || page.Next == nil || *page.Next == "" ||
+			(page.Count != nil && offset >= *page.Count) {
+			return children, nil
+		}
+	}
+}
+
+// fail records the first error and stops the retrieval of the other children.
+func (builder *catalogTreeBuilder) fail(err error) {
+	builder.mutex.Lock()
+	defer builder.mutex.Unlock()
+	if builder.err == nil {
+		builder.err = err
+		builder.cancel()
+	}
+}
@@ -0,0 +1,108 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalCatalogV1 catalog tree`, func() {
+	var testServer *httptest.Server
+	var globalCatalogService *globalcatalogv1.GlobalCatalogV1
+	var mutex sync.Mutex
+	var requested []string
+	BeforeEach(func() {
+		// The service "svc" has the plans "lite" and "std" (returned in two pages); "std" has the deployment "std-us"
+		// and "lite" has none.
+		entry := func(id string, kind string) string {
+			return fmt.Sprintf(`{"id": "%s", "kind": "%s", "name": "%s"}`, id, kind, id)
+		}
+		requested = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			mutex.Lock()
+			requested = append(requested, req.URL.Path)
+			mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Path {
+			case "/svc":
+				fmt.Fprint(res, entry("svc", "service"))
+			case "/svc/*":
+				Expect(req.URL.Query().Get("_limit")).To(Equal("100"))
+				if req.URL.Query().Get("_offset") == "0" {
+					fmt.Fprintf(res, `{"count": 2, "resource_count": 1, "next": "/svc/*?_offset=1", "resources": [%s]}`, entry("lite", "plan"))
+					return
+				}
+				fmt.Fprintf(res, `{"count": 2, "resource_count": 1, "resources": [%s]}`, entry("std", "plan"))
+			case "/std/*":
+				fmt.Fprintf(res, `{"count": 1, "resource_count": 1, "resources": [%s]}`, entry("std-us", "deployment"))
+			case "/lite/*", "/std-us/*":
+				fmt.Fprint(res, `{"count": 0, "resource_count": 0, "resources": []}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			}
+		}))
+		var serviceErr error
+		globalCatalogService, serviceErr = globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Retrieves the offerings, plans and deployments of an entry`, func() {
+		tree, err := globalCatalogService.GetCatalogTree(context.Background(), "svc", -1, 1)
+		Expect(err).To(BeNil())
+		Expect(tree.ID()).To(Equal("svc"))
+
+		var visited []string
+		tree.Walk(func(node *globalcatalogv1.CatalogTreeNode, depth int) {
+			visited = append(visited, fmt.Sprintf("%s%s", strings.Repeat(" ", depth), node.ID()))
+		})
+		Expect(visited).To(Equal([]string{"svc", " lite", " std", "  std-us"}))
+		Expect(tree.ChildrenOfKind(globalcatalogv1.CatalogEntryKindPlanConst)).To(HaveLen(2))
+		Expect(tree.Find("std-us").Kind()).To(Equal(globalcatalogv1.CatalogEntryKindDeploymentConst))
+		Expect(tree.Find("missing")).To(BeNil())
+	})
+	It(`Stops at the requested depth`, func() {
+		tree, err := globalCatalogService.GetCatalogTree(context.Background(), "svc", 1, 0)
+		Expect(err).To(BeNil())
+		Expect(tree.Children).To(HaveLen(2))
+		Expect(tree.Find("std").Children).To(BeNil())
+		Expect(requested).ToNot(ContainElement("/std/*"))
+	})
+	It(`Returns the error of a failed retrieval`, func() {
+		_, err := globalCatalogService.GetCatalogTree(context.Background(), "missing", 2, 0)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("error retrieving catalog entry 'missing'"))
+	})
+})
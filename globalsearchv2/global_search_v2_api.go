@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package globalsearchv2
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// GlobalSearchV2API : The operations of the GlobalSearchV2 service, implemented by *GlobalSearchV2.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type GlobalSearchV2API interface {
+	// Clone makes a copy of "globalSearch" suitable for processing requests.
+	Clone() *GlobalSearchV2
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// Search : Find instances of resources (v3)
+	Search(searchOptions *SearchOptions) (result *ScanResult, response *core.DetailedResponse, err error)
+
+	// SearchWithContext is an alternate form of the Search method which supports a Context parameter
+	SearchWithContext(ctx context.Context, searchOptions *SearchOptions) (result *ScanResult, response *core.DetailedResponse, err error)
+
+	// GetSupportedTypes : DEPRECATED. Get all GhoST indices
+	GetSupportedTypes(getSupportedTypesOptions *GetSupportedTypesOptions) (result *SupportedTypesList, response *core.DetailedResponse, err error)
+
+	// GetSupportedTypesWithContext is an alternate form of the GetSupportedTypes method which supports a Context
+	// parameter
+	GetSupportedTypesWithContext(ctx context.Context, getSupportedTypesOptions *GetSupportedTypesOptions) (result *SupportedTypesList, response *core.DetailedResponse, err error)
+
+	// NewGetSupportedTypesOptions : Instantiate GetSupportedTypesOptions
+	NewGetSupportedTypesOptions() *GetSupportedTypesOptions
+
+	// NewSearchOptions : Instantiate SearchOptions
+	NewSearchOptions() *SearchOptions
+
+	// NewSearchOptionsWith returns SearchOptions populated by applying "options" in order.
+	NewSearchOptionsWith(options ...SearchOption) *SearchOptions
+
+	// SearchWith is an alternate form of the SearchWithContext method which takes functional options instead of a
+	// SearchOptions struct.
+	SearchWith(ctx context.Context, options ...SearchOption) (result *ScanResult, response *core.DetailedResponse, err error)
+
+	// NewGetSupportedTypesOptionsWith returns GetSupportedTypesOptions populated by applying "options" in order.
+	NewGetSupportedTypesOptionsWith(options ...GetSupportedTypesOption) *GetSupportedTypesOptions
+
+	// GetSupportedTypesWith is an alternate form of the GetSupportedTypesWithContext method which takes functional
+	// options instead of a GetSupportedTypesOptions struct.
+	GetSupportedTypesWith(ctx context.Context, options ...GetSupportedTypesOption) (result *SupportedTypesList, response *core.DetailedResponse, err error)
+
+	// NewSearchPager returns a new SearchPager instance.
+	NewSearchPager(options *SearchOptions) (pager *SearchPager, err error)
+
+	// NewSearchPagerFromCheckpoint returns a new SearchPager instance that continues the scan recorded in
+	// "checkpoint".
+	NewSearchPagerFromCheckpoint(options *SearchOptions, checkpoint *SearchCheckpoint) (pager *SearchPager, err error)
+}
+
+var _ GlobalSearchV2API = (*GlobalSearchV2)(nil)
@@ -0,0 +1,34 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2
+
+import (
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// CRNQuery returns a Lucene query string that matches the resources with any of the specified CRNs, for use with
+// SearchOptions.SetQuery. CRNs contain colons, so each one is quoted.
+func CRNQuery(crns ...common.CRN) string {
+	if len(crns) == 0 {
+		return ""
+	}
+	queries := make([]Query, len(crns))
+	for i, crn := range crns {
+		queries[i] = Field("crn").Eq(crn.String())
+	}
+	return Any(queries...).String()
+}
@@ -0,0 +1,35 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2_test
+
+import (
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalSearchV2 CRNQuery`, func() {
+	It(`Quotes each CRN`, func() {
+		first := common.NewCRN("kms", "us-south", common.AccountScope("acct1"), "inst1")
+		second := first.WithResource("key", "k1")
+		Expect(globalsearchv2.CRNQuery(first)).To(Equal(`crn:"crn:v1:bluemix:public:kms:us-south:a/acct1:inst1::"`))
+		Expect(globalsearchv2.CRNQuery(first, second)).To(Equal(
+			`crn:"crn:v1:bluemix:public:kms:us-south:a/acct1:inst1::" OR crn:"crn:v1:bluemix:public:kms:us-south:a/acct1:inst1:key:k1"`))
+		Expect(globalsearchv2.CRNQuery()).To(Equal(""))
+	})
+})
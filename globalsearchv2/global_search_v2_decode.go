@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// Constants associated with the "type" property of search results.
+const (
+	ResourceTypeResourceInstanceConst = "resource-instance"
+	ResourceTypeClusterConst          = "k8-cluster"
+	ResourceTypeCOSBucketConst        = "bucket"
+)
+
+// DecodeItem decodes the properties of a search result into "result", which must be a pointer to a struct with JSON
+// field tags, such as one of the typed results of this package (e.g. *ResourceInstanceItem) or a struct describing
+// the fields requested with SearchOptions.SetFields. Properties that are not returned leave the corresponding fields
+// unchanged; properties whose type does not match the field cause an error.
+func DecodeItem(item *ResultItem, result interface{}) (err error) {
+	if item == nil {
+		err = fmt.Errorf("item cannot be nil")
+		return
+	}
+	buffer, err := json.Marshal(item)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(buffer, result)
+	if err != nil {
+		crn := ""
+		if item.CRN != nil {
+			crn = *item.CRN
+		}
+		err = fmt.Errorf("error decoding search result '%s': %w", crn, err)
+	}
+	return
+}
+
+// ResourceItem : The properties that the search service returns for every kind of resource.
+type ResourceItem struct {
+	// Resource identifier in CRN format.
+	CRN *string `json:"crn,omitempty"`
+
+	// The name of the resource.
+	Name *string `json:"name,omitempty"`
+
+	// The family of the resource (e.g. "resource_controller").
+	Family *string `json:"family,omitempty"`
+
+	// The type of the resource (e.g. ResourceTypeResourceInstanceConst).
+	Type *string `json:"type,omitempty"`
+
+	// The region of the resource.
+	Region *string `json:"region,omitempty"`
+
+	// The ID of the account that owns the resource.
+	AccountID *string `json:"account_id,omitempty"`
+
+	// The ID of the resource group of the resource.
+	ResourceGroupID *string `json:"resource_group_id,omitempty"`
+
+	// The tags attached to the resource.
+	Tags []string `json:"tags,omitempty"`
+
+	// The date when the resource was created.
+	CreationDate *strfmt.DateTime `json:"creation_date,omitempty"`
+
+	// The date when the resource was last modified.
+	ModificationDate *strfmt.DateTime `json:"modification_date,omitempty"`
+}
+
+// ResourceInstanceItem : A search result that describes a resource instance.
+type ResourceInstanceItem struct {
+	ResourceItem
+
+	// The properties specific to resource instances.
+	Doc *ResourceInstanceDoc `json:"doc,omitempty"`
+}
+
+// ResourceInstanceDoc : The properties of a resource instance returned in the "doc" field of a search result.
+type ResourceInstanceDoc struct {
+	// The ID of the service offering of the instance.
+	ResourceID *string `json:"resource_id,omitempty"`
+
+	// The ID of the plan of the instance.
+	ResourcePlanID *string `json:"resource_plan_id,omitempty"`
+
+	// The state of the instance (e.g. "active").
+	State *string `json:"state,omitempty"`
+
+	// The dashboard URL of the instance.
+	DashboardURL *string `json:"dashboard_url,omitempty"`
+}
+
+// ClusterItem : A search result that describes a Kubernetes or OpenShift cluster.
+type ClusterItem struct {
+	ResourceItem
+
+	// The properties specific to clusters.
+	Doc *ClusterDoc `json:"doc,omitempty"`
+}
+
+// ClusterDoc : The properties of a cluster returned in the "doc" field of a search result.
+type ClusterDoc struct {
+	// The state of the cluster (e.g. "normal").
+	State *string `json:"state,omitempty"`
+
+	// The Kubernetes version of the cluster master.
+	MasterKubeVersion *string `json:"master_kube_version,omitempty"`
+
+	// The number of worker nodes of the cluster.
+	WorkerCount *int64 `json:"worker_count,omitempty"`
+
+	// The data center of the cluster.
+	Datacenter *string `json:"datacenter,omitempty"`
+}
+
+// COSBucketItem : A search result that describes a Cloud Object Storage bucket.
+type COSBucketItem struct {
+	ResourceItem
+
+	// The properties specific to buckets.
+	Doc *COSBucketDoc `json:"doc,omitempty"`
+}
+
+// COSBucketDoc : The properties of a bucket returned in the "doc" field of a search result.
+type COSBucketDoc struct {
+	// The location of the bucket (e.g. "us-south").
+	Location *string `json:"location,omitempty"`
+
+	// The storage class of the bucket (e.g. "standard").
+	StorageClass *string `json:"storage_class,omitempty"`
+
+	// The CRN of the Cloud Object Storage instance that owns the bucket.
+	ServiceInstanceCRN *string `json:"service_instance_crn,omitempty"`
+}
+
+// DecodeResourceInstance decodes a search result into a ResourceInstanceItem.
+func DecodeResourceInstance(item *ResultItem) (result *ResourceInstanceItem, err error) {
+	result = &ResourceInstanceItem{}
+	if err = DecodeItem(item, result); err != nil {
+		result = nil
+	}
+	return
+}
+
+// DecodeCluster decodes a search result into a ClusterItem.
+func DecodeCluster(item *ResultItem) (result *ClusterItem, err error) {
+	result = &ClusterItem{}
+	if err = DecodeItem(item, result); err != nil {
+		result = nil
+	}
+	return
+}
+
+// DecodeCOSBucket decodes a search result into a COSBucketItem.
+func DecodeCOSBucket(item *ResultItem) (result *COSBucketItem, err error) {
+	result = &COSBucketItem{}
+	if err = DecodeItem(item, result); err != nil {
+		result = nil
+	}
+	return
+}
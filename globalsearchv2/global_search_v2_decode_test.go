@@ -0,0 +1,80 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalSearchV2 typed result decoding`, func() {
+	unmarshalItem := func(body string) *globalsearchv2.ResultItem {
+		var raw map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(body), &raw)).To(Succeed())
+		var item *globalsearchv2.ResultItem
+		Expect(globalsearchv2.UnmarshalResultItem(raw, &item)).To(Succeed())
+		return item
+	}
+
+	It(`Decodes resource instances`, func() {
+		item := unmarshalItem(`{"crn": "crn:v1:bluemix:public:cloudantnosqldb:us-south:a/acct:inst::", "name": "db",
+			"type": "resource-instance", "region": "us-south", "resource_group_id": "rg1", "tags": ["env:prod"],
+			"creation_date": "2022-03-01T10:00:00.000Z", "doc": {"state": "active", "resource_plan_id": "plan1"}}`)
+		instance, err := globalsearchv2.DecodeResourceInstance(item)
+		Expect(err).To(BeNil())
+		Expect(instance.CRN).To(Equal(item.CRN))
+		Expect(instance.Name).To(Equal(core.StringPtr("db")))
+		Expect(instance.Type).To(Equal(core.StringPtr(globalsearchv2.ResourceTypeResourceInstanceConst)))
+		Expect(instance.Tags).To(Equal([]string{"env:prod"}))
+		Expect(instance.CreationDate.String()).To(Equal("2022-03-01T10:00:00.000Z"))
+		Expect(instance.ModificationDate).To(BeNil())
+		Expect(instance.Doc.State).To(Equal(core.StringPtr("active")))
+		Expect(instance.Doc.ResourcePlanID).To(Equal(core.StringPtr("plan1")))
+	})
+	It(`Decodes clusters and buckets`, func() {
+		cluster, err := globalsearchv2.DecodeCluster(unmarshalItem(`{"crn": "crn:c", "type": "k8-cluster",
+			"doc": {"state": "normal", "worker_count": 3, "master_kube_version": "1.24.4"}}`))
+		Expect(err).To(BeNil())
+		Expect(cluster.Doc.WorkerCount).To(Equal(core.Int64Ptr(3)))
+		Expect(cluster.Doc.MasterKubeVersion).To(Equal(core.StringPtr("1.24.4")))
+
+		bucket, err := globalsearchv2.DecodeCOSBucket(unmarshalItem(`{"crn": "crn:b", "name": "logs",
+			"doc": {"location": "eu-de", "storage_class": "smart"}}`))
+		Expect(err).To(BeNil())
+		Expect(bucket.Name).To(Equal(core.StringPtr("logs")))
+		Expect(bucket.Doc.StorageClass).To(Equal(core.StringPtr("smart")))
+	})
+	It(`Decodes into caller-defined structs`, func() {
+		var result struct {
+			Name  string `json:"name"`
+			Owner string `json:"owner"`
+		}
+		Expect(globalsearchv2.DecodeItem(unmarshalItem(`{"crn": "crn:x", "name": "x", "owner": "ann"}`), &result)).To(Succeed())
+		Expect(result.Name).To(Equal("x"))
+		Expect(result.Owner).To(Equal("ann"))
+	})
+	It(`Reports mismatched properties`, func() {
+		_, err := globalsearchv2.DecodeCluster(unmarshalItem(`{"crn": "crn:c", "doc": {"worker_count": "three"}}`))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("crn:c"))
+		Expect(globalsearchv2.DecodeItem(nil, &struct{}{})).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,215 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultSearchExportPageSize is the default value of SearchExporter.PageSize, the largest page the search service
+// returns.
+const DefaultSearchExportPageSize = 1000
+
+// SearchExportColumns are the columns written by ExportSearchResults, in order. They are the properties of
+// ResourceItem: "tags" lists the tags separated by ";", and the dates are in RFC 3339 format.
+var SearchExportColumns = []common.Column{
+	{Name: "crn", Type: common.ColumnTypeString},
+	{Name: "name", Type: common.ColumnTypeString},
+	{Name: "family", Type: common.ColumnTypeString},
+	{Name: "type", Type: common.ColumnTypeString},
+	{Name: "region", Type: common.ColumnTypeString},
+	{Name: "account_id", Type: common.ColumnTypeString},
+	{Name: "resource_group_id", Type: common.ColumnTypeString},
+	{Name: "tags", Type: common.ColumnTypeString},
+	{Name: "creation_date", Type: common.ColumnTypeString},
+	{Name: "modification_date", Type: common.ColumnTypeString},
+}
+
+// SearchExporter : Exports the results of a search, however many there are, to an io.Writer such as the upload
+// stream of an object in IBM Cloud Object Storage. An export can be checkpointed, so that an export of a very large
+// account that is interrupted continues where it stopped.
+type SearchExporter struct {
+	*GlobalSearchV2
+
+	// If not empty, only the resources of this account are exported.
+	AccountID string
+
+	// The number of results retrieved per request. Defaults to DefaultSearchExportPageSize.
+	PageSize int64
+
+	// The ID of the export's checkpoint. Required if Checkpointer is set.
+	JobID string
+
+	// Stores the position of the export after each page. If nil, an interrupted export starts again from the first
+	// page.
+	Checkpointer common.Checkpointer
+}
+
+// NewSearchExporter returns a new SearchExporter that uses the specified client, without checkpoints.
+func NewSearchExporter(globalSearch *GlobalSearchV2) *SearchExporter {
+	return &SearchExporter{
+		GlobalSearchV2: globalSearch,
+		PageSize:       DefaultSearchExportPageSize,
+	}
+}
+
+// SearchExport : The outcome of ExportSearchResults.
+type SearchExport struct {
+	// The number of results written by this run of the export.
+	Items int64
+
+	// The number of pages retrieved by this run of the export.
+	Pages int64
+
+	// Whether this run continued from a saved checkpoint.
+	Resumed bool
+
+	// The position of the export: once the export is complete, Done is true.
+	Checkpoint *SearchCheckpoint
+}
+
+// ExportSearchResults writes the resources matched by "query" to "w" in the specified format (common.ExportFormatCSV
+// or common.ExportFormatNDJSON), with the columns described by SearchExportColumns. The results are retrieved one page
+// at a time, and each page is written and flushed to "w" before the next one is requested, so a slow writer slows the
+// export down rather than the results piling up in memory.
+//
+// The export runs as a common.Job: if the exporter has a Checkpointer, the position of the export is saved after each
+// page has been written, and an export that finds a checkpoint for its JobID continues from it: the caller should then
+// direct the output to a new object (or part of a multipart upload), as a CSV export starts with a header row again.
+// The checkpoint is cleared once the export is complete. If the export fails, the results written so far are described
+// by the returned export along with the error.
+func (exporter *SearchExporter) ExportSearchResults(ctx context.Context, query Query, w io.Writer, format string) (export *SearchExport, err error) {
+	if format != common.ExportFormatCSV && format != common.ExportFormatNDJSON {
+		err = fmt.Errorf("unsupported search export format '%s'", format)
+		return
+	}
+	if exporter.Checkpointer != nil && exporter.JobID == "" {
+		err = fmt.Errorf("a job ID is required to checkpoint the export")
+		return
+	}
+	pageSize := exporter.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultSearchExportPageSize
+	}
+	fields := make([]string, len(SearchExportColumns))
+	for i, column := range SearchExportColumns {
+		fields[i] = column.Name
+	}
+	options := exporter.NewSearchOptions().SetQueryFrom(query).SetFields(fields).SetLimit(pageSize)
+	if exporter.AccountID != "" {
+		options.SetAccountID(exporter.AccountID)
+	}
+
+	export = &SearchExport{}
+	tableWriter, err := common.NewTableWriter(w, format, SearchExportColumns)
+	if err != nil {
+		return
+	}
+
+	// The cursor of the job is the checkpoint of the pager, which is created from the saved checkpoint, if any.
+	var pager *SearchPager
+	fetch := func(ctx context.Context, cursor string) (items []interface{}, nextCursor string, err error) {
+		if pager == nil {
+			pager, err = exporter.resumePager(options, cursor)
+			if err != nil {
+				return
+			}
+			export.Resumed = cursor != ""
+			export.Checkpoint = pager.Checkpoint()
+		}
+		page, err := pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("error retrieving page %d of the search results: %w", pager.Checkpoint().PageIndex+1, err)
+			return
+		}
+		for i := range page {
+			items = append(items, &page[i])
+		}
+		if checkpoint := pager.Checkpoint(); !checkpoint.Done {
+			nextCursor = checkpoint.String()
+		}
+		return
+	}
+	job := &common.Job{
+		ID:           exporter.JobID,
+		Checkpointer: exporter.Checkpointer,
+		AfterPage: func(ctx context.Context) error {
+			if err := tableWriter.(common.TableFlusher).Flush(); err != nil {
+				return fmt.Errorf("error writing the search results: %w", err)
+			}
+			return nil
+		},
+		OnProgress: func(progress common.JobProgress) {
+			export.Items = progress.Items
+			export.Pages = progress.Pages
+			export.Checkpoint = pager.Checkpoint()
+		},
+	}
+	err = job.Run(ctx, fetch, func(ctx context.Context, item interface{}) error {
+		return writeSearchExportRow(tableWriter, item.(*ResultItem))
+	})
+	var jobErr *common.JobError
+	if errors.As(err, &jobErr) {
+		err = jobErr.Err
+	}
+	if err != nil {
+		return
+	}
+	err = tableWriter.Close()
+	return
+}
+
+// resumePager returns a pager that continues from the checkpoint "cursor", or starts a new scan if it is empty.
+func (exporter *SearchExporter) resumePager(options *SearchOptions, cursor string) (pager *SearchPager, err error) {
+	if cursor == "" {
+		return exporter.NewSearchPager(options)
+	}
+	checkpoint, err := ParseSearchCheckpoint(cursor)
+	if err != nil {
+		return
+	}
+	return exporter.NewSearchPagerFromCheckpoint(options, checkpoint)
+}
+
+// writeSearchExportRow writes the row of a search result.
+func writeSearchExportRow(tableWriter common.TableWriter, item *ResultItem) (err error) {
+	var resource ResourceItem
+	err = DecodeItem(item, &resource)
+	if err != nil {
+		return
+	}
+	return tableWriter.WriteRow(resource.CRN, resource.Name, resource.Family, resource.Type, resource.Region,
+		resource.AccountID, resource.ResourceGroupID, strings.Join(resource.Tags, ";"),
+		formatSearchExportDate(resource.CreationDate), formatSearchExportDate(resource.ModificationDate))
+}
+
+// formatSearchExportDate returns a date in RFC 3339 format, or nil if it is not set.
+func formatSearchExportDate(date *strfmt.DateTime) *string {
+	if date == nil {
+		return nil
+	}
+	return core.StringPtr(time.Time(*date).UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,134 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalSearchV2 SearchExporter`, func() {
+	var testServer *httptest.Server
+	var exporter *globalsearchv2.SearchExporter
+	var failCursor string
+	var cursors []string
+	BeforeEach(func() {
+		failCursor = "none"
+		cursors = nil
+		pages := map[string]string{
+			"": `{"search_cursor": "cursor1", "items": [
+				{"crn": "crn1", "name": "bucket-1", "type": "bucket", "region": "us-south", "account_id": "acct", "tags": ["env:prod", "team:a"], "creation_date": "2022-01-02T03:04:05Z"},
+				{"crn": "crn2", "name": "bucket,2", "type": "bucket"}]}`,
+			"cursor1": `{"search_cursor": "cursor2", "items": [{"crn": "crn3", "name": "bucket-3", "type": "bucket"}]}`,
+			"cursor2": `{"search_cursor": "cursor3", "items": []}`,
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v3/resources/search"))
+			Expect(req.URL.Query().Get("account_id")).To(Equal("acct"))
+			Expect(req.URL.Query().Get("limit")).To(Equal("2"))
+			var body map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			Expect(body["query"]).To(Equal(`type:"bucket"`))
+			Expect(body["fields"]).To(ContainElements("crn", "tags", "modification_date"))
+			cursor, _ := body["search_cursor"].(string)
+			cursors = append(cursors, cursor)
+
+			res.Header().Set("Content-type", "application/json")
+			if cursor == failCursor {
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"error": "unavailable"}`)
+				return
+			}
+			fmt.Fprint(res, pages[cursor])
+		}))
+		globalSearchService, serviceErr := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		exporter = globalsearchv2.NewSearchExporter(globalSearchService)
+		exporter.AccountID = "acct"
+		exporter.PageSize = 2
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	query := globalsearchv2.Field("type").Eq("bucket")
+
+	It(`Exports every page as NDJSON`, func() {
+		buffer := new(bytes.Buffer)
+		export, err := exporter.ExportSearchResults(context.Background(), query, buffer, common.ExportFormatNDJSON)
+		Expect(err).To(BeNil())
+		Expect(export.Items).To(Equal(int64(3)))
+		Expect(export.Pages).To(Equal(int64(3)))
+		Expect(export.Checkpoint.Done).To(BeTrue())
+		Expect(buffer.String()).To(Equal(
+			`{"crn":"crn1","name":"bucket-1","type":"bucket","region":"us-south","account_id":"acct","tags":"env:prod;team:a","creation_date":"2022-01-02T03:04:05Z"}` + "\n" +
+				`{"crn":"crn2","name":"bucket,2","type":"bucket","tags":""}` + "\n" +
+				`{"crn":"crn3","name":"bucket-3","type":"bucket","tags":""}` + "\n"))
+	})
+	It(`Resumes an interrupted CSV export from its checkpoint`, func() {
+		checkpointer := common.NewMemoryCheckpointer()
+		exporter.Checkpointer = checkpointer
+		exporter.JobID = "export"
+		failCursor = "cursor1"
+
+		first := new(bytes.Buffer)
+		export, err := exporter.ExportSearchResults(context.Background(), query, first, common.ExportFormatCSV)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("error retrieving page 2"))
+		Expect(export.Items).To(Equal(int64(2)))
+		Expect(first.String()).To(Equal("crn,name,family,type,region,account_id,resource_group_id,tags,creation_date,modification_date\n" +
+			"crn1,bucket-1,,bucket,us-south,acct,,env:prod;team:a,2022-01-02T03:04:05Z,\n" +
+			"crn2,\"bucket,2\",,bucket,,,,,,\n"))
+		saved, found, _ := checkpointer.LoadCheckpoint(context.Background(), "export")
+		Expect(found).To(BeTrue())
+		Expect(saved).To(ContainSubstring("cursor1"))
+
+		failCursor = "none"
+		second := new(bytes.Buffer)
+		export, err = exporter.ExportSearchResults(context.Background(), query, second, common.ExportFormatCSV)
+		Expect(err).To(BeNil())
+		Expect(export.Resumed).To(BeTrue())
+		Expect(export.Items).To(Equal(int64(1)))
+		Expect(second.String()).To(HaveSuffix("\ncrn3,bucket-3,,bucket,,,,,,\n"))
+		Expect(cursors).To(Equal([]string{"", "cursor1", "cursor1", "cursor2"}))
+		_, found, _ = checkpointer.LoadCheckpoint(context.Background(), "export")
+		Expect(found).To(BeFalse())
+	})
+	It(`Rejects unsupported formats and missing job IDs`, func() {
+		_, err := exporter.ExportSearchResults(context.Background(), query, new(bytes.Buffer), common.ExportFormatParquet)
+		Expect(err).ToNot(BeNil())
+		exporter.Checkpointer = common.NewMemoryCheckpointer()
+		_, err = exporter.ExportSearchResults(context.Background(), query, new(bytes.Buffer), common.ExportFormatCSV)
+		Expect(err).ToNot(BeNil())
+		Expect(cursors).To(BeEmpty())
+	})
+})
@@ -0,0 +1,241 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SearchCheckpoint : The position of a SearchPager within a search scan, from which an interrupted scan can be resumed.
+type SearchCheckpoint struct {
+	// The search cursor used to retrieve the next page; empty if no page has been retrieved yet.
+	SearchCursor string `json:"search_cursor,omitempty"`
+
+	// The number of pages retrieved so far.
+	PageIndex int64 `json:"page_index"`
+
+	// True if all pages have been retrieved.
+	Done bool `json:"done,omitempty"`
+}
+
+// String returns the JSON encoding of the checkpoint, suitable for storing with a common.Checkpointer.
+func (checkpoint *SearchCheckpoint) String() string {
+	data, _ := json.Marshal(checkpoint)
+	return string(data)
+}
+
+// ParseSearchCheckpoint decodes a checkpoint produced by SearchCheckpoint.String.
+func ParseSearchCheckpoint(value string) (checkpoint *SearchCheckpoint, err error) {
+	checkpoint = new(SearchCheckpoint)
+	err = json.Unmarshal([]byte(value), checkpoint)
+	if err != nil {
+		checkpoint = nil
+		err = fmt.Errorf("error parsing search checkpoint: %s", err.Error())
+	}
+	return
+}
+
+// ErrSearchMaxResultsExceeded is wrapped by the error returned by a SearchPager when the scan has more results than
+// the pager's maximum (see SearchPager.SetMaxResults).
+var ErrSearchMaxResultsExceeded = errors.New("the search has more results than the maximum")
+
+// SearchPager can be used to simplify the use of the "Search" method.
+// A failed call to GetNext may be retried: the pager only advances once a page has been retrieved successfully.
+// Results can be retrieved a page at a time with GetNext, all at once with GetAll, or one at a time with Next.
+type SearchPager struct {
+	hasNext     bool
+	options     *SearchOptions
+	client      *GlobalSearchV2
+	pageContext struct {
+		next      *string
+		pageIndex int64
+	}
+
+	// The maximum number of results, the number returned so far, and whether results were dropped to respect it.
+	maxResults int64
+	results    int64
+	truncated  bool
+
+	// The state of Next: the current page, the index of the current item within it, and the last error.
+	page      []ResultItem
+	itemIndex int
+	err       error
+}
+
+// NewSearchPager returns a new SearchPager instance.
+func (globalSearch *GlobalSearchV2) NewSearchPager(options *SearchOptions) (pager *SearchPager, err error) {
+	if options.SearchCursor != nil && *options.SearchCursor != "" {
+		err = fmt.Errorf("the 'options.SearchCursor' field should not be set")
+		return
+	}
+
+	var optionsCopy SearchOptions = *options
+	pager = &SearchPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  globalSearch,
+	}
+	return
+}
+
+// NewSearchPagerFromCheckpoint returns a new SearchPager instance that continues the scan recorded in "checkpoint".
+// "options" must specify the same query as the scan that produced the checkpoint.
+func (globalSearch *GlobalSearchV2) NewSearchPagerFromCheckpoint(options *SearchOptions, checkpoint *SearchCheckpoint) (pager *SearchPager, err error) {
+	err = core.ValidateNotNil(checkpoint, "checkpoint cannot be nil")
+	if err != nil {
+		return
+	}
+	pager, err = globalSearch.NewSearchPager(options)
+	if err != nil {
+		return
+	}
+	if checkpoint.SearchCursor != "" {
+		pager.pageContext.next = core.StringPtr(checkpoint.SearchCursor)
+	}
+	pager.pageContext.pageIndex = checkpoint.PageIndex
+	pager.hasNext = !checkpoint.Done
+	return
+}
+
+// Checkpoint returns the current position of the pager. A pager created from the checkpoint with
+// NewSearchPagerFromCheckpoint continues with the page that this pager would retrieve next.
+func (pager *SearchPager) Checkpoint() *SearchCheckpoint {
+	return &SearchCheckpoint{
+		SearchCursor: core.StringNilMapper(pager.pageContext.next),
+		PageIndex:    pager.pageContext.pageIndex,
+		Done:         !pager.hasNext,
+	}
+}
+
+// SetMaxResults sets a safety limit on the number of results returned by the pager; 0 (the default) means no limit.
+// Whenever results are dropped to respect the limit, including from the last page, the call that drops them returns
+// the results up to the limit with an error wrapping ErrSearchMaxResultsExceeded rather than silently returning a
+// partial result set. The limit applies to the results returned by this pager, not to those
+// returned before a checkpoint it was created from.
+func (pager *SearchPager) SetMaxResults(maxResults int64) *SearchPager {
+	pager.maxResults = maxResults
+	return pager
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *SearchPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *SearchPager) GetNextWithContext(ctx context.Context) (page []ResultItem, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+	if pager.truncated {
+		return nil, pager.maxResultsError()
+	}
+
+	pager.options.SearchCursor = pager.pageContext.next
+
+	result, _, err := pager.client.SearchWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	// An empty page signals the end of the result set.
+	pager.pageContext.next = result.SearchCursor
+	pager.pageContext.pageIndex++
+	pager.hasNext = len(result.Items) > 0 && result.SearchCursor != nil
+	page = result.Items
+
+	if pager.maxResults > 0 && pager.results+int64(len(page)) > pager.maxResults {
+		// Results are dropped, even from the last page: the results up to the limit are returned with the error.
+		pager.truncated = true
+		page = page[:pager.maxResults-pager.results]
+		pager.results += int64(len(page))
+		err = pager.maxResultsError()
+		return
+	}
+	pager.results += int64(len(page))
+
+	return
+}
+
+func (pager *SearchPager) maxResultsError() error {
+	return fmt.Errorf("%w: more than %d results", ErrSearchMaxResultsExceeded, pager.maxResults)
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved. If the maximum number of results is exceeded, the results up to
+// the limit are returned with the error.
+func (pager *SearchPager) GetAllWithContext(ctx context.Context) (allItems []ResultItem, err error) {
+	for pager.HasNext() {
+		var nextPage []ResultItem
+		nextPage, err = pager.GetNextWithContext(ctx)
+		allItems = append(allItems, nextPage...)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// NextWithContext advances to the next result, retrieving the next page using the specified Context when the
+// current page is exhausted. It returns false when there are no more results or an error occurs; use Item to get the
+// result and Err to get the error. A checkpoint taken while iterating records the position of the next page, so a
+// pager resumed from it skips the unread results of the current page.
+func (pager *SearchPager) NextWithContext(ctx context.Context) bool {
+	pager.itemIndex++
+	for pager.itemIndex >= len(pager.page) {
+		if pager.err != nil || !pager.HasNext() {
+			return false
+		}
+		// The results returned with an error (see SetMaxResults) are iterated over before Next returns false.
+		pager.page, pager.err = pager.GetNextWithContext(ctx)
+		pager.itemIndex = 0
+	}
+	return true
+}
+
+// Next invokes NextWithContext() using context.Background() as the Context parameter.
+func (pager *SearchPager) Next() bool {
+	return pager.NextWithContext(context.Background())
+}
+
+// Item returns the result that the last call to Next advanced to, or nil if there is none.
+func (pager *SearchPager) Item() *ResultItem {
+	if pager.itemIndex >= len(pager.page) {
+		return nil
+	}
+	return &pager.page[pager.itemIndex]
+}
+
+// Err returns the error that stopped Next, if any.
+func (pager *SearchPager) Err() error {
+	return pager.err
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *SearchPager) GetNext() (page []ResultItem, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *SearchPager) GetAll() (allItems []ResultItem, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
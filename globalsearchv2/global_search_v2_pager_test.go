@@ -0,0 +1,204 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalSearchV2 SearchPager`, func() {
+	var testServer *httptest.Server
+	var globalSearchService *globalsearchv2.GlobalSearchV2
+	var failNext bool
+	var cursors []string
+	BeforeEach(func() {
+		failNext = false
+		cursors = nil
+		// Three pages of results followed by an empty page.
+		pages := map[string]string{
+			"":        `{"search_cursor": "cursor1", "items": [{"crn": "crn1"}, {"crn": "crn2"}]}`,
+			"cursor1": `{"search_cursor": "cursor2", "items": [{"crn": "crn3"}, {"crn": "crn4"}]}`,
+			"cursor2": `{"search_cursor": "cursor3", "items": [{"crn": "crn5"}]}`,
+			"cursor3": `{"search_cursor": "cursor4", "items": []}`,
+			// A last page without a cursor.
+			"last": `{"items": [{"crn": "crn6"}, {"crn": "crn7"}]}`,
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v3/resources/search"))
+			var body map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			Expect(body["query"]).To(Equal("type:bucket"))
+			cursor, _ := body["search_cursor"].(string)
+			cursors = append(cursors, cursor)
+
+			res.Header().Set("Content-type", "application/json")
+			if failNext {
+				failNext = false
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"error": "unavailable"}`)
+				return
+			}
+			fmt.Fprint(res, pages[cursor])
+		}))
+		var serviceErr error
+		globalSearchService, serviceErr = globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	crns := func(items []globalsearchv2.ResultItem) (result []string) {
+		for _, item := range items {
+			result = append(result, *item.CRN)
+		}
+		return
+	}
+
+	It(`Retrieves all pages`, func() {
+		pager, err := globalSearchService.NewSearchPager(globalSearchService.NewSearchOptions().SetQuery("type:bucket"))
+		Expect(err).To(BeNil())
+		items, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(crns(items)).To(Equal([]string{"crn1", "crn2", "crn3", "crn4", "crn5"}))
+		Expect(pager.HasNext()).To(BeFalse())
+		Expect(pager.Checkpoint().Done).To(BeTrue())
+
+		_, err = pager.GetNext()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Retries a failed page without advancing`, func() {
+		pager, err := globalSearchService.NewSearchPager(globalSearchService.NewSearchOptions().SetQuery("type:bucket"))
+		Expect(err).To(BeNil())
+		_, err = pager.GetNext()
+		Expect(err).To(BeNil())
+
+		failNext = true
+		_, err = pager.GetNext()
+		Expect(err).ToNot(BeNil())
+		Expect(pager.Checkpoint()).To(Equal(&globalsearchv2.SearchCheckpoint{SearchCursor: "cursor1", PageIndex: 1}))
+
+		page, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(crns(page)).To(Equal([]string{"crn3", "crn4"}))
+		Expect(cursors).To(Equal([]string{"", "cursor1", "cursor1"}))
+	})
+	It(`Resumes from a checkpoint`, func() {
+		pager, err := globalSearchService.NewSearchPager(globalSearchService.NewSearchOptions().SetQuery("type:bucket"))
+		Expect(err).To(BeNil())
+		_, err = pager.GetNext()
+		Expect(err).To(BeNil())
+		_, err = pager.GetNext()
+		Expect(err).To(BeNil())
+
+		saved := pager.Checkpoint().String()
+		checkpoint, err := globalsearchv2.ParseSearchCheckpoint(saved)
+		Expect(err).To(BeNil())
+		Expect(checkpoint.PageIndex).To(Equal(int64(2)))
+
+		resumed, err := globalSearchService.NewSearchPagerFromCheckpoint(globalSearchService.NewSearchOptions().SetQuery("type:bucket"), checkpoint)
+		Expect(err).To(BeNil())
+		items, err := resumed.GetAll()
+		Expect(err).To(BeNil())
+		Expect(crns(items)).To(Equal([]string{"crn5"}))
+		Expect(resumed.Checkpoint().PageIndex).To(Equal(int64(4)))
+	})
+	It(`Iterates over results`, func() {
+		pager, err := globalSearchService.NewSearchPager(globalSearchService.NewSearchOptions().SetQuery("type:bucket"))
+		Expect(err).To(BeNil())
+		Expect(pager.Item()).To(BeNil())
+		var items []string
+		for pager.Next() {
+			items = append(items, *pager.Item().CRN)
+		}
+		Expect(pager.Err()).To(BeNil())
+		Expect(items).To(Equal([]string{"crn1", "crn2", "crn3", "crn4", "crn5"}))
+		Expect(pager.Next()).To(BeFalse())
+
+		pager, err = globalSearchService.NewSearchPager(globalSearchService.NewSearchOptions().SetQuery("type:bucket"))
+		Expect(err).To(BeNil())
+		Expect(pager.Next()).To(BeTrue())
+		failNext = true
+		Expect(pager.Next()).To(BeTrue())
+		Expect(pager.Next()).To(BeFalse())
+		Expect(pager.Err()).ToNot(BeNil())
+	})
+	It(`Enforces the maximum number of results`, func() {
+		pager, err := globalSearchService.NewSearchPager(globalSearchService.NewSearchOptions().SetQuery("type:bucket"))
+		Expect(err).To(BeNil())
+		items, err := pager.SetMaxResults(3).GetAll()
+		Expect(errors.Is(err, globalsearchv2.ErrSearchMaxResultsExceeded)).To(BeTrue())
+		Expect(crns(items)).To(Equal([]string{"crn1", "crn2", "crn3"}))
+
+		pager, err = globalSearchService.NewSearchPager(globalSearchService.NewSearchOptions().SetQuery("type:bucket"))
+		Expect(err).To(BeNil())
+		items, err = pager.SetMaxResults(5).GetAll()
+		Expect(err).To(BeNil())
+		Expect(items).To(HaveLen(5))
+
+		pager, err = globalSearchService.NewSearchPager(globalSearchService.NewSearchOptions().SetQuery("type:bucket"))
+		Expect(err).To(BeNil())
+		pager.SetMaxResults(4)
+		count := 0
+		for pager.Next() {
+			count++
+		}
+		Expect(count).To(Equal(4))
+		Expect(errors.Is(pager.Err(), globalsearchv2.ErrSearchMaxResultsExceeded)).To(BeTrue())
+
+		// The results dropped from the last page are reported too.
+		checkpoint := &globalsearchv2.SearchCheckpoint{SearchCursor: "last", PageIndex: 1}
+		pager, err = globalSearchService.NewSearchPagerFromCheckpoint(globalSearchService.NewSearchOptions().SetQuery("type:bucket"), checkpoint)
+		Expect(err).To(BeNil())
+		items, err = pager.SetMaxResults(1).GetAll()
+		Expect(errors.Is(err, globalsearchv2.ErrSearchMaxResultsExceeded)).To(BeTrue())
+		Expect(crns(items)).To(Equal([]string{"crn6"}))
+		Expect(pager.HasNext()).To(BeFalse())
+
+		pager, err = globalSearchService.NewSearchPagerFromCheckpoint(globalSearchService.NewSearchOptions().SetQuery("type:bucket"), checkpoint)
+		Expect(err).To(BeNil())
+		pager.SetMaxResults(1)
+		var iterated []string
+		for pager.Next() {
+			iterated = append(iterated, *pager.Item().CRN)
+		}
+		Expect(iterated).To(Equal([]string{"crn6"}))
+		Expect(errors.Is(pager.Err(), globalsearchv2.ErrSearchMaxResultsExceeded)).To(BeTrue())
+	})
+	It(`Validates its arguments`, func() {
+		_, err := globalSearchService.NewSearchPager(globalSearchService.NewSearchOptions().SetSearchCursor("cursor1"))
+		Expect(err).ToNot(BeNil())
+		_, err = globalSearchService.NewSearchPagerFromCheckpoint(globalSearchService.NewSearchOptions(), nil)
+		Expect(err).ToNot(BeNil())
+		_, err = globalsearchv2.ParseSearchCheckpoint("not json")
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,188 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2
+
+import (
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Boolean operators of the query syntax.
+const (
+	queryOperatorAnd = "AND"
+	queryOperatorOr  = "OR"
+)
+
+// querySpecialCharacters are escaped with a backslash in unquoted terms and field names.
+const querySpecialCharacters = "+-&|!(){}[]^\"~*?:\\/ "
+
+// Query : A search query built from field conditions, rendered to the Lucene query syntax accepted by Search.
+// The zero Query matches all resources.
+type Query struct {
+	text string
+
+	// The operator that joins the top-level clauses of the query, or "" if the query is a single clause.
+	operator string
+}
+
+// QueryField : A field of the resources, used to build conditions on its value.
+type QueryField struct {
+	name string
+}
+
+// Field returns the field with the specified name (e.g. "type", "region", "tags", "doc.state").
+func Field(name string) QueryField {
+	return QueryField{name: escapeQueryTerm(name)}
+}
+
+// Eq returns a condition that matches resources whose field has the specified value.
+func (field QueryField) Eq(value string) Query {
+	return Query{text: field.name + ":" + quoteQueryValue(value)}
+}
+
+// In returns a condition that matches resources whose field has any of the specified values. With no values, the
+// condition matches no resources.
+func (field QueryField) In(values ...string) Query {
+	if len(values) == 1 {
+		return field.Eq(values[0])
+	}
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = quoteQueryValue(value)
+	}
+	if len(quoted) == 0 {
+		quoted = []string{quoteQueryValue("")}
+	}
+	return Query{text: field.name + ":(" + strings.Join(quoted, " "+queryOperatorOr+" ") + ")"}
+}
+
+// Prefix returns a condition that matches resources whose field starts with the specified value.
+func (field QueryField) Prefix(value string) Query {
+	return Query{text: field.name + ":" + escapeQueryTerm(value) + "*"}
+}
+
+// Exists returns a condition that matches resources that have a value for the field.
+func (field QueryField) Exists() Query {
+	return Query{text: field.name + ":*"}
+}
+
+// Between returns a condition that matches resources whose field is within the inclusive range [from, to]. An empty
+// bound leaves that end of the range open.
+func (field QueryField) Between(from string, to string) Query {
+	bound := func(value string) string {
+		if value == "" {
+			return "*"
+		}
+		return quoteQueryValue(value)
+	}
+	return Query{text: field.name + ":[" + bound(from) + " TO " + bound(to) + "]"}
+}
+
+// And returns a query that matches resources matched by this query and all of "others".
+func (query Query) And(others ...Query) Query {
+	return combineQueries(queryOperatorAnd, append([]Query{query}, others...))
+}
+
+// Or returns a query that matches resources matched by this query or any of "others".
+func (query Query) Or(others ...Query) Query {
+	return combineQueries(queryOperatorOr, append([]Query{query}, others...))
+}
+
+// Not returns a query that matches the resources that this query does not match.
+func (query Query) Not() Query {
+	if query.text == "" {
+		return Query{text: "NOT *"}
+	}
+	return Query{text: "NOT " + query.group()}
+}
+
+// All returns a query that matches resources matched by all of the queries.
+func All(queries ...Query) Query {
+	return combineQueries(queryOperatorAnd, queries)
+}
+
+// Any returns a query that matches resources matched by any of the queries.
+func Any(queries ...Query) Query {
+	return combineQueries(queryOperatorOr, queries)
+}
+
+// String returns the query in the Lucene query syntax, or "*" for the zero Query.
+func (query Query) String() string {
+	if query.text == "" {
+		return "*"
+	}
+	return query.text
+}
+
+// SetQueryFrom : Allow user to set Query from a Query built with Field
+func (options *SearchOptions) SetQueryFrom(query Query) *SearchOptions {
+	options.Query = core.StringPtr(query.String())
+	return options
+}
+
+// group returns the query text, in parentheses if it has more than one clause.
+func (query Query) group() string {
+	if query.operator == "" {
+		return query.text
+	}
+	return "(" + query.text + ")"
+}
+
+func combineQueries(operator string, queries []Query) Query {
+	var clauses []string
+	for _, query := range queries {
+		switch {
+		case query.text == "":
+			// The zero Query matches everything, so it does not restrict a conjunction; in a disjunction it is
+			// kept so that the result still matches everything.
+			if operator == queryOperatorOr {
+				clauses = append(clauses, "*")
+			}
+		case query.operator == operator:
+			clauses = append(clauses, query.text)
+		default:
+			clauses = append(clauses, query.group())
+		}
+	}
+	switch len(clauses) {
+	case 0:
+		return Query{}
+	case 1:
+		return Query{text: clauses[0]}
+	}
+	return Query{text: strings.Join(clauses, " "+operator+" "), operator: operator}
+}
+
+// quoteQueryValue returns the value as a quoted phrase, escaping the characters that are special within quotes.
+func quoteQueryValue(value string) string {
+	value = strings.ReplaceAll(value, "\\", "\\\\")
+	value = strings.ReplaceAll(value, "\"", "\\\"")
+	return "\"" + value + "\""
+}
+
+// escapeQueryTerm returns the value with the characters that are special in unquoted terms escaped.
+func escapeQueryTerm(value string) string {
+	var escaped strings.Builder
+	for _, character := range value {
+		if strings.ContainsRune(querySpecialCharacters, character) {
+			escaped.WriteByte('\\')
+		}
+		escaped.WriteRune(character)
+	}
+	return escaped.String()
+}
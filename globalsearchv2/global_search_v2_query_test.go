@@ -0,0 +1,62 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2_test
+
+import (
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalSearchV2 query builder`, func() {
+	Field := globalsearchv2.Field
+
+	It(`Renders conditions`, func() {
+		Expect(Field("type").Eq("cf-service-instance").String()).To(Equal(`type:"cf-service-instance"`))
+		Expect(Field("region").In("us-south", "eu-de").String()).To(Equal(`region:("us-south" OR "eu-de")`))
+		Expect(Field("region").In("us-south").String()).To(Equal(`region:"us-south"`))
+		Expect(Field("region").In().String()).To(Equal(`region:("")`))
+		Expect(Field("name").Prefix("my db").String()).To(Equal(`name:my\ db*`))
+		Expect(Field("tags").Exists().String()).To(Equal(`tags:*`))
+		Expect(Field("creation_date").Between("2022-01-01", "").String()).To(Equal(`creation_date:["2022-01-01" TO *]`))
+		Expect(globalsearchv2.Query{}.String()).To(Equal("*"))
+	})
+	It(`Escapes values and field names`, func() {
+		Expect(Field("name").Eq(`a" OR name:"b`).String()).To(Equal(`name:"a\" OR name:\"b"`))
+		Expect(Field("name").Eq(`back\slash`).String()).To(Equal(`name:"back\\slash"`))
+		Expect(Field("name").Prefix("x) OR (*").String()).To(Equal(`name:x\)\ OR\ \(\**`))
+		Expect(Field("doc.a:b").Exists().String()).To(Equal(`doc.a\:b:*`))
+	})
+	It(`Combines conditions`, func() {
+		query := Field("type").Eq("cf-service-instance").And(Field("region").In("us-south", "eu-de"))
+		Expect(query.String()).To(Equal(`type:"cf-service-instance" AND region:("us-south" OR "eu-de")`))
+
+		query = Field("type").Eq("a").Or(Field("type").Eq("b")).And(Field("region").Eq("r"), Field("family").Eq("f"))
+		Expect(query.String()).To(Equal(`(type:"a" OR type:"b") AND region:"r" AND family:"f"`))
+
+		query = globalsearchv2.All(Field("region").Eq("r"), Field("type").Eq("a").Or(Field("type").Eq("b")).Not())
+		Expect(query.String()).To(Equal(`region:"r" AND NOT (type:"a" OR type:"b")`))
+
+		Expect(globalsearchv2.All(globalsearchv2.Query{}, Field("region").Eq("r")).String()).To(Equal(`region:"r"`))
+		Expect(globalsearchv2.Any(globalsearchv2.Query{}, Field("region").Eq("r")).String()).To(Equal(`* OR region:"r"`))
+		Expect(globalsearchv2.All().String()).To(Equal("*"))
+	})
+	It(`Sets the query of the search options`, func() {
+		options := new(globalsearchv2.SearchOptions).SetQueryFrom(Field("type").Eq("resource-group"))
+		Expect(*options.Query).To(Equal(`type:"resource-group"`))
+	})
+})
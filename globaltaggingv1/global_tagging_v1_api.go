@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package globaltaggingv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// GlobalTaggingV1API : The operations of the GlobalTaggingV1 service, implemented by *GlobalTaggingV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type GlobalTaggingV1API interface {
+	// Clone makes a copy of "globalTagging" suitable for processing requests.
+	Clone() *GlobalTaggingV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// ListTags : Get all tags
+	ListTags(listTagsOptions *ListTagsOptions) (result *TagList, response *core.DetailedResponse, err error)
+
+	// ListTagsWithContext is an alternate form of the ListTags method which supports a Context parameter
+	ListTagsWithContext(ctx context.Context, listTagsOptions *ListTagsOptions) (result *TagList, response *core.DetailedResponse, err error)
+
+	// CreateTag : Create an access tag
+	CreateTag(createTagOptions *CreateTagOptions) (result *CreateTagResults, response *core.DetailedResponse, err error)
+
+	// CreateTagWithContext is an alternate form of the CreateTag method which supports a Context parameter
+	CreateTagWithContext(ctx context.Context, createTagOptions *CreateTagOptions) (result *CreateTagResults, response *core.DetailedResponse, err error)
+
+	// DeleteTagAll : Delete all unused tags
+	DeleteTagAll(deleteTagAllOptions *DeleteTagAllOptions) (result *DeleteTagsResult, response *core.DetailedResponse, err error)
+
+	// DeleteTagAllWithContext is an alternate form of the DeleteTagAll method which supports a Context parameter
+	DeleteTagAllWithContext(ctx context.Context, deleteTagAllOptions *DeleteTagAllOptions) (result *DeleteTagsResult, response *core.DetailedResponse, err error)
+
+	// DeleteTag : Delete an unused tag
+	DeleteTag(deleteTagOptions *DeleteTagOptions) (result *DeleteTagResults, response *core.DetailedResponse, err error)
+
+	// DeleteTagWithContext is an alternate form of the DeleteTag method which supports a Context parameter
+	DeleteTagWithContext(ctx context.Context, deleteTagOptions *DeleteTagOptions) (result *DeleteTagResults, response *core.DetailedResponse, err error)
+
+	// AttachTag : Attach tags
+	AttachTag(attachTagOptions *AttachTagOptions) (result *TagResults, response *core.DetailedResponse, err error)
+
+	// AttachTagWithContext is an alternate form of the AttachTag method which supports a Context parameter
+	AttachTagWithContext(ctx context.Context, attachTagOptions *AttachTagOptions) (result *TagResults, response *core.DetailedResponse, err error)
+
+	// DetachTag : Detach tags
+	DetachTag(detachTagOptions *DetachTagOptions) (result *TagResults, response *core.DetailedResponse, err error)
+
+	// DetachTagWithContext is an alternate form of the DetachTag method which supports a Context parameter
+	DetachTagWithContext(ctx context.Context, detachTagOptions *DetachTagOptions) (result *TagResults, response *core.DetailedResponse, err error)
+
+	// NewAttachTagOptions : Instantiate AttachTagOptions
+	NewAttachTagOptions(resources []Resource) *AttachTagOptions
+
+	// NewCreateTagOptions : Instantiate CreateTagOptions
+	NewCreateTagOptions(tagNames []string) *CreateTagOptions
+
+	// NewDeleteTagAllOptions : Instantiate DeleteTagAllOptions
+	NewDeleteTagAllOptions() *DeleteTagAllOptions
+
+	// NewDeleteTagOptions : Instantiate DeleteTagOptions
+	NewDeleteTagOptions(tagName string) *DeleteTagOptions
+
+	// NewDetachTagOptions : Instantiate DetachTagOptions
+	NewDetachTagOptions(resources []Resource) *DetachTagOptions
+
+	// NewListTagsOptions : Instantiate ListTagsOptions
+	NewListTagsOptions() *ListTagsOptions
+
+	// NewResource : Instantiate Resource (Generic Model Constructor)
+	NewResource(resourceID string) (_model *Resource, err error)
+
+	// AttachTagBatch attaches tags to any number of resources.
+	AttachTagBatch(ctx context.Context, attachTagOptions *AttachTagOptions, concurrency int) (result *TagBatchResults, err error)
+
+	// DetachTagBatch detaches tags from any number of resources, splitting the resources of "detachTagOptions" into
+	// requests in the same way as AttachTagBatch.
+	DetachTagBatch(ctx context.Context, detachTagOptions *DetachTagOptions, concurrency int) (result *TagBatchResults, err error)
+
+	// NewResourceFromCRN : Instantiate Resource for the resource with the specified CRN
+	NewResourceFromCRN(crn common.CRN) *Resource
+
+	// NewListTagsOptionsWith returns ListTagsOptions populated by applying "options" in order.
+	NewListTagsOptionsWith(options ...ListTagsOption) *ListTagsOptions
+
+	// ListTagsWith is an alternate form of the ListTagsWithContext method which takes functional options instead of a
+	// ListTagsOptions struct.
+	ListTagsWith(ctx context.Context, options ...ListTagsOption) (result *TagList, response *core.DetailedResponse, err error)
+
+	// NewCreateTagOptionsWith returns CreateTagOptions populated by applying "options" in order.
+	NewCreateTagOptionsWith(options ...CreateTagOption) *CreateTagOptions
+
+	// CreateTagWith is an alternate form of the CreateTagWithContext method which takes functional options instead of
+	// a CreateTagOptions struct.
+	CreateTagWith(ctx context.Context, options ...CreateTagOption) (result *CreateTagResults, response *core.DetailedResponse, err error)
+
+	// NewDeleteTagAllOptionsWith returns DeleteTagAllOptions populated by applying "options" in order.
+	NewDeleteTagAllOptionsWith(options ...DeleteTagAllOption) *DeleteTagAllOptions
+
+	// DeleteTagAllWith is an alternate form of the DeleteTagAllWithContext method which takes functional options
+	// instead of a DeleteTagAllOptions struct.
+	DeleteTagAllWith(ctx context.Context, options ...DeleteTagAllOption) (result *DeleteTagsResult, response *core.DetailedResponse, err error)
+
+	// NewDeleteTagOptionsWith returns DeleteTagOptions populated by applying "options" in order.
+	NewDeleteTagOptionsWith(options ...DeleteTagOption) *DeleteTagOptions
+
+	// DeleteTagWith is an alternate form of the DeleteTagWithContext method which takes functional options instead of
+	// a DeleteTagOptions struct.
+	DeleteTagWith(ctx context.Context, options ...DeleteTagOption) (result *DeleteTagResults, response *core.DetailedResponse, err error)
+
+	// NewAttachTagOptionsWith returns AttachTagOptions populated by applying "options" in order.
+	NewAttachTagOptionsWith(options ...AttachTagOption) *AttachTagOptions
+
+	// AttachTagWith is an alternate form of the AttachTagWithContext method which takes functional options instead of
+	// an AttachTagOptions struct.
+	AttachTagWith(ctx context.Context, options ...AttachTagOption) (result *TagResults, response *core.DetailedResponse, err error)
+
+	// NewDetachTagOptionsWith returns DetachTagOptions populated by applying "options" in order.
+	NewDetachTagOptionsWith(options ...DetachTagOption) *DetachTagOptions
+
+	// DetachTagWith is an alternate form of the DetachTagWithContext method which takes functional options instead of
+	// a DetachTagOptions struct.
+	DetachTagWith(ctx context.Context, options ...DetachTagOption) (result *TagResults, response *core.DetailedResponse, err error)
+}
+
+var _ GlobalTaggingV1API = (*GlobalTaggingV1)(nil)
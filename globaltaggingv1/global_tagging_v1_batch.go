@@ -0,0 +1,269 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// MaxResourcesPerTagRequest is the maximum number of resources that a single attach or detach request may specify.
+const MaxResourcesPerTagRequest = 100
+
+// DefaultTagBatchConcurrency is the number of requests that AttachTagBatch and DetachTagBatch send concurrently when
+// no concurrency is specified.
+const DefaultTagBatchConcurrency = 4
+
+// TagBatchResults : The merged results of the requests sent by AttachTagBatch or DetachTagBatch.
+type TagBatchResults struct {
+	// The result for each resource, in the order of the resources of the options.
+	Results []TagBatchResultsItem
+
+	// The number of requests sent.
+	Requests int
+
+	// The workflow ID sent with every request of the batch (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// TagBatchResultsItem : The result of an attach or detach operation for one resource.
+type TagBatchResultsItem struct {
+	// The CRN or IMS ID of the resource.
+	ResourceID string
+
+	// It is true if the operation failed for the resource.
+	IsError bool
+
+	// The error returned by the request containing the resource, or an error if its response has no result for the
+	// resource. It is nil if the service reported a result, even if that result is an error.
+	Err error
+
+	// It is true if the operation failed for a reason that may not recur, so that it may succeed if the resource is sent
+	// again: the request failed with a network error or a status code indicating a temporary condition, the response
+	// had no result for the resource, or the resource was not sent because the context was done. Failures reported by
+	// the service for the resource itself are not retryable.
+	Retryable bool
+}
+
+// FailedResources returns the IDs of the resources for which the service reported that the operation failed.
+func (tagResults *TagResults) FailedResources() (resourceIDs []string) {
+	for _, item := range tagResults.Results {
+		if item.IsError != nil && *item.IsError {
+			resourceIDs = append(resourceIDs, core.StringNilMapper(item.ResourceID))
+		}
+	}
+	return
+}
+
+// SucceededResources returns the IDs of the resources for which the service reported that the operation succeeded.
+func (tagResults *TagResults) SucceededResources() (resourceIDs []string) {
+	for _, item := range tagResults.Results {
+		if item.IsError == nil || !*item.IsError {
+			resourceIDs = append(resourceIDs, core.StringNilMapper(item.ResourceID))
+		}
+	}
+	return
+}
+
+// RetryableFailures returns the IDs of the resources of "resources", the resources sent in the request, for which
+// the operation failed but may succeed if they are sent again: those for which the response has no result. Failures
+// reported by the service for a resource are not retryable.
+func (tagResults *TagResults) RetryableFailures(resources []Resource) (resourceIDs []string) {
+	reported := make(map[string]bool)
+	for _, item := range tagResults.Results {
+		reported[core.StringNilMapper(item.ResourceID)] = true
+	}
+	for _, resource := range resources {
+		if resourceID := core.StringNilMapper(resource.ResourceID); !reported[resourceID] {
+			resourceIDs = append(resourceIDs, resourceID)
+		}
+	}
+	return
+}
+
+// Failed returns the results of the resources for which the operation failed.
+func (results *TagBatchResults) Failed() (failed []TagBatchResultsItem) {
+	for _, item := range results.Results {
+		if item.IsError {
+			failed = append(failed, item)
+		}
+	}
+	return
+}
+
+// FailedResources returns the IDs of the resources for which the operation failed.
+func (results *TagBatchResults) FailedResources() (resourceIDs []string) {
+	for _, item := range results.Results {
+		if item.IsError {
+			resourceIDs = append(resourceIDs, item.ResourceID)
+		}
+	}
+	return
+}
+
+// SucceededResources returns the IDs of the resources for which the operation succeeded.
+func (results *TagBatchResults) SucceededResources() (resourceIDs []string) {
+	for _, item := range results.Results {
+		if !item.IsError {
+			resourceIDs = append(resourceIDs, item.ResourceID)
+		}
+	}
+	return
+}
+
+// RetryableFailures returns the IDs of the resources for which the operation failed but may succeed if they are sent
+// again (see TagBatchResultsItem.Retryable), for example in the options of another AttachTagBatch call.
+func (results *TagBatchResults) RetryableFailures() (resourceIDs []string) {
+	for _, item := range results.Results {
+		if item.IsError && item.Retryable {
+			resourceIDs = append(resourceIDs, item.ResourceID)
+		}
+	}
+	return
+}
+
+// HasErrors returns true if the operation failed for any resource.
+func (results *TagBatchResults) HasErrors() bool {
+	for _, item := range results.Results {
+		if item.IsError {
+			return true
+		}
+	}
+	return false
+}
+
+// AttachTagBatch attaches tags to any number of resources. The resources of "attachTagOptions" are split into
+// requests of at most MaxResourcesPerTagRequest resources, and up to "concurrency" requests are sent concurrently
+// (DefaultTagBatchConcurrency if "concurrency" is not positive). A request that fails does not stop the others: its
+// error is recorded in the results of its resources, so "err" is only returned if the options are invalid or the
+// context is done. In the latter case the results are still returned with a *common.PartialError, and the resources
+// that were not sent have the error of the context.
+func (globalTagging *GlobalTaggingV1) AttachTagBatch(ctx context.Context, attachTagOptions *AttachTagOptions, concurrency int) (result *TagBatchResults, err error) {
+	err = core.ValidateNotNil(attachTagOptions, "attachTagOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(attachTagOptions, "attachTagOptions")
+	if err != nil {
+		return
+	}
+	return runTagBatch(ctx, globalTagging.Service, attachTagOptions.Resources, concurrency, func(ctx context.Context, resources []Resource) (*TagResults, *core.DetailedResponse, error) {
+		chunkOptions := *attachTagOptions
+		chunkOptions.Resources = resources
+		return globalTagging.AttachTagWithContext(ctx, &chunkOptions)
+	})
+}
+
+// DetachTagBatch detaches tags from any number of resources, splitting the resources of "detachTagOptions" into
+// requests in the same way as AttachTagBatch.
+func (globalTagging *GlobalTaggingV1) DetachTagBatch(ctx context.Context, detachTagOptions *DetachTagOptions, concurrency int) (result *TagBatchResults, err error) {
+	err = core.ValidateNotNil(detachTagOptions, "detachTagOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(detachTagOptions, "detachTagOptions")
+	if err != nil {
+		return
+	}
+	return runTagBatch(ctx, globalTagging.Service, detachTagOptions.Resources, concurrency, func(ctx context.Context, resources []Resource) (*TagResults, *core.DetailedResponse, error) {
+		chunkOptions := *detachTagOptions
+		chunkOptions.Resources = resources
+		return globalTagging.DetachTagWithContext(ctx, &chunkOptions)
+	})
+}
+
+// runTagBatch sends "resources" in chunks of MaxResourcesPerTagRequest with "send", which uses "service", and merges
+// the results.
+func runTagBatch(ctx context.Context, service *core.BaseService, resources []Resource, concurrency int, send func(context.Context, []Resource) (*TagResults, *core.DetailedResponse, error)) (result *TagBatchResults, err error) {
+	if concurrency <= 0 {
+		concurrency = DefaultTagBatchConcurrency
+	}
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, service)
+
+	result = &TagBatchResults{Results: make([]TagBatchResultsItem, len(resources)), WorkflowID: workflowID}
+	for i, resource := range resources {
+		result.Results[i].ResourceID = core.StringNilMapper(resource.ResourceID)
+	}
+
+	chunks := (len(resources) + MaxResourcesPerTagRequest - 1) / MaxResourcesPerTagRequest
+	err = common.ForEachConcurrently(ctx, chunks, concurrency, func(ctx context.Context, chunk int) {
+		start := chunk * MaxResourcesPerTagRequest
+		end := start + MaxResourcesPerTagRequest
+		if end > len(resources) {
+			end = len(resources)
+		}
+		// Each call only writes the results of its own chunk, so no locking is needed.
+		tagResults, response, sendErr := send(ctx, resources[start:end])
+		mergeTagResults(result.Results[start:end], resources[start:end], tagResults, response, common.WrapWorkflowError(workflowID, sendErr))
+	})
+
+	result.Requests = chunks
+	var partialErr *common.PartialError
+	if errors.As(err, &partialErr) {
+		result.Requests = partialErr.Processed
+		for i := partialErr.Processed * MaxResourcesPerTagRequest; i < len(resources); i++ {
+			result.Results[i].IsError = true
+			result.Results[i].Retryable = true
+			result.Results[i].Err = common.WrapWorkflowError(workflowID, partialErr.Err)
+		}
+	}
+	err = common.WrapWorkflowError(workflowID, err)
+	return
+}
+
+// mergeTagResults records the outcome of the request for a chunk of resources, "resources", in "items". Resources
+// that are missing from a successful response are considered to have failed.
+func mergeTagResults(items []TagBatchResultsItem, resources []Resource, tagResults *TagResults, response *core.DetailedResponse, sendErr error) {
+	if sendErr != nil {
+		retryable := isTransientTagResponse(response)
+		for i := range items {
+			items[i].IsError = true
+			items[i].Retryable = retryable
+			items[i].Err = sendErr
+		}
+		return
+	}
+	if tagResults == nil {
+		tagResults = &TagResults{}
+	}
+	failed := make(map[string]bool)
+	for _, resourceID := range tagResults.FailedResources() {
+		failed[resourceID] = true
+	}
+	missing := make(map[string]bool)
+	for _, resourceID := range tagResults.RetryableFailures(resources) {
+		missing[resourceID] = true
+	}
+	for i := range items {
+		items[i].IsError = failed[items[i].ResourceID] || missing[items[i].ResourceID]
+		if missing[items[i].ResourceID] {
+			items[i].Retryable = true
+			items[i].Err = fmt.Errorf("no result returned for resource '%s'", items[i].ResourceID)
+		}
+	}
+}
+
+// isTransientTagResponse returns true if a request failed with a network error or a status code that indicates a
+// temporary condition.
+func isTransientTagResponse(response *core.DetailedResponse) bool {
+	return response == nil || response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500
+}
@@ -0,0 +1,210 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalTaggingV1 batched tagging`, func() {
+	var testServer *httptest.Server
+	var globalTaggingService *globaltaggingv1.GlobalTaggingV1
+	var mutex sync.Mutex
+	var requestSizes []int
+	var active, maxActive int
+	var cancelOnSecondRequest context.CancelFunc
+	BeforeEach(func() {
+		requestSizes = nil
+		active, maxActive = 0, 0
+		cancelOnSecondRequest = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("POST"))
+			Expect(req.URL.EscapedPath()).To(Or(Equal("/v3/tags/attach"), Equal("/v3/tags/detach")))
+			Expect(req.URL.Query().Get("tag_type")).To(Equal("user"))
+			var body struct {
+				Resources []globaltaggingv1.Resource `json:"resources"`
+				TagNames  []string                   `json:"tag_names"`
+			}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			Expect(body.TagNames).To(Equal([]string{"env:prod"}))
+
+			mutex.Lock()
+			requestSizes = append(requestSizes, len(body.Resources))
+			if len(requestSizes) == 2 && cancelOnSecondRequest != nil {
+				cancelOnSecondRequest()
+			}
+			active++
+			if active > maxActive {
+				maxActive = active
+			}
+			mutex.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mutex.Lock()
+			active--
+			mutex.Unlock()
+
+			res.Header().Set("Content-type", "application/json")
+			var results []string
+			for _, resource := range body.Resources {
+				id := *resource.ResourceID
+				if id == "crn:unavailable" {
+					res.WriteHeader(503)
+					fmt.Fprint(res, `{"errors": [{"message": "unavailable"}]}`)
+					return
+				}
+				if id == "crn:missing" {
+					continue
+				}
+				results = append(results, fmt.Sprintf(`{"resource_id": "%s", "is_error": %t}`, id, strings.HasPrefix(id, "crn:bad")))
+			}
+			fmt.Fprintf(res, `{"results": [%s]}`, strings.Join(results, ","))
+		}))
+		var serviceErr error
+		globalTaggingService, serviceErr = globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	resources := func(count int) []globaltaggingv1.Resource {
+		result := make([]globaltaggingv1.Resource, count)
+		for i := range result {
+			result[i] = globaltaggingv1.Resource{ResourceID: core.StringPtr(fmt.Sprintf("crn:%d", i))}
+		}
+		return result
+	}
+
+	It(`Attaches tags in chunks with bounded concurrency`, func() {
+		options := globalTaggingService.NewAttachTagOptions(resources(250)).
+			SetTagNames([]string{"env:prod"}).
+			SetTagType(globaltaggingv1.AttachTagOptionsTagTypeUserConst)
+		result, err := globalTaggingService.AttachTagBatch(context.Background(), options, 2)
+		Expect(err).To(BeNil())
+		Expect(result.Requests).To(Equal(3))
+		Expect(requestSizes).To(ConsistOf(100, 100, 50))
+		Expect(maxActive).To(BeNumerically("<=", 2))
+		Expect(result.Results).To(HaveLen(250))
+		Expect(result.Results[249].ResourceID).To(Equal("crn:249"))
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(options.Resources).To(HaveLen(250))
+	})
+	It(`Merges partial failures`, func() {
+		input := resources(150)
+		input[3].ResourceID = core.StringPtr("crn:bad")
+		input[5].ResourceID = core.StringPtr("crn:missing")
+		input[120].ResourceID = core.StringPtr("crn:unavailable")
+		options := globalTaggingService.NewDetachTagOptions(input).
+			SetTagNames([]string{"env:prod"}).
+			SetTagType(globaltaggingv1.DetachTagOptionsTagTypeUserConst)
+		result, err := globalTaggingService.DetachTagBatch(context.Background(), options, 0)
+		Expect(err).To(BeNil())
+		Expect(result.HasErrors()).To(BeTrue())
+
+		failed := result.Failed()
+		Expect(failed).To(HaveLen(52))
+		Expect(failed[0].ResourceID).To(Equal("crn:bad"))
+		Expect(failed[0].Err).To(BeNil())
+		Expect(failed[1].ResourceID).To(Equal("crn:missing"))
+		Expect(failed[1].Err).ToNot(BeNil())
+		Expect(failed[2].ResourceID).To(Equal("crn:100"))
+		Expect(failed[2].Err.Error()).To(ContainSubstring("unavailable"))
+
+		Expect(result.FailedResources()).To(HaveLen(52))
+		Expect(result.SucceededResources()).To(HaveLen(98))
+		Expect(result.SucceededResources()).ToNot(ContainElement("crn:bad"))
+		retryable := result.RetryableFailures()
+		Expect(retryable).To(HaveLen(51))
+		Expect(retryable[0]).To(Equal("crn:missing"))
+		Expect(retryable).To(ContainElement("crn:unavailable"))
+		Expect(retryable).ToNot(ContainElement("crn:bad"))
+	})
+	It(`Stops when the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		options := globalTaggingService.NewAttachTagOptions(resources(10)).SetTagNames([]string{"env:prod"})
+		result, err := globalTaggingService.AttachTagBatch(ctx, options, 1)
+		Expect(err).To(MatchError(context.Canceled))
+		var partialErr *common.PartialError
+		Expect(errors.As(err, &partialErr)).To(BeTrue())
+		Expect(partialErr.Processed).To(Equal(0))
+		Expect(result.Requests).To(Equal(0))
+		Expect(result.Failed()).To(HaveLen(10))
+		Expect(result.Results[9].Err).To(MatchError(context.Canceled))
+		Expect(result.RetryableFailures()).To(HaveLen(10))
+	})
+	It(`Returns the partial results when the context is done during the batch`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cancelOnSecondRequest = cancel
+		options := globalTaggingService.NewAttachTagOptions(resources(250)).
+			SetTagNames([]string{"env:prod"}).
+			SetTagType(globaltaggingv1.AttachTagOptionsTagTypeUserConst)
+		result, err := globalTaggingService.AttachTagBatch(ctx, options, 1)
+		var partialErr *common.PartialError
+		Expect(errors.As(err, &partialErr)).To(BeTrue())
+		Expect(partialErr.Processed).To(Equal(2))
+		Expect(partialErr.Total).To(Equal(3))
+		Expect(result.Requests).To(Equal(2))
+		Expect(result.Results[99].IsError).To(BeFalse())
+		Expect(result.Failed()).To(HaveLen(150))
+		Expect(result.Results[249].Err).To(MatchError(context.Canceled))
+	})
+	It(`Reports the failed and succeeded resources of a response`, func() {
+		options := globalTaggingService.NewAttachTagOptions([]globaltaggingv1.Resource{
+			{ResourceID: core.StringPtr("crn:1")}, {ResourceID: core.StringPtr("crn:bad")}, {ResourceID: core.StringPtr("crn:2")},
+		}).SetTagNames([]string{"env:prod"}).SetTagType(globaltaggingv1.AttachTagOptionsTagTypeUserConst)
+		tagResults, _, err := globalTaggingService.AttachTag(options)
+		Expect(err).To(BeNil())
+		Expect(tagResults.FailedResources()).To(Equal([]string{"crn:bad"}))
+		Expect(tagResults.SucceededResources()).To(Equal([]string{"crn:1", "crn:2"}))
+		Expect(tagResults.RetryableFailures(options.Resources)).To(BeEmpty())
+
+		options.SetResources([]globaltaggingv1.Resource{
+			{ResourceID: core.StringPtr("crn:1")}, {ResourceID: core.StringPtr("crn:bad")}, {ResourceID: core.StringPtr("crn:missing")},
+		})
+		tagResults, _, err = globalTaggingService.AttachTag(options)
+		Expect(err).To(BeNil())
+		Expect(tagResults.FailedResources()).To(Equal([]string{"crn:bad"}))
+		Expect(tagResults.RetryableFailures(options.Resources)).To(Equal([]string{"crn:missing"}))
+	})
+	It(`Validates the options`, func() {
+		_, err := globalTaggingService.AttachTagBatch(context.Background(), nil, 1)
+		Expect(err).ToNot(BeNil())
+		_, err = globalTaggingService.DetachTagBatch(context.Background(), &globaltaggingv1.DetachTagOptions{}, 1)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,180 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+)
+
+// Constants associated with the "mode" parameter of EnsureTags.
+const (
+	// Attach the desired tags that are missing, and keep any other tags.
+	EnsureTagsModeAdditiveConst = "additive"
+
+	// Attach the desired tags that are missing, and detach any other tags.
+	EnsureTagsModeAuthoritativeConst = "authoritative"
+)
+
+// EnsureTagsResult : The changes made by EnsureTags.
+type EnsureTagsResult struct {
+	// The tags attached to the resource, sorted by name.
+	Attached []string
+
+	// The tags detached from the resource, sorted by name.
+	Detached []string
+
+	// The workflow ID sent with the requests made by EnsureTags (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// Changed returns true if any tag was attached or detached.
+func (result *EnsureTagsResult) Changed() bool {
+	return len(result.Attached) > 0 || len(result.Detached) > 0
+}
+
+// TagReconciler : Reconciles the user tags of resources with a desired set, using the Global Search service to read
+// the current tags.
+type TagReconciler struct {
+	*GlobalTaggingV1
+
+	// The client used to read the current tags of resources.
+	GlobalSearch *globalsearchv2.GlobalSearchV2
+}
+
+// NewTagReconciler returns a new TagReconciler that uses the specified clients.
+func NewTagReconciler(globalTagging *GlobalTaggingV1, globalSearch *globalsearchv2.GlobalSearchV2) *TagReconciler {
+	return &TagReconciler{
+		GlobalTaggingV1: globalTagging,
+		GlobalSearch:    globalSearch,
+	}
+}
+
+// EnsureTags makes the user tags of the resource with the specified CRN match "desiredTags": the missing tags are
+// attached, and with EnsureTagsModeAuthoritativeConst the tags that are not desired are detached. Tags are compared
+// case-insensitively, as the service stores them in lower case. No request is sent if the tags already match.
+// The current tags are read from the search index, which is updated shortly after tags change, so EnsureTags should
+// not be called again for the same resource immediately after it made changes.
+func (reconciler *TagReconciler) EnsureTags(ctx context.Context, resourceCRN string, desiredTags []string, mode string) (result *EnsureTagsResult, err error) {
+	if mode != EnsureTagsModeAdditiveConst && mode != EnsureTagsModeAuthoritativeConst {
+		err = fmt.Errorf("unsupported mode '%s'", mode)
+		return
+	}
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, reconciler.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	currentTags, err := reconciler.currentTags(ctx, resourceCRN)
+	if err != nil {
+		return
+	}
+
+	desired := normalizeTags(desiredTags)
+	current := normalizeTags(currentTags)
+	result = &EnsureTagsResult{WorkflowID: workflowID}
+	for tag := range desired {
+		if !current[tag] {
+			result.Attached = append(result.Attached, tag)
+		}
+	}
+	if mode == EnsureTagsModeAuthoritativeConst {
+		for tag := range current {
+			if !desired[tag] {
+				result.Detached = append(result.Detached, tag)
+			}
+		}
+	}
+	sort.Strings(result.Attached)
+	sort.Strings(result.Detached)
+
+	resources := []Resource{{ResourceID: core.StringPtr(resourceCRN)}}
+	if len(result.Attached) > 0 {
+		attachTagOptions := reconciler.NewAttachTagOptions(resources).
+			SetTagNames(result.Attached).
+			SetTagType(AttachTagOptionsTagTypeUserConst)
+		tagResults, _, attachErr := reconciler.AttachTagWithContext(ctx, attachTagOptions)
+		if err = checkTagResults(tagResults, attachErr, "attaching"); err != nil {
+			result = nil
+			return
+		}
+	}
+	if len(result.Detached) > 0 {
+		detachTagOptions := reconciler.NewDetachTagOptions(resources).
+			SetTagNames(result.Detached).
+			SetTagType(DetachTagOptionsTagTypeUserConst)
+		tagResults, _, detachErr := reconciler.DetachTagWithContext(ctx, detachTagOptions)
+		if err = checkTagResults(tagResults, detachErr, "detaching"); err != nil {
+			result = nil
+			return
+		}
+	}
+	return
+}
+
+// currentTags returns the user tags of a resource, as recorded in the search index.
+func (reconciler *TagReconciler) currentTags(ctx context.Context, resourceCRN string) (tags []string, err error) {
+	searchOptions := reconciler.GlobalSearch.NewSearchOptions().
+		SetQueryFrom(globalsearchv2.Field("crn").Eq(resourceCRN)).
+		SetFields([]string{"crn", "tags"}).
+		SetLimit(1)
+	scanResult, _, err := reconciler.GlobalSearch.SearchWithContext(ctx, searchOptions)
+	if err != nil {
+		err = fmt.Errorf("error reading the tags of resource '%s': %w", resourceCRN, err)
+		return
+	}
+	if len(scanResult.Items) == 0 {
+		err = fmt.Errorf("resource '%s' was not found", resourceCRN)
+		return
+	}
+	values, _ := scanResult.Items[0].GetProperty("tags").([]interface{})
+	for _, value := range values {
+		if tag, ok := value.(string); ok {
+			tags = append(tags, tag)
+		}
+	}
+	return
+}
+
+// normalizeTags returns the set of distinct, non-empty tags in lower case.
+func normalizeTags(tags []string) map[string]bool {
+	result := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" {
+			result[tag] = true
+		}
+	}
+	return result
+}
+
+// checkTagResults returns an error if an attach or detach request failed or reported an error for the resource.
+func checkTagResults(tagResults *TagResults, err error, action string) error {
+	if err != nil {
+		return fmt.Errorf("error %s tags: %w", action, err)
+	}
+	if tagResults != nil {
+		if failed := tagResults.FailedResources(); len(failed) > 0 {
+			return fmt.Errorf("error %s tags: the operation failed for resource '%s'", action, failed[0])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalTaggingV1 EnsureTags`, func() {
+	const resourceCRN = "crn:v1:bluemix:public:cloudantnosqldb:us-south:a/acct:inst::"
+	var testServer *httptest.Server
+	var reconciler *globaltaggingv1.TagReconciler
+	var searchItems string
+	var requests map[string][]string
+	var failDetach bool
+	BeforeEach(func() {
+		searchItems = `[{"crn": "` + resourceCRN + `", "tags": ["env:dev", "Team:Payments", "legacy"]}]`
+		requests = make(map[string][]string)
+		failDetach = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			var body map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			switch req.URL.EscapedPath() {
+			case "/v3/resources/search":
+				Expect(body["query"]).To(Equal(`crn:"` + resourceCRN + `"`))
+				fmt.Fprintf(res, `{"search_cursor": "c1", "items": %s}`, searchItems)
+			case "/v3/tags/attach", "/v3/tags/detach":
+				Expect(req.URL.Query().Get("tag_type")).To(Equal("user"))
+				for _, tag := range body["tag_names"].([]interface{}) {
+					requests[req.URL.EscapedPath()] = append(requests[req.URL.EscapedPath()], tag.(string))
+				}
+				isError := failDetach && req.URL.EscapedPath() == "/v3/tags/detach"
+				fmt.Fprintf(res, `{"results": [{"resource_id": "%s", "is_error": %t}]}`, resourceCRN, isError)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		globalTaggingService, serviceErr := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		globalSearchService, serviceErr := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		reconciler = globaltaggingv1.NewTagReconciler(globalTaggingService, globalSearchService)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Attaches missing tags in additive mode`, func() {
+		result, err := reconciler.EnsureTags(context.Background(), resourceCRN, []string{"team:payments", "env:prod", " "}, globaltaggingv1.EnsureTagsModeAdditiveConst)
+		Expect(err).To(BeNil())
+		Expect(result.Attached).To(Equal([]string{"env:prod"}))
+		Expect(result.Detached).To(BeEmpty())
+		Expect(requests).To(Equal(map[string][]string{"/v3/tags/attach": {"env:prod"}}))
+	})
+	It(`Replaces tags in authoritative mode`, func() {
+		result, err := reconciler.EnsureTags(context.Background(), resourceCRN, []string{"team:payments", "env:prod"}, globaltaggingv1.EnsureTagsModeAuthoritativeConst)
+		Expect(err).To(BeNil())
+		Expect(result.Changed()).To(BeTrue())
+		Expect(result.Attached).To(Equal([]string{"env:prod"}))
+		Expect(result.Detached).To(Equal([]string{"env:dev", "legacy"}))
+		Expect(requests["/v3/tags/detach"]).To(Equal([]string{"env:dev", "legacy"}))
+	})
+	It(`Does nothing when the tags match`, func() {
+		result, err := reconciler.EnsureTags(context.Background(), resourceCRN, []string{"legacy", "ENV:dev", "team:payments"}, globaltaggingv1.EnsureTagsModeAuthoritativeConst)
+		Expect(err).To(BeNil())
+		Expect(result.Changed()).To(BeFalse())
+		Expect(requests).To(BeEmpty())
+	})
+	It(`Reports failures`, func() {
+		failDetach = true
+		_, err := reconciler.EnsureTags(context.Background(), resourceCRN, nil, globaltaggingv1.EnsureTagsModeAuthoritativeConst)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("detaching"))
+
+		searchItems = `[]`
+		_, err = reconciler.EnsureTags(context.Background(), resourceCRN, nil, globaltaggingv1.EnsureTagsModeAdditiveConst)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+
+		_, err = reconciler.EnsureTags(context.Background(), resourceCRN, nil, "replace")
+		Expect(err).ToNot(BeNil())
+	})
+})
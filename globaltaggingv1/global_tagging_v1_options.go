@@ -0,0 +1,464 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package globaltaggingv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ListTagsOption : A functional option that sets a property of ListTagsOptions (see ListTagsWith).
+type ListTagsOption func(options *ListTagsOptions)
+
+// ListTagsImpersonateUser sets the ImpersonateUser property of ListTagsOptions. The user on whose behalf the get
+// operation must be performed (_for administrators only_).
+func ListTagsImpersonateUser(impersonateUser string) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		options.ImpersonateUser = core.StringPtr(impersonateUser)
+	}
+}
+
+// ListTagsAccountID sets the AccountID property of ListTagsOptions. The ID of the billing account to list the tags
+// for.
+func ListTagsAccountID(accountID string) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListTagsTagType sets the TagType property of ListTagsOptions. The type of the tag you want to list.
+func ListTagsTagType(tagType string) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		options.TagType = core.StringPtr(tagType)
+	}
+}
+
+// ListTagsFullData sets the FullData property of ListTagsOptions. If set to `true`, this query returns the
+// provider, `ghost`, `ims` or `ghost,ims`, where the tag exists and the number of attached resources.
+func ListTagsFullData(fullData bool) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		options.FullData = core.BoolPtr(fullData)
+	}
+}
+
+// ListTagsProviders sets the Providers property of ListTagsOptions. Select a provider.
+func ListTagsProviders(providers ...string) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		options.Providers = append(options.Providers, providers...)
+	}
+}
+
+// ListTagsAttachedTo sets the AttachedTo property of ListTagsOptions. If you want to return only the list of tags
+// attached to a specified resource, pass the ID of the resource on this parameter.
+func ListTagsAttachedTo(attachedTo string) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		options.AttachedTo = core.StringPtr(attachedTo)
+	}
+}
+
+// ListTagsOffset sets the Offset property of ListTagsOptions. The offset is the index of the item from which you
+// want to start returning data from.
+func ListTagsOffset(offset int64) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// ListTagsLimit sets the Limit property of ListTagsOptions. The number of tags to return.
+func ListTagsLimit(limit int64) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListTagsTimeout sets the Timeout property of ListTagsOptions. The search timeout bounds the search request to be
+// executed within the specified time value.
+func ListTagsTimeout(timeout int64) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		options.Timeout = core.Int64Ptr(timeout)
+	}
+}
+
+// ListTagsOrderByName sets the OrderByName property of ListTagsOptions. Order the output by tag name.
+func ListTagsOrderByName(orderByName string) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		options.OrderByName = core.StringPtr(orderByName)
+	}
+}
+
+// ListTagsAttachedOnly sets the AttachedOnly property of ListTagsOptions. Filter on attached tags.
+func ListTagsAttachedOnly(attachedOnly bool) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		options.AttachedOnly = core.BoolPtr(attachedOnly)
+	}
+}
+
+// ListTagsHeader sets the Headers property of ListTagsOptions. Allows users to set headers on API requests.
+func ListTagsHeader(name string, value string) ListTagsOption {
+	return func(options *ListTagsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListTagsOptionsWith returns ListTagsOptions populated by applying "options" in order.
+func (*GlobalTaggingV1) NewListTagsOptionsWith(options ...ListTagsOption) *ListTagsOptions {
+	listTagsOptions := &ListTagsOptions{}
+	for _, option := range options {
+		option(listTagsOptions)
+	}
+	return listTagsOptions
+}
+
+// ListTagsWith is an alternate form of the ListTagsWithContext method which takes functional options instead of a
+// ListTagsOptions struct.
+func (globalTagging *GlobalTaggingV1) ListTagsWith(ctx context.Context, options ...ListTagsOption) (result *TagList, response *core.DetailedResponse, err error) {
+	return globalTagging.ListTagsWithContext(ctx, globalTagging.NewListTagsOptionsWith(options...))
+}
+
+// CreateTagOption : A functional option that sets a property of CreateTagOptions (see CreateTagWith).
+type CreateTagOption func(options *CreateTagOptions)
+
+// CreateTagTagNames sets the TagNames property of CreateTagOptions. An array of tag names to create.
+func CreateTagTagNames(tagNames ...string) CreateTagOption {
+	return func(options *CreateTagOptions) {
+		options.TagNames = append(options.TagNames, tagNames...)
+	}
+}
+
+// CreateTagImpersonateUser sets the ImpersonateUser property of CreateTagOptions. The user on whose behalf the
+// create operation must be performed (_for administrators only_).
+func CreateTagImpersonateUser(impersonateUser string) CreateTagOption {
+	return func(options *CreateTagOptions) {
+		options.ImpersonateUser = core.StringPtr(impersonateUser)
+	}
+}
+
+// CreateTagAccountID sets the AccountID property of CreateTagOptions. The ID of the billing account where the tag
+// must be created.
+func CreateTagAccountID(accountID string) CreateTagOption {
+	return func(options *CreateTagOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// CreateTagTagType sets the TagType property of CreateTagOptions. The type of the tags you want to create.
+func CreateTagTagType(tagType string) CreateTagOption {
+	return func(options *CreateTagOptions) {
+		options.TagType = core.StringPtr(tagType)
+	}
+}
+
+// CreateTagHeader sets the Headers property of CreateTagOptions. Allows users to set headers on API requests.
+func CreateTagHeader(name string, value string) CreateTagOption {
+	return func(options *CreateTagOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateTagOptionsWith returns CreateTagOptions populated by applying "options" in order.
+func (*GlobalTaggingV1) NewCreateTagOptionsWith(options ...CreateTagOption) *CreateTagOptions {
+	createTagOptions := &CreateTagOptions{}
+	for _, option := range options {
+		option(createTagOptions)
+	}
+	return createTagOptions
+}
+
+// CreateTagWith is an alternate form of the CreateTagWithContext method which takes functional options instead of
+// a CreateTagOptions struct. CreateTagTagNames is required.
+func (globalTagging *GlobalTaggingV1) CreateTagWith(ctx context.Context, options ...CreateTagOption) (result *CreateTagResults, response *core.DetailedResponse, err error) {
+	return globalTagging.CreateTagWithContext(ctx, globalTagging.NewCreateTagOptionsWith(options...))
+}
+
+// DeleteTagAllOption : A functional option that sets a property of DeleteTagAllOptions (see DeleteTagAllWith).
+type DeleteTagAllOption func(options *DeleteTagAllOptions)
+
+// DeleteTagAllProviders sets the Providers property of DeleteTagAllOptions. Select a provider.
+func DeleteTagAllProviders(providers string) DeleteTagAllOption {
+	return func(options *DeleteTagAllOptions) {
+		options.Providers = core.StringPtr(providers)
+	}
+}
+
+// DeleteTagAllImpersonateUser sets the ImpersonateUser property of DeleteTagAllOptions. The user on whose behalf
+// the delete all operation must be performed (_for administrators only_).
+func DeleteTagAllImpersonateUser(impersonateUser string) DeleteTagAllOption {
+	return func(options *DeleteTagAllOptions) {
+		options.ImpersonateUser = core.StringPtr(impersonateUser)
+	}
+}
+
+// DeleteTagAllAccountID sets the AccountID property of DeleteTagAllOptions. The ID of the billing account to
+// delete the tags for.
+func DeleteTagAllAccountID(accountID string) DeleteTagAllOption {
+	return func(options *DeleteTagAllOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// DeleteTagAllTagType sets the TagType property of DeleteTagAllOptions. The type of the tag.
+func DeleteTagAllTagType(tagType string) DeleteTagAllOption {
+	return func(options *DeleteTagAllOptions) {
+		options.TagType = core.StringPtr(tagType)
+	}
+}
+
+// DeleteTagAllHeader sets the Headers property of DeleteTagAllOptions. Allows users to set headers on API
+// requests.
+func DeleteTagAllHeader(name string, value string) DeleteTagAllOption {
+	return func(options *DeleteTagAllOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteTagAllOptionsWith returns DeleteTagAllOptions populated by applying "options" in order.
+func (*GlobalTaggingV1) NewDeleteTagAllOptionsWith(options ...DeleteTagAllOption) *DeleteTagAllOptions {
+	deleteTagAllOptions := &DeleteTagAllOptions{}
+	for _, option := range options {
+		option(deleteTagAllOptions)
+	}
+	return deleteTagAllOptions
+}
+
+// DeleteTagAllWith is an alternate form of the DeleteTagAllWithContext method which takes functional options
+// instead of a DeleteTagAllOptions struct.
+func (globalTagging *GlobalTaggingV1) DeleteTagAllWith(ctx context.Context, options ...DeleteTagAllOption) (result *DeleteTagsResult, response *core.DetailedResponse, err error) {
+	return globalTagging.DeleteTagAllWithContext(ctx, globalTagging.NewDeleteTagAllOptionsWith(options...))
+}
+
+// DeleteTagOption : A functional option that sets a property of DeleteTagOptions (see DeleteTagWith).
+type DeleteTagOption func(options *DeleteTagOptions)
+
+// DeleteTagTagName sets the TagName property of DeleteTagOptions. The name of tag to be deleted.
+func DeleteTagTagName(tagName string) DeleteTagOption {
+	return func(options *DeleteTagOptions) {
+		options.TagName = core.StringPtr(tagName)
+	}
+}
+
+// DeleteTagProviders sets the Providers property of DeleteTagOptions. Select a provider.
+func DeleteTagProviders(providers ...string) DeleteTagOption {
+	return func(options *DeleteTagOptions) {
+		options.Providers = append(options.Providers, providers...)
+	}
+}
+
+// DeleteTagImpersonateUser sets the ImpersonateUser property of DeleteTagOptions. The user on whose behalf the
+// delete operation must be performed (_for administrators only_).
+func DeleteTagImpersonateUser(impersonateUser string) DeleteTagOption {
+	return func(options *DeleteTagOptions) {
+		options.ImpersonateUser = core.StringPtr(impersonateUser)
+	}
+}
+
+// DeleteTagAccountID sets the AccountID property of DeleteTagOptions. The ID of the billing account to delete the
+// tag for.
+func DeleteTagAccountID(accountID string) DeleteTagOption {
+	return func(options *DeleteTagOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// DeleteTagTagType sets the TagType property of DeleteTagOptions. The type of the tag.
+func DeleteTagTagType(tagType string) DeleteTagOption {
+	return func(options *DeleteTagOptions) {
+		options.TagType = core.StringPtr(tagType)
+	}
+}
+
+// DeleteTagHeader sets the Headers property of DeleteTagOptions. Allows users to set headers on API requests.
+func DeleteTagHeader(name string, value string) DeleteTagOption {
+	return func(options *DeleteTagOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteTagOptionsWith returns DeleteTagOptions populated by applying "options" in order.
+func (*GlobalTaggingV1) NewDeleteTagOptionsWith(options ...DeleteTagOption) *DeleteTagOptions {
+	deleteTagOptions := &DeleteTagOptions{}
+	for _, option := range options {
+		option(deleteTagOptions)
+	}
+	return deleteTagOptions
+}
+
+// DeleteTagWith is an alternate form of the DeleteTagWithContext method which takes functional options instead of
+// a DeleteTagOptions struct. DeleteTagTagName is required.
+func (globalTagging *GlobalTaggingV1) DeleteTagWith(ctx context.Context, options ...DeleteTagOption) (result *DeleteTagResults, response *core.DetailedResponse, err error) {
+	return globalTagging.DeleteTagWithContext(ctx, globalTagging.NewDeleteTagOptionsWith(options...))
+}
+
+// AttachTagOption : A functional option that sets a property of AttachTagOptions (see AttachTagWith).
+type AttachTagOption func(options *AttachTagOptions)
+
+// AttachTagResources sets the Resources property of AttachTagOptions. List of resources on which the tag or tags
+// should be attached.
+func AttachTagResources(resources ...Resource) AttachTagOption {
+	return func(options *AttachTagOptions) {
+		options.Resources = append(options.Resources, resources...)
+	}
+}
+
+// AttachTagTagName sets the TagName property of AttachTagOptions. The name of the tag to attach.
+func AttachTagTagName(tagName string) AttachTagOption {
+	return func(options *AttachTagOptions) {
+		options.TagName = core.StringPtr(tagName)
+	}
+}
+
+// AttachTagTagNames sets the TagNames property of AttachTagOptions. An array of tag names to attach.
+func AttachTagTagNames(tagNames ...string) AttachTagOption {
+	return func(options *AttachTagOptions) {
+		options.TagNames = append(options.TagNames, tagNames...)
+	}
+}
+
+// AttachTagImpersonateUser sets the ImpersonateUser property of AttachTagOptions. The user on whose behalf the
+// attach operation must be performed (_for administrators only_).
+func AttachTagImpersonateUser(impersonateUser string) AttachTagOption {
+	return func(options *AttachTagOptions) {
+		options.ImpersonateUser = core.StringPtr(impersonateUser)
+	}
+}
+
+// AttachTagAccountID sets the AccountID property of AttachTagOptions. The ID of the billing account where the
+// resources to be tagged lives.
+func AttachTagAccountID(accountID string) AttachTagOption {
+	return func(options *AttachTagOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// AttachTagTagType sets the TagType property of AttachTagOptions. The type of the tag.
+func AttachTagTagType(tagType string) AttachTagOption {
+	return func(options *AttachTagOptions) {
+		options.TagType = core.StringPtr(tagType)
+	}
+}
+
+// AttachTagHeader sets the Headers property of AttachTagOptions. Allows users to set headers on API requests.
+func AttachTagHeader(name string, value string) AttachTagOption {
+	return func(options *AttachTagOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewAttachTagOptionsWith returns AttachTagOptions populated by applying "options" in order.
+func (*GlobalTaggingV1) NewAttachTagOptionsWith(options ...AttachTagOption) *AttachTagOptions {
+	attachTagOptions := &AttachTagOptions{}
+	for _, option := range options {
+		option(attachTagOptions)
+	}
+	return attachTagOptions
+}
+
+// AttachTagWith is an alternate form of the AttachTagWithContext method which takes functional options instead of
+// an AttachTagOptions struct. AttachTagResources is required.
+func (globalTagging *GlobalTaggingV1) AttachTagWith(ctx context.Context, options ...AttachTagOption) (result *TagResults, response *core.DetailedResponse, err error) {
+	return globalTagging.AttachTagWithContext(ctx, globalTagging.NewAttachTagOptionsWith(options...))
+}
+
+// DetachTagOption : A functional option that sets a property of DetachTagOptions (see DetachTagWith).
+type DetachTagOption func(options *DetachTagOptions)
+
+// DetachTagResources sets the Resources property of DetachTagOptions. List of resources on which the tag or tags
+// should be detached.
+func DetachTagResources(resources ...Resource) DetachTagOption {
+	return func(options *DetachTagOptions) {
+		options.Resources = append(options.Resources, resources...)
+	}
+}
+
+// DetachTagTagName sets the TagName property of DetachTagOptions. The name of the tag to detach.
+func DetachTagTagName(tagName string) DetachTagOption {
+	return func(options *DetachTagOptions) {
+		options.TagName = core.StringPtr(tagName)
+	}
+}
+
+// DetachTagTagNames sets the TagNames property of DetachTagOptions. An array of tag names to detach.
+func DetachTagTagNames(tagNames ...string) DetachTagOption {
+	return func(options *DetachTagOptions) {
+		options.TagNames = append(options.TagNames, tagNames...)
+	}
+}
+
+// DetachTagImpersonateUser sets the ImpersonateUser property of DetachTagOptions. The user on whose behalf the
+// detach operation must be performed (_for administrators only_).
+func DetachTagImpersonateUser(impersonateUser string) DetachTagOption {
+	return func(options *DetachTagOptions) {
+		options.ImpersonateUser = core.StringPtr(impersonateUser)
+	}
+}
+
+// DetachTagAccountID sets the AccountID property of DetachTagOptions. The ID of the billing account where the
+// resources to be un-tagged lives.
+func DetachTagAccountID(accountID string) DetachTagOption {
+	return func(options *DetachTagOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// DetachTagTagType sets the TagType property of DetachTagOptions. The type of the tag.
+func DetachTagTagType(tagType string) DetachTagOption {
+	return func(options *DetachTagOptions) {
+		options.TagType = core.StringPtr(tagType)
+	}
+}
+
+// DetachTagHeader sets the Headers property of DetachTagOptions. Allows users to set headers on API requests.
+func DetachTagHeader(name string, value string) DetachTagOption {
+	return func(options *DetachTagOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDetachTagOptionsWith returns DetachTagOptions populated by applying "options" in order.
+func (*GlobalTaggingV1) NewDetachTagOptionsWith(options ...DetachTagOption) *DetachTagOptions {
+	detachTagOptions := &DetachTagOptions{}
+	for _, option := range options {
+		option(detachTagOptions)
+	}
+	return detachTagOptions
+}
+
+// DetachTagWith is an alternate form of the DetachTagWithContext method which takes functional options instead of
+// a DetachTagOptions struct. DetachTagResources is required.
+func (globalTagging *GlobalTaggingV1) DetachTagWith(ctx context.Context, options ...DetachTagOption) (result *TagResults, response *core.DetailedResponse, err error) {
+	return globalTagging.DetachTagWithContext(ctx, globalTagging.NewDetachTagOptionsWith(options...))
+}
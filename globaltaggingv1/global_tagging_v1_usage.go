@@ -0,0 +1,194 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+)
+
+// tagListPageSize is the number of tags requested per page by GetTagUsage, the maximum allowed by the service.
+const tagListPageSize = 1000
+
+// TagUsage : The number of resources a tag is attached to.
+type TagUsage struct {
+	// The name of the tag.
+	Name string
+
+	// The number of resources the tag is attached to.
+	AttachedResources int64
+}
+
+// TagUsageReport : The tags of an account and their usage, as returned by GetTagUsage.
+type TagUsageReport struct {
+	// The tags, sorted by decreasing usage and then by name.
+	Tags []TagUsage
+}
+
+// Orphaned returns the tags that are not attached to any resource, sorted by name.
+func (report *TagUsageReport) Orphaned() (orphaned []TagUsage) {
+	for _, usage := range report.Tags {
+		if usage.AttachedResources == 0 {
+			orphaned = append(orphaned, usage)
+		}
+	}
+	return
+}
+
+// TagUsageReporter : Reports the usage of the tags of an account, using the Global Search service to count the
+// resources each tag is attached to.
+type TagUsageReporter struct {
+	*GlobalTaggingV1
+
+	// The client used to count the resources each tag is attached to.
+	GlobalSearch *globalsearchv2.GlobalSearchV2
+
+	// The number of tags whose resources are counted concurrently. Defaults to DefaultTagBatchConcurrency.
+	Concurrency int
+}
+
+// NewTagUsageReporter returns a new TagUsageReporter that uses the specified clients.
+func NewTagUsageReporter(globalTagging *GlobalTaggingV1, globalSearch *globalsearchv2.GlobalSearchV2) *TagUsageReporter {
+	return &TagUsageReporter{
+		GlobalTaggingV1: globalTagging,
+		GlobalSearch:    globalSearch,
+	}
+}
+
+// GetTagUsage lists every tag selected by "listTagsOptions" (all user tags if nil) and counts the resources each one
+// is attached to, including tags that are not attached to any resource. The pagination and AttachedOnly options of
+// "listTagsOptions" are ignored. If any request fails, the remaining requests are cancelled and the first error is
+// returned.
+func (reporter *TagUsageReporter) GetTagUsage(ctx context.Context, listTagsOptions *ListTagsOptions) (result *TagUsageReport, err error) {
+	options := ListTagsOptions{}
+	if listTagsOptions != nil {
+		options = *listTagsOptions
+	}
+	options.Offset = nil
+	options.Limit = core.Int64Ptr(tagListPageSize)
+	options.AttachedOnly = nil
+
+	names, err := reporter.listTagNames(ctx, &options)
+	if err != nil {
+		return
+	}
+
+	searchField := "tags"
+	if options.TagType != nil && *options.TagType != ListTagsOptionsTagTypeUserConst {
+		searchField = *options.TagType + "_tags"
+	}
+
+	concurrency := reporter.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultTagBatchConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	result = &TagUsageReport{Tags: make([]TagUsage, len(names))}
+	var mutex sync.Mutex
+	var waitGroup sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for i, name := range names {
+		result.Tags[i].Name = name
+		waitGroup.Add(1)
+		go func(usage *TagUsage) {
+			defer waitGroup.Done()
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				return
+			}
+
+			count, countErr := reporter.countResources(ctx, searchField, usage.Name, options.AccountID)
+			if countErr != nil {
+				mutex.Lock()
+				defer mutex.Unlock()
+				if err == nil {
+					err = fmt.Errorf("error counting the resources of tag '%s': %w", usage.Name, countErr)
+					cancel()
+				}
+				return
+			}
+			usage.AttachedResources = count
+		}(&result.Tags[i])
+	}
+	waitGroup.Wait()
+
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		result = nil
+		return
+	}
+	sort.Slice(result.Tags, func(i, j int) bool {
+		if result.Tags[i].AttachedResources != result.Tags[j].AttachedResources {
+			return result.Tags[i].AttachedResources > result.Tags[j].AttachedResources
+		}
+		return result.Tags[i].Name < result.Tags[j].Name
+	})
+	return
+}
+
+// listTagNames returns the names of all the tags selected by "options", requesting one page at a time.
+func (globalTagging *GlobalTaggingV1) listTagNames(ctx context.Context, options *ListTagsOptions) (names []string, err error) {
+	var offset int64
+	for {
+		options.Offset = core.Int64Ptr(offset)
+		tagList, _, listErr := globalTagging.ListTagsWithContext(ctx, options)
+		if listErr != nil {
+			err = fmt.Errorf("error listing tags: %w", listErr)
+			return
+		}
+		for _, tag := range tagList.Items {
+			names = append(names, core.StringNilMapper(tag.Name))
+		}
+		offset += int64(len(tagList.Items))
+		if len(tagList.Items) == 0 || (tagList.TotalCount != nil && offset >= *tagList.TotalCount) {
+			return
+		}
+	}
+}
+
+// countResources returns the number of resources whose "field" contains the tag "name".
+func (reporter *TagUsageReporter) countResources(ctx context.Context, field string, name string, accountID *string) (count int64, err error) {
+	searchOptions := reporter.GlobalSearch.NewSearchOptions().
+		SetQueryFrom(globalsearchv2.Field(field).Eq(name)).
+		SetFields([]string{"crn"}).
+		SetLimit(tagListPageSize)
+	searchOptions.AccountID = accountID
+	pager, err := reporter.GlobalSearch.NewSearchPager(searchOptions)
+	if err != nil {
+		return
+	}
+	for pager.HasNext() {
+		var page []globalsearchv2.ResultItem
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		count += int64(len(page))
+	}
+	return
+}
@@ -0,0 +1,123 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalTaggingV1 GetTagUsage`, func() {
+	var testServer *httptest.Server
+	var reporter *globaltaggingv1.TagUsageReporter
+	var failTag string
+	BeforeEach(func() {
+		failTag = ""
+		// The number of resources each tag is attached to; "env:prod" needs two pages of search results.
+		counts := map[string]int{"env:dev": 2, "env:prod": 3, "legacy": 0, "owner:ann": 2}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			query := req.URL.Query()
+			switch req.URL.EscapedPath() {
+			case "/v3/tags":
+				Expect(query.Get("tag_type")).To(Equal("user"))
+				Expect(query.Get("limit")).To(Equal("1000"))
+				if query.Get("offset") == "0" {
+					fmt.Fprint(res, `{"total_count": 4, "items": [{"name": "legacy"}, {"name": "env:dev"}, {"name": "env:prod"}]}`)
+				} else {
+					Expect(query.Get("offset")).To(Equal("3"))
+					fmt.Fprint(res, `{"total_count": 4, "items": [{"name": "owner:ann"}]}`)
+				}
+			case "/v3/resources/search":
+				Expect(query.Get("account_id")).To(Equal("acct"))
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				tag := strings.TrimSuffix(strings.TrimPrefix(body["query"].(string), `tags:"`), `"`)
+				if tag == failTag {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"message": "failed"}`)
+					return
+				}
+				// Pages of at most two results, followed by an empty page.
+				start := 0
+				if cursor, ok := body["search_cursor"].(string); ok {
+					fmt.Sscan(cursor, &start)
+				}
+				items := make([]string, 0)
+				for i := start; i < counts[tag] && i < start+2; i++ {
+					items = append(items, fmt.Sprintf(`{"crn": "crn:%s:%d"}`, tag, i))
+				}
+				fmt.Fprintf(res, `{"search_cursor": "%d", "items": [%s]}`, start+len(items), strings.Join(items, ","))
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		globalTaggingService, serviceErr := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		globalSearchService, serviceErr := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		reporter = globaltaggingv1.NewTagUsageReporter(globalTaggingService, globalSearchService)
+		reporter.Concurrency = 2
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	options := func() *globaltaggingv1.ListTagsOptions {
+		return (&globaltaggingv1.GlobalTaggingV1{}).NewListTagsOptions().
+			SetTagType(globaltaggingv1.ListTagsOptionsTagTypeUserConst).
+			SetAccountID("acct").
+			SetAttachedOnly(true)
+	}
+
+	It(`Reports tags sorted by usage`, func() {
+		report, err := reporter.GetTagUsage(context.Background(), options())
+		Expect(err).To(BeNil())
+		Expect(report.Tags).To(Equal([]globaltaggingv1.TagUsage{
+			{Name: "env:prod", AttachedResources: 3},
+			{Name: "env:dev", AttachedResources: 2},
+			{Name: "owner:ann", AttachedResources: 2},
+			{Name: "legacy", AttachedResources: 0},
+		}))
+		Expect(report.Orphaned()).To(Equal([]globaltaggingv1.TagUsage{{Name: "legacy"}}))
+	})
+	It(`Returns the first error`, func() {
+		failTag = "env:dev"
+		report, err := reporter.GetTagUsage(context.Background(), options())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("env:dev"))
+		Expect(report).To(BeNil())
+	})
+})
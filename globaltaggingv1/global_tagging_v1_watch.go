@@ -0,0 +1,236 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultTagWatchInterval is the interval between polls used by WatchTags when no interval is specified.
+const DefaultTagWatchInterval = time.Minute
+
+// Constants associated with the TagEvent.Type property.
+const (
+	// The tag was attached to the resource.
+	TagEventTypeAttachConst = "attach"
+
+	// The tag was detached from the resource.
+	TagEventTypeDetachConst = "detach"
+)
+
+// TagEvent : A change of the tags of a resource detected by a TagWatcher.
+type TagEvent struct {
+	// The CRN of the resource.
+	CRN string `json:"crn"`
+
+	// The name of the tag, in lower case.
+	Tag string `json:"tag"`
+
+	// Whether the tag was attached or detached.
+	Type string `json:"type"`
+
+	// When the change was detected. The change happened between the previous poll and this time.
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// TagStateStore : Stores the tags of each resource seen by a TagWatcher at its last poll, so that changes can be
+// detected across restarts.
+type TagStateStore interface {
+	// LoadTags returns the tags saved for a resource; "found" is false if no tags were ever saved for it.
+	LoadTags(ctx context.Context, resourceCRN string) (tags []string, found bool, err error)
+
+	// SaveTags saves the tags of a resource, replacing those saved before.
+	SaveTags(ctx context.Context, resourceCRN string, tags []string) error
+}
+
+// MemoryTagStateStore : A TagStateStore that keeps the tags in memory. It is safe for concurrent use.
+type MemoryTagStateStore struct {
+	mutex sync.Mutex
+	tags  map[string][]string
+}
+
+// NewMemoryTagStateStore returns a new, empty MemoryTagStateStore.
+func NewMemoryTagStateStore() *MemoryTagStateStore {
+	return &MemoryTagStateStore{tags: make(map[string][]string)}
+}
+
+// LoadTags returns the tags saved for a resource.
+func (store *MemoryTagStateStore) LoadTags(ctx context.Context, resourceCRN string) (tags []string, found bool, err error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	tags, found = store.tags[resourceCRN]
+	return
+}
+
+// SaveTags saves the tags of a resource.
+func (store *MemoryTagStateStore) SaveTags(ctx context.Context, resourceCRN string, tags []string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.tags[resourceCRN] = append([]string(nil), tags...)
+	return nil
+}
+
+// TagWatcher : Detects changes of the tags of resources by listing their tags periodically and comparing them with
+// the tags seen at the previous poll, as the platform does not notify tag changes.
+// Tags that are attached and detached again between two polls are not detected.
+type TagWatcher struct {
+	*GlobalTaggingV1
+
+	// The store of the tags seen at the previous poll.
+	Store TagStateStore
+
+	// The type of the tags watched. Defaults to ListTagsOptionsTagTypeUserConst.
+	TagType string
+
+	// The ID of the account of the resources; required to watch access tags.
+	AccountID string
+
+	// If not nil, called by WatchTags with the errors of the polls.
+	OnError func(err error)
+}
+
+// NewTagWatcher returns a new TagWatcher that uses the specified client and store. If "store" is nil, a
+// MemoryTagStateStore is used.
+func NewTagWatcher(globalTagging *GlobalTaggingV1, store TagStateStore) *TagWatcher {
+	if store == nil {
+		store = NewMemoryTagStateStore()
+	}
+	return &TagWatcher{
+		GlobalTaggingV1: globalTagging,
+		Store:           store,
+		TagType:         ListTagsOptionsTagTypeUserConst,
+	}
+}
+
+// PollTags lists the tags of the resources with the specified CRNs, returns the events of the differences with the
+// tags in the store, and saves the new tags in the store. The first time a resource is polled, its tags are saved
+// without returning events. Tags are compared case-insensitively.
+// If listing the tags of a resource fails, the resource is skipped and the events of the other resources are
+// returned with the first error.
+func (watcher *TagWatcher) PollTags(ctx context.Context, crns []string) (events []TagEvent, err error) {
+	for _, resourceCRN := range crns {
+		resourceEvents, pollErr := watcher.pollResource(ctx, resourceCRN)
+		if pollErr != nil {
+			if ctx.Err() != nil {
+				err = ctx.Err()
+				return
+			}
+			if err == nil {
+				err = pollErr
+			}
+			continue
+		}
+		events = append(events, resourceEvents...)
+	}
+	return
+}
+
+func (watcher *TagWatcher) pollResource(ctx context.Context, resourceCRN string) (events []TagEvent, err error) {
+	options := &ListTagsOptions{
+		AttachedTo: core.StringPtr(resourceCRN),
+		Limit:      core.Int64Ptr(tagListPageSize),
+	}
+	if watcher.TagType != "" {
+		options.TagType = core.StringPtr(watcher.TagType)
+	}
+	if watcher.AccountID != "" {
+		options.AccountID = core.StringPtr(watcher.AccountID)
+	}
+	names, err := watcher.listTagNames(ctx, options)
+	if err != nil {
+		err = fmt.Errorf("error listing the tags of resource '%s': %w", resourceCRN, err)
+		return
+	}
+	current := normalizeTags(names)
+	tags := make([]string, 0, len(current))
+	for tag := range current {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	previousTags, found, err := watcher.Store.LoadTags(ctx, resourceCRN)
+	if err != nil {
+		err = fmt.Errorf("error loading the tags of resource '%s': %w", resourceCRN, err)
+		return
+	}
+	if found {
+		detectedAt := time.Now()
+		previous := normalizeTags(previousTags)
+		for _, tag := range tags {
+			if !previous[tag] {
+				events = append(events, TagEvent{CRN: resourceCRN, Tag: tag, Type: TagEventTypeAttachConst, DetectedAt: detectedAt})
+			}
+		}
+		var detached []string
+		for tag := range previous {
+			if !current[tag] {
+				detached = append(detached, tag)
+			}
+		}
+		sort.Strings(detached)
+		for _, tag := range detached {
+			events = append(events, TagEvent{CRN: resourceCRN, Tag: tag, Type: TagEventTypeDetachConst, DetectedAt: detectedAt})
+		}
+	}
+
+	err = watcher.Store.SaveTags(ctx, resourceCRN, tags)
+	if err != nil {
+		events = nil
+		err = fmt.Errorf("error saving the tags of resource '%s': %w", resourceCRN, err)
+	}
+	return
+}
+
+// WatchTags polls the tags of the resources with the specified CRNs every "interval" (DefaultTagWatchInterval if
+// zero), starting immediately, and sends the detected changes on the returned channel. The errors of the polls are
+// passed to OnError. The channel is closed when the context is done; the caller must receive the events until then.
+func (watcher *TagWatcher) WatchTags(ctx context.Context, crns []string, interval time.Duration) <-chan TagEvent {
+	if interval <= 0 {
+		interval = DefaultTagWatchInterval
+	}
+	events := make(chan TagEvent)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			polled, err := watcher.PollTags(ctx, crns)
+			if err != nil && ctx.Err() == nil && watcher.OnError != nil {
+				watcher.OnError(err)
+			}
+			for _, event := range polled {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return events
+}
@@ -0,0 +1,144 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalTaggingV1 WatchTags`, func() {
+	const crn1 = "crn:v1:bluemix:public:kms:us-south:a/acct:inst1::"
+	const crn2 = "crn:v1:bluemix:public:kms:us-south:a/acct:inst2::"
+	var testServer *httptest.Server
+	var watcher *globaltaggingv1.TagWatcher
+	var mutex sync.Mutex
+	var tags map[string][]string
+	BeforeEach(func() {
+		tags = map[string][]string{crn1: {"env:dev", "Owner:Ann"}, crn2: {"env:prod"}}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v3/tags"))
+			query := req.URL.Query()
+			Expect(query.Get("tag_type")).To(Equal("user"))
+			res.Header().Set("Content-type", "application/json")
+			mutex.Lock()
+			resourceTags, found := tags[query.Get("attached_to")]
+			mutex.Unlock()
+			if !found {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+				return
+			}
+			items := make([]string, len(resourceTags))
+			for i, tag := range resourceTags {
+				items[i] = fmt.Sprintf(`{"name": "%s"}`, tag)
+			}
+			fmt.Fprintf(res, `{"total_count": %d, "items": [%s]}`, len(items), strings.Join(items, ","))
+		}))
+		globalTaggingService, serviceErr := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		watcher = globaltaggingv1.NewTagWatcher(globalTaggingService, nil)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	setTags := func(resourceCRN string, resourceTags ...string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		tags[resourceCRN] = resourceTags
+	}
+
+	It(`Reports the differences with the previous poll`, func() {
+		events, err := watcher.PollTags(context.Background(), []string{crn1, crn2})
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+
+		setTags(crn1, "env:prod", "owner:ann")
+		setTags(crn2)
+		events, err = watcher.PollTags(context.Background(), []string{crn1, crn2})
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(3))
+		Expect(events[0]).To(matchTagEvent(crn1, "env:prod", globaltaggingv1.TagEventTypeAttachConst))
+		Expect(events[1]).To(matchTagEvent(crn1, "env:dev", globaltaggingv1.TagEventTypeDetachConst))
+		Expect(events[2]).To(matchTagEvent(crn2, "env:prod", globaltaggingv1.TagEventTypeDetachConst))
+		Expect(events[0].DetectedAt).ToNot(BeZero())
+
+		events, err = watcher.PollTags(context.Background(), []string{crn1, crn2})
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+	})
+	It(`Skips the resources whose tags cannot be listed`, func() {
+		store := globaltaggingv1.NewMemoryTagStateStore()
+		Expect(store.SaveTags(context.Background(), crn2, []string{"env:dev"})).To(Succeed())
+		watcher.Store = store
+
+		events, err := watcher.PollTags(context.Background(), []string{"crn:missing", crn2})
+		Expect(err).ToNot(BeNil())
+		Expect(events).To(HaveLen(2))
+		Expect(events[0]).To(matchTagEvent(crn2, "env:prod", globaltaggingv1.TagEventTypeAttachConst))
+		Expect(events[1]).To(matchTagEvent(crn2, "env:dev", globaltaggingv1.TagEventTypeDetachConst))
+		_, found, _ := store.LoadTags(context.Background(), "crn:missing")
+		Expect(found).To(BeFalse())
+	})
+	It(`Streams the changes until the context is done`, func() {
+		var errorsMutex sync.Mutex
+		var pollErrors []error
+		watcher.OnError = func(err error) {
+			errorsMutex.Lock()
+			defer errorsMutex.Unlock()
+			pollErrors = append(pollErrors, err)
+		}
+		_, err := watcher.PollTags(context.Background(), []string{crn1})
+		Expect(err).To(BeNil())
+		ctx, cancel := context.WithCancel(context.Background())
+		events := watcher.WatchTags(ctx, []string{crn1, "crn:missing"}, 10*time.Millisecond)
+
+		setTags(crn1, "env:dev", "owner:ann", "team:a")
+		var event globaltaggingv1.TagEvent
+		Eventually(events).Should(Receive(&event))
+		Expect(event).To(matchTagEvent(crn1, "team:a", globaltaggingv1.TagEventTypeAttachConst))
+
+		cancel()
+		Eventually(events).Should(BeClosed())
+		errorsMutex.Lock()
+		defer errorsMutex.Unlock()
+		Expect(pollErrors).ToNot(BeEmpty())
+	})
+})
+
+// matchTagEvent returns a matcher of a TagEvent with the specified properties.
+func matchTagEvent(resourceCRN string, tag string, eventType string) OmegaMatcher {
+	return WithTransform(func(event globaltaggingv1.TagEvent) []string {
+		return []string{event.CRN, event.Tag, event.Type}
+	}, Equal([]string{resourceCRN, tag, eventType}))
+}
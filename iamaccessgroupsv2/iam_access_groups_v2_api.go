@@ -0,0 +1,414 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package iamaccessgroupsv2
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// IamAccessGroupsV2API : The operations of the IamAccessGroupsV2 service, implemented by *IamAccessGroupsV2.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type IamAccessGroupsV2API interface {
+	// Clone makes a copy of "iamAccessGroups" suitable for processing requests.
+	Clone() *IamAccessGroupsV2
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// CreateAccessGroup : Create an access group
+	CreateAccessGroup(createAccessGroupOptions *CreateAccessGroupOptions) (result *Group, response *core.DetailedResponse, err error)
+
+	// CreateAccessGroupWithContext is an alternate form of the CreateAccessGroup method which supports a Context
+	// parameter
+	CreateAccessGroupWithContext(ctx context.Context, createAccessGroupOptions *CreateAccessGroupOptions) (result *Group, response *core.DetailedResponse, err error)
+
+	// ListAccessGroups : List access groups
+	ListAccessGroups(listAccessGroupsOptions *ListAccessGroupsOptions) (result *GroupsList, response *core.DetailedResponse, err error)
+
+	// ListAccessGroupsWithContext is an alternate form of the ListAccessGroups method which supports a Context
+	// parameter
+	ListAccessGroupsWithContext(ctx context.Context, listAccessGroupsOptions *ListAccessGroupsOptions) (result *GroupsList, response *core.DetailedResponse, err error)
+
+	// GetAccessGroup : Get an access group
+	GetAccessGroup(getAccessGroupOptions *GetAccessGroupOptions) (result *Group, response *core.DetailedResponse, err error)
+
+	// GetAccessGroupWithContext is an alternate form of the GetAccessGroup method which supports a Context parameter
+	GetAccessGroupWithContext(ctx context.Context, getAccessGroupOptions *GetAccessGroupOptions) (result *Group, response *core.DetailedResponse, err error)
+
+	// UpdateAccessGroup : Update an access group
+	UpdateAccessGroup(updateAccessGroupOptions *UpdateAccessGroupOptions) (result *Group, response *core.DetailedResponse, err error)
+
+	// UpdateAccessGroupWithContext is an alternate form of the UpdateAccessGroup method which supports a Context
+	// parameter
+	UpdateAccessGroupWithContext(ctx context.Context, updateAccessGroupOptions *UpdateAccessGroupOptions) (result *Group, response *core.DetailedResponse, err error)
+
+	// DeleteAccessGroup : Delete an access group
+	DeleteAccessGroup(deleteAccessGroupOptions *DeleteAccessGroupOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteAccessGroupWithContext is an alternate form of the DeleteAccessGroup method which supports a Context
+	// parameter
+	DeleteAccessGroupWithContext(ctx context.Context, deleteAccessGroupOptions *DeleteAccessGroupOptions) (response *core.DetailedResponse, err error)
+
+	// IsMemberOfAccessGroup : Check membership in an access group
+	IsMemberOfAccessGroup(isMemberOfAccessGroupOptions *IsMemberOfAccessGroupOptions) (response *core.DetailedResponse, err error)
+
+	// IsMemberOfAccessGroupWithContext is an alternate form of the IsMemberOfAccessGroup method which supports a
+	// Context parameter
+	IsMemberOfAccessGroupWithContext(ctx context.Context, isMemberOfAccessGroupOptions *IsMemberOfAccessGroupOptions) (response *core.DetailedResponse, err error)
+
+	// AddMembersToAccessGroup : Add members to an access group
+	AddMembersToAccessGroup(addMembersToAccessGroupOptions *AddMembersToAccessGroupOptions) (result *AddGroupMembersResponse, response *core.DetailedResponse, err error)
+
+	// AddMembersToAccessGroupWithContext is an alternate form of the AddMembersToAccessGroup method which supports a
+	// Context parameter
+	AddMembersToAccessGroupWithContext(ctx context.Context, addMembersToAccessGroupOptions *AddMembersToAccessGroupOptions) (result *AddGroupMembersResponse, response *core.DetailedResponse, err error)
+
+	// ListAccessGroupMembers : List access group members
+	ListAccessGroupMembers(listAccessGroupMembersOptions *ListAccessGroupMembersOptions) (result *GroupMembersList, response *core.DetailedResponse, err error)
+
+	// ListAccessGroupMembersWithContext is an alternate form of the ListAccessGroupMembers method which supports a
+	// Context parameter
+	ListAccessGroupMembersWithContext(ctx context.Context, listAccessGroupMembersOptions *ListAccessGroupMembersOptions) (result *GroupMembersList, response *core.DetailedResponse, err error)
+
+	// RemoveMemberFromAccessGroup : Delete member from an access group
+	RemoveMemberFromAccessGroup(removeMemberFromAccessGroupOptions *RemoveMemberFromAccessGroupOptions) (response *core.DetailedResponse, err error)
+
+	// RemoveMemberFromAccessGroupWithContext is an alternate form of the RemoveMemberFromAccessGroup method which
+	// supports a Context parameter
+	RemoveMemberFromAccessGroupWithContext(ctx context.Context, removeMemberFromAccessGroupOptions *RemoveMemberFromAccessGroupOptions) (response *core.DetailedResponse, err error)
+
+	// RemoveMembersFromAccessGroup : Delete members from an access group
+	RemoveMembersFromAccessGroup(removeMembersFromAccessGroupOptions *RemoveMembersFromAccessGroupOptions) (result *DeleteGroupBulkMembersResponse, response *core.DetailedResponse, err error)
+
+	// RemoveMembersFromAccessGroupWithContext is an alternate form of the RemoveMembersFromAccessGroup method which
+	// supports a Context parameter
+	RemoveMembersFromAccessGroupWithContext(ctx context.Context, removeMembersFromAccessGroupOptions *RemoveMembersFromAccessGroupOptions) (result *DeleteGroupBulkMembersResponse, response *core.DetailedResponse, err error)
+
+	// RemoveMemberFromAllAccessGroups : Delete member from all access groups
+	RemoveMemberFromAllAccessGroups(removeMemberFromAllAccessGroupsOptions *RemoveMemberFromAllAccessGroupsOptions) (result *DeleteFromAllGroupsResponse, response *core.DetailedResponse, err error)
+
+	// RemoveMemberFromAllAccessGroupsWithContext is an alternate form of the RemoveMemberFromAllAccessGroups method
+	// which supports a Context parameter
+	RemoveMemberFromAllAccessGroupsWithContext(ctx context.Context, removeMemberFromAllAccessGroupsOptions *RemoveMemberFromAllAccessGroupsOptions) (result *DeleteFromAllGroupsResponse, response *core.DetailedResponse, err error)
+
+	// AddMemberToMultipleAccessGroups : Add member to multiple access groups
+	AddMemberToMultipleAccessGroups(addMemberToMultipleAccessGroupsOptions *AddMemberToMultipleAccessGroupsOptions) (result *AddMembershipMultipleGroupsResponse, response *core.DetailedResponse, err error)
+
+	// AddMemberToMultipleAccessGroupsWithContext is an alternate form of the AddMemberToMultipleAccessGroups method
+	// which supports a Context parameter
+	AddMemberToMultipleAccessGroupsWithContext(ctx context.Context, addMemberToMultipleAccessGroupsOptions *AddMemberToMultipleAccessGroupsOptions) (result *AddMembershipMultipleGroupsResponse, response *core.DetailedResponse, err error)
+
+	// AddAccessGroupRule : Create rule for an access group
+	AddAccessGroupRule(addAccessGroupRuleOptions *AddAccessGroupRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// AddAccessGroupRuleWithContext is an alternate form of the AddAccessGroupRule method which supports a Context
+	// parameter
+	AddAccessGroupRuleWithContext(ctx context.Context, addAccessGroupRuleOptions *AddAccessGroupRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// ListAccessGroupRules : List access group rules
+	ListAccessGroupRules(listAccessGroupRulesOptions *ListAccessGroupRulesOptions) (result *RulesList, response *core.DetailedResponse, err error)
+
+	// ListAccessGroupRulesWithContext is an alternate form of the ListAccessGroupRules method which supports a Context
+	// parameter
+	ListAccessGroupRulesWithContext(ctx context.Context, listAccessGroupRulesOptions *ListAccessGroupRulesOptions) (result *RulesList, response *core.DetailedResponse, err error)
+
+	// GetAccessGroupRule : Get an access group rule
+	GetAccessGroupRule(getAccessGroupRuleOptions *GetAccessGroupRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// GetAccessGroupRuleWithContext is an alternate form of the GetAccessGroupRule method which supports a Context
+	// parameter
+	GetAccessGroupRuleWithContext(ctx context.Context, getAccessGroupRuleOptions *GetAccessGroupRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// ReplaceAccessGroupRule : Replace an access group rule
+	ReplaceAccessGroupRule(replaceAccessGroupRuleOptions *ReplaceAccessGroupRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// ReplaceAccessGroupRuleWithContext is an alternate form of the ReplaceAccessGroupRule method which supports a
+	// Context parameter
+	ReplaceAccessGroupRuleWithContext(ctx context.Context, replaceAccessGroupRuleOptions *ReplaceAccessGroupRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// RemoveAccessGroupRule : Delete an access group rule
+	RemoveAccessGroupRule(removeAccessGroupRuleOptions *RemoveAccessGroupRuleOptions) (response *core.DetailedResponse, err error)
+
+	// RemoveAccessGroupRuleWithContext is an alternate form of the RemoveAccessGroupRule method which supports a
+	// Context parameter
+	RemoveAccessGroupRuleWithContext(ctx context.Context, removeAccessGroupRuleOptions *RemoveAccessGroupRuleOptions) (response *core.DetailedResponse, err error)
+
+	// GetAccountSettings : Get account settings
+	GetAccountSettings(getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// GetAccountSettingsWithContext is an alternate form of the GetAccountSettings method which supports a Context
+	// parameter
+	GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// UpdateAccountSettings : Update account settings
+	UpdateAccountSettings(updateAccountSettingsOptions *UpdateAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// UpdateAccountSettingsWithContext is an alternate form of the UpdateAccountSettings method which supports a
+	// Context parameter
+	UpdateAccountSettingsWithContext(ctx context.Context, updateAccountSettingsOptions *UpdateAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// NewAddAccessGroupRuleOptions : Instantiate AddAccessGroupRuleOptions
+	NewAddAccessGroupRuleOptions(accessGroupID string, expiration int64, realmName string, conditions []RuleConditions) *AddAccessGroupRuleOptions
+
+	// NewAddGroupMembersRequestMembersItem : Instantiate AddGroupMembersRequestMembersItem (Generic Model Constructor)
+	NewAddGroupMembersRequestMembersItem(iamID string, typeVar string) (_model *AddGroupMembersRequestMembersItem, err error)
+
+	// NewAddMemberToMultipleAccessGroupsOptions : Instantiate AddMemberToMultipleAccessGroupsOptions
+	NewAddMemberToMultipleAccessGroupsOptions(accountID string, iamID string) *AddMemberToMultipleAccessGroupsOptions
+
+	// NewAddMembersToAccessGroupOptions : Instantiate AddMembersToAccessGroupOptions
+	NewAddMembersToAccessGroupOptions(accessGroupID string) *AddMembersToAccessGroupOptions
+
+	// NewCreateAccessGroupOptions : Instantiate CreateAccessGroupOptions
+	NewCreateAccessGroupOptions(accountID string, name string) *CreateAccessGroupOptions
+
+	// NewDeleteAccessGroupOptions : Instantiate DeleteAccessGroupOptions
+	NewDeleteAccessGroupOptions(accessGroupID string) *DeleteAccessGroupOptions
+
+	// NewGetAccessGroupOptions : Instantiate GetAccessGroupOptions
+	NewGetAccessGroupOptions(accessGroupID string) *GetAccessGroupOptions
+
+	// NewGetAccessGroupRuleOptions : Instantiate GetAccessGroupRuleOptions
+	NewGetAccessGroupRuleOptions(accessGroupID string, ruleID string) *GetAccessGroupRuleOptions
+
+	// NewGetAccountSettingsOptions : Instantiate GetAccountSettingsOptions
+	NewGetAccountSettingsOptions(accountID string) *GetAccountSettingsOptions
+
+	// NewIsMemberOfAccessGroupOptions : Instantiate IsMemberOfAccessGroupOptions
+	NewIsMemberOfAccessGroupOptions(accessGroupID string, iamID string) *IsMemberOfAccessGroupOptions
+
+	// NewListAccessGroupMembersOptions : Instantiate ListAccessGroupMembersOptions
+	NewListAccessGroupMembersOptions(accessGroupID string) *ListAccessGroupMembersOptions
+
+	// NewListAccessGroupRulesOptions : Instantiate ListAccessGroupRulesOptions
+	NewListAccessGroupRulesOptions(accessGroupID string) *ListAccessGroupRulesOptions
+
+	// NewListAccessGroupsOptions : Instantiate ListAccessGroupsOptions
+	NewListAccessGroupsOptions(accountID string) *ListAccessGroupsOptions
+
+	// NewRemoveAccessGroupRuleOptions : Instantiate RemoveAccessGroupRuleOptions
+	NewRemoveAccessGroupRuleOptions(accessGroupID string, ruleID string) *RemoveAccessGroupRuleOptions
+
+	// NewRemoveMemberFromAccessGroupOptions : Instantiate RemoveMemberFromAccessGroupOptions
+	NewRemoveMemberFromAccessGroupOptions(accessGroupID string, iamID string) *RemoveMemberFromAccessGroupOptions
+
+	// NewRemoveMemberFromAllAccessGroupsOptions : Instantiate RemoveMemberFromAllAccessGroupsOptions
+	NewRemoveMemberFromAllAccessGroupsOptions(accountID string, iamID string) *RemoveMemberFromAllAccessGroupsOptions
+
+	// NewRemoveMembersFromAccessGroupOptions : Instantiate RemoveMembersFromAccessGroupOptions
+	NewRemoveMembersFromAccessGroupOptions(accessGroupID string) *RemoveMembersFromAccessGroupOptions
+
+	// NewReplaceAccessGroupRuleOptions : Instantiate ReplaceAccessGroupRuleOptions
+	NewReplaceAccessGroupRuleOptions(accessGroupID string, ruleID string, ifMatch string, expiration int64, realmName string, conditions []RuleConditions) *ReplaceAccessGroupRuleOptions
+
+	// NewRuleConditions : Instantiate RuleConditions (Generic Model Constructor)
+	NewRuleConditions(claim string, operator string, value string) (_model *RuleConditions, err error)
+
+	// NewUpdateAccessGroupOptions : Instantiate UpdateAccessGroupOptions
+	NewUpdateAccessGroupOptions(accessGroupID string, ifMatch string) *UpdateAccessGroupOptions
+
+	// NewUpdateAccountSettingsOptions : Instantiate UpdateAccountSettingsOptions
+	NewUpdateAccountSettingsOptions(accountID string) *UpdateAccountSettingsOptions
+
+	// ResolveEffectiveMembers returns the flattened, de-duplicated list of the identities that are effectively members
+	// of an access group, sorted by IAM ID.
+	ResolveEffectiveMembers(ctx context.Context, groupID string, resolveProfile ProfileMembersResolver) (members []EffectiveMember, err error)
+
+	// EffectiveMemberIamIDs returns the IAM IDs of the identities that are effectively members of an access group (see
+	// ResolveEffectiveMembers; trusted profiles are not resolved), sorted.
+	EffectiveMemberIamIDs(ctx context.Context, groupID string) (iamIDs []string, err error)
+
+	// NewCreateAccessGroupOptionsWith returns CreateAccessGroupOptions populated by applying "options" in order.
+	NewCreateAccessGroupOptionsWith(options ...CreateAccessGroupOption) *CreateAccessGroupOptions
+
+	// CreateAccessGroupWith is an alternate form of the CreateAccessGroupWithContext method which takes functional
+	// options instead of a CreateAccessGroupOptions struct.
+	CreateAccessGroupWith(ctx context.Context, options ...CreateAccessGroupOption) (result *Group, response *core.DetailedResponse, err error)
+
+	// NewListAccessGroupsOptionsWith returns ListAccessGroupsOptions populated by applying "options" in order.
+	NewListAccessGroupsOptionsWith(options ...ListAccessGroupsOption) *ListAccessGroupsOptions
+
+	// ListAccessGroupsWith is an alternate form of the ListAccessGroupsWithContext method which takes functional
+	// options instead of a ListAccessGroupsOptions struct.
+	ListAccessGroupsWith(ctx context.Context, options ...ListAccessGroupsOption) (result *GroupsList, response *core.DetailedResponse, err error)
+
+	// NewGetAccessGroupOptionsWith returns GetAccessGroupOptions populated by applying "options" in order.
+	NewGetAccessGroupOptionsWith(options ...GetAccessGroupOption) *GetAccessGroupOptions
+
+	// GetAccessGroupWith is an alternate form of the GetAccessGroupWithContext method which takes functional options
+	// instead of a GetAccessGroupOptions struct.
+	GetAccessGroupWith(ctx context.Context, options ...GetAccessGroupOption) (result *Group, response *core.DetailedResponse, err error)
+
+	// NewUpdateAccessGroupOptionsWith returns UpdateAccessGroupOptions populated by applying "options" in order.
+	NewUpdateAccessGroupOptionsWith(options ...UpdateAccessGroupOption) *UpdateAccessGroupOptions
+
+	// UpdateAccessGroupWith is an alternate form of the UpdateAccessGroupWithContext method which takes functional
+	// options instead of an UpdateAccessGroupOptions struct.
+	UpdateAccessGroupWith(ctx context.Context, options ...UpdateAccessGroupOption) (result *Group, response *core.DetailedResponse, err error)
+
+	// NewDeleteAccessGroupOptionsWith returns DeleteAccessGroupOptions populated by applying "options" in order.
+	NewDeleteAccessGroupOptionsWith(options ...DeleteAccessGroupOption) *DeleteAccessGroupOptions
+
+	// DeleteAccessGroupWith is an alternate form of the DeleteAccessGroupWithContext method which takes functional
+	// options instead of a DeleteAccessGroupOptions struct.
+	DeleteAccessGroupWith(ctx context.Context, options ...DeleteAccessGroupOption) (response *core.DetailedResponse, err error)
+
+	// NewIsMemberOfAccessGroupOptionsWith returns IsMemberOfAccessGroupOptions populated by applying "options" in
+	// order.
+	NewIsMemberOfAccessGroupOptionsWith(options ...IsMemberOfAccessGroupOption) *IsMemberOfAccessGroupOptions
+
+	// IsMemberOfAccessGroupWith is an alternate form of the IsMemberOfAccessGroupWithContext method which takes
+	// functional options instead of an IsMemberOfAccessGroupOptions struct.
+	IsMemberOfAccessGroupWith(ctx context.Context, options ...IsMemberOfAccessGroupOption) (response *core.DetailedResponse, err error)
+
+	// NewAddMembersToAccessGroupOptionsWith returns AddMembersToAccessGroupOptions populated by applying "options" in
+	// order.
+	NewAddMembersToAccessGroupOptionsWith(options ...AddMembersToAccessGroupOption) *AddMembersToAccessGroupOptions
+
+	// AddMembersToAccessGroupWith is an alternate form of the AddMembersToAccessGroupWithContext method which takes
+	// functional options instead of an AddMembersToAccessGroupOptions struct.
+	AddMembersToAccessGroupWith(ctx context.Context, options ...AddMembersToAccessGroupOption) (result *AddGroupMembersResponse, response *core.DetailedResponse, err error)
+
+	// NewListAccessGroupMembersOptionsWith returns ListAccessGroupMembersOptions populated by applying "options" in
+	// order.
+	NewListAccessGroupMembersOptionsWith(options ...ListAccessGroupMembersOption) *ListAccessGroupMembersOptions
+
+	// ListAccessGroupMembersWith is an alternate form of the ListAccessGroupMembersWithContext method which takes
+	// functional options instead of a ListAccessGroupMembersOptions struct.
+	ListAccessGroupMembersWith(ctx context.Context, options ...ListAccessGroupMembersOption) (result *GroupMembersList, response *core.DetailedResponse, err error)
+
+	// NewRemoveMemberFromAccessGroupOptionsWith returns RemoveMemberFromAccessGroupOptions populated by applying
+	// "options" in order.
+	NewRemoveMemberFromAccessGroupOptionsWith(options ...RemoveMemberFromAccessGroupOption) *RemoveMemberFromAccessGroupOptions
+
+	// RemoveMemberFromAccessGroupWith is an alternate form of the RemoveMemberFromAccessGroupWithContext method which
+	// takes functional options instead of a RemoveMemberFromAccessGroupOptions struct.
+	RemoveMemberFromAccessGroupWith(ctx context.Context, options ...RemoveMemberFromAccessGroupOption) (response *core.DetailedResponse, err error)
+
+	// NewRemoveMembersFromAccessGroupOptionsWith returns RemoveMembersFromAccessGroupOptions populated by applying
+	// "options" in order.
+	NewRemoveMembersFromAccessGroupOptionsWith(options ...RemoveMembersFromAccessGroupOption) *RemoveMembersFromAccessGroupOptions
+
+	// RemoveMembersFromAccessGroupWith is an alternate form of the RemoveMembersFromAccessGroupWithContext method
+	// which takes functional options instead of a RemoveMembersFromAccessGroupOptions struct.
+	RemoveMembersFromAccessGroupWith(ctx context.Context, options ...RemoveMembersFromAccessGroupOption) (result *DeleteGroupBulkMembersResponse, response *core.DetailedResponse, err error)
+
+	// NewRemoveMemberFromAllAccessGroupsOptionsWith returns RemoveMemberFromAllAccessGroupsOptions populated by
+	// applying "options" in order.
+	NewRemoveMemberFromAllAccessGroupsOptionsWith(options ...RemoveMemberFromAllAccessGroupsOption) *RemoveMemberFromAllAccessGroupsOptions
+
+	// RemoveMemberFromAllAccessGroupsWith is an alternate form of the RemoveMemberFromAllAccessGroupsWithContext
+	// method which takes functional options instead of a RemoveMemberFromAllAccessGroupsOptions struct.
+	RemoveMemberFromAllAccessGroupsWith(ctx context.Context, options ...RemoveMemberFromAllAccessGroupsOption) (result *DeleteFromAllGroupsResponse, response *core.DetailedResponse, err error)
+
+	// NewAddMemberToMultipleAccessGroupsOptionsWith returns AddMemberToMultipleAccessGroupsOptions populated by
+	// applying "options" in order.
+	NewAddMemberToMultipleAccessGroupsOptionsWith(options ...AddMemberToMultipleAccessGroupsOption) *AddMemberToMultipleAccessGroupsOptions
+
+	// AddMemberToMultipleAccessGroupsWith is an alternate form of the AddMemberToMultipleAccessGroupsWithContext
+	// method which takes functional options instead of an AddMemberToMultipleAccessGroupsOptions struct.
+	AddMemberToMultipleAccessGroupsWith(ctx context.Context, options ...AddMemberToMultipleAccessGroupsOption) (result *AddMembershipMultipleGroupsResponse, response *core.DetailedResponse, err error)
+
+	// NewAddAccessGroupRuleOptionsWith returns AddAccessGroupRuleOptions populated by applying "options" in order.
+	NewAddAccessGroupRuleOptionsWith(options ...AddAccessGroupRuleOption) *AddAccessGroupRuleOptions
+
+	// AddAccessGroupRuleWith is an alternate form of the AddAccessGroupRuleWithContext method which takes functional
+	// options instead of an AddAccessGroupRuleOptions struct.
+	AddAccessGroupRuleWith(ctx context.Context, options ...AddAccessGroupRuleOption) (result *Rule, response *core.DetailedResponse, err error)
+
+	// NewListAccessGroupRulesOptionsWith returns ListAccessGroupRulesOptions populated by applying "options" in order.
+	NewListAccessGroupRulesOptionsWith(options ...ListAccessGroupRulesOption) *ListAccessGroupRulesOptions
+
+	// ListAccessGroupRulesWith is an alternate form of the ListAccessGroupRulesWithContext method which takes
+	// functional options instead of a ListAccessGroupRulesOptions struct.
+	ListAccessGroupRulesWith(ctx context.Context, options ...ListAccessGroupRulesOption) (result *RulesList, response *core.DetailedResponse, err error)
+
+	// NewGetAccessGroupRuleOptionsWith returns GetAccessGroupRuleOptions populated by applying "options" in order.
+	NewGetAccessGroupRuleOptionsWith(options ...GetAccessGroupRuleOption) *GetAccessGroupRuleOptions
+
+	// GetAccessGroupRuleWith is an alternate form of the GetAccessGroupRuleWithContext method which takes functional
+	// options instead of a GetAccessGroupRuleOptions struct.
+	GetAccessGroupRuleWith(ctx context.Context, options ...GetAccessGroupRuleOption) (result *Rule, response *core.DetailedResponse, err error)
+
+	// NewReplaceAccessGroupRuleOptionsWith returns ReplaceAccessGroupRuleOptions populated by applying "options" in
+	// order.
+	NewReplaceAccessGroupRuleOptionsWith(options ...ReplaceAccessGroupRuleOption) *ReplaceAccessGroupRuleOptions
+
+	// ReplaceAccessGroupRuleWith is an alternate form of the ReplaceAccessGroupRuleWithContext method which takes
+	// functional options instead of a ReplaceAccessGroupRuleOptions struct.
+	ReplaceAccessGroupRuleWith(ctx context.Context, options ...ReplaceAccessGroupRuleOption) (result *Rule, response *core.DetailedResponse, err error)
+
+	// NewRemoveAccessGroupRuleOptionsWith returns RemoveAccessGroupRuleOptions populated by applying "options" in
+	// order.
+	NewRemoveAccessGroupRuleOptionsWith(options ...RemoveAccessGroupRuleOption) *RemoveAccessGroupRuleOptions
+
+	// RemoveAccessGroupRuleWith is an alternate form of the RemoveAccessGroupRuleWithContext method which takes
+	// functional options instead of a RemoveAccessGroupRuleOptions struct.
+	RemoveAccessGroupRuleWith(ctx context.Context, options ...RemoveAccessGroupRuleOption) (response *core.DetailedResponse, err error)
+
+	// NewGetAccountSettingsOptionsWith returns GetAccountSettingsOptions populated by applying "options" in order.
+	NewGetAccountSettingsOptionsWith(options ...GetAccountSettingsOption) *GetAccountSettingsOptions
+
+	// GetAccountSettingsWith is an alternate form of the GetAccountSettingsWithContext method which takes functional
+	// options instead of a GetAccountSettingsOptions struct.
+	GetAccountSettingsWith(ctx context.Context, options ...GetAccountSettingsOption) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// NewUpdateAccountSettingsOptionsWith returns UpdateAccountSettingsOptions populated by applying "options" in
+	// order.
+	NewUpdateAccountSettingsOptionsWith(options ...UpdateAccountSettingsOption) *UpdateAccountSettingsOptions
+
+	// UpdateAccountSettingsWith is an alternate form of the UpdateAccountSettingsWithContext method which takes
+	// functional options instead of an UpdateAccountSettingsOptions struct.
+	UpdateAccountSettingsWith(ctx context.Context, options ...UpdateAccountSettingsOption) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// PreviewDynamicRule evaluates the conditions of a dynamic rule locally against sample identity claims, in the
+	// same way as TestRule, and reports the first condition that does not match.
+	PreviewDynamicRule(ctx context.Context, rule *Rule, sampleClaims map[string]interface{}) (result *RulePreview, err error)
+
+	// SyncGroupMembers reconciles the static membership of an access group with "desiredMembers" (a list of IBMid,
+	// service ID and trusted profile IDs).
+	SyncGroupMembers(ctx context.Context, groupID string, desiredMembers []string) (results []GroupMemberSyncResult, err error)
+}
+
+var _ IamAccessGroupsV2API = (*IamAccessGroupsV2)(nil)
@@ -0,0 +1,251 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamaccessgroupsv2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+// InactiveGroupMember : A member of an access group that has not authenticated within the inactivity window.
+type InactiveGroupMember struct {
+	// The IAM ID of the member.
+	IamID string
+
+	// The name of the member.
+	Name string
+
+	// The member type (MemberTypeUserConst, MemberTypeServiceConst or MemberTypeProfileConst).
+	Type string
+
+	// True if the member was added by an access group rule. Such members cannot be removed; the rule must be changed.
+	Dynamic bool
+
+	// Time when the member was last authenticated; empty if it has never authenticated.
+	LastAuthn string
+}
+
+// InactiveGroupMembersReport : The outcome of FindInactiveGroupMembers or RemoveInactiveGroupMembers.
+type InactiveGroupMembersReport struct {
+	// The ID of the access group.
+	GroupID string
+
+	// The ID of the account of the access group.
+	AccountID string
+
+	// The inactivity window, in days.
+	Days int
+
+	// The inactive members, least recently authenticated first.
+	Members []InactiveGroupMember
+
+	// True if the removal was only simulated: Removed lists the members that would have been removed.
+	DryRun bool
+
+	// The results of the removal of the static inactive members, sorted by IAM ID. Empty for FindInactiveGroupMembers.
+	Removed []GroupMemberSyncResult
+
+	// The workflow ID sent with every request of the operation (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// GroupRecertifier : Reviews the members of access groups against the authentication activity recorded by the IAM
+// Identity service, for periodic access recertification.
+type GroupRecertifier struct {
+	*IamAccessGroupsV2
+
+	// The client used to generate inactivity reports.
+	IamIdentity *iamidentityv1.IamIdentityV1
+
+	// The interval at which the inactivity reports are polled until they complete. Defaults to
+	// iamidentityv1.DefaultInactivityReportPollInterval.
+	ReportPollInterval time.Duration
+}
+
+// NewGroupRecertifier returns a new GroupRecertifier that lists members with "iamAccessGroups" and their activity with
+// "iamIdentity".
+func NewGroupRecertifier(iamAccessGroups *IamAccessGroupsV2, iamIdentity *iamidentityv1.IamIdentityV1) *GroupRecertifier {
+	return &GroupRecertifier{
+		IamAccessGroupsV2: iamAccessGroups,
+		IamIdentity:       iamIdentity,
+	}
+}
+
+// FindInactiveGroupMembers generates an inactivity report for the account of an access group (see
+// iamidentityv1.InactivityReport) and returns the members of the group, static and dynamic, that have not
+// authenticated in the last "days" days. Nothing is changed. If "ctx" carries a common.AccountContext, the access
+// group must belong to its account.
+func (recertifier *GroupRecertifier) FindInactiveGroupMembers(ctx context.Context, groupID string, days int) (report *InactiveGroupMembersReport, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, recertifier.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+	return recertifier.findInactiveGroupMembers(ctx, groupID, days, workflowID)
+}
+
+// RemoveInactiveGroupMembers finds the inactive members of an access group like FindInactiveGroupMembers, then
+// removes its static inactive members with bulk RemoveMembersFromAccessGroup calls. Dynamic members are reported but
+// never removed. If "dryRun" is true, nothing is removed and Removed lists the members that would be removed. If a
+// request fails, the results obtained so far are returned together with the error, which is a *common.PartialError
+// if the context is done.
+func (recertifier *GroupRecertifier) RemoveInactiveGroupMembers(ctx context.Context, groupID string, days int, dryRun bool) (report *InactiveGroupMembersReport, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, recertifier.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+	report, err = recertifier.findInactiveGroupMembers(ctx, groupID, days, workflowID)
+	if err != nil {
+		return
+	}
+	report.DryRun = dryRun
+
+	var toRemove []string
+	for _, member := range report.Members {
+		if !member.Dynamic {
+			toRemove = append(toRemove, member.IamID)
+		}
+	}
+	sort.Strings(toRemove)
+	if dryRun {
+		for _, iamID := range toRemove {
+			report.Removed = append(report.Removed, GroupMemberSyncResult{IamID: iamID, Action: GroupMemberSyncResultActionRemovedConst})
+		}
+		return
+	}
+
+	defer func() {
+		sort.SliceStable(report.Removed, func(i, j int) bool {
+			return report.Removed[i].IamID < report.Removed[j].IamID
+		})
+	}()
+	for start := 0; start < len(toRemove); start += syncGroupMembersBatchSize {
+		if ctx.Err() != nil {
+			err = &common.PartialError{Processed: start, Total: len(toRemove), Err: ctx.Err()}
+			return
+		}
+		batch := toRemove[start:minInt(start+syncGroupMembersBatchSize, len(toRemove))]
+		options := recertifier.NewRemoveMembersFromAccessGroupOptions(groupID).SetMembers(batch)
+		var result *DeleteGroupBulkMembersResponse
+		result, _, err = recertifier.RemoveMembersFromAccessGroupWithContext(ctx, options)
+		if err != nil && ctx.Err() != nil {
+			err = &common.PartialError{Processed: start + len(batch), Total: len(toRemove), Err: ctx.Err()}
+			return
+		}
+		if err != nil {
+			err = fmt.Errorf("error removing inactive members from access group '%s': %w", groupID, err)
+			return
+		}
+		for _, member := range result.Members {
+			report.Removed = append(report.Removed, GroupMemberSyncResult{
+				IamID:      core.StringNilMapper(member.IamID),
+				Action:     GroupMemberSyncResultActionRemovedConst,
+				StatusCode: int64NilMapper(member.StatusCode),
+				Errors:     member.Errors,
+			})
+		}
+	}
+	return
+}
+
+func (recertifier *GroupRecertifier) findInactiveGroupMembers(ctx context.Context, groupID string, days int, workflowID string) (report *InactiveGroupMembersReport, err error) {
+	if groupID == "" {
+		err = fmt.Errorf("groupID cannot be empty")
+		return
+	}
+	if days <= 0 {
+		err = fmt.Errorf("days must be greater than zero")
+		return
+	}
+	err = core.ValidateNotNil(recertifier.IamIdentity, "IamIdentity cannot be nil")
+	if err != nil {
+		return
+	}
+
+	group, _, err := recertifier.GetAccessGroupWithContext(ctx, recertifier.NewGetAccessGroupOptions(groupID))
+	if err != nil {
+		err = fmt.Errorf("error retrieving access group '%s': %w", groupID, err)
+		return
+	}
+	err = checkGroupAccountID(ctx, groupID, group)
+	if err != nil {
+		return
+	}
+	accountID := core.StringNilMapper(group.AccountID)
+	activity, err := recertifier.IamIdentity.InactivityReport(ctx, accountID, days, recertifier.ReportPollInterval)
+	if err != nil {
+		err = fmt.Errorf("error generating the inactivity report of account '%s': %w", accountID, err)
+		return
+	}
+	lastAuthn := inactiveIamIDs(activity)
+
+	report = &InactiveGroupMembersReport{GroupID: groupID, AccountID: accountID, Days: days, WorkflowID: workflowID}
+	err = recertifier.forEachGroupMember(ctx, groupID, "all", func(member *ListGroupMembersResponseMember) {
+		iamID := core.StringNilMapper(member.IamID)
+		authn, inactive := lastAuthn[iamID]
+		if !inactive {
+			return
+		}
+		report.Members = append(report.Members, InactiveGroupMember{
+			IamID:     iamID,
+			Name:      core.StringNilMapper(member.Name),
+			Type:      core.StringNilMapper(member.Type),
+			Dynamic:   core.StringNilMapper(member.MembershipType) == "dynamic",
+			LastAuthn: authn,
+		})
+	})
+	if err != nil {
+		report = nil
+		err = fmt.Errorf("error listing the members of access group '%s': %w", groupID, err)
+		return
+	}
+	sort.SliceStable(report.Members, func(i, j int) bool {
+		a, b := report.Members[i], report.Members[j]
+		if a.LastAuthn != b.LastAuthn {
+			// Members that have never authenticated sort first, since "" is the smallest string.
+			return a.LastAuthn < b.LastAuthn
+		}
+		return a.IamID < b.IamID
+	})
+	return
+}
+
+// inactiveIamIDs returns the time of the last authentication of each identity of an inactivity report, by IAM ID.
+func inactiveIamIDs(activity *iamidentityv1.Report) map[string]string {
+	lastAuthn := map[string]string{}
+	for _, user := range activity.Users {
+		if user.IamID != nil {
+			lastAuthn[*user.IamID] = core.StringNilMapper(user.LastAuthn)
+		}
+	}
+	for _, entities := range [][]iamidentityv1.EntityActivity{activity.Serviceids, activity.Profiles} {
+		for _, entity := range entities {
+			if entity.ID == nil {
+				continue
+			}
+			// The report identifies service IDs and trusted profiles by ID rather than by IAM ID.
+			iamID := *entity.ID
+			if !strings.HasPrefix(iamID, "iam-") {
+				iamID = "iam-" + iamID
+			}
+			lastAuthn[iamID] = core.StringNilMapper(entity.LastAuthn)
+		}
+	}
+	return lastAuthn
+}
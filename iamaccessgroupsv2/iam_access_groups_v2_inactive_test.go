@@ -0,0 +1,153 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamaccessgroupsv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamAccessGroupsV2 FindInactiveGroupMembers`, func() {
+	var testServer *httptest.Server
+	var recertifier *iamaccessgroupsv2.GroupRecertifier
+	var removeRequests [][]string
+	var onRemove func()
+	BeforeEach(func() {
+		removeRequests = nil
+		onRemove = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v2/groups/group1":
+				fmt.Fprint(res, `{"id": "group1", "account_id": "acct"}`)
+			case req.Method == "POST" && req.URL.EscapedPath() == "/v1/activity/accounts/acct/report":
+				Expect(req.URL.Query().Get("duration")).To(Equal("2160"))
+				res.WriteHeader(202)
+				fmt.Fprint(res, `{"account_id": "acct", "reference": "ref1"}`)
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v1/activity/accounts/acct/report/ref1":
+				fmt.Fprint(res, `{"created_by": "IBMid-admin", "reference": "ref1", "report_duration": "2160",
+					"report_start_time": "2022-01-01", "report_end_time": "2022-03-31",
+					"users": [
+						{"iam_id": "IBMid-old", "username": "old@example.com", "last_authn": "2021-06-01T00:00:00Z"},
+						{"iam_id": "IBMid-rule", "username": "rule@example.com", "last_authn": "2021-01-01T00:00:00Z"},
+						{"iam_id": "IBMid-elsewhere", "username": "elsewhere@example.com"}],
+					"serviceids": [{"id": "ServiceId-never", "name": "deployer"}]}`)
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v2/groups/group1/members":
+				Expect(req.URL.Query().Get("membership_type")).To(Equal("all"))
+				fmt.Fprint(res, `{"members": [
+					{"iam_id": "IBMid-active", "type": "user", "membership_type": "static"},
+					{"iam_id": "IBMid-old", "name": "Old", "type": "user", "membership_type": "static"},
+					{"iam_id": "IBMid-rule", "type": "user", "membership_type": "dynamic"},
+					{"iam_id": "iam-ServiceId-never", "name": "deployer", "type": "service", "membership_type": "static"}]}`)
+			case req.Method == "POST" && req.URL.EscapedPath() == "/v2/groups/group1/members/delete":
+				var body struct {
+					Members []string `json:"members"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				removeRequests = append(removeRequests, body.Members)
+				if onRemove != nil {
+					onRemove()
+				}
+				items := []string{}
+				for _, iamID := range body.Members {
+					items = append(items, fmt.Sprintf(`{"iam_id": "%s", "status_code": 204}`, iamID))
+				}
+				res.WriteHeader(207)
+				fmt.Fprintf(res, `{"access_group_id": "group1", "members": [%s]}`, strings.Join(items, ","))
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(iamAccessGroupsService.Service)
+		iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		recertifier = iamaccessgroupsv2.NewGroupRecertifier(iamAccessGroupsService, iamIdentityService)
+		recertifier.ReportPollInterval = time.Millisecond
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Reports the members that have not authenticated within the window`, func() {
+		report, err := recertifier.FindInactiveGroupMembers(context.Background(), "group1", 90)
+		Expect(err).To(BeNil())
+		Expect(report.AccountID).To(Equal("acct"))
+		Expect(report.WorkflowID).ToNot(BeEmpty())
+		Expect(report.Members).To(Equal([]iamaccessgroupsv2.InactiveGroupMember{
+			{IamID: "iam-ServiceId-never", Name: "deployer", Type: "service"},
+			{IamID: "IBMid-rule", Type: "user", Dynamic: true, LastAuthn: "2021-01-01T00:00:00Z"},
+			{IamID: "IBMid-old", Name: "Old", Type: "user", LastAuthn: "2021-06-01T00:00:00Z"},
+		}))
+		Expect(report.Removed).To(BeEmpty())
+		Expect(removeRequests).To(BeEmpty())
+	})
+	It(`Simulates the removal of the static inactive members`, func() {
+		report, err := recertifier.RemoveInactiveGroupMembers(context.Background(), "group1", 90, true)
+		Expect(err).To(BeNil())
+		Expect(report.DryRun).To(BeTrue())
+		Expect(report.Removed).To(HaveLen(2))
+		Expect(report.Removed[0].IamID).To(Equal("IBMid-old"))
+		Expect(removeRequests).To(BeEmpty())
+	})
+	It(`Removes the static inactive members`, func() {
+		report, err := recertifier.RemoveInactiveGroupMembers(context.Background(), "group1", 90, false)
+		Expect(err).To(BeNil())
+		Expect(removeRequests).To(Equal([][]string{{"IBMid-old", "iam-ServiceId-never"}}))
+		Expect(report.Removed).To(HaveLen(2))
+		Expect(report.Removed[1].IamID).To(Equal("iam-ServiceId-never"))
+		Expect(report.Removed[1].Succeeded()).To(BeTrue())
+	})
+	It(`Returns the partial report when the context is done during the removal`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		onRemove = cancel
+		report, err := recertifier.RemoveInactiveGroupMembers(ctx, "group1", 90, false)
+		var partialErr *common.PartialError
+		Expect(errors.As(err, &partialErr)).To(BeTrue())
+		Expect(partialErr.Processed).To(Equal(2))
+		Expect(partialErr.Total).To(Equal(2))
+		Expect(report.Members).To(HaveLen(3))
+		Expect(report.Removed).To(BeEmpty())
+	})
+	It(`Rejects an invalid window`, func() {
+		_, err := recertifier.FindInactiveGroupMembers(context.Background(), "group1", 0)
+		Expect(err).ToNot(BeNil())
+	})
+})
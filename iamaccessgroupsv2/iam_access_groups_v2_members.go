@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamaccessgroupsv2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// Constants associated with the EffectiveMember.Sources property.
+const (
+	EffectiveMemberSourceStaticConst  = "static"
+	EffectiveMemberSourceDynamicConst = "dynamic"
+)
+
+// ProfileMembersResolver returns the IAM IDs of the identities that can act as the trusted profile with the specified
+// IAM ID, for example by combining the profile's links and claim rules from the IAM Identity service.
+type ProfileMembersResolver func(ctx context.Context, profileIamID string) (iamIDs []string, err error)
+
+// EffectiveMember : An identity that is effectively a member of an access group.
+type EffectiveMember struct {
+	// The IBMid, service ID or trusted profile ID of the member.
+	IamID string
+
+	// The member type - either `user`, `service` or `profile`.
+	Type string
+
+	// How the identity came to be a member: "static", "dynamic", or the IAM ID of the trusted profile through which the
+	// identity is a member.
+	Sources []string
+}
+
+// ResolveEffectiveMembers returns the flattened, de-duplicated list of the identities that are effectively members
+// of an access group, sorted by IAM ID. It includes the static members, the members added by the group's dynamic
+// rules and, if "resolveProfile" is not nil, the identities that can act as each trusted profile member (recursively,
+// for profiles that can be assumed through other profiles).
+// Pagination is handled internally. If "ctx" carries a common.AccountContext, the access group must belong to its
+// account.
+func (iamAccessGroups *IamAccessGroupsV2) ResolveEffectiveMembers(ctx context.Context, groupID string, resolveProfile ProfileMembersResolver) (members []EffectiveMember, err error) {
+	if groupID == "" {
+		err = fmt.Errorf("groupID cannot be empty")
+		return
+	}
+	err = iamAccessGroups.checkGroupAccount(ctx, groupID)
+	if err != nil {
+		return
+	}
+
+	byIamID := map[string]*EffectiveMember{}
+	add := func(iamID string, memberType string, source string) {
+		member := byIamID[iamID]
+		if member == nil {
+			member = &EffectiveMember{IamID: iamID, Type: memberType}
+			byIamID[iamID] = member
+		}
+		for _, existing := range member.Sources {
+			if existing == source {
+				return
+			}
+		}
+		member.Sources = append(member.Sources, source)
+	}
+
+	var profiles []string
+	err = iamAccessGroups.forEachGroupMember(ctx, groupID, "all", func(member *ListGroupMembersResponseMember) {
+		if member.IamID == nil {
+			return
+		}
+		memberType := MemberTypeForIamID(*member.IamID)
+		if member.Type != nil {
+			memberType = *member.Type
+		}
+		source := EffectiveMemberSourceStaticConst
+		if member.MembershipType != nil {
+			source = *member.MembershipType
+		}
+		if memberType == MemberTypeProfileConst && byIamID[*member.IamID] == nil {
+			profiles = append(profiles, *member.IamID)
+		}
+		add(*member.IamID, memberType, source)
+	})
+	if err != nil {
+		return
+	}
+
+	if resolveProfile != nil {
+		// Profiles that can be assumed through another profile are resolved in turn; each profile is resolved once.
+		for i := 0; i < len(profiles); i++ {
+			profileIamID := profiles[i]
+			var iamIDs []string
+			iamIDs, err = resolveProfile(ctx, profileIamID)
+			if err != nil {
+				err = fmt.Errorf("error resolving members of trusted profile '%s': %s", profileIamID, err.Error())
+				return
+			}
+			for _, iamID := range iamIDs {
+				memberType := MemberTypeForIamID(iamID)
+				if memberType == MemberTypeProfileConst && byIamID[iamID] == nil {
+					profiles = append(profiles, iamID)
+				}
+				add(iamID, memberType, profileIamID)
+			}
+		}
+	}
+
+	for _, member := range byIamID {
+		members = append(members, *member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].IamID < members[j].IamID
+	})
+	return
+}
+
+// EffectiveMemberIamIDs returns the IAM IDs of the identities that are effectively members of an access group (see
+// ResolveEffectiveMembers; trusted profiles are not resolved), sorted. It can be used as the
+// iamidentityv1.AccessGroupMembersResolver of an iamidentityv1.InactivityReporter.
+func (iamAccessGroups *IamAccessGroupsV2) EffectiveMemberIamIDs(ctx context.Context, groupID string) (iamIDs []string, err error) {
+	members, err := iamAccessGroups.ResolveEffectiveMembers(ctx, groupID, nil)
+	if err != nil {
+		return
+	}
+	for _, member := range members {
+		iamIDs = append(iamIDs, member.IamID)
+	}
+	return
+}
@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamaccessgroupsv2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamAccessGroupsV2 ResolveEffectiveMembers`, func() {
+	var testServer *httptest.Server
+	var iamAccessGroupsService *iamaccessgroupsv2.IamAccessGroupsV2
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.URL.EscapedPath()).To(Equal("/v2/groups/group1/members"))
+			Expect(req.URL.Query().Get("membership_type")).To(Equal("all"))
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Query().Get("offset") == "0" {
+				fmt.Fprint(res, `{"members": [
+					{"iam_id": "IBMid-static", "type": "user", "membership_type": "static"},
+					{"iam_id": "IBMid-federated", "type": "user", "membership_type": "dynamic"}
+				], "next": {"href": "next"}}`)
+			} else {
+				fmt.Fprint(res, `{"members": [
+					{"iam_id": "iam-Profile-ci", "type": "profile", "membership_type": "static"},
+					{"iam_id": "iam-ServiceId-deployer", "type": "service", "membership_type": "static"}
+				]}`)
+			}
+		}))
+		var serviceErr error
+		iamAccessGroupsService, serviceErr = iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	sources := func(members []iamaccessgroupsv2.EffectiveMember) map[string][]string {
+		result := map[string][]string{}
+		for _, member := range members {
+			result[member.IamID] = member.Sources
+		}
+		return result
+	}
+
+	It(`Lists static and dynamic members across pages`, func() {
+		members, err := iamAccessGroupsService.ResolveEffectiveMembers(context.Background(), "group1", nil)
+		Expect(err).To(BeNil())
+		Expect(members).To(HaveLen(4))
+		Expect(members[0].IamID).To(Equal("IBMid-federated"))
+		Expect(members[2].Type).To(Equal(iamaccessgroupsv2.MemberTypeProfileConst))
+		Expect(sources(members)).To(Equal(map[string][]string{
+			"IBMid-federated":        {"dynamic"},
+			"IBMid-static":           {"static"},
+			"iam-Profile-ci":         {"static"},
+			"iam-ServiceId-deployer": {"static"},
+		}))
+
+		iamIDs, err := iamAccessGroupsService.EffectiveMemberIamIDs(context.Background(), "group1")
+		Expect(err).To(BeNil())
+		Expect(iamIDs).To(Equal([]string{"IBMid-federated", "IBMid-static", "iam-Profile-ci", "iam-ServiceId-deployer"}))
+	})
+	It(`Expands trusted profiles recursively`, func() {
+		resolved := []string{}
+		resolver := func(ctx context.Context, profileIamID string) ([]string, error) {
+			resolved = append(resolved, profileIamID)
+			switch profileIamID {
+			case "iam-Profile-ci":
+				return []string{"iam-ServiceId-deployer", "iam-Profile-nested", "IBMid-operator"}, nil
+			case "iam-Profile-nested":
+				return []string{"iam-Profile-ci", "iam-ServiceId-nightly"}, nil
+			}
+			return nil, nil
+		}
+		members, err := iamAccessGroupsService.ResolveEffectiveMembers(context.Background(), "group1", resolver)
+		Expect(err).To(BeNil())
+		Expect(resolved).To(Equal([]string{"iam-Profile-ci", "iam-Profile-nested"}))
+		Expect(sources(members)).To(Equal(map[string][]string{
+			"IBMid-federated":        {"dynamic"},
+			"IBMid-operator":         {"iam-Profile-ci"},
+			"IBMid-static":           {"static"},
+			"iam-Profile-ci":         {"static", "iam-Profile-nested"},
+			"iam-Profile-nested":     {"iam-Profile-ci"},
+			"iam-ServiceId-deployer": {"static", "iam-Profile-ci"},
+			"iam-ServiceId-nightly":  {"iam-Profile-nested"},
+		}))
+	})
+	It(`Returns resolver errors`, func() {
+		_, err := iamAccessGroupsService.ResolveEffectiveMembers(context.Background(), "group1",
+			func(ctx context.Context, profileIamID string) ([]string, error) {
+				return nil, errors.New("forbidden")
+			})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("iam-Profile-ci"))
+
+		_, err = iamAccessGroupsService.ResolveEffectiveMembers(context.Background(), "", nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,1237 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package iamaccessgroupsv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CreateAccessGroupOption : A functional option that sets a property of CreateAccessGroupOptions (see CreateAccessGroupWith).
+type CreateAccessGroupOption func(options *CreateAccessGroupOptions)
+
+// CreateAccessGroupAccountID sets the AccountID property of CreateAccessGroupOptions. Account ID of the API
+// keys(s) to query.
+func CreateAccessGroupAccountID(accountID string) CreateAccessGroupOption {
+	return func(options *CreateAccessGroupOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// CreateAccessGroupName sets the Name property of CreateAccessGroupOptions. Assign the specified name to the
+// access group.
+func CreateAccessGroupName(nameVar string) CreateAccessGroupOption {
+	return func(options *CreateAccessGroupOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateAccessGroupDescription sets the Description property of CreateAccessGroupOptions. Assign an optional
+// description for the access group.
+func CreateAccessGroupDescription(description string) CreateAccessGroupOption {
+	return func(options *CreateAccessGroupOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// CreateAccessGroupTransactionID sets the TransactionID property of CreateAccessGroupOptions. An optional
+// transaction ID can be passed to your request, which can be useful for tracking calls through multiple services
+// by using one identifier.
+func CreateAccessGroupTransactionID(transactionID string) CreateAccessGroupOption {
+	return func(options *CreateAccessGroupOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// CreateAccessGroupHeader sets the Headers property of CreateAccessGroupOptions. Allows users to set headers on
+// API requests.
+func CreateAccessGroupHeader(name string, value string) CreateAccessGroupOption {
+	return func(options *CreateAccessGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateAccessGroupOptionsWith returns CreateAccessGroupOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewCreateAccessGroupOptionsWith(options ...CreateAccessGroupOption) *CreateAccessGroupOptions {
+	createAccessGroupOptions := &CreateAccessGroupOptions{}
+	for _, option := range options {
+		option(createAccessGroupOptions)
+	}
+	return createAccessGroupOptions
+}
+
+// CreateAccessGroupWith is an alternate form of the CreateAccessGroupWithContext method which takes functional
+// options instead of a CreateAccessGroupOptions struct. CreateAccessGroupAccountID and CreateAccessGroupName are
+// required.
+func (iamAccessGroups *IamAccessGroupsV2) CreateAccessGroupWith(ctx context.Context, options ...CreateAccessGroupOption) (result *Group, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.CreateAccessGroupWithContext(ctx, iamAccessGroups.NewCreateAccessGroupOptionsWith(options...))
+}
+
+// ListAccessGroupsOption : A functional option that sets a property of ListAccessGroupsOptions (see ListAccessGroupsWith).
+type ListAccessGroupsOption func(options *ListAccessGroupsOptions)
+
+// ListAccessGroupsAccountID sets the AccountID property of ListAccessGroupsOptions. Account ID of the API keys(s)
+// to query.
+func ListAccessGroupsAccountID(accountID string) ListAccessGroupsOption {
+	return func(options *ListAccessGroupsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListAccessGroupsTransactionID sets the TransactionID property of ListAccessGroupsOptions. An optional
+// transaction ID can be passed to your request, which can be useful for tracking calls through multiple services
+// by using one identifier.
+func ListAccessGroupsTransactionID(transactionID string) ListAccessGroupsOption {
+	return func(options *ListAccessGroupsOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ListAccessGroupsIamID sets the IamID property of ListAccessGroupsOptions. Return groups for member ID (IBMid,
+// service ID or trusted profile ID).
+func ListAccessGroupsIamID(iamID string) ListAccessGroupsOption {
+	return func(options *ListAccessGroupsOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// ListAccessGroupsMembershipType sets the MembershipType property of ListAccessGroupsOptions. Membership type need
+// to be specified along with iam_id and must be either `static`, `dynamic` or `all`.
+func ListAccessGroupsMembershipType(membershipType string) ListAccessGroupsOption {
+	return func(options *ListAccessGroupsOptions) {
+		options.MembershipType = core.StringPtr(membershipType)
+	}
+}
+
+// ListAccessGroupsLimit sets the Limit property of ListAccessGroupsOptions. Return up to this limit of results
+// where limit is between 0 and 100.
+func ListAccessGroupsLimit(limit int64) ListAccessGroupsOption {
+	return func(options *ListAccessGroupsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListAccessGroupsOffset sets the Offset property of ListAccessGroupsOptions. The offset of the first result item
+// to be returned.
+func ListAccessGroupsOffset(offset int64) ListAccessGroupsOption {
+	return func(options *ListAccessGroupsOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// ListAccessGroupsSort sets the Sort property of ListAccessGroupsOptions. Sort the results by id, name,
+// description, or is_federated flag.
+func ListAccessGroupsSort(sort string) ListAccessGroupsOption {
+	return func(options *ListAccessGroupsOptions) {
+		options.Sort = core.StringPtr(sort)
+	}
+}
+
+// ListAccessGroupsShowFederated sets the ShowFederated property of ListAccessGroupsOptions. If show_federated is
+// true, each group listed will return an is_federated value that is set to true if rules exist for the group.
+func ListAccessGroupsShowFederated(showFederated bool) ListAccessGroupsOption {
+	return func(options *ListAccessGroupsOptions) {
+		options.ShowFederated = core.BoolPtr(showFederated)
+	}
+}
+
+// ListAccessGroupsHidePublicAccess sets the HidePublicAccess property of ListAccessGroupsOptions. If
+// hide_public_access is true, do not include the Public Access Group in the results.
+func ListAccessGroupsHidePublicAccess(hidePublicAccess bool) ListAccessGroupsOption {
+	return func(options *ListAccessGroupsOptions) {
+		options.HidePublicAccess = core.BoolPtr(hidePublicAccess)
+	}
+}
+
+// ListAccessGroupsHeader sets the Headers property of ListAccessGroupsOptions. Allows users to set headers on API
+// requests.
+func ListAccessGroupsHeader(name string, value string) ListAccessGroupsOption {
+	return func(options *ListAccessGroupsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListAccessGroupsOptionsWith returns ListAccessGroupsOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewListAccessGroupsOptionsWith(options ...ListAccessGroupsOption) *ListAccessGroupsOptions {
+	listAccessGroupsOptions := &ListAccessGroupsOptions{}
+	for _, option := range options {
+		option(listAccessGroupsOptions)
+	}
+	return listAccessGroupsOptions
+}
+
+// ListAccessGroupsWith is an alternate form of the ListAccessGroupsWithContext method which takes functional
+// options instead of a ListAccessGroupsOptions struct. ListAccessGroupsAccountID is required.
+func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupsWith(ctx context.Context, options ...ListAccessGroupsOption) (result *GroupsList, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.ListAccessGroupsWithContext(ctx, iamAccessGroups.NewListAccessGroupsOptionsWith(options...))
+}
+
+// GetAccessGroupOption : A functional option that sets a property of GetAccessGroupOptions (see GetAccessGroupWith).
+type GetAccessGroupOption func(options *GetAccessGroupOptions)
+
+// GetAccessGroupAccessGroupID sets the AccessGroupID property of GetAccessGroupOptions. The access group
+// identifier.
+func GetAccessGroupAccessGroupID(accessGroupID string) GetAccessGroupOption {
+	return func(options *GetAccessGroupOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// GetAccessGroupTransactionID sets the TransactionID property of GetAccessGroupOptions. An optional transaction ID
+// can be passed to your request, which can be useful for tracking calls through multiple services by using one
+// identifier.
+func GetAccessGroupTransactionID(transactionID string) GetAccessGroupOption {
+	return func(options *GetAccessGroupOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// GetAccessGroupShowFederated sets the ShowFederated property of GetAccessGroupOptions. If show_federated is true,
+// the group will return an is_federated value that is set to true if rules exist for the group.
+func GetAccessGroupShowFederated(showFederated bool) GetAccessGroupOption {
+	return func(options *GetAccessGroupOptions) {
+		options.ShowFederated = core.BoolPtr(showFederated)
+	}
+}
+
+// GetAccessGroupHeader sets the Headers property of GetAccessGroupOptions. Allows users to set headers on API
+// requests.
+func GetAccessGroupHeader(name string, value string) GetAccessGroupOption {
+	return func(options *GetAccessGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAccessGroupOptionsWith returns GetAccessGroupOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewGetAccessGroupOptionsWith(options ...GetAccessGroupOption) *GetAccessGroupOptions {
+	getAccessGroupOptions := &GetAccessGroupOptions{}
+	for _, option := range options {
+		option(getAccessGroupOptions)
+	}
+	return getAccessGroupOptions
+}
+
+// GetAccessGroupWith is an alternate form of the GetAccessGroupWithContext method which takes functional options
+// instead of a GetAccessGroupOptions struct. GetAccessGroupAccessGroupID is required.
+func (iamAccessGroups *IamAccessGroupsV2) GetAccessGroupWith(ctx context.Context, options ...GetAccessGroupOption) (result *Group, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.GetAccessGroupWithContext(ctx, iamAccessGroups.NewGetAccessGroupOptionsWith(options...))
+}
+
+// UpdateAccessGroupOption : A functional option that sets a property of UpdateAccessGroupOptions (see UpdateAccessGroupWith).
+type UpdateAccessGroupOption func(options *UpdateAccessGroupOptions)
+
+// UpdateAccessGroupAccessGroupID sets the AccessGroupID property of UpdateAccessGroupOptions. The access group
+// identifier.
+func UpdateAccessGroupAccessGroupID(accessGroupID string) UpdateAccessGroupOption {
+	return func(options *UpdateAccessGroupOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// UpdateAccessGroupIfMatch sets the IfMatch property of UpdateAccessGroupOptions. The current revision number of
+// the group being updated.
+func UpdateAccessGroupIfMatch(ifMatch string) UpdateAccessGroupOption {
+	return func(options *UpdateAccessGroupOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// UpdateAccessGroupName sets the Name property of UpdateAccessGroupOptions. Assign the specified name to the
+// access group.
+func UpdateAccessGroupName(nameVar string) UpdateAccessGroupOption {
+	return func(options *UpdateAccessGroupOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateAccessGroupDescription sets the Description property of UpdateAccessGroupOptions. Assign an optional
+// description for the access group.
+func UpdateAccessGroupDescription(description string) UpdateAccessGroupOption {
+	return func(options *UpdateAccessGroupOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// UpdateAccessGroupTransactionID sets the TransactionID property of UpdateAccessGroupOptions. An optional
+// transaction ID can be passed to your request, which can be useful for tracking calls through multiple services
+// by using one identifier.
+func UpdateAccessGroupTransactionID(transactionID string) UpdateAccessGroupOption {
+	return func(options *UpdateAccessGroupOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// UpdateAccessGroupHeader sets the Headers property of UpdateAccessGroupOptions. Allows users to set headers on
+// API requests.
+func UpdateAccessGroupHeader(name string, value string) UpdateAccessGroupOption {
+	return func(options *UpdateAccessGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateAccessGroupOptionsWith returns UpdateAccessGroupOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewUpdateAccessGroupOptionsWith(options ...UpdateAccessGroupOption) *UpdateAccessGroupOptions {
+	updateAccessGroupOptions := &UpdateAccessGroupOptions{}
+	for _, option := range options {
+		option(updateAccessGroupOptions)
+	}
+	return updateAccessGroupOptions
+}
+
+// UpdateAccessGroupWith is an alternate form of the UpdateAccessGroupWithContext method which takes functional
+// options instead of an UpdateAccessGroupOptions struct. UpdateAccessGroupAccessGroupID and
+// UpdateAccessGroupIfMatch are required.
+func (iamAccessGroups *IamAccessGroupsV2) UpdateAccessGroupWith(ctx context.Context, options ...UpdateAccessGroupOption) (result *Group, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.UpdateAccessGroupWithContext(ctx, iamAccessGroups.NewUpdateAccessGroupOptionsWith(options...))
+}
+
+// DeleteAccessGroupOption : A functional option that sets a property of DeleteAccessGroupOptions (see DeleteAccessGroupWith).
+type DeleteAccessGroupOption func(options *DeleteAccessGroupOptions)
+
+// DeleteAccessGroupAccessGroupID sets the AccessGroupID property of DeleteAccessGroupOptions. The access group
+// identifier.
+func DeleteAccessGroupAccessGroupID(accessGroupID string) DeleteAccessGroupOption {
+	return func(options *DeleteAccessGroupOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// DeleteAccessGroupTransactionID sets the TransactionID property of DeleteAccessGroupOptions. An optional
+// transaction ID can be passed to your request, which can be useful for tracking calls through multiple services
+// by using one identifier.
+func DeleteAccessGroupTransactionID(transactionID string) DeleteAccessGroupOption {
+	return func(options *DeleteAccessGroupOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// DeleteAccessGroupForce sets the Force property of DeleteAccessGroupOptions. If force is true, delete the group
+// as well as its associated members and rules.
+func DeleteAccessGroupForce(force bool) DeleteAccessGroupOption {
+	return func(options *DeleteAccessGroupOptions) {
+		options.Force = core.BoolPtr(force)
+	}
+}
+
+// DeleteAccessGroupHeader sets the Headers property of DeleteAccessGroupOptions. Allows users to set headers on
+// API requests.
+func DeleteAccessGroupHeader(name string, value string) DeleteAccessGroupOption {
+	return func(options *DeleteAccessGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteAccessGroupOptionsWith returns DeleteAccessGroupOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewDeleteAccessGroupOptionsWith(options ...DeleteAccessGroupOption) *DeleteAccessGroupOptions {
+	deleteAccessGroupOptions := &DeleteAccessGroupOptions{}
+	for _, option := range options {
+		option(deleteAccessGroupOptions)
+	}
+	return deleteAccessGroupOptions
+}
+
+// DeleteAccessGroupWith is an alternate form of the DeleteAccessGroupWithContext method which takes functional
+// options instead of a DeleteAccessGroupOptions struct. DeleteAccessGroupAccessGroupID is required.
+func (iamAccessGroups *IamAccessGroupsV2) DeleteAccessGroupWith(ctx context.Context, options ...DeleteAccessGroupOption) (response *core.DetailedResponse, err error) {
+	return iamAccessGroups.DeleteAccessGroupWithContext(ctx, iamAccessGroups.NewDeleteAccessGroupOptionsWith(options...))
+}
+
+// IsMemberOfAccessGroupOption : A functional option that sets a property of IsMemberOfAccessGroupOptions (see IsMemberOfAccessGroupWith).
+type IsMemberOfAccessGroupOption func(options *IsMemberOfAccessGroupOptions)
+
+// IsMemberOfAccessGroupAccessGroupID sets the AccessGroupID property of IsMemberOfAccessGroupOptions. The access
+// group identifier.
+func IsMemberOfAccessGroupAccessGroupID(accessGroupID string) IsMemberOfAccessGroupOption {
+	return func(options *IsMemberOfAccessGroupOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// IsMemberOfAccessGroupIamID sets the IamID property of IsMemberOfAccessGroupOptions. The IAM identifier.
+func IsMemberOfAccessGroupIamID(iamID string) IsMemberOfAccessGroupOption {
+	return func(options *IsMemberOfAccessGroupOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// IsMemberOfAccessGroupTransactionID sets the TransactionID property of IsMemberOfAccessGroupOptions. An optional
+// transaction ID can be passed to your request, which can be useful for tracking calls through multiple services
+// by using one identifier.
+func IsMemberOfAccessGroupTransactionID(transactionID string) IsMemberOfAccessGroupOption {
+	return func(options *IsMemberOfAccessGroupOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// IsMemberOfAccessGroupHeader sets the Headers property of IsMemberOfAccessGroupOptions. Allows users to set
+// headers on API requests.
+func IsMemberOfAccessGroupHeader(name string, value string) IsMemberOfAccessGroupOption {
+	return func(options *IsMemberOfAccessGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewIsMemberOfAccessGroupOptionsWith returns IsMemberOfAccessGroupOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewIsMemberOfAccessGroupOptionsWith(options ...IsMemberOfAccessGroupOption) *IsMemberOfAccessGroupOptions {
+	isMemberOfAccessGroupOptions := &IsMemberOfAccessGroupOptions{}
+	for _, option := range options {
+		option(isMemberOfAccessGroupOptions)
+	}
+	return isMemberOfAccessGroupOptions
+}
+
+// IsMemberOfAccessGroupWith is an alternate form of the IsMemberOfAccessGroupWithContext method which takes
+// functional options instead of an IsMemberOfAccessGroupOptions struct. IsMemberOfAccessGroupAccessGroupID and
+// IsMemberOfAccessGroupIamID are required.
+func (iamAccessGroups *IamAccessGroupsV2) IsMemberOfAccessGroupWith(ctx context.Context, options ...IsMemberOfAccessGroupOption) (response *core.DetailedResponse, err error) {
+	return iamAccessGroups.IsMemberOfAccessGroupWithContext(ctx, iamAccessGroups.NewIsMemberOfAccessGroupOptionsWith(options...))
+}
+
+// AddMembersToAccessGroupOption : A functional option that sets a property of AddMembersToAccessGroupOptions (see AddMembersToAccessGroupWith).
+type AddMembersToAccessGroupOption func(options *AddMembersToAccessGroupOptions)
+
+// AddMembersToAccessGroupAccessGroupID sets the AccessGroupID property of AddMembersToAccessGroupOptions. The
+// access group identifier.
+func AddMembersToAccessGroupAccessGroupID(accessGroupID string) AddMembersToAccessGroupOption {
+	return func(options *AddMembersToAccessGroupOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// AddMembersToAccessGroupMembers sets the Members property of AddMembersToAccessGroupOptions. An array of member
+// objects to add to an access group.
+func AddMembersToAccessGroupMembers(members ...AddGroupMembersRequestMembersItem) AddMembersToAccessGroupOption {
+	return func(options *AddMembersToAccessGroupOptions) {
+		options.Members = append(options.Members, members...)
+	}
+}
+
+// AddMembersToAccessGroupTransactionID sets the TransactionID property of AddMembersToAccessGroupOptions. An
+// optional transaction ID can be passed to your request, which can be useful for tracking calls through multiple
+// services by using one identifier.
+func AddMembersToAccessGroupTransactionID(transactionID string) AddMembersToAccessGroupOption {
+	return func(options *AddMembersToAccessGroupOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// AddMembersToAccessGroupHeader sets the Headers property of AddMembersToAccessGroupOptions. Allows users to set
+// headers on API requests.
+func AddMembersToAccessGroupHeader(name string, value string) AddMembersToAccessGroupOption {
+	return func(options *AddMembersToAccessGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewAddMembersToAccessGroupOptionsWith returns AddMembersToAccessGroupOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewAddMembersToAccessGroupOptionsWith(options ...AddMembersToAccessGroupOption) *AddMembersToAccessGroupOptions {
+	addMembersToAccessGroupOptions := &AddMembersToAccessGroupOptions{}
+	for _, option := range options {
+		option(addMembersToAccessGroupOptions)
+	}
+	return addMembersToAccessGroupOptions
+}
+
+// AddMembersToAccessGroupWith is an alternate form of the AddMembersToAccessGroupWithContext method which takes
+// functional options instead of an AddMembersToAccessGroupOptions struct. AddMembersToAccessGroupAccessGroupID is
+// required.
+func (iamAccessGroups *IamAccessGroupsV2) AddMembersToAccessGroupWith(ctx context.Context, options ...AddMembersToAccessGroupOption) (result *AddGroupMembersResponse, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.AddMembersToAccessGroupWithContext(ctx, iamAccessGroups.NewAddMembersToAccessGroupOptionsWith(options...))
+}
+
+// ListAccessGroupMembersOption : A functional option that sets a property of ListAccessGroupMembersOptions (see ListAccessGroupMembersWith).
+type ListAccessGroupMembersOption func(options *ListAccessGroupMembersOptions)
+
+// ListAccessGroupMembersAccessGroupID sets the AccessGroupID property of ListAccessGroupMembersOptions. The access
+// group identifier.
+func ListAccessGroupMembersAccessGroupID(accessGroupID string) ListAccessGroupMembersOption {
+	return func(options *ListAccessGroupMembersOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// ListAccessGroupMembersTransactionID sets the TransactionID property of ListAccessGroupMembersOptions. An
+// optional transaction ID can be passed to your request, which can be useful for tracking calls through multiple
+// services by using one identifier.
+func ListAccessGroupMembersTransactionID(transactionID string) ListAccessGroupMembersOption {
+	return func(options *ListAccessGroupMembersOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ListAccessGroupMembersMembershipType sets the MembershipType property of ListAccessGroupMembersOptions. Filters
+// members by membership type.
+func ListAccessGroupMembersMembershipType(membershipType string) ListAccessGroupMembersOption {
+	return func(options *ListAccessGroupMembersOptions) {
+		options.MembershipType = core.StringPtr(membershipType)
+	}
+}
+
+// ListAccessGroupMembersLimit sets the Limit property of ListAccessGroupMembersOptions. Return up to this limit of
+// results where limit is between 0 and 100.
+func ListAccessGroupMembersLimit(limit int64) ListAccessGroupMembersOption {
+	return func(options *ListAccessGroupMembersOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListAccessGroupMembersOffset sets the Offset property of ListAccessGroupMembersOptions. The offset of the first
+// result item to be returned.
+func ListAccessGroupMembersOffset(offset int64) ListAccessGroupMembersOption {
+	return func(options *ListAccessGroupMembersOptions) {
+		options.Offset = core.Int64Ptr(offset)
+	}
+}
+
+// ListAccessGroupMembersType sets the Type property of ListAccessGroupMembersOptions. Filter the results by member
+// type.
+func ListAccessGroupMembersType(typeVar string) ListAccessGroupMembersOption {
+	return func(options *ListAccessGroupMembersOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// ListAccessGroupMembersVerbose sets the Verbose property of ListAccessGroupMembersOptions. Return user's email
+// and name for each user ID or the name for each service ID or trusted profile.
+func ListAccessGroupMembersVerbose(verbose bool) ListAccessGroupMembersOption {
+	return func(options *ListAccessGroupMembersOptions) {
+		options.Verbose = core.BoolPtr(verbose)
+	}
+}
+
+// ListAccessGroupMembersSort sets the Sort property of ListAccessGroupMembersOptions. If verbose is true, sort the
+// results by id, name, or email.
+func ListAccessGroupMembersSort(sort string) ListAccessGroupMembersOption {
+	return func(options *ListAccessGroupMembersOptions) {
+		options.Sort = core.StringPtr(sort)
+	}
+}
+
+// ListAccessGroupMembersHeader sets the Headers property of ListAccessGroupMembersOptions. Allows users to set
+// headers on API requests.
+func ListAccessGroupMembersHeader(name string, value string) ListAccessGroupMembersOption {
+	return func(options *ListAccessGroupMembersOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListAccessGroupMembersOptionsWith returns ListAccessGroupMembersOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewListAccessGroupMembersOptionsWith(options ...ListAccessGroupMembersOption) *ListAccessGroupMembersOptions {
+	listAccessGroupMembersOptions := &ListAccessGroupMembersOptions{}
+	for _, option := range options {
+		option(listAccessGroupMembersOptions)
+	}
+	return listAccessGroupMembersOptions
+}
+
+// ListAccessGroupMembersWith is an alternate form of the ListAccessGroupMembersWithContext method which takes
+// functional options instead of a ListAccessGroupMembersOptions struct. ListAccessGroupMembersAccessGroupID is
+// required.
+func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupMembersWith(ctx context.Context, options ...ListAccessGroupMembersOption) (result *GroupMembersList, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.ListAccessGroupMembersWithContext(ctx, iamAccessGroups.NewListAccessGroupMembersOptionsWith(options...))
+}
+
+// RemoveMemberFromAccessGroupOption : A functional option that sets a property of RemoveMemberFromAccessGroupOptions (see RemoveMemberFromAccessGroupWith).
+type RemoveMemberFromAccessGroupOption func(options *RemoveMemberFromAccessGroupOptions)
+
+// RemoveMemberFromAccessGroupAccessGroupID sets the AccessGroupID property of RemoveMemberFromAccessGroupOptions.
+// The access group identifier.
+func RemoveMemberFromAccessGroupAccessGroupID(accessGroupID string) RemoveMemberFromAccessGroupOption {
+	return func(options *RemoveMemberFromAccessGroupOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// RemoveMemberFromAccessGroupIamID sets the IamID property of RemoveMemberFromAccessGroupOptions. The IAM
+// identifier.
+func RemoveMemberFromAccessGroupIamID(iamID string) RemoveMemberFromAccessGroupOption {
+	return func(options *RemoveMemberFromAccessGroupOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// RemoveMemberFromAccessGroupTransactionID sets the TransactionID property of RemoveMemberFromAccessGroupOptions.
+// An optional transaction ID can be passed to your request, which can be useful for tracking calls through
+// multiple services by using one identifier.
+func RemoveMemberFromAccessGroupTransactionID(transactionID string) RemoveMemberFromAccessGroupOption {
+	return func(options *RemoveMemberFromAccessGroupOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// RemoveMemberFromAccessGroupHeader sets the Headers property of RemoveMemberFromAccessGroupOptions. Allows users
+// to set headers on API requests.
+func RemoveMemberFromAccessGroupHeader(name string, value string) RemoveMemberFromAccessGroupOption {
+	return func(options *RemoveMemberFromAccessGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewRemoveMemberFromAccessGroupOptionsWith returns RemoveMemberFromAccessGroupOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewRemoveMemberFromAccessGroupOptionsWith(options ...RemoveMemberFromAccessGroupOption) *RemoveMemberFromAccessGroupOptions {
+	removeMemberFromAccessGroupOptions := &RemoveMemberFromAccessGroupOptions{}
+	for _, option := range options {
+		option(removeMemberFromAccessGroupOptions)
+	}
+	return removeMemberFromAccessGroupOptions
+}
+
+// RemoveMemberFromAccessGroupWith is an alternate form of the RemoveMemberFromAccessGroupWithContext method which
+// takes functional options instead of a RemoveMemberFromAccessGroupOptions struct.
+// RemoveMemberFromAccessGroupAccessGroupID and RemoveMemberFromAccessGroupIamID are required.
+func (iamAccessGroups *IamAccessGroupsV2) RemoveMemberFromAccessGroupWith(ctx context.Context, options ...RemoveMemberFromAccessGroupOption) (response *core.DetailedResponse, err error) {
+	return iamAccessGroups.RemoveMemberFromAccessGroupWithContext(ctx, iamAccessGroups.NewRemoveMemberFromAccessGroupOptionsWith(options...))
+}
+
+// RemoveMembersFromAccessGroupOption : A functional option that sets a property of RemoveMembersFromAccessGroupOptions (see RemoveMembersFromAccessGroupWith).
+type RemoveMembersFromAccessGroupOption func(options *RemoveMembersFromAccessGroupOptions)
+
+// RemoveMembersFromAccessGroupAccessGroupID sets the AccessGroupID property of
+// RemoveMembersFromAccessGroupOptions. The access group identifier.
+func RemoveMembersFromAccessGroupAccessGroupID(accessGroupID string) RemoveMembersFromAccessGroupOption {
+	return func(options *RemoveMembersFromAccessGroupOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// RemoveMembersFromAccessGroupMembers sets the Members property of RemoveMembersFromAccessGroupOptions. The
+// `iam_id`s to remove from the access group.
+func RemoveMembersFromAccessGroupMembers(members ...string) RemoveMembersFromAccessGroupOption {
+	return func(options *RemoveMembersFromAccessGroupOptions) {
+		options.Members = append(options.Members, members...)
+	}
+}
+
+// RemoveMembersFromAccessGroupTransactionID sets the TransactionID property of
+// RemoveMembersFromAccessGroupOptions. An optional transaction ID can be passed to your request, which can be
+// useful for tracking calls through multiple services by using one identifier.
+func RemoveMembersFromAccessGroupTransactionID(transactionID string) RemoveMembersFromAccessGroupOption {
+	return func(options *RemoveMembersFromAccessGroupOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// RemoveMembersFromAccessGroupHeader sets the Headers property of RemoveMembersFromAccessGroupOptions. Allows
+// users to set headers on API requests.
+func RemoveMembersFromAccessGroupHeader(name string, value string) RemoveMembersFromAccessGroupOption {
+	return func(options *RemoveMembersFromAccessGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewRemoveMembersFromAccessGroupOptionsWith returns RemoveMembersFromAccessGroupOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewRemoveMembersFromAccessGroupOptionsWith(options ...RemoveMembersFromAccessGroupOption) *RemoveMembersFromAccessGroupOptions {
+	removeMembersFromAccessGroupOptions := &RemoveMembersFromAccessGroupOptions{}
+	for _, option := range options {
+		option(removeMembersFromAccessGroupOptions)
+	}
+	return removeMembersFromAccessGroupOptions
+}
+
+// RemoveMembersFromAccessGroupWith is an alternate form of the RemoveMembersFromAccessGroupWithContext method
+// which takes functional options instead of a RemoveMembersFromAccessGroupOptions struct.
+// RemoveMembersFromAccessGroupAccessGroupID is required.
+func (iamAccessGroups *IamAccessGroupsV2) RemoveMembersFromAccessGroupWith(ctx context.Context, options ...RemoveMembersFromAccessGroupOption) (result *DeleteGroupBulkMembersResponse, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.RemoveMembersFromAccessGroupWithContext(ctx, iamAccessGroups.NewRemoveMembersFromAccessGroupOptionsWith(options...))
+}
+
+// RemoveMemberFromAllAccessGroupsOption : A functional option that sets a property of RemoveMemberFromAllAccessGroupsOptions (see RemoveMemberFromAllAccessGroupsWith).
+type RemoveMemberFromAllAccessGroupsOption func(options *RemoveMemberFromAllAccessGroupsOptions)
+
+// RemoveMemberFromAllAccessGroupsAccountID sets the AccountID property of RemoveMemberFromAllAccessGroupsOptions.
+// Account ID of the API keys(s) to query.
+func RemoveMemberFromAllAccessGroupsAccountID(accountID string) RemoveMemberFromAllAccessGroupsOption {
+	return func(options *RemoveMemberFromAllAccessGroupsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// RemoveMemberFromAllAccessGroupsIamID sets the IamID property of RemoveMemberFromAllAccessGroupsOptions. The IAM
+// identifier.
+func RemoveMemberFromAllAccessGroupsIamID(iamID string) RemoveMemberFromAllAccessGroupsOption {
+	return func(options *RemoveMemberFromAllAccessGroupsOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// RemoveMemberFromAllAccessGroupsTransactionID sets the TransactionID property of
+// RemoveMemberFromAllAccessGroupsOptions. An optional transaction ID can be passed to your request, which can be
+// useful for tracking calls through multiple services by using one identifier.
+func RemoveMemberFromAllAccessGroupsTransactionID(transactionID string) RemoveMemberFromAllAccessGroupsOption {
+	return func(options *RemoveMemberFromAllAccessGroupsOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// RemoveMemberFromAllAccessGroupsHeader sets the Headers property of RemoveMemberFromAllAccessGroupsOptions.
+// Allows users to set headers on API requests.
+func RemoveMemberFromAllAccessGroupsHeader(name string, value string) RemoveMemberFromAllAccessGroupsOption {
+	return func(options *RemoveMemberFromAllAccessGroupsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewRemoveMemberFromAllAccessGroupsOptionsWith returns RemoveMemberFromAllAccessGroupsOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewRemoveMemberFromAllAccessGroupsOptionsWith(options ...RemoveMemberFromAllAccessGroupsOption) *RemoveMemberFromAllAccessGroupsOptions {
+	removeMemberFromAllAccessGroupsOptions := &RemoveMemberFromAllAccessGroupsOptions{}
+	for _, option := range options {
+		option(removeMemberFromAllAccessGroupsOptions)
+	}
+	return removeMemberFromAllAccessGroupsOptions
+}
+
+// RemoveMemberFromAllAccessGroupsWith is an alternate form of the RemoveMemberFromAllAccessGroupsWithContext
+// method which takes functional options instead of a RemoveMemberFromAllAccessGroupsOptions struct.
+// RemoveMemberFromAllAccessGroupsAccountID and RemoveMemberFromAllAccessGroupsIamID are required.
+func (iamAccessGroups *IamAccessGroupsV2) RemoveMemberFromAllAccessGroupsWith(ctx context.Context, options ...RemoveMemberFromAllAccessGroupsOption) (result *DeleteFromAllGroupsResponse, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.RemoveMemberFromAllAccessGroupsWithContext(ctx, iamAccessGroups.NewRemoveMemberFromAllAccessGroupsOptionsWith(options...))
+}
+
+// AddMemberToMultipleAccessGroupsOption : A functional option that sets a property of AddMemberToMultipleAccessGroupsOptions (see AddMemberToMultipleAccessGroupsWith).
+type AddMemberToMultipleAccessGroupsOption func(options *AddMemberToMultipleAccessGroupsOptions)
+
+// AddMemberToMultipleAccessGroupsAccountID sets the AccountID property of AddMemberToMultipleAccessGroupsOptions.
+// Account ID of the API keys(s) to query.
+func AddMemberToMultipleAccessGroupsAccountID(accountID string) AddMemberToMultipleAccessGroupsOption {
+	return func(options *AddMemberToMultipleAccessGroupsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// AddMemberToMultipleAccessGroupsIamID sets the IamID property of AddMemberToMultipleAccessGroupsOptions. The IAM
+// identifier.
+func AddMemberToMultipleAccessGroupsIamID(iamID string) AddMemberToMultipleAccessGroupsOption {
+	return func(options *AddMemberToMultipleAccessGroupsOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// AddMemberToMultipleAccessGroupsType sets the Type property of AddMemberToMultipleAccessGroupsOptions. The type
+// of the member, must be either "user", "service" or "trusted profile".
+func AddMemberToMultipleAccessGroupsType(typeVar string) AddMemberToMultipleAccessGroupsOption {
+	return func(options *AddMemberToMultipleAccessGroupsOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// AddMemberToMultipleAccessGroupsGroups sets the Groups property of AddMemberToMultipleAccessGroupsOptions. The
+// ids of the access groups a given member is to be added to.
+func AddMemberToMultipleAccessGroupsGroups(groups ...string) AddMemberToMultipleAccessGroupsOption {
+	return func(options *AddMemberToMultipleAccessGroupsOptions) {
+		options.Groups = append(options.Groups, groups...)
+	}
+}
+
+// AddMemberToMultipleAccessGroupsTransactionID sets the TransactionID property of
+// AddMemberToMultipleAccessGroupsOptions. An optional transaction ID can be passed to your request, which can be
+// useful for tracking calls through multiple services by using one identifier.
+func AddMemberToMultipleAccessGroupsTransactionID(transactionID string) AddMemberToMultipleAccessGroupsOption {
+	return func(options *AddMemberToMultipleAccessGroupsOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// AddMemberToMultipleAccessGroupsHeader sets the Headers property of AddMemberToMultipleAccessGroupsOptions.
+// Allows users to set headers on API requests.
+func AddMemberToMultipleAccessGroupsHeader(name string, value string) AddMemberToMultipleAccessGroupsOption {
+	return func(options *AddMemberToMultipleAccessGroupsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewAddMemberToMultipleAccessGroupsOptionsWith returns AddMemberToMultipleAccessGroupsOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewAddMemberToMultipleAccessGroupsOptionsWith(options ...AddMemberToMultipleAccessGroupsOption) *AddMemberToMultipleAccessGroupsOptions {
+	addMemberToMultipleAccessGroupsOptions := &AddMemberToMultipleAccessGroupsOptions{}
+	for _, option := range options {
+		option(addMemberToMultipleAccessGroupsOptions)
+	}
+	return addMemberToMultipleAccessGroupsOptions
+}
+
+// AddMemberToMultipleAccessGroupsWith is an alternate form of the AddMemberToMultipleAccessGroupsWithContext
+// method which takes functional options instead of an AddMemberToMultipleAccessGroupsOptions struct.
+// AddMemberToMultipleAccessGroupsAccountID and AddMemberToMultipleAccessGroupsIamID are required.
+func (iamAccessGroups *IamAccessGroupsV2) AddMemberToMultipleAccessGroupsWith(ctx context.Context, options ...AddMemberToMultipleAccessGroupsOption) (result *AddMembershipMultipleGroupsResponse, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.AddMemberToMultipleAccessGroupsWithContext(ctx, iamAccessGroups.NewAddMemberToMultipleAccessGroupsOptionsWith(options...))
+}
+
+// AddAccessGroupRuleOption : A functional option that sets a property of AddAccessGroupRuleOptions (see AddAccessGroupRuleWith).
+type AddAccessGroupRuleOption func(options *AddAccessGroupRuleOptions)
+
+// AddAccessGroupRuleAccessGroupID sets the AccessGroupID property of AddAccessGroupRuleOptions. The access group
+// identifier.
+func AddAccessGroupRuleAccessGroupID(accessGroupID string) AddAccessGroupRuleOption {
+	return func(options *AddAccessGroupRuleOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// AddAccessGroupRuleExpiration sets the Expiration property of AddAccessGroupRuleOptions. The number of hours that
+// the rule lives for.
+func AddAccessGroupRuleExpiration(expiration int64) AddAccessGroupRuleOption {
+	return func(options *AddAccessGroupRuleOptions) {
+		options.Expiration = core.Int64Ptr(expiration)
+	}
+}
+
+// AddAccessGroupRuleRealmName sets the RealmName property of AddAccessGroupRuleOptions. The url of the identity
+// provider.
+func AddAccessGroupRuleRealmName(realmName string) AddAccessGroupRuleOption {
+	return func(options *AddAccessGroupRuleOptions) {
+		options.RealmName = core.StringPtr(realmName)
+	}
+}
+
+// AddAccessGroupRuleConditions sets the Conditions property of AddAccessGroupRuleOptions. A list of conditions the
+// rule must satisfy.
+func AddAccessGroupRuleConditions(conditions ...RuleConditions) AddAccessGroupRuleOption {
+	return func(options *AddAccessGroupRuleOptions) {
+		options.Conditions = append(options.Conditions, conditions...)
+	}
+}
+
+// AddAccessGroupRuleName sets the Name property of AddAccessGroupRuleOptions. The name of the rule.
+func AddAccessGroupRuleName(nameVar string) AddAccessGroupRuleOption {
+	return func(options *AddAccessGroupRuleOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// AddAccessGroupRuleTransactionID sets the TransactionID property of AddAccessGroupRuleOptions. An optional
+// transaction ID can be passed to your request, which can be useful for tracking calls through multiple services
+// by using one identifier.
+func AddAccessGroupRuleTransactionID(transactionID string) AddAccessGroupRuleOption {
+	return func(options *AddAccessGroupRuleOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// AddAccessGroupRuleHeader sets the Headers property of AddAccessGroupRuleOptions. Allows users to set headers on
+// API requests.
+func AddAccessGroupRuleHeader(name string, value string) AddAccessGroupRuleOption {
+	return func(options *AddAccessGroupRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewAddAccessGroupRuleOptionsWith returns AddAccessGroupRuleOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewAddAccessGroupRuleOptionsWith(options ...AddAccessGroupRuleOption) *AddAccessGroupRuleOptions {
+	addAccessGroupRuleOptions := &AddAccessGroupRuleOptions{}
+	for _, option := range options {
+		option(addAccessGroupRuleOptions)
+	}
+	return addAccessGroupRuleOptions
+}
+
+// AddAccessGroupRuleWith is an alternate form of the AddAccessGroupRuleWithContext method which takes functional
+// options instead of an AddAccessGroupRuleOptions struct. AddAccessGroupRuleAccessGroupID,
+// AddAccessGroupRuleExpiration, AddAccessGroupRuleRealmName and AddAccessGroupRuleConditions are required.
+func (iamAccessGroups *IamAccessGroupsV2) AddAccessGroupRuleWith(ctx context.Context, options ...AddAccessGroupRuleOption) (result *Rule, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.AddAccessGroupRuleWithContext(ctx, iamAccessGroups.NewAddAccessGroupRuleOptionsWith(options...))
+}
+
+// ListAccessGroupRulesOption : A functional option that sets a property of ListAccessGroupRulesOptions (see ListAccessGroupRulesWith).
+type ListAccessGroupRulesOption func(options *ListAccessGroupRulesOptions)
+
+// ListAccessGroupRulesAccessGroupID sets the AccessGroupID property of ListAccessGroupRulesOptions. The access
+// group identifier.
+func ListAccessGroupRulesAccessGroupID(accessGroupID string) ListAccessGroupRulesOption {
+	return func(options *ListAccessGroupRulesOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// ListAccessGroupRulesTransactionID sets the TransactionID property of ListAccessGroupRulesOptions. An optional
+// transaction ID can be passed to your request, which can be useful for tracking calls through multiple services
+// by using one identifier.
+func ListAccessGroupRulesTransactionID(transactionID string) ListAccessGroupRulesOption {
+	return func(options *ListAccessGroupRulesOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ListAccessGroupRulesHeader sets the Headers property of ListAccessGroupRulesOptions. Allows users to set headers
+// on API requests.
+func ListAccessGroupRulesHeader(name string, value string) ListAccessGroupRulesOption {
+	return func(options *ListAccessGroupRulesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListAccessGroupRulesOptionsWith returns ListAccessGroupRulesOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewListAccessGroupRulesOptionsWith(options ...ListAccessGroupRulesOption) *ListAccessGroupRulesOptions {
+	listAccessGroupRulesOptions := &ListAccessGroupRulesOptions{}
+	for _, option := range options {
+		option(listAccessGroupRulesOptions)
+	}
+	return listAccessGroupRulesOptions
+}
+
+// ListAccessGroupRulesWith is an alternate form of the ListAccessGroupRulesWithContext method which takes
+// functional options instead of a ListAccessGroupRulesOptions struct. ListAccessGroupRulesAccessGroupID is
+// required.
+func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupRulesWith(ctx context.Context, options ...ListAccessGroupRulesOption) (result *RulesList, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.ListAccessGroupRulesWithContext(ctx, iamAccessGroups.NewListAccessGroupRulesOptionsWith(options...))
+}
+
+// GetAccessGroupRuleOption : A functional option that sets a property of GetAccessGroupRuleOptions (see GetAccessGroupRuleWith).
+type GetAccessGroupRuleOption func(options *GetAccessGroupRuleOptions)
+
+// GetAccessGroupRuleAccessGroupID sets the AccessGroupID property of GetAccessGroupRuleOptions. The access group
+// identifier.
+func GetAccessGroupRuleAccessGroupID(accessGroupID string) GetAccessGroupRuleOption {
+	return func(options *GetAccessGroupRuleOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// GetAccessGroupRuleRuleID sets the RuleID property of GetAccessGroupRuleOptions. The rule to get.
+func GetAccessGroupRuleRuleID(ruleID string) GetAccessGroupRuleOption {
+	return func(options *GetAccessGroupRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// GetAccessGroupRuleTransactionID sets the TransactionID property of GetAccessGroupRuleOptions. An optional
+// transaction ID can be passed to your request, which can be useful for tracking calls through multiple services
+// by using one identifier.
+func GetAccessGroupRuleTransactionID(transactionID string) GetAccessGroupRuleOption {
+	return func(options *GetAccessGroupRuleOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// GetAccessGroupRuleHeader sets the Headers property of GetAccessGroupRuleOptions. Allows users to set headers on
+// API requests.
+func GetAccessGroupRuleHeader(name string, value string) GetAccessGroupRuleOption {
+	return func(options *GetAccessGroupRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAccessGroupRuleOptionsWith returns GetAccessGroupRuleOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewGetAccessGroupRuleOptionsWith(options ...GetAccessGroupRuleOption) *GetAccessGroupRuleOptions {
+	getAccessGroupRuleOptions := &GetAccessGroupRuleOptions{}
+	for _, option := range options {
+		option(getAccessGroupRuleOptions)
+	}
+	return getAccessGroupRuleOptions
+}
+
+// GetAccessGroupRuleWith is an alternate form of the GetAccessGroupRuleWithContext method which takes functional
+// options instead of a GetAccessGroupRuleOptions struct. GetAccessGroupRuleAccessGroupID and
+// GetAccessGroupRuleRuleID are required.
+func (iamAccessGroups *IamAccessGroupsV2) GetAccessGroupRuleWith(ctx context.Context, options ...GetAccessGroupRuleOption) (result *Rule, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.GetAccessGroupRuleWithContext(ctx, iamAccessGroups.NewGetAccessGroupRuleOptionsWith(options...))
+}
+
+// ReplaceAccessGroupRuleOption : A functional option that sets a property of ReplaceAccessGroupRuleOptions (see ReplaceAccessGroupRuleWith).
+type ReplaceAccessGroupRuleOption func(options *ReplaceAccessGroupRuleOptions)
+
+// ReplaceAccessGroupRuleAccessGroupID sets the AccessGroupID property of ReplaceAccessGroupRuleOptions. The access
+// group identifier.
+func ReplaceAccessGroupRuleAccessGroupID(accessGroupID string) ReplaceAccessGroupRuleOption {
+	return func(options *ReplaceAccessGroupRuleOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// ReplaceAccessGroupRuleRuleID sets the RuleID property of ReplaceAccessGroupRuleOptions. The rule to get.
+func ReplaceAccessGroupRuleRuleID(ruleID string) ReplaceAccessGroupRuleOption {
+	return func(options *ReplaceAccessGroupRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// ReplaceAccessGroupRuleIfMatch sets the IfMatch property of ReplaceAccessGroupRuleOptions. The current revision
+// number of the rule being updated.
+func ReplaceAccessGroupRuleIfMatch(ifMatch string) ReplaceAccessGroupRuleOption {
+	return func(options *ReplaceAccessGroupRuleOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// ReplaceAccessGroupRuleExpiration sets the Expiration property of ReplaceAccessGroupRuleOptions. The number of
+// hours that the rule lives for.
+func ReplaceAccessGroupRuleExpiration(expiration int64) ReplaceAccessGroupRuleOption {
+	return func(options *ReplaceAccessGroupRuleOptions) {
+		options.Expiration = core.Int64Ptr(expiration)
+	}
+}
+
+// ReplaceAccessGroupRuleRealmName sets the RealmName property of ReplaceAccessGroupRuleOptions. The url of the
+// identity provider.
+func ReplaceAccessGroupRuleRealmName(realmName string) ReplaceAccessGroupRuleOption {
+	return func(options *ReplaceAccessGroupRuleOptions) {
+		options.RealmName = core.StringPtr(realmName)
+	}
+}
+
+// ReplaceAccessGroupRuleConditions sets the Conditions property of ReplaceAccessGroupRuleOptions. A list of
+// conditions the rule must satisfy.
+func ReplaceAccessGroupRuleConditions(conditions ...RuleConditions) ReplaceAccessGroupRuleOption {
+	return func(options *ReplaceAccessGroupRuleOptions) {
+		options.Conditions = append(options.Conditions, conditions...)
+	}
+}
+
+// ReplaceAccessGroupRuleName sets the Name property of ReplaceAccessGroupRuleOptions. The name of the rule.
+func ReplaceAccessGroupRuleName(nameVar string) ReplaceAccessGroupRuleOption {
+	return func(options *ReplaceAccessGroupRuleOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ReplaceAccessGroupRuleTransactionID sets the TransactionID property of ReplaceAccessGroupRuleOptions. An
+// optional transaction ID can be passed to your request, which can be useful for tracking calls through multiple
+// services by using one identifier.
+func ReplaceAccessGroupRuleTransactionID(transactionID string) ReplaceAccessGroupRuleOption {
+	return func(options *ReplaceAccessGroupRuleOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// ReplaceAccessGroupRuleHeader sets the Headers property of ReplaceAccessGroupRuleOptions. Allows users to set
+// headers on API requests.
+func ReplaceAccessGroupRuleHeader(name string, value string) ReplaceAccessGroupRuleOption {
+	return func(options *ReplaceAccessGroupRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceAccessGroupRuleOptionsWith returns ReplaceAccessGroupRuleOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewReplaceAccessGroupRuleOptionsWith(options ...ReplaceAccessGroupRuleOption) *ReplaceAccessGroupRuleOptions {
+	replaceAccessGroupRuleOptions := &ReplaceAccessGroupRuleOptions{}
+	for _, option := range options {
+		option(replaceAccessGroupRuleOptions)
+	}
+	return replaceAccessGroupRuleOptions
+}
+
+// ReplaceAccessGroupRuleWith is an alternate form of the ReplaceAccessGroupRuleWithContext method which takes
+// functional options instead of a ReplaceAccessGroupRuleOptions struct. ReplaceAccessGroupRuleAccessGroupID,
+// ReplaceAccessGroupRuleRuleID, ReplaceAccessGroupRuleIfMatch, ReplaceAccessGroupRuleExpiration,
+// ReplaceAccessGroupRuleRealmName and ReplaceAccessGroupRuleConditions are required.
+func (iamAccessGroups *IamAccessGroupsV2) ReplaceAccessGroupRuleWith(ctx context.Context, options ...ReplaceAccessGroupRuleOption) (result *Rule, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.ReplaceAccessGroupRuleWithContext(ctx, iamAccessGroups.NewReplaceAccessGroupRuleOptionsWith(options...))
+}
+
+// RemoveAccessGroupRuleOption : A functional option that sets a property of RemoveAccessGroupRuleOptions (see RemoveAccessGroupRuleWith).
+type RemoveAccessGroupRuleOption func(options *RemoveAccessGroupRuleOptions)
+
+// RemoveAccessGroupRuleAccessGroupID sets the AccessGroupID property of RemoveAccessGroupRuleOptions. The access
+// group identifier.
+func RemoveAccessGroupRuleAccessGroupID(accessGroupID string) RemoveAccessGroupRuleOption {
+	return func(options *RemoveAccessGroupRuleOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// RemoveAccessGroupRuleRuleID sets the RuleID property of RemoveAccessGroupRuleOptions. The rule to get.
+func RemoveAccessGroupRuleRuleID(ruleID string) RemoveAccessGroupRuleOption {
+	return func(options *RemoveAccessGroupRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// RemoveAccessGroupRuleTransactionID sets the TransactionID property of RemoveAccessGroupRuleOptions. An optional
+// transaction ID can be passed to your request, which can be useful for tracking calls through multiple services
+// by using one identifier.
+func RemoveAccessGroupRuleTransactionID(transactionID string) RemoveAccessGroupRuleOption {
+	return func(options *RemoveAccessGroupRuleOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// RemoveAccessGroupRuleHeader sets the Headers property of RemoveAccessGroupRuleOptions. Allows users to set
+// headers on API requests.
+func RemoveAccessGroupRuleHeader(name string, value string) RemoveAccessGroupRuleOption {
+	return func(options *RemoveAccessGroupRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewRemoveAccessGroupRuleOptionsWith returns RemoveAccessGroupRuleOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewRemoveAccessGroupRuleOptionsWith(options ...RemoveAccessGroupRuleOption) *RemoveAccessGroupRuleOptions {
+	removeAccessGroupRuleOptions := &RemoveAccessGroupRuleOptions{}
+	for _, option := range options {
+		option(removeAccessGroupRuleOptions)
+	}
+	return removeAccessGroupRuleOptions
+}
+
+// RemoveAccessGroupRuleWith is an alternate form of the RemoveAccessGroupRuleWithContext method which takes
+// functional options instead of a RemoveAccessGroupRuleOptions struct. RemoveAccessGroupRuleAccessGroupID and
+// RemoveAccessGroupRuleRuleID are required.
+func (iamAccessGroups *IamAccessGroupsV2) RemoveAccessGroupRuleWith(ctx context.Context, options ...RemoveAccessGroupRuleOption) (response *core.DetailedResponse, err error) {
+	return iamAccessGroups.RemoveAccessGroupRuleWithContext(ctx, iamAccessGroups.NewRemoveAccessGroupRuleOptionsWith(options...))
+}
+
+// GetAccountSettingsOption : A functional option that sets a property of GetAccountSettingsOptions (see GetAccountSettingsWith).
+type GetAccountSettingsOption func(options *GetAccountSettingsOptions)
+
+// GetAccountSettingsAccountID sets the AccountID property of GetAccountSettingsOptions. Account ID of the API
+// keys(s) to query.
+func GetAccountSettingsAccountID(accountID string) GetAccountSettingsOption {
+	return func(options *GetAccountSettingsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetAccountSettingsTransactionID sets the TransactionID property of GetAccountSettingsOptions. An optional
+// transaction ID can be passed to your request, which can be useful for tracking calls through multiple services
+// by using one identifier.
+func GetAccountSettingsTransactionID(transactionID string) GetAccountSettingsOption {
+	return func(options *GetAccountSettingsOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// GetAccountSettingsHeader sets the Headers property of GetAccountSettingsOptions. Allows users to set headers on
+// API requests.
+func GetAccountSettingsHeader(name string, value string) GetAccountSettingsOption {
+	return func(options *GetAccountSettingsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAccountSettingsOptionsWith returns GetAccountSettingsOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewGetAccountSettingsOptionsWith(options ...GetAccountSettingsOption) *GetAccountSettingsOptions {
+	getAccountSettingsOptions := &GetAccountSettingsOptions{}
+	for _, option := range options {
+		option(getAccountSettingsOptions)
+	}
+	return getAccountSettingsOptions
+}
+
+// GetAccountSettingsWith is an alternate form of the GetAccountSettingsWithContext method which takes functional
+// options instead of a GetAccountSettingsOptions struct. GetAccountSettingsAccountID is required.
+func (iamAccessGroups *IamAccessGroupsV2) GetAccountSettingsWith(ctx context.Context, options ...GetAccountSettingsOption) (result *AccountSettings, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.GetAccountSettingsWithContext(ctx, iamAccessGroups.NewGetAccountSettingsOptionsWith(options...))
+}
+
+// UpdateAccountSettingsOption : A functional option that sets a property of UpdateAccountSettingsOptions (see UpdateAccountSettingsWith).
+type UpdateAccountSettingsOption func(options *UpdateAccountSettingsOptions)
+
+// UpdateAccountSettingsAccountID sets the AccountID property of UpdateAccountSettingsOptions. Account ID of the
+// API keys(s) to query.
+func UpdateAccountSettingsAccountID(accountID string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// UpdateAccountSettingsPublicAccessEnabled sets the PublicAccessEnabled property of UpdateAccountSettingsOptions.
+// This flag controls the public access feature within the account.
+func UpdateAccountSettingsPublicAccessEnabled(publicAccessEnabled bool) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.PublicAccessEnabled = core.BoolPtr(publicAccessEnabled)
+	}
+}
+
+// UpdateAccountSettingsTransactionID sets the TransactionID property of UpdateAccountSettingsOptions. An optional
+// transaction ID can be passed to your request, which can be useful for tracking calls through multiple services
+// by using one identifier.
+func UpdateAccountSettingsTransactionID(transactionID string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.TransactionID = core.StringPtr(transactionID)
+	}
+}
+
+// UpdateAccountSettingsHeader sets the Headers property of UpdateAccountSettingsOptions. Allows users to set
+// headers on API requests.
+func UpdateAccountSettingsHeader(name string, value string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateAccountSettingsOptionsWith returns UpdateAccountSettingsOptions populated by applying "options" in order.
+func (*IamAccessGroupsV2) NewUpdateAccountSettingsOptionsWith(options ...UpdateAccountSettingsOption) *UpdateAccountSettingsOptions {
+	updateAccountSettingsOptions := &UpdateAccountSettingsOptions{}
+	for _, option := range options {
+		option(updateAccountSettingsOptions)
+	}
+	return updateAccountSettingsOptions
+}
+
+// UpdateAccountSettingsWith is an alternate form of the UpdateAccountSettingsWithContext method which takes
+// functional options instead of an UpdateAccountSettingsOptions struct. UpdateAccountSettingsAccountID is
+// required.
+func (iamAccessGroups *IamAccessGroupsV2) UpdateAccountSettingsWith(ctx context.Context, options ...UpdateAccountSettingsOption) (result *AccountSettings, response *core.DetailedResponse, err error) {
+	return iamAccessGroups.UpdateAccountSettingsWithContext(ctx, iamAccessGroups.NewUpdateAccountSettingsOptionsWith(options...))
+}
@@ -0,0 +1,359 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamaccessgroupsv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The range of values allowed for the expiration of a dynamic rule, in hours.
+const (
+	RuleExpirationMinimum = 1
+	RuleExpirationMaximum = 24
+)
+
+// Claim names may not contain whitespace or quotes, e.g. "blueGroups" or
+// "http://schemas.xmlsoap.org/claims/Group".
+var ruleClaimPattern = regexp.MustCompile(`^[^\s"'\\]{1,256}$`)
+
+// RuleBuilder : Builds the conditions of an access group dynamic rule, encoding the condition values as JSON and
+// validating them client-side.
+type RuleBuilder struct {
+	name       *string
+	realmName  *string
+	expiration int64
+	conditions []RuleConditions
+	err        error
+}
+
+// NewRuleBuilder returns a new RuleBuilder for a rule that matches users of the identity provider "realmName".
+// The rule expiration defaults to RuleExpirationMaximum hours.
+func NewRuleBuilder(realmName string) *RuleBuilder {
+	return &RuleBuilder{
+		realmName:  core.StringPtr(realmName),
+		expiration: RuleExpirationMaximum,
+	}
+}
+
+// Name sets the name of the rule.
+func (builder *RuleBuilder) Name(name string) *RuleBuilder {
+	builder.name = core.StringPtr(name)
+	return builder
+}
+
+// Expiration sets the number of hours that the rule lives for.
+func (builder *RuleBuilder) Expiration(hours int64) *RuleBuilder {
+	builder.expiration = hours
+	return builder
+}
+
+// Condition adds a condition that compares "claim" to "value" using "operator" (one of the
+// RuleConditionsOperator*Const values). "value" is encoded as JSON.
+func (builder *RuleBuilder) Condition(claim string, operator string, value interface{}) *RuleBuilder {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		if builder.err == nil {
+			builder.err = fmt.Errorf("error encoding value of claim '%s': %s", claim, err.Error())
+		}
+		return builder
+	}
+	builder.conditions = append(builder.conditions, RuleConditions{
+		Claim:    core.StringPtr(claim),
+		Operator: core.StringPtr(operator),
+		Value:    core.StringPtr(string(encoded)),
+	})
+	return builder
+}
+
+// Equals adds a condition that matches if "claim" is equal to "value" (a string, number or boolean).
+func (builder *RuleBuilder) Equals(claim string, value interface{}) *RuleBuilder {
+	return builder.Condition(claim, RuleConditionsOperatorEqualsConst, value)
+}
+
+// NotEquals adds a condition that matches if "claim" is not equal to "value" (a string, number or boolean).
+func (builder *RuleBuilder) NotEquals(claim string, value interface{}) *RuleBuilder {
+	return builder.Condition(claim, RuleConditionsOperatorNotEqualsConst, value)
+}
+
+// EqualsIgnoreCase adds a condition that matches if "claim" is equal to "value", ignoring case.
+func (builder *RuleBuilder) EqualsIgnoreCase(claim string, value string) *RuleBuilder {
+	return builder.Condition(claim, RuleConditionsOperatorEqualsIgnoreCaseConst, value)
+}
+
+// NotEqualsIgnoreCase adds a condition that matches if "claim" is not equal to "value", ignoring case.
+func (builder *RuleBuilder) NotEqualsIgnoreCase(claim string, value string) *RuleBuilder {
+	return builder.Condition(claim, RuleConditionsOperatorNotEqualsIgnoreCaseConst, value)
+}
+
+// Contains adds a condition that matches if "claim" contains "value".
+func (builder *RuleBuilder) Contains(claim string, value string) *RuleBuilder {
+	return builder.Condition(claim, RuleConditionsOperatorContainsConst, value)
+}
+
+// In adds a condition that matches if "claim" is equal to one of "values".
+func (builder *RuleBuilder) In(claim string, values ...interface{}) *RuleBuilder {
+	if values == nil {
+		values = []interface{}{}
+	}
+	return builder.Condition(claim, RuleConditionsOperatorInConst, values)
+}
+
+// Conditions returns the validated conditions of the rule.
+func (builder *RuleBuilder) Conditions() (conditions []RuleConditions, err error) {
+	if builder.err != nil {
+		err = builder.err
+		return
+	}
+	err = ValidateRuleConditions(builder.conditions)
+	if err != nil {
+		return
+	}
+	conditions = append(conditions, builder.conditions...)
+	return
+}
+
+// Build validates the rule and returns the options to add it to the access group "accessGroupID".
+func (builder *RuleBuilder) Build(accessGroupID string) (options *AddAccessGroupRuleOptions, err error) {
+	conditions, err := builder.Conditions()
+	if err != nil {
+		return
+	}
+	if builder.expiration < RuleExpirationMinimum || builder.expiration > RuleExpirationMaximum {
+		err = fmt.Errorf("rule expiration must be between %d and %d hours", RuleExpirationMinimum, RuleExpirationMaximum)
+		return
+	}
+
+	options = &AddAccessGroupRuleOptions{
+		AccessGroupID: core.StringPtr(accessGroupID),
+		Expiration:    core.Int64Ptr(builder.expiration),
+		RealmName:     builder.realmName,
+		Conditions:    conditions,
+		Name:          builder.name,
+	}
+	err = core.ValidateStruct(options, "addAccessGroupRuleOptions")
+	if err != nil {
+		options = nil
+	}
+	return
+}
+
+// ValidateRuleConditions checks the syntax of the claim names, that the operators are supported and that each value
+// is valid JSON of a type that is compatible with its operator.
+func ValidateRuleConditions(conditions []RuleConditions) error {
+	if len(conditions) == 0 {
+		return fmt.Errorf("a rule must have at least one condition")
+	}
+	for i, condition := range conditions {
+		err := validateRuleCondition(&condition)
+		if err != nil {
+			return fmt.Errorf("condition %d: %s", i, err.Error())
+		}
+	}
+	return nil
+}
+
+func validateRuleCondition(condition *RuleConditions) error {
+	if condition.Claim == nil || !ruleClaimPattern.MatchString(*condition.Claim) {
+		return fmt.Errorf("invalid claim name '%s'", core.StringNilMapper(condition.Claim))
+	}
+	if condition.Value == nil {
+		return fmt.Errorf("value of claim '%s' must be specified", *condition.Claim)
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(*condition.Value), &value); err != nil {
+		return fmt.Errorf("value of claim '%s' is not valid JSON: %s", *condition.Claim, err.Error())
+	}
+
+	operator := core.StringNilMapper(condition.Operator)
+	switch operator {
+	case RuleConditionsOperatorEqualsConst, RuleConditionsOperatorNotEqualsConst:
+		if !isScalarClaimValue(value) {
+			return fmt.Errorf("operator %s requires a string, number or boolean value", operator)
+		}
+	case RuleConditionsOperatorEqualsIgnoreCaseConst, RuleConditionsOperatorNotEqualsIgnoreCaseConst, RuleConditionsOperatorContainsConst:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("operator %s requires a string value", operator)
+		}
+	case RuleConditionsOperatorInConst:
+		values, ok := value.([]interface{})
+		if !ok || len(values) == 0 {
+			return fmt.Errorf("operator %s requires a non-empty array value", operator)
+		}
+		for _, v := range values {
+			if !isScalarClaimValue(v) {
+				return fmt.Errorf("operator %s requires an array of strings, numbers or booleans", operator)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported operator '%s'", operator)
+	}
+	return nil
+}
+
+// TestRule evaluates the conditions of "rule" against the claims of a sample login token and reports whether the
+// rule would add the user to the access group. Claims are looked up in the token's "ext" object if it has one, and
+// otherwise at the top level. A condition on a claim with multiple values matches if any of the values match; a
+// condition on a claim that is not present does not match.
+func TestRule(rule *Rule, sampleToken map[string]interface{}) (matched bool, err error) {
+	preview, err := previewRule(rule, sampleToken)
+	if err != nil {
+		return
+	}
+	return preview.Matched, nil
+}
+
+// RulePreview : The result of evaluating a dynamic rule against sample claims with PreviewDynamicRule.
+type RulePreview struct {
+	// Whether the rule would add the user to the access group.
+	Matched bool
+
+	// The index of the first condition that did not match, or -1 if the rule matched.
+	FailedConditionIndex int
+
+	// The first condition that did not match, or nil if the rule matched.
+	FailedCondition *RuleConditions
+
+	// Why the condition did not match, or "" if the rule matched.
+	Reason string
+}
+
+// PreviewDynamicRule evaluates the conditions of a dynamic rule locally against sample identity claims, in the same
+// way as TestRule, and reports the first condition that does not match. This allows rules to be checked before they
+// are saved. If "rule" has no conditions but has an ID and an access group ID, the saved rule is retrieved first; if
+// "ctx" carries a common.AccountContext, its access group must then belong to the account of the context.
+func (iamAccessGroups *IamAccessGroupsV2) PreviewDynamicRule(ctx context.Context, rule *Rule, sampleClaims map[string]interface{}) (result *RulePreview, err error) {
+	err = core.ValidateNotNil(rule, "rule cannot be nil")
+	if err != nil {
+		return
+	}
+	if len(rule.Conditions) == 0 && rule.ID != nil && rule.AccessGroupID != nil {
+		err = iamAccessGroups.checkGroupAccount(ctx, *rule.AccessGroupID)
+		if err != nil {
+			return
+		}
+		getAccessGroupRuleOptions := iamAccessGroups.NewGetAccessGroupRuleOptions(*rule.AccessGroupID, *rule.ID)
+		rule, _, err = iamAccessGroups.GetAccessGroupRuleWithContext(ctx, getAccessGroupRuleOptions)
+		if err != nil {
+			return
+		}
+	}
+	return previewRule(rule, sampleClaims)
+}
+
+func previewRule(rule *Rule, sampleToken map[string]interface{}) (result *RulePreview, err error) {
+	err = core.ValidateNotNil(rule, "rule cannot be nil")
+	if err != nil {
+		return
+	}
+	err = ValidateRuleConditions(rule.Conditions)
+	if err != nil {
+		return
+	}
+
+	claims := sampleToken
+	if ext, ok := sampleToken["ext"].(map[string]interface{}); ok {
+		claims = ext
+	}
+	for i := range rule.Conditions {
+		condition := &rule.Conditions[i]
+		if !evaluateRuleCondition(condition, claims) {
+			result = &RulePreview{
+				FailedConditionIndex: i,
+				FailedCondition:      condition,
+				Reason:               ruleConditionFailure(condition, claims),
+			}
+			return
+		}
+	}
+	result = &RulePreview{Matched: true, FailedConditionIndex: -1}
+	return
+}
+
+// ruleConditionFailure describes why a condition does not match the claims.
+func ruleConditionFailure(condition *RuleConditions, claims map[string]interface{}) string {
+	claim, ok := claims[*condition.Claim]
+	if !ok || claim == nil {
+		return fmt.Sprintf("claim '%s' is not present", *condition.Claim)
+	}
+	encoded, _ := json.Marshal(claim)
+	return fmt.Sprintf("claim '%s' with value %s does not satisfy %s %s", *condition.Claim, encoded, *condition.Operator, *condition.Value)
+}
+
+func evaluateRuleCondition(condition *RuleConditions, claims map[string]interface{}) bool {
+	claim, ok := claims[*condition.Claim]
+	if !ok || claim == nil {
+		return false
+	}
+	claimValues, ok := claim.([]interface{})
+	if !ok {
+		claimValues = []interface{}{claim}
+	}
+
+	var value interface{}
+	_ = json.Unmarshal([]byte(*condition.Value), &value)
+
+	switch *condition.Operator {
+	case RuleConditionsOperatorEqualsConst:
+		return anyClaimValue(claimValues, func(v interface{}) bool { return claimValuesEqual(v, value) })
+	case RuleConditionsOperatorNotEqualsConst:
+		return !anyClaimValue(claimValues, func(v interface{}) bool { return claimValuesEqual(v, value) })
+	case RuleConditionsOperatorEqualsIgnoreCaseConst:
+		return anyClaimValue(claimValues, func(v interface{}) bool { return strings.EqualFold(fmt.Sprint(v), value.(string)) })
+	case RuleConditionsOperatorNotEqualsIgnoreCaseConst:
+		return !anyClaimValue(claimValues, func(v interface{}) bool { return strings.EqualFold(fmt.Sprint(v), value.(string)) })
+	case RuleConditionsOperatorContainsConst:
+		return anyClaimValue(claimValues, func(v interface{}) bool { return strings.Contains(fmt.Sprint(v), value.(string)) })
+	case RuleConditionsOperatorInConst:
+		return anyClaimValue(claimValues, func(v interface{}) bool {
+			for _, candidate := range value.([]interface{}) {
+				if claimValuesEqual(v, candidate) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	return false
+}
+
+func anyClaimValue(values []interface{}, predicate func(interface{}) bool) bool {
+	for _, v := range values {
+		if predicate(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// claimValuesEqual compares two decoded JSON values; claims are often strings, so "1" is equal to 1.
+func claimValuesEqual(a interface{}, b interface{}) bool {
+	return fmt.Sprint(a) == fmt.Sprint(b)
+}
+
+func isScalarClaimValue(value interface{}) bool {
+	switch value.(type) {
+	case string, float64, bool:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamaccessgroupsv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamAccessGroupsV2 RuleBuilder`, func() {
+	It(`Builds rule options with JSON-encoded values`, func() {
+		options, err := iamaccessgroupsv2.NewRuleBuilder("https://idp.example.com/saml").
+			Name("Managers").
+			Expiration(12).
+			Equals("isManager", true).
+			In("department", "sales", "marketing").
+			Contains("blueGroups", "admins").
+			Build("group1")
+		Expect(err).To(BeNil())
+		Expect(*options.AccessGroupID).To(Equal("group1"))
+		Expect(*options.Name).To(Equal("Managers"))
+		Expect(*options.Expiration).To(Equal(int64(12)))
+		Expect(*options.RealmName).To(Equal("https://idp.example.com/saml"))
+		Expect(options.Conditions).To(HaveLen(3))
+		Expect(*options.Conditions[0].Value).To(Equal("true"))
+		Expect(*options.Conditions[1].Operator).To(Equal(iamaccessgroupsv2.RuleConditionsOperatorInConst))
+		Expect(*options.Conditions[1].Value).To(Equal(`["sales","marketing"]`))
+		Expect(*options.Conditions[2].Value).To(Equal(`"admins"`))
+	})
+	It(`Rejects invalid rules`, func() {
+		_, err := iamaccessgroupsv2.NewRuleBuilder("realm").Build("group1")
+		Expect(err).ToNot(BeNil())
+		_, err = iamaccessgroupsv2.NewRuleBuilder("realm").Equals("bad claim", "x").Build("group1")
+		Expect(err.Error()).To(ContainSubstring("invalid claim name 'bad claim'"))
+		_, err = iamaccessgroupsv2.NewRuleBuilder("realm").Equals("dept", []string{"a"}).Build("group1")
+		Expect(err.Error()).To(ContainSubstring("requires a string, number or boolean value"))
+		_, err = iamaccessgroupsv2.NewRuleBuilder("realm").In("dept").Build("group1")
+		Expect(err.Error()).To(ContainSubstring("non-empty array"))
+		_, err = iamaccessgroupsv2.NewRuleBuilder("realm").Condition("dept", "MATCHES", "a").Build("group1")
+		Expect(err.Error()).To(ContainSubstring("unsupported operator 'MATCHES'"))
+		_, err = iamaccessgroupsv2.NewRuleBuilder("realm").Condition("dept", "EQUALS", make(chan int)).Build("group1")
+		Expect(err).ToNot(BeNil())
+		_, err = iamaccessgroupsv2.NewRuleBuilder("realm").Expiration(48).Equals("dept", "a").Build("group1")
+		Expect(err.Error()).To(ContainSubstring("expiration"))
+	})
+	It(`Validates raw conditions`, func() {
+		err := iamaccessgroupsv2.ValidateRuleConditions([]iamaccessgroupsv2.RuleConditions{{
+			Claim:    core.StringPtr("blueGroups"),
+			Operator: core.StringPtr(iamaccessgroupsv2.RuleConditionsOperatorContainsConst),
+			Value:    core.StringPtr("admins"),
+		}})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("not valid JSON"))
+
+		err = iamaccessgroupsv2.ValidateRuleConditions([]iamaccessgroupsv2.RuleConditions{{
+			Claim:    core.StringPtr("isManager"),
+			Operator: core.StringPtr(iamaccessgroupsv2.RuleConditionsOperatorEqualsConst),
+			Value:    core.StringPtr("true"),
+		}})
+		Expect(err).To(BeNil())
+	})
+})
+
+var _ = Describe(`IamAccessGroupsV2 TestRule`, func() {
+	newRule := func(builder *iamaccessgroupsv2.RuleBuilder) *iamaccessgroupsv2.Rule {
+		conditions, err := builder.Conditions()
+		Expect(err).To(BeNil())
+		return &iamaccessgroupsv2.Rule{Conditions: conditions}
+	}
+	token := map[string]interface{}{
+		"sub": "user@example.com",
+		"ext": map[string]interface{}{
+			"isManager":  true,
+			"department": "Sales",
+			"level":      float64(7),
+			"blueGroups": []interface{}{"eng-admins", "all-staff"},
+		},
+	}
+
+	It(`Matches when every condition holds`, func() {
+		rule := newRule(iamaccessgroupsv2.NewRuleBuilder("realm").
+			Equals("isManager", true).
+			EqualsIgnoreCase("department", "sales").
+			In("level", 6, 7, 8).
+			Contains("blueGroups", "admins"))
+		Expect(iamaccessgroupsv2.TestRule(rule, token)).To(BeTrue())
+	})
+	It(`Does not match when any condition fails`, func() {
+		for _, builder := range []*iamaccessgroupsv2.RuleBuilder{
+			iamaccessgroupsv2.NewRuleBuilder("realm").Equals("department", "sales"),
+			iamaccessgroupsv2.NewRuleBuilder("realm").NotEquals("level", "7"),
+			iamaccessgroupsv2.NewRuleBuilder("realm").NotEqualsIgnoreCase("blueGroups", "ALL-STAFF"),
+			iamaccessgroupsv2.NewRuleBuilder("realm").Equals("isManager", true).Equals("missing", "x"),
+			iamaccessgroupsv2.NewRuleBuilder("realm").In("department", "Marketing"),
+		} {
+			Expect(iamaccessgroupsv2.TestRule(newRule(builder), token)).To(BeFalse())
+		}
+	})
+	It(`Uses top-level claims when there is no ext object`, func() {
+		rule := newRule(iamaccessgroupsv2.NewRuleBuilder("realm").Equals("sub", "user@example.com"))
+		Expect(iamaccessgroupsv2.TestRule(rule, map[string]interface{}{"sub": "user@example.com"})).To(BeTrue())
+	})
+	It(`Rejects invalid rules`, func() {
+		_, err := iamaccessgroupsv2.TestRule(nil, token)
+		Expect(err).ToNot(BeNil())
+		_, err = iamaccessgroupsv2.TestRule(&iamaccessgroupsv2.Rule{}, token)
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe(`IamAccessGroupsV2 PreviewDynamicRule`, func() {
+	var testServer *httptest.Server
+	var iamAccessGroupsService *iamaccessgroupsv2.IamAccessGroupsV2
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.URL.EscapedPath()).To(Equal("/v2/groups/group1/rules/rule1"))
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"id": "rule1", "access_group_id": "group1", "realm_name": "realm",
+				"conditions": [{"claim": "department", "operator": "EQUALS", "value": "\"Sales\""}]}`)
+		}))
+		var serviceErr error
+		iamAccessGroupsService, serviceErr = iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	claims := map[string]interface{}{"department": "Sales", "blueGroups": []interface{}{"all-staff"}}
+
+	It(`Reports the first failing condition`, func() {
+		conditions, err := iamaccessgroupsv2.NewRuleBuilder("realm").
+			Equals("department", "Sales").
+			Contains("blueGroups", "admins").
+			Equals("missing", "x").
+			Conditions()
+		Expect(err).To(BeNil())
+		preview, err := iamAccessGroupsService.PreviewDynamicRule(context.Background(), &iamaccessgroupsv2.Rule{Conditions: conditions}, claims)
+		Expect(err).To(BeNil())
+		Expect(preview.Matched).To(BeFalse())
+		Expect(preview.FailedConditionIndex).To(Equal(1))
+		Expect(*preview.FailedCondition.Claim).To(Equal("blueGroups"))
+		Expect(preview.Reason).To(Equal(`claim 'blueGroups' with value ["all-staff"] does not satisfy CONTAINS "admins"`))
+
+		preview, err = iamAccessGroupsService.PreviewDynamicRule(context.Background(), &iamaccessgroupsv2.Rule{Conditions: conditions[2:]}, claims)
+		Expect(err).To(BeNil())
+		Expect(preview.Reason).To(Equal(`claim 'missing' is not present`))
+	})
+	It(`Previews a saved rule`, func() {
+		rule := &iamaccessgroupsv2.Rule{ID: core.StringPtr("rule1"), AccessGroupID: core.StringPtr("group1")}
+		preview, err := iamAccessGroupsService.PreviewDynamicRule(context.Background(), rule, claims)
+		Expect(err).To(BeNil())
+		Expect(preview.Matched).To(BeTrue())
+		Expect(preview.FailedConditionIndex).To(Equal(-1))
+		Expect(preview.FailedCondition).To(BeNil())
+	})
+	It(`Rejects invalid rules`, func() {
+		_, err := iamAccessGroupsService.PreviewDynamicRule(context.Background(), nil, claims)
+		Expect(err).ToNot(BeNil())
+		_, err = iamAccessGroupsService.PreviewDynamicRule(context.Background(), &iamaccessgroupsv2.Rule{}, claims)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,247 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamaccessgroupsv2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the GroupMemberSyncResult.Action property.
+const (
+	GroupMemberSyncResultActionAddedConst     = "added"
+	GroupMemberSyncResultActionRemovedConst   = "removed"
+	GroupMemberSyncResultActionUnchangedConst = "unchanged"
+)
+
+// The member types accepted by AddMembersToAccessGroup.
+const (
+	MemberTypeUserConst    = "user"
+	MemberTypeServiceConst = "service"
+	MemberTypeProfileConst = "profile"
+)
+
+// The maximum number of members added or removed by a single SyncGroupMembers request.
+const syncGroupMembersBatchSize = 50
+
+// The page size used to list the members of a group.
+const groupMembersPageSize = 100
+
+// GroupMemberSyncResult : The outcome of reconciling one member of an access group.
+type GroupMemberSyncResult struct {
+	// The IBMid, service ID or trusted profile ID of the member.
+	IamID string
+
+	// The action taken for the member.
+	Action string
+
+	// The status code reported for the member by the add or remove request; zero if the member was unchanged.
+	StatusCode int64
+
+	// The errors reported for the member by the add or remove request, if any.
+	Errors []Error
+}
+
+// Succeeded returns true if the member is now in the desired state.
+func (result *GroupMemberSyncResult) Succeeded() bool {
+	return len(result.Errors) == 0 && result.StatusCode < 300
+}
+
+// SyncGroupMembers reconciles the static membership of an access group with "desiredMembers" (a list of IBMid,
+// service ID and trusted profile IDs). Members that are missing are added and members that are not desired are
+// removed, using as few bulk AddMembersToAccessGroup and RemoveMembersFromAccessGroup calls as possible.
+// Dynamic members, which are managed by access group rules, are neither listed nor removed.
+// The returned report contains one result per member, sorted by IAM ID; if a request fails, the results obtained so
+// far are returned together with the error. If "ctx" carries a common.AccountContext, the access group must belong to
+// its account.
+func (iamAccessGroups *IamAccessGroupsV2) SyncGroupMembers(ctx context.Context, groupID string, desiredMembers []string) (results []GroupMemberSyncResult, err error) {
+	if groupID == "" {
+		err = fmt.Errorf("groupID cannot be empty")
+		return
+	}
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, iamAccessGroups.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	err = iamAccessGroups.checkGroupAccount(ctx, groupID)
+	if err != nil {
+		return
+	}
+	current, err := iamAccessGroups.listStaticMembers(ctx, groupID)
+	if err != nil {
+		return
+	}
+
+	desired := map[string]bool{}
+	var toAdd []string
+	for _, iamID := range desiredMembers {
+		if desired[iamID] {
+			continue
+		}
+		desired[iamID] = true
+		if current[iamID] {
+			results = append(results, GroupMemberSyncResult{IamID: iamID, Action: GroupMemberSyncResultActionUnchangedConst})
+		} else {
+			toAdd = append(toAdd, iamID)
+		}
+	}
+	var toRemove []string
+	for iamID := range current {
+		if !desired[iamID] {
+			toRemove = append(toRemove, iamID)
+		}
+	}
+	sort.Strings(toAdd)
+	sort.Strings(toRemove)
+	defer func() {
+		sort.SliceStable(results, func(i, j int) bool {
+			return results[i].IamID < results[j].IamID
+		})
+	}()
+
+	for start := 0; start < len(toRemove); start += syncGroupMembersBatchSize {
+		batch := toRemove[start:minInt(start+syncGroupMembersBatchSize, len(toRemove))]
+		options := iamAccessGroups.NewRemoveMembersFromAccessGroupOptions(groupID).SetMembers(batch)
+		var result *DeleteGroupBulkMembersResponse
+		result, _, err = iamAccessGroups.RemoveMembersFromAccessGroupWithContext(ctx, options)
+		if err != nil {
+			return
+		}
+		for _, member := range result.Members {
+			results = append(results, GroupMemberSyncResult{
+				IamID:      core.StringNilMapper(member.IamID),
+				Action:     GroupMemberSyncResultActionRemovedConst,
+				StatusCode: int64NilMapper(member.StatusCode),
+				Errors:     member.Errors,
+			})
+		}
+	}
+
+	for start := 0; start < len(toAdd); start += syncGroupMembersBatchSize {
+		batch := toAdd[start:minInt(start+syncGroupMembersBatchSize, len(toAdd))]
+		members := make([]AddGroupMembersRequestMembersItem, len(batch))
+		for i, iamID := range batch {
+			members[i] = AddGroupMembersRequestMembersItem{
+				IamID: core.StringPtr(iamID),
+				Type:  core.StringPtr(MemberTypeForIamID(iamID)),
+			}
+		}
+		options := iamAccessGroups.NewAddMembersToAccessGroupOptions(groupID).SetMembers(members)
+		var result *AddGroupMembersResponse
+		result, _, err = iamAccessGroups.AddMembersToAccessGroupWithContext(ctx, options)
+		if err != nil {
+			return
+		}
+		for _, member := range result.Members {
+			results = append(results, GroupMemberSyncResult{
+				IamID:      core.StringNilMapper(member.IamID),
+				Action:     GroupMemberSyncResultActionAddedConst,
+				StatusCode: int64NilMapper(member.StatusCode),
+				Errors:     member.Errors,
+			})
+		}
+	}
+	return
+}
+
+// MemberTypeForIamID returns the member type ("user", "service" or "profile") that corresponds to an IAM ID.
+func MemberTypeForIamID(iamID string) string {
+	switch {
+	case strings.HasPrefix(iamID, "iam-ServiceId-"):
+		return MemberTypeServiceConst
+	case strings.HasPrefix(iamID, "iam-Profile-"):
+		return MemberTypeProfileConst
+	default:
+		return MemberTypeUserConst
+	}
+}
+
+// listStaticMembers returns the set of IAM IDs that are static members of the access group.
+func (iamAccessGroups *IamAccessGroupsV2) listStaticMembers(ctx context.Context, groupID string) (members map[string]bool, err error) {
+	members = map[string]bool{}
+	err = iamAccessGroups.forEachGroupMember(ctx, groupID, "static", func(member *ListGroupMembersResponseMember) {
+		if member.IamID != nil {
+			members[*member.IamID] = true
+		}
+	})
+	return
+}
+
+// forEachGroupMember lists the members of the access group with the specified membership type ("static", "dynamic"
+// or "all") and calls "visit" for each of them.
+func (iamAccessGroups *IamAccessGroupsV2) forEachGroupMember(ctx context.Context, groupID string, membershipType string, visit func(member *ListGroupMembersResponseMember)) (err error) {
+	options := iamAccessGroups.NewListAccessGroupMembersOptions(groupID).
+		SetMembershipType(membershipType).
+		SetLimit(groupMembersPageSize)
+	var offset int64
+	for {
+		options.SetOffset(offset)
+		var result *GroupMembersList
+		result, _, err = iamAccessGroups.ListAccessGroupMembersWithContext(ctx, options)
+		if err != nil {
+			return
+		}
+		for i := range result.Members {
+			visit(&result.Members[i])
+		}
+		offset += int64(len(result.Members))
+		if len(result.Members) == 0 || result.Next == nil || result.Next.Href == nil {
+			return
+		}
+	}
+}
+
+// checkGroupAccount returns an error (wrapping a *common.AccountMismatchError) if "ctx" carries a
+// common.AccountContext and the access group belongs to another account. No request is sent otherwise.
+func (iamAccessGroups *IamAccessGroupsV2) checkGroupAccount(ctx context.Context, groupID string) (err error) {
+	accountContext := common.GetAccountContext(ctx)
+	if accountContext == nil || accountContext.AccountID == "" {
+		return
+	}
+	group, _, err := iamAccessGroups.GetAccessGroupWithContext(ctx, iamAccessGroups.NewGetAccessGroupOptions(groupID))
+	if err != nil {
+		return fmt.Errorf("error retrieving access group '%s': %w", groupID, err)
+	}
+	return checkGroupAccountID(ctx, groupID, group)
+}
+
+// checkGroupAccountID returns an error (wrapping a *common.AccountMismatchError) if the account of "group" does not
+// match the common.AccountContext carried by "ctx".
+func checkGroupAccountID(ctx context.Context, groupID string, group *Group) error {
+	if _, err := common.ResolveAccountID(ctx, core.StringNilMapper(group.AccountID)); err != nil {
+		return fmt.Errorf("access group '%s' is not in the account of the context: %w", groupID, err)
+	}
+	return nil
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func int64NilMapper(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamaccessgroupsv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamAccessGroupsV2 SyncGroupMembers`, func() {
+	var testServer *httptest.Server
+	var addRequests, removeRequests [][]string
+	BeforeEach(func() {
+		addRequests = nil
+		removeRequests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v2/groups/group1":
+				fmt.Fprint(res, `{"id": "group1", "account_id": "account1"}`)
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v2/groups/group1/members":
+				Expect(req.URL.Query().Get("membership_type")).To(Equal("static"))
+				if req.URL.Query().Get("offset") == "0" {
+					fmt.Fprint(res, `{"members": [{"iam_id": "IBMid-keep"}, {"iam_id": "IBMid-old"}], "next": {"href": "next"}}`)
+				} else {
+					Expect(req.URL.Query().Get("offset")).To(Equal("2"))
+					fmt.Fprint(res, `{"members": [{"iam_id": "iam-ServiceId-old"}]}`)
+				}
+			case req.Method == "PUT" && req.URL.EscapedPath() == "/v2/groups/group1/members":
+				var body struct {
+					Members []struct {
+						IamID string `json:"iam_id"`
+						Type  string `json:"type"`
+					} `json:"members"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				ids := []string{}
+				items := []string{}
+				for _, member := range body.Members {
+					ids = append(ids, member.IamID+"/"+member.Type)
+					statusCode := 200
+					if member.IamID == "IBMid-bad" {
+						statusCode = 404
+					}
+					items = append(items, fmt.Sprintf(`{"iam_id": "%s", "status_code": %d}`, member.IamID, statusCode))
+				}
+				addRequests = append(addRequests, ids)
+				fmt.Fprintf(res, `{"members": [%s]}`, strings.Join(items, ","))
+			case req.Method == "POST" && req.URL.EscapedPath() == "/v2/groups/group1/members/delete":
+				var body struct {
+					Members []string `json:"members"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				removeRequests = append(removeRequests, body.Members)
+				items := []string{}
+				for _, iamID := range body.Members {
+					items = append(items, fmt.Sprintf(`{"iam_id": "%s", "status_code": 200}`, iamID))
+				}
+				res.WriteHeader(207)
+				fmt.Fprintf(res, `{"access_group_id": "group1", "members": [%s]}`, strings.Join(items, ","))
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *iamaccessgroupsv2.IamAccessGroupsV2 {
+		iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return iamAccessGroupsService
+	}
+
+	It(`Adds and removes only the delta`, func() {
+		results, err := newService().SyncGroupMembers(context.Background(), "group1",
+			[]string{"IBMid-keep", "iam-Profile-new", "IBMid-new", "IBMid-bad", "IBMid-new"})
+		Expect(err).To(BeNil())
+
+		Expect(removeRequests).To(Equal([][]string{{"IBMid-old", "iam-ServiceId-old"}}))
+		Expect(addRequests).To(Equal([][]string{{"IBMid-bad/user", "IBMid-new/user", "iam-Profile-new/profile"}}))
+
+		summary := []string{}
+		for _, result := range results {
+			summary = append(summary, fmt.Sprintf("%s:%s:%t", result.IamID, result.Action, result.Succeeded()))
+		}
+		Expect(summary).To(Equal([]string{
+			"IBMid-bad:added:false",
+			"IBMid-keep:unchanged:true",
+			"IBMid-new:added:true",
+			"IBMid-old:removed:true",
+			"iam-Profile-new:added:true",
+			"iam-ServiceId-old:removed:true",
+		}))
+	})
+	It(`Makes no changes when membership already matches`, func() {
+		results, err := newService().SyncGroupMembers(context.Background(), "group1",
+			[]string{"iam-ServiceId-old", "IBMid-old", "IBMid-keep"})
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(3))
+		Expect(addRequests).To(BeEmpty())
+		Expect(removeRequests).To(BeEmpty())
+	})
+	It(`Checks the account of the group against the account context`, func() {
+		ctx := common.WithAccountContext(context.Background(), &common.AccountContext{AccountID: "account1"})
+		results, err := newService().SyncGroupMembers(ctx, "group1", []string{"iam-ServiceId-old", "IBMid-old", "IBMid-keep"})
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(3))
+
+		ctx = common.WithAccountContext(context.Background(), &common.AccountContext{AccountID: "account2"})
+		_, err = newService().SyncGroupMembers(ctx, "group1", []string{"IBMid-new"})
+		var mismatch *common.AccountMismatchError
+		Expect(errors.As(err, &mismatch)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("access group 'group1' is not in the account of the context"))
+		Expect(addRequests).To(BeEmpty())
+		Expect(removeRequests).To(BeEmpty())
+	})
+	It(`Requires a group ID`, func() {
+		_, err := newService().SyncGroupMembers(context.Background(), "", nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Infers member types from IAM IDs`, func() {
+		Expect(iamaccessgroupsv2.MemberTypeForIamID("IBMid-123")).To(Equal(iamaccessgroupsv2.MemberTypeUserConst))
+		Expect(iamaccessgroupsv2.MemberTypeForIamID("iam-ServiceId-123")).To(Equal(iamaccessgroupsv2.MemberTypeServiceConst))
+		Expect(iamaccessgroupsv2.MemberTypeForIamID("iam-Profile-123")).To(Equal(iamaccessgroupsv2.MemberTypeProfileConst))
+	})
+})
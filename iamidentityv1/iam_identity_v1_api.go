@@ -0,0 +1,617 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package iamidentityv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// IamIdentityV1API : The operations of the IamIdentityV1 service, implemented by *IamIdentityV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type IamIdentityV1API interface {
+	// Clone makes a copy of "iamIdentity" suitable for processing requests.
+	Clone() *IamIdentityV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// ListAPIKeys : Get API keys for a given service or user IAM ID and account ID
+	ListAPIKeys(listAPIKeysOptions *ListAPIKeysOptions) (result *APIKeyList, response *core.DetailedResponse, err error)
+
+	// ListAPIKeysWithContext is an alternate form of the ListAPIKeys method which supports a Context parameter
+	ListAPIKeysWithContext(ctx context.Context, listAPIKeysOptions *ListAPIKeysOptions) (result *APIKeyList, response *core.DetailedResponse, err error)
+
+	// CreateAPIKey : Create an API key
+	CreateAPIKey(createAPIKeyOptions *CreateAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// CreateAPIKeyWithContext is an alternate form of the CreateAPIKey method which supports a Context parameter
+	CreateAPIKeyWithContext(ctx context.Context, createAPIKeyOptions *CreateAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// GetAPIKeysDetails : Get details of an API key by its value
+	GetAPIKeysDetails(getAPIKeysDetailsOptions *GetAPIKeysDetailsOptions) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// GetAPIKeysDetailsWithContext is an alternate form of the GetAPIKeysDetails method which supports a Context
+	// parameter
+	GetAPIKeysDetailsWithContext(ctx context.Context, getAPIKeysDetailsOptions *GetAPIKeysDetailsOptions) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// GetAPIKey : Get details of an API key
+	GetAPIKey(getAPIKeyOptions *GetAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// GetAPIKeyWithContext is an alternate form of the GetAPIKey method which supports a Context parameter
+	GetAPIKeyWithContext(ctx context.Context, getAPIKeyOptions *GetAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// UpdateAPIKey : Updates an API key
+	UpdateAPIKey(updateAPIKeyOptions *UpdateAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// UpdateAPIKeyWithContext is an alternate form of the UpdateAPIKey method which supports a Context parameter
+	UpdateAPIKeyWithContext(ctx context.Context, updateAPIKeyOptions *UpdateAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// DeleteAPIKey : Deletes an API key
+	DeleteAPIKey(deleteAPIKeyOptions *DeleteAPIKeyOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteAPIKeyWithContext is an alternate form of the DeleteAPIKey method which supports a Context parameter
+	DeleteAPIKeyWithContext(ctx context.Context, deleteAPIKeyOptions *DeleteAPIKeyOptions) (response *core.DetailedResponse, err error)
+
+	// LockAPIKey : Lock the API key
+	LockAPIKey(lockAPIKeyOptions *LockAPIKeyOptions) (response *core.DetailedResponse, err error)
+
+	// LockAPIKeyWithContext is an alternate form of the LockAPIKey method which supports a Context parameter
+	LockAPIKeyWithContext(ctx context.Context, lockAPIKeyOptions *LockAPIKeyOptions) (response *core.DetailedResponse, err error)
+
+	// UnlockAPIKey : Unlock the API key
+	UnlockAPIKey(unlockAPIKeyOptions *UnlockAPIKeyOptions) (response *core.DetailedResponse, err error)
+
+	// UnlockAPIKeyWithContext is an alternate form of the UnlockAPIKey method which supports a Context parameter
+	UnlockAPIKeyWithContext(ctx context.Context, unlockAPIKeyOptions *UnlockAPIKeyOptions) (response *core.DetailedResponse, err error)
+
+	// ListServiceIds : List service IDs
+	ListServiceIds(listServiceIdsOptions *ListServiceIdsOptions) (result *ServiceIDList, response *core.DetailedResponse, err error)
+
+	// ListServiceIdsWithContext is an alternate form of the ListServiceIds method which supports a Context parameter
+	ListServiceIdsWithContext(ctx context.Context, listServiceIdsOptions *ListServiceIdsOptions) (result *ServiceIDList, response *core.DetailedResponse, err error)
+
+	// CreateServiceID : Create a service ID
+	CreateServiceID(createServiceIDOptions *CreateServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+
+	// CreateServiceIDWithContext is an alternate form of the CreateServiceID method which supports a Context parameter
+	CreateServiceIDWithContext(ctx context.Context, createServiceIDOptions *CreateServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+
+	// GetServiceID : Get details of a service ID
+	GetServiceID(getServiceIDOptions *GetServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+
+	// GetServiceIDWithContext is an alternate form of the GetServiceID method which supports a Context parameter
+	GetServiceIDWithContext(ctx context.Context, getServiceIDOptions *GetServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+
+	// UpdateServiceID : Update service ID
+	UpdateServiceID(updateServiceIDOptions *UpdateServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+
+	// UpdateServiceIDWithContext is an alternate form of the UpdateServiceID method which supports a Context parameter
+	UpdateServiceIDWithContext(ctx context.Context, updateServiceIDOptions *UpdateServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+
+	// DeleteServiceID : Deletes a service ID and associated API keys
+	DeleteServiceID(deleteServiceIDOptions *DeleteServiceIDOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteServiceIDWithContext is an alternate form of the DeleteServiceID method which supports a Context parameter
+	DeleteServiceIDWithContext(ctx context.Context, deleteServiceIDOptions *DeleteServiceIDOptions) (response *core.DetailedResponse, err error)
+
+	// LockServiceID : Lock the service ID
+	LockServiceID(lockServiceIDOptions *LockServiceIDOptions) (response *core.DetailedResponse, err error)
+
+	// LockServiceIDWithContext is an alternate form of the LockServiceID method which supports a Context parameter
+	LockServiceIDWithContext(ctx context.Context, lockServiceIDOptions *LockServiceIDOptions) (response *core.DetailedResponse, err error)
+
+	// UnlockServiceID : Unlock the service ID
+	UnlockServiceID(unlockServiceIDOptions *UnlockServiceIDOptions) (response *core.DetailedResponse, err error)
+
+	// UnlockServiceIDWithContext is an alternate form of the UnlockServiceID method which supports a Context parameter
+	UnlockServiceIDWithContext(ctx context.Context, unlockServiceIDOptions *UnlockServiceIDOptions) (response *core.DetailedResponse, err error)
+
+	// CreateProfile : Create a trusted profile
+	CreateProfile(createProfileOptions *CreateProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+
+	// CreateProfileWithContext is an alternate form of the CreateProfile method which supports a Context parameter
+	CreateProfileWithContext(ctx context.Context, createProfileOptions *CreateProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+
+	// ListProfiles : List trusted profiles
+	ListProfiles(listProfilesOptions *ListProfilesOptions) (result *TrustedProfilesList, response *core.DetailedResponse, err error)
+
+	// ListProfilesWithContext is an alternate form of the ListProfiles method which supports a Context parameter
+	ListProfilesWithContext(ctx context.Context, listProfilesOptions *ListProfilesOptions) (result *TrustedProfilesList, response *core.DetailedResponse, err error)
+
+	// GetProfile : Get a trusted profile
+	GetProfile(getProfileOptions *GetProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+
+	// GetProfileWithContext is an alternate form of the GetProfile method which supports a Context parameter
+	GetProfileWithContext(ctx context.Context, getProfileOptions *GetProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+
+	// UpdateProfile : Update a trusted profile
+	UpdateProfile(updateProfileOptions *UpdateProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+
+	// UpdateProfileWithContext is an alternate form of the UpdateProfile method which supports a Context parameter
+	UpdateProfileWithContext(ctx context.Context, updateProfileOptions *UpdateProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+
+	// DeleteProfile : Delete a trusted profile
+	DeleteProfile(deleteProfileOptions *DeleteProfileOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteProfileWithContext is an alternate form of the DeleteProfile method which supports a Context parameter
+	DeleteProfileWithContext(ctx context.Context, deleteProfileOptions *DeleteProfileOptions) (response *core.DetailedResponse, err error)
+
+	// CreateClaimRule : Create claim rule for a trusted profile
+	CreateClaimRule(createClaimRuleOptions *CreateClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+
+	// CreateClaimRuleWithContext is an alternate form of the CreateClaimRule method which supports a Context parameter
+	CreateClaimRuleWithContext(ctx context.Context, createClaimRuleOptions *CreateClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+
+	// ListClaimRules : List claim rules for a trusted profile
+	ListClaimRules(listClaimRulesOptions *ListClaimRulesOptions) (result *ProfileClaimRuleList, response *core.DetailedResponse, err error)
+
+	// ListClaimRulesWithContext is an alternate form of the ListClaimRules method which supports a Context parameter
+	ListClaimRulesWithContext(ctx context.Context, listClaimRulesOptions *ListClaimRulesOptions) (result *ProfileClaimRuleList, response *core.DetailedResponse, err error)
+
+	// GetClaimRule : Get a claim rule for a trusted profile
+	GetClaimRule(getClaimRuleOptions *GetClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+
+	// GetClaimRuleWithContext is an alternate form of the GetClaimRule method which supports a Context parameter
+	GetClaimRuleWithContext(ctx context.Context, getClaimRuleOptions *GetClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+
+	// UpdateClaimRule : Update claim rule for a trusted profile
+	UpdateClaimRule(updateClaimRuleOptions *UpdateClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+
+	// UpdateClaimRuleWithContext is an alternate form of the UpdateClaimRule method which supports a Context parameter
+	UpdateClaimRuleWithContext(ctx context.Context, updateClaimRuleOptions *UpdateClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+
+	// DeleteClaimRule : Delete a claim rule
+	DeleteClaimRule(deleteClaimRuleOptions *DeleteClaimRuleOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteClaimRuleWithContext is an alternate form of the DeleteClaimRule method which supports a Context parameter
+	DeleteClaimRuleWithContext(ctx context.Context, deleteClaimRuleOptions *DeleteClaimRuleOptions) (response *core.DetailedResponse, err error)
+
+	// CreateLink : Create link to a trusted profile
+	CreateLink(createLinkOptions *CreateLinkOptions) (result *ProfileLink, response *core.DetailedResponse, err error)
+
+	// CreateLinkWithContext is an alternate form of the CreateLink method which supports a Context parameter
+	CreateLinkWithContext(ctx context.Context, createLinkOptions *CreateLinkOptions) (result *ProfileLink, response *core.DetailedResponse, err error)
+
+	// ListLinks : List links to a trusted profile
+	ListLinks(listLinksOptions *ListLinksOptions) (result *ProfileLinkList, response *core.DetailedResponse, err error)
+
+	// ListLinksWithContext is an alternate form of the ListLinks method which supports a Context parameter
+	ListLinksWithContext(ctx context.Context, listLinksOptions *ListLinksOptions) (result *ProfileLinkList, response *core.DetailedResponse, err error)
+
+	// GetLink : Get link to a trusted profile
+	GetLink(getLinkOptions *GetLinkOptions) (result *ProfileLink, response *core.DetailedResponse, err error)
+
+	// GetLinkWithContext is an alternate form of the GetLink method which supports a Context parameter
+	GetLinkWithContext(ctx context.Context, getLinkOptions *GetLinkOptions) (result *ProfileLink, response *core.DetailedResponse, err error)
+
+	// DeleteLink : Delete link to a trusted profile
+	DeleteLink(deleteLinkOptions *DeleteLinkOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteLinkWithContext is an alternate form of the DeleteLink method which supports a Context parameter
+	DeleteLinkWithContext(ctx context.Context, deleteLinkOptions *DeleteLinkOptions) (response *core.DetailedResponse, err error)
+
+	// GetAccountSettings : Get account configurations
+	GetAccountSettings(getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettingsResponse, response *core.DetailedResponse, err error)
+
+	// GetAccountSettingsWithContext is an alternate form of the GetAccountSettings method which supports a Context
+	// parameter
+	GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettingsResponse, response *core.DetailedResponse, err error)
+
+	// UpdateAccountSettings : Update account configurations
+	UpdateAccountSettings(updateAccountSettingsOptions *UpdateAccountSettingsOptions) (result *AccountSettingsResponse, response *core.DetailedResponse, err error)
+
+	// UpdateAccountSettingsWithContext is an alternate form of the UpdateAccountSettings method which supports a
+	// Context parameter
+	UpdateAccountSettingsWithContext(ctx context.Context, updateAccountSettingsOptions *UpdateAccountSettingsOptions) (result *AccountSettingsResponse, response *core.DetailedResponse, err error)
+
+	// CreateReport : Trigger activity report across on account scope
+	CreateReport(createReportOptions *CreateReportOptions) (result *ReportReference, response *core.DetailedResponse, err error)
+
+	// CreateReportWithContext is an alternate form of the CreateReport method which supports a Context parameter
+	CreateReportWithContext(ctx context.Context, createReportOptions *CreateReportOptions) (result *ReportReference, response *core.DetailedResponse, err error)
+
+	// GetReport : Get activity report across on account scope
+	GetReport(getReportOptions *GetReportOptions) (result *Report, response *core.DetailedResponse, err error)
+
+	// GetReportWithContext is an alternate form of the GetReport method which supports a Context parameter
+	GetReportWithContext(ctx context.Context, getReportOptions *GetReportOptions) (result *Report, response *core.DetailedResponse, err error)
+
+	// NewAPIKeyInsideCreateServiceIDRequest : Instantiate APIKeyInsideCreateServiceIDRequest (Generic Model
+	// Constructor)
+	NewAPIKeyInsideCreateServiceIDRequest(name string) (_model *APIKeyInsideCreateServiceIDRequest, err error)
+
+	// NewCreateAPIKeyOptions : Instantiate CreateAPIKeyOptions
+	NewCreateAPIKeyOptions(name string, iamID string) *CreateAPIKeyOptions
+
+	// NewCreateClaimRuleOptions : Instantiate CreateClaimRuleOptions
+	NewCreateClaimRuleOptions(profileID string, typeVar string, conditions []ProfileClaimRuleConditions) *CreateClaimRuleOptions
+
+	// NewCreateLinkOptions : Instantiate CreateLinkOptions
+	NewCreateLinkOptions(profileID string, crType string, link *CreateProfileLinkRequestLink) *CreateLinkOptions
+
+	// NewCreateProfileLinkRequestLink : Instantiate CreateProfileLinkRequestLink (Generic Model Constructor)
+	NewCreateProfileLinkRequestLink(crn string, namespace string) (_model *CreateProfileLinkRequestLink, err error)
+
+	// NewCreateProfileOptions : Instantiate CreateProfileOptions
+	NewCreateProfileOptions(name string, accountID string) *CreateProfileOptions
+
+	// NewCreateReportOptions : Instantiate CreateReportOptions
+	NewCreateReportOptions(accountID string) *CreateReportOptions
+
+	// NewCreateServiceIDOptions : Instantiate CreateServiceIDOptions
+	NewCreateServiceIDOptions(accountID string, name string) *CreateServiceIDOptions
+
+	// NewDeleteAPIKeyOptions : Instantiate DeleteAPIKeyOptions
+	NewDeleteAPIKeyOptions(id string) *DeleteAPIKeyOptions
+
+	// NewDeleteClaimRuleOptions : Instantiate DeleteClaimRuleOptions
+	NewDeleteClaimRuleOptions(profileID string, ruleID string) *DeleteClaimRuleOptions
+
+	// NewDeleteLinkOptions : Instantiate DeleteLinkOptions
+	NewDeleteLinkOptions(profileID string, linkID string) *DeleteLinkOptions
+
+	// NewDeleteProfileOptions : Instantiate DeleteProfileOptions
+	NewDeleteProfileOptions(profileID string) *DeleteProfileOptions
+
+	// NewDeleteServiceIDOptions : Instantiate DeleteServiceIDOptions
+	NewDeleteServiceIDOptions(id string) *DeleteServiceIDOptions
+
+	// NewGetAccountSettingsOptions : Instantiate GetAccountSettingsOptions
+	NewGetAccountSettingsOptions(accountID string) *GetAccountSettingsOptions
+
+	// NewGetAPIKeyOptions : Instantiate GetAPIKeyOptions
+	NewGetAPIKeyOptions(id string) *GetAPIKeyOptions
+
+	// NewGetAPIKeysDetailsOptions : Instantiate GetAPIKeysDetailsOptions
+	NewGetAPIKeysDetailsOptions() *GetAPIKeysDetailsOptions
+
+	// NewGetClaimRuleOptions : Instantiate GetClaimRuleOptions
+	NewGetClaimRuleOptions(profileID string, ruleID string) *GetClaimRuleOptions
+
+	// NewGetLinkOptions : Instantiate GetLinkOptions
+	NewGetLinkOptions(profileID string, linkID string) *GetLinkOptions
+
+	// NewGetProfileOptions : Instantiate GetProfileOptions
+	NewGetProfileOptions(profileID string) *GetProfileOptions
+
+	// NewGetReportOptions : Instantiate GetReportOptions
+	NewGetReportOptions(accountID string, reference string) *GetReportOptions
+
+	// NewGetServiceIDOptions : Instantiate GetServiceIDOptions
+	NewGetServiceIDOptions(id string) *GetServiceIDOptions
+
+	// NewListAPIKeysOptions : Instantiate ListAPIKeysOptions
+	NewListAPIKeysOptions() *ListAPIKeysOptions
+
+	// NewListClaimRulesOptions : Instantiate ListClaimRulesOptions
+	NewListClaimRulesOptions(profileID string) *ListClaimRulesOptions
+
+	// NewListLinksOptions : Instantiate ListLinksOptions
+	NewListLinksOptions(profileID string) *ListLinksOptions
+
+	// NewListProfilesOptions : Instantiate ListProfilesOptions
+	NewListProfilesOptions(accountID string) *ListProfilesOptions
+
+	// NewListServiceIdsOptions : Instantiate ListServiceIdsOptions
+	NewListServiceIdsOptions() *ListServiceIdsOptions
+
+	// NewLockAPIKeyOptions : Instantiate LockAPIKeyOptions
+	NewLockAPIKeyOptions(id string) *LockAPIKeyOptions
+
+	// NewLockServiceIDOptions : Instantiate LockServiceIDOptions
+	NewLockServiceIDOptions(id string) *LockServiceIDOptions
+
+	// NewProfileClaimRuleConditions : Instantiate ProfileClaimRuleConditions (Generic Model Constructor)
+	NewProfileClaimRuleConditions(claim string, operator string, value string) (_model *ProfileClaimRuleConditions, err error)
+
+	// NewUnlockAPIKeyOptions : Instantiate UnlockAPIKeyOptions
+	NewUnlockAPIKeyOptions(id string) *UnlockAPIKeyOptions
+
+	// NewUnlockServiceIDOptions : Instantiate UnlockServiceIDOptions
+	NewUnlockServiceIDOptions(id string) *UnlockServiceIDOptions
+
+	// NewUpdateAccountSettingsOptions : Instantiate UpdateAccountSettingsOptions
+	NewUpdateAccountSettingsOptions(ifMatch string, accountID string) *UpdateAccountSettingsOptions
+
+	// NewUpdateAPIKeyOptions : Instantiate UpdateAPIKeyOptions
+	NewUpdateAPIKeyOptions(id string, ifMatch string) *UpdateAPIKeyOptions
+
+	// NewUpdateClaimRuleOptions : Instantiate UpdateClaimRuleOptions
+	NewUpdateClaimRuleOptions(profileID string, ruleID string, ifMatch string, typeVar string, conditions []ProfileClaimRuleConditions) *UpdateClaimRuleOptions
+
+	// NewUpdateProfileOptions : Instantiate UpdateProfileOptions
+	NewUpdateProfileOptions(profileID string, ifMatch string) *UpdateProfileOptions
+
+	// NewUpdateServiceIDOptions : Instantiate UpdateServiceIDOptions
+	NewUpdateServiceIDOptions(id string, ifMatch string) *UpdateServiceIDOptions
+
+	// InactivityReport creates a report of the identities in the account that have not authenticated in the last
+	// "days" days, and waits for it to complete, polling it every "pollInterval" (DefaultInactivityReportPollInterval
+	// if zero).
+	InactivityReport(ctx context.Context, accountID string, days int, pollInterval time.Duration) (report *Report, err error)
+
+	// NewListAPIKeysOptionsWith returns ListAPIKeysOptions populated by applying "options" in order.
+	NewListAPIKeysOptionsWith(options ...ListAPIKeysOption) *ListAPIKeysOptions
+
+	// ListAPIKeysWith is an alternate form of the ListAPIKeysWithContext method which takes functional options instead
+	// of a ListAPIKeysOptions struct.
+	ListAPIKeysWith(ctx context.Context, options ...ListAPIKeysOption) (result *APIKeyList, response *core.DetailedResponse, err error)
+
+	// NewCreateAPIKeyOptionsWith returns CreateAPIKeyOptions populated by applying "options" in order.
+	NewCreateAPIKeyOptionsWith(options ...CreateAPIKeyOption) *CreateAPIKeyOptions
+
+	// CreateAPIKeyWith is an alternate form of the CreateAPIKeyWithContext method which takes functional options
+	// instead of a CreateAPIKeyOptions struct.
+	CreateAPIKeyWith(ctx context.Context, options ...CreateAPIKeyOption) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// NewGetAPIKeysDetailsOptionsWith returns GetAPIKeysDetailsOptions populated by applying "options" in order.
+	NewGetAPIKeysDetailsOptionsWith(options ...GetAPIKeysDetailsOption) *GetAPIKeysDetailsOptions
+
+	// GetAPIKeysDetailsWith is an alternate form of the GetAPIKeysDetailsWithContext method which takes functional
+	// options instead of a GetAPIKeysDetailsOptions struct.
+	GetAPIKeysDetailsWith(ctx context.Context, options ...GetAPIKeysDetailsOption) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// NewGetAPIKeyOptionsWith returns GetAPIKeyOptions populated by applying "options" in order.
+	NewGetAPIKeyOptionsWith(options ...GetAPIKeyOption) *GetAPIKeyOptions
+
+	// GetAPIKeyWith is an alternate form of the GetAPIKeyWithContext method which takes functional options instead of
+	// a GetAPIKeyOptions struct.
+	GetAPIKeyWith(ctx context.Context, options ...GetAPIKeyOption) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// NewUpdateAPIKeyOptionsWith returns UpdateAPIKeyOptions populated by applying "options" in order.
+	NewUpdateAPIKeyOptionsWith(options ...UpdateAPIKeyOption) *UpdateAPIKeyOptions
+
+	// UpdateAPIKeyWith is an alternate form of the UpdateAPIKeyWithContext method which takes functional options
+	// instead of an UpdateAPIKeyOptions struct.
+	UpdateAPIKeyWith(ctx context.Context, options ...UpdateAPIKeyOption) (result *APIKey, response *core.DetailedResponse, err error)
+
+	// NewDeleteAPIKeyOptionsWith returns DeleteAPIKeyOptions populated by applying "options" in order.
+	NewDeleteAPIKeyOptionsWith(options ...DeleteAPIKeyOption) *DeleteAPIKeyOptions
+
+	// DeleteAPIKeyWith is an alternate form of the DeleteAPIKeyWithContext method which takes functional options
+	// instead of a DeleteAPIKeyOptions struct.
+	DeleteAPIKeyWith(ctx context.Context, options ...DeleteAPIKeyOption) (response *core.DetailedResponse, err error)
+
+	// NewLockAPIKeyOptionsWith returns LockAPIKeyOptions populated by applying "options" in order.
+	NewLockAPIKeyOptionsWith(options ...LockAPIKeyOption) *LockAPIKeyOptions
+
+	// LockAPIKeyWith is an alternate form of the LockAPIKeyWithContext method which takes functional options instead
+	// of a LockAPIKeyOptions struct.
+	LockAPIKeyWith(ctx context.Context, options ...LockAPIKeyOption) (response *core.DetailedResponse, err error)
+
+	// NewUnlockAPIKeyOptionsWith returns UnlockAPIKeyOptions populated by applying "options" in order.
+	NewUnlockAPIKeyOptionsWith(options ...UnlockAPIKeyOption) *UnlockAPIKeyOptions
+
+	// UnlockAPIKeyWith is an alternate form of the UnlockAPIKeyWithContext method which takes functional options
+	// instead of an UnlockAPIKeyOptions struct.
+	UnlockAPIKeyWith(ctx context.Context, options ...UnlockAPIKeyOption) (response *core.DetailedResponse, err error)
+
+	// NewListServiceIdsOptionsWith returns ListServiceIdsOptions populated by applying "options" in order.
+	NewListServiceIdsOptionsWith(options ...ListServiceIdsOption) *ListServiceIdsOptions
+
+	// ListServiceIdsWith is an alternate form of the ListServiceIdsWithContext method which takes functional options
+	// instead of a ListServiceIdsOptions struct.
+	ListServiceIdsWith(ctx context.Context, options ...ListServiceIdsOption) (result *ServiceIDList, response *core.DetailedResponse, err error)
+
+	// NewCreateServiceIDOptionsWith returns CreateServiceIDOptions populated by applying "options" in order.
+	NewCreateServiceIDOptionsWith(options ...CreateServiceIDOption) *CreateServiceIDOptions
+
+	// CreateServiceIDWith is an alternate form of the CreateServiceIDWithContext method which takes functional options
+	// instead of a CreateServiceIDOptions struct.
+	CreateServiceIDWith(ctx context.Context, options ...CreateServiceIDOption) (result *ServiceID, response *core.DetailedResponse, err error)
+
+	// NewGetServiceIDOptionsWith returns GetServiceIDOptions populated by applying "options" in order.
+	NewGetServiceIDOptionsWith(options ...GetServiceIDOption) *GetServiceIDOptions
+
+	// GetServiceIDWith is an alternate form of the GetServiceIDWithContext method which takes functional options
+	// instead of a GetServiceIDOptions struct.
+	GetServiceIDWith(ctx context.Context, options ...GetServiceIDOption) (result *ServiceID, response *core.DetailedResponse, err error)
+
+	// NewUpdateServiceIDOptionsWith returns UpdateServiceIDOptions populated by applying "options" in order.
+	NewUpdateServiceIDOptionsWith(options ...UpdateServiceIDOption) *UpdateServiceIDOptions
+
+	// UpdateServiceIDWith is an alternate form of the UpdateServiceIDWithContext method which takes functional options
+	// instead of an UpdateServiceIDOptions struct.
+	UpdateServiceIDWith(ctx context.Context, options ...UpdateServiceIDOption) (result *ServiceID, response *core.DetailedResponse, err error)
+
+	// NewDeleteServiceIDOptionsWith returns DeleteServiceIDOptions populated by applying "options" in order.
+	NewDeleteServiceIDOptionsWith(options ...DeleteServiceIDOption) *DeleteServiceIDOptions
+
+	// DeleteServiceIDWith is an alternate form of the DeleteServiceIDWithContext method which takes functional options
+	// instead of a DeleteServiceIDOptions struct.
+	DeleteServiceIDWith(ctx context.Context, options ...DeleteServiceIDOption) (response *core.DetailedResponse, err error)
+
+	// NewLockServiceIDOptionsWith returns LockServiceIDOptions populated by applying "options" in order.
+	NewLockServiceIDOptionsWith(options ...LockServiceIDOption) *LockServiceIDOptions
+
+	// LockServiceIDWith is an alternate form of the LockServiceIDWithContext method which takes functional options
+	// instead of a LockServiceIDOptions struct.
+	LockServiceIDWith(ctx context.Context, options ...LockServiceIDOption) (response *core.DetailedResponse, err error)
+
+	// NewUnlockServiceIDOptionsWith returns UnlockServiceIDOptions populated by applying "options" in order.
+	NewUnlockServiceIDOptionsWith(options ...UnlockServiceIDOption) *UnlockServiceIDOptions
+
+	// UnlockServiceIDWith is an alternate form of the UnlockServiceIDWithContext method which takes functional options
+	// instead of an UnlockServiceIDOptions struct.
+	UnlockServiceIDWith(ctx context.Context, options ...UnlockServiceIDOption) (response *core.DetailedResponse, err error)
+
+	// NewCreateProfileOptionsWith returns CreateProfileOptions populated by applying "options" in order.
+	NewCreateProfileOptionsWith(options ...CreateProfileOption) *CreateProfileOptions
+
+	// CreateProfileWith is an alternate form of the CreateProfileWithContext method which takes functional options
+	// instead of a CreateProfileOptions struct.
+	CreateProfileWith(ctx context.Context, options ...CreateProfileOption) (result *TrustedProfile, response *core.DetailedResponse, err error)
+
+	// NewListProfilesOptionsWith returns ListProfilesOptions populated by applying "options" in order.
+	NewListProfilesOptionsWith(options ...ListProfilesOption) *ListProfilesOptions
+
+	// ListProfilesWith is an alternate form of the ListProfilesWithContext method which takes functional options
+	// instead of a ListProfilesOptions struct.
+	ListProfilesWith(ctx context.Context, options ...ListProfilesOption) (result *TrustedProfilesList, response *core.DetailedResponse, err error)
+
+	// NewGetProfileOptionsWith returns GetProfileOptions populated by applying "options" in order.
+	NewGetProfileOptionsWith(options ...GetProfileOption) *GetProfileOptions
+
+	// GetProfileWith is an alternate form of the GetProfileWithContext method which takes functional options instead
+	// of a GetProfileOptions struct.
+	GetProfileWith(ctx context.Context, options ...GetProfileOption) (result *TrustedProfile, response *core.DetailedResponse, err error)
+
+	// NewUpdateProfileOptionsWith returns UpdateProfileOptions populated by applying "options" in order.
+	NewUpdateProfileOptionsWith(options ...UpdateProfileOption) *UpdateProfileOptions
+
+	// UpdateProfileWith is an alternate form of the UpdateProfileWithContext method which takes functional options
+	// instead of an UpdateProfileOptions struct.
+	UpdateProfileWith(ctx context.Context, options ...UpdateProfileOption) (result *TrustedProfile, response *core.DetailedResponse, err error)
+
+	// NewDeleteProfileOptionsWith returns DeleteProfileOptions populated by applying "options" in order.
+	NewDeleteProfileOptionsWith(options ...DeleteProfileOption) *DeleteProfileOptions
+
+	// DeleteProfileWith is an alternate form of the DeleteProfileWithContext method which takes functional options
+	// instead of a DeleteProfileOptions struct.
+	DeleteProfileWith(ctx context.Context, options ...DeleteProfileOption) (response *core.DetailedResponse, err error)
+
+	// NewCreateClaimRuleOptionsWith returns CreateClaimRuleOptions populated by applying "options" in order.
+	NewCreateClaimRuleOptionsWith(options ...CreateClaimRuleOption) *CreateClaimRuleOptions
+
+	// CreateClaimRuleWith is an alternate form of the CreateClaimRuleWithContext method which takes functional options
+	// instead of a CreateClaimRuleOptions struct.
+	CreateClaimRuleWith(ctx context.Context, options ...CreateClaimRuleOption) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+
+	// NewListClaimRulesOptionsWith returns ListClaimRulesOptions populated by applying "options" in order.
+	NewListClaimRulesOptionsWith(options ...ListClaimRulesOption) *ListClaimRulesOptions
+
+	// ListClaimRulesWith is an alternate form of the ListClaimRulesWithContext method which takes functional options
+	// instead of a ListClaimRulesOptions struct.
+	ListClaimRulesWith(ctx context.Context, options ...ListClaimRulesOption) (result *ProfileClaimRuleList, response *core.DetailedResponse, err error)
+
+	// NewGetClaimRuleOptionsWith returns GetClaimRuleOptions populated by applying "options" in order.
+	NewGetClaimRuleOptionsWith(options ...GetClaimRuleOption) *GetClaimRuleOptions
+
+	// GetClaimRuleWith is an alternate form of the GetClaimRuleWithContext method which takes functional options
+	// instead of a GetClaimRuleOptions struct.
+	GetClaimRuleWith(ctx context.Context, options ...GetClaimRuleOption) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+
+	// NewUpdateClaimRuleOptionsWith returns UpdateClaimRuleOptions populated by applying "options" in order.
+	NewUpdateClaimRuleOptionsWith(options ...UpdateClaimRuleOption) *UpdateClaimRuleOptions
+
+	// UpdateClaimRuleWith is an alternate form of the UpdateClaimRuleWithContext method which takes functional options
+	// instead of an UpdateClaimRuleOptions struct.
+	UpdateClaimRuleWith(ctx context.Context, options ...UpdateClaimRuleOption) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+
+	// NewDeleteClaimRuleOptionsWith returns DeleteClaimRuleOptions populated by applying "options" in order.
+	NewDeleteClaimRuleOptionsWith(options ...DeleteClaimRuleOption) *DeleteClaimRuleOptions
+
+	// DeleteClaimRuleWith is an alternate form of the DeleteClaimRuleWithContext method which takes functional options
+	// instead of a DeleteClaimRuleOptions struct.
+	DeleteClaimRuleWith(ctx context.Context, options ...DeleteClaimRuleOption) (response *core.DetailedResponse, err error)
+
+	// NewCreateLinkOptionsWith returns CreateLinkOptions populated by applying "options" in order.
+	NewCreateLinkOptionsWith(options ...CreateLinkOption) *CreateLinkOptions
+
+	// CreateLinkWith is an alternate form of the CreateLinkWithContext method which takes functional options instead
+	// of a CreateLinkOptions struct.
+	CreateLinkWith(ctx context.Context, options ...CreateLinkOption) (result *ProfileLink, response *core.DetailedResponse, err error)
+
+	// NewListLinksOptionsWith returns ListLinksOptions populated by applying "options" in order.
+	NewListLinksOptionsWith(options ...ListLinksOption) *ListLinksOptions
+
+	// ListLinksWith is an alternate form of the ListLinksWithContext method which takes functional options instead of
+	// a ListLinksOptions struct.
+	ListLinksWith(ctx context.Context, options ...ListLinksOption) (result *ProfileLinkList, response *core.DetailedResponse, err error)
+
+	// NewGetLinkOptionsWith returns GetLinkOptions populated by applying "options" in order.
+	NewGetLinkOptionsWith(options ...GetLinkOption) *GetLinkOptions
+
+	// GetLinkWith is an alternate form of the GetLinkWithContext method which takes functional options instead of a
+	// GetLinkOptions struct.
+	GetLinkWith(ctx context.Context, options ...GetLinkOption) (result *ProfileLink, response *core.DetailedResponse, err error)
+
+	// NewDeleteLinkOptionsWith returns DeleteLinkOptions populated by applying "options" in order.
+	NewDeleteLinkOptionsWith(options ...DeleteLinkOption) *DeleteLinkOptions
+
+	// DeleteLinkWith is an alternate form of the DeleteLinkWithContext method which takes functional options instead
+	// of a DeleteLinkOptions struct.
+	DeleteLinkWith(ctx context.Context, options ...DeleteLinkOption) (response *core.DetailedResponse, err error)
+
+	// NewGetAccountSettingsOptionsWith returns GetAccountSettingsOptions populated by applying "options" in order.
+	NewGetAccountSettingsOptionsWith(options ...GetAccountSettingsOption) *GetAccountSettingsOptions
+
+	// GetAccountSettingsWith is an alternate form of the GetAccountSettingsWithContext method which takes functional
+	// options instead of a GetAccountSettingsOptions struct.
+	GetAccountSettingsWith(ctx context.Context, options ...GetAccountSettingsOption) (result *AccountSettingsResponse, response *core.DetailedResponse, err error)
+
+	// NewUpdateAccountSettingsOptionsWith returns UpdateAccountSettingsOptions populated by applying "options" in
+	// order.
+	NewUpdateAccountSettingsOptionsWith(options ...UpdateAccountSettingsOption) *UpdateAccountSettingsOptions
+
+	// UpdateAccountSettingsWith is an alternate form of the UpdateAccountSettingsWithContext method which takes
+	// functional options instead of an UpdateAccountSettingsOptions struct.
+	UpdateAccountSettingsWith(ctx context.Context, options ...UpdateAccountSettingsOption) (result *AccountSettingsResponse, response *core.DetailedResponse, err error)
+
+	// NewCreateReportOptionsWith returns CreateReportOptions populated by applying "options" in order.
+	NewCreateReportOptionsWith(options ...CreateReportOption) *CreateReportOptions
+
+	// CreateReportWith is an alternate form of the CreateReportWithContext method which takes functional options
+	// instead of a CreateReportOptions struct.
+	CreateReportWith(ctx context.Context, options ...CreateReportOption) (result *ReportReference, response *core.DetailedResponse, err error)
+
+	// NewGetReportOptionsWith returns GetReportOptions populated by applying "options" in order.
+	NewGetReportOptionsWith(options ...GetReportOption) *GetReportOptions
+
+	// GetReportWith is an alternate form of the GetReportWithContext method which takes functional options instead of
+	// a GetReportOptions struct.
+	GetReportWith(ctx context.Context, options ...GetReportOption) (result *Report, response *core.DetailedResponse, err error)
+
+	// ApplyProfileDefaults brings the claim rules and links of a trusted profile in line with "defaults".
+	ApplyProfileDefaults(ctx context.Context, profileID string, defaults *ProfileDefaults) (result *ProfileDefaultsResult, err error)
+
+	// CreateAPIKeyToSecretStore creates an API key and stores its value with "writer" instead of returning it.
+	CreateAPIKeyToSecretStore(ctx context.Context, createAPIKeyOptions *CreateAPIKeyOptions, writer SecretWriter) (result *DeliveredAPIKey, err error)
+
+	// CreateServiceIDToSecretStore creates a service ID together with an API key (createServiceIDOptions.Apikey is
+	// required) and stores the value of the API key with "writer" instead of returning it.
+	CreateServiceIDToSecretStore(ctx context.Context, createServiceIDOptions *CreateServiceIDOptions, writer SecretWriter) (result *DeliveredServiceID, err error)
+}
+
+var _ IamIdentityV1API = (*IamIdentityV1)(nil)
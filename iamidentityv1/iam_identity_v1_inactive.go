@@ -0,0 +1,351 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamidentityv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// Constants associated with the InactiveIdentity.Type property.
+const (
+	InactiveIdentityTypeUserConst      = "user"
+	InactiveIdentityTypeServiceidConst = "serviceid"
+	InactiveIdentityTypeProfileConst   = "profile"
+)
+
+// DefaultInactivityReportPollInterval is the interval at which InactivityReport polls for the completion of the
+// inactivity report, if no interval is specified.
+const DefaultInactivityReportPollInterval = 2 * time.Second
+
+// InactiveIdentity : An identity that has not authenticated recently and is the subject of access policies.
+type InactiveIdentity struct {
+	// The IAM ID of the identity.
+	IamID string
+
+	// The name of the identity.
+	Name string
+
+	// The type of the identity.
+	Type string
+
+	// Time when the identity was last authenticated; empty if it has never authenticated.
+	LastAuthn string
+
+	// The IDs of the policies that grant the identity access to the service.
+	PolicyIDs []string
+
+	// The IDs of the access groups through which policies grant the identity access to the service, if any.
+	AccessGroupIDs []string
+
+	// The roles granted to the identity on the service.
+	Roles []string
+}
+
+// ServiceInactiveIdentities : The inactive identities that have been granted access to a service.
+type ServiceInactiveIdentities struct {
+	// The name of the service; empty for policies that are not restricted to a service.
+	ServiceName string
+
+	// The inactive identities, least recently authenticated first.
+	Identities []InactiveIdentity
+}
+
+// AccessGroupMembersResolver returns the IAM IDs of the identities that are members of the access group with the
+// specified ID. iamaccessgroupsv2.IamAccessGroupsV2.EffectiveMemberIamIDs can be used as an AccessGroupMembersResolver.
+type AccessGroupMembersResolver func(ctx context.Context, accessGroupID string) (iamIDs []string, err error)
+
+// InactivityReporter : Combines the inactivity reports of the IAM Identity service with the access policies of an
+// account, to report which services rely on identities that have not authenticated recently.
+type InactivityReporter struct {
+	*IamIdentityV1
+
+	// The client used to list the access policies of the account.
+	PolicyManagement *iampolicymanagementv1.IamPolicyManagementV1
+
+	// Resolves the members of the access groups to which policies are granted.
+	ResolveAccessGroupMembers AccessGroupMembersResolver
+
+	// The ID of the account. If empty, the account of the common.AccountContext of the context is used.
+	AccountID string
+
+	// The interval at which the inactivity report is polled until it completes. Defaults to
+	// DefaultInactivityReportPollInterval.
+	ReportPollInterval time.Duration
+}
+
+// NewInactivityReporter returns a new InactivityReporter that creates inactivity reports with "iamIdentity", lists
+// the policies of the account with "policyManagement" and resolves the members of access groups with
+// "resolveAccessGroupMembers".
+func NewInactivityReporter(iamIdentity *IamIdentityV1, policyManagement *iampolicymanagementv1.IamPolicyManagementV1, resolveAccessGroupMembers AccessGroupMembersResolver) *InactivityReporter {
+	return &InactivityReporter{
+		IamIdentityV1:             iamIdentity,
+		PolicyManagement:          policyManagement,
+		ResolveAccessGroupMembers: resolveAccessGroupMembers,
+	}
+}
+
+// InactiveIdentitiesByService generates an inactivity report for the account and combines it with the account's
+// access policies to list, for each service, the users, service IDs and trusted profiles with access to it that have
+// not authenticated in the last "days" days. The policies granted to access groups apply to the members of the groups.
+// Services with the most inactive identities are listed first, so the result can be used as a prioritized cleanup list.
+// Identities without any policy are not reported.
+func (reporter *InactivityReporter) InactiveIdentitiesByService(ctx context.Context, days int) (report []ServiceInactiveIdentities, err error) {
+	if days <= 0 {
+		err = fmt.Errorf("days must be greater than zero")
+		return
+	}
+	accountID, err := common.ResolveAccountID(ctx, reporter.AccountID)
+	if err != nil {
+		return
+	}
+	if accountID == "" {
+		err = fmt.Errorf("an account ID is required")
+		return
+	}
+
+	policies, err := reporter.PolicyManagement.ListAllPolicies(ctx,
+		reporter.PolicyManagement.NewListPoliciesOptions(accountID).SetType("access"))
+	if err != nil {
+		err = fmt.Errorf("error listing the access policies of account '%s': %w", accountID, err)
+		return
+	}
+	activity, err := reporter.InactivityReport(ctx, accountID, days, reporter.ReportPollInterval)
+	if err != nil {
+		return
+	}
+
+	inactive := map[string]InactiveIdentity{}
+	for _, user := range activity.Users {
+		if user.IamID != nil {
+			inactive[*user.IamID] = InactiveIdentity{
+				IamID:     *user.IamID,
+				Name:      core.StringNilMapper(user.Username),
+				Type:      InactiveIdentityTypeUserConst,
+				LastAuthn: core.StringNilMapper(user.LastAuthn),
+			}
+		}
+	}
+	for _, entities := range []struct {
+		identityType string
+		activity     []EntityActivity
+	}{
+		{InactiveIdentityTypeServiceidConst, activity.Serviceids},
+		{InactiveIdentityTypeProfileConst, activity.Profiles},
+	} {
+		for _, entity := range entities.activity {
+			if entity.ID == nil {
+				continue
+			}
+			iamID := entityIamID(*entity.ID)
+			inactive[iamID] = InactiveIdentity{
+				IamID:     iamID,
+				Name:      core.StringNilMapper(entity.Name),
+				Type:      entities.identityType,
+				LastAuthn: core.StringNilMapper(entity.LastAuthn),
+			}
+		}
+	}
+
+	// A grant is an identity to which a policy applies, directly or as a member of an access group.
+	type grant struct {
+		iamID   string
+		groupID string
+	}
+	groupMembers := map[string][]string{}
+	byService := map[string]map[string]*InactiveIdentity{}
+	for i := range policies {
+		policy := &policies[i]
+		iamIDs, groupIDs := policySubjects(policy)
+		var grants []grant
+		for _, iamID := range iamIDs {
+			grants = append(grants, grant{iamID: iamID})
+		}
+		for _, groupID := range groupIDs {
+			members, found := groupMembers[groupID]
+			if !found {
+				members, err = reporter.accessGroupMembers(ctx, core.StringNilMapper(policy.ID), groupID)
+				if err != nil {
+					return
+				}
+				groupMembers[groupID] = members
+			}
+			for _, iamID := range members {
+				grants = append(grants, grant{iamID: iamID, groupID: groupID})
+			}
+		}
+
+		for _, grant := range grants {
+			identity, ok := inactive[grant.iamID]
+			if !ok {
+				continue
+			}
+			for _, serviceName := range policyServiceNames(policy) {
+				identities := byService[serviceName]
+				if identities == nil {
+					identities = map[string]*InactiveIdentity{}
+					byService[serviceName] = identities
+				}
+				entry := identities[grant.iamID]
+				if entry == nil {
+					entry = &InactiveIdentity{IamID: identity.IamID, Name: identity.Name, Type: identity.Type, LastAuthn: identity.LastAuthn}
+					identities[grant.iamID] = entry
+				}
+				if policy.ID != nil && !containsString(entry.PolicyIDs, *policy.ID) {
+					entry.PolicyIDs = append(entry.PolicyIDs, *policy.ID)
+				}
+				if grant.groupID != "" && !containsString(entry.AccessGroupIDs, grant.groupID) {
+					entry.AccessGroupIDs = append(entry.AccessGroupIDs, grant.groupID)
+				}
+				for _, role := range policy.Roles {
+					if role.RoleID != nil && !containsString(entry.Roles, *role.RoleID) {
+						entry.Roles = append(entry.Roles, *role.RoleID)
+					}
+				}
+			}
+		}
+	}
+
+	for serviceName, identities := range byService {
+		service := ServiceInactiveIdentities{ServiceName: serviceName}
+		for _, identity := range identities {
+			service.Identities = append(service.Identities, *identity)
+		}
+		sort.Slice(service.Identities, func(i, j int) bool {
+			a, b := service.Identities[i], service.Identities[j]
+			if a.LastAuthn != b.LastAuthn {
+				// Identities that have never authenticated sort first, since "" is the smallest string.
+				return a.LastAuthn < b.LastAuthn
+			}
+			return a.IamID < b.IamID
+		})
+		report = append(report, service)
+	}
+	sort.Slice(report, func(i, j int) bool {
+		if len(report[i].Identities) != len(report[j].Identities) {
+			return len(report[i].Identities) > len(report[j].Identities)
+		}
+		return report[i].ServiceName < report[j].ServiceName
+	})
+	return
+}
+
+// InactivityReport creates a report of the identities in the account that have not authenticated in the last "days"
+// days, and waits for it to complete, polling it every "pollInterval" (DefaultInactivityReportPollInterval if zero).
+func (iamIdentity *IamIdentityV1) InactivityReport(ctx context.Context, accountID string, days int, pollInterval time.Duration) (report *Report, err error) {
+	createReportOptions := iamIdentity.NewCreateReportOptions(accountID).
+		SetType("inactive").
+		SetDuration(fmt.Sprint(days * 24))
+	reference, _, err := iamIdentity.CreateReportWithContext(ctx, createReportOptions)
+	if err != nil {
+		return
+	}
+	if reference == nil || reference.Reference == nil {
+		err = fmt.Errorf("the inactivity report of account '%s' has no reference", accountID)
+		return
+	}
+
+	if pollInterval <= 0 {
+		pollInterval = DefaultInactivityReportPollInterval
+	}
+	getReportOptions := iamIdentity.NewGetReportOptions(accountID, *reference.Reference)
+	for {
+		// The report has no content (status code 204) until it is complete.
+		report, _, err = iamIdentity.GetReportWithContext(ctx, getReportOptions)
+		if err != nil || report != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// entityIamID returns the IAM ID of a service ID or trusted profile given its ID.
+func entityIamID(id string) string {
+	if strings.HasPrefix(id, "iam-") {
+		return id
+	}
+	return "iam-" + id
+}
+
+// accessGroupMembers returns the IAM IDs of the members of an access group to which a policy is granted.
+func (reporter *InactivityReporter) accessGroupMembers(ctx context.Context, policyID string, groupID string) (iamIDs []string, err error) {
+	if reporter.ResolveAccessGroupMembers == nil {
+		err = fmt.Errorf("policy '%s' is granted to access group '%s', but no access group members resolver is set", policyID, groupID)
+		return
+	}
+	iamIDs, err = reporter.ResolveAccessGroupMembers(ctx, groupID)
+	if err != nil {
+		err = fmt.Errorf("error resolving the members of access group '%s': %w", groupID, err)
+	}
+	return
+}
+
+// policySubjects returns the IAM IDs and the access group IDs to which a policy is granted.
+func policySubjects(policy *iampolicymanagementv1.Policy) (iamIDs []string, accessGroupIDs []string) {
+	for _, subject := range policy.Subjects {
+		for _, attribute := range subject.Attributes {
+			if attribute.Name == nil || attribute.Value == nil {
+				continue
+			}
+			switch *attribute.Name {
+			case iampolicymanagementv1.PolicyAttributeIamIDConst:
+				iamIDs = append(iamIDs, *attribute.Value)
+			case iampolicymanagementv1.PolicyAttributeAccessGroupIDConst:
+				accessGroupIDs = append(accessGroupIDs, *attribute.Value)
+			}
+		}
+	}
+	return
+}
+
+// policyServiceNames returns the services to which a policy grants access, or "" if it is not restricted to a service.
+func policyServiceNames(policy *iampolicymanagementv1.Policy) (serviceNames []string) {
+	for _, resource := range policy.Resources {
+		serviceName := ""
+		for _, attribute := range resource.Attributes {
+			if attribute.Name != nil && *attribute.Name == iampolicymanagementv1.PolicyAttributeServiceNameConst && attribute.Value != nil {
+				serviceName = *attribute.Value
+			}
+		}
+		if !containsString(serviceNames, serviceName) {
+			serviceNames = append(serviceNames, serviceName)
+		}
+	}
+	return
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
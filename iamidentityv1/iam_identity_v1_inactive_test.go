@@ -0,0 +1,194 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamidentityv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const testInactivityReportJSON = `{
+	"created_by": "IBMid-admin", "reference": "ref1", "report_duration": "720",
+	"report_start_time": "2022-01-01", "report_end_time": "2022-01-31",
+	"users": [{"iam_id": "IBMid-old", "username": "old@example.com", "last_authn": "2021-06-01T00:00:00Z"}],
+	"serviceids": [
+		{"id": "ServiceId-never", "name": "deployer"},
+		{"id": "ServiceId-stale", "name": "backup", "last_authn": "2021-09-01T00:00:00Z"}
+	],
+	"profiles": [{"id": "Profile-unused", "name": "cluster"}]
+}`
+
+var _ = Describe(`IamIdentityV1 InactiveIdentitiesByService`, func() {
+	var testServer *httptest.Server
+	var reporter *iamidentityv1.InactivityReporter
+	var reportPolls int
+	var policies []iampolicymanagementv1.Policy
+	var reportReference string
+	BeforeEach(func() {
+		reportPolls = 0
+		policies = nil
+		reportReference = `"ref1"`
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v1/policies":
+				Expect(req.URL.Query().Get("account_id")).To(Equal("acct"))
+				Expect(req.URL.Query().Get("type")).To(Equal("access"))
+				Expect(json.NewEncoder(res).Encode(map[string]interface{}{"policies": policies})).To(Succeed())
+			case req.Method == "POST" && req.URL.EscapedPath() == "/v1/activity/accounts/acct/report":
+				Expect(req.URL.Query().Get("type")).To(Equal("inactive"))
+				Expect(req.URL.Query().Get("duration")).To(Equal("720"))
+				res.WriteHeader(202)
+				fmt.Fprintf(res, `{"account_id": "acct", "reference": %s}`, reportReference)
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v1/activity/accounts/acct/report/ref1":
+				reportPolls++
+				if reportPolls < 3 {
+					res.WriteHeader(204)
+					return
+				}
+				fmt.Fprint(res, testInactivityReportJSON)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		policyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		groupMembers := map[string][]string{"AccessGroupId-ops": {"IBMid-old", "IBMid-active"}}
+		reporter = iamidentityv1.NewInactivityReporter(iamIdentityService, policyManagementService,
+			func(ctx context.Context, accessGroupID string) ([]string, error) {
+				return groupMembers[accessGroupID], nil
+			})
+		reporter.AccountID = "acct"
+		reporter.ReportPollInterval = time.Millisecond
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newPolicy := func(id string, subject string, value string, serviceName string, role string) iampolicymanagementv1.Policy {
+		resource := iampolicymanagementv1.PolicyResource{
+			Attributes: []iampolicymanagementv1.ResourceAttribute{{Name: core.StringPtr("accountId"), Value: core.StringPtr("acct")}},
+		}
+		if serviceName != "" {
+			resource.Attributes = append(resource.Attributes, iampolicymanagementv1.ResourceAttribute{Name: core.StringPtr("serviceName"), Value: core.StringPtr(serviceName)})
+		}
+		return iampolicymanagementv1.Policy{
+			ID:   core.StringPtr(id),
+			Type: core.StringPtr("access"),
+			Subjects: []iampolicymanagementv1.PolicySubject{{
+				Attributes: []iampolicymanagementv1.SubjectAttribute{{Name: core.StringPtr(subject), Value: core.StringPtr(value)}},
+			}},
+			Roles:     []iampolicymanagementv1.PolicyRole{{RoleID: core.StringPtr(role)}},
+			Resources: []iampolicymanagementv1.PolicyResource{resource},
+		}
+	}
+
+	It(`Reports inactive identities grouped by service`, func() {
+		policies = []iampolicymanagementv1.Policy{
+			newPolicy("p1", "iam_id", "iam-ServiceId-stale", "cloud-object-storage", iampolicymanagementv1.RoleWriter),
+			newPolicy("p2", "iam_id", "iam-ServiceId-never", "cloud-object-storage", iampolicymanagementv1.RoleReader),
+			newPolicy("p3", "iam_id", "iam-ServiceId-stale", "cloud-object-storage", iampolicymanagementv1.RoleReader),
+			newPolicy("p4", "iam_id", "IBMid-old", "kms", iampolicymanagementv1.RoleManager),
+			newPolicy("p5", "iam_id", "iam-Profile-unused", "", iampolicymanagementv1.RoleViewer),
+			newPolicy("p6", "iam_id", "IBMid-active", "kms", iampolicymanagementv1.RoleManager),
+			newPolicy("p7", "access_group_id", "AccessGroupId-ops", "kms", iampolicymanagementv1.RoleWriter),
+			newPolicy("p8", "access_group_id", "AccessGroupId-ops", "is", iampolicymanagementv1.RoleViewer),
+		}
+		report, err := reporter.InactiveIdentitiesByService(context.Background(), 30)
+		Expect(err).To(BeNil())
+		Expect(reportPolls).To(Equal(3))
+
+		Expect(report).To(HaveLen(4))
+		Expect(report[0].ServiceName).To(Equal("cloud-object-storage"))
+		Expect(report[0].Identities).To(HaveLen(2))
+		Expect(report[0].Identities[0].IamID).To(Equal("iam-ServiceId-never"))
+		Expect(report[0].Identities[0].LastAuthn).To(BeEmpty())
+		stale := report[0].Identities[1]
+		Expect(stale.IamID).To(Equal("iam-ServiceId-stale"))
+		Expect(stale.Name).To(Equal("backup"))
+		Expect(stale.Type).To(Equal(iamidentityv1.InactiveIdentityTypeServiceidConst))
+		Expect(stale.PolicyIDs).To(Equal([]string{"p1", "p3"}))
+		Expect(stale.AccessGroupIDs).To(BeEmpty())
+		Expect(stale.Roles).To(Equal([]string{iampolicymanagementv1.RoleWriter, iampolicymanagementv1.RoleReader}))
+
+		Expect(report[1].ServiceName).To(Equal(""))
+		Expect(report[1].Identities[0].Type).To(Equal(iamidentityv1.InactiveIdentityTypeProfileConst))
+
+		// The policies granted to an access group apply to its inactive members.
+		Expect(report[2].ServiceName).To(Equal("is"))
+		Expect(report[2].Identities).To(HaveLen(1))
+		Expect(report[2].Identities[0].IamID).To(Equal("IBMid-old"))
+		Expect(report[2].Identities[0].AccessGroupIDs).To(Equal([]string{"AccessGroupId-ops"}))
+		Expect(report[3].ServiceName).To(Equal("kms"))
+		Expect(report[3].Identities).To(HaveLen(1))
+		old := report[3].Identities[0]
+		Expect(old.Name).To(Equal("old@example.com"))
+		Expect(old.PolicyIDs).To(Equal([]string{"p4", "p7"}))
+		Expect(old.AccessGroupIDs).To(Equal([]string{"AccessGroupId-ops"}))
+		Expect(old.Roles).To(Equal([]string{iampolicymanagementv1.RoleManager, iampolicymanagementv1.RoleWriter}))
+	})
+	It(`Requires a resolver for the policies of access groups`, func() {
+		policies = []iampolicymanagementv1.Policy{
+			newPolicy("p7", "access_group_id", "AccessGroupId-ops", "kms", iampolicymanagementv1.RoleWriter),
+		}
+		reporter.ResolveAccessGroupMembers = nil
+		_, err := reporter.InactiveIdentitiesByService(context.Background(), 30)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("policy 'p7' is granted to access group 'AccessGroupId-ops'"))
+	})
+	It(`Takes the account from the account context`, func() {
+		reporter.AccountID = ""
+		_, err := reporter.InactiveIdentitiesByService(context.Background(), 30)
+		Expect(err).ToNot(BeNil())
+
+		ctx := common.WithAccountContext(context.Background(), &common.AccountContext{AccountID: "acct"})
+		report, err := reporter.InactiveIdentitiesByService(ctx, 30)
+		Expect(err).To(BeNil())
+		Expect(report).To(BeEmpty())
+	})
+	It(`Rejects a report without a reference`, func() {
+		reportReference = "null"
+		_, err := reporter.InactiveIdentitiesByService(context.Background(), 30)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("has no reference"))
+	})
+	It(`Requires a positive number of days`, func() {
+		_, err := reporter.InactiveIdentitiesByService(context.Background(), 0)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,1863 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package iamidentityv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ListAPIKeysOption : A functional option that sets a property of ListAPIKeysOptions (see ListAPIKeysWith).
+type ListAPIKeysOption func(options *ListAPIKeysOptions)
+
+// ListAPIKeysAccountID sets the AccountID property of ListAPIKeysOptions. Account ID of the API keys to query.
+func ListAPIKeysAccountID(accountID string) ListAPIKeysOption {
+	return func(options *ListAPIKeysOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListAPIKeysIamID sets the IamID property of ListAPIKeysOptions. IAM ID of the API keys to be queried.
+func ListAPIKeysIamID(iamID string) ListAPIKeysOption {
+	return func(options *ListAPIKeysOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// ListAPIKeysPagesize sets the Pagesize property of ListAPIKeysOptions. Optional size of a single page.
+func ListAPIKeysPagesize(pagesize int64) ListAPIKeysOption {
+	return func(options *ListAPIKeysOptions) {
+		options.Pagesize = core.Int64Ptr(pagesize)
+	}
+}
+
+// ListAPIKeysPagetoken sets the Pagetoken property of ListAPIKeysOptions. Optional Prev or Next page token
+// returned from a previous query execution.
+func ListAPIKeysPagetoken(pagetoken string) ListAPIKeysOption {
+	return func(options *ListAPIKeysOptions) {
+		options.Pagetoken = core.StringPtr(pagetoken)
+	}
+}
+
+// ListAPIKeysScope sets the Scope property of ListAPIKeysOptions. Optional parameter to define the scope of the
+// queried API keys.
+func ListAPIKeysScope(scope string) ListAPIKeysOption {
+	return func(options *ListAPIKeysOptions) {
+		options.Scope = core.StringPtr(scope)
+	}
+}
+
+// ListAPIKeysType sets the Type property of ListAPIKeysOptions. Optional parameter to filter the type of the
+// queried API keys.
+func ListAPIKeysType(typeVar string) ListAPIKeysOption {
+	return func(options *ListAPIKeysOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// ListAPIKeysSort sets the Sort property of ListAPIKeysOptions. Optional sort property, valid values are name,
+// description, created_at and created_by.
+func ListAPIKeysSort(sort string) ListAPIKeysOption {
+	return func(options *ListAPIKeysOptions) {
+		options.Sort = core.StringPtr(sort)
+	}
+}
+
+// ListAPIKeysOrder sets the Order property of ListAPIKeysOptions. Optional sort order, valid values are asc and
+// desc.
+func ListAPIKeysOrder(order string) ListAPIKeysOption {
+	return func(options *ListAPIKeysOptions) {
+		options.Order = core.StringPtr(order)
+	}
+}
+
+// ListAPIKeysIncludeHistory sets the IncludeHistory property of ListAPIKeysOptions. Defines if the entity history
+// is included in the response.
+func ListAPIKeysIncludeHistory(includeHistory bool) ListAPIKeysOption {
+	return func(options *ListAPIKeysOptions) {
+		options.IncludeHistory = core.BoolPtr(includeHistory)
+	}
+}
+
+// ListAPIKeysHeader sets the Headers property of ListAPIKeysOptions. Allows users to set headers on API requests.
+func ListAPIKeysHeader(name string, value string) ListAPIKeysOption {
+	return func(options *ListAPIKeysOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListAPIKeysOptionsWith returns ListAPIKeysOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewListAPIKeysOptionsWith(options ...ListAPIKeysOption) *ListAPIKeysOptions {
+	listAPIKeysOptions := &ListAPIKeysOptions{}
+	for _, option := range options {
+		option(listAPIKeysOptions)
+	}
+	return listAPIKeysOptions
+}
+
+// ListAPIKeysWith is an alternate form of the ListAPIKeysWithContext method which takes functional options instead
+// of a ListAPIKeysOptions struct.
+func (iamIdentity *IamIdentityV1) ListAPIKeysWith(ctx context.Context, options ...ListAPIKeysOption) (result *APIKeyList, response *core.DetailedResponse, err error) {
+	return iamIdentity.ListAPIKeysWithContext(ctx, iamIdentity.NewListAPIKeysOptionsWith(options...))
+}
+
+// CreateAPIKeyOption : A functional option that sets a property of CreateAPIKeyOptions (see CreateAPIKeyWith).
+type CreateAPIKeyOption func(options *CreateAPIKeyOptions)
+
+// CreateAPIKeyName sets the Name property of CreateAPIKeyOptions. Name of the API key.
+func CreateAPIKeyName(nameVar string) CreateAPIKeyOption {
+	return func(options *CreateAPIKeyOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateAPIKeyIamID sets the IamID property of CreateAPIKeyOptions. The iam_id that this API key authenticates.
+func CreateAPIKeyIamID(iamID string) CreateAPIKeyOption {
+	return func(options *CreateAPIKeyOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// CreateAPIKeyDescription sets the Description property of CreateAPIKeyOptions. The optional description of the
+// API key.
+func CreateAPIKeyDescription(description string) CreateAPIKeyOption {
+	return func(options *CreateAPIKeyOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// CreateAPIKeyAccountID sets the AccountID property of CreateAPIKeyOptions. The account ID of the API key.
+func CreateAPIKeyAccountID(accountID string) CreateAPIKeyOption {
+	return func(options *CreateAPIKeyOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// CreateAPIKeyApikey sets the Apikey property of CreateAPIKeyOptions. You can optionally passthrough the API key
+// value for this API key.
+func CreateAPIKeyApikey(apikey string) CreateAPIKeyOption {
+	return func(options *CreateAPIKeyOptions) {
+		options.Apikey = core.StringPtr(apikey)
+	}
+}
+
+// CreateAPIKeyStoreValue sets the StoreValue property of CreateAPIKeyOptions. Send true or false to set whether
+// the API key value is retrievable in the future by using the Get details of an API key request.
+func CreateAPIKeyStoreValue(storeValue bool) CreateAPIKeyOption {
+	return func(options *CreateAPIKeyOptions) {
+		options.StoreValue = core.BoolPtr(storeValue)
+	}
+}
+
+// CreateAPIKeyEntityLock sets the EntityLock property of CreateAPIKeyOptions. Indicates if the API key is locked
+// for further write operations.
+func CreateAPIKeyEntityLock(entityLock string) CreateAPIKeyOption {
+	return func(options *CreateAPIKeyOptions) {
+		options.EntityLock = core.StringPtr(entityLock)
+	}
+}
+
+// CreateAPIKeyHeader sets the Headers property of CreateAPIKeyOptions. Allows users to set headers on API
+// requests.
+func CreateAPIKeyHeader(name string, value string) CreateAPIKeyOption {
+	return func(options *CreateAPIKeyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateAPIKeyOptionsWith returns CreateAPIKeyOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewCreateAPIKeyOptionsWith(options ...CreateAPIKeyOption) *CreateAPIKeyOptions {
+	createAPIKeyOptions := &CreateAPIKeyOptions{}
+	for _, option := range options {
+		option(createAPIKeyOptions)
+	}
+	return createAPIKeyOptions
+}
+
+// CreateAPIKeyWith is an alternate form of the CreateAPIKeyWithContext method which takes functional options
+// instead of a CreateAPIKeyOptions struct. CreateAPIKeyName and CreateAPIKeyIamID are required.
+func (iamIdentity *IamIdentityV1) CreateAPIKeyWith(ctx context.Context, options ...CreateAPIKeyOption) (result *APIKey, response *core.DetailedResponse, err error) {
+	return iamIdentity.CreateAPIKeyWithContext(ctx, iamIdentity.NewCreateAPIKeyOptionsWith(options...))
+}
+
+// GetAPIKeysDetailsOption : A functional option that sets a property of GetAPIKeysDetailsOptions (see GetAPIKeysDetailsWith).
+type GetAPIKeysDetailsOption func(options *GetAPIKeysDetailsOptions)
+
+// GetAPIKeysDetailsIamAPIKey sets the IamAPIKey property of GetAPIKeysDetailsOptions. API key value.
+func GetAPIKeysDetailsIamAPIKey(iamAPIKey string) GetAPIKeysDetailsOption {
+	return func(options *GetAPIKeysDetailsOptions) {
+		options.IamAPIKey = core.StringPtr(iamAPIKey)
+	}
+}
+
+// GetAPIKeysDetailsIncludeHistory sets the IncludeHistory property of GetAPIKeysDetailsOptions. Defines if the
+// entity history is included in the response.
+func GetAPIKeysDetailsIncludeHistory(includeHistory bool) GetAPIKeysDetailsOption {
+	return func(options *GetAPIKeysDetailsOptions) {
+		options.IncludeHistory = core.BoolPtr(includeHistory)
+	}
+}
+
+// GetAPIKeysDetailsHeader sets the Headers property of GetAPIKeysDetailsOptions. Allows users to set headers on
+// API requests.
+func GetAPIKeysDetailsHeader(name string, value string) GetAPIKeysDetailsOption {
+	return func(options *GetAPIKeysDetailsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAPIKeysDetailsOptionsWith returns GetAPIKeysDetailsOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewGetAPIKeysDetailsOptionsWith(options ...GetAPIKeysDetailsOption) *GetAPIKeysDetailsOptions {
+	getAPIKeysDetailsOptions := &GetAPIKeysDetailsOptions{}
+	for _, option := range options {
+		option(getAPIKeysDetailsOptions)
+	}
+	return getAPIKeysDetailsOptions
+}
+
+// GetAPIKeysDetailsWith is an alternate form of the GetAPIKeysDetailsWithContext method which takes functional
+// options instead of a GetAPIKeysDetailsOptions struct.
+func (iamIdentity *IamIdentityV1) GetAPIKeysDetailsWith(ctx context.Context, options ...GetAPIKeysDetailsOption) (result *APIKey, response *core.DetailedResponse, err error) {
+	return iamIdentity.GetAPIKeysDetailsWithContext(ctx, iamIdentity.NewGetAPIKeysDetailsOptionsWith(options...))
+}
+
+// GetAPIKeyOption : A functional option that sets a property of GetAPIKeyOptions (see GetAPIKeyWith).
+type GetAPIKeyOption func(options *GetAPIKeyOptions)
+
+// GetAPIKeyID sets the ID property of GetAPIKeyOptions. Unique ID of the API key.
+func GetAPIKeyID(id string) GetAPIKeyOption {
+	return func(options *GetAPIKeyOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetAPIKeyIncludeHistory sets the IncludeHistory property of GetAPIKeyOptions. Defines if the entity history is
+// included in the response.
+func GetAPIKeyIncludeHistory(includeHistory bool) GetAPIKeyOption {
+	return func(options *GetAPIKeyOptions) {
+		options.IncludeHistory = core.BoolPtr(includeHistory)
+	}
+}
+
+// GetAPIKeyIncludeActivity sets the IncludeActivity property of GetAPIKeyOptions. Defines if the entity's activity
+// is included in the response.
+func GetAPIKeyIncludeActivity(includeActivity bool) GetAPIKeyOption {
+	return func(options *GetAPIKeyOptions) {
+		options.IncludeActivity = core.BoolPtr(includeActivity)
+	}
+}
+
+// GetAPIKeyHeader sets the Headers property of GetAPIKeyOptions. Allows users to set headers on API requests.
+func GetAPIKeyHeader(name string, value string) GetAPIKeyOption {
+	return func(options *GetAPIKeyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAPIKeyOptionsWith returns GetAPIKeyOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewGetAPIKeyOptionsWith(options ...GetAPIKeyOption) *GetAPIKeyOptions {
+	getAPIKeyOptions := &GetAPIKeyOptions{}
+	for _, option := range options {
+		option(getAPIKeyOptions)
+	}
+	return getAPIKeyOptions
+}
+
+// GetAPIKeyWith is an alternate form of the GetAPIKeyWithContext method which takes functional options instead of
+// a GetAPIKeyOptions struct. GetAPIKeyID is required.
+func (iamIdentity *IamIdentityV1) GetAPIKeyWith(ctx context.Context, options ...GetAPIKeyOption) (result *APIKey, response *core.DetailedResponse, err error) {
+	return iamIdentity.GetAPIKeyWithContext(ctx, iamIdentity.NewGetAPIKeyOptionsWith(options...))
+}
+
+// UpdateAPIKeyOption : A functional option that sets a property of UpdateAPIKeyOptions (see UpdateAPIKeyWith).
+type UpdateAPIKeyOption func(options *UpdateAPIKeyOptions)
+
+// UpdateAPIKeyID sets the ID property of UpdateAPIKeyOptions. Unique ID of the API key to be updated.
+func UpdateAPIKeyID(id string) UpdateAPIKeyOption {
+	return func(options *UpdateAPIKeyOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UpdateAPIKeyIfMatch sets the IfMatch property of UpdateAPIKeyOptions. Version of the API key to be updated.
+func UpdateAPIKeyIfMatch(ifMatch string) UpdateAPIKeyOption {
+	return func(options *UpdateAPIKeyOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// UpdateAPIKeyName sets the Name property of UpdateAPIKeyOptions. The name of the API key to update.
+func UpdateAPIKeyName(nameVar string) UpdateAPIKeyOption {
+	return func(options *UpdateAPIKeyOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateAPIKeyDescription sets the Description property of UpdateAPIKeyOptions. The description of the API key to
+// update.
+func UpdateAPIKeyDescription(description string) UpdateAPIKeyOption {
+	return func(options *UpdateAPIKeyOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// UpdateAPIKeyHeader sets the Headers property of UpdateAPIKeyOptions. Allows users to set headers on API
+// requests.
+func UpdateAPIKeyHeader(name string, value string) UpdateAPIKeyOption {
+	return func(options *UpdateAPIKeyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateAPIKeyOptionsWith returns UpdateAPIKeyOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewUpdateAPIKeyOptionsWith(options ...UpdateAPIKeyOption) *UpdateAPIKeyOptions {
+	updateAPIKeyOptions := &UpdateAPIKeyOptions{}
+	for _, option := range options {
+		option(updateAPIKeyOptions)
+	}
+	return updateAPIKeyOptions
+}
+
+// UpdateAPIKeyWith is an alternate form of the UpdateAPIKeyWithContext method which takes functional options
+// instead of an UpdateAPIKeyOptions struct. UpdateAPIKeyID and UpdateAPIKeyIfMatch are required.
+func (iamIdentity *IamIdentityV1) UpdateAPIKeyWith(ctx context.Context, options ...UpdateAPIKeyOption) (result *APIKey, response *core.DetailedResponse, err error) {
+	return iamIdentity.UpdateAPIKeyWithContext(ctx, iamIdentity.NewUpdateAPIKeyOptionsWith(options...))
+}
+
+// DeleteAPIKeyOption : A functional option that sets a property of DeleteAPIKeyOptions (see DeleteAPIKeyWith).
+type DeleteAPIKeyOption func(options *DeleteAPIKeyOptions)
+
+// DeleteAPIKeyID sets the ID property of DeleteAPIKeyOptions. Unique ID of the API key.
+func DeleteAPIKeyID(id string) DeleteAPIKeyOption {
+	return func(options *DeleteAPIKeyOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// DeleteAPIKeyHeader sets the Headers property of DeleteAPIKeyOptions. Allows users to set headers on API
+// requests.
+func DeleteAPIKeyHeader(name string, value string) DeleteAPIKeyOption {
+	return func(options *DeleteAPIKeyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteAPIKeyOptionsWith returns DeleteAPIKeyOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewDeleteAPIKeyOptionsWith(options ...DeleteAPIKeyOption) *DeleteAPIKeyOptions {
+	deleteAPIKeyOptions := &DeleteAPIKeyOptions{}
+	for _, option := range options {
+		option(deleteAPIKeyOptions)
+	}
+	return deleteAPIKeyOptions
+}
+
+// DeleteAPIKeyWith is an alternate form of the DeleteAPIKeyWithContext method which takes functional options
+// instead of a DeleteAPIKeyOptions struct. DeleteAPIKeyID is required.
+func (iamIdentity *IamIdentityV1) DeleteAPIKeyWith(ctx context.Context, options ...DeleteAPIKeyOption) (response *core.DetailedResponse, err error) {
+	return iamIdentity.DeleteAPIKeyWithContext(ctx, iamIdentity.NewDeleteAPIKeyOptionsWith(options...))
+}
+
+// LockAPIKeyOption : A functional option that sets a property of LockAPIKeyOptions (see LockAPIKeyWith).
+type LockAPIKeyOption func(options *LockAPIKeyOptions)
+
+// LockAPIKeyID sets the ID property of LockAPIKeyOptions. Unique ID of the API key.
+func LockAPIKeyID(id string) LockAPIKeyOption {
+	return func(options *LockAPIKeyOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// LockAPIKeyHeader sets the Headers property of LockAPIKeyOptions. Allows users to set headers on API requests.
+func LockAPIKeyHeader(name string, value string) LockAPIKeyOption {
+	return func(options *LockAPIKeyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewLockAPIKeyOptionsWith returns LockAPIKeyOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewLockAPIKeyOptionsWith(options ...LockAPIKeyOption) *LockAPIKeyOptions {
+	lockAPIKeyOptions := &LockAPIKeyOptions{}
+	for _, option := range options {
+		option(lockAPIKeyOptions)
+	}
+	return lockAPIKeyOptions
+}
+
+// LockAPIKeyWith is an alternate form of the LockAPIKeyWithContext method which takes functional options instead
+// of a LockAPIKeyOptions struct. LockAPIKeyID is required.
+func (iamIdentity *IamIdentityV1) LockAPIKeyWith(ctx context.Context, options ...LockAPIKeyOption) (response *core.DetailedResponse, err error) {
+	return iamIdentity.LockAPIKeyWithContext(ctx, iamIdentity.NewLockAPIKeyOptionsWith(options...))
+}
+
+// UnlockAPIKeyOption : A functional option that sets a property of UnlockAPIKeyOptions (see UnlockAPIKeyWith).
+type UnlockAPIKeyOption func(options *UnlockAPIKeyOptions)
+
+// UnlockAPIKeyID sets the ID property of UnlockAPIKeyOptions. Unique ID of the API key.
+func UnlockAPIKeyID(id string) UnlockAPIKeyOption {
+	return func(options *UnlockAPIKeyOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UnlockAPIKeyHeader sets the Headers property of UnlockAPIKeyOptions. Allows users to set headers on API
+// requests.
+func UnlockAPIKeyHeader(name string, value string) UnlockAPIKeyOption {
+	return func(options *UnlockAPIKeyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUnlockAPIKeyOptionsWith returns UnlockAPIKeyOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewUnlockAPIKeyOptionsWith(options ...UnlockAPIKeyOption) *UnlockAPIKeyOptions {
+	unlockAPIKeyOptions := &UnlockAPIKeyOptions{}
+	for _, option := range options {
+		option(unlockAPIKeyOptions)
+	}
+	return unlockAPIKeyOptions
+}
+
+// UnlockAPIKeyWith is an alternate form of the UnlockAPIKeyWithContext method which takes functional options
+// instead of an UnlockAPIKeyOptions struct. UnlockAPIKeyID is required.
+func (iamIdentity *IamIdentityV1) UnlockAPIKeyWith(ctx context.Context, options ...UnlockAPIKeyOption) (response *core.DetailedResponse, err error) {
+	return iamIdentity.UnlockAPIKeyWithContext(ctx, iamIdentity.NewUnlockAPIKeyOptionsWith(options...))
+}
+
+// ListServiceIdsOption : A functional option that sets a property of ListServiceIdsOptions (see ListServiceIdsWith).
+type ListServiceIdsOption func(options *ListServiceIdsOptions)
+
+// ListServiceIdsAccountID sets the AccountID property of ListServiceIdsOptions. Account ID of the service ID(s) to
+// query.
+func ListServiceIdsAccountID(accountID string) ListServiceIdsOption {
+	return func(options *ListServiceIdsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListServiceIdsName sets the Name property of ListServiceIdsOptions. Name of the service ID(s) to query.
+func ListServiceIdsName(nameVar string) ListServiceIdsOption {
+	return func(options *ListServiceIdsOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListServiceIdsPagesize sets the Pagesize property of ListServiceIdsOptions. Optional size of a single page.
+func ListServiceIdsPagesize(pagesize int64) ListServiceIdsOption {
+	return func(options *ListServiceIdsOptions) {
+		options.Pagesize = core.Int64Ptr(pagesize)
+	}
+}
+
+// ListServiceIdsPagetoken sets the Pagetoken property of ListServiceIdsOptions. Optional Prev or Next page token
+// returned from a previous query execution.
+func ListServiceIdsPagetoken(pagetoken string) ListServiceIdsOption {
+	return func(options *ListServiceIdsOptions) {
+		options.Pagetoken = core.StringPtr(pagetoken)
+	}
+}
+
+// ListServiceIdsSort sets the Sort property of ListServiceIdsOptions. Optional sort property, valid values are
+// name, description, created_at and modified_at.
+func ListServiceIdsSort(sort string) ListServiceIdsOption {
+	return func(options *ListServiceIdsOptions) {
+		options.Sort = core.StringPtr(sort)
+	}
+}
+
+// ListServiceIdsOrder sets the Order property of ListServiceIdsOptions. Optional sort order, valid values are asc
+// and desc.
+func ListServiceIdsOrder(order string) ListServiceIdsOption {
+	return func(options *ListServiceIdsOptions) {
+		options.Order = core.StringPtr(order)
+	}
+}
+
+// ListServiceIdsIncludeHistory sets the IncludeHistory property of ListServiceIdsOptions. Defines if the entity
+// history is included in the response.
+func ListServiceIdsIncludeHistory(includeHistory bool) ListServiceIdsOption {
+	return func(options *ListServiceIdsOptions) {
+		options.IncludeHistory = core.BoolPtr(includeHistory)
+	}
+}
+
+// ListServiceIdsHeader sets the Headers property of ListServiceIdsOptions. Allows users to set headers on API
+// requests.
+func ListServiceIdsHeader(name string, value string) ListServiceIdsOption {
+	return func(options *ListServiceIdsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListServiceIdsOptionsWith returns ListServiceIdsOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewListServiceIdsOptionsWith(options ...ListServiceIdsOption) *ListServiceIdsOptions {
+	listServiceIdsOptions := &ListServiceIdsOptions{}
+	for _, option := range options {
+		option(listServiceIdsOptions)
+	}
+	return listServiceIdsOptions
+}
+
+// ListServiceIdsWith is an alternate form of the ListServiceIdsWithContext method which takes functional options
+// instead of a ListServiceIdsOptions struct.
+func (iamIdentity *IamIdentityV1) ListServiceIdsWith(ctx context.Context, options ...ListServiceIdsOption) (result *ServiceIDList, response *core.DetailedResponse, err error) {
+	return iamIdentity.ListServiceIdsWithContext(ctx, iamIdentity.NewListServiceIdsOptionsWith(options...))
+}
+
+// CreateServiceIDOption : A functional option that sets a property of CreateServiceIDOptions (see CreateServiceIDWith).
+type CreateServiceIDOption func(options *CreateServiceIDOptions)
+
+// CreateServiceIDAccountID sets the AccountID property of CreateServiceIDOptions. ID of the account the service ID
+// belongs to.
+func CreateServiceIDAccountID(accountID string) CreateServiceIDOption {
+	return func(options *CreateServiceIDOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// CreateServiceIDName sets the Name property of CreateServiceIDOptions. Name of the Service Id.
+func CreateServiceIDName(nameVar string) CreateServiceIDOption {
+	return func(options *CreateServiceIDOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateServiceIDDescription sets the Description property of CreateServiceIDOptions. The optional description of
+// the Service Id.
+func CreateServiceIDDescription(description string) CreateServiceIDOption {
+	return func(options *CreateServiceIDOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// CreateServiceIDUniqueInstanceCrns sets the UniqueInstanceCrns property of CreateServiceIDOptions. Optional list
+// of CRNs (string array) which point to the services connected to the service ID.
+func CreateServiceIDUniqueInstanceCrns(uniqueInstanceCrns ...string) CreateServiceIDOption {
+	return func(options *CreateServiceIDOptions) {
+		options.UniqueInstanceCrns = append(options.UniqueInstanceCrns, uniqueInstanceCrns...)
+	}
+}
+
+// CreateServiceIDApikey sets the Apikey property of CreateServiceIDOptions. Parameters for the API key in the
+// Create service Id V1 REST request.
+func CreateServiceIDApikey(apikey *APIKeyInsideCreateServiceIDRequest) CreateServiceIDOption {
+	return func(options *CreateServiceIDOptions) {
+		options.Apikey = apikey
+	}
+}
+
+// CreateServiceIDEntityLock sets the EntityLock property of CreateServiceIDOptions. Indicates if the service ID is
+// locked for further write operations.
+func CreateServiceIDEntityLock(entityLock string) CreateServiceIDOption {
+	return func(options *CreateServiceIDOptions) {
+		options.EntityLock = core.StringPtr(entityLock)
+	}
+}
+
+// CreateServiceIDHeader sets the Headers property of CreateServiceIDOptions. Allows users to set headers on API
+// requests.
+func CreateServiceIDHeader(name string, value string) CreateServiceIDOption {
+	return func(options *CreateServiceIDOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateServiceIDOptionsWith returns CreateServiceIDOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewCreateServiceIDOptionsWith(options ...CreateServiceIDOption) *CreateServiceIDOptions {
+	createServiceIDOptions := &CreateServiceIDOptions{}
+	for _, option := range options {
+		option(createServiceIDOptions)
+	}
+	return createServiceIDOptions
+}
+
+// CreateServiceIDWith is an alternate form of the CreateServiceIDWithContext method which takes functional options
+// instead of a CreateServiceIDOptions struct. CreateServiceIDAccountID and CreateServiceIDName are required.
+func (iamIdentity *IamIdentityV1) CreateServiceIDWith(ctx context.Context, options ...CreateServiceIDOption) (result *ServiceID, response *core.DetailedResponse, err error) {
+	return iamIdentity.CreateServiceIDWithContext(ctx, iamIdentity.NewCreateServiceIDOptionsWith(options...))
+}
+
+// GetServiceIDOption : A functional option that sets a property of GetServiceIDOptions (see GetServiceIDWith).
+type GetServiceIDOption func(options *GetServiceIDOptions)
+
+// GetServiceIDID sets the ID property of GetServiceIDOptions. Unique ID of the service ID.
+func GetServiceIDID(id string) GetServiceIDOption {
+	return func(options *GetServiceIDOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetServiceIDIncludeHistory sets the IncludeHistory property of GetServiceIDOptions. Defines if the entity
+// history is included in the response.
+func GetServiceIDIncludeHistory(includeHistory bool) GetServiceIDOption {
+	return func(options *GetServiceIDOptions) {
+		options.IncludeHistory = core.BoolPtr(includeHistory)
+	}
+}
+
+// GetServiceIDIncludeActivity sets the IncludeActivity property of GetServiceIDOptions. Defines if the entity's
+// activity is included in the response.
+func GetServiceIDIncludeActivity(includeActivity bool) GetServiceIDOption {
+	return func(options *GetServiceIDOptions) {
+		options.IncludeActivity = core.BoolPtr(includeActivity)
+	}
+}
+
+// GetServiceIDHeader sets the Headers property of GetServiceIDOptions. Allows users to set headers on API
+// requests.
+func GetServiceIDHeader(name string, value string) GetServiceIDOption {
+	return func(options *GetServiceIDOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetServiceIDOptionsWith returns GetServiceIDOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewGetServiceIDOptionsWith(options ...GetServiceIDOption) *GetServiceIDOptions {
+	getServiceIDOptions := &GetServiceIDOptions{}
+	for _, option := range options {
+		option(getServiceIDOptions)
+	}
+	return getServiceIDOptions
+}
+
+// GetServiceIDWith is an alternate form of the GetServiceIDWithContext method which takes functional options
+// instead of a GetServiceIDOptions struct. GetServiceIDID is required.
+func (iamIdentity *IamIdentityV1) GetServiceIDWith(ctx context.Context, options ...GetServiceIDOption) (result *ServiceID, response *core.DetailedResponse, err error) {
+	return iamIdentity.GetServiceIDWithContext(ctx, iamIdentity.NewGetServiceIDOptionsWith(options...))
+}
+
+// UpdateServiceIDOption : A functional option that sets a property of UpdateServiceIDOptions (see UpdateServiceIDWith).
+type UpdateServiceIDOption func(options *UpdateServiceIDOptions)
+
+// UpdateServiceIDID sets the ID property of UpdateServiceIDOptions. Unique ID of the service ID to be updated.
+func UpdateServiceIDID(id string) UpdateServiceIDOption {
+	return func(options *UpdateServiceIDOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UpdateServiceIDIfMatch sets the IfMatch property of UpdateServiceIDOptions. Version of the service ID to be
+// updated.
+func UpdateServiceIDIfMatch(ifMatch string) UpdateServiceIDOption {
+	return func(options *UpdateServiceIDOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// UpdateServiceIDName sets the Name property of UpdateServiceIDOptions. The name of the service ID to update.
+func UpdateServiceIDName(nameVar string) UpdateServiceIDOption {
+	return func(options *UpdateServiceIDOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateServiceIDDescription sets the Description property of UpdateServiceIDOptions. The description of the
+// service ID to update.
+func UpdateServiceIDDescription(description string) UpdateServiceIDOption {
+	return func(options *UpdateServiceIDOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// UpdateServiceIDUniqueInstanceCrns sets the UniqueInstanceCrns property of UpdateServiceIDOptions. List of CRNs
+// which point to the services connected to this service ID.
+func UpdateServiceIDUniqueInstanceCrns(uniqueInstanceCrns ...string) UpdateServiceIDOption {
+	return func(options *UpdateServiceIDOptions) {
+		options.UniqueInstanceCrns = append(options.UniqueInstanceCrns, uniqueInstanceCrns...)
+	}
+}
+
+// UpdateServiceIDHeader sets the Headers property of UpdateServiceIDOptions. Allows users to set headers on API
+// requests.
+func UpdateServiceIDHeader(name string, value string) UpdateServiceIDOption {
+	return func(options *UpdateServiceIDOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateServiceIDOptionsWith returns UpdateServiceIDOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewUpdateServiceIDOptionsWith(options ...UpdateServiceIDOption) *UpdateServiceIDOptions {
+	updateServiceIDOptions := &UpdateServiceIDOptions{}
+	for _, option := range options {
+		option(updateServiceIDOptions)
+	}
+	return updateServiceIDOptions
+}
+
+// UpdateServiceIDWith is an alternate form of the UpdateServiceIDWithContext method which takes functional options
+// instead of an UpdateServiceIDOptions struct. UpdateServiceIDID and UpdateServiceIDIfMatch are required.
+func (iamIdentity *IamIdentityV1) UpdateServiceIDWith(ctx context.Context, options ...UpdateServiceIDOption) (result *ServiceID, response *core.DetailedResponse, err error) {
+	return iamIdentity.UpdateServiceIDWithContext(ctx, iamIdentity.NewUpdateServiceIDOptionsWith(options...))
+}
+
+// DeleteServiceIDOption : A functional option that sets a property of DeleteServiceIDOptions (see DeleteServiceIDWith).
+type DeleteServiceIDOption func(options *DeleteServiceIDOptions)
+
+// DeleteServiceIDID sets the ID property of DeleteServiceIDOptions. Unique ID of the service ID.
+func DeleteServiceIDID(id string) DeleteServiceIDOption {
+	return func(options *DeleteServiceIDOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// DeleteServiceIDHeader sets the Headers property of DeleteServiceIDOptions. Allows users to set headers on API
+// requests.
+func DeleteServiceIDHeader(name string, value string) DeleteServiceIDOption {
+	return func(options *DeleteServiceIDOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteServiceIDOptionsWith returns DeleteServiceIDOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewDeleteServiceIDOptionsWith(options ...DeleteServiceIDOption) *DeleteServiceIDOptions {
+	deleteServiceIDOptions := &DeleteServiceIDOptions{}
+	for _, option := range options {
+		option(deleteServiceIDOptions)
+	}
+	return deleteServiceIDOptions
+}
+
+// DeleteServiceIDWith is an alternate form of the DeleteServiceIDWithContext method which takes functional options
+// instead of a DeleteServiceIDOptions struct. DeleteServiceIDID is required.
+func (iamIdentity *IamIdentityV1) DeleteServiceIDWith(ctx context.Context, options ...DeleteServiceIDOption) (response *core.DetailedResponse, err error) {
+	return iamIdentity.DeleteServiceIDWithContext(ctx, iamIdentity.NewDeleteServiceIDOptionsWith(options...))
+}
+
+// LockServiceIDOption : A functional option that sets a property of LockServiceIDOptions (see LockServiceIDWith).
+type LockServiceIDOption func(options *LockServiceIDOptions)
+
+// LockServiceIDID sets the ID property of LockServiceIDOptions. Unique ID of the service ID.
+func LockServiceIDID(id string) LockServiceIDOption {
+	return func(options *LockServiceIDOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// LockServiceIDHeader sets the Headers property of LockServiceIDOptions. Allows users to set headers on API
+// requests.
+func LockServiceIDHeader(name string, value string) LockServiceIDOption {
+	return func(options *LockServiceIDOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewLockServiceIDOptionsWith returns LockServiceIDOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewLockServiceIDOptionsWith(options ...LockServiceIDOption) *LockServiceIDOptions {
+	lockServiceIDOptions := &LockServiceIDOptions{}
+	for _, option := range options {
+		option(lockServiceIDOptions)
+	}
+	return lockServiceIDOptions
+}
+
+// LockServiceIDWith is an alternate form of the LockServiceIDWithContext method which takes functional options
+// instead of a LockServiceIDOptions struct. LockServiceIDID is required.
+func (iamIdentity *IamIdentityV1) LockServiceIDWith(ctx context.Context, options ...LockServiceIDOption) (response *core.DetailedResponse, err error) {
+	return iamIdentity.LockServiceIDWithContext(ctx, iamIdentity.NewLockServiceIDOptionsWith(options...))
+}
+
+// UnlockServiceIDOption : A functional option that sets a property of UnlockServiceIDOptions (see UnlockServiceIDWith).
+type UnlockServiceIDOption func(options *UnlockServiceIDOptions)
+
+// UnlockServiceIDID sets the ID property of UnlockServiceIDOptions. Unique ID of the service ID.
+func UnlockServiceIDID(id string) UnlockServiceIDOption {
+	return func(options *UnlockServiceIDOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UnlockServiceIDHeader sets the Headers property of UnlockServiceIDOptions. Allows users to set headers on API
+// requests.
+func UnlockServiceIDHeader(name string, value string) UnlockServiceIDOption {
+	return func(options *UnlockServiceIDOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUnlockServiceIDOptionsWith returns UnlockServiceIDOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewUnlockServiceIDOptionsWith(options ...UnlockServiceIDOption) *UnlockServiceIDOptions {
+	unlockServiceIDOptions := &UnlockServiceIDOptions{}
+	for _, option := range options {
+		option(unlockServiceIDOptions)
+	}
+	return unlockServiceIDOptions
+}
+
+// UnlockServiceIDWith is an alternate form of the UnlockServiceIDWithContext method which takes functional options
+// instead of an UnlockServiceIDOptions struct. UnlockServiceIDID is required.
+func (iamIdentity *IamIdentityV1) UnlockServiceIDWith(ctx context.Context, options ...UnlockServiceIDOption) (response *core.DetailedResponse, err error) {
+	return iamIdentity.UnlockServiceIDWithContext(ctx, iamIdentity.NewUnlockServiceIDOptionsWith(options...))
+}
+
+// CreateProfileOption : A functional option that sets a property of CreateProfileOptions (see CreateProfileWith).
+type CreateProfileOption func(options *CreateProfileOptions)
+
+// CreateProfileName sets the Name property of CreateProfileOptions. Name of the trusted profile.
+func CreateProfileName(nameVar string) CreateProfileOption {
+	return func(options *CreateProfileOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateProfileAccountID sets the AccountID property of CreateProfileOptions. The account ID of the trusted
+// profile.
+func CreateProfileAccountID(accountID string) CreateProfileOption {
+	return func(options *CreateProfileOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// CreateProfileDescription sets the Description property of CreateProfileOptions. The optional description of the
+// trusted profile.
+func CreateProfileDescription(description string) CreateProfileOption {
+	return func(options *CreateProfileOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// CreateProfileHeader sets the Headers property of CreateProfileOptions. Allows users to set headers on API
+// requests.
+func CreateProfileHeader(name string, value string) CreateProfileOption {
+	return func(options *CreateProfileOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateProfileOptionsWith returns CreateProfileOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewCreateProfileOptionsWith(options ...CreateProfileOption) *CreateProfileOptions {
+	createProfileOptions := &CreateProfileOptions{}
+	for _, option := range options {
+		option(createProfileOptions)
+	}
+	return createProfileOptions
+}
+
+// CreateProfileWith is an alternate form of the CreateProfileWithContext method which takes functional options
+// instead of a CreateProfileOptions struct. CreateProfileName and CreateProfileAccountID are required.
+func (iamIdentity *IamIdentityV1) CreateProfileWith(ctx context.Context, options ...CreateProfileOption) (result *TrustedProfile, response *core.DetailedResponse, err error) {
+	return iamIdentity.CreateProfileWithContext(ctx, iamIdentity.NewCreateProfileOptionsWith(options...))
+}
+
+// ListProfilesOption : A functional option that sets a property of ListProfilesOptions (see ListProfilesWith).
+type ListProfilesOption func(options *ListProfilesOptions)
+
+// ListProfilesAccountID sets the AccountID property of ListProfilesOptions. Account ID to query for trusted
+// profiles.
+func ListProfilesAccountID(accountID string) ListProfilesOption {
+	return func(options *ListProfilesOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListProfilesName sets the Name property of ListProfilesOptions. Name of the trusted profile to query.
+func ListProfilesName(nameVar string) ListProfilesOption {
+	return func(options *ListProfilesOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListProfilesPagesize sets the Pagesize property of ListProfilesOptions. Optional size of a single page.
+func ListProfilesPagesize(pagesize int64) ListProfilesOption {
+	return func(options *ListProfilesOptions) {
+		options.Pagesize = core.Int64Ptr(pagesize)
+	}
+}
+
+// ListProfilesSort sets the Sort property of ListProfilesOptions. Optional sort property, valid values are name,
+// description, created_at and modified_at.
+func ListProfilesSort(sort string) ListProfilesOption {
+	return func(options *ListProfilesOptions) {
+		options.Sort = core.StringPtr(sort)
+	}
+}
+
+// ListProfilesOrder sets the Order property of ListProfilesOptions. Optional sort order, valid values are asc and
+// desc.
+func ListProfilesOrder(order string) ListProfilesOption {
+	return func(options *ListProfilesOptions) {
+		options.Order = core.StringPtr(order)
+	}
+}
+
+// ListProfilesIncludeHistory sets the IncludeHistory property of ListProfilesOptions. Defines if the entity
+// history is included in the response.
+func ListProfilesIncludeHistory(includeHistory bool) ListProfilesOption {
+	return func(options *ListProfilesOptions) {
+		options.IncludeHistory = core.BoolPtr(includeHistory)
+	}
+}
+
+// ListProfilesPagetoken sets the Pagetoken property of ListProfilesOptions. Optional Prev or Next page token
+// returned from a previous query execution.
+func ListProfilesPagetoken(pagetoken string) ListProfilesOption {
+	return func(options *ListProfilesOptions) {
+		options.Pagetoken = core.StringPtr(pagetoken)
+	}
+}
+
+// ListProfilesHeader sets the Headers property of ListProfilesOptions. Allows users to set headers on API
+// requests.
+func ListProfilesHeader(name string, value string) ListProfilesOption {
+	return func(options *ListProfilesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListProfilesOptionsWith returns ListProfilesOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewListProfilesOptionsWith(options ...ListProfilesOption) *ListProfilesOptions {
+	listProfilesOptions := &ListProfilesOptions{}
+	for _, option := range options {
+		option(listProfilesOptions)
+	}
+	return listProfilesOptions
+}
+
+// ListProfilesWith is an alternate form of the ListProfilesWithContext method which takes functional options
+// instead of a ListProfilesOptions struct. ListProfilesAccountID is required.
+func (iamIdentity *IamIdentityV1) ListProfilesWith(ctx context.Context, options ...ListProfilesOption) (result *TrustedProfilesList, response *core.DetailedResponse, err error) {
+	return iamIdentity.ListProfilesWithContext(ctx, iamIdentity.NewListProfilesOptionsWith(options...))
+}
+
+// GetProfileOption : A functional option that sets a property of GetProfileOptions (see GetProfileWith).
+type GetProfileOption func(options *GetProfileOptions)
+
+// GetProfileProfileID sets the ProfileID property of GetProfileOptions. ID of the trusted profile to get.
+func GetProfileProfileID(profileID string) GetProfileOption {
+	return func(options *GetProfileOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// GetProfileIncludeActivity sets the IncludeActivity property of GetProfileOptions. Defines if the entity's
+// activity is included in the response.
+func GetProfileIncludeActivity(includeActivity bool) GetProfileOption {
+	return func(options *GetProfileOptions) {
+		options.IncludeActivity = core.BoolPtr(includeActivity)
+	}
+}
+
+// GetProfileHeader sets the Headers property of GetProfileOptions. Allows users to set headers on API requests.
+func GetProfileHeader(name string, value string) GetProfileOption {
+	return func(options *GetProfileOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetProfileOptionsWith returns GetProfileOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewGetProfileOptionsWith(options ...GetProfileOption) *GetProfileOptions {
+	getProfileOptions := &GetProfileOptions{}
+	for _, option := range options {
+		option(getProfileOptions)
+	}
+	return getProfileOptions
+}
+
+// GetProfileWith is an alternate form of the GetProfileWithContext method which takes functional options instead
+// of a GetProfileOptions struct. GetProfileProfileID is required.
+func (iamIdentity *IamIdentityV1) GetProfileWith(ctx context.Context, options ...GetProfileOption) (result *TrustedProfile, response *core.DetailedResponse, err error) {
+	return iamIdentity.GetProfileWithContext(ctx, iamIdentity.NewGetProfileOptionsWith(options...))
+}
+
+// UpdateProfileOption : A functional option that sets a property of UpdateProfileOptions (see UpdateProfileWith).
+type UpdateProfileOption func(options *UpdateProfileOptions)
+
+// UpdateProfileProfileID sets the ProfileID property of UpdateProfileOptions. ID of the trusted profile to be
+// updated.
+func UpdateProfileProfileID(profileID string) UpdateProfileOption {
+	return func(options *UpdateProfileOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// UpdateProfileIfMatch sets the IfMatch property of UpdateProfileOptions. Version of the trusted profile to be
+// updated.
+func UpdateProfileIfMatch(ifMatch string) UpdateProfileOption {
+	return func(options *UpdateProfileOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// UpdateProfileName sets the Name property of UpdateProfileOptions. The name of the trusted profile to update.
+func UpdateProfileName(nameVar string) UpdateProfileOption {
+	return func(options *UpdateProfileOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateProfileDescription sets the Description property of UpdateProfileOptions. The description of the trusted
+// profile to update.
+func UpdateProfileDescription(description string) UpdateProfileOption {
+	return func(options *UpdateProfileOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// UpdateProfileHeader sets the Headers property of UpdateProfileOptions. Allows users to set headers on API
+// requests.
+func UpdateProfileHeader(name string, value string) UpdateProfileOption {
+	return func(options *UpdateProfileOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateProfileOptionsWith returns UpdateProfileOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewUpdateProfileOptionsWith(options ...UpdateProfileOption) *UpdateProfileOptions {
+	updateProfileOptions := &UpdateProfileOptions{}
+	for _, option := range options {
+		option(updateProfileOptions)
+	}
+	return updateProfileOptions
+}
+
+// UpdateProfileWith is an alternate form of the UpdateProfileWithContext method which takes functional options
+// instead of an UpdateProfileOptions struct. UpdateProfileProfileID and UpdateProfileIfMatch are required.
+func (iamIdentity *IamIdentityV1) UpdateProfileWith(ctx context.Context, options ...UpdateProfileOption) (result *TrustedProfile, response *core.DetailedResponse, err error) {
+	return iamIdentity.UpdateProfileWithContext(ctx, iamIdentity.NewUpdateProfileOptionsWith(options...))
+}
+
+// DeleteProfileOption : A functional option that sets a property of DeleteProfileOptions (see DeleteProfileWith).
+type DeleteProfileOption func(options *DeleteProfileOptions)
+
+// DeleteProfileProfileID sets the ProfileID property of DeleteProfileOptions. ID of the trusted profile.
+func DeleteProfileProfileID(profileID string) DeleteProfileOption {
+	return func(options *DeleteProfileOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// DeleteProfileHeader sets the Headers property of DeleteProfileOptions. Allows users to set headers on API
+// requests.
+func DeleteProfileHeader(name string, value string) DeleteProfileOption {
+	return func(options *DeleteProfileOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteProfileOptionsWith returns DeleteProfileOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewDeleteProfileOptionsWith(options ...DeleteProfileOption) *DeleteProfileOptions {
+	deleteProfileOptions := &DeleteProfileOptions{}
+	for _, option := range options {
+		option(deleteProfileOptions)
+	}
+	return deleteProfileOptions
+}
+
+// DeleteProfileWith is an alternate form of the DeleteProfileWithContext method which takes functional options
+// instead of a DeleteProfileOptions struct. DeleteProfileProfileID is required.
+func (iamIdentity *IamIdentityV1) DeleteProfileWith(ctx context.Context, options ...DeleteProfileOption) (response *core.DetailedResponse, err error) {
+	return iamIdentity.DeleteProfileWithContext(ctx, iamIdentity.NewDeleteProfileOptionsWith(options...))
+}
+
+// CreateClaimRuleOption : A functional option that sets a property of CreateClaimRuleOptions (see CreateClaimRuleWith).
+type CreateClaimRuleOption func(options *CreateClaimRuleOptions)
+
+// CreateClaimRuleProfileID sets the ProfileID property of CreateClaimRuleOptions. ID of the trusted profile to
+// create a claim rule.
+func CreateClaimRuleProfileID(profileID string) CreateClaimRuleOption {
+	return func(options *CreateClaimRuleOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// CreateClaimRuleType sets the Type property of CreateClaimRuleOptions. Type of the calim rule, either
+// 'Profile-SAML' or 'Profile-CR'.
+func CreateClaimRuleType(typeVar string) CreateClaimRuleOption {
+	return func(options *CreateClaimRuleOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// CreateClaimRuleConditions sets the Conditions property of CreateClaimRuleOptions. Conditions of this claim rule.
+func CreateClaimRuleConditions(conditions ...ProfileClaimRuleConditions) CreateClaimRuleOption {
+	return func(options *CreateClaimRuleOptions) {
+		options.Conditions = append(options.Conditions, conditions...)
+	}
+}
+
+// CreateClaimRuleContext sets the Context property of CreateClaimRuleOptions. Context with key properties for
+// problem determination.
+func CreateClaimRuleContext(contextVar *ResponseContext) CreateClaimRuleOption {
+	return func(options *CreateClaimRuleOptions) {
+		options.Context = contextVar
+	}
+}
+
+// CreateClaimRuleName sets the Name property of CreateClaimRuleOptions. Name of the claim rule to be created or
+// updated.
+func CreateClaimRuleName(nameVar string) CreateClaimRuleOption {
+	return func(options *CreateClaimRuleOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateClaimRuleRealmName sets the RealmName property of CreateClaimRuleOptions. The realm name of the Idp this
+// claim rule applies to.
+func CreateClaimRuleRealmName(realmName string) CreateClaimRuleOption {
+	return func(options *CreateClaimRuleOptions) {
+		options.RealmName = core.StringPtr(realmName)
+	}
+}
+
+// CreateClaimRuleCrType sets the CrType property of CreateClaimRuleOptions. The compute resource type the rule
+// applies to, required only if type is specified as 'Profile-CR'.
+func CreateClaimRuleCrType(crType string) CreateClaimRuleOption {
+	return func(options *CreateClaimRuleOptions) {
+		options.CrType = core.StringPtr(crType)
+	}
+}
+
+// CreateClaimRuleExpiration sets the Expiration property of CreateClaimRuleOptions. Session expiration in seconds,
+// only required if type is 'Profile-SAML'.
+func CreateClaimRuleExpiration(expiration int64) CreateClaimRuleOption {
+	return func(options *CreateClaimRuleOptions) {
+		options.Expiration = core.Int64Ptr(expiration)
+	}
+}
+
+// CreateClaimRuleHeader sets the Headers property of CreateClaimRuleOptions. Allows users to set headers on API
+// requests.
+func CreateClaimRuleHeader(name string, value string) CreateClaimRuleOption {
+	return func(options *CreateClaimRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateClaimRuleOptionsWith returns CreateClaimRuleOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewCreateClaimRuleOptionsWith(options ...CreateClaimRuleOption) *CreateClaimRuleOptions {
+	createClaimRuleOptions := &CreateClaimRuleOptions{}
+	for _, option := range options {
+		option(createClaimRuleOptions)
+	}
+	return createClaimRuleOptions
+}
+
+// CreateClaimRuleWith is an alternate form of the CreateClaimRuleWithContext method which takes functional options
+// instead of a CreateClaimRuleOptions struct. CreateClaimRuleProfileID, CreateClaimRuleType and
+// CreateClaimRuleConditions are required.
+func (iamIdentity *IamIdentityV1) CreateClaimRuleWith(ctx context.Context, options ...CreateClaimRuleOption) (result *ProfileClaimRule, response *core.DetailedResponse, err error) {
+	return iamIdentity.CreateClaimRuleWithContext(ctx, iamIdentity.NewCreateClaimRuleOptionsWith(options...))
+}
+
+// ListClaimRulesOption : A functional option that sets a property of ListClaimRulesOptions (see ListClaimRulesWith).
+type ListClaimRulesOption func(options *ListClaimRulesOptions)
+
+// ListClaimRulesProfileID sets the ProfileID property of ListClaimRulesOptions. ID of the trusted profile.
+func ListClaimRulesProfileID(profileID string) ListClaimRulesOption {
+	return func(options *ListClaimRulesOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// ListClaimRulesHeader sets the Headers property of ListClaimRulesOptions. Allows users to set headers on API
+// requests.
+func ListClaimRulesHeader(name string, value string) ListClaimRulesOption {
+	return func(options *ListClaimRulesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListClaimRulesOptionsWith returns ListClaimRulesOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewListClaimRulesOptionsWith(options ...ListClaimRulesOption) *ListClaimRulesOptions {
+	listClaimRulesOptions := &ListClaimRulesOptions{}
+	for _, option := range options {
+		option(listClaimRulesOptions)
+	}
+	return listClaimRulesOptions
+}
+
+// ListClaimRulesWith is an alternate form of the ListClaimRulesWithContext method which takes functional options
+// instead of a ListClaimRulesOptions struct. ListClaimRulesProfileID is required.
+func (iamIdentity *IamIdentityV1) ListClaimRulesWith(ctx context.Context, options ...ListClaimRulesOption) (result *ProfileClaimRuleList, response *core.DetailedResponse, err error) {
+	return iamIdentity.ListClaimRulesWithContext(ctx, iamIdentity.NewListClaimRulesOptionsWith(options...))
+}
+
+// GetClaimRuleOption : A functional option that sets a property of GetClaimRuleOptions (see GetClaimRuleWith).
+type GetClaimRuleOption func(options *GetClaimRuleOptions)
+
+// GetClaimRuleProfileID sets the ProfileID property of GetClaimRuleOptions. ID of the trusted profile.
+func GetClaimRuleProfileID(profileID string) GetClaimRuleOption {
+	return func(options *GetClaimRuleOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// GetClaimRuleRuleID sets the RuleID property of GetClaimRuleOptions. ID of the claim rule to get.
+func GetClaimRuleRuleID(ruleID string) GetClaimRuleOption {
+	return func(options *GetClaimRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// GetClaimRuleHeader sets the Headers property of GetClaimRuleOptions. Allows users to set headers on API
+// requests.
+func GetClaimRuleHeader(name string, value string) GetClaimRuleOption {
+	return func(options *GetClaimRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetClaimRuleOptionsWith returns GetClaimRuleOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewGetClaimRuleOptionsWith(options ...GetClaimRuleOption) *GetClaimRuleOptions {
+	getClaimRuleOptions := &GetClaimRuleOptions{}
+	for _, option := range options {
+		option(getClaimRuleOptions)
+	}
+	return getClaimRuleOptions
+}
+
+// GetClaimRuleWith is an alternate form of the GetClaimRuleWithContext method which takes functional options
+// instead of a GetClaimRuleOptions struct. GetClaimRuleProfileID and GetClaimRuleRuleID are required.
+func (iamIdentity *IamIdentityV1) GetClaimRuleWith(ctx context.Context, options ...GetClaimRuleOption) (result *ProfileClaimRule, response *core.DetailedResponse, err error) {
+	return iamIdentity.GetClaimRuleWithContext(ctx, iamIdentity.NewGetClaimRuleOptionsWith(options...))
+}
+
+// UpdateClaimRuleOption : A functional option that sets a property of UpdateClaimRuleOptions (see UpdateClaimRuleWith).
+type UpdateClaimRuleOption func(options *UpdateClaimRuleOptions)
+
+// UpdateClaimRuleProfileID sets the ProfileID property of UpdateClaimRuleOptions. ID of the trusted profile.
+func UpdateClaimRuleProfileID(profileID string) UpdateClaimRuleOption {
+	return func(options *UpdateClaimRuleOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// UpdateClaimRuleRuleID sets the RuleID property of UpdateClaimRuleOptions. ID of the claim rule to update.
+func UpdateClaimRuleRuleID(ruleID string) UpdateClaimRuleOption {
+	return func(options *UpdateClaimRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// UpdateClaimRuleIfMatch sets the IfMatch property of UpdateClaimRuleOptions. Version of the claim rule to be
+// updated.
+func UpdateClaimRuleIfMatch(ifMatch string) UpdateClaimRuleOption {
+	return func(options *UpdateClaimRuleOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// UpdateClaimRuleType sets the Type property of UpdateClaimRuleOptions. Type of the calim rule, either
+// 'Profile-SAML' or 'Profile-CR'.
+func UpdateClaimRuleType(typeVar string) UpdateClaimRuleOption {
+	return func(options *UpdateClaimRuleOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// UpdateClaimRuleConditions sets the Conditions property of UpdateClaimRuleOptions. Conditions of this claim rule.
+func UpdateClaimRuleConditions(conditions ...ProfileClaimRuleConditions) UpdateClaimRuleOption {
+	return func(options *UpdateClaimRuleOptions) {
+		options.Conditions = append(options.Conditions, conditions...)
+	}
+}
+
+// UpdateClaimRuleContext sets the Context property of UpdateClaimRuleOptions. Context with key properties for
+// problem determination.
+func UpdateClaimRuleContext(contextVar *ResponseContext) UpdateClaimRuleOption {
+	return func(options *UpdateClaimRuleOptions) {
+		options.Context = contextVar
+	}
+}
+
+// UpdateClaimRuleName sets the Name property of UpdateClaimRuleOptions. Name of the claim rule to be created or
+// updated.
+func UpdateClaimRuleName(nameVar string) UpdateClaimRuleOption {
+	return func(options *UpdateClaimRuleOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateClaimRuleRealmName sets the RealmName property of UpdateClaimRuleOptions. The realm name of the Idp this
+// claim rule applies to.
+func UpdateClaimRuleRealmName(realmName string) UpdateClaimRuleOption {
+	return func(options *UpdateClaimRuleOptions) {
+		options.RealmName = core.StringPtr(realmName)
+	}
+}
+
+// UpdateClaimRuleCrType sets the CrType property of UpdateClaimRuleOptions. The compute resource type the rule
+// applies to, required only if type is specified as 'Profile-CR'.
+func UpdateClaimRuleCrType(crType string) UpdateClaimRuleOption {
+	return func(options *UpdateClaimRuleOptions) {
+		options.CrType = core.StringPtr(crType)
+	}
+}
+
+// UpdateClaimRuleExpiration sets the Expiration property of UpdateClaimRuleOptions. Session expiration in seconds,
+// only required if type is 'Profile-SAML'.
+func UpdateClaimRuleExpiration(expiration int64) UpdateClaimRuleOption {
+	return func(options *UpdateClaimRuleOptions) {
+		options.Expiration = core.Int64Ptr(expiration)
+	}
+}
+
+// UpdateClaimRuleHeader sets the Headers property of UpdateClaimRuleOptions. Allows users to set headers on API
+// requests.
+func UpdateClaimRuleHeader(name string, value string) UpdateClaimRuleOption {
+	return func(options *UpdateClaimRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateClaimRuleOptionsWith returns UpdateClaimRuleOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewUpdateClaimRuleOptionsWith(options ...UpdateClaimRuleOption) *UpdateClaimRuleOptions {
+	updateClaimRuleOptions := &UpdateClaimRuleOptions{}
+	for _, option := range options {
+		option(updateClaimRuleOptions)
+	}
+	return updateClaimRuleOptions
+}
+
+// UpdateClaimRuleWith is an alternate form of the UpdateClaimRuleWithContext method which takes functional options
+// instead of an UpdateClaimRuleOptions struct. UpdateClaimRuleProfileID, UpdateClaimRuleRuleID,
+// UpdateClaimRuleIfMatch, UpdateClaimRuleType and UpdateClaimRuleConditions are required.
+func (iamIdentity *IamIdentityV1) UpdateClaimRuleWith(ctx context.Context, options ...UpdateClaimRuleOption) (result *ProfileClaimRule, response *core.DetailedResponse, err error) {
+	return iamIdentity.UpdateClaimRuleWithContext(ctx, iamIdentity.NewUpdateClaimRuleOptionsWith(options...))
+}
+
+// DeleteClaimRuleOption : A functional option that sets a property of DeleteClaimRuleOptions (see DeleteClaimRuleWith).
+type DeleteClaimRuleOption func(options *DeleteClaimRuleOptions)
+
+// DeleteClaimRuleProfileID sets the ProfileID property of DeleteClaimRuleOptions. ID of the trusted profile.
+func DeleteClaimRuleProfileID(profileID string) DeleteClaimRuleOption {
+	return func(options *DeleteClaimRuleOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// DeleteClaimRuleRuleID sets the RuleID property of DeleteClaimRuleOptions. ID of the claim rule to delete.
+func DeleteClaimRuleRuleID(ruleID string) DeleteClaimRuleOption {
+	return func(options *DeleteClaimRuleOptions) {
+		options.RuleID = core.StringPtr(ruleID)
+	}
+}
+
+// DeleteClaimRuleHeader sets the Headers property of DeleteClaimRuleOptions. Allows users to set headers on API
+// requests.
+func DeleteClaimRuleHeader(name string, value string) DeleteClaimRuleOption {
+	return func(options *DeleteClaimRuleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteClaimRuleOptionsWith returns DeleteClaimRuleOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewDeleteClaimRuleOptionsWith(options ...DeleteClaimRuleOption) *DeleteClaimRuleOptions {
+	deleteClaimRuleOptions := &DeleteClaimRuleOptions{}
+	for _, option := range options {
+		option(deleteClaimRuleOptions)
+	}
+	return deleteClaimRuleOptions
+}
+
+// DeleteClaimRuleWith is an alternate form of the DeleteClaimRuleWithContext method which takes functional options
+// instead of a DeleteClaimRuleOptions struct. DeleteClaimRuleProfileID and DeleteClaimRuleRuleID are required.
+func (iamIdentity *IamIdentityV1) DeleteClaimRuleWith(ctx context.Context, options ...DeleteClaimRuleOption) (response *core.DetailedResponse, err error) {
+	return iamIdentity.DeleteClaimRuleWithContext(ctx, iamIdentity.NewDeleteClaimRuleOptionsWith(options...))
+}
+
+// CreateLinkOption : A functional option that sets a property of CreateLinkOptions (see CreateLinkWith).
+type CreateLinkOption func(options *CreateLinkOptions)
+
+// CreateLinkProfileID sets the ProfileID property of CreateLinkOptions. ID of the trusted profile.
+func CreateLinkProfileID(profileID string) CreateLinkOption {
+	return func(options *CreateLinkOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// CreateLinkCrType sets the CrType property of CreateLinkOptions. The compute resource type.
+func CreateLinkCrType(crType string) CreateLinkOption {
+	return func(options *CreateLinkOptions) {
+		options.CrType = core.StringPtr(crType)
+	}
+}
+
+// CreateLinkLink sets the Link property of CreateLinkOptions. Link details.
+func CreateLinkLink(link *CreateProfileLinkRequestLink) CreateLinkOption {
+	return func(options *CreateLinkOptions) {
+		options.Link = link
+	}
+}
+
+// CreateLinkName sets the Name property of CreateLinkOptions. Optional name of the Link.
+func CreateLinkName(nameVar string) CreateLinkOption {
+	return func(options *CreateLinkOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateLinkHeader sets the Headers property of CreateLinkOptions. Allows users to set headers on API requests.
+func CreateLinkHeader(name string, value string) CreateLinkOption {
+	return func(options *CreateLinkOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateLinkOptionsWith returns CreateLinkOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewCreateLinkOptionsWith(options ...CreateLinkOption) *CreateLinkOptions {
+	createLinkOptions := &CreateLinkOptions{}
+	for _, option := range options {
+		option(createLinkOptions)
+	}
+	return createLinkOptions
+}
+
+// CreateLinkWith is an alternate form of the CreateLinkWithContext method which takes functional options instead
+// of a CreateLinkOptions struct. CreateLinkProfileID, CreateLinkCrType and CreateLinkLink are required.
+func (iamIdentity *IamIdentityV1) CreateLinkWith(ctx context.Context, options ...CreateLinkOption) (result *ProfileLink, response *core.DetailedResponse, err error) {
+	return iamIdentity.CreateLinkWithContext(ctx, iamIdentity.NewCreateLinkOptionsWith(options...))
+}
+
+// ListLinksOption : A functional option that sets a property of ListLinksOptions (see ListLinksWith).
+type ListLinksOption func(options *ListLinksOptions)
+
+// ListLinksProfileID sets the ProfileID property of ListLinksOptions. ID of the trusted profile.
+func ListLinksProfileID(profileID string) ListLinksOption {
+	return func(options *ListLinksOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// ListLinksHeader sets the Headers property of ListLinksOptions. Allows users to set headers on API requests.
+func ListLinksHeader(name string, value string) ListLinksOption {
+	return func(options *ListLinksOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListLinksOptionsWith returns ListLinksOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewListLinksOptionsWith(options ...ListLinksOption) *ListLinksOptions {
+	listLinksOptions := &ListLinksOptions{}
+	for _, option := range options {
+		option(listLinksOptions)
+	}
+	return listLinksOptions
+}
+
+// ListLinksWith is an alternate form of the ListLinksWithContext method which takes functional options instead of
+// a ListLinksOptions struct. ListLinksProfileID is required.
+func (iamIdentity *IamIdentityV1) ListLinksWith(ctx context.Context, options ...ListLinksOption) (result *ProfileLinkList, response *core.DetailedResponse, err error) {
+	return iamIdentity.ListLinksWithContext(ctx, iamIdentity.NewListLinksOptionsWith(options...))
+}
+
+// GetLinkOption : A functional option that sets a property of GetLinkOptions (see GetLinkWith).
+type GetLinkOption func(options *GetLinkOptions)
+
+// GetLinkProfileID sets the ProfileID property of GetLinkOptions. ID of the trusted profile.
+func GetLinkProfileID(profileID string) GetLinkOption {
+	return func(options *GetLinkOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// GetLinkLinkID sets the LinkID property of GetLinkOptions. ID of the link.
+func GetLinkLinkID(linkID string) GetLinkOption {
+	return func(options *GetLinkOptions) {
+		options.LinkID = core.StringPtr(linkID)
+	}
+}
+
+// GetLinkHeader sets the Headers property of GetLinkOptions. Allows users to set headers on API requests.
+func GetLinkHeader(name string, value string) GetLinkOption {
+	return func(options *GetLinkOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetLinkOptionsWith returns GetLinkOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewGetLinkOptionsWith(options ...GetLinkOption) *GetLinkOptions {
+	getLinkOptions := &GetLinkOptions{}
+	for _, option := range options {
+		option(getLinkOptions)
+	}
+	return getLinkOptions
+}
+
+// GetLinkWith is an alternate form of the GetLinkWithContext method which takes functional options instead of a
+// GetLinkOptions struct. GetLinkProfileID and GetLinkLinkID are required.
+func (iamIdentity *IamIdentityV1) GetLinkWith(ctx context.Context, options ...GetLinkOption) (result *ProfileLink, response *core.DetailedResponse, err error) {
+	return iamIdentity.GetLinkWithContext(ctx, iamIdentity.NewGetLinkOptionsWith(options...))
+}
+
+// DeleteLinkOption : A functional option that sets a property of DeleteLinkOptions (see DeleteLinkWith).
+type DeleteLinkOption func(options *DeleteLinkOptions)
+
+// DeleteLinkProfileID sets the ProfileID property of DeleteLinkOptions. ID of the trusted profile.
+func DeleteLinkProfileID(profileID string) DeleteLinkOption {
+	return func(options *DeleteLinkOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// DeleteLinkLinkID sets the LinkID property of DeleteLinkOptions. ID of the link.
+func DeleteLinkLinkID(linkID string) DeleteLinkOption {
+	return func(options *DeleteLinkOptions) {
+		options.LinkID = core.StringPtr(linkID)
+	}
+}
+
+// DeleteLinkHeader sets the Headers property of DeleteLinkOptions. Allows users to set headers on API requests.
+func DeleteLinkHeader(name string, value string) DeleteLinkOption {
+	return func(options *DeleteLinkOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteLinkOptionsWith returns DeleteLinkOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewDeleteLinkOptionsWith(options ...DeleteLinkOption) *DeleteLinkOptions {
+	deleteLinkOptions := &DeleteLinkOptions{}
+	for _, option := range options {
+		option(deleteLinkOptions)
+	}
+	return deleteLinkOptions
+}
+
+// DeleteLinkWith is an alternate form of the DeleteLinkWithContext method which takes functional options instead
+// of a DeleteLinkOptions struct. DeleteLinkProfileID and DeleteLinkLinkID are required.
+func (iamIdentity *IamIdentityV1) DeleteLinkWith(ctx context.Context, options ...DeleteLinkOption) (response *core.DetailedResponse, err error) {
+	return iamIdentity.DeleteLinkWithContext(ctx, iamIdentity.NewDeleteLinkOptionsWith(options...))
+}
+
+// GetAccountSettingsOption : A functional option that sets a property of GetAccountSettingsOptions (see GetAccountSettingsWith).
+type GetAccountSettingsOption func(options *GetAccountSettingsOptions)
+
+// GetAccountSettingsAccountID sets the AccountID property of GetAccountSettingsOptions. Unique ID of the account.
+func GetAccountSettingsAccountID(accountID string) GetAccountSettingsOption {
+	return func(options *GetAccountSettingsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetAccountSettingsIncludeHistory sets the IncludeHistory property of GetAccountSettingsOptions. Defines if the
+// entity history is included in the response.
+func GetAccountSettingsIncludeHistory(includeHistory bool) GetAccountSettingsOption {
+	return func(options *GetAccountSettingsOptions) {
+		options.IncludeHistory = core.BoolPtr(includeHistory)
+	}
+}
+
+// GetAccountSettingsHeader sets the Headers property of GetAccountSettingsOptions. Allows users to set headers on
+// API requests.
+func GetAccountSettingsHeader(name string, value string) GetAccountSettingsOption {
+	return func(options *GetAccountSettingsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAccountSettingsOptionsWith returns GetAccountSettingsOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewGetAccountSettingsOptionsWith(options ...GetAccountSettingsOption) *GetAccountSettingsOptions {
+	getAccountSettingsOptions := &GetAccountSettingsOptions{}
+	for _, option := range options {
+		option(getAccountSettingsOptions)
+	}
+	return getAccountSettingsOptions
+}
+
+// GetAccountSettingsWith is an alternate form of the GetAccountSettingsWithContext method which takes functional
+// options instead of a GetAccountSettingsOptions struct. GetAccountSettingsAccountID is required.
+func (iamIdentity *IamIdentityV1) GetAccountSettingsWith(ctx context.Context, options ...GetAccountSettingsOption) (result *AccountSettingsResponse, response *core.DetailedResponse, err error) {
+	return iamIdentity.GetAccountSettingsWithContext(ctx, iamIdentity.NewGetAccountSettingsOptionsWith(options...))
+}
+
+// UpdateAccountSettingsOption : A functional option that sets a property of UpdateAccountSettingsOptions (see UpdateAccountSettingsWith).
+type UpdateAccountSettingsOption func(options *UpdateAccountSettingsOptions)
+
+// UpdateAccountSettingsIfMatch sets the IfMatch property of UpdateAccountSettingsOptions. Version of the account
+// settings to be updated.
+func UpdateAccountSettingsIfMatch(ifMatch string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// UpdateAccountSettingsAccountID sets the AccountID property of UpdateAccountSettingsOptions. The id of the
+// account to update the settings for.
+func UpdateAccountSettingsAccountID(accountID string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// UpdateAccountSettingsRestrictCreateServiceID sets the RestrictCreateServiceID property of
+// UpdateAccountSettingsOptions. Defines whether or not creating a Service Id is access controlled.
+func UpdateAccountSettingsRestrictCreateServiceID(restrictCreateServiceID string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.RestrictCreateServiceID = core.StringPtr(restrictCreateServiceID)
+	}
+}
+
+// UpdateAccountSettingsRestrictCreatePlatformApikey sets the RestrictCreatePlatformApikey property of
+// UpdateAccountSettingsOptions. Defines whether or not creating platform API keys is access controlled.
+func UpdateAccountSettingsRestrictCreatePlatformApikey(restrictCreatePlatformApikey string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.RestrictCreatePlatformApikey = core.StringPtr(restrictCreatePlatformApikey)
+	}
+}
+
+// UpdateAccountSettingsAllowedIPAddresses sets the AllowedIPAddresses property of UpdateAccountSettingsOptions.
+// Defines the IP addresses and subnets from which IAM tokens can be created for the account.
+func UpdateAccountSettingsAllowedIPAddresses(allowedIPAddresses string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.AllowedIPAddresses = core.StringPtr(allowedIPAddresses)
+	}
+}
+
+// UpdateAccountSettingsMfa sets the Mfa property of UpdateAccountSettingsOptions. Defines the MFA trait for the
+// account.
+func UpdateAccountSettingsMfa(mfa string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.Mfa = core.StringPtr(mfa)
+	}
+}
+
+// UpdateAccountSettingsSessionExpirationInSeconds sets the SessionExpirationInSeconds property of
+// UpdateAccountSettingsOptions. Defines the session expiration in seconds for the account.
+func UpdateAccountSettingsSessionExpirationInSeconds(sessionExpirationInSeconds string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.SessionExpirationInSeconds = core.StringPtr(sessionExpirationInSeconds)
+	}
+}
+
+// UpdateAccountSettingsSessionInvalidationInSeconds sets the SessionInvalidationInSeconds property of
+// UpdateAccountSettingsOptions. Defines the period of time in seconds in which a session will be invalidated due
+// to inactivity.
+func UpdateAccountSettingsSessionInvalidationInSeconds(sessionInvalidationInSeconds string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.SessionInvalidationInSeconds = core.StringPtr(sessionInvalidationInSeconds)
+	}
+}
+
+// UpdateAccountSettingsMaxSessionsPerIdentity sets the MaxSessionsPerIdentity property of
+// UpdateAccountSettingsOptions. Defines the max allowed sessions per identity required by the account.
+func UpdateAccountSettingsMaxSessionsPerIdentity(maxSessionsPerIdentity string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		options.MaxSessionsPerIdentity = core.StringPtr(maxSessionsPerIdentity)
+	}
+}
+
+// UpdateAccountSettingsHeader sets the Headers property of UpdateAccountSettingsOptions. Allows users to set
+// headers on API requests.
+func UpdateAccountSettingsHeader(name string, value string) UpdateAccountSettingsOption {
+	return func(options *UpdateAccountSettingsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateAccountSettingsOptionsWith returns UpdateAccountSettingsOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewUpdateAccountSettingsOptionsWith(options ...UpdateAccountSettingsOption) *UpdateAccountSettingsOptions {
+	updateAccountSettingsOptions := &UpdateAccountSettingsOptions{}
+	for _, option := range options {
+		option(updateAccountSettingsOptions)
+	}
+	return updateAccountSettingsOptions
+}
+
+// UpdateAccountSettingsWith is an alternate form of the UpdateAccountSettingsWithContext method which takes
+// functional options instead of an UpdateAccountSettingsOptions struct. UpdateAccountSettingsIfMatch and
+// UpdateAccountSettingsAccountID are required.
+func (iamIdentity *IamIdentityV1) UpdateAccountSettingsWith(ctx context.Context, options ...UpdateAccountSettingsOption) (result *AccountSettingsResponse, response *core.DetailedResponse, err error) {
+	return iamIdentity.UpdateAccountSettingsWithContext(ctx, iamIdentity.NewUpdateAccountSettingsOptionsWith(options...))
+}
+
+// CreateReportOption : A functional option that sets a property of CreateReportOptions (see CreateReportWith).
+type CreateReportOption func(options *CreateReportOptions)
+
+// CreateReportAccountID sets the AccountID property of CreateReportOptions. ID of the account.
+func CreateReportAccountID(accountID string) CreateReportOption {
+	return func(options *CreateReportOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// CreateReportType sets the Type property of CreateReportOptions. Optional report type, supported value is
+// 'inactive' - List all identities that have not authenticated within the time indicated by duration.
+func CreateReportType(typeVar string) CreateReportOption {
+	return func(options *CreateReportOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// CreateReportDuration sets the Duration property of CreateReportOptions. Optional duration of the report,
+// supported unit of duration is hours.
+func CreateReportDuration(duration string) CreateReportOption {
+	return func(options *CreateReportOptions) {
+		options.Duration = core.StringPtr(duration)
+	}
+}
+
+// CreateReportHeader sets the Headers property of CreateReportOptions. Allows users to set headers on API
+// requests.
+func CreateReportHeader(name string, value string) CreateReportOption {
+	return func(options *CreateReportOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateReportOptionsWith returns CreateReportOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewCreateReportOptionsWith(options ...CreateReportOption) *CreateReportOptions {
+	createReportOptions := &CreateReportOptions{}
+	for _, option := range options {
+		option(createReportOptions)
+	}
+	return createReportOptions
+}
+
+// CreateReportWith is an alternate form of the CreateReportWithContext method which takes functional options
+// instead of a CreateReportOptions struct. CreateReportAccountID is required.
+func (iamIdentity *IamIdentityV1) CreateReportWith(ctx context.Context, options ...CreateReportOption) (result *ReportReference, response *core.DetailedResponse, err error) {
+	return iamIdentity.CreateReportWithContext(ctx, iamIdentity.NewCreateReportOptionsWith(options...))
+}
+
+// GetReportOption : A functional option that sets a property of GetReportOptions (see GetReportWith).
+type GetReportOption func(options *GetReportOptions)
+
+// GetReportAccountID sets the AccountID property of GetReportOptions. ID of the account.
+func GetReportAccountID(accountID string) GetReportOption {
+	return func(options *GetReportOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetReportReference sets the Reference property of GetReportOptions. Reference for the report to be generated,
+// You can use 'latest' to get the latest report for the given account.
+func GetReportReference(reference string) GetReportOption {
+	return func(options *GetReportOptions) {
+		options.Reference = core.StringPtr(reference)
+	}
+}
+
+// GetReportHeader sets the Headers property of GetReportOptions. Allows users to set headers on API requests.
+func GetReportHeader(name string, value string) GetReportOption {
+	return func(options *GetReportOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetReportOptionsWith returns GetReportOptions populated by applying "options" in order.
+func (*IamIdentityV1) NewGetReportOptionsWith(options ...GetReportOption) *GetReportOptions {
+	getReportOptions := &GetReportOptions{}
+	for _, option := range options {
+		option(getReportOptions)
+	}
+	return getReportOptions
+}
+
+// GetReportWith is an alternate form of the GetReportWithContext method which takes functional options instead of
+// a GetReportOptions struct. GetReportAccountID and GetReportReference are required.
+func (iamIdentity *IamIdentityV1) GetReportWith(ctx context.Context, options ...GetReportOption) (result *Report, response *core.DetailedResponse, err error) {
+	return iamIdentity.GetReportWithContext(ctx, iamIdentity.NewGetReportOptionsWith(options...))
+}
@@ -0,0 +1,417 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamidentityv1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the ProfileClaimRuleDefault.Type property.
+const (
+	ProfileClaimRuleTypeSamlConst = "Profile-SAML"
+	ProfileClaimRuleTypeCrConst   = "Profile-CR"
+)
+
+// Constants associated with the ProfileDefaultChange.Kind property.
+const (
+	ProfileDefaultChangeKindClaimRuleConst = "claim_rule"
+	ProfileDefaultChangeKindLinkConst      = "link"
+)
+
+// Constants associated with the ProfileDefaultChange.Action property.
+const (
+	ProfileDefaultChangeActionCreateConst    = "create"
+	ProfileDefaultChangeActionUpdateConst    = "update"
+	ProfileDefaultChangeActionUnchangedConst = "unchanged"
+)
+
+// accountSettingNotSet is the value of an account setting that is not set.
+const accountSettingNotSet = "NOT_SET"
+
+// ProfileDefaults : The standard configuration of trusted profiles, applied by ApplyProfileDefaults and
+// ProfileFactory.
+type ProfileDefaults struct {
+	// The session expiration in seconds of the claim rules of type Profile-SAML that don't set one. If zero, the
+	// session expiration of the account settings is used, or that of the service if the account doesn't set one.
+	SessionExpiration int64
+
+	// The claim rules of the profiles, identified by their names.
+	ClaimRules []ProfileClaimRuleDefault
+
+	// The links of the profiles to compute resources.
+	Links []ProfileLinkDefault
+}
+
+// ProfileClaimRuleDefault : A claim rule of ProfileDefaults.
+type ProfileClaimRuleDefault struct {
+	// The name of the claim rule, which identifies it among the claim rules of a profile. Required.
+	Name string
+
+	// The type of the claim rule (a ProfileClaimRuleType*Const value).
+	Type string
+
+	// The realm name of the identity provider, for a claim rule of type Profile-SAML.
+	RealmName string
+
+	// The compute resource type (VSI, IKS_SA or ROKS_SA), for a claim rule of type Profile-CR.
+	CrType string
+
+	// The session expiration in seconds. If zero, the expiration of ProfileDefaults applies.
+	Expiration int64
+
+	// The conditions of the claim rule.
+	Conditions []ProfileClaimRuleConditions
+}
+
+// ProfileLinkDefault : A link of ProfileDefaults to a compute resource.
+type ProfileLinkDefault struct {
+	// The optional name of the link.
+	Name string
+
+	// The compute resource type (VSI, IKS_SA or ROKS_SA).
+	CrType string
+
+	// The CRN of the compute resource.
+	CRN string
+
+	// The namespace and the name of the compute resource, for the types IKS_SA and ROKS_SA.
+	Namespace    string
+	ResourceName string
+}
+
+// ProfileDefaultsResult : The outcome of ApplyProfileDefaults and ProfileFactory.CreateProfile.
+type ProfileDefaultsResult struct {
+	// The trusted profile.
+	Profile *TrustedProfile
+
+	// The claim rules and links of the defaults, in order, with what was done to each.
+	Changes []ProfileDefaultChange
+
+	// The workflow ID sent with every request of the operation (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// ProfileDefaultChange : A claim rule or link of the defaults, as applied to a trusted profile.
+type ProfileDefaultChange struct {
+	// The kind of object (a ProfileDefaultChangeKind*Const value).
+	Kind string
+
+	// The name of the claim rule or link.
+	Name string
+
+	// The ID of the claim rule or link.
+	ID string
+
+	// What was done (a ProfileDefaultChangeAction*Const value).
+	Action string
+}
+
+// ApplyProfileDefaults brings the claim rules and links of a trusted profile in line with "defaults". A claim rule of
+// the defaults is created if the profile has no claim rule with its name, and updated if the claim rule of the profile
+// differs from it; a link is created if the profile has no link to the same compute resource. The claim rules and
+// links of the profile that are not part of the defaults are kept, so that profile-specific configuration survives.
+// The claim rules of type Profile-SAML without an expiration get the session expiration of the defaults, or else of
+// the account settings, so that profiles follow the session policy of the account.
+// If a change fails, the result describes the changes made so far along with the error.
+func (iamIdentity *IamIdentityV1) ApplyProfileDefaults(ctx context.Context, profileID string, defaults *ProfileDefaults) (result *ProfileDefaultsResult, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, iamIdentity.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	err = validateProfileDefaults(defaults)
+	if err != nil {
+		return
+	}
+	profile, _, err := iamIdentity.GetProfileWithContext(ctx, iamIdentity.NewGetProfileOptions(profileID))
+	if err != nil {
+		err = fmt.Errorf("error retrieving trusted profile '%s': %w", profileID, err)
+		return
+	}
+	result = &ProfileDefaultsResult{Profile: profile, WorkflowID: workflowID}
+	err = iamIdentity.applyProfileDefaults(ctx, result, defaults)
+	return
+}
+
+// ProfileFactory : Creates trusted profiles that are configured with standard claim rules and links from the start,
+// instead of repeating their configuration wherever profiles are provisioned.
+type ProfileFactory struct {
+	*IamIdentityV1
+
+	// The account in which the profiles are created.
+	AccountID string
+
+	// The configuration applied to each profile.
+	Defaults *ProfileDefaults
+}
+
+// NewProfileFactory returns a new ProfileFactory that creates profiles with the specified defaults in an account.
+func NewProfileFactory(iamIdentity *IamIdentityV1, accountID string, defaults *ProfileDefaults) *ProfileFactory {
+	return &ProfileFactory{
+		IamIdentityV1: iamIdentity,
+		AccountID:     accountID,
+		Defaults:      defaults,
+	}
+}
+
+// CreateProfile creates a trusted profile and applies the defaults of the factory to it (see ApplyProfileDefaults). If
+// the defaults cannot be applied, the profile is deleted so that no profile remains with part of the standard
+// configuration.
+func (factory *ProfileFactory) CreateProfile(ctx context.Context, name string, description string) (result *ProfileDefaultsResult, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, factory.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	err = validateProfileDefaults(factory.Defaults)
+	if err != nil {
+		return
+	}
+	options := factory.NewCreateProfileOptions(name, factory.AccountID)
+	if description != "" {
+		options.SetDescription(description)
+	}
+	profile, _, err := factory.CreateProfileWithContext(ctx, options)
+	if err != nil {
+		return
+	}
+
+	result = &ProfileDefaultsResult{Profile: profile, WorkflowID: workflowID}
+	err = factory.applyProfileDefaults(ctx, result, factory.Defaults)
+	if err != nil {
+		_, deleteErr := factory.DeleteProfileWithContext(ctx, factory.NewDeleteProfileOptions(*profile.ID))
+		if deleteErr != nil {
+			err = fmt.Errorf("%s; in addition, trusted profile '%s' could not be deleted: %s", err.Error(), *profile.ID, deleteErr.Error())
+		}
+		result = nil
+	}
+	return
+}
+
+// validateProfileDefaults checks that the defaults are complete and that their claim rules have unique names.
+func validateProfileDefaults(defaults *ProfileDefaults) (err error) {
+	err = core.ValidateNotNil(defaults, "defaults cannot be nil")
+	if err != nil {
+		return
+	}
+	names := make(map[string]bool)
+	for _, rule := range defaults.ClaimRules {
+		switch {
+		case rule.Name == "":
+			return fmt.Errorf("claim rules must have a name")
+		case names[rule.Name]:
+			return fmt.Errorf("several claim rules are named '%s'", rule.Name)
+		case len(rule.Conditions) == 0:
+			return fmt.Errorf("claim rule '%s' has no conditions", rule.Name)
+		case rule.Type == ProfileClaimRuleTypeSamlConst && rule.RealmName == "":
+			return fmt.Errorf("claim rule '%s' of type %s must have a realm name", rule.Name, rule.Type)
+		case rule.Type == ProfileClaimRuleTypeCrConst && rule.CrType == "":
+			return fmt.Errorf("claim rule '%s' of type %s must have a compute resource type", rule.Name, rule.Type)
+		case rule.Type != ProfileClaimRuleTypeSamlConst && rule.Type != ProfileClaimRuleTypeCrConst:
+			return fmt.Errorf("claim rule '%s' has unsupported type '%s'", rule.Name, rule.Type)
+		}
+		names[rule.Name] = true
+	}
+	for _, link := range defaults.Links {
+		if link.CrType == "" || link.CRN == "" {
+			return fmt.Errorf("links must have a compute resource type and a CRN")
+		}
+	}
+	return
+}
+
+// applyProfileDefaults creates or updates the claim rules and links of the profile of "result", recording the changes.
+func (iamIdentity *IamIdentityV1) applyProfileDefaults(ctx context.Context, result *ProfileDefaultsResult, defaults *ProfileDefaults) (err error) {
+	profileID := *result.Profile.ID
+	expiration, err := iamIdentity.defaultSessionExpiration(ctx, result.Profile, defaults)
+	if err != nil {
+		return
+	}
+
+	ruleList, _, err := iamIdentity.ListClaimRulesWithContext(ctx, iamIdentity.NewListClaimRulesOptions(profileID))
+	if err != nil {
+		err = fmt.Errorf("error listing the claim rules of trusted profile '%s': %w", profileID, err)
+		return
+	}
+	existingRules := make(map[string]*ProfileClaimRule)
+	for i := range ruleList.Rules {
+		if ruleList.Rules[i].Name != nil {
+			existingRules[*ruleList.Rules[i].Name] = &ruleList.Rules[i]
+		}
+	}
+	for _, rule := range defaults.ClaimRules {
+		if rule.Expiration == 0 && rule.Type == ProfileClaimRuleTypeSamlConst {
+			rule.Expiration = expiration
+		}
+		change := ProfileDefaultChange{Kind: ProfileDefaultChangeKindClaimRuleConst, Name: rule.Name}
+		existing := existingRules[rule.Name]
+		switch {
+		case existing == nil:
+			change.Action = ProfileDefaultChangeActionCreateConst
+			var created *ProfileClaimRule
+			created, _, err = iamIdentity.CreateClaimRuleWithContext(ctx, newCreateClaimRuleOptions(iamIdentity, profileID, &rule))
+			if created != nil {
+				change.ID = core.StringNilMapper(created.ID)
+			}
+		case claimRuleMatches(existing, &rule):
+			change.Action = ProfileDefaultChangeActionUnchangedConst
+			change.ID = core.StringNilMapper(existing.ID)
+		default:
+			change.Action = ProfileDefaultChangeActionUpdateConst
+			change.ID = core.StringNilMapper(existing.ID)
+			_, _, err = iamIdentity.UpdateClaimRuleWithContext(ctx,
+				newUpdateClaimRuleOptions(iamIdentity, profileID, existing, &rule))
+		}
+		if err != nil {
+			err = fmt.Errorf("error applying claim rule '%s' to trusted profile '%s': %w", rule.Name, profileID, err)
+			return
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	linkList, _, err := iamIdentity.ListLinksWithContext(ctx, iamIdentity.NewListLinksOptions(profileID))
+	if err != nil {
+		err = fmt.Errorf("error listing the links of trusted profile '%s': %w", profileID, err)
+		return
+	}
+	for _, link := range defaults.Links {
+		change := ProfileDefaultChange{Kind: ProfileDefaultChangeKindLinkConst, Name: link.Name}
+		for i := range linkList.Links {
+			if linkMatches(&linkList.Links[i], &link) {
+				change.Action = ProfileDefaultChangeActionUnchangedConst
+				change.ID = core.StringNilMapper(linkList.Links[i].ID)
+				break
+			}
+		}
+		if change.Action == "" {
+			change.Action = ProfileDefaultChangeActionCreateConst
+			options := iamIdentity.NewCreateLinkOptions(profileID, link.CrType, &CreateProfileLinkRequestLink{
+				CRN:       core.StringPtr(link.CRN),
+				Namespace: core.StringPtr(link.Namespace),
+			})
+			if link.ResourceName != "" {
+				options.Link.Name = core.StringPtr(link.ResourceName)
+			}
+			if link.Name != "" {
+				options.SetName(link.Name)
+			}
+			var created *ProfileLink
+			created, _, err = iamIdentity.CreateLinkWithContext(ctx, options)
+			if err != nil {
+				err = fmt.Errorf("error linking trusted profile '%s' to '%s': %w", profileID, link.CRN, err)
+				return
+			}
+			change.ID = core.StringNilMapper(created.ID)
+		}
+		result.Changes = append(result.Changes, change)
+	}
+	return
+}
+
+// defaultSessionExpiration returns the session expiration of the claim rules of type Profile-SAML that don't set one:
+// that of the defaults, or else that of the account settings. It is zero if neither sets one. The account settings are
+// only retrieved if they are needed.
+func (iamIdentity *IamIdentityV1) defaultSessionExpiration(ctx context.Context, profile *TrustedProfile, defaults *ProfileDefaults) (expiration int64, err error) {
+	if defaults.SessionExpiration != 0 {
+		return defaults.SessionExpiration, nil
+	}
+	needed := false
+	for _, rule := range defaults.ClaimRules {
+		needed = needed || (rule.Type == ProfileClaimRuleTypeSamlConst && rule.Expiration == 0)
+	}
+	if !needed {
+		return
+	}
+	accountID := core.StringNilMapper(profile.AccountID)
+	settings, _, err := iamIdentity.GetAccountSettingsWithContext(ctx, iamIdentity.NewGetAccountSettingsOptions(accountID))
+	if err != nil {
+		err = fmt.Errorf("error retrieving the settings of account '%s': %w", accountID, err)
+		return
+	}
+	value := core.StringNilMapper(settings.SessionExpirationInSeconds)
+	if value == "" || value == accountSettingNotSet {
+		return
+	}
+	expiration, err = strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		err = fmt.Errorf("invalid session expiration '%s' in the settings of account '%s'", value, accountID)
+	}
+	return
+}
+
+// newCreateClaimRuleOptions returns the options that create a claim rule of the defaults.
+func newCreateClaimRuleOptions(iamIdentity *IamIdentityV1, profileID string, rule *ProfileClaimRuleDefault) *CreateClaimRuleOptions {
+	options := iamIdentity.NewCreateClaimRuleOptions(profileID, rule.Type, rule.Conditions).SetName(rule.Name)
+	if rule.RealmName != "" {
+		options.SetRealmName(rule.RealmName)
+	}
+	if rule.CrType != "" {
+		options.SetCrType(rule.CrType)
+	}
+	if rule.Expiration != 0 {
+		options.SetExpiration(rule.Expiration)
+	}
+	return options
+}
+
+// newUpdateClaimRuleOptions returns the options that replace an existing claim rule with a claim rule of the defaults.
+func newUpdateClaimRuleOptions(iamIdentity *IamIdentityV1, profileID string, existing *ProfileClaimRule, rule *ProfileClaimRuleDefault) *UpdateClaimRuleOptions {
+	options := iamIdentity.NewUpdateClaimRuleOptions(profileID, *existing.ID, core.StringNilMapper(existing.EntityTag),
+		rule.Type, rule.Conditions).SetName(rule.Name)
+	if rule.RealmName != "" {
+		options.SetRealmName(rule.RealmName)
+	}
+	if rule.CrType != "" {
+		options.SetCrType(rule.CrType)
+	}
+	if rule.Expiration != 0 {
+		options.SetExpiration(rule.Expiration)
+	}
+	return options
+}
+
+// claimRuleMatches returns true if an existing claim rule is configured as the claim rule of the defaults. The
+// expiration is only compared if the defaults set one, as the service sets it otherwise.
+func claimRuleMatches(existing *ProfileClaimRule, rule *ProfileClaimRuleDefault) bool {
+	if core.StringNilMapper(existing.Type) != rule.Type ||
+		core.StringNilMapper(existing.RealmName) != rule.RealmName ||
+		core.StringNilMapper(existing.CrType) != rule.CrType ||
+		(rule.Expiration != 0 && (existing.Expiration == nil || *existing.Expiration != rule.Expiration)) ||
+		len(existing.Conditions) != len(rule.Conditions) {
+		return false
+	}
+	for i, condition := range rule.Conditions {
+		other := existing.Conditions[i]
+		if core.StringNilMapper(other.Claim) != core.StringNilMapper(condition.Claim) ||
+			core.StringNilMapper(other.Operator) != core.StringNilMapper(condition.Operator) ||
+			core.StringNilMapper(other.Value) != core.StringNilMapper(condition.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// linkMatches returns true if an existing link is to the compute resource of a link of the defaults.
+func linkMatches(existing *ProfileLink, link *ProfileLinkDefault) bool {
+	if core.StringNilMapper(existing.CrType) != link.CrType || existing.Link == nil {
+		return false
+	}
+	return core.StringNilMapper(existing.Link.CRN) == link.CRN &&
+		core.StringNilMapper(existing.Link.Namespace) == link.Namespace &&
+		core.StringNilMapper(existing.Link.Name) == link.ResourceName
+}
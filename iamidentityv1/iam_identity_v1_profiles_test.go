@@ -0,0 +1,192 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamidentityv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamIdentityV1 profile defaults`, func() {
+	var testServer *httptest.Server
+	var iamIdentityService *iamidentityv1.IamIdentityV1
+	var requests []string
+	var bodies map[string]map[string]interface{}
+	var failLinks bool
+	BeforeEach(func() {
+		requests = nil
+		bodies = make(map[string]map[string]interface{})
+		failLinks = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			request := req.Method + " " + req.URL.EscapedPath()
+			requests = append(requests, request)
+			if req.Method == "POST" || req.Method == "PUT" {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				bodies[request] = body
+			}
+
+			res.Header().Set("Content-type", "application/json")
+			switch request {
+			case "GET /v1/profiles/profile-1":
+				fmt.Fprint(res, `{"id": "profile-1", "name": "ops", "account_id": "acct", "entity_tag": "1"}`)
+			case "POST /v1/profiles":
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "profile-2", "name": "new", "account_id": "acct", "entity_tag": "1"}`)
+			case "DELETE /v1/profiles/profile-2":
+				res.WriteHeader(204)
+			case "GET /v1/accounts/acct/settings/identity":
+				fmt.Fprint(res, `{"account_id": "acct", "session_expiration_in_seconds": "7200"}`)
+			case "GET /v1/profiles/profile-1/rules":
+				fmt.Fprint(res, `{"rules": [
+					{"id": "rule-admins", "entity_tag": "3", "name": "admins", "type": "Profile-SAML", "realm_name": "https://idp.example.com", "expiration": 3600,
+					 "conditions": [{"claim": "groups", "operator": "CONTAINS", "value": "\"ops\""}]},
+					{"id": "rule-custom", "entity_tag": "1", "name": "custom", "type": "Profile-SAML", "realm_name": "https://idp.example.com", "expiration": 900,
+					 "conditions": [{"claim": "email", "operator": "EQUALS", "value": "\"a@example.com\""}]},
+					{"id": "rule-vsi", "entity_tag": "1", "name": "vsi", "type": "Profile-CR", "cr_type": "VSI", "expiration": 43200,
+					 "conditions": [{"claim": "vpc_id", "operator": "EQUALS", "value": "\"vpc-1\""}]}]}`)
+			case "GET /v1/profiles/profile-2/rules", "GET /v1/profiles/profile-2/links":
+				fmt.Fprint(res, `{"rules": [], "links": []}`)
+			case "GET /v1/profiles/profile-1/links":
+				fmt.Fprint(res, `{"links": [{"id": "link-1", "entity_tag": "1", "cr_type": "VSI", "link": {"crn": "crn:vsi-1", "namespace": ""}}]}`)
+			case "POST /v1/profiles/profile-1/rules", "POST /v1/profiles/profile-2/rules":
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"id": "rule-%s", "entity_tag": "1"}`, bodies[request]["name"])
+			case "PUT /v1/profiles/profile-1/rules/rule-admins":
+				Expect(req.Header.Get("If-Match")).To(Equal("3"))
+				fmt.Fprint(res, `{"id": "rule-admins", "entity_tag": "4"}`)
+			case "POST /v1/profiles/profile-1/links", "POST /v1/profiles/profile-2/links":
+				if failLinks {
+					res.WriteHeader(400)
+					fmt.Fprint(res, `{"errors": [{"message": "invalid cluster"}]}`)
+					return
+				}
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "link-2", "entity_tag": "1"}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+			}
+		}))
+		var serviceErr error
+		iamIdentityService, serviceErr = iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(iamIdentityService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	condition := func(claim, operator, value string) iamidentityv1.ProfileClaimRuleConditions {
+		return iamidentityv1.ProfileClaimRuleConditions{Claim: core.StringPtr(claim), Operator: core.StringPtr(operator), Value: core.StringPtr(value)}
+	}
+	newDefaults := func() *iamidentityv1.ProfileDefaults {
+		return &iamidentityv1.ProfileDefaults{
+			ClaimRules: []iamidentityv1.ProfileClaimRuleDefault{
+				{Name: "admins", Type: iamidentityv1.ProfileClaimRuleTypeSamlConst, RealmName: "https://idp.example.com",
+					Conditions: []iamidentityv1.ProfileClaimRuleConditions{condition("groups", "CONTAINS", `"admins"`)}},
+				{Name: "vsi", Type: iamidentityv1.ProfileClaimRuleTypeCrConst, CrType: "VSI",
+					Conditions: []iamidentityv1.ProfileClaimRuleConditions{condition("vpc_id", "EQUALS", `"vpc-1"`)}},
+				{Name: "auditors", Type: iamidentityv1.ProfileClaimRuleTypeSamlConst, RealmName: "https://idp.example.com", Expiration: 900,
+					Conditions: []iamidentityv1.ProfileClaimRuleConditions{condition("groups", "CONTAINS", `"audit"`)}},
+			},
+			Links: []iamidentityv1.ProfileLinkDefault{
+				{CrType: "VSI", CRN: "crn:vsi-1"},
+				{Name: "cluster", CrType: "IKS_SA", CRN: "crn:cluster-1", Namespace: "default", ResourceName: "deployer"},
+			},
+		}
+	}
+
+	It(`Applies the defaults to an existing profile`, func() {
+		result, err := iamIdentityService.ApplyProfileDefaults(context.Background(), "profile-1", newDefaults())
+		Expect(err).To(BeNil())
+		Expect(result.WorkflowID).ToNot(BeEmpty())
+		Expect(*result.Profile.Name).To(Equal("ops"))
+		Expect(result.Changes).To(Equal([]iamidentityv1.ProfileDefaultChange{
+			{Kind: "claim_rule", Name: "admins", ID: "rule-admins", Action: "update"},
+			{Kind: "claim_rule", Name: "vsi", ID: "rule-vsi", Action: "unchanged"},
+			{Kind: "claim_rule", Name: "auditors", ID: "rule-auditors", Action: "create"},
+			{Kind: "link", Name: "", ID: "link-1", Action: "unchanged"},
+			{Kind: "link", Name: "cluster", ID: "link-2", Action: "create"},
+		}))
+		Expect(requests).To(ContainElement("GET /v1/accounts/acct/settings/identity"))
+		Expect(requests).ToNot(ContainElement(ContainSubstring("rule-custom")))
+
+		update := bodies["PUT /v1/profiles/profile-1/rules/rule-admins"]
+		Expect(update["expiration"]).To(Equal(float64(7200)))
+		Expect(update["conditions"]).To(Equal([]interface{}{map[string]interface{}{"claim": "groups", "operator": "CONTAINS", "value": `"admins"`}}))
+		Expect(bodies["POST /v1/profiles/profile-1/rules"]["expiration"]).To(Equal(float64(900)))
+		Expect(bodies["POST /v1/profiles/profile-1/links"]).To(Equal(map[string]interface{}{
+			"name": "cluster", "cr_type": "IKS_SA",
+			"link": map[string]interface{}{"crn": "crn:cluster-1", "namespace": "default", "name": "deployer"},
+		}))
+	})
+	It(`Uses the session expiration of the defaults`, func() {
+		defaults := newDefaults()
+		defaults.SessionExpiration = 1800
+		_, err := iamIdentityService.ApplyProfileDefaults(context.Background(), "profile-1", defaults)
+		Expect(err).To(BeNil())
+		Expect(requests).ToNot(ContainElement("GET /v1/accounts/acct/settings/identity"))
+		Expect(bodies["PUT /v1/profiles/profile-1/rules/rule-admins"]["expiration"]).To(Equal(float64(1800)))
+	})
+	It(`Creates profiles with the defaults`, func() {
+		factory := iamidentityv1.NewProfileFactory(iamIdentityService, "acct", newDefaults())
+		result, err := factory.CreateProfile(context.Background(), "new", "Deployments")
+		Expect(err).To(BeNil())
+		Expect(*result.Profile.ID).To(Equal("profile-2"))
+		Expect(bodies["POST /v1/profiles"]).To(Equal(map[string]interface{}{"name": "new", "account_id": "acct", "description": "Deployments"}))
+		Expect(result.Changes).To(HaveLen(5))
+		for _, change := range result.Changes {
+			Expect(change.Action).To(Equal(iamidentityv1.ProfileDefaultChangeActionCreateConst))
+		}
+	})
+	It(`Deletes a profile whose defaults cannot be applied`, func() {
+		failLinks = true
+		factory := iamidentityv1.NewProfileFactory(iamIdentityService, "acct", newDefaults())
+		result, err := factory.CreateProfile(context.Background(), "new", "")
+		Expect(result).To(BeNil())
+		Expect(err.Error()).To(ContainSubstring("error linking trusted profile 'profile-2' to 'crn:vsi-1'"))
+		Expect(requests).To(ContainElement("DELETE /v1/profiles/profile-2"))
+	})
+	It(`Rejects invalid defaults`, func() {
+		defaults := newDefaults()
+		defaults.ClaimRules[1].Name = "admins"
+		_, err := iamIdentityService.ApplyProfileDefaults(context.Background(), "profile-1", defaults)
+		Expect(err.Error()).To(ContainSubstring("several claim rules are named 'admins'"))
+
+		defaults = newDefaults()
+		defaults.ClaimRules[0].RealmName = ""
+		factory := iamidentityv1.NewProfileFactory(iamIdentityService, "acct", defaults)
+		_, err = factory.CreateProfile(context.Background(), "new", "")
+		Expect(err.Error()).To(ContainSubstring("claim rule 'admins' of type Profile-SAML must have a realm name"))
+		Expect(requests).To(BeEmpty())
+	})
+})
@@ -0,0 +1,50 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamidentityv1_test
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamIdentityV1 redacted models`, func() {
+	apiKey := iamidentityv1.APIKey{
+		ID:     core.StringPtr("ApiKey-1"),
+		Name:   core.StringPtr("my-key"),
+		Apikey: core.StringPtr("do-not-log"),
+	}
+
+	It(`Masks the API key when a model is printed`, func() {
+		for _, printed := range []string{fmt.Sprintf("%+v", apiKey), fmt.Sprintf("%v", &apiKey), apiKey.String()} {
+			Expect(printed).To(HavePrefix("iamidentityv1.APIKey{"))
+			Expect(printed).To(ContainSubstring(`"name":"my-key"`))
+			Expect(printed).ToNot(ContainSubstring("do-not-log"))
+		}
+	})
+	It(`Masks the API key when a model is marshaled`, func() {
+		b, err := apiKey.MarshalRedactedJSON()
+		Expect(err).To(BeNil())
+		Expect(string(b)).To(ContainSubstring(`"apikey":"[REDACTED]"`))
+
+		options := &iamidentityv1.CreateAPIKeyOptions{Name: core.StringPtr("my-key"), IamID: core.StringPtr("iam"), Apikey: core.StringPtr("do-not-log")}
+		Expect(options.String()).ToNot(ContainSubstring("do-not-log"))
+	})
+})
@@ -0,0 +1,204 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamidentityv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The time allowed to delete an API key or service ID whose value could not be stored. The deletion does not use the
+// context of the call, which may be done by then.
+const secretRollbackTimeout = 30 * time.Second
+
+// SecretWriter : Stores key material in a secrets store, such as IBM Cloud Secrets Manager, so that it can be
+// delivered to its consumers without passing through the code that created it.
+type SecretWriter interface {
+	// WriteSecret stores the secret and returns a reference to it, such as the ID or CRN of the secret in the store.
+	WriteSecret(ctx context.Context, secret *APIKeySecret) (reference string, err error)
+}
+
+// SecretWriterFunc : An adapter that allows an ordinary function to be used as a SecretWriter.
+type SecretWriterFunc func(ctx context.Context, secret *APIKeySecret) (reference string, err error)
+
+// WriteSecret calls the function.
+func (writerFunc SecretWriterFunc) WriteSecret(ctx context.Context, secret *APIKeySecret) (string, error) {
+	return writerFunc(ctx, secret)
+}
+
+// APIKeySecret : The value of an API key and the identifiers that describe it, as passed to a SecretWriter.
+type APIKeySecret struct {
+	// The name of the API key.
+	Name string
+
+	// The description of the API key, if any.
+	Description string
+
+	// The unique identifier of the API key.
+	APIKeyID string
+
+	// The IAM ID of the identity that the API key authenticates.
+	IamID string
+
+	// The ID of the account that contains the API key.
+	AccountID string
+
+	// The API key value.
+	Value string
+}
+
+// String returns a description of the secret in which the API key value is redacted, so that the secret can be
+// logged.
+func (secret APIKeySecret) String() string {
+	value := ""
+	if secret.Value != "" {
+		value = "REDACTED"
+	}
+	return fmt.Sprintf("{Name:%s Description:%s APIKeyID:%s IamID:%s AccountID:%s Value:%s}",
+		secret.Name, secret.Description, secret.APIKeyID, secret.IamID, secret.AccountID, value)
+}
+
+// DeliveredAPIKey : An API key whose value was stored by a SecretWriter.
+type DeliveredAPIKey struct {
+	// The API key, without its value.
+	APIKey *APIKey
+
+	// The reference returned by the SecretWriter.
+	SecretReference string
+}
+
+// DeliveredServiceID : A service ID whose API key value was stored by a SecretWriter.
+type DeliveredServiceID struct {
+	// The service ID, with its API key but without the value of the API key.
+	ServiceID *ServiceID
+
+	// The reference returned by the SecretWriter.
+	SecretReference string
+}
+
+// CreateAPIKeyToSecretStore creates an API key and stores its value with "writer" instead of returning it. The value
+// is removed from the returned API key, so only the secret reference reaches the caller. If the value cannot be
+// stored, the API key is deleted so that no key remains whose value is unknown.
+func (iamIdentity *IamIdentityV1) CreateAPIKeyToSecretStore(ctx context.Context, createAPIKeyOptions *CreateAPIKeyOptions, writer SecretWriter) (result *DeliveredAPIKey, err error) {
+	err = core.ValidateNotNil(writer, "writer cannot be nil")
+	if err != nil {
+		return
+	}
+	apiKey, _, err := iamIdentity.CreateAPIKeyWithContext(ctx, createAPIKeyOptions)
+	if err != nil {
+		return
+	}
+	if apiKey.ID == nil {
+		err = fmt.Errorf("the ID of the created API key was not returned")
+		return
+	}
+
+	reference, err := writeAPIKeySecret(ctx, writer, apiKey)
+	if err != nil {
+		err = rollBackSecretDelivery(err, "API key", *apiKey.ID, func(ctx context.Context) error {
+			_, deleteErr := iamIdentity.DeleteAPIKeyWithContext(ctx, iamIdentity.NewDeleteAPIKeyOptions(*apiKey.ID))
+			return deleteErr
+		})
+		return
+	}
+	result = &DeliveredAPIKey{
+		APIKey:          apiKey,
+		SecretReference: reference,
+	}
+	return
+}
+
+// CreateServiceIDToSecretStore creates a service ID together with an API key (createServiceIDOptions.Apikey is
+// required) and stores the value of the API key with "writer" instead of returning it. The value is removed from the
+// returned service ID, so only the secret reference reaches the caller. If the value cannot be stored, the service ID
+// is deleted.
+func (iamIdentity *IamIdentityV1) CreateServiceIDToSecretStore(ctx context.Context, createServiceIDOptions *CreateServiceIDOptions, writer SecretWriter) (result *DeliveredServiceID, err error) {
+	err = core.ValidateNotNil(writer, "writer cannot be nil")
+	if err != nil {
+		return
+	}
+	if createServiceIDOptions != nil && createServiceIDOptions.Apikey == nil {
+		err = fmt.Errorf("createServiceIDOptions.Apikey must be set to create an API key for the service ID")
+		return
+	}
+	created, _, err := iamIdentity.CreateServiceIDWithContext(ctx, createServiceIDOptions)
+	if err != nil {
+		return
+	}
+	if created.ID == nil {
+		err = fmt.Errorf("the ID of the created service ID was not returned")
+		return
+	}
+
+	var reference string
+	if created.Apikey == nil {
+		err = fmt.Errorf("no API key was returned for service ID '%s'", *created.ID)
+	} else {
+		reference, err = writeAPIKeySecret(ctx, writer, created.Apikey)
+	}
+	if err != nil {
+		err = rollBackSecretDelivery(err, "service ID", *created.ID, func(ctx context.Context) error {
+			_, deleteErr := iamIdentity.DeleteServiceIDWithContext(ctx, iamIdentity.NewDeleteServiceIDOptions(*created.ID))
+			return deleteErr
+		})
+		return
+	}
+	result = &DeliveredServiceID{
+		ServiceID:       created,
+		SecretReference: reference,
+	}
+	return
+}
+
+// rollBackSecretDelivery deletes the API key or service ID with the specified ID after its value could not be stored,
+// and returns "err" with the error of the deletion, if any. The deletion gets a context of its own, bounded by
+// secretRollbackTimeout, since the failure may be that the context of the call is done.
+func rollBackSecretDelivery(err error, kind string, id string, deleteFunc func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), secretRollbackTimeout)
+	defer cancel()
+	deleteErr := deleteFunc(ctx)
+	if deleteErr != nil {
+		return fmt.Errorf("%w; in addition, %s '%s' could not be deleted: %s", err, kind, id, deleteErr.Error())
+	}
+	return err
+}
+
+// writeAPIKeySecret stores the value of the API key with the writer and then removes it from the API key.
+func writeAPIKeySecret(ctx context.Context, writer SecretWriter, apiKey *APIKey) (reference string, err error) {
+	if apiKey.Apikey == nil || *apiKey.Apikey == "" {
+		err = fmt.Errorf("the value of API key '%s' was not returned", core.StringNilMapper(apiKey.ID))
+		return
+	}
+	secret := &APIKeySecret{
+		Name:        core.StringNilMapper(apiKey.Name),
+		Description: core.StringNilMapper(apiKey.Description),
+		APIKeyID:    core.StringNilMapper(apiKey.ID),
+		IamID:       core.StringNilMapper(apiKey.IamID),
+		AccountID:   core.StringNilMapper(apiKey.AccountID),
+		Value:       *apiKey.Apikey,
+	}
+	apiKey.Apikey = nil
+
+	reference, err = writer.WriteSecret(ctx, secret)
+	if err != nil {
+		err = fmt.Errorf("error storing the value of API key '%s': %w", secret.APIKeyID, err)
+	}
+	return
+}
@@ -0,0 +1,134 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamidentityv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamIdentityV1 secret delivery`, func() {
+	var testServer *httptest.Server
+	var iamIdentityService *iamidentityv1.IamIdentityV1
+	var deleted []string
+	var stored []*iamidentityv1.APIKeySecret
+	const apiKeyJSON = `{"id": "ApiKey-1", "crn": "crn:key1", "locked": false, "created_by": "me", "name": "deployer-key",
+		"iam_id": "iam-ServiceId-1", "account_id": "acct", "apikey": "s3cr3t"}`
+	BeforeEach(func() {
+		deleted = nil
+		stored = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "POST" && req.URL.EscapedPath() == "/v1/apikeys":
+				res.WriteHeader(201)
+				fmt.Fprint(res, apiKeyJSON)
+			case req.Method == "POST" && req.URL.EscapedPath() == "/v1/serviceids/":
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"id": "ServiceId-1", "iam_id": "iam-ServiceId-1", "entity_tag": "1", "crn": "crn:sid1", "locked": false,
+					"created_at": "2022-01-01T00:00:00Z", "modified_at": "2022-01-01T00:00:00Z", "account_id": "acct", "name": "deployer",
+					"apikey": %s}`, apiKeyJSON)
+			case req.Method == "DELETE":
+				deleted = append(deleted, req.URL.EscapedPath())
+				res.WriteHeader(204)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		iamIdentityService, serviceErr = iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	writer := iamidentityv1.SecretWriterFunc(func(ctx context.Context, secret *iamidentityv1.APIKeySecret) (string, error) {
+		stored = append(stored, secret)
+		return "secret-" + secret.APIKeyID, nil
+	})
+	failingWriter := iamidentityv1.SecretWriterFunc(func(ctx context.Context, secret *iamidentityv1.APIKeySecret) (string, error) {
+		return "", errors.New("secrets manager unavailable")
+	})
+
+	It(`Stores the value of a new API key`, func() {
+		createAPIKeyOptions := iamIdentityService.NewCreateAPIKeyOptions("deployer-key", "iam-ServiceId-1")
+		result, err := iamIdentityService.CreateAPIKeyToSecretStore(context.Background(), createAPIKeyOptions, writer)
+		Expect(err).To(BeNil())
+		Expect(result.SecretReference).To(Equal("secret-ApiKey-1"))
+		Expect(result.APIKey.Apikey).To(BeNil())
+		Expect(stored).To(HaveLen(1))
+		Expect(*stored[0]).To(Equal(iamidentityv1.APIKeySecret{Name: "deployer-key", APIKeyID: "ApiKey-1",
+			IamID: "iam-ServiceId-1", AccountID: "acct", Value: "s3cr3t"}))
+	})
+	It(`Deletes the API key if its value cannot be stored`, func() {
+		createAPIKeyOptions := iamIdentityService.NewCreateAPIKeyOptions("deployer-key", "iam-ServiceId-1")
+		result, err := iamIdentityService.CreateAPIKeyToSecretStore(context.Background(), createAPIKeyOptions, failingWriter)
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("secrets manager unavailable"))
+		Expect(err.Error()).ToNot(ContainSubstring("s3cr3t"))
+		Expect(deleted).To(Equal([]string{"/v1/apikeys/ApiKey-1"}))
+	})
+	It(`Deletes the API key even if the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancellingWriter := iamidentityv1.SecretWriterFunc(func(ctx context.Context, secret *iamidentityv1.APIKeySecret) (string, error) {
+			cancel()
+			return "", ctx.Err()
+		})
+		createAPIKeyOptions := iamIdentityService.NewCreateAPIKeyOptions("deployer-key", "iam-ServiceId-1")
+		_, err := iamIdentityService.CreateAPIKeyToSecretStore(ctx, createAPIKeyOptions, cancellingWriter)
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		Expect(err.Error()).ToNot(ContainSubstring("could not be deleted"))
+		Expect(deleted).To(Equal([]string{"/v1/apikeys/ApiKey-1"}))
+	})
+	It(`Redacts the value of a secret when it is formatted`, func() {
+		secret := &iamidentityv1.APIKeySecret{Name: "deployer-key", APIKeyID: "ApiKey-1", Value: "s3cr3t"}
+		Expect(fmt.Sprint(secret)).ToNot(ContainSubstring("s3cr3t"))
+		Expect(fmt.Sprintf("%+v", *secret)).To(ContainSubstring("Value:REDACTED"))
+		Expect(fmt.Sprint(secret)).To(ContainSubstring("APIKeyID:ApiKey-1"))
+	})
+	It(`Stores the value of the API key of a new service ID`, func() {
+		createServiceIDOptions := iamIdentityService.NewCreateServiceIDOptions("acct", "deployer").
+			SetApikey(&iamidentityv1.APIKeyInsideCreateServiceIDRequest{Name: core.StringPtr("deployer-key")})
+		result, err := iamIdentityService.CreateServiceIDToSecretStore(context.Background(), createServiceIDOptions, writer)
+		Expect(err).To(BeNil())
+		Expect(result.SecretReference).To(Equal("secret-ApiKey-1"))
+		Expect(result.ServiceID.Apikey.Apikey).To(BeNil())
+
+		result, err = iamIdentityService.CreateServiceIDToSecretStore(context.Background(), createServiceIDOptions, failingWriter)
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		Expect(deleted).To(Equal([]string{"/v1/serviceids/ServiceId-1"}))
+
+		_, err = iamIdentityService.CreateServiceIDToSecretStore(context.Background(),
+			iamIdentityService.NewCreateServiceIDOptions("acct", "deployer"), writer)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,157 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Keys of the annotations recorded in policy descriptions.
+const (
+	PolicyAnnotationOwnerConst   = "owner"
+	PolicyAnnotationPurposeConst = "purpose"
+	PolicyAnnotationTeamConst    = "team"
+)
+
+// PolicyDescriptionMaxLength is the maximum length of a policy description.
+const PolicyDescriptionMaxLength = 300
+
+// PolicyAnnotations : Ownership metadata recorded in the description of a policy.
+// The annotations are appended to the description as a bracketed list of key=value pairs, for example:
+//
+//	Key Protect administrators [owner=alice@example.com; team=security; purpose=key rotation]
+//
+// Values cannot contain ";", "=", "[" or "]".
+type PolicyAnnotations struct {
+	// The person or service ID responsible for the policy.
+	Owner string
+
+	// The team that owns the policy.
+	Team string
+
+	// Why the policy exists.
+	Purpose string
+}
+
+// IsEmpty returns true if no annotation is set.
+func (annotations PolicyAnnotations) IsEmpty() bool {
+	return annotations.Owner == "" && annotations.Team == "" && annotations.Purpose == ""
+}
+
+// FormatPolicyDescription returns a policy description made of "text" followed by "annotations". An error is returned
+// if an annotation contains a reserved character or the description is longer than PolicyDescriptionMaxLength.
+func FormatPolicyDescription(text string, annotations PolicyAnnotations) (description string, err error) {
+	var pairs []string
+	for _, annotation := range []struct{ key, value string }{
+		{PolicyAnnotationOwnerConst, annotations.Owner},
+		{PolicyAnnotationTeamConst, annotations.Team},
+		{PolicyAnnotationPurposeConst, annotations.Purpose},
+	} {
+		value := strings.TrimSpace(annotation.value)
+		if value == "" {
+			continue
+		}
+		if strings.ContainsAny(value, ";=[]") {
+			err = fmt.Errorf("policy annotation '%s' cannot contain ';', '=', '[' or ']': '%s'", annotation.key, value)
+			return
+		}
+		pairs = append(pairs, annotation.key+"="+value)
+	}
+
+	description = strings.TrimSpace(text)
+	if len(pairs) > 0 {
+		block := "[" + strings.Join(pairs, "; ") + "]"
+		if description == "" {
+			description = block
+		} else {
+			description += " " + block
+		}
+	}
+	if len(description) > PolicyDescriptionMaxLength {
+		err = fmt.Errorf("policy description is %d characters long; the maximum is %d", len(description), PolicyDescriptionMaxLength)
+		description = ""
+	}
+	return
+}
+
+// ParsePolicyDescription splits a policy description into its free text and the annotations formatted by
+// FormatPolicyDescription. A description without annotations is returned unchanged as "text".
+func ParsePolicyDescription(description string) (text string, annotations PolicyAnnotations) {
+	text = strings.TrimSpace(description)
+	start := strings.LastIndex(text, "[")
+	if start < 0 || !strings.HasSuffix(text, "]") {
+		return
+	}
+
+	var parsed PolicyAnnotations
+	for _, pair := range strings.Split(text[start+1:len(text)-1], ";") {
+		equals := strings.Index(pair, "=")
+		if equals < 0 {
+			// Not an annotation block.
+			return
+		}
+		value := strings.TrimSpace(pair[equals+1:])
+		switch strings.ToLower(strings.TrimSpace(pair[:equals])) {
+		case PolicyAnnotationOwnerConst:
+			parsed.Owner = value
+		case PolicyAnnotationTeamConst:
+			parsed.Team = value
+		case PolicyAnnotationPurposeConst:
+			parsed.Purpose = value
+		}
+	}
+	return strings.TrimSpace(text[:start]), parsed
+}
+
+// GetAnnotations returns the annotations recorded in the description of the policy.
+func (policy *Policy) GetAnnotations() PolicyAnnotations {
+	if policy.Description == nil {
+		return PolicyAnnotations{}
+	}
+	_, annotations := ParsePolicyDescription(*policy.Description)
+	return annotations
+}
+
+// PolicyWithOwner returns a PolicyFilter that matches policies annotated with the specified owner. Owners are
+// compared case-insensitively.
+func PolicyWithOwner(owner string) PolicyFilter {
+	return func(policy *Policy) bool {
+		return strings.EqualFold(policy.GetAnnotations().Owner, owner)
+	}
+}
+
+// PolicyWithTeam returns a PolicyFilter that matches policies annotated with the specified team. Teams are compared
+// case-insensitively.
+func PolicyWithTeam(team string) PolicyFilter {
+	return func(policy *Policy) bool {
+		return strings.EqualFold(policy.GetAnnotations().Team, team)
+	}
+}
+
+// PolicyWithoutOwner returns a PolicyFilter that matches policies that are not annotated with an owner.
+func PolicyWithoutOwner() PolicyFilter {
+	return func(policy *Policy) bool {
+		return policy.GetAnnotations().Owner == ""
+	}
+}
+
+// ListPoliciesByOwner returns the policies in the account that are annotated with the specified owner.
+func (iamPolicyManagement *IamPolicyManagementV1) ListPoliciesByOwner(ctx context.Context, accountID string, owner string) (policies []Policy, err error) {
+	return iamPolicyManagement.ListAllPolicies(ctx, iamPolicyManagement.NewListPoliciesOptions(accountID), PolicyWithOwner(owner))
+}
@@ -0,0 +1,91 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamPolicyManagementV1 policy annotations`, func() {
+	It(`Formats and parses annotated descriptions`, func() {
+		annotations := iampolicymanagementv1.PolicyAnnotations{Owner: "alice@example.com", Team: "security", Purpose: "key rotation"}
+		description, err := iampolicymanagementv1.FormatPolicyDescription("Key Protect administrators", annotations)
+		Expect(err).To(BeNil())
+		Expect(description).To(Equal("Key Protect administrators [owner=alice@example.com; team=security; purpose=key rotation]"))
+
+		text, parsed := iampolicymanagementv1.ParsePolicyDescription(description)
+		Expect(text).To(Equal("Key Protect administrators"))
+		Expect(parsed).To(Equal(annotations))
+
+		description, err = iampolicymanagementv1.FormatPolicyDescription("", iampolicymanagementv1.PolicyAnnotations{Team: "ops"})
+		Expect(err).To(BeNil())
+		Expect(description).To(Equal("[team=ops]"))
+	})
+	It(`Leaves plain descriptions alone`, func() {
+		text, parsed := iampolicymanagementv1.ParsePolicyDescription("Readers [legacy]")
+		Expect(text).To(Equal("Readers [legacy]"))
+		Expect(parsed.IsEmpty()).To(BeTrue())
+	})
+	It(`Rejects invalid annotations`, func() {
+		_, err := iampolicymanagementv1.FormatPolicyDescription("x", iampolicymanagementv1.PolicyAnnotations{Owner: "a;b"})
+		Expect(err).ToNot(BeNil())
+		_, err = iampolicymanagementv1.FormatPolicyDescription(strings.Repeat("x", 290), iampolicymanagementv1.PolicyAnnotations{Owner: "alice"})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("maximum is 300"))
+	})
+	It(`Lists policies by owner`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v1/policies"))
+			Expect(req.URL.Query().Get("account_id")).To(Equal("acct"))
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"policies": [
+				{"id": "p1", "description": "KMS admins [owner=Alice@example.com; team=security]"},
+				{"id": "p2", "description": "Viewers [owner=bob@example.com]"},
+				{"id": "p3"}
+			]}`)
+		}))
+		defer testServer.Close()
+		iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		policies, err := iamPolicyManagementService.ListPoliciesByOwner(context.Background(), "acct", "alice@example.com")
+		Expect(err).To(BeNil())
+		Expect(policies).To(HaveLen(1))
+		Expect(*policies[0].ID).To(Equal("p1"))
+		Expect(policies[0].GetAnnotations().Team).To(Equal("security"))
+
+		policies, err = iamPolicyManagementService.ListAllPolicies(context.Background(),
+			iamPolicyManagementService.NewListPoliciesOptions("acct"), iampolicymanagementv1.PolicyWithoutOwner())
+		Expect(err).To(BeNil())
+		Expect(policies).To(HaveLen(1))
+		Expect(*policies[0].ID).To(Equal("p3"))
+	})
+})
@@ -0,0 +1,264 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package iampolicymanagementv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// IamPolicyManagementV1API : The operations of the IamPolicyManagementV1 service, implemented by *IamPolicyManagementV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type IamPolicyManagementV1API interface {
+	// Clone makes a copy of "iamPolicyManagement" suitable for processing requests.
+	Clone() *IamPolicyManagementV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// ListPolicies : Get policies by attributes
+	ListPolicies(listPoliciesOptions *ListPoliciesOptions) (result *PolicyList, response *core.DetailedResponse, err error)
+
+	// ListPoliciesWithContext is an alternate form of the ListPolicies method which supports a Context parameter
+	ListPoliciesWithContext(ctx context.Context, listPoliciesOptions *ListPoliciesOptions) (result *PolicyList, response *core.DetailedResponse, err error)
+
+	// CreatePolicy : Create a policy
+	CreatePolicy(createPolicyOptions *CreatePolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+
+	// CreatePolicyWithContext is an alternate form of the CreatePolicy method which supports a Context parameter
+	CreatePolicyWithContext(ctx context.Context, createPolicyOptions *CreatePolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+
+	// UpdatePolicy : Update a policy
+	UpdatePolicy(updatePolicyOptions *UpdatePolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+
+	// UpdatePolicyWithContext is an alternate form of the UpdatePolicy method which supports a Context parameter
+	UpdatePolicyWithContext(ctx context.Context, updatePolicyOptions *UpdatePolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+
+	// GetPolicy : Retrieve a policy by ID
+	GetPolicy(getPolicyOptions *GetPolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+
+	// GetPolicyWithContext is an alternate form of the GetPolicy method which supports a Context parameter
+	GetPolicyWithContext(ctx context.Context, getPolicyOptions *GetPolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+
+	// DeletePolicy : Delete a policy by ID
+	DeletePolicy(deletePolicyOptions *DeletePolicyOptions) (response *core.DetailedResponse, err error)
+
+	// DeletePolicyWithContext is an alternate form of the DeletePolicy method which supports a Context parameter
+	DeletePolicyWithContext(ctx context.Context, deletePolicyOptions *DeletePolicyOptions) (response *core.DetailedResponse, err error)
+
+	// PatchPolicy : Restore a deleted policy by ID
+	PatchPolicy(patchPolicyOptions *PatchPolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+
+	// PatchPolicyWithContext is an alternate form of the PatchPolicy method which supports a Context parameter
+	PatchPolicyWithContext(ctx context.Context, patchPolicyOptions *PatchPolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+
+	// ListRoles : Get roles by filters
+	ListRoles(listRolesOptions *ListRolesOptions) (result *RoleList, response *core.DetailedResponse, err error)
+
+	// ListRolesWithContext is an alternate form of the ListRoles method which supports a Context parameter
+	ListRolesWithContext(ctx context.Context, listRolesOptions *ListRolesOptions) (result *RoleList, response *core.DetailedResponse, err error)
+
+	// CreateRole : Create a role
+	CreateRole(createRoleOptions *CreateRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// CreateRoleWithContext is an alternate form of the CreateRole method which supports a Context parameter
+	CreateRoleWithContext(ctx context.Context, createRoleOptions *CreateRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// UpdateRole : Update a role
+	UpdateRole(updateRoleOptions *UpdateRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// UpdateRoleWithContext is an alternate form of the UpdateRole method which supports a Context parameter
+	UpdateRoleWithContext(ctx context.Context, updateRoleOptions *UpdateRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// GetRole : Retrieve a role by ID
+	GetRole(getRoleOptions *GetRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// GetRoleWithContext is an alternate form of the GetRole method which supports a Context parameter
+	GetRoleWithContext(ctx context.Context, getRoleOptions *GetRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// DeleteRole : Delete a role by ID
+	DeleteRole(deleteRoleOptions *DeleteRoleOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteRoleWithContext is an alternate form of the DeleteRole method which supports a Context parameter
+	DeleteRoleWithContext(ctx context.Context, deleteRoleOptions *DeleteRoleOptions) (response *core.DetailedResponse, err error)
+
+	// NewCreatePolicyOptions : Instantiate CreatePolicyOptions
+	NewCreatePolicyOptions(typeVar string, subjects []PolicySubject, roles []PolicyRole, resources []PolicyResource) *CreatePolicyOptions
+
+	// NewCreateRoleOptions : Instantiate CreateRoleOptions
+	NewCreateRoleOptions(displayName string, actions []string, name string, accountID string, serviceName string) *CreateRoleOptions
+
+	// NewDeletePolicyOptions : Instantiate DeletePolicyOptions
+	NewDeletePolicyOptions(policyID string) *DeletePolicyOptions
+
+	// NewDeleteRoleOptions : Instantiate DeleteRoleOptions
+	NewDeleteRoleOptions(roleID string) *DeleteRoleOptions
+
+	// NewGetPolicyOptions : Instantiate GetPolicyOptions
+	NewGetPolicyOptions(policyID string) *GetPolicyOptions
+
+	// NewGetRoleOptions : Instantiate GetRoleOptions
+	NewGetRoleOptions(roleID string) *GetRoleOptions
+
+	// NewListPoliciesOptions : Instantiate ListPoliciesOptions
+	NewListPoliciesOptions(accountID string) *ListPoliciesOptions
+
+	// NewListRolesOptions : Instantiate ListRolesOptions
+	NewListRolesOptions() *ListRolesOptions
+
+	// NewPatchPolicyOptions : Instantiate PatchPolicyOptions
+	NewPatchPolicyOptions(policyID string, ifMatch string) *PatchPolicyOptions
+
+	// NewUpdatePolicyOptions : Instantiate UpdatePolicyOptions
+	NewUpdatePolicyOptions(policyID string, ifMatch string, typeVar string, subjects []PolicySubject, roles []PolicyRole, resources []PolicyResource) *UpdatePolicyOptions
+
+	// NewUpdateRoleOptions : Instantiate UpdateRoleOptions
+	NewUpdateRoleOptions(roleID string, ifMatch string) *UpdateRoleOptions
+
+	// NewPolicyRole : Instantiate PolicyRole (Generic Model Constructor)
+	NewPolicyRole(roleID string) (_model *PolicyRole, err error)
+
+	// NewResourceAttribute : Instantiate ResourceAttribute (Generic Model Constructor)
+	NewResourceAttribute(name string, value string) (_model *ResourceAttribute, err error)
+
+	// NewResourceTag : Instantiate ResourceTag (Generic Model Constructor)
+	NewResourceTag(name string, value string) (_model *ResourceTag, err error)
+
+	// NewSubjectAttribute : Instantiate SubjectAttribute (Generic Model Constructor)
+	NewSubjectAttribute(name string, value string) (_model *SubjectAttribute, err error)
+
+	// ListPoliciesByOwner returns the policies in the account that are annotated with the specified owner.
+	ListPoliciesByOwner(ctx context.Context, accountID string, owner string) (policies []Policy, err error)
+
+	// ListAuthorizationPolicies returns the authorization policies of an account selected by "options", with their
+	// source and target decoded.
+	ListAuthorizationPolicies(ctx context.Context, options *ListAuthorizationPoliciesOptions) (policies []AuthorizationPolicy, err error)
+
+	// InventoryAuthorizationPolicies lists the authorization policies of several accounts, such as the accounts of an
+	// enterprise, so that the service-to-service authorizations between them can be reviewed (see
+	// AuthorizationInventory.CrossAccount).
+	InventoryAuthorizationPolicies(ctx context.Context, accountIDs []string) (inventory *AuthorizationInventory, err error)
+
+	// ListAllPolicies retrieves the policies selected by the server-side filters in "listPoliciesOptions" and returns
+	// those that are matched by every one of the client-side "filters".
+	ListAllPolicies(ctx context.Context, listPoliciesOptions *ListPoliciesOptions, filters ...PolicyFilter) (policies []Policy, err error)
+
+	// NewListPoliciesOptionsWith returns ListPoliciesOptions populated by applying "options" in order.
+	NewListPoliciesOptionsWith(options ...ListPoliciesOption) *ListPoliciesOptions
+
+	// ListPoliciesWith is an alternate form of the ListPoliciesWithContext method which takes functional options
+	// instead of a ListPoliciesOptions struct.
+	ListPoliciesWith(ctx context.Context, options ...ListPoliciesOption) (result *PolicyList, response *core.DetailedResponse, err error)
+
+	// NewCreatePolicyOptionsWith returns CreatePolicyOptions populated by applying "options" in order.
+	NewCreatePolicyOptionsWith(options ...CreatePolicyOption) *CreatePolicyOptions
+
+	// CreatePolicyWith is an alternate form of the CreatePolicyWithContext method which takes functional options
+	// instead of a CreatePolicyOptions struct.
+	CreatePolicyWith(ctx context.Context, options ...CreatePolicyOption) (result *Policy, response *core.DetailedResponse, err error)
+
+	// NewUpdatePolicyOptionsWith returns UpdatePolicyOptions populated by applying "options" in order.
+	NewUpdatePolicyOptionsWith(options ...UpdatePolicyOption) *UpdatePolicyOptions
+
+	// UpdatePolicyWith is an alternate form of the UpdatePolicyWithContext method which takes functional options
+	// instead of an UpdatePolicyOptions struct.
+	UpdatePolicyWith(ctx context.Context, options ...UpdatePolicyOption) (result *Policy, response *core.DetailedResponse, err error)
+
+	// NewGetPolicyOptionsWith returns GetPolicyOptions populated by applying "options" in order.
+	NewGetPolicyOptionsWith(options ...GetPolicyOption) *GetPolicyOptions
+
+	// GetPolicyWith is an alternate form of the GetPolicyWithContext method which takes functional options instead of
+	// a GetPolicyOptions struct.
+	GetPolicyWith(ctx context.Context, options ...GetPolicyOption) (result *Policy, response *core.DetailedResponse, err error)
+
+	// NewDeletePolicyOptionsWith returns DeletePolicyOptions populated by applying "options" in order.
+	NewDeletePolicyOptionsWith(options ...DeletePolicyOption) *DeletePolicyOptions
+
+	// DeletePolicyWith is an alternate form of the DeletePolicyWithContext method which takes functional options
+	// instead of a DeletePolicyOptions struct.
+	DeletePolicyWith(ctx context.Context, options ...DeletePolicyOption) (response *core.DetailedResponse, err error)
+
+	// NewPatchPolicyOptionsWith returns PatchPolicyOptions populated by applying "options" in order.
+	NewPatchPolicyOptionsWith(options ...PatchPolicyOption) *PatchPolicyOptions
+
+	// PatchPolicyWith is an alternate form of the PatchPolicyWithContext method which takes functional options instead
+	// of a PatchPolicyOptions struct.
+	PatchPolicyWith(ctx context.Context, options ...PatchPolicyOption) (result *Policy, response *core.DetailedResponse, err error)
+
+	// NewListRolesOptionsWith returns ListRolesOptions populated by applying "options" in order.
+	NewListRolesOptionsWith(options ...ListRolesOption) *ListRolesOptions
+
+	// ListRolesWith is an alternate form of the ListRolesWithContext method which takes functional options instead of
+	// a ListRolesOptions struct.
+	ListRolesWith(ctx context.Context, options ...ListRolesOption) (result *RoleList, response *core.DetailedResponse, err error)
+
+	// NewCreateRoleOptionsWith returns CreateRoleOptions populated by applying "options" in order.
+	NewCreateRoleOptionsWith(options ...CreateRoleOption) *CreateRoleOptions
+
+	// CreateRoleWith is an alternate form of the CreateRoleWithContext method which takes functional options instead
+	// of a CreateRoleOptions struct.
+	CreateRoleWith(ctx context.Context, options ...CreateRoleOption) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// NewUpdateRoleOptionsWith returns UpdateRoleOptions populated by applying "options" in order.
+	NewUpdateRoleOptionsWith(options ...UpdateRoleOption) *UpdateRoleOptions
+
+	// UpdateRoleWith is an alternate form of the UpdateRoleWithContext method which takes functional options instead
+	// of an UpdateRoleOptions struct.
+	UpdateRoleWith(ctx context.Context, options ...UpdateRoleOption) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// NewGetRoleOptionsWith returns GetRoleOptions populated by applying "options" in order.
+	NewGetRoleOptionsWith(options ...GetRoleOption) *GetRoleOptions
+
+	// GetRoleWith is an alternate form of the GetRoleWithContext method which takes functional options instead of a
+	// GetRoleOptions struct.
+	GetRoleWith(ctx context.Context, options ...GetRoleOption) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// NewDeleteRoleOptionsWith returns DeleteRoleOptions populated by applying "options" in order.
+	NewDeleteRoleOptionsWith(options ...DeleteRoleOption) *DeleteRoleOptions
+
+	// DeleteRoleWith is an alternate form of the DeleteRoleWithContext method which takes functional options instead
+	// of a DeleteRoleOptions struct.
+	DeleteRoleWith(ctx context.Context, options ...DeleteRoleOption) (response *core.DetailedResponse, err error)
+}
+
+var _ IamPolicyManagementV1API = (*IamPolicyManagementV1)(nil)
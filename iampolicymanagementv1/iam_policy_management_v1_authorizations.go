@@ -0,0 +1,251 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// DefaultAuthorizationInventoryConcurrency is the number of accounts whose authorization policies
+// InventoryAuthorizationPolicies lists concurrently.
+const DefaultAuthorizationInventoryConcurrency = 4
+
+// ListAuthorizationPoliciesOptions : The ListAuthorizationPolicies options. The fields other than AccountID select
+// the policies to return; empty fields match every policy.
+type ListAuthorizationPoliciesOptions struct {
+	// The account whose authorization policies are listed.
+	AccountID string
+
+	// The name of the service that is granted access (e.g. "cloud-object-storage").
+	SourceServiceName string
+
+	// The account of the service that is granted access.
+	SourceAccountID string
+
+	// The name of the service that is accessed (e.g. "kms").
+	TargetServiceName string
+
+	// The GUID of the service instance that is accessed.
+	TargetServiceInstance string
+
+	// The CRN of a role that the policies must grant (e.g. RoleReader).
+	Role string
+
+	// The state of the policies (a ListPoliciesOptionsState*Const value). If empty, the server default applies.
+	State string
+}
+
+// AuthorizationPolicy : An authorization policy, which grants a service (the source) access to another service (the
+// target), with the attributes of its subject and resource decoded. Attributes that the policy does not set are empty.
+type AuthorizationPolicy struct {
+	// The policy as returned by the service.
+	Policy Policy
+
+	// The attributes of the subject of the policy.
+	SourceServiceName     string
+	SourceAccountID       string
+	SourceServiceInstance string
+	SourceResourceType    string
+	SourceResourceGroupID string
+
+	// The attributes of the resource of the policy.
+	TargetServiceName     string
+	TargetAccountID       string
+	TargetServiceInstance string
+	TargetResourceType    string
+	TargetResourceGroupID string
+
+	// The CRNs of the roles granted by the policy.
+	Roles []string
+}
+
+// CrossAccount returns true if the policy grants a service of another account access to the target account.
+func (policy *AuthorizationPolicy) CrossAccount() bool {
+	return policy.SourceAccountID != "" && policy.TargetAccountID != "" && policy.SourceAccountID != policy.TargetAccountID
+}
+
+// NewAuthorizationPolicy decodes the subject and resource attributes of an authorization policy.
+func NewAuthorizationPolicy(policy *Policy) *AuthorizationPolicy {
+	authorization := &AuthorizationPolicy{Policy: *policy}
+	source := map[string]*string{
+		PolicyAttributeServiceNameConst:     &authorization.SourceServiceName,
+		PolicyAttributeAccountIDConst:       &authorization.SourceAccountID,
+		PolicyAttributeServiceInstanceConst: &authorization.SourceServiceInstance,
+		PolicyAttributeResourceTypeConst:    &authorization.SourceResourceType,
+		PolicyAttributeResourceGroupIDConst: &authorization.SourceResourceGroupID,
+	}
+	for _, subject := range policy.Subjects {
+		for _, attribute := range subject.Attributes {
+			if field, ok := source[core.StringNilMapper(attribute.Name)]; ok {
+				*field = core.StringNilMapper(attribute.Value)
+			}
+		}
+	}
+	target := map[string]*string{
+		PolicyAttributeServiceNameConst:     &authorization.TargetServiceName,
+		PolicyAttributeAccountIDConst:       &authorization.TargetAccountID,
+		PolicyAttributeServiceInstanceConst: &authorization.TargetServiceInstance,
+		PolicyAttributeResourceTypeConst:    &authorization.TargetResourceType,
+		PolicyAttributeResourceGroupIDConst: &authorization.TargetResourceGroupID,
+	}
+	for _, resource := range policy.Resources {
+		for _, attribute := range resource.Attributes {
+			if field, ok := target[core.StringNilMapper(attribute.Name)]; ok {
+				*field = core.StringNilMapper(attribute.Value)
+			}
+		}
+	}
+	for _, role := range policy.Roles {
+		authorization.Roles = append(authorization.Roles, core.StringNilMapper(role.RoleID))
+	}
+	return authorization
+}
+
+// ListAuthorizationPolicies returns the authorization policies of an account selected by "options", with their
+// source and target decoded. Unlike ListPolicies, access policies are never returned. The common.AccountContext of
+// "ctx", if any, is applied to "options", so the account ID may be left empty.
+func (iamPolicyManagement *IamPolicyManagementV1) ListAuthorizationPolicies(ctx context.Context, options *ListAuthorizationPoliciesOptions) (policies []AuthorizationPolicy, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	err = common.ApplyAccountContext(ctx, options)
+	if err != nil {
+		return
+	}
+	if options.AccountID == "" {
+		err = fmt.Errorf("the account ID must be specified")
+		return
+	}
+
+	listPoliciesOptions := iamPolicyManagement.NewListPoliciesOptions(options.AccountID).
+		SetType(ListPoliciesOptionsTypeAuthorizationConst)
+	if options.State != "" {
+		listPoliciesOptions.SetState(options.State)
+	}
+	var filters []PolicyFilter
+	if options.SourceServiceName != "" {
+		filters = append(filters, PolicyWithSubjectAttribute(PolicyAttributeServiceNameConst, options.SourceServiceName))
+	}
+	if options.SourceAccountID != "" {
+		filters = append(filters, PolicyWithSubjectAttribute(PolicyAttributeAccountIDConst, options.SourceAccountID))
+	}
+	if options.TargetServiceName != "" {
+		filters = append(filters, PolicyWithService(options.TargetServiceName))
+	}
+	if options.TargetServiceInstance != "" {
+		filters = append(filters, PolicyWithResourceAttribute(PolicyAttributeServiceInstanceConst, options.TargetServiceInstance))
+	}
+	if options.Role != "" {
+		filters = append(filters, PolicyWithRole(options.Role))
+	}
+
+	result, err := iamPolicyManagement.ListAllPolicies(ctx, listPoliciesOptions, filters...)
+	if err != nil {
+		return
+	}
+	for i := range result {
+		policies = append(policies, *NewAuthorizationPolicy(&result[i]))
+	}
+	return
+}
+
+// AuthorizationInventory : The outcome of InventoryAuthorizationPolicies.
+type AuthorizationInventory struct {
+	// The authorization policies of each account, in the order of the account IDs passed to
+	// InventoryAuthorizationPolicies.
+	Accounts []AccountAuthorizations
+
+	// The workflow ID sent with every request of the inventory (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// AccountAuthorizations : The authorization policies of one account.
+type AccountAuthorizations struct {
+	// The ID of the account.
+	AccountID string
+
+	// The authorization policies of the account.
+	Policies []AuthorizationPolicy
+
+	// The error that prevented the policies of the account from being listed, if any.
+	Err error
+}
+
+// CrossAccount returns the policies of every account that grant a service of another account access to it.
+func (inventory *AuthorizationInventory) CrossAccount() (policies []AuthorizationPolicy) {
+	for _, account := range inventory.Accounts {
+		for _, policy := range account.Policies {
+			if policy.CrossAccount() {
+				policies = append(policies, policy)
+			}
+		}
+	}
+	return
+}
+
+// Failed returns the accounts whose policies could not be listed.
+func (inventory *AuthorizationInventory) Failed() (failed []AccountAuthorizations) {
+	for _, account := range inventory.Accounts {
+		if account.Err != nil {
+			failed = append(failed, account)
+		}
+	}
+	return
+}
+
+// InventoryAuthorizationPolicies lists the authorization policies of several accounts, such as the accounts of an
+// enterprise, so that the service-to-service authorizations between them can be reviewed (see
+// AuthorizationInventory.CrossAccount). The accounts are listed concurrently.
+//
+// The failure to list the policies of an account does not stop the others: its error is recorded in the inventory,
+// so "err" is only returned if the context is done. In that case the inventory is still returned with a
+// *common.PartialError, and the accounts that were not listed have the error of the context.
+func (iamPolicyManagement *IamPolicyManagementV1) InventoryAuthorizationPolicies(ctx context.Context, accountIDs []string) (inventory *AuthorizationInventory, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, iamPolicyManagement.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	inventory = &AuthorizationInventory{Accounts: make([]AccountAuthorizations, len(accountIDs)), WorkflowID: workflowID}
+	for i, accountID := range accountIDs {
+		inventory.Accounts[i].AccountID = accountID
+	}
+	err = common.ForEachConcurrently(ctx, len(accountIDs), DefaultAuthorizationInventoryConcurrency, func(ctx context.Context, i int) {
+		account := &inventory.Accounts[i]
+		var listErr error
+		account.Policies, listErr = iamPolicyManagement.ListAuthorizationPolicies(ctx, &ListAuthorizationPoliciesOptions{
+			AccountID: account.AccountID,
+			State:     ListPoliciesOptionsStateActiveConst,
+		})
+		if listErr != nil {
+			account.Err = common.WrapWorkflowError(workflowID,
+				fmt.Errorf("error listing the authorization policies of account '%s': %w", account.AccountID, listErr))
+		}
+	})
+
+	var partialErr *common.PartialError
+	if errors.As(err, &partialErr) {
+		for i := partialErr.Processed; i < len(accountIDs); i++ {
+			inventory.Accounts[i].Err = common.WrapWorkflowError(workflowID, partialErr.Err)
+		}
+	}
+	return
+}
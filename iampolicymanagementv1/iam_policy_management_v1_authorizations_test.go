@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const testAuthorizationPolicyListJSON = `{"policies": [
+	{"id": "a1", "type": "authorization", "subjects": [{"attributes": [{"name": "accountId", "value": "acct"}, {"name": "serviceName", "value": "cloud-object-storage"}]}], "roles": [{"role_id": "crn:v1:bluemix:public:iam::::serviceRole:Reader"}], "resources": [{"attributes": [{"name": "accountId", "value": "acct"}, {"name": "serviceName", "value": "kms"}, {"name": "serviceInstance", "value": "kms-1"}]}]},
+	{"id": "a2", "type": "authorization", "subjects": [{"attributes": [{"name": "accountId", "value": "other"}, {"name": "serviceName", "value": "databases-for-postgresql"}, {"name": "serviceInstance", "value": "pg-1"}]}], "roles": [{"role_id": "crn:v1:bluemix:public:iam::::serviceRole:Reader"}, {"role_id": "crn:v1:bluemix:public:iam::::serviceRole:Writer"}], "resources": [{"attributes": [{"name": "accountId", "value": "acct"}, {"name": "serviceName", "value": "kms"}]}]}
+]}`
+
+var _ = Describe(`IamPolicyManagementV1 authorization policies`, func() {
+	var testServer *httptest.Server
+	var iamPolicyManagementService *iampolicymanagementv1.IamPolicyManagementV1
+	var cancelOnRequest context.CancelFunc
+	BeforeEach(func() {
+		cancelOnRequest = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v1/policies"))
+			Expect(req.URL.Query().Get("type")).To(Equal("authorization"))
+			if cancelOnRequest != nil {
+				cancelOnRequest()
+			}
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Query().Get("account_id") {
+			case "acct":
+				res.WriteHeader(200)
+				fmt.Fprint(res, testAuthorizationPolicyListJSON)
+			case "empty":
+				Expect(req.URL.Query().Get("state")).To(Equal("active"))
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"policies": []}`)
+			default:
+				res.WriteHeader(403)
+				fmt.Fprint(res, `{"errors": [{"message": "forbidden"}]}`)
+			}
+		}))
+		var serviceErr error
+		iamPolicyManagementService, serviceErr = iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(iamPolicyManagementService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	listPolicyIDs := func(options *iampolicymanagementv1.ListAuthorizationPoliciesOptions) []string {
+		policies, err := iamPolicyManagementService.ListAuthorizationPolicies(context.Background(), options)
+		Expect(err).To(BeNil())
+		ids := []string{}
+		for _, policy := range policies {
+			ids = append(ids, *policy.Policy.ID)
+		}
+		return ids
+	}
+
+	It(`Decodes the source and target of the policies`, func() {
+		policies, err := iamPolicyManagementService.ListAuthorizationPolicies(context.Background(),
+			&iampolicymanagementv1.ListAuthorizationPoliciesOptions{AccountID: "acct"})
+		Expect(err).To(BeNil())
+		Expect(policies).To(HaveLen(2))
+		Expect(policies[0].SourceServiceName).To(Equal("cloud-object-storage"))
+		Expect(policies[0].TargetServiceName).To(Equal("kms"))
+		Expect(policies[0].TargetServiceInstance).To(Equal("kms-1"))
+		Expect(policies[0].CrossAccount()).To(BeFalse())
+		Expect(policies[1].SourceAccountID).To(Equal("other"))
+		Expect(policies[1].SourceServiceInstance).To(Equal("pg-1"))
+		Expect(policies[1].TargetAccountID).To(Equal("acct"))
+		Expect(policies[1].Roles).To(Equal([]string{iampolicymanagementv1.RoleReader, iampolicymanagementv1.RoleWriter}))
+		Expect(policies[1].CrossAccount()).To(BeTrue())
+	})
+	It(`Selects the policies with the typed fields`, func() {
+		Expect(listPolicyIDs(&iampolicymanagementv1.ListAuthorizationPoliciesOptions{
+			AccountID: "acct", SourceServiceName: "cloud-object-storage"})).To(Equal([]string{"a1"}))
+		Expect(listPolicyIDs(&iampolicymanagementv1.ListAuthorizationPoliciesOptions{
+			AccountID: "acct", SourceAccountID: "other", TargetServiceName: "kms"})).To(Equal([]string{"a2"}))
+		Expect(listPolicyIDs(&iampolicymanagementv1.ListAuthorizationPoliciesOptions{
+			AccountID: "acct", TargetServiceInstance: "kms-1"})).To(Equal([]string{"a1"}))
+		Expect(listPolicyIDs(&iampolicymanagementv1.ListAuthorizationPoliciesOptions{
+			AccountID: "acct", Role: iampolicymanagementv1.RoleWriter})).To(Equal([]string{"a2"}))
+	})
+	It(`Rejects missing options`, func() {
+		_, err := iamPolicyManagementService.ListAuthorizationPolicies(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+		_, err = iamPolicyManagementService.ListAuthorizationPolicies(context.Background(), &iampolicymanagementv1.ListAuthorizationPoliciesOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Inventories the cross-account policies of several accounts`, func() {
+		inventory, err := iamPolicyManagementService.InventoryAuthorizationPolicies(context.Background(), []string{"acct", "empty", "denied"})
+		Expect(err).To(BeNil())
+		Expect(inventory.WorkflowID).ToNot(BeEmpty())
+		Expect(inventory.Accounts).To(HaveLen(3))
+		Expect(inventory.Accounts[0].Policies).To(HaveLen(2))
+		Expect(inventory.Accounts[1].Policies).To(BeEmpty())
+
+		crossAccount := inventory.CrossAccount()
+		Expect(crossAccount).To(HaveLen(1))
+		Expect(*crossAccount[0].Policy.ID).To(Equal("a2"))
+
+		failed := inventory.Failed()
+		Expect(failed).To(HaveLen(1))
+		Expect(failed[0].AccountID).To(Equal("denied"))
+		Expect(failed[0].Err.Error()).To(ContainSubstring("forbidden"))
+	})
+	It(`Returns the partial inventory when the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancelOnRequest = cancel
+		accountIDs := make([]string, 10)
+		for i := range accountIDs {
+			accountIDs[i] = "acct"
+		}
+		inventory, err := iamPolicyManagementService.InventoryAuthorizationPolicies(ctx, accountIDs)
+		var partialErr *common.PartialError
+		Expect(errors.As(err, &partialErr)).To(BeTrue())
+		Expect(partialErr.Processed).To(BeNumerically("<", 10))
+		Expect(inventory.Accounts[9].Err).To(MatchError(context.Canceled))
+	})
+})
@@ -0,0 +1,296 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CRNs of the IAM platform and service roles that are available in every account.
+const (
+	RoleViewer        = "crn:v1:bluemix:public:iam::::role:Viewer"
+	RoleOperator      = "crn:v1:bluemix:public:iam::::role:Operator"
+	RoleEditor        = "crn:v1:bluemix:public:iam::::role:Editor"
+	RoleAdministrator = "crn:v1:bluemix:public:iam::::role:Administrator"
+	RoleReader        = "crn:v1:bluemix:public:iam::::serviceRole:Reader"
+	RoleWriter        = "crn:v1:bluemix:public:iam::::serviceRole:Writer"
+	RoleManager       = "crn:v1:bluemix:public:iam::::serviceRole:Manager"
+)
+
+// Constants associated with the CreatePolicyOptions.Type property.
+const (
+	PolicyTypeAccessConst        = "access"
+	PolicyTypeAuthorizationConst = "authorization"
+)
+
+// Names of the policy subject and resource attributes set by PolicyBuilder.
+const (
+	PolicyAttributeAccessGroupIDConst   = "access_group_id"
+	PolicyAttributeAccountIDConst       = "accountId"
+	PolicyAttributeIamIDConst           = "iam_id"
+	PolicyAttributeRegionConst          = "region"
+	PolicyAttributeResourceConst        = "resource"
+	PolicyAttributeResourceGroupIDConst = "resourceGroupId"
+	PolicyAttributeResourceTypeConst    = "resourceType"
+	PolicyAttributeServiceInstanceConst = "serviceInstance"
+	PolicyAttributeServiceNameConst     = "serviceName"
+)
+
+const (
+	policyBuilderSectionNone = iota
+	policyBuilderSectionSubject
+	policyBuilderSectionResource
+)
+
+// PolicyBuilder : Assembles a validated CreatePolicyOptions instance without requiring the caller to construct the
+// nested subject, role and resource structs by hand.
+//
+// Subject() and Resource() select the section that subsequent attribute methods apply to, for example:
+//
+//	options, err := iampolicymanagementv1.NewPolicyBuilder().
+//	  Subject().IAMID(iamID).
+//	  Role(iampolicymanagementv1.RoleViewer).
+//	  Resource().AccountID(accountID).Service("kms").
+//	  Build()
+//
+// The first error encountered while building is retained and returned by Build().
+type PolicyBuilder struct {
+	policyType  string
+	description *string
+	subjects    []PolicySubject
+	roles       []PolicyRole
+	resources   []PolicyResource
+	section     int
+	err         error
+}
+
+// NewPolicyBuilder returns a new PolicyBuilder for an access policy.
+func NewPolicyBuilder() *PolicyBuilder {
+	return &PolicyBuilder{
+		policyType: PolicyTypeAccessConst,
+	}
+}
+
+// Type sets the policy type ("access" or "authorization").
+func (builder *PolicyBuilder) Type(policyType string) *PolicyBuilder {
+	if policyType != PolicyTypeAccessConst && policyType != PolicyTypeAuthorizationConst {
+		builder.fail(fmt.Errorf("invalid policy type '%s'", policyType))
+	}
+	builder.policyType = policyType
+	return builder
+}
+
+// Description sets the customer-defined description of the policy.
+func (builder *PolicyBuilder) Description(description string) *PolicyBuilder {
+	builder.description = core.StringPtr(description)
+	return builder
+}
+
+// Subject starts a new policy subject. Subsequent attribute methods apply to this subject.
+func (builder *PolicyBuilder) Subject() *PolicyBuilder {
+	builder.subjects = append(builder.subjects, PolicySubject{})
+	builder.section = policyBuilderSectionSubject
+	return builder
+}
+
+// Resource starts the policy resource. Subsequent attribute methods apply to this resource.
+// Only one resource is allowed in a policy.
+func (builder *PolicyBuilder) Resource() *PolicyBuilder {
+	if len(builder.resources) > 0 {
+		builder.fail(fmt.Errorf("only one resource is allowed in a policy"))
+	} else {
+		builder.resources = append(builder.resources, PolicyResource{})
+	}
+	builder.section = policyBuilderSectionResource
+	return builder
+}
+
+// Role adds one or more roles, identified by their CRNs, to the policy.
+func (builder *PolicyBuilder) Role(roleIDs ...string) *PolicyBuilder {
+	for _, roleID := range roleIDs {
+		if roleID == "" {
+			builder.fail(fmt.Errorf("role ID must not be empty"))
+			continue
+		}
+		builder.roles = append(builder.roles, PolicyRole{RoleID: core.StringPtr(roleID)})
+	}
+	return builder
+}
+
+// Attribute adds an attribute to the current subject or resource.
+func (builder *PolicyBuilder) Attribute(name string, value string) *PolicyBuilder {
+	switch builder.section {
+	case policyBuilderSectionSubject:
+		subject := &builder.subjects[len(builder.subjects)-1]
+		subject.Attributes = append(subject.Attributes, SubjectAttribute{
+			Name:  core.StringPtr(name),
+			Value: core.StringPtr(value),
+		})
+	case policyBuilderSectionResource:
+		builder.resourceAttribute(name, value, nil)
+	default:
+		builder.fail(fmt.Errorf("attribute '%s' must follow Subject() or Resource()", name))
+	}
+	return builder
+}
+
+// AttributeWithOperator adds an attribute with an explicit operator (for example "stringMatch") to the resource.
+func (builder *PolicyBuilder) AttributeWithOperator(name string, operator string, value string) *PolicyBuilder {
+	if builder.section != policyBuilderSectionResource {
+		builder.fail(fmt.Errorf("attribute operators are only supported on the policy resource"))
+		return builder
+	}
+	builder.resourceAttribute(name, value, core.StringPtr(operator))
+	return builder
+}
+
+// Tag adds an access management tag to the resource.
+func (builder *PolicyBuilder) Tag(name string, value string) *PolicyBuilder {
+	if builder.section != policyBuilderSectionResource {
+		builder.fail(fmt.Errorf("tags are only supported on the policy resource"))
+		return builder
+	}
+	resource := &builder.resources[len(builder.resources)-1]
+	resource.Tags = append(resource.Tags, ResourceTag{
+		Name:     core.StringPtr(name),
+		Value:    core.StringPtr(value),
+		Operator: core.StringPtr("stringEquals"),
+	})
+	return builder
+}
+
+// IAMID sets the "iam_id" attribute of the current subject.
+func (builder *PolicyBuilder) IAMID(iamID string) *PolicyBuilder {
+	return builder.subjectOnly(PolicyAttributeIamIDConst, iamID)
+}
+
+// AccessGroupID sets the "access_group_id" attribute of the current subject.
+func (builder *PolicyBuilder) AccessGroupID(accessGroupID string) *PolicyBuilder {
+	return builder.subjectOnly(PolicyAttributeAccessGroupIDConst, accessGroupID)
+}
+
+// AccountID sets the "accountId" attribute of the current subject or resource.
+func (builder *PolicyBuilder) AccountID(accountID string) *PolicyBuilder {
+	return builder.Attribute(PolicyAttributeAccountIDConst, accountID)
+}
+
+// Service sets the "serviceName" attribute of the current subject or resource.
+func (builder *PolicyBuilder) Service(serviceName string) *PolicyBuilder {
+	return builder.Attribute(PolicyAttributeServiceNameConst, serviceName)
+}
+
+// ServiceInstance sets the "serviceInstance" attribute of the current subject or resource.
+func (builder *PolicyBuilder) ServiceInstance(serviceInstance string) *PolicyBuilder {
+	return builder.Attribute(PolicyAttributeServiceInstanceConst, serviceInstance)
+}
+
+// Region sets the "region" attribute of the current subject or resource.
+func (builder *PolicyBuilder) Region(region string) *PolicyBuilder {
+	return builder.Attribute(PolicyAttributeRegionConst, region)
+}
+
+// ResourceType sets the "resourceType" attribute of the current subject or resource.
+func (builder *PolicyBuilder) ResourceType(resourceType string) *PolicyBuilder {
+	return builder.Attribute(PolicyAttributeResourceTypeConst, resourceType)
+}
+
+// ResourceID sets the "resource" attribute of the current subject or resource.
+func (builder *PolicyBuilder) ResourceID(resource string) *PolicyBuilder {
+	return builder.Attribute(PolicyAttributeResourceConst, resource)
+}
+
+// ResourceGroupID sets the "resourceGroupId" attribute of the current subject or resource.
+func (builder *PolicyBuilder) ResourceGroupID(resourceGroupID string) *PolicyBuilder {
+	return builder.Attribute(PolicyAttributeResourceGroupIDConst, resourceGroupID)
+}
+
+// Build validates the policy and returns the corresponding CreatePolicyOptions.
+func (builder *PolicyBuilder) Build() (options *CreatePolicyOptions, err error) {
+	if builder.err != nil {
+		err = builder.err
+		return
+	}
+	if len(builder.subjects) == 0 {
+		err = fmt.Errorf("policy must have at least one subject")
+		return
+	}
+	for i, subject := range builder.subjects {
+		if len(subject.Attributes) == 0 {
+			err = fmt.Errorf("policy subject %d has no attributes", i)
+			return
+		}
+	}
+	if len(builder.roles) == 0 {
+		err = fmt.Errorf("policy must grant at least one role")
+		return
+	}
+	if len(builder.resources) == 0 || len(builder.resources[0].Attributes) == 0 {
+		err = fmt.Errorf("policy must have a resource with at least one attribute")
+		return
+	}
+	if !builder.hasResourceAttribute(PolicyAttributeAccountIDConst) {
+		err = fmt.Errorf("policy resource must include the '%s' attribute", PolicyAttributeAccountIDConst)
+		return
+	}
+
+	options = &CreatePolicyOptions{
+		Type:        core.StringPtr(builder.policyType),
+		Subjects:    append([]PolicySubject(nil), builder.subjects...),
+		Roles:       append([]PolicyRole(nil), builder.roles...),
+		Resources:   append([]PolicyResource(nil), builder.resources...),
+		Description: builder.description,
+	}
+	err = core.ValidateStruct(options, "createPolicyOptions")
+	if err != nil {
+		options = nil
+	}
+	return
+}
+
+func (builder *PolicyBuilder) subjectOnly(name string, value string) *PolicyBuilder {
+	if builder.section != policyBuilderSectionSubject {
+		builder.fail(fmt.Errorf("attribute '%s' is only supported on a policy subject", name))
+		return builder
+	}
+	return builder.Attribute(name, value)
+}
+
+func (builder *PolicyBuilder) resourceAttribute(name string, value string, operator *string) {
+	resource := &builder.resources[len(builder.resources)-1]
+	resource.Attributes = append(resource.Attributes, ResourceAttribute{
+		Name:     core.StringPtr(name),
+		Value:    core.StringPtr(value),
+		Operator: operator,
+	})
+}
+
+func (builder *PolicyBuilder) hasResourceAttribute(name string) bool {
+	for _, attribute := range builder.resources[0].Attributes {
+		if attribute.Name != nil && *attribute.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (builder *PolicyBuilder) fail(err error) {
+	if builder.err == nil {
+		builder.err = err
+	}
+}
@@ -0,0 +1,103 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1_test
+
+import (
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamPolicyManagementV1 PolicyBuilder`, func() {
+	It(`Builds an access policy`, func() {
+		options, err := iampolicymanagementv1.NewPolicyBuilder().
+			Description("kms readers").
+			Subject().IAMID("IBMid-123").
+			Role(iampolicymanagementv1.RoleViewer, iampolicymanagementv1.RoleReader).
+			Resource().AccountID("acct").Service("kms").Tag("project", "alpha").
+			Build()
+		Expect(err).To(BeNil())
+		Expect(options).ToNot(BeNil())
+		Expect(*options.Type).To(Equal(iampolicymanagementv1.PolicyTypeAccessConst))
+		Expect(*options.Description).To(Equal("kms readers"))
+		Expect(options.Subjects).To(HaveLen(1))
+		Expect(*options.Subjects[0].Attributes[0].Name).To(Equal("iam_id"))
+		Expect(*options.Subjects[0].Attributes[0].Value).To(Equal("IBMid-123"))
+		Expect(options.Roles).To(HaveLen(2))
+		Expect(*options.Roles[1].RoleID).To(Equal("crn:v1:bluemix:public:iam::::serviceRole:Reader"))
+		Expect(options.Resources).To(HaveLen(1))
+		Expect(options.Resources[0].Attributes).To(HaveLen(2))
+		Expect(*options.Resources[0].Attributes[1].Name).To(Equal("serviceName"))
+		Expect(*options.Resources[0].Tags[0].Operator).To(Equal("stringEquals"))
+	})
+	It(`Builds an authorization policy`, func() {
+		options, err := iampolicymanagementv1.NewPolicyBuilder().
+			Type(iampolicymanagementv1.PolicyTypeAuthorizationConst).
+			Subject().AccountID("acct").Service("cloud-object-storage").
+			Role("crn:v1:bluemix:public:iam::::serviceRole:Reader").
+			Resource().AccountID("acct").Service("kms").AttributeWithOperator("resourceType", "stringMatch", "key*").
+			Build()
+		Expect(err).To(BeNil())
+		Expect(*options.Type).To(Equal("authorization"))
+		Expect(options.Subjects[0].Attributes).To(HaveLen(2))
+		Expect(*options.Resources[0].Attributes[2].Operator).To(Equal("stringMatch"))
+	})
+	It(`Reports validation errors`, func() {
+		_, err := iampolicymanagementv1.NewPolicyBuilder().
+			Role(iampolicymanagementv1.RoleViewer).
+			Resource().AccountID("acct").
+			Build()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("subject"))
+
+		_, err = iampolicymanagementv1.NewPolicyBuilder().
+			Subject().IAMID("IBMid-123").
+			Resource().AccountID("acct").
+			Build()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("role"))
+
+		_, err = iampolicymanagementv1.NewPolicyBuilder().
+			Subject().IAMID("IBMid-123").
+			Role(iampolicymanagementv1.RoleViewer).
+			Resource().Service("kms").
+			Build()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("accountId"))
+
+		_, err = iampolicymanagementv1.NewPolicyBuilder().
+			Subject().IAMID("IBMid-123").
+			Role(iampolicymanagementv1.RoleViewer).
+			Resource().AccountID("acct").IAMID("IBMid-456").
+			Build()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("only supported on a policy subject"))
+
+		_, err = iampolicymanagementv1.NewPolicyBuilder().
+			Subject().IAMID("IBMid-123").
+			Role(iampolicymanagementv1.RoleViewer).
+			Resource().AccountID("acct").
+			Resource().AccountID("acct").
+			Build()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("only one resource"))
+
+		_, err = iampolicymanagementv1.NewPolicyBuilder().Type("bogus").Build()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("invalid policy type"))
+	})
+})
@@ -0,0 +1,130 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// PolicyFilter : A client-side predicate applied to the policies returned by ListAllPolicies.
+type PolicyFilter func(policy *Policy) bool
+
+// PolicyWithSubjectAttribute returns a PolicyFilter that matches policies with a subject that has the attribute
+// "name" set to "value".
+func PolicyWithSubjectAttribute(name string, value string) PolicyFilter {
+	return func(policy *Policy) bool {
+		for _, subject := range policy.Subjects {
+			for _, attribute := range subject.Attributes {
+				if attribute.Name != nil && *attribute.Name == name && attribute.Value != nil && *attribute.Value == value {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// PolicyWithSubject returns a PolicyFilter that matches policies whose subject is the specified IAM ID.
+func PolicyWithSubject(iamID string) PolicyFilter {
+	return PolicyWithSubjectAttribute(PolicyAttributeIamIDConst, iamID)
+}
+
+// PolicyWithResourceAttribute returns a PolicyFilter that matches policies with a resource that has the attribute
+// "name" set to "value".
+func PolicyWithResourceAttribute(name string, value string) PolicyFilter {
+	return func(policy *Policy) bool {
+		for _, resource := range policy.Resources {
+			for _, attribute := range resource.Attributes {
+				if attribute.Name != nil && *attribute.Name == name && attribute.Value != nil && *attribute.Value == value {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// PolicyWithService returns a PolicyFilter that matches policies whose resource is the specified service.
+func PolicyWithService(serviceName string) PolicyFilter {
+	return PolicyWithResourceAttribute(PolicyAttributeServiceNameConst, serviceName)
+}
+
+// PolicyWithRole returns a PolicyFilter that matches policies granting the role with the specified CRN.
+func PolicyWithRole(roleID string) PolicyFilter {
+	return func(policy *Policy) bool {
+		for _, role := range policy.Roles {
+			if role.RoleID != nil && *role.RoleID == roleID {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// AnyPolicyFilter returns a PolicyFilter that matches policies matched by at least one of "filters".
+func AnyPolicyFilter(filters ...PolicyFilter) PolicyFilter {
+	return func(policy *Policy) bool {
+		for _, filter := range filters {
+			if filter(policy) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ListAllPolicies retrieves the policies selected by the server-side filters in "listPoliciesOptions" and returns
+// those that are matched by every one of the client-side "filters".
+// For example, the access policies granting Administrator on a service can be found with:
+//
+//	policies, err := iamPolicyManagementService.ListAllPolicies(ctx,
+//	  iamPolicyManagementService.NewListPoliciesOptions(accountID).SetType("access"),
+//	  iampolicymanagementv1.PolicyWithService("kms"),
+//	  iampolicymanagementv1.PolicyWithRole(iampolicymanagementv1.RoleAdministrator))
+//
+// The ListPolicies operation returns all matching policies in a single response, so no pagination is needed. The
+// common.AccountContext of "ctx", if any, is applied to "listPoliciesOptions", so the account ID may be left empty.
+func (iamPolicyManagement *IamPolicyManagementV1) ListAllPolicies(ctx context.Context, listPoliciesOptions *ListPoliciesOptions, filters ...PolicyFilter) (policies []Policy, err error) {
+	if listPoliciesOptions != nil {
+		err = common.ApplyAccountContext(ctx, listPoliciesOptions)
+		if err != nil {
+			return
+		}
+	}
+	result, _, err := iamPolicyManagement.ListPoliciesWithContext(ctx, listPoliciesOptions)
+	if err != nil {
+		return
+	}
+
+	for i := range result.Policies {
+		if matchesAllPolicyFilters(&result.Policies[i], filters) {
+			policies = append(policies, result.Policies[i])
+		}
+	}
+	return
+}
+
+func matchesAllPolicyFilters(policy *Policy, filters []PolicyFilter) bool {
+	for _, filter := range filters {
+		if !filter(policy) {
+			return false
+		}
+	}
+	return true
+}
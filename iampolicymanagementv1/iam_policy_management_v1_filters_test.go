@@ -0,0 +1,107 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const testPolicyListJSON = `{"policies": [
+	{"id": "p1", "type": "access", "subjects": [{"attributes": [{"name": "iam_id", "value": "IBMid-1"}]}], "roles": [{"role_id": "crn:v1:bluemix:public:iam::::role:Administrator"}], "resources": [{"attributes": [{"name": "accountId", "value": "acct"}, {"name": "serviceName", "value": "kms"}]}]},
+	{"id": "p2", "type": "access", "subjects": [{"attributes": [{"name": "iam_id", "value": "IBMid-2"}]}], "roles": [{"role_id": "crn:v1:bluemix:public:iam::::role:Viewer"}], "resources": [{"attributes": [{"name": "accountId", "value": "acct"}, {"name": "serviceName", "value": "kms"}]}]},
+	{"id": "p3", "type": "access", "subjects": [{"attributes": [{"name": "access_group_id", "value": "AccessGroupId-1"}]}], "roles": [{"role_id": "crn:v1:bluemix:public:iam::::role:Administrator"}], "resources": [{"attributes": [{"name": "accountId", "value": "acct"}, {"name": "serviceName", "value": "cloud-object-storage"}]}]}
+]}`
+
+var _ = Describe(`IamPolicyManagementV1 ListAllPolicies`, func() {
+	var testServer *httptest.Server
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v1/policies"))
+			Expect(req.URL.Query().Get("account_id")).To(Equal("acct"))
+			Expect(req.URL.Query().Get("type")).To(Equal("access"))
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, testPolicyListJSON)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	listPolicyIDs := func(filters ...iampolicymanagementv1.PolicyFilter) []string {
+		iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		options := iamPolicyManagementService.NewListPoliciesOptions("acct").SetType("access")
+		policies, err := iamPolicyManagementService.ListAllPolicies(context.Background(), options, filters...)
+		Expect(err).To(BeNil())
+		ids := []string{}
+		for _, policy := range policies {
+			ids = append(ids, *policy.ID)
+		}
+		return ids
+	}
+
+	It(`Returns all policies without client-side filters`, func() {
+		Expect(listPolicyIDs()).To(Equal([]string{"p1", "p2", "p3"}))
+	})
+	It(`Applies every client-side filter`, func() {
+		Expect(listPolicyIDs(
+			iampolicymanagementv1.PolicyWithService("kms"),
+			iampolicymanagementv1.PolicyWithRole(iampolicymanagementv1.RoleAdministrator),
+		)).To(Equal([]string{"p1"}))
+		Expect(listPolicyIDs(iampolicymanagementv1.PolicyWithSubject("IBMid-2"))).To(Equal([]string{"p2"}))
+		Expect(listPolicyIDs(iampolicymanagementv1.PolicyWithSubjectAttribute("access_group_id", "AccessGroupId-1"))).To(Equal([]string{"p3"}))
+		Expect(listPolicyIDs(iampolicymanagementv1.PolicyWithResourceAttribute("serviceName", "iam-groups"))).To(BeEmpty())
+	})
+	It(`Fills in the account ID from the account context`, func() {
+		iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		ctx := common.WithAccountContext(context.Background(), &common.AccountContext{AccountID: "acct"})
+
+		policies, err := iamPolicyManagementService.ListAllPolicies(ctx, iamPolicyManagementService.NewListPoliciesOptions("").SetType("access"))
+		Expect(err).To(BeNil())
+		Expect(policies).To(HaveLen(3))
+
+		_, err = iamPolicyManagementService.ListAllPolicies(ctx, iamPolicyManagementService.NewListPoliciesOptions("other").SetType("access"))
+		Expect(err).To(MatchError(&common.AccountMismatchError{Field: "AccountID", Expected: "acct", Actual: "other"}))
+	})
+	It(`Combines filters with AnyPolicyFilter`, func() {
+		Expect(listPolicyIDs(iampolicymanagementv1.AnyPolicyFilter(
+			iampolicymanagementv1.PolicyWithSubject("IBMid-1"),
+			iampolicymanagementv1.PolicyWithService("cloud-object-storage"),
+		))).To(Equal([]string{"p1", "p3"}))
+	})
+})
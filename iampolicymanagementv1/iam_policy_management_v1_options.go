@@ -0,0 +1,706 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package iampolicymanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ListPoliciesOption : A functional option that sets a property of ListPoliciesOptions (see ListPoliciesWith).
+type ListPoliciesOption func(options *ListPoliciesOptions)
+
+// ListPoliciesAccountID sets the AccountID property of ListPoliciesOptions. The account GUID in which the policies
+// belong to.
+func ListPoliciesAccountID(accountID string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListPoliciesAcceptLanguage sets the AcceptLanguage property of ListPoliciesOptions. Language code for
+// translations * `default` - English * `de` - German (Standard) * `en` - English * `es` - Spanish (Spain) * `fr` -
+// French (Standard) * `it` - Italian (Standard) * `ja` - Japanese * `ko` - Korean * `pt-br` - Portuguese (Brazil)
+// * `zh-cn` - Chinese (Simplified, PRC) * `zh-tw` - (Chinese, Taiwan).
+func ListPoliciesAcceptLanguage(acceptLanguage string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	}
+}
+
+// ListPoliciesIamID sets the IamID property of ListPoliciesOptions. Optional IAM ID used to identify the subject.
+func ListPoliciesIamID(iamID string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// ListPoliciesAccessGroupID sets the AccessGroupID property of ListPoliciesOptions. Optional access group id.
+func ListPoliciesAccessGroupID(accessGroupID string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		options.AccessGroupID = core.StringPtr(accessGroupID)
+	}
+}
+
+// ListPoliciesType sets the Type property of ListPoliciesOptions. Optional type of policy.
+func ListPoliciesType(typeVar string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// ListPoliciesServiceType sets the ServiceType property of ListPoliciesOptions. Optional type of service.
+func ListPoliciesServiceType(serviceType string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		options.ServiceType = core.StringPtr(serviceType)
+	}
+}
+
+// ListPoliciesTagName sets the TagName property of ListPoliciesOptions. Optional name of the access management tag
+// in the policy.
+func ListPoliciesTagName(tagName string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		options.TagName = core.StringPtr(tagName)
+	}
+}
+
+// ListPoliciesTagValue sets the TagValue property of ListPoliciesOptions. Optional value of the access management
+// tag in the policy.
+func ListPoliciesTagValue(tagValue string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		options.TagValue = core.StringPtr(tagValue)
+	}
+}
+
+// ListPoliciesSort sets the Sort property of ListPoliciesOptions. Optional top level policy field to sort results.
+func ListPoliciesSort(sort string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		options.Sort = core.StringPtr(sort)
+	}
+}
+
+// ListPoliciesFormat sets the Format property of ListPoliciesOptions. Include additional data per policy returned
+// * `include_last_permit` - returns details of when the policy last granted a permit decision and the number of
+// times it has done so * `display` - returns the list of all actions included in each of the policy roles.
+func ListPoliciesFormat(format string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		options.Format = core.StringPtr(format)
+	}
+}
+
+// ListPoliciesState sets the State property of ListPoliciesOptions. The state of the policy.
+func ListPoliciesState(state string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		options.State = core.StringPtr(state)
+	}
+}
+
+// ListPoliciesHeader sets the Headers property of ListPoliciesOptions. Allows users to set headers on API
+// requests.
+func ListPoliciesHeader(name string, value string) ListPoliciesOption {
+	return func(options *ListPoliciesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListPoliciesOptionsWith returns ListPoliciesOptions populated by applying "options" in order.
+func (*IamPolicyManagementV1) NewListPoliciesOptionsWith(options ...ListPoliciesOption) *ListPoliciesOptions {
+	listPoliciesOptions := &ListPoliciesOptions{}
+	for _, option := range options {
+		option(listPoliciesOptions)
+	}
+	return listPoliciesOptions
+}
+
+// ListPoliciesWith is an alternate form of the ListPoliciesWithContext method which takes functional options
+// instead of a ListPoliciesOptions struct. ListPoliciesAccountID is required.
+func (iamPolicyManagement *IamPolicyManagementV1) ListPoliciesWith(ctx context.Context, options ...ListPoliciesOption) (result *PolicyList, response *core.DetailedResponse, err error) {
+	return iamPolicyManagement.ListPoliciesWithContext(ctx, iamPolicyManagement.NewListPoliciesOptionsWith(options...))
+}
+
+// CreatePolicyOption : A functional option that sets a property of CreatePolicyOptions (see CreatePolicyWith).
+type CreatePolicyOption func(options *CreatePolicyOptions)
+
+// CreatePolicyType sets the Type property of CreatePolicyOptions. The policy type; either 'access' or
+// 'authorization'.
+func CreatePolicyType(typeVar string) CreatePolicyOption {
+	return func(options *CreatePolicyOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// CreatePolicySubjects sets the Subjects property of CreatePolicyOptions. The subjects associated with a policy.
+func CreatePolicySubjects(subjects ...PolicySubject) CreatePolicyOption {
+	return func(options *CreatePolicyOptions) {
+		options.Subjects = append(options.Subjects, subjects...)
+	}
+}
+
+// CreatePolicyRoles sets the Roles property of CreatePolicyOptions. A set of role cloud resource names (CRNs)
+// granted by the policy.
+func CreatePolicyRoles(roles ...PolicyRole) CreatePolicyOption {
+	return func(options *CreatePolicyOptions) {
+		options.Roles = append(options.Roles, roles...)
+	}
+}
+
+// CreatePolicyResources sets the Resources property of CreatePolicyOptions. The resources associated with a
+// policy.
+func CreatePolicyResources(resources ...PolicyResource) CreatePolicyOption {
+	return func(options *CreatePolicyOptions) {
+		options.Resources = append(options.Resources, resources...)
+	}
+}
+
+// CreatePolicyDescription sets the Description property of CreatePolicyOptions. Customer-defined description.
+func CreatePolicyDescription(description string) CreatePolicyOption {
+	return func(options *CreatePolicyOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// CreatePolicyAcceptLanguage sets the AcceptLanguage property of CreatePolicyOptions. Language code for
+// translations * `default` - English * `de` - German (Standard) * `en` - English * `es` - Spanish (Spain) * `fr` -
+// French (Standard) * `it` - Italian (Standard) * `ja` - Japanese * `ko` - Korean * `pt-br` - Portuguese (Brazil)
+// * `zh-cn` - Chinese (Simplified, PRC) * `zh-tw` - (Chinese, Taiwan).
+func CreatePolicyAcceptLanguage(acceptLanguage string) CreatePolicyOption {
+	return func(options *CreatePolicyOptions) {
+		options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	}
+}
+
+// CreatePolicyHeader sets the Headers property of CreatePolicyOptions. Allows users to set headers on API
+// requests.
+func CreatePolicyHeader(name string, value string) CreatePolicyOption {
+	return func(options *CreatePolicyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreatePolicyOptionsWith returns CreatePolicyOptions populated by applying "options" in order.
+func (*IamPolicyManagementV1) NewCreatePolicyOptionsWith(options ...CreatePolicyOption) *CreatePolicyOptions {
+	createPolicyOptions := &CreatePolicyOptions{}
+	for _, option := range options {
+		option(createPolicyOptions)
+	}
+	return createPolicyOptions
+}
+
+// CreatePolicyWith is an alternate form of the CreatePolicyWithContext method which takes functional options
+// instead of a CreatePolicyOptions struct. CreatePolicyType, CreatePolicySubjects, CreatePolicyRoles and
+// CreatePolicyResources are required.
+func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyWith(ctx context.Context, options ...CreatePolicyOption) (result *Policy, response *core.DetailedResponse, err error) {
+	return iamPolicyManagement.CreatePolicyWithContext(ctx, iamPolicyManagement.NewCreatePolicyOptionsWith(options...))
+}
+
+// UpdatePolicyOption : A functional option that sets a property of UpdatePolicyOptions (see UpdatePolicyWith).
+type UpdatePolicyOption func(options *UpdatePolicyOptions)
+
+// UpdatePolicyPolicyID sets the PolicyID property of UpdatePolicyOptions. The policy ID.
+func UpdatePolicyPolicyID(policyID string) UpdatePolicyOption {
+	return func(options *UpdatePolicyOptions) {
+		options.PolicyID = core.StringPtr(policyID)
+	}
+}
+
+// UpdatePolicyIfMatch sets the IfMatch property of UpdatePolicyOptions. The revision number for updating a policy
+// and must match the ETag value of the existing policy.
+func UpdatePolicyIfMatch(ifMatch string) UpdatePolicyOption {
+	return func(options *UpdatePolicyOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// UpdatePolicyType sets the Type property of UpdatePolicyOptions. The policy type; either 'access' or
+// 'authorization'.
+func UpdatePolicyType(typeVar string) UpdatePolicyOption {
+	return func(options *UpdatePolicyOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// UpdatePolicySubjects sets the Subjects property of UpdatePolicyOptions. The subjects associated with a policy.
+func UpdatePolicySubjects(subjects ...PolicySubject) UpdatePolicyOption {
+	return func(options *UpdatePolicyOptions) {
+		options.Subjects = append(options.Subjects, subjects...)
+	}
+}
+
+// UpdatePolicyRoles sets the Roles property of UpdatePolicyOptions. A set of role cloud resource names (CRNs)
+// granted by the policy.
+func UpdatePolicyRoles(roles ...PolicyRole) UpdatePolicyOption {
+	return func(options *UpdatePolicyOptions) {
+		options.Roles = append(options.Roles, roles...)
+	}
+}
+
+// UpdatePolicyResources sets the Resources property of UpdatePolicyOptions. The resources associated with a
+// policy.
+func UpdatePolicyResources(resources ...PolicyResource) UpdatePolicyOption {
+	return func(options *UpdatePolicyOptions) {
+		options.Resources = append(options.Resources, resources...)
+	}
+}
+
+// UpdatePolicyDescription sets the Description property of UpdatePolicyOptions. Customer-defined description.
+func UpdatePolicyDescription(description string) UpdatePolicyOption {
+	return func(options *UpdatePolicyOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// UpdatePolicyHeader sets the Headers property of UpdatePolicyOptions. Allows users to set headers on API
+// requests.
+func UpdatePolicyHeader(name string, value string) UpdatePolicyOption {
+	return func(options *UpdatePolicyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdatePolicyOptionsWith returns UpdatePolicyOptions populated by applying "options" in order.
+func (*IamPolicyManagementV1) NewUpdatePolicyOptionsWith(options ...UpdatePolicyOption) *UpdatePolicyOptions {
+	updatePolicyOptions := &UpdatePolicyOptions{}
+	for _, option := range options {
+		option(updatePolicyOptions)
+	}
+	return updatePolicyOptions
+}
+
+// UpdatePolicyWith is an alternate form of the UpdatePolicyWithContext method which takes functional options
+// instead of an UpdatePolicyOptions struct. UpdatePolicyPolicyID, UpdatePolicyIfMatch, UpdatePolicyType,
+// UpdatePolicySubjects, UpdatePolicyRoles and UpdatePolicyResources are required.
+func (iamPolicyManagement *IamPolicyManagementV1) UpdatePolicyWith(ctx context.Context, options ...UpdatePolicyOption) (result *Policy, response *core.DetailedResponse, err error) {
+	return iamPolicyManagement.UpdatePolicyWithContext(ctx, iamPolicyManagement.NewUpdatePolicyOptionsWith(options...))
+}
+
+// GetPolicyOption : A functional option that sets a property of GetPolicyOptions (see GetPolicyWith).
+type GetPolicyOption func(options *GetPolicyOptions)
+
+// GetPolicyPolicyID sets the PolicyID property of GetPolicyOptions. The policy ID.
+func GetPolicyPolicyID(policyID string) GetPolicyOption {
+	return func(options *GetPolicyOptions) {
+		options.PolicyID = core.StringPtr(policyID)
+	}
+}
+
+// GetPolicyHeader sets the Headers property of GetPolicyOptions. Allows users to set headers on API requests.
+func GetPolicyHeader(name string, value string) GetPolicyOption {
+	return func(options *GetPolicyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetPolicyOptionsWith returns GetPolicyOptions populated by applying "options" in order.
+func (*IamPolicyManagementV1) NewGetPolicyOptionsWith(options ...GetPolicyOption) *GetPolicyOptions {
+	getPolicyOptions := &GetPolicyOptions{}
+	for _, option := range options {
+		option(getPolicyOptions)
+	}
+	return getPolicyOptions
+}
+
+// GetPolicyWith is an alternate form of the GetPolicyWithContext method which takes functional options instead of
+// a GetPolicyOptions struct. GetPolicyPolicyID is required.
+func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyWith(ctx context.Context, options ...GetPolicyOption) (result *Policy, response *core.DetailedResponse, err error) {
+	return iamPolicyManagement.GetPolicyWithContext(ctx, iamPolicyManagement.NewGetPolicyOptionsWith(options...))
+}
+
+// DeletePolicyOption : A functional option that sets a property of DeletePolicyOptions (see DeletePolicyWith).
+type DeletePolicyOption func(options *DeletePolicyOptions)
+
+// DeletePolicyPolicyID sets the PolicyID property of DeletePolicyOptions. The policy ID.
+func DeletePolicyPolicyID(policyID string) DeletePolicyOption {
+	return func(options *DeletePolicyOptions) {
+		options.PolicyID = core.StringPtr(policyID)
+	}
+}
+
+// DeletePolicyHeader sets the Headers property of DeletePolicyOptions. Allows users to set headers on API
+// requests.
+func DeletePolicyHeader(name string, value string) DeletePolicyOption {
+	return func(options *DeletePolicyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeletePolicyOptionsWith returns DeletePolicyOptions populated by applying "options" in order.
+func (*IamPolicyManagementV1) NewDeletePolicyOptionsWith(options ...DeletePolicyOption) *DeletePolicyOptions {
+	deletePolicyOptions := &DeletePolicyOptions{}
+	for _, option := range options {
+		option(deletePolicyOptions)
+	}
+	return deletePolicyOptions
+}
+
+// DeletePolicyWith is an alternate form of the DeletePolicyWithContext method which takes functional options
+// instead of a DeletePolicyOptions struct. DeletePolicyPolicyID is required.
+func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyWith(ctx context.Context, options ...DeletePolicyOption) (response *core.DetailedResponse, err error) {
+	return iamPolicyManagement.DeletePolicyWithContext(ctx, iamPolicyManagement.NewDeletePolicyOptionsWith(options...))
+}
+
+// PatchPolicyOption : A functional option that sets a property of PatchPolicyOptions (see PatchPolicyWith).
+type PatchPolicyOption func(options *PatchPolicyOptions)
+
+// PatchPolicyPolicyID sets the PolicyID property of PatchPolicyOptions. The policy ID.
+func PatchPolicyPolicyID(policyID string) PatchPolicyOption {
+	return func(options *PatchPolicyOptions) {
+		options.PolicyID = core.StringPtr(policyID)
+	}
+}
+
+// PatchPolicyIfMatch sets the IfMatch property of PatchPolicyOptions. The revision number for updating a policy
+// and must match the ETag value of the existing policy.
+func PatchPolicyIfMatch(ifMatch string) PatchPolicyOption {
+	return func(options *PatchPolicyOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// PatchPolicyState sets the State property of PatchPolicyOptions. The policy state.
+func PatchPolicyState(state string) PatchPolicyOption {
+	return func(options *PatchPolicyOptions) {
+		options.State = core.StringPtr(state)
+	}
+}
+
+// PatchPolicyHeader sets the Headers property of PatchPolicyOptions. Allows users to set headers on API requests.
+func PatchPolicyHeader(name string, value string) PatchPolicyOption {
+	return func(options *PatchPolicyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewPatchPolicyOptionsWith returns PatchPolicyOptions populated by applying "options" in order.
+func (*IamPolicyManagementV1) NewPatchPolicyOptionsWith(options ...PatchPolicyOption) *PatchPolicyOptions {
+	patchPolicyOptions := &PatchPolicyOptions{}
+	for _, option := range options {
+		option(patchPolicyOptions)
+	}
+	return patchPolicyOptions
+}
+
+// PatchPolicyWith is an alternate form of the PatchPolicyWithContext method which takes functional options instead
+// of a PatchPolicyOptions struct. PatchPolicyPolicyID and PatchPolicyIfMatch are required.
+func (iamPolicyManagement *IamPolicyManagementV1) PatchPolicyWith(ctx context.Context, options ...PatchPolicyOption) (result *Policy, response *core.DetailedResponse, err error) {
+	return iamPolicyManagement.PatchPolicyWithContext(ctx, iamPolicyManagement.NewPatchPolicyOptionsWith(options...))
+}
+
+// ListRolesOption : A functional option that sets a property of ListRolesOptions (see ListRolesWith).
+type ListRolesOption func(options *ListRolesOptions)
+
+// ListRolesAcceptLanguage sets the AcceptLanguage property of ListRolesOptions. Language code for translations *
+// `default` - English * `de` - German (Standard) * `en` - English * `es` - Spanish (Spain) * `fr` - French
+// (Standard) * `it` - Italian (Standard) * `ja` - Japanese * `ko` - Korean * `pt-br` - Portuguese (Brazil) *
+// `zh-cn` - Chinese (Simplified, PRC) * `zh-tw` - (Chinese, Taiwan).
+func ListRolesAcceptLanguage(acceptLanguage string) ListRolesOption {
+	return func(options *ListRolesOptions) {
+		options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	}
+}
+
+// ListRolesAccountID sets the AccountID property of ListRolesOptions. Optional account GUID in which the roles
+// belong to.
+func ListRolesAccountID(accountID string) ListRolesOption {
+	return func(options *ListRolesOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListRolesServiceName sets the ServiceName property of ListRolesOptions. Optional name of IAM enabled service.
+func ListRolesServiceName(serviceName string) ListRolesOption {
+	return func(options *ListRolesOptions) {
+		options.ServiceName = core.StringPtr(serviceName)
+	}
+}
+
+// ListRolesSourceServiceName sets the SourceServiceName property of ListRolesOptions. Optional name of source IAM
+// enabled service.
+func ListRolesSourceServiceName(sourceServiceName string) ListRolesOption {
+	return func(options *ListRolesOptions) {
+		options.SourceServiceName = core.StringPtr(sourceServiceName)
+	}
+}
+
+// ListRolesPolicyType sets the PolicyType property of ListRolesOptions. Optional Policy Type.
+func ListRolesPolicyType(policyType string) ListRolesOption {
+	return func(options *ListRolesOptions) {
+		options.PolicyType = core.StringPtr(policyType)
+	}
+}
+
+// ListRolesHeader sets the Headers property of ListRolesOptions. Allows users to set headers on API requests.
+func ListRolesHeader(name string, value string) ListRolesOption {
+	return func(options *ListRolesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListRolesOptionsWith returns ListRolesOptions populated by applying "options" in order.
+func (*IamPolicyManagementV1) NewListRolesOptionsWith(options ...ListRolesOption) *ListRolesOptions {
+	listRolesOptions := &ListRolesOptions{}
+	for _, option := range options {
+		option(listRolesOptions)
+	}
+	return listRolesOptions
+}
+
+// ListRolesWith is an alternate form of the ListRolesWithContext method which takes functional options instead of
+// a ListRolesOptions struct.
+func (iamPolicyManagement *IamPolicyManagementV1) ListRolesWith(ctx context.Context, options ...ListRolesOption) (result *RoleList, response *core.DetailedResponse, err error) {
+	return iamPolicyManagement.ListRolesWithContext(ctx, iamPolicyManagement.NewListRolesOptionsWith(options...))
+}
+
+// CreateRoleOption : A functional option that sets a property of CreateRoleOptions (see CreateRoleWith).
+type CreateRoleOption func(options *CreateRoleOptions)
+
+// CreateRoleDisplayName sets the DisplayName property of CreateRoleOptions. The display name of the role that is
+// shown in the console.
+func CreateRoleDisplayName(displayName string) CreateRoleOption {
+	return func(options *CreateRoleOptions) {
+		options.DisplayName = core.StringPtr(displayName)
+	}
+}
+
+// CreateRoleActions sets the Actions property of CreateRoleOptions. The actions of the role.
+func CreateRoleActions(actions ...string) CreateRoleOption {
+	return func(options *CreateRoleOptions) {
+		options.Actions = append(options.Actions, actions...)
+	}
+}
+
+// CreateRoleName sets the Name property of CreateRoleOptions. The name of the role that is used in the CRN.
+func CreateRoleName(nameVar string) CreateRoleOption {
+	return func(options *CreateRoleOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateRoleAccountID sets the AccountID property of CreateRoleOptions. The account GUID.
+func CreateRoleAccountID(accountID string) CreateRoleOption {
+	return func(options *CreateRoleOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// CreateRoleServiceName sets the ServiceName property of CreateRoleOptions. The service name.
+func CreateRoleServiceName(serviceName string) CreateRoleOption {
+	return func(options *CreateRoleOptions) {
+		options.ServiceName = core.StringPtr(serviceName)
+	}
+}
+
+// CreateRoleDescription sets the Description property of CreateRoleOptions. The description of the role.
+func CreateRoleDescription(description string) CreateRoleOption {
+	return func(options *CreateRoleOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// CreateRoleAcceptLanguage sets the AcceptLanguage property of CreateRoleOptions. Language code for translations *
+// `default` - English * `de` - German (Standard) * `en` - English * `es` - Spanish (Spain) * `fr` - French
+// (Standard) * `it` - Italian (Standard) * `ja` - Japanese * `ko` - Korean * `pt-br` - Portuguese (Brazil) *
+// `zh-cn` - Chinese (Simplified, PRC) * `zh-tw` - (Chinese, Taiwan).
+func CreateRoleAcceptLanguage(acceptLanguage string) CreateRoleOption {
+	return func(options *CreateRoleOptions) {
+		options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	}
+}
+
+// CreateRoleHeader sets the Headers property of CreateRoleOptions. Allows users to set headers on API requests.
+func CreateRoleHeader(name string, value string) CreateRoleOption {
+	return func(options *CreateRoleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateRoleOptionsWith returns CreateRoleOptions populated by applying "options" in order.
+func (*IamPolicyManagementV1) NewCreateRoleOptionsWith(options ...CreateRoleOption) *CreateRoleOptions {
+	createRoleOptions := &CreateRoleOptions{}
+	for _, option := range options {
+		option(createRoleOptions)
+	}
+	return createRoleOptions
+}
+
+// CreateRoleWith is an alternate form of the CreateRoleWithContext method which takes functional options instead
+// of a CreateRoleOptions struct. CreateRoleDisplayName, CreateRoleActions, CreateRoleName, CreateRoleAccountID and
+// CreateRoleServiceName are required.
+func (iamPolicyManagement *IamPolicyManagementV1) CreateRoleWith(ctx context.Context, options ...CreateRoleOption) (result *CustomRole, response *core.DetailedResponse, err error) {
+	return iamPolicyManagement.CreateRoleWithContext(ctx, iamPolicyManagement.NewCreateRoleOptionsWith(options...))
+}
+
+// UpdateRoleOption : A functional option that sets a property of UpdateRoleOptions (see UpdateRoleWith).
+type UpdateRoleOption func(options *UpdateRoleOptions)
+
+// UpdateRoleRoleID sets the RoleID property of UpdateRoleOptions. The role ID.
+func UpdateRoleRoleID(roleID string) UpdateRoleOption {
+	return func(options *UpdateRoleOptions) {
+		options.RoleID = core.StringPtr(roleID)
+	}
+}
+
+// UpdateRoleIfMatch sets the IfMatch property of UpdateRoleOptions. The revision number for updating a role and
+// must match the ETag value of the existing role.
+func UpdateRoleIfMatch(ifMatch string) UpdateRoleOption {
+	return func(options *UpdateRoleOptions) {
+		options.IfMatch = core.StringPtr(ifMatch)
+	}
+}
+
+// UpdateRoleDisplayName sets the DisplayName property of UpdateRoleOptions. The display name of the role that is
+// shown in the console.
+func UpdateRoleDisplayName(displayName string) UpdateRoleOption {
+	return func(options *UpdateRoleOptions) {
+		options.DisplayName = core.StringPtr(displayName)
+	}
+}
+
+// UpdateRoleDescription sets the Description property of UpdateRoleOptions. The description of the role.
+func UpdateRoleDescription(description string) UpdateRoleOption {
+	return func(options *UpdateRoleOptions) {
+		options.Description = core.StringPtr(description)
+	}
+}
+
+// UpdateRoleActions sets the Actions property of UpdateRoleOptions. The actions of the role.
+func UpdateRoleActions(actions ...string) UpdateRoleOption {
+	return func(options *UpdateRoleOptions) {
+		options.Actions = append(options.Actions, actions...)
+	}
+}
+
+// UpdateRoleHeader sets the Headers property of UpdateRoleOptions. Allows users to set headers on API requests.
+func UpdateRoleHeader(name string, value string) UpdateRoleOption {
+	return func(options *UpdateRoleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateRoleOptionsWith returns UpdateRoleOptions populated by applying "options" in order.
+func (*IamPolicyManagementV1) NewUpdateRoleOptionsWith(options ...UpdateRoleOption) *UpdateRoleOptions {
+	updateRoleOptions := &UpdateRoleOptions{}
+	for _, option := range options {
+		option(updateRoleOptions)
+	}
+	return updateRoleOptions
+}
+
+// UpdateRoleWith is an alternate form of the UpdateRoleWithContext method which takes functional options instead
+// of an UpdateRoleOptions struct. UpdateRoleRoleID and UpdateRoleIfMatch are required.
+func (iamPolicyManagement *IamPolicyManagementV1) UpdateRoleWith(ctx context.Context, options ...UpdateRoleOption) (result *CustomRole, response *core.DetailedResponse, err error) {
+	return iamPolicyManagement.UpdateRoleWithContext(ctx, iamPolicyManagement.NewUpdateRoleOptionsWith(options...))
+}
+
+// GetRoleOption : A functional option that sets a property of GetRoleOptions (see GetRoleWith).
+type GetRoleOption func(options *GetRoleOptions)
+
+// GetRoleRoleID sets the RoleID property of GetRoleOptions. The role ID.
+func GetRoleRoleID(roleID string) GetRoleOption {
+	return func(options *GetRoleOptions) {
+		options.RoleID = core.StringPtr(roleID)
+	}
+}
+
+// GetRoleHeader sets the Headers property of GetRoleOptions. Allows users to set headers on API requests.
+func GetRoleHeader(name string, value string) GetRoleOption {
+	return func(options *GetRoleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetRoleOptionsWith returns GetRoleOptions populated by applying "options" in order.
+func (*IamPolicyManagementV1) NewGetRoleOptionsWith(options ...GetRoleOption) *GetRoleOptions {
+	getRoleOptions := &GetRoleOptions{}
+	for _, option := range options {
+		option(getRoleOptions)
+	}
+	return getRoleOptions
+}
+
+// GetRoleWith is an alternate form of the GetRoleWithContext method which takes functional options instead of a
+// GetRoleOptions struct. GetRoleRoleID is required.
+func (iamPolicyManagement *IamPolicyManagementV1) GetRoleWith(ctx context.Context, options ...GetRoleOption) (result *CustomRole, response *core.DetailedResponse, err error) {
+	return iamPolicyManagement.GetRoleWithContext(ctx, iamPolicyManagement.NewGetRoleOptionsWith(options...))
+}
+
+// DeleteRoleOption : A functional option that sets a property of DeleteRoleOptions (see DeleteRoleWith).
+type DeleteRoleOption func(options *DeleteRoleOptions)
+
+// DeleteRoleRoleID sets the RoleID property of DeleteRoleOptions. The role ID.
+func DeleteRoleRoleID(roleID string) DeleteRoleOption {
+	return func(options *DeleteRoleOptions) {
+		options.RoleID = core.StringPtr(roleID)
+	}
+}
+
+// DeleteRoleHeader sets the Headers property of DeleteRoleOptions. Allows users to set headers on API requests.
+func DeleteRoleHeader(name string, value string) DeleteRoleOption {
+	return func(options *DeleteRoleOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteRoleOptionsWith returns DeleteRoleOptions populated by applying "options" in order.
+func (*IamPolicyManagementV1) NewDeleteRoleOptionsWith(options ...DeleteRoleOption) *DeleteRoleOptions {
+	deleteRoleOptions := &DeleteRoleOptions{}
+	for _, option := range options {
+		option(deleteRoleOptions)
+	}
+	return deleteRoleOptions
+}
+
+// DeleteRoleWith is an alternate form of the DeleteRoleWithContext method which takes functional options instead
+// of a DeleteRoleOptions struct. DeleteRoleRoleID is required.
+func (iamPolicyManagement *IamPolicyManagementV1) DeleteRoleWith(ctx context.Context, options ...DeleteRoleOption) (response *core.DetailedResponse, err error) {
+	return iamPolicyManagement.DeleteRoleWithContext(ctx, iamPolicyManagement.NewDeleteRoleOptionsWith(options...))
+}
@@ -0,0 +1,213 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// PolicyReplicationMapping : Which policies ReplicatePolicies copies from one account to another, and how the IDs they
+// refer to are translated.
+type PolicyReplicationMapping struct {
+	// The account whose policies are copied.
+	SourceAccountID string
+
+	// The account in which the policies are created. The "accountId" attribute of every resource is set to it.
+	TargetAccountID string
+
+	// If not empty, only policies of this type (e.g. "access") are copied.
+	Type string
+
+	// Only the policies matched by every filter are copied.
+	Filters []PolicyFilter
+
+	// The values of subject attributes (e.g. access group IDs and service IDs) in the target account, by their values
+	// in the source account. Values that are not mapped are copied unchanged.
+	SubjectIDs map[string]string
+
+	// The values of resource attributes and tags (e.g. resource group IDs and service instance IDs) in the target
+	// account, by their values in the source account. Values that are not mapped are copied unchanged.
+	ResourceIDs map[string]string
+}
+
+// ReplicatedPolicy : A policy copied, or to be copied, by ReplicatePolicies.
+type ReplicatedPolicy struct {
+	// The ID of the policy in the source account.
+	SourcePolicyID string
+
+	// The options with which the policy is created in the target account.
+	Options *CreatePolicyOptions
+
+	// True if an equivalent policy already exists in the target account, in which case no policy is created.
+	Exists bool
+
+	// The policy created in the target account; nil in a dry run or if the policy exists.
+	Policy *Policy
+}
+
+// ReplicatePolicies copies the policies of the source account selected by "mapping" to the target account, remapping
+// the IDs of their subjects and resources, so that a parallel environment can be given an equivalent access model.
+// "sourceClient" and "targetClient" may be the same client if its credentials have access to both accounts.
+// Policies for which an equivalent policy (with the same type, subjects, roles and resources) exists in the target
+// account are not created again, so replication can be repeated. If "dryRun" is true, no policies are created.
+// The policies are returned in the order of the source account; if creating a policy fails, the policies replicated
+// so far are returned with the error.
+func ReplicatePolicies(ctx context.Context, sourceClient *IamPolicyManagementV1, targetClient *IamPolicyManagementV1, mapping *PolicyReplicationMapping, dryRun bool) (replicated []ReplicatedPolicy, err error) {
+	err = core.ValidateNotNil(mapping, "mapping cannot be nil")
+	if err != nil {
+		return
+	}
+	if mapping.SourceAccountID == "" || mapping.TargetAccountID == "" {
+		err = fmt.Errorf("the source and target account IDs must be specified")
+		return
+	}
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, sourceClient.Service, targetClient.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	sourceOptions := sourceClient.NewListPoliciesOptions(mapping.SourceAccountID)
+	targetOptions := targetClient.NewListPoliciesOptions(mapping.TargetAccountID)
+	if mapping.Type != "" {
+		sourceOptions.SetType(mapping.Type)
+		targetOptions.SetType(mapping.Type)
+	}
+	sourcePolicies, err := sourceClient.ListAllPolicies(ctx, sourceOptions, mapping.Filters...)
+	if err != nil {
+		err = fmt.Errorf("error listing the policies of the source account: %w", err)
+		return
+	}
+	targetPolicies, err := targetClient.ListAllPolicies(ctx, targetOptions)
+	if err != nil {
+		err = fmt.Errorf("error listing the policies of the target account: %w", err)
+		return
+	}
+	existing := make(map[string]bool)
+	for _, policy := range targetPolicies {
+		existing[policySignature(policy.Type, policy.Subjects, policy.Roles, policy.Resources)] = true
+	}
+
+	for i := range sourcePolicies {
+		source := &sourcePolicies[i]
+		options := mapping.remap(source)
+		signature := policySignature(options.Type, options.Subjects, options.Roles, options.Resources)
+		replica := ReplicatedPolicy{
+			SourcePolicyID: core.StringNilMapper(source.ID),
+			Options:        options,
+			Exists:         existing[signature],
+		}
+		if !dryRun && !replica.Exists {
+			replica.Policy, _, err = targetClient.CreatePolicyWithContext(ctx, options)
+			if err != nil {
+				err = fmt.Errorf("error replicating policy '%s': %w", replica.SourcePolicyID, err)
+				return
+			}
+			existing[signature] = true
+		}
+		replicated = append(replicated, replica)
+	}
+	return
+}
+
+// remap returns the options that create a copy of "policy" in the target account.
+func (mapping *PolicyReplicationMapping) remap(policy *Policy) *CreatePolicyOptions {
+	mapValue := func(ids map[string]string, value *string) *string {
+		if value != nil {
+			if mapped, found := ids[*value]; found {
+				return core.StringPtr(mapped)
+			}
+		}
+		return value
+	}
+
+	options := &CreatePolicyOptions{
+		Type:        policy.Type,
+		Description: policy.Description,
+	}
+	for _, subject := range policy.Subjects {
+		remapped := PolicySubject{}
+		for _, attribute := range subject.Attributes {
+			remapped.Attributes = append(remapped.Attributes, SubjectAttribute{
+				Name:  attribute.Name,
+				Value: mapValue(mapping.SubjectIDs, attribute.Value),
+			})
+		}
+		options.Subjects = append(options.Subjects, remapped)
+	}
+	for _, role := range policy.Roles {
+		options.Roles = append(options.Roles, PolicyRole{RoleID: role.RoleID})
+	}
+	for _, resource := range policy.Resources {
+		remapped := PolicyResource{}
+		for _, attribute := range resource.Attributes {
+			value := mapValue(mapping.ResourceIDs, attribute.Value)
+			if attribute.Name != nil && *attribute.Name == PolicyAttributeAccountIDConst {
+				value = core.StringPtr(mapping.TargetAccountID)
+			}
+			remapped.Attributes = append(remapped.Attributes, ResourceAttribute{
+				Name:     attribute.Name,
+				Value:    value,
+				Operator: attribute.Operator,
+			})
+		}
+		for _, tag := range resource.Tags {
+			remapped.Tags = append(remapped.Tags, ResourceTag{
+				Name:     tag.Name,
+				Value:    mapValue(mapping.ResourceIDs, tag.Value),
+				Operator: tag.Operator,
+			})
+		}
+		options.Resources = append(options.Resources, remapped)
+	}
+	return options
+}
+
+// policySignature returns a string that is equal for policies that grant the same access, regardless of the order
+// of their attributes and roles.
+func policySignature(policyType *string, subjects []PolicySubject, roles []PolicyRole, resources []PolicyResource) string {
+	var parts []string
+	for _, subject := range subjects {
+		var attributes []string
+		for _, attribute := range subject.Attributes {
+			attributes = append(attributes, core.StringNilMapper(attribute.Name)+"="+core.StringNilMapper(attribute.Value))
+		}
+		sort.Strings(attributes)
+		parts = append(parts, "subject:"+strings.Join(attributes, ","))
+	}
+	for _, role := range roles {
+		parts = append(parts, "role:"+core.StringNilMapper(role.RoleID))
+	}
+	for _, resource := range resources {
+		var attributes []string
+		for _, attribute := range resource.Attributes {
+			attributes = append(attributes, core.StringNilMapper(attribute.Name)+core.StringNilMapper(attribute.Operator)+"="+
+				core.StringNilMapper(attribute.Value))
+		}
+		for _, tag := range resource.Tags {
+			attributes = append(attributes, "tag:"+core.StringNilMapper(tag.Name)+core.StringNilMapper(tag.Operator)+"="+
+				core.StringNilMapper(tag.Value))
+		}
+		sort.Strings(attributes)
+		parts = append(parts, "resource:"+strings.Join(attributes, ","))
+	}
+	sort.Strings(parts)
+	return core.StringNilMapper(policyType) + "|" + strings.Join(parts, "|")
+}
@@ -0,0 +1,126 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamPolicyManagementV1 ReplicatePolicies`, func() {
+	var testServer *httptest.Server
+	var iamPolicyManagementService *iampolicymanagementv1.IamPolicyManagementV1
+	var created []map[string]interface{}
+	BeforeEach(func() {
+		created = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v1/policies"))
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method {
+			case "GET":
+				Expect(req.URL.Query().Get("type")).To(Equal("access"))
+				switch req.URL.Query().Get("account_id") {
+				case "acct":
+					fmt.Fprint(res, testPolicyListJSON)
+				case "target":
+					// The copy of p2, with its attributes in a different order.
+					fmt.Fprint(res, `{"policies": [{"id": "t1", "type": "access",
+						"subjects": [{"attributes": [{"name": "iam_id", "value": "IBMid-2"}]}],
+						"roles": [{"role_id": "crn:v1:bluemix:public:iam::::role:Viewer"}],
+						"resources": [{"attributes": [{"name": "serviceName", "value": "kms"}, {"name": "accountId", "value": "target"}]}]}]}`)
+				default:
+					Fail("unexpected account " + req.URL.RawQuery)
+				}
+			case "POST":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				created = append(created, body)
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"id": "new%d", "type": "access"}`, len(created))
+			default:
+				Fail("unexpected request " + req.Method)
+			}
+		}))
+		var serviceErr error
+		iamPolicyManagementService, serviceErr = iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	mapping := &iampolicymanagementv1.PolicyReplicationMapping{
+		SourceAccountID: "acct",
+		TargetAccountID: "target",
+		Type:            "access",
+		SubjectIDs:      map[string]string{"AccessGroupId-1": "AccessGroupId-9"},
+	}
+
+	It(`Copies the policies that do not exist in the target account`, func() {
+		replicated, err := iampolicymanagementv1.ReplicatePolicies(context.Background(), iamPolicyManagementService, iamPolicyManagementService, mapping, false)
+		Expect(err).To(BeNil())
+		Expect(replicated).To(HaveLen(3))
+		Expect(replicated[0].SourcePolicyID).To(Equal("p1"))
+		Expect(*replicated[0].Policy.ID).To(Equal("new1"))
+		Expect(replicated[1].Exists).To(BeTrue())
+		Expect(replicated[1].Policy).To(BeNil())
+		Expect(*replicated[2].Policy.ID).To(Equal("new2"))
+
+		Expect(created).To(HaveLen(2))
+		Expect(created[1]["subjects"]).To(Equal([]interface{}{map[string]interface{}{
+			"attributes": []interface{}{map[string]interface{}{"name": "access_group_id", "value": "AccessGroupId-9"}},
+		}}))
+		Expect(created[1]["resources"]).To(Equal([]interface{}{map[string]interface{}{
+			"attributes": []interface{}{
+				map[string]interface{}{"name": "accountId", "value": "target"},
+				map[string]interface{}{"name": "serviceName", "value": "cloud-object-storage"},
+			},
+		}}))
+	})
+	It(`Only reports the policies in a dry run`, func() {
+		filtered := *mapping
+		filtered.Filters = []iampolicymanagementv1.PolicyFilter{iampolicymanagementv1.PolicyWithService("kms")}
+		replicated, err := iampolicymanagementv1.ReplicatePolicies(context.Background(), iamPolicyManagementService, iamPolicyManagementService, &filtered, true)
+		Expect(err).To(BeNil())
+		Expect(replicated).To(HaveLen(2))
+		Expect(replicated[0].Exists).To(BeFalse())
+		Expect(replicated[0].Policy).To(BeNil())
+		Expect(*replicated[0].Options.Resources[0].Attributes[0].Value).To(Equal("target"))
+		Expect(replicated[1].Exists).To(BeTrue())
+		Expect(created).To(BeEmpty())
+	})
+	It(`Validates the mapping`, func() {
+		_, err := iampolicymanagementv1.ReplicatePolicies(context.Background(), iamPolicyManagementService, iamPolicyManagementService, nil, true)
+		Expect(err).ToNot(BeNil())
+		_, err = iampolicymanagementv1.ReplicatePolicies(context.Background(), iamPolicyManagementService, iamPolicyManagementService,
+			&iampolicymanagementv1.PolicyReplicationMapping{SourceAccountID: "acct"}, true)
+		Expect(err).ToNot(BeNil())
+	})
+})
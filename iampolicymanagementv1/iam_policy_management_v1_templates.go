@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Variables that are always available when a PolicyTemplate is expanded.
+const (
+	PolicyTemplateVariableAccountIDConst      = "account_id"
+	PolicyTemplateVariableAccountGroupIDConst = "account_group_id"
+	PolicyTemplateVariableEnterpriseIDConst   = "enterprise_id"
+)
+
+var policyTemplateVariablePattern = regexp.MustCompile(`\$\{([A-Za-z0-9_]+)\}`)
+
+// PolicyTemplate : A set of policies that is materialized in each target account.
+// Attribute values, role IDs and descriptions may refer to variables using the "${name}" syntax; see
+// PolicyTemplateTarget for the variables that are available.
+// If a policy's resource has no "accountId" attribute, the target account ID is added to it.
+type PolicyTemplate struct {
+	// The name of the template.
+	Name string
+
+	// The policies defined by the template.
+	Policies []CreatePolicyOptions
+}
+
+// PolicyTemplateTarget : The account (and, optionally, its enterprise context) to which a PolicyTemplate is applied.
+type PolicyTemplateTarget struct {
+	// The ID of the target account. Available as "${account_id}".
+	AccountID string
+
+	// The ID of the enterprise that contains the account. Available as "${enterprise_id}".
+	EnterpriseID string
+
+	// The ID of the account group that contains the account. Available as "${account_group_id}".
+	AccountGroupID string
+
+	// Additional template variables.
+	Variables map[string]string
+}
+
+// ExpandedPolicy : A concrete policy that a PolicyTemplate materializes in a target account.
+type ExpandedPolicy struct {
+	// The account in which the policy would be created.
+	AccountID string
+
+	// The index of the policy within PolicyTemplate.Policies.
+	TemplatePolicyIndex int
+
+	// The options that would be passed to CreatePolicy.
+	Options *CreatePolicyOptions
+}
+
+// ExpandPolicyTemplate returns the concrete policies that "template" materializes in each of "targets", so that an
+// assignment can be reviewed before any policies are created.
+// An error is returned if a policy refers to an undefined variable or an expanded policy is not valid.
+func ExpandPolicyTemplate(template *PolicyTemplate, targets ...PolicyTemplateTarget) (expanded []ExpandedPolicy, err error) {
+	if template == nil {
+		err = fmt.Errorf("template cannot be nil")
+		return
+	}
+
+	for _, target := range targets {
+		if target.AccountID == "" {
+			err = fmt.Errorf("target account ID must be specified")
+			return
+		}
+		variables := map[string]string{
+			PolicyTemplateVariableAccountIDConst:      target.AccountID,
+			PolicyTemplateVariableAccountGroupIDConst: target.AccountGroupID,
+			PolicyTemplateVariableEnterpriseIDConst:   target.EnterpriseID,
+		}
+		for name, value := range target.Variables {
+			variables[name] = value
+		}
+
+		for i := range template.Policies {
+			var options *CreatePolicyOptions
+			options, err = expandTemplatePolicy(&template.Policies[i], variables)
+			if err != nil {
+				err = fmt.Errorf("template '%s' policy %d for account '%s': %s", template.Name, i, target.AccountID, err.Error())
+				return
+			}
+			expanded = append(expanded, ExpandedPolicy{
+				AccountID:           target.AccountID,
+				TemplatePolicyIndex: i,
+				Options:             options,
+			})
+		}
+	}
+	return
+}
+
+func expandTemplatePolicy(policy *CreatePolicyOptions, variables map[string]string) (options *CreatePolicyOptions, err error) {
+	expander := &templateExpander{variables: variables}
+	options = &CreatePolicyOptions{
+		Type:           expander.expandPtr(policy.Type),
+		Description:    expander.expandPtr(policy.Description),
+		AcceptLanguage: policy.AcceptLanguage,
+	}
+
+	for _, subject := range policy.Subjects {
+		expandedSubject := PolicySubject{}
+		for _, attribute := range subject.Attributes {
+			expandedSubject.Attributes = append(expandedSubject.Attributes, SubjectAttribute{
+				Name:  attribute.Name,
+				Value: expander.expandPtr(attribute.Value),
+			})
+		}
+		options.Subjects = append(options.Subjects, expandedSubject)
+	}
+
+	for _, role := range policy.Roles {
+		options.Roles = append(options.Roles, PolicyRole{RoleID: expander.expandPtr(role.RoleID)})
+	}
+
+	for _, resource := range policy.Resources {
+		expandedResource := PolicyResource{}
+		hasAccountID := false
+		for _, attribute := range resource.Attributes {
+			if attribute.Name != nil && *attribute.Name == PolicyAttributeAccountIDConst {
+				hasAccountID = true
+			}
+			expandedResource.Attributes = append(expandedResource.Attributes, ResourceAttribute{
+				Name:     attribute.Name,
+				Value:    expander.expandPtr(attribute.Value),
+				Operator: attribute.Operator,
+			})
+		}
+		if !hasAccountID {
+			expandedResource.Attributes = append(expandedResource.Attributes, ResourceAttribute{
+				Name:  core.StringPtr(PolicyAttributeAccountIDConst),
+				Value: core.StringPtr(variables[PolicyTemplateVariableAccountIDConst]),
+			})
+		}
+		for _, tag := range resource.Tags {
+			expandedResource.Tags = append(expandedResource.Tags, ResourceTag{
+				Name:     tag.Name,
+				Value:    expander.expandPtr(tag.Value),
+				Operator: tag.Operator,
+			})
+		}
+		options.Resources = append(options.Resources, expandedResource)
+	}
+
+	if expander.err != nil {
+		err = expander.err
+		options = nil
+		return
+	}
+	err = core.ValidateStruct(options, "createPolicyOptions")
+	if err != nil {
+		options = nil
+	}
+	return
+}
+
+// templateExpander replaces "${name}" references and records the first undefined variable.
+type templateExpander struct {
+	variables map[string]string
+	err       error
+}
+
+func (expander *templateExpander) expandPtr(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	expanded := policyTemplateVariablePattern.ReplaceAllStringFunc(*value, func(reference string) string {
+		name := policyTemplateVariablePattern.FindStringSubmatch(reference)[1]
+		replacement, ok := expander.variables[name]
+		if (!ok || replacement == "") && expander.err == nil {
+			expander.err = fmt.Errorf("variable '%s' is not defined", name)
+		}
+		return replacement
+	})
+	return &expanded
+}
@@ -0,0 +1,102 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1_test
+
+import (
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamPolicyManagementV1 ExpandPolicyTemplate`, func() {
+	newTemplate := func() *iampolicymanagementv1.PolicyTemplate {
+		viewers, err := iampolicymanagementv1.NewPolicyBuilder().
+			Description("Viewers of ${account_id}").
+			Subject().AccessGroupID("${viewer_group}").
+			Role(iampolicymanagementv1.RoleViewer).
+			Resource().ResourceGroupID("${resource_group}").
+			AccountID("placeholder").
+			Build()
+		Expect(err).To(BeNil())
+		// Templates usually leave the account to the target; drop the placeholder account attribute.
+		viewers.Resources[0].Attributes = viewers.Resources[0].Attributes[:1]
+
+		auditors, err := iampolicymanagementv1.NewPolicyBuilder().
+			Subject().IAMID("IBMid-auditor").
+			Role(iampolicymanagementv1.RoleReader).
+			Resource().AccountID("${enterprise_id}").Service("enterprise").
+			Build()
+		Expect(err).To(BeNil())
+
+		return &iampolicymanagementv1.PolicyTemplate{
+			Name:     "baseline",
+			Policies: []iampolicymanagementv1.CreatePolicyOptions{*viewers, *auditors},
+		}
+	}
+
+	It(`Expands the template for each target`, func() {
+		template := newTemplate()
+		expanded, err := iampolicymanagementv1.ExpandPolicyTemplate(template,
+			iampolicymanagementv1.PolicyTemplateTarget{
+				AccountID:    "acct1",
+				EnterpriseID: "ent",
+				Variables:    map[string]string{"viewer_group": "AccessGroupId-1", "resource_group": "rg1"},
+			},
+			iampolicymanagementv1.PolicyTemplateTarget{
+				AccountID:    "acct2",
+				EnterpriseID: "ent",
+				Variables:    map[string]string{"viewer_group": "AccessGroupId-2", "resource_group": "rg2"},
+			},
+		)
+		Expect(err).To(BeNil())
+		Expect(expanded).To(HaveLen(4))
+
+		Expect(expanded[0].AccountID).To(Equal("acct1"))
+		Expect(expanded[0].TemplatePolicyIndex).To(Equal(0))
+		viewers := expanded[0].Options
+		Expect(*viewers.Description).To(Equal("Viewers of acct1"))
+		Expect(*viewers.Subjects[0].Attributes[0].Value).To(Equal("AccessGroupId-1"))
+		Expect(viewers.Resources[0].Attributes).To(HaveLen(2))
+		Expect(*viewers.Resources[0].Attributes[0].Value).To(Equal("rg1"))
+		Expect(*viewers.Resources[0].Attributes[1].Name).To(Equal("accountId"))
+		Expect(*viewers.Resources[0].Attributes[1].Value).To(Equal("acct1"))
+
+		auditors := expanded[1].Options
+		Expect(auditors.Resources[0].Attributes).To(HaveLen(2))
+		Expect(*auditors.Resources[0].Attributes[0].Value).To(Equal("ent"))
+
+		Expect(expanded[2].AccountID).To(Equal("acct2"))
+		Expect(*expanded[2].Options.Resources[0].Attributes[0].Value).To(Equal("rg2"))
+
+		// The template itself is not modified.
+		Expect(*template.Policies[0].Description).To(Equal("Viewers of ${account_id}"))
+	})
+	It(`Reports undefined variables`, func() {
+		_, err := iampolicymanagementv1.ExpandPolicyTemplate(newTemplate(), iampolicymanagementv1.PolicyTemplateTarget{
+			AccountID:    "acct1",
+			EnterpriseID: "ent",
+			Variables:    map[string]string{"viewer_group": "AccessGroupId-1"},
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("variable 'resource_group' is not defined"))
+
+		_, err = iampolicymanagementv1.ExpandPolicyTemplate(newTemplate(), iampolicymanagementv1.PolicyTemplateTarget{})
+		Expect(err).ToNot(BeNil())
+		_, err = iampolicymanagementv1.ExpandPolicyTemplate(nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package ibmcloudshellv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// IBMCloudShellV1API : The operations of the IBMCloudShellV1 service, implemented by *IBMCloudShellV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type IBMCloudShellV1API interface {
+	// Clone makes a copy of "ibmCloudShell" suitable for processing requests.
+	Clone() *IBMCloudShellV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// GetAccountSettings : Get account settings
+	GetAccountSettings(getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// GetAccountSettingsWithContext is an alternate form of the GetAccountSettings method which supports a Context
+	// parameter
+	GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// UpdateAccountSettings : Update account settings
+	UpdateAccountSettings(updateAccountSettingsOptions *UpdateAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// UpdateAccountSettingsWithContext is an alternate form of the UpdateAccountSettings method which supports a
+	// Context parameter
+	UpdateAccountSettingsWithContext(ctx context.Context, updateAccountSettingsOptions *UpdateAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// NewGetAccountSettingsOptions : Instantiate GetAccountSettingsOptions
+	NewGetAccountSettingsOptions(accountID string) *GetAccountSettingsOptions
+
+	// NewUpdateAccountSettingsOptions : Instantiate UpdateAccountSettingsOptions
+	NewUpdateAccountSettingsOptions(accountID string) *UpdateAccountSettingsOptions
+
+	// NewGetAccountSettingsOptionsWith returns GetAccountSettingsOptions populated by applying "options" in order.
+	NewGetAccountSettingsOptionsWith(options ...GetAccountSettingsOption) *GetAccountSettingsOptions
+
+	// GetAccountSettingsWith is an alternate form of the GetAccountSettingsWithContext method which takes functional
+	// options instead of a GetAccountSettingsOptions struct.
+	GetAccountSettingsWith(ctx context.Context, options ...GetAccountSettingsOption) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// NewUpdateAccountSettingsOptionsWith returns UpdateAccountSettingsOptions populated by applying "options" in
+	// order.
+	NewUpdateAccountSettingsOptionsWith(options ...UpdateAccountSettingsOption) *UpdateAccountSettingsOptions
+
+	// UpdateAccountSettingsWith is an alternate form of the UpdateAccountSettingsWithContext method which takes
+	// functional options instead of an UpdateAccountSettingsOptions struct.
+	UpdateAccountSettingsWith(ctx context.Context, options ...UpdateAccountSettingsOption) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// WatchAccountSettings polls the Cloud Shell settings of an account every "interval"
+	// (DefaultAccountSettingsWatchInterval if zero), starting immediately, and sends the changes detected by
+	// DiffAccountSettings on the returned channel.
+	WatchAccountSettings(ctx context.Context, accountID string, interval time.Duration) <-chan AccountSettingsEvent
+}
+
+var _ IBMCloudShellV1API = (*IBMCloudShellV1)(nil)
@@ -0,0 +1,213 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ibmcloudshellv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultAccountSettingsWatchInterval is the interval between polls used by WatchAccountSettings when no interval is
+// specified.
+const DefaultAccountSettingsWatchInterval = time.Minute
+
+// Constants associated with the AccountSettingsEvent.Type property.
+const (
+	// Cloud Shell was enabled or disabled for the account.
+	AccountSettingsEventTypeCloudShellEnabledConst  = "cloud_shell_enabled"
+	AccountSettingsEventTypeCloudShellDisabledConst = "cloud_shell_disabled"
+
+	// A feature was enabled or disabled. A feature that is no longer listed is considered disabled.
+	AccountSettingsEventTypeFeatureEnabledConst  = "feature_enabled"
+	AccountSettingsEventTypeFeatureDisabledConst = "feature_disabled"
+
+	// A region was enabled or disabled. A region that is no longer listed is considered disabled.
+	AccountSettingsEventTypeRegionEnabledConst  = "region_enabled"
+	AccountSettingsEventTypeRegionDisabledConst = "region_disabled"
+
+	// The default_enable_new_features or default_enable_new_regions setting changed.
+	AccountSettingsEventTypeDefaultChangedConst = "default_changed"
+
+	// The settings could not be retrieved; see AccountSettingsEvent.Err.
+	AccountSettingsEventTypeErrorConst = "error"
+)
+
+// AccountSettingsEvent : A change of the Cloud Shell settings of an account detected by WatchAccountSettings.
+type AccountSettingsEvent struct {
+	// The ID of the account.
+	AccountID string
+
+	// The kind of change (one of the AccountSettingsEventType*Const values).
+	Type string
+
+	// The key of the feature or region, or the name of the default setting, that changed. Empty for the other types.
+	Key string
+
+	// The new state of the feature, region or default setting.
+	Enabled bool
+
+	// The revision of the settings in which the change was detected, and the IAM ID of their last updater.
+	Rev       string
+	UpdatedBy string
+
+	// When the change was detected. The change happened between the previous poll and this time.
+	DetectedAt time.Time
+
+	// The error of the poll, for events of type AccountSettingsEventTypeErrorConst.
+	Err error
+}
+
+// DiffAccountSettings returns the events of the differences between two versions of the settings of an account:
+// first the change of the account-wide enablement, then the changes of the default settings, the features and the
+// regions, each sorted by key. The events are not timestamped.
+func DiffAccountSettings(previous *AccountSettings, current *AccountSettings) (events []AccountSettingsEvent) {
+	newEvent := func(eventType string, key string, enabled bool) AccountSettingsEvent {
+		return AccountSettingsEvent{
+			AccountID: core.StringNilMapper(current.AccountID),
+			Type:      eventType,
+			Key:       key,
+			Enabled:   enabled,
+			Rev:       core.StringNilMapper(current.Rev),
+			UpdatedBy: core.StringNilMapper(current.UpdatedBy),
+		}
+	}
+
+	if enabled := boolValue(current.Enabled); enabled != boolValue(previous.Enabled) {
+		eventType := AccountSettingsEventTypeCloudShellDisabledConst
+		if enabled {
+			eventType = AccountSettingsEventTypeCloudShellEnabledConst
+		}
+		events = append(events, newEvent(eventType, "", enabled))
+	}
+	if enabled := boolValue(current.DefaultEnableNewFeatures); enabled != boolValue(previous.DefaultEnableNewFeatures) {
+		events = append(events, newEvent(AccountSettingsEventTypeDefaultChangedConst, "default_enable_new_features", enabled))
+	}
+	if enabled := boolValue(current.DefaultEnableNewRegions); enabled != boolValue(previous.DefaultEnableNewRegions) {
+		events = append(events, newEvent(AccountSettingsEventTypeDefaultChangedConst, "default_enable_new_regions", enabled))
+	}
+
+	previousFeatures, currentFeatures := map[string]bool{}, map[string]bool{}
+	for _, feature := range previous.Features {
+		previousFeatures[core.StringNilMapper(feature.Key)] = boolValue(feature.Enabled)
+	}
+	for _, feature := range current.Features {
+		currentFeatures[core.StringNilMapper(feature.Key)] = boolValue(feature.Enabled)
+	}
+	for _, key := range changedKeys(previousFeatures, currentFeatures) {
+		eventType := AccountSettingsEventTypeFeatureDisabledConst
+		if currentFeatures[key] {
+			eventType = AccountSettingsEventTypeFeatureEnabledConst
+		}
+		events = append(events, newEvent(eventType, key, currentFeatures[key]))
+	}
+
+	previousRegions, currentRegions := map[string]bool{}, map[string]bool{}
+	for _, region := range previous.Regions {
+		previousRegions[core.StringNilMapper(region.Key)] = boolValue(region.Enabled)
+	}
+	for _, region := range current.Regions {
+		currentRegions[core.StringNilMapper(region.Key)] = boolValue(region.Enabled)
+	}
+	for _, key := range changedKeys(previousRegions, currentRegions) {
+		eventType := AccountSettingsEventTypeRegionDisabledConst
+		if currentRegions[key] {
+			eventType = AccountSettingsEventTypeRegionEnabledConst
+		}
+		events = append(events, newEvent(eventType, key, currentRegions[key]))
+	}
+	return
+}
+
+// WatchAccountSettings polls the Cloud Shell settings of an account every "interval"
+// (DefaultAccountSettingsWatchInterval if zero), starting immediately, and sends the changes detected by
+// DiffAccountSettings on the returned channel. The first successful poll only records the settings. A poll that fails
+// sends an event of type AccountSettingsEventTypeErrorConst, and the settings of the last successful poll are kept
+// for the next comparison. The channel is closed when the context is done; the caller must receive the events until
+// then.
+func (ibmCloudShell *IBMCloudShellV1) WatchAccountSettings(ctx context.Context, accountID string, interval time.Duration) <-chan AccountSettingsEvent {
+	if interval <= 0 {
+		interval = DefaultAccountSettingsWatchInterval
+	}
+	events := make(chan AccountSettingsEvent)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		var previous *AccountSettings
+		for {
+			var polled []AccountSettingsEvent
+			current, _, err := ibmCloudShell.GetAccountSettingsWithContext(ctx, ibmCloudShell.NewGetAccountSettingsOptions(accountID))
+			detectedAt := time.Now()
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				polled = append(polled, AccountSettingsEvent{
+					AccountID:  accountID,
+					Type:       AccountSettingsEventTypeErrorConst,
+					DetectedAt: detectedAt,
+					Err:        fmt.Errorf("error retrieving the Cloud Shell settings of account '%s': %w", accountID, err),
+				})
+			} else {
+				if previous != nil {
+					polled = DiffAccountSettings(previous, current)
+				}
+				previous = current
+			}
+			for _, event := range polled {
+				event.DetectedAt = detectedAt
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return events
+}
+
+// changedKeys returns the sorted keys whose state differs between "previous" and "current". A missing key is
+// considered disabled.
+func changedKeys(previous map[string]bool, current map[string]bool) (keys []string) {
+	for key, enabled := range current {
+		if enabled != previous[key] {
+			keys = append(keys, key)
+		}
+	}
+	for key, enabled := range previous {
+		if _, found := current[key]; !found && enabled {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return
+}
+
+// boolValue returns the value of "value", or false if it is nil.
+func boolValue(value *bool) bool {
+	return value != nil && *value
+}
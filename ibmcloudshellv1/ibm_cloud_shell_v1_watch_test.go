@@ -0,0 +1,122 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ibmcloudshellv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/ibmcloudshellv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IBMCloudShellV1 WatchAccountSettings`, func() {
+	var testServer *httptest.Server
+	var ibmCloudShellService *ibmcloudshellv1.IBMCloudShellV1
+	var mutex sync.Mutex
+	var responses []string
+	BeforeEach(func() {
+		responses = []string{
+			`{"_rev": "1", "account_id": "acct", "enabled": true, "default_enable_new_features": true,
+			  "features": [{"key": "server.file_manager", "enabled": true}, {"key": "server.web_preview", "enabled": false}],
+			  "regions": [{"key": "eu-de", "enabled": true}, {"key": "jp-tok", "enabled": true}, {"key": "us-south", "enabled": true}]}`,
+			`error`,
+			`{"_rev": "2", "account_id": "acct", "enabled": false, "default_enable_new_regions": true, "updated_by": "IBMid-admin",
+			  "features": [{"key": "server.file_manager", "enabled": true}, {"key": "server.web_preview", "enabled": true}],
+			  "regions": [{"key": "eu-de", "enabled": false}, {"key": "us-south", "enabled": true}, {"key": "us-east", "enabled": true}]}`,
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.URL.EscapedPath()).To(Equal("/api/v1/user/accounts/acct/settings"))
+			mutex.Lock()
+			response := responses[0]
+			if len(responses) > 1 {
+				responses = responses[1:]
+			}
+			mutex.Unlock()
+
+			res.Header().Set("Content-type", "application/json")
+			if response == "error" {
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"message": "unavailable"}`)
+				return
+			}
+			fmt.Fprint(res, response)
+		}))
+		var serviceErr error
+		ibmCloudShellService, serviceErr = ibmcloudshellv1.NewIBMCloudShellV1(&ibmcloudshellv1.IBMCloudShellV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Emits the changes detected between polls`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events := ibmCloudShellService.WatchAccountSettings(ctx, "acct", 5*time.Millisecond)
+
+		var received []string
+		for len(received) < 8 {
+			event := <-events
+			Expect(event.AccountID).To(Equal("acct"))
+			Expect(event.DetectedAt).ToNot(BeZero())
+			if event.Type == ibmcloudshellv1.AccountSettingsEventTypeErrorConst {
+				Expect(event.Err.Error()).To(ContainSubstring("error retrieving the Cloud Shell settings of account 'acct'"))
+			} else {
+				Expect(event.Rev).To(Equal("2"))
+				Expect(event.UpdatedBy).To(Equal("IBMid-admin"))
+			}
+			received = append(received, fmt.Sprintf("%s %s %t", event.Type, event.Key, event.Enabled))
+		}
+		Expect(received).To(Equal([]string{
+			"error  false",
+			"cloud_shell_disabled  false",
+			"default_changed default_enable_new_features false",
+			"default_changed default_enable_new_regions true",
+			"feature_enabled server.web_preview true",
+			"region_disabled eu-de false",
+			"region_disabled jp-tok false",
+			"region_enabled us-east true",
+		}))
+
+		// The settings do not change anymore, so no other event is sent until the context is done.
+		Consistently(events, 30*time.Millisecond).ShouldNot(Receive())
+		cancel()
+		Eventually(events).Should(BeClosed())
+	})
+	It(`Reports no events for identical settings`, func() {
+		settings := &ibmcloudshellv1.AccountSettings{
+			Enabled:  core.BoolPtr(true),
+			Features: []ibmcloudshellv1.Feature{{Key: core.StringPtr("server.file_manager"), Enabled: core.BoolPtr(false)}},
+		}
+		Expect(ibmcloudshellv1.DiffAccountSettings(settings, settings)).To(BeEmpty())
+		// A disabled feature that is no longer listed does not change.
+		Expect(ibmcloudshellv1.DiffAccountSettings(settings, &ibmcloudshellv1.AccountSettings{Enabled: core.BoolPtr(true)})).To(BeEmpty())
+	})
+})
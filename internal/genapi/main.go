@@ -0,0 +1,326 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command genapi generates the API interface of a service package: an interface named after the service struct
+// with the "API" suffix (e.g. CaseManagementV1API) that declares every exported method of the service, so that
+// applications can depend on the interface and mock the service with tools such as gomock or mockery. It is run by
+// "go generate" in the package directory, and must be re-run whenever the service code is re-generated or a method
+// is added to the service:
+//
+//	go generate ./...
+//
+// The generated file also runs genoptions, before genapi, so that the interface declares the generated functional
+// options methods.
+//
+// The service struct is the struct type with a "Service *core.BaseService" field declared in the generated service
+// file (the file with the "IBM OpenAPI SDK Code Generator" header). The methods of the interface are those of the
+// generated service file, in order, followed by those of the other files of the package, sorted by file name.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const generatorMarker = "IBM OpenAPI SDK Code Generator"
+
+const header = `/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+`
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genapi: ")
+	dir := "."
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+	if err := generate(dir); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// method is an exported method of the service struct.
+type method struct {
+	name      string
+	doc       string
+	signature string
+	imports   map[string]string
+}
+
+// generate writes the "_api.go" file of the service package in "dir".
+func generate(dir string) error {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+	var serviceFile string
+	var pkg *ast.File
+	parsedFiles := map[string]*ast.File{}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") || strings.HasSuffix(file, "_api.go") {
+			continue
+		}
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		parsed, err := parser.ParseFile(fset, file, src, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		parsedFiles[file] = parsed
+		if !bytes.Contains(src, []byte(generatorMarker)) {
+			continue
+		}
+		if serviceFile != "" {
+			return fmt.Errorf("both %s and %s are generated service files", serviceFile, file)
+		}
+		serviceFile, pkg = file, parsed
+	}
+	if serviceFile == "" {
+		return fmt.Errorf("no generated service file in %s", dir)
+	}
+	service := serviceStruct(pkg)
+	if service == "" {
+		return fmt.Errorf("no service struct in %s", serviceFile)
+	}
+
+	ordered := []string{serviceFile}
+	var others []string
+	for file := range parsedFiles {
+		if file != serviceFile {
+			others = append(others, file)
+		}
+	}
+	sort.Strings(others)
+	ordered = append(ordered, others...)
+
+	var methods []method
+	imports := map[string]string{}
+	for _, file := range ordered {
+		fileMethods, err := serviceMethods(fset, parsedFiles[file], service)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		for _, m := range fileMethods {
+			for name, path := range m.imports {
+				imports[name] = path
+			}
+		}
+		methods = append(methods, fileMethods...)
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header)
+	out.WriteString("package " + pkg.Name.Name + "\n\n")
+	if len(imports) > 0 {
+		var names []string
+		for name := range imports {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			if isStandard(imports[names[i]]) != isStandard(imports[names[j]]) {
+				return isStandard(imports[names[i]])
+			}
+			return imports[names[i]] < imports[names[j]]
+		})
+		out.WriteString("import (\n")
+		for i, name := range names {
+			path := imports[name]
+			if i > 0 && isStandard(imports[names[i-1]]) && !isStandard(path) {
+				out.WriteString("\n")
+			}
+			if name == filepath.Base(path) {
+				fmt.Fprintf(&out, "%q\n", path)
+			} else {
+				fmt.Fprintf(&out, "%s %q\n", name, path)
+			}
+		}
+		out.WriteString(")\n\n")
+	}
+	out.WriteString("//go:generate go run ../internal/genoptions\n")
+	out.WriteString("//go:generate go run ../internal/genapi\n\n")
+	fmt.Fprintf(&out, "// %[1]sAPI : The operations of the %[1]s service, implemented by *%[1]s.\n", service)
+	out.WriteString("// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.\n")
+	fmt.Fprintf(&out, "type %sAPI interface {\n", service)
+	for i, m := range methods {
+		if i > 0 {
+			out.WriteString("\n")
+		}
+		for _, line := range wrap(m.doc, 112) {
+			fmt.Fprintf(&out, "// %s\n", line)
+		}
+		fmt.Fprintf(&out, "%s%s\n", m.name, m.signature)
+	}
+	out.WriteString("}\n\n")
+	fmt.Fprintf(&out, "var _ %[1]sAPI = (*%[1]s)(nil)\n", service)
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+	target := strings.TrimSuffix(serviceFile, ".go") + "_api.go"
+	return ioutil.WriteFile(target, formatted, 0644)
+}
+
+// serviceStruct returns the name of the struct type of "file" with a "Service *core.BaseService" field.
+func serviceStruct(file *ast.File) string {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			for _, field := range structType.Fields.List {
+				if len(field.Names) == 1 && field.Names[0].Name == "Service" {
+					return typeSpec.Name.Name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// serviceMethods returns the exported methods of the service struct declared in "file", in order, with the first
+// line of their doc comment and the imports used by their signature.
+func serviceMethods(fset *token.FileSet, file *ast.File, service string) (methods []method, err error) {
+	fileImports := map[string]string{}
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		fileImports[name] = path
+	}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || !fn.Name.IsExported() {
+			continue
+		}
+		if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); !ok || !isIdent(star.X, service) {
+			continue
+		}
+		var signature bytes.Buffer
+		if err = printer.Fprint(&signature, fset, fn.Type); err != nil {
+			return
+		}
+		m := method{
+			name:      fn.Name.Name,
+			signature: strings.TrimPrefix(signature.String(), "func"),
+			imports:   map[string]string{},
+		}
+		if fn.Doc != nil {
+			m.doc = summary(fn.Doc.Text())
+		}
+		ast.Inspect(fn.Type, func(node ast.Node) bool {
+			if selector, ok := node.(*ast.SelectorExpr); ok {
+				if ident, ok := selector.X.(*ast.Ident); ok {
+					if path, found := fileImports[ident.Name]; found {
+						m.imports[ident.Name] = path
+					}
+				}
+			}
+			return true
+		})
+		methods = append(methods, m)
+	}
+	return
+}
+
+// isIdent returns true if "expr" is the identifier "name".
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+// summary returns the first line of a doc comment if it is a generated summary ("Name : Summary") or a complete
+// sentence, and otherwise the first sentence of its first paragraph.
+func summary(doc string) string {
+	paragraph := strings.SplitN(strings.TrimSpace(doc), "\n\n", 2)[0]
+	lines := strings.Split(paragraph, "\n")
+	if strings.Contains(lines[0], " : ") || strings.HasSuffix(lines[0], ".") {
+		return lines[0]
+	}
+	sentence := strings.Join(lines, " ")
+	if end := strings.Index(sentence, ". "); end >= 0 {
+		sentence = sentence[:end+1]
+	}
+	return sentence
+}
+
+// isStandard returns true if "path" is the import path of a package of the standard library.
+func isStandard(path string) bool {
+	return !strings.Contains(strings.SplitN(path, "/", 2)[0], ".")
+}
+
+// wrap splits "text" into lines of at most "width" characters, unless a word is longer.
+func wrap(text string, width int) (lines []string) {
+	var line string
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return
+}
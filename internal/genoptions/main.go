@@ -0,0 +1,558 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command genoptions generates the functional options of the operations of a service package, an alternative to
+// populating the operation options structs with pointer values. For each operation (e.g. CreateCase) it generates:
+//
+//	type CreateCaseOption func(options *CreateCaseOptions)
+//	func CreateCaseSubject(subject string) CreateCaseOption // one setter per property, named after the operation
+//	func CreateCaseHeader(name string, value string) CreateCaseOption
+//	func (*CaseManagementV1) NewCreateCaseOptionsWith(options ...CreateCaseOption) *CreateCaseOptions
+//	func (*CaseManagementV1) CreateCaseWith(ctx context.Context, options ...CreateCaseOption) (...)
+//
+// The setters of the *string, *int64, *bool and *float64 properties take a value, and those of the slice properties
+// are variadic and append to the property. It is run by "go generate" in the package directory, before genapi so that
+// the API interface declares the generated methods, and must be re-run whenever the service code is re-generated:
+//
+//	go generate ./...
+//
+// The operations are the methods of the service struct declared in the generated service file (the file with the
+// "IBM OpenAPI SDK Code Generator" header) whose name ends with "WithContext" and that take a pointer to an options
+// struct. The generation fails if a generated name is already declared in the package.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const generatorMarker = "IBM OpenAPI SDK Code Generator"
+
+const optionsMarker = "Code generated by internal/genoptions."
+
+const header = `/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+`
+
+// pointerSetters maps the pointer types of properties to the core function that returns a pointer to a value.
+var pointerSetters = map[string]string{
+	"*string":  "core.StringPtr",
+	"*int64":   "core.Int64Ptr",
+	"*bool":    "core.BoolPtr",
+	"*float64": "core.Float64Ptr",
+}
+
+// reservedNames are the identifiers that a parameter of a generated function must not shadow.
+var reservedNames = map[string]bool{
+	"options": true, "ctx": true, "core": true, "context": true, "strfmt": true, "io": true, "name": true, "value": true,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genoptions: ")
+	dir := "."
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+	if err := generate(dir); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// operation is an operation of the service, with the options struct that it takes.
+type operation struct {
+	name       string
+	receiver   string
+	optionsRef string
+	results    string
+	resultList *ast.FieldList
+	fields     []*ast.Field
+	required   []string
+}
+
+// generate writes the "_options.go" file of the service package in "dir".
+func generate(dir string) error {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+	var serviceFile string
+	var pkg *ast.File
+	declared := map[string]bool{}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if bytes.Contains(src, []byte(optionsMarker)) {
+			continue
+		}
+		parsed, err := parser.ParseFile(fset, file, src, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		for name := range declaredNames(parsed) {
+			declared[name] = true
+		}
+		if !bytes.Contains(src, []byte(generatorMarker)) {
+			continue
+		}
+		if serviceFile != "" {
+			return fmt.Errorf("both %s and %s are generated service files", serviceFile, file)
+		}
+		serviceFile, pkg = file, parsed
+	}
+	if serviceFile == "" {
+		return fmt.Errorf("no generated service file in %s", dir)
+	}
+	service := serviceStruct(pkg)
+	if service == "" {
+		return fmt.Errorf("no service struct in %s", serviceFile)
+	}
+	operations, err := serviceOperations(fset, pkg, service)
+	if err != nil {
+		return fmt.Errorf("%s: %w", serviceFile, err)
+	}
+
+	var body bytes.Buffer
+	generated := map[string]bool{}
+	declare := func(name string) error {
+		if declared[name] || generated[name] {
+			return fmt.Errorf("%s is already declared in %s", name, dir)
+		}
+		generated[name] = true
+		return nil
+	}
+	for _, op := range operations {
+		if err = writeOperation(&body, fset, service, op, declare); err != nil {
+			return err
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header)
+	out.WriteString("package " + pkg.Name.Name + "\n\n")
+	out.WriteString("import (\n")
+	imports := usedImports(pkg, operations)
+	for i, path := range imports {
+		if i > 0 && isStandard(imports[i-1]) && !isStandard(path) {
+			out.WriteString("\n")
+		}
+		fmt.Fprintf(&out, "%q\n", path)
+	}
+	out.WriteString(")\n")
+	out.Write(body.Bytes())
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+	target := strings.TrimSuffix(serviceFile, ".go") + "_options.go"
+	return ioutil.WriteFile(target, formatted, 0644)
+}
+
+// writeOperation writes the functional options of an operation.
+func writeOperation(out *bytes.Buffer, fset *token.FileSet, service string, op *operation, declare func(string) error) (err error) {
+	optionType := op.name + "Option"
+	if err = declare(optionType); err != nil {
+		return
+	}
+	fmt.Fprintf(out, "\n// %s : A functional option that sets a property of %s (see %sWith).\n", optionType, op.optionsRef, op.name)
+	fmt.Fprintf(out, "type %s func(options *%s)\n", optionType, op.optionsRef)
+
+	for _, field := range op.fields {
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+			var fieldType bytes.Buffer
+			if err = printer.Fprint(&fieldType, fset, field.Type); err != nil {
+				return
+			}
+			setter := op.name + ident.Name
+			if ident.Name == "Headers" && fieldType.String() == "map[string]string" {
+				setter = op.name + "Header"
+			}
+			if err = declare(setter); err != nil {
+				return
+			}
+			writeComment(out, fmt.Sprintf("%s sets the %s property of %s. %s", setter, ident.Name, op.optionsRef, fieldSummary(field)))
+			param := parameterName(ident.Name)
+			switch fieldType := fieldType.String(); {
+			case setter == op.name+"Header":
+				fmt.Fprintf(out, `func %s(name string, value string) %s {
+	return func(options *%s) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+`, setter, optionType, op.optionsRef)
+			case pointerSetters[fieldType] != "":
+				fmt.Fprintf(out, "func %s(%s %s) %s {\n\treturn func(options *%s) {\n\t\toptions.%s = %s(%s)\n\t}\n}\n",
+					setter, param, strings.TrimPrefix(fieldType, "*"), optionType, op.optionsRef, ident.Name, pointerSetters[fieldType], param)
+			case strings.HasPrefix(fieldType, "[]") && fieldType != "[]byte":
+				fmt.Fprintf(out, "func %s(%s ...%s) %s {\n\treturn func(options *%s) {\n\t\toptions.%s = append(options.%s, %s...)\n\t}\n}\n",
+					setter, param, strings.TrimPrefix(fieldType, "[]"), optionType, op.optionsRef, ident.Name, ident.Name, param)
+			default:
+				fmt.Fprintf(out, "func %s(%s %s) %s {\n\treturn func(options *%s) {\n\t\toptions.%s = %s\n\t}\n}\n",
+					setter, param, fieldType, optionType, op.optionsRef, ident.Name, param)
+			}
+		}
+	}
+
+	newOptions := "New" + op.optionsRef + "With"
+	if err = declare(service + "." + newOptions); err != nil {
+		return
+	}
+	fmt.Fprintf(out, `
+// %[1]s returns %[2]s populated by applying "options" in order.
+func (*%[3]s) %[1]s(options ...%[4]s) *%[2]s {
+	%[5]s := &%[2]s{}
+	for _, option := range options {
+		option(%[5]s)
+	}
+	return %[5]s
+}
+`, newOptions, op.optionsRef, service, optionType, parameterName(op.optionsRef))
+
+	with := op.name + "With"
+	if err = declare(service + "." + with); err != nil {
+		return
+	}
+	article := "a"
+	if strings.ContainsAny(op.optionsRef[:1], "AEIOU") {
+		article = "an"
+	}
+	doc := fmt.Sprintf("%s is an alternate form of the %sWithContext method which takes functional options instead of %s %s struct.", with, op.name, article, op.optionsRef)
+	if len(op.required) > 0 {
+		doc += " " + requiredSentence(op.name, op.required)
+	}
+	writeComment(out, "\n"+doc)
+	fmt.Fprintf(out, "func (%[1]s *%[2]s) %[3]s(ctx context.Context, options ...%[4]s) %[5]s {\n\treturn %[1]s.%[6]sWithContext(ctx, %[1]s.%[7]s(options...))\n}\n",
+		op.receiver, service, with, optionType, op.results, op.name, newOptions)
+	return
+}
+
+// usedImports returns the import paths used by the generated code, sorted with the standard library first: those
+// of "context" and the core package, and those of the packages referenced by the properties and the results of the
+// operations.
+func usedImports(file *ast.File, operations []*operation) []string {
+	fileImports := map[string]string{}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		fileImports[name] = path
+	}
+	used := map[string]bool{"context": true, "github.com/IBM/go-sdk-core/v5/core": true}
+	inspect := func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok && fileImports[ident.Name] != "" {
+				used[fileImports[ident.Name]] = true
+			}
+		}
+		return true
+	}
+	for _, op := range operations {
+		ast.Inspect(op.resultList, inspect)
+		for _, field := range op.fields {
+			ast.Inspect(field.Type, inspect)
+		}
+	}
+	var imports []string
+	for path := range used {
+		imports = append(imports, path)
+	}
+	sort.Slice(imports, func(i, j int) bool {
+		if isStandard(imports[i]) != isStandard(imports[j]) {
+			return isStandard(imports[i])
+		}
+		return imports[i] < imports[j]
+	})
+	return imports
+}
+
+// isStandard returns true if "path" is the import path of a package of the standard library.
+func isStandard(path string) bool {
+	return !strings.Contains(strings.SplitN(path, "/", 2)[0], ".")
+}
+
+// requiredSentence returns the sentence that lists the setters of the required properties of an operation.
+func requiredSentence(operation string, required []string) string {
+	setters := make([]string, len(required))
+	for i, name := range required {
+		setters[i] = operation + name
+	}
+	if len(setters) == 1 {
+		return setters[0] + " is required."
+	}
+	return strings.Join(setters[:len(setters)-1], ", ") + " and " + setters[len(setters)-1] + " are required."
+}
+
+// writeComment writes "text" as a comment wrapped at 112 characters. A leading newline is written as is.
+func writeComment(out *bytes.Buffer, text string) {
+	if strings.HasPrefix(text, "\n") {
+		out.WriteString("\n")
+	}
+	for _, line := range wrap(text, 112) {
+		fmt.Fprintf(out, "// %s\n", line)
+	}
+}
+
+// serviceStruct returns the name of the struct type of "file" with a "Service *core.BaseService" field.
+func serviceStruct(file *ast.File) string {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			for _, field := range structType.Fields.List {
+				if len(field.Names) == 1 && field.Names[0].Name == "Service" {
+					return typeSpec.Name.Name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// serviceOperations returns the operations of the service declared in "file", in order.
+func serviceOperations(fset *token.FileSet, file *ast.File, service string) (operations []*operation, err error) {
+	structs := map[string]*ast.StructType{}
+	constructors := map[string]*ast.FuncType{}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+					structs[typeSpec.Name.Name] = structType
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv != nil && strings.HasPrefix(decl.Name.Name, "New") {
+				constructors[strings.TrimPrefix(decl.Name.Name, "New")] = decl.Type
+			}
+		}
+	}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || !strings.HasSuffix(fn.Name.Name, "WithContext") {
+			continue
+		}
+		if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); !ok || !isIdent(star.X, service) {
+			continue
+		}
+		params := fn.Type.Params.List
+		if len(params) != 2 || len(params[1].Names) != 1 {
+			continue
+		}
+		star, ok := params[1].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		optionsIdent, ok := star.X.(*ast.Ident)
+		if !ok || structs[optionsIdent.Name] == nil {
+			continue
+		}
+		var results []string
+		for _, result := range fn.Type.Results.List {
+			var resultType bytes.Buffer
+			if err = printer.Fprint(&resultType, fset, result.Type); err != nil {
+				return
+			}
+			for _, ident := range result.Names {
+				results = append(results, ident.Name+" "+resultType.String())
+			}
+		}
+		op := &operation{
+			name:       strings.TrimSuffix(fn.Name.Name, "WithContext"),
+			receiver:   fn.Recv.List[0].Names[0].Name,
+			optionsRef: optionsIdent.Name,
+			results:    "(" + strings.Join(results, ", ") + ")",
+			resultList: fn.Type.Results,
+			fields:     structs[optionsIdent.Name].Fields.List,
+		}
+		if constructor := constructors[optionsIdent.Name]; constructor != nil {
+			op.required = requiredFields(constructor, op.fields)
+		}
+		operations = append(operations, op)
+	}
+	return
+}
+
+// requiredFields returns the names of the properties set by the parameters of the constructor of an options struct.
+func requiredFields(constructor *ast.FuncType, fields []*ast.Field) (required []string) {
+	for _, param := range constructor.Params.List {
+		for _, paramName := range param.Names {
+			name := strings.TrimSuffix(paramName.Name, "Var")
+			for _, field := range fields {
+				for _, ident := range field.Names {
+					if strings.EqualFold(ident.Name, name) {
+						required = append(required, ident.Name)
+					}
+				}
+			}
+		}
+	}
+	return
+}
+
+// declaredNames returns the package-level names declared in "file", with the methods named "Type.Method".
+func declaredNames(file *ast.File) map[string]bool {
+	names := map[string]bool{}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			name := decl.Name.Name
+			if decl.Recv != nil && len(decl.Recv.List) == 1 {
+				name = receiverType(decl.Recv.List[0].Type) + "." + name
+			}
+			names[name] = true
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names[spec.Name.Name] = true
+				case *ast.ValueSpec:
+					for _, ident := range spec.Names {
+						names[ident.Name] = true
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// fieldSummary returns the first sentence of the doc comment of a field, or an empty string.
+func fieldSummary(field *ast.Field) string {
+	if field.Doc == nil {
+		return ""
+	}
+	text := strings.Join(strings.Fields(field.Doc.Text()), " ")
+	if end := strings.Index(text, ". "); end >= 0 {
+		text = text[:end+1]
+	}
+	if text != "" && !strings.HasSuffix(text, ".") {
+		text += "."
+	}
+	return text
+}
+
+// parameterName returns the lower camel case form of an exported name, such as "accountID" for "AccountID" or
+// "crn" for "CRN", with a "Var" suffix if it is a keyword or a name used by the generated code.
+func parameterName(name string) string {
+	runes := []rune(name)
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+	if upper > 1 && upper < len(runes) {
+		upper--
+	}
+	for i := 0; i < upper; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	param := string(runes)
+	if token.IsKeyword(param) || reservedNames[param] {
+		param += "Var"
+	}
+	return param
+}
+
+// isIdent returns true if "expr" is the identifier "name".
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+// receiverType returns the name of the type of a method receiver.
+func receiverType(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// wrap splits "text" into lines of at most "width" characters, unless a word is longer.
+func wrap(text string, width int) (lines []string) {
+	var line string
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return
+}
@@ -0,0 +1,180 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command genredact generates the String and MarshalRedactedJSON methods of the models of a service package, which
+// mask sensitive fields with common.MarshalRedactedJSON. It is run by "go generate" in the package directory, and
+// must be re-run whenever the service code is re-generated:
+//
+//	go generate ./...
+//
+// The models are the struct types with JSON-tagged fields declared in the generated service file (the file with the
+// "IBM OpenAPI SDK Code Generator" header). Types that already declare either method are skipped.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const generatorMarker = "IBM OpenAPI SDK Code Generator"
+
+const header = `/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genredact. DO NOT EDIT.
+
+`
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genredact: ")
+	dir := "."
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+	if err := generate(dir); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate writes the "_redact.go" file of the service package in "dir".
+func generate(dir string) error {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+	var serviceFile string
+	var pkg *ast.File
+	var models []string
+	declared := map[string]bool{}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") || strings.HasSuffix(file, "_redact.go") {
+			continue
+		}
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		parsed, err := parser.ParseFile(fset, file, src, 0)
+		if err != nil {
+			return err
+		}
+		for _, decl := range parsed.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil && len(fn.Recv.List) == 1 {
+				declared[receiverType(fn.Recv.List[0].Type)+"."+fn.Name.Name] = true
+			}
+		}
+		if !bytes.Contains(src, []byte(generatorMarker)) {
+			continue
+		}
+		if serviceFile != "" {
+			return fmt.Errorf("both %s and %s are generated service files", serviceFile, file)
+		}
+		serviceFile, pkg = file, parsed
+		models = structModels(parsed)
+	}
+	if serviceFile == "" {
+		return fmt.Errorf("no generated service file in %s", dir)
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header)
+	out.WriteString("package " + pkg.Name.Name + "\n\n")
+	out.WriteString("import \"github.com/IBM/platform-services-go-sdk/common\"\n\n")
+	out.WriteString("//go:generate go run ../internal/genredact\n")
+	for _, model := range models {
+		if declared[model+".String"] || declared[model+".MarshalRedactedJSON"] {
+			continue
+		}
+		fmt.Fprintf(&out, `
+// String returns the model as JSON, with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model %[1]s) String() string {
+	return common.RedactedString(&model)
+}
+
+// MarshalRedactedJSON marshals the model with its sensitive fields masked (see common.MarshalRedactedJSON).
+func (model %[1]s) MarshalRedactedJSON() ([]byte, error) {
+	return common.MarshalRedactedJSON(&model)
+}
+`, model)
+	}
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+	target := strings.TrimSuffix(serviceFile, ".go") + "_redact.go"
+	return ioutil.WriteFile(target, formatted, 0644)
+}
+
+// structModels returns the names of the struct types of "file" with at least one JSON-tagged field, sorted.
+func structModels(file *ast.File) (models []string) {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok || !typeSpec.Name.IsExported() {
+				continue
+			}
+			for _, field := range structType.Fields.List {
+				if field.Tag != nil && strings.Contains(field.Tag.Value, `json:"`) {
+					models = append(models, typeSpec.Name.Name)
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(models)
+	return
+}
+
+// receiverType returns the name of the type of a method receiver.
+func receiverType(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
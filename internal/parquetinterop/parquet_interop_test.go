@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package parquetinterop checks the Parquet files written by common.NewTableWriter against a reference reader.
+// It is a separate module so that the SDK does not depend on the reader.
+package parquetinterop
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type testRow struct {
+	Name  *string  `parquet:"name,optional"`
+	Cost  *float64 `parquet:"cost,optional"`
+	Count *int64   `parquet:"count,optional"`
+}
+
+func TestParquetRoundTrip(t *testing.T) {
+	columns := []common.Column{
+		{Name: "name", Type: common.ColumnTypeString},
+		{Name: "cost", Type: common.ColumnTypeDouble},
+		{Name: "count", Type: common.ColumnTypeInt64},
+	}
+	// More rows than fit in one row group, with a null in a different column of each row.
+	var expected []testRow
+	for i := 0; i < 10003; i++ {
+		name := fmt.Sprintf("row %d", i)
+		cost := float64(i) / 4
+		count := int64(i) - 5000
+		row := testRow{Name: &name, Cost: &cost, Count: &count}
+		switch i % 4 {
+		case 1:
+			row.Name = nil
+		case 2:
+			row.Cost = nil
+		case 3:
+			row.Count = nil
+		}
+		expected = append(expected, row)
+	}
+
+	buffer := new(bytes.Buffer)
+	writer, err := common.NewTableWriter(buffer, common.ExportFormatParquet, columns)
+	assert.Nil(t, err)
+	for _, row := range expected {
+		// Nil pointers are written as missing values.
+		assert.Nil(t, writer.WriteRow(row.Name, row.Cost, row.Count))
+	}
+	assert.Nil(t, writer.Close())
+
+	file, err := parquet.OpenFile(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, int64(len(expected)), file.NumRows())
+	assert.Len(t, file.RowGroups(), 2)
+	fields := file.Schema().Fields()
+	if assert.Len(t, fields, 3) {
+		assert.Equal(t, "name", fields[0].Name())
+		assert.True(t, fields[0].Optional())
+		assert.Equal(t, parquet.ByteArray, fields[0].Type().Kind())
+		assert.Equal(t, parquet.Double, fields[1].Type().Kind())
+		assert.Equal(t, parquet.Int64, fields[2].Type().Kind())
+	}
+
+	reader := parquet.NewReader(file)
+	defer reader.Close()
+	var actual []testRow
+	for {
+		var row testRow
+		err = reader.Read(&row)
+		if err == io.EOF {
+			break
+		}
+		if !assert.Nil(t, err) {
+			return
+		}
+		actual = append(actual, row)
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestParquetRoundTripEmpty(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	writer, err := common.NewTableWriter(buffer, common.ExportFormatParquet, []common.Column{{Name: "name", Type: common.ColumnTypeString}})
+	assert.Nil(t, err)
+	assert.Nil(t, writer.Close())
+
+	file, err := parquet.OpenFile(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if assert.Nil(t, err) {
+		assert.Equal(t, int64(0), file.NumRows())
+		assert.Len(t, file.Schema().Fields(), 1)
+	}
+}
@@ -0,0 +1,218 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package openservicebrokerv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// OpenServiceBrokerV1API : The operations of the OpenServiceBrokerV1 service, implemented by *OpenServiceBrokerV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type OpenServiceBrokerV1API interface {
+	// Clone makes a copy of "openServiceBroker" suitable for processing requests.
+	Clone() *OpenServiceBrokerV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// GetServiceInstanceState : Get the current state of the service instance
+	GetServiceInstanceState(getServiceInstanceStateOptions *GetServiceInstanceStateOptions) (result *Resp1874644Root, response *core.DetailedResponse, err error)
+
+	// GetServiceInstanceStateWithContext is an alternate form of the GetServiceInstanceState method which supports a
+	// Context parameter
+	GetServiceInstanceStateWithContext(ctx context.Context, getServiceInstanceStateOptions *GetServiceInstanceStateOptions) (result *Resp1874644Root, response *core.DetailedResponse, err error)
+
+	// ReplaceServiceInstanceState : Update the state of a provisioned service instance
+	ReplaceServiceInstanceState(replaceServiceInstanceStateOptions *ReplaceServiceInstanceStateOptions) (result *Resp2448145Root, response *core.DetailedResponse, err error)
+
+	// ReplaceServiceInstanceStateWithContext is an alternate form of the ReplaceServiceInstanceState method which
+	// supports a Context parameter
+	ReplaceServiceInstanceStateWithContext(ctx context.Context, replaceServiceInstanceStateOptions *ReplaceServiceInstanceStateOptions) (result *Resp2448145Root, response *core.DetailedResponse, err error)
+
+	// ReplaceServiceInstance : Create (provision) a service instance
+	ReplaceServiceInstance(replaceServiceInstanceOptions *ReplaceServiceInstanceOptions) (result *Resp2079872Root, response *core.DetailedResponse, err error)
+
+	// ReplaceServiceInstanceWithContext is an alternate form of the ReplaceServiceInstance method which supports a
+	// Context parameter
+	ReplaceServiceInstanceWithContext(ctx context.Context, replaceServiceInstanceOptions *ReplaceServiceInstanceOptions) (result *Resp2079872Root, response *core.DetailedResponse, err error)
+
+	// UpdateServiceInstance : Update a service instance
+	UpdateServiceInstance(updateServiceInstanceOptions *UpdateServiceInstanceOptions) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+
+	// UpdateServiceInstanceWithContext is an alternate form of the UpdateServiceInstance method which supports a
+	// Context parameter
+	UpdateServiceInstanceWithContext(ctx context.Context, updateServiceInstanceOptions *UpdateServiceInstanceOptions) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+
+	// DeleteServiceInstance : Delete (deprovision) a service instance
+	DeleteServiceInstance(deleteServiceInstanceOptions *DeleteServiceInstanceOptions) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+
+	// DeleteServiceInstanceWithContext is an alternate form of the DeleteServiceInstance method which supports a
+	// Context parameter
+	DeleteServiceInstanceWithContext(ctx context.Context, deleteServiceInstanceOptions *DeleteServiceInstanceOptions) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+
+	// ListCatalog : Get the catalog metadata stored within the broker
+	ListCatalog(listCatalogOptions *ListCatalogOptions) (result *Resp1874650Root, response *core.DetailedResponse, err error)
+
+	// ListCatalogWithContext is an alternate form of the ListCatalog method which supports a Context parameter
+	ListCatalogWithContext(ctx context.Context, listCatalogOptions *ListCatalogOptions) (result *Resp1874650Root, response *core.DetailedResponse, err error)
+
+	// GetLastOperation : Get the current status of a provision in-progress for a service instance
+	GetLastOperation(getLastOperationOptions *GetLastOperationOptions) (result *Resp2079894Root, response *core.DetailedResponse, err error)
+
+	// GetLastOperationWithContext is an alternate form of the GetLastOperation method which supports a Context
+	// parameter
+	GetLastOperationWithContext(ctx context.Context, getLastOperationOptions *GetLastOperationOptions) (result *Resp2079894Root, response *core.DetailedResponse, err error)
+
+	// ReplaceServiceBinding : Bind a service instance to another resource
+	ReplaceServiceBinding(replaceServiceBindingOptions *ReplaceServiceBindingOptions) (result *Resp2079876Root, response *core.DetailedResponse, err error)
+
+	// ReplaceServiceBindingWithContext is an alternate form of the ReplaceServiceBinding method which supports a
+	// Context parameter
+	ReplaceServiceBindingWithContext(ctx context.Context, replaceServiceBindingOptions *ReplaceServiceBindingOptions) (result *Resp2079876Root, response *core.DetailedResponse, err error)
+
+	// DeleteServiceBinding : Delete (unbind) the credentials bound to a resource
+	DeleteServiceBinding(deleteServiceBindingOptions *DeleteServiceBindingOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteServiceBindingWithContext is an alternate form of the DeleteServiceBinding method which supports a Context
+	// parameter
+	DeleteServiceBindingWithContext(ctx context.Context, deleteServiceBindingOptions *DeleteServiceBindingOptions) (response *core.DetailedResponse, err error)
+
+	// NewDeleteServiceBindingOptions : Instantiate DeleteServiceBindingOptions
+	NewDeleteServiceBindingOptions(bindingID string, instanceID string, planID string, serviceID string) *DeleteServiceBindingOptions
+
+	// NewDeleteServiceInstanceOptions : Instantiate DeleteServiceInstanceOptions
+	NewDeleteServiceInstanceOptions(serviceID string, planID string, instanceID string) *DeleteServiceInstanceOptions
+
+	// NewGetLastOperationOptions : Instantiate GetLastOperationOptions
+	NewGetLastOperationOptions(instanceID string) *GetLastOperationOptions
+
+	// NewGetServiceInstanceStateOptions : Instantiate GetServiceInstanceStateOptions
+	NewGetServiceInstanceStateOptions(instanceID string) *GetServiceInstanceStateOptions
+
+	// NewListCatalogOptions : Instantiate ListCatalogOptions
+	NewListCatalogOptions() *ListCatalogOptions
+
+	// NewReplaceServiceBindingOptions : Instantiate ReplaceServiceBindingOptions
+	NewReplaceServiceBindingOptions(bindingID string, instanceID string) *ReplaceServiceBindingOptions
+
+	// NewReplaceServiceInstanceOptions : Instantiate ReplaceServiceInstanceOptions
+	NewReplaceServiceInstanceOptions(instanceID string) *ReplaceServiceInstanceOptions
+
+	// NewReplaceServiceInstanceStateOptions : Instantiate ReplaceServiceInstanceStateOptions
+	NewReplaceServiceInstanceStateOptions(instanceID string) *ReplaceServiceInstanceStateOptions
+
+	// NewUpdateServiceInstanceOptions : Instantiate UpdateServiceInstanceOptions
+	NewUpdateServiceInstanceOptions(instanceID string) *UpdateServiceInstanceOptions
+
+	// NewGetServiceInstanceStateOptionsWith returns GetServiceInstanceStateOptions populated by applying "options" in
+	// order.
+	NewGetServiceInstanceStateOptionsWith(options ...GetServiceInstanceStateOption) *GetServiceInstanceStateOptions
+
+	// GetServiceInstanceStateWith is an alternate form of the GetServiceInstanceStateWithContext method which takes
+	// functional options instead of a GetServiceInstanceStateOptions struct.
+	GetServiceInstanceStateWith(ctx context.Context, options ...GetServiceInstanceStateOption) (result *Resp1874644Root, response *core.DetailedResponse, err error)
+
+	// NewReplaceServiceInstanceStateOptionsWith returns ReplaceServiceInstanceStateOptions populated by applying
+	// "options" in order.
+	NewReplaceServiceInstanceStateOptionsWith(options ...ReplaceServiceInstanceStateOption) *ReplaceServiceInstanceStateOptions
+
+	// ReplaceServiceInstanceStateWith is an alternate form of the ReplaceServiceInstanceStateWithContext method which
+	// takes functional options instead of a ReplaceServiceInstanceStateOptions struct.
+	ReplaceServiceInstanceStateWith(ctx context.Context, options ...ReplaceServiceInstanceStateOption) (result *Resp2448145Root, response *core.DetailedResponse, err error)
+
+	// NewReplaceServiceInstanceOptionsWith returns ReplaceServiceInstanceOptions populated by applying "options" in
+	// order.
+	NewReplaceServiceInstanceOptionsWith(options ...ReplaceServiceInstanceOption) *ReplaceServiceInstanceOptions
+
+	// ReplaceServiceInstanceWith is an alternate form of the ReplaceServiceInstanceWithContext method which takes
+	// functional options instead of a ReplaceServiceInstanceOptions struct.
+	ReplaceServiceInstanceWith(ctx context.Context, options ...ReplaceServiceInstanceOption) (result *Resp2079872Root, response *core.DetailedResponse, err error)
+
+	// NewUpdateServiceInstanceOptionsWith returns UpdateServiceInstanceOptions populated by applying "options" in
+	// order.
+	NewUpdateServiceInstanceOptionsWith(options ...UpdateServiceInstanceOption) *UpdateServiceInstanceOptions
+
+	// UpdateServiceInstanceWith is an alternate form of the UpdateServiceInstanceWithContext method which takes
+	// functional options instead of an UpdateServiceInstanceOptions struct.
+	UpdateServiceInstanceWith(ctx context.Context, options ...UpdateServiceInstanceOption) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+
+	// NewDeleteServiceInstanceOptionsWith returns DeleteServiceInstanceOptions populated by applying "options" in
+	// order.
+	NewDeleteServiceInstanceOptionsWith(options ...DeleteServiceInstanceOption) *DeleteServiceInstanceOptions
+
+	// DeleteServiceInstanceWith is an alternate form of the DeleteServiceInstanceWithContext method which takes
+	// functional options instead of a DeleteServiceInstanceOptions struct.
+	DeleteServiceInstanceWith(ctx context.Context, options ...DeleteServiceInstanceOption) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+
+	// NewListCatalogOptionsWith returns ListCatalogOptions populated by applying "options" in order.
+	NewListCatalogOptionsWith(options ...ListCatalogOption) *ListCatalogOptions
+
+	// ListCatalogWith is an alternate form of the ListCatalogWithContext method which takes functional options instead
+	// of a ListCatalogOptions struct.
+	ListCatalogWith(ctx context.Context, options ...ListCatalogOption) (result *Resp1874650Root, response *core.DetailedResponse, err error)
+
+	// NewGetLastOperationOptionsWith returns GetLastOperationOptions populated by applying "options" in order.
+	NewGetLastOperationOptionsWith(options ...GetLastOperationOption) *GetLastOperationOptions
+
+	// GetLastOperationWith is an alternate form of the GetLastOperationWithContext method which takes functional
+	// options instead of a GetLastOperationOptions struct.
+	GetLastOperationWith(ctx context.Context, options ...GetLastOperationOption) (result *Resp2079894Root, response *core.DetailedResponse, err error)
+
+	// NewReplaceServiceBindingOptionsWith returns ReplaceServiceBindingOptions populated by applying "options" in
+	// order.
+	NewReplaceServiceBindingOptionsWith(options ...ReplaceServiceBindingOption) *ReplaceServiceBindingOptions
+
+	// ReplaceServiceBindingWith is an alternate form of the ReplaceServiceBindingWithContext method which takes
+	// functional options instead of a ReplaceServiceBindingOptions struct.
+	ReplaceServiceBindingWith(ctx context.Context, options ...ReplaceServiceBindingOption) (result *Resp2079876Root, response *core.DetailedResponse, err error)
+
+	// NewDeleteServiceBindingOptionsWith returns DeleteServiceBindingOptions populated by applying "options" in order.
+	NewDeleteServiceBindingOptionsWith(options ...DeleteServiceBindingOption) *DeleteServiceBindingOptions
+
+	// DeleteServiceBindingWith is an alternate form of the DeleteServiceBindingWithContext method which takes
+	// functional options instead of a DeleteServiceBindingOptions struct.
+	DeleteServiceBindingWith(ctx context.Context, options ...DeleteServiceBindingOption) (response *core.DetailedResponse, err error)
+}
+
+var _ OpenServiceBrokerV1API = (*OpenServiceBrokerV1)(nil)
@@ -0,0 +1,599 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package openservicebrokerv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GetServiceInstanceStateOption : A functional option that sets a property of GetServiceInstanceStateOptions (see GetServiceInstanceStateWith).
+type GetServiceInstanceStateOption func(options *GetServiceInstanceStateOptions)
+
+// GetServiceInstanceStateInstanceID sets the InstanceID property of GetServiceInstanceStateOptions. The
+// `instance_id` of a service instance is provided by the IBM Cloud platform.
+func GetServiceInstanceStateInstanceID(instanceID string) GetServiceInstanceStateOption {
+	return func(options *GetServiceInstanceStateOptions) {
+		options.InstanceID = core.StringPtr(instanceID)
+	}
+}
+
+// GetServiceInstanceStateHeader sets the Headers property of GetServiceInstanceStateOptions. Allows users to set
+// headers on API requests.
+func GetServiceInstanceStateHeader(name string, value string) GetServiceInstanceStateOption {
+	return func(options *GetServiceInstanceStateOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetServiceInstanceStateOptionsWith returns GetServiceInstanceStateOptions populated by applying "options" in order.
+func (*OpenServiceBrokerV1) NewGetServiceInstanceStateOptionsWith(options ...GetServiceInstanceStateOption) *GetServiceInstanceStateOptions {
+	getServiceInstanceStateOptions := &GetServiceInstanceStateOptions{}
+	for _, option := range options {
+		option(getServiceInstanceStateOptions)
+	}
+	return getServiceInstanceStateOptions
+}
+
+// GetServiceInstanceStateWith is an alternate form of the GetServiceInstanceStateWithContext method which takes
+// functional options instead of a GetServiceInstanceStateOptions struct. GetServiceInstanceStateInstanceID is
+// required.
+func (openServiceBroker *OpenServiceBrokerV1) GetServiceInstanceStateWith(ctx context.Context, options ...GetServiceInstanceStateOption) (result *Resp1874644Root, response *core.DetailedResponse, err error) {
+	return openServiceBroker.GetServiceInstanceStateWithContext(ctx, openServiceBroker.NewGetServiceInstanceStateOptionsWith(options...))
+}
+
+// ReplaceServiceInstanceStateOption : A functional option that sets a property of ReplaceServiceInstanceStateOptions (see ReplaceServiceInstanceStateWith).
+type ReplaceServiceInstanceStateOption func(options *ReplaceServiceInstanceStateOptions)
+
+// ReplaceServiceInstanceStateInstanceID sets the InstanceID property of ReplaceServiceInstanceStateOptions. The
+// `instance_id` of a service instance is provided by the IBM Cloud platform.
+func ReplaceServiceInstanceStateInstanceID(instanceID string) ReplaceServiceInstanceStateOption {
+	return func(options *ReplaceServiceInstanceStateOptions) {
+		options.InstanceID = core.StringPtr(instanceID)
+	}
+}
+
+// ReplaceServiceInstanceStateEnabled sets the Enabled property of ReplaceServiceInstanceStateOptions. Indicates
+// the current state of the service instance.
+func ReplaceServiceInstanceStateEnabled(enabled bool) ReplaceServiceInstanceStateOption {
+	return func(options *ReplaceServiceInstanceStateOptions) {
+		options.Enabled = core.BoolPtr(enabled)
+	}
+}
+
+// ReplaceServiceInstanceStateInitiatorID sets the InitiatorID property of ReplaceServiceInstanceStateOptions.
+// Optional string that shows the user ID that is initiating the call.
+func ReplaceServiceInstanceStateInitiatorID(initiatorID string) ReplaceServiceInstanceStateOption {
+	return func(options *ReplaceServiceInstanceStateOptions) {
+		options.InitiatorID = core.StringPtr(initiatorID)
+	}
+}
+
+// ReplaceServiceInstanceStateReasonCode sets the ReasonCode property of ReplaceServiceInstanceStateOptions.
+// Optional string that states the reason code for the service instance state change.
+func ReplaceServiceInstanceStateReasonCode(reasonCode string) ReplaceServiceInstanceStateOption {
+	return func(options *ReplaceServiceInstanceStateOptions) {
+		options.ReasonCode = core.StringPtr(reasonCode)
+	}
+}
+
+// ReplaceServiceInstanceStateHeader sets the Headers property of ReplaceServiceInstanceStateOptions. Allows users
+// to set headers on API requests.
+func ReplaceServiceInstanceStateHeader(name string, value string) ReplaceServiceInstanceStateOption {
+	return func(options *ReplaceServiceInstanceStateOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceServiceInstanceStateOptionsWith returns ReplaceServiceInstanceStateOptions populated by applying "options" in order.
+func (*OpenServiceBrokerV1) NewReplaceServiceInstanceStateOptionsWith(options ...ReplaceServiceInstanceStateOption) *ReplaceServiceInstanceStateOptions {
+	replaceServiceInstanceStateOptions := &ReplaceServiceInstanceStateOptions{}
+	for _, option := range options {
+		option(replaceServiceInstanceStateOptions)
+	}
+	return replaceServiceInstanceStateOptions
+}
+
+// ReplaceServiceInstanceStateWith is an alternate form of the ReplaceServiceInstanceStateWithContext method which
+// takes functional options instead of a ReplaceServiceInstanceStateOptions struct.
+// ReplaceServiceInstanceStateInstanceID is required.
+func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceInstanceStateWith(ctx context.Context, options ...ReplaceServiceInstanceStateOption) (result *Resp2448145Root, response *core.DetailedResponse, err error) {
+	return openServiceBroker.ReplaceServiceInstanceStateWithContext(ctx, openServiceBroker.NewReplaceServiceInstanceStateOptionsWith(options...))
+}
+
+// ReplaceServiceInstanceOption : A functional option that sets a property of ReplaceServiceInstanceOptions (see ReplaceServiceInstanceWith).
+type ReplaceServiceInstanceOption func(options *ReplaceServiceInstanceOptions)
+
+// ReplaceServiceInstanceInstanceID sets the InstanceID property of ReplaceServiceInstanceOptions. The
+// `instance_id` of a service instance is provided by the IBM Cloud platform.
+func ReplaceServiceInstanceInstanceID(instanceID string) ReplaceServiceInstanceOption {
+	return func(options *ReplaceServiceInstanceOptions) {
+		options.InstanceID = core.StringPtr(instanceID)
+	}
+}
+
+// ReplaceServiceInstanceContext sets the Context property of ReplaceServiceInstanceOptions. Platform specific
+// contextual information under which the service instance is to be provisioned.
+func ReplaceServiceInstanceContext(contextVar *Context) ReplaceServiceInstanceOption {
+	return func(options *ReplaceServiceInstanceOptions) {
+		options.Context = contextVar
+	}
+}
+
+// ReplaceServiceInstanceOrganizationGUID sets the OrganizationGUID property of ReplaceServiceInstanceOptions.
+// Deprecated in favor of `context`.
+func ReplaceServiceInstanceOrganizationGUID(organizationGUID string) ReplaceServiceInstanceOption {
+	return func(options *ReplaceServiceInstanceOptions) {
+		options.OrganizationGUID = core.StringPtr(organizationGUID)
+	}
+}
+
+// ReplaceServiceInstanceParameters sets the Parameters property of ReplaceServiceInstanceOptions. Configuration
+// options for the service instance.
+func ReplaceServiceInstanceParameters(parameters map[string]string) ReplaceServiceInstanceOption {
+	return func(options *ReplaceServiceInstanceOptions) {
+		options.Parameters = parameters
+	}
+}
+
+// ReplaceServiceInstancePlanID sets the PlanID property of ReplaceServiceInstanceOptions. The ID of the plan for
+// which the service instance has been requested, which is stored in the catalog.json of your broker.
+func ReplaceServiceInstancePlanID(planID string) ReplaceServiceInstanceOption {
+	return func(options *ReplaceServiceInstanceOptions) {
+		options.PlanID = core.StringPtr(planID)
+	}
+}
+
+// ReplaceServiceInstanceServiceID sets the ServiceID property of ReplaceServiceInstanceOptions. The ID of the
+// service stored in the catalog.json of your broker.
+func ReplaceServiceInstanceServiceID(serviceID string) ReplaceServiceInstanceOption {
+	return func(options *ReplaceServiceInstanceOptions) {
+		options.ServiceID = core.StringPtr(serviceID)
+	}
+}
+
+// ReplaceServiceInstanceSpaceGUID sets the SpaceGUID property of ReplaceServiceInstanceOptions. Deprecated in
+// favor of `context`.
+func ReplaceServiceInstanceSpaceGUID(spaceGUID string) ReplaceServiceInstanceOption {
+	return func(options *ReplaceServiceInstanceOptions) {
+		options.SpaceGUID = core.StringPtr(spaceGUID)
+	}
+}
+
+// ReplaceServiceInstanceAcceptsIncomplete sets the AcceptsIncomplete property of ReplaceServiceInstanceOptions. A
+// value of true indicates that both the IBM Cloud platform and the requesting client support asynchronous
+// deprovisioning.
+func ReplaceServiceInstanceAcceptsIncomplete(acceptsIncomplete bool) ReplaceServiceInstanceOption {
+	return func(options *ReplaceServiceInstanceOptions) {
+		options.AcceptsIncomplete = core.BoolPtr(acceptsIncomplete)
+	}
+}
+
+// ReplaceServiceInstanceHeader sets the Headers property of ReplaceServiceInstanceOptions. Allows users to set
+// headers on API requests.
+func ReplaceServiceInstanceHeader(name string, value string) ReplaceServiceInstanceOption {
+	return func(options *ReplaceServiceInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceServiceInstanceOptionsWith returns ReplaceServiceInstanceOptions populated by applying "options" in order.
+func (*OpenServiceBrokerV1) NewReplaceServiceInstanceOptionsWith(options ...ReplaceServiceInstanceOption) *ReplaceServiceInstanceOptions {
+	replaceServiceInstanceOptions := &ReplaceServiceInstanceOptions{}
+	for _, option := range options {
+		option(replaceServiceInstanceOptions)
+	}
+	return replaceServiceInstanceOptions
+}
+
+// ReplaceServiceInstanceWith is an alternate form of the ReplaceServiceInstanceWithContext method which takes
+// functional options instead of a ReplaceServiceInstanceOptions struct. ReplaceServiceInstanceInstanceID is
+// required.
+func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceInstanceWith(ctx context.Context, options ...ReplaceServiceInstanceOption) (result *Resp2079872Root, response *core.DetailedResponse, err error) {
+	return openServiceBroker.ReplaceServiceInstanceWithContext(ctx, openServiceBroker.NewReplaceServiceInstanceOptionsWith(options...))
+}
+
+// UpdateServiceInstanceOption : A functional option that sets a property of UpdateServiceInstanceOptions (see UpdateServiceInstanceWith).
+type UpdateServiceInstanceOption func(options *UpdateServiceInstanceOptions)
+
+// UpdateServiceInstanceInstanceID sets the InstanceID property of UpdateServiceInstanceOptions. The ID of a
+// previously provisioned service instance.
+func UpdateServiceInstanceInstanceID(instanceID string) UpdateServiceInstanceOption {
+	return func(options *UpdateServiceInstanceOptions) {
+		options.InstanceID = core.StringPtr(instanceID)
+	}
+}
+
+// UpdateServiceInstanceContext sets the Context property of UpdateServiceInstanceOptions. Platform specific
+// contextual information under which the service instance is to be provisioned.
+func UpdateServiceInstanceContext(contextVar *Context) UpdateServiceInstanceOption {
+	return func(options *UpdateServiceInstanceOptions) {
+		options.Context = contextVar
+	}
+}
+
+// UpdateServiceInstanceParameters sets the Parameters property of UpdateServiceInstanceOptions. Configuration
+// options for the service instance.
+func UpdateServiceInstanceParameters(parameters map[string]string) UpdateServiceInstanceOption {
+	return func(options *UpdateServiceInstanceOptions) {
+		options.Parameters = parameters
+	}
+}
+
+// UpdateServiceInstancePlanID sets the PlanID property of UpdateServiceInstanceOptions. The ID of the plan for
+// which the service instance has been requested, which is stored in the catalog.json of your broker.
+func UpdateServiceInstancePlanID(planID string) UpdateServiceInstanceOption {
+	return func(options *UpdateServiceInstanceOptions) {
+		options.PlanID = core.StringPtr(planID)
+	}
+}
+
+// UpdateServiceInstancePreviousValues sets the PreviousValues property of UpdateServiceInstanceOptions.
+// Information about the service instance prior to the update.
+func UpdateServiceInstancePreviousValues(previousValues map[string]string) UpdateServiceInstanceOption {
+	return func(options *UpdateServiceInstanceOptions) {
+		options.PreviousValues = previousValues
+	}
+}
+
+// UpdateServiceInstanceServiceID sets the ServiceID property of UpdateServiceInstanceOptions. The ID of the
+// service stored in the catalog.json of your broker.
+func UpdateServiceInstanceServiceID(serviceID string) UpdateServiceInstanceOption {
+	return func(options *UpdateServiceInstanceOptions) {
+		options.ServiceID = core.StringPtr(serviceID)
+	}
+}
+
+// UpdateServiceInstanceAcceptsIncomplete sets the AcceptsIncomplete property of UpdateServiceInstanceOptions. A
+// value of true indicates that both the IBM Cloud platform and the requesting client support asynchronous
+// deprovisioning.
+func UpdateServiceInstanceAcceptsIncomplete(acceptsIncomplete bool) UpdateServiceInstanceOption {
+	return func(options *UpdateServiceInstanceOptions) {
+		options.AcceptsIncomplete = core.BoolPtr(acceptsIncomplete)
+	}
+}
+
+// UpdateServiceInstanceHeader sets the Headers property of UpdateServiceInstanceOptions. Allows users to set
+// headers on API requests.
+func UpdateServiceInstanceHeader(name string, value string) UpdateServiceInstanceOption {
+	return func(options *UpdateServiceInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateServiceInstanceOptionsWith returns UpdateServiceInstanceOptions populated by applying "options" in order.
+func (*OpenServiceBrokerV1) NewUpdateServiceInstanceOptionsWith(options ...UpdateServiceInstanceOption) *UpdateServiceInstanceOptions {
+	updateServiceInstanceOptions := &UpdateServiceInstanceOptions{}
+	for _, option := range options {
+		option(updateServiceInstanceOptions)
+	}
+	return updateServiceInstanceOptions
+}
+
+// UpdateServiceInstanceWith is an alternate form of the UpdateServiceInstanceWithContext method which takes
+// functional options instead of an UpdateServiceInstanceOptions struct. UpdateServiceInstanceInstanceID is
+// required.
+func (openServiceBroker *OpenServiceBrokerV1) UpdateServiceInstanceWith(ctx context.Context, options ...UpdateServiceInstanceOption) (result *Resp2079874Root, response *core.DetailedResponse, err error) {
+	return openServiceBroker.UpdateServiceInstanceWithContext(ctx, openServiceBroker.NewUpdateServiceInstanceOptionsWith(options...))
+}
+
+// DeleteServiceInstanceOption : A functional option that sets a property of DeleteServiceInstanceOptions (see DeleteServiceInstanceWith).
+type DeleteServiceInstanceOption func(options *DeleteServiceInstanceOptions)
+
+// DeleteServiceInstanceServiceID sets the ServiceID property of DeleteServiceInstanceOptions. The ID of the
+// service stored in the catalog.json of your broker.
+func DeleteServiceInstanceServiceID(serviceID string) DeleteServiceInstanceOption {
+	return func(options *DeleteServiceInstanceOptions) {
+		options.ServiceID = core.StringPtr(serviceID)
+	}
+}
+
+// DeleteServiceInstancePlanID sets the PlanID property of DeleteServiceInstanceOptions. The ID of the plan for
+// which the service instance has been requested, which is stored in the catalog.json of your broker.
+func DeleteServiceInstancePlanID(planID string) DeleteServiceInstanceOption {
+	return func(options *DeleteServiceInstanceOptions) {
+		options.PlanID = core.StringPtr(planID)
+	}
+}
+
+// DeleteServiceInstanceInstanceID sets the InstanceID property of DeleteServiceInstanceOptions. The ID of a
+// previously provisioned service instance.
+func DeleteServiceInstanceInstanceID(instanceID string) DeleteServiceInstanceOption {
+	return func(options *DeleteServiceInstanceOptions) {
+		options.InstanceID = core.StringPtr(instanceID)
+	}
+}
+
+// DeleteServiceInstanceAcceptsIncomplete sets the AcceptsIncomplete property of DeleteServiceInstanceOptions. A
+// value of true indicates that both the IBM Cloud platform and the requesting client support asynchronous
+// deprovisioning.
+func DeleteServiceInstanceAcceptsIncomplete(acceptsIncomplete bool) DeleteServiceInstanceOption {
+	return func(options *DeleteServiceInstanceOptions) {
+		options.AcceptsIncomplete = core.BoolPtr(acceptsIncomplete)
+	}
+}
+
+// DeleteServiceInstanceHeader sets the Headers property of DeleteServiceInstanceOptions. Allows users to set
+// headers on API requests.
+func DeleteServiceInstanceHeader(name string, value string) DeleteServiceInstanceOption {
+	return func(options *DeleteServiceInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteServiceInstanceOptionsWith returns DeleteServiceInstanceOptions populated by applying "options" in order.
+func (*OpenServiceBrokerV1) NewDeleteServiceInstanceOptionsWith(options ...DeleteServiceInstanceOption) *DeleteServiceInstanceOptions {
+	deleteServiceInstanceOptions := &DeleteServiceInstanceOptions{}
+	for _, option := range options {
+		option(deleteServiceInstanceOptions)
+	}
+	return deleteServiceInstanceOptions
+}
+
+// DeleteServiceInstanceWith is an alternate form of the DeleteServiceInstanceWithContext method which takes
+// functional options instead of a DeleteServiceInstanceOptions struct. DeleteServiceInstanceServiceID,
+// DeleteServiceInstancePlanID and DeleteServiceInstanceInstanceID are required.
+func (openServiceBroker *OpenServiceBrokerV1) DeleteServiceInstanceWith(ctx context.Context, options ...DeleteServiceInstanceOption) (result *Resp2079874Root, response *core.DetailedResponse, err error) {
+	return openServiceBroker.DeleteServiceInstanceWithContext(ctx, openServiceBroker.NewDeleteServiceInstanceOptionsWith(options...))
+}
+
+// ListCatalogOption : A functional option that sets a property of ListCatalogOptions (see ListCatalogWith).
+type ListCatalogOption func(options *ListCatalogOptions)
+
+// ListCatalogHeader sets the Headers property of ListCatalogOptions. Allows users to set headers on API requests.
+func ListCatalogHeader(name string, value string) ListCatalogOption {
+	return func(options *ListCatalogOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListCatalogOptionsWith returns ListCatalogOptions populated by applying "options" in order.
+func (*OpenServiceBrokerV1) NewListCatalogOptionsWith(options ...ListCatalogOption) *ListCatalogOptions {
+	listCatalogOptions := &ListCatalogOptions{}
+	for _, option := range options {
+		option(listCatalogOptions)
+	}
+	return listCatalogOptions
+}
+
+// ListCatalogWith is an alternate form of the ListCatalogWithContext method which takes functional options instead
+// of a ListCatalogOptions struct.
+func (openServiceBroker *OpenServiceBrokerV1) ListCatalogWith(ctx context.Context, options ...ListCatalogOption) (result *Resp1874650Root, response *core.DetailedResponse, err error) {
+	return openServiceBroker.ListCatalogWithContext(ctx, openServiceBroker.NewListCatalogOptionsWith(options...))
+}
+
+// GetLastOperationOption : A functional option that sets a property of GetLastOperationOptions (see GetLastOperationWith).
+type GetLastOperationOption func(options *GetLastOperationOptions)
+
+// GetLastOperationInstanceID sets the InstanceID property of GetLastOperationOptions. The unique instance ID
+// generated during provisioning by the IBM Cloud platform.
+func GetLastOperationInstanceID(instanceID string) GetLastOperationOption {
+	return func(options *GetLastOperationOptions) {
+		options.InstanceID = core.StringPtr(instanceID)
+	}
+}
+
+// GetLastOperationOperation sets the Operation property of GetLastOperationOptions. A broker-provided identifier
+// for the operation.
+func GetLastOperationOperation(operation string) GetLastOperationOption {
+	return func(options *GetLastOperationOptions) {
+		options.Operation = core.StringPtr(operation)
+	}
+}
+
+// GetLastOperationPlanID sets the PlanID property of GetLastOperationOptions. ID of the plan from the catalog.json
+// in your broker.
+func GetLastOperationPlanID(planID string) GetLastOperationOption {
+	return func(options *GetLastOperationOptions) {
+		options.PlanID = core.StringPtr(planID)
+	}
+}
+
+// GetLastOperationServiceID sets the ServiceID property of GetLastOperationOptions. ID of the service from the
+// catalog.json in your service broker.
+func GetLastOperationServiceID(serviceID string) GetLastOperationOption {
+	return func(options *GetLastOperationOptions) {
+		options.ServiceID = core.StringPtr(serviceID)
+	}
+}
+
+// GetLastOperationHeader sets the Headers property of GetLastOperationOptions. Allows users to set headers on API
+// requests.
+func GetLastOperationHeader(name string, value string) GetLastOperationOption {
+	return func(options *GetLastOperationOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetLastOperationOptionsWith returns GetLastOperationOptions populated by applying "options" in order.
+func (*OpenServiceBrokerV1) NewGetLastOperationOptionsWith(options ...GetLastOperationOption) *GetLastOperationOptions {
+	getLastOperationOptions := &GetLastOperationOptions{}
+	for _, option := range options {
+		option(getLastOperationOptions)
+	}
+	return getLastOperationOptions
+}
+
+// GetLastOperationWith is an alternate form of the GetLastOperationWithContext method which takes functional
+// options instead of a GetLastOperationOptions struct. GetLastOperationInstanceID is required.
+func (openServiceBroker *OpenServiceBrokerV1) GetLastOperationWith(ctx context.Context, options ...GetLastOperationOption) (result *Resp2079894Root, response *core.DetailedResponse, err error) {
+	return openServiceBroker.GetLastOperationWithContext(ctx, openServiceBroker.NewGetLastOperationOptionsWith(options...))
+}
+
+// ReplaceServiceBindingOption : A functional option that sets a property of ReplaceServiceBindingOptions (see ReplaceServiceBindingWith).
+type ReplaceServiceBindingOption func(options *ReplaceServiceBindingOptions)
+
+// ReplaceServiceBindingBindingID sets the BindingID property of ReplaceServiceBindingOptions. The `binding_id` is
+// provided by the IBM Cloud platform.
+func ReplaceServiceBindingBindingID(bindingID string) ReplaceServiceBindingOption {
+	return func(options *ReplaceServiceBindingOptions) {
+		options.BindingID = core.StringPtr(bindingID)
+	}
+}
+
+// ReplaceServiceBindingInstanceID sets the InstanceID property of ReplaceServiceBindingOptions. The :`instance_id`
+// is the ID of a previously provisioned service instance.
+func ReplaceServiceBindingInstanceID(instanceID string) ReplaceServiceBindingOption {
+	return func(options *ReplaceServiceBindingOptions) {
+		options.InstanceID = core.StringPtr(instanceID)
+	}
+}
+
+// ReplaceServiceBindingBindResource sets the BindResource property of ReplaceServiceBindingOptions. A JSON object
+// that contains data for platform resources associated with the binding to be created.
+func ReplaceServiceBindingBindResource(bindResource *BindResource) ReplaceServiceBindingOption {
+	return func(options *ReplaceServiceBindingOptions) {
+		options.BindResource = bindResource
+	}
+}
+
+// ReplaceServiceBindingParameters sets the Parameters property of ReplaceServiceBindingOptions. Configuration
+// options for the service instance.
+func ReplaceServiceBindingParameters(parameters map[string]string) ReplaceServiceBindingOption {
+	return func(options *ReplaceServiceBindingOptions) {
+		options.Parameters = parameters
+	}
+}
+
+// ReplaceServiceBindingPlanID sets the PlanID property of ReplaceServiceBindingOptions. The ID of the plan from
+// the catalog.json in your broker.
+func ReplaceServiceBindingPlanID(planID string) ReplaceServiceBindingOption {
+	return func(options *ReplaceServiceBindingOptions) {
+		options.PlanID = core.StringPtr(planID)
+	}
+}
+
+// ReplaceServiceBindingServiceID sets the ServiceID property of ReplaceServiceBindingOptions. The ID of the
+// service from the catalog.json in your broker.
+func ReplaceServiceBindingServiceID(serviceID string) ReplaceServiceBindingOption {
+	return func(options *ReplaceServiceBindingOptions) {
+		options.ServiceID = core.StringPtr(serviceID)
+	}
+}
+
+// ReplaceServiceBindingHeader sets the Headers property of ReplaceServiceBindingOptions. Allows users to set
+// headers on API requests.
+func ReplaceServiceBindingHeader(name string, value string) ReplaceServiceBindingOption {
+	return func(options *ReplaceServiceBindingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReplaceServiceBindingOptionsWith returns ReplaceServiceBindingOptions populated by applying "options" in order.
+func (*OpenServiceBrokerV1) NewReplaceServiceBindingOptionsWith(options ...ReplaceServiceBindingOption) *ReplaceServiceBindingOptions {
+	replaceServiceBindingOptions := &ReplaceServiceBindingOptions{}
+	for _, option := range options {
+		option(replaceServiceBindingOptions)
+	}
+	return replaceServiceBindingOptions
+}
+
+// ReplaceServiceBindingWith is an alternate form of the ReplaceServiceBindingWithContext method which takes
+// functional options instead of a ReplaceServiceBindingOptions struct. ReplaceServiceBindingBindingID and
+// ReplaceServiceBindingInstanceID are required.
+func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceBindingWith(ctx context.Context, options ...ReplaceServiceBindingOption) (result *Resp2079876Root, response *core.DetailedResponse, err error) {
+	return openServiceBroker.ReplaceServiceBindingWithContext(ctx, openServiceBroker.NewReplaceServiceBindingOptionsWith(options...))
+}
+
+// DeleteServiceBindingOption : A functional option that sets a property of DeleteServiceBindingOptions (see DeleteServiceBindingWith).
+type DeleteServiceBindingOption func(options *DeleteServiceBindingOptions)
+
+// DeleteServiceBindingBindingID sets the BindingID property of DeleteServiceBindingOptions. The `binding_id` is
+// the ID of a previously provisioned binding for that service instance.
+func DeleteServiceBindingBindingID(bindingID string) DeleteServiceBindingOption {
+	return func(options *DeleteServiceBindingOptions) {
+		options.BindingID = core.StringPtr(bindingID)
+	}
+}
+
+// DeleteServiceBindingInstanceID sets the InstanceID property of DeleteServiceBindingOptions. The `instance_id` is
+// the ID of a previously provisioned service instance.
+func DeleteServiceBindingInstanceID(instanceID string) DeleteServiceBindingOption {
+	return func(options *DeleteServiceBindingOptions) {
+		options.InstanceID = core.StringPtr(instanceID)
+	}
+}
+
+// DeleteServiceBindingPlanID sets the PlanID property of DeleteServiceBindingOptions. The ID of the plan from the
+// catalog.json in the broker.
+func DeleteServiceBindingPlanID(planID string) DeleteServiceBindingOption {
+	return func(options *DeleteServiceBindingOptions) {
+		options.PlanID = core.StringPtr(planID)
+	}
+}
+
+// DeleteServiceBindingServiceID sets the ServiceID property of DeleteServiceBindingOptions. The ID of the service
+// from the catalog.json in the broker.
+func DeleteServiceBindingServiceID(serviceID string) DeleteServiceBindingOption {
+	return func(options *DeleteServiceBindingOptions) {
+		options.ServiceID = core.StringPtr(serviceID)
+	}
+}
+
+// DeleteServiceBindingHeader sets the Headers property of DeleteServiceBindingOptions. Allows users to set headers
+// on API requests.
+func DeleteServiceBindingHeader(name string, value string) DeleteServiceBindingOption {
+	return func(options *DeleteServiceBindingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteServiceBindingOptionsWith returns DeleteServiceBindingOptions populated by applying "options" in order.
+func (*OpenServiceBrokerV1) NewDeleteServiceBindingOptionsWith(options ...DeleteServiceBindingOption) *DeleteServiceBindingOptions {
+	deleteServiceBindingOptions := &DeleteServiceBindingOptions{}
+	for _, option := range options {
+		option(deleteServiceBindingOptions)
+	}
+	return deleteServiceBindingOptions
+}
+
+// DeleteServiceBindingWith is an alternate form of the DeleteServiceBindingWithContext method which takes
+// functional options instead of a DeleteServiceBindingOptions struct. DeleteServiceBindingBindingID,
+// DeleteServiceBindingInstanceID, DeleteServiceBindingPlanID and DeleteServiceBindingServiceID are required.
+func (openServiceBroker *OpenServiceBrokerV1) DeleteServiceBindingWith(ctx context.Context, options ...DeleteServiceBindingOption) (response *core.DetailedResponse, err error) {
+	return openServiceBroker.DeleteServiceBindingWithContext(ctx, openServiceBroker.NewDeleteServiceBindingOptionsWith(options...))
+}
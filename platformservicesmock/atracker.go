@@ -0,0 +1,157 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// AtrackerTarget returns the JSON representation of a Cloud Object Storage target with the specified name, as
+// returned by version 2 of the Activity Tracker service.
+func AtrackerTarget(name string) map[string]interface{} {
+	id := "target-" + name
+	return map[string]interface{}{
+		"id":          id,
+		"name":        name,
+		"crn":         fmt.Sprintf("crn:v1:bluemix:public:atracker:us-south:a/%s::target:%s", AccountID, id),
+		"target_type": "cloud_object_storage",
+		"region":      "us-south",
+		"cos_endpoint": map[string]interface{}{
+			"endpoint":                   "s3.private.us-south.cloud-object-storage.appdomain.cloud",
+			"target_crn":                 fmt.Sprintf("crn:v1:bluemix:public:cloud-object-storage:global:a/%s:mock-cos::", AccountID),
+			"bucket":                     "mock-bucket",
+			"service_to_service_enabled": true,
+		},
+		"write_status": map[string]interface{}{"status": "success"},
+		"created_at":   fixtureTimestamp,
+		"updated_at":   fixtureTimestamp,
+		"api_version":  2,
+	}
+}
+
+// AtrackerRoute returns the JSON representation of a route with the specified name that sends the events of all
+// locations to the targets with the specified IDs, as returned by version 2 of the Activity Tracker service.
+func AtrackerRoute(name string, targetIDs ...string) map[string]interface{} {
+	id := "route-" + name
+	return map[string]interface{}{
+		"id":          id,
+		"name":        name,
+		"crn":         fmt.Sprintf("crn:v1:bluemix:public:atracker:global:a/%s::route:%s", AccountID, id),
+		"version":     0,
+		"rules":       []interface{}{map[string]interface{}{"target_ids": stringItems(targetIDs), "locations": []interface{}{"*"}}},
+		"created_at":  fixtureTimestamp,
+		"updated_at":  fixtureTimestamp,
+		"api_version": 2,
+	}
+}
+
+// AtrackerV1Target returns the JSON representation of a Cloud Object Storage target with the specified name, as
+// returned by version 1 of the Activity Tracker service.
+func AtrackerV1Target(name string) map[string]interface{} {
+	id := "target-" + name
+	return map[string]interface{}{
+		"id":          id,
+		"name":        name,
+		"crn":         fmt.Sprintf("crn:v1:bluemix:public:atracker:us-south:a/%s::target:%s", AccountID, id),
+		"target_type": "cloud_object_storage",
+		"encrypt_key": "REDACTED",
+		"cos_endpoint": map[string]interface{}{
+			"endpoint":   "s3.private.us-south.cloud-object-storage.appdomain.cloud",
+			"target_crn": fmt.Sprintf("crn:v1:bluemix:public:cloud-object-storage:global:a/%s:mock-cos::", AccountID),
+			"bucket":     "mock-bucket",
+			"api_key":    "REDACTED",
+		},
+		"cos_write_status": map[string]interface{}{"status": "success"},
+		"created":          fixtureTimestamp,
+		"updated":          fixtureTimestamp,
+	}
+}
+
+// AtrackerV1Route returns the JSON representation of a route with the specified name that sends the events of its
+// region to the targets with the specified IDs, as returned by version 1 of the Activity Tracker service.
+func AtrackerV1Route(name string, targetIDs ...string) map[string]interface{} {
+	id := "route-" + name
+	return map[string]interface{}{
+		"id":                    id,
+		"name":                  name,
+		"crn":                   fmt.Sprintf("crn:v1:bluemix:public:atracker:us-south:a/%s::route:%s", AccountID, id),
+		"version":               0,
+		"receive_global_events": false,
+		"rules":                 []interface{}{map[string]interface{}{"target_ids": stringItems(targetIDs)}},
+		"created":               fixtureTimestamp,
+		"updated":               fixtureTimestamp,
+	}
+}
+
+// NewAtrackerServer returns a new Server with default responses for the target and route operations of version 2 of
+// the Activity Tracker service: the list operations return one fixture, the get operations return a fixture with the
+// requested ID, the create operations return a fixture with the name (and target IDs) of the request, and the delete
+// operations succeed.
+func NewAtrackerServer() *Server {
+	return newAtrackerServer("/api/v2", AtrackerTarget, AtrackerRoute)
+}
+
+// NewAtrackerV1Server returns a new Server with the default responses of NewAtrackerServer, for version 1 of the
+// Activity Tracker service.
+func NewAtrackerV1Server() *Server {
+	return newAtrackerServer("/api/v1", AtrackerV1Target, AtrackerV1Route)
+}
+
+func newAtrackerServer(basePath string, target func(string) map[string]interface{}, route func(string, ...string) map[string]interface{}) *Server {
+	server := NewServer()
+	server.Default("GET", basePath+"/targets").WithJSON(map[string]interface{}{
+		"targets": []interface{}{target("mock-target")},
+	})
+	server.Default("GET", basePath+"/targets/{id}").WithBodyFunc(func(request *Request) interface{} {
+		result := target("mock-target")
+		result["id"] = request.PathParams["id"]
+		return result
+	})
+	server.Default("POST", basePath+"/targets").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Name string `json:"name"`
+		}
+		_ = request.JSON(&body)
+		return target(body.Name)
+	})
+	server.Default("DELETE", basePath+"/targets/{id}").WithStatus(http.StatusNoContent)
+	server.Default("GET", basePath+"/routes").WithJSON(map[string]interface{}{
+		"routes": []interface{}{route("mock-route", target("mock-target")["id"].(string))},
+	})
+	server.Default("GET", basePath+"/routes/{id}").WithBodyFunc(func(request *Request) interface{} {
+		result := route("mock-route", target("mock-target")["id"].(string))
+		result["id"] = request.PathParams["id"]
+		return result
+	})
+	server.Default("POST", basePath+"/routes").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Name  string `json:"name"`
+			Rules []struct {
+				TargetIds []string `json:"target_ids"`
+			} `json:"rules"`
+		}
+		_ = request.JSON(&body)
+		var targetIDs []string
+		for _, rule := range body.Rules {
+			targetIDs = append(targetIDs, rule.TargetIds...)
+		}
+		return route(body.Name, targetIDs...)
+	})
+	server.Default("DELETE", basePath+"/routes/{id}").WithStatus(http.StatusNoContent)
+	return server
+}
@@ -0,0 +1,126 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Catalog returns the JSON representation of a private catalog with the specified label, owned by the mock account,
+// as returned by the Catalog Management service.
+func Catalog(label string) map[string]interface{} {
+	id := "catalog-" + label
+	return map[string]interface{}{
+		"id":                id,
+		"_rev":              "1-mock",
+		"label":             label,
+		"short_description": "A catalog created by the mock server.",
+		"tags":              []interface{}{},
+		"url":               "/api/v1-beta/catalogs/" + id,
+		"crn":               fmt.Sprintf("crn:v1:bluemix:public:globalcatalog-collection:global:a/%s::catalog:%s", AccountID, id),
+		"offerings_url":     "/api/v1-beta/catalogs/" + id + "/offerings",
+		"disabled":          false,
+		"created":           fixtureTimestamp,
+		"updated":           fixtureTimestamp,
+		"resource_group_id": ResourceGroupID,
+		"owning_account":    AccountID,
+		"kind":              "offering",
+	}
+}
+
+// Offering returns the JSON representation of an offering with the specified name in the catalog with the specified
+// ID, with one Terraform version, as returned by the Catalog Management service.
+func Offering(catalogID string, name string) map[string]interface{} {
+	id := "offering-" + name
+	versionLocator := catalogID + "." + id + "-1.0.0"
+	return map[string]interface{}{
+		"id":                id,
+		"_rev":              "1-mock",
+		"url":               "/api/v1-beta/catalogs/" + catalogID + "/offerings/" + id,
+		"crn":               fmt.Sprintf("crn:v1:bluemix:public:globalcatalog-collection:global:a/%s::offering:%s", AccountID, id),
+		"label":             name,
+		"name":              name,
+		"short_description": "An offering created by the mock server.",
+		"created":           fixtureTimestamp,
+		"updated":           fixtureTimestamp,
+		"catalog_id":        catalogID,
+		"catalog_name":      "mock-catalog",
+		"kinds": []interface{}{map[string]interface{}{
+			"id":           "kind-terraform",
+			"format_kind":  "terraform",
+			"install_kind": "terraform",
+			"target_kind":  "terraform",
+			"created":      fixtureTimestamp,
+			"updated":      fixtureTimestamp,
+			"versions": []interface{}{map[string]interface{}{
+				"id":              id + "-1.0.0",
+				"version":         "1.0.0",
+				"created":         fixtureTimestamp,
+				"updated":         fixtureTimestamp,
+				"offering_id":     id,
+				"catalog_id":      catalogID,
+				"kind_id":         "kind-terraform",
+				"tgz_url":         "https://example.com/mock-offering-1.0.0.tgz",
+				"version_locator": versionLocator,
+				"state":           map[string]interface{}{"current": "consumable", "current_entered": fixtureTimestamp},
+			}},
+		}},
+	}
+}
+
+// NewCatalogManagementServer returns a new Server with default responses for the catalog and offering operations of
+// the Catalog Management service: the list operations return one fixture, the get operations return a fixture with
+// the requested ID (getting a version returns the fixture of its offering), creating a catalog returns a fixture with
+// the label of the request, and deleting a catalog or an offering succeeds.
+func NewCatalogManagementServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/catalogs").WithJSON(map[string]interface{}{
+		"total_count": 1,
+		"resources":   []interface{}{Catalog("mock-catalog")},
+	})
+	server.Default("GET", "/catalogs/{catalog_identifier}").WithBodyFunc(func(request *Request) interface{} {
+		catalog := Catalog("mock-catalog")
+		catalog["id"] = request.PathParams["catalog_identifier"]
+		return catalog
+	})
+	server.Default("POST", "/catalogs").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Label string `json:"label"`
+		}
+		_ = request.JSON(&body)
+		return Catalog(body.Label)
+	})
+	server.Default("DELETE", "/catalogs/{catalog_identifier}").WithStatus(http.StatusOK)
+	server.Default("GET", "/catalogs/{catalog_identifier}/offerings").WithBodyFunc(func(request *Request) interface{} {
+		return map[string]interface{}{
+			"offset":         0,
+			"limit":          100,
+			"total_count":    1,
+			"resource_count": 1,
+			"resources":      []interface{}{Offering(request.PathParams["catalog_identifier"], "mock-offering")},
+		}
+	})
+	server.Default("GET", "/catalogs/{catalog_identifier}/offerings/{offering_id}").WithBodyFunc(func(request *Request) interface{} {
+		offering := Offering(request.PathParams["catalog_identifier"], "mock-offering")
+		offering["id"] = request.PathParams["offering_id"]
+		return offering
+	})
+	server.Default("DELETE", "/catalogs/{catalog_identifier}/offerings/{offering_id}").WithStatus(http.StatusOK)
+	server.Default("GET", "/versions/{version_loc_id}").WithJSON(Offering(Catalog("mock-catalog")["id"].(string), "mock-offering"))
+	return server
+}
@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"net/http"
+)
+
+// ConfigRule returns the JSON representation of a rule with the specified name that requires the Cloud Object
+// Storage buckets of the mock account to allow only private access, as returned by the Configuration Governance
+// service.
+func ConfigRule(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"account_id":  AccountID,
+		"name":        name,
+		"description": "A rule created by the mock server.",
+		"rule_type":   "user_defined",
+		"target": map[string]interface{}{
+			"service_name":  "cloud-object-storage",
+			"resource_kind": "bucket",
+		},
+		"required_config": map[string]interface{}{
+			"description": "Private access only",
+			"property":    "public_access_enabled",
+			"operator":    "is_false",
+		},
+		"enforcement_actions":   []interface{}{map[string]interface{}{"action": "disallow"}},
+		"labels":                []interface{}{},
+		"rule_id":               "rule-" + name,
+		"creation_date":         fixtureTimestamp,
+		"created_by":            IamID,
+		"modification_date":     fixtureTimestamp,
+		"modified_by":           IamID,
+		"number_of_attachments": 1,
+	}
+}
+
+// ConfigAttachment returns the JSON representation of an attachment of the rule with the specified ID to the mock
+// account, as returned by the Configuration Governance service.
+func ConfigAttachment(ruleID string, attachmentID string) map[string]interface{} {
+	return map[string]interface{}{
+		"attachment_id":  attachmentID,
+		"rule_id":        ruleID,
+		"account_id":     AccountID,
+		"included_scope": map[string]interface{}{"scope_id": AccountID, "scope_type": "account"},
+	}
+}
+
+// NewConfigurationGovernanceServer returns a new Server with default responses for the rule and attachment
+// operations of the Configuration Governance service: the list operations return one fixture, the get operations
+// return a fixture with the requested ID, creating rules returns a fixture for each rule of the request (with its
+// name), and the delete operations succeed.
+func NewConfigurationGovernanceServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/config/v1/rules").WithJSON(map[string]interface{}{
+		"offset":      0,
+		"limit":       100,
+		"total_count": 1,
+		"first":       map[string]interface{}{"href": "/config/v1/rules?offset=0"},
+		"last":        map[string]interface{}{"href": "/config/v1/rules?offset=0"},
+		"rules":       []interface{}{ConfigRule("mock-rule")},
+	})
+	server.Default("GET", "/config/v1/rules/{rule_id}").WithBodyFunc(func(request *Request) interface{} {
+		rule := ConfigRule("mock-rule")
+		rule["rule_id"] = request.PathParams["rule_id"]
+		return rule
+	})
+	server.Default("POST", "/config/v1/rules").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Rules []struct {
+				RequestID string `json:"request_id"`
+				Rule      struct {
+					Name string `json:"name"`
+				} `json:"rule"`
+			} `json:"rules"`
+		}
+		_ = request.JSON(&body)
+		rules := make([]interface{}, len(body.Rules))
+		for i, rule := range body.Rules {
+			rules[i] = map[string]interface{}{
+				"request_id":  rule.RequestID,
+				"status_code": http.StatusCreated,
+				"rule":        ConfigRule(rule.Rule.Name),
+			}
+		}
+		return map[string]interface{}{"rules": rules}
+	})
+	server.Default("DELETE", "/config/v1/rules/{rule_id}").WithStatus(http.StatusNoContent)
+	server.Default("GET", "/config/v1/rules/{rule_id}/attachments").WithBodyFunc(func(request *Request) interface{} {
+		return map[string]interface{}{
+			"offset":      0,
+			"limit":       100,
+			"total_count": 1,
+			"first":       map[string]interface{}{"href": "/config/v1/rules/" + request.PathParams["rule_id"] + "/attachments?offset=0"},
+			"last":        map[string]interface{}{"href": "/config/v1/rules/" + request.PathParams["rule_id"] + "/attachments?offset=0"},
+			"attachments": []interface{}{ConfigAttachment(request.PathParams["rule_id"], "mock-attachment")},
+		}
+	})
+	server.Default("GET", "/config/v1/rules/{rule_id}/attachments/{attachment_id}").WithBodyFunc(func(request *Request) interface{} {
+		return ConfigAttachment(request.PathParams["rule_id"], request.PathParams["attachment_id"])
+	})
+	server.Default("DELETE", "/config/v1/rules/{rule_id}/attachments/{attachment_id}").WithStatus(http.StatusNoContent)
+	return server
+}
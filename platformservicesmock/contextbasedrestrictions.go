@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// CBRZone returns the JSON representation of a network zone with the specified name that contains one IP address,
+// as returned by the Context Based Restrictions service.
+func CBRZone(name string) map[string]interface{} {
+	id := "zone-" + name
+	return map[string]interface{}{
+		"id":                  id,
+		"crn":                 fmt.Sprintf("crn:v1:bluemix:public:context-based-restrictions:global:a/%s::zone:%s", AccountID, id),
+		"address_count":       1,
+		"excluded_count":      0,
+		"name":                name,
+		"account_id":          AccountID,
+		"description":         "A zone created by the mock server.",
+		"addresses":           []interface{}{map[string]interface{}{"type": "ipAddress", "value": "169.23.56.234"}},
+		"excluded":            []interface{}{},
+		"href":                "/v1/zones/" + id,
+		"created_at":          fixtureTimestamp,
+		"created_by_id":       IamID,
+		"last_modified_at":    fixtureTimestamp,
+		"last_modified_by_id": IamID,
+	}
+}
+
+// CBRRule returns the JSON representation of a rule with the specified ID that restricts access to the mock service
+// of the mock account to the network zone with the specified ID, as returned by the Context Based Restrictions
+// service.
+func CBRRule(id string, zoneID string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          id,
+		"crn":         fmt.Sprintf("crn:v1:bluemix:public:context-based-restrictions:global:a/%s::rule:%s", AccountID, id),
+		"description": "A rule created by the mock server.",
+		"contexts": []interface{}{map[string]interface{}{
+			"attributes": []interface{}{map[string]interface{}{"name": "networkZoneId", "value": zoneID}},
+		}},
+		"resources": []interface{}{map[string]interface{}{
+			"attributes": []interface{}{
+				map[string]interface{}{"name": "accountId", "value": AccountID},
+				map[string]interface{}{"name": "serviceName", "value": "mock-service"},
+			},
+		}},
+		"enforcement_mode":    "enabled",
+		"href":                "/v1/rules/" + id,
+		"created_at":          fixtureTimestamp,
+		"created_by_id":       IamID,
+		"last_modified_at":    fixtureTimestamp,
+		"last_modified_by_id": IamID,
+	}
+}
+
+// NewContextBasedRestrictionsServer returns a new Server with default responses for the zone and rule operations of
+// the Context Based Restrictions service: the list operations return one fixture, the get operations return a
+// fixture with the requested ID (and an ETag), creating a zone returns a fixture with the name of the request, and the
+// delete operations succeed.
+func NewContextBasedRestrictionsServer() *Server {
+	server := NewServer()
+	zone := CBRZone("mock-zone")
+	server.Default("GET", "/v1/zones").WithJSON(map[string]interface{}{
+		"count": 1,
+		"zones": []interface{}{cbrZoneSummary(zone)},
+	})
+	server.Default("GET", "/v1/zones/{zone_id}").WithHeader("ETag", `"mock-etag"`).WithBodyFunc(func(request *Request) interface{} {
+		result := CBRZone("mock-zone")
+		result["id"] = request.PathParams["zone_id"]
+		return result
+	})
+	server.Default("POST", "/v1/zones").WithStatus(http.StatusCreated).WithHeader("ETag", `"mock-etag"`).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Name string `json:"name"`
+		}
+		_ = request.JSON(&body)
+		return CBRZone(body.Name)
+	})
+	server.Default("DELETE", "/v1/zones/{zone_id}").WithStatus(http.StatusNoContent)
+	server.Default("GET", "/v1/rules").WithJSON(map[string]interface{}{
+		"count": 1,
+		"rules": []interface{}{CBRRule("mock-rule", zone["id"].(string))},
+	})
+	server.Default("GET", "/v1/rules/{rule_id}").WithHeader("ETag", `"mock-etag"`).WithBodyFunc(func(request *Request) interface{} {
+		return CBRRule(request.PathParams["rule_id"], zone["id"].(string))
+	})
+	server.Default("DELETE", "/v1/rules/{rule_id}").WithStatus(http.StatusNoContent)
+	return server
+}
+
+// cbrZoneSummary returns the summary of a zone that is returned by the list operation.
+func cbrZoneSummary(zone map[string]interface{}) map[string]interface{} {
+	summary := map[string]interface{}{"addresses_preview": zone["addresses"]}
+	for _, field := range []string{"id", "crn", "name", "description", "address_count", "excluded_count", "href",
+		"created_at", "created_by_id", "last_modified_at", "last_modified_by_id"} {
+		summary[field] = zone[field]
+	}
+	return summary
+}
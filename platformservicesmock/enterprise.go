@@ -0,0 +1,261 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// enterpriseCRN is the CRN of the mock enterprise.
+var enterpriseCRN = fmt.Sprintf("crn:v1:bluemix:public:enterprise::a/%s::enterprise:%s", AccountID, EnterpriseID)
+
+// Enterprise returns the JSON representation of the active mock enterprise, whose enterprise account is the mock
+// account, with the specified name, as returned by the Enterprise Management service.
+func Enterprise(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"url":                    "/v1/enterprises/" + EnterpriseID,
+		"id":                     EnterpriseID,
+		"enterprise_account_id":  AccountID,
+		"crn":                    enterpriseCRN,
+		"name":                   name,
+		"domain":                 "example.com",
+		"state":                  "ACTIVE",
+		"primary_contact_iam_id": IamID,
+		"primary_contact_email":  "mock.user@example.com",
+		"created_at":             fixtureTimestamp,
+		"created_by":             IamID,
+		"updated_at":             fixtureTimestamp,
+		"updated_by":             IamID,
+	}
+}
+
+// EnterpriseAccount returns the JSON representation of an active account with the specified ID and name, directly
+// under the mock enterprise, as returned by the Enterprise Management service.
+func EnterpriseAccount(id string, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"url":                   "/v1/accounts/" + id,
+		"id":                    id,
+		"crn":                   fmt.Sprintf("crn:v1:bluemix:public:enterprise::a/%s::account:%s", AccountID, id),
+		"parent":                enterpriseCRN,
+		"enterprise_account_id": AccountID,
+		"enterprise_id":         EnterpriseID,
+		"enterprise_path":       "enterprise:" + EnterpriseID + "/account:" + id,
+		"name":                  name,
+		"state":                 "ACTIVE",
+		"owner_iam_id":          IamID,
+		"paid":                  true,
+		"owner_email":           "mock.user@example.com",
+		"is_enterprise_account": id == AccountID,
+		"created_at":            fixtureTimestamp,
+		"created_by":            IamID,
+		"updated_at":            fixtureTimestamp,
+		"updated_by":            IamID,
+	}
+}
+
+// AccountGroup returns the JSON representation of an active account group with the specified ID and name, directly
+// under the mock enterprise, as returned by the Enterprise Management service.
+func AccountGroup(id string, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"url":                    "/v1/account-groups/" + id,
+		"id":                     id,
+		"crn":                    fmt.Sprintf("crn:v1:bluemix:public:enterprise::a/%s::account-group:%s", AccountID, id),
+		"parent":                 enterpriseCRN,
+		"enterprise_account_id":  AccountID,
+		"enterprise_id":          EnterpriseID,
+		"enterprise_path":        "enterprise:" + EnterpriseID + "/account-group:" + id,
+		"name":                   name,
+		"state":                  "ACTIVE",
+		"primary_contact_iam_id": IamID,
+		"primary_contact_email":  "mock.user@example.com",
+		"created_at":             fixtureTimestamp,
+		"created_by":             IamID,
+		"updated_at":             fixtureTimestamp,
+		"updated_by":             IamID,
+	}
+}
+
+// BillingUnit returns the JSON representation of the mock billing unit of the mock enterprise, with the specified
+// name, as returned by the Enterprise Billing Units service.
+func BillingUnit(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            BillingUnitID,
+		"crn":           fmt.Sprintf("crn:v1:bluemix:public:billing::a/%s::billing-unit:%s", AccountID, BillingUnitID),
+		"name":          name,
+		"enterprise_id": EnterpriseID,
+		"currency_code": "USD",
+		"country_code":  "USA",
+		"master":        true,
+		"created_at":    fixtureTimestamp,
+	}
+}
+
+// CreditPool returns the JSON representation of the platform credit pool of the mock billing unit, with one
+// subscription of the specified total and current balance, as returned by the Enterprise Billing Units service.
+func CreditPool(totalCredits float64, currentBalance float64) map[string]interface{} {
+	return map[string]interface{}{
+		"type":            "PLATFORM",
+		"currency_code":   "USD",
+		"billing_unit_id": BillingUnitID,
+		"term_credits": []interface{}{map[string]interface{}{
+			"billing_option_id": "mock-billing-option-id",
+			"category":          "PLATFORM",
+			"start_date":        fixtureTimestamp,
+			"end_date":          "2023-01-01T00:00:00.000Z",
+			"total_credits":     totalCredits,
+			"starting_balance":  totalCredits,
+			"used_credits":      totalCredits - currentBalance,
+			"current_balance":   currentBalance,
+		}},
+		"overage": map[string]interface{}{"cost": 0},
+	}
+}
+
+// ResourceUsageReport returns the JSON representation of the usage report of the mock account for the specified
+// month ("yyyy-mm"), with one resource of the specified billable cost, as returned by the Enterprise Usage Reports
+// service.
+func ResourceUsageReport(month string, billableCost float64) map[string]interface{} {
+	return map[string]interface{}{
+		"entity_id":               AccountID,
+		"entity_type":             "account",
+		"entity_crn":              fmt.Sprintf("crn:v1:bluemix:public:enterprise::a/%s::account:%s", AccountID, AccountID),
+		"entity_name":             "Mock account",
+		"billing_unit_id":         BillingUnitID,
+		"billing_unit_crn":        fmt.Sprintf("crn:v1:bluemix:public:billing::a/%s::billing-unit:%s", AccountID, BillingUnitID),
+		"billing_unit_name":       "Mock billing unit",
+		"country_code":            "USA",
+		"currency_code":           "USD",
+		"month":                   month,
+		"billable_cost":           billableCost,
+		"non_billable_cost":       0,
+		"billable_rated_cost":     billableCost,
+		"non_billable_rated_cost": 0,
+		"resources": []interface{}{map[string]interface{}{
+			"resource_id":             "mock-service-id",
+			"billable_cost":           billableCost,
+			"billable_rated_cost":     billableCost,
+			"non_billable_cost":       0,
+			"non_billable_rated_cost": 0,
+			"plans": []interface{}{map[string]interface{}{
+				"plan_id":    "mock-plan-id",
+				"billable":   true,
+				"cost":       billableCost,
+				"rated_cost": billableCost,
+				"usage": []interface{}{map[string]interface{}{
+					"metric":            "INSTANCE_HOURS",
+					"unit":              "HOURS",
+					"quantity":          720,
+					"rateable_quantity": 720,
+					"cost":              billableCost,
+					"rated_cost":        billableCost,
+				}},
+			}},
+		}},
+	}
+}
+
+// NewEnterpriseManagementServer returns a new Server with default responses for the enterprise, account and account
+// group operations of the Enterprise Management service: the list operations return one fixture, the get operations
+// return a fixture with the requested ID, the create operations return a new ID, and the update operations and
+// importing an account succeed.
+func NewEnterpriseManagementServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/enterprises").WithJSON(enterpriseList(Enterprise("mock-enterprise")))
+	server.Default("GET", "/enterprises/{enterprise_id}").WithBodyFunc(func(request *Request) interface{} {
+		enterprise := Enterprise("mock-enterprise")
+		enterprise["id"] = request.PathParams["enterprise_id"]
+		return enterprise
+	})
+	server.Default("POST", "/enterprises").WithStatus(http.StatusAccepted).WithJSON(map[string]interface{}{
+		"enterprise_id":         EnterpriseID,
+		"enterprise_account_id": AccountID,
+	})
+	server.Default("PATCH", "/enterprises/{enterprise_id}").WithStatus(http.StatusNoContent)
+	server.Default("PUT", "/enterprises/{enterprise_id}/import/accounts/{account_id}").WithStatus(http.StatusAccepted)
+	server.Default("GET", "/accounts").WithJSON(enterpriseList(EnterpriseAccount("mock-child-account-id", "mock-account")))
+	server.Default("GET", "/accounts/{account_id}").WithBodyFunc(func(request *Request) interface{} {
+		return EnterpriseAccount(request.PathParams["account_id"], "mock-account")
+	})
+	server.Default("POST", "/accounts").WithStatus(http.StatusAccepted).WithJSON(map[string]interface{}{
+		"account_id": "mock-child-account-id",
+	})
+	server.Default("PATCH", "/accounts/{account_id}").WithStatus(http.StatusAccepted)
+	server.Default("GET", "/account-groups").WithJSON(enterpriseList(AccountGroup("mock-account-group-id", "mock-account-group")))
+	server.Default("GET", "/account-groups/{account_group_id}").WithBodyFunc(func(request *Request) interface{} {
+		return AccountGroup(request.PathParams["account_group_id"], "mock-account-group")
+	})
+	server.Default("POST", "/account-groups").WithStatus(http.StatusCreated).WithJSON(map[string]interface{}{
+		"account_group_id": "mock-account-group-id",
+	})
+	server.Default("PATCH", "/account-groups/{account_group_id}").WithStatus(http.StatusNoContent)
+	return server
+}
+
+// NewEnterpriseBillingUnitsServer returns a new Server with default responses for the operations of the Enterprise
+// Billing Units service: listing the billing units returns one fixture, getting a billing unit returns a fixture with
+// the requested ID, listing the billing options returns one subscription, and listing the credit pools returns one
+// fixture.
+func NewEnterpriseBillingUnitsServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/v1/billing-units").WithJSON(enterpriseList(BillingUnit("mock-billing-unit")))
+	server.Default("GET", "/v1/billing-units/{billing_unit_id}").WithBodyFunc(func(request *Request) interface{} {
+		billingUnit := BillingUnit("mock-billing-unit")
+		billingUnit["id"] = request.PathParams["billing_unit_id"]
+		return billingUnit
+	})
+	server.Default("GET", "/v1/billing-options").WithJSON(enterpriseList(map[string]interface{}{
+		"id":                 "mock-billing-option-id",
+		"billing_unit_id":    BillingUnitID,
+		"start_date":         fixtureTimestamp,
+		"end_date":           "2023-01-01T00:00:00.000Z",
+		"state":              "ACTIVE",
+		"type":               "SUBSCRIPTION",
+		"category":           "PLATFORM",
+		"duration_in_months": 12,
+		"line_item_id":       1,
+		"renewal_mode_code":  "A",
+		"updated_at":         fixtureTimestamp,
+	}))
+	server.Default("GET", "/v1/credit-pools").WithJSON(enterpriseList(CreditPool(10000, 7500)))
+	return server
+}
+
+// NewEnterpriseUsageReportsServer returns a new Server with a default response for the operation of the Enterprise
+// Usage Reports service: getting the usage reports returns one fixture for the requested month, or for January 2022
+// if no month is requested.
+func NewEnterpriseUsageReportsServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/v1/resource-usage-reports").WithBodyFunc(func(request *Request) interface{} {
+		month := request.Query.Get("month")
+		if month == "" {
+			month = "2022-01"
+		}
+		return map[string]interface{}{
+			"limit":   10,
+			"first":   map[string]interface{}{"href": "/v1/resource-usage-reports?month=" + month},
+			"reports": []interface{}{ResourceUsageReport(month, 100)},
+		}
+	})
+	return server
+}
+
+// enterpriseList returns the JSON representation of a page of resources that is the last page, in the format of the
+// enterprise services.
+func enterpriseList(resources ...map[string]interface{}) map[string]interface{} {
+	return resourceControllerList(resources)
+}
@@ -0,0 +1,310 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// The account, resource group and user of the fixtures, and the enterprise and billing unit of the mock account.
+const (
+	AccountID       = "mock-account-id"
+	ResourceGroupID = "mock-resource-group-id"
+	IamID           = "IBMid-mock-user"
+	EnterpriseID    = "mock-enterprise-id"
+	BillingUnitID   = "mock-billing-unit-id"
+)
+
+// fixtureTimestamp is the creation and update time of the fixtures.
+const fixtureTimestamp = "2022-01-01T00:00:00.000Z"
+
+// ResourceInstance returns the JSON representation of an active resource instance with the specified name, in the
+// mock account and resource group, as returned by the Resource Controller. Change the fields of the returned map to
+// customize it.
+func ResourceInstance(name string) map[string]interface{} {
+	guid := "guid-" + name
+	crn := fmt.Sprintf("crn:v1:bluemix:public:mock-service:us-south:a/%s:%s::", AccountID, guid)
+	return map[string]interface{}{
+		"id":                    crn,
+		"guid":                  guid,
+		"crn":                   crn,
+		"url":                   "/v2/resource_instances/" + guid,
+		"name":                  name,
+		"region_id":             "us-south",
+		"account_id":            AccountID,
+		"resource_plan_id":      "mock-plan-id",
+		"resource_group_id":     ResourceGroupID,
+		"resource_group_crn":    fmt.Sprintf("crn:v1:bluemix:public:resource-controller::a/%s::resource-group:%s", AccountID, ResourceGroupID),
+		"resource_id":           "mock-service-id",
+		"target_crn":            "crn:v1:bluemix:public:globalcatalog::::deployment:mock-plan-id%3Aus-south",
+		"state":                 "active",
+		"type":                  "service_instance",
+		"allow_cleanup":         false,
+		"locked":                false,
+		"created_at":            fixtureTimestamp,
+		"created_by":            IamID,
+		"updated_at":            fixtureTimestamp,
+		"updated_by":            IamID,
+		"resource_keys_url":     "/v2/resource_instances/" + guid + "/resource_keys",
+		"resource_aliases_url":  "/v2/resource_instances/" + guid + "/resource_aliases",
+		"resource_bindings_url": "/v2/resource_instances/" + guid + "/resource_bindings",
+	}
+}
+
+// ResourceKey returns the JSON representation of an active resource key with the specified name for the resource
+// instance with the specified CRN, as returned by the Resource Controller.
+func ResourceKey(name string, sourceCRN string) map[string]interface{} {
+	guid := "guid-" + name
+	return map[string]interface{}{
+		"id":                fmt.Sprintf("crn:v1:bluemix:public:mock-service:us-south:a/%s::resource-key:%s", AccountID, guid),
+		"guid":              guid,
+		"crn":               fmt.Sprintf("crn:v1:bluemix:public:mock-service:us-south:a/%s::resource-key:%s", AccountID, guid),
+		"url":               "/v2/resource_keys/" + guid,
+		"name":              name,
+		"account_id":        AccountID,
+		"resource_group_id": ResourceGroupID,
+		"source_crn":        sourceCRN,
+		"state":             "active",
+		"iam_compatible":    true,
+		"credentials": map[string]interface{}{
+			"apikey":            "mock-apikey",
+			"iam_apikey_name":   name,
+			"iam_role_crn":      "crn:v1:bluemix:public:iam::::serviceRole:Writer",
+			"iam_serviceid_crn": fmt.Sprintf("crn:v1:bluemix:public:iam-identity::a/%s::serviceid:ServiceId-mock", AccountID),
+		},
+		"created_at": fixtureTimestamp,
+		"created_by": IamID,
+		"updated_at": fixtureTimestamp,
+		"updated_by": IamID,
+	}
+}
+
+// ResourceInstancesList returns the JSON representation of a page of resource instances that is the last page.
+func ResourceInstancesList(instances ...map[string]interface{}) map[string]interface{} {
+	return resourceControllerList(instances)
+}
+
+// ResourceKeysList returns the JSON representation of a page of resource keys that is the last page.
+func ResourceKeysList(keys ...map[string]interface{}) map[string]interface{} {
+	return resourceControllerList(keys)
+}
+
+// Case returns the JSON representation of a new support case with the specified number, as returned by the Case
+// Management service.
+func Case(number string) map[string]interface{} {
+	user := map[string]interface{}{"name": "Mock User", "realm": "IBMid", "user_id": "mock.user@example.com"}
+	return map[string]interface{}{
+		"number":            number,
+		"short_description": "Mock case",
+		"description":       "A case created by the mock server.",
+		"created_at":        fixtureTimestamp,
+		"created_by":        user,
+		"updated_at":        fixtureTimestamp,
+		"updated_by":        user,
+		"contact_type":      "Cloud Support Center",
+		"contact":           user,
+		"status":            "New",
+		"severity":          4,
+		"support_tier":      "Basic",
+		"offering": map[string]interface{}{
+			"name": "Mock Service",
+			"type": map[string]interface{}{"group": "crn_service_name", "key": "mock-service", "kind": "service", "id": "mock-service-id"},
+		},
+		"watchlist":   []interface{}{},
+		"attachments": []interface{}{},
+		"resources":   []interface{}{},
+		"comments":    []interface{}{},
+	}
+}
+
+// CaseList returns the JSON representation of a page of support cases that is the last page.
+func CaseList(cases ...map[string]interface{}) map[string]interface{} {
+	items := make([]interface{}, len(cases))
+	for i, item := range cases {
+		items[i] = item
+	}
+	return map[string]interface{}{
+		"total_count": len(cases),
+		"first":       map[string]interface{}{"href": "/cases?offset=0"},
+		"last":        map[string]interface{}{"href": "/cases?offset=0"},
+		"cases":       items,
+	}
+}
+
+// Policy returns the JSON representation of an active access policy with the specified ID that grants the Viewer
+// role on the mock service to the mock user, as returned by the IAM Policy Management service.
+func Policy(id string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          id,
+		"type":        "access",
+		"description": "A policy created by the mock server.",
+		"subjects": []interface{}{map[string]interface{}{
+			"attributes": []interface{}{map[string]interface{}{"name": "iam_id", "value": IamID}},
+		}},
+		"roles": []interface{}{map[string]interface{}{
+			"role_id":      "crn:v1:bluemix:public:iam::::role:Viewer",
+			"display_name": "Viewer",
+		}},
+		"resources": []interface{}{map[string]interface{}{
+			"attributes": []interface{}{
+				map[string]interface{}{"name": "accountId", "value": AccountID, "operator": "stringEquals"},
+				map[string]interface{}{"name": "serviceName", "value": "mock-service", "operator": "stringEquals"},
+			},
+		}},
+		"href":                "/v1/policies/" + id,
+		"created_at":          fixtureTimestamp,
+		"created_by_id":       IamID,
+		"last_modified_at":    fixtureTimestamp,
+		"last_modified_by_id": IamID,
+		"state":               "active",
+	}
+}
+
+// PolicyList returns the JSON representation of a list of policies.
+func PolicyList(policies ...map[string]interface{}) map[string]interface{} {
+	items := make([]interface{}, len(policies))
+	for i, item := range policies {
+		items[i] = item
+	}
+	return map[string]interface{}{"policies": items}
+}
+
+// NewResourceControllerServer returns a new Server with default responses for the resource instance and resource key
+// operations of the Resource Controller: the list operations return one fixture, the get operations return a
+// fixture with the requested ID, the create operations return a fixture with the name (and source) of the request,
+// and the delete operations succeed.
+func NewResourceControllerServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/v2/resource_instances").WithJSON(ResourceInstancesList(ResourceInstance("mock-instance")))
+	server.Default("GET", "/v2/resource_instances/{id}").WithBodyFunc(func(request *Request) interface{} {
+		instance := ResourceInstance("mock-instance")
+		instance["id"] = request.PathParams["id"]
+		return instance
+	})
+	server.Default("POST", "/v2/resource_instances").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Name           string `json:"name"`
+			Target         string `json:"target"`
+			ResourceGroup  string `json:"resource_group"`
+			ResourcePlanID string `json:"resource_plan_id"`
+		}
+		_ = request.JSON(&body)
+		instance := ResourceInstance(body.Name)
+		instance["region_id"] = body.Target
+		instance["resource_group_id"] = body.ResourceGroup
+		instance["resource_plan_id"] = body.ResourcePlanID
+		return instance
+	})
+	server.Default("DELETE", "/v2/resource_instances/{id}").WithStatus(http.StatusNoContent)
+	server.Default("GET", "/v2/resource_keys").WithJSON(ResourceKeysList(ResourceKey("mock-key", ResourceInstance("mock-instance")["crn"].(string))))
+	server.Default("GET", "/v2/resource_keys/{id}").WithBodyFunc(func(request *Request) interface{} {
+		key := ResourceKey("mock-key", ResourceInstance("mock-instance")["crn"].(string))
+		key["id"] = request.PathParams["id"]
+		return key
+	})
+	server.Default("POST", "/v2/resource_keys").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Name   string `json:"name"`
+			Source string `json:"source"`
+		}
+		_ = request.JSON(&body)
+		return ResourceKey(body.Name, body.Source)
+	})
+	server.Default("DELETE", "/v2/resource_keys/{id}").WithStatus(http.StatusNoContent)
+	return server
+}
+
+// NewCaseManagementServer returns a new Server with default responses for the case operations of the Case
+// Management service: listing the cases returns one fixture, getting a case returns a fixture with the requested
+// number, creating a case returns a fixture with the subject, description and severity of the request, and adding a comment
+// returns the comment.
+func NewCaseManagementServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/cases").WithJSON(CaseList(Case("CS0000001")))
+	server.Default("GET", "/cases/{case_number}").WithBodyFunc(func(request *Request) interface{} {
+		return Case(request.PathParams["case_number"])
+	})
+	server.Default("POST", "/cases").WithBodyFunc(func(request *Request) interface{} {
+		var body map[string]interface{}
+		_ = request.JSON(&body)
+		result := Case("CS0000001")
+		if subject, found := body["subject"]; found {
+			result["short_description"] = subject
+		}
+		for _, field := range []string{"description", "severity"} {
+			if value, found := body[field]; found {
+				result[field] = value
+			}
+		}
+		return result
+	})
+	server.Default("PUT", "/cases/{case_number}/comments").WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Comment string `json:"comment"`
+		}
+		_ = request.JSON(&body)
+		return map[string]interface{}{
+			"value":    body.Comment,
+			"added_at": fixtureTimestamp,
+			"added_by": map[string]interface{}{"name": "Mock User", "realm": "IBMid", "user_id": "mock.user@example.com"},
+		}
+	})
+	return server
+}
+
+// NewIamPolicyManagementServer returns a new Server with default responses for the access policy operations of the
+// IAM Policy Management service: listing the policies returns one fixture, getting a policy returns a fixture with
+// the requested ID (and an ETag), creating a policy returns a fixture with the type, subjects, roles and resources of
+// the request, and deleting a policy succeeds.
+func NewIamPolicyManagementServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/v1/policies").WithJSON(PolicyList(Policy("mock-policy")))
+	server.Default("GET", "/v1/policies/{policy_id}").WithHeader("ETag", `W/"mock-etag"`).WithBodyFunc(func(request *Request) interface{} {
+		return Policy(request.PathParams["policy_id"])
+	})
+	server.Default("POST", "/v1/policies").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body map[string]interface{}
+		_ = request.JSON(&body)
+		result := Policy("mock-policy")
+		for _, field := range []string{"type", "description", "subjects", "roles", "resources"} {
+			if value, found := body[field]; found {
+				result[field] = value
+			}
+		}
+		return result
+	})
+	server.Default("DELETE", "/v1/policies/{policy_id}").WithStatus(http.StatusNoContent)
+	return server
+}
+
+func resourceControllerList(resources []map[string]interface{}) map[string]interface{} {
+	items := make([]interface{}, len(resources))
+	for i, item := range resources {
+		items[i] = item
+	}
+	return map[string]interface{}{"rows_count": len(resources), "next_url": nil, "resources": items}
+}
+
+// stringItems returns the strings as a JSON array that is never null.
+func stringItems(values []string) []interface{} {
+	items := make([]interface{}, len(values))
+	for i, value := range values {
+		items[i] = value
+	}
+	return items
+}
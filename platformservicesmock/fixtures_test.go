@@ -0,0 +1,193 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv1"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/IBM/platform-services-go-sdk/configurationgovernancev1"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/ibmcloudshellv1"
+	"github.com/IBM/platform-services-go-sdk/openservicebrokerv1"
+	"github.com/IBM/platform-services-go-sdk/platformservicesmock"
+	"github.com/IBM/platform-services-go-sdk/posturemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	"github.com/IBM/platform-services-go-sdk/usermanagementv1"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFixtures unmarshals each fixture into its generated model, checks that the fixture has the required properties
+// of the model, and checks that marshalling the model again gives back the fixture, so that no property of the fixture is unknown
+// to the model or has the wrong type.
+func TestFixtures(t *testing.T) {
+	instance := platformservicesmock.ResourceInstance("db")
+	fixtures := []struct {
+		name         string
+		fixture      map[string]interface{}
+		result       interface{}
+		unmarshaller core.ModelUnmarshaller
+	}{
+		{"ResourceInstance", instance, new(*resourcecontrollerv2.ResourceInstance), resourcecontrollerv2.UnmarshalResourceInstance},
+		{"ResourceKey", platformservicesmock.ResourceKey("writer", instance["crn"].(string)), new(*resourcecontrollerv2.ResourceKey), resourcecontrollerv2.UnmarshalResourceKey},
+		{"ResourceInstancesList", platformservicesmock.ResourceInstancesList(instance), new(*resourcecontrollerv2.ResourceInstancesList), resourcecontrollerv2.UnmarshalResourceInstancesList},
+		{"Case", platformservicesmock.Case("CS1234567"), new(*casemanagementv1.Case), casemanagementv1.UnmarshalCase},
+		{"CaseList", platformservicesmock.CaseList(platformservicesmock.Case("CS1234567")), new(*casemanagementv1.CaseList), casemanagementv1.UnmarshalCaseList},
+		{"Policy", platformservicesmock.Policy("policy-1"), new(*iampolicymanagementv1.Policy), iampolicymanagementv1.UnmarshalPolicy},
+		{"PolicyList", platformservicesmock.PolicyList(platformservicesmock.Policy("policy-1")), new(*iampolicymanagementv1.PolicyList), iampolicymanagementv1.UnmarshalPolicyList},
+		{"AtrackerTarget", platformservicesmock.AtrackerTarget("cos"), new(*atrackerv2.Target), atrackerv2.UnmarshalTarget},
+		{"AtrackerRoute", platformservicesmock.AtrackerRoute("all", "target-cos"), new(*atrackerv2.Route), atrackerv2.UnmarshalRoute},
+		{"AtrackerV1Target", platformservicesmock.AtrackerV1Target("cos"), new(*atrackerv1.Target), atrackerv1.UnmarshalTarget},
+		{"AtrackerV1Route", platformservicesmock.AtrackerV1Route("all", "target-cos"), new(*atrackerv1.Route), atrackerv1.UnmarshalRoute},
+		{"Catalog", platformservicesmock.Catalog("private"), new(*catalogmanagementv1.Catalog), catalogmanagementv1.UnmarshalCatalog},
+		{"Offering", platformservicesmock.Offering("catalog-private", "vpc"), new(*catalogmanagementv1.Offering), catalogmanagementv1.UnmarshalOffering},
+		{"ConfigRule", platformservicesmock.ConfigRule("private-buckets"), new(*configurationgovernancev1.Rule), configurationgovernancev1.UnmarshalRule},
+		{"ConfigAttachment", platformservicesmock.ConfigAttachment("rule-1", "attachment-1"), new(*configurationgovernancev1.Attachment), configurationgovernancev1.UnmarshalAttachment},
+		{"CBRZone", platformservicesmock.CBRZone("office"), new(*contextbasedrestrictionsv1.Zone), contextbasedrestrictionsv1.UnmarshalZone},
+		{"CBRRule", platformservicesmock.CBRRule("rule-1", "zone-office"), new(*contextbasedrestrictionsv1.Rule), contextbasedrestrictionsv1.UnmarshalRule},
+		{"Enterprise", platformservicesmock.Enterprise("acme"), new(*enterprisemanagementv1.Enterprise), enterprisemanagementv1.UnmarshalEnterprise},
+		{"EnterpriseAccount", platformservicesmock.EnterpriseAccount("account-1", "dev"), new(*enterprisemanagementv1.Account), enterprisemanagementv1.UnmarshalAccount},
+		{"AccountGroup", platformservicesmock.AccountGroup("group-1", "teams"), new(*enterprisemanagementv1.AccountGroup), enterprisemanagementv1.UnmarshalAccountGroup},
+		{"BillingUnit", platformservicesmock.BillingUnit("main"), new(*enterprisebillingunitsv1.BillingUnit), enterprisebillingunitsv1.UnmarshalBillingUnit},
+		{"CreditPool", platformservicesmock.CreditPool(1000, 250), new(*enterprisebillingunitsv1.CreditPool), enterprisebillingunitsv1.UnmarshalCreditPool},
+		{"ResourceUsageReport", platformservicesmock.ResourceUsageReport("2022-01", 12.5), new(*enterpriseusagereportsv1.ResourceUsageReport), enterpriseusagereportsv1.UnmarshalResourceUsageReport},
+		{"CatalogEntry", platformservicesmock.CatalogEntry("db"), new(*globalcatalogv1.CatalogEntry), globalcatalogv1.UnmarshalCatalogEntry},
+		{"SearchResultItem", platformservicesmock.SearchResultItem("db", "env:test"), new(*globalsearchv2.ResultItem), globalsearchv2.UnmarshalResultItem},
+		{"Tag", platformservicesmock.Tag("env:test"), new(*globaltaggingv1.Tag), globaltaggingv1.UnmarshalTag},
+		{"AccessGroup", platformservicesmock.AccessGroup("AccessGroupId-1", "admins"), new(*iamaccessgroupsv2.Group), iamaccessgroupsv2.UnmarshalGroup},
+		{"AccessGroupMember", platformservicesmock.AccessGroupMember("IBMid-1"), new(*iamaccessgroupsv2.ListGroupMembersResponseMember), iamaccessgroupsv2.UnmarshalListGroupMembersResponseMember},
+		{"APIKey", platformservicesmock.APIKey("ApiKey-1", "ci"), new(*iamidentityv1.APIKey), iamidentityv1.UnmarshalAPIKey},
+		{"ServiceID", platformservicesmock.ServiceID("ServiceId-1", "deployer"), new(*iamidentityv1.ServiceID), iamidentityv1.UnmarshalServiceID},
+		{"TrustedProfile", platformservicesmock.TrustedProfile("Profile-1", "cluster"), new(*iamidentityv1.TrustedProfile), iamidentityv1.UnmarshalTrustedProfile},
+		{"CloudShellAccountSettings", platformservicesmock.CloudShellAccountSettings(), new(*ibmcloudshellv1.AccountSettings), ibmcloudshellv1.UnmarshalAccountSettings},
+		{"BrokerService", platformservicesmock.BrokerService("db"), new(*openservicebrokerv1.Services), openservicebrokerv1.UnmarshalServices},
+		{"PostureProfile", platformservicesmock.PostureProfile("profile-1", "CIS"), new(*posturemanagementv1.Profile), posturemanagementv1.UnmarshalProfile},
+		{"PostureScope", platformservicesmock.PostureScope("scope-1", "prod"), new(*posturemanagementv1.Scope), posturemanagementv1.UnmarshalScope},
+		{"ResourceGroup", platformservicesmock.ResourceGroup(platformservicesmock.ResourceGroupID, "Default"), new(*resourcemanagerv2.ResourceGroup), resourcemanagerv2.UnmarshalResourceGroup},
+		{"QuotaDefinition", platformservicesmock.QuotaDefinition("quota-1", "Trial Quota"), new(*resourcemanagerv2.QuotaDefinition), resourcemanagerv2.UnmarshalQuotaDefinition},
+		{"AccountUsage", platformservicesmock.AccountUsage("2022-01", 12.5), new(*usagereportsv4.AccountUsage), usagereportsv4.UnmarshalAccountUsage},
+		{"AccountSummary", platformservicesmock.AccountSummary("2022-01", 12.5), new(*usagereportsv4.AccountSummary), usagereportsv4.UnmarshalAccountSummary},
+		{"InstanceUsage", platformservicesmock.InstanceUsage("db", "2022-01", 12.5), new(*usagereportsv4.InstanceUsage), usagereportsv4.UnmarshalInstanceUsage},
+		{"UsageResource", platformservicesmock.UsageResource(12.5), new(*usagereportsv4.Resource), usagereportsv4.UnmarshalResource},
+		{"UserProfile", platformservicesmock.UserProfile("IBMid-1"), new(*usermanagementv1.UserProfile), usermanagementv1.UnmarshalUserProfile},
+	}
+	for _, fixture := range fixtures {
+		fixtureJSON, err := json.Marshal(fixture.fixture)
+		assert.Nil(t, err, fixture.name)
+		var raw map[string]json.RawMessage
+		assert.Nil(t, json.Unmarshal(fixtureJSON, &raw), fixture.name)
+
+		err = core.UnmarshalModel(raw, "", fixture.result, fixture.unmarshaller)
+		if !assert.Nil(t, err, fixture.name) {
+			continue
+		}
+		model := reflect.ValueOf(fixture.result).Elem().Interface()
+		var expected, actual interface{}
+		assert.Nil(t, json.Unmarshal(fixtureJSON, &expected), fixture.name)
+		assertRequiredProperties(t, fixture.name, reflect.TypeOf(model), expected)
+
+		modelJSON, err := json.Marshal(model)
+		assert.Nil(t, err, fixture.name)
+		assert.Nil(t, json.Unmarshal(modelJSON, &actual), fixture.name)
+		assert.Equal(t, pruneEmpty(expected), pruneEmpty(actual), fixture.name)
+	}
+}
+
+// assertRequiredProperties checks that a JSON value has the properties that are required by the model type (those
+// whose field has a "required" validate tag), recursively. A required property may be null.
+func assertRequiredProperties(t *testing.T, name string, modelType reflect.Type, value interface{}) {
+	switch modelType.Kind() {
+	case reflect.Ptr:
+		assertRequiredProperties(t, name, modelType.Elem(), value)
+	case reflect.Slice:
+		items, _ := value.([]interface{})
+		for _, item := range items {
+			assertRequiredProperties(t, name, modelType.Elem(), item)
+		}
+	case reflect.Map:
+		properties, _ := value.(map[string]interface{})
+		for _, property := range properties {
+			assertRequiredProperties(t, name, modelType.Elem(), property)
+		}
+	case reflect.Struct:
+		properties, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for i := 0; i < modelType.NumField(); i++ {
+			field := modelType.Field(i)
+			key := strings.Split(field.Tag.Get("json"), ",")[0]
+			if key == "" || key == "-" {
+				continue
+			}
+			property, found := properties[key]
+			if strings.Contains(field.Tag.Get("validate"), "required") {
+				assert.True(t, found, "%s: missing required property '%s' of %s", name, key, modelType.Name())
+			}
+			if found {
+				assertRequiredProperties(t, name, field.Type, property)
+			}
+		}
+	}
+}
+
+// pruneEmpty removes the null values, empty arrays and empty objects of a JSON value, which the models omit.
+func pruneEmpty(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		pruned := map[string]interface{}{}
+		for key, item := range v {
+			if item = pruneEmpty(item); item != nil {
+				pruned[key] = item
+			}
+		}
+		if len(pruned) == 0 {
+			return nil
+		}
+		return pruned
+	case []interface{}:
+		var pruned []interface{}
+		for _, item := range v {
+			if item = pruneEmpty(item); item != nil {
+				pruned = append(pruned, item)
+			}
+		}
+		if len(pruned) == 0 {
+			return nil
+		}
+		return pruned
+	}
+	return value
+}
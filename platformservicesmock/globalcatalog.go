@@ -0,0 +1,105 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"net/http"
+)
+
+// CatalogEntry returns the JSON representation of an active service entry with the specified name, as returned by
+// the Global Catalog service.
+func CatalogEntry(name string) map[string]interface{} {
+	id := "entry-" + name
+	return map[string]interface{}{
+		"name": name,
+		"kind": "service",
+		"overview_ui": map[string]interface{}{
+			"en": map[string]interface{}{
+				"display_name":     "Mock " + name,
+				"long_description": "A catalog entry created by the mock server.",
+				"description":      "A mock catalog entry.",
+			},
+		},
+		"images":       map[string]interface{}{"image": "https://example.com/mock.svg"},
+		"disabled":     false,
+		"tags":         []interface{}{"mock"},
+		"provider":     map[string]interface{}{"email": "mock.user@example.com", "name": "Mock Provider"},
+		"active":       true,
+		"id":           id,
+		"catalog_crn":  "crn:v1:bluemix:public:globalcatalog::::service:" + id,
+		"url":          "/api/v1/" + id,
+		"children_url": "/api/v1/" + id + "/%2A",
+		"geo_tags":     []interface{}{"global"},
+		"created":      fixtureTimestamp,
+		"updated":      fixtureTimestamp,
+	}
+}
+
+// NewGlobalCatalogServer returns a new Server with default responses for the catalog entry operations of the Global
+// Catalog service: listing the entries and the children of an entry returns one fixture, getting an entry returns a
+// fixture with the requested ID, creating an entry returns a fixture with the name, kind and tags of the request,
+// getting the visibility of an entry returns a public visibility, and deleting an entry succeeds. Because the
+// operations of the service have no common path prefix, set the URL of the client to Server.URL without a base path.
+func NewGlobalCatalogServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/").WithJSON(catalogEntryList(CatalogEntry("mock-service")))
+	server.Default("POST", "/").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body map[string]interface{}
+		_ = request.JSON(&body)
+		entry := CatalogEntry("mock-service")
+		for _, field := range []string{"name", "kind", "tags", "id"} {
+			if value, found := body[field]; found {
+				entry[field] = value
+			}
+		}
+		return entry
+	})
+	server.Default("GET", "/{id}").WithBodyFunc(func(request *Request) interface{} {
+		entry := CatalogEntry("mock-service")
+		entry["id"] = request.PathParams["id"]
+		return entry
+	})
+	server.Default("DELETE", "/{id}").WithStatus(http.StatusOK)
+	server.Default("GET", "/{id}/visibility").WithJSON(map[string]interface{}{
+		"restrictions": "public",
+		"owner":        AccountID,
+		"extendable":   false,
+		"approved":     true,
+	})
+	server.Default("GET", "/{id}/{kind}").WithBodyFunc(func(request *Request) interface{} {
+		entry := CatalogEntry("mock-plan")
+		entry["kind"] = "plan"
+		entry["parent_id"] = request.PathParams["id"]
+		return catalogEntryList(entry)
+	})
+	return server
+}
+
+// catalogEntryList returns the JSON representation of a page of catalog entries that is the last page.
+func catalogEntryList(entries ...map[string]interface{}) map[string]interface{} {
+	items := make([]interface{}, len(entries))
+	for i, item := range entries {
+		items[i] = item
+	}
+	return map[string]interface{}{
+		"offset":         0,
+		"limit":          200,
+		"count":          len(entries),
+		"resource_count": len(entries),
+		"resources":      items,
+	}
+}
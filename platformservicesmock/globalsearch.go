@@ -0,0 +1,59 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+// searchCursor is the search cursor returned by the Global Search preset.
+const searchCursor = "mock-search-cursor"
+
+// SearchResultItem returns the JSON representation of a search result for the resource instance with the specified
+// name (see ResourceInstance), with its name, family, type, region, account, resource group and tags, as returned by
+// the Global Search service.
+func SearchResultItem(name string, tags ...string) map[string]interface{} {
+	instance := ResourceInstance(name)
+	return map[string]interface{}{
+		"crn":               instance["crn"],
+		"name":              name,
+		"family":            "resource_controller",
+		"type":              "resource-instance",
+		"region":            "us-south",
+		"account_id":        AccountID,
+		"resource_group_id": ResourceGroupID,
+		"tags":              stringItems(tags),
+	}
+}
+
+// NewGlobalSearchServer returns a new Server with default responses for the operations of the Global Search service:
+// a search without a search cursor returns one fixture and a search cursor, a search with a search cursor returns no
+// items (the end of the results), and getting the supported types returns the type of the fixture.
+func NewGlobalSearchServer() *Server {
+	server := NewServer()
+	server.Default("POST", "/v3/resources/search").WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			SearchCursor string `json:"search_cursor"`
+		}
+		_ = request.JSON(&body)
+		items := []interface{}{SearchResultItem("mock-instance", "env:test")}
+		if body.SearchCursor != "" {
+			items = []interface{}{}
+		}
+		return map[string]interface{}{"search_cursor": searchCursor, "limit": 10, "items": items}
+	})
+	server.Default("GET", "/v2/resources/supported_types").WithJSON(map[string]interface{}{
+		"supported_types": []interface{}{"resource-instance"},
+	})
+	return server
+}
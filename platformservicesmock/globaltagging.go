@@ -0,0 +1,65 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+// Tag returns the JSON representation of a tag with the specified name, as returned by the Global Tagging service.
+func Tag(name string) map[string]interface{} {
+	return map[string]interface{}{"name": name}
+}
+
+// NewGlobalTaggingServer returns a new Server with default responses for the operations of the Global Tagging
+// service: listing the tags returns one fixture, attaching and detaching tags succeed for each resource of the
+// request, creating tags succeeds for each tag of the request, and deleting a tag succeeds.
+func NewGlobalTaggingServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/v3/tags").WithJSON(map[string]interface{}{
+		"total_count": 1,
+		"offset":      0,
+		"limit":       100,
+		"items":       []interface{}{Tag("env:test")},
+	})
+	for _, operation := range []string{"attach", "detach"} {
+		server.Default("POST", "/v3/tags/"+operation).WithBodyFunc(func(request *Request) interface{} {
+			var body struct {
+				Resources []struct {
+					ResourceID string `json:"resource_id"`
+				} `json:"resources"`
+			}
+			_ = request.JSON(&body)
+			results := make([]interface{}, len(body.Resources))
+			for i, resource := range body.Resources {
+				results[i] = map[string]interface{}{"resource_id": resource.ResourceID, "is_error": false}
+			}
+			return map[string]interface{}{"results": results}
+		})
+	}
+	server.Default("POST", "/v3/tags").WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			TagNames []string `json:"tag_names"`
+		}
+		_ = request.JSON(&body)
+		results := make([]interface{}, len(body.TagNames))
+		for i, name := range body.TagNames {
+			results[i] = map[string]interface{}{"tag_name": name, "is_error": false}
+		}
+		return map[string]interface{}{"results": results}
+	})
+	server.Default("DELETE", "/v3/tags/{tag_name}").WithJSON(map[string]interface{}{
+		"results": []interface{}{map[string]interface{}{"provider": "ghost", "is_error": false}},
+	})
+	return server
+}
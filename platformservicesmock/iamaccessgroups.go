@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"net/http"
+)
+
+// AccessGroup returns the JSON representation of an access group with the specified ID and name in the mock account,
+// as returned by the IAM Access Groups service.
+func AccessGroup(id string, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                  id,
+		"name":                name,
+		"description":         "An access group created by the mock server.",
+		"account_id":          AccountID,
+		"created_at":          fixtureTimestamp,
+		"created_by_id":       IamID,
+		"last_modified_at":    fixtureTimestamp,
+		"last_modified_by_id": IamID,
+		"href":                "https://iam.cloud.ibm.com/v2/groups/" + id,
+		"is_federated":        false,
+	}
+}
+
+// AccessGroupMember returns the JSON representation of a static member of an access group with the specified IAM ID,
+// as returned by the IAM Access Groups service.
+func AccessGroupMember(iamID string) map[string]interface{} {
+	return map[string]interface{}{
+		"iam_id":          iamID,
+		"type":            "user",
+		"membership_type": "static",
+		"name":            "Mock User",
+		"email":           "mock.user@example.com",
+		"href":            "https://iam.cloud.ibm.com/v2/groups/members/" + iamID,
+		"created_at":      fixtureTimestamp,
+		"created_by_id":   IamID,
+	}
+}
+
+// NewIamAccessGroupsServer returns a new Server with default responses for the access group and member operations of
+// the IAM Access Groups service: the list operations return one fixture, getting an access group returns a fixture
+// with the requested ID, creating an access group returns a fixture with the name of the request, adding members
+// returns each member of the request, checking a membership succeeds, and the delete operations succeed.
+func NewIamAccessGroupsServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/v2/groups").WithJSON(map[string]interface{}{
+		"limit":       100,
+		"offset":      0,
+		"total_count": 1,
+		"first":       map[string]interface{}{"href": "https://iam.cloud.ibm.com/v2/groups?limit=100"},
+		"last":        map[string]interface{}{"href": "https://iam.cloud.ibm.com/v2/groups?limit=100"},
+		"groups":      []interface{}{AccessGroup("AccessGroupId-mock", "mock-group")},
+	})
+	server.Default("GET", "/v2/groups/{access_group_id}").WithHeader("ETag", "1-mock").WithBodyFunc(func(request *Request) interface{} {
+		return AccessGroup(request.PathParams["access_group_id"], "mock-group")
+	})
+	server.Default("POST", "/v2/groups").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Name string `json:"name"`
+		}
+		_ = request.JSON(&body)
+		return AccessGroup("AccessGroupId-mock", body.Name)
+	})
+	server.Default("DELETE", "/v2/groups/{access_group_id}").WithStatus(http.StatusNoContent)
+	server.Default("GET", "/v2/groups/{access_group_id}/members").WithBodyFunc(func(request *Request) interface{} {
+		href := "https://iam.cloud.ibm.com/v2/groups/" + request.PathParams["access_group_id"] + "/members?limit=100"
+		return map[string]interface{}{
+			"limit":       100,
+			"offset":      0,
+			"total_count": 1,
+			"first":       map[string]interface{}{"href": href},
+			"last":        map[string]interface{}{"href": href},
+			"members":     []interface{}{AccessGroupMember(IamID)},
+		}
+	})
+	server.Default("PUT", "/v2/groups/{access_group_id}/members").WithStatus(http.StatusMultiStatus).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Members []struct {
+				IamID string `json:"iam_id"`
+				Type  string `json:"type"`
+			} `json:"members"`
+		}
+		_ = request.JSON(&body)
+		members := make([]interface{}, len(body.Members))
+		for i, member := range body.Members {
+			members[i] = map[string]interface{}{
+				"iam_id":        member.IamID,
+				"type":          member.Type,
+				"created_at":    fixtureTimestamp,
+				"created_by_id": IamID,
+				"status_code":   http.StatusOK,
+			}
+		}
+		return map[string]interface{}{"members": members}
+	})
+	server.Default("HEAD", "/v2/groups/{access_group_id}/members/{iam_id}").WithStatus(http.StatusNoContent)
+	server.Default("DELETE", "/v2/groups/{access_group_id}/members/{iam_id}").WithStatus(http.StatusNoContent)
+	return server
+}
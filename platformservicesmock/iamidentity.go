@@ -0,0 +1,135 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// APIKey returns the JSON representation of an unlocked API key with the specified ID and name of the mock user, as
+// returned by the IAM Identity service. The value of the key is not included, as when the key is read.
+func APIKey(id string, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          id,
+		"entity_tag":  "1-mock",
+		"crn":         fmt.Sprintf("crn:v1:bluemix:public:iam-identity::a/%s::apikey:%s", AccountID, id),
+		"locked":      false,
+		"created_at":  fixtureTimestamp,
+		"created_by":  IamID,
+		"modified_at": fixtureTimestamp,
+		"name":        name,
+		"description": "An API key created by the mock server.",
+		"iam_id":      IamID,
+		"account_id":  AccountID,
+		"apikey":      "",
+	}
+}
+
+// ServiceID returns the JSON representation of an unlocked service ID with the specified ID and name in the mock
+// account, as returned by the IAM Identity service.
+func ServiceID(id string, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          id,
+		"iam_id":      "iam-" + id,
+		"entity_tag":  "1-mock",
+		"crn":         fmt.Sprintf("crn:v1:bluemix:public:iam-identity::a/%s::serviceid:%s", AccountID, id),
+		"locked":      false,
+		"created_at":  fixtureTimestamp,
+		"modified_at": fixtureTimestamp,
+		"account_id":  AccountID,
+		"name":        name,
+		"description": "A service ID created by the mock server.",
+	}
+}
+
+// TrustedProfile returns the JSON representation of a trusted profile with the specified ID and name in the mock
+// account, as returned by the IAM Identity service.
+func TrustedProfile(id string, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          id,
+		"entity_tag":  "1-mock",
+		"crn":         fmt.Sprintf("crn:v1:bluemix:public:iam-identity::a/%s::profile:%s", AccountID, id),
+		"name":        name,
+		"description": "A trusted profile created by the mock server.",
+		"created_at":  fixtureTimestamp,
+		"modified_at": fixtureTimestamp,
+		"iam_id":      "iam-" + id,
+		"account_id":  AccountID,
+	}
+}
+
+// NewIamIdentityServer returns a new Server with default responses for the API key, service ID and trusted profile
+// operations of the IAM Identity service: the list operations return one fixture, the get operations return a
+// fixture with the requested ID, the create operations return a fixture with the name of the request (and, for an API
+// key, a generated value), and the delete operations succeed.
+func NewIamIdentityServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/v1/apikeys").WithJSON(map[string]interface{}{
+		"offset":  0,
+		"limit":   20,
+		"apikeys": []interface{}{APIKey("ApiKey-mock", "mock-apikey")},
+	})
+	server.Default("GET", "/v1/apikeys/{id}").WithBodyFunc(func(request *Request) interface{} {
+		return APIKey(request.PathParams["id"], "mock-apikey")
+	})
+	server.Default("POST", "/v1/apikeys").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Name  string `json:"name"`
+			IamID string `json:"iam_id"`
+		}
+		_ = request.JSON(&body)
+		apiKey := APIKey("ApiKey-mock", body.Name)
+		apiKey["iam_id"] = body.IamID
+		apiKey["apikey"] = "mock-apikey-value"
+		return apiKey
+	})
+	server.Default("DELETE", "/v1/apikeys/{id}").WithStatus(http.StatusNoContent)
+	server.Default("GET", "/v1/serviceids").WithJSON(map[string]interface{}{
+		"offset":     0,
+		"limit":      20,
+		"serviceids": []interface{}{ServiceID("ServiceId-mock", "mock-serviceid")},
+	})
+	server.Default("GET", "/v1/serviceids/{id}").WithBodyFunc(func(request *Request) interface{} {
+		return ServiceID(request.PathParams["id"], "mock-serviceid")
+	})
+	server.Default("POST", "/v1/serviceids").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Name string `json:"name"`
+		}
+		_ = request.JSON(&body)
+		return ServiceID("ServiceId-mock", body.Name)
+	})
+	server.Default("DELETE", "/v1/serviceids/{id}").WithStatus(http.StatusNoContent)
+	server.Default("GET", "/v1/profiles").WithJSON(map[string]interface{}{
+		"offset":   0,
+		"limit":    20,
+		"profiles": []interface{}{TrustedProfile("Profile-mock", "mock-profile")},
+	})
+	server.Default("GET", "/v1/profiles/{profile-id}").WithBodyFunc(func(request *Request) interface{} {
+		return TrustedProfile(request.PathParams["profile-id"], "mock-profile")
+	})
+	server.Default("POST", "/v1/profiles").WithStatus(http.StatusCreated).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Name string `json:"name"`
+		}
+		_ = request.JSON(&body)
+		return TrustedProfile("Profile-mock", body.Name)
+	})
+	server.Default("DELETE", "/v1/profiles/{profile-id}").WithStatus(http.StatusNoContent)
+	return server
+}
@@ -0,0 +1,66 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+// CloudShellAccountSettings returns the JSON representation of the Cloud Shell settings of the mock account, with
+// Cloud Shell, its features and its regions enabled, as returned by the IBM Cloud Shell service.
+func CloudShellAccountSettings() map[string]interface{} {
+	return map[string]interface{}{
+		"_id":                         "ac-" + AccountID,
+		"_rev":                        "1-mock",
+		"account_id":                  AccountID,
+		"created_at":                  1640995200,
+		"created_by":                  IamID,
+		"default_enable_new_features": true,
+		"default_enable_new_regions":  true,
+		"enabled":                     true,
+		"features": []interface{}{
+			map[string]interface{}{"enabled": true, "key": "server.file_manager"},
+			map[string]interface{}{"enabled": true, "key": "server.web_preview"},
+		},
+		"regions": []interface{}{
+			map[string]interface{}{"enabled": true, "key": "eu-de"},
+			map[string]interface{}{"enabled": true, "key": "us-south"},
+		},
+		"type":       "account_settings",
+		"updated_at": 1640995200,
+		"updated_by": IamID,
+	}
+}
+
+// NewIBMCloudShellServer returns a new Server with default responses for the operations of the IBM Cloud Shell
+// service: getting the account settings returns the fixture for the requested account, and updating them returns the
+// settings of the request.
+func NewIBMCloudShellServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/api/v1/user/accounts/{account_id}/settings").WithBodyFunc(func(request *Request) interface{} {
+		settings := CloudShellAccountSettings()
+		settings["account_id"] = request.PathParams["account_id"]
+		return settings
+	})
+	server.Default("POST", "/api/v1/user/accounts/{account_id}/settings").WithBodyFunc(func(request *Request) interface{} {
+		var body map[string]interface{}
+		_ = request.JSON(&body)
+		settings := CloudShellAccountSettings()
+		for field, value := range body {
+			settings[field] = value
+		}
+		settings["account_id"] = request.PathParams["account_id"]
+		return settings
+	})
+	return server
+}
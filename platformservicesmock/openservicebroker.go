@@ -0,0 +1,72 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"net/http"
+)
+
+// BrokerService returns the JSON representation of a bindable service with the specified name and one free plan, as
+// listed in the catalog of an Open Service Broker.
+func BrokerService(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"bindable":        true,
+		"description":     "A service created by the mock server.",
+		"id":              "service-" + name,
+		"name":            name,
+		"plan_updateable": true,
+		"plans": []interface{}{map[string]interface{}{
+			"description": "A free plan.",
+			"free":        true,
+			"id":          "plan-" + name + "-lite",
+			"name":        "lite",
+		}},
+	}
+}
+
+// NewOpenServiceBrokerServer returns a new Server with default responses for the operations of an Open Service
+// Broker: listing the catalog returns one fixture, provisioning, updating and deprovisioning an instance start an
+// asynchronous operation, getting the last operation returns its success, getting or replacing the state of an
+// instance returns an enabled and active state, binding an instance returns credentials, and unbinding succeeds.
+func NewOpenServiceBrokerServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/v2/catalog").WithJSON(map[string]interface{}{
+		"services": []interface{}{BrokerService("mock-service")},
+	})
+	server.Default("PUT", "/v2/service_instances/{instance_id}").WithStatus(http.StatusAccepted).WithJSON(map[string]interface{}{
+		"dashboard_url": "https://example.com/dashboard",
+		"operation":     "mock-provision",
+	})
+	server.Default("PATCH", "/v2/service_instances/{instance_id}").WithStatus(http.StatusAccepted).WithJSON(map[string]interface{}{
+		"operation": "mock-update",
+	})
+	server.Default("DELETE", "/v2/service_instances/{instance_id}").WithStatus(http.StatusAccepted).WithJSON(map[string]interface{}{
+		"operation": "mock-deprovision",
+	})
+	server.Default("GET", "/v2/service_instances/{instance_id}/last_operation").WithJSON(map[string]interface{}{
+		"description": "The operation succeeded.",
+		"state":       "succeeded",
+	})
+	state := map[string]interface{}{"active": true, "enabled": true, "last_active": 1640995200}
+	server.Default("GET", "/bluemix_v1/service_instances/{instance_id}").WithJSON(state)
+	server.Default("PUT", "/bluemix_v1/service_instances/{instance_id}").WithJSON(state)
+	server.Default("PUT", "/v2/service_instances/{instance_id}/service_bindings/{binding_id}").WithStatus(http.StatusCreated).WithJSON(map[string]interface{}{
+		"credentials": map[string]interface{}{"apikey": "mock-apikey", "url": "https://example.com"},
+	})
+	server.Default("DELETE", "/v2/service_instances/{instance_id}/service_bindings/{binding_id}").WithStatus(http.StatusOK).WithJSON(`{}`)
+	return server
+}
@@ -0,0 +1,73 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"net/http"
+)
+
+// PostureProfile returns the JSON representation of an enabled predefined profile with the specified ID and name, as
+// returned by the Posture Management service.
+func PostureProfile(id string, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          name,
+		"description":   "A profile created by the mock server.",
+		"version":       1,
+		"created_by":    "IBM",
+		"modified_by":   "IBM",
+		"profile_id":    id,
+		"base_profile":  name,
+		"profile_type":  "predefined",
+		"created_time":  fixtureTimestamp,
+		"modified_time": fixtureTimestamp,
+		"enabled":       true,
+	}
+}
+
+// PostureScope returns the JSON representation of an enabled IBM Cloud scope with the specified ID and name, as
+// returned by the Posture Management service.
+func PostureScope(id string, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"description":      "A scope created by the mock server.",
+		"created_by":       IamID,
+		"modified_by":      IamID,
+		"scope_id":         id,
+		"name":             name,
+		"enabled":          true,
+		"environment_type": "ibm",
+		"created_time":     fixtureTimestamp,
+		"modified_time":    fixtureTimestamp,
+		"collectors_id":    []interface{}{"mock-collector-id"},
+	}
+}
+
+// NewPostureManagementServer returns a new Server with default responses for the operations of the Posture
+// Management service: listing the profiles and the scopes returns one fixture, and creating a validation succeeds.
+func NewPostureManagementServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/posture/v1/profiles").WithJSON(map[string]interface{}{
+		"profiles": []interface{}{PostureProfile("mock-profile-id", "IBM Cloud Best Practices Controls 1.0")},
+	})
+	server.Default("GET", "/posture/v1/scopes").WithJSON(map[string]interface{}{
+		"scopes": []interface{}{PostureScope("mock-scope-id", "mock-scope")},
+	})
+	server.Default("POST", "/posture/v1/scans/validations").WithStatus(http.StatusAccepted).WithJSON(map[string]interface{}{
+		"result":  true,
+		"message": "Successfully started the validation.",
+	})
+	return server
+}
@@ -0,0 +1,559 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock_test
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv1"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/IBM/platform-services-go-sdk/configurationgovernancev1"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/ibmcloudshellv1"
+	"github.com/IBM/platform-services-go-sdk/openservicebrokerv1"
+	"github.com/IBM/platform-services-go-sdk/platformservicesmock"
+	"github.com/IBM/platform-services-go-sdk/posturemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/IBM/platform-services-go-sdk/usagemeteringv4"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	"github.com/IBM/platform-services-go-sdk/usermanagementv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtrackerServer(t *testing.T) {
+	server := platformservicesmock.NewAtrackerServer()
+	defer server.Close()
+	service, err := atrackerv2.NewAtrackerV2(&atrackerv2.AtrackerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	targets, _, err := service.ListTargets(service.NewListTargetsOptions())
+	assert.Nil(t, err)
+	assert.Len(t, targets.Targets, 1)
+	target, _, err := service.GetTarget(service.NewGetTargetOptions("target-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "target-1", *target.ID)
+
+	rule := atrackerv2.RulePrototype{TargetIds: []string{*target.ID}}
+	route, _, err := service.CreateRoute(service.NewCreateRouteOptions("my-route", []atrackerv2.RulePrototype{rule}))
+	assert.Nil(t, err)
+	assert.Equal(t, "my-route", *route.Name)
+	assert.Equal(t, []string{"target-1"}, route.Rules[0].TargetIds)
+	_, err = service.DeleteRoute(service.NewDeleteRouteOptions(*route.ID))
+	assert.Nil(t, err)
+	assert.Nil(t, server.Verify())
+}
+
+func TestAtrackerV1Server(t *testing.T) {
+	server := platformservicesmock.NewAtrackerV1Server()
+	defer server.Close()
+	service, err := atrackerv1.NewAtrackerV1(&atrackerv1.AtrackerV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	routes, _, err := service.ListRoutes(service.NewListRoutesOptions())
+	assert.Nil(t, err)
+	assert.Len(t, routes.Routes, 1)
+	cosEndpoint := &atrackerv1.CosEndpoint{
+		Endpoint:  core.StringPtr("s3.private.us-east.cloud-object-storage.appdomain.cloud"),
+		TargetCRN: core.StringPtr("crn:v1:bluemix:public:cloud-object-storage:global:a/mock::"),
+		Bucket:    core.StringPtr("my-bucket"),
+		APIKey:    core.StringPtr("mock-api-key"),
+	}
+	target, _, err := service.CreateTarget(service.NewCreateTargetOptions("my-target", "cloud_object_storage", cosEndpoint))
+	assert.Nil(t, err)
+	assert.Equal(t, "my-target", *target.Name)
+	assert.Nil(t, server.Verify())
+}
+
+func TestCatalogManagementServer(t *testing.T) {
+	server := platformservicesmock.NewCatalogManagementServer()
+	defer server.Close()
+	service, err := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	catalogs, _, err := service.ListCatalogs(service.NewListCatalogsOptions())
+	assert.Nil(t, err)
+	assert.Len(t, catalogs.Resources, 1)
+	catalog, _, err := service.CreateCatalog(service.NewCreateCatalogOptions().SetLabel("my-catalog"))
+	assert.Nil(t, err)
+	assert.Equal(t, "my-catalog", *catalog.Label)
+
+	offerings, _, err := service.ListOfferings(service.NewListOfferingsOptions(*catalog.ID))
+	assert.Nil(t, err)
+	assert.Len(t, offerings.Resources, 1)
+	assert.Equal(t, *catalog.ID, *offerings.Resources[0].CatalogID)
+	offering, _, err := service.GetOffering(service.NewGetOfferingOptions(*catalog.ID, "offering-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "offering-1", *offering.ID)
+	_, err = service.DeleteCatalog(service.NewDeleteCatalogOptions(*catalog.ID))
+	assert.Nil(t, err)
+	assert.Nil(t, server.Verify())
+}
+
+func TestConfigurationGovernanceServer(t *testing.T) {
+	server := platformservicesmock.NewConfigurationGovernanceServer()
+	defer server.Close()
+	service, err := configurationgovernancev1.NewConfigurationGovernanceV1(&configurationgovernancev1.ConfigurationGovernanceV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	rules, _, err := service.ListRules(service.NewListRulesOptions(platformservicesmock.AccountID))
+	assert.Nil(t, err)
+	assert.Len(t, rules.Rules, 1)
+	rule, _, err := service.GetRule(service.NewGetRuleOptions("rule-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "rule-1", *rule.RuleID)
+
+	attachments, _, err := service.ListAttachments(service.NewListAttachmentsOptions("rule-1"))
+	assert.Nil(t, err)
+	assert.Len(t, attachments.Attachments, 1)
+	assert.Equal(t, "rule-1", *attachments.Attachments[0].RuleID)
+	_, err = service.DeleteRule(service.NewDeleteRuleOptions("rule-1"))
+	assert.Nil(t, err)
+	assert.Nil(t, server.Verify())
+}
+
+func TestContextBasedRestrictionsServer(t *testing.T) {
+	server := platformservicesmock.NewContextBasedRestrictionsServer()
+	defer server.Close()
+	service, err := contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	zones, _, err := service.ListZones(service.NewListZonesOptions(platformservicesmock.AccountID))
+	assert.Nil(t, err)
+	assert.Len(t, zones.Zones, 1)
+	zone, response, err := service.CreateZone(service.NewCreateZoneOptions().SetName("my-zone"))
+	assert.Nil(t, err)
+	assert.Equal(t, "my-zone", *zone.Name)
+	assert.NotEmpty(t, response.Headers.Get("ETag"))
+
+	rules, _, err := service.ListRules(service.NewListRulesOptions(platformservicesmock.AccountID))
+	assert.Nil(t, err)
+	assert.Len(t, rules.Rules, 1)
+	rule, _, err := service.GetRule(service.NewGetRuleOptions("rule-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "rule-1", *rule.ID)
+	_, err = service.DeleteZone(service.NewDeleteZoneOptions(*zone.ID))
+	assert.Nil(t, err)
+	assert.Nil(t, server.Verify())
+}
+
+func TestEnterpriseManagementServer(t *testing.T) {
+	server := platformservicesmock.NewEnterpriseManagementServer()
+	defer server.Close()
+	service, err := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	enterprises, _, err := service.ListEnterprises(service.NewListEnterprisesOptions())
+	assert.Nil(t, err)
+	assert.Len(t, enterprises.Resources, 1)
+	enterprise, _, err := service.GetEnterprise(service.NewGetEnterpriseOptions(platformservicesmock.EnterpriseID))
+	assert.Nil(t, err)
+	assert.Equal(t, platformservicesmock.EnterpriseID, *enterprise.ID)
+
+	accounts, _, err := service.ListAccounts(service.NewListAccountsOptions())
+	assert.Nil(t, err)
+	assert.Len(t, accounts.Resources, 1)
+	account, _, err := service.GetAccount(service.NewGetAccountOptions("account-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "account-1", *account.ID)
+	assert.Equal(t, *enterprise.CRN, *account.Parent)
+	created, _, err := service.CreateAccount(service.NewCreateAccountOptions(*enterprise.CRN, "my-account", platformservicesmock.IamID))
+	assert.Nil(t, err)
+	assert.NotNil(t, created.AccountID)
+
+	groups, _, err := service.ListAccountGroups(service.NewListAccountGroupsOptions())
+	assert.Nil(t, err)
+	assert.Len(t, groups.Resources, 1)
+	_, err = service.UpdateAccountGroup(service.NewUpdateAccountGroupOptions(*groups.Resources[0].ID).SetName("renamed"))
+	assert.Nil(t, err)
+	assert.Nil(t, server.Verify())
+}
+
+func TestEnterpriseBillingUnitsServer(t *testing.T) {
+	server := platformservicesmock.NewEnterpriseBillingUnitsServer()
+	defer server.Close()
+	service, err := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(&enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	billingUnits, _, err := service.ListBillingUnits(service.NewListBillingUnitsOptions())
+	assert.Nil(t, err)
+	assert.Len(t, billingUnits.Resources, 1)
+	billingUnit, _, err := service.GetBillingUnit(service.NewGetBillingUnitOptions(platformservicesmock.BillingUnitID))
+	assert.Nil(t, err)
+	assert.Equal(t, platformservicesmock.BillingUnitID, *billingUnit.ID)
+
+	billingOptions, _, err := service.ListBillingOptions(service.NewListBillingOptionsOptions(platformservicesmock.BillingUnitID))
+	assert.Nil(t, err)
+	assert.Len(t, billingOptions.Resources, 1)
+	creditPools, _, err := service.GetCreditPools(service.NewGetCreditPoolsOptions(platformservicesmock.BillingUnitID))
+	assert.Nil(t, err)
+	assert.Len(t, creditPools.Resources, 1)
+	assert.Nil(t, server.Verify())
+}
+
+func TestEnterpriseUsageReportsServer(t *testing.T) {
+	server := platformservicesmock.NewEnterpriseUsageReportsServer()
+	defer server.Close()
+	service, err := enterpriseusagereportsv1.NewEnterpriseUsageReportsV1(&enterpriseusagereportsv1.EnterpriseUsageReportsV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	reports, _, err := service.GetResourceUsageReport(service.NewGetResourceUsageReportOptions().SetMonth("2022-03"))
+	assert.Nil(t, err)
+	assert.Len(t, reports.Reports, 1)
+	assert.Equal(t, "2022-03", *reports.Reports[0].Month)
+	assert.Nil(t, server.Verify())
+}
+
+func TestGlobalCatalogServer(t *testing.T) {
+	server := platformservicesmock.NewGlobalCatalogServer()
+	defer server.Close()
+	service, err := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	entries, _, err := service.ListCatalogEntries(service.NewListCatalogEntriesOptions())
+	assert.Nil(t, err)
+	assert.Len(t, entries.Resources, 1)
+	entry, _, err := service.GetCatalogEntry(service.NewGetCatalogEntryOptions("entry-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "entry-1", *entry.ID)
+
+	children, _, err := service.GetChildObjects(service.NewGetChildObjectsOptions("entry-1", "plan"))
+	assert.Nil(t, err)
+	assert.Len(t, children.Resources, 1)
+	assert.Equal(t, "plan", *children.Resources[0].Kind)
+	visibility, _, err := service.GetVisibility(service.NewGetVisibilityOptions("entry-1"))
+	assert.Nil(t, err)
+	assert.NotNil(t, visibility.Restrictions)
+	_, err = service.DeleteCatalogEntry(service.NewDeleteCatalogEntryOptions("entry-1"))
+	assert.Nil(t, err)
+	assert.Nil(t, server.Verify())
+}
+
+func TestGlobalSearchServer(t *testing.T) {
+	server := platformservicesmock.NewGlobalSearchServer()
+	defer server.Close()
+	service, err := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	result, _, err := service.Search(service.NewSearchOptions().SetQuery("*"))
+	assert.Nil(t, err)
+	assert.Len(t, result.Items, 1)
+	assert.NotNil(t, result.SearchCursor)
+	result, _, err = service.Search(service.NewSearchOptions().SetQuery("*").SetSearchCursor(*result.SearchCursor))
+	assert.Nil(t, err)
+	assert.Empty(t, result.Items)
+
+	types, _, err := service.GetSupportedTypes(service.NewGetSupportedTypesOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"resource-instance"}, types.SupportedTypes)
+	assert.Nil(t, server.Verify())
+}
+
+func TestGlobalTaggingServer(t *testing.T) {
+	server := platformservicesmock.NewGlobalTaggingServer()
+	defer server.Close()
+	service, err := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	tags, _, err := service.ListTags(service.NewListTagsOptions())
+	assert.Nil(t, err)
+	assert.Len(t, tags.Items, 1)
+
+	resources := []globaltaggingv1.Resource{{ResourceID: core.StringPtr("crn-1")}, {ResourceID: core.StringPtr("crn-2")}}
+	results, _, err := service.AttachTag(service.NewAttachTagOptions(resources).SetTagNames([]string{"env:test"}))
+	assert.Nil(t, err)
+	assert.Len(t, results.Results, 2)
+	assert.Equal(t, "crn-2", *results.Results[1].ResourceID)
+	assert.False(t, *results.Results[1].IsError)
+
+	created, _, err := service.CreateTag(service.NewCreateTagOptions([]string{"team:a", "team:b"}))
+	assert.Nil(t, err)
+	assert.Len(t, created.Results, 2)
+	deleted, _, err := service.DeleteTag(service.NewDeleteTagOptions("team:a"))
+	assert.Nil(t, err)
+	assert.False(t, *deleted.Results[0].IsError)
+	assert.Nil(t, server.Verify())
+}
+
+func TestIamAccessGroupsServer(t *testing.T) {
+	server := platformservicesmock.NewIamAccessGroupsServer()
+	defer server.Close()
+	service, err := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	groups, _, err := service.ListAccessGroups(service.NewListAccessGroupsOptions(platformservicesmock.AccountID))
+	assert.Nil(t, err)
+	assert.Len(t, groups.Groups, 1)
+	group, _, err := service.CreateAccessGroup(service.NewCreateAccessGroupOptions(platformservicesmock.AccountID, "my-group"))
+	assert.Nil(t, err)
+	assert.Equal(t, "my-group", *group.Name)
+
+	members, _, err := service.ListAccessGroupMembers(service.NewListAccessGroupMembersOptions(*group.ID))
+	assert.Nil(t, err)
+	assert.Len(t, members.Members, 1)
+	addMembersOptions := service.NewAddMembersToAccessGroupOptions(*group.ID).SetMembers([]iamaccessgroupsv2.AddGroupMembersRequestMembersItem{
+		{IamID: core.StringPtr("IBMid-other"), Type: core.StringPtr("user")},
+	})
+	added, _, err := service.AddMembersToAccessGroup(addMembersOptions)
+	assert.Nil(t, err)
+	assert.Equal(t, "IBMid-other", *added.Members[0].IamID)
+	_, err = service.IsMemberOfAccessGroup(service.NewIsMemberOfAccessGroupOptions(*group.ID, "IBMid-other"))
+	assert.Nil(t, err)
+	assert.Nil(t, server.Verify())
+}
+
+func TestIamIdentityServer(t *testing.T) {
+	server := platformservicesmock.NewIamIdentityServer()
+	defer server.Close()
+	service, err := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	apiKeys, _, err := service.ListAPIKeys(service.NewListAPIKeysOptions())
+	assert.Nil(t, err)
+	assert.Len(t, apiKeys.Apikeys, 1)
+	serviceID, _, err := service.CreateServiceID(service.NewCreateServiceIDOptions(platformservicesmock.AccountID, "my-service-id"))
+	assert.Nil(t, err)
+	assert.Equal(t, "my-service-id", *serviceID.Name)
+	apiKey, _, err := service.CreateAPIKey(service.NewCreateAPIKeyOptions("my-key", *serviceID.IamID))
+	assert.Nil(t, err)
+	assert.Equal(t, "my-key", *apiKey.Name)
+	assert.Equal(t, *serviceID.IamID, *apiKey.IamID)
+	assert.NotNil(t, apiKey.Apikey)
+
+	profiles, _, err := service.ListProfiles(service.NewListProfilesOptions(platformservicesmock.AccountID))
+	assert.Nil(t, err)
+	assert.Len(t, profiles.Profiles, 1)
+	_, err = service.DeleteAPIKey(service.NewDeleteAPIKeyOptions(*apiKey.ID))
+	assert.Nil(t, err)
+	assert.Nil(t, server.Verify())
+}
+
+func TestIBMCloudShellServer(t *testing.T) {
+	server := platformservicesmock.NewIBMCloudShellServer()
+	defer server.Close()
+	service, err := ibmcloudshellv1.NewIBMCloudShellV1(&ibmcloudshellv1.IBMCloudShellV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	settings, _, err := service.GetAccountSettings(service.NewGetAccountSettingsOptions(platformservicesmock.AccountID))
+	assert.Nil(t, err)
+	assert.Equal(t, platformservicesmock.AccountID, *settings.AccountID)
+	updated, _, err := service.UpdateAccountSettings(service.NewUpdateAccountSettingsOptions(platformservicesmock.AccountID).
+		SetRev(*settings.Rev).SetDefaultEnableNewRegions(false))
+	assert.Nil(t, err)
+	assert.False(t, *updated.DefaultEnableNewRegions)
+	assert.Nil(t, server.Verify())
+}
+
+func TestOpenServiceBrokerServer(t *testing.T) {
+	server := platformservicesmock.NewOpenServiceBrokerServer()
+	defer server.Close()
+	service, err := openservicebrokerv1.NewOpenServiceBrokerV1(&openservicebrokerv1.OpenServiceBrokerV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	catalog, _, err := service.ListCatalog(service.NewListCatalogOptions())
+	assert.Nil(t, err)
+	assert.Len(t, catalog.Services, 1)
+	instance, _, err := service.ReplaceServiceInstance(service.NewReplaceServiceInstanceOptions("instance-1"))
+	assert.Nil(t, err)
+	assert.NotNil(t, instance.Operation)
+	operation, _, err := service.GetLastOperation(service.NewGetLastOperationOptions("instance-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "succeeded", *operation.State)
+	binding, _, err := service.ReplaceServiceBinding(service.NewReplaceServiceBindingOptions("binding-1", "instance-1"))
+	assert.Nil(t, err)
+	assert.NotNil(t, binding.Credentials)
+	assert.Nil(t, server.Verify())
+}
+
+func TestPostureManagementServer(t *testing.T) {
+	server := platformservicesmock.NewPostureManagementServer()
+	defer server.Close()
+	service, err := posturemanagementv1.NewPostureManagementV1(&posturemanagementv1.PostureManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	profiles, _, err := service.ListProfiles(service.NewListProfilesOptions(platformservicesmock.AccountID))
+	assert.Nil(t, err)
+	assert.Len(t, profiles.Profiles, 1)
+	scopes, _, err := service.ListScopes(service.NewListScopesOptions(platformservicesmock.AccountID))
+	assert.Nil(t, err)
+	assert.Len(t, scopes.Scopes, 1)
+	validation, _, err := service.CreateValidation(service.NewCreateValidationOptions(platformservicesmock.AccountID).
+		SetScopeID(*scopes.Scopes[0].ScopeID).SetProfileID(*profiles.Profiles[0].ProfileID))
+	assert.Nil(t, err)
+	assert.NotNil(t, validation.Result)
+	assert.Nil(t, server.Verify())
+}
+
+func TestResourceManagerServer(t *testing.T) {
+	server := platformservicesmock.NewResourceManagerServer()
+	defer server.Close()
+	service, err := resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	groups, _, err := service.ListResourceGroups(service.NewListResourceGroupsOptions())
+	assert.Nil(t, err)
+	assert.Len(t, groups.Resources, 1)
+	created, _, err := service.CreateResourceGroup(service.NewCreateResourceGroupOptions().SetName("my-group"))
+	assert.Nil(t, err)
+	group, _, err := service.UpdateResourceGroup(service.NewUpdateResourceGroupOptions(*created.ID).SetName("renamed"))
+	assert.Nil(t, err)
+	assert.Equal(t, *created.ID, *group.ID)
+	assert.Equal(t, "renamed", *group.Name)
+
+	quotas, _, err := service.ListQuotaDefinitions(service.NewListQuotaDefinitionsOptions())
+	assert.Nil(t, err)
+	assert.Len(t, quotas.Resources, 1)
+	_, err = service.DeleteResourceGroup(service.NewDeleteResourceGroupOptions(*created.ID))
+	assert.Nil(t, err)
+	assert.Nil(t, server.Verify())
+}
+
+func TestUsageMeteringServer(t *testing.T) {
+	server := platformservicesmock.NewUsageMeteringServer()
+	defer server.Close()
+	service, err := usagemeteringv4.NewUsageMeteringV4(&usagemeteringv4.UsageMeteringV4Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	usage := []usagemeteringv4.ResourceInstanceUsage{
+		{ResourceInstanceID: core.StringPtr("instance-1"), PlanID: core.StringPtr("plan-1"), Start: core.Int64Ptr(1), End: core.Int64Ptr(2),
+			MeasuredUsage: []usagemeteringv4.MeasureAndQuantity{{Measure: core.StringPtr("STORAGE"), Quantity: core.Int64Ptr(1)}}},
+		{ResourceInstanceID: core.StringPtr("instance-2"), PlanID: core.StringPtr("plan-1"), Start: core.Int64Ptr(1), End: core.Int64Ptr(2),
+			MeasuredUsage: []usagemeteringv4.MeasureAndQuantity{{Measure: core.StringPtr("STORAGE"), Quantity: core.Int64Ptr(1)}}},
+	}
+	accepted, _, err := service.ReportResourceUsage(service.NewReportResourceUsageOptions("resource-1", usage))
+	assert.Nil(t, err)
+	assert.Len(t, accepted.Resources, 2)
+	assert.Equal(t, int64(201), *accepted.Resources[1].Status)
+	assert.Nil(t, server.Verify())
+}
+
+func TestUsageReportsServer(t *testing.T) {
+	server := platformservicesmock.NewUsageReportsServer()
+	defer server.Close()
+	service, err := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	summary, _, err := service.GetAccountSummary(service.NewGetAccountSummaryOptions("account-1", "2022-03"))
+	assert.Nil(t, err)
+	assert.Equal(t, "account-1", *summary.AccountID)
+	assert.Equal(t, "2022-03", *summary.BillingMonth)
+	usage, _, err := service.GetResourceGroupUsage(service.NewGetResourceGroupUsageOptions("account-1", "rg-1", "2022-03"))
+	assert.Nil(t, err)
+	assert.Equal(t, "rg-1", *usage.ResourceGroupID)
+	assert.Equal(t, float64(100), *usage.Resources[0].BillableCost)
+
+	instances, _, err := service.GetResourceUsageAccount(service.NewGetResourceUsageAccountOptions("account-1", "2022-03"))
+	assert.Nil(t, err)
+	assert.Len(t, instances.Resources, 1)
+	assert.Nil(t, instances.Next)
+	assert.Nil(t, server.Verify())
+}
+
+func TestUserManagementServer(t *testing.T) {
+	server := platformservicesmock.NewUserManagementServer()
+	defer server.Close()
+	service, err := usermanagementv1.NewUserManagementV1(&usermanagementv1.UserManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	users, _, err := service.ListUsers(service.NewListUsersOptions(platformservicesmock.AccountID))
+	assert.Nil(t, err)
+	assert.Len(t, users.Resources, 1)
+	profile, _, err := service.GetUserProfile(service.NewGetUserProfileOptions(platformservicesmock.AccountID, "IBMid-other"))
+	assert.Nil(t, err)
+	assert.Equal(t, "IBMid-other", *profile.IamID)
+
+	invited, _, err := service.InviteUsers(service.NewInviteUsersOptions(platformservicesmock.AccountID).SetUsers([]usermanagementv1.InviteUser{
+		{Email: core.StringPtr("new.user@example.com"), AccountRole: core.StringPtr("Member")},
+	}))
+	assert.Nil(t, err)
+	assert.Len(t, invited.Resources, 1)
+	settings, _, err := service.GetUserSettings(service.NewGetUserSettingsOptions(platformservicesmock.AccountID, "IBMid-other"))
+	assert.Nil(t, err)
+	assert.NotNil(t, settings.Language)
+	_, err = service.RemoveUser(service.NewRemoveUserOptions(platformservicesmock.AccountID, "IBMid-other"))
+	assert.Nil(t, err)
+	assert.Nil(t, server.Verify())
+}
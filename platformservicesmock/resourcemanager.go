@@ -0,0 +1,95 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ResourceGroup returns the JSON representation of an active resource group with the specified ID and name in the
+// mock account, as returned by the Resource Manager service.
+func ResourceGroup(id string, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                  id,
+		"crn":                 fmt.Sprintf("crn:v1:bluemix:public:resource-controller::a/%s::resource-group:%s", AccountID, id),
+		"account_id":          AccountID,
+		"name":                name,
+		"state":               "ACTIVE",
+		"default":             id == ResourceGroupID,
+		"quota_id":            "mock-quota-id",
+		"quota_url":           "/v2/quota_definitions/mock-quota-id",
+		"payment_methods_url": "/v2/resource_groups/" + id + "/payment_methods",
+		"resource_linkages":   []interface{}{},
+		"teams_url":           "/v2/resource_groups/" + id + "/teams",
+		"created_at":          fixtureTimestamp,
+		"updated_at":          fixtureTimestamp,
+	}
+}
+
+// QuotaDefinition returns the JSON representation of a quota definition with the specified ID and name, as returned
+// by the Resource Manager service.
+func QuotaDefinition(id string, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                          id,
+		"name":                        name,
+		"type":                        "mock",
+		"number_of_apps":              100,
+		"number_of_service_instances": 1000,
+		"default_number_of_instances_per_lite_plan": 1,
+		"instances_per_app":                         100,
+		"instance_memory":                           "2G",
+		"total_app_memory":                          "10G",
+		"vsi_limit":                                 25,
+		"created_at":                                fixtureTimestamp,
+		"updated_at":                                fixtureTimestamp,
+	}
+}
+
+// NewResourceManagerServer returns a new Server with default responses for the operations of the Resource Manager
+// service: the list operations return one fixture, the get operations return a fixture with the requested ID,
+// creating a resource group returns its ID and CRN, and updating and deleting a resource group succeed.
+func NewResourceManagerServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/v2/resource_groups").WithJSON(map[string]interface{}{
+		"resources": []interface{}{ResourceGroup(ResourceGroupID, "Default")},
+	})
+	server.Default("GET", "/v2/resource_groups/{id}").WithBodyFunc(func(request *Request) interface{} {
+		return ResourceGroup(request.PathParams["id"], "Default")
+	})
+	server.Default("POST", "/v2/resource_groups").WithStatus(http.StatusCreated).WithJSON(map[string]interface{}{
+		"id":  "mock-new-resource-group-id",
+		"crn": ResourceGroup("mock-new-resource-group-id", "")["crn"],
+	})
+	server.Default("PATCH", "/v2/resource_groups/{id}").WithBodyFunc(func(request *Request) interface{} {
+		var body map[string]interface{}
+		_ = request.JSON(&body)
+		resourceGroup := ResourceGroup(request.PathParams["id"], "Default")
+		if name, found := body["name"]; found {
+			resourceGroup["name"] = name
+		}
+		return resourceGroup
+	})
+	server.Default("DELETE", "/v2/resource_groups/{id}").WithStatus(http.StatusNoContent)
+	server.Default("GET", "/v2/quota_definitions").WithJSON(map[string]interface{}{
+		"resources": []interface{}{QuotaDefinition("mock-quota-id", "Trial Quota")},
+	})
+	server.Default("GET", "/v2/quota_definitions/{id}").WithBodyFunc(func(request *Request) interface{} {
+		return QuotaDefinition(request.PathParams["id"], "Trial Quota")
+	})
+	return server
+}
@@ -0,0 +1,363 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package platformservicesmock provides in-memory HTTP servers that stand in for the IBM Cloud platform services in
+// the unit tests of applications built with this SDK. Point a service client at a server by setting the URL of its
+// options to Server.URL, and use a core.NoAuthAuthenticator.
+//
+// The package provides a generic Server, which answers each request with a response that was enqueued for it or with
+// a default response, and works with any service. It also provides a preset for each service, whose server comes with
+// canned default responses built from the fixtures of this package: NewAtrackerServer, NewAtrackerV1Server,
+// NewCaseManagementServer, NewCatalogManagementServer, NewConfigurationGovernanceServer,
+// NewContextBasedRestrictionsServer, NewEnterpriseBillingUnitsServer, NewEnterpriseManagementServer,
+// NewEnterpriseUsageReportsServer, NewGlobalCatalogServer, NewGlobalSearchServer, NewGlobalTaggingServer,
+// NewIamAccessGroupsServer, NewIamIdentityServer, NewIamPolicyManagementServer, NewIBMCloudShellServer,
+// NewOpenServiceBrokerServer, NewPostureManagementServer, NewResourceControllerServer, NewResourceManagerServer,
+// NewUsageMeteringServer, NewUsageReportsServer and NewUserManagementServer. The fixtures are valid instances of the
+// models of the service packages; the default responses of a preset can be replaced with Default, and responses for
+// specific requests can be added with Enqueue.
+//
+// The patterns of the presets match the trailing segments of the request path, so the URL of a client can include
+// the base path of its service, except for the Global Catalog service, whose operations have no common path prefix:
+// set the URL of its client to Server.URL.
+package platformservicesmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Request : A request received by a Server.
+type Request struct {
+	// The HTTP method.
+	Method string
+
+	// The path of the request URL.
+	Path string
+
+	// The query parameters of the request URL.
+	Query url.Values
+
+	// The request headers.
+	Header http.Header
+
+	// The request body.
+	Body []byte
+
+	// The values of the path parameters of the pattern of the response that answered the request (for example
+	// PathParams["id"] for the pattern "/v2/resource_instances/{id}"). Empty if no response matched.
+	PathParams map[string]string
+}
+
+// JSON unmarshals the body of the request into "value".
+func (request *Request) JSON(value interface{}) error {
+	return json.Unmarshal(request.Body, value)
+}
+
+// Response : A response that a Server sends to the requests that match its method and path pattern. A response is
+// configured with its With* methods, which return the response so that they can be chained.
+type Response struct {
+	method   string
+	pattern  []string
+	status   int
+	header   http.Header
+	body     []byte
+	bodyFunc func(request *Request) interface{}
+	err      error
+
+	// The number of requests that an enqueued response still answers; ignored for default responses.
+	remaining int
+}
+
+// WithStatus sets the status code of the response. The default is 200.
+func (response *Response) WithStatus(status int) *Response {
+	response.status = status
+	return response
+}
+
+// WithHeader sets a header of the response.
+func (response *Response) WithHeader(name string, value string) *Response {
+	response.header.Set(name, value)
+	return response
+}
+
+// WithJSON sets the body of the response. A []byte or string body is sent as is; any other value is marshalled to
+// JSON. If the value cannot be marshalled, requests matching the response fail with status 500 and the error is
+// returned by Server.Verify.
+func (response *Response) WithJSON(body interface{}) *Response {
+	response.body, response.err = marshalBody(body)
+	response.bodyFunc = nil
+	return response
+}
+
+// WithBodyFunc sets a function that computes the body of the response from the request, for example to echo a path
+// parameter. Its result is handled as by WithJSON.
+func (response *Response) WithBodyFunc(bodyFunc func(request *Request) interface{}) *Response {
+	response.bodyFunc = bodyFunc
+	response.body = nil
+	return response
+}
+
+// WithError makes the response an error with the specified status code, in the format used by the platform services.
+func (response *Response) WithError(status int, message string) *Response {
+	return response.WithStatus(status).WithJSON(map[string]interface{}{
+		"errors": []interface{}{map[string]interface{}{"code": http.StatusText(status), "message": message}},
+		"trace":  "mock",
+	})
+}
+
+// Times sets the number of requests that an enqueued response answers before it is removed. The default is 1.
+func (response *Response) Times(count int) *Response {
+	response.remaining = count
+	return response
+}
+
+// Server : An httptest.Server that answers requests with enqueued and default responses, and records the requests it
+// receives. It is safe for concurrent use.
+//
+// Responses match a request by HTTP method and path pattern. A pattern is a path whose segments may be path
+// parameters enclosed in braces (e.g. "/v2/resource_instances/{id}"); the request path may contain a prefix (e.g. a
+// base path from the service URL) before the segments of the pattern. A request is answered by the first enqueued
+// response that matches it and, if there is none, by the matching default response with the longest pattern.
+// Requests that match no response are answered with status 404 and reported by Verify.
+type Server struct {
+	*httptest.Server
+
+	mutex     sync.Mutex
+	queue     []*Response
+	defaults  []*Response
+	requests  []Request
+	unmatched []Request
+	failures  []string
+}
+
+// NewServer starts and returns a new Server without responses. Close it when the test is done.
+func NewServer() *Server {
+	server := &Server{}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+// Enqueue adds a response that answers the next request matching "method" and "pattern" (or the next requests, see
+// Response.Times), before the default responses.
+func (server *Server) Enqueue(method string, pattern string) *Response {
+	response := newResponse(method, pattern)
+	response.remaining = 1
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.queue = append(server.queue, response)
+	return response
+}
+
+// Default sets the response that answers every request matching "method" and "pattern" for which no response is
+// enqueued, replacing any default response with the same method and pattern.
+func (server *Server) Default(method string, pattern string) *Response {
+	response := newResponse(method, pattern)
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	for i, existing := range server.defaults {
+		if existing.method == response.method && strings.Join(existing.pattern, "/") == strings.Join(response.pattern, "/") {
+			server.defaults[i] = response
+			return response
+		}
+	}
+	server.defaults = append(server.defaults, response)
+	return response
+}
+
+// Requests returns the requests received so far, in order.
+func (server *Server) Requests() []Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]Request(nil), server.requests...)
+}
+
+// RequestsTo returns the requests received so far that match "method" and "pattern", in order.
+func (server *Server) RequestsTo(method string, pattern string) (requests []Request) {
+	patternSegments := splitPath(pattern)
+	for _, request := range server.Requests() {
+		if strings.EqualFold(request.Method, method) && matchPath(patternSegments, splitPath(request.Path)) != nil {
+			requests = append(requests, request)
+		}
+	}
+	return
+}
+
+// Verify returns an error if a request matched no response, an enqueued response was not used, or a response body
+// could not be marshalled.
+func (server *Server) Verify() error {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	var problems []string
+	for _, request := range server.unmatched {
+		problems = append(problems, fmt.Sprintf("unexpected request %s %s", request.Method, request.Path))
+	}
+	for _, response := range server.queue {
+		problems = append(problems, fmt.Sprintf("unused response for %s /%s", response.method, strings.Join(response.pattern, "/")))
+	}
+	problems = append(problems, server.failures...)
+	if len(problems) > 0 {
+		return fmt.Errorf("mock server: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// Reset removes the enqueued responses and the recorded requests. The default responses are kept.
+func (server *Server) Reset() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.queue = nil
+	server.requests = nil
+	server.unmatched = nil
+	server.failures = nil
+}
+
+func (server *Server) serveHTTP(res http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	request := Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query(),
+		Header: req.Header.Clone(),
+		Body:   body,
+	}
+
+	response := server.match(&request)
+	if response == nil {
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(res, `{"errors": [{"code": "not_found", "message": "no mock response for %s %s"}], "trace": "mock"}`,
+			request.Method, request.Path)
+		return
+	}
+
+	responseBody, err := response.body, response.err
+	if response.bodyFunc != nil {
+		responseBody, err = marshalBody(response.bodyFunc(&request))
+	}
+	if err != nil {
+		server.mutex.Lock()
+		server.failures = append(server.failures, err.Error())
+		server.mutex.Unlock()
+		http.Error(res, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for name, values := range response.header {
+		res.Header()[name] = values
+	}
+	if len(responseBody) > 0 && res.Header().Get("Content-Type") == "" {
+		res.Header().Set("Content-Type", "application/json")
+	}
+	res.WriteHeader(response.status)
+	res.Write(responseBody)
+}
+
+// match records the request and returns the response that answers it, or nil.
+func (server *Server) match(request *Request) *Response {
+	pathSegments := splitPath(request.Path)
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.requests = append(server.requests, *request)
+
+	for i, response := range server.queue {
+		if params := response.match(request.Method, pathSegments); params != nil {
+			response.remaining--
+			if response.remaining <= 0 {
+				server.queue = append(server.queue[:i:i], server.queue[i+1:]...)
+			}
+			request.PathParams = params
+			server.requests[len(server.requests)-1].PathParams = params
+			return response
+		}
+	}
+
+	var result *Response
+	var resultParams map[string]string
+	for _, response := range server.defaults {
+		if params := response.match(request.Method, pathSegments); params != nil {
+			if result == nil || len(response.pattern) > len(result.pattern) {
+				result, resultParams = response, params
+			}
+		}
+	}
+	if result == nil {
+		server.unmatched = append(server.unmatched, *request)
+		return nil
+	}
+	request.PathParams = resultParams
+	server.requests[len(server.requests)-1].PathParams = resultParams
+	return result
+}
+
+func newResponse(method string, pattern string) *Response {
+	return &Response{
+		method:  strings.ToUpper(method),
+		pattern: splitPath(pattern),
+		status:  http.StatusOK,
+		header:  http.Header{},
+	}
+}
+
+// match returns the path parameters of the request if it matches the response, or nil.
+func (response *Response) match(method string, pathSegments []string) map[string]string {
+	if !strings.EqualFold(response.method, method) {
+		return nil
+	}
+	return matchPath(response.pattern, pathSegments)
+}
+
+// matchPath returns the path parameters of the pattern if the trailing segments of a request path match it, or nil.
+func matchPath(patternSegments []string, pathSegments []string) map[string]string {
+	if len(patternSegments) > len(pathSegments) {
+		return nil
+	}
+	pathSegments = pathSegments[len(pathSegments)-len(patternSegments):]
+	params := map[string]string{}
+	for i, segment := range patternSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[strings.Trim(segment, "{}")] = pathSegments[i]
+		} else if segment != pathSegments[i] {
+			return nil
+		}
+	}
+	return params
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func marshalBody(body interface{}) ([]byte, error) {
+	switch value := body.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return value, nil
+	case string:
+		return []byte(value), nil
+	}
+	marshalled, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling mock response body: %w", err)
+	}
+	return marshalled, nil
+}
@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/platformservicesmock"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerResponses(t *testing.T) {
+	server := platformservicesmock.NewServer()
+	defer server.Close()
+	server.Default("GET", "/v1/things/{id}").WithBodyFunc(func(request *platformservicesmock.Request) interface{} {
+		return map[string]interface{}{"id": request.PathParams["id"]}
+	})
+	server.Default("GET", "/v1/things/{id}/parts").WithJSON(`[]`)
+	server.Enqueue("GET", "/v1/things/{id}").WithError(http.StatusServiceUnavailable, "try again").Times(2)
+
+	get := func(method string, path string, body string) (int, string) {
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		assert.Nil(t, err)
+		res, err := http.DefaultClient.Do(req)
+		assert.Nil(t, err)
+		defer res.Body.Close()
+		responseBody, err := ioutil.ReadAll(res.Body)
+		assert.Nil(t, err)
+		return res.StatusCode, string(responseBody)
+	}
+
+	status, body := get("GET", "/api/v1/things/a", "")
+	assert.Equal(t, 503, status)
+	assert.Contains(t, body, "try again")
+	status, _ = get("GET", "/api/v1/things/b", "")
+	assert.Equal(t, 503, status)
+	status, body = get("GET", "/api/v1/things/c", "")
+	assert.Equal(t, 200, status)
+	assert.JSONEq(t, `{"id": "c"}`, body)
+	status, body = get("GET", "/api/v1/things/c/parts", "")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "[]", body)
+	assert.Nil(t, server.Verify())
+
+	server.Enqueue("POST", "/v1/things").WithStatus(http.StatusCreated).WithHeader("Location", "/v1/things/new")
+	status, _ = get("DELETE", "/api/v1/things/c", "")
+	assert.Equal(t, 404, status)
+	err := server.Verify()
+	assert.Equal(t, "mock server: unexpected request DELETE /api/v1/things/c; unused response for POST /v1/things", err.Error())
+
+	status, _ = get("POST", "/v1/things", `{"name": "new"}`)
+	assert.Equal(t, 201, status)
+	posts := server.RequestsTo("POST", "/v1/things")
+	assert.Len(t, posts, 1)
+	var posted map[string]interface{}
+	assert.Nil(t, posts[0].JSON(&posted))
+	assert.Equal(t, "new", posted["name"])
+	assert.Len(t, server.RequestsTo("GET", "/v1/things/{id}"), 3)
+	assert.Equal(t, "c", server.Requests()[2].PathParams["id"])
+
+	server.Reset()
+	assert.Empty(t, server.Requests())
+	assert.Nil(t, server.Verify())
+	status, _ = get("GET", "/v1/things/d", "")
+	assert.Equal(t, 200, status)
+
+	server.Enqueue("GET", "/v1/things/{id}").WithJSON(map[string]interface{}{"invalid": func() {}})
+	status, _ = get("GET", "/v1/things/e", "")
+	assert.Equal(t, 500, status)
+	assert.Contains(t, server.Verify().Error(), "error marshalling mock response body")
+}
+
+func TestResourceControllerServer(t *testing.T) {
+	server := platformservicesmock.NewResourceControllerServer()
+	defer server.Close()
+	service, err := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	validator, err := service.EnableResponseValidation()
+	assert.Nil(t, err)
+
+	instances, _, err := service.ListResourceInstances(service.NewListResourceInstancesOptions())
+	assert.Nil(t, err)
+	assert.Len(t, instances.Resources, 1)
+	assert.Equal(t, platformservicesmock.AccountID, *instances.Resources[0].AccountID)
+
+	instance, _, err := service.GetResourceInstance(service.NewGetResourceInstanceOptions("instance-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "instance-1", *instance.ID)
+
+	instance, _, err = service.CreateResourceInstance(service.NewCreateResourceInstanceOptions("db", "eu-de", "rg-1", "plan-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "db", *instance.Name)
+	assert.Equal(t, "eu-de", *instance.RegionID)
+	assert.Equal(t, "rg-1", *instance.ResourceGroupID)
+
+	key, _, err := service.CreateResourceKey(service.NewCreateResourceKeyOptions("writer", *instance.CRN))
+	assert.Nil(t, err)
+	assert.Equal(t, *instance.CRN, *key.SourceCRN)
+	keys, _, err := service.ListResourceKeys(service.NewListResourceKeysOptions())
+	assert.Nil(t, err)
+	assert.Len(t, keys.Resources, 1)
+	_, err = service.DeleteResourceInstance(service.NewDeleteResourceInstanceOptions("instance-1"))
+	assert.Nil(t, err)
+
+	assert.Empty(t, validator.Violations())
+	assert.Nil(t, server.Verify())
+	assert.Len(t, server.Requests(), 6)
+}
+
+func TestCaseManagementServer(t *testing.T) {
+	server := platformservicesmock.NewCaseManagementServer()
+	defer server.Close()
+	service, err := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+		URL:           server.URL + "/case-management/v1",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	cases, _, err := service.GetCases(service.NewGetCasesOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), *cases.TotalCount)
+
+	result, _, err := service.GetCase(service.NewGetCaseOptions("CS1234567"))
+	assert.Nil(t, err)
+	assert.Equal(t, "CS1234567", *result.Number)
+	assert.Equal(t, "IBMid", *result.CreatedBy.Realm)
+
+	createCaseOptions := service.NewCreateCaseOptions("technical", "Outage", "Nothing works").SetSeverity(1)
+	result, _, err = service.CreateCase(createCaseOptions)
+	assert.Nil(t, err)
+	assert.Equal(t, "Outage", *result.ShortDescription)
+	assert.Equal(t, float64(1), *result.Severity)
+
+	comment, _, err := service.AddComment(service.NewAddCommentOptions("CS1234567", "Any update?"))
+	assert.Nil(t, err)
+	assert.Equal(t, "Any update?", *comment.Value)
+	assert.Nil(t, server.Verify())
+}
+
+func TestIamPolicyManagementServer(t *testing.T) {
+	server := platformservicesmock.NewIamPolicyManagementServer()
+	defer server.Close()
+	service, err := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	policies, _, err := service.ListPolicies(service.NewListPoliciesOptions(platformservicesmock.AccountID))
+	assert.Nil(t, err)
+	assert.Len(t, policies.Policies, 1)
+	assert.Equal(t, "Viewer", *policies.Policies[0].Roles[0].DisplayName)
+	assert.Equal(t, []string{platformservicesmock.AccountID}, server.RequestsTo("GET", "/v1/policies")[0].Query["account_id"])
+
+	policy, response, err := service.GetPolicy(service.NewGetPolicyOptions("policy-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "policy-1", *policy.ID)
+	assert.Equal(t, `W/"mock-etag"`, response.Headers.Get("ETag"))
+
+	subject := iampolicymanagementv1.PolicySubject{Attributes: []iampolicymanagementv1.SubjectAttribute{
+		{Name: core.StringPtr("iam_id"), Value: core.StringPtr("IBMid-other")},
+	}}
+	role := iampolicymanagementv1.PolicyRole{RoleID: core.StringPtr("crn:v1:bluemix:public:iam::::role:Editor")}
+	resource := iampolicymanagementv1.PolicyResource{Attributes: []iampolicymanagementv1.ResourceAttribute{
+		{Name: core.StringPtr("accountId"), Value: core.StringPtr(platformservicesmock.AccountID)},
+	}}
+	policy, _, err = service.CreatePolicy(service.NewCreatePolicyOptions("access",
+		[]iampolicymanagementv1.PolicySubject{subject}, []iampolicymanagementv1.PolicyRole{role}, []iampolicymanagementv1.PolicyResource{resource}))
+	assert.Nil(t, err)
+	assert.Equal(t, "IBMid-other", *policy.Subjects[0].Attributes[0].Value)
+	assert.Equal(t, "crn:v1:bluemix:public:iam::::role:Editor", *policy.Roles[0].RoleID)
+	assert.Nil(t, server.Verify())
+}
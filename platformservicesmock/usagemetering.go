@@ -0,0 +1,41 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// NewUsageMeteringServer returns a new Server with a default response for the operation of the Usage Metering
+// service: reporting usage accepts each record of the request.
+func NewUsageMeteringServer() *Server {
+	server := NewServer()
+	server.Default("POST", "/v4/metering/resources/{resource_id}/usage").WithStatus(http.StatusAccepted).WithBodyFunc(func(request *Request) interface{} {
+		var records []interface{}
+		_ = request.JSON(&records)
+		resources := make([]interface{}, len(records))
+		for i := range records {
+			resources[i] = map[string]interface{}{
+				"status":   http.StatusCreated,
+				"location": fmt.Sprintf("/v1/metering/resources/%s/usage/mock-usage-%d", request.PathParams["resource_id"], i),
+			}
+		}
+		return map[string]interface{}{"resources": resources}
+	})
+	return server
+}
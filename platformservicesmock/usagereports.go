@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+// UsageResource returns the JSON representation of the usage of the mock service for a month, with one plan and one
+// metric of the specified billable cost, as returned by the Usage Reports service.
+func UsageResource(billableCost float64) map[string]interface{} {
+	return map[string]interface{}{
+		"resource_id":             "mock-service-id",
+		"resource_name":           "Mock Service",
+		"billable_cost":           billableCost,
+		"billable_rated_cost":     billableCost,
+		"non_billable_cost":       0,
+		"non_billable_rated_cost": 0,
+		"plans": []interface{}{map[string]interface{}{
+			"plan_id":        "mock-plan-id",
+			"plan_name":      "Standard",
+			"pricing_region": "Global",
+			"billable":       true,
+			"cost":           billableCost,
+			"rated_cost":     billableCost,
+			"usage":          []interface{}{usageMetric(billableCost)},
+			"discounts":      []interface{}{},
+		}},
+		"discounts": []interface{}{},
+	}
+}
+
+// AccountUsage returns the JSON representation of the usage of the mock account for the specified month ("yyyy-mm"),
+// with one resource of the specified billable cost, as returned by the Usage Reports service.
+func AccountUsage(month string, billableCost float64) map[string]interface{} {
+	return map[string]interface{}{
+		"account_id":      AccountID,
+		"pricing_country": "USA",
+		"currency_code":   "USD",
+		"month":           month,
+		"resources":       []interface{}{UsageResource(billableCost)},
+	}
+}
+
+// AccountSummary returns the JSON representation of the summary of the mock account for the specified month
+// ("yyyy-mm"), with the specified billable cost and no offers, as returned by the Usage Reports service.
+func AccountSummary(month string, billableCost float64) map[string]interface{} {
+	return map[string]interface{}{
+		"account_id":            AccountID,
+		"billing_month":         month,
+		"billing_country_code":  "USA",
+		"billing_currency_code": "USD",
+		"resources":             map[string]interface{}{"billable_cost": billableCost, "non_billable_cost": 0},
+		"offers":                []interface{}{},
+		"support":               []interface{}{map[string]interface{}{"cost": 0, "type": "BASIC", "overage": 0}},
+		"subscription":          map[string]interface{}{"overage": 0, "subscriptions": []interface{}{}},
+	}
+}
+
+// InstanceUsage returns the JSON representation of the usage of the resource instance with the specified name (see
+// ResourceInstance) for the specified month ("yyyy-mm"), with one metric of the specified billable cost, as returned
+// by the Usage Reports service.
+func InstanceUsage(name string, month string, billableCost float64) map[string]interface{} {
+	return map[string]interface{}{
+		"account_id":             AccountID,
+		"resource_instance_id":   ResourceInstance(name)["crn"],
+		"resource_instance_name": name,
+		"resource_id":            "mock-service-id",
+		"resource_name":          "Mock Service",
+		"resource_group_id":      ResourceGroupID,
+		"resource_group_name":    "Default",
+		"region":                 "us-south",
+		"pricing_region":         "Global",
+		"pricing_country":        "USA",
+		"currency_code":          "USD",
+		"billable":               true,
+		"plan_id":                "mock-plan-id",
+		"plan_name":              "Standard",
+		"month":                  month,
+		"usage":                  []interface{}{usageMetric(billableCost)},
+	}
+}
+
+// NewUsageReportsServer returns a new Server with default responses for the operations of the Usage Reports
+// service: the summary and usage operations return fixtures with a billable cost of 100 for the requested account,
+// month and (for the usage of a resource group or an organization) resource group or organization, and the resource
+// instance usage operations return one fixture.
+func NewUsageReportsServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/v4/accounts/{account_id}/summary/{billingmonth}").WithBodyFunc(func(request *Request) interface{} {
+		summary := AccountSummary(request.PathParams["billingmonth"], 100)
+		summary["account_id"] = request.PathParams["account_id"]
+		return summary
+	})
+	server.Default("GET", "/v4/accounts/{account_id}/usage/{billingmonth}").WithBodyFunc(func(request *Request) interface{} {
+		usage := AccountUsage(request.PathParams["billingmonth"], 100)
+		usage["account_id"] = request.PathParams["account_id"]
+		return usage
+	})
+	server.Default("GET", "/v4/accounts/{account_id}/resource_groups/{resource_group_id}/usage/{billingmonth}").WithBodyFunc(func(request *Request) interface{} {
+		usage := AccountUsage(request.PathParams["billingmonth"], 100)
+		usage["account_id"] = request.PathParams["account_id"]
+		usage["resource_group_id"] = request.PathParams["resource_group_id"]
+		usage["resource_group_name"] = "Default"
+		return usage
+	})
+	server.Default("GET", "/v4/accounts/{account_id}/organizations/{organization_id}/usage/{billingmonth}").WithBodyFunc(func(request *Request) interface{} {
+		usage := AccountUsage(request.PathParams["billingmonth"], 100)
+		usage["account_id"] = request.PathParams["account_id"]
+		usage["organization_id"] = request.PathParams["organization_id"]
+		usage["organization_name"] = "mock-org"
+		return usage
+	})
+	instancesUsage := func(request *Request) interface{} {
+		usage := InstanceUsage("mock-instance", request.PathParams["billingmonth"], 100)
+		usage["account_id"] = request.PathParams["account_id"]
+		return map[string]interface{}{
+			"limit":     30,
+			"count":     1,
+			"first":     map[string]interface{}{"href": request.Path},
+			"resources": []interface{}{usage},
+		}
+	}
+	for _, pattern := range []string{
+		"/v4/accounts/{account_id}/resource_instances/usage/{billingmonth}",
+		"/v4/accounts/{account_id}/resource_groups/{resource_group_id}/resource_instances/usage/{billingmonth}",
+		"/v4/accounts/{account_id}/organizations/{organization_id}/resource_instances/usage/{billingmonth}",
+	} {
+		server.Default("GET", pattern).WithBodyFunc(instancesUsage)
+	}
+	return server
+}
+
+func usageMetric(cost float64) map[string]interface{} {
+	return map[string]interface{}{
+		"metric":            "INSTANCE_HOURS",
+		"metric_name":       "Instance hours",
+		"quantity":          720,
+		"rateable_quantity": 720,
+		"cost":              cost,
+		"rated_cost":        cost,
+		"unit":              "HOURS",
+		"unit_name":         "Hours",
+		"discounts":         []interface{}{},
+	}
+}
@@ -0,0 +1,80 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformservicesmock
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// UserProfile returns the JSON representation of the active profile of the user with the specified IAM ID in the
+// mock account, as returned by the User Management service.
+func UserProfile(iamID string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          "mock-user-id-" + iamID,
+		"iam_id":      iamID,
+		"realm":       "IBMid",
+		"user_id":     "mock.user@example.com",
+		"firstname":   "Mock",
+		"lastname":    "User",
+		"state":       "ACTIVE",
+		"email":       "mock.user@example.com",
+		"phonenumber": "0000000000",
+		"account_id":  AccountID,
+	}
+}
+
+// NewUserManagementServer returns a new Server with default responses for the operations of the User Management
+// service: listing the users returns one fixture, getting a user profile returns a fixture with the requested IAM ID,
+// getting the settings of a user returns default settings, inviting users returns each user of the request as
+// pending, and the update and remove operations succeed.
+func NewUserManagementServer() *Server {
+	server := NewServer()
+	server.Default("GET", "/v2/accounts/{account_id}/users").WithJSON(map[string]interface{}{
+		"total_results": 1,
+		"limit":         100,
+		"resources":     []interface{}{UserProfile(IamID)},
+	})
+	server.Default("GET", "/v2/accounts/{account_id}/users/{iam_id}").WithBodyFunc(func(request *Request) interface{} {
+		profile := UserProfile(request.PathParams["iam_id"])
+		profile["account_id"] = request.PathParams["account_id"]
+		return profile
+	})
+	server.Default("POST", "/v2/accounts/{account_id}/users").WithStatus(http.StatusAccepted).WithBodyFunc(func(request *Request) interface{} {
+		var body struct {
+			Users []struct {
+				Email string `json:"email"`
+			} `json:"users"`
+		}
+		_ = request.JSON(&body)
+		users := make([]interface{}, len(body.Users))
+		for i, user := range body.Users {
+			users[i] = map[string]interface{}{"email": user.Email, "id": fmt.Sprintf("mock-invited-user-id-%d", i), "state": "PROCESSING"}
+		}
+		return map[string]interface{}{"resources": users}
+	})
+	server.Default("PATCH", "/v2/accounts/{account_id}/users/{iam_id}").WithStatus(http.StatusNoContent)
+	server.Default("DELETE", "/v2/accounts/{account_id}/users/{iam_id}").WithStatus(http.StatusNoContent)
+	server.Default("GET", "/v2/accounts/{account_id}/users/{iam_id}/settings").WithJSON(map[string]interface{}{
+		"language":              "en-us",
+		"notification_language": "en-us",
+		"allowed_ip_addresses":  "",
+		"self_manage":           false,
+	})
+	server.Default("PATCH", "/v2/accounts/{account_id}/users/{iam_id}/settings").WithStatus(http.StatusNoContent)
+	return server
+}
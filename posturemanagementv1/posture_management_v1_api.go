@@ -0,0 +1,109 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package posturemanagementv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// PostureManagementV1API : The operations of the PostureManagementV1 service, implemented by *PostureManagementV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type PostureManagementV1API interface {
+	// Clone makes a copy of "postureManagement" suitable for processing requests.
+	Clone() *PostureManagementV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// CreateValidation : Initiate a validations scan
+	CreateValidation(createValidationOptions *CreateValidationOptions) (result *Result, response *core.DetailedResponse, err error)
+
+	// CreateValidationWithContext is an alternate form of the CreateValidation method which supports a Context
+	// parameter
+	CreateValidationWithContext(ctx context.Context, createValidationOptions *CreateValidationOptions) (result *Result, response *core.DetailedResponse, err error)
+
+	// ListProfiles : List profiles
+	ListProfiles(listProfilesOptions *ListProfilesOptions) (result *ProfilesList, response *core.DetailedResponse, err error)
+
+	// ListProfilesWithContext is an alternate form of the ListProfiles method which supports a Context parameter
+	ListProfilesWithContext(ctx context.Context, listProfilesOptions *ListProfilesOptions) (result *ProfilesList, response *core.DetailedResponse, err error)
+
+	// ListScopes : List scopes
+	ListScopes(listScopesOptions *ListScopesOptions) (result *ScopesList, response *core.DetailedResponse, err error)
+
+	// ListScopesWithContext is an alternate form of the ListScopes method which supports a Context parameter
+	ListScopesWithContext(ctx context.Context, listScopesOptions *ListScopesOptions) (result *ScopesList, response *core.DetailedResponse, err error)
+
+	// NewCreateValidationOptions : Instantiate CreateValidationOptions
+	NewCreateValidationOptions(accountID string) *CreateValidationOptions
+
+	// NewListProfilesOptions : Instantiate ListProfilesOptions
+	NewListProfilesOptions(accountID string) *ListProfilesOptions
+
+	// NewListScopesOptions : Instantiate ListScopesOptions
+	NewListScopesOptions(accountID string) *ListScopesOptions
+
+	// NewCreateValidationOptionsWith returns CreateValidationOptions populated by applying "options" in order.
+	NewCreateValidationOptionsWith(options ...CreateValidationOption) *CreateValidationOptions
+
+	// CreateValidationWith is an alternate form of the CreateValidationWithContext method which takes functional
+	// options instead of a CreateValidationOptions struct.
+	CreateValidationWith(ctx context.Context, options ...CreateValidationOption) (result *Result, response *core.DetailedResponse, err error)
+
+	// NewListProfilesOptionsWith returns ListProfilesOptions populated by applying "options" in order.
+	NewListProfilesOptionsWith(options ...ListProfilesOption) *ListProfilesOptions
+
+	// ListProfilesWith is an alternate form of the ListProfilesWithContext method which takes functional options
+	// instead of a ListProfilesOptions struct.
+	ListProfilesWith(ctx context.Context, options ...ListProfilesOption) (result *ProfilesList, response *core.DetailedResponse, err error)
+
+	// NewListScopesOptionsWith returns ListScopesOptions populated by applying "options" in order.
+	NewListScopesOptionsWith(options ...ListScopesOption) *ListScopesOptions
+
+	// ListScopesWith is an alternate form of the ListScopesWithContext method which takes functional options instead
+	// of a ListScopesOptions struct.
+	ListScopesWith(ctx context.Context, options ...ListScopesOption) (result *ScopesList, response *core.DetailedResponse, err error)
+}
+
+var _ PostureManagementV1API = (*PostureManagementV1)(nil)
@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package posturemanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CreateValidationOption : A functional option that sets a property of CreateValidationOptions (see CreateValidationWith).
+type CreateValidationOption func(options *CreateValidationOptions)
+
+// CreateValidationAccountID sets the AccountID property of CreateValidationOptions. Your IBM Cloud account ID.
+func CreateValidationAccountID(accountID string) CreateValidationOption {
+	return func(options *CreateValidationOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// CreateValidationScopeID sets the ScopeID property of CreateValidationOptions. The unique ID of the scope.
+func CreateValidationScopeID(scopeID string) CreateValidationOption {
+	return func(options *CreateValidationOptions) {
+		options.ScopeID = core.StringPtr(scopeID)
+	}
+}
+
+// CreateValidationProfileID sets the ProfileID property of CreateValidationOptions. The unique ID of the profile.
+func CreateValidationProfileID(profileID string) CreateValidationOption {
+	return func(options *CreateValidationOptions) {
+		options.ProfileID = core.StringPtr(profileID)
+	}
+}
+
+// CreateValidationGroupProfileID sets the GroupProfileID property of CreateValidationOptions. The ID of the
+// profile group.
+func CreateValidationGroupProfileID(groupProfileID string) CreateValidationOption {
+	return func(options *CreateValidationOptions) {
+		options.GroupProfileID = core.StringPtr(groupProfileID)
+	}
+}
+
+// CreateValidationHeader sets the Headers property of CreateValidationOptions. Allows users to set headers on API
+// requests.
+func CreateValidationHeader(name string, value string) CreateValidationOption {
+	return func(options *CreateValidationOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateValidationOptionsWith returns CreateValidationOptions populated by applying "options" in order.
+func (*PostureManagementV1) NewCreateValidationOptionsWith(options ...CreateValidationOption) *CreateValidationOptions {
+	createValidationOptions := &CreateValidationOptions{}
+	for _, option := range options {
+		option(createValidationOptions)
+	}
+	return createValidationOptions
+}
+
+// CreateValidationWith is an alternate form of the CreateValidationWithContext method which takes functional
+// options instead of a CreateValidationOptions struct. CreateValidationAccountID is required.
+func (postureManagement *PostureManagementV1) CreateValidationWith(ctx context.Context, options ...CreateValidationOption) (result *Result, response *core.DetailedResponse, err error) {
+	return postureManagement.CreateValidationWithContext(ctx, postureManagement.NewCreateValidationOptionsWith(options...))
+}
+
+// ListProfilesOption : A functional option that sets a property of ListProfilesOptions (see ListProfilesWith).
+type ListProfilesOption func(options *ListProfilesOptions)
+
+// ListProfilesAccountID sets the AccountID property of ListProfilesOptions. Your account ID.
+func ListProfilesAccountID(accountID string) ListProfilesOption {
+	return func(options *ListProfilesOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListProfilesName sets the Name property of ListProfilesOptions. The name of the profile.
+func ListProfilesName(nameVar string) ListProfilesOption {
+	return func(options *ListProfilesOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListProfilesHeader sets the Headers property of ListProfilesOptions. Allows users to set headers on API
+// requests.
+func ListProfilesHeader(name string, value string) ListProfilesOption {
+	return func(options *ListProfilesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListProfilesOptionsWith returns ListProfilesOptions populated by applying "options" in order.
+func (*PostureManagementV1) NewListProfilesOptionsWith(options ...ListProfilesOption) *ListProfilesOptions {
+	listProfilesOptions := &ListProfilesOptions{}
+	for _, option := range options {
+		option(listProfilesOptions)
+	}
+	return listProfilesOptions
+}
+
+// ListProfilesWith is an alternate form of the ListProfilesWithContext method which takes functional options
+// instead of a ListProfilesOptions struct. ListProfilesAccountID is required.
+func (postureManagement *PostureManagementV1) ListProfilesWith(ctx context.Context, options ...ListProfilesOption) (result *ProfilesList, response *core.DetailedResponse, err error) {
+	return postureManagement.ListProfilesWithContext(ctx, postureManagement.NewListProfilesOptionsWith(options...))
+}
+
+// ListScopesOption : A functional option that sets a property of ListScopesOptions (see ListScopesWith).
+type ListScopesOption func(options *ListScopesOptions)
+
+// ListScopesAccountID sets the AccountID property of ListScopesOptions. Your IBM Cloud account ID.
+func ListScopesAccountID(accountID string) ListScopesOption {
+	return func(options *ListScopesOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListScopesName sets the Name property of ListScopesOptions. The name of the scope.
+func ListScopesName(nameVar string) ListScopesOption {
+	return func(options *ListScopesOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListScopesHeader sets the Headers property of ListScopesOptions. Allows users to set headers on API requests.
+func ListScopesHeader(name string, value string) ListScopesOption {
+	return func(options *ListScopesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListScopesOptionsWith returns ListScopesOptions populated by applying "options" in order.
+func (*PostureManagementV1) NewListScopesOptionsWith(options ...ListScopesOption) *ListScopesOptions {
+	listScopesOptions := &ListScopesOptions{}
+	for _, option := range options {
+		option(listScopesOptions)
+	}
+	return listScopesOptions
+}
+
+// ListScopesWith is an alternate form of the ListScopesWithContext method which takes functional options instead
+// of a ListScopesOptions struct. ListScopesAccountID is required.
+func (postureManagement *PostureManagementV1) ListScopesWith(ctx context.Context, options ...ListScopesOption) (result *ScopesList, response *core.DetailedResponse, err error) {
+	return postureManagement.ListScopesWithContext(ctx, postureManagement.NewListScopesOptionsWith(options...))
+}
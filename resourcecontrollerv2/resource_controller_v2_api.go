@@ -0,0 +1,604 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// ResourceControllerV2API : The operations of the ResourceControllerV2 service, implemented by *ResourceControllerV2.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type ResourceControllerV2API interface {
+	// Clone makes a copy of "resourceController" suitable for processing requests.
+	Clone() *ResourceControllerV2
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// ListResourceInstances : Get a list of all resource instances
+	ListResourceInstances(listResourceInstancesOptions *ListResourceInstancesOptions) (result *ResourceInstancesList, response *core.DetailedResponse, err error)
+
+	// ListResourceInstancesWithContext is an alternate form of the ListResourceInstances method which supports a
+	// Context parameter
+	ListResourceInstancesWithContext(ctx context.Context, listResourceInstancesOptions *ListResourceInstancesOptions) (result *ResourceInstancesList, response *core.DetailedResponse, err error)
+
+	// CreateResourceInstance : Create (provision) a new resource instance
+	CreateResourceInstance(createResourceInstanceOptions *CreateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// CreateResourceInstanceWithContext is an alternate form of the CreateResourceInstance method which supports a
+	// Context parameter
+	CreateResourceInstanceWithContext(ctx context.Context, createResourceInstanceOptions *CreateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// GetResourceInstance : Get a resource instance
+	GetResourceInstance(getResourceInstanceOptions *GetResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// GetResourceInstanceWithContext is an alternate form of the GetResourceInstance method which supports a Context
+	// parameter
+	GetResourceInstanceWithContext(ctx context.Context, getResourceInstanceOptions *GetResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// DeleteResourceInstance : Delete a resource instance
+	DeleteResourceInstance(deleteResourceInstanceOptions *DeleteResourceInstanceOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteResourceInstanceWithContext is an alternate form of the DeleteResourceInstance method which supports a
+	// Context parameter
+	DeleteResourceInstanceWithContext(ctx context.Context, deleteResourceInstanceOptions *DeleteResourceInstanceOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateResourceInstance : Update a resource instance
+	UpdateResourceInstance(updateResourceInstanceOptions *UpdateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// UpdateResourceInstanceWithContext is an alternate form of the UpdateResourceInstance method which supports a
+	// Context parameter
+	UpdateResourceInstanceWithContext(ctx context.Context, updateResourceInstanceOptions *UpdateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// ListResourceAliasesForInstance : Get a list of all resource aliases for the instance
+	ListResourceAliasesForInstance(listResourceAliasesForInstanceOptions *ListResourceAliasesForInstanceOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+
+	// ListResourceAliasesForInstanceWithContext is an alternate form of the ListResourceAliasesForInstance method
+	// which supports a Context parameter
+	ListResourceAliasesForInstanceWithContext(ctx context.Context, listResourceAliasesForInstanceOptions *ListResourceAliasesForInstanceOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+
+	// ListResourceKeysForInstance : Get a list of all the resource keys for the instance
+	ListResourceKeysForInstance(listResourceKeysForInstanceOptions *ListResourceKeysForInstanceOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+
+	// ListResourceKeysForInstanceWithContext is an alternate form of the ListResourceKeysForInstance method which
+	// supports a Context parameter
+	ListResourceKeysForInstanceWithContext(ctx context.Context, listResourceKeysForInstanceOptions *ListResourceKeysForInstanceOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+
+	// LockResourceInstance : Lock a resource instance
+	LockResourceInstance(lockResourceInstanceOptions *LockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// LockResourceInstanceWithContext is an alternate form of the LockResourceInstance method which supports a Context
+	// parameter
+	LockResourceInstanceWithContext(ctx context.Context, lockResourceInstanceOptions *LockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// UnlockResourceInstance : Unlock a resource instance
+	UnlockResourceInstance(unlockResourceInstanceOptions *UnlockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// UnlockResourceInstanceWithContext is an alternate form of the UnlockResourceInstance method which supports a
+	// Context parameter
+	UnlockResourceInstanceWithContext(ctx context.Context, unlockResourceInstanceOptions *UnlockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// CancelLastopResourceInstance : Cancel the in progress last operation of the resource instance
+	CancelLastopResourceInstance(cancelLastopResourceInstanceOptions *CancelLastopResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// CancelLastopResourceInstanceWithContext is an alternate form of the CancelLastopResourceInstance method which
+	// supports a Context parameter
+	CancelLastopResourceInstanceWithContext(ctx context.Context, cancelLastopResourceInstanceOptions *CancelLastopResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// ListResourceKeys : Get a list of all of the resource keys
+	ListResourceKeys(listResourceKeysOptions *ListResourceKeysOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+
+	// ListResourceKeysWithContext is an alternate form of the ListResourceKeys method which supports a Context
+	// parameter
+	ListResourceKeysWithContext(ctx context.Context, listResourceKeysOptions *ListResourceKeysOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+
+	// CreateResourceKey : Create a new resource key
+	CreateResourceKey(createResourceKeyOptions *CreateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// CreateResourceKeyWithContext is an alternate form of the CreateResourceKey method which supports a Context
+	// parameter
+	CreateResourceKeyWithContext(ctx context.Context, createResourceKeyOptions *CreateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// GetResourceKey : Get resource key by ID
+	GetResourceKey(getResourceKeyOptions *GetResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// GetResourceKeyWithContext is an alternate form of the GetResourceKey method which supports a Context parameter
+	GetResourceKeyWithContext(ctx context.Context, getResourceKeyOptions *GetResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// DeleteResourceKey : Delete a resource key by ID
+	DeleteResourceKey(deleteResourceKeyOptions *DeleteResourceKeyOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteResourceKeyWithContext is an alternate form of the DeleteResourceKey method which supports a Context
+	// parameter
+	DeleteResourceKeyWithContext(ctx context.Context, deleteResourceKeyOptions *DeleteResourceKeyOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateResourceKey : Update a resource key
+	UpdateResourceKey(updateResourceKeyOptions *UpdateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// UpdateResourceKeyWithContext is an alternate form of the UpdateResourceKey method which supports a Context
+	// parameter
+	UpdateResourceKeyWithContext(ctx context.Context, updateResourceKeyOptions *UpdateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// ListResourceBindings : Get a list of all resource bindings
+	ListResourceBindings(listResourceBindingsOptions *ListResourceBindingsOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+
+	// ListResourceBindingsWithContext is an alternate form of the ListResourceBindings method which supports a Context
+	// parameter
+	ListResourceBindingsWithContext(ctx context.Context, listResourceBindingsOptions *ListResourceBindingsOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+
+	// CreateResourceBinding : Create a new resource binding
+	CreateResourceBinding(createResourceBindingOptions *CreateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// CreateResourceBindingWithContext is an alternate form of the CreateResourceBinding method which supports a
+	// Context parameter
+	CreateResourceBindingWithContext(ctx context.Context, createResourceBindingOptions *CreateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// GetResourceBinding : Get a resource binding
+	GetResourceBinding(getResourceBindingOptions *GetResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// GetResourceBindingWithContext is an alternate form of the GetResourceBinding method which supports a Context
+	// parameter
+	GetResourceBindingWithContext(ctx context.Context, getResourceBindingOptions *GetResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// DeleteResourceBinding : Delete a resource binding
+	DeleteResourceBinding(deleteResourceBindingOptions *DeleteResourceBindingOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteResourceBindingWithContext is an alternate form of the DeleteResourceBinding method which supports a
+	// Context parameter
+	DeleteResourceBindingWithContext(ctx context.Context, deleteResourceBindingOptions *DeleteResourceBindingOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateResourceBinding : Update a resource binding
+	UpdateResourceBinding(updateResourceBindingOptions *UpdateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// UpdateResourceBindingWithContext is an alternate form of the UpdateResourceBinding method which supports a
+	// Context parameter
+	UpdateResourceBindingWithContext(ctx context.Context, updateResourceBindingOptions *UpdateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// ListResourceAliases : Get a list of all resource aliases
+	ListResourceAliases(listResourceAliasesOptions *ListResourceAliasesOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+
+	// ListResourceAliasesWithContext is an alternate form of the ListResourceAliases method which supports a Context
+	// parameter
+	ListResourceAliasesWithContext(ctx context.Context, listResourceAliasesOptions *ListResourceAliasesOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+
+	// CreateResourceAlias : Create a new resource alias
+	CreateResourceAlias(createResourceAliasOptions *CreateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// CreateResourceAliasWithContext is an alternate form of the CreateResourceAlias method which supports a Context
+	// parameter
+	CreateResourceAliasWithContext(ctx context.Context, createResourceAliasOptions *CreateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// GetResourceAlias : Get a resource alias
+	GetResourceAlias(getResourceAliasOptions *GetResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// GetResourceAliasWithContext is an alternate form of the GetResourceAlias method which supports a Context
+	// parameter
+	GetResourceAliasWithContext(ctx context.Context, getResourceAliasOptions *GetResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// DeleteResourceAlias : Delete a resource alias
+	DeleteResourceAlias(deleteResourceAliasOptions *DeleteResourceAliasOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteResourceAliasWithContext is an alternate form of the DeleteResourceAlias method which supports a Context
+	// parameter
+	DeleteResourceAliasWithContext(ctx context.Context, deleteResourceAliasOptions *DeleteResourceAliasOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateResourceAlias : Update a resource alias
+	UpdateResourceAlias(updateResourceAliasOptions *UpdateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// UpdateResourceAliasWithContext is an alternate form of the UpdateResourceAlias method which supports a Context
+	// parameter
+	UpdateResourceAliasWithContext(ctx context.Context, updateResourceAliasOptions *UpdateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// ListResourceBindingsForAlias : Get a list of all resource bindings for the alias
+	ListResourceBindingsForAlias(listResourceBindingsForAliasOptions *ListResourceBindingsForAliasOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+
+	// ListResourceBindingsForAliasWithContext is an alternate form of the ListResourceBindingsForAlias method which
+	// supports a Context parameter
+	ListResourceBindingsForAliasWithContext(ctx context.Context, listResourceBindingsForAliasOptions *ListResourceBindingsForAliasOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+
+	// ListReclamations : Get a list of all reclamations
+	ListReclamations(listReclamationsOptions *ListReclamationsOptions) (result *ReclamationsList, response *core.DetailedResponse, err error)
+
+	// ListReclamationsWithContext is an alternate form of the ListReclamations method which supports a Context
+	// parameter
+	ListReclamationsWithContext(ctx context.Context, listReclamationsOptions *ListReclamationsOptions) (result *ReclamationsList, response *core.DetailedResponse, err error)
+
+	// RunReclamationAction : Perform a reclamation action
+	RunReclamationAction(runReclamationActionOptions *RunReclamationActionOptions) (result *Reclamation, response *core.DetailedResponse, err error)
+
+	// RunReclamationActionWithContext is an alternate form of the RunReclamationAction method which supports a Context
+	// parameter
+	RunReclamationActionWithContext(ctx context.Context, runReclamationActionOptions *RunReclamationActionOptions) (result *Reclamation, response *core.DetailedResponse, err error)
+
+	// NewCancelLastopResourceInstanceOptions : Instantiate CancelLastopResourceInstanceOptions
+	NewCancelLastopResourceInstanceOptions(id string) *CancelLastopResourceInstanceOptions
+
+	// NewCreateResourceAliasOptions : Instantiate CreateResourceAliasOptions
+	NewCreateResourceAliasOptions(name string, source string, target string) *CreateResourceAliasOptions
+
+	// NewCreateResourceBindingOptions : Instantiate CreateResourceBindingOptions
+	NewCreateResourceBindingOptions(source string, target string) *CreateResourceBindingOptions
+
+	// NewCreateResourceInstanceOptions : Instantiate CreateResourceInstanceOptions
+	NewCreateResourceInstanceOptions(name string, target string, resourceGroup string, resourcePlanID string) *CreateResourceInstanceOptions
+
+	// NewCreateResourceKeyOptions : Instantiate CreateResourceKeyOptions
+	NewCreateResourceKeyOptions(name string, source string) *CreateResourceKeyOptions
+
+	// NewDeleteResourceAliasOptions : Instantiate DeleteResourceAliasOptions
+	NewDeleteResourceAliasOptions(id string) *DeleteResourceAliasOptions
+
+	// NewDeleteResourceBindingOptions : Instantiate DeleteResourceBindingOptions
+	NewDeleteResourceBindingOptions(id string) *DeleteResourceBindingOptions
+
+	// NewDeleteResourceInstanceOptions : Instantiate DeleteResourceInstanceOptions
+	NewDeleteResourceInstanceOptions(id string) *DeleteResourceInstanceOptions
+
+	// NewDeleteResourceKeyOptions : Instantiate DeleteResourceKeyOptions
+	NewDeleteResourceKeyOptions(id string) *DeleteResourceKeyOptions
+
+	// NewGetResourceAliasOptions : Instantiate GetResourceAliasOptions
+	NewGetResourceAliasOptions(id string) *GetResourceAliasOptions
+
+	// NewGetResourceBindingOptions : Instantiate GetResourceBindingOptions
+	NewGetResourceBindingOptions(id string) *GetResourceBindingOptions
+
+	// NewGetResourceInstanceOptions : Instantiate GetResourceInstanceOptions
+	NewGetResourceInstanceOptions(id string) *GetResourceInstanceOptions
+
+	// NewGetResourceKeyOptions : Instantiate GetResourceKeyOptions
+	NewGetResourceKeyOptions(id string) *GetResourceKeyOptions
+
+	// NewListReclamationsOptions : Instantiate ListReclamationsOptions
+	NewListReclamationsOptions() *ListReclamationsOptions
+
+	// NewListResourceAliasesForInstanceOptions : Instantiate ListResourceAliasesForInstanceOptions
+	NewListResourceAliasesForInstanceOptions(id string) *ListResourceAliasesForInstanceOptions
+
+	// NewListResourceAliasesOptions : Instantiate ListResourceAliasesOptions
+	NewListResourceAliasesOptions() *ListResourceAliasesOptions
+
+	// NewListResourceBindingsForAliasOptions : Instantiate ListResourceBindingsForAliasOptions
+	NewListResourceBindingsForAliasOptions(id string) *ListResourceBindingsForAliasOptions
+
+	// NewListResourceBindingsOptions : Instantiate ListResourceBindingsOptions
+	NewListResourceBindingsOptions() *ListResourceBindingsOptions
+
+	// NewListResourceInstancesOptions : Instantiate ListResourceInstancesOptions
+	NewListResourceInstancesOptions() *ListResourceInstancesOptions
+
+	// NewListResourceKeysForInstanceOptions : Instantiate ListResourceKeysForInstanceOptions
+	NewListResourceKeysForInstanceOptions(id string) *ListResourceKeysForInstanceOptions
+
+	// NewListResourceKeysOptions : Instantiate ListResourceKeysOptions
+	NewListResourceKeysOptions() *ListResourceKeysOptions
+
+	// NewLockResourceInstanceOptions : Instantiate LockResourceInstanceOptions
+	NewLockResourceInstanceOptions(id string) *LockResourceInstanceOptions
+
+	// NewRunReclamationActionOptions : Instantiate RunReclamationActionOptions
+	NewRunReclamationActionOptions(id string, actionName string) *RunReclamationActionOptions
+
+	// NewUnlockResourceInstanceOptions : Instantiate UnlockResourceInstanceOptions
+	NewUnlockResourceInstanceOptions(id string) *UnlockResourceInstanceOptions
+
+	// NewUpdateResourceAliasOptions : Instantiate UpdateResourceAliasOptions
+	NewUpdateResourceAliasOptions(id string, name string) *UpdateResourceAliasOptions
+
+	// NewUpdateResourceBindingOptions : Instantiate UpdateResourceBindingOptions
+	NewUpdateResourceBindingOptions(id string, name string) *UpdateResourceBindingOptions
+
+	// NewUpdateResourceInstanceOptions : Instantiate UpdateResourceInstanceOptions
+	NewUpdateResourceInstanceOptions(id string) *UpdateResourceInstanceOptions
+
+	// NewUpdateResourceKeyOptions : Instantiate UpdateResourceKeyOptions
+	NewUpdateResourceKeyOptions(id string, name string) *UpdateResourceKeyOptions
+
+	// CloneEnvironment lists the resource instances matching "sourceFilter" and their resource keys, and plans the
+	// creation of an equivalent instance (same plan, parameters and location) in the resource group of "targetSpec",
+	// followed by equivalent keys (same role) and the same user tags.
+	CloneEnvironment(ctx context.Context, sourceFilter *CloneSourceFilter, targetSpec *CloneTargetSpec, dryRun bool) (result *EnvironmentClone, err error)
+
+	// GetInstanceDistribution lists the resource instances that match "filter" (all the instances of the account if it
+	// is nil) and groups them by service and region.
+	GetInstanceDistribution(ctx context.Context, filter *ListResourceInstancesOptions) (result *InstanceDistribution, err error)
+
+	// NewListResourceInstancesOptionsWith returns ListResourceInstancesOptions populated by applying "options" in
+	// order.
+	NewListResourceInstancesOptionsWith(options ...ListResourceInstancesOption) *ListResourceInstancesOptions
+
+	// ListResourceInstancesWith is an alternate form of the ListResourceInstancesWithContext method which takes
+	// functional options instead of a ListResourceInstancesOptions struct.
+	ListResourceInstancesWith(ctx context.Context, options ...ListResourceInstancesOption) (result *ResourceInstancesList, response *core.DetailedResponse, err error)
+
+	// NewCreateResourceInstanceOptionsWith returns CreateResourceInstanceOptions populated by applying "options" in
+	// order.
+	NewCreateResourceInstanceOptionsWith(options ...CreateResourceInstanceOption) *CreateResourceInstanceOptions
+
+	// CreateResourceInstanceWith is an alternate form of the CreateResourceInstanceWithContext method which takes
+	// functional options instead of a CreateResourceInstanceOptions struct.
+	CreateResourceInstanceWith(ctx context.Context, options ...CreateResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// NewGetResourceInstanceOptionsWith returns GetResourceInstanceOptions populated by applying "options" in order.
+	NewGetResourceInstanceOptionsWith(options ...GetResourceInstanceOption) *GetResourceInstanceOptions
+
+	// GetResourceInstanceWith is an alternate form of the GetResourceInstanceWithContext method which takes functional
+	// options instead of a GetResourceInstanceOptions struct.
+	GetResourceInstanceWith(ctx context.Context, options ...GetResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// NewDeleteResourceInstanceOptionsWith returns DeleteResourceInstanceOptions populated by applying "options" in
+	// order.
+	NewDeleteResourceInstanceOptionsWith(options ...DeleteResourceInstanceOption) *DeleteResourceInstanceOptions
+
+	// DeleteResourceInstanceWith is an alternate form of the DeleteResourceInstanceWithContext method which takes
+	// functional options instead of a DeleteResourceInstanceOptions struct.
+	DeleteResourceInstanceWith(ctx context.Context, options ...DeleteResourceInstanceOption) (response *core.DetailedResponse, err error)
+
+	// NewUpdateResourceInstanceOptionsWith returns UpdateResourceInstanceOptions populated by applying "options" in
+	// order.
+	NewUpdateResourceInstanceOptionsWith(options ...UpdateResourceInstanceOption) *UpdateResourceInstanceOptions
+
+	// UpdateResourceInstanceWith is an alternate form of the UpdateResourceInstanceWithContext method which takes
+	// functional options instead of an UpdateResourceInstanceOptions struct.
+	UpdateResourceInstanceWith(ctx context.Context, options ...UpdateResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// NewListResourceAliasesForInstanceOptionsWith returns ListResourceAliasesForInstanceOptions populated by applying
+	// "options" in order.
+	NewListResourceAliasesForInstanceOptionsWith(options ...ListResourceAliasesForInstanceOption) *ListResourceAliasesForInstanceOptions
+
+	// ListResourceAliasesForInstanceWith is an alternate form of the ListResourceAliasesForInstanceWithContext method
+	// which takes functional options instead of a ListResourceAliasesForInstanceOptions struct.
+	ListResourceAliasesForInstanceWith(ctx context.Context, options ...ListResourceAliasesForInstanceOption) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+
+	// NewListResourceKeysForInstanceOptionsWith returns ListResourceKeysForInstanceOptions populated by applying
+	// "options" in order.
+	NewListResourceKeysForInstanceOptionsWith(options ...ListResourceKeysForInstanceOption) *ListResourceKeysForInstanceOptions
+
+	// ListResourceKeysForInstanceWith is an alternate form of the ListResourceKeysForInstanceWithContext method which
+	// takes functional options instead of a ListResourceKeysForInstanceOptions struct.
+	ListResourceKeysForInstanceWith(ctx context.Context, options ...ListResourceKeysForInstanceOption) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+
+	// NewLockResourceInstanceOptionsWith returns LockResourceInstanceOptions populated by applying "options" in order.
+	NewLockResourceInstanceOptionsWith(options ...LockResourceInstanceOption) *LockResourceInstanceOptions
+
+	// LockResourceInstanceWith is an alternate form of the LockResourceInstanceWithContext method which takes
+	// functional options instead of a LockResourceInstanceOptions struct.
+	LockResourceInstanceWith(ctx context.Context, options ...LockResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// NewUnlockResourceInstanceOptionsWith returns UnlockResourceInstanceOptions populated by applying "options" in
+	// order.
+	NewUnlockResourceInstanceOptionsWith(options ...UnlockResourceInstanceOption) *UnlockResourceInstanceOptions
+
+	// UnlockResourceInstanceWith is an alternate form of the UnlockResourceInstanceWithContext method which takes
+	// functional options instead of an UnlockResourceInstanceOptions struct.
+	UnlockResourceInstanceWith(ctx context.Context, options ...UnlockResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// NewCancelLastopResourceInstanceOptionsWith returns CancelLastopResourceInstanceOptions populated by applying
+	// "options" in order.
+	NewCancelLastopResourceInstanceOptionsWith(options ...CancelLastopResourceInstanceOption) *CancelLastopResourceInstanceOptions
+
+	// CancelLastopResourceInstanceWith is an alternate form of the CancelLastopResourceInstanceWithContext method
+	// which takes functional options instead of a CancelLastopResourceInstanceOptions struct.
+	CancelLastopResourceInstanceWith(ctx context.Context, options ...CancelLastopResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// NewListResourceKeysOptionsWith returns ListResourceKeysOptions populated by applying "options" in order.
+	NewListResourceKeysOptionsWith(options ...ListResourceKeysOption) *ListResourceKeysOptions
+
+	// ListResourceKeysWith is an alternate form of the ListResourceKeysWithContext method which takes functional
+	// options instead of a ListResourceKeysOptions struct.
+	ListResourceKeysWith(ctx context.Context, options ...ListResourceKeysOption) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+
+	// NewCreateResourceKeyOptionsWith returns CreateResourceKeyOptions populated by applying "options" in order.
+	NewCreateResourceKeyOptionsWith(options ...CreateResourceKeyOption) *CreateResourceKeyOptions
+
+	// CreateResourceKeyWith is an alternate form of the CreateResourceKeyWithContext method which takes functional
+	// options instead of a CreateResourceKeyOptions struct.
+	CreateResourceKeyWith(ctx context.Context, options ...CreateResourceKeyOption) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// NewGetResourceKeyOptionsWith returns GetResourceKeyOptions populated by applying "options" in order.
+	NewGetResourceKeyOptionsWith(options ...GetResourceKeyOption) *GetResourceKeyOptions
+
+	// GetResourceKeyWith is an alternate form of the GetResourceKeyWithContext method which takes functional options
+	// instead of a GetResourceKeyOptions struct.
+	GetResourceKeyWith(ctx context.Context, options ...GetResourceKeyOption) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// NewDeleteResourceKeyOptionsWith returns DeleteResourceKeyOptions populated by applying "options" in order.
+	NewDeleteResourceKeyOptionsWith(options ...DeleteResourceKeyOption) *DeleteResourceKeyOptions
+
+	// DeleteResourceKeyWith is an alternate form of the DeleteResourceKeyWithContext method which takes functional
+	// options instead of a DeleteResourceKeyOptions struct.
+	DeleteResourceKeyWith(ctx context.Context, options ...DeleteResourceKeyOption) (response *core.DetailedResponse, err error)
+
+	// NewUpdateResourceKeyOptionsWith returns UpdateResourceKeyOptions populated by applying "options" in order.
+	NewUpdateResourceKeyOptionsWith(options ...UpdateResourceKeyOption) *UpdateResourceKeyOptions
+
+	// UpdateResourceKeyWith is an alternate form of the UpdateResourceKeyWithContext method which takes functional
+	// options instead of an UpdateResourceKeyOptions struct.
+	UpdateResourceKeyWith(ctx context.Context, options ...UpdateResourceKeyOption) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// NewListResourceBindingsOptionsWith returns ListResourceBindingsOptions populated by applying "options" in order.
+	NewListResourceBindingsOptionsWith(options ...ListResourceBindingsOption) *ListResourceBindingsOptions
+
+	// ListResourceBindingsWith is an alternate form of the ListResourceBindingsWithContext method which takes
+	// functional options instead of a ListResourceBindingsOptions struct.
+	ListResourceBindingsWith(ctx context.Context, options ...ListResourceBindingsOption) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+
+	// NewCreateResourceBindingOptionsWith returns CreateResourceBindingOptions populated by applying "options" in
+	// order.
+	NewCreateResourceBindingOptionsWith(options ...CreateResourceBindingOption) *CreateResourceBindingOptions
+
+	// CreateResourceBindingWith is an alternate form of the CreateResourceBindingWithContext method which takes
+	// functional options instead of a CreateResourceBindingOptions struct.
+	CreateResourceBindingWith(ctx context.Context, options ...CreateResourceBindingOption) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// NewGetResourceBindingOptionsWith returns GetResourceBindingOptions populated by applying "options" in order.
+	NewGetResourceBindingOptionsWith(options ...GetResourceBindingOption) *GetResourceBindingOptions
+
+	// GetResourceBindingWith is an alternate form of the GetResourceBindingWithContext method which takes functional
+	// options instead of a GetResourceBindingOptions struct.
+	GetResourceBindingWith(ctx context.Context, options ...GetResourceBindingOption) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// NewDeleteResourceBindingOptionsWith returns DeleteResourceBindingOptions populated by applying "options" in
+	// order.
+	NewDeleteResourceBindingOptionsWith(options ...DeleteResourceBindingOption) *DeleteResourceBindingOptions
+
+	// DeleteResourceBindingWith is an alternate form of the DeleteResourceBindingWithContext method which takes
+	// functional options instead of a DeleteResourceBindingOptions struct.
+	DeleteResourceBindingWith(ctx context.Context, options ...DeleteResourceBindingOption) (response *core.DetailedResponse, err error)
+
+	// NewUpdateResourceBindingOptionsWith returns UpdateResourceBindingOptions populated by applying "options" in
+	// order.
+	NewUpdateResourceBindingOptionsWith(options ...UpdateResourceBindingOption) *UpdateResourceBindingOptions
+
+	// UpdateResourceBindingWith is an alternate form of the UpdateResourceBindingWithContext method which takes
+	// functional options instead of an UpdateResourceBindingOptions struct.
+	UpdateResourceBindingWith(ctx context.Context, options ...UpdateResourceBindingOption) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// NewListResourceAliasesOptionsWith returns ListResourceAliasesOptions populated by applying "options" in order.
+	NewListResourceAliasesOptionsWith(options ...ListResourceAliasesOption) *ListResourceAliasesOptions
+
+	// ListResourceAliasesWith is an alternate form of the ListResourceAliasesWithContext method which takes functional
+	// options instead of a ListResourceAliasesOptions struct.
+	ListResourceAliasesWith(ctx context.Context, options ...ListResourceAliasesOption) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+
+	// NewCreateResourceAliasOptionsWith returns CreateResourceAliasOptions populated by applying "options" in order.
+	NewCreateResourceAliasOptionsWith(options ...CreateResourceAliasOption) *CreateResourceAliasOptions
+
+	// CreateResourceAliasWith is an alternate form of the CreateResourceAliasWithContext method which takes functional
+	// options instead of a CreateResourceAliasOptions struct.
+	CreateResourceAliasWith(ctx context.Context, options ...CreateResourceAliasOption) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// NewGetResourceAliasOptionsWith returns GetResourceAliasOptions populated by applying "options" in order.
+	NewGetResourceAliasOptionsWith(options ...GetResourceAliasOption) *GetResourceAliasOptions
+
+	// GetResourceAliasWith is an alternate form of the GetResourceAliasWithContext method which takes functional
+	// options instead of a GetResourceAliasOptions struct.
+	GetResourceAliasWith(ctx context.Context, options ...GetResourceAliasOption) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// NewDeleteResourceAliasOptionsWith returns DeleteResourceAliasOptions populated by applying "options" in order.
+	NewDeleteResourceAliasOptionsWith(options ...DeleteResourceAliasOption) *DeleteResourceAliasOptions
+
+	// DeleteResourceAliasWith is an alternate form of the DeleteResourceAliasWithContext method which takes functional
+	// options instead of a DeleteResourceAliasOptions struct.
+	DeleteResourceAliasWith(ctx context.Context, options ...DeleteResourceAliasOption) (response *core.DetailedResponse, err error)
+
+	// NewUpdateResourceAliasOptionsWith returns UpdateResourceAliasOptions populated by applying "options" in order.
+	NewUpdateResourceAliasOptionsWith(options ...UpdateResourceAliasOption) *UpdateResourceAliasOptions
+
+	// UpdateResourceAliasWith is an alternate form of the UpdateResourceAliasWithContext method which takes functional
+	// options instead of an UpdateResourceAliasOptions struct.
+	UpdateResourceAliasWith(ctx context.Context, options ...UpdateResourceAliasOption) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// NewListResourceBindingsForAliasOptionsWith returns ListResourceBindingsForAliasOptions populated by applying
+	// "options" in order.
+	NewListResourceBindingsForAliasOptionsWith(options ...ListResourceBindingsForAliasOption) *ListResourceBindingsForAliasOptions
+
+	// ListResourceBindingsForAliasWith is an alternate form of the ListResourceBindingsForAliasWithContext method
+	// which takes functional options instead of a ListResourceBindingsForAliasOptions struct.
+	ListResourceBindingsForAliasWith(ctx context.Context, options ...ListResourceBindingsForAliasOption) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+
+	// NewListReclamationsOptionsWith returns ListReclamationsOptions populated by applying "options" in order.
+	NewListReclamationsOptionsWith(options ...ListReclamationsOption) *ListReclamationsOptions
+
+	// ListReclamationsWith is an alternate form of the ListReclamationsWithContext method which takes functional
+	// options instead of a ListReclamationsOptions struct.
+	ListReclamationsWith(ctx context.Context, options ...ListReclamationsOption) (result *ReclamationsList, response *core.DetailedResponse, err error)
+
+	// NewRunReclamationActionOptionsWith returns RunReclamationActionOptions populated by applying "options" in order.
+	NewRunReclamationActionOptionsWith(options ...RunReclamationActionOption) *RunReclamationActionOptions
+
+	// RunReclamationActionWith is an alternate form of the RunReclamationActionWithContext method which takes
+	// functional options instead of a RunReclamationActionOptions struct.
+	RunReclamationActionWith(ctx context.Context, options ...RunReclamationActionOption) (result *Reclamation, response *core.DetailedResponse, err error)
+
+	// FindOrphanedArtifacts lists the resource keys, bindings and aliases in the account and reports those whose
+	// parent resource instance (or, for keys and bindings created for an alias, parent alias) does not exist or is
+	// pending reclamation.
+	FindOrphanedArtifacts(ctx context.Context) (report *OrphanedArtifactsReport, err error)
+
+	// CleanupOrphanedArtifacts deletes the artifacts in a report returned by FindOrphanedArtifacts, recording the
+	// outcome in the Deleted and DeleteError fields of each artifact.
+	CleanupOrphanedArtifacts(ctx context.Context, report *OrphanedArtifactsReport) (err error)
+
+	// NewResourceInstancesPager returns a new ResourceInstancesPager instance.
+	NewResourceInstancesPager(options *ListResourceInstancesOptions) (pager *ResourceInstancesPager, err error)
+
+	// NewResourceKeysPager returns a new ResourceKeysPager instance.
+	NewResourceKeysPager(options *ListResourceKeysOptions) (pager *ResourceKeysPager, err error)
+
+	// NewResourceBindingsPager returns a new ResourceBindingsPager instance.
+	NewResourceBindingsPager(options *ListResourceBindingsOptions) (pager *ResourceBindingsPager, err error)
+
+	// NewResourceAliasesPager returns a new ResourceAliasesPager instance.
+	NewResourceAliasesPager(options *ListResourceAliasesOptions) (pager *ResourceAliasesPager, err error)
+
+	// ListAllResourceInstances returns every resource instance that matches "options", retrieving all pages of
+	// results.
+	ListAllResourceInstances(ctx context.Context, options *ListResourceInstancesOptions) (allItems []ResourceInstance, err error)
+
+	// ListAllByResourceGroup returns every resource instance that matches "options" in each of the specified resource
+	// groups, keyed by resource group ID.
+	ListAllByResourceGroup(ctx context.Context, resourceGroupIDs []string, options *ListResourceInstancesOptions, concurrency int) (result map[string][]ResourceInstance, err error)
+
+	// RestoreOrPurge finds the reclamation of a deleted resource instance and runs the specified action on it:
+	// ReclamationActionRestoreConst to make the instance usable again, or ReclamationActionReclaimConst to delete it
+	// permanently.
+	RestoreOrPurge(ctx context.Context, resourceID string, action string, waitForCompletion bool, pollConfig PollConfig) (result *Reclamation, err error)
+
+	// RotateResourceKey creates a replacement for the resource key with the specified ID, for the same source instance
+	// or alias, with the same name and role, and (so that the replacement keeps the access policies granted to the old
+	// key) the same service ID.
+	RotateResourceKey(ctx context.Context, keyID string, gracePeriod time.Duration) (result *ResourceKeyRotation, err error)
+
+	// EnableResponseValidation configures the client to check responses against ResponseSchemas.
+	EnableResponseValidation() (validator *common.ResponseValidator, err error)
+
+	// WaitForResourceInstance polls GetResourceInstance with exponential backoff until the instance reaches
+	// "targetState" (typically ResourceInstanceStateActiveConst after a create or ResourceInstanceStateRemovedConst
+	// after a delete) and returns the instance in that state.
+	WaitForResourceInstance(ctx context.Context, instanceID string, targetState string, pollConfig PollConfig) (result *ResourceInstance, err error)
+}
+
+var _ ResourceControllerV2API = (*ResourceControllerV2)(nil)
@@ -0,0 +1,527 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+)
+
+// Constants associated with the CloneAction.Kind and UnclonableResource.Kind properties.
+const (
+	CloneKindResourceInstanceConst = "resource_instance"
+	CloneKindResourceKeyConst      = "resource_key"
+)
+
+// Constants associated with the CloneAction.Action property.
+const (
+	CloneActionCreateConst = "create"
+	CloneActionExistsConst = "exists"
+)
+
+// Constants associated with the UnclonableResource.Reason property.
+const (
+	UnclonableReasonNotActiveConst        = "not_active"
+	UnclonableReasonNoPlanConst           = "no_plan"
+	UnclonableReasonAliasKeyConst         = "alias_key"
+	UnclonableReasonParentUnclonableConst = "parent_unclonable"
+)
+
+// cloneSourceStates are the states of the resource instances listed by CloneEnvironment: every state of an instance
+// that has not been deleted.
+var cloneSourceStates = []string{
+	ResourceInstanceStateActiveConst,
+	ResourceInstanceStateProvisioningConst,
+	ResourceInstanceStatePreProvisioningConst,
+	ResourceInstanceStateInactiveConst,
+	ResourceInstanceStateFailedConst,
+}
+
+// CloneSourceFilter : Selects the resource instances cloned by CloneEnvironment. Instances must match every field that
+// is set.
+type CloneSourceFilter struct {
+	// The ID of the resource group to clone.
+	ResourceGroupID string
+
+	// If set, only instances of this plan are cloned.
+	ResourcePlanID string
+
+	// If set, only instances whose CRN is in this list are cloned, for example the CRNs returned by a Global Search
+	// query.
+	CRNs []string
+
+	// If set, only instances with all of these user tags are cloned. Tags are compared case-insensitively. Requires
+	// GlobalTagging.
+	Tags []string
+
+	// The client used to read the user tags of the source instances, which are copied to their clones and matched
+	// against Tags. If nil, the tags of the source instances are neither copied nor matched.
+	GlobalTagging *globaltaggingv1.GlobalTaggingV1
+}
+
+// CloneTargetSpec : Describes where CloneEnvironment recreates the selected resource instances.
+type CloneTargetSpec struct {
+	// The client used to create the clones, for example to clone into another account. Defaults to the client on which
+	// CloneEnvironment is called.
+	Client *ResourceControllerV2
+
+	// The ID of the resource group in which the clones are created. Required.
+	ResourceGroupID string
+
+	// The location of the clones. Defaults to the region of each source instance.
+	Location string
+
+	// The prefix and suffix added to the name of each source instance and key to form the name of its clone.
+	NamePrefix string
+	NameSuffix string
+
+	// The tags attached to each cloned instance, in addition to the user tags of its source instance.
+	Tags []string
+
+	// Controls how long CloneEnvironment waits for each cloned instance to become active before creating its keys.
+	PollConfig PollConfig
+}
+
+// CloneAction : A resource instance or key to be recreated by CloneEnvironment.
+type CloneAction struct {
+	// The kind of resource (one of the CloneKind*Const values).
+	Kind string
+
+	// The ID, name and CRN of the source resource.
+	SourceID   string
+	SourceName string
+	SourceCRN  string
+
+	// The name of the clone.
+	TargetName string
+
+	// Whether the clone is created, or already exists in the target (one of the CloneAction*Const values).
+	Action string
+
+	// The user tags attached to the clone of an instance when it is created: those of the source instance, if they
+	// are read (see CloneSourceFilter.GlobalTagging), followed by the tags of the target spec.
+	Tags []string
+
+	// The ID and CRN of the clone, once it exists.
+	TargetID  string
+	TargetCRN string
+
+	// The index in EnvironmentClone.Actions of the instance action that a key action depends on, or -1.
+	Parent int
+
+	// True if the clone was created by CloneEnvironment.
+	Applied bool
+
+	// The error returned when the clone could not be created, if any.
+	Err error
+}
+
+// UnclonableResource : A resource instance or key matched by the source filter that CloneEnvironment cannot recreate.
+type UnclonableResource struct {
+	// The kind of resource (one of the CloneKind*Const values).
+	Kind string
+
+	// The ID, name and CRN of the resource.
+	ID   string
+	Name string
+	CRN  string
+
+	// Why the resource cannot be cloned (one of the UnclonableReason*Const values).
+	Reason string
+
+	// A human-readable description of the reason.
+	Detail string
+}
+
+// EnvironmentClone : The plan computed by CloneEnvironment and, unless it was a dry run, the outcome of each action.
+// Each instance action is listed before the actions of its keys.
+type EnvironmentClone struct {
+	// True if the plan was computed but not executed.
+	DryRun bool
+
+	// The instances and keys to be recreated.
+	Actions []CloneAction
+
+	// The instances and keys that cannot be recreated.
+	Unclonable []UnclonableResource
+
+	// The workflow ID sent with every request of the clone (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// Failed returns the actions that could not be applied.
+func (clone *EnvironmentClone) Failed() (failed []CloneAction) {
+	for _, action := range clone.Actions {
+		if action.Err != nil {
+			failed = append(failed, action)
+		}
+	}
+	return
+}
+
+// CloneEnvironment lists the resource instances matching "sourceFilter" and their resource keys, and plans the creation
+// of an equivalent instance (same plan, parameters and location) in the resource group of "targetSpec", followed by
+// equivalent keys (same role) and the same user tags. Clones are named after their source with the prefix and suffix of
+// "targetSpec"; a clone that already exists under that name is left unchanged, so the function can be rerun after a
+// partial failure. Instances that are not active, instances without a plan and keys of aliases are reported as
+// unclonable.
+//
+// If "dryRun" is false, the plan is executed: each instance is created and waited for until it is active, and its
+// keys are then created. An instance that cannot be created is recorded in its action and its keys are skipped, and
+// the remaining instances are still attempted; an error is returned if any action failed. Resource key credentials
+// are generated by the target service and are not copied.
+func (resourceController *ResourceControllerV2) CloneEnvironment(ctx context.Context, sourceFilter *CloneSourceFilter, targetSpec *CloneTargetSpec, dryRun bool) (result *EnvironmentClone, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, resourceController.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	err = core.ValidateNotNil(sourceFilter, "sourceFilter cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateNotNil(targetSpec, "targetSpec cannot be nil")
+	if err != nil {
+		return
+	}
+	if targetSpec.ResourceGroupID == "" {
+		err = fmt.Errorf("the target resource group ID must be specified")
+		return
+	}
+	if len(sourceFilter.Tags) > 0 && sourceFilter.GlobalTagging == nil {
+		err = fmt.Errorf("a Global Tagging client must be specified to select instances by tag")
+		return
+	}
+	target := targetSpec.Client
+	if target == nil {
+		target = resourceController
+	}
+
+	listOptions := resourceController.NewListResourceInstancesOptions()
+	if sourceFilter.ResourceGroupID != "" {
+		listOptions.SetResourceGroupID(sourceFilter.ResourceGroupID)
+	}
+	if sourceFilter.ResourcePlanID != "" {
+		listOptions.SetResourcePlanID(sourceFilter.ResourcePlanID)
+	}
+	instances, err := listAllStatesResourceInstances(ctx, resourceController, listOptions)
+	if err != nil {
+		return
+	}
+	keys, err := listAllResourceKeys(ctx, resourceController)
+	if err != nil {
+		return
+	}
+	existingInstances, err := listAllStatesResourceInstances(ctx, target, target.NewListResourceInstancesOptions().SetResourceGroupID(targetSpec.ResourceGroupID))
+	if err != nil {
+		return
+	}
+	existingKeys, err := listAllResourceKeys(ctx, target)
+	if err != nil {
+		return
+	}
+
+	// existingByName maps the name of each instance in the target resource group to the instance, and existingKeyNames
+	// maps the source CRN and name of each key in the target to the key.
+	existingByName := map[string]ResourceInstance{}
+	for _, instance := range existingInstances {
+		existingByName[core.StringNilMapper(instance.Name)] = instance
+	}
+	existingKeyNames := map[string]ResourceKey{}
+	for _, key := range existingKeys {
+		existingKeyNames[core.StringNilMapper(key.SourceCRN)+"\n"+core.StringNilMapper(key.Name)] = key
+	}
+	keysBySource := map[string][]ResourceKey{}
+	for _, key := range keys {
+		keysBySource[core.StringNilMapper(key.SourceCRN)] = append(keysBySource[core.StringNilMapper(key.SourceCRN)], key)
+	}
+	var selected map[string]bool
+	if sourceFilter.CRNs != nil {
+		selected = map[string]bool{}
+		for _, crn := range sourceFilter.CRNs {
+			selected[crn] = true
+		}
+	}
+
+	result = &EnvironmentClone{DryRun: dryRun, WorkflowID: workflowID}
+	cloned := map[string]bool{}
+	for _, instance := range instances {
+		crn := core.StringNilMapper(instance.CRN)
+		if selected != nil && !selected[crn] {
+			continue
+		}
+		var sourceTags []string
+		if sourceFilter.GlobalTagging != nil {
+			sourceTags, err = listUserTags(ctx, sourceFilter.GlobalTagging, crn)
+			if err != nil {
+				return
+			}
+			if !hasAllTags(sourceTags, sourceFilter.Tags) {
+				continue
+			}
+		}
+		cloned[crn] = true
+		cloned[core.StringNilMapper(instance.ID)] = true
+
+		reason, detail := "", ""
+		if state := core.StringNilMapper(instance.State); state != ResourceInstanceStateActiveConst {
+			reason, detail = UnclonableReasonNotActiveConst, fmt.Sprintf("the instance is in state '%s'", state)
+		} else if core.StringNilMapper(instance.ResourcePlanID) == "" {
+			reason, detail = UnclonableReasonNoPlanConst, "the plan of the instance is unknown"
+		}
+		if reason != "" {
+			result.Unclonable = append(result.Unclonable, UnclonableResource{
+				Kind:   CloneKindResourceInstanceConst,
+				ID:     core.StringNilMapper(instance.ID),
+				Name:   core.StringNilMapper(instance.Name),
+				CRN:    crn,
+				Reason: reason,
+				Detail: detail,
+			})
+			for _, key := range keysBySource[crn] {
+				result.Unclonable = append(result.Unclonable, unclonableKey(key, UnclonableReasonParentUnclonableConst,
+					fmt.Sprintf("the instance '%s' of the key cannot be cloned", core.StringNilMapper(instance.Name))))
+			}
+			continue
+		}
+
+		action := CloneAction{
+			Kind:       CloneKindResourceInstanceConst,
+			SourceID:   core.StringNilMapper(instance.ID),
+			SourceName: core.StringNilMapper(instance.Name),
+			SourceCRN:  crn,
+			TargetName: targetSpec.NamePrefix + core.StringNilMapper(instance.Name) + targetSpec.NameSuffix,
+			Action:     CloneActionCreateConst,
+			Tags:       mergeTags(sourceTags, targetSpec.Tags),
+			Parent:     -1,
+		}
+		if existing, found := existingByName[action.TargetName]; found {
+			action.Action = CloneActionExistsConst
+			action.TargetID = core.StringNilMapper(existing.ID)
+			action.TargetCRN = core.StringNilMapper(existing.CRN)
+		}
+		parent := len(result.Actions)
+		result.Actions = append(result.Actions, action)
+
+		for _, key := range keysBySource[crn] {
+			keyAction := CloneAction{
+				Kind:       CloneKindResourceKeyConst,
+				SourceID:   core.StringNilMapper(key.ID),
+				SourceName: core.StringNilMapper(key.Name),
+				SourceCRN:  core.StringNilMapper(key.CRN),
+				TargetName: targetSpec.NamePrefix + core.StringNilMapper(key.Name) + targetSpec.NameSuffix,
+				Action:     CloneActionCreateConst,
+				Parent:     parent,
+			}
+			if existing, found := existingKeyNames[action.TargetCRN+"\n"+keyAction.TargetName]; found && action.TargetCRN != "" {
+				keyAction.Action = CloneActionExistsConst
+				keyAction.TargetID = core.StringNilMapper(existing.ID)
+				keyAction.TargetCRN = core.StringNilMapper(existing.CRN)
+			}
+			result.Actions = append(result.Actions, keyAction)
+		}
+	}
+
+	// Keys whose source is not a selected instance belong to an alias of a selected instance, or to an instance that
+	// was not selected; only the former are reported.
+	aliasesPager, err := resourceController.NewResourceAliasesPager(resourceController.NewListResourceAliasesOptions())
+	if err != nil {
+		return
+	}
+	aliases, err := aliasesPager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	for _, alias := range aliases {
+		if !cloned[core.StringNilMapper(alias.ResourceInstanceID)] {
+			continue
+		}
+		for _, key := range keysBySource[core.StringNilMapper(alias.CRN)] {
+			result.Unclonable = append(result.Unclonable, unclonableKey(key, UnclonableReasonAliasKeyConst,
+				fmt.Sprintf("the key belongs to the alias '%s', which is not cloned", core.StringNilMapper(alias.Name))))
+		}
+	}
+
+	if dryRun {
+		return
+	}
+
+	roles := map[string]string{}
+	for _, key := range keys {
+		if key.Credentials != nil {
+			roles[core.StringNilMapper(key.ID)] = core.StringNilMapper(key.Credentials.IamRoleCRN)
+		}
+	}
+	sources := map[string]ResourceInstance{}
+	for _, instance := range instances {
+		sources[core.StringNilMapper(instance.ID)] = instance
+	}
+
+	failures := 0
+	for i := range result.Actions {
+		action := &result.Actions[i]
+		if action.Action != CloneActionCreateConst {
+			continue
+		}
+		if action.Parent >= 0 && result.Actions[action.Parent].TargetCRN == "" {
+			action.Err = fmt.Errorf("resource instance '%s' was not cloned", result.Actions[action.Parent].TargetName)
+			failures++
+			continue
+		}
+
+		switch action.Kind {
+		case CloneKindResourceInstanceConst:
+			action.Err = target.cloneResourceInstance(ctx, action, sources[action.SourceID], targetSpec)
+		case CloneKindResourceKeyConst:
+			createOptions := target.NewCreateResourceKeyOptions(action.TargetName, result.Actions[action.Parent].TargetCRN)
+			if role := roles[action.SourceID]; role != "" {
+				createOptions.SetRole(role)
+			}
+			var key *ResourceKey
+			key, _, action.Err = target.CreateResourceKeyWithContext(ctx, createOptions)
+			if action.Err != nil {
+				action.Err = fmt.Errorf("error creating resource key '%s': %w", action.TargetName, action.Err)
+			} else {
+				action.TargetID = core.StringNilMapper(key.ID)
+				action.TargetCRN = core.StringNilMapper(key.CRN)
+			}
+		}
+		action.Applied = action.Err == nil
+		if !action.Applied {
+			failures++
+		}
+	}
+	if failures > 0 {
+		err = fmt.Errorf("%d of %d clone actions failed", failures, len(result.Actions))
+	}
+	return
+}
+
+// cloneResourceInstance creates the clone of "source" described by "action" and waits until it is active.
+func (resourceController *ResourceControllerV2) cloneResourceInstance(ctx context.Context, action *CloneAction, source ResourceInstance, targetSpec *CloneTargetSpec) (err error) {
+	location := targetSpec.Location
+	if location == "" {
+		location = core.StringNilMapper(source.RegionID)
+	}
+	createOptions := resourceController.NewCreateResourceInstanceOptions(action.TargetName, location, targetSpec.ResourceGroupID,
+		core.StringNilMapper(source.ResourcePlanID))
+	if len(action.Tags) > 0 {
+		createOptions.SetTags(action.Tags)
+	}
+	if len(source.Parameters) > 0 {
+		createOptions.SetParameters(source.Parameters)
+	}
+	if source.AllowCleanup != nil {
+		createOptions.SetAllowCleanup(*source.AllowCleanup)
+	}
+
+	instance, _, err := resourceController.CreateResourceInstanceWithContext(ctx, createOptions)
+	if err != nil {
+		return fmt.Errorf("error creating resource instance '%s': %w", action.TargetName, err)
+	}
+	action.TargetID = core.StringNilMapper(instance.ID)
+	if core.StringNilMapper(instance.State) != ResourceInstanceStateActiveConst {
+		instance, err = resourceController.WaitForResourceInstance(ctx, action.TargetID, ResourceInstanceStateActiveConst, targetSpec.PollConfig)
+		if err != nil {
+			return fmt.Errorf("error waiting for resource instance '%s': %w", action.TargetName, err)
+		}
+	}
+	action.TargetCRN = core.StringNilMapper(instance.CRN)
+	return
+}
+
+// listAllStatesResourceInstances lists the resource instances that match "listOptions" in each of the
+// cloneSourceStates. Without a state, only active and provisioning instances are listed.
+func listAllStatesResourceInstances(ctx context.Context, resourceController *ResourceControllerV2, listOptions *ListResourceInstancesOptions) (instances []ResourceInstance, err error) {
+	for _, state := range cloneSourceStates {
+		stateOptions := *listOptions
+		var stateInstances []ResourceInstance
+		stateInstances, err = resourceController.ListAllResourceInstances(ctx, stateOptions.SetState(state))
+		if err != nil {
+			return
+		}
+		instances = append(instances, stateInstances...)
+	}
+	return
+}
+
+// listUserTags returns the user tags attached to the resource with the specified CRN.
+func listUserTags(ctx context.Context, globalTagging *globaltaggingv1.GlobalTaggingV1, crn string) (tags []string, err error) {
+	listTagsOptions := globalTagging.NewListTagsOptions().
+		SetAttachedTo(crn).
+		SetTagType(globaltaggingv1.ListTagsOptionsTagTypeUserConst).
+		SetLimit(1000)
+	tagList, _, err := globalTagging.ListTagsWithContext(ctx, listTagsOptions)
+	if err != nil {
+		err = fmt.Errorf("error reading the tags of resource instance '%s': %w", crn, err)
+		return
+	}
+	for _, tag := range tagList.Items {
+		tags = append(tags, core.StringNilMapper(tag.Name))
+	}
+	return
+}
+
+// hasAllTags returns true if "tags" contains each of "required", compared case-insensitively.
+func hasAllTags(tags []string, required []string) bool {
+	present := map[string]bool{}
+	for _, tag := range tags {
+		present[strings.ToLower(tag)] = true
+	}
+	for _, tag := range required {
+		if !present[strings.ToLower(tag)] {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeTags returns the distinct tags of "tags" and "additional", compared case-insensitively, in order.
+func mergeTags(tags []string, additional []string) (merged []string) {
+	seen := map[string]bool{}
+	for _, tag := range append(append([]string{}, tags...), additional...) {
+		if !seen[strings.ToLower(tag)] {
+			seen[strings.ToLower(tag)] = true
+			merged = append(merged, tag)
+		}
+	}
+	return
+}
+
+// listAllResourceKeys lists every resource key visible to "resourceController".
+func listAllResourceKeys(ctx context.Context, resourceController *ResourceControllerV2) (keys []ResourceKey, err error) {
+	pager, err := resourceController.NewResourceKeysPager(resourceController.NewListResourceKeysOptions())
+	if err != nil {
+		return
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+// unclonableKey returns an UnclonableResource describing "key".
+func unclonableKey(key ResourceKey, reason string, detail string) UnclonableResource {
+	return UnclonableResource{
+		Kind:   CloneKindResourceKeyConst,
+		ID:     core.StringNilMapper(key.ID),
+		Name:   core.StringNilMapper(key.Name),
+		CRN:    core.StringNilMapper(key.CRN),
+		Reason: reason,
+		Detail: detail,
+	}
+}
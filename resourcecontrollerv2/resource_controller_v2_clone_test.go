@@ -0,0 +1,282 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 CloneEnvironment`, func() {
+	var sourceServer *httptest.Server
+	var targetServer *httptest.Server
+	var taggingServer *httptest.Server
+	var sourceService *resourcecontrollerv2.ResourceControllerV2
+	var targetService *resourcecontrollerv2.ResourceControllerV2
+	var globalTaggingService *globaltaggingv1.GlobalTaggingV1
+	var sourceQuery string
+	var sourceStates []string
+	var mutations []string
+	var bodies map[string]map[string]interface{}
+	var failDB bool
+	BeforeEach(func() {
+		mutations = nil
+		bodies = make(map[string]map[string]interface{})
+		failDB = false
+		sourceStates = nil
+		sourceServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/v2/resource_instances":
+				state := req.URL.Query().Get("state")
+				sourceStates = append(sourceStates, state)
+				switch state {
+				case "active":
+					sourceQuery = req.URL.RawQuery
+					fmt.Fprint(res, `{"rows_count": 3, "next_url": null, "resources": [
+						{"id": "crn:db", "name": "db", "crn": "crn:db", "state": "active", "region_id": "us-south", "resource_plan_id": "plan-db",
+						 "parameters": {"members": 3}, "allow_cleanup": false},
+						{"id": "crn:cache", "name": "cache", "crn": "crn:cache", "state": "active", "region_id": "eu-de", "resource_plan_id": "plan-cache"},
+						{"id": "crn:legacy", "name": "legacy", "crn": "crn:legacy", "state": "active"}]}`)
+				case "provisioning":
+					fmt.Fprint(res, `{"rows_count": 1, "next_url": null, "resources": [
+						{"id": "crn:queue", "name": "queue", "crn": "crn:queue", "state": "provisioning", "region_id": "us-south", "resource_plan_id": "plan-queue"}]}`)
+				case "inactive":
+					fmt.Fprint(res, `{"rows_count": 1, "next_url": null, "resources": [
+						{"id": "crn:old", "name": "old", "crn": "crn:old", "state": "inactive", "region_id": "us-south", "resource_plan_id": "plan-old"}]}`)
+				default:
+					fmt.Fprint(res, `{"rows_count": 0, "next_url": null, "resources": []}`)
+				}
+			case "/v2/resource_keys":
+				fmt.Fprint(res, `{"rows_count": 5, "next_url": null, "resources": [
+					{"id": "key-db-writer", "name": "writer", "crn": "crn:key-db-writer", "source_crn": "crn:db", "credentials": {"iam_role_crn": "crn:role:Writer"}},
+					{"id": "key-db-reader", "name": "reader", "crn": "crn:key-db-reader", "source_crn": "crn:db", "credentials": {"REDACTED": "REDACTED"}},
+					{"id": "key-cache", "name": "app", "crn": "crn:key-cache", "source_crn": "crn:cache"},
+					{"id": "key-queue", "name": "consumer", "crn": "crn:key-queue", "source_crn": "crn:queue"},
+					{"id": "key-alias", "name": "cf", "crn": "crn:key-alias", "source_crn": "crn:alias-db"}]}`)
+			case "/v2/resource_aliases":
+				fmt.Fprint(res, `{"rows_count": 1, "next_url": null, "resources": [
+					{"id": "alias-db", "name": "db-alias", "crn": "crn:alias-db", "resource_instance_id": "crn:db"}]}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			}
+		}))
+		targetServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			request := req.Method + " " + req.URL.EscapedPath()
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == "POST" {
+				mutations = append(mutations, request)
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				bodies[fmt.Sprintf("%s %s", request, body["name"])] = body
+			}
+			switch request {
+			case "GET /v2/resource_instances":
+				Expect(req.URL.Query().Get("resource_group_id")).To(Equal("rg-staging"))
+				if req.URL.Query().Get("state") != "active" {
+					fmt.Fprint(res, `{"rows_count": 0, "next_url": null, "resources": []}`)
+					return
+				}
+				fmt.Fprint(res, `{"rows_count": 1, "next_url": null, "resources": [
+					{"id": "crn:stg-cache", "name": "stg-cache", "crn": "crn:stg-cache", "state": "active"}]}`)
+			case "GET /v2/resource_keys":
+				fmt.Fprint(res, `{"rows_count": 1, "next_url": null, "resources": [
+					{"id": "stg-key-cache", "name": "stg-app", "crn": "crn:stg-key-cache", "source_crn": "crn:stg-cache"}]}`)
+			case "POST /v2/resource_instances":
+				if failDB {
+					res.WriteHeader(400)
+					fmt.Fprint(res, `{"message": "quota exceeded"}`)
+					return
+				}
+				res.WriteHeader(202)
+				fmt.Fprint(res, `{"id": "crn:stg-db", "name": "stg-db", "state": "provisioning"}`)
+			case "GET /v2/resource_instances/crn:stg-db":
+				fmt.Fprint(res, `{"id": "crn:stg-db", "name": "stg-db", "crn": "crn:stg-db", "state": "active"}`)
+			case "POST /v2/resource_keys":
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "stg-key", "crn": "crn:stg-key"}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			}
+		}))
+		taggingServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method + " " + req.URL.EscapedPath()).To(Equal("GET /v3/tags"))
+			Expect(req.URL.Query().Get("tag_type")).To(Equal("user"))
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Query().Get("attached_to") {
+			case "crn:db":
+				fmt.Fprint(res, `{"total_count": 2, "items": [{"name": "env:prod"}, {"name": "team:data"}]}`)
+			case "crn:cache":
+				fmt.Fprint(res, `{"total_count": 1, "items": [{"name": "env:prod"}]}`)
+			default:
+				fmt.Fprint(res, `{"total_count": 0, "items": []}`)
+			}
+		}))
+		var serviceErr error
+		sourceService, serviceErr = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           sourceServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(sourceService.Service)
+		targetService, serviceErr = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           targetServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		globalTaggingService, serviceErr = globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+			URL:           taggingServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		sourceServer.Close()
+		targetServer.Close()
+		taggingServer.Close()
+	})
+
+	newTargetSpec := func() *resourcecontrollerv2.CloneTargetSpec {
+		return &resourcecontrollerv2.CloneTargetSpec{
+			Client:          targetService,
+			ResourceGroupID: "rg-staging",
+			NamePrefix:      "stg-",
+			Tags:            []string{"env:staging"},
+			PollConfig:      resourcecontrollerv2.PollConfig{InitialInterval: time.Millisecond},
+		}
+	}
+
+	It(`Plans the clone in a dry run`, func() {
+		result, err := sourceService.CloneEnvironment(context.Background(), &resourcecontrollerv2.CloneSourceFilter{ResourceGroupID: "rg-prod"}, newTargetSpec(), true)
+		Expect(err).To(BeNil())
+		Expect(sourceQuery).To(ContainSubstring("resource_group_id=rg-prod"))
+		Expect(sourceStates).To(Equal([]string{"active", "provisioning", "pre_provisioning", "inactive", "failed"}))
+		Expect(result.DryRun).To(BeTrue())
+		Expect(result.WorkflowID).ToNot(BeEmpty())
+		Expect(mutations).To(BeEmpty())
+
+		var actions []string
+		for _, action := range result.Actions {
+			actions = append(actions, fmt.Sprintf("%s %s %s %s", action.Action, action.Kind, action.TargetName, action.TargetID))
+		}
+		Expect(actions).To(Equal([]string{
+			"create resource_instance stg-db ",
+			"create resource_key stg-writer ",
+			"create resource_key stg-reader ",
+			"exists resource_instance stg-cache crn:stg-cache",
+			"exists resource_key stg-app stg-key-cache",
+		}))
+		Expect(result.Actions[1].Parent).To(Equal(0))
+
+		var unclonable []string
+		for _, resource := range result.Unclonable {
+			unclonable = append(unclonable, resource.Kind+" "+resource.ID+" "+resource.Reason)
+		}
+		Expect(unclonable).To(Equal([]string{
+			"resource_instance crn:legacy no_plan",
+			"resource_instance crn:queue not_active",
+			"resource_key key-queue parent_unclonable",
+			"resource_instance crn:old not_active",
+			"resource_key key-alias alias_key",
+		}))
+		Expect(result.Unclonable[1].Detail).To(Equal("the instance is in state 'provisioning'"))
+		Expect(result.Unclonable[3].Detail).To(Equal("the instance is in state 'inactive'"))
+	})
+	It(`Creates the instances and then their keys`, func() {
+		result, err := sourceService.CloneEnvironment(context.Background(), &resourcecontrollerv2.CloneSourceFilter{ResourceGroupID: "rg-prod"}, newTargetSpec(), false)
+		Expect(err).To(BeNil())
+		Expect(mutations).To(Equal([]string{
+			"POST /v2/resource_instances",
+			"POST /v2/resource_keys",
+			"POST /v2/resource_keys",
+		}))
+		Expect(bodies["POST /v2/resource_instances stg-db"]).To(Equal(map[string]interface{}{
+			"name": "stg-db", "target": "us-south", "resource_group": "rg-staging", "resource_plan_id": "plan-db",
+			"tags": []interface{}{"env:staging"}, "parameters": map[string]interface{}{"members": float64(3)}, "allow_cleanup": false,
+		}))
+		Expect(bodies["POST /v2/resource_keys stg-writer"]).To(Equal(map[string]interface{}{
+			"name": "stg-writer", "source": "crn:stg-db", "role": "crn:role:Writer",
+		}))
+		Expect(bodies["POST /v2/resource_keys stg-reader"]).ToNot(HaveKey("role"))
+		Expect(result.Actions[0].TargetCRN).To(Equal("crn:stg-db"))
+		Expect(result.Actions[0].Applied).To(BeTrue())
+		Expect(result.Actions[1].TargetID).To(Equal("stg-key"))
+		Expect(result.Actions[3].Applied).To(BeFalse())
+		Expect(result.Failed()).To(BeEmpty())
+	})
+	It(`Skips the keys of instances that cannot be created`, func() {
+		failDB = true
+		result, err := sourceService.CloneEnvironment(context.Background(), &resourcecontrollerv2.CloneSourceFilter{ResourceGroupID: "rg-prod"}, newTargetSpec(), false)
+		Expect(err.Error()).To(ContainSubstring("3 of 5 clone actions failed"))
+		Expect(mutations).To(Equal([]string{"POST /v2/resource_instances"}))
+		failed := result.Failed()
+		Expect(failed).To(HaveLen(3))
+		Expect(failed[0].Err.Error()).To(ContainSubstring("error creating resource instance 'stg-db': quota exceeded"))
+		Expect(failed[1].Err.Error()).To(Equal("resource instance 'stg-db' was not cloned"))
+	})
+	It(`Selects instances by CRN`, func() {
+		sourceFilter := &resourcecontrollerv2.CloneSourceFilter{ResourcePlanID: "plan-cache", CRNs: []string{"crn:cache"}}
+		result, err := sourceService.CloneEnvironment(context.Background(), sourceFilter, newTargetSpec(), true)
+		Expect(err).To(BeNil())
+		Expect(sourceQuery).To(ContainSubstring("resource_plan_id=plan-cache"))
+		Expect(result.Actions).To(HaveLen(2))
+		Expect(result.Actions[0].SourceCRN).To(Equal("crn:cache"))
+		Expect(result.Unclonable).To(BeEmpty())
+	})
+	It(`Selects instances by tag and copies their tags`, func() {
+		sourceFilter := &resourcecontrollerv2.CloneSourceFilter{Tags: []string{"Team:Data"}, GlobalTagging: globalTaggingService}
+		result, err := sourceService.CloneEnvironment(context.Background(), sourceFilter, newTargetSpec(), false)
+		Expect(err).To(BeNil())
+		Expect(result.Actions).To(HaveLen(3))
+		Expect(result.Actions[0].SourceCRN).To(Equal("crn:db"))
+		Expect(result.Actions[0].Tags).To(Equal([]string{"env:prod", "team:data", "env:staging"}))
+		Expect(bodies["POST /v2/resource_instances stg-db"]["tags"]).To(Equal([]interface{}{"env:prod", "team:data", "env:staging"}))
+		Expect(result.Unclonable).To(HaveLen(1))
+		Expect(result.Unclonable[0].Reason).To(Equal(resourcecontrollerv2.UnclonableReasonAliasKeyConst))
+	})
+	It(`Requires a Global Tagging client to select instances by tag`, func() {
+		sourceFilter := &resourcecontrollerv2.CloneSourceFilter{Tags: []string{"team:data"}}
+		_, err := sourceService.CloneEnvironment(context.Background(), sourceFilter, newTargetSpec(), true)
+		Expect(err.Error()).To(ContainSubstring("a Global Tagging client must be specified to select instances by tag"))
+	})
+	It(`Requires a target resource group`, func() {
+		targetSpec := newTargetSpec()
+		targetSpec.ResourceGroupID = ""
+		_, err := sourceService.CloneEnvironment(context.Background(), &resourcecontrollerv2.CloneSourceFilter{}, targetSpec, true)
+		Expect(err.Error()).To(ContainSubstring("the target resource group ID must be specified"))
+	})
+})
@@ -0,0 +1,709 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultReadAfterWritePollConfig controls how a ReadAfterWriteClient retries reads when no poll configuration is
+// specified. Its Timeout is also used when the Timeout of the client's configuration is zero, since the retries of a
+// ReadAfterWriteClient are always bounded.
+var DefaultReadAfterWritePollConfig = PollConfig{
+	InitialInterval: 500 * time.Millisecond,
+	MaxInterval:     5 * time.Second,
+	Timeout:         30 * time.Second,
+}
+
+// The kinds of resources tracked by a ReadAfterWriteClient.
+const (
+	resourceKindInstance = "resource_instance"
+	resourceKindKey      = "resource_key"
+	resourceKindBinding  = "resource_binding"
+	resourceKindAlias    = "resource_alias"
+)
+
+// ReadAfterWriteClient : Wraps a ResourceControllerV2 client so that reads return the effect of the writes made
+// through the same client. The Resource Controller is eventually consistent: right after a resource instance, key,
+// binding or alias is created or updated, retrieving it may fail with status code 404 or return its previous version,
+// and listing resources may omit it. After a create or update operation, the client retries the get operations of the
+// resource, and the list operations whose filters match the resource, until the change is visible or
+// PollConfig.Timeout has elapsed since the write; the last response is then returned as is.
+// A list operation is only retried when it is not paginated (the first page is the whole result) and has no
+// updated_from or updated_to filter, since the absence of the resource cannot be told otherwise.
+// All other operations are passed through to the wrapped client unchanged.
+type ReadAfterWriteClient struct {
+	*ResourceControllerV2
+
+	// Controls how reads are retried. Defaults to DefaultReadAfterWritePollConfig.
+	PollConfig PollConfig
+
+	mutex   sync.Mutex
+	pending map[string][]*pendingWrite
+}
+
+// pendingWrite : A write made through a ReadAfterWriteClient that has not been observed by a read yet.
+type pendingWrite struct {
+	// The kind of the resource.
+	kind string
+
+	// The ID, GUID and CRN of the resource.
+	identifiers []string
+
+	// The update time of the resource returned by the write, if any.
+	updatedAt time.Time
+
+	// The values of the resource for the list filters, by the name of the query parameter.
+	attributes map[string]string
+
+	// The time after which reads are no longer retried for the write.
+	deadline time.Time
+}
+
+// resourceVersion : The ID and update time of a resource returned by a read.
+type resourceVersion struct {
+	id        string
+	updatedAt *strfmt.DateTime
+}
+
+// NewReadAfterWriteClient returns a new ReadAfterWriteClient that wraps "resourceController", with
+// DefaultReadAfterWritePollConfig.
+func NewReadAfterWriteClient(resourceController *ResourceControllerV2) *ReadAfterWriteClient {
+	return &ReadAfterWriteClient{
+		ResourceControllerV2: resourceController,
+		PollConfig:           DefaultReadAfterWritePollConfig,
+	}
+}
+
+// CreateResourceInstance creates an instance and tracks it until it is visible to reads.
+func (client *ReadAfterWriteClient) CreateResourceInstance(createResourceInstanceOptions *CreateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	return client.CreateResourceInstanceWithContext(context.Background(), createResourceInstanceOptions)
+}
+
+// CreateResourceInstanceWithContext is an alternate form of the CreateResourceInstance method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) CreateResourceInstanceWithContext(ctx context.Context, createResourceInstanceOptions *CreateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	result, response, err = client.ResourceControllerV2.CreateResourceInstanceWithContext(ctx, createResourceInstanceOptions)
+	if err == nil {
+		client.recordInstance(result)
+	}
+	return
+}
+
+// UpdateResourceInstance updates an instance and tracks it until the update is visible to reads.
+func (client *ReadAfterWriteClient) UpdateResourceInstance(updateResourceInstanceOptions *UpdateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	return client.UpdateResourceInstanceWithContext(context.Background(), updateResourceInstanceOptions)
+}
+
+// UpdateResourceInstanceWithContext is an alternate form of the UpdateResourceInstance method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) UpdateResourceInstanceWithContext(ctx context.Context, updateResourceInstanceOptions *UpdateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	result, response, err = client.ResourceControllerV2.UpdateResourceInstanceWithContext(ctx, updateResourceInstanceOptions)
+	if err == nil {
+		client.recordInstance(result)
+	}
+	return
+}
+
+// DeleteResourceInstance deletes an instance and stops tracking its writes.
+func (client *ReadAfterWriteClient) DeleteResourceInstance(deleteResourceInstanceOptions *DeleteResourceInstanceOptions) (response *core.DetailedResponse, err error) {
+	return client.DeleteResourceInstanceWithContext(context.Background(), deleteResourceInstanceOptions)
+}
+
+// DeleteResourceInstanceWithContext is an alternate form of the DeleteResourceInstance method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) DeleteResourceInstanceWithContext(ctx context.Context, deleteResourceInstanceOptions *DeleteResourceInstanceOptions) (response *core.DetailedResponse, err error) {
+	response, err = client.ResourceControllerV2.DeleteResourceInstanceWithContext(ctx, deleteResourceInstanceOptions)
+	if err == nil {
+		client.forget(resourceKindInstance, core.StringNilMapper(deleteResourceInstanceOptions.ID))
+	}
+	return
+}
+
+// GetResourceInstance retrieves an instance, retrying until the writes made to it through the client are visible.
+func (client *ReadAfterWriteClient) GetResourceInstance(getResourceInstanceOptions *GetResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	return client.GetResourceInstanceWithContext(context.Background(), getResourceInstanceOptions)
+}
+
+// GetResourceInstanceWithContext is an alternate form of the GetResourceInstance method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) GetResourceInstanceWithContext(ctx context.Context, getResourceInstanceOptions *GetResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	var writes []*pendingWrite
+	if getResourceInstanceOptions != nil {
+		writes = client.pendingForGet(resourceKindInstance, core.StringNilMapper(getResourceInstanceOptions.ID))
+	}
+	client.awaitWrites(ctx, writes, func() ([]resourceVersion, bool) {
+		result, response, err = client.ResourceControllerV2.GetResourceInstanceWithContext(ctx, getResourceInstanceOptions)
+		if err != nil {
+			return nil, isNotFound(response)
+		}
+		return []resourceVersion{{core.StringNilMapper(result.ID), result.UpdatedAt}}, true
+	})
+	return
+}
+
+// ListResourceInstances lists instances, retrying until the writes made through the client to the instances that
+// match the filters are visible.
+func (client *ReadAfterWriteClient) ListResourceInstances(listResourceInstancesOptions *ListResourceInstancesOptions) (result *ResourceInstancesList, response *core.DetailedResponse, err error) {
+	return client.ListResourceInstancesWithContext(context.Background(), listResourceInstancesOptions)
+}
+
+// ListResourceInstancesWithContext is an alternate form of the ListResourceInstances method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) ListResourceInstancesWithContext(ctx context.Context, listResourceInstancesOptions *ListResourceInstancesOptions) (result *ResourceInstancesList, response *core.DetailedResponse, err error) {
+	var writes []*pendingWrite
+	if options := listResourceInstancesOptions; options != nil && options.Start == nil && options.UpdatedFrom == nil && options.UpdatedTo == nil {
+		writes = client.pendingForList(resourceKindInstance, map[string]*string{
+			"guid":              options.GUID,
+			"name":              options.Name,
+			"resource_group_id": options.ResourceGroupID,
+			"resource_id":       options.ResourceID,
+			"resource_plan_id":  options.ResourcePlanID,
+			"type":              options.Type,
+			"sub_type":          options.SubType,
+			"state":             options.State,
+		})
+	}
+	client.awaitWrites(ctx, writes, func() (versions []resourceVersion, retry bool) {
+		result, response, err = client.ResourceControllerV2.ListResourceInstancesWithContext(ctx, listResourceInstancesOptions)
+		if err != nil || result.NextURL != nil {
+			return nil, false
+		}
+		for _, instance := range result.Resources {
+			versions = append(versions, resourceVersion{core.StringNilMapper(instance.ID), instance.UpdatedAt})
+		}
+		return versions, true
+	})
+	return
+}
+
+// CreateResourceKey creates a key and tracks it until it is visible to reads.
+func (client *ReadAfterWriteClient) CreateResourceKey(createResourceKeyOptions *CreateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error) {
+	return client.CreateResourceKeyWithContext(context.Background(), createResourceKeyOptions)
+}
+
+// CreateResourceKeyWithContext is an alternate form of the CreateResourceKey method which supports a Context parameter.
+func (client *ReadAfterWriteClient) CreateResourceKeyWithContext(ctx context.Context, createResourceKeyOptions *CreateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error) {
+	result, response, err = client.ResourceControllerV2.CreateResourceKeyWithContext(ctx, createResourceKeyOptions)
+	if err == nil {
+		client.recordKey(result)
+	}
+	return
+}
+
+// UpdateResourceKey updates a key and tracks it until the update is visible to reads.
+func (client *ReadAfterWriteClient) UpdateResourceKey(updateResourceKeyOptions *UpdateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error) {
+	return client.UpdateResourceKeyWithContext(context.Background(), updateResourceKeyOptions)
+}
+
+// UpdateResourceKeyWithContext is an alternate form of the UpdateResourceKey method which supports a Context parameter.
+func (client *ReadAfterWriteClient) UpdateResourceKeyWithContext(ctx context.Context, updateResourceKeyOptions *UpdateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error) {
+	result, response, err = client.ResourceControllerV2.UpdateResourceKeyWithContext(ctx, updateResourceKeyOptions)
+	if err == nil {
+		client.recordKey(result)
+	}
+	return
+}
+
+// DeleteResourceKey deletes a key and stops tracking its writes.
+func (client *ReadAfterWriteClient) DeleteResourceKey(deleteResourceKeyOptions *DeleteResourceKeyOptions) (response *core.DetailedResponse, err error) {
+	return client.DeleteResourceKeyWithContext(context.Background(), deleteResourceKeyOptions)
+}
+
+// DeleteResourceKeyWithContext is an alternate form of the DeleteResourceKey method which supports a Context parameter.
+func (client *ReadAfterWriteClient) DeleteResourceKeyWithContext(ctx context.Context, deleteResourceKeyOptions *DeleteResourceKeyOptions) (response *core.DetailedResponse, err error) {
+	response, err = client.ResourceControllerV2.DeleteResourceKeyWithContext(ctx, deleteResourceKeyOptions)
+	if err == nil {
+		client.forget(resourceKindKey, core.StringNilMapper(deleteResourceKeyOptions.ID))
+	}
+	return
+}
+
+// GetResourceKey retrieves a key, retrying until the writes made to it through the client are visible.
+func (client *ReadAfterWriteClient) GetResourceKey(getResourceKeyOptions *GetResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error) {
+	return client.GetResourceKeyWithContext(context.Background(), getResourceKeyOptions)
+}
+
+// GetResourceKeyWithContext is an alternate form of the GetResourceKey method which supports a Context parameter.
+func (client *ReadAfterWriteClient) GetResourceKeyWithContext(ctx context.Context, getResourceKeyOptions *GetResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error) {
+	var writes []*pendingWrite
+	if getResourceKeyOptions != nil {
+		writes = client.pendingForGet(resourceKindKey, core.StringNilMapper(getResourceKeyOptions.ID))
+	}
+	client.awaitWrites(ctx, writes, func() ([]resourceVersion, bool) {
+		result, response, err = client.ResourceControllerV2.GetResourceKeyWithContext(ctx, getResourceKeyOptions)
+		if err != nil {
+			return nil, isNotFound(response)
+		}
+		return []resourceVersion{{core.StringNilMapper(result.ID), result.UpdatedAt}}, true
+	})
+	return
+}
+
+// ListResourceKeys lists keys, retrying until the writes made through the client to the keys that match the filters
+// are visible.
+func (client *ReadAfterWriteClient) ListResourceKeys(listResourceKeysOptions *ListResourceKeysOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error) {
+	return client.ListResourceKeysWithContext(context.Background(), listResourceKeysOptions)
+}
+
+// ListResourceKeysWithContext is an alternate form of the ListResourceKeys method which supports a Context parameter.
+func (client *ReadAfterWriteClient) ListResourceKeysWithContext(ctx context.Context, listResourceKeysOptions *ListResourceKeysOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error) {
+	var writes []*pendingWrite
+	if options := listResourceKeysOptions; options != nil && options.Start == nil && options.UpdatedFrom == nil && options.UpdatedTo == nil {
+		writes = client.pendingForList(resourceKindKey, map[string]*string{
+			"guid":              options.GUID,
+			"name":              options.Name,
+			"resource_group_id": options.ResourceGroupID,
+			"resource_id":       options.ResourceID,
+		})
+	}
+	client.awaitWrites(ctx, writes, func() (versions []resourceVersion, retry bool) {
+		result, response, err = client.ResourceControllerV2.ListResourceKeysWithContext(ctx, listResourceKeysOptions)
+		if err != nil || result.NextURL != nil {
+			return nil, false
+		}
+		for _, key := range result.Resources {
+			versions = append(versions, resourceVersion{core.StringNilMapper(key.ID), key.UpdatedAt})
+		}
+		return versions, true
+	})
+	return
+}
+
+// CreateResourceBinding creates a binding and tracks it until it is visible to reads.
+func (client *ReadAfterWriteClient) CreateResourceBinding(createResourceBindingOptions *CreateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error) {
+	return client.CreateResourceBindingWithContext(context.Background(), createResourceBindingOptions)
+}
+
+// CreateResourceBindingWithContext is an alternate form of the CreateResourceBinding method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) CreateResourceBindingWithContext(ctx context.Context, createResourceBindingOptions *CreateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error) {
+	result, response, err = client.ResourceControllerV2.CreateResourceBindingWithContext(ctx, createResourceBindingOptions)
+	if err == nil {
+		client.recordBinding(result)
+	}
+	return
+}
+
+// UpdateResourceBinding updates a binding and tracks it until the update is visible to reads.
+func (client *ReadAfterWriteClient) UpdateResourceBinding(updateResourceBindingOptions *UpdateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error) {
+	return client.UpdateResourceBindingWithContext(context.Background(), updateResourceBindingOptions)
+}
+
+// UpdateResourceBindingWithContext is an alternate form of the UpdateResourceBinding method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) UpdateResourceBindingWithContext(ctx context.Context, updateResourceBindingOptions *UpdateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error) {
+	result, response, err = client.ResourceControllerV2.UpdateResourceBindingWithContext(ctx, updateResourceBindingOptions)
+	if err == nil {
+		client.recordBinding(result)
+	}
+	return
+}
+
+// DeleteResourceBinding deletes a binding and stops tracking its writes.
+func (client *ReadAfterWriteClient) DeleteResourceBinding(deleteResourceBindingOptions *DeleteResourceBindingOptions) (response *core.DetailedResponse, err error) {
+	return client.DeleteResourceBindingWithContext(context.Background(), deleteResourceBindingOptions)
+}
+
+// DeleteResourceBindingWithContext is an alternate form of the DeleteResourceBinding method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) DeleteResourceBindingWithContext(ctx context.Context, deleteResourceBindingOptions *DeleteResourceBindingOptions) (response *core.DetailedResponse, err error) {
+	response, err = client.ResourceControllerV2.DeleteResourceBindingWithContext(ctx, deleteResourceBindingOptions)
+	if err == nil {
+		client.forget(resourceKindBinding, core.StringNilMapper(deleteResourceBindingOptions.ID))
+	}
+	return
+}
+
+// GetResourceBinding retrieves a binding, retrying until the writes made to it through the client are visible.
+func (client *ReadAfterWriteClient) GetResourceBinding(getResourceBindingOptions *GetResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error) {
+	return client.GetResourceBindingWithContext(context.Background(), getResourceBindingOptions)
+}
+
+// GetResourceBindingWithContext is an alternate form of the GetResourceBinding method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) GetResourceBindingWithContext(ctx context.Context, getResourceBindingOptions *GetResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error) {
+	var writes []*pendingWrite
+	if getResourceBindingOptions != nil {
+		writes = client.pendingForGet(resourceKindBinding, core.StringNilMapper(getResourceBindingOptions.ID))
+	}
+	client.awaitWrites(ctx, writes, func() ([]resourceVersion, bool) {
+		result, response, err = client.ResourceControllerV2.GetResourceBindingWithContext(ctx, getResourceBindingOptions)
+		if err != nil {
+			return nil, isNotFound(response)
+		}
+		return []resourceVersion{{core.StringNilMapper(result.ID), result.UpdatedAt}}, true
+	})
+	return
+}
+
+// ListResourceBindings lists bindings, retrying until the writes made through the client to the bindings that match
+// the filters are visible.
+func (client *ReadAfterWriteClient) ListResourceBindings(listResourceBindingsOptions *ListResourceBindingsOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error) {
+	return client.ListResourceBindingsWithContext(context.Background(), listResourceBindingsOptions)
+}
+
+// ListResourceBindingsWithContext is an alternate form of the ListResourceBindings method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) ListResourceBindingsWithContext(ctx context.Context, listResourceBindingsOptions *ListResourceBindingsOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error) {
+	var writes []*pendingWrite
+	if options := listResourceBindingsOptions; options != nil && options.Start == nil && options.UpdatedFrom == nil && options.UpdatedTo == nil {
+		writes = client.pendingForList(resourceKindBinding, map[string]*string{
+			"guid":              options.GUID,
+			"name":              options.Name,
+			"resource_group_id": options.ResourceGroupID,
+			"resource_id":       options.ResourceID,
+			"region_binding_id": options.RegionBindingID,
+		})
+	}
+	client.awaitWrites(ctx, writes, func() (versions []resourceVersion, retry bool) {
+		result, response, err = client.ResourceControllerV2.ListResourceBindingsWithContext(ctx, listResourceBindingsOptions)
+		if err != nil || result.NextURL != nil {
+			return nil, false
+		}
+		for _, binding := range result.Resources {
+			versions = append(versions, resourceVersion{core.StringNilMapper(binding.ID), binding.UpdatedAt})
+		}
+		return versions, true
+	})
+	return
+}
+
+// CreateResourceAlias creates an alias and tracks it until it is visible to reads.
+func (client *ReadAfterWriteClient) CreateResourceAlias(createResourceAliasOptions *CreateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error) {
+	return client.CreateResourceAliasWithContext(context.Background(), createResourceAliasOptions)
+}
+
+// CreateResourceAliasWithContext is an alternate form of the CreateResourceAlias method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) CreateResourceAliasWithContext(ctx context.Context, createResourceAliasOptions *CreateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error) {
+	result, response, err = client.ResourceControllerV2.CreateResourceAliasWithContext(ctx, createResourceAliasOptions)
+	if err == nil {
+		client.recordAlias(result)
+	}
+	return
+}
+
+// UpdateResourceAlias updates an alias and tracks it until the update is visible to reads.
+func (client *ReadAfterWriteClient) UpdateResourceAlias(updateResourceAliasOptions *UpdateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error) {
+	return client.UpdateResourceAliasWithContext(context.Background(), updateResourceAliasOptions)
+}
+
+// UpdateResourceAliasWithContext is an alternate form of the UpdateResourceAlias method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) UpdateResourceAliasWithContext(ctx context.Context, updateResourceAliasOptions *UpdateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error) {
+	result, response, err = client.ResourceControllerV2.UpdateResourceAliasWithContext(ctx, updateResourceAliasOptions)
+	if err == nil {
+		client.recordAlias(result)
+	}
+	return
+}
+
+// DeleteResourceAlias deletes an alias and stops tracking its writes.
+func (client *ReadAfterWriteClient) DeleteResourceAlias(deleteResourceAliasOptions *DeleteResourceAliasOptions) (response *core.DetailedResponse, err error) {
+	return client.DeleteResourceAliasWithContext(context.Background(), deleteResourceAliasOptions)
+}
+
+// DeleteResourceAliasWithContext is an alternate form of the DeleteResourceAlias method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) DeleteResourceAliasWithContext(ctx context.Context, deleteResourceAliasOptions *DeleteResourceAliasOptions) (response *core.DetailedResponse, err error) {
+	response, err = client.ResourceControllerV2.DeleteResourceAliasWithContext(ctx, deleteResourceAliasOptions)
+	if err == nil {
+		client.forget(resourceKindAlias, core.StringNilMapper(deleteResourceAliasOptions.ID))
+	}
+	return
+}
+
+// GetResourceAlias retrieves an alias, retrying until the writes made to it through the client are visible.
+func (client *ReadAfterWriteClient) GetResourceAlias(getResourceAliasOptions *GetResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error) {
+	return client.GetResourceAliasWithContext(context.Background(), getResourceAliasOptions)
+}
+
+// GetResourceAliasWithContext is an alternate form of the GetResourceAlias method which supports a Context parameter.
+func (client *ReadAfterWriteClient) GetResourceAliasWithContext(ctx context.Context, getResourceAliasOptions *GetResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error) {
+	var writes []*pendingWrite
+	if getResourceAliasOptions != nil {
+		writes = client.pendingForGet(resourceKindAlias, core.StringNilMapper(getResourceAliasOptions.ID))
+	}
+	client.awaitWrites(ctx, writes, func() ([]resourceVersion, bool) {
+		result, response, err = client.ResourceControllerV2.GetResourceAliasWithContext(ctx, getResourceAliasOptions)
+		if err != nil {
+			return nil, isNotFound(response)
+		}
+		return []resourceVersion{{core.StringNilMapper(result.ID), result.UpdatedAt}}, true
+	})
+	return
+}
+
+// ListResourceAliases lists aliases, retrying until the writes made through the client to the aliases that match the
+// filters are visible.
+func (client *ReadAfterWriteClient) ListResourceAliases(listResourceAliasesOptions *ListResourceAliasesOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error) {
+	return client.ListResourceAliasesWithContext(context.Background(), listResourceAliasesOptions)
+}
+
+// ListResourceAliasesWithContext is an alternate form of the ListResourceAliases method which supports a Context
+// parameter.
+func (client *ReadAfterWriteClient) ListResourceAliasesWithContext(ctx context.Context, listResourceAliasesOptions *ListResourceAliasesOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error) {
+	var writes []*pendingWrite
+	if options := listResourceAliasesOptions; options != nil && options.Start == nil && options.UpdatedFrom == nil && options.UpdatedTo == nil {
+		writes = client.pendingForList(resourceKindAlias, map[string]*string{
+			"guid":                 options.GUID,
+			"name":                 options.Name,
+			"resource_instance_id": options.ResourceInstanceID,
+			"region_instance_id":   options.RegionInstanceID,
+			"resource_id":          options.ResourceID,
+			"resource_group_id":    options.ResourceGroupID,
+		})
+	}
+	client.awaitWrites(ctx, writes, func() (versions []resourceVersion, retry bool) {
+		result, response, err = client.ResourceControllerV2.ListResourceAliasesWithContext(ctx, listResourceAliasesOptions)
+		if err != nil || result.NextURL != nil {
+			return nil, false
+		}
+		for _, alias := range result.Resources {
+			versions = append(versions, resourceVersion{core.StringNilMapper(alias.ID), alias.UpdatedAt})
+		}
+		return versions, true
+	})
+	return
+}
+
+// recordInstance tracks an instance returned by a write.
+func (client *ReadAfterWriteClient) recordInstance(instance *ResourceInstance) {
+	if instance == nil {
+		return
+	}
+	client.record(resourceKindInstance, instance.UpdatedAt, []*string{instance.ID, instance.GUID, instance.CRN}, map[string]*string{
+		"guid":              instance.GUID,
+		"name":              instance.Name,
+		"resource_group_id": instance.ResourceGroupID,
+		"resource_id":       instance.ResourceID,
+		"resource_plan_id":  instance.ResourcePlanID,
+		"type":              instance.Type,
+		"sub_type":          instance.SubType,
+		"state":             instance.State,
+	})
+}
+
+// recordKey tracks a key returned by a write.
+func (client *ReadAfterWriteClient) recordKey(key *ResourceKey) {
+	if key == nil {
+		return
+	}
+	client.record(resourceKindKey, key.UpdatedAt, []*string{key.ID, key.GUID, key.CRN}, map[string]*string{
+		"guid":              key.GUID,
+		"name":              key.Name,
+		"resource_group_id": key.ResourceGroupID,
+		"resource_id":       key.ResourceID,
+	})
+}
+
+// recordBinding tracks a binding returned by a write.
+func (client *ReadAfterWriteClient) recordBinding(binding *ResourceBinding) {
+	if binding == nil {
+		return
+	}
+	client.record(resourceKindBinding, binding.UpdatedAt, []*string{binding.ID, binding.GUID, binding.CRN}, map[string]*string{
+		"guid":              binding.GUID,
+		"name":              binding.Name,
+		"resource_group_id": binding.ResourceGroupID,
+		"resource_id":       binding.ResourceID,
+		"region_binding_id": binding.RegionBindingID,
+	})
+}
+
+// recordAlias tracks an alias returned by a write.
+func (client *ReadAfterWriteClient) recordAlias(alias *ResourceAlias) {
+	if alias == nil {
+		return
+	}
+	client.record(resourceKindAlias, alias.UpdatedAt, []*string{alias.ID, alias.GUID, alias.CRN}, map[string]*string{
+		"guid":                 alias.GUID,
+		"name":                 alias.Name,
+		"resource_instance_id": alias.ResourceInstanceID,
+		"region_instance_id":   alias.RegionInstanceID,
+		"resource_id":          alias.ResourceID,
+		"resource_group_id":    alias.ResourceGroupID,
+	})
+}
+
+// record tracks a write, replacing any earlier write to the same resource.
+func (client *ReadAfterWriteClient) record(kind string, updatedAt *strfmt.DateTime, identifiers []*string, attributes map[string]*string) {
+	write := &pendingWrite{kind: kind, attributes: make(map[string]string), deadline: time.Now().Add(client.timeout())}
+	for _, identifier := range identifiers {
+		if identifier != nil && *identifier != "" {
+			write.identifiers = append(write.identifiers, *identifier)
+		}
+	}
+	if len(write.identifiers) == 0 {
+		return
+	}
+	if updatedAt != nil {
+		write.updatedAt = time.Time(*updatedAt)
+	}
+	for name, value := range attributes {
+		if value != nil {
+			write.attributes[name] = *value
+		}
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	if client.pending == nil {
+		client.pending = make(map[string][]*pendingWrite)
+	}
+	client.removeLocked(kind, write.identifiers[0])
+	client.pending[kind] = append(client.pending[kind], write)
+}
+
+// forget stops tracking the writes to a resource.
+func (client *ReadAfterWriteClient) forget(kind string, identifier string) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.removeLocked(kind, identifier)
+}
+
+// removeLocked removes the pending write to a resource, if any, and the writes whose deadline has passed. The mutex
+// must be held.
+func (client *ReadAfterWriteClient) removeLocked(kind string, identifier string) {
+	if len(client.pending[kind]) == 0 {
+		return
+	}
+	now := time.Now()
+	writes := client.pending[kind][:0]
+	for _, write := range client.pending[kind] {
+		if now.Before(write.deadline) && !write.identifiedBy(identifier) {
+			writes = append(writes, write)
+		}
+	}
+	client.pending[kind] = writes
+}
+
+// pendingForGet returns the pending write to the resource with the specified ID, GUID or CRN.
+func (client *ReadAfterWriteClient) pendingForGet(kind string, identifier string) []*pendingWrite {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.removeLocked(kind, "")
+	for _, write := range client.pending[kind] {
+		if write.identifiedBy(identifier) {
+			return []*pendingWrite{write}
+		}
+	}
+	return nil
+}
+
+// pendingForList returns the pending writes to the resources that match all the non-nil list filters.
+func (client *ReadAfterWriteClient) pendingForList(kind string, filters map[string]*string) (writes []*pendingWrite) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.removeLocked(kind, "")
+	for _, write := range client.pending[kind] {
+		matches := true
+		for name, value := range filters {
+			if value != nil && write.attributes[name] != *value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			writes = append(writes, write)
+		}
+	}
+	return
+}
+
+// awaitWrites calls "read" until the resources that it returns include every write in "writes", the deadline of the
+// writes passes, or "read" returns false (its result cannot show the writes, for example because it failed). The
+// writes that are observed are no longer tracked. "read" is called once if "writes" is empty.
+func (client *ReadAfterWriteClient) awaitWrites(ctx context.Context, writes []*pendingWrite, read func() (versions []resourceVersion, retry bool)) {
+	if len(writes) == 0 {
+		read()
+		return
+	}
+	deadline := writes[0].deadline
+	for _, write := range writes[1:] {
+		if write.deadline.After(deadline) {
+			deadline = write.deadline
+		}
+	}
+	pollConfig := client.PollConfig
+	pollConfig.Timeout = 0
+	waitCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	// The reads use "ctx" rather than the context of the wait, so that the last read is not interrupted by the
+	// deadline and its response is returned as is.
+	_ = pollWithBackoff(waitCtx, pollConfig, func(context.Context) (done bool, err error) {
+		versions, retry := read()
+		if !retry {
+			return true, nil
+		}
+		remaining := writes[:0]
+		for _, write := range writes {
+			if write.observedIn(versions) {
+				client.forget(write.kind, write.identifiers[0])
+			} else {
+				remaining = append(remaining, write)
+			}
+		}
+		writes = remaining
+		return len(writes) == 0, nil
+	})
+}
+
+// timeout returns the maximum time during which reads are retried after a write.
+func (client *ReadAfterWriteClient) timeout() time.Duration {
+	if client.PollConfig.Timeout > 0 {
+		return client.PollConfig.Timeout
+	}
+	return DefaultReadAfterWritePollConfig.Timeout
+}
+
+// identifiedBy returns true if the ID, GUID or CRN of the resource of the write is "identifier".
+func (write *pendingWrite) identifiedBy(identifier string) bool {
+	for _, writeIdentifier := range write.identifiers {
+		if writeIdentifier == identifier {
+			return true
+		}
+	}
+	return false
+}
+
+// observedIn returns true if "versions" include the resource of the write, at least as recent as the write.
+func (write *pendingWrite) observedIn(versions []resourceVersion) bool {
+	for _, version := range versions {
+		if !write.identifiedBy(version.id) {
+			continue
+		}
+		if write.updatedAt.IsZero() {
+			return true
+		}
+		return version.updatedAt != nil && !time.Time(*version.updatedAt).Before(write.updatedAt)
+	}
+	return false
+}
+
+// isNotFound returns true if "response" has status code 404.
+func isNotFound(response *core.DetailedResponse) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
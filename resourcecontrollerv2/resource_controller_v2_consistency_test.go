@@ -0,0 +1,145 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 read-after-write consistency`, func() {
+	var testServer *httptest.Server
+	var client *resourcecontrollerv2.ReadAfterWriteClient
+	// The number of reads after which each read operation returns the written version.
+	var staleReads int
+	var reads map[string]int
+	BeforeEach(func() {
+		staleReads = 2
+		reads = map[string]int{}
+		instance := func(updatedAt string) string {
+			return fmt.Sprintf(`{"id": "crn:v1:inst", "guid": "inst", "name": "db", "resource_group_id": "rg", "updated_at": "%s"}`, updatedAt)
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			request := req.Method + " " + req.URL.Path
+			res.Header().Set("Content-type", "application/json")
+			if req.Method != http.MethodGet {
+				res.WriteHeader(201)
+				fmt.Fprint(res, instance("2022-03-01T10:00:00Z"))
+				return
+			}
+			reads[request]++
+			visible := reads[request] > staleReads
+			switch request {
+			case "GET /v2/resource_instances/inst":
+				if !visible {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"message": "not found"}`)
+					return
+				}
+				fmt.Fprint(res, instance("2022-03-01T10:00:00Z"))
+			case "GET /v2/resource_instances/crn:v1:inst":
+				if !visible {
+					fmt.Fprint(res, instance("2022-02-01T10:00:00Z"))
+					return
+				}
+				fmt.Fprint(res, instance("2022-03-01T10:00:00Z"))
+			case "GET /v2/resource_instances":
+				resources := ""
+				if visible {
+					resources = instance("2022-03-01T10:00:00Z")
+				}
+				fmt.Fprintf(res, `{"rows_count": 1, "next_url": null, "resources": [%s]}`, resources)
+			default:
+				Fail("unexpected request " + request)
+			}
+		}))
+		resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		client = resourcecontrollerv2.NewReadAfterWriteClient(resourceControllerService)
+		client.PollConfig = resourcecontrollerv2.PollConfig{InitialInterval: time.Millisecond, Timeout: time.Second}
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	create := func() {
+		_, _, err := client.CreateResourceInstance(client.NewCreateResourceInstanceOptions("db", "global", "rg", "plan"))
+		Expect(err).To(BeNil())
+	}
+
+	It(`Retries a get until the created instance is found`, func() {
+		create()
+		instance, _, err := client.GetResourceInstance(client.NewGetResourceInstanceOptions("inst"))
+		Expect(err).To(BeNil())
+		Expect(*instance.GUID).To(Equal("inst"))
+		Expect(reads["GET /v2/resource_instances/inst"]).To(Equal(3))
+
+		// Once observed, the write is no longer tracked.
+		staleReads = 10
+		_, response, err := client.GetResourceInstance(client.NewGetResourceInstanceOptions("inst"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+		Expect(reads["GET /v2/resource_instances/inst"]).To(Equal(4))
+	})
+	It(`Retries a get until the update is visible`, func() {
+		_, _, err := client.UpdateResourceInstance(client.NewUpdateResourceInstanceOptions("crn:v1:inst").SetName("db"))
+		Expect(err).To(BeNil())
+		instance, _, err := client.GetResourceInstance(client.NewGetResourceInstanceOptions("crn:v1:inst"))
+		Expect(err).To(BeNil())
+		Expect(instance.UpdatedAt.String()).To(HavePrefix("2022-03-01"))
+		Expect(reads["GET /v2/resource_instances/crn:v1:inst"]).To(Equal(3))
+	})
+	It(`Retries a list whose filters match the created instance`, func() {
+		create()
+		list, _, err := client.ListResourceInstances(client.NewListResourceInstancesOptions().SetResourceGroupID("other"))
+		Expect(err).To(BeNil())
+		Expect(list.Resources).To(BeEmpty())
+		Expect(reads["GET /v2/resource_instances"]).To(Equal(1))
+
+		list, _, err = client.ListResourceInstances(client.NewListResourceInstancesOptions().SetResourceGroupID("rg"))
+		Expect(err).To(BeNil())
+		Expect(list.Resources).To(HaveLen(1))
+		Expect(reads["GET /v2/resource_instances"]).To(Equal(3))
+	})
+	It(`Gives up after the timeout`, func() {
+		staleReads = 1000
+		client.PollConfig.Timeout = 50 * time.Millisecond
+		create()
+		_, response, err := client.GetResourceInstance(client.NewGetResourceInstanceOptions("inst"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+		Expect(reads["GET /v2/resource_instances/inst"]).To(BeNumerically(">", 1))
+	})
+	It(`Passes reads through when nothing was written`, func() {
+		staleReads = 1000
+		_, _, err := client.GetResourceInstance(client.NewGetResourceInstanceOptions("inst"))
+		Expect(err).ToNot(BeNil())
+		Expect(reads["GET /v2/resource_instances/inst"]).To(Equal(1))
+	})
+})
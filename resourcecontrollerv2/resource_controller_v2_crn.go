@@ -0,0 +1,70 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// SetSourceCRN : Allow user to set Source from a parsed CRN
+func (options *CreateResourceKeyOptions) SetSourceCRN(source common.CRN) *CreateResourceKeyOptions {
+	options.Source = core.StringPtr(source.String())
+	return options
+}
+
+// SetSourceCRN : Allow user to set Source from a parsed CRN
+func (options *CreateResourceBindingOptions) SetSourceCRN(source common.CRN) *CreateResourceBindingOptions {
+	options.Source = core.StringPtr(source.String())
+	return options
+}
+
+// SetTargetCRN : Allow user to set Target from a parsed CRN
+func (options *CreateResourceBindingOptions) SetTargetCRN(target common.CRN) *CreateResourceBindingOptions {
+	options.Target = core.StringPtr(target.String())
+	return options
+}
+
+// SetTargetCRN : Allow user to set Target from a parsed CRN
+func (options *CreateResourceAliasOptions) SetTargetCRN(target common.CRN) *CreateResourceAliasOptions {
+	options.Target = core.StringPtr(target.String())
+	return options
+}
+
+// ParsedCRN returns the parsed CRN of the resource instance.
+func (resourceInstance *ResourceInstance) ParsedCRN() (common.CRN, error) {
+	return parseModelCRN("resource instance", resourceInstance.ID, resourceInstance.CRN)
+}
+
+// ParsedCRN returns the parsed CRN of the resource key.
+func (resourceKey *ResourceKey) ParsedCRN() (common.CRN, error) {
+	return parseModelCRN("resource key", resourceKey.ID, resourceKey.CRN)
+}
+
+// ParsedSourceCRN returns the parsed CRN of the resource instance or alias that the resource key belongs to.
+func (resourceKey *ResourceKey) ParsedSourceCRN() (common.CRN, error) {
+	return parseModelCRN("resource key source", resourceKey.ID, resourceKey.SourceCRN)
+}
+
+func parseModelCRN(kind string, id *string, crn *string) (common.CRN, error) {
+	if crn == nil {
+		return common.CRN{}, fmt.Errorf("%s '%s' has no CRN", kind, core.StringNilMapper(id))
+	}
+	return common.ParseCRN(*crn)
+}
@@ -0,0 +1,53 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 CRN helpers`, func() {
+	resourceControllerService, _ := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		URL:           "http://resourcecontrollerv2modelgenerator.com",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	instanceCRN := common.NewCRN("kms", "us-south", common.AccountScope("acct1"), "inst1")
+
+	It(`Sets CRN options`, func() {
+		createResourceKeyOptions := resourceControllerService.NewCreateResourceKeyOptions("key", "").SetSourceCRN(instanceCRN)
+		Expect(*createResourceKeyOptions.Source).To(Equal("crn:v1:bluemix:public:kms:us-south:a/acct1:inst1::"))
+		createResourceBindingOptions := resourceControllerService.NewCreateResourceBindingOptions("", "").
+			SetSourceCRN(instanceCRN).SetTargetCRN(instanceCRN.WithResource("app", "a1"))
+		Expect(*createResourceBindingOptions.Target).To(Equal("crn:v1:bluemix:public:kms:us-south:a/acct1:inst1:app:a1"))
+	})
+	It(`Parses model CRNs`, func() {
+		resourceKey := &resourcecontrollerv2.ResourceKey{
+			ID:        core.StringPtr("key1"),
+			SourceCRN: core.StringPtr(instanceCRN.String()),
+		}
+		source, err := resourceKey.ParsedSourceCRN()
+		Expect(err).To(BeNil())
+		Expect(source).To(Equal(instanceCRN))
+		_, err = resourceKey.ParsedCRN()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("resource key 'key1' has no CRN"))
+	})
+})
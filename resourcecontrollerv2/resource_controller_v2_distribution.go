@@ -0,0 +1,161 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// InstanceDistribution : The resource instances of an account grouped by service and region, as returned by
+// GetInstanceDistribution.
+type InstanceDistribution struct {
+	// The instances of each service (by CRN service name) in each region.
+	Instances map[string]map[string][]ResourceInstance
+
+	// The total number of instances.
+	Total int
+}
+
+// DistributionSpec : The desired placement of the instances of a service, checked by InstanceDistribution.Compare.
+type DistributionSpec struct {
+	// The CRN service name of the service (e.g. "cloud-object-storage").
+	ServiceName string
+
+	// The regions that must each have at least MinPerRegion instances of the service.
+	Regions []string
+
+	// The minimum number of distinct regions that must have at least MinPerRegion instances of the service.
+	MinRegions int
+
+	// The minimum number of instances that a region needs to count towards Regions and MinRegions. Defaults to 1.
+	MinPerRegion int
+}
+
+// DistributionViolation : A difference between the placement of the instances of a service and a DistributionSpec.
+type DistributionViolation struct {
+	// The CRN service name of the service.
+	ServiceName string
+
+	// The region that lacks instances, or an empty string if the service is placed in too few regions.
+	Region string
+
+	// A description of the difference.
+	Message string
+}
+
+// Error returns the description of the violation.
+func (violation DistributionViolation) Error() string {
+	return violation.Message
+}
+
+// GetInstanceDistribution lists the resource instances that match "filter" (all the instances of the account if it is
+// nil) and groups them by service and region. The service of an instance is the service name of its CRN, and its
+// region is its region ID (or the region of its CRN if it has none); instances of global services are grouped under
+// the "global" region.
+func (resourceController *ResourceControllerV2) GetInstanceDistribution(ctx context.Context, filter *ListResourceInstancesOptions) (result *InstanceDistribution, err error) {
+	options := resourceController.NewListResourceInstancesOptions()
+	if filter != nil {
+		copied := *filter
+		options = &copied
+		options.Start = nil
+	}
+	instances, err := resourceController.ListAllResourceInstances(ctx, options)
+	if err != nil {
+		return
+	}
+
+	result = &InstanceDistribution{Instances: map[string]map[string][]ResourceInstance{}}
+	for _, instance := range instances {
+		serviceName, region := core.StringNilMapper(instance.ResourceID), core.StringNilMapper(instance.RegionID)
+		if crn, parseErr := instance.ParsedCRN(); parseErr == nil {
+			serviceName = crn.ServiceName()
+			if region == "" {
+				region = crn.Region()
+			}
+		}
+		if region == "" {
+			region = "global"
+		}
+		if result.Instances[serviceName] == nil {
+			result.Instances[serviceName] = map[string][]ResourceInstance{}
+		}
+		result.Instances[serviceName][region] = append(result.Instances[serviceName][region], instance)
+		result.Total++
+	}
+	return
+}
+
+// ServiceNames returns the names of the services that have instances, sorted.
+func (distribution *InstanceDistribution) ServiceNames() (serviceNames []string) {
+	for serviceName := range distribution.Instances {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+	return
+}
+
+// Regions returns the regions in which a service has instances, sorted.
+func (distribution *InstanceDistribution) Regions(serviceName string) (regions []string) {
+	for region := range distribution.Instances[serviceName] {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return
+}
+
+// Count returns the number of instances of a service in a region.
+func (distribution *InstanceDistribution) Count(serviceName string, region string) int {
+	return len(distribution.Instances[serviceName][region])
+}
+
+// Compare returns the violations of the desired distribution "desired", in the order of its specs.
+func (distribution *InstanceDistribution) Compare(desired []DistributionSpec) (violations []DistributionViolation) {
+	for _, spec := range desired {
+		minPerRegion := spec.MinPerRegion
+		if minPerRegion <= 0 {
+			minPerRegion = 1
+		}
+		for _, region := range spec.Regions {
+			if count := distribution.Count(spec.ServiceName, region); count < minPerRegion {
+				violations = append(violations, DistributionViolation{
+					ServiceName: spec.ServiceName,
+					Region:      region,
+					Message: fmt.Sprintf("service '%s' has %d instances in region '%s', but at least %d are required",
+						spec.ServiceName, count, region, minPerRegion),
+				})
+			}
+		}
+		regions := 0
+		for _, region := range distribution.Regions(spec.ServiceName) {
+			if distribution.Count(spec.ServiceName, region) >= minPerRegion {
+				regions++
+			}
+		}
+		if regions < spec.MinRegions {
+			violations = append(violations, DistributionViolation{
+				ServiceName: spec.ServiceName,
+				Message: fmt.Sprintf("service '%s' is placed in %d regions, but at least %d are required",
+					spec.ServiceName, regions, spec.MinRegions),
+			})
+		}
+	}
+	return
+}
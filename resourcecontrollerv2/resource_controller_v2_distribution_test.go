@@ -0,0 +1,85 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 instance distribution`, func() {
+	var testServer *httptest.Server
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+	BeforeEach(func() {
+		instance := func(id string, service string, region string) string {
+			return fmt.Sprintf(`{"id": "%s", "region_id": "%s", "crn": "crn:v1:bluemix:public:%s:%s:a/acct:%s::"}`,
+				id, region, service, region, id)
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v2/resource_instances"))
+			Expect(req.URL.Query().Get("resource_group_id")).To(Equal("rg"))
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Query().Get("start") == "" {
+				fmt.Fprintf(res, `{"rows_count": 2, "next_url": "/v2/resource_instances?start=next", "resources": [%s, %s]}`,
+					instance("db1", "databases-for-postgresql", "us-south"), instance("db2", "databases-for-postgresql", "eu-de"))
+				return
+			}
+			fmt.Fprintf(res, `{"rows_count": 2, "next_url": null, "resources": [%s, %s]}`,
+				instance("db3", "databases-for-postgresql", "us-south"), instance("cos1", "cloud-object-storage", "global"))
+		}))
+		var serviceErr error
+		resourceControllerService, serviceErr = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Groups instances by service and region and compares them with a desired distribution`, func() {
+		filter := resourceControllerService.NewListResourceInstancesOptions().SetResourceGroupID("rg")
+		distribution, err := resourceControllerService.GetInstanceDistribution(context.Background(), filter)
+		Expect(err).To(BeNil())
+		Expect(distribution.Total).To(Equal(4))
+		Expect(distribution.ServiceNames()).To(Equal([]string{"cloud-object-storage", "databases-for-postgresql"}))
+		Expect(distribution.Regions("databases-for-postgresql")).To(Equal([]string{"eu-de", "us-south"}))
+		Expect(distribution.Count("databases-for-postgresql", "us-south")).To(Equal(2))
+		Expect(distribution.Count("cloud-object-storage", "global")).To(Equal(1))
+
+		violations := distribution.Compare([]resourcecontrollerv2.DistributionSpec{
+			{ServiceName: "databases-for-postgresql", Regions: []string{"us-south", "eu-de"}, MinRegions: 2},
+			{ServiceName: "databases-for-postgresql", Regions: []string{"us-east"}, MinRegions: 2, MinPerRegion: 2},
+			{ServiceName: "event-streams", MinRegions: 1},
+		})
+		Expect(violations).To(HaveLen(3))
+		Expect(violations[0].Region).To(Equal("us-east"))
+		Expect(violations[1].ServiceName).To(Equal("databases-for-postgresql"))
+		Expect(violations[1].Error()).To(Equal("service 'databases-for-postgresql' is placed in 1 regions, but at least 2 are required"))
+		Expect(violations[2].ServiceName).To(Equal("event-streams"))
+	})
+})
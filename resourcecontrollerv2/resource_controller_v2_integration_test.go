@@ -161,11 +161,10 @@ var _ = Describe("Resource Controller - Integration Tests", func() {
 		Expect(service).ToNot(BeNil())
 
 		core.SetLogger(core.NewLogger(core.LevelDebug, log.New(GinkgoWriter, "", log.LstdFlags), log.New(GinkgoWriter, "", log.LstdFlags)))
-		service.EnableRetries(4, 30*time.Second)
 
-		//setting timeout to 1 minute
-		service.Service.Client.Timeout = 1 * time.Minute
-		fmt.Fprintln(GinkgoWriter, "Timeout set to: ", service.Service.Client.Timeout)
+		// Apply the timeout and retry policy of the class of each operation.
+		timeouts := common.EnableOperationTimeouts(service.Service, nil)
+		fmt.Fprintln(GinkgoWriter, "Timeout of mutations set to: ", timeouts.Policy(common.OperationClassMutate).Timeout)
 	})
 
 	Describe("Create, Retrieve, and Update Resource Instance", func() {
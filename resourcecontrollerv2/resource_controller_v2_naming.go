@@ -0,0 +1,196 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the NamingPolicyViolation.Rule property.
+const (
+	NamingPolicyViolationRulePatternConst = "pattern"
+	NamingPolicyViolationRulePrefixConst  = "prefix"
+)
+
+// NamingPolicy : The naming conventions that resource instance names must follow.
+type NamingPolicy struct {
+	// If set, the entire instance name must match this expression.
+	Pattern *regexp.Regexp
+
+	// The name prefixes allowed in each environment (for example "dev" or "prod"), keyed by environment name.
+	Prefixes map[string][]string
+
+	// The environment whose entry in Prefixes applies. Prefixes are not checked if the environment has no entry.
+	Environment string
+}
+
+// NamingPolicyViolation : Describes a resource instance name that does not comply with a NamingPolicy.
+type NamingPolicyViolation struct {
+	// The non-compliant name.
+	Name string
+
+	// The environment the name was checked against.
+	Environment string
+
+	// The rule that was violated.
+	Rule string
+
+	// A human-readable description of the violation.
+	Message string
+
+	// The ID of the instance, if the name belongs to an existing instance.
+	InstanceID string
+
+	// The CRN of the instance, if the name belongs to an existing instance.
+	CRN string
+}
+
+// Error returns a description of the naming policy violation.
+func (violation *NamingPolicyViolation) Error() string {
+	return fmt.Sprintf("resource instance name '%s' violates the naming policy: %s", violation.Name, violation.Message)
+}
+
+// Validate checks "name" against the policy and returns a *NamingPolicyViolation if it is not compliant.
+func (policy *NamingPolicy) Validate(name string) error {
+	if policy == nil {
+		return nil
+	}
+
+	if policy.Pattern != nil {
+		// The pattern is anchored so that the leftmost match cannot hide a match of the entire name (e.g. "a|ab").
+		anchored := regexp.MustCompile(`^(?:` + policy.Pattern.String() + `)$`)
+		if !anchored.MatchString(name) {
+			return &NamingPolicyViolation{
+				Name:        name,
+				Environment: policy.Environment,
+				Rule:        NamingPolicyViolationRulePatternConst,
+				Message:     fmt.Sprintf("name must match the pattern '%s'", policy.Pattern.String()),
+			}
+		}
+	}
+
+	if prefixes, ok := policy.Prefixes[policy.Environment]; ok {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(name, prefix) {
+				return nil
+			}
+		}
+		return &NamingPolicyViolation{
+			Name:        name,
+			Environment: policy.Environment,
+			Rule:        NamingPolicyViolationRulePrefixConst,
+			Message: fmt.Sprintf("name must start with one of [%s] in environment '%s'",
+				strings.Join(prefixes, ", "), policy.Environment),
+		}
+	}
+
+	return nil
+}
+
+// NamingPolicyEnforcer : Wraps a ResourceControllerV2 client so that resource instance create and update operations
+// are rejected client-side when the requested name does not comply with a NamingPolicy.
+// All other operations are passed through to the wrapped client unchanged.
+type NamingPolicyEnforcer struct {
+	*ResourceControllerV2
+
+	// The policy applied to instance names.
+	Policy *NamingPolicy
+}
+
+// NewNamingPolicyEnforcer returns a new NamingPolicyEnforcer that applies "policy" to "resourceController".
+func NewNamingPolicyEnforcer(resourceController *ResourceControllerV2, policy *NamingPolicy) *NamingPolicyEnforcer {
+	return &NamingPolicyEnforcer{
+		ResourceControllerV2: resourceController,
+		Policy:               policy,
+	}
+}
+
+// CreateResourceInstance validates the instance name against the naming policy and then creates the instance.
+func (enforcer *NamingPolicyEnforcer) CreateResourceInstance(createResourceInstanceOptions *CreateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	return enforcer.CreateResourceInstanceWithContext(context.Background(), createResourceInstanceOptions)
+}
+
+// CreateResourceInstanceWithContext is an alternate form of the CreateResourceInstance method which supports a Context
+// parameter.
+func (enforcer *NamingPolicyEnforcer) CreateResourceInstanceWithContext(ctx context.Context, createResourceInstanceOptions *CreateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	if createResourceInstanceOptions != nil && createResourceInstanceOptions.Name != nil {
+		err = enforcer.Policy.Validate(*createResourceInstanceOptions.Name)
+		if err != nil {
+			return
+		}
+	}
+	return enforcer.ResourceControllerV2.CreateResourceInstanceWithContext(ctx, createResourceInstanceOptions)
+}
+
+// UpdateResourceInstance validates the new instance name (if any) against the naming policy and then updates the
+// instance.
+func (enforcer *NamingPolicyEnforcer) UpdateResourceInstance(updateResourceInstanceOptions *UpdateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	return enforcer.UpdateResourceInstanceWithContext(context.Background(), updateResourceInstanceOptions)
+}
+
+// UpdateResourceInstanceWithContext is an alternate form of the UpdateResourceInstance method which supports a Context
+// parameter.
+func (enforcer *NamingPolicyEnforcer) UpdateResourceInstanceWithContext(ctx context.Context, updateResourceInstanceOptions *UpdateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	if updateResourceInstanceOptions != nil && updateResourceInstanceOptions.Name != nil {
+		err = enforcer.Policy.Validate(*updateResourceInstanceOptions.Name)
+		if err != nil {
+			return
+		}
+	}
+	return enforcer.ResourceControllerV2.UpdateResourceInstanceWithContext(ctx, updateResourceInstanceOptions)
+}
+
+// AuditInstanceNames lists all resource instances in the account and returns a violation for each instance whose
+// name does not comply with the naming policy.
+func (enforcer *NamingPolicyEnforcer) AuditInstanceNames(ctx context.Context) (violations []NamingPolicyViolation, err error) {
+	options := &ListResourceInstancesOptions{}
+	for {
+		var result *ResourceInstancesList
+		result, _, err = enforcer.ListResourceInstancesWithContext(ctx, options)
+		if err != nil {
+			return
+		}
+
+		for _, instance := range result.Resources {
+			if instance.Name == nil {
+				continue
+			}
+			if validationErr := enforcer.Policy.Validate(*instance.Name); validationErr != nil {
+				violation := *validationErr.(*NamingPolicyViolation)
+				if instance.ID != nil {
+					violation.InstanceID = *instance.ID
+				}
+				if instance.CRN != nil {
+					violation.CRN = *instance.CRN
+				}
+				violations = append(violations, violation)
+			}
+		}
+
+		var start *string
+		start, err = core.GetQueryParam(result.NextURL, "start")
+		if err != nil || start == nil {
+			return
+		}
+		options.Start = start
+	}
+}
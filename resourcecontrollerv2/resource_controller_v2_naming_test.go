@@ -0,0 +1,145 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 naming policy`, func() {
+	var testServer *httptest.Server
+	policy := &resourcecontrollerv2.NamingPolicy{
+		Pattern:     regexp.MustCompile(`[a-z0-9-]+`),
+		Prefixes:    map[string][]string{"dev": {"dev-", "sandbox-"}, "prod": {"prod-"}},
+		Environment: "dev",
+	}
+
+	Describe(`NamingPolicy.Validate`, func() {
+		It(`Accepts compliant names`, func() {
+			Expect(policy.Validate("dev-db")).To(BeNil())
+			Expect(policy.Validate("sandbox-cache")).To(BeNil())
+		})
+		It(`Rejects names that do not match the pattern`, func() {
+			err := policy.Validate("dev-DB")
+			Expect(err).ToNot(BeNil())
+			violation, ok := err.(*resourcecontrollerv2.NamingPolicyViolation)
+			Expect(ok).To(BeTrue())
+			Expect(violation.Rule).To(Equal(resourcecontrollerv2.NamingPolicyViolationRulePatternConst))
+		})
+		It(`Matches the pattern against the entire name`, func() {
+			alternatives := &resourcecontrollerv2.NamingPolicy{Pattern: regexp.MustCompile(`a|ab`)}
+			Expect(alternatives.Validate("ab")).To(BeNil())
+			Expect(alternatives.Validate("abc")).ToNot(BeNil())
+		})
+		It(`Rejects names without an allowed prefix`, func() {
+			err := policy.Validate("prod-db")
+			Expect(err).ToNot(BeNil())
+			violation, ok := err.(*resourcecontrollerv2.NamingPolicyViolation)
+			Expect(ok).To(BeTrue())
+			Expect(violation.Rule).To(Equal(resourcecontrollerv2.NamingPolicyViolationRulePrefixConst))
+			Expect(violation.Environment).To(Equal("dev"))
+		})
+		It(`Skips prefix checks for unknown environments`, func() {
+			stagingPolicy := *policy
+			stagingPolicy.Environment = "staging"
+			Expect(stagingPolicy.Validate("anything")).To(BeNil())
+		})
+	})
+
+	Describe(`NamingPolicyEnforcer`, func() {
+		var requestCount int
+		BeforeEach(func() {
+			requestCount = 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				requestCount++
+
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case req.Method == "POST" && req.URL.EscapedPath() == "/v2/resource_instances":
+					res.WriteHeader(201)
+					fmt.Fprintf(res, "%s", `{"id": "new-id", "name": "dev-db"}`)
+				case req.Method == "PATCH":
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"id": "testString", "name": "dev-renamed"}`)
+				case req.Method == "GET" && req.URL.Query().Get("start") == "":
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"rows_count": 2, "next_url": "/v2/resource_instances?start=page2", "resources": [{"id": "1", "crn": "crn1", "name": "dev-ok"}, {"id": "2", "crn": "crn2", "name": "Bad Name"}]}`)
+				case req.Method == "GET" && req.URL.Query().Get("start") == "page2":
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"rows_count": 1, "next_url": null, "resources": [{"id": "3", "crn": "crn3", "name": "prod-db"}]}`)
+				default:
+					res.WriteHeader(404)
+				}
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		newEnforcer := func() *resourcecontrollerv2.NamingPolicyEnforcer {
+			resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			return resourcecontrollerv2.NewNamingPolicyEnforcer(resourceControllerService, policy)
+		}
+		It(`Rejects non-compliant creates without calling the service`, func() {
+			enforcer := newEnforcer()
+			options := enforcer.NewCreateResourceInstanceOptions("prod-db", "global", "rg", "plan")
+			result, response, err := enforcer.CreateResourceInstance(options)
+			Expect(err).ToNot(BeNil())
+			Expect(result).To(BeNil())
+			Expect(response).To(BeNil())
+			Expect(requestCount).To(Equal(0))
+		})
+		It(`Passes compliant creates and updates through`, func() {
+			enforcer := newEnforcer()
+			result, _, err := enforcer.CreateResourceInstance(enforcer.NewCreateResourceInstanceOptions("dev-db", "global", "rg", "plan"))
+			Expect(err).To(BeNil())
+			Expect(*result.ID).To(Equal("new-id"))
+
+			updateOptions := enforcer.NewUpdateResourceInstanceOptions("testString").SetName("dev-renamed")
+			result, _, err = enforcer.UpdateResourceInstance(updateOptions)
+			Expect(err).To(BeNil())
+			Expect(*result.Name).To(Equal("dev-renamed"))
+
+			_, _, err = enforcer.UpdateResourceInstance(updateOptions.SetName("Renamed"))
+			Expect(err).ToNot(BeNil())
+			Expect(requestCount).To(Equal(2))
+		})
+		It(`Audits existing instance names across pages`, func() {
+			enforcer := newEnforcer()
+			violations, err := enforcer.AuditInstanceNames(context.Background())
+			Expect(err).To(BeNil())
+			Expect(violations).To(HaveLen(2))
+			Expect(violations[0].InstanceID).To(Equal("2"))
+			Expect(violations[0].Rule).To(Equal(resourcecontrollerv2.NamingPolicyViolationRulePatternConst))
+			Expect(violations[1].CRN).To(Equal("crn3"))
+			Expect(violations[1].Rule).To(Equal(resourcecontrollerv2.NamingPolicyViolationRulePrefixConst))
+		})
+	})
+})
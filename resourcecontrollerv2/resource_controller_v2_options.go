@@ -0,0 +1,1607 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package resourcecontrollerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ListResourceInstancesOption : A functional option that sets a property of ListResourceInstancesOptions (see ListResourceInstancesWith).
+type ListResourceInstancesOption func(options *ListResourceInstancesOptions)
+
+// ListResourceInstancesGUID sets the GUID property of ListResourceInstancesOptions. When you provision a new
+// resource in the specified location for the selected plan, a GUID (globally unique identifier) is created.
+func ListResourceInstancesGUID(guid string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.GUID = core.StringPtr(guid)
+	}
+}
+
+// ListResourceInstancesName sets the Name property of ListResourceInstancesOptions. The human-readable name of the
+// instance.
+func ListResourceInstancesName(nameVar string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListResourceInstancesResourceGroupID sets the ResourceGroupID property of ListResourceInstancesOptions. Short ID
+// of a resource group.
+func ListResourceInstancesResourceGroupID(resourceGroupID string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// ListResourceInstancesResourceID sets the ResourceID property of ListResourceInstancesOptions. The unique ID of
+// the offering.
+func ListResourceInstancesResourceID(resourceID string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.ResourceID = core.StringPtr(resourceID)
+	}
+}
+
+// ListResourceInstancesResourcePlanID sets the ResourcePlanID property of ListResourceInstancesOptions. The unique
+// ID of the plan associated with the offering.
+func ListResourceInstancesResourcePlanID(resourcePlanID string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.ResourcePlanID = core.StringPtr(resourcePlanID)
+	}
+}
+
+// ListResourceInstancesType sets the Type property of ListResourceInstancesOptions. The type of the instance, for
+// example, `service_instance`.
+func ListResourceInstancesType(typeVar string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.Type = core.StringPtr(typeVar)
+	}
+}
+
+// ListResourceInstancesSubType sets the SubType property of ListResourceInstancesOptions. The sub-type of
+// instance, for example, `cfaas`.
+func ListResourceInstancesSubType(subType string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.SubType = core.StringPtr(subType)
+	}
+}
+
+// ListResourceInstancesLimit sets the Limit property of ListResourceInstancesOptions. Limit on how many items
+// should be returned.
+func ListResourceInstancesLimit(limit int64) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListResourceInstancesStart sets the Start property of ListResourceInstancesOptions. An optional token that
+// indicates the beginning of the page of results to be returned.
+func ListResourceInstancesStart(start string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListResourceInstancesState sets the State property of ListResourceInstancesOptions. The state of the instance.
+func ListResourceInstancesState(state string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.State = core.StringPtr(state)
+	}
+}
+
+// ListResourceInstancesUpdatedFrom sets the UpdatedFrom property of ListResourceInstancesOptions. Start date
+// inclusive filter.
+func ListResourceInstancesUpdatedFrom(updatedFrom string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.UpdatedFrom = core.StringPtr(updatedFrom)
+	}
+}
+
+// ListResourceInstancesUpdatedTo sets the UpdatedTo property of ListResourceInstancesOptions. End date inclusive
+// filter.
+func ListResourceInstancesUpdatedTo(updatedTo string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		options.UpdatedTo = core.StringPtr(updatedTo)
+	}
+}
+
+// ListResourceInstancesHeader sets the Headers property of ListResourceInstancesOptions. Allows users to set
+// headers on API requests.
+func ListResourceInstancesHeader(name string, value string) ListResourceInstancesOption {
+	return func(options *ListResourceInstancesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListResourceInstancesOptionsWith returns ListResourceInstancesOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewListResourceInstancesOptionsWith(options ...ListResourceInstancesOption) *ListResourceInstancesOptions {
+	listResourceInstancesOptions := &ListResourceInstancesOptions{}
+	for _, option := range options {
+		option(listResourceInstancesOptions)
+	}
+	return listResourceInstancesOptions
+}
+
+// ListResourceInstancesWith is an alternate form of the ListResourceInstancesWithContext method which takes
+// functional options instead of a ListResourceInstancesOptions struct.
+func (resourceController *ResourceControllerV2) ListResourceInstancesWith(ctx context.Context, options ...ListResourceInstancesOption) (result *ResourceInstancesList, response *core.DetailedResponse, err error) {
+	return resourceController.ListResourceInstancesWithContext(ctx, resourceController.NewListResourceInstancesOptionsWith(options...))
+}
+
+// CreateResourceInstanceOption : A functional option that sets a property of CreateResourceInstanceOptions (see CreateResourceInstanceWith).
+type CreateResourceInstanceOption func(options *CreateResourceInstanceOptions)
+
+// CreateResourceInstanceName sets the Name property of CreateResourceInstanceOptions. The name of the instance.
+func CreateResourceInstanceName(nameVar string) CreateResourceInstanceOption {
+	return func(options *CreateResourceInstanceOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateResourceInstanceTarget sets the Target property of CreateResourceInstanceOptions. The deployment location
+// where the instance should be hosted.
+func CreateResourceInstanceTarget(target string) CreateResourceInstanceOption {
+	return func(options *CreateResourceInstanceOptions) {
+		options.Target = core.StringPtr(target)
+	}
+}
+
+// CreateResourceInstanceResourceGroup sets the ResourceGroup property of CreateResourceInstanceOptions. Short or
+// long ID of resource group.
+func CreateResourceInstanceResourceGroup(resourceGroup string) CreateResourceInstanceOption {
+	return func(options *CreateResourceInstanceOptions) {
+		options.ResourceGroup = core.StringPtr(resourceGroup)
+	}
+}
+
+// CreateResourceInstanceResourcePlanID sets the ResourcePlanID property of CreateResourceInstanceOptions. The
+// unique ID of the plan associated with the offering.
+func CreateResourceInstanceResourcePlanID(resourcePlanID string) CreateResourceInstanceOption {
+	return func(options *CreateResourceInstanceOptions) {
+		options.ResourcePlanID = core.StringPtr(resourcePlanID)
+	}
+}
+
+// CreateResourceInstanceTags sets the Tags property of CreateResourceInstanceOptions. Tags that are attached to
+// the instance after provisioning.
+func CreateResourceInstanceTags(tags ...string) CreateResourceInstanceOption {
+	return func(options *CreateResourceInstanceOptions) {
+		options.Tags = append(options.Tags, tags...)
+	}
+}
+
+// CreateResourceInstanceAllowCleanup sets the AllowCleanup property of CreateResourceInstanceOptions. A boolean
+// that dictates if the resource instance should be deleted (cleaned up) during the processing of a region instance
+// delete call.
+func CreateResourceInstanceAllowCleanup(allowCleanup bool) CreateResourceInstanceOption {
+	return func(options *CreateResourceInstanceOptions) {
+		options.AllowCleanup = core.BoolPtr(allowCleanup)
+	}
+}
+
+// CreateResourceInstanceParameters sets the Parameters property of CreateResourceInstanceOptions. Configuration
+// options represented as key-value pairs that are passed through to the target resource brokers.
+func CreateResourceInstanceParameters(parameters map[string]interface{}) CreateResourceInstanceOption {
+	return func(options *CreateResourceInstanceOptions) {
+		options.Parameters = parameters
+	}
+}
+
+// CreateResourceInstanceEntityLock sets the EntityLock property of CreateResourceInstanceOptions. Indicates if the
+// resource instance is locked for further update or delete operations.
+func CreateResourceInstanceEntityLock(entityLock bool) CreateResourceInstanceOption {
+	return func(options *CreateResourceInstanceOptions) {
+		options.EntityLock = core.BoolPtr(entityLock)
+	}
+}
+
+// CreateResourceInstanceHeader sets the Headers property of CreateResourceInstanceOptions. Allows users to set
+// headers on API requests.
+func CreateResourceInstanceHeader(name string, value string) CreateResourceInstanceOption {
+	return func(options *CreateResourceInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateResourceInstanceOptionsWith returns CreateResourceInstanceOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewCreateResourceInstanceOptionsWith(options ...CreateResourceInstanceOption) *CreateResourceInstanceOptions {
+	createResourceInstanceOptions := &CreateResourceInstanceOptions{}
+	for _, option := range options {
+		option(createResourceInstanceOptions)
+	}
+	return createResourceInstanceOptions
+}
+
+// CreateResourceInstanceWith is an alternate form of the CreateResourceInstanceWithContext method which takes
+// functional options instead of a CreateResourceInstanceOptions struct. CreateResourceInstanceName,
+// CreateResourceInstanceTarget, CreateResourceInstanceResourceGroup and CreateResourceInstanceResourcePlanID are
+// required.
+func (resourceController *ResourceControllerV2) CreateResourceInstanceWith(ctx context.Context, options ...CreateResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	return resourceController.CreateResourceInstanceWithContext(ctx, resourceController.NewCreateResourceInstanceOptionsWith(options...))
+}
+
+// GetResourceInstanceOption : A functional option that sets a property of GetResourceInstanceOptions (see GetResourceInstanceWith).
+type GetResourceInstanceOption func(options *GetResourceInstanceOptions)
+
+// GetResourceInstanceID sets the ID property of GetResourceInstanceOptions. The short or long ID of the instance.
+func GetResourceInstanceID(id string) GetResourceInstanceOption {
+	return func(options *GetResourceInstanceOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetResourceInstanceHeader sets the Headers property of GetResourceInstanceOptions. Allows users to set headers
+// on API requests.
+func GetResourceInstanceHeader(name string, value string) GetResourceInstanceOption {
+	return func(options *GetResourceInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetResourceInstanceOptionsWith returns GetResourceInstanceOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewGetResourceInstanceOptionsWith(options ...GetResourceInstanceOption) *GetResourceInstanceOptions {
+	getResourceInstanceOptions := &GetResourceInstanceOptions{}
+	for _, option := range options {
+		option(getResourceInstanceOptions)
+	}
+	return getResourceInstanceOptions
+}
+
+// GetResourceInstanceWith is an alternate form of the GetResourceInstanceWithContext method which takes functional
+// options instead of a GetResourceInstanceOptions struct. GetResourceInstanceID is required.
+func (resourceController *ResourceControllerV2) GetResourceInstanceWith(ctx context.Context, options ...GetResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	return resourceController.GetResourceInstanceWithContext(ctx, resourceController.NewGetResourceInstanceOptionsWith(options...))
+}
+
+// DeleteResourceInstanceOption : A functional option that sets a property of DeleteResourceInstanceOptions (see DeleteResourceInstanceWith).
+type DeleteResourceInstanceOption func(options *DeleteResourceInstanceOptions)
+
+// DeleteResourceInstanceID sets the ID property of DeleteResourceInstanceOptions. The short or long ID of the
+// instance.
+func DeleteResourceInstanceID(id string) DeleteResourceInstanceOption {
+	return func(options *DeleteResourceInstanceOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// DeleteResourceInstanceRecursive sets the Recursive property of DeleteResourceInstanceOptions. Will delete
+// resource bindings, keys and aliases associated with the instance.
+func DeleteResourceInstanceRecursive(recursive bool) DeleteResourceInstanceOption {
+	return func(options *DeleteResourceInstanceOptions) {
+		options.Recursive = core.BoolPtr(recursive)
+	}
+}
+
+// DeleteResourceInstanceHeader sets the Headers property of DeleteResourceInstanceOptions. Allows users to set
+// headers on API requests.
+func DeleteResourceInstanceHeader(name string, value string) DeleteResourceInstanceOption {
+	return func(options *DeleteResourceInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteResourceInstanceOptionsWith returns DeleteResourceInstanceOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewDeleteResourceInstanceOptionsWith(options ...DeleteResourceInstanceOption) *DeleteResourceInstanceOptions {
+	deleteResourceInstanceOptions := &DeleteResourceInstanceOptions{}
+	for _, option := range options {
+		option(deleteResourceInstanceOptions)
+	}
+	return deleteResourceInstanceOptions
+}
+
+// DeleteResourceInstanceWith is an alternate form of the DeleteResourceInstanceWithContext method which takes
+// functional options instead of a DeleteResourceInstanceOptions struct. DeleteResourceInstanceID is required.
+func (resourceController *ResourceControllerV2) DeleteResourceInstanceWith(ctx context.Context, options ...DeleteResourceInstanceOption) (response *core.DetailedResponse, err error) {
+	return resourceController.DeleteResourceInstanceWithContext(ctx, resourceController.NewDeleteResourceInstanceOptionsWith(options...))
+}
+
+// UpdateResourceInstanceOption : A functional option that sets a property of UpdateResourceInstanceOptions (see UpdateResourceInstanceWith).
+type UpdateResourceInstanceOption func(options *UpdateResourceInstanceOptions)
+
+// UpdateResourceInstanceID sets the ID property of UpdateResourceInstanceOptions. The short or long ID of the
+// instance.
+func UpdateResourceInstanceID(id string) UpdateResourceInstanceOption {
+	return func(options *UpdateResourceInstanceOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UpdateResourceInstanceName sets the Name property of UpdateResourceInstanceOptions. The new name of the
+// instance.
+func UpdateResourceInstanceName(nameVar string) UpdateResourceInstanceOption {
+	return func(options *UpdateResourceInstanceOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateResourceInstanceParameters sets the Parameters property of UpdateResourceInstanceOptions. The new
+// configuration options for the instance.
+func UpdateResourceInstanceParameters(parameters map[string]interface{}) UpdateResourceInstanceOption {
+	return func(options *UpdateResourceInstanceOptions) {
+		options.Parameters = parameters
+	}
+}
+
+// UpdateResourceInstanceResourcePlanID sets the ResourcePlanID property of UpdateResourceInstanceOptions. The
+// unique ID of the plan associated with the offering.
+func UpdateResourceInstanceResourcePlanID(resourcePlanID string) UpdateResourceInstanceOption {
+	return func(options *UpdateResourceInstanceOptions) {
+		options.ResourcePlanID = core.StringPtr(resourcePlanID)
+	}
+}
+
+// UpdateResourceInstanceAllowCleanup sets the AllowCleanup property of UpdateResourceInstanceOptions. A boolean
+// that dictates if the resource instance should be deleted (cleaned up) during the processing of a region instance
+// delete call.
+func UpdateResourceInstanceAllowCleanup(allowCleanup bool) UpdateResourceInstanceOption {
+	return func(options *UpdateResourceInstanceOptions) {
+		options.AllowCleanup = core.BoolPtr(allowCleanup)
+	}
+}
+
+// UpdateResourceInstanceHeader sets the Headers property of UpdateResourceInstanceOptions. Allows users to set
+// headers on API requests.
+func UpdateResourceInstanceHeader(name string, value string) UpdateResourceInstanceOption {
+	return func(options *UpdateResourceInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateResourceInstanceOptionsWith returns UpdateResourceInstanceOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewUpdateResourceInstanceOptionsWith(options ...UpdateResourceInstanceOption) *UpdateResourceInstanceOptions {
+	updateResourceInstanceOptions := &UpdateResourceInstanceOptions{}
+	for _, option := range options {
+		option(updateResourceInstanceOptions)
+	}
+	return updateResourceInstanceOptions
+}
+
+// UpdateResourceInstanceWith is an alternate form of the UpdateResourceInstanceWithContext method which takes
+// functional options instead of an UpdateResourceInstanceOptions struct. UpdateResourceInstanceID is required.
+func (resourceController *ResourceControllerV2) UpdateResourceInstanceWith(ctx context.Context, options ...UpdateResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	return resourceController.UpdateResourceInstanceWithContext(ctx, resourceController.NewUpdateResourceInstanceOptionsWith(options...))
+}
+
+// ListResourceAliasesForInstanceOption : A functional option that sets a property of ListResourceAliasesForInstanceOptions (see ListResourceAliasesForInstanceWith).
+type ListResourceAliasesForInstanceOption func(options *ListResourceAliasesForInstanceOptions)
+
+// ListResourceAliasesForInstanceID sets the ID property of ListResourceAliasesForInstanceOptions. The short or
+// long ID of the instance.
+func ListResourceAliasesForInstanceID(id string) ListResourceAliasesForInstanceOption {
+	return func(options *ListResourceAliasesForInstanceOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// ListResourceAliasesForInstanceLimit sets the Limit property of ListResourceAliasesForInstanceOptions. Limit on
+// how many items should be returned.
+func ListResourceAliasesForInstanceLimit(limit int64) ListResourceAliasesForInstanceOption {
+	return func(options *ListResourceAliasesForInstanceOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListResourceAliasesForInstanceStart sets the Start property of ListResourceAliasesForInstanceOptions. An
+// optional token that indicates the beginning of the page of results to be returned.
+func ListResourceAliasesForInstanceStart(start string) ListResourceAliasesForInstanceOption {
+	return func(options *ListResourceAliasesForInstanceOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListResourceAliasesForInstanceHeader sets the Headers property of ListResourceAliasesForInstanceOptions. Allows
+// users to set headers on API requests.
+func ListResourceAliasesForInstanceHeader(name string, value string) ListResourceAliasesForInstanceOption {
+	return func(options *ListResourceAliasesForInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListResourceAliasesForInstanceOptionsWith returns ListResourceAliasesForInstanceOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewListResourceAliasesForInstanceOptionsWith(options ...ListResourceAliasesForInstanceOption) *ListResourceAliasesForInstanceOptions {
+	listResourceAliasesForInstanceOptions := &ListResourceAliasesForInstanceOptions{}
+	for _, option := range options {
+		option(listResourceAliasesForInstanceOptions)
+	}
+	return listResourceAliasesForInstanceOptions
+}
+
+// ListResourceAliasesForInstanceWith is an alternate form of the ListResourceAliasesForInstanceWithContext method
+// which takes functional options instead of a ListResourceAliasesForInstanceOptions struct.
+// ListResourceAliasesForInstanceID is required.
+func (resourceController *ResourceControllerV2) ListResourceAliasesForInstanceWith(ctx context.Context, options ...ListResourceAliasesForInstanceOption) (result *ResourceAliasesList, response *core.DetailedResponse, err error) {
+	return resourceController.ListResourceAliasesForInstanceWithContext(ctx, resourceController.NewListResourceAliasesForInstanceOptionsWith(options...))
+}
+
+// ListResourceKeysForInstanceOption : A functional option that sets a property of ListResourceKeysForInstanceOptions (see ListResourceKeysForInstanceWith).
+type ListResourceKeysForInstanceOption func(options *ListResourceKeysForInstanceOptions)
+
+// ListResourceKeysForInstanceID sets the ID property of ListResourceKeysForInstanceOptions. The short or long ID
+// of the instance.
+func ListResourceKeysForInstanceID(id string) ListResourceKeysForInstanceOption {
+	return func(options *ListResourceKeysForInstanceOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// ListResourceKeysForInstanceLimit sets the Limit property of ListResourceKeysForInstanceOptions. Limit on how
+// many items should be returned.
+func ListResourceKeysForInstanceLimit(limit int64) ListResourceKeysForInstanceOption {
+	return func(options *ListResourceKeysForInstanceOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListResourceKeysForInstanceStart sets the Start property of ListResourceKeysForInstanceOptions. An optional
+// token that indicates the beginning of the page of results to be returned.
+func ListResourceKeysForInstanceStart(start string) ListResourceKeysForInstanceOption {
+	return func(options *ListResourceKeysForInstanceOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListResourceKeysForInstanceHeader sets the Headers property of ListResourceKeysForInstanceOptions. Allows users
+// to set headers on API requests.
+func ListResourceKeysForInstanceHeader(name string, value string) ListResourceKeysForInstanceOption {
+	return func(options *ListResourceKeysForInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListResourceKeysForInstanceOptionsWith returns ListResourceKeysForInstanceOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewListResourceKeysForInstanceOptionsWith(options ...ListResourceKeysForInstanceOption) *ListResourceKeysForInstanceOptions {
+	listResourceKeysForInstanceOptions := &ListResourceKeysForInstanceOptions{}
+	for _, option := range options {
+		option(listResourceKeysForInstanceOptions)
+	}
+	return listResourceKeysForInstanceOptions
+}
+
+// ListResourceKeysForInstanceWith is an alternate form of the ListResourceKeysForInstanceWithContext method which
+// takes functional options instead of a ListResourceKeysForInstanceOptions struct. ListResourceKeysForInstanceID
+// is required.
+func (resourceController *ResourceControllerV2) ListResourceKeysForInstanceWith(ctx context.Context, options ...ListResourceKeysForInstanceOption) (result *ResourceKeysList, response *core.DetailedResponse, err error) {
+	return resourceController.ListResourceKeysForInstanceWithContext(ctx, resourceController.NewListResourceKeysForInstanceOptionsWith(options...))
+}
+
+// LockResourceInstanceOption : A functional option that sets a property of LockResourceInstanceOptions (see LockResourceInstanceWith).
+type LockResourceInstanceOption func(options *LockResourceInstanceOptions)
+
+// LockResourceInstanceID sets the ID property of LockResourceInstanceOptions. The short or long ID of the
+// instance.
+func LockResourceInstanceID(id string) LockResourceInstanceOption {
+	return func(options *LockResourceInstanceOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// LockResourceInstanceHeader sets the Headers property of LockResourceInstanceOptions. Allows users to set headers
+// on API requests.
+func LockResourceInstanceHeader(name string, value string) LockResourceInstanceOption {
+	return func(options *LockResourceInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewLockResourceInstanceOptionsWith returns LockResourceInstanceOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewLockResourceInstanceOptionsWith(options ...LockResourceInstanceOption) *LockResourceInstanceOptions {
+	lockResourceInstanceOptions := &LockResourceInstanceOptions{}
+	for _, option := range options {
+		option(lockResourceInstanceOptions)
+	}
+	return lockResourceInstanceOptions
+}
+
+// LockResourceInstanceWith is an alternate form of the LockResourceInstanceWithContext method which takes
+// functional options instead of a LockResourceInstanceOptions struct. LockResourceInstanceID is required.
+func (resourceController *ResourceControllerV2) LockResourceInstanceWith(ctx context.Context, options ...LockResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	return resourceController.LockResourceInstanceWithContext(ctx, resourceController.NewLockResourceInstanceOptionsWith(options...))
+}
+
+// UnlockResourceInstanceOption : A functional option that sets a property of UnlockResourceInstanceOptions (see UnlockResourceInstanceWith).
+type UnlockResourceInstanceOption func(options *UnlockResourceInstanceOptions)
+
+// UnlockResourceInstanceID sets the ID property of UnlockResourceInstanceOptions. The short or long ID of the
+// instance.
+func UnlockResourceInstanceID(id string) UnlockResourceInstanceOption {
+	return func(options *UnlockResourceInstanceOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UnlockResourceInstanceHeader sets the Headers property of UnlockResourceInstanceOptions. Allows users to set
+// headers on API requests.
+func UnlockResourceInstanceHeader(name string, value string) UnlockResourceInstanceOption {
+	return func(options *UnlockResourceInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUnlockResourceInstanceOptionsWith returns UnlockResourceInstanceOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewUnlockResourceInstanceOptionsWith(options ...UnlockResourceInstanceOption) *UnlockResourceInstanceOptions {
+	unlockResourceInstanceOptions := &UnlockResourceInstanceOptions{}
+	for _, option := range options {
+		option(unlockResourceInstanceOptions)
+	}
+	return unlockResourceInstanceOptions
+}
+
+// UnlockResourceInstanceWith is an alternate form of the UnlockResourceInstanceWithContext method which takes
+// functional options instead of an UnlockResourceInstanceOptions struct. UnlockResourceInstanceID is required.
+func (resourceController *ResourceControllerV2) UnlockResourceInstanceWith(ctx context.Context, options ...UnlockResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	return resourceController.UnlockResourceInstanceWithContext(ctx, resourceController.NewUnlockResourceInstanceOptionsWith(options...))
+}
+
+// CancelLastopResourceInstanceOption : A functional option that sets a property of CancelLastopResourceInstanceOptions (see CancelLastopResourceInstanceWith).
+type CancelLastopResourceInstanceOption func(options *CancelLastopResourceInstanceOptions)
+
+// CancelLastopResourceInstanceID sets the ID property of CancelLastopResourceInstanceOptions. The resource
+// instance URL-encoded CRN or GUID.
+func CancelLastopResourceInstanceID(id string) CancelLastopResourceInstanceOption {
+	return func(options *CancelLastopResourceInstanceOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// CancelLastopResourceInstanceHeader sets the Headers property of CancelLastopResourceInstanceOptions. Allows
+// users to set headers on API requests.
+func CancelLastopResourceInstanceHeader(name string, value string) CancelLastopResourceInstanceOption {
+	return func(options *CancelLastopResourceInstanceOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCancelLastopResourceInstanceOptionsWith returns CancelLastopResourceInstanceOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewCancelLastopResourceInstanceOptionsWith(options ...CancelLastopResourceInstanceOption) *CancelLastopResourceInstanceOptions {
+	cancelLastopResourceInstanceOptions := &CancelLastopResourceInstanceOptions{}
+	for _, option := range options {
+		option(cancelLastopResourceInstanceOptions)
+	}
+	return cancelLastopResourceInstanceOptions
+}
+
+// CancelLastopResourceInstanceWith is an alternate form of the CancelLastopResourceInstanceWithContext method
+// which takes functional options instead of a CancelLastopResourceInstanceOptions struct.
+// CancelLastopResourceInstanceID is required.
+func (resourceController *ResourceControllerV2) CancelLastopResourceInstanceWith(ctx context.Context, options ...CancelLastopResourceInstanceOption) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	return resourceController.CancelLastopResourceInstanceWithContext(ctx, resourceController.NewCancelLastopResourceInstanceOptionsWith(options...))
+}
+
+// ListResourceKeysOption : A functional option that sets a property of ListResourceKeysOptions (see ListResourceKeysWith).
+type ListResourceKeysOption func(options *ListResourceKeysOptions)
+
+// ListResourceKeysGUID sets the GUID property of ListResourceKeysOptions. When you create a new key, a GUID
+// (globally unique identifier) is assigned.
+func ListResourceKeysGUID(guid string) ListResourceKeysOption {
+	return func(options *ListResourceKeysOptions) {
+		options.GUID = core.StringPtr(guid)
+	}
+}
+
+// ListResourceKeysName sets the Name property of ListResourceKeysOptions. The human-readable name of the key.
+func ListResourceKeysName(nameVar string) ListResourceKeysOption {
+	return func(options *ListResourceKeysOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListResourceKeysResourceGroupID sets the ResourceGroupID property of ListResourceKeysOptions. The short ID of
+// the resource group.
+func ListResourceKeysResourceGroupID(resourceGroupID string) ListResourceKeysOption {
+	return func(options *ListResourceKeysOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// ListResourceKeysResourceID sets the ResourceID property of ListResourceKeysOptions. The unique ID of the
+// offering.
+func ListResourceKeysResourceID(resourceID string) ListResourceKeysOption {
+	return func(options *ListResourceKeysOptions) {
+		options.ResourceID = core.StringPtr(resourceID)
+	}
+}
+
+// ListResourceKeysLimit sets the Limit property of ListResourceKeysOptions. Limit on how many items should be
+// returned.
+func ListResourceKeysLimit(limit int64) ListResourceKeysOption {
+	return func(options *ListResourceKeysOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListResourceKeysStart sets the Start property of ListResourceKeysOptions. An optional token that indicates the
+// beginning of the page of results to be returned.
+func ListResourceKeysStart(start string) ListResourceKeysOption {
+	return func(options *ListResourceKeysOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListResourceKeysUpdatedFrom sets the UpdatedFrom property of ListResourceKeysOptions. Start date inclusive
+// filter.
+func ListResourceKeysUpdatedFrom(updatedFrom string) ListResourceKeysOption {
+	return func(options *ListResourceKeysOptions) {
+		options.UpdatedFrom = core.StringPtr(updatedFrom)
+	}
+}
+
+// ListResourceKeysUpdatedTo sets the UpdatedTo property of ListResourceKeysOptions. End date inclusive filter.
+func ListResourceKeysUpdatedTo(updatedTo string) ListResourceKeysOption {
+	return func(options *ListResourceKeysOptions) {
+		options.UpdatedTo = core.StringPtr(updatedTo)
+	}
+}
+
+// ListResourceKeysHeader sets the Headers property of ListResourceKeysOptions. Allows users to set headers on API
+// requests.
+func ListResourceKeysHeader(name string, value string) ListResourceKeysOption {
+	return func(options *ListResourceKeysOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListResourceKeysOptionsWith returns ListResourceKeysOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewListResourceKeysOptionsWith(options ...ListResourceKeysOption) *ListResourceKeysOptions {
+	listResourceKeysOptions := &ListResourceKeysOptions{}
+	for _, option := range options {
+		option(listResourceKeysOptions)
+	}
+	return listResourceKeysOptions
+}
+
+// ListResourceKeysWith is an alternate form of the ListResourceKeysWithContext method which takes functional
+// options instead of a ListResourceKeysOptions struct.
+func (resourceController *ResourceControllerV2) ListResourceKeysWith(ctx context.Context, options ...ListResourceKeysOption) (result *ResourceKeysList, response *core.DetailedResponse, err error) {
+	return resourceController.ListResourceKeysWithContext(ctx, resourceController.NewListResourceKeysOptionsWith(options...))
+}
+
+// CreateResourceKeyOption : A functional option that sets a property of CreateResourceKeyOptions (see CreateResourceKeyWith).
+type CreateResourceKeyOption func(options *CreateResourceKeyOptions)
+
+// CreateResourceKeyName sets the Name property of CreateResourceKeyOptions. The name of the key.
+func CreateResourceKeyName(nameVar string) CreateResourceKeyOption {
+	return func(options *CreateResourceKeyOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateResourceKeySource sets the Source property of CreateResourceKeyOptions. The short or long ID of resource
+// instance or alias.
+func CreateResourceKeySource(source string) CreateResourceKeyOption {
+	return func(options *CreateResourceKeyOptions) {
+		options.Source = core.StringPtr(source)
+	}
+}
+
+// CreateResourceKeyParameters sets the Parameters property of CreateResourceKeyOptions. Configuration options
+// represented as key-value pairs.
+func CreateResourceKeyParameters(parameters *ResourceKeyPostParameters) CreateResourceKeyOption {
+	return func(options *CreateResourceKeyOptions) {
+		options.Parameters = parameters
+	}
+}
+
+// CreateResourceKeyRole sets the Role property of CreateResourceKeyOptions. The role name or it's CRN.
+func CreateResourceKeyRole(role string) CreateResourceKeyOption {
+	return func(options *CreateResourceKeyOptions) {
+		options.Role = core.StringPtr(role)
+	}
+}
+
+// CreateResourceKeyHeader sets the Headers property of CreateResourceKeyOptions. Allows users to set headers on
+// API requests.
+func CreateResourceKeyHeader(name string, value string) CreateResourceKeyOption {
+	return func(options *CreateResourceKeyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateResourceKeyOptionsWith returns CreateResourceKeyOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewCreateResourceKeyOptionsWith(options ...CreateResourceKeyOption) *CreateResourceKeyOptions {
+	createResourceKeyOptions := &CreateResourceKeyOptions{}
+	for _, option := range options {
+		option(createResourceKeyOptions)
+	}
+	return createResourceKeyOptions
+}
+
+// CreateResourceKeyWith is an alternate form of the CreateResourceKeyWithContext method which takes functional
+// options instead of a CreateResourceKeyOptions struct. CreateResourceKeyName and CreateResourceKeySource are
+// required.
+func (resourceController *ResourceControllerV2) CreateResourceKeyWith(ctx context.Context, options ...CreateResourceKeyOption) (result *ResourceKey, response *core.DetailedResponse, err error) {
+	return resourceController.CreateResourceKeyWithContext(ctx, resourceController.NewCreateResourceKeyOptionsWith(options...))
+}
+
+// GetResourceKeyOption : A functional option that sets a property of GetResourceKeyOptions (see GetResourceKeyWith).
+type GetResourceKeyOption func(options *GetResourceKeyOptions)
+
+// GetResourceKeyID sets the ID property of GetResourceKeyOptions. The short or long ID of the key.
+func GetResourceKeyID(id string) GetResourceKeyOption {
+	return func(options *GetResourceKeyOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetResourceKeyHeader sets the Headers property of GetResourceKeyOptions. Allows users to set headers on API
+// requests.
+func GetResourceKeyHeader(name string, value string) GetResourceKeyOption {
+	return func(options *GetResourceKeyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetResourceKeyOptionsWith returns GetResourceKeyOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewGetResourceKeyOptionsWith(options ...GetResourceKeyOption) *GetResourceKeyOptions {
+	getResourceKeyOptions := &GetResourceKeyOptions{}
+	for _, option := range options {
+		option(getResourceKeyOptions)
+	}
+	return getResourceKeyOptions
+}
+
+// GetResourceKeyWith is an alternate form of the GetResourceKeyWithContext method which takes functional options
+// instead of a GetResourceKeyOptions struct. GetResourceKeyID is required.
+func (resourceController *ResourceControllerV2) GetResourceKeyWith(ctx context.Context, options ...GetResourceKeyOption) (result *ResourceKey, response *core.DetailedResponse, err error) {
+	return resourceController.GetResourceKeyWithContext(ctx, resourceController.NewGetResourceKeyOptionsWith(options...))
+}
+
+// DeleteResourceKeyOption : A functional option that sets a property of DeleteResourceKeyOptions (see DeleteResourceKeyWith).
+type DeleteResourceKeyOption func(options *DeleteResourceKeyOptions)
+
+// DeleteResourceKeyID sets the ID property of DeleteResourceKeyOptions. The short or long ID of the key.
+func DeleteResourceKeyID(id string) DeleteResourceKeyOption {
+	return func(options *DeleteResourceKeyOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// DeleteResourceKeyHeader sets the Headers property of DeleteResourceKeyOptions. Allows users to set headers on
+// API requests.
+func DeleteResourceKeyHeader(name string, value string) DeleteResourceKeyOption {
+	return func(options *DeleteResourceKeyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteResourceKeyOptionsWith returns DeleteResourceKeyOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewDeleteResourceKeyOptionsWith(options ...DeleteResourceKeyOption) *DeleteResourceKeyOptions {
+	deleteResourceKeyOptions := &DeleteResourceKeyOptions{}
+	for _, option := range options {
+		option(deleteResourceKeyOptions)
+	}
+	return deleteResourceKeyOptions
+}
+
+// DeleteResourceKeyWith is an alternate form of the DeleteResourceKeyWithContext method which takes functional
+// options instead of a DeleteResourceKeyOptions struct. DeleteResourceKeyID is required.
+func (resourceController *ResourceControllerV2) DeleteResourceKeyWith(ctx context.Context, options ...DeleteResourceKeyOption) (response *core.DetailedResponse, err error) {
+	return resourceController.DeleteResourceKeyWithContext(ctx, resourceController.NewDeleteResourceKeyOptionsWith(options...))
+}
+
+// UpdateResourceKeyOption : A functional option that sets a property of UpdateResourceKeyOptions (see UpdateResourceKeyWith).
+type UpdateResourceKeyOption func(options *UpdateResourceKeyOptions)
+
+// UpdateResourceKeyID sets the ID property of UpdateResourceKeyOptions. The short or long ID of the key.
+func UpdateResourceKeyID(id string) UpdateResourceKeyOption {
+	return func(options *UpdateResourceKeyOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UpdateResourceKeyName sets the Name property of UpdateResourceKeyOptions. The new name of the key.
+func UpdateResourceKeyName(nameVar string) UpdateResourceKeyOption {
+	return func(options *UpdateResourceKeyOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateResourceKeyHeader sets the Headers property of UpdateResourceKeyOptions. Allows users to set headers on
+// API requests.
+func UpdateResourceKeyHeader(name string, value string) UpdateResourceKeyOption {
+	return func(options *UpdateResourceKeyOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateResourceKeyOptionsWith returns UpdateResourceKeyOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewUpdateResourceKeyOptionsWith(options ...UpdateResourceKeyOption) *UpdateResourceKeyOptions {
+	updateResourceKeyOptions := &UpdateResourceKeyOptions{}
+	for _, option := range options {
+		option(updateResourceKeyOptions)
+	}
+	return updateResourceKeyOptions
+}
+
+// UpdateResourceKeyWith is an alternate form of the UpdateResourceKeyWithContext method which takes functional
+// options instead of an UpdateResourceKeyOptions struct. UpdateResourceKeyID and UpdateResourceKeyName are
+// required.
+func (resourceController *ResourceControllerV2) UpdateResourceKeyWith(ctx context.Context, options ...UpdateResourceKeyOption) (result *ResourceKey, response *core.DetailedResponse, err error) {
+	return resourceController.UpdateResourceKeyWithContext(ctx, resourceController.NewUpdateResourceKeyOptionsWith(options...))
+}
+
+// ListResourceBindingsOption : A functional option that sets a property of ListResourceBindingsOptions (see ListResourceBindingsWith).
+type ListResourceBindingsOption func(options *ListResourceBindingsOptions)
+
+// ListResourceBindingsGUID sets the GUID property of ListResourceBindingsOptions. The short ID of the binding.
+func ListResourceBindingsGUID(guid string) ListResourceBindingsOption {
+	return func(options *ListResourceBindingsOptions) {
+		options.GUID = core.StringPtr(guid)
+	}
+}
+
+// ListResourceBindingsName sets the Name property of ListResourceBindingsOptions. The human-readable name of the
+// binding.
+func ListResourceBindingsName(nameVar string) ListResourceBindingsOption {
+	return func(options *ListResourceBindingsOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListResourceBindingsResourceGroupID sets the ResourceGroupID property of ListResourceBindingsOptions. Short ID
+// of the resource group.
+func ListResourceBindingsResourceGroupID(resourceGroupID string) ListResourceBindingsOption {
+	return func(options *ListResourceBindingsOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// ListResourceBindingsResourceID sets the ResourceID property of ListResourceBindingsOptions. The unique ID of the
+// offering (service name).
+func ListResourceBindingsResourceID(resourceID string) ListResourceBindingsOption {
+	return func(options *ListResourceBindingsOptions) {
+		options.ResourceID = core.StringPtr(resourceID)
+	}
+}
+
+// ListResourceBindingsRegionBindingID sets the RegionBindingID property of ListResourceBindingsOptions. Short ID
+// of the binding in the specific targeted environment, for example, service_binding_id in a given IBM Cloud
+// environment.
+func ListResourceBindingsRegionBindingID(regionBindingID string) ListResourceBindingsOption {
+	return func(options *ListResourceBindingsOptions) {
+		options.RegionBindingID = core.StringPtr(regionBindingID)
+	}
+}
+
+// ListResourceBindingsLimit sets the Limit property of ListResourceBindingsOptions. Limit on how many items should
+// be returned.
+func ListResourceBindingsLimit(limit int64) ListResourceBindingsOption {
+	return func(options *ListResourceBindingsOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListResourceBindingsStart sets the Start property of ListResourceBindingsOptions. An optional token that
+// indicates the beginning of the page of results to be returned.
+func ListResourceBindingsStart(start string) ListResourceBindingsOption {
+	return func(options *ListResourceBindingsOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListResourceBindingsUpdatedFrom sets the UpdatedFrom property of ListResourceBindingsOptions. Start date
+// inclusive filter.
+func ListResourceBindingsUpdatedFrom(updatedFrom string) ListResourceBindingsOption {
+	return func(options *ListResourceBindingsOptions) {
+		options.UpdatedFrom = core.StringPtr(updatedFrom)
+	}
+}
+
+// ListResourceBindingsUpdatedTo sets the UpdatedTo property of ListResourceBindingsOptions. End date inclusive
+// filter.
+func ListResourceBindingsUpdatedTo(updatedTo string) ListResourceBindingsOption {
+	return func(options *ListResourceBindingsOptions) {
+		options.UpdatedTo = core.StringPtr(updatedTo)
+	}
+}
+
+// ListResourceBindingsHeader sets the Headers property of ListResourceBindingsOptions. Allows users to set headers
+// on API requests.
+func ListResourceBindingsHeader(name string, value string) ListResourceBindingsOption {
+	return func(options *ListResourceBindingsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListResourceBindingsOptionsWith returns ListResourceBindingsOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewListResourceBindingsOptionsWith(options ...ListResourceBindingsOption) *ListResourceBindingsOptions {
+	listResourceBindingsOptions := &ListResourceBindingsOptions{}
+	for _, option := range options {
+		option(listResourceBindingsOptions)
+	}
+	return listResourceBindingsOptions
+}
+
+// ListResourceBindingsWith is an alternate form of the ListResourceBindingsWithContext method which takes
+// functional options instead of a ListResourceBindingsOptions struct.
+func (resourceController *ResourceControllerV2) ListResourceBindingsWith(ctx context.Context, options ...ListResourceBindingsOption) (result *ResourceBindingsList, response *core.DetailedResponse, err error) {
+	return resourceController.ListResourceBindingsWithContext(ctx, resourceController.NewListResourceBindingsOptionsWith(options...))
+}
+
+// CreateResourceBindingOption : A functional option that sets a property of CreateResourceBindingOptions (see CreateResourceBindingWith).
+type CreateResourceBindingOption func(options *CreateResourceBindingOptions)
+
+// CreateResourceBindingSource sets the Source property of CreateResourceBindingOptions. The short or long ID of
+// resource alias.
+func CreateResourceBindingSource(source string) CreateResourceBindingOption {
+	return func(options *CreateResourceBindingOptions) {
+		options.Source = core.StringPtr(source)
+	}
+}
+
+// CreateResourceBindingTarget sets the Target property of CreateResourceBindingOptions. The CRN of application to
+// bind to in a specific environment, for example, Dallas YP, CFEE instance.
+func CreateResourceBindingTarget(target string) CreateResourceBindingOption {
+	return func(options *CreateResourceBindingOptions) {
+		options.Target = core.StringPtr(target)
+	}
+}
+
+// CreateResourceBindingName sets the Name property of CreateResourceBindingOptions. The name of the binding.
+func CreateResourceBindingName(nameVar string) CreateResourceBindingOption {
+	return func(options *CreateResourceBindingOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateResourceBindingParameters sets the Parameters property of CreateResourceBindingOptions. Configuration
+// options represented as key-value pairs.
+func CreateResourceBindingParameters(parameters *ResourceBindingPostParameters) CreateResourceBindingOption {
+	return func(options *CreateResourceBindingOptions) {
+		options.Parameters = parameters
+	}
+}
+
+// CreateResourceBindingRole sets the Role property of CreateResourceBindingOptions. The role name or it's CRN.
+func CreateResourceBindingRole(role string) CreateResourceBindingOption {
+	return func(options *CreateResourceBindingOptions) {
+		options.Role = core.StringPtr(role)
+	}
+}
+
+// CreateResourceBindingHeader sets the Headers property of CreateResourceBindingOptions. Allows users to set
+// headers on API requests.
+func CreateResourceBindingHeader(name string, value string) CreateResourceBindingOption {
+	return func(options *CreateResourceBindingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateResourceBindingOptionsWith returns CreateResourceBindingOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewCreateResourceBindingOptionsWith(options ...CreateResourceBindingOption) *CreateResourceBindingOptions {
+	createResourceBindingOptions := &CreateResourceBindingOptions{}
+	for _, option := range options {
+		option(createResourceBindingOptions)
+	}
+	return createResourceBindingOptions
+}
+
+// CreateResourceBindingWith is an alternate form of the CreateResourceBindingWithContext method which takes
+// functional options instead of a CreateResourceBindingOptions struct. CreateResourceBindingSource and
+// CreateResourceBindingTarget are required.
+func (resourceController *ResourceControllerV2) CreateResourceBindingWith(ctx context.Context, options ...CreateResourceBindingOption) (result *ResourceBinding, response *core.DetailedResponse, err error) {
+	return resourceController.CreateResourceBindingWithContext(ctx, resourceController.NewCreateResourceBindingOptionsWith(options...))
+}
+
+// GetResourceBindingOption : A functional option that sets a property of GetResourceBindingOptions (see GetResourceBindingWith).
+type GetResourceBindingOption func(options *GetResourceBindingOptions)
+
+// GetResourceBindingID sets the ID property of GetResourceBindingOptions. The short or long ID of the binding.
+func GetResourceBindingID(id string) GetResourceBindingOption {
+	return func(options *GetResourceBindingOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetResourceBindingHeader sets the Headers property of GetResourceBindingOptions. Allows users to set headers on
+// API requests.
+func GetResourceBindingHeader(name string, value string) GetResourceBindingOption {
+	return func(options *GetResourceBindingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetResourceBindingOptionsWith returns GetResourceBindingOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewGetResourceBindingOptionsWith(options ...GetResourceBindingOption) *GetResourceBindingOptions {
+	getResourceBindingOptions := &GetResourceBindingOptions{}
+	for _, option := range options {
+		option(getResourceBindingOptions)
+	}
+	return getResourceBindingOptions
+}
+
+// GetResourceBindingWith is an alternate form of the GetResourceBindingWithContext method which takes functional
+// options instead of a GetResourceBindingOptions struct. GetResourceBindingID is required.
+func (resourceController *ResourceControllerV2) GetResourceBindingWith(ctx context.Context, options ...GetResourceBindingOption) (result *ResourceBinding, response *core.DetailedResponse, err error) {
+	return resourceController.GetResourceBindingWithContext(ctx, resourceController.NewGetResourceBindingOptionsWith(options...))
+}
+
+// DeleteResourceBindingOption : A functional option that sets a property of DeleteResourceBindingOptions (see DeleteResourceBindingWith).
+type DeleteResourceBindingOption func(options *DeleteResourceBindingOptions)
+
+// DeleteResourceBindingID sets the ID property of DeleteResourceBindingOptions. The short or long ID of the
+// binding.
+func DeleteResourceBindingID(id string) DeleteResourceBindingOption {
+	return func(options *DeleteResourceBindingOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// DeleteResourceBindingHeader sets the Headers property of DeleteResourceBindingOptions. Allows users to set
+// headers on API requests.
+func DeleteResourceBindingHeader(name string, value string) DeleteResourceBindingOption {
+	return func(options *DeleteResourceBindingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteResourceBindingOptionsWith returns DeleteResourceBindingOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewDeleteResourceBindingOptionsWith(options ...DeleteResourceBindingOption) *DeleteResourceBindingOptions {
+	deleteResourceBindingOptions := &DeleteResourceBindingOptions{}
+	for _, option := range options {
+		option(deleteResourceBindingOptions)
+	}
+	return deleteResourceBindingOptions
+}
+
+// DeleteResourceBindingWith is an alternate form of the DeleteResourceBindingWithContext method which takes
+// functional options instead of a DeleteResourceBindingOptions struct. DeleteResourceBindingID is required.
+func (resourceController *ResourceControllerV2) DeleteResourceBindingWith(ctx context.Context, options ...DeleteResourceBindingOption) (response *core.DetailedResponse, err error) {
+	return resourceController.DeleteResourceBindingWithContext(ctx, resourceController.NewDeleteResourceBindingOptionsWith(options...))
+}
+
+// UpdateResourceBindingOption : A functional option that sets a property of UpdateResourceBindingOptions (see UpdateResourceBindingWith).
+type UpdateResourceBindingOption func(options *UpdateResourceBindingOptions)
+
+// UpdateResourceBindingID sets the ID property of UpdateResourceBindingOptions. The short or long ID of the
+// binding.
+func UpdateResourceBindingID(id string) UpdateResourceBindingOption {
+	return func(options *UpdateResourceBindingOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UpdateResourceBindingName sets the Name property of UpdateResourceBindingOptions. The new name of the binding.
+func UpdateResourceBindingName(nameVar string) UpdateResourceBindingOption {
+	return func(options *UpdateResourceBindingOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateResourceBindingHeader sets the Headers property of UpdateResourceBindingOptions. Allows users to set
+// headers on API requests.
+func UpdateResourceBindingHeader(name string, value string) UpdateResourceBindingOption {
+	return func(options *UpdateResourceBindingOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateResourceBindingOptionsWith returns UpdateResourceBindingOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewUpdateResourceBindingOptionsWith(options ...UpdateResourceBindingOption) *UpdateResourceBindingOptions {
+	updateResourceBindingOptions := &UpdateResourceBindingOptions{}
+	for _, option := range options {
+		option(updateResourceBindingOptions)
+	}
+	return updateResourceBindingOptions
+}
+
+// UpdateResourceBindingWith is an alternate form of the UpdateResourceBindingWithContext method which takes
+// functional options instead of an UpdateResourceBindingOptions struct. UpdateResourceBindingID and
+// UpdateResourceBindingName are required.
+func (resourceController *ResourceControllerV2) UpdateResourceBindingWith(ctx context.Context, options ...UpdateResourceBindingOption) (result *ResourceBinding, response *core.DetailedResponse, err error) {
+	return resourceController.UpdateResourceBindingWithContext(ctx, resourceController.NewUpdateResourceBindingOptionsWith(options...))
+}
+
+// ListResourceAliasesOption : A functional option that sets a property of ListResourceAliasesOptions (see ListResourceAliasesWith).
+type ListResourceAliasesOption func(options *ListResourceAliasesOptions)
+
+// ListResourceAliasesGUID sets the GUID property of ListResourceAliasesOptions. Short ID of the alias.
+func ListResourceAliasesGUID(guid string) ListResourceAliasesOption {
+	return func(options *ListResourceAliasesOptions) {
+		options.GUID = core.StringPtr(guid)
+	}
+}
+
+// ListResourceAliasesName sets the Name property of ListResourceAliasesOptions. The human-readable name of the
+// alias.
+func ListResourceAliasesName(nameVar string) ListResourceAliasesOption {
+	return func(options *ListResourceAliasesOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListResourceAliasesResourceInstanceID sets the ResourceInstanceID property of ListResourceAliasesOptions.
+// Resource instance short ID.
+func ListResourceAliasesResourceInstanceID(resourceInstanceID string) ListResourceAliasesOption {
+	return func(options *ListResourceAliasesOptions) {
+		options.ResourceInstanceID = core.StringPtr(resourceInstanceID)
+	}
+}
+
+// ListResourceAliasesRegionInstanceID sets the RegionInstanceID property of ListResourceAliasesOptions. Short ID
+// of the instance in a specific targeted environment.
+func ListResourceAliasesRegionInstanceID(regionInstanceID string) ListResourceAliasesOption {
+	return func(options *ListResourceAliasesOptions) {
+		options.RegionInstanceID = core.StringPtr(regionInstanceID)
+	}
+}
+
+// ListResourceAliasesResourceID sets the ResourceID property of ListResourceAliasesOptions. The unique ID of the
+// offering (service name).
+func ListResourceAliasesResourceID(resourceID string) ListResourceAliasesOption {
+	return func(options *ListResourceAliasesOptions) {
+		options.ResourceID = core.StringPtr(resourceID)
+	}
+}
+
+// ListResourceAliasesResourceGroupID sets the ResourceGroupID property of ListResourceAliasesOptions. Short ID of
+// Resource group.
+func ListResourceAliasesResourceGroupID(resourceGroupID string) ListResourceAliasesOption {
+	return func(options *ListResourceAliasesOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// ListResourceAliasesLimit sets the Limit property of ListResourceAliasesOptions. Limit on how many items should
+// be returned.
+func ListResourceAliasesLimit(limit int64) ListResourceAliasesOption {
+	return func(options *ListResourceAliasesOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListResourceAliasesStart sets the Start property of ListResourceAliasesOptions. An optional token that indicates
+// the beginning of the page of results to be returned.
+func ListResourceAliasesStart(start string) ListResourceAliasesOption {
+	return func(options *ListResourceAliasesOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListResourceAliasesUpdatedFrom sets the UpdatedFrom property of ListResourceAliasesOptions. Start date inclusive
+// filter.
+func ListResourceAliasesUpdatedFrom(updatedFrom string) ListResourceAliasesOption {
+	return func(options *ListResourceAliasesOptions) {
+		options.UpdatedFrom = core.StringPtr(updatedFrom)
+	}
+}
+
+// ListResourceAliasesUpdatedTo sets the UpdatedTo property of ListResourceAliasesOptions. End date inclusive
+// filter.
+func ListResourceAliasesUpdatedTo(updatedTo string) ListResourceAliasesOption {
+	return func(options *ListResourceAliasesOptions) {
+		options.UpdatedTo = core.StringPtr(updatedTo)
+	}
+}
+
+// ListResourceAliasesHeader sets the Headers property of ListResourceAliasesOptions. Allows users to set headers
+// on API requests.
+func ListResourceAliasesHeader(name string, value string) ListResourceAliasesOption {
+	return func(options *ListResourceAliasesOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListResourceAliasesOptionsWith returns ListResourceAliasesOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewListResourceAliasesOptionsWith(options ...ListResourceAliasesOption) *ListResourceAliasesOptions {
+	listResourceAliasesOptions := &ListResourceAliasesOptions{}
+	for _, option := range options {
+		option(listResourceAliasesOptions)
+	}
+	return listResourceAliasesOptions
+}
+
+// ListResourceAliasesWith is an alternate form of the ListResourceAliasesWithContext method which takes functional
+// options instead of a ListResourceAliasesOptions struct.
+func (resourceController *ResourceControllerV2) ListResourceAliasesWith(ctx context.Context, options ...ListResourceAliasesOption) (result *ResourceAliasesList, response *core.DetailedResponse, err error) {
+	return resourceController.ListResourceAliasesWithContext(ctx, resourceController.NewListResourceAliasesOptionsWith(options...))
+}
+
+// CreateResourceAliasOption : A functional option that sets a property of CreateResourceAliasOptions (see CreateResourceAliasWith).
+type CreateResourceAliasOption func(options *CreateResourceAliasOptions)
+
+// CreateResourceAliasName sets the Name property of CreateResourceAliasOptions. The name of the alias.
+func CreateResourceAliasName(nameVar string) CreateResourceAliasOption {
+	return func(options *CreateResourceAliasOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateResourceAliasSource sets the Source property of CreateResourceAliasOptions. The short or long ID of
+// resource instance.
+func CreateResourceAliasSource(source string) CreateResourceAliasOption {
+	return func(options *CreateResourceAliasOptions) {
+		options.Source = core.StringPtr(source)
+	}
+}
+
+// CreateResourceAliasTarget sets the Target property of CreateResourceAliasOptions. The CRN of target name(space)
+// in a specific environment, for example, space in Dallas YP, CFEE instance etc.
+func CreateResourceAliasTarget(target string) CreateResourceAliasOption {
+	return func(options *CreateResourceAliasOptions) {
+		options.Target = core.StringPtr(target)
+	}
+}
+
+// CreateResourceAliasHeader sets the Headers property of CreateResourceAliasOptions. Allows users to set headers
+// on API requests.
+func CreateResourceAliasHeader(name string, value string) CreateResourceAliasOption {
+	return func(options *CreateResourceAliasOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateResourceAliasOptionsWith returns CreateResourceAliasOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewCreateResourceAliasOptionsWith(options ...CreateResourceAliasOption) *CreateResourceAliasOptions {
+	createResourceAliasOptions := &CreateResourceAliasOptions{}
+	for _, option := range options {
+		option(createResourceAliasOptions)
+	}
+	return createResourceAliasOptions
+}
+
+// CreateResourceAliasWith is an alternate form of the CreateResourceAliasWithContext method which takes functional
+// options instead of a CreateResourceAliasOptions struct. CreateResourceAliasName, CreateResourceAliasSource and
+// CreateResourceAliasTarget are required.
+func (resourceController *ResourceControllerV2) CreateResourceAliasWith(ctx context.Context, options ...CreateResourceAliasOption) (result *ResourceAlias, response *core.DetailedResponse, err error) {
+	return resourceController.CreateResourceAliasWithContext(ctx, resourceController.NewCreateResourceAliasOptionsWith(options...))
+}
+
+// GetResourceAliasOption : A functional option that sets a property of GetResourceAliasOptions (see GetResourceAliasWith).
+type GetResourceAliasOption func(options *GetResourceAliasOptions)
+
+// GetResourceAliasID sets the ID property of GetResourceAliasOptions. The short or long ID of the alias.
+func GetResourceAliasID(id string) GetResourceAliasOption {
+	return func(options *GetResourceAliasOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetResourceAliasHeader sets the Headers property of GetResourceAliasOptions. Allows users to set headers on API
+// requests.
+func GetResourceAliasHeader(name string, value string) GetResourceAliasOption {
+	return func(options *GetResourceAliasOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetResourceAliasOptionsWith returns GetResourceAliasOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewGetResourceAliasOptionsWith(options ...GetResourceAliasOption) *GetResourceAliasOptions {
+	getResourceAliasOptions := &GetResourceAliasOptions{}
+	for _, option := range options {
+		option(getResourceAliasOptions)
+	}
+	return getResourceAliasOptions
+}
+
+// GetResourceAliasWith is an alternate form of the GetResourceAliasWithContext method which takes functional
+// options instead of a GetResourceAliasOptions struct. GetResourceAliasID is required.
+func (resourceController *ResourceControllerV2) GetResourceAliasWith(ctx context.Context, options ...GetResourceAliasOption) (result *ResourceAlias, response *core.DetailedResponse, err error) {
+	return resourceController.GetResourceAliasWithContext(ctx, resourceController.NewGetResourceAliasOptionsWith(options...))
+}
+
+// DeleteResourceAliasOption : A functional option that sets a property of DeleteResourceAliasOptions (see DeleteResourceAliasWith).
+type DeleteResourceAliasOption func(options *DeleteResourceAliasOptions)
+
+// DeleteResourceAliasID sets the ID property of DeleteResourceAliasOptions. The short or long ID of the alias.
+func DeleteResourceAliasID(id string) DeleteResourceAliasOption {
+	return func(options *DeleteResourceAliasOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// DeleteResourceAliasHeader sets the Headers property of DeleteResourceAliasOptions. Allows users to set headers
+// on API requests.
+func DeleteResourceAliasHeader(name string, value string) DeleteResourceAliasOption {
+	return func(options *DeleteResourceAliasOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteResourceAliasOptionsWith returns DeleteResourceAliasOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewDeleteResourceAliasOptionsWith(options ...DeleteResourceAliasOption) *DeleteResourceAliasOptions {
+	deleteResourceAliasOptions := &DeleteResourceAliasOptions{}
+	for _, option := range options {
+		option(deleteResourceAliasOptions)
+	}
+	return deleteResourceAliasOptions
+}
+
+// DeleteResourceAliasWith is an alternate form of the DeleteResourceAliasWithContext method which takes functional
+// options instead of a DeleteResourceAliasOptions struct. DeleteResourceAliasID is required.
+func (resourceController *ResourceControllerV2) DeleteResourceAliasWith(ctx context.Context, options ...DeleteResourceAliasOption) (response *core.DetailedResponse, err error) {
+	return resourceController.DeleteResourceAliasWithContext(ctx, resourceController.NewDeleteResourceAliasOptionsWith(options...))
+}
+
+// UpdateResourceAliasOption : A functional option that sets a property of UpdateResourceAliasOptions (see UpdateResourceAliasWith).
+type UpdateResourceAliasOption func(options *UpdateResourceAliasOptions)
+
+// UpdateResourceAliasID sets the ID property of UpdateResourceAliasOptions. The short or long ID of the alias.
+func UpdateResourceAliasID(id string) UpdateResourceAliasOption {
+	return func(options *UpdateResourceAliasOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UpdateResourceAliasName sets the Name property of UpdateResourceAliasOptions. The new name of the alias.
+func UpdateResourceAliasName(nameVar string) UpdateResourceAliasOption {
+	return func(options *UpdateResourceAliasOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateResourceAliasHeader sets the Headers property of UpdateResourceAliasOptions. Allows users to set headers
+// on API requests.
+func UpdateResourceAliasHeader(name string, value string) UpdateResourceAliasOption {
+	return func(options *UpdateResourceAliasOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateResourceAliasOptionsWith returns UpdateResourceAliasOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewUpdateResourceAliasOptionsWith(options ...UpdateResourceAliasOption) *UpdateResourceAliasOptions {
+	updateResourceAliasOptions := &UpdateResourceAliasOptions{}
+	for _, option := range options {
+		option(updateResourceAliasOptions)
+	}
+	return updateResourceAliasOptions
+}
+
+// UpdateResourceAliasWith is an alternate form of the UpdateResourceAliasWithContext method which takes functional
+// options instead of an UpdateResourceAliasOptions struct. UpdateResourceAliasID and UpdateResourceAliasName are
+// required.
+func (resourceController *ResourceControllerV2) UpdateResourceAliasWith(ctx context.Context, options ...UpdateResourceAliasOption) (result *ResourceAlias, response *core.DetailedResponse, err error) {
+	return resourceController.UpdateResourceAliasWithContext(ctx, resourceController.NewUpdateResourceAliasOptionsWith(options...))
+}
+
+// ListResourceBindingsForAliasOption : A functional option that sets a property of ListResourceBindingsForAliasOptions (see ListResourceBindingsForAliasWith).
+type ListResourceBindingsForAliasOption func(options *ListResourceBindingsForAliasOptions)
+
+// ListResourceBindingsForAliasID sets the ID property of ListResourceBindingsForAliasOptions. The short or long ID
+// of the alias.
+func ListResourceBindingsForAliasID(id string) ListResourceBindingsForAliasOption {
+	return func(options *ListResourceBindingsForAliasOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// ListResourceBindingsForAliasLimit sets the Limit property of ListResourceBindingsForAliasOptions. Limit on how
+// many items should be returned.
+func ListResourceBindingsForAliasLimit(limit int64) ListResourceBindingsForAliasOption {
+	return func(options *ListResourceBindingsForAliasOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListResourceBindingsForAliasStart sets the Start property of ListResourceBindingsForAliasOptions. An optional
+// token that indicates the beginning of the page of results to be returned.
+func ListResourceBindingsForAliasStart(start string) ListResourceBindingsForAliasOption {
+	return func(options *ListResourceBindingsForAliasOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListResourceBindingsForAliasHeader sets the Headers property of ListResourceBindingsForAliasOptions. Allows
+// users to set headers on API requests.
+func ListResourceBindingsForAliasHeader(name string, value string) ListResourceBindingsForAliasOption {
+	return func(options *ListResourceBindingsForAliasOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListResourceBindingsForAliasOptionsWith returns ListResourceBindingsForAliasOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewListResourceBindingsForAliasOptionsWith(options ...ListResourceBindingsForAliasOption) *ListResourceBindingsForAliasOptions {
+	listResourceBindingsForAliasOptions := &ListResourceBindingsForAliasOptions{}
+	for _, option := range options {
+		option(listResourceBindingsForAliasOptions)
+	}
+	return listResourceBindingsForAliasOptions
+}
+
+// ListResourceBindingsForAliasWith is an alternate form of the ListResourceBindingsForAliasWithContext method
+// which takes functional options instead of a ListResourceBindingsForAliasOptions struct.
+// ListResourceBindingsForAliasID is required.
+func (resourceController *ResourceControllerV2) ListResourceBindingsForAliasWith(ctx context.Context, options ...ListResourceBindingsForAliasOption) (result *ResourceBindingsList, response *core.DetailedResponse, err error) {
+	return resourceController.ListResourceBindingsForAliasWithContext(ctx, resourceController.NewListResourceBindingsForAliasOptionsWith(options...))
+}
+
+// ListReclamationsOption : A functional option that sets a property of ListReclamationsOptions (see ListReclamationsWith).
+type ListReclamationsOption func(options *ListReclamationsOptions)
+
+// ListReclamationsAccountID sets the AccountID property of ListReclamationsOptions. An alpha-numeric value
+// identifying the account ID.
+func ListReclamationsAccountID(accountID string) ListReclamationsOption {
+	return func(options *ListReclamationsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListReclamationsResourceInstanceID sets the ResourceInstanceID property of ListReclamationsOptions. The short ID
+// of the resource instance.
+func ListReclamationsResourceInstanceID(resourceInstanceID string) ListReclamationsOption {
+	return func(options *ListReclamationsOptions) {
+		options.ResourceInstanceID = core.StringPtr(resourceInstanceID)
+	}
+}
+
+// ListReclamationsHeader sets the Headers property of ListReclamationsOptions. Allows users to set headers on API
+// requests.
+func ListReclamationsHeader(name string, value string) ListReclamationsOption {
+	return func(options *ListReclamationsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListReclamationsOptionsWith returns ListReclamationsOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewListReclamationsOptionsWith(options ...ListReclamationsOption) *ListReclamationsOptions {
+	listReclamationsOptions := &ListReclamationsOptions{}
+	for _, option := range options {
+		option(listReclamationsOptions)
+	}
+	return listReclamationsOptions
+}
+
+// ListReclamationsWith is an alternate form of the ListReclamationsWithContext method which takes functional
+// options instead of a ListReclamationsOptions struct.
+func (resourceController *ResourceControllerV2) ListReclamationsWith(ctx context.Context, options ...ListReclamationsOption) (result *ReclamationsList, response *core.DetailedResponse, err error) {
+	return resourceController.ListReclamationsWithContext(ctx, resourceController.NewListReclamationsOptionsWith(options...))
+}
+
+// RunReclamationActionOption : A functional option that sets a property of RunReclamationActionOptions (see RunReclamationActionWith).
+type RunReclamationActionOption func(options *RunReclamationActionOptions)
+
+// RunReclamationActionID sets the ID property of RunReclamationActionOptions. The ID associated with the
+// reclamation.
+func RunReclamationActionID(id string) RunReclamationActionOption {
+	return func(options *RunReclamationActionOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// RunReclamationActionActionName sets the ActionName property of RunReclamationActionOptions. The reclamation
+// action name.
+func RunReclamationActionActionName(actionName string) RunReclamationActionOption {
+	return func(options *RunReclamationActionOptions) {
+		options.ActionName = core.StringPtr(actionName)
+	}
+}
+
+// RunReclamationActionRequestBy sets the RequestBy property of RunReclamationActionOptions. The request initiator,
+// if different from the request token.
+func RunReclamationActionRequestBy(requestBy string) RunReclamationActionOption {
+	return func(options *RunReclamationActionOptions) {
+		options.RequestBy = core.StringPtr(requestBy)
+	}
+}
+
+// RunReclamationActionComment sets the Comment property of RunReclamationActionOptions. A comment to describe the
+// action.
+func RunReclamationActionComment(comment string) RunReclamationActionOption {
+	return func(options *RunReclamationActionOptions) {
+		options.Comment = core.StringPtr(comment)
+	}
+}
+
+// RunReclamationActionHeader sets the Headers property of RunReclamationActionOptions. Allows users to set headers
+// on API requests.
+func RunReclamationActionHeader(name string, value string) RunReclamationActionOption {
+	return func(options *RunReclamationActionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewRunReclamationActionOptionsWith returns RunReclamationActionOptions populated by applying "options" in order.
+func (*ResourceControllerV2) NewRunReclamationActionOptionsWith(options ...RunReclamationActionOption) *RunReclamationActionOptions {
+	runReclamationActionOptions := &RunReclamationActionOptions{}
+	for _, option := range options {
+		option(runReclamationActionOptions)
+	}
+	return runReclamationActionOptions
+}
+
+// RunReclamationActionWith is an alternate form of the RunReclamationActionWithContext method which takes
+// functional options instead of a RunReclamationActionOptions struct. RunReclamationActionID and
+// RunReclamationActionActionName are required.
+func (resourceController *ResourceControllerV2) RunReclamationActionWith(ctx context.Context, options ...RunReclamationActionOption) (result *Reclamation, response *core.DetailedResponse, err error) {
+	return resourceController.RunReclamationActionWithContext(ctx, resourceController.NewRunReclamationActionOptionsWith(options...))
+}
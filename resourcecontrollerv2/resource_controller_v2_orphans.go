@@ -0,0 +1,277 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the OrphanedArtifact.Kind property.
+const (
+	OrphanedArtifactKindResourceAliasConst   = "resource_alias"
+	OrphanedArtifactKindResourceBindingConst = "resource_binding"
+	OrphanedArtifactKindResourceKeyConst     = "resource_key"
+)
+
+// Constants associated with the OrphanedArtifact.Reason property.
+const (
+	OrphanedArtifactReasonParentMissingConst            = "parent_missing"
+	OrphanedArtifactReasonParentPendingReclamationConst = "parent_pending_reclamation"
+)
+
+// orphanParentStates are the states of the resource instances whose keys, bindings and aliases are not orphaned.
+var orphanParentStates = []string{
+	ResourceInstanceStateActiveConst,
+	ResourceInstanceStateProvisioningConst,
+	ResourceInstanceStatePreProvisioningConst,
+	ResourceInstanceStateInactiveConst,
+	ResourceInstanceStateFailedConst,
+}
+
+// OrphanedArtifact : A resource key, binding or alias whose parent no longer exists or is pending reclamation.
+type OrphanedArtifact struct {
+	// The kind of artifact (one of the OrphanedArtifactKind*Const values).
+	Kind string
+
+	// The ID of the artifact.
+	ID string
+
+	// The name of the artifact.
+	Name string
+
+	// The CRN of the artifact.
+	CRN string
+
+	// The ID or CRN of the parent instance (for aliases) or the CRN of the parent instance or alias (for keys and
+	// bindings).
+	Parent string
+
+	// Why the artifact is orphaned (one of the OrphanedArtifactReason*Const values).
+	Reason string
+
+	// True if the artifact was deleted by CleanupOrphanedArtifacts.
+	Deleted bool
+
+	// The error returned when CleanupOrphanedArtifacts tried to delete the artifact, if any.
+	DeleteError error
+}
+
+// OrphanedArtifactsReport : The orphaned artifacts found by FindOrphanedArtifacts. Keys and bindings are listed before
+// aliases so that they are deleted first.
+type OrphanedArtifactsReport struct {
+	// The orphaned artifacts.
+	Artifacts []OrphanedArtifact
+
+	// The number of resource keys, bindings and aliases that were checked.
+	Checked int
+
+	// The workflow ID sent with the requests made by FindOrphanedArtifacts, and reused by CleanupOrphanedArtifacts
+	// (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// Failed returns the artifacts that CleanupOrphanedArtifacts could not delete.
+func (report *OrphanedArtifactsReport) Failed() (failed []OrphanedArtifact) {
+	for _, artifact := range report.Artifacts {
+		if artifact.DeleteError != nil {
+			failed = append(failed, artifact)
+		}
+	}
+	return
+}
+
+// FindOrphanedArtifacts lists the resource keys, bindings and aliases in the account and reports those whose parent
+// resource instance (or, for keys and bindings created for an alias, parent alias) does not exist or is pending
+// reclamation. A parent in any other state, such as inactive or failed, is considered to exist. Nothing is deleted;
+// pass the report to CleanupOrphanedArtifacts to delete the artifacts.
+func (resourceController *ResourceControllerV2) FindOrphanedArtifacts(ctx context.Context) (report *OrphanedArtifactsReport, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, resourceController.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	// parents maps the ID, GUID and CRN of each potential parent to "" if it is live, or to the reason its children
+	// are orphaned.
+	parents := map[string]string{}
+
+	// Without a state, only active and provisioning instances are listed, so the other states that keep their
+	// children usable are listed one by one.
+	for _, state := range orphanParentStates {
+		var instances []ResourceInstance
+		instances, err = resourceController.ListAllResourceInstances(ctx, resourceController.NewListResourceInstancesOptions().SetState(state))
+		if err != nil {
+			return
+		}
+		for _, instance := range instances {
+			addParent(parents, "", instance.ID, instance.GUID, instance.CRN)
+		}
+	}
+
+	reclamations, _, err := resourceController.ListReclamationsWithContext(ctx, resourceController.NewListReclamationsOptions())
+	if err != nil {
+		return
+	}
+	for _, reclamation := range reclamations.Resources {
+		addParent(parents, OrphanedArtifactReasonParentPendingReclamationConst, reclamation.ResourceInstanceID, reclamation.EntityID, reclamation.EntityCRN)
+	}
+
+	aliasesPager, err := resourceController.NewResourceAliasesPager(resourceController.NewListResourceAliasesOptions())
+	if err != nil {
+		return
+	}
+	aliases, err := aliasesPager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	var orphanedAliases []OrphanedArtifact
+	for _, alias := range aliases {
+		reason := parentReason(parents, alias.ResourceInstanceID)
+		if reason != "" {
+			orphanedAliases = append(orphanedAliases, OrphanedArtifact{
+				Kind:   OrphanedArtifactKindResourceAliasConst,
+				ID:     core.StringNilMapper(alias.ID),
+				Name:   core.StringNilMapper(alias.Name),
+				CRN:    core.StringNilMapper(alias.CRN),
+				Parent: core.StringNilMapper(alias.ResourceInstanceID),
+				Reason: reason,
+			})
+		}
+		// Keys and bindings of an orphaned alias are orphaned for the same reason as the alias.
+		addParent(parents, reason, alias.ID, alias.GUID, alias.CRN)
+	}
+
+	keysPager, err := resourceController.NewResourceKeysPager(resourceController.NewListResourceKeysOptions())
+	if err != nil {
+		return
+	}
+	keys, err := keysPager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	bindingsPager, err := resourceController.NewResourceBindingsPager(resourceController.NewListResourceBindingsOptions())
+	if err != nil {
+		return
+	}
+	bindings, err := bindingsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+
+	report = &OrphanedArtifactsReport{Checked: len(aliases) + len(keys) + len(bindings), WorkflowID: workflowID}
+	for _, key := range keys {
+		if reason := parentReason(parents, key.SourceCRN); reason != "" {
+			report.Artifacts = append(report.Artifacts, OrphanedArtifact{
+				Kind:   OrphanedArtifactKindResourceKeyConst,
+				ID:     core.StringNilMapper(key.ID),
+				Name:   core.StringNilMapper(key.Name),
+				CRN:    core.StringNilMapper(key.CRN),
+				Parent: core.StringNilMapper(key.SourceCRN),
+				Reason: reason,
+			})
+		}
+	}
+	for _, binding := range bindings {
+		if reason := parentReason(parents, binding.SourceCRN); reason != "" {
+			report.Artifacts = append(report.Artifacts, OrphanedArtifact{
+				Kind:   OrphanedArtifactKindResourceBindingConst,
+				ID:     core.StringNilMapper(binding.ID),
+				Name:   core.StringNilMapper(binding.Name),
+				CRN:    core.StringNilMapper(binding.CRN),
+				Parent: core.StringNilMapper(binding.SourceCRN),
+				Reason: reason,
+			})
+		}
+	}
+	report.Artifacts = append(report.Artifacts, orphanedAliases...)
+	return
+}
+
+// CleanupOrphanedArtifacts deletes the artifacts in a report returned by FindOrphanedArtifacts, recording the outcome
+// in the Deleted and DeleteError fields of each artifact. Artifacts that no longer exist are considered deleted. An
+// error is returned if any artifact could not be deleted; the remaining artifacts are still attempted unless the
+// context is done, in which case a *common.PartialError is returned and the artifacts that were not attempted are
+// left unchanged.
+func (resourceController *ResourceControllerV2) CleanupOrphanedArtifacts(ctx context.Context, report *OrphanedArtifactsReport) (err error) {
+	if common.WorkflowID(ctx) == "" && report.WorkflowID != "" {
+		ctx = common.WithWorkflowID(ctx, report.WorkflowID)
+	}
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, resourceController.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	failures := 0
+	for i := range report.Artifacts {
+		if ctx.Err() != nil {
+			err = &common.PartialError{Processed: i, Total: len(report.Artifacts), Err: ctx.Err()}
+			return
+		}
+
+		artifact := &report.Artifacts[i]
+		if artifact.Deleted {
+			continue
+		}
+		var response *core.DetailedResponse
+		switch artifact.Kind {
+		case OrphanedArtifactKindResourceKeyConst:
+			response, artifact.DeleteError = resourceController.DeleteResourceKeyWithContext(ctx, resourceController.NewDeleteResourceKeyOptions(artifact.ID))
+		case OrphanedArtifactKindResourceBindingConst:
+			response, artifact.DeleteError = resourceController.DeleteResourceBindingWithContext(ctx, resourceController.NewDeleteResourceBindingOptions(artifact.ID))
+		case OrphanedArtifactKindResourceAliasConst:
+			response, artifact.DeleteError = resourceController.DeleteResourceAliasWithContext(ctx, resourceController.NewDeleteResourceAliasOptions(artifact.ID))
+		default:
+			artifact.DeleteError = fmt.Errorf("unknown artifact kind '%s'", artifact.Kind)
+		}
+		if artifact.DeleteError != nil && response != nil && response.StatusCode == http.StatusNotFound {
+			artifact.DeleteError = nil
+		}
+		artifact.Deleted = artifact.DeleteError == nil
+		if !artifact.Deleted {
+			failures++
+		}
+	}
+	if failures > 0 {
+		err = fmt.Errorf("%d of %d orphaned artifacts could not be deleted", failures, len(report.Artifacts))
+	}
+	return
+}
+
+// addParent records the state of a potential parent under each of its identifiers. A live parent is never overridden.
+func addParent(parents map[string]string, reason string, identifiers ...*string) {
+	for _, identifier := range identifiers {
+		if identifier == nil || *identifier == "" {
+			continue
+		}
+		if existing, found := parents[*identifier]; found && existing == "" {
+			continue
+		}
+		parents[*identifier] = reason
+	}
+}
+
+// parentReason returns "" if the parent is live, or the reason its children are orphaned.
+func parentReason(parents map[string]string, parent *string) string {
+	if parent == nil || *parent == "" {
+		return OrphanedArtifactReasonParentMissingConst
+	}
+	reason, found := parents[*parent]
+	if !found {
+		return OrphanedArtifactReasonParentMissingConst
+	}
+	return reason
+}
@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 orphaned artifacts`, func() {
+	var testServer *httptest.Server
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+	var deleted []string
+	var workflowIDs map[string]bool
+	BeforeEach(func() {
+		deleted = nil
+		workflowIDs = map[string]bool{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			workflowIDs[req.Header.Get(common.WorkflowIDHeader)] = true
+			if req.Method == "DELETE" {
+				deleted = append(deleted, req.URL.EscapedPath())
+				switch req.URL.EscapedPath() {
+				case "/v2/resource_keys/key-gone":
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"message": "not found"}`)
+				case "/v2/resource_aliases/alias-orphan":
+					res.WriteHeader(400)
+					fmt.Fprint(res, `{"message": "alias has bindings"}`)
+				default:
+					res.WriteHeader(204)
+				}
+				return
+			}
+
+			Expect(req.Method).To(Equal("GET"))
+			switch req.URL.EscapedPath() {
+			case "/v2/resource_instances":
+				switch req.URL.Query().Get("state") {
+				case "active":
+					fmt.Fprint(res, `{"rows_count": 1, "next_url": null, "resources": [{"id": "crn:instance1", "guid": "instance1", "crn": "crn:instance1"}]}`)
+				case "inactive":
+					fmt.Fprint(res, `{"rows_count": 1, "next_url": null, "resources": [{"id": "crn:instance5", "guid": "instance5", "crn": "crn:instance5"}]}`)
+				case "provisioning", "pre_provisioning", "failed":
+					fmt.Fprint(res, `{"rows_count": 0, "next_url": null, "resources": []}`)
+				default:
+					Fail("unexpected state " + req.URL.RawQuery)
+				}
+			case "/v1/reclamations":
+				fmt.Fprint(res, `{"resources": [{"id": "r1", "entity_id": "instance2", "entity_crn": "crn:instance2", "resource_instance_id": "instance2"}]}`)
+			case "/v2/resource_aliases":
+				fmt.Fprint(res, `{"rows_count": 2, "next_url": null, "resources": [
+					{"id": "alias-live", "crn": "crn:alias-live", "resource_instance_id": "crn:instance1"},
+					{"id": "alias-orphan", "name": "old-alias", "crn": "crn:alias-orphan", "resource_instance_id": "crn:instance3"}]}`)
+			case "/v2/resource_keys":
+				fmt.Fprint(res, `{"rows_count": 5, "next_url": null, "resources": [
+					{"id": "key-live", "source_crn": "crn:instance1"},
+					{"id": "key-inactive-parent", "source_crn": "crn:instance5"},
+					{"id": "key-live-alias", "source_crn": "crn:alias-live"},
+					{"id": "key-reclaimed", "name": "reclaimed", "source_crn": "crn:instance2"},
+					{"id": "key-gone", "source_crn": "crn:instance4"}]}`)
+			case "/v2/resource_bindings":
+				fmt.Fprint(res, `{"rows_count": 1, "next_url": null, "resources": [{"id": "binding-orphan", "source_crn": "crn:alias-orphan"}]}`)
+			default:
+				Fail("unexpected request " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		resourceControllerService, serviceErr = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Finds and cleans up orphaned artifacts`, func() {
+		common.EnableWorkflowIDs(resourceControllerService.Service)
+		report, err := resourceControllerService.FindOrphanedArtifacts(context.Background())
+		Expect(err).To(BeNil())
+		Expect(report.Checked).To(Equal(8))
+
+		var found []string
+		for _, artifact := range report.Artifacts {
+			found = append(found, artifact.Kind+" "+artifact.ID+" "+artifact.Reason)
+		}
+		// The key of the inactive instance is not orphaned.
+		Expect(found).To(Equal([]string{
+			"resource_key key-reclaimed parent_pending_reclamation",
+			"resource_key key-gone parent_missing",
+			"resource_binding binding-orphan parent_missing",
+			"resource_alias alias-orphan parent_missing",
+		}))
+		Expect(report.Artifacts[0].Name).To(Equal("reclaimed"))
+		Expect(report.Artifacts[0].Parent).To(Equal("crn:instance2"))
+		Expect(deleted).To(BeEmpty())
+
+		err = resourceControllerService.CleanupOrphanedArtifacts(context.Background(), report)
+		Expect(err).ToNot(BeNil())
+		var workflowErr *common.WorkflowError
+		Expect(errors.As(err, &workflowErr)).To(BeTrue())
+		Expect(workflowErr.WorkflowID).To(Equal(report.WorkflowID))
+		Expect(workflowErr.Err.Error()).To(Equal("1 of 4 orphaned artifacts could not be deleted"))
+		Expect(deleted).To(Equal([]string{
+			"/v2/resource_keys/key-reclaimed",
+			"/v2/resource_keys/key-gone",
+			"/v2/resource_bindings/binding-orphan",
+			"/v2/resource_aliases/alias-orphan",
+		}))
+		Expect(report.Artifacts[1].Deleted).To(BeTrue())
+		failed := report.Failed()
+		Expect(failed).To(HaveLen(1))
+		Expect(failed[0].ID).To(Equal("alias-orphan"))
+
+		deleted = nil
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err = resourceControllerService.CleanupOrphanedArtifacts(ctx, report)
+		var partialErr *common.PartialError
+		Expect(errors.As(err, &partialErr)).To(BeTrue())
+		Expect(partialErr.Processed).To(Equal(0))
+		Expect(partialErr.Total).To(Equal(4))
+		Expect(deleted).To(BeEmpty())
+
+		Expect(resourceControllerService.CleanupOrphanedArtifacts(context.Background(), report)).ToNot(BeNil())
+		Expect(deleted).To(Equal([]string{"/v2/resource_aliases/alias-orphan"}))
+
+		// Every request of the find and cleanup workflow carried its ID.
+		Expect(workflowIDs).To(Equal(map[string]bool{report.WorkflowID: true}))
+	})
+})
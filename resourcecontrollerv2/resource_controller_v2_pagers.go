@@ -0,0 +1,435 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// DefaultListConcurrency is the number of resource groups that ListAllByResourceGroup lists concurrently when no
+// concurrency is specified.
+const DefaultListConcurrency = 8
+
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ResourceInstancesList) GetNextStart() (*string, error) {
+	return core.GetQueryParam(resp.NextURL, "start")
+}
+
+// ResourceInstancesPager can be used to simplify the use of the "ListResourceInstances" method.
+type ResourceInstancesPager struct {
+	hasNext     bool
+	options     *ListResourceInstancesOptions
+	client      *ResourceControllerV2
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewResourceInstancesPager returns a new ResourceInstancesPager instance.
+func (resourceController *ResourceControllerV2) NewResourceInstancesPager(options *ListResourceInstancesOptions) (pager *ResourceInstancesPager, err error) {
+	if options.Start != nil && *options.Start != "" {
+		err = fmt.Errorf("the 'options.Start' field should not be set")
+		return
+	}
+
+	var optionsCopy ListResourceInstancesOptions = *options
+	pager = &ResourceInstancesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  resourceController,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ResourceInstancesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ResourceInstancesPager) GetNextWithContext(ctx context.Context) (page []ResourceInstance, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Start = pager.pageContext.next
+
+	result, _, err := pager.client.ListResourceInstancesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextStart()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Resources
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ResourceInstancesPager) GetAllWithContext(ctx context.Context) (allItems []ResourceInstance, err error) {
+	for pager.HasNext() {
+		var nextPage []ResourceInstance
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ResourceInstancesPager) GetNext() (page []ResourceInstance, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ResourceInstancesPager) GetAll() (allItems []ResourceInstance, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ResourceKeysList) GetNextStart() (*string, error) {
+	return core.GetQueryParam(resp.NextURL, "start")
+}
+
+// ResourceKeysPager can be used to simplify the use of the "ListResourceKeys" method.
+type ResourceKeysPager struct {
+	hasNext     bool
+	options     *ListResourceKeysOptions
+	client      *ResourceControllerV2
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewResourceKeysPager returns a new ResourceKeysPager instance.
+func (resourceController *ResourceControllerV2) NewResourceKeysPager(options *ListResourceKeysOptions) (pager *ResourceKeysPager, err error) {
+	if options.Start != nil && *options.Start != "" {
+		err = fmt.Errorf("the 'options.Start' field should not be set")
+		return
+	}
+
+	var optionsCopy ListResourceKeysOptions = *options
+	pager = &ResourceKeysPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  resourceController,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ResourceKeysPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ResourceKeysPager) GetNextWithContext(ctx context.Context) (page []ResourceKey, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Start = pager.pageContext.next
+
+	result, _, err := pager.client.ListResourceKeysWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextStart()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Resources
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ResourceKeysPager) GetAllWithContext(ctx context.Context) (allItems []ResourceKey, err error) {
+	for pager.HasNext() {
+		var nextPage []ResourceKey
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ResourceKeysPager) GetNext() (page []ResourceKey, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ResourceKeysPager) GetAll() (allItems []ResourceKey, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ResourceBindingsList) GetNextStart() (*string, error) {
+	return core.GetQueryParam(resp.NextURL, "start")
+}
+
+// ResourceBindingsPager can be used to simplify the use of the "ListResourceBindings" method.
+type ResourceBindingsPager struct {
+	hasNext     bool
+	options     *ListResourceBindingsOptions
+	client      *ResourceControllerV2
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewResourceBindingsPager returns a new ResourceBindingsPager instance.
+func (resourceController *ResourceControllerV2) NewResourceBindingsPager(options *ListResourceBindingsOptions) (pager *ResourceBindingsPager, err error) {
+	if options.Start != nil && *options.Start != "" {
+		err = fmt.Errorf("the 'options.Start' field should not be set")
+		return
+	}
+
+	var optionsCopy ListResourceBindingsOptions = *options
+	pager = &ResourceBindingsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  resourceController,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ResourceBindingsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ResourceBindingsPager) GetNextWithContext(ctx context.Context) (page []ResourceBinding, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Start = pager.pageContext.next
+
+	result, _, err := pager.client.ListResourceBindingsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextStart()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Resources
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ResourceBindingsPager) GetAllWithContext(ctx context.Context) (allItems []ResourceBinding, err error) {
+	for pager.HasNext() {
+		var nextPage []ResourceBinding
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ResourceBindingsPager) GetNext() (page []ResourceBinding, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ResourceBindingsPager) GetAll() (allItems []ResourceBinding, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ResourceAliasesList) GetNextStart() (*string, error) {
+	return core.GetQueryParam(resp.NextURL, "start")
+}
+
+// ResourceAliasesPager can be used to simplify the use of the "ListResourceAliases" method.
+type ResourceAliasesPager struct {
+	hasNext     bool
+	options     *ListResourceAliasesOptions
+	client      *ResourceControllerV2
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewResourceAliasesPager returns a new ResourceAliasesPager instance.
+func (resourceController *ResourceControllerV2) NewResourceAliasesPager(options *ListResourceAliasesOptions) (pager *ResourceAliasesPager, err error) {
+	if options.Start != nil && *options.Start != "" {
+		err = fmt.Errorf("the 'options.Start' field should not be set")
+		return
+	}
+
+	var optionsCopy ListResourceAliasesOptions = *options
+	pager = &ResourceAliasesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  resourceController,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ResourceAliasesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ResourceAliasesPager) GetNextWithContext(ctx context.Context) (page []ResourceAlias, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Start = pager.pageContext.next
+
+	result, _, err := pager.client.ListResourceAliasesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextStart()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Resources
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ResourceAliasesPager) GetAllWithContext(ctx context.Context) (allItems []ResourceAlias, err error) {
+	for pager.HasNext() {
+		var nextPage []ResourceAlias
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ResourceAliasesPager) GetNext() (page []ResourceAlias, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ResourceAliasesPager) GetAll() (allItems []ResourceAlias, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// ListAllResourceInstances returns every resource instance that matches "options", retrieving all pages of results.
+func (resourceController *ResourceControllerV2) ListAllResourceInstances(ctx context.Context, options *ListResourceInstancesOptions) (allItems []ResourceInstance, err error) {
+	pager, err := resourceController.NewResourceInstancesPager(options)
+	if err != nil {
+		return
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+// ListAllByResourceGroup returns every resource instance that matches "options" in each of the specified resource
+// groups, keyed by resource group ID. Pages are requested serially within a resource group, because each page token
+// comes from the previous page, but up to "concurrency" resource groups are listed concurrently
+// (DefaultListConcurrency if "concurrency" is not positive). The ResourceGroupID of "options" is ignored. If listing
+// any resource group fails, the remaining requests are cancelled and the first error is returned. If the context is
+// done, the resource groups that were listed are returned with a *common.PartialError; the others are not in the map.
+func (resourceController *ResourceControllerV2) ListAllByResourceGroup(ctx context.Context, resourceGroupIDs []string, options *ListResourceInstancesOptions, concurrency int) (result map[string][]ResourceInstance, err error) {
+	if options == nil {
+		options = resourceController.NewListResourceInstancesOptions()
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultListConcurrency
+	}
+
+	listCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	result = make(map[string][]ResourceInstance, len(resourceGroupIDs))
+	var uniqueIDs []string
+	for _, resourceGroupID := range resourceGroupIDs {
+		if _, found := result[resourceGroupID]; !found {
+			result[resourceGroupID] = nil
+			uniqueIDs = append(uniqueIDs, resourceGroupID)
+		}
+	}
+
+	var mutex sync.Mutex
+	listed := make(map[string]bool, len(uniqueIDs))
+	partialErr := common.ForEachConcurrently(listCtx, len(uniqueIDs), concurrency, func(listCtx context.Context, i int) {
+		resourceGroupID := uniqueIDs[i]
+		groupOptions := *options
+		groupOptions.ResourceGroupID = core.StringPtr(resourceGroupID)
+		instances, listErr := resourceController.ListAllResourceInstances(listCtx, &groupOptions)
+		mutex.Lock()
+		defer mutex.Unlock()
+		if listErr != nil {
+			if err == nil && ctx.Err() == nil {
+				err = fmt.Errorf("error listing resource instances in resource group '%s': %w", resourceGroupID, listErr)
+				cancel()
+			}
+			return
+		}
+		result[resourceGroupID] = instances
+		listed[resourceGroupID] = true
+	})
+
+	if err != nil {
+		result = nil
+	} else if partialErr != nil {
+		// The context of the caller is done: only the resource groups that were listed are kept.
+		err = partialErr
+		for resourceGroupID := range result {
+			if !listed[resourceGroupID] {
+				delete(result, resourceGroupID)
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,137 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 resource instance pagers`, func() {
+	var testServer *httptest.Server
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+	var cancelOnGroup context.CancelFunc
+	BeforeEach(func() {
+		cancelOnGroup = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v2/resource_instances"))
+			Expect(req.URL.Query().Get("type")).To(Equal("service_instance"))
+			res.Header().Set("Content-type", "application/json")
+			group := req.URL.Query().Get("resource_group_id")
+			switch group + "/" + req.URL.Query().Get("start") {
+			case "/", "rg1/":
+				fmt.Fprintf(res, `{"rows_count": 2, "next_url": "/v2/resource_instances?start=page2&resource_group_id=%s",
+					"resources": [{"id": "%s-a"}, {"id": "%s-b"}]}`, group, group, group)
+			case "/page2", "rg1/page2":
+				fmt.Fprintf(res, `{"rows_count": 1, "next_url": null, "resources": [{"id": "%s-c"}]}`, group)
+			case "rg2/":
+				if cancelOnGroup != nil {
+					cancelOnGroup()
+				}
+				fmt.Fprint(res, `{"rows_count": 1, "next_url": null, "resources": [{"id": "rg2-a"}]}`)
+			case "rg3/":
+				fmt.Fprint(res, `{"rows_count": 0, "next_url": null, "resources": []}`)
+			default:
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"message": "internal error"}`)
+			}
+		}))
+		var serviceErr error
+		resourceControllerService, serviceErr = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	ids := func(instances []resourcecontrollerv2.ResourceInstance) (result []string) {
+		for _, instance := range instances {
+			result = append(result, *instance.ID)
+		}
+		return
+	}
+	newOptions := func() *resourcecontrollerv2.ListResourceInstancesOptions {
+		return resourceControllerService.NewListResourceInstancesOptions().SetType("service_instance")
+	}
+
+	It(`Use ResourceInstancesPager.GetNext successfully`, func() {
+		pager, err := resourceControllerService.NewResourceInstancesPager(newOptions())
+		Expect(err).To(BeNil())
+
+		var allResults []resourcecontrollerv2.ResourceInstance
+		for pager.HasNext() {
+			nextPage, err := pager.GetNext()
+			Expect(err).To(BeNil())
+			allResults = append(allResults, nextPage...)
+		}
+		Expect(ids(allResults)).To(Equal([]string{"-a", "-b", "-c"}))
+		_, err = pager.GetNext()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Use ResourceInstancesPager.GetAll successfully`, func() {
+		pager, err := resourceControllerService.NewResourceInstancesPager(newOptions())
+		Expect(err).To(BeNil())
+		allResults, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(allResults).To(HaveLen(3))
+
+		_, err = resourceControllerService.NewResourceInstancesPager(newOptions().SetStart("page2"))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Lists resource groups concurrently`, func() {
+		result, err := resourceControllerService.ListAllByResourceGroup(context.Background(),
+			[]string{"rg1", "rg2", "rg3", "rg1"}, newOptions(), 2)
+		Expect(err).To(BeNil())
+		Expect(result).To(HaveLen(3))
+		Expect(ids(result["rg1"])).To(Equal([]string{"rg1-a", "rg1-b", "rg1-c"}))
+		Expect(ids(result["rg2"])).To(Equal([]string{"rg2-a"}))
+		Expect(result["rg3"]).To(BeEmpty())
+	})
+	It(`Returns the first error`, func() {
+		result, err := resourceControllerService.ListAllByResourceGroup(context.Background(),
+			[]string{"rg1", "broken"}, newOptions(), 0)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("resource group 'broken'"))
+		Expect(result).To(BeNil())
+	})
+	It(`Returns the resource groups listed before the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cancelOnGroup = cancel
+		result, err := resourceControllerService.ListAllByResourceGroup(ctx, []string{"rg1", "rg2", "rg3"}, newOptions(), 1)
+		var partialErr *common.PartialError
+		Expect(errors.As(err, &partialErr)).To(BeTrue())
+		Expect(partialErr.Processed).To(Equal(2))
+		Expect(partialErr.Total).To(Equal(3))
+		Expect(result).To(HaveLen(1))
+		Expect(ids(result["rg1"])).To(Equal([]string{"rg1-a", "rg1-b", "rg1-c"}))
+	})
+})
@@ -0,0 +1,121 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the RunReclamationActionOptions.ActionName property.
+const (
+	ReclamationActionReclaimConst = "reclaim"
+	ReclamationActionRestoreConst = "restore"
+)
+
+// Constants associated with the Reclamation.State property.
+const (
+	ReclamationStateFailedConst     = "FAILED"
+	ReclamationStateReclaimedConst  = "RECLAIMED"
+	ReclamationStateReclaimingConst = "RECLAIMING"
+	ReclamationStateRestoredConst   = "RESTORED"
+	ReclamationStateRestoringConst  = "RESTORING"
+	ReclamationStateScheduledConst  = "SCHEDULED"
+)
+
+// RestoreOrPurge finds the reclamation of a deleted resource instance and runs the specified action on it:
+// ReclamationActionRestoreConst to make the instance usable again, or ReclamationActionReclaimConst to delete it
+// permanently. "resourceID" is the ID, GUID or CRN of the instance. If "waitForCompletion" is true, the reclamation is
+// polled (as specified by "pollConfig") until it completes, and an error is returned if it fails. If the timeout of
+// "pollConfig" or the deadline of the context expires first, the error reports the current state and wraps
+// context.DeadlineExceeded; if the context is cancelled, context.Canceled is returned. The reclamation returned by the
+// action is returned.
+func (resourceController *ResourceControllerV2) RestoreOrPurge(ctx context.Context, resourceID string, action string, waitForCompletion bool, pollConfig PollConfig) (result *Reclamation, err error) {
+	if action != ReclamationActionRestoreConst && action != ReclamationActionReclaimConst {
+		err = fmt.Errorf("unsupported reclamation action '%s'", action)
+		return
+	}
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, resourceController.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	reclamation, err := resourceController.findReclamation(ctx, resourceID)
+	if err != nil {
+		return
+	}
+	if reclamation == nil {
+		err = fmt.Errorf("no reclamation found for resource instance '%s'", resourceID)
+		return
+	}
+
+	runReclamationActionOptions := resourceController.NewRunReclamationActionOptions(*reclamation.ID, action)
+	result, _, err = resourceController.RunReclamationActionWithContext(ctx, runReclamationActionOptions)
+	if err != nil || !waitForCompletion {
+		return
+	}
+
+	state := core.StringNilMapper(result.State)
+	err = pollWithBackoff(ctx, pollConfig, func(ctx context.Context) (done bool, err error) {
+		current, err := resourceController.findReclamation(ctx, resourceID)
+		if err != nil {
+			return
+		}
+		// A completed reclamation is no longer listed.
+		if current == nil {
+			return true, nil
+		}
+		state = core.StringNilMapper(current.State)
+		switch {
+		case strings.EqualFold(state, ReclamationStateFailedConst):
+			return false, fmt.Errorf("%s action on the reclamation of resource instance '%s' failed", action, resourceID)
+		case strings.EqualFold(state, ReclamationStateReclaimedConst), strings.EqualFold(state, ReclamationStateRestoredConst):
+			return true, nil
+		}
+		return false, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("timed out waiting for the %s action on resource instance '%s' to complete (current state '%s'): %w",
+			action, resourceID, state, err)
+	}
+	return
+}
+
+// findReclamation returns the reclamation of the resource instance with the specified ID, GUID or CRN, or nil if there
+// is none.
+func (resourceController *ResourceControllerV2) findReclamation(ctx context.Context, resourceID string) (reclamation *Reclamation, err error) {
+	listReclamationsOptions := resourceController.NewListReclamationsOptions()
+	if !strings.HasPrefix(resourceID, "crn:") {
+		listReclamationsOptions.SetResourceInstanceID(resourceID)
+	}
+	reclamations, _, err := resourceController.ListReclamationsWithContext(ctx, listReclamationsOptions)
+	if err != nil {
+		return
+	}
+	for i, candidate := range reclamations.Resources {
+		for _, id := range []*string{candidate.ResourceInstanceID, candidate.EntityID, candidate.EntityCRN} {
+			if id != nil && *id == resourceID {
+				reclamation = &reclamations.Resources[i]
+				return
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,123 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 RestoreOrPurge`, func() {
+	var testServer *httptest.Server
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+	var listResponses []string
+	var listCount int
+	var actions []string
+	pollConfig := resourcecontrollerv2.PollConfig{InitialInterval: time.Millisecond}
+	BeforeEach(func() {
+		listCount = 0
+		actions = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v1/reclamations":
+				Expect(req.URL.Query().Get("resource_instance_id")).To(Equal("guid1"))
+				response := listResponses[listCount]
+				if listCount < len(listResponses)-1 {
+					listCount++
+				}
+				fmt.Fprint(res, response)
+			case req.Method == "POST":
+				actions = append(actions, req.URL.EscapedPath())
+				fmt.Fprint(res, `{"id": "rec1", "resource_instance_id": "guid1", "state": "RESTORING"}`)
+			default:
+				Fail("unexpected request " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		resourceControllerService, serviceErr = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Restores an instance and waits for completion`, func() {
+		listResponses = []string{
+			`{"resources": [{"id": "rec1", "resource_instance_id": "guid1", "state": "SCHEDULED"}]}`,
+			`{"resources": [{"id": "rec1", "resource_instance_id": "guid1", "state": "RESTORING"}]}`,
+			`{"resources": []}`,
+		}
+		result, err := resourceControllerService.RestoreOrPurge(context.Background(), "guid1", resourcecontrollerv2.ReclamationActionRestoreConst, true, pollConfig)
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("rec1"))
+		Expect(actions).To(Equal([]string{"/v1/reclamations/rec1/actions/restore"}))
+		Expect(listCount).To(Equal(2))
+	})
+	It(`Reclaims an instance without waiting`, func() {
+		listResponses = []string{`{"resources": [{"id": "rec1", "resource_instance_id": "guid1", "state": "SCHEDULED"}]}`}
+		_, err := resourceControllerService.RestoreOrPurge(context.Background(), "guid1", resourcecontrollerv2.ReclamationActionReclaimConst, false, pollConfig)
+		Expect(err).To(BeNil())
+		Expect(actions).To(Equal([]string{"/v1/reclamations/rec1/actions/reclaim"}))
+		Expect(listCount).To(Equal(0))
+	})
+	It(`Reports failed actions`, func() {
+		listResponses = []string{
+			`{"resources": [{"id": "rec1", "resource_instance_id": "guid1", "state": "SCHEDULED"}]}`,
+			`{"resources": [{"id": "rec1", "resource_instance_id": "guid1", "state": "FAILED"}]}`,
+		}
+		_, err := resourceControllerService.RestoreOrPurge(context.Background(), "guid1", resourcecontrollerv2.ReclamationActionReclaimConst, true, pollConfig)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("reclaim action on the reclamation of resource instance 'guid1' failed"))
+	})
+	It(`Rejects unknown instances and actions`, func() {
+		listResponses = []string{`{"resources": []}`}
+		_, err := resourceControllerService.RestoreOrPurge(context.Background(), "guid1", resourcecontrollerv2.ReclamationActionRestoreConst, true, pollConfig)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("no reclamation found"))
+		_, err = resourceControllerService.RestoreOrPurge(context.Background(), "guid1", "delete", true, pollConfig)
+		Expect(err).ToNot(BeNil())
+		Expect(actions).To(BeEmpty())
+	})
+	It(`Reports a timeout only when the deadline expires`, func() {
+		listResponses = []string{`{"resources": [{"id": "rec1", "resource_instance_id": "guid1", "state": "RESTORING"}]}`}
+		timeoutConfig := resourcecontrollerv2.PollConfig{InitialInterval: time.Millisecond, Timeout: 20 * time.Millisecond}
+		_, err := resourceControllerService.RestoreOrPurge(context.Background(), "guid1", resourcecontrollerv2.ReclamationActionRestoreConst, true, timeoutConfig)
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("timed out waiting for the restore action on resource instance 'guid1' to complete (current state 'RESTORING')"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+		_, err = resourceControllerService.RestoreOrPurge(ctx, "guid1", resourcecontrollerv2.ReclamationActionRestoreConst, true, pollConfig)
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		Expect(err.Error()).ToNot(ContainSubstring("timed out"))
+	})
+})
@@ -0,0 +1,162 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// ResourceKeyRotation : The result of rotating a resource key with RotateResourceKey.
+type ResourceKeyRotation struct {
+	// The key that was rotated, including its credentials.
+	OldKey *ResourceKey
+
+	// The replacement key, including its credentials.
+	NewKey *ResourceKey
+
+	// The time at which the old key will be deleted, or the zero time if its deletion was not scheduled.
+	DeletionScheduledAt time.Time
+
+	timer     *time.Timer
+	deleted   chan struct{}
+	deleteErr error
+}
+
+// CancelDeletion cancels the scheduled deletion of the old key. It returns false if no deletion was scheduled or the
+// deletion has already started.
+func (rotation *ResourceKeyRotation) CancelDeletion() bool {
+	if rotation.timer == nil || !rotation.timer.Stop() {
+		return false
+	}
+	close(rotation.deleted)
+	return true
+}
+
+// WaitForDeletion waits for the scheduled deletion of the old key and returns its error. It returns nil immediately
+// if no deletion was scheduled, and nil once a deletion is cancelled.
+func (rotation *ResourceKeyRotation) WaitForDeletion(ctx context.Context) error {
+	if rotation.deleted == nil {
+		return nil
+	}
+	select {
+	case <-rotation.deleted:
+		return rotation.deleteErr
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RotateResourceKey creates a replacement for the resource key with the specified ID, for the same source instance
+// or alias, with the same name, role and parameters and (so that the replacement keeps the access policies granted to
+// the old key) the same service ID. Both keys are returned with their credentials so that consumers can be moved to
+// the new credentials.
+// If "gracePeriod" is positive, the old key is deleted once it elapses. The deletion runs in the calling process, so
+// the process must keep running until then; use WaitForDeletion to wait for it and CancelDeletion to keep the old key.
+// Because the keys share the service ID, the service ID must outlive the old key: rotate keys that were created with
+// an existing service ID (the "serviceid_crn" parameter), since the resource controller deletes a service ID that it
+// created for a key along with the key, which would also revoke the access of the replacement.
+func (resourceController *ResourceControllerV2) RotateResourceKey(ctx context.Context, keyID string, gracePeriod time.Duration) (result *ResourceKeyRotation, err error) {
+	oldKey, parameters, err := resourceController.getResourceKeyWithParameters(ctx, keyID)
+	if err != nil {
+		return
+	}
+	if oldKey.SourceCRN == nil || oldKey.Name == nil {
+		err = fmt.Errorf("resource key '%s' does not identify its name and source", keyID)
+		return
+	}
+	if oldKey.Credentials == nil || oldKey.Credentials.Redacted != nil {
+		err = fmt.Errorf("the credentials of resource key '%s' are redacted; the role of the key cannot be determined", keyID)
+		return
+	}
+
+	createResourceKeyOptions := resourceController.NewCreateResourceKeyOptions(*oldKey.Name, *oldKey.SourceCRN)
+	if oldKey.Credentials.IamRoleCRN != nil {
+		createResourceKeyOptions.SetRole(*oldKey.Credentials.IamRoleCRN)
+	}
+	if parameters.ServiceidCRN == nil {
+		parameters.ServiceidCRN = oldKey.Credentials.IamServiceidCRN
+	}
+	if parameters.ServiceidCRN != nil || len(parameters.additionalProperties) > 0 {
+		createResourceKeyOptions.SetParameters(parameters)
+	}
+	newKey, _, err := resourceController.CreateResourceKeyWithContext(ctx, createResourceKeyOptions)
+	if err != nil {
+		return
+	}
+
+	result = &ResourceKeyRotation{
+		OldKey: oldKey,
+		NewKey: newKey,
+	}
+	if gracePeriod > 0 {
+		result.DeletionScheduledAt = time.Now().Add(gracePeriod)
+		result.deleted = make(chan struct{})
+		result.timer = time.AfterFunc(gracePeriod, func() {
+			defer close(result.deleted)
+			_, result.deleteErr = resourceController.DeleteResourceKey(resourceController.NewDeleteResourceKeyOptions(keyID))
+		})
+	}
+	return
+}
+
+// getResourceKeyWithParameters retrieves the resource key with the specified ID together with the parameters it was
+// created with, which are part of the response but not of the ResourceKey model.
+func (resourceController *ResourceControllerV2) getResourceKeyWithParameters(ctx context.Context, keyID string) (key *ResourceKey, parameters *ResourceKeyPostParameters, err error) {
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_keys/{id}`, map[string]string{"id": keyID})
+	if err != nil {
+		return
+	}
+	for headerName, headerValue := range common.GetSdkHeaders("resource_controller", "V2", "GetResourceKey") {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	_, err = resourceController.Service.Request(request, &rawResponse)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(rawResponse, "", &key, UnmarshalResourceKey)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(rawResponse, "parameters", &parameters, UnmarshalResourceKeyPostParameters)
+	if err != nil {
+		return
+	}
+	if key == nil {
+		err = fmt.Errorf("resource key '%s' was not returned", keyID)
+		return
+	}
+	if parameters == nil {
+		parameters = &ResourceKeyPostParameters{}
+	}
+	return
+}
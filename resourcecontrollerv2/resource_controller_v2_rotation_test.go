@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 RotateResourceKey`, func() {
+	var testServer *httptest.Server
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+	var mutex sync.Mutex
+	var deleted []string
+	BeforeEach(func() {
+		deleted = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v2/resource_keys/key1":
+				fmt.Fprint(res, `{"id": "key1", "name": "app-key", "source_crn": "crn:instance1", "parameters": {"HMAC": true},
+					"credentials": {"apikey": "old", "iam_role_crn": "crn:role:Writer", "iam_serviceid_crn": "crn:serviceid1"}}`)
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v2/resource_keys/redacted":
+				fmt.Fprint(res, `{"id": "redacted", "name": "app-key", "source_crn": "crn:instance1", "credentials": {"REDACTED": "REDACTED"}}`)
+			case req.Method == "POST" && req.URL.EscapedPath() == "/v2/resource_keys":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body).To(Equal(map[string]interface{}{
+					"name":       "app-key",
+					"source":     "crn:instance1",
+					"role":       "crn:role:Writer",
+					"parameters": map[string]interface{}{"serviceid_crn": "crn:serviceid1", "HMAC": true},
+				}))
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "key2", "name": "app-key", "credentials": {"apikey": "new"}}`)
+			case req.Method == "DELETE":
+				mutex.Lock()
+				deleted = append(deleted, req.URL.EscapedPath())
+				mutex.Unlock()
+				res.WriteHeader(204)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		resourceControllerService, serviceErr = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Creates a replacement key and keeps the old key`, func() {
+		rotation, err := resourceControllerService.RotateResourceKey(context.Background(), "key1", 0)
+		Expect(err).To(BeNil())
+		Expect(*rotation.OldKey.Credentials.Apikey).To(Equal("old"))
+		Expect(*rotation.NewKey.Credentials.Apikey).To(Equal("new"))
+		Expect(rotation.DeletionScheduledAt.IsZero()).To(BeTrue())
+		Expect(rotation.WaitForDeletion(context.Background())).To(BeNil())
+		Expect(rotation.CancelDeletion()).To(BeFalse())
+		Expect(deleted).To(BeEmpty())
+	})
+	It(`Deletes the old key after the grace period`, func() {
+		rotation, err := resourceControllerService.RotateResourceKey(context.Background(), "key1", 10*time.Millisecond)
+		Expect(err).To(BeNil())
+		Expect(rotation.DeletionScheduledAt.IsZero()).To(BeFalse())
+		Expect(rotation.WaitForDeletion(context.Background())).To(BeNil())
+		mutex.Lock()
+		defer mutex.Unlock()
+		Expect(deleted).To(Equal([]string{"/v2/resource_keys/key1"}))
+	})
+	It(`Cancels a scheduled deletion`, func() {
+		rotation, err := resourceControllerService.RotateResourceKey(context.Background(), "key1", time.Hour)
+		Expect(err).To(BeNil())
+		Expect(rotation.CancelDeletion()).To(BeTrue())
+		Expect(rotation.WaitForDeletion(context.Background())).To(BeNil())
+		Expect(deleted).To(BeEmpty())
+	})
+	It(`Rejects keys with redacted credentials`, func() {
+		_, err := resourceControllerService.RotateResourceKey(context.Background(), "redacted", 0)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("redacted"))
+	})
+})
@@ -0,0 +1,285 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// InstanceAction : A change applied to a resource instance by a scheduled action. Services that support being
+// stopped and started do so either through instance parameters or through a change of plan, so an action sets the
+// parameters, the plan, or both.
+type InstanceAction struct {
+	// The name of the action, for reporting (e.g. "stop").
+	Name string
+
+	// The parameters to set on the instance.
+	Parameters map[string]interface{}
+
+	// The ID of the plan to move the instance to.
+	ResourcePlanID string
+}
+
+// InstanceActionSchedule : When a scheduled action runs.
+type InstanceActionSchedule struct {
+	// The time of the first run.
+	At time.Time
+
+	// The interval between runs, or zero to run once. It is a fixed duration, so daily runs are shifted by an hour
+	// when daylight saving time starts or ends.
+	Every time.Duration
+
+	// If not empty, runs that fall on other days of the week (in the location of At) are skipped; for example, an
+	// action that runs every 24 hours on weekdays only.
+	Weekdays []time.Weekday
+}
+
+// ScheduledInstanceAction : An action scheduled with ScheduleInstanceAction.
+type ScheduledInstanceAction struct {
+	// The ID of the scheduled action, used to cancel it.
+	ID string
+
+	// The ID of the resource instance.
+	InstanceID string
+
+	// The action to run.
+	Action InstanceAction
+
+	// When the action runs.
+	Schedule InstanceActionSchedule
+
+	// The time of the next run.
+	NextRun time.Time
+
+	// The time of the last run, or the zero time if the action has not run yet.
+	LastRun time.Time
+
+	// The error of the last run, or nil if it succeeded.
+	LastError error
+}
+
+// InstanceActionRun : The outcome of running a scheduled action.
+type InstanceActionRun struct {
+	// The ID of the scheduled action.
+	ScheduledActionID string
+
+	// The ID of the resource instance.
+	InstanceID string
+
+	// The name of the action.
+	Action string
+
+	// The time at which the action was due.
+	DueAt time.Time
+
+	// The updated instance, or nil if the run failed.
+	Instance *ResourceInstance
+
+	// The error of the run, or nil if it succeeded.
+	Err error
+}
+
+// InstanceActionScheduler : Runs actions, such as stopping instances outside of office hours, on resource instances
+// according to schedules. Scheduled actions are kept in memory, so the process must keep running (see Run) for them to
+// be executed.
+type InstanceActionScheduler struct {
+	*ResourceControllerV2
+
+	// If not nil, called after each run.
+	OnRun func(InstanceActionRun)
+
+	mutex   sync.Mutex
+	actions map[string]*ScheduledInstanceAction
+	lastID  int64
+}
+
+// NewInstanceActionScheduler returns a new InstanceActionScheduler with no scheduled actions.
+func NewInstanceActionScheduler(resourceController *ResourceControllerV2) *InstanceActionScheduler {
+	return &InstanceActionScheduler{
+		ResourceControllerV2: resourceController,
+		actions:              make(map[string]*ScheduledInstanceAction),
+	}
+}
+
+// ScheduleInstanceAction schedules "action" to run on the resource instance with the specified ID according to
+// "schedule". The instance is retrieved first, so that an invalid ID is reported immediately rather than when the
+// action is due. Runs that are due before the first call to RunDue or Run are run once, then the schedule continues
+// from the current time.
+func (scheduler *InstanceActionScheduler) ScheduleInstanceAction(ctx context.Context, instanceID string, action InstanceAction, schedule InstanceActionSchedule) (result *ScheduledInstanceAction, err error) {
+	if len(action.Parameters) == 0 && action.ResourcePlanID == "" {
+		err = fmt.Errorf("the action must set parameters or a plan")
+		return
+	}
+	if schedule.At.IsZero() || schedule.Every < 0 {
+		err = fmt.Errorf("the schedule must have a start time and a non-negative interval")
+		return
+	}
+	nextRun, found := schedule.next(schedule.At.Add(-time.Nanosecond))
+	if !found {
+		err = fmt.Errorf("the schedule never runs on the specified weekdays")
+		return
+	}
+
+	_, _, err = scheduler.GetResourceInstanceWithContext(ctx, scheduler.NewGetResourceInstanceOptions(instanceID))
+	if err != nil {
+		return
+	}
+
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	scheduler.lastID++
+	scheduled := &ScheduledInstanceAction{
+		ID:         fmt.Sprint(scheduler.lastID),
+		InstanceID: instanceID,
+		Action:     action,
+		Schedule:   schedule,
+		NextRun:    nextRun,
+	}
+	scheduler.actions[scheduled.ID] = scheduled
+	copied := *scheduled
+	result = &copied
+	return
+}
+
+// next returns the first run of the schedule after "after", or false if there is none.
+func (schedule *InstanceActionSchedule) next(after time.Time) (next time.Time, found bool) {
+	next = schedule.At
+	if schedule.Every == 0 {
+		return next, next.After(after) && schedule.allows(next)
+	}
+	if !next.After(after) {
+		steps := after.Sub(next)/schedule.Every + 1
+		next = next.Add(steps * schedule.Every)
+	}
+	// Every day of the week is reached within a week of runs, unless the interval is a multiple of a day that never
+	// lands on an allowed weekday.
+	limit := next.Add(7 * 24 * time.Hour)
+	for ; !next.After(limit); next = next.Add(schedule.Every) {
+		if schedule.allows(next) {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (schedule *InstanceActionSchedule) allows(t time.Time) bool {
+	if len(schedule.Weekdays) == 0 {
+		return true
+	}
+	weekday := t.In(schedule.At.Location()).Weekday()
+	for _, allowed := range schedule.Weekdays {
+		if allowed == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// Cancel removes the scheduled action with the specified ID. It returns false if there is no such action.
+func (scheduler *InstanceActionScheduler) Cancel(id string) bool {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	_, found := scheduler.actions[id]
+	delete(scheduler.actions, id)
+	return found
+}
+
+// Actions returns the scheduled actions, sorted by the time of their next run.
+func (scheduler *InstanceActionScheduler) Actions() []ScheduledInstanceAction {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	actions := make([]ScheduledInstanceAction, 0, len(scheduler.actions))
+	for _, action := range scheduler.actions {
+		actions = append(actions, *action)
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].NextRun.Before(actions[j].NextRun)
+	})
+	return actions
+}
+
+// RunDue runs the actions that are due at "now", one at a time in the order in which they became due, and returns the
+// outcome of each run. Actions that run once are removed after running, whether or not they succeed; recurring
+// actions are rescheduled after "now", so runs missed while the process was not running are skipped.
+func (scheduler *InstanceActionScheduler) RunDue(ctx context.Context, now time.Time) (runs []InstanceActionRun) {
+	for _, action := range scheduler.Actions() {
+		if action.NextRun.After(now) || ctx.Err() != nil {
+			break
+		}
+		run := InstanceActionRun{
+			ScheduledActionID: action.ID,
+			InstanceID:        action.InstanceID,
+			Action:            action.Action.Name,
+			DueAt:             action.NextRun,
+		}
+		run.Instance, run.Err = scheduler.runInstanceAction(ctx, action.InstanceID, &action.Action)
+
+		scheduler.mutex.Lock()
+		if scheduled, found := scheduler.actions[action.ID]; found {
+			scheduled.LastRun = now
+			scheduled.LastError = run.Err
+			nextRun, found := scheduled.Schedule.next(now)
+			if found && scheduled.Schedule.Every > 0 {
+				scheduled.NextRun = nextRun
+			} else {
+				delete(scheduler.actions, action.ID)
+			}
+		}
+		scheduler.mutex.Unlock()
+
+		if scheduler.OnRun != nil {
+			scheduler.OnRun(run)
+		}
+		runs = append(runs, run)
+	}
+	return
+}
+
+func (scheduler *InstanceActionScheduler) runInstanceAction(ctx context.Context, instanceID string, action *InstanceAction) (instance *ResourceInstance, err error) {
+	updateResourceInstanceOptions := scheduler.NewUpdateResourceInstanceOptions(instanceID)
+	if len(action.Parameters) > 0 {
+		updateResourceInstanceOptions.SetParameters(action.Parameters)
+	}
+	if action.ResourcePlanID != "" {
+		updateResourceInstanceOptions.ResourcePlanID = core.StringPtr(action.ResourcePlanID)
+	}
+	instance, _, err = scheduler.UpdateResourceInstanceWithContext(ctx, updateResourceInstanceOptions)
+	if err != nil {
+		err = fmt.Errorf("error running action '%s' on resource instance '%s': %w", action.Name, instanceID, err)
+	}
+	return
+}
+
+// Run calls RunDue every "interval" until the context is done, and then returns the context's error.
+func (scheduler *InstanceActionScheduler) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		scheduler.RunDue(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
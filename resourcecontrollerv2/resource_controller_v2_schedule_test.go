@@ -0,0 +1,149 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 InstanceActionScheduler`, func() {
+	var testServer *httptest.Server
+	var scheduler *resourcecontrollerv2.InstanceActionScheduler
+	var updates []map[string]interface{}
+	BeforeEach(func() {
+		updates = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.URL.EscapedPath() == "/v2/resource_instances/missing":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			case req.Method == "GET":
+				fmt.Fprint(res, `{"id": "inst1", "state": "active"}`)
+			case req.Method == "PATCH" && req.URL.EscapedPath() == "/v2/resource_instances/broken":
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"message": "failed"}`)
+			case req.Method == "PATCH":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				updates = append(updates, body)
+				fmt.Fprint(res, `{"id": "inst1", "state": "active"}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		scheduler = resourcecontrollerv2.NewInstanceActionScheduler(resourceControllerService)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	// Monday, 7 PM.
+	monday := time.Date(2022, 5, 2, 19, 0, 0, 0, time.UTC)
+	stop := resourcecontrollerv2.InstanceAction{Name: "stop", Parameters: map[string]interface{}{"state": "stopped"}}
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	It(`Runs recurring actions on the scheduled weekdays`, func() {
+		schedule := resourcecontrollerv2.InstanceActionSchedule{At: monday, Every: 24 * time.Hour, Weekdays: weekdays}
+		scheduled, err := scheduler.ScheduleInstanceAction(context.Background(), "inst1", stop, schedule)
+		Expect(err).To(BeNil())
+		Expect(scheduled.NextRun).To(Equal(monday))
+
+		Expect(scheduler.RunDue(context.Background(), monday.Add(-time.Minute))).To(BeEmpty())
+		runs := scheduler.RunDue(context.Background(), monday)
+		Expect(runs).To(HaveLen(1))
+		Expect(runs[0].Err).To(BeNil())
+		Expect(runs[0].Action).To(Equal("stop"))
+		Expect(*runs[0].Instance.ID).To(Equal("inst1"))
+		Expect(updates).To(Equal([]map[string]interface{}{{"parameters": map[string]interface{}{"state": "stopped"}}}))
+		Expect(scheduler.Actions()[0].NextRun).To(Equal(monday.AddDate(0, 0, 1)))
+
+		// Runs missed until Friday are skipped, and the weekend is skipped.
+		runs = scheduler.RunDue(context.Background(), monday.AddDate(0, 0, 4).Add(time.Minute))
+		Expect(runs).To(HaveLen(1))
+		Expect(runs[0].DueAt).To(Equal(monday.AddDate(0, 0, 1)))
+		Expect(scheduler.Actions()[0].NextRun).To(Equal(monday.AddDate(0, 0, 7)))
+
+		Expect(scheduler.Cancel(scheduled.ID)).To(BeTrue())
+		Expect(scheduler.Cancel(scheduled.ID)).To(BeFalse())
+		Expect(scheduler.Actions()).To(BeEmpty())
+	})
+	It(`Removes one-off actions after they run and records failures`, func() {
+		var reported []resourcecontrollerv2.InstanceActionRun
+		scheduler.OnRun = func(run resourcecontrollerv2.InstanceActionRun) {
+			reported = append(reported, run)
+		}
+		resize := resourcecontrollerv2.InstanceAction{Name: "downgrade", ResourcePlanID: "lite"}
+		_, err := scheduler.ScheduleInstanceAction(context.Background(), "inst1", resize, resourcecontrollerv2.InstanceActionSchedule{At: monday})
+		Expect(err).To(BeNil())
+		_, err = scheduler.ScheduleInstanceAction(context.Background(), "broken", stop,
+			resourcecontrollerv2.InstanceActionSchedule{At: monday.Add(time.Hour), Every: time.Hour})
+		Expect(err).To(BeNil())
+
+		runs := scheduler.RunDue(context.Background(), monday.Add(time.Hour))
+		Expect(runs).To(HaveLen(2))
+		Expect(reported).To(Equal(runs))
+		Expect(runs[0].Err).To(BeNil())
+		Expect(updates[0]).To(Equal(map[string]interface{}{"resource_plan_id": "lite"}))
+		Expect(runs[1].Err).ToNot(BeNil())
+		Expect(runs[1].Instance).To(BeNil())
+
+		actions := scheduler.Actions()
+		Expect(actions).To(HaveLen(1))
+		Expect(actions[0].InstanceID).To(Equal("broken"))
+		Expect(actions[0].LastError).To(Equal(runs[1].Err))
+		Expect(actions[0].NextRun).To(Equal(monday.Add(2 * time.Hour)))
+	})
+	It(`Rejects invalid actions and schedules`, func() {
+		_, err := scheduler.ScheduleInstanceAction(context.Background(), "inst1", resourcecontrollerv2.InstanceAction{Name: "noop"},
+			resourcecontrollerv2.InstanceActionSchedule{At: monday})
+		Expect(err).ToNot(BeNil())
+		_, err = scheduler.ScheduleInstanceAction(context.Background(), "inst1", stop, resourcecontrollerv2.InstanceActionSchedule{})
+		Expect(err).ToNot(BeNil())
+		_, err = scheduler.ScheduleInstanceAction(context.Background(), "inst1", stop,
+			resourcecontrollerv2.InstanceActionSchedule{At: monday, Every: 7 * 24 * time.Hour, Weekdays: []time.Weekday{time.Sunday}})
+		Expect(err).ToNot(BeNil())
+		_, err = scheduler.ScheduleInstanceAction(context.Background(), "missing", stop, resourcecontrollerv2.InstanceActionSchedule{At: monday})
+		Expect(err).ToNot(BeNil())
+		Expect(scheduler.Actions()).To(BeEmpty())
+	})
+	It(`Runs until the context is done`, func() {
+		_, err := scheduler.ScheduleInstanceAction(context.Background(), "inst1", stop, resourcecontrollerv2.InstanceActionSchedule{At: time.Now()})
+		Expect(err).To(BeNil())
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		Expect(scheduler.Run(ctx, 10*time.Millisecond)).To(Equal(context.DeadlineExceeded))
+		Expect(updates).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,45 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	_ "embed"
+
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// responseSchemas describes the responses of the resource instance and resource key operations.
+//
+//go:embed resource_controller_v2_schemas.json
+var responseSchemas []byte
+
+// ResponseSchemas returns the response schemas of the Resource Controller operations. The schemas only describe the
+// fields that the SDK models rely on, so they flag missing or mistyped fields but allow new ones.
+func ResponseSchemas() (*common.SchemaRegistry, error) {
+	return common.NewSchemaRegistry(responseSchemas)
+}
+
+// EnableResponseValidation configures the client to check responses against ResponseSchemas. It is intended for
+// integration tests: responses are returned unchanged and the violations are recorded by the returned validator.
+func (resourceController *ResourceControllerV2) EnableResponseValidation() (validator *common.ResponseValidator, err error) {
+	registry, err := ResponseSchemas()
+	if err != nil {
+		return
+	}
+	validator = common.EnableResponseValidation(resourceController.Service, registry)
+	return
+}
@@ -0,0 +1,58 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 response validation`, func() {
+	It(`Parses the embedded response schemas`, func() {
+		registry, err := resourcecontrollerv2.ResponseSchemas()
+		Expect(err).To(BeNil())
+		Expect(registry.Lookup("GET", "/v2/resource_instances/abc").OperationID).To(Equal("get_resource_instance"))
+		Expect(registry.Lookup("GET", "/v2/resource_instances/abc/resource_keys").OperationID).To(Equal("list_resource_keys_for_instance"))
+	})
+	It(`Records responses that do not match the schemas`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"rows_count": 1, "resources": [{"id": "i1", "guid": "g1", "crn": "crn:1", "name": "db", "state": 1}]}`)
+		}))
+		defer testServer.Close()
+		resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		validator, err := resourceControllerService.EnableResponseValidation()
+		Expect(err).To(BeNil())
+
+		result, _, err := resourceControllerService.ListResourceInstances(resourceControllerService.NewListResourceInstancesOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(result).To(BeNil())
+		violations := validator.Violations()
+		Expect(violations).To(HaveLen(1))
+		Expect(violations[0].Error()).To(Equal("list_resource_instances: resources[0].state: expected string, found integer"))
+	})
+})
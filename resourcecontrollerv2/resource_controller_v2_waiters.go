@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the ResourceInstance.State property.
+const (
+	ResourceInstanceStateActiveConst             = "active"
+	ResourceInstanceStateFailedConst             = "failed"
+	ResourceInstanceStateInactiveConst           = "inactive"
+	ResourceInstanceStatePendingReclamationConst = "pending_reclamation"
+	ResourceInstanceStatePreProvisioningConst    = "pre_provisioning"
+	ResourceInstanceStateProvisioningConst       = "provisioning"
+	ResourceInstanceStateRemovedConst            = "removed"
+)
+
+// PollConfig : Controls how a waiter polls for a state change. The interval between polls starts at InitialInterval and
+// is multiplied by Multiplier after each poll, up to MaxInterval.
+type PollConfig struct {
+	// The interval before the second poll. Defaults to 5 seconds.
+	InitialInterval time.Duration
+
+	// The maximum interval between polls. Defaults to 60 seconds.
+	MaxInterval time.Duration
+
+	// The factor by which the interval grows after each poll. Defaults to 2; values below 1 are treated as 1.
+	Multiplier float64
+
+	// The maximum time to wait. If zero, the waiter waits until the context is done.
+	Timeout time.Duration
+}
+
+// ResourceInstanceOperationError : Describes a resource instance operation (for example a provision or deprovision)
+// that failed while waiting for the instance to reach a state.
+type ResourceInstanceOperationError struct {
+	// The ID of the instance.
+	InstanceID string
+
+	// The state of the instance when the failure was detected.
+	State string
+
+	// The type of the failed operation, from the instance's last operation.
+	Type string
+
+	// The description of the failure, from the instance's last operation.
+	Description string
+
+	// The instance as last retrieved.
+	Instance *ResourceInstance
+}
+
+// Error returns a description of the failed operation.
+func (operationErr *ResourceInstanceOperationError) Error() string {
+	message := fmt.Sprintf("resource instance '%s'", operationErr.InstanceID)
+	if operationErr.Type != "" {
+		message += fmt.Sprintf(" %s operation", operationErr.Type)
+	}
+	message += fmt.Sprintf(" failed (state '%s')", operationErr.State)
+	if operationErr.Description != "" {
+		message += ": " + operationErr.Description
+	}
+	return message
+}
+
+// WaitForResourceInstance polls GetResourceInstance with exponential backoff until the instance reaches "targetState"
+// (typically ResourceInstanceStateActiveConst after a create or ResourceInstanceStateRemovedConst after a delete) and
+// returns the instance in that state. If the instance's last operation fails, or the instance enters the "failed"
+// state, a *ResourceInstanceOperationError is returned. When waiting for the "removed" state, an instance that no longer
+// exists (status code 404) is also considered removed, and a nil result is returned. If the timeout of "pollConfig" or
+// the deadline of the context expires first, the error reports the current state and wraps context.DeadlineExceeded;
+// if the context is cancelled, context.Canceled is returned.
+func (resourceController *ResourceControllerV2) WaitForResourceInstance(ctx context.Context, instanceID string, targetState string, pollConfig PollConfig) (result *ResourceInstance, err error) {
+	getResourceInstanceOptions := resourceController.NewGetResourceInstanceOptions(instanceID)
+	state := ""
+	err = pollWithBackoff(ctx, pollConfig, func(ctx context.Context) (done bool, err error) {
+		var response *core.DetailedResponse
+		result, response, err = resourceController.GetResourceInstanceWithContext(ctx, getResourceInstanceOptions)
+		if err != nil {
+			if targetState == ResourceInstanceStateRemovedConst && response != nil && response.StatusCode == http.StatusNotFound {
+				result = nil
+				return true, nil
+			}
+			return
+		}
+
+		state = core.StringNilMapper(result.State)
+		if state == targetState {
+			return true, nil
+		}
+		return false, resourceInstanceOperationError(instanceID, result)
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("timed out waiting for resource instance '%s' to reach state '%s' (current state '%s'): %w",
+			instanceID, targetState, state, err)
+	}
+	return
+}
+
+// pollWithBackoff calls "check" until it returns true or an error, waiting between calls as specified by "pollConfig".
+// If the context is done (or the timeout of "pollConfig" expires) first, the error of the context is returned.
+func pollWithBackoff(ctx context.Context, pollConfig PollConfig, check func(ctx context.Context) (done bool, err error)) error {
+	if pollConfig.InitialInterval <= 0 {
+		pollConfig.InitialInterval = 5 * time.Second
+	}
+	if pollConfig.MaxInterval <= 0 {
+		pollConfig.MaxInterval = 60 * time.Second
+	}
+	if pollConfig.Multiplier == 0 {
+		pollConfig.Multiplier = 2
+	} else if pollConfig.Multiplier < 1 {
+		pollConfig.Multiplier = 1
+	}
+	if pollConfig.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, pollConfig.Timeout)
+		defer cancel()
+	}
+
+	interval := pollConfig.InitialInterval
+	for {
+		done, err := check(ctx)
+		if err != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if done || err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		interval = time.Duration(float64(interval) * pollConfig.Multiplier)
+		if interval > pollConfig.MaxInterval {
+			interval = pollConfig.MaxInterval
+		}
+	}
+}
+
+// resourceInstanceOperationError returns a *ResourceInstanceOperationError if the instance's last operation failed or
+// the instance is in the "failed" state.
+func resourceInstanceOperationError(instanceID string, instance *ResourceInstance) error {
+	state := core.StringNilMapper(instance.State)
+	operationState, _ := instance.LastOperation["state"].(string)
+	if operationState != ResourceInstanceLastOperationStateFailedConst && state != ResourceInstanceStateFailedConst {
+		return nil
+	}
+
+	operationErr := &ResourceInstanceOperationError{
+		InstanceID: instanceID,
+		State:      state,
+		Instance:   instance,
+	}
+	operationErr.Type, _ = instance.LastOperation["type"].(string)
+	operationErr.Description, _ = instance.LastOperation["description"].(string)
+	return operationErr
+}
@@ -0,0 +1,133 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 WaitForResourceInstance`, func() {
+	var testServer *httptest.Server
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+	var responses []string
+	var requestCount int
+	pollConfig := resourcecontrollerv2.PollConfig{InitialInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+	BeforeEach(func() {
+		requestCount = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.URL.EscapedPath()).To(Equal("/v2/resource_instances/instance1"))
+			response := responses[requestCount]
+			if requestCount < len(responses)-1 {
+				requestCount++
+			}
+			res.Header().Set("Content-type", "application/json")
+			if response == "" {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "Instance not found"}`)
+				return
+			}
+			fmt.Fprint(res, response)
+		}))
+		var serviceErr error
+		resourceControllerService, serviceErr = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Waits until the instance is active`, func() {
+		responses = []string{
+			`{"id": "instance1", "state": "provisioning", "last_operation": {"type": "create", "state": "in progress"}}`,
+			`{"id": "instance1", "state": "provisioning", "last_operation": {"type": "create", "state": "in progress"}}`,
+			`{"id": "instance1", "state": "active", "last_operation": {"type": "create", "state": "succeeded"}}`,
+		}
+		result, err := resourceControllerService.WaitForResourceInstance(context.Background(), "instance1",
+			resourcecontrollerv2.ResourceInstanceStateActiveConst, pollConfig)
+		Expect(err).To(BeNil())
+		Expect(*result.State).To(Equal("active"))
+		Expect(requestCount).To(Equal(2))
+	})
+	It(`Surfaces a failed last operation`, func() {
+		responses = []string{
+			`{"id": "instance1", "state": "inactive", "last_operation": {"type": "create", "state": "failed", "description": "Quota exceeded"}}`,
+		}
+		_, err := resourceControllerService.WaitForResourceInstance(context.Background(), "instance1",
+			resourcecontrollerv2.ResourceInstanceStateActiveConst, pollConfig)
+		Expect(err).ToNot(BeNil())
+		var operationErr *resourcecontrollerv2.ResourceInstanceOperationError
+		Expect(errors.As(err, &operationErr)).To(BeTrue())
+		Expect(operationErr.Type).To(Equal("create"))
+		Expect(operationErr.State).To(Equal("inactive"))
+		Expect(operationErr.Description).To(Equal("Quota exceeded"))
+		Expect(err.Error()).To(Equal("resource instance 'instance1' create operation failed (state 'inactive'): Quota exceeded"))
+	})
+	It(`Treats a missing instance as removed`, func() {
+		responses = []string{
+			`{"id": "instance1", "state": "active", "last_operation": {"type": "delete", "state": "in progress"}}`,
+			``,
+		}
+		result, err := resourceControllerService.WaitForResourceInstance(context.Background(), "instance1",
+			resourcecontrollerv2.ResourceInstanceStateRemovedConst, pollConfig)
+		Expect(err).To(BeNil())
+		Expect(result).To(BeNil())
+
+		requestCount = 1
+		_, err = resourceControllerService.WaitForResourceInstance(context.Background(), "instance1",
+			resourcecontrollerv2.ResourceInstanceStateActiveConst, pollConfig)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Times out`, func() {
+		responses = []string{
+			`{"id": "instance1", "state": "provisioning", "last_operation": {"type": "create", "state": "in progress"}}`,
+		}
+		timeoutConfig := pollConfig
+		timeoutConfig.Timeout = 20 * time.Millisecond
+		_, err := resourceControllerService.WaitForResourceInstance(context.Background(), "instance1",
+			resourcecontrollerv2.ResourceInstanceStateActiveConst, timeoutConfig)
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("current state 'provisioning'"))
+	})
+	It(`Returns the cancellation of the context as is`, func() {
+		responses = []string{
+			`{"id": "instance1", "state": "provisioning", "last_operation": {"type": "create", "state": "in progress"}}`,
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+		_, err := resourceControllerService.WaitForResourceInstance(ctx, "instance1",
+			resourcecontrollerv2.ResourceInstanceStateActiveConst, pollConfig)
+		Expect(err).To(Equal(context.Canceled))
+	})
+})
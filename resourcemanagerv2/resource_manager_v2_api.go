@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package resourcemanagerv2
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// ResourceManagerV2API : The operations of the ResourceManagerV2 service, implemented by *ResourceManagerV2.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type ResourceManagerV2API interface {
+	// Clone makes a copy of "resourceManager" suitable for processing requests.
+	Clone() *ResourceManagerV2
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// ListResourceGroups : Get a list of all resource groups
+	ListResourceGroups(listResourceGroupsOptions *ListResourceGroupsOptions) (result *ResourceGroupList, response *core.DetailedResponse, err error)
+
+	// ListResourceGroupsWithContext is an alternate form of the ListResourceGroups method which supports a Context
+	// parameter
+	ListResourceGroupsWithContext(ctx context.Context, listResourceGroupsOptions *ListResourceGroupsOptions) (result *ResourceGroupList, response *core.DetailedResponse, err error)
+
+	// CreateResourceGroup : Create a resource group
+	CreateResourceGroup(createResourceGroupOptions *CreateResourceGroupOptions) (result *ResCreateResourceGroup, response *core.DetailedResponse, err error)
+
+	// CreateResourceGroupWithContext is an alternate form of the CreateResourceGroup method which supports a Context
+	// parameter
+	CreateResourceGroupWithContext(ctx context.Context, createResourceGroupOptions *CreateResourceGroupOptions) (result *ResCreateResourceGroup, response *core.DetailedResponse, err error)
+
+	// GetResourceGroup : Get a resource group
+	GetResourceGroup(getResourceGroupOptions *GetResourceGroupOptions) (result *ResourceGroup, response *core.DetailedResponse, err error)
+
+	// GetResourceGroupWithContext is an alternate form of the GetResourceGroup method which supports a Context
+	// parameter
+	GetResourceGroupWithContext(ctx context.Context, getResourceGroupOptions *GetResourceGroupOptions) (result *ResourceGroup, response *core.DetailedResponse, err error)
+
+	// UpdateResourceGroup : Update a resource group
+	UpdateResourceGroup(updateResourceGroupOptions *UpdateResourceGroupOptions) (result *ResourceGroup, response *core.DetailedResponse, err error)
+
+	// UpdateResourceGroupWithContext is an alternate form of the UpdateResourceGroup method which supports a Context
+	// parameter
+	UpdateResourceGroupWithContext(ctx context.Context, updateResourceGroupOptions *UpdateResourceGroupOptions) (result *ResourceGroup, response *core.DetailedResponse, err error)
+
+	// DeleteResourceGroup : Delete a resource group
+	DeleteResourceGroup(deleteResourceGroupOptions *DeleteResourceGroupOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteResourceGroupWithContext is an alternate form of the DeleteResourceGroup method which supports a Context
+	// parameter
+	DeleteResourceGroupWithContext(ctx context.Context, deleteResourceGroupOptions *DeleteResourceGroupOptions) (response *core.DetailedResponse, err error)
+
+	// ListQuotaDefinitions : List quota definitions
+	ListQuotaDefinitions(listQuotaDefinitionsOptions *ListQuotaDefinitionsOptions) (result *QuotaDefinitionList, response *core.DetailedResponse, err error)
+
+	// ListQuotaDefinitionsWithContext is an alternate form of the ListQuotaDefinitions method which supports a Context
+	// parameter
+	ListQuotaDefinitionsWithContext(ctx context.Context, listQuotaDefinitionsOptions *ListQuotaDefinitionsOptions) (result *QuotaDefinitionList, response *core.DetailedResponse, err error)
+
+	// GetQuotaDefinition : Get a quota definition
+	GetQuotaDefinition(getQuotaDefinitionOptions *GetQuotaDefinitionOptions) (result *QuotaDefinition, response *core.DetailedResponse, err error)
+
+	// GetQuotaDefinitionWithContext is an alternate form of the GetQuotaDefinition method which supports a Context
+	// parameter
+	GetQuotaDefinitionWithContext(ctx context.Context, getQuotaDefinitionOptions *GetQuotaDefinitionOptions) (result *QuotaDefinition, response *core.DetailedResponse, err error)
+
+	// NewCreateResourceGroupOptions : Instantiate CreateResourceGroupOptions
+	NewCreateResourceGroupOptions() *CreateResourceGroupOptions
+
+	// NewDeleteResourceGroupOptions : Instantiate DeleteResourceGroupOptions
+	NewDeleteResourceGroupOptions(id string) *DeleteResourceGroupOptions
+
+	// NewGetQuotaDefinitionOptions : Instantiate GetQuotaDefinitionOptions
+	NewGetQuotaDefinitionOptions(id string) *GetQuotaDefinitionOptions
+
+	// NewGetResourceGroupOptions : Instantiate GetResourceGroupOptions
+	NewGetResourceGroupOptions(id string) *GetResourceGroupOptions
+
+	// NewListQuotaDefinitionsOptions : Instantiate ListQuotaDefinitionsOptions
+	NewListQuotaDefinitionsOptions() *ListQuotaDefinitionsOptions
+
+	// NewListResourceGroupsOptions : Instantiate ListResourceGroupsOptions
+	NewListResourceGroupsOptions() *ListResourceGroupsOptions
+
+	// NewUpdateResourceGroupOptions : Instantiate UpdateResourceGroupOptions
+	NewUpdateResourceGroupOptions(id string) *UpdateResourceGroupOptions
+
+	// EnsureResourceGroup returns the resource group with the specified name in the account, creating it if there is
+	// none.
+	EnsureResourceGroup(ctx context.Context, name string, accountID string, retries int) (result *ResourceGroup, created bool, err error)
+
+	// NewListResourceGroupsOptionsWith returns ListResourceGroupsOptions populated by applying "options" in order.
+	NewListResourceGroupsOptionsWith(options ...ListResourceGroupsOption) *ListResourceGroupsOptions
+
+	// ListResourceGroupsWith is an alternate form of the ListResourceGroupsWithContext method which takes functional
+	// options instead of a ListResourceGroupsOptions struct.
+	ListResourceGroupsWith(ctx context.Context, options ...ListResourceGroupsOption) (result *ResourceGroupList, response *core.DetailedResponse, err error)
+
+	// NewCreateResourceGroupOptionsWith returns CreateResourceGroupOptions populated by applying "options" in order.
+	NewCreateResourceGroupOptionsWith(options ...CreateResourceGroupOption) *CreateResourceGroupOptions
+
+	// CreateResourceGroupWith is an alternate form of the CreateResourceGroupWithContext method which takes functional
+	// options instead of a CreateResourceGroupOptions struct.
+	CreateResourceGroupWith(ctx context.Context, options ...CreateResourceGroupOption) (result *ResCreateResourceGroup, response *core.DetailedResponse, err error)
+
+	// NewGetResourceGroupOptionsWith returns GetResourceGroupOptions populated by applying "options" in order.
+	NewGetResourceGroupOptionsWith(options ...GetResourceGroupOption) *GetResourceGroupOptions
+
+	// GetResourceGroupWith is an alternate form of the GetResourceGroupWithContext method which takes functional
+	// options instead of a GetResourceGroupOptions struct.
+	GetResourceGroupWith(ctx context.Context, options ...GetResourceGroupOption) (result *ResourceGroup, response *core.DetailedResponse, err error)
+
+	// NewUpdateResourceGroupOptionsWith returns UpdateResourceGroupOptions populated by applying "options" in order.
+	NewUpdateResourceGroupOptionsWith(options ...UpdateResourceGroupOption) *UpdateResourceGroupOptions
+
+	// UpdateResourceGroupWith is an alternate form of the UpdateResourceGroupWithContext method which takes functional
+	// options instead of an UpdateResourceGroupOptions struct.
+	UpdateResourceGroupWith(ctx context.Context, options ...UpdateResourceGroupOption) (result *ResourceGroup, response *core.DetailedResponse, err error)
+
+	// NewDeleteResourceGroupOptionsWith returns DeleteResourceGroupOptions populated by applying "options" in order.
+	NewDeleteResourceGroupOptionsWith(options ...DeleteResourceGroupOption) *DeleteResourceGroupOptions
+
+	// DeleteResourceGroupWith is an alternate form of the DeleteResourceGroupWithContext method which takes functional
+	// options instead of a DeleteResourceGroupOptions struct.
+	DeleteResourceGroupWith(ctx context.Context, options ...DeleteResourceGroupOption) (response *core.DetailedResponse, err error)
+
+	// NewListQuotaDefinitionsOptionsWith returns ListQuotaDefinitionsOptions populated by applying "options" in order.
+	NewListQuotaDefinitionsOptionsWith(options ...ListQuotaDefinitionsOption) *ListQuotaDefinitionsOptions
+
+	// ListQuotaDefinitionsWith is an alternate form of the ListQuotaDefinitionsWithContext method which takes
+	// functional options instead of a ListQuotaDefinitionsOptions struct.
+	ListQuotaDefinitionsWith(ctx context.Context, options ...ListQuotaDefinitionsOption) (result *QuotaDefinitionList, response *core.DetailedResponse, err error)
+
+	// NewGetQuotaDefinitionOptionsWith returns GetQuotaDefinitionOptions populated by applying "options" in order.
+	NewGetQuotaDefinitionOptionsWith(options ...GetQuotaDefinitionOption) *GetQuotaDefinitionOptions
+
+	// GetQuotaDefinitionWith is an alternate form of the GetQuotaDefinitionWithContext method which takes functional
+	// options instead of a GetQuotaDefinitionOptions struct.
+	GetQuotaDefinitionWith(ctx context.Context, options ...GetQuotaDefinitionOption) (result *QuotaDefinition, response *core.DetailedResponse, err error)
+
+	// GetAccountResourceGroupReport returns the resource groups of the account together with their quota definitions.
+	GetAccountResourceGroupReport(ctx context.Context, accountID string) (result *AccountResourceGroupReport, err error)
+}
+
+var _ ResourceManagerV2API = (*ResourceManagerV2)(nil)
@@ -0,0 +1,249 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcemanagerv2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+)
+
+// Constants associated with the ResourceGroupViolation.Rule property.
+const (
+	ResourceGroupViolationRuleNameConst        = "name"
+	ResourceGroupViolationRuleRequiredTagConst = "required_tag"
+)
+
+// ResourceGroupAuditUnassignedTeam is the team of violations whose team cannot be determined.
+const ResourceGroupAuditUnassignedTeam = "unassigned"
+
+// DefaultResourceGroupTeamTag is the tag used to identify the team of a resource when ResourceGroupPolicy.TeamTag is
+// not set.
+const DefaultResourceGroupTeamTag = "team"
+
+// ResourceGroupPolicy : The naming and tagging conventions checked by AuditResourceGroups.
+type ResourceGroupPolicy struct {
+	// If set, the entire name of each resource group must match this expression. If the expression has a
+	// subexpression named "team" (for example "^(?P<team>[a-z]+)-(dev|prod)$"), it identifies the team that owns the
+	// group.
+	NamePattern *regexp.Regexp
+
+	// The tags that every resource in a resource group must have. A tag "key" is satisfied by the tag "key" or by any
+	// tag "key:value".
+	RequiredTags []string
+
+	// The key of the "key:value" tag that identifies the team that owns a resource. Defaults to
+	// DefaultResourceGroupTeamTag. Resources without it belong to the team of their resource group.
+	TeamTag string
+}
+
+// ResourceGroupViolation : Describes a resource group or resource that does not comply with a ResourceGroupPolicy.
+type ResourceGroupViolation struct {
+	// The rule that was violated.
+	Rule string
+
+	// The team that owns the non-compliant group or resource, or ResourceGroupAuditUnassignedTeam.
+	Team string
+
+	// The ID of the resource group.
+	ResourceGroupID string
+
+	// The name of the resource group.
+	ResourceGroupName string
+
+	// The CRN of the non-compliant resource, or "" for a resource group violation.
+	ResourceCRN string
+
+	// The name of the non-compliant resource, or "" for a resource group violation.
+	ResourceName string
+
+	// A human-readable description of the violation.
+	Message string
+}
+
+// ResourceGroupAudit : The result of AuditResourceGroups.
+type ResourceGroupAudit struct {
+	// The violations, keyed by team.
+	ViolationsByTeam map[string][]ResourceGroupViolation
+
+	// The number of resource groups checked.
+	ResourceGroupsChecked int
+
+	// The number of resources checked.
+	ResourcesChecked int
+}
+
+// Teams returns the teams that have violations, sorted by name.
+func (audit *ResourceGroupAudit) Teams() []string {
+	teams := make([]string, 0, len(audit.ViolationsByTeam))
+	for team := range audit.ViolationsByTeam {
+		teams = append(teams, team)
+	}
+	sort.Strings(teams)
+	return teams
+}
+
+// ViolationCount returns the total number of violations.
+func (audit *ResourceGroupAudit) ViolationCount() (count int) {
+	for _, violations := range audit.ViolationsByTeam {
+		count += len(violations)
+	}
+	return
+}
+
+// ResourceGroupAuditor : Audits the resource groups of an account, using the Global Search service to find the
+// resources they contain.
+type ResourceGroupAuditor struct {
+	*ResourceManagerV2
+
+	// The client used to search for the resources in the resource groups.
+	GlobalSearch *globalsearchv2.GlobalSearchV2
+}
+
+// NewResourceGroupAuditor returns a new ResourceGroupAuditor that uses the specified clients.
+func NewResourceGroupAuditor(resourceManager *ResourceManagerV2, globalSearch *globalsearchv2.GlobalSearchV2) *ResourceGroupAuditor {
+	return &ResourceGroupAuditor{
+		ResourceManagerV2: resourceManager,
+		GlobalSearch:      globalSearch,
+	}
+}
+
+// AuditResourceGroups checks the names of the resource groups of the account against policy.NamePattern, and the tags
+// of the resources they contain against policy.RequiredTags, and returns the violations grouped by team. The
+// resources are retrieved with a single search scan; resource tags are only checked if policy.RequiredTags is set.
+func (auditor *ResourceGroupAuditor) AuditResourceGroups(ctx context.Context, accountID string, policy *ResourceGroupPolicy) (result *ResourceGroupAudit, err error) {
+	err = core.ValidateNotNil(policy, "policy cannot be nil")
+	if err != nil {
+		return
+	}
+	teamTag := policy.TeamTag
+	if teamTag == "" {
+		teamTag = DefaultResourceGroupTeamTag
+	}
+
+	listResourceGroupsOptions := auditor.NewListResourceGroupsOptions().SetAccountID(accountID)
+	resourceGroupList, _, err := auditor.ListResourceGroupsWithContext(ctx, listResourceGroupsOptions)
+	if err != nil {
+		err = fmt.Errorf("error listing resource groups: %w", err)
+		return
+	}
+
+	result = &ResourceGroupAudit{ViolationsByTeam: make(map[string][]ResourceGroupViolation)}
+	add := func(violation ResourceGroupViolation) {
+		if violation.Team == "" {
+			violation.Team = ResourceGroupAuditUnassignedTeam
+		}
+		result.ViolationsByTeam[violation.Team] = append(result.ViolationsByTeam[violation.Team], violation)
+	}
+
+	groups := make(map[string]*ResourceGroup)
+	groupTeams := make(map[string]string)
+	for i := range resourceGroupList.Resources {
+		resourceGroup := &resourceGroupList.Resources[i]
+		id := core.StringNilMapper(resourceGroup.ID)
+		name := core.StringNilMapper(resourceGroup.Name)
+		groups[id] = resourceGroup
+		result.ResourceGroupsChecked++
+		if policy.NamePattern == nil {
+			continue
+		}
+		match := policy.NamePattern.FindStringSubmatchIndex(name)
+		if match == nil || match[0] != 0 || match[1] != len(name) {
+			add(ResourceGroupViolation{
+				Rule:              ResourceGroupViolationRuleNameConst,
+				ResourceGroupID:   id,
+				ResourceGroupName: name,
+				Message:           fmt.Sprintf("resource group name must match the pattern '%s'", policy.NamePattern.String()),
+			})
+			continue
+		}
+		if teamIndex := policy.NamePattern.SubexpIndex("team"); teamIndex > 0 && match[2*teamIndex] >= 0 {
+			groupTeams[id] = name[match[2*teamIndex]:match[2*teamIndex+1]]
+		}
+	}
+	if len(policy.RequiredTags) == 0 || auditor.GlobalSearch == nil {
+		return
+	}
+
+	searchOptions := auditor.GlobalSearch.NewSearchOptions().
+		SetQueryFrom(globalsearchv2.Field("resource_group_id").Exists()).
+		SetFields([]string{"crn", "name", "resource_group_id", "tags"}).
+		SetAccountID(accountID)
+	pager, err := auditor.GlobalSearch.NewSearchPager(searchOptions)
+	if err != nil {
+		return
+	}
+	for pager.NextWithContext(ctx) {
+		item := pager.Item()
+		groupID, _ := item.GetProperty("resource_group_id").(string)
+		resourceGroup, found := groups[groupID]
+		if !found {
+			continue
+		}
+		result.ResourcesChecked++
+
+		tags := resourceTags(item)
+		team := groupTeams[groupID]
+		if values := tags[teamTag]; len(values) > 0 && values[0] != "" {
+			team = values[0]
+		}
+		for _, requiredTag := range policy.RequiredTags {
+			if _, found := tags[requiredTag]; found {
+				continue
+			}
+			resourceName, _ := item.GetProperty("name").(string)
+			add(ResourceGroupViolation{
+				Rule:              ResourceGroupViolationRuleRequiredTagConst,
+				Team:              team,
+				ResourceGroupID:   groupID,
+				ResourceGroupName: core.StringNilMapper(resourceGroup.Name),
+				ResourceCRN:       core.StringNilMapper(item.CRN),
+				ResourceName:      resourceName,
+				Message:           fmt.Sprintf("resource is missing the required tag '%s'", requiredTag),
+			})
+		}
+	}
+	if err = pager.Err(); err != nil {
+		err = fmt.Errorf("error searching for resources: %w", err)
+		result = nil
+	}
+	return
+}
+
+// resourceTags returns the values of the tags of a search result, keyed by tag key. A tag without a value maps to an
+// empty value.
+func resourceTags(item *globalsearchv2.ResultItem) map[string][]string {
+	tags := make(map[string][]string)
+	values, _ := item.GetProperty("tags").([]interface{})
+	for _, value := range values {
+		tag, ok := value.(string)
+		if !ok {
+			continue
+		}
+		key, tagValue := tag, ""
+		if i := strings.Index(tag, ":"); i >= 0 {
+			key, tagValue = tag[:i], tag[i+1:]
+		}
+		tags[key] = append(tags[key], tagValue)
+	}
+	return tags
+}
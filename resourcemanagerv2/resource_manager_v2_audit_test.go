@@ -0,0 +1,141 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcemanagerv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceManagerV2 AuditResourceGroups`, func() {
+	var testServer *httptest.Server
+	var auditor *resourcemanagerv2.ResourceGroupAuditor
+	var searchStatus int
+	BeforeEach(func() {
+		searchStatus = 200
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v2/resource_groups":
+				Expect(req.URL.Query().Get("account_id")).To(Equal("acct"))
+				fmt.Fprint(res, `{"resources": [{"id": "rg1", "name": "payments-prod"}, {"id": "rg2", "name": "Scratch"},
+					{"id": "rg3", "name": "search-dev"}]}`)
+			case req.Method == "POST" && req.URL.EscapedPath() == "/v3/resources/search":
+				Expect(req.URL.Query().Get("account_id")).To(Equal("acct"))
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body["query"]).To(Equal("resource_group_id:*"))
+				res.WriteHeader(searchStatus)
+				if searchStatus != 200 {
+					fmt.Fprint(res, `{"message": "unavailable"}`)
+					return
+				}
+				if body["search_cursor"] == nil {
+					fmt.Fprint(res, `{"search_cursor": "c1", "items": [
+						{"crn": "crn:1", "name": "db", "resource_group_id": "rg1", "tags": ["env:prod", "owner:ann"]},
+						{"crn": "crn:2", "name": "cache", "resource_group_id": "rg1", "tags": ["team:platform"]},
+						{"crn": "crn:3", "name": "tmp", "resource_group_id": "rg2", "tags": ["env:dev"]}]}`)
+				} else if body["search_cursor"] == "c1" {
+					fmt.Fprint(res, `{"search_cursor": "c2", "items": [
+						{"crn": "crn:4", "name": "other", "resource_group_id": "rg-unknown", "tags": []}]}`)
+				} else {
+					fmt.Fprint(res, `{"search_cursor": "c3", "items": []}`)
+				}
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		resourceManagerService, serviceErr := resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		globalSearchService, serviceErr := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		auditor = resourcemanagerv2.NewResourceGroupAuditor(resourceManagerService, globalSearchService)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	policy := &resourcemanagerv2.ResourceGroupPolicy{
+		NamePattern:  regexp.MustCompile(`(?P<team>[a-z]+)-(dev|prod)`),
+		RequiredTags: []string{"env", "owner"},
+	}
+
+	It(`Groups name and tag violations by team`, func() {
+		audit, err := auditor.AuditResourceGroups(context.Background(), "acct", policy)
+		Expect(err).To(BeNil())
+		Expect(audit.ResourceGroupsChecked).To(Equal(3))
+		Expect(audit.ResourcesChecked).To(Equal(3))
+		Expect(audit.ViolationCount()).To(Equal(4))
+		Expect(audit.Teams()).To(Equal([]string{"platform", resourcemanagerv2.ResourceGroupAuditUnassignedTeam}))
+
+		unassigned := audit.ViolationsByTeam[resourcemanagerv2.ResourceGroupAuditUnassignedTeam]
+		Expect(unassigned).To(HaveLen(2))
+		Expect(unassigned[0].Rule).To(Equal(resourcemanagerv2.ResourceGroupViolationRuleNameConst))
+		Expect(unassigned[0].ResourceGroupName).To(Equal("Scratch"))
+		Expect(unassigned[1].Rule).To(Equal(resourcemanagerv2.ResourceGroupViolationRuleRequiredTagConst))
+		Expect(unassigned[1].ResourceCRN).To(Equal("crn:3"))
+		Expect(unassigned[1].Message).To(ContainSubstring("'owner'"))
+
+		platform := audit.ViolationsByTeam["platform"]
+		Expect(platform).To(HaveLen(2))
+		Expect(platform[0].ResourceName).To(Equal("cache"))
+		Expect(platform[0].ResourceGroupName).To(Equal("payments-prod"))
+	})
+	It(`Uses the team from the resource group name`, func() {
+		teamPolicy := *policy
+		teamPolicy.TeamTag = "owner"
+		audit, err := auditor.AuditResourceGroups(context.Background(), "acct", &teamPolicy)
+		Expect(err).To(BeNil())
+		Expect(audit.Teams()).To(Equal([]string{"payments", resourcemanagerv2.ResourceGroupAuditUnassignedTeam}))
+		Expect(audit.ViolationsByTeam["payments"][0].ResourceCRN).To(Equal("crn:2"))
+	})
+	It(`Only checks names when no tags are required`, func() {
+		audit, err := auditor.AuditResourceGroups(context.Background(), "acct",
+			&resourcemanagerv2.ResourceGroupPolicy{NamePattern: policy.NamePattern})
+		Expect(err).To(BeNil())
+		Expect(audit.ResourcesChecked).To(BeZero())
+		Expect(audit.ViolationCount()).To(Equal(1))
+	})
+	It(`Returns search errors`, func() {
+		searchStatus = 503
+		audit, err := auditor.AuditResourceGroups(context.Background(), "acct", policy)
+		Expect(err).ToNot(BeNil())
+		Expect(audit).To(BeNil())
+	})
+	It(`Rejects a nil policy`, func() {
+		_, err := auditor.AuditResourceGroups(context.Background(), "acct", nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
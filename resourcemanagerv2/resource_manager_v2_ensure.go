@@ -0,0 +1,80 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcemanagerv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// DefaultEnsureResourceGroupRetries is the number of retries that callers of EnsureResourceGroup can pass for the
+// default behavior.
+const DefaultEnsureResourceGroupRetries = 2
+
+// EnsureResourceGroup returns the resource group with the specified name in the account, creating it if there is
+// none. "created" is true if the group was created by this call. Because the group is looked up by name first, the
+// call can safely be repeated, for example by a provisioning pipeline that is restarted after a crash.
+// When the creation fails with a conflict or a server error, either of which may mean that the group was created
+// concurrently (or by an attempt whose response was lost), the group is looked up again and its creation retried up
+// to "retries" times; pass 0 to return such failures immediately.
+func (resourceManager *ResourceManagerV2) EnsureResourceGroup(ctx context.Context, name string, accountID string, retries int) (result *ResourceGroup, created bool, err error) {
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, resourceManager.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	for attempt := 0; ; attempt++ {
+		result, err = resourceManager.findResourceGroup(ctx, name, accountID)
+		if err != nil || result != nil {
+			return
+		}
+
+		createResourceGroupOptions := resourceManager.NewCreateResourceGroupOptions().SetName(name).SetAccountID(accountID)
+		var createResult *ResCreateResourceGroup
+		var response *core.DetailedResponse
+		createResult, response, err = resourceManager.CreateResourceGroupWithContext(ctx, createResourceGroupOptions)
+		if err == nil {
+			result, _, err = resourceManager.GetResourceGroupWithContext(ctx, resourceManager.NewGetResourceGroupOptions(*createResult.ID))
+			created = err == nil
+			return
+		}
+		retryable := response != nil && (response.StatusCode == http.StatusConflict || response.StatusCode >= 500)
+		if !retryable || attempt >= retries {
+			err = fmt.Errorf("error creating resource group '%s': %w", name, err)
+			return
+		}
+	}
+}
+
+// findResourceGroup returns the resource group with the specified name in the account, or nil if there is none.
+func (resourceManager *ResourceManagerV2) findResourceGroup(ctx context.Context, name string, accountID string) (result *ResourceGroup, err error) {
+	listResourceGroupsOptions := resourceManager.NewListResourceGroupsOptions().SetAccountID(accountID).SetName(name)
+	resourceGroupList, _, err := resourceManager.ListResourceGroupsWithContext(ctx, listResourceGroupsOptions)
+	if err != nil {
+		err = fmt.Errorf("error looking up resource group '%s': %w", name, err)
+		return
+	}
+	for i := range resourceGroupList.Resources {
+		if core.StringNilMapper(resourceGroupList.Resources[i].Name) == name {
+			result = &resourceGroupList.Resources[i]
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,109 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcemanagerv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceManagerV2 EnsureResourceGroup`, func() {
+	var testServer *httptest.Server
+	var resourceManagerService *resourcemanagerv2.ResourceManagerV2
+	var existing bool
+	var createStatus int
+	var creates int
+	BeforeEach(func() {
+		existing = false
+		createStatus = 201
+		creates = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v2/resource_groups":
+				Expect(req.URL.Query().Get("account_id")).To(Equal("acct"))
+				Expect(req.URL.Query().Get("name")).To(Equal("dev"))
+				if existing {
+					fmt.Fprint(res, `{"resources": [{"id": "rg1", "name": "dev", "account_id": "acct"}]}`)
+				} else {
+					fmt.Fprint(res, `{"resources": []}`)
+				}
+			case req.Method == "POST" && req.URL.EscapedPath() == "/v2/resource_groups":
+				creates++
+				res.WriteHeader(createStatus)
+				if createStatus == 201 {
+					fmt.Fprint(res, `{"id": "rg2", "crn": "crn:rg2"}`)
+				} else {
+					// Simulate a group created concurrently by another pipeline.
+					existing = true
+					fmt.Fprint(res, `{"message": "conflict"}`)
+				}
+			case req.Method == "GET" && req.URL.EscapedPath() == "/v2/resource_groups/rg2":
+				fmt.Fprint(res, `{"id": "rg2", "name": "dev", "account_id": "acct"}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		resourceManagerService, serviceErr = resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Returns an existing group`, func() {
+		existing = true
+		resourceGroup, created, err := resourceManagerService.EnsureResourceGroup(context.Background(), "dev", "acct", resourcemanagerv2.DefaultEnsureResourceGroupRetries)
+		Expect(err).To(BeNil())
+		Expect(created).To(BeFalse())
+		Expect(*resourceGroup.ID).To(Equal("rg1"))
+		Expect(creates).To(Equal(0))
+	})
+	It(`Creates a missing group`, func() {
+		resourceGroup, created, err := resourceManagerService.EnsureResourceGroup(context.Background(), "dev", "acct", resourcemanagerv2.DefaultEnsureResourceGroupRetries)
+		Expect(err).To(BeNil())
+		Expect(created).To(BeTrue())
+		Expect(*resourceGroup.ID).To(Equal("rg2"))
+	})
+	It(`Returns the group created concurrently after a conflict`, func() {
+		createStatus = 409
+		resourceGroup, created, err := resourceManagerService.EnsureResourceGroup(context.Background(), "dev", "acct", resourcemanagerv2.DefaultEnsureResourceGroupRetries)
+		Expect(err).To(BeNil())
+		Expect(created).To(BeFalse())
+		Expect(*resourceGroup.ID).To(Equal("rg1"))
+		Expect(creates).To(Equal(1))
+	})
+	It(`Does not retry when retries are disabled`, func() {
+		createStatus = 409
+		_, _, err := resourceManagerService.EnsureResourceGroup(context.Background(), "dev", "acct", 0)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(HavePrefix("error creating resource group 'dev'"))
+	})
+})
@@ -0,0 +1,326 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package resourcemanagerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ListResourceGroupsOption : A functional option that sets a property of ListResourceGroupsOptions (see ListResourceGroupsWith).
+type ListResourceGroupsOption func(options *ListResourceGroupsOptions)
+
+// ListResourceGroupsAccountID sets the AccountID property of ListResourceGroupsOptions. The ID of the account that
+// contains the resource groups that you want to get.
+func ListResourceGroupsAccountID(accountID string) ListResourceGroupsOption {
+	return func(options *ListResourceGroupsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListResourceGroupsDate sets the Date property of ListResourceGroupsOptions. The date in the format of YYYY-MM
+// which returns resource groups.
+func ListResourceGroupsDate(date string) ListResourceGroupsOption {
+	return func(options *ListResourceGroupsOptions) {
+		options.Date = core.StringPtr(date)
+	}
+}
+
+// ListResourceGroupsName sets the Name property of ListResourceGroupsOptions. The name of the resource group.
+func ListResourceGroupsName(nameVar string) ListResourceGroupsOption {
+	return func(options *ListResourceGroupsOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// ListResourceGroupsDefault sets the Default property of ListResourceGroupsOptions. Boolean value to specify
+// whether or not to list default resource groups.
+func ListResourceGroupsDefault(defaultVar bool) ListResourceGroupsOption {
+	return func(options *ListResourceGroupsOptions) {
+		options.Default = core.BoolPtr(defaultVar)
+	}
+}
+
+// ListResourceGroupsIncludeDeleted sets the IncludeDeleted property of ListResourceGroupsOptions. Boolean value to
+// specify whether or not to list default resource groups.
+func ListResourceGroupsIncludeDeleted(includeDeleted bool) ListResourceGroupsOption {
+	return func(options *ListResourceGroupsOptions) {
+		options.IncludeDeleted = core.BoolPtr(includeDeleted)
+	}
+}
+
+// ListResourceGroupsHeader sets the Headers property of ListResourceGroupsOptions. Allows users to set headers on
+// API requests.
+func ListResourceGroupsHeader(name string, value string) ListResourceGroupsOption {
+	return func(options *ListResourceGroupsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListResourceGroupsOptionsWith returns ListResourceGroupsOptions populated by applying "options" in order.
+func (*ResourceManagerV2) NewListResourceGroupsOptionsWith(options ...ListResourceGroupsOption) *ListResourceGroupsOptions {
+	listResourceGroupsOptions := &ListResourceGroupsOptions{}
+	for _, option := range options {
+		option(listResourceGroupsOptions)
+	}
+	return listResourceGroupsOptions
+}
+
+// ListResourceGroupsWith is an alternate form of the ListResourceGroupsWithContext method which takes functional
+// options instead of a ListResourceGroupsOptions struct.
+func (resourceManager *ResourceManagerV2) ListResourceGroupsWith(ctx context.Context, options ...ListResourceGroupsOption) (result *ResourceGroupList, response *core.DetailedResponse, err error) {
+	return resourceManager.ListResourceGroupsWithContext(ctx, resourceManager.NewListResourceGroupsOptionsWith(options...))
+}
+
+// CreateResourceGroupOption : A functional option that sets a property of CreateResourceGroupOptions (see CreateResourceGroupWith).
+type CreateResourceGroupOption func(options *CreateResourceGroupOptions)
+
+// CreateResourceGroupName sets the Name property of CreateResourceGroupOptions. The new name of the resource
+// group.
+func CreateResourceGroupName(nameVar string) CreateResourceGroupOption {
+	return func(options *CreateResourceGroupOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// CreateResourceGroupAccountID sets the AccountID property of CreateResourceGroupOptions. The account id of the
+// resource group.
+func CreateResourceGroupAccountID(accountID string) CreateResourceGroupOption {
+	return func(options *CreateResourceGroupOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// CreateResourceGroupHeader sets the Headers property of CreateResourceGroupOptions. Allows users to set headers
+// on API requests.
+func CreateResourceGroupHeader(name string, value string) CreateResourceGroupOption {
+	return func(options *CreateResourceGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewCreateResourceGroupOptionsWith returns CreateResourceGroupOptions populated by applying "options" in order.
+func (*ResourceManagerV2) NewCreateResourceGroupOptionsWith(options ...CreateResourceGroupOption) *CreateResourceGroupOptions {
+	createResourceGroupOptions := &CreateResourceGroupOptions{}
+	for _, option := range options {
+		option(createResourceGroupOptions)
+	}
+	return createResourceGroupOptions
+}
+
+// CreateResourceGroupWith is an alternate form of the CreateResourceGroupWithContext method which takes functional
+// options instead of a CreateResourceGroupOptions struct.
+func (resourceManager *ResourceManagerV2) CreateResourceGroupWith(ctx context.Context, options ...CreateResourceGroupOption) (result *ResCreateResourceGroup, response *core.DetailedResponse, err error) {
+	return resourceManager.CreateResourceGroupWithContext(ctx, resourceManager.NewCreateResourceGroupOptionsWith(options...))
+}
+
+// GetResourceGroupOption : A functional option that sets a property of GetResourceGroupOptions (see GetResourceGroupWith).
+type GetResourceGroupOption func(options *GetResourceGroupOptions)
+
+// GetResourceGroupID sets the ID property of GetResourceGroupOptions. The short or long ID of the alias.
+func GetResourceGroupID(id string) GetResourceGroupOption {
+	return func(options *GetResourceGroupOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetResourceGroupHeader sets the Headers property of GetResourceGroupOptions. Allows users to set headers on API
+// requests.
+func GetResourceGroupHeader(name string, value string) GetResourceGroupOption {
+	return func(options *GetResourceGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetResourceGroupOptionsWith returns GetResourceGroupOptions populated by applying "options" in order.
+func (*ResourceManagerV2) NewGetResourceGroupOptionsWith(options ...GetResourceGroupOption) *GetResourceGroupOptions {
+	getResourceGroupOptions := &GetResourceGroupOptions{}
+	for _, option := range options {
+		option(getResourceGroupOptions)
+	}
+	return getResourceGroupOptions
+}
+
+// GetResourceGroupWith is an alternate form of the GetResourceGroupWithContext method which takes functional
+// options instead of a GetResourceGroupOptions struct. GetResourceGroupID is required.
+func (resourceManager *ResourceManagerV2) GetResourceGroupWith(ctx context.Context, options ...GetResourceGroupOption) (result *ResourceGroup, response *core.DetailedResponse, err error) {
+	return resourceManager.GetResourceGroupWithContext(ctx, resourceManager.NewGetResourceGroupOptionsWith(options...))
+}
+
+// UpdateResourceGroupOption : A functional option that sets a property of UpdateResourceGroupOptions (see UpdateResourceGroupWith).
+type UpdateResourceGroupOption func(options *UpdateResourceGroupOptions)
+
+// UpdateResourceGroupID sets the ID property of UpdateResourceGroupOptions. The short or long ID of the alias.
+func UpdateResourceGroupID(id string) UpdateResourceGroupOption {
+	return func(options *UpdateResourceGroupOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// UpdateResourceGroupName sets the Name property of UpdateResourceGroupOptions. The new name of the resource
+// group.
+func UpdateResourceGroupName(nameVar string) UpdateResourceGroupOption {
+	return func(options *UpdateResourceGroupOptions) {
+		options.Name = core.StringPtr(nameVar)
+	}
+}
+
+// UpdateResourceGroupState sets the State property of UpdateResourceGroupOptions. The state of the resource group.
+func UpdateResourceGroupState(state string) UpdateResourceGroupOption {
+	return func(options *UpdateResourceGroupOptions) {
+		options.State = core.StringPtr(state)
+	}
+}
+
+// UpdateResourceGroupHeader sets the Headers property of UpdateResourceGroupOptions. Allows users to set headers
+// on API requests.
+func UpdateResourceGroupHeader(name string, value string) UpdateResourceGroupOption {
+	return func(options *UpdateResourceGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateResourceGroupOptionsWith returns UpdateResourceGroupOptions populated by applying "options" in order.
+func (*ResourceManagerV2) NewUpdateResourceGroupOptionsWith(options ...UpdateResourceGroupOption) *UpdateResourceGroupOptions {
+	updateResourceGroupOptions := &UpdateResourceGroupOptions{}
+	for _, option := range options {
+		option(updateResourceGroupOptions)
+	}
+	return updateResourceGroupOptions
+}
+
+// UpdateResourceGroupWith is an alternate form of the UpdateResourceGroupWithContext method which takes functional
+// options instead of an UpdateResourceGroupOptions struct. UpdateResourceGroupID is required.
+func (resourceManager *ResourceManagerV2) UpdateResourceGroupWith(ctx context.Context, options ...UpdateResourceGroupOption) (result *ResourceGroup, response *core.DetailedResponse, err error) {
+	return resourceManager.UpdateResourceGroupWithContext(ctx, resourceManager.NewUpdateResourceGroupOptionsWith(options...))
+}
+
+// DeleteResourceGroupOption : A functional option that sets a property of DeleteResourceGroupOptions (see DeleteResourceGroupWith).
+type DeleteResourceGroupOption func(options *DeleteResourceGroupOptions)
+
+// DeleteResourceGroupID sets the ID property of DeleteResourceGroupOptions. The short or long ID of the alias.
+func DeleteResourceGroupID(id string) DeleteResourceGroupOption {
+	return func(options *DeleteResourceGroupOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// DeleteResourceGroupHeader sets the Headers property of DeleteResourceGroupOptions. Allows users to set headers
+// on API requests.
+func DeleteResourceGroupHeader(name string, value string) DeleteResourceGroupOption {
+	return func(options *DeleteResourceGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewDeleteResourceGroupOptionsWith returns DeleteResourceGroupOptions populated by applying "options" in order.
+func (*ResourceManagerV2) NewDeleteResourceGroupOptionsWith(options ...DeleteResourceGroupOption) *DeleteResourceGroupOptions {
+	deleteResourceGroupOptions := &DeleteResourceGroupOptions{}
+	for _, option := range options {
+		option(deleteResourceGroupOptions)
+	}
+	return deleteResourceGroupOptions
+}
+
+// DeleteResourceGroupWith is an alternate form of the DeleteResourceGroupWithContext method which takes functional
+// options instead of a DeleteResourceGroupOptions struct. DeleteResourceGroupID is required.
+func (resourceManager *ResourceManagerV2) DeleteResourceGroupWith(ctx context.Context, options ...DeleteResourceGroupOption) (response *core.DetailedResponse, err error) {
+	return resourceManager.DeleteResourceGroupWithContext(ctx, resourceManager.NewDeleteResourceGroupOptionsWith(options...))
+}
+
+// ListQuotaDefinitionsOption : A functional option that sets a property of ListQuotaDefinitionsOptions (see ListQuotaDefinitionsWith).
+type ListQuotaDefinitionsOption func(options *ListQuotaDefinitionsOptions)
+
+// ListQuotaDefinitionsHeader sets the Headers property of ListQuotaDefinitionsOptions. Allows users to set headers
+// on API requests.
+func ListQuotaDefinitionsHeader(name string, value string) ListQuotaDefinitionsOption {
+	return func(options *ListQuotaDefinitionsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListQuotaDefinitionsOptionsWith returns ListQuotaDefinitionsOptions populated by applying "options" in order.
+func (*ResourceManagerV2) NewListQuotaDefinitionsOptionsWith(options ...ListQuotaDefinitionsOption) *ListQuotaDefinitionsOptions {
+	listQuotaDefinitionsOptions := &ListQuotaDefinitionsOptions{}
+	for _, option := range options {
+		option(listQuotaDefinitionsOptions)
+	}
+	return listQuotaDefinitionsOptions
+}
+
+// ListQuotaDefinitionsWith is an alternate form of the ListQuotaDefinitionsWithContext method which takes
+// functional options instead of a ListQuotaDefinitionsOptions struct.
+func (resourceManager *ResourceManagerV2) ListQuotaDefinitionsWith(ctx context.Context, options ...ListQuotaDefinitionsOption) (result *QuotaDefinitionList, response *core.DetailedResponse, err error) {
+	return resourceManager.ListQuotaDefinitionsWithContext(ctx, resourceManager.NewListQuotaDefinitionsOptionsWith(options...))
+}
+
+// GetQuotaDefinitionOption : A functional option that sets a property of GetQuotaDefinitionOptions (see GetQuotaDefinitionWith).
+type GetQuotaDefinitionOption func(options *GetQuotaDefinitionOptions)
+
+// GetQuotaDefinitionID sets the ID property of GetQuotaDefinitionOptions. The id of the quota.
+func GetQuotaDefinitionID(id string) GetQuotaDefinitionOption {
+	return func(options *GetQuotaDefinitionOptions) {
+		options.ID = core.StringPtr(id)
+	}
+}
+
+// GetQuotaDefinitionHeader sets the Headers property of GetQuotaDefinitionOptions. Allows users to set headers on
+// API requests.
+func GetQuotaDefinitionHeader(name string, value string) GetQuotaDefinitionOption {
+	return func(options *GetQuotaDefinitionOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetQuotaDefinitionOptionsWith returns GetQuotaDefinitionOptions populated by applying "options" in order.
+func (*ResourceManagerV2) NewGetQuotaDefinitionOptionsWith(options ...GetQuotaDefinitionOption) *GetQuotaDefinitionOptions {
+	getQuotaDefinitionOptions := &GetQuotaDefinitionOptions{}
+	for _, option := range options {
+		option(getQuotaDefinitionOptions)
+	}
+	return getQuotaDefinitionOptions
+}
+
+// GetQuotaDefinitionWith is an alternate form of the GetQuotaDefinitionWithContext method which takes functional
+// options instead of a GetQuotaDefinitionOptions struct. GetQuotaDefinitionID is required.
+func (resourceManager *ResourceManagerV2) GetQuotaDefinitionWith(ctx context.Context, options ...GetQuotaDefinitionOption) (result *QuotaDefinition, response *core.DetailedResponse, err error) {
+	return resourceManager.GetQuotaDefinitionWithContext(ctx, resourceManager.NewGetQuotaDefinitionOptionsWith(options...))
+}
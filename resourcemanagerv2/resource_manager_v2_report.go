@@ -0,0 +1,163 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcemanagerv2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AccountResourceGroupReport : A snapshot of the resource groups of an account and the quota definitions that apply
+// to them.
+type AccountResourceGroupReport struct {
+	// The ID of the account.
+	AccountID string
+
+	// The resource groups of the account with their quota definitions, in the order returned by the service.
+	ResourceGroups []ResourceGroupWithQuota
+
+	// The quota definitions referenced by the resource groups, keyed by ID.
+	QuotaDefinitions map[string]*QuotaDefinition
+}
+
+// ResourceGroupWithQuota : A resource group and its quota definition.
+type ResourceGroupWithQuota struct {
+	ResourceGroup *ResourceGroup
+
+	// The quota definition of the resource group, or nil if the group has no quota ID.
+	QuotaDefinition *QuotaDefinition
+}
+
+// DefaultResourceGroup returns the default resource group of the account, or nil if the report does not contain it.
+func (report *AccountResourceGroupReport) DefaultResourceGroup() *ResourceGroupWithQuota {
+	for i := range report.ResourceGroups {
+		if defaultGroup := report.ResourceGroups[i].ResourceGroup.Default; defaultGroup != nil && *defaultGroup {
+			return &report.ResourceGroups[i]
+		}
+	}
+	return nil
+}
+
+// GetAccountResourceGroupReport returns the resource groups of the account together with their quota definitions.
+// The resource groups and the quota definitions are listed concurrently, and any quota definition referenced by a
+// group but missing from the list is then retrieved individually (also concurrently), so the report takes two rounds
+// of requests rather than one request per group.
+func (resourceManager *ResourceManagerV2) GetAccountResourceGroupReport(ctx context.Context, accountID string) (result *AccountResourceGroupReport, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var resourceGroupList *ResourceGroupList
+	var quotaDefinitionList *QuotaDefinitionList
+	var groupsErr, quotasErr error
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(2)
+	go func() {
+		defer waitGroup.Done()
+		listResourceGroupsOptions := resourceManager.NewListResourceGroupsOptions().SetAccountID(accountID)
+		resourceGroupList, _, groupsErr = resourceManager.ListResourceGroupsWithContext(ctx, listResourceGroupsOptions)
+		if groupsErr != nil {
+			cancel()
+		}
+	}()
+	go func() {
+		defer waitGroup.Done()
+		quotaDefinitionList, _, quotasErr = resourceManager.ListQuotaDefinitionsWithContext(ctx, resourceManager.NewListQuotaDefinitionsOptions())
+		if quotasErr != nil {
+			cancel()
+		}
+	}()
+	waitGroup.Wait()
+	if groupsErr != nil {
+		err = fmt.Errorf("error listing resource groups: %w", groupsErr)
+		return
+	}
+	if quotasErr != nil {
+		err = fmt.Errorf("error listing quota definitions: %w", quotasErr)
+		return
+	}
+
+	quotaDefinitions := make(map[string]*QuotaDefinition)
+	for i := range quotaDefinitionList.Resources {
+		quotaDefinition := &quotaDefinitionList.Resources[i]
+		if quotaDefinition.ID != nil {
+			quotaDefinitions[*quotaDefinition.ID] = quotaDefinition
+		}
+	}
+
+	var missingIDs []string
+	referenced := make(map[string]*QuotaDefinition)
+	for _, resourceGroup := range resourceGroupList.Resources {
+		quotaID := core.StringNilMapper(resourceGroup.QuotaID)
+		if _, found := referenced[quotaID]; quotaID == "" || found {
+			continue
+		}
+		referenced[quotaID] = quotaDefinitions[quotaID]
+		if referenced[quotaID] == nil {
+			missingIDs = append(missingIDs, quotaID)
+		}
+	}
+
+	err = resourceManager.getQuotaDefinitions(ctx, missingIDs, referenced)
+	if err != nil {
+		return
+	}
+
+	result = &AccountResourceGroupReport{
+		AccountID:        accountID,
+		ResourceGroups:   make([]ResourceGroupWithQuota, len(resourceGroupList.Resources)),
+		QuotaDefinitions: referenced,
+	}
+	for i := range resourceGroupList.Resources {
+		resourceGroup := &resourceGroupList.Resources[i]
+		result.ResourceGroups[i] = ResourceGroupWithQuota{
+			ResourceGroup:   resourceGroup,
+			QuotaDefinition: referenced[core.StringNilMapper(resourceGroup.QuotaID)],
+		}
+	}
+	return
+}
+
+// getQuotaDefinitions retrieves the specified quota definitions concurrently and stores them in "quotaDefinitions".
+func (resourceManager *ResourceManagerV2) getQuotaDefinitions(ctx context.Context, ids []string, quotaDefinitions map[string]*QuotaDefinition) (err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mutex sync.Mutex
+	var waitGroup sync.WaitGroup
+	for _, id := range ids {
+		waitGroup.Add(1)
+		go func(id string) {
+			defer waitGroup.Done()
+			quotaDefinition, _, getErr := resourceManager.GetQuotaDefinitionWithContext(ctx, resourceManager.NewGetQuotaDefinitionOptions(id))
+			mutex.Lock()
+			defer mutex.Unlock()
+			if getErr != nil {
+				if err == nil {
+					err = fmt.Errorf("error getting quota definition '%s': %w", id, getErr)
+					cancel()
+				}
+				return
+			}
+			quotaDefinitions[id] = quotaDefinition
+		}(id)
+	}
+	waitGroup.Wait()
+	return
+}
@@ -0,0 +1,98 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcemanagerv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceManagerV2 GetAccountResourceGroupReport`, func() {
+	var testServer *httptest.Server
+	var resourceManagerService *resourcemanagerv2.ResourceManagerV2
+	var mutex sync.Mutex
+	var requests []string
+	var failQuotas bool
+	BeforeEach(func() {
+		requests = nil
+		failQuotas = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			mutex.Lock()
+			requests = append(requests, req.URL.EscapedPath())
+			mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/v2/resource_groups":
+				Expect(req.URL.Query().Get("account_id")).To(Equal("acct"))
+				fmt.Fprint(res, `{"resources": [
+					{"id": "rg1", "name": "Default", "default": true, "quota_id": "q1"},
+					{"id": "rg2", "name": "dev", "default": false, "quota_id": "q2"},
+					{"id": "rg3", "name": "test", "default": false, "quota_id": "q1"}]}`)
+			case "/v2/quota_definitions":
+				if failQuotas {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"message": "unavailable"}`)
+					return
+				}
+				fmt.Fprint(res, `{"resources": [{"id": "q1", "name": "Trial Quota"}, {"id": "q9", "name": "Unused"}]}`)
+			case "/v2/quota_definitions/q2":
+				fmt.Fprint(res, `{"id": "q2", "name": "Hidden Quota"}`)
+			default:
+				Fail("unexpected request " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		resourceManagerService, serviceErr = resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Joins resource groups with their quota definitions`, func() {
+		report, err := resourceManagerService.GetAccountResourceGroupReport(context.Background(), "acct")
+		Expect(err).To(BeNil())
+		Expect(report.AccountID).To(Equal("acct"))
+		Expect(report.ResourceGroups).To(HaveLen(3))
+		Expect(*report.ResourceGroups[0].QuotaDefinition.Name).To(Equal("Trial Quota"))
+		Expect(*report.ResourceGroups[1].QuotaDefinition.Name).To(Equal("Hidden Quota"))
+		Expect(report.ResourceGroups[2].QuotaDefinition).To(BeIdenticalTo(report.ResourceGroups[0].QuotaDefinition))
+		Expect(report.QuotaDefinitions).To(HaveLen(2))
+		Expect(*report.DefaultResourceGroup().ResourceGroup.ID).To(Equal("rg1"))
+		Expect(requests).To(ConsistOf("/v2/resource_groups", "/v2/quota_definitions", "/v2/quota_definitions/q2"))
+	})
+	It(`Returns an error if a list fails`, func() {
+		failQuotas = true
+		report, err := resourceManagerService.GetAccountResourceGroupReport(context.Background(), "acct")
+		Expect(report).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(HavePrefix("error listing quota definitions"))
+	})
+})
@@ -0,0 +1,83 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package usagemeteringv4
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// UsageMeteringV4API : The operations of the UsageMeteringV4 service, implemented by *UsageMeteringV4.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type UsageMeteringV4API interface {
+	// Clone makes a copy of "usageMetering" suitable for processing requests.
+	Clone() *UsageMeteringV4
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// ReportResourceUsage : Report Resource Controller resource usage
+	ReportResourceUsage(reportResourceUsageOptions *ReportResourceUsageOptions) (result *ResponseAccepted, response *core.DetailedResponse, err error)
+
+	// ReportResourceUsageWithContext is an alternate form of the ReportResourceUsage method which supports a Context
+	// parameter
+	ReportResourceUsageWithContext(ctx context.Context, reportResourceUsageOptions *ReportResourceUsageOptions) (result *ResponseAccepted, response *core.DetailedResponse, err error)
+
+	// NewReportResourceUsageOptions : Instantiate ReportResourceUsageOptions
+	NewReportResourceUsageOptions(resourceID string, resourceUsage []ResourceInstanceUsage) *ReportResourceUsageOptions
+
+	// NewMeasureAndQuantity : Instantiate MeasureAndQuantity (Generic Model Constructor)
+	NewMeasureAndQuantity(measure string, quantity interface{}) (model *MeasureAndQuantity, err error)
+
+	// NewResourceInstanceUsage : Instantiate ResourceInstanceUsage (Generic Model Constructor)
+	NewResourceInstanceUsage(resourceInstanceID string, planID string, start int64, end int64, measuredUsage []MeasureAndQuantity) (model *ResourceInstanceUsage, err error)
+
+	// NewReportResourceUsageOptionsWith returns ReportResourceUsageOptions populated by applying "options" in order.
+	NewReportResourceUsageOptionsWith(options ...ReportResourceUsageOption) *ReportResourceUsageOptions
+
+	// ReportResourceUsageWith is an alternate form of the ReportResourceUsageWithContext method which takes functional
+	// options instead of a ReportResourceUsageOptions struct.
+	ReportResourceUsageWith(ctx context.Context, options ...ReportResourceUsageOption) (result *ResponseAccepted, response *core.DetailedResponse, err error)
+}
+
+var _ UsageMeteringV4API = (*UsageMeteringV4)(nil)
@@ -0,0 +1,71 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package usagemeteringv4
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ReportResourceUsageOption : A functional option that sets a property of ReportResourceUsageOptions (see ReportResourceUsageWith).
+type ReportResourceUsageOption func(options *ReportResourceUsageOptions)
+
+// ReportResourceUsageResourceID sets the ResourceID property of ReportResourceUsageOptions. The resource for which
+// the usage is submitted.
+func ReportResourceUsageResourceID(resourceID string) ReportResourceUsageOption {
+	return func(options *ReportResourceUsageOptions) {
+		options.ResourceID = core.StringPtr(resourceID)
+	}
+}
+
+// ReportResourceUsageResourceUsage sets the ResourceUsage property of ReportResourceUsageOptions. Array of usage
+// records.
+func ReportResourceUsageResourceUsage(resourceUsage ...ResourceInstanceUsage) ReportResourceUsageOption {
+	return func(options *ReportResourceUsageOptions) {
+		options.ResourceUsage = append(options.ResourceUsage, resourceUsage...)
+	}
+}
+
+// ReportResourceUsageHeader sets the Headers property of ReportResourceUsageOptions. Allows users to set headers
+// on API requests.
+func ReportResourceUsageHeader(name string, value string) ReportResourceUsageOption {
+	return func(options *ReportResourceUsageOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewReportResourceUsageOptionsWith returns ReportResourceUsageOptions populated by applying "options" in order.
+func (*UsageMeteringV4) NewReportResourceUsageOptionsWith(options ...ReportResourceUsageOption) *ReportResourceUsageOptions {
+	reportResourceUsageOptions := &ReportResourceUsageOptions{}
+	for _, option := range options {
+		option(reportResourceUsageOptions)
+	}
+	return reportResourceUsageOptions
+}
+
+// ReportResourceUsageWith is an alternate form of the ReportResourceUsageWithContext method which takes functional
+// options instead of a ReportResourceUsageOptions struct. ReportResourceUsageResourceID and
+// ReportResourceUsageResourceUsage are required.
+func (usageMetering *UsageMeteringV4) ReportResourceUsageWith(ctx context.Context, options ...ReportResourceUsageOption) (result *ResponseAccepted, response *core.DetailedResponse, err error) {
+	return usageMetering.ReportResourceUsageWithContext(ctx, usageMetering.NewReportResourceUsageOptionsWith(options...))
+}
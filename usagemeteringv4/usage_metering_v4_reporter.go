@@ -0,0 +1,391 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagemeteringv4
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MaxUsageRecordsPerRequest is the maximum number of usage records that a single ReportResourceUsage request may
+// contain.
+const MaxUsageRecordsPerRequest = 100
+
+// Default values of the settings of a MeteringReporter.
+const (
+	DefaultMeteringWindow        = time.Hour
+	DefaultMeteringFlushInterval = time.Minute
+)
+
+// MeasurementPoint : A single measurement submitted to a MeteringReporter.
+type MeasurementPoint struct {
+	// The ID of the service (its global catalog ID) that the usage is reported for.
+	ResourceID string
+
+	// The ID of the resource instance and of its plan.
+	ResourceInstanceID string
+	PlanID             string
+
+	// The pricing region of the usage, if any.
+	Region string
+
+	// The ID of the consumer of the usage, if any.
+	ConsumerID string
+
+	// The name of the measure and the quantity consumed.
+	Measure  string
+	Quantity float64
+
+	// When the usage occurred. If zero, the time at which the point is added is used.
+	Time time.Time
+}
+
+// meteringKey identifies the usage record to which a point is added.
+type meteringKey struct {
+	resourceID         string
+	resourceInstanceID string
+	planID             string
+	region             string
+	consumerID         string
+	window             int64
+}
+
+// meteringRecord is the usage accumulated for a key.
+type meteringRecord struct {
+	start      time.Time
+	end        time.Time
+	quantities map[string]float64
+}
+
+// meteringSubmission is a usage record as submitted to the service.
+type meteringSubmission struct {
+	key   meteringKey
+	usage ResourceInstanceUsage
+}
+
+func (record *meteringRecord) merge(other *meteringRecord) {
+	if other.start.Before(record.start) {
+		record.start = other.start
+	}
+	if other.end.After(record.end) {
+		record.end = other.end
+	}
+	for measure, quantity := range other.quantities {
+		record.quantities[measure] += quantity
+	}
+}
+
+// MeteringReporter : Buffers measurement points and submits them with ReportResourceUsage in the background.
+// The points of a resource instance, plan, region and consumer are summed per measure within each time window, and
+// each sum is submitted as one usage record spanning the times of its points. Records are submitted every
+// FlushInterval, or as soon as a service has a full request of records.
+// Delivery is at least once: records are kept until the service accepts them (or reports them as duplicates) and
+// are resubmitted by the next flush if the request fails or the service reports a transient error for them. A record
+// is resubmitted unchanged, so that the service recognizes it as a duplicate if it was in fact accepted; the points
+// added since are submitted in a record of their own. Records that the service rejects are dropped and passed to
+// OnDropped.
+// A MeteringReporter is safe for concurrent use.
+type MeteringReporter struct {
+	client *UsageMeteringV4
+
+	// The time window within which points are summed. Defaults to DefaultMeteringWindow.
+	Window time.Duration
+
+	// The interval between flushes in Run. Defaults to DefaultMeteringFlushInterval.
+	FlushInterval time.Duration
+
+	// The maximum number of records submitted in one request. Defaults to MaxUsageRecordsPerRequest.
+	MaxBatchSize int
+
+	// If not nil, called with the records that the service rejected.
+	OnDropped func(resourceID string, usage ResourceInstanceUsage, err error)
+
+	mutex      sync.Mutex
+	flushMutex sync.Mutex
+	pending    map[meteringKey]*meteringRecord
+	retries    []meteringSubmission
+	flushNow   chan struct{}
+}
+
+// NewMeteringReporter returns a new MeteringReporter that submits usage with "usageMetering".
+func NewMeteringReporter(usageMetering *UsageMeteringV4) *MeteringReporter {
+	return &MeteringReporter{
+		client:        usageMetering,
+		Window:        DefaultMeteringWindow,
+		FlushInterval: DefaultMeteringFlushInterval,
+		MaxBatchSize:  MaxUsageRecordsPerRequest,
+		pending:       make(map[meteringKey]*meteringRecord),
+		flushNow:      make(chan struct{}, 1),
+	}
+}
+
+// Add buffers a measurement point. It does not block on the submission of usage.
+func (reporter *MeteringReporter) Add(point MeasurementPoint) error {
+	if point.ResourceID == "" || point.ResourceInstanceID == "" || point.PlanID == "" || point.Measure == "" {
+		return fmt.Errorf("the resource ID, resource instance ID, plan ID and measure of a measurement point must be specified")
+	}
+	if point.Time.IsZero() {
+		point.Time = time.Now()
+	}
+	window := reporter.Window
+	if window <= 0 {
+		window = DefaultMeteringWindow
+	}
+
+	key := meteringKey{
+		resourceID:         point.ResourceID,
+		resourceInstanceID: point.ResourceInstanceID,
+		planID:             point.PlanID,
+		region:             point.Region,
+		consumerID:         point.ConsumerID,
+		window:             point.Time.Truncate(window).UnixNano(),
+	}
+	reporter.mutex.Lock()
+	reporter.add(key, &meteringRecord{
+		start:      point.Time,
+		end:        point.Time,
+		quantities: map[string]float64{point.Measure: point.Quantity},
+	})
+	full := reporter.pendingRecords(point.ResourceID) >= reporter.batchSize()
+	reporter.mutex.Unlock()
+
+	if full {
+		select {
+		case reporter.flushNow <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// add merges "record" into the pending records. The caller must hold the mutex.
+func (reporter *MeteringReporter) add(key meteringKey, record *meteringRecord) {
+	if existing, found := reporter.pending[key]; found {
+		existing.merge(record)
+		return
+	}
+	reporter.pending[key] = record
+}
+
+// pendingRecords returns the number of pending records of a service. The caller must hold the mutex.
+func (reporter *MeteringReporter) pendingRecords(resourceID string) (count int) {
+	for key := range reporter.pending {
+		if key.resourceID == resourceID {
+			count++
+		}
+	}
+	return
+}
+
+func (reporter *MeteringReporter) batchSize() int {
+	if reporter.MaxBatchSize <= 0 || reporter.MaxBatchSize > MaxUsageRecordsPerRequest {
+		return MaxUsageRecordsPerRequest
+	}
+	return reporter.MaxBatchSize
+}
+
+// Pending returns the number of usage records that have not been accepted yet.
+func (reporter *MeteringReporter) Pending() int {
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+	return len(reporter.pending) + len(reporter.retries)
+}
+
+// Flush submits the records that failed to be delivered, unchanged, and the pending records. Records that could not
+// be delivered because of a transient error are kept for the next flush; the first error encountered is returned.
+func (reporter *MeteringReporter) Flush(ctx context.Context) (err error) {
+	reporter.flushMutex.Lock()
+	defer reporter.flushMutex.Unlock()
+
+	reporter.mutex.Lock()
+	records := reporter.pending
+	retries := reporter.retries
+	reporter.pending = make(map[meteringKey]*meteringRecord)
+	reporter.retries = nil
+	reporter.mutex.Unlock()
+
+	keys := make([]meteringKey, 0, len(records))
+	for key := range records {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.resourceID != b.resourceID {
+			return a.resourceID < b.resourceID
+		}
+		if a.window != b.window {
+			return a.window < b.window
+		}
+		if a.resourceInstanceID != b.resourceInstanceID {
+			return a.resourceInstanceID < b.resourceInstanceID
+		}
+		if a.planID != b.planID {
+			return a.planID < b.planID
+		}
+		if a.region != b.region {
+			return a.region < b.region
+		}
+		return a.consumerID < b.consumerID
+	})
+
+	// The retries are submitted first, followed by the new records, grouped by service.
+	submissions := retries
+	for _, key := range keys {
+		submissions = append(submissions, meteringSubmission{key: key, usage: records[key].usage(key)})
+	}
+	sort.SliceStable(submissions, func(i, j int) bool {
+		return submissions[i].key.resourceID < submissions[j].key.resourceID
+	})
+
+	for start := 0; start < len(submissions); {
+		end := start + 1
+		for end < len(submissions) && end-start < reporter.batchSize() && submissions[end].key.resourceID == submissions[start].key.resourceID {
+			end++
+		}
+		sendErr := reporter.send(ctx, submissions[start:end])
+		if sendErr != nil && err == nil {
+			err = sendErr
+		}
+		start = end
+	}
+	return
+}
+
+// send submits "submissions", which belong to the same service, in one request. The submissions that fail with a
+// transient error are kept, unchanged, for the next flush.
+func (reporter *MeteringReporter) send(ctx context.Context, submissions []meteringSubmission) (err error) {
+	resourceID := submissions[0].key.resourceID
+	usage := make([]ResourceInstanceUsage, len(submissions))
+	for i, submission := range submissions {
+		usage[i] = submission.usage
+	}
+
+	requeue := func(i int) {
+		reporter.mutex.Lock()
+		defer reporter.mutex.Unlock()
+		reporter.retries = append(reporter.retries, submissions[i])
+	}
+	drop := func(i int, dropErr error) {
+		if reporter.OnDropped != nil {
+			reporter.OnDropped(resourceID, usage[i], dropErr)
+		}
+	}
+
+	options := reporter.client.NewReportResourceUsageOptions(resourceID, usage)
+	result, response, err := reporter.client.ReportResourceUsageWithContext(ctx, options)
+	if err != nil {
+		err = fmt.Errorf("error reporting the usage of resource '%s': %w", resourceID, err)
+		for i := range submissions {
+			if response == nil || isTransientMeteringStatus(int64(response.StatusCode)) {
+				requeue(i)
+			} else {
+				drop(i, err)
+			}
+		}
+		return
+	}
+
+	for i, submission := range submissions {
+		key := submission.key
+		if result == nil || i >= len(result.Resources) {
+			requeue(i)
+			continue
+		}
+		details := result.Resources[i]
+		status := int64(0)
+		if details.Status != nil {
+			status = *details.Status
+		}
+		switch {
+		case status >= 200 && status < 300, status == http.StatusConflict:
+			// Accepted, or a duplicate of a record that was accepted before.
+		case isTransientMeteringStatus(status):
+			requeue(i)
+			if err == nil {
+				err = fmt.Errorf("usage record of resource instance '%s' not accepted: status %d", key.resourceInstanceID, status)
+			}
+		default:
+			dropErr := fmt.Errorf("usage record of resource instance '%s' rejected: status %d: %s %s", key.resourceInstanceID,
+				status, core.StringNilMapper(details.Code), core.StringNilMapper(details.Message))
+			drop(i, dropErr)
+			if err == nil {
+				err = dropErr
+			}
+		}
+	}
+	return
+}
+
+func isTransientMeteringStatus(status int64) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+// usage returns the usage record of the accumulated points.
+func (record *meteringRecord) usage(key meteringKey) ResourceInstanceUsage {
+	measures := make([]string, 0, len(record.quantities))
+	for measure := range record.quantities {
+		measures = append(measures, measure)
+	}
+	sort.Strings(measures)
+
+	usage := ResourceInstanceUsage{
+		ResourceInstanceID: core.StringPtr(key.resourceInstanceID),
+		PlanID:             core.StringPtr(key.planID),
+		Start:              core.Int64Ptr(record.start.UnixNano() / int64(time.Millisecond)),
+		End:                core.Int64Ptr(record.end.UnixNano() / int64(time.Millisecond)),
+	}
+	if key.region != "" {
+		usage.Region = core.StringPtr(key.region)
+	}
+	if key.consumerID != "" {
+		usage.ConsumerID = core.StringPtr(key.consumerID)
+	}
+	for _, measure := range measures {
+		usage.MeasuredUsage = append(usage.MeasuredUsage, MeasureAndQuantity{
+			Measure:  core.StringPtr(measure),
+			Quantity: record.quantities[measure],
+		})
+	}
+	return usage
+}
+
+// Run flushes the pending records every FlushInterval, and whenever a service has a full request of records, until
+// the context is done, and then returns the context's error. Records added after the last flush remain pending; call
+// Flush after Run returns to deliver them.
+func (reporter *MeteringReporter) Run(ctx context.Context) error {
+	interval := reporter.FlushInterval
+	if interval <= 0 {
+		interval = DefaultMeteringFlushInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-reporter.flushNow:
+		}
+		_ = reporter.Flush(ctx)
+	}
+}
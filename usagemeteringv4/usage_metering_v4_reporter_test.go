@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagemeteringv4_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagemeteringv4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UsageMeteringV4 MeteringReporter`, func() {
+	var testServer *httptest.Server
+	var reporter *usagemeteringv4.MeteringReporter
+	var mutex sync.Mutex
+	var requests []map[string][]map[string]interface{}
+	var statuses []int
+	var unavailable bool
+	BeforeEach(func() {
+		requests = nil
+		statuses = nil
+		unavailable = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("POST"))
+			resourceID := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/v4/metering/resources/"), "/usage")
+			var body []map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			requests = append(requests, map[string][]map[string]interface{}{resourceID: body})
+			res.Header().Set("Content-type", "application/json")
+			if unavailable {
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"message": "unavailable"}`)
+				return
+			}
+			var details []string
+			for i := range body {
+				status := 201
+				if i < len(statuses) {
+					status = statuses[i]
+				}
+				details = append(details, fmt.Sprintf(`{"status": %d, "location": "loc%d"}`, status, i))
+			}
+			res.WriteHeader(202)
+			fmt.Fprintf(res, `{"resources": [%s]}`, strings.Join(details, ","))
+		}))
+		usageMeteringService, serviceErr := usagemeteringv4.NewUsageMeteringV4(&usagemeteringv4.UsageMeteringV4Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		reporter = usagemeteringv4.NewMeteringReporter(usageMeteringService)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	window := time.Date(2022, 5, 2, 10, 0, 0, 0, time.UTC)
+	point := func(instance string, measure string, quantity float64, minutes int) usagemeteringv4.MeasurementPoint {
+		return usagemeteringv4.MeasurementPoint{
+			ResourceID:         "service",
+			ResourceInstanceID: instance,
+			PlanID:             "plan",
+			Measure:            measure,
+			Quantity:           quantity,
+			Time:               window.Add(time.Duration(minutes) * time.Minute),
+		}
+	}
+	millis := func(minutes int) float64 {
+		return float64(window.Add(time.Duration(minutes)*time.Minute).UnixNano() / int64(time.Millisecond))
+	}
+
+	It(`Sums the points of each instance and window`, func() {
+		Expect(reporter.Add(point("i1", "API_CALLS", 10, 5))).To(Succeed())
+		Expect(reporter.Add(point("i1", "API_CALLS", 5, 20))).To(Succeed())
+		Expect(reporter.Add(point("i1", "STORAGE", 1, 10))).To(Succeed())
+		Expect(reporter.Add(point("i1", "API_CALLS", 7, 70))).To(Succeed())
+		Expect(reporter.Add(point("i2", "API_CALLS", 1, 5))).To(Succeed())
+		Expect(reporter.Pending()).To(Equal(3))
+
+		Expect(reporter.Flush(context.Background())).To(Succeed())
+		Expect(reporter.Pending()).To(Equal(0))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0]["service"]).To(Equal([]map[string]interface{}{
+			{"resource_instance_id": "i1", "plan_id": "plan", "start": millis(5), "end": millis(20), "measured_usage": []interface{}{
+				map[string]interface{}{"measure": "API_CALLS", "quantity": 15.0},
+				map[string]interface{}{"measure": "STORAGE", "quantity": 1.0}}},
+			{"resource_instance_id": "i2", "plan_id": "plan", "start": millis(5), "end": millis(5), "measured_usage": []interface{}{
+				map[string]interface{}{"measure": "API_CALLS", "quantity": 1.0}}},
+			{"resource_instance_id": "i1", "plan_id": "plan", "start": millis(70), "end": millis(70), "measured_usage": []interface{}{
+				map[string]interface{}{"measure": "API_CALLS", "quantity": 7.0}}},
+		}))
+	})
+	It(`Keeps records until they are accepted`, func() {
+		var dropped []string
+		reporter.OnDropped = func(resourceID string, usage usagemeteringv4.ResourceInstanceUsage, err error) {
+			dropped = append(dropped, *usage.ResourceInstanceID)
+		}
+		Expect(reporter.Add(point("i1", "API_CALLS", 10, 5))).To(Succeed())
+		Expect(reporter.Add(point("i2", "API_CALLS", 1, 5))).To(Succeed())
+		Expect(reporter.Add(point("i3", "API_CALLS", 1, 5))).To(Succeed())
+
+		unavailable = true
+		Expect(reporter.Flush(context.Background())).ToNot(Succeed())
+		Expect(reporter.Pending()).To(Equal(3))
+
+		// The transient failure of i2 is retried; the rejection of i3 is not. The failed records are resubmitted
+		// unchanged, and the point added since is submitted in a record of its own.
+		unavailable = false
+		statuses = []int{409, 500, 400}
+		Expect(reporter.Add(point("i2", "API_CALLS", 2, 6))).To(Succeed())
+		Expect(reporter.Flush(context.Background())).ToNot(Succeed())
+		Expect(dropped).To(Equal([]string{"i3"}))
+		Expect(reporter.Pending()).To(Equal(1))
+		Expect(requests[1]["service"]).To(HaveLen(4))
+		Expect(requests[1]["service"][0]).To(Equal(requests[0]["service"][0]))
+		Expect(requests[1]["service"][1]).To(Equal(requests[0]["service"][1]))
+		Expect(requests[1]["service"][3]["measured_usage"]).To(Equal([]interface{}{
+			map[string]interface{}{"measure": "API_CALLS", "quantity": 2.0}}))
+
+		statuses = nil
+		Expect(reporter.Flush(context.Background())).To(Succeed())
+		Expect(reporter.Pending()).To(Equal(0))
+		Expect(requests).To(HaveLen(3))
+		Expect(requests[2]["service"]).To(Equal(requests[0]["service"][1:2]))
+	})
+	It(`Flushes full batches in the background`, func() {
+		reporter.MaxBatchSize = 2
+		reporter.FlushInterval = time.Hour
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() { done <- reporter.Run(ctx) }()
+
+		Expect(reporter.Add(point("i1", "API_CALLS", 1, 5))).To(Succeed())
+		Expect(reporter.Add(point("i2", "API_CALLS", 1, 5))).To(Succeed())
+		Eventually(func() int {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return len(requests)
+		}).Should(Equal(1))
+		cancel()
+		Expect(<-done).To(Equal(context.Canceled))
+	})
+	It(`Validates points`, func() {
+		Expect(reporter.Add(usagemeteringv4.MeasurementPoint{ResourceID: "service", Measure: "API_CALLS"})).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,289 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagemeteringv4
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+)
+
+// Default values of the settings of a UsageValidator.
+const (
+	DefaultUsageMaxAge    = 30 * 24 * time.Hour
+	DefaultUsageClockSkew = 5 * time.Minute
+)
+
+// UsageViolation : A problem found by a UsageValidator in a usage record.
+type UsageViolation struct {
+	// The index of the record in the validated slice.
+	Index int
+
+	// The ID of the resource instance of the record.
+	ResourceInstanceID string
+
+	// The name of the measure that the problem concerns, if any.
+	Measure string
+
+	// The description of the problem.
+	Message string
+}
+
+// Error returns the description of the violation.
+func (violation UsageViolation) Error() string {
+	if violation.Measure != "" {
+		return fmt.Sprintf("usage record %d of resource instance '%s', measure '%s': %s", violation.Index,
+			violation.ResourceInstanceID, violation.Measure, violation.Message)
+	}
+	return fmt.Sprintf("usage record %d of resource instance '%s': %s", violation.Index, violation.ResourceInstanceID,
+		violation.Message)
+}
+
+// planMetric is the definition of a measure of a plan in the Global Catalog.
+type planMetric struct {
+	effectiveFrom  time.Time
+	effectiveUntil time.Time
+}
+
+// UsageValidator : Checks usage records against the pricing metrics that their plans define in the Global Catalog
+// before they are submitted, so that records that the service would reject are found early: unknown or duplicate
+// measures, quantities that are not numbers, and time ranges that are inverted or out of bounds.
+// The metrics of each plan are retrieved once. A UsageValidator is safe for concurrent use.
+type UsageValidator struct {
+	// The client used to retrieve the pricing metrics of plans.
+	GlobalCatalog *globalcatalogv1.GlobalCatalogV1
+
+	// The maximum age of the start of a record. Defaults to DefaultUsageMaxAge.
+	MaxAge time.Duration
+
+	// How far in the future the end of a record may be. Defaults to DefaultUsageClockSkew.
+	ClockSkew time.Duration
+
+	mutex   sync.Mutex
+	metrics map[string]map[string]planMetric
+}
+
+// NewUsageValidator returns a new UsageValidator that retrieves the pricing metrics of plans with "globalCatalog".
+func NewUsageValidator(globalCatalog *globalcatalogv1.GlobalCatalogV1) *UsageValidator {
+	return &UsageValidator{
+		GlobalCatalog: globalCatalog,
+		MaxAge:        DefaultUsageMaxAge,
+		ClockSkew:     DefaultUsageClockSkew,
+		metrics:       make(map[string]map[string]planMetric),
+	}
+}
+
+// ValidateUsage returns the violations found in "usage". An error is returned only if the metrics of a plan could not
+// be retrieved; a plan that does not exist in the catalog is reported as a violation.
+func (validator *UsageValidator) ValidateUsage(ctx context.Context, usage []ResourceInstanceUsage) (violations []UsageViolation, err error) {
+	now := time.Now()
+	maxAge := validator.MaxAge
+	if maxAge <= 0 {
+		maxAge = DefaultUsageMaxAge
+	}
+	clockSkew := validator.ClockSkew
+	if clockSkew <= 0 {
+		clockSkew = DefaultUsageClockSkew
+	}
+
+	for i := range usage {
+		record := &usage[i]
+		violate := func(measure string, format string, args ...interface{}) {
+			violations = append(violations, UsageViolation{
+				Index:              i,
+				ResourceInstanceID: core.StringNilMapper(record.ResourceInstanceID),
+				Measure:            measure,
+				Message:            fmt.Sprintf(format, args...),
+			})
+		}
+
+		if core.StringNilMapper(record.ResourceInstanceID) == "" {
+			violate("", "the resource instance ID is missing")
+		}
+		if record.Start == nil || record.End == nil {
+			violate("", "the start and end times are required")
+			continue
+		}
+		start := time.Unix(0, *record.Start*int64(time.Millisecond))
+		end := time.Unix(0, *record.End*int64(time.Millisecond))
+		if end.Before(start) {
+			violate("", "the end time %s is before the start time %s", end.UTC().Format(time.RFC3339), start.UTC().Format(time.RFC3339))
+		}
+		if end.After(now.Add(clockSkew)) {
+			violate("", "the end time %s is in the future", end.UTC().Format(time.RFC3339))
+		}
+		if start.Before(now.Add(-maxAge)) {
+			violate("", "the start time %s is older than %s", start.UTC().Format(time.RFC3339), maxAge)
+		}
+		if len(record.MeasuredUsage) == 0 {
+			violate("", "no measures are specified")
+		}
+
+		planID := core.StringNilMapper(record.PlanID)
+		if planID == "" {
+			violate("", "the plan ID is missing")
+			continue
+		}
+		metrics, found, metricsErr := validator.planMetrics(ctx, planID)
+		if metricsErr != nil {
+			err = metricsErr
+			return
+		}
+		if !found {
+			violate("", "plan '%s' does not exist in the catalog", planID)
+		}
+
+		seen := make(map[string]bool)
+		for _, measured := range record.MeasuredUsage {
+			measure := core.StringNilMapper(measured.Measure)
+			if measure == "" {
+				violate("", "a measure has no name")
+				continue
+			}
+			if seen[measure] {
+				violate(measure, "the measure is specified more than once")
+			}
+			seen[measure] = true
+
+			if found {
+				metric, defined := metrics[measure]
+				if !defined {
+					violate(measure, "the measure is not defined by plan '%s'", planID)
+				} else {
+					if !metric.effectiveFrom.IsZero() && start.Before(metric.effectiveFrom) {
+						violate(measure, "the measure is not effective before %s", metric.effectiveFrom.UTC().Format(time.RFC3339))
+					}
+					if !metric.effectiveUntil.IsZero() && end.After(metric.effectiveUntil) {
+						violate(measure, "the measure is not effective after %s", metric.effectiveUntil.UTC().Format(time.RFC3339))
+					}
+				}
+			}
+
+			eventBased, quantityErr := checkQuantity(measured.Quantity)
+			if quantityErr != "" {
+				violate(measure, "%s", quantityErr)
+			} else if eventBased && *record.Start != *record.End {
+				violate(measure, "event-based quantities require the start and end times to be equal")
+			}
+		}
+	}
+	return
+}
+
+// checkQuantity returns a description of the problem with a quantity, if any, and whether it is an event-based
+// quantity (with previous and current values).
+func checkQuantity(quantity interface{}) (eventBased bool, problem string) {
+	if values, ok := quantity.(map[string]interface{}); ok {
+		for _, key := range []string{"previous", "current"} {
+			value, found := values[key]
+			if !found {
+				problem = fmt.Sprintf("the event-based quantity has no '%s' value", key)
+				return
+			}
+			if _, isNumber := quantityValue(value); !isNumber {
+				problem = fmt.Sprintf("the '%s' value of the quantity is not a number", key)
+				return
+			}
+		}
+		eventBased = true
+		return
+	}
+
+	value, isNumber := quantityValue(quantity)
+	switch {
+	case quantity == nil:
+		problem = "the quantity is missing"
+	case !isNumber:
+		problem = fmt.Sprintf("the quantity %v is not a number", quantity)
+	case value < 0:
+		problem = fmt.Sprintf("the quantity %v is negative", value)
+	}
+	return
+}
+
+// quantityValue returns the value of a numeric quantity.
+func quantityValue(quantity interface{}) (value float64, ok bool) {
+	ok = true
+	switch number := quantity.(type) {
+	case float64:
+		value = number
+	case float32:
+		value = float64(number)
+	case int:
+		value = float64(number)
+	case int32:
+		value = float64(number)
+	case int64:
+		value = float64(number)
+	case json.Number:
+		var err error
+		value, err = number.Float64()
+		ok = err == nil
+	default:
+		ok = false
+	}
+	return
+}
+
+// planMetrics returns the metrics of a plan by measure name; "found" is false if the plan has no pricing in the
+// catalog.
+func (validator *UsageValidator) planMetrics(ctx context.Context, planID string) (metrics map[string]planMetric, found bool, err error) {
+	validator.mutex.Lock()
+	metrics, found = validator.metrics[planID]
+	validator.mutex.Unlock()
+	if found {
+		return metrics, metrics != nil, nil
+	}
+
+	pricing, response, err := validator.GlobalCatalog.GetPricingWithContext(ctx, validator.GlobalCatalog.NewGetPricingOptions(planID))
+	if err != nil {
+		if response == nil || response.StatusCode != http.StatusNotFound {
+			err = fmt.Errorf("error retrieving the pricing of plan '%s': %w", planID, err)
+			return
+		}
+		err = nil
+	} else {
+		metrics = make(map[string]planMetric)
+		for _, metric := range pricing.Metrics {
+			name := core.StringNilMapper(metric.ChargeUnitName)
+			if name == "" {
+				continue
+			}
+			definition := planMetric{}
+			if metric.EffectiveFrom != nil {
+				definition.effectiveFrom = time.Time(*metric.EffectiveFrom)
+			}
+			if metric.EffectiveUntil != nil {
+				definition.effectiveUntil = time.Time(*metric.EffectiveUntil)
+			}
+			metrics[strings.TrimSpace(name)] = definition
+		}
+	}
+
+	validator.mutex.Lock()
+	validator.metrics[planID] = metrics
+	validator.mutex.Unlock()
+	found = metrics != nil
+	return
+}
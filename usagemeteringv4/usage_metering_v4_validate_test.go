@@ -0,0 +1,131 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagemeteringv4_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/IBM/platform-services-go-sdk/usagemeteringv4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UsageMeteringV4 UsageValidator`, func() {
+	var testServer *httptest.Server
+	var validator *usagemeteringv4.UsageValidator
+	var pricingRequests int
+	BeforeEach(func() {
+		pricingRequests = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			pricingRequests++
+			switch req.URL.EscapedPath() {
+			case "/plan/pricing":
+				fmt.Fprint(res, `{"metrics": [
+					{"metric_id": "part-1", "charge_unit_name": "API_CALLS"},
+					{"metric_id": "part-2", "charge_unit_name": "LEGACY_CALLS", "effective_until": "2020-01-01T00:00:00.000Z"}]}`)
+			case "/missing/pricing":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			default:
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"message": "failed"}`)
+			}
+		}))
+		globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		validator = usagemeteringv4.NewUsageValidator(globalCatalogService)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	record := func(planID string, start int64, end int64, measures ...usagemeteringv4.MeasureAndQuantity) usagemeteringv4.ResourceInstanceUsage {
+		return usagemeteringv4.ResourceInstanceUsage{
+			ResourceInstanceID: core.StringPtr("instance"),
+			PlanID:             core.StringPtr(planID),
+			Start:              core.Int64Ptr(start),
+			End:                core.Int64Ptr(end),
+			MeasuredUsage:      measures,
+		}
+	}
+	measure := func(name string, quantity interface{}) usagemeteringv4.MeasureAndQuantity {
+		return usagemeteringv4.MeasureAndQuantity{Measure: core.StringPtr(name), Quantity: quantity}
+	}
+	messages := func(violations []usagemeteringv4.UsageViolation) (result []string) {
+		for _, violation := range violations {
+			result = append(result, fmt.Sprintf("%d %s: %s", violation.Index, violation.Measure, violation.Message))
+		}
+		return
+	}
+
+	It(`Accepts valid records`, func() {
+		violations, err := validator.ValidateUsage(context.Background(), []usagemeteringv4.ResourceInstanceUsage{
+			record("plan", now-60000, now, measure("API_CALLS", 10.0)),
+			record("plan", now, now, measure("API_CALLS", map[string]interface{}{"previous": 1, "current": 2})),
+		})
+		Expect(err).To(BeNil())
+		Expect(violations).To(BeEmpty())
+		Expect(pricingRequests).To(Equal(1))
+	})
+	It(`Reports the problems of invalid records`, func() {
+		hour := int64(time.Hour / time.Millisecond)
+		violations, err := validator.ValidateUsage(context.Background(), []usagemeteringv4.ResourceInstanceUsage{
+			record("plan", now, now-hour, measure("API_CALLS", "ten"), measure("API_CALLS", -1), measure("STORAGE", 1)),
+			record("plan", now-hour, now+hour, measure("API_CALLS", map[string]interface{}{"current": 2})),
+			record("plan", now-hour, now, measure("API_CALLS", map[string]interface{}{"previous": 1, "current": 2})),
+			record("plan", now-60*24*hour, now, measure("LEGACY_CALLS", 1)),
+			record("missing", now, now, measure("API_CALLS", 1)),
+		})
+		Expect(err).To(BeNil())
+		Expect(messages(violations)).To(Equal([]string{
+			"0 : the end time " + time.Unix(0, (now-hour)*int64(time.Millisecond)).UTC().Format(time.RFC3339) +
+				" is before the start time " + time.Unix(0, now*int64(time.Millisecond)).UTC().Format(time.RFC3339),
+			"0 API_CALLS: the quantity ten is not a number",
+			"0 API_CALLS: the measure is specified more than once",
+			"0 API_CALLS: the quantity -1 is negative",
+			"0 STORAGE: the measure is not defined by plan 'plan'",
+			"1 : the end time " + time.Unix(0, (now+hour)*int64(time.Millisecond)).UTC().Format(time.RFC3339) + " is in the future",
+			"1 API_CALLS: the event-based quantity has no 'previous' value",
+			"2 API_CALLS: event-based quantities require the start and end times to be equal",
+			"3 : the start time " + time.Unix(0, (now-60*24*hour)*int64(time.Millisecond)).UTC().Format(time.RFC3339) +
+				" is older than 720h0m0s",
+			"3 LEGACY_CALLS: the measure is not effective after 2020-01-01T00:00:00Z",
+			"4 : plan 'missing' does not exist in the catalog",
+		}))
+		Expect(pricingRequests).To(Equal(2))
+	})
+	It(`Returns an error if the metrics of a plan cannot be retrieved`, func() {
+		_, err := validator.ValidateUsage(context.Background(), []usagemeteringv4.ResourceInstanceUsage{
+			record("broken", now, now, measure("API_CALLS", 1)),
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,226 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package analysis provides analyses of the usage reports retrieved with the usagereportsv4 package.
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+)
+
+// DefaultAnomalyThreshold is the relative month-over-month change in cost above which a change is an anomaly when
+// no threshold is configured: 0.5 means an increase or decrease of more than 50%.
+const DefaultAnomalyThreshold = 0.5
+
+// Constants associated with the Kind field of an Anomaly.
+const (
+	AnomalyKindIncreaseConst = "increase"
+	AnomalyKindDecreaseConst = "decrease"
+	AnomalyKindNewConst      = "new"
+)
+
+// AnomalyOptions : The configuration of DetectAnomalies.
+type AnomalyOptions struct {
+	// The relative change in cost above which a change is an anomaly. Defaults to DefaultAnomalyThreshold.
+	Threshold float64
+
+	// Thresholds that override Threshold for the plans of a service, by resource ID.
+	ServiceThresholds map[string]float64
+
+	// Thresholds that override Threshold and ServiceThresholds for a plan, by plan ID.
+	PlanThresholds map[string]float64
+
+	// Changes are ignored if the cost was below this amount in both months, so that small costs do not cause
+	// anomalies.
+	MinimumCost float64
+}
+
+func (options *AnomalyOptions) threshold(resourceID string, planID string) float64 {
+	if threshold, found := options.PlanThresholds[planID]; found {
+		return threshold
+	}
+	if threshold, found := options.ServiceThresholds[resourceID]; found {
+		return threshold
+	}
+	if options.Threshold > 0 {
+		return options.Threshold
+	}
+	return DefaultAnomalyThreshold
+}
+
+// Anomaly : A month-over-month change in the cost of a plan that exceeds its threshold.
+type Anomaly struct {
+	// The kind of change: AnomalyKindIncreaseConst, AnomalyKindDecreaseConst, or AnomalyKindNewConst if the plan
+	// had no cost in the previous month.
+	Kind string `json:"kind"`
+
+	// The ID of the service (resource).
+	ResourceID string `json:"resource_id"`
+
+	// The ID of the plan.
+	PlanID string `json:"plan_id"`
+
+	// The month of the change and the month it is compared with.
+	Month         string `json:"month"`
+	PreviousMonth string `json:"previous_month"`
+
+	// The cost of the plan in each month.
+	Cost         float64 `json:"cost"`
+	PreviousCost float64 `json:"previous_cost"`
+
+	// The difference between the costs.
+	Difference float64 `json:"difference"`
+
+	// The difference relative to the previous cost; 0 if the kind is AnomalyKindNewConst.
+	Ratio float64 `json:"ratio"`
+
+	// The threshold that was exceeded.
+	Threshold float64 `json:"threshold"`
+}
+
+// AnomalyReport : The anomalies found by DetectAnomalies.
+type AnomalyReport struct {
+	// The ID of the account.
+	AccountID string `json:"account_id"`
+
+	// The months analyzed, in ascending order.
+	Months []string `json:"months"`
+
+	// The anomalies, by month and then by decreasing absolute difference.
+	Anomalies []Anomaly `json:"anomalies"`
+}
+
+// HasAnomalies returns true if any anomaly was found.
+func (report *AnomalyReport) HasAnomalies() bool {
+	return len(report.Anomalies) > 0
+}
+
+// MonthAnomalies returns the anomalies found in a month.
+func (report *AnomalyReport) MonthAnomalies(month string) (anomalies []Anomaly) {
+	for _, anomaly := range report.Anomalies {
+		if anomaly.Month == month {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+	return
+}
+
+type planKey struct {
+	resourceID string
+	planID     string
+}
+
+// DetectAnomalies compares the cost of each plan of each service in every month of "usage" with its cost in the
+// preceding month of "usage" and reports the changes that exceed the configured thresholds. The usage reports must
+// be of the same account and of distinct months, in any order (e.g. the Usage of an AccountUsageSeries). The cost of
+// a plan is the sum of its costs in all pricing regions. "options" may be nil.
+func DetectAnomalies(usage []usagereportsv4.AccountUsage, options *AnomalyOptions) (report *AnomalyReport, err error) {
+	if options == nil {
+		options = &AnomalyOptions{}
+	}
+
+	costs := make(map[string]map[planKey]float64)
+	report = &AnomalyReport{}
+	for _, monthUsage := range usage {
+		accountID := core.StringNilMapper(monthUsage.AccountID)
+		month := core.StringNilMapper(monthUsage.Month)
+		if report.AccountID == "" {
+			report.AccountID = accountID
+		} else if accountID != report.AccountID {
+			return nil, fmt.Errorf("the usage reports are of different accounts: '%s' and '%s'", report.AccountID, accountID)
+		}
+		if _, found := costs[month]; found {
+			return nil, fmt.Errorf("there is more than one usage report for month '%s'", month)
+		}
+		monthCosts := make(map[planKey]float64)
+		for _, resource := range monthUsage.Resources {
+			for _, plan := range resource.Plans {
+				key := planKey{core.StringNilMapper(resource.ResourceID), core.StringNilMapper(plan.PlanID)}
+				if plan.Cost != nil {
+					monthCosts[key] += *plan.Cost
+				}
+			}
+		}
+		costs[month] = monthCosts
+		report.Months = append(report.Months, month)
+	}
+	sort.Strings(report.Months)
+
+	for i := 1; i < len(report.Months); i++ {
+		previousMonth, month := report.Months[i-1], report.Months[i]
+		report.Anomalies = append(report.Anomalies,
+			compareMonths(previousMonth, costs[previousMonth], month, costs[month], options)...)
+	}
+	return
+}
+
+// compareMonths returns the anomalies in the costs of the plans in "month" compared with "previousMonth".
+func compareMonths(previousMonth string, previousCosts map[planKey]float64, month string, costs map[planKey]float64, options *AnomalyOptions) (anomalies []Anomaly) {
+	keys := make(map[planKey]bool)
+	for key := range previousCosts {
+		keys[key] = true
+	}
+	for key := range costs {
+		keys[key] = true
+	}
+
+	for key := range keys {
+		previousCost, cost := previousCosts[key], costs[key]
+		if math.Max(previousCost, cost) < options.MinimumCost || previousCost == cost {
+			continue
+		}
+		anomaly := Anomaly{
+			ResourceID:    key.resourceID,
+			PlanID:        key.planID,
+			Month:         month,
+			PreviousMonth: previousMonth,
+			Cost:          cost,
+			PreviousCost:  previousCost,
+			Difference:    cost - previousCost,
+			Threshold:     options.threshold(key.resourceID, key.planID),
+		}
+		if previousCost == 0 {
+			anomaly.Kind = AnomalyKindNewConst
+		} else {
+			anomaly.Ratio = anomaly.Difference / previousCost
+			if math.Abs(anomaly.Ratio) <= anomaly.Threshold {
+				continue
+			}
+			anomaly.Kind = AnomalyKindIncreaseConst
+			if anomaly.Difference < 0 {
+				anomaly.Kind = AnomalyKindDecreaseConst
+			}
+		}
+		anomalies = append(anomalies, anomaly)
+	}
+
+	sort.Slice(anomalies, func(i, j int) bool {
+		a, b := anomalies[i], anomalies[j]
+		if math.Abs(a.Difference) != math.Abs(b.Difference) {
+			return math.Abs(a.Difference) > math.Abs(b.Difference)
+		}
+		if a.ResourceID != b.ResourceID {
+			return a.ResourceID < b.ResourceID
+		}
+		return a.PlanID < b.PlanID
+	})
+	return
+}
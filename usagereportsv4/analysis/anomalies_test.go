@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analysis
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	"github.com/stretchr/testify/assert"
+)
+
+// accountUsage returns the usage of account "acct" for a month, with the cost of each plan keyed by
+// "<resource ID>/<plan ID>".
+func accountUsage(month string, costs map[string]float64) usagereportsv4.AccountUsage {
+	usage := usagereportsv4.AccountUsage{AccountID: core.StringPtr("acct"), Month: core.StringPtr(month)}
+	for key, cost := range costs {
+		ids := strings.SplitN(key, "/", 2)
+		resourceID, planID := ids[0], ids[1]
+		usage.Resources = append(usage.Resources, usagereportsv4.Resource{
+			ResourceID: core.StringPtr(resourceID),
+			Plans:      []usagereportsv4.Plan{{PlanID: core.StringPtr(planID), Cost: core.Float64Ptr(cost)}},
+		})
+	}
+	return usage
+}
+
+func TestDetectAnomalies(t *testing.T) {
+	usage := []usagereportsv4.AccountUsage{
+		accountUsage("2022-03", map[string]float64{"cos/standard": 100, "kms/private": 10, "logs/lite": 1, "db/standard": 50}),
+		accountUsage("2022-01", map[string]float64{"cos/standard": 100, "kms/private": 10, "logs/lite": 0.5}),
+		accountUsage("2022-02", map[string]float64{"cos/standard": 200, "kms/private": 4, "logs/lite": 1}),
+	}
+
+	report, err := DetectAnomalies(usage, &AnomalyOptions{
+		ServiceThresholds: map[string]float64{"kms": 0.7},
+		MinimumCost:       5,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "acct", report.AccountID)
+	assert.Equal(t, []string{"2022-01", "2022-02", "2022-03"}, report.Months)
+	assert.True(t, report.HasAnomalies())
+	assert.Equal(t, []Anomaly{
+		{Kind: AnomalyKindIncreaseConst, ResourceID: "cos", PlanID: "standard", Month: "2022-02", PreviousMonth: "2022-01",
+			Cost: 200, PreviousCost: 100, Difference: 100, Ratio: 1, Threshold: DefaultAnomalyThreshold},
+		{Kind: AnomalyKindNewConst, ResourceID: "db", PlanID: "standard", Month: "2022-03", PreviousMonth: "2022-02",
+			Cost: 50, Difference: 50, Threshold: DefaultAnomalyThreshold},
+		{Kind: AnomalyKindIncreaseConst, ResourceID: "kms", PlanID: "private", Month: "2022-03", PreviousMonth: "2022-02",
+			Cost: 10, PreviousCost: 4, Difference: 6, Ratio: 1.5, Threshold: 0.7},
+	}, report.Anomalies)
+	// The kms decrease of 60% is below its threshold, and the logs costs are below the minimum.
+	assert.Len(t, report.MonthAnomalies("2022-02"), 1)
+	assert.Len(t, report.MonthAnomalies("2022-03"), 2)
+
+	// The threshold of a plan takes precedence over that of its service.
+	report, err = DetectAnomalies(usage, &AnomalyOptions{
+		ServiceThresholds: map[string]float64{"cos": 2},
+		PlanThresholds:    map[string]float64{"standard": 0.4},
+		MinimumCost:       5,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []Anomaly{
+		{Kind: AnomalyKindDecreaseConst, ResourceID: "cos", PlanID: "standard", Month: "2022-03", PreviousMonth: "2022-02",
+			Cost: 100, PreviousCost: 200, Difference: -100, Ratio: -0.5, Threshold: 0.4},
+		{Kind: AnomalyKindNewConst, ResourceID: "db", PlanID: "standard", Month: "2022-03", PreviousMonth: "2022-02",
+			Cost: 50, Difference: 50, Threshold: 0.4},
+	}, report.MonthAnomalies("2022-03")[:2])
+}
+
+func TestDetectAnomaliesErrors(t *testing.T) {
+	_, err := DetectAnomalies([]usagereportsv4.AccountUsage{
+		accountUsage("2022-01", nil),
+		accountUsage("2022-01", nil),
+	}, nil)
+	assert.NotNil(t, err)
+
+	other := accountUsage("2022-02", nil)
+	other.AccountID = core.StringPtr("other")
+	_, err = DetectAnomalies([]usagereportsv4.AccountUsage{accountUsage("2022-01", nil), other}, nil)
+	assert.NotNil(t, err)
+
+	report, err := DetectAnomalies(nil, nil)
+	assert.Nil(t, err)
+	assert.False(t, report.HasAnomalies())
+}
@@ -0,0 +1,201 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package usagereportsv4
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// UsageReportsV4API : The operations of the UsageReportsV4 service, implemented by *UsageReportsV4.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type UsageReportsV4API interface {
+	// Clone makes a copy of "usageReports" suitable for processing requests.
+	Clone() *UsageReportsV4
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// GetAccountSummary : Get account summary
+	GetAccountSummary(getAccountSummaryOptions *GetAccountSummaryOptions) (result *AccountSummary, response *core.DetailedResponse, err error)
+
+	// GetAccountSummaryWithContext is an alternate form of the GetAccountSummary method which supports a Context
+	// parameter
+	GetAccountSummaryWithContext(ctx context.Context, getAccountSummaryOptions *GetAccountSummaryOptions) (result *AccountSummary, response *core.DetailedResponse, err error)
+
+	// GetAccountUsage : Get account usage
+	GetAccountUsage(getAccountUsageOptions *GetAccountUsageOptions) (result *AccountUsage, response *core.DetailedResponse, err error)
+
+	// GetAccountUsageWithContext is an alternate form of the GetAccountUsage method which supports a Context parameter
+	GetAccountUsageWithContext(ctx context.Context, getAccountUsageOptions *GetAccountUsageOptions) (result *AccountUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceGroupUsage : Get resource group usage
+	GetResourceGroupUsage(getResourceGroupUsageOptions *GetResourceGroupUsageOptions) (result *ResourceGroupUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceGroupUsageWithContext is an alternate form of the GetResourceGroupUsage method which supports a
+	// Context parameter
+	GetResourceGroupUsageWithContext(ctx context.Context, getResourceGroupUsageOptions *GetResourceGroupUsageOptions) (result *ResourceGroupUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceUsageAccount : Get resource instance usage in an account
+	GetResourceUsageAccount(getResourceUsageAccountOptions *GetResourceUsageAccountOptions) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceUsageAccountWithContext is an alternate form of the GetResourceUsageAccount method which supports a
+	// Context parameter
+	GetResourceUsageAccountWithContext(ctx context.Context, getResourceUsageAccountOptions *GetResourceUsageAccountOptions) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceUsageResourceGroup : Get resource instance usage in a resource group
+	GetResourceUsageResourceGroup(getResourceUsageResourceGroupOptions *GetResourceUsageResourceGroupOptions) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceUsageResourceGroupWithContext is an alternate form of the GetResourceUsageResourceGroup method which
+	// supports a Context parameter
+	GetResourceUsageResourceGroupWithContext(ctx context.Context, getResourceUsageResourceGroupOptions *GetResourceUsageResourceGroupOptions) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceUsageOrg : Get resource instance usage in an organization
+	GetResourceUsageOrg(getResourceUsageOrgOptions *GetResourceUsageOrgOptions) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceUsageOrgWithContext is an alternate form of the GetResourceUsageOrg method which supports a Context
+	// parameter
+	GetResourceUsageOrgWithContext(ctx context.Context, getResourceUsageOrgOptions *GetResourceUsageOrgOptions) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// GetOrgUsage : Get organization usage
+	GetOrgUsage(getOrgUsageOptions *GetOrgUsageOptions) (result *OrgUsage, response *core.DetailedResponse, err error)
+
+	// GetOrgUsageWithContext is an alternate form of the GetOrgUsage method which supports a Context parameter
+	GetOrgUsageWithContext(ctx context.Context, getOrgUsageOptions *GetOrgUsageOptions) (result *OrgUsage, response *core.DetailedResponse, err error)
+
+	// NewGetAccountSummaryOptions : Instantiate GetAccountSummaryOptions
+	NewGetAccountSummaryOptions(accountID string, billingmonth string) *GetAccountSummaryOptions
+
+	// NewGetAccountUsageOptions : Instantiate GetAccountUsageOptions
+	NewGetAccountUsageOptions(accountID string, billingmonth string) *GetAccountUsageOptions
+
+	// NewGetOrgUsageOptions : Instantiate GetOrgUsageOptions
+	NewGetOrgUsageOptions(accountID string, organizationID string, billingmonth string) *GetOrgUsageOptions
+
+	// NewGetResourceGroupUsageOptions : Instantiate GetResourceGroupUsageOptions
+	NewGetResourceGroupUsageOptions(accountID string, resourceGroupID string, billingmonth string) *GetResourceGroupUsageOptions
+
+	// NewGetResourceUsageAccountOptions : Instantiate GetResourceUsageAccountOptions
+	NewGetResourceUsageAccountOptions(accountID string, billingmonth string) *GetResourceUsageAccountOptions
+
+	// NewGetResourceUsageOrgOptions : Instantiate GetResourceUsageOrgOptions
+	NewGetResourceUsageOrgOptions(accountID string, organizationID string, billingmonth string) *GetResourceUsageOrgOptions
+
+	// NewGetResourceUsageResourceGroupOptions : Instantiate GetResourceUsageResourceGroupOptions
+	NewGetResourceUsageResourceGroupOptions(accountID string, resourceGroupID string, billingmonth string) *GetResourceUsageResourceGroupOptions
+
+	// ExportAccountUsage retrieves the usage of an account for a month and writes it to "w" in the specified format
+	// (common.ExportFormatCSV, common.ExportFormatNDJSON or common.ExportFormatParquet), flattened into the rows
+	// described by AccountUsageExportColumns.
+	ExportAccountUsage(ctx context.Context, getAccountUsageOptions *GetAccountUsageOptions, w io.Writer, format string) (err error)
+
+	// ForecastMonthEnd retrieves the billable cost of a scope for the current month and for the previous
+	// ForecastHistoryMonths months, and projects the cost at the end of the current month with DefaultForecaster.
+	ForecastMonthEnd(ctx context.Context, scope ForecastScope) (forecast *CostForecast, err error)
+
+	// ForecastMonthEndWith is an alternate form of ForecastMonthEnd that projects the cost with "forecaster".
+	ForecastMonthEndWith(ctx context.Context, scope ForecastScope, forecaster Forecaster) (forecast *CostForecast, err error)
+
+	// NewGetAccountSummaryOptionsWith returns GetAccountSummaryOptions populated by applying "options" in order.
+	NewGetAccountSummaryOptionsWith(options ...GetAccountSummaryOption) *GetAccountSummaryOptions
+
+	// GetAccountSummaryWith is an alternate form of the GetAccountSummaryWithContext method which takes functional
+	// options instead of a GetAccountSummaryOptions struct.
+	GetAccountSummaryWith(ctx context.Context, options ...GetAccountSummaryOption) (result *AccountSummary, response *core.DetailedResponse, err error)
+
+	// NewGetAccountUsageOptionsWith returns GetAccountUsageOptions populated by applying "options" in order.
+	NewGetAccountUsageOptionsWith(options ...GetAccountUsageOption) *GetAccountUsageOptions
+
+	// GetAccountUsageWith is an alternate form of the GetAccountUsageWithContext method which takes functional options
+	// instead of a GetAccountUsageOptions struct.
+	GetAccountUsageWith(ctx context.Context, options ...GetAccountUsageOption) (result *AccountUsage, response *core.DetailedResponse, err error)
+
+	// NewGetResourceGroupUsageOptionsWith returns GetResourceGroupUsageOptions populated by applying "options" in
+	// order.
+	NewGetResourceGroupUsageOptionsWith(options ...GetResourceGroupUsageOption) *GetResourceGroupUsageOptions
+
+	// GetResourceGroupUsageWith is an alternate form of the GetResourceGroupUsageWithContext method which takes
+	// functional options instead of a GetResourceGroupUsageOptions struct.
+	GetResourceGroupUsageWith(ctx context.Context, options ...GetResourceGroupUsageOption) (result *ResourceGroupUsage, response *core.DetailedResponse, err error)
+
+	// NewGetResourceUsageAccountOptionsWith returns GetResourceUsageAccountOptions populated by applying "options" in
+	// order.
+	NewGetResourceUsageAccountOptionsWith(options ...GetResourceUsageAccountOption) *GetResourceUsageAccountOptions
+
+	// GetResourceUsageAccountWith is an alternate form of the GetResourceUsageAccountWithContext method which takes
+	// functional options instead of a GetResourceUsageAccountOptions struct.
+	GetResourceUsageAccountWith(ctx context.Context, options ...GetResourceUsageAccountOption) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// NewGetResourceUsageResourceGroupOptionsWith returns GetResourceUsageResourceGroupOptions populated by applying
+	// "options" in order.
+	NewGetResourceUsageResourceGroupOptionsWith(options ...GetResourceUsageResourceGroupOption) *GetResourceUsageResourceGroupOptions
+
+	// GetResourceUsageResourceGroupWith is an alternate form of the GetResourceUsageResourceGroupWithContext method
+	// which takes functional options instead of a GetResourceUsageResourceGroupOptions struct.
+	GetResourceUsageResourceGroupWith(ctx context.Context, options ...GetResourceUsageResourceGroupOption) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// NewGetResourceUsageOrgOptionsWith returns GetResourceUsageOrgOptions populated by applying "options" in order.
+	NewGetResourceUsageOrgOptionsWith(options ...GetResourceUsageOrgOption) *GetResourceUsageOrgOptions
+
+	// GetResourceUsageOrgWith is an alternate form of the GetResourceUsageOrgWithContext method which takes functional
+	// options instead of a GetResourceUsageOrgOptions struct.
+	GetResourceUsageOrgWith(ctx context.Context, options ...GetResourceUsageOrgOption) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// NewGetOrgUsageOptionsWith returns GetOrgUsageOptions populated by applying "options" in order.
+	NewGetOrgUsageOptionsWith(options ...GetOrgUsageOption) *GetOrgUsageOptions
+
+	// GetOrgUsageWith is an alternate form of the GetOrgUsageWithContext method which takes functional options instead
+	// of a GetOrgUsageOptions struct.
+	GetOrgUsageWith(ctx context.Context, options ...GetOrgUsageOption) (result *OrgUsage, response *core.DetailedResponse, err error)
+
+	// GetAccountUsageRange retrieves the usage of an account for each billing month from "fromMonth" through "toMonth"
+	// (inclusive, in "yyyy-mm" format) and returns it as a series.
+	GetAccountUsageRange(ctx context.Context, accountID string, fromMonth string, toMonth string) (series *AccountUsageSeries, err error)
+
+	// StreamSnapshotRecords downloads the billing snapshot objects at "urls", which are presigned Cloud Object Storage
+	// URLs, in order and passes each JSON-lines record to "callback".
+	StreamSnapshotRecords(ctx context.Context, urls []string, callback SnapshotRecordCallback) (err error)
+}
+
+var _ UsageReportsV4API = (*UsageReportsV4)(nil)
@@ -0,0 +1,99 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// AccountUsageExportColumns are the columns written by ExportAccountUsage, in order.
+// Each row describes the usage of one metric of one plan of one resource. A plan without usage is written as a
+// single row with empty metric columns. "billable" and "non_chargeable" are "true" or "false".
+var AccountUsageExportColumns = []common.Column{
+	{Name: "account_id", Type: common.ColumnTypeString},
+	{Name: "month", Type: common.ColumnTypeString},
+	{Name: "pricing_country", Type: common.ColumnTypeString},
+	{Name: "currency_code", Type: common.ColumnTypeString},
+	{Name: "resource_id", Type: common.ColumnTypeString},
+	{Name: "resource_name", Type: common.ColumnTypeString},
+	{Name: "plan_id", Type: common.ColumnTypeString},
+	{Name: "plan_name", Type: common.ColumnTypeString},
+	{Name: "pricing_region", Type: common.ColumnTypeString},
+	{Name: "billable", Type: common.ColumnTypeString},
+	{Name: "metric", Type: common.ColumnTypeString},
+	{Name: "metric_name", Type: common.ColumnTypeString},
+	{Name: "unit", Type: common.ColumnTypeString},
+	{Name: "unit_name", Type: common.ColumnTypeString},
+	{Name: "quantity", Type: common.ColumnTypeDouble},
+	{Name: "rateable_quantity", Type: common.ColumnTypeDouble},
+	{Name: "cost", Type: common.ColumnTypeDouble},
+	{Name: "rated_cost", Type: common.ColumnTypeDouble},
+	{Name: "non_chargeable", Type: common.ColumnTypeString},
+}
+
+// ExportAccountUsage retrieves the usage of an account for a month and writes it to "w" in the specified format
+// (common.ExportFormatCSV, common.ExportFormatNDJSON or common.ExportFormatParquet), flattened into the rows
+// described by AccountUsageExportColumns.
+func (usageReports *UsageReportsV4) ExportAccountUsage(ctx context.Context, getAccountUsageOptions *GetAccountUsageOptions, w io.Writer, format string) (err error) {
+	tableWriter, err := common.NewTableWriter(w, format, AccountUsageExportColumns)
+	if err != nil {
+		return
+	}
+
+	accountUsage, _, err := usageReports.GetAccountUsageWithContext(ctx, getAccountUsageOptions)
+	if err != nil {
+		return
+	}
+
+	for _, resource := range accountUsage.Resources {
+		for _, plan := range resource.Plans {
+			prefix := []interface{}{accountUsage.AccountID, accountUsage.Month, accountUsage.PricingCountry,
+				accountUsage.CurrencyCode, resource.ResourceID, resource.ResourceName, plan.PlanID, plan.PlanName,
+				plan.PricingRegion, formatBool(plan.Billable)}
+			if len(plan.Usage) == 0 {
+				err = tableWriter.WriteRow(append(prefix, nil, nil, nil, nil, nil, nil, nil, nil, nil)...)
+				if err != nil {
+					return
+				}
+				continue
+			}
+			for _, metric := range plan.Usage {
+				row := append(append([]interface{}(nil), prefix...), metric.Metric, metric.MetricName, metric.Unit,
+					metric.UnitName, metric.Quantity, metric.RateableQuantity, metric.Cost, metric.RatedCost,
+					formatBool(metric.NonChargeable))
+				err = tableWriter.WriteRow(row...)
+				if err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	return tableWriter.Close()
+}
+
+// formatBool returns the string form of a boolean property, or nil if it is not set.
+func formatBool(value *bool) interface{} {
+	if value == nil {
+		return nil
+	}
+	return strconv.FormatBool(*value)
+}
@@ -0,0 +1,88 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UsageReportsV4 ExportAccountUsage`, func() {
+	var testServer *httptest.Server
+	var usageReportsService *usagereportsv4.UsageReportsV4
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v4/accounts/acct/usage/2022-05"))
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"account_id": "acct", "pricing_country": "USA", "currency_code": "USD", "month": "2022-05",
+				"resources": [{"resource_id": "cloudantnosqldb", "resource_name": "Cloudant", "billable_cost": 12.5,
+					"billable_rated_cost": 12.5, "non_billable_cost": 0, "non_billable_rated_cost": 0, "discounts": [],
+					"plans": [
+						{"plan_id": "standard", "plan_name": "Standard", "pricing_region": "Global", "billable": true,
+							"cost": 12.5, "rated_cost": 12.5, "discounts": [], "usage": [
+							{"metric": "STORAGE", "metric_name": "Storage", "unit": "GIGABYTE_HOURS", "quantity": 100,
+								"rateable_quantity": 80, "cost": 10, "rated_cost": 10, "non_chargeable": false, "discounts": []},
+							{"metric": "READS", "quantity": 2000, "cost": 2.5, "rated_cost": 2.5, "discounts": []}]},
+						{"plan_id": "lite", "billable": false, "cost": 0, "rated_cost": 0, "discounts": [], "usage": []}]}]}`)
+		}))
+		var serviceErr error
+		usageReportsService, serviceErr = usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Flattens account usage into CSV rows`, func() {
+		var buffer bytes.Buffer
+		options := usageReportsService.NewGetAccountUsageOptions("acct", "2022-05")
+		err := usageReportsService.ExportAccountUsage(context.Background(), options, &buffer, common.ExportFormatCSV)
+		Expect(err).To(BeNil())
+		Expect(buffer.String()).To(Equal(
+			"account_id,month,pricing_country,currency_code,resource_id,resource_name,plan_id,plan_name,pricing_region,billable," +
+				"metric,metric_name,unit,unit_name,quantity,rateable_quantity,cost,rated_cost,non_chargeable\n" +
+				"acct,2022-05,USA,USD,cloudantnosqldb,Cloudant,standard,Standard,Global,true,STORAGE,Storage,GIGABYTE_HOURS,,100,80,10,10,false\n" +
+				"acct,2022-05,USA,USD,cloudantnosqldb,Cloudant,standard,Standard,Global,true,READS,,,,2000,,2.5,2.5,\n" +
+				"acct,2022-05,USA,USD,cloudantnosqldb,Cloudant,lite,,,false,,,,,,,,,\n"))
+	})
+	It(`Writes Parquet files`, func() {
+		var buffer bytes.Buffer
+		options := usageReportsService.NewGetAccountUsageOptions("acct", "2022-05")
+		err := usageReportsService.ExportAccountUsage(context.Background(), options, &buffer, common.ExportFormatParquet)
+		Expect(err).To(BeNil())
+		Expect(buffer.Bytes()[:4]).To(Equal([]byte("PAR1")))
+		Expect(buffer.Bytes()[buffer.Len()-4:]).To(Equal([]byte("PAR1")))
+	})
+	It(`Rejects unsupported formats`, func() {
+		options := usageReportsService.NewGetAccountUsageOptions("acct", "2022-05")
+		Expect(usageReportsService.ExportAccountUsage(context.Background(), options, &bytes.Buffer{}, "xlsx")).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,330 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the ForecastScope.Type property.
+const (
+	ForecastScopeAccountConst       = "account"
+	ForecastScopeResourceGroupConst = "resource_group"
+	ForecastScopeOrganizationConst  = "organization"
+)
+
+// Defaults of LinearSeasonalForecaster.
+const (
+	DefaultForecastConfidence  = 0.8
+	DefaultForecastUncertainty = 0.25
+)
+
+// ForecastHistoryMonths is the number of complete months before the current one whose cost ForecastMonthEnd passes to
+// the forecaster. Twelve months or more allow LinearSeasonalForecaster to apply a seasonal adjustment.
+var ForecastHistoryMonths = 12
+
+// DefaultForecaster is the forecaster used by ForecastMonthEnd.
+var DefaultForecaster Forecaster = &LinearSeasonalForecaster{}
+
+// Forecaster : Projects the cost of the current billing month. Implementations can replace DefaultForecaster, or be
+// passed to ForecastMonthEndWith, to plug a cost model into the forecast helpers.
+type Forecaster interface {
+	Forecast(input *ForecastInput) (projection CostProjection, err error)
+}
+
+// ForecastInput : The costs from which a Forecaster projects the cost of the current month.
+type ForecastInput struct {
+	// The current billing month, in "yyyy-mm" format.
+	Month string
+
+	// The billable cost of the current month so far.
+	MonthToDateCost float64
+
+	// The fraction of the current month that has elapsed, between 0 and 1.
+	ElapsedFraction float64
+
+	// The billable cost of the complete months before the current one, oldest first. It may be empty, for example for
+	// a new account.
+	History []MonthlyCost
+}
+
+// MonthlyCost : The billable cost of a billing month.
+type MonthlyCost struct {
+	// The month, in "yyyy-mm" format.
+	Month string
+
+	// The billable cost.
+	Cost float64
+}
+
+// CostProjection : The projected cost of a month, with the bounds of its confidence interval.
+type CostProjection struct {
+	// The projected cost at the end of the month.
+	Projected float64
+
+	// The bounds of the confidence interval of the projection. Lower is never below the month-to-date cost.
+	Lower float64
+	Upper float64
+
+	// The probability that the cost at the end of the month is within the bounds, for example 0.8.
+	Confidence float64
+}
+
+// ForecastScope : The usage whose cost is forecast: the usage of an account, or of a resource group or organization
+// of an account.
+type ForecastScope struct {
+	// The type of the scope (a ForecastScope*Const value).
+	Type string
+
+	// The ID of the account.
+	AccountID string
+
+	// The ID of the resource group or organization. It is empty for an account.
+	ID string
+}
+
+// CostForecast : The forecast of the cost of a scope at the end of the current month, as returned by
+// ForecastMonthEnd.
+type CostForecast struct {
+	CostProjection
+
+	// The scope of the forecast.
+	Scope ForecastScope
+
+	// The current billing month, in "yyyy-mm" format.
+	Month string
+
+	// The billable cost of the current month so far.
+	MonthToDateCost float64
+
+	// The fraction of the current month that had elapsed at the time of the forecast.
+	ElapsedFraction float64
+
+	// The cost of the months on which the forecast is based, oldest first.
+	History []MonthlyCost
+
+	// The time of the forecast.
+	ForecastAt time.Time
+}
+
+// LinearSeasonalForecaster : The default Forecaster. It blends two estimates of the cost of the month: the run rate of
+// the month-to-date cost, and the linear trend of the cost of the previous months, adjusted by the deviation of the
+// same month of the previous year from the trend when at least twelve months of history are available. The run rate is
+// weighted by the elapsed fraction of the month, so that it dominates as the month progresses. The confidence interval
+// is derived from the deviation of the history from its trend, and narrows as the month progresses.
+type LinearSeasonalForecaster struct {
+	// The probability that the cost is within the bounds of the projection. Defaults to DefaultForecastConfidence.
+	Confidence float64
+
+	// The standard deviation of the cost, relative to the projection, that is assumed when fewer than three months of
+	// history are available. Defaults to DefaultForecastUncertainty.
+	Uncertainty float64
+}
+
+// Forecast projects the cost of the current month.
+func (forecaster *LinearSeasonalForecaster) Forecast(input *ForecastInput) (projection CostProjection, err error) {
+	if input.ElapsedFraction < 0 || input.ElapsedFraction > 1 {
+		err = fmt.Errorf("invalid elapsed fraction %g", input.ElapsedFraction)
+		return
+	}
+	projection.Confidence = forecaster.Confidence
+	if projection.Confidence <= 0 || projection.Confidence >= 1 {
+		projection.Confidence = DefaultForecastConfidence
+	}
+	uncertainty := forecaster.Uncertainty
+	if uncertainty <= 0 {
+		uncertainty = DefaultForecastUncertainty
+	}
+
+	costs := make([]float64, len(input.History))
+	for i, month := range input.History {
+		costs[i] = month.Cost
+	}
+	weight := input.ElapsedFraction
+	runRate := input.MonthToDateCost
+	if weight > 0 {
+		runRate = input.MonthToDateCost / weight
+	}
+
+	// Without history, the run rate is the only estimate.
+	projection.Projected = runRate
+	sigma := uncertainty * runRate
+	if len(costs) > 0 {
+		intercept, slope := linearTrend(costs)
+		trend := func(i int) float64 {
+			return intercept + slope*float64(i)
+		}
+		historical := trend(len(costs))
+		if len(costs) >= 12 {
+			if seasonal := trend(len(costs) - 12); seasonal > 0 {
+				historical *= costs[len(costs)-12] / seasonal
+			}
+		}
+		historical = math.Max(historical, 0)
+		projection.Projected = weight*runRate + (1-weight)*historical
+
+		if len(costs) >= 3 {
+			sumSquares := 0.0
+			for i, cost := range costs {
+				sumSquares += (cost - trend(i)) * (cost - trend(i))
+			}
+			sigma = math.Sqrt(sumSquares / float64(len(costs)-2))
+		} else {
+			sigma = uncertainty * projection.Projected
+		}
+	}
+	projection.Projected = math.Max(projection.Projected, input.MonthToDateCost)
+
+	// Only the cost of the rest of the month is uncertain.
+	halfWidth := math.Sqrt2 * math.Erfinv(projection.Confidence) * sigma * (1 - weight)
+	projection.Lower = math.Max(projection.Projected-halfWidth, input.MonthToDateCost)
+	projection.Upper = projection.Projected + halfWidth
+	return
+}
+
+// linearTrend returns the least-squares line through the costs, indexed from 0.
+func linearTrend(costs []float64) (intercept float64, slope float64) {
+	if len(costs) < 2 {
+		return costs[0], 0
+	}
+	n := float64(len(costs))
+	var sumX, sumY, sumXY, sumXX float64
+	for i, cost := range costs {
+		x := float64(i)
+		sumX += x
+		sumY += cost
+		sumXY += x * cost
+		sumXX += x * x
+	}
+	slope = (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+	intercept = (sumY - slope*sumX) / n
+	return
+}
+
+// AccountForecastScope returns the scope of the usage of an account.
+func AccountForecastScope(accountID string) ForecastScope {
+	return ForecastScope{Type: ForecastScopeAccountConst, AccountID: accountID}
+}
+
+// ResourceGroupForecastScope returns the scope of the usage of a resource group of an account.
+func ResourceGroupForecastScope(accountID string, resourceGroupID string) ForecastScope {
+	return ForecastScope{Type: ForecastScopeResourceGroupConst, AccountID: accountID, ID: resourceGroupID}
+}
+
+// OrganizationForecastScope returns the scope of the usage of a Cloud Foundry organization of an account.
+func OrganizationForecastScope(accountID string, organizationID string) ForecastScope {
+	return ForecastScope{Type: ForecastScopeOrganizationConst, AccountID: accountID, ID: organizationID}
+}
+
+// ForecastMonthEnd retrieves the billable cost of a scope for the current month and for the previous
+// ForecastHistoryMonths months, and projects the cost at the end of the current month with DefaultForecaster.
+func (usageReports *UsageReportsV4) ForecastMonthEnd(ctx context.Context, scope ForecastScope) (forecast *CostForecast, err error) {
+	return usageReports.ForecastMonthEndWith(ctx, scope, DefaultForecaster)
+}
+
+// ForecastMonthEndWith is an alternate form of ForecastMonthEnd that projects the cost with "forecaster".
+func (usageReports *UsageReportsV4) ForecastMonthEndWith(ctx context.Context, scope ForecastScope, forecaster Forecaster) (forecast *CostForecast, err error) {
+	switch scope.Type {
+	case ForecastScopeAccountConst, ForecastScopeResourceGroupConst, ForecastScopeOrganizationConst:
+	default:
+		err = fmt.Errorf("unsupported forecast scope type '%s'", scope.Type)
+		return
+	}
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, 0)
+	input := &ForecastInput{
+		Month:           monthStart.Format("2006-01"),
+		ElapsedFraction: float64(now.Sub(monthStart)) / float64(monthEnd.Sub(monthStart)),
+	}
+
+	limiter := common.NewRateLimiter(1/DefaultUsageRangeRequestInterval.Seconds(), 1)
+	input.MonthToDateCost, err = usageReports.scopeMonthCost(ctx, limiter, scope, input.Month)
+	if err != nil {
+		return
+	}
+	for i := ForecastHistoryMonths; i > 0; i-- {
+		month := monthStart.AddDate(0, -i, 0).Format("2006-01")
+		var cost float64
+		cost, err = usageReports.scopeMonthCost(ctx, limiter, scope, month)
+		if err != nil {
+			return
+		}
+		input.History = append(input.History, MonthlyCost{Month: month, Cost: cost})
+	}
+
+	projection, err := forecaster.Forecast(input)
+	if err != nil {
+		err = fmt.Errorf("error forecasting the cost of month '%s': %w", input.Month, err)
+		return
+	}
+	forecast = &CostForecast{
+		CostProjection:  projection,
+		Scope:           scope,
+		Month:           input.Month,
+		MonthToDateCost: input.MonthToDateCost,
+		ElapsedFraction: input.ElapsedFraction,
+		History:         input.History,
+		ForecastAt:      now,
+	}
+	return
+}
+
+// scopeMonthCost returns the billable cost of a scope for a month.
+func (usageReports *UsageReportsV4) scopeMonthCost(ctx context.Context, limiter common.Limiter, scope ForecastScope, month string) (cost float64, err error) {
+	err = limiter.Wait(ctx)
+	if err != nil {
+		return
+	}
+	var resources []Resource
+	switch scope.Type {
+	case ForecastScopeAccountConst:
+		var usage *AccountUsage
+		usage, _, err = usageReports.GetAccountUsageWithContext(ctx, usageReports.NewGetAccountUsageOptions(scope.AccountID, month))
+		if usage != nil {
+			resources = usage.Resources
+		}
+	case ForecastScopeResourceGroupConst:
+		var usage *ResourceGroupUsage
+		usage, _, err = usageReports.GetResourceGroupUsageWithContext(ctx, usageReports.NewGetResourceGroupUsageOptions(scope.AccountID, scope.ID, month))
+		if usage != nil {
+			resources = usage.Resources
+		}
+	case ForecastScopeOrganizationConst:
+		var usage *OrgUsage
+		usage, _, err = usageReports.GetOrgUsageWithContext(ctx, usageReports.NewGetOrgUsageOptions(scope.AccountID, scope.ID, month))
+		if usage != nil {
+			resources = usage.Resources
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("error retrieving the usage of month '%s': %w", month, err)
+		return
+	}
+	for _, resource := range resources {
+		if resource.BillableCost != nil {
+			cost += *resource.BillableCost
+		}
+	}
+	return
+}
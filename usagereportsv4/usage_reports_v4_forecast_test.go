@@ -0,0 +1,141 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UsageReportsV4 cost forecast`, func() {
+	history := func(costs ...float64) (months []usagereportsv4.MonthlyCost) {
+		for i, cost := range costs {
+			months = append(months, usagereportsv4.MonthlyCost{Month: fmt.Sprintf("m%d", i), Cost: cost})
+		}
+		return
+	}
+	forecaster := &usagereportsv4.LinearSeasonalForecaster{}
+
+	It(`Blends the run rate with the trend of the history`, func() {
+		projection, err := forecaster.Forecast(&usagereportsv4.ForecastInput{
+			MonthToDateCost: 70,
+			ElapsedFraction: 0.5,
+			History:         history(100, 110, 120),
+		})
+		Expect(err).To(BeNil())
+		Expect(projection.Projected).To(BeNumerically("~", 135, 1e-9))
+		Expect(projection.Lower).To(BeNumerically("~", 135, 1e-9))
+		Expect(projection.Upper).To(BeNumerically("~", 135, 1e-9))
+		Expect(projection.Confidence).To(Equal(usagereportsv4.DefaultForecastConfidence))
+	})
+	It(`Widens the bounds with the deviation of the history and narrows them as the month progresses`, func() {
+		input := &usagereportsv4.ForecastInput{MonthToDateCost: 25, ElapsedFraction: 0.25, History: history(80, 120, 90, 110)}
+		early, err := forecaster.Forecast(input)
+		Expect(err).To(BeNil())
+		Expect(early.Lower).To(BeNumerically("<", early.Projected))
+		Expect(early.Upper).To(BeNumerically(">", early.Projected))
+		Expect(early.Lower).To(BeNumerically(">=", 25))
+
+		input.MonthToDateCost, input.ElapsedFraction = 75, 0.75
+		late, err := forecaster.Forecast(input)
+		Expect(err).To(BeNil())
+		Expect(late.Upper - late.Lower).To(BeNumerically("<", early.Upper-early.Lower))
+	})
+	It(`Applies the seasonal deviation of the same month of the previous year`, func() {
+		flat := history(100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100)
+		seasonal := history(200, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100)
+		input := &usagereportsv4.ForecastInput{ElapsedFraction: 0, History: flat}
+		flatProjection, err := forecaster.Forecast(input)
+		Expect(err).To(BeNil())
+		Expect(flatProjection.Projected).To(BeNumerically("~", 100, 1e-9))
+
+		input.History = seasonal
+		seasonalProjection, err := forecaster.Forecast(input)
+		Expect(err).To(BeNil())
+		Expect(seasonalProjection.Projected).To(BeNumerically(">", flatProjection.Projected+20))
+	})
+	It(`Uses the run rate without history`, func() {
+		projection, err := forecaster.Forecast(&usagereportsv4.ForecastInput{MonthToDateCost: 30, ElapsedFraction: 0.5})
+		Expect(err).To(BeNil())
+		Expect(projection.Projected).To(BeNumerically("~", 60, 1e-9))
+		Expect(projection.Upper).To(BeNumerically(">", 60))
+		_, err = forecaster.Forecast(&usagereportsv4.ForecastInput{ElapsedFraction: 2})
+		Expect(err).ToNot(BeNil())
+	})
+
+	Describe(`ForecastMonthEnd`, func() {
+		var testServer *httptest.Server
+		var usageReportsService *usagereportsv4.UsageReportsV4
+		var requested []string
+		currentMonth := time.Now().UTC().Format("2006-01")
+		BeforeEach(func() {
+			requested = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.Path).To(HavePrefix("/v4/accounts/acct/resource_groups/rg/usage/"))
+				month := strings.TrimPrefix(req.URL.Path, "/v4/accounts/acct/resource_groups/rg/usage/")
+				requested = append(requested, month)
+				cost := 100
+				if month == currentMonth {
+					cost = 10
+				}
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"account_id": "acct", "resource_group_id": "rg", "pricing_country": "USA", "currency_code": "USD",
+					"month": "%s", "resources": [{"resource_id": "kms", "billable_cost": %d, "billable_rated_cost": 0,
+					"non_billable_cost": 0, "non_billable_rated_cost": 0, "plans": [], "discounts": []}]}`, month, cost)
+			}))
+			var serviceErr error
+			usageReportsService, serviceErr = usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Forecasts the cost of a resource group from its current and past usage`, func() {
+			scope := usagereportsv4.ResourceGroupForecastScope("acct", "rg")
+			forecast, err := usageReportsService.ForecastMonthEnd(context.Background(), scope)
+			Expect(err).To(BeNil())
+			Expect(forecast.Scope).To(Equal(scope))
+			Expect(forecast.Month).To(Equal(currentMonth))
+			Expect(forecast.MonthToDateCost).To(Equal(10.0))
+			Expect(forecast.History).To(HaveLen(usagereportsv4.ForecastHistoryMonths))
+			Expect(forecast.History[0].Month).To(Equal(requested[1]))
+			Expect(forecast.History[11].Month).To(Equal(time.Date(time.Now().UTC().Year(), time.Now().UTC().Month()-1, 1, 0, 0, 0, 0, time.UTC).Format("2006-01")))
+			Expect(forecast.Lower).To(BeNumerically(">=", 10))
+			Expect(forecast.Upper).To(BeNumerically(">=", forecast.Projected))
+		})
+		It(`Rejects unknown scopes`, func() {
+			_, err := usageReportsService.ForecastMonthEnd(context.Background(), usagereportsv4.ForecastScope{Type: "planet"})
+			Expect(err).ToNot(BeNil())
+			Expect(requested).To(BeEmpty())
+		})
+	})
+})
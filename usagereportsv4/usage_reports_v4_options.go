@@ -0,0 +1,631 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package usagereportsv4
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GetAccountSummaryOption : A functional option that sets a property of GetAccountSummaryOptions (see GetAccountSummaryWith).
+type GetAccountSummaryOption func(options *GetAccountSummaryOptions)
+
+// GetAccountSummaryAccountID sets the AccountID property of GetAccountSummaryOptions. Account ID for which the
+// usage report is requested.
+func GetAccountSummaryAccountID(accountID string) GetAccountSummaryOption {
+	return func(options *GetAccountSummaryOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetAccountSummaryBillingmonth sets the Billingmonth property of GetAccountSummaryOptions. The billing month for
+// which the usage report is requested.
+func GetAccountSummaryBillingmonth(billingmonth string) GetAccountSummaryOption {
+	return func(options *GetAccountSummaryOptions) {
+		options.Billingmonth = core.StringPtr(billingmonth)
+	}
+}
+
+// GetAccountSummaryHeader sets the Headers property of GetAccountSummaryOptions. Allows users to set headers on
+// API requests.
+func GetAccountSummaryHeader(name string, value string) GetAccountSummaryOption {
+	return func(options *GetAccountSummaryOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAccountSummaryOptionsWith returns GetAccountSummaryOptions populated by applying "options" in order.
+func (*UsageReportsV4) NewGetAccountSummaryOptionsWith(options ...GetAccountSummaryOption) *GetAccountSummaryOptions {
+	getAccountSummaryOptions := &GetAccountSummaryOptions{}
+	for _, option := range options {
+		option(getAccountSummaryOptions)
+	}
+	return getAccountSummaryOptions
+}
+
+// GetAccountSummaryWith is an alternate form of the GetAccountSummaryWithContext method which takes functional
+// options instead of a GetAccountSummaryOptions struct. GetAccountSummaryAccountID and
+// GetAccountSummaryBillingmonth are required.
+func (usageReports *UsageReportsV4) GetAccountSummaryWith(ctx context.Context, options ...GetAccountSummaryOption) (result *AccountSummary, response *core.DetailedResponse, err error) {
+	return usageReports.GetAccountSummaryWithContext(ctx, usageReports.NewGetAccountSummaryOptionsWith(options...))
+}
+
+// GetAccountUsageOption : A functional option that sets a property of GetAccountUsageOptions (see GetAccountUsageWith).
+type GetAccountUsageOption func(options *GetAccountUsageOptions)
+
+// GetAccountUsageAccountID sets the AccountID property of GetAccountUsageOptions. Account ID for which the usage
+// report is requested.
+func GetAccountUsageAccountID(accountID string) GetAccountUsageOption {
+	return func(options *GetAccountUsageOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetAccountUsageBillingmonth sets the Billingmonth property of GetAccountUsageOptions. The billing month for
+// which the usage report is requested.
+func GetAccountUsageBillingmonth(billingmonth string) GetAccountUsageOption {
+	return func(options *GetAccountUsageOptions) {
+		options.Billingmonth = core.StringPtr(billingmonth)
+	}
+}
+
+// GetAccountUsageNames sets the Names property of GetAccountUsageOptions. Include the name of every resource,
+// plan, resource instance, organization, and resource group.
+func GetAccountUsageNames(names bool) GetAccountUsageOption {
+	return func(options *GetAccountUsageOptions) {
+		options.Names = core.BoolPtr(names)
+	}
+}
+
+// GetAccountUsageAcceptLanguage sets the AcceptLanguage property of GetAccountUsageOptions. Prioritize the names
+// returned in the order of the specified languages.
+func GetAccountUsageAcceptLanguage(acceptLanguage string) GetAccountUsageOption {
+	return func(options *GetAccountUsageOptions) {
+		options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	}
+}
+
+// GetAccountUsageHeader sets the Headers property of GetAccountUsageOptions. Allows users to set headers on API
+// requests.
+func GetAccountUsageHeader(name string, value string) GetAccountUsageOption {
+	return func(options *GetAccountUsageOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetAccountUsageOptionsWith returns GetAccountUsageOptions populated by applying "options" in order.
+func (*UsageReportsV4) NewGetAccountUsageOptionsWith(options ...GetAccountUsageOption) *GetAccountUsageOptions {
+	getAccountUsageOptions := &GetAccountUsageOptions{}
+	for _, option := range options {
+		option(getAccountUsageOptions)
+	}
+	return getAccountUsageOptions
+}
+
+// GetAccountUsageWith is an alternate form of the GetAccountUsageWithContext method which takes functional options
+// instead of a GetAccountUsageOptions struct. GetAccountUsageAccountID and GetAccountUsageBillingmonth are
+// required.
+func (usageReports *UsageReportsV4) GetAccountUsageWith(ctx context.Context, options ...GetAccountUsageOption) (result *AccountUsage, response *core.DetailedResponse, err error) {
+	return usageReports.GetAccountUsageWithContext(ctx, usageReports.NewGetAccountUsageOptionsWith(options...))
+}
+
+// GetResourceGroupUsageOption : A functional option that sets a property of GetResourceGroupUsageOptions (see GetResourceGroupUsageWith).
+type GetResourceGroupUsageOption func(options *GetResourceGroupUsageOptions)
+
+// GetResourceGroupUsageAccountID sets the AccountID property of GetResourceGroupUsageOptions. Account ID for which
+// the usage report is requested.
+func GetResourceGroupUsageAccountID(accountID string) GetResourceGroupUsageOption {
+	return func(options *GetResourceGroupUsageOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetResourceGroupUsageResourceGroupID sets the ResourceGroupID property of GetResourceGroupUsageOptions. Resource
+// group for which the usage report is requested.
+func GetResourceGroupUsageResourceGroupID(resourceGroupID string) GetResourceGroupUsageOption {
+	return func(options *GetResourceGroupUsageOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// GetResourceGroupUsageBillingmonth sets the Billingmonth property of GetResourceGroupUsageOptions. The billing
+// month for which the usage report is requested.
+func GetResourceGroupUsageBillingmonth(billingmonth string) GetResourceGroupUsageOption {
+	return func(options *GetResourceGroupUsageOptions) {
+		options.Billingmonth = core.StringPtr(billingmonth)
+	}
+}
+
+// GetResourceGroupUsageNames sets the Names property of GetResourceGroupUsageOptions. Include the name of every
+// resource, plan, resource instance, organization, and resource group.
+func GetResourceGroupUsageNames(names bool) GetResourceGroupUsageOption {
+	return func(options *GetResourceGroupUsageOptions) {
+		options.Names = core.BoolPtr(names)
+	}
+}
+
+// GetResourceGroupUsageAcceptLanguage sets the AcceptLanguage property of GetResourceGroupUsageOptions. Prioritize
+// the names returned in the order of the specified languages.
+func GetResourceGroupUsageAcceptLanguage(acceptLanguage string) GetResourceGroupUsageOption {
+	return func(options *GetResourceGroupUsageOptions) {
+		options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	}
+}
+
+// GetResourceGroupUsageHeader sets the Headers property of GetResourceGroupUsageOptions. Allows users to set
+// headers on API requests.
+func GetResourceGroupUsageHeader(name string, value string) GetResourceGroupUsageOption {
+	return func(options *GetResourceGroupUsageOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetResourceGroupUsageOptionsWith returns GetResourceGroupUsageOptions populated by applying "options" in order.
+func (*UsageReportsV4) NewGetResourceGroupUsageOptionsWith(options ...GetResourceGroupUsageOption) *GetResourceGroupUsageOptions {
+	getResourceGroupUsageOptions := &GetResourceGroupUsageOptions{}
+	for _, option := range options {
+		option(getResourceGroupUsageOptions)
+	}
+	return getResourceGroupUsageOptions
+}
+
+// GetResourceGroupUsageWith is an alternate form of the GetResourceGroupUsageWithContext method which takes
+// functional options instead of a GetResourceGroupUsageOptions struct. GetResourceGroupUsageAccountID,
+// GetResourceGroupUsageResourceGroupID and GetResourceGroupUsageBillingmonth are required.
+func (usageReports *UsageReportsV4) GetResourceGroupUsageWith(ctx context.Context, options ...GetResourceGroupUsageOption) (result *ResourceGroupUsage, response *core.DetailedResponse, err error) {
+	return usageReports.GetResourceGroupUsageWithContext(ctx, usageReports.NewGetResourceGroupUsageOptionsWith(options...))
+}
+
+// GetResourceUsageAccountOption : A functional option that sets a property of GetResourceUsageAccountOptions (see GetResourceUsageAccountWith).
+type GetResourceUsageAccountOption func(options *GetResourceUsageAccountOptions)
+
+// GetResourceUsageAccountAccountID sets the AccountID property of GetResourceUsageAccountOptions. Account ID for
+// which the usage report is requested.
+func GetResourceUsageAccountAccountID(accountID string) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetResourceUsageAccountBillingmonth sets the Billingmonth property of GetResourceUsageAccountOptions. The
+// billing month for which the usage report is requested.
+func GetResourceUsageAccountBillingmonth(billingmonth string) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.Billingmonth = core.StringPtr(billingmonth)
+	}
+}
+
+// GetResourceUsageAccountNames sets the Names property of GetResourceUsageAccountOptions. Include the name of
+// every resource, plan, resource instance, organization, and resource group.
+func GetResourceUsageAccountNames(names bool) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.Names = core.BoolPtr(names)
+	}
+}
+
+// GetResourceUsageAccountAcceptLanguage sets the AcceptLanguage property of GetResourceUsageAccountOptions.
+// Prioritize the names returned in the order of the specified languages.
+func GetResourceUsageAccountAcceptLanguage(acceptLanguage string) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	}
+}
+
+// GetResourceUsageAccountLimit sets the Limit property of GetResourceUsageAccountOptions. Number of usage records
+// returned.
+func GetResourceUsageAccountLimit(limit int64) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// GetResourceUsageAccountStart sets the Start property of GetResourceUsageAccountOptions. The offset from which
+// the records must be fetched.
+func GetResourceUsageAccountStart(start string) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// GetResourceUsageAccountResourceGroupID sets the ResourceGroupID property of GetResourceUsageAccountOptions.
+// Filter by resource group.
+func GetResourceUsageAccountResourceGroupID(resourceGroupID string) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// GetResourceUsageAccountOrganizationID sets the OrganizationID property of GetResourceUsageAccountOptions. Filter
+// by organization_id.
+func GetResourceUsageAccountOrganizationID(organizationID string) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.OrganizationID = core.StringPtr(organizationID)
+	}
+}
+
+// GetResourceUsageAccountResourceInstanceID sets the ResourceInstanceID property of
+// GetResourceUsageAccountOptions. Filter by resource instance_id.
+func GetResourceUsageAccountResourceInstanceID(resourceInstanceID string) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.ResourceInstanceID = core.StringPtr(resourceInstanceID)
+	}
+}
+
+// GetResourceUsageAccountResourceID sets the ResourceID property of GetResourceUsageAccountOptions. Filter by
+// resource_id.
+func GetResourceUsageAccountResourceID(resourceID string) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.ResourceID = core.StringPtr(resourceID)
+	}
+}
+
+// GetResourceUsageAccountPlanID sets the PlanID property of GetResourceUsageAccountOptions. Filter by plan_id.
+func GetResourceUsageAccountPlanID(planID string) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.PlanID = core.StringPtr(planID)
+	}
+}
+
+// GetResourceUsageAccountRegion sets the Region property of GetResourceUsageAccountOptions. Region in which the
+// resource instance is provisioned.
+func GetResourceUsageAccountRegion(region string) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// GetResourceUsageAccountHeader sets the Headers property of GetResourceUsageAccountOptions. Allows users to set
+// headers on API requests.
+func GetResourceUsageAccountHeader(name string, value string) GetResourceUsageAccountOption {
+	return func(options *GetResourceUsageAccountOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetResourceUsageAccountOptionsWith returns GetResourceUsageAccountOptions populated by applying "options" in order.
+func (*UsageReportsV4) NewGetResourceUsageAccountOptionsWith(options ...GetResourceUsageAccountOption) *GetResourceUsageAccountOptions {
+	getResourceUsageAccountOptions := &GetResourceUsageAccountOptions{}
+	for _, option := range options {
+		option(getResourceUsageAccountOptions)
+	}
+	return getResourceUsageAccountOptions
+}
+
+// GetResourceUsageAccountWith is an alternate form of the GetResourceUsageAccountWithContext method which takes
+// functional options instead of a GetResourceUsageAccountOptions struct. GetResourceUsageAccountAccountID and
+// GetResourceUsageAccountBillingmonth are required.
+func (usageReports *UsageReportsV4) GetResourceUsageAccountWith(ctx context.Context, options ...GetResourceUsageAccountOption) (result *InstancesUsage, response *core.DetailedResponse, err error) {
+	return usageReports.GetResourceUsageAccountWithContext(ctx, usageReports.NewGetResourceUsageAccountOptionsWith(options...))
+}
+
+// GetResourceUsageResourceGroupOption : A functional option that sets a property of GetResourceUsageResourceGroupOptions (see GetResourceUsageResourceGroupWith).
+type GetResourceUsageResourceGroupOption func(options *GetResourceUsageResourceGroupOptions)
+
+// GetResourceUsageResourceGroupAccountID sets the AccountID property of GetResourceUsageResourceGroupOptions.
+// Account ID for which the usage report is requested.
+func GetResourceUsageResourceGroupAccountID(accountID string) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetResourceUsageResourceGroupResourceGroupID sets the ResourceGroupID property of
+// GetResourceUsageResourceGroupOptions. Resource group for which the usage report is requested.
+func GetResourceUsageResourceGroupResourceGroupID(resourceGroupID string) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		options.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+}
+
+// GetResourceUsageResourceGroupBillingmonth sets the Billingmonth property of
+// GetResourceUsageResourceGroupOptions. The billing month for which the usage report is requested.
+func GetResourceUsageResourceGroupBillingmonth(billingmonth string) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		options.Billingmonth = core.StringPtr(billingmonth)
+	}
+}
+
+// GetResourceUsageResourceGroupNames sets the Names property of GetResourceUsageResourceGroupOptions. Include the
+// name of every resource, plan, resource instance, organization, and resource group.
+func GetResourceUsageResourceGroupNames(names bool) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		options.Names = core.BoolPtr(names)
+	}
+}
+
+// GetResourceUsageResourceGroupAcceptLanguage sets the AcceptLanguage property of
+// GetResourceUsageResourceGroupOptions. Prioritize the names returned in the order of the specified languages.
+func GetResourceUsageResourceGroupAcceptLanguage(acceptLanguage string) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	}
+}
+
+// GetResourceUsageResourceGroupLimit sets the Limit property of GetResourceUsageResourceGroupOptions. Number of
+// usage records returned.
+func GetResourceUsageResourceGroupLimit(limit int64) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// GetResourceUsageResourceGroupStart sets the Start property of GetResourceUsageResourceGroupOptions. The offset
+// from which the records must be fetched.
+func GetResourceUsageResourceGroupStart(start string) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// GetResourceUsageResourceGroupResourceInstanceID sets the ResourceInstanceID property of
+// GetResourceUsageResourceGroupOptions. Filter by resource instance id.
+func GetResourceUsageResourceGroupResourceInstanceID(resourceInstanceID string) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		options.ResourceInstanceID = core.StringPtr(resourceInstanceID)
+	}
+}
+
+// GetResourceUsageResourceGroupResourceID sets the ResourceID property of GetResourceUsageResourceGroupOptions.
+// Filter by resource_id.
+func GetResourceUsageResourceGroupResourceID(resourceID string) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		options.ResourceID = core.StringPtr(resourceID)
+	}
+}
+
+// GetResourceUsageResourceGroupPlanID sets the PlanID property of GetResourceUsageResourceGroupOptions. Filter by
+// plan_id.
+func GetResourceUsageResourceGroupPlanID(planID string) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		options.PlanID = core.StringPtr(planID)
+	}
+}
+
+// GetResourceUsageResourceGroupRegion sets the Region property of GetResourceUsageResourceGroupOptions. Region in
+// which the resource instance is provisioned.
+func GetResourceUsageResourceGroupRegion(region string) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// GetResourceUsageResourceGroupHeader sets the Headers property of GetResourceUsageResourceGroupOptions. Allows
+// users to set headers on API requests.
+func GetResourceUsageResourceGroupHeader(name string, value string) GetResourceUsageResourceGroupOption {
+	return func(options *GetResourceUsageResourceGroupOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetResourceUsageResourceGroupOptionsWith returns GetResourceUsageResourceGroupOptions populated by applying "options" in order.
+func (*UsageReportsV4) NewGetResourceUsageResourceGroupOptionsWith(options ...GetResourceUsageResourceGroupOption) *GetResourceUsageResourceGroupOptions {
+	getResourceUsageResourceGroupOptions := &GetResourceUsageResourceGroupOptions{}
+	for _, option := range options {
+		option(getResourceUsageResourceGroupOptions)
+	}
+	return getResourceUsageResourceGroupOptions
+}
+
+// GetResourceUsageResourceGroupWith is an alternate form of the GetResourceUsageResourceGroupWithContext method
+// which takes functional options instead of a GetResourceUsageResourceGroupOptions struct.
+// GetResourceUsageResourceGroupAccountID, GetResourceUsageResourceGroupResourceGroupID and
+// GetResourceUsageResourceGroupBillingmonth are required.
+func (usageReports *UsageReportsV4) GetResourceUsageResourceGroupWith(ctx context.Context, options ...GetResourceUsageResourceGroupOption) (result *InstancesUsage, response *core.DetailedResponse, err error) {
+	return usageReports.GetResourceUsageResourceGroupWithContext(ctx, usageReports.NewGetResourceUsageResourceGroupOptionsWith(options...))
+}
+
+// GetResourceUsageOrgOption : A functional option that sets a property of GetResourceUsageOrgOptions (see GetResourceUsageOrgWith).
+type GetResourceUsageOrgOption func(options *GetResourceUsageOrgOptions)
+
+// GetResourceUsageOrgAccountID sets the AccountID property of GetResourceUsageOrgOptions. Account ID for which the
+// usage report is requested.
+func GetResourceUsageOrgAccountID(accountID string) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetResourceUsageOrgOrganizationID sets the OrganizationID property of GetResourceUsageOrgOptions. ID of the
+// organization.
+func GetResourceUsageOrgOrganizationID(organizationID string) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		options.OrganizationID = core.StringPtr(organizationID)
+	}
+}
+
+// GetResourceUsageOrgBillingmonth sets the Billingmonth property of GetResourceUsageOrgOptions. The billing month
+// for which the usage report is requested.
+func GetResourceUsageOrgBillingmonth(billingmonth string) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		options.Billingmonth = core.StringPtr(billingmonth)
+	}
+}
+
+// GetResourceUsageOrgNames sets the Names property of GetResourceUsageOrgOptions. Include the name of every
+// resource, plan, resource instance, organization, and resource group.
+func GetResourceUsageOrgNames(names bool) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		options.Names = core.BoolPtr(names)
+	}
+}
+
+// GetResourceUsageOrgAcceptLanguage sets the AcceptLanguage property of GetResourceUsageOrgOptions. Prioritize the
+// names returned in the order of the specified languages.
+func GetResourceUsageOrgAcceptLanguage(acceptLanguage string) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	}
+}
+
+// GetResourceUsageOrgLimit sets the Limit property of GetResourceUsageOrgOptions. Number of usage records
+// returned.
+func GetResourceUsageOrgLimit(limit int64) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// GetResourceUsageOrgStart sets the Start property of GetResourceUsageOrgOptions. The offset from which the
+// records must be fetched.
+func GetResourceUsageOrgStart(start string) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// GetResourceUsageOrgResourceInstanceID sets the ResourceInstanceID property of GetResourceUsageOrgOptions. Filter
+// by resource instance id.
+func GetResourceUsageOrgResourceInstanceID(resourceInstanceID string) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		options.ResourceInstanceID = core.StringPtr(resourceInstanceID)
+	}
+}
+
+// GetResourceUsageOrgResourceID sets the ResourceID property of GetResourceUsageOrgOptions. Filter by resource_id.
+func GetResourceUsageOrgResourceID(resourceID string) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		options.ResourceID = core.StringPtr(resourceID)
+	}
+}
+
+// GetResourceUsageOrgPlanID sets the PlanID property of GetResourceUsageOrgOptions. Filter by plan_id.
+func GetResourceUsageOrgPlanID(planID string) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		options.PlanID = core.StringPtr(planID)
+	}
+}
+
+// GetResourceUsageOrgRegion sets the Region property of GetResourceUsageOrgOptions. Region in which the resource
+// instance is provisioned.
+func GetResourceUsageOrgRegion(region string) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		options.Region = core.StringPtr(region)
+	}
+}
+
+// GetResourceUsageOrgHeader sets the Headers property of GetResourceUsageOrgOptions. Allows users to set headers
+// on API requests.
+func GetResourceUsageOrgHeader(name string, value string) GetResourceUsageOrgOption {
+	return func(options *GetResourceUsageOrgOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetResourceUsageOrgOptionsWith returns GetResourceUsageOrgOptions populated by applying "options" in order.
+func (*UsageReportsV4) NewGetResourceUsageOrgOptionsWith(options ...GetResourceUsageOrgOption) *GetResourceUsageOrgOptions {
+	getResourceUsageOrgOptions := &GetResourceUsageOrgOptions{}
+	for _, option := range options {
+		option(getResourceUsageOrgOptions)
+	}
+	return getResourceUsageOrgOptions
+}
+
+// GetResourceUsageOrgWith is an alternate form of the GetResourceUsageOrgWithContext method which takes functional
+// options instead of a GetResourceUsageOrgOptions struct. GetResourceUsageOrgAccountID,
+// GetResourceUsageOrgOrganizationID and GetResourceUsageOrgBillingmonth are required.
+func (usageReports *UsageReportsV4) GetResourceUsageOrgWith(ctx context.Context, options ...GetResourceUsageOrgOption) (result *InstancesUsage, response *core.DetailedResponse, err error) {
+	return usageReports.GetResourceUsageOrgWithContext(ctx, usageReports.NewGetResourceUsageOrgOptionsWith(options...))
+}
+
+// GetOrgUsageOption : A functional option that sets a property of GetOrgUsageOptions (see GetOrgUsageWith).
+type GetOrgUsageOption func(options *GetOrgUsageOptions)
+
+// GetOrgUsageAccountID sets the AccountID property of GetOrgUsageOptions. Account ID for which the usage report is
+// requested.
+func GetOrgUsageAccountID(accountID string) GetOrgUsageOption {
+	return func(options *GetOrgUsageOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetOrgUsageOrganizationID sets the OrganizationID property of GetOrgUsageOptions. ID of the organization.
+func GetOrgUsageOrganizationID(organizationID string) GetOrgUsageOption {
+	return func(options *GetOrgUsageOptions) {
+		options.OrganizationID = core.StringPtr(organizationID)
+	}
+}
+
+// GetOrgUsageBillingmonth sets the Billingmonth property of GetOrgUsageOptions. The billing month for which the
+// usage report is requested.
+func GetOrgUsageBillingmonth(billingmonth string) GetOrgUsageOption {
+	return func(options *GetOrgUsageOptions) {
+		options.Billingmonth = core.StringPtr(billingmonth)
+	}
+}
+
+// GetOrgUsageNames sets the Names property of GetOrgUsageOptions. Include the name of every resource, plan,
+// resource instance, organization, and resource group.
+func GetOrgUsageNames(names bool) GetOrgUsageOption {
+	return func(options *GetOrgUsageOptions) {
+		options.Names = core.BoolPtr(names)
+	}
+}
+
+// GetOrgUsageAcceptLanguage sets the AcceptLanguage property of GetOrgUsageOptions. Prioritize the names returned
+// in the order of the specified languages.
+func GetOrgUsageAcceptLanguage(acceptLanguage string) GetOrgUsageOption {
+	return func(options *GetOrgUsageOptions) {
+		options.AcceptLanguage = core.StringPtr(acceptLanguage)
+	}
+}
+
+// GetOrgUsageHeader sets the Headers property of GetOrgUsageOptions. Allows users to set headers on API requests.
+func GetOrgUsageHeader(name string, value string) GetOrgUsageOption {
+	return func(options *GetOrgUsageOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetOrgUsageOptionsWith returns GetOrgUsageOptions populated by applying "options" in order.
+func (*UsageReportsV4) NewGetOrgUsageOptionsWith(options ...GetOrgUsageOption) *GetOrgUsageOptions {
+	getOrgUsageOptions := &GetOrgUsageOptions{}
+	for _, option := range options {
+		option(getOrgUsageOptions)
+	}
+	return getOrgUsageOptions
+}
+
+// GetOrgUsageWith is an alternate form of the GetOrgUsageWithContext method which takes functional options instead
+// of a GetOrgUsageOptions struct. GetOrgUsageAccountID, GetOrgUsageOrganizationID and GetOrgUsageBillingmonth are
+// required.
+func (usageReports *UsageReportsV4) GetOrgUsageWith(ctx context.Context, options ...GetOrgUsageOption) (result *OrgUsage, response *core.DetailedResponse, err error) {
+	return usageReports.GetOrgUsageWithContext(ctx, usageReports.NewGetOrgUsageOptionsWith(options...))
+}
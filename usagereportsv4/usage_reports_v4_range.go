@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// DefaultUsageRangeConcurrency is the number of months for which GetAccountUsageRange retrieves usage concurrently.
+const DefaultUsageRangeConcurrency = 4
+
+// DefaultUsageRangeRequestInterval is the minimum interval between the requests sent by GetAccountUsageRange.
+const DefaultUsageRangeRequestInterval = 100 * time.Millisecond
+
+// AccountUsageSeries : The usage of an account for a range of billing months.
+type AccountUsageSeries struct {
+	// The ID of the account.
+	AccountID string
+
+	// The billing months, in ascending order.
+	Months []string
+
+	// The usage of the account for each month, in the order of Months.
+	Usage []AccountUsage
+}
+
+// BillableCost returns the billable cost of the account for each month, in the order of Months.
+func (series *AccountUsageSeries) BillableCost() []float64 {
+	costs := make([]float64, len(series.Usage))
+	for i, usage := range series.Usage {
+		for _, resource := range usage.Resources {
+			if resource.BillableCost != nil {
+				costs[i] += *resource.BillableCost
+			}
+		}
+	}
+	return costs
+}
+
+// ResourceBillableCost returns the billable cost of each resource (service) for each month, in the order of Months,
+// by resource ID. The cost of a resource is zero in the months in which it was not used.
+func (series *AccountUsageSeries) ResourceBillableCost() map[string][]float64 {
+	costs := make(map[string][]float64)
+	for i, usage := range series.Usage {
+		for _, resource := range usage.Resources {
+			resourceID := core.StringNilMapper(resource.ResourceID)
+			if costs[resourceID] == nil {
+				costs[resourceID] = make([]float64, len(series.Usage))
+			}
+			if resource.BillableCost != nil {
+				costs[resourceID][i] += *resource.BillableCost
+			}
+		}
+	}
+	return costs
+}
+
+// GetAccountUsageRange retrieves the usage of an account for each billing month from "fromMonth" through "toMonth"
+// (inclusive, in "yyyy-mm" format) and returns it as a series. The months are retrieved concurrently, at most
+// DefaultUsageRangeConcurrency at a time and with requests spaced DefaultUsageRangeRequestInterval apart. If the
+// usage of any month cannot be retrieved, the error is returned.
+func (usageReports *UsageReportsV4) GetAccountUsageRange(ctx context.Context, accountID string, fromMonth string, toMonth string) (series *AccountUsageSeries, err error) {
+	months, err := common.MonthRange(fromMonth, toMonth)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	limiter := common.NewRateLimiter(1/DefaultUsageRangeRequestInterval.Seconds(), 1)
+
+	series = &AccountUsageSeries{
+		AccountID: accountID,
+		Months:    months,
+		Usage:     make([]AccountUsage, len(months)),
+	}
+	var mutex sync.Mutex
+	var firstErr error
+	var waitGroup sync.WaitGroup
+	semaphore := make(chan struct{}, DefaultUsageRangeConcurrency)
+	for i, month := range months {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		waitGroup.Add(1)
+		go func(i int, month string) {
+			defer waitGroup.Done()
+			defer func() { <-semaphore }()
+
+			usage, getErr := usageReports.getMonthUsage(ctx, limiter, accountID, month)
+			if getErr != nil {
+				mutex.Lock()
+				if firstErr == nil {
+					firstErr = getErr
+					cancel()
+				}
+				mutex.Unlock()
+				return
+			}
+			// Each goroutine only writes the usage of its own month, so no locking is needed.
+			series.Usage[i] = *usage
+		}(i, month)
+	}
+	waitGroup.Wait()
+
+	err = firstErr
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		series = nil
+	}
+	return
+}
+
+func (usageReports *UsageReportsV4) getMonthUsage(ctx context.Context, limiter common.Limiter, accountID string, month string) (usage *AccountUsage, err error) {
+	err = limiter.Wait(ctx)
+	if err != nil {
+		return
+	}
+	usage, _, err = usageReports.GetAccountUsageWithContext(ctx, usageReports.NewGetAccountUsageOptions(accountID, month))
+	if err != nil {
+		err = fmt.Errorf("error retrieving the usage of month '%s': %w", month, err)
+	}
+	return
+}
@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UsageReportsV4 GetAccountUsageRange`, func() {
+	var testServer *httptest.Server
+	var usageReportsService *usagereportsv4.UsageReportsV4
+	var mutex sync.Mutex
+	var requested []string
+	BeforeEach(func() {
+		requested = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			month := strings.TrimPrefix(req.URL.Path, "/v4/accounts/acct/usage/")
+			mutex.Lock()
+			requested = append(requested, month)
+			mutex.Unlock()
+
+			res.Header().Set("Content-type", "application/json")
+			resources := map[string]string{
+				"2021-12": `[{"resource_id": "cloudantnosqldb", "billable_cost": 10, "billable_rated_cost": 10, "non_billable_cost": 0,
+					"non_billable_rated_cost": 0, "plans": [], "discounts": []}]`,
+				"2022-01": `[]`,
+				"2022-02": `[{"resource_id": "cloudantnosqldb", "billable_cost": 12, "billable_rated_cost": 12, "non_billable_cost": 0,
+					"non_billable_rated_cost": 0, "plans": [], "discounts": []},
+					{"resource_id": "kms", "billable_cost": 3, "billable_rated_cost": 3, "non_billable_cost": 1,
+					"non_billable_rated_cost": 1, "plans": [], "discounts": []}]`,
+			}[month]
+			if resources == "" {
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"message": "unavailable"}`)
+				return
+			}
+			fmt.Fprintf(res, `{"account_id": "acct", "pricing_country": "USA", "currency_code": "USD", "month": "%s", "resources": %s}`,
+				month, resources)
+		}))
+		var serviceErr error
+		usageReportsService, serviceErr = usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Merges the usage of each month into a series`, func() {
+		series, err := usageReportsService.GetAccountUsageRange(context.Background(), "acct", "2021-12", "2022-02")
+		Expect(err).To(BeNil())
+		Expect(series.Months).To(Equal([]string{"2021-12", "2022-01", "2022-02"}))
+		Expect(requested).To(ConsistOf("2021-12", "2022-01", "2022-02"))
+		for i, usage := range series.Usage {
+			Expect(*usage.Month).To(Equal(series.Months[i]))
+		}
+		Expect(series.BillableCost()).To(Equal([]float64{10, 0, 15}))
+		Expect(series.ResourceBillableCost()).To(Equal(map[string][]float64{
+			"cloudantnosqldb": {10, 0, 12},
+			"kms":             {0, 0, 3},
+		}))
+	})
+	It(`Fails if a month cannot be retrieved`, func() {
+		series, err := usageReportsService.GetAccountUsageRange(context.Background(), "acct", "2022-01", "2022-03")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("2022-03"))
+		Expect(series).To(BeNil())
+
+		_, err = usageReportsService.GetAccountUsageRange(context.Background(), "acct", "2022-02", "2022-01")
+		Expect(err).ToNot(BeNil())
+	})
+})
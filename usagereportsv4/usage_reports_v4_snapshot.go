@@ -0,0 +1,124 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// SnapshotRecordCallback is called by StreamSnapshotRecords and StreamSnapshotObject for each record of a billing
+// snapshot object, with the name of the object (or its URL), the line number of the record, and the record itself.
+// Returning an error stops the stream; the error is wrapped by the error returned to the caller.
+type SnapshotRecordCallback func(object string, line int64, record json.RawMessage) error
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// StreamSnapshotRecords downloads the billing snapshot objects at "urls", which are presigned Cloud Object Storage
+// URLs, in order and passes each JSON-lines record to "callback". Objects are decompressed while they are read if
+// they are gzip-compressed, so a snapshot is never held in memory as a whole. The Usage Reports API definition that this
+// package is generated from has no operation that lists the objects of a snapshot, so the URLs must be obtained from
+// the bucket that the snapshots are written to.
+// The objects are downloaded with the base transport of the service's HTTP client (see common.BaseTransport), so
+// proxy and TLS settings apply, but without the service's authenticator, since the URLs carry their own credentials,
+// and without the timeout of the client and of its operation timeouts, or the buffering of its stale cache: a
+// download is only bounded by "ctx".
+func (usageReports *UsageReportsV4) StreamSnapshotRecords(ctx context.Context, urls []string, callback SnapshotRecordCallback) (err error) {
+	err = core.ValidateNotNil(callback, "callback cannot be nil")
+	if err != nil {
+		return
+	}
+	client := &http.Client{}
+	if serviceClient := usageReports.Service.GetHTTPClient(); serviceClient != nil {
+		client.Transport = common.BaseTransport(serviceClient.Transport)
+		client.CheckRedirect = serviceClient.CheckRedirect
+	}
+	for _, url := range urls {
+		err = streamSnapshotURL(ctx, client, url, callback)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func streamSnapshotURL(ctx context.Context, client *http.Client, url string, callback SnapshotRecordCallback) (err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating the request for snapshot object '%s': %w", url, err)
+	}
+	response, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error downloading snapshot object '%s': %w", url, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("error downloading snapshot object '%s': %s", url, response.Status)
+	}
+	return StreamSnapshotObject(response.Body, url, callback)
+}
+
+// StreamSnapshotObject passes each JSON-lines record read from "r" to "callback", decompressing it first if it is
+// gzip-compressed. Use it with the body of an object retrieved with a Cloud Object Storage client; "object" is the
+// name passed to the callback and used in errors. Blank lines are skipped and a line that is not valid JSON is an
+// error.
+func StreamSnapshotObject(r io.Reader, object string, callback SnapshotRecordCallback) (err error) {
+	reader := bufio.NewReader(r)
+	magic, _ := reader.Peek(len(gzipMagic))
+	if bytes.Equal(magic, gzipMagic) {
+		var gzipReader *gzip.Reader
+		gzipReader, err = gzip.NewReader(reader)
+		if err != nil {
+			return fmt.Errorf("error decompressing snapshot object '%s': %w", object, err)
+		}
+		defer gzipReader.Close()
+		reader = bufio.NewReader(gzipReader)
+	}
+
+	var line int64
+	for {
+		data, readErr := reader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return fmt.Errorf("error reading snapshot object '%s': %w", object, readErr)
+		}
+		if len(data) > 0 {
+			line++
+			record := bytes.TrimSpace(data)
+			if len(record) > 0 {
+				if !json.Valid(record) {
+					return fmt.Errorf("snapshot object '%s' line %d is not valid JSON", object, line)
+				}
+				err = callback(object, line, json.RawMessage(record))
+				if err != nil {
+					return fmt.Errorf("error processing snapshot object '%s' line %d: %w", object, line, err)
+				}
+			}
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+	}
+}
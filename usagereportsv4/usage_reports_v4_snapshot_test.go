@@ -0,0 +1,126 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UsageReportsV4 StreamSnapshotRecords`, func() {
+	var testServer *httptest.Server
+	var usageReportsService *usagereportsv4.UsageReportsV4
+	var records []string
+	collect := func(object string, line int64, record json.RawMessage) error {
+		records = append(records, fmt.Sprintf("%s:%d:%s", object[strings.LastIndex(object, "/")+1:], line, record))
+		return nil
+	}
+	BeforeEach(func() {
+		records = nil
+		var compressed bytes.Buffer
+		gzipWriter := gzip.NewWriter(&compressed)
+		fmt.Fprint(gzipWriter, "{\"id\": 1}\n\n{\"id\": 2}")
+		Expect(gzipWriter.Close()).To(Succeed())
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			// Presigned URLs carry their own credentials.
+			Expect(req.Header.Get("Authorization")).To(BeEmpty())
+			switch req.URL.Path {
+			case "/part1.json.gz":
+				res.Header().Set("Content-Type", "application/gzip")
+				res.Write(compressed.Bytes())
+			case "/part2.json":
+				fmt.Fprint(res, "{\"id\": 3}\r\n")
+			case "/slow.json":
+				fmt.Fprint(res, "{\"id\": 6}\n")
+				res.(http.Flusher).Flush()
+				time.Sleep(100 * time.Millisecond)
+				fmt.Fprint(res, "{\"id\": 7}\n")
+			case "/invalid.json":
+				fmt.Fprint(res, "{\"id\": 4}\n{\"id\":\n")
+			default:
+				res.WriteHeader(403)
+			}
+		}))
+		var serviceErr error
+		usageReportsService, serviceErr = usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+			URL:           testServer.URL,
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: "token"},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Streams decompressed records in order`, func() {
+		err := usageReportsService.StreamSnapshotRecords(context.Background(),
+			[]string{testServer.URL + "/part1.json.gz", testServer.URL + "/part2.json"}, collect)
+		Expect(err).To(BeNil())
+		Expect(records).To(Equal([]string{`part1.json.gz:1:{"id": 1}`, `part1.json.gz:3:{"id": 2}`, `part2.json:1:{"id": 3}`}))
+	})
+	It(`Stops on errors`, func() {
+		err := usageReportsService.StreamSnapshotRecords(context.Background(),
+			[]string{testServer.URL + "/part2.json", testServer.URL + "/expired.json"}, collect)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("403"))
+		Expect(records).To(HaveLen(1))
+
+		records = nil
+		err = usageReportsService.StreamSnapshotRecords(context.Background(), []string{testServer.URL + "/invalid.json"}, collect)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("line 2"))
+		Expect(records).To(HaveLen(1))
+
+		stop := errors.New("stop")
+		err = usageReportsService.StreamSnapshotRecords(context.Background(), []string{testServer.URL + "/part1.json.gz"},
+			func(object string, line int64, record json.RawMessage) error { return stop })
+		Expect(errors.Is(err, stop)).To(BeTrue())
+
+		Expect(usageReportsService.StreamSnapshotRecords(context.Background(), nil, nil)).ToNot(BeNil())
+	})
+	It(`Downloads objects without the operation timeouts and stale cache of the service`, func() {
+		common.EnableOperationTimeouts(usageReportsService.Service, &common.OperationTimeouts{
+			Policies: map[common.OperationClass]common.OperationPolicy{common.OperationClassFastRead: {Timeout: 20 * time.Millisecond}},
+		})
+		common.EnableServeStaleOnError(usageReportsService.Service, time.Minute)
+		err := usageReportsService.StreamSnapshotRecords(context.Background(), []string{testServer.URL + "/slow.json"}, collect)
+		Expect(err).To(BeNil())
+		Expect(records).To(Equal([]string{`slow.json:1:{"id": 6}`, `slow.json:2:{"id": 7}`}))
+	})
+	It(`Reads objects retrieved by other clients`, func() {
+		err := usagereportsv4.StreamSnapshotObject(strings.NewReader("{\"id\": 5}\n"), "snapshot.json", collect)
+		Expect(err).To(BeNil())
+		Expect(records).To(Equal([]string{`snapshot.json:1:{"id": 5}`}))
+	})
+})
@@ -0,0 +1,233 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+)
+
+// Constants associated with the canonical units to which metric quantities are normalized.
+const (
+	CanonicalUnitGBHoursConst   = "GB-hours"
+	CanonicalUnitVCPUHoursConst = "vCPU-hours"
+	CanonicalUnitAPICallsConst  = "API calls"
+)
+
+// HoursPerMonth is the number of hours in a month used to convert monthly units to hourly ones.
+const HoursPerMonth = 730
+
+// UnitConversion : The conversion of a metric unit to a canonical unit: a quantity in the unit is multiplied by
+// Factor to obtain the quantity in the canonical unit.
+type UnitConversion struct {
+	CanonicalUnit string
+	Factor        float64
+}
+
+// CanonicalUnits maps the units reported by usage reports and the charge units of catalog pricing metrics, in upper
+// case with spaces and hyphens replaced by underscores, to their canonical units. Units that are not listed are
+// left as they are. Entries may be added for service-specific units.
+var CanonicalUnits = map[string]UnitConversion{
+	"MEGABYTE_HOURS":               {CanonicalUnitGBHoursConst, 0.001},
+	"GIGABYTE_HOURS":               {CanonicalUnitGBHoursConst, 1},
+	"GB_HOURS":                     {CanonicalUnitGBHoursConst, 1},
+	"TERABYTE_HOURS":               {CanonicalUnitGBHoursConst, 1000},
+	"GIGABYTE_MONTHS":              {CanonicalUnitGBHoursConst, HoursPerMonth},
+	"GB_MONTHS":                    {CanonicalUnitGBHoursConst, HoursPerMonth},
+	"TERABYTE_MONTHS":              {CanonicalUnitGBHoursConst, 1000 * HoursPerMonth},
+	"VIRTUAL_PROCESSOR_CORE_HOURS": {CanonicalUnitVCPUHoursConst, 1},
+	"VCPU_HOURS":                   {CanonicalUnitVCPUHoursConst, 1},
+	"VCPU_MONTHS":                  {CanonicalUnitVCPUHoursConst, HoursPerMonth},
+	"API_CALL":                     {CanonicalUnitAPICallsConst, 1},
+	"API_CALLS":                    {CanonicalUnitAPICallsConst, 1},
+	"THOUSAND_API_CALLS":           {CanonicalUnitAPICallsConst, 1000},
+	"MILLION_API_CALLS":            {CanonicalUnitAPICallsConst, 1000000},
+}
+
+// NormalizeQuantity converts a quantity in the specified unit to its canonical unit (see CanonicalUnits). "ok" is
+// false, and the unit and quantity are returned unchanged, if the unit has no canonical unit.
+func NormalizeQuantity(unit string, quantity float64) (canonicalUnit string, canonicalQuantity float64, ok bool) {
+	conversion, ok := CanonicalUnits[unitKey(unit)]
+	if !ok {
+		return unit, quantity, false
+	}
+	return conversion.CanonicalUnit, quantity * conversion.Factor, true
+}
+
+func unitKey(unit string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_").Replace(strings.TrimSpace(unit)))
+}
+
+// UnitCost : The cost of a metric of a service plan per canonical unit.
+type UnitCost struct {
+	// The ID of the service (resource) the plan belongs to.
+	ResourceID string
+
+	// The ID of the plan.
+	PlanID string
+
+	// The ID of the metric.
+	Metric string
+
+	// The unit of the metric, taken from the catalog pricing metric if there is one and from the usage report
+	// otherwise.
+	Unit string
+
+	// The canonical unit, or Unit if it has none.
+	CanonicalUnit string
+
+	// The quantity in the canonical unit, summed over the instances of the plan.
+	Quantity float64
+
+	// The cost, summed over the instances of the plan.
+	Cost float64
+
+	// True if Unit has a canonical unit.
+	Normalized bool
+}
+
+// CostPerUnit returns the cost per canonical unit, or 0 if the quantity is 0.
+func (unitCost *UnitCost) CostPerUnit() float64 {
+	if unitCost.Quantity == 0 {
+		return 0
+	}
+	return unitCost.Cost / unitCost.Quantity
+}
+
+// UnitCostCalculator : Normalizes the metric quantities of usage reports to canonical units, using the pricing
+// metrics of the global catalog to identify the unit of each metric.
+type UnitCostCalculator struct {
+	*UsageReportsV4
+	GlobalCatalog *globalcatalogv1.GlobalCatalogV1
+
+	// The pricing metrics of the plans retrieved so far, by plan ID and metric ID.
+	chargeUnits map[string]map[string]string
+}
+
+// NewUnitCostCalculator : Instantiate UnitCostCalculator
+func NewUnitCostCalculator(usageReports *UsageReportsV4, globalCatalog *globalcatalogv1.GlobalCatalogV1) *UnitCostCalculator {
+	return &UnitCostCalculator{
+		UsageReportsV4: usageReports,
+		GlobalCatalog:  globalCatalog,
+		chargeUnits:    make(map[string]map[string]string),
+	}
+}
+
+// GetUnitCosts retrieves the usage of an account for a month and returns the unit cost of each metric of each
+// service plan, sorted by resource ID, plan ID and metric.
+func (calculator *UnitCostCalculator) GetUnitCosts(ctx context.Context, getAccountUsageOptions *GetAccountUsageOptions) (unitCosts []UnitCost, err error) {
+	accountUsage, _, err := calculator.GetAccountUsageWithContext(ctx, getAccountUsageOptions)
+	if err != nil {
+		return
+	}
+	return calculator.UnitCosts(ctx, accountUsage.Resources)
+}
+
+// UnitCosts returns the unit cost of each metric of each service plan in "resources", which may be the resources of
+// an account, resource group or organization usage report, sorted by resource ID, plan ID and metric. The pricing
+// metrics of each plan are retrieved from the global catalog once and cached; a plan that is not in the catalog is
+// normalized using the units of the usage report.
+func (calculator *UnitCostCalculator) UnitCosts(ctx context.Context, resources []Resource) (unitCosts []UnitCost, err error) {
+	byKey := make(map[[3]string]*UnitCost)
+	for _, resource := range resources {
+		resourceID := core.StringNilMapper(resource.ResourceID)
+		for _, plan := range resource.Plans {
+			planID := core.StringNilMapper(plan.PlanID)
+			var chargeUnits map[string]string
+			chargeUnits, err = calculator.planChargeUnits(ctx, planID)
+			if err != nil {
+				return nil, err
+			}
+			for _, metric := range plan.Usage {
+				metricID := core.StringNilMapper(metric.Metric)
+				unit, ok := chargeUnits[metricID]
+				if !ok {
+					unit = core.StringNilMapper(metric.Unit)
+				}
+				var quantity float64
+				if metric.Quantity != nil {
+					quantity = *metric.Quantity
+				}
+				canonicalUnit, canonicalQuantity, normalized := NormalizeQuantity(unit, quantity)
+
+				key := [3]string{resourceID, planID, metricID}
+				unitCost := byKey[key]
+				if unitCost == nil {
+					unitCost = &UnitCost{
+						ResourceID:    resourceID,
+						PlanID:        planID,
+						Metric:        metricID,
+						Unit:          unit,
+						CanonicalUnit: canonicalUnit,
+						Normalized:    normalized,
+					}
+					byKey[key] = unitCost
+				}
+				unitCost.Quantity += canonicalQuantity
+				if metric.Cost != nil {
+					unitCost.Cost += *metric.Cost
+				}
+			}
+		}
+	}
+
+	for _, unitCost := range byKey {
+		unitCosts = append(unitCosts, *unitCost)
+	}
+	sort.Slice(unitCosts, func(i, j int) bool {
+		a, b := unitCosts[i], unitCosts[j]
+		if a.ResourceID != b.ResourceID {
+			return a.ResourceID < b.ResourceID
+		}
+		if a.PlanID != b.PlanID {
+			return a.PlanID < b.PlanID
+		}
+		return a.Metric < b.Metric
+	})
+	return
+}
+
+// planChargeUnits returns the charge units of the pricing metrics of a plan, by metric ID.
+func (calculator *UnitCostCalculator) planChargeUnits(ctx context.Context, planID string) (chargeUnits map[string]string, err error) {
+	chargeUnits, ok := calculator.chargeUnits[planID]
+	if ok || planID == "" {
+		return
+	}
+
+	chargeUnits = make(map[string]string)
+	pricing, response, err := calculator.GlobalCatalog.GetPricingWithContext(ctx, calculator.GlobalCatalog.NewGetPricingOptions(planID))
+	if err != nil {
+		if response == nil || response.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("error retrieving the pricing of plan '%s': %w", planID, err)
+		}
+		err = nil
+	} else {
+		for _, metric := range pricing.Metrics {
+			if metric.MetricID != nil && metric.ChargeUnit != nil {
+				chargeUnits[*metric.MetricID] = *metric.ChargeUnit
+			}
+		}
+	}
+	calculator.chargeUnits[planID] = chargeUnits
+	return
+}
@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UsageReportsV4 UnitCostCalculator`, func() {
+	var testServer *httptest.Server
+	var calculator *usagereportsv4.UnitCostCalculator
+	var pricingRequests map[string]int
+	BeforeEach(func() {
+		pricingRequests = make(map[string]int)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/v4/accounts/acct/usage/2022-05":
+				fmt.Fprint(res, `{"account_id": "acct", "pricing_country": "USA", "currency_code": "USD", "month": "2022-05",
+					"resources": [
+						{"resource_id": "cloudantnosqldb", "billable_cost": 0, "billable_rated_cost": 0, "non_billable_cost": 0,
+							"non_billable_rated_cost": 0, "discounts": [], "plans": [
+							{"plan_id": "standard", "billable": true, "cost": 0, "rated_cost": 0, "discounts": [], "usage": [
+								{"metric": "STORAGE", "unit": "GIGABYTE_MONTHS", "quantity": 2, "cost": 14.6, "rated_cost": 14.6, "discounts": []},
+								{"metric": "READS", "unit": "API_CALLS", "quantity": 4, "cost": 2, "rated_cost": 2, "discounts": []},
+								{"metric": "INSTANCES", "unit": "INSTANCES", "quantity": 2, "cost": 10, "rated_cost": 10, "discounts": []}]}]},
+						{"resource_id": "kms", "billable_cost": 0, "billable_rated_cost": 0, "non_billable_cost": 0,
+							"non_billable_rated_cost": 0, "discounts": [], "plans": [
+							{"plan_id": "private", "billable": true, "cost": 0, "rated_cost": 0, "discounts": [], "usage": [
+								{"metric": "VCPU", "unit": "Virtual Processor Core-Hours", "quantity": 100, "cost": 5, "rated_cost": 5, "discounts": []}]}]}]}`)
+			case "/standard/pricing":
+				pricingRequests["standard"]++
+				// The catalog reports reads in thousands of API calls.
+				fmt.Fprint(res, `{"metrics": [
+					{"metric_id": "STORAGE", "charge_unit": "GIGABYTE_MONTHS"},
+					{"metric_id": "READS", "charge_unit": "THOUSAND_API_CALLS"}]}`)
+			case "/private/pricing":
+				pricingRequests["private"]++
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			default:
+				Fail("unexpected request: " + req.URL.Path)
+			}
+		}))
+		usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		calculator = usagereportsv4.NewUnitCostCalculator(usageReportsService, globalCatalogService)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Normalizes quantities to canonical units`, func() {
+		unitCosts, err := calculator.GetUnitCosts(context.Background(), calculator.NewGetAccountUsageOptions("acct", "2022-05"))
+		Expect(err).To(BeNil())
+		Expect(unitCosts).To(Equal([]usagereportsv4.UnitCost{
+			{ResourceID: "cloudantnosqldb", PlanID: "standard", Metric: "INSTANCES", Unit: "INSTANCES", CanonicalUnit: "INSTANCES", Quantity: 2, Cost: 10},
+			{ResourceID: "cloudantnosqldb", PlanID: "standard", Metric: "READS", Unit: "THOUSAND_API_CALLS", CanonicalUnit: usagereportsv4.CanonicalUnitAPICallsConst, Quantity: 4000, Cost: 2, Normalized: true},
+			{ResourceID: "cloudantnosqldb", PlanID: "standard", Metric: "STORAGE", Unit: "GIGABYTE_MONTHS", CanonicalUnit: usagereportsv4.CanonicalUnitGBHoursConst, Quantity: 1460, Cost: 14.6, Normalized: true},
+			{ResourceID: "kms", PlanID: "private", Metric: "VCPU", Unit: "Virtual Processor Core-Hours", CanonicalUnit: usagereportsv4.CanonicalUnitVCPUHoursConst, Quantity: 100, Cost: 5, Normalized: true},
+		}))
+		Expect(unitCosts[1].CostPerUnit()).To(Equal(0.0005))
+		Expect(unitCosts[2].CostPerUnit()).To(BeNumerically("~", 0.01, 1e-12))
+		Expect((&usagereportsv4.UnitCost{}).CostPerUnit()).To(Equal(0.0))
+
+		_, err = calculator.GetUnitCosts(context.Background(), calculator.NewGetAccountUsageOptions("acct", "2022-05"))
+		Expect(err).To(BeNil())
+		Expect(pricingRequests).To(Equal(map[string]int{"standard": 1, "private": 1}))
+	})
+	It(`Converts individual quantities`, func() {
+		unit, quantity, ok := usagereportsv4.NormalizeQuantity("terabyte-hours", 2)
+		Expect(ok).To(BeTrue())
+		Expect(unit).To(Equal(usagereportsv4.CanonicalUnitGBHoursConst))
+		Expect(quantity).To(Equal(2000.0))
+
+		unit, quantity, ok = usagereportsv4.NormalizeQuantity("ITEMS", 3)
+		Expect(ok).To(BeFalse())
+		Expect(unit).To(Equal("ITEMS"))
+		Expect(quantity).To(Equal(3.0))
+	})
+})
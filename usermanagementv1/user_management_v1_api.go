@@ -0,0 +1,187 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genapi. DO NOT EDIT.
+
+package usermanagementv1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run ../internal/genoptions
+//go:generate go run ../internal/genapi
+
+// UserManagementV1API : The operations of the UserManagementV1 service, implemented by *UserManagementV1.
+// Depend on this interface instead of the service struct to replace the service with a mock in unit tests.
+type UserManagementV1API interface {
+	// Clone makes a copy of "userManagement" suitable for processing requests.
+	Clone() *UserManagementV1
+
+	// SetServiceURL sets the service URL
+	SetServiceURL(url string) error
+
+	// GetServiceURL returns the service URL
+	GetServiceURL() string
+
+	// SetDefaultHeaders sets HTTP headers to be sent in every request
+	SetDefaultHeaders(headers http.Header)
+
+	// SetEnableGzipCompression sets the service's EnableGzipCompression field
+	SetEnableGzipCompression(enableGzip bool)
+
+	// GetEnableGzipCompression returns the service's EnableGzipCompression field
+	GetEnableGzipCompression() bool
+
+	// EnableRetries enables automatic retries for requests invoked for this service instance.
+	EnableRetries(maxRetries int, maxRetryInterval time.Duration)
+
+	// DisableRetries disables automatic retries for requests invoked for this service instance.
+	DisableRetries()
+
+	// ListUsers : List users
+	ListUsers(listUsersOptions *ListUsersOptions) (result *UserList, response *core.DetailedResponse, err error)
+
+	// ListUsersWithContext is an alternate form of the ListUsers method which supports a Context parameter
+	ListUsersWithContext(ctx context.Context, listUsersOptions *ListUsersOptions) (result *UserList, response *core.DetailedResponse, err error)
+
+	// InviteUsers : Invite users to an account
+	InviteUsers(inviteUsersOptions *InviteUsersOptions) (result *InvitedUserList, response *core.DetailedResponse, err error)
+
+	// InviteUsersWithContext is an alternate form of the InviteUsers method which supports a Context parameter
+	InviteUsersWithContext(ctx context.Context, inviteUsersOptions *InviteUsersOptions) (result *InvitedUserList, response *core.DetailedResponse, err error)
+
+	// GetUserProfile : Get user profile
+	GetUserProfile(getUserProfileOptions *GetUserProfileOptions) (result *UserProfile, response *core.DetailedResponse, err error)
+
+	// GetUserProfileWithContext is an alternate form of the GetUserProfile method which supports a Context parameter
+	GetUserProfileWithContext(ctx context.Context, getUserProfileOptions *GetUserProfileOptions) (result *UserProfile, response *core.DetailedResponse, err error)
+
+	// UpdateUserProfile : Partially update user profile
+	UpdateUserProfile(updateUserProfileOptions *UpdateUserProfileOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateUserProfileWithContext is an alternate form of the UpdateUserProfile method which supports a Context
+	// parameter
+	UpdateUserProfileWithContext(ctx context.Context, updateUserProfileOptions *UpdateUserProfileOptions) (response *core.DetailedResponse, err error)
+
+	// RemoveUser : Remove user from account
+	RemoveUser(removeUserOptions *RemoveUserOptions) (response *core.DetailedResponse, err error)
+
+	// RemoveUserWithContext is an alternate form of the RemoveUser method which supports a Context parameter
+	RemoveUserWithContext(ctx context.Context, removeUserOptions *RemoveUserOptions) (response *core.DetailedResponse, err error)
+
+	// GetUserSettings : Get user settings
+	GetUserSettings(getUserSettingsOptions *GetUserSettingsOptions) (result *UserSettings, response *core.DetailedResponse, err error)
+
+	// GetUserSettingsWithContext is an alternate form of the GetUserSettings method which supports a Context parameter
+	GetUserSettingsWithContext(ctx context.Context, getUserSettingsOptions *GetUserSettingsOptions) (result *UserSettings, response *core.DetailedResponse, err error)
+
+	// UpdateUserSettings : Partially update user settings
+	UpdateUserSettings(updateUserSettingsOptions *UpdateUserSettingsOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateUserSettingsWithContext is an alternate form of the UpdateUserSettings method which supports a Context
+	// parameter
+	UpdateUserSettingsWithContext(ctx context.Context, updateUserSettingsOptions *UpdateUserSettingsOptions) (response *core.DetailedResponse, err error)
+
+	// NewGetUserProfileOptions : Instantiate GetUserProfileOptions
+	NewGetUserProfileOptions(accountID string, iamID string) *GetUserProfileOptions
+
+	// NewGetUserSettingsOptions : Instantiate GetUserSettingsOptions
+	NewGetUserSettingsOptions(accountID string, iamID string) *GetUserSettingsOptions
+
+	// NewInviteUsersOptions : Instantiate InviteUsersOptions
+	NewInviteUsersOptions(accountID string) *InviteUsersOptions
+
+	// NewListUsersOptions : Instantiate ListUsersOptions
+	NewListUsersOptions(accountID string) *ListUsersOptions
+
+	// NewRemoveUserOptions : Instantiate RemoveUserOptions
+	NewRemoveUserOptions(accountID string, iamID string) *RemoveUserOptions
+
+	// NewUpdateUserProfileOptions : Instantiate UpdateUserProfileOptions
+	NewUpdateUserProfileOptions(accountID string, iamID string) *UpdateUserProfileOptions
+
+	// NewUpdateUserSettingsOptions : Instantiate UpdateUserSettingsOptions
+	NewUpdateUserSettingsOptions(accountID string, iamID string) *UpdateUserSettingsOptions
+
+	// NewInviteUserIamPolicy : Instantiate InviteUserIamPolicy (Generic Model Constructor)
+	NewInviteUserIamPolicy(typeVar string) (model *InviteUserIamPolicy, err error)
+
+	// InviteUsersBulk invites the users whose email addresses are read from "readerOfEmails" to an account.
+	InviteUsersBulk(ctx context.Context, accountID string, readerOfEmails io.Reader, options *InviteUsersBulkOptions) (report *BulkInviteReport, err error)
+
+	// NewListUsersOptionsWith returns ListUsersOptions populated by applying "options" in order.
+	NewListUsersOptionsWith(options ...ListUsersOption) *ListUsersOptions
+
+	// ListUsersWith is an alternate form of the ListUsersWithContext method which takes functional options instead of
+	// a ListUsersOptions struct.
+	ListUsersWith(ctx context.Context, options ...ListUsersOption) (result *UserList, response *core.DetailedResponse, err error)
+
+	// NewInviteUsersOptionsWith returns InviteUsersOptions populated by applying "options" in order.
+	NewInviteUsersOptionsWith(options ...InviteUsersOption) *InviteUsersOptions
+
+	// InviteUsersWith is an alternate form of the InviteUsersWithContext method which takes functional options instead
+	// of an InviteUsersOptions struct.
+	InviteUsersWith(ctx context.Context, options ...InviteUsersOption) (result *InvitedUserList, response *core.DetailedResponse, err error)
+
+	// NewGetUserProfileOptionsWith returns GetUserProfileOptions populated by applying "options" in order.
+	NewGetUserProfileOptionsWith(options ...GetUserProfileOption) *GetUserProfileOptions
+
+	// GetUserProfileWith is an alternate form of the GetUserProfileWithContext method which takes functional options
+	// instead of a GetUserProfileOptions struct.
+	GetUserProfileWith(ctx context.Context, options ...GetUserProfileOption) (result *UserProfile, response *core.DetailedResponse, err error)
+
+	// NewUpdateUserProfileOptionsWith returns UpdateUserProfileOptions populated by applying "options" in order.
+	NewUpdateUserProfileOptionsWith(options ...UpdateUserProfileOption) *UpdateUserProfileOptions
+
+	// UpdateUserProfileWith is an alternate form of the UpdateUserProfileWithContext method which takes functional
+	// options instead of an UpdateUserProfileOptions struct.
+	UpdateUserProfileWith(ctx context.Context, options ...UpdateUserProfileOption) (response *core.DetailedResponse, err error)
+
+	// NewRemoveUserOptionsWith returns RemoveUserOptions populated by applying "options" in order.
+	NewRemoveUserOptionsWith(options ...RemoveUserOption) *RemoveUserOptions
+
+	// RemoveUserWith is an alternate form of the RemoveUserWithContext method which takes functional options instead
+	// of a RemoveUserOptions struct.
+	RemoveUserWith(ctx context.Context, options ...RemoveUserOption) (response *core.DetailedResponse, err error)
+
+	// NewGetUserSettingsOptionsWith returns GetUserSettingsOptions populated by applying "options" in order.
+	NewGetUserSettingsOptionsWith(options ...GetUserSettingsOption) *GetUserSettingsOptions
+
+	// GetUserSettingsWith is an alternate form of the GetUserSettingsWithContext method which takes functional options
+	// instead of a GetUserSettingsOptions struct.
+	GetUserSettingsWith(ctx context.Context, options ...GetUserSettingsOption) (result *UserSettings, response *core.DetailedResponse, err error)
+
+	// NewUpdateUserSettingsOptionsWith returns UpdateUserSettingsOptions populated by applying "options" in order.
+	NewUpdateUserSettingsOptionsWith(options ...UpdateUserSettingsOption) *UpdateUserSettingsOptions
+
+	// UpdateUserSettingsWith is an alternate form of the UpdateUserSettingsWithContext method which takes functional
+	// options instead of an UpdateUserSettingsOptions struct.
+	UpdateUserSettingsWith(ctx context.Context, options ...UpdateUserSettingsOption) (response *core.DetailedResponse, err error)
+
+	// SyncAccountUsers makes the users of an account match a desired set of email addresses (compared
+	// case-insensitively): the users of the set that are not in the account are invited, without an account role, IAM
+	// policies or access groups, as InviteUsersBulk does, and if "removeExtra" is true, the users of the account that
+	// are not in the set are removed.
+	SyncAccountUsers(ctx context.Context, accountID string, desiredEmails []string, removeExtra bool) (report *AccountUsersSyncReport, err error)
+}
+
+var _ UserManagementV1API = (*UserManagementV1)(nil)
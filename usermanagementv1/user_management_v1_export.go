@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usermanagementv1
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+// UserExportNeverAuthenticated is the value of the "inactive_since" column for users who have never authenticated.
+const UserExportNeverAuthenticated = "never_authenticated"
+
+// DefaultUserExportInactivityDays is the default value of UserExporter.InactivityDays.
+const DefaultUserExportInactivityDays = 90
+
+// UserExportColumns are the columns written by ExportUsers, in order.
+// "access_groups" lists the names of the user's access groups separated by ";". "inactive_since" is only set for the
+// users who have not authenticated within UserExporter.InactivityDays days: it is the time they last authenticated,
+// or UserExportNeverAuthenticated. It is empty for the other users, whose last login time is not available from the
+// IAM Identity service, and for every user if inactivity data is not exported.
+var UserExportColumns = []common.Column{
+	{Name: "iam_id", Type: common.ColumnTypeString},
+	{Name: "user_id", Type: common.ColumnTypeString},
+	{Name: "realm", Type: common.ColumnTypeString},
+	{Name: "email", Type: common.ColumnTypeString},
+	{Name: "firstname", Type: common.ColumnTypeString},
+	{Name: "lastname", Type: common.ColumnTypeString},
+	{Name: "state", Type: common.ColumnTypeString},
+	{Name: "access_groups", Type: common.ColumnTypeString},
+	{Name: "inactive_since", Type: common.ColumnTypeString},
+}
+
+// UserExporter : Exports the users of an account enriched with their access groups (from the IAM Access Groups
+// service) and, for inactive users, the time they last authenticated (from the inactivity report of the IAM Identity
+// service).
+type UserExporter struct {
+	*UserManagementV1
+
+	// The client used to look up the access groups of each user. If nil, the "access_groups" column is empty.
+	AccessGroups *iamaccessgroupsv2.IamAccessGroupsV2
+
+	// The client used to create the inactivity report that provides the "inactive_since" column. If nil, the
+	// column is empty.
+	Identity *iamidentityv1.IamIdentityV1
+
+	// The period of the inactivity report: users who authenticated more recently have no "inactive_since" value.
+	InactivityDays int
+
+	// The interval at which the inactivity report is polled until it completes. Defaults to
+	// iamidentityv1.DefaultInactivityReportPollInterval.
+	ReportPollInterval time.Duration
+}
+
+// NewUserExporter returns a new UserExporter that uses the specified clients. "accessGroups" and "identity" may be
+// nil to skip the corresponding enrichment.
+func NewUserExporter(userManagement *UserManagementV1, accessGroups *iamaccessgroupsv2.IamAccessGroupsV2, identity *iamidentityv1.IamIdentityV1) *UserExporter {
+	return &UserExporter{
+		UserManagementV1: userManagement,
+		AccessGroups:     accessGroups,
+		Identity:         identity,
+		InactivityDays:   DefaultUserExportInactivityDays,
+	}
+}
+
+// ExportUsers writes every user in the account to "w" in the specified format (common.ExportFormatCSV,
+// common.ExportFormatNDJSON or common.ExportFormatParquet), with the columns described by UserExportColumns.
+// Users are written one page at a time as they are retrieved. If "accountID" is empty, the account of the
+// common.AccountContext of "ctx" is used.
+func (exporter *UserExporter) ExportUsers(ctx context.Context, accountID string, w io.Writer, format string) (err error) {
+	accountID, err = common.ResolveAccountID(ctx, accountID)
+	if err != nil {
+		return
+	}
+	tableWriter, err := common.NewTableWriter(w, format, UserExportColumns)
+	if err != nil {
+		return
+	}
+
+	inactiveSince, err := exporter.inactiveSince(ctx, accountID)
+	if err != nil {
+		return
+	}
+
+	listUsersOptions := exporter.NewListUsersOptions(accountID)
+	for {
+		var result *UserList
+		result, _, err = exporter.ListUsersWithContext(ctx, listUsersOptions)
+		if err != nil {
+			return
+		}
+		for _, user := range result.Resources {
+			var accessGroups string
+			accessGroups, err = exporter.accessGroupNames(ctx, accountID, core.StringNilMapper(user.IamID))
+			if err != nil {
+				return
+			}
+			err = tableWriter.WriteRow(user.IamID, user.UserID, user.Realm, user.Email, user.Firstname, user.Lastname,
+				user.State, accessGroups, inactiveSince[core.StringNilMapper(user.IamID)])
+			if err != nil {
+				return
+			}
+		}
+
+		var start *string
+		start, err = core.GetQueryParam(result.NextURL, "_start")
+		if err != nil {
+			return
+		}
+		if start == nil || len(result.Resources) == 0 {
+			break
+		}
+		listUsersOptions.Start = start
+	}
+
+	return tableWriter.Close()
+}
+
+// inactiveSince returns the "inactive_since" value of each inactive user, keyed by IAM ID.
+func (exporter *UserExporter) inactiveSince(ctx context.Context, accountID string) (inactiveSince map[string]string, err error) {
+	inactiveSince = map[string]string{}
+	if exporter.Identity == nil {
+		return
+	}
+	days := exporter.InactivityDays
+	if days <= 0 {
+		days = DefaultUserExportInactivityDays
+	}
+
+	report, err := exporter.Identity.InactivityReport(ctx, accountID, days, exporter.ReportPollInterval)
+	if err != nil {
+		return
+	}
+	for _, user := range report.Users {
+		lastAuthn := core.StringNilMapper(user.LastAuthn)
+		if lastAuthn == "" {
+			lastAuthn = UserExportNeverAuthenticated
+		}
+		inactiveSince[core.StringNilMapper(user.IamID)] = lastAuthn
+	}
+	return
+}
+
+// accessGroupNames returns the sorted names of the access groups of the user, separated by ";".
+func (exporter *UserExporter) accessGroupNames(ctx context.Context, accountID string, iamID string) (names string, err error) {
+	if exporter.AccessGroups == nil || iamID == "" {
+		return
+	}
+
+	var groupNames []string
+	listAccessGroupsOptions := exporter.AccessGroups.NewListAccessGroupsOptions(accountID).SetIamID(iamID)
+	for {
+		var result *iamaccessgroupsv2.GroupsList
+		result, _, err = exporter.AccessGroups.ListAccessGroupsWithContext(ctx, listAccessGroupsOptions)
+		if err != nil {
+			return
+		}
+		for _, group := range result.Groups {
+			groupNames = append(groupNames, core.StringNilMapper(group.Name))
+		}
+
+		var offset *int64
+		offset, err = result.GetNextOffset()
+		if err != nil {
+			return
+		}
+		if offset == nil || len(result.Groups) == 0 {
+			break
+		}
+		listAccessGroupsOptions.Offset = offset
+	}
+
+	sort.Strings(groupNames)
+	names = strings.Join(groupNames, ";")
+	return
+}
@@ -0,0 +1,119 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usermanagementv1_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/usermanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UserManagementV1 ExportUsers`, func() {
+	var testServer *httptest.Server
+	var userManagementService *usermanagementv1.UserManagementV1
+	var accessGroupsService *iamaccessgroupsv2.IamAccessGroupsV2
+	var identityService *iamidentityv1.IamIdentityV1
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			query := req.URL.Query()
+			switch req.URL.EscapedPath() {
+			case "/v2/accounts/acct/users":
+				if query.Get("_start") == "" {
+					fmt.Fprint(res, `{"total_results": 2, "limit": 1, "next_url": "/v2/accounts/acct/users?_start=next",
+						"resources": [{"iam_id": "IBMid-1", "user_id": "alice@example.com", "realm": "IBMid", "email": "alice@example.com",
+						"firstname": "Alice", "lastname": "Smith", "state": "ACTIVE"}]}`)
+				} else {
+					Expect(query.Get("_start")).To(Equal("next"))
+					fmt.Fprint(res, `{"total_results": 2, "limit": 1,
+						"resources": [{"iam_id": "IBMid-2", "user_id": "bob@example.com", "state": "PENDING"}]}`)
+				}
+			case "/v2/groups":
+				Expect(query.Get("account_id")).To(Equal("acct"))
+				if query.Get("iam_id") == "IBMid-1" {
+					fmt.Fprint(res, `{"limit": 50, "offset": 0, "total_count": 2, "groups": [{"id": "g2", "name": "Viewers"}, {"id": "g1", "name": "Admins"}]}`)
+				} else {
+					fmt.Fprint(res, `{"limit": 50, "offset": 0, "total_count": 0, "groups": []}`)
+				}
+			case "/v1/activity/accounts/acct/report":
+				Expect(query.Get("duration")).To(Equal("720"))
+				res.WriteHeader(202)
+				fmt.Fprint(res, `{"reference": "ref1"}`)
+			case "/v1/activity/accounts/acct/report/ref1":
+				fmt.Fprint(res, `{"created_by": "me", "reference": "ref1", "report_duration": "720", "report_start_time": "s", "report_end_time": "e",
+					"users": [{"iam_id": "IBMid-2", "username": "bob@example.com"}]}`)
+			default:
+				Fail("unexpected request " + req.URL.String())
+			}
+		}))
+		var serviceErr error
+		userManagementService, serviceErr = usermanagementv1.NewUserManagementV1(&usermanagementv1.UserManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		accessGroupsService, serviceErr = iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		identityService, serviceErr = iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Exports users enriched with access groups and inactivity`, func() {
+		exporter := usermanagementv1.NewUserExporter(userManagementService, accessGroupsService, identityService)
+		exporter.InactivityDays = 30
+		var buffer bytes.Buffer
+		err := exporter.ExportUsers(context.Background(), "acct", &buffer, common.ExportFormatCSV)
+		Expect(err).To(BeNil())
+		Expect(buffer.String()).To(Equal(
+			"iam_id,user_id,realm,email,firstname,lastname,state,access_groups,inactive_since\n" +
+				"IBMid-1,alice@example.com,IBMid,alice@example.com,Alice,Smith,ACTIVE,Admins;Viewers,\n" +
+				"IBMid-2,bob@example.com,,,,,PENDING,,never_authenticated\n"))
+	})
+	It(`Exports users without enrichment`, func() {
+		exporter := usermanagementv1.NewUserExporter(userManagementService, nil, nil)
+		var buffer bytes.Buffer
+		err := exporter.ExportUsers(context.Background(), "acct", &buffer, common.ExportFormatNDJSON)
+		Expect(err).To(BeNil())
+		Expect(buffer.String()).To(ContainSubstring(`"iam_id":"IBMid-2"`))
+		Expect(buffer.String()).To(ContainSubstring(`"access_groups":""`))
+	})
+	It(`Rejects unsupported formats`, func() {
+		exporter := usermanagementv1.NewUserExporter(userManagementService, nil, nil)
+		Expect(exporter.ExportUsers(context.Background(), "acct", &bytes.Buffer{}, "xml")).ToNot(BeNil())
+	})
+})
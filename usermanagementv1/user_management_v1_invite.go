@@ -0,0 +1,344 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usermanagementv1
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// MaxUsersPerInviteRequest is the maximum number of users that a single InviteUsers request may invite.
+const MaxUsersPerInviteRequest = 100
+
+// Defaults of InviteUsersBulkOptions.
+const (
+	DefaultInviteUsersConcurrency   = 2
+	DefaultInviteUsersMaxRetries    = 3
+	DefaultInviteUsersRetryInterval = 5 * time.Second
+)
+
+// InviteUsersBulkOptions : Controls how InviteUsersBulk invites users. The zero value uses the defaults.
+type InviteUsersBulkOptions struct {
+	// The account role of the users whose row does not specify one. If empty, the default role of the service applies.
+	AccountRole string
+
+	// The IAM policies granted to every invited user.
+	IamPolicy []InviteUserIamPolicy
+
+	// The IDs of the access groups that every invited user is added to.
+	AccessGroups []string
+
+	// The number of invitation requests sent concurrently. Defaults to DefaultInviteUsersConcurrency.
+	Concurrency int
+
+	// The number of times an invitation request that fails with a transient error (a network error, status code 429
+	// or a 5xx status code) is retried. Defaults to DefaultInviteUsersMaxRetries; a negative value disables retries.
+	MaxRetries int
+
+	// The interval before the first retry of an invitation request, which doubles after each retry. Defaults to
+	// DefaultInviteUsersRetryInterval.
+	RetryInterval time.Duration
+}
+
+// BulkInviteReport : The outcome of InviteUsersBulk.
+type BulkInviteReport struct {
+	// The ID of the account.
+	AccountID string
+
+	// The result for each email address read, in the order in which it was read. Repeated addresses are only
+	// reported once.
+	Results []BulkInviteResult
+
+	// The workflow ID sent with every request of the invitation (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// BulkInviteResult : The outcome of the invitation of one email address.
+type BulkInviteResult struct {
+	// The email address, as read.
+	Email string
+
+	// The account role requested for the user, if any.
+	AccountRole string
+
+	// The user as returned by the service, or nil if the invitation failed.
+	User *InvitedUser
+
+	// The number of invitation requests sent for the user. It is zero if the address is invalid.
+	Attempts int
+
+	// The reason why the invitation failed, or nil if it succeeded.
+	Err error
+}
+
+// Invited returns the results of the users that were invited.
+func (report *BulkInviteReport) Invited() (invited []BulkInviteResult) {
+	for _, result := range report.Results {
+		if result.Err == nil {
+			invited = append(invited, result)
+		}
+	}
+	return
+}
+
+// Failed returns the results of the users whose invitation failed. Once the cause of the failures is resolved, the
+// addresses of these users can be passed to InviteUsersBulk again.
+func (report *BulkInviteReport) Failed() (failed []BulkInviteResult) {
+	for _, result := range report.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return
+}
+
+// HasErrors returns true if the invitation of any user failed.
+func (report *BulkInviteReport) HasErrors() bool {
+	return len(report.Failed()) > 0
+}
+
+// InviteUsersBulk invites the users whose email addresses are read from "readerOfEmails" to an account. The input is
+// CSV: either with a header row that has an "email" column (and optionally an "account_role" column), or without a
+// header, with the email address in the first column, optionally followed by the account role. Empty rows and lines
+// starting with "#" are ignored. Each user is granted the IAM policies and access groups of "options" (which may be
+// nil). The users are invited in requests of at most MaxUsersPerInviteRequest users, sent concurrently, and a request
+// that fails with a transient error is retried.
+//
+// The invitation of a user that fails does not stop the others: its error is recorded in the report, as is the error
+// of an invalid address, so "err" is only returned if the input cannot be read or the context is done. In the latter
+// case the report is still returned with a *common.PartialError, and the users that were not invited have the error of
+// the context. If "accountID" is empty, the account of the common.AccountContext of "ctx" is used.
+func (userManagement *UserManagementV1) InviteUsersBulk(ctx context.Context, accountID string, readerOfEmails io.Reader, options *InviteUsersBulkOptions) (report *BulkInviteReport, err error) {
+	accountID, err = common.ResolveAccountID(ctx, accountID)
+	if err != nil {
+		return
+	}
+	if accountID == "" {
+		err = fmt.Errorf("accountID cannot be empty")
+		return
+	}
+	inviter := userInviter{userManagement: userManagement, accountID: accountID}
+	if options != nil {
+		inviter.options = *options
+	}
+	inviter.setDefaults()
+
+	results, err := readInviteRows(readerOfEmails, inviter.options.AccountRole)
+	if err != nil {
+		err = fmt.Errorf("error reading the email addresses: %w", err)
+		return
+	}
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, userManagement.Service)
+	report = &BulkInviteReport{AccountID: accountID, Results: results, WorkflowID: workflowID}
+
+	var pending []*BulkInviteResult
+	for i := range report.Results {
+		if report.Results[i].Err == nil {
+			pending = append(pending, &report.Results[i])
+		}
+	}
+	err = common.WrapWorkflowError(workflowID, inviter.inviteAll(ctx, workflowID, pending))
+	return
+}
+
+type userInviter struct {
+	userManagement *UserManagementV1
+	accountID      string
+	options        InviteUsersBulkOptions
+}
+
+// inviteAll invites the users of "pending" in chunks of at most MaxUsersPerInviteRequest users, sent concurrently, and
+// records the outcome in their results. If the context is done, the users that were not invited have the error of the
+// context and a *common.PartialError is returned.
+func (inviter *userInviter) inviteAll(ctx context.Context, workflowID string, pending []*BulkInviteResult) error {
+	chunks := (len(pending) + MaxUsersPerInviteRequest - 1) / MaxUsersPerInviteRequest
+	chunk := func(i int) []*BulkInviteResult {
+		start := i * MaxUsersPerInviteRequest
+		end := start + MaxUsersPerInviteRequest
+		if end > len(pending) {
+			end = len(pending)
+		}
+		return pending[start:end]
+	}
+	err := common.ForEachConcurrently(ctx, chunks, inviter.options.Concurrency, func(ctx context.Context, i int) {
+		// Each call only writes the results of its own chunk, so no locking is needed.
+		inviter.invite(ctx, chunk(i))
+		for _, result := range chunk(i) {
+			result.Err = common.WrapWorkflowError(workflowID, result.Err)
+		}
+	})
+
+	var partialErr *common.PartialError
+	if errors.As(err, &partialErr) {
+		for i := partialErr.Processed; i < chunks; i++ {
+			for _, result := range chunk(i) {
+				result.Err = common.WrapWorkflowError(workflowID, partialErr.Err)
+			}
+		}
+	}
+	return err
+}
+
+func (inviter *userInviter) setDefaults() {
+	options := &inviter.options
+	if options.Concurrency <= 0 {
+		options.Concurrency = DefaultInviteUsersConcurrency
+	}
+	if options.MaxRetries == 0 {
+		options.MaxRetries = DefaultInviteUsersMaxRetries
+	}
+	if options.RetryInterval <= 0 {
+		options.RetryInterval = DefaultInviteUsersRetryInterval
+	}
+}
+
+// invite sends the invitation request of a chunk of users, retrying it if it fails with a transient error, and records
+// the outcome in their results.
+func (inviter *userInviter) invite(ctx context.Context, results []*BulkInviteResult) {
+	inviteOptions := inviter.userManagement.NewInviteUsersOptions(inviter.accountID)
+	for _, result := range results {
+		user := InviteUser{Email: core.StringPtr(result.Email)}
+		if result.AccountRole != "" {
+			user.AccountRole = core.StringPtr(result.AccountRole)
+		}
+		inviteOptions.Users = append(inviteOptions.Users, user)
+	}
+	inviteOptions.IamPolicy = inviter.options.IamPolicy
+	inviteOptions.AccessGroups = inviter.options.AccessGroups
+
+	var invited *InvitedUserList
+	var err error
+	retryInterval := inviter.options.RetryInterval
+	for attempts := 1; ; attempts++ {
+		for _, result := range results {
+			result.Attempts = attempts
+		}
+		var response *core.DetailedResponse
+		invited, response, err = inviter.userManagement.InviteUsersWithContext(ctx, inviteOptions)
+		if err == nil || !isTransientUserResponse(response) || attempts > inviter.options.MaxRetries || ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-time.After(retryInterval):
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		retryInterval *= 2
+	}
+	if err != nil {
+		err = fmt.Errorf("error inviting users to account '%s': %w", inviter.accountID, err)
+		for _, result := range results {
+			result.Err = err
+		}
+		return
+	}
+
+	users := make(map[string]*InvitedUser)
+	for i := range invited.Resources {
+		users[strings.ToLower(core.StringNilMapper(invited.Resources[i].Email))] = &invited.Resources[i]
+	}
+	for _, result := range results {
+		result.User = users[strings.ToLower(result.Email)]
+		if result.User == nil {
+			result.Err = fmt.Errorf("no result returned for user '%s'", result.Email)
+		}
+	}
+}
+
+// readInviteRows reads the email addresses (and account roles) to invite. Invalid addresses are returned with an
+// error, and repeated addresses are skipped.
+func readInviteRows(r io.Reader, defaultRole string) (results []BulkInviteResult, err error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	emailColumn, roleColumn := 0, 1
+	seen := make(map[string]bool)
+	for first := true; ; first = false {
+		var record []string
+		record, err = reader.Read()
+		if err == io.EOF {
+			err = nil
+			return
+		}
+		if err != nil {
+			return
+		}
+		if first && csvColumnIndex(record, "email") >= 0 {
+			emailColumn, roleColumn = csvColumnIndex(record, "email"), csvColumnIndex(record, "account_role")
+			continue
+		}
+
+		email := strings.TrimSpace(csvField(record, emailColumn))
+		if email == "" || seen[strings.ToLower(email)] {
+			continue
+		}
+		seen[strings.ToLower(email)] = true
+		result := BulkInviteResult{Email: email, AccountRole: strings.TrimSpace(csvField(record, roleColumn))}
+		if result.AccountRole == "" {
+			result.AccountRole = defaultRole
+		}
+		result.Err = validateInviteEmail(email)
+		results = append(results, result)
+	}
+}
+
+// validateInviteEmail returns an error if "email" is not a bare email address.
+func validateInviteEmail(email string) error {
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return fmt.Errorf("invalid email address '%s'", email)
+	}
+	return nil
+}
+
+// csvColumnIndex returns the index of the specified column of a header row, or -1 if it has no such column.
+func csvColumnIndex(header []string, name string) int {
+	for i, column := range header {
+		if strings.EqualFold(strings.TrimSpace(column), name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// csvField returns the value of a column of a record, or an empty string if the record has no such column.
+func csvField(record []string, column int) string {
+	if column < 0 || column >= len(record) {
+		return ""
+	}
+	return record[column]
+}
+
+// isTransientUserResponse returns true if a request failed with a network error or a status code that indicates a
+// temporary condition.
+func isTransientUserResponse(response *core.DetailedResponse) bool {
+	return response == nil || response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500
+}
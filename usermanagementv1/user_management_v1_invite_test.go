@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usermanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/usermanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UserManagementV1 bulk invitations`, func() {
+	var testServer *httptest.Server
+	var userManagementService *usermanagementv1.UserManagementV1
+	var mutex sync.Mutex
+	var requests []map[string]interface{}
+	var failures int
+	BeforeEach(func() {
+		requests = nil
+		failures = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("POST"))
+			Expect(req.URL.EscapedPath()).To(Equal("/v2/accounts/acct/users"))
+			var body map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			mutex.Lock()
+			requests = append(requests, body)
+			fail := failures > 0
+			if fail {
+				failures--
+			}
+			mutex.Unlock()
+
+			res.Header().Set("Content-type", "application/json")
+			if fail {
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"errors": [{"message": "unavailable"}]}`)
+				return
+			}
+			var invited []string
+			for _, user := range body["users"].([]interface{}) {
+				email := user.(map[string]interface{})["email"].(string)
+				if email == "lost@example.com" {
+					continue
+				}
+				invited = append(invited, fmt.Sprintf(`{"email": "%s", "id": "id-%s", "state": "PROCESSING"}`, strings.ToUpper(email), email))
+			}
+			res.WriteHeader(202)
+			fmt.Fprintf(res, `{"resources": [%s]}`, strings.Join(invited, ","))
+		}))
+		var serviceErr error
+		userManagementService, serviceErr = usermanagementv1.NewUserManagementV1(&usermanagementv1.UserManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(userManagementService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invites the users of a CSV file with a header`, func() {
+		input := "name,Email,account_role\n" +
+			"Ann,ann@example.com,Owner\n" +
+			"Bob,bob@example.com,\n" +
+			"# a comment\n" +
+			"Ann again,ANN@example.com,\n" +
+			"Eve,not an address,\n" +
+			"Lost,lost@example.com,\n"
+		failures = 1
+		options := &usermanagementv1.InviteUsersBulkOptions{
+			AccountRole:   "Member",
+			AccessGroups:  []string{"AccessGroupId-1"},
+			RetryInterval: time.Millisecond,
+		}
+		report, err := userManagementService.InviteUsersBulk(context.Background(), "acct", strings.NewReader(input), options)
+		Expect(err).To(BeNil())
+		Expect(report.WorkflowID).ToNot(BeEmpty())
+		Expect(report.Results).To(HaveLen(4))
+
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1]["users"]).To(Equal([]interface{}{
+			map[string]interface{}{"email": "ann@example.com", "account_role": "Owner"},
+			map[string]interface{}{"email": "bob@example.com", "account_role": "Member"},
+			map[string]interface{}{"email": "lost@example.com", "account_role": "Member"},
+		}))
+		Expect(requests[1]["access_groups"]).To(Equal([]interface{}{"AccessGroupId-1"}))
+
+		invited := report.Invited()
+		Expect(invited).To(HaveLen(2))
+		Expect(invited[0].Attempts).To(Equal(2))
+		Expect(*invited[0].User.ID).To(Equal("id-ann@example.com"))
+		Expect(*invited[1].User.State).To(Equal("PROCESSING"))
+
+		failed := report.Failed()
+		Expect(failed).To(HaveLen(2))
+		Expect(failed[0].Attempts).To(Equal(0))
+		Expect(failed[0].Err.Error()).To(ContainSubstring("invalid email address 'not an address'"))
+		Expect(failed[1].Err.Error()).To(ContainSubstring("no result returned for user 'lost@example.com'"))
+	})
+	It(`Splits the invitations into requests of at most MaxUsersPerInviteRequest users`, func() {
+		var input strings.Builder
+		for i := 0; i < 150; i++ {
+			fmt.Fprintf(&input, "user%d@example.com\n", i)
+		}
+		report, err := userManagementService.InviteUsersBulk(context.Background(), "acct", strings.NewReader(input.String()), nil)
+		Expect(err).To(BeNil())
+		Expect(report.HasErrors()).To(BeFalse())
+		Expect(report.Results[149].AccountRole).To(BeEmpty())
+
+		var sizes []int
+		for _, request := range requests {
+			sizes = append(sizes, len(request["users"].([]interface{})))
+		}
+		Expect(sizes).To(ConsistOf(100, 50))
+	})
+	It(`Records the error of a request that keeps failing`, func() {
+		failures = 3
+		options := &usermanagementv1.InviteUsersBulkOptions{MaxRetries: 1, RetryInterval: time.Millisecond}
+		report, err := userManagementService.InviteUsersBulk(context.Background(), "acct", strings.NewReader("ann@example.com"), options)
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(2))
+		Expect(report.Failed()).To(HaveLen(1))
+		Expect(report.Results[0].Err.Error()).To(ContainSubstring("unavailable"))
+	})
+	It(`Returns the partial report when the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		report, err := userManagementService.InviteUsersBulk(ctx, "acct", strings.NewReader("ann@example.com\nbad"), nil)
+		var partialErr *common.PartialError
+		Expect(errors.As(err, &partialErr)).To(BeTrue())
+		Expect(requests).To(BeEmpty())
+		Expect(report.Results[0].Err).To(MatchError(context.Canceled))
+		Expect(report.Results[1].Err.Error()).To(ContainSubstring("invalid email address"))
+	})
+	It(`Rejects malformed input`, func() {
+		_, err := userManagementService.InviteUsersBulk(context.Background(), "acct", strings.NewReader(`"unterminated`), nil)
+		Expect(err).ToNot(BeNil())
+		_, err = userManagementService.InviteUsersBulk(context.Background(), "", strings.NewReader(""), nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,203 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usermanagementv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// Constants associated with the OffboardingAction.Kind property.
+const (
+	OffboardingActionRemoveFromAccessGroupConst = "remove_from_access_group"
+	OffboardingActionDeletePolicyConst          = "delete_policy"
+	OffboardingActionRemoveFromAccountConst     = "remove_from_account"
+)
+
+// UserOffboarder : Removes users from an account together with their access: their access group memberships (with
+// the IAM Access Groups service) and the access policies granted to them directly (with the IAM Policy Management
+// service).
+type UserOffboarder struct {
+	*UserManagementV1
+
+	// The client used to remove the user from the access groups. If nil, the memberships are kept.
+	AccessGroups *iamaccessgroupsv2.IamAccessGroupsV2
+
+	// The client used to delete the policies of the user. If nil, the policies are kept.
+	PolicyManagement *iampolicymanagementv1.IamPolicyManagementV1
+}
+
+// NewUserOffboarder returns a new UserOffboarder that uses the specified clients. "accessGroups" and
+// "policyManagement" may be nil to skip the corresponding step.
+func NewUserOffboarder(userManagement *UserManagementV1, accessGroups *iamaccessgroupsv2.IamAccessGroupsV2, policyManagement *iampolicymanagementv1.IamPolicyManagementV1) *UserOffboarder {
+	return &UserOffboarder{
+		UserManagementV1: userManagement,
+		AccessGroups:     accessGroups,
+		PolicyManagement: policyManagement,
+	}
+}
+
+// OffboardingReport : The outcome of OffboardUser.
+type OffboardingReport struct {
+	// The ID of the account.
+	AccountID string
+
+	// The IAM ID of the user.
+	IamID string
+
+	// The actions taken, or attempted, in order.
+	Actions []OffboardingAction
+
+	// The workflow ID sent with every request of the offboarding (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// OffboardingAction : A change made, or attempted, by OffboardUser.
+type OffboardingAction struct {
+	// The kind of the action (an OffboardingAction*Const value).
+	Kind string
+
+	// The ID of the access group, policy or account that the action applies to. It is empty if the targets of the
+	// action could not be determined, in which case Err describes why.
+	Target string
+
+	// The reason why the action failed, or nil if it succeeded.
+	Err error
+}
+
+// Failed returns the actions that failed. Once the cause of the failures is resolved, OffboardUser can be called
+// again to complete the offboarding.
+func (report *OffboardingReport) Failed() (failed []OffboardingAction) {
+	for _, action := range report.Actions {
+		if action.Err != nil {
+			failed = append(failed, action)
+		}
+	}
+	return
+}
+
+// HasErrors returns true if any action failed.
+func (report *OffboardingReport) HasErrors() bool {
+	return len(report.Failed()) > 0
+}
+
+// OffboardUser removes a user from an account: it removes the user from every access group of the account, deletes
+// the access policies whose subject is the user (the policies of their access groups are kept), and finally removes
+// the user from the account. Each step is attempted even if a previous one failed, and every failure is recorded in
+// the report, so "err" is only returned if the arguments are invalid or the context is done. A step that finds
+// nothing to do (for example because the user was already removed) is not an error, so the offboarding can be
+// repeated until the report has no errors. If "accountID" is empty, the account of the common.AccountContext of "ctx"
+// is used.
+func (offboarder *UserOffboarder) OffboardUser(ctx context.Context, accountID string, iamID string) (report *OffboardingReport, err error) {
+	accountID, err = common.ResolveAccountID(ctx, accountID)
+	if err != nil {
+		return
+	}
+	if accountID == "" || iamID == "" {
+		err = fmt.Errorf("the account ID and IAM ID must be specified")
+		return
+	}
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, offboarder.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	report = &OffboardingReport{AccountID: accountID, IamID: iamID, WorkflowID: workflowID}
+	record := func(kind string, target string, err error) {
+		report.Actions = append(report.Actions, OffboardingAction{
+			Kind:   kind,
+			Target: target,
+			Err:    common.WrapWorkflowError(workflowID, err),
+		})
+	}
+
+	if offboarder.AccessGroups != nil {
+		offboarder.removeFromAccessGroups(ctx, accountID, iamID, record)
+	}
+	if offboarder.PolicyManagement != nil {
+		offboarder.deletePolicies(ctx, accountID, iamID, record)
+	}
+	response, removeErr := offboarder.RemoveUserWithContext(ctx, offboarder.NewRemoveUserOptions(accountID, iamID))
+	if removeErr != nil && !isNotFoundResponse(response) {
+		removeErr = fmt.Errorf("error removing user '%s' from account '%s': %w", iamID, accountID, removeErr)
+	} else {
+		removeErr = nil
+	}
+	record(OffboardingActionRemoveFromAccountConst, accountID, removeErr)
+
+	err = ctx.Err()
+	return
+}
+
+// removeFromAccessGroups removes the user from every access group of the account and records the outcome for each
+// group.
+func (offboarder *UserOffboarder) removeFromAccessGroups(ctx context.Context, accountID string, iamID string, record func(kind string, target string, err error)) {
+	options := offboarder.AccessGroups.NewRemoveMemberFromAllAccessGroupsOptions(accountID, iamID)
+	result, response, err := offboarder.AccessGroups.RemoveMemberFromAllAccessGroupsWithContext(ctx, options)
+	if err != nil {
+		// The service responds with 404 if the user is not a member of any access group.
+		if !isNotFoundResponse(response) {
+			record(OffboardingActionRemoveFromAccessGroupConst, "", fmt.Errorf("error removing user '%s' from the access groups: %w", iamID, err))
+		}
+		return
+	}
+	for _, group := range result.Groups {
+		var groupErr error
+		var statusCode int64
+		if group.StatusCode != nil {
+			statusCode = *group.StatusCode
+		}
+		if statusCode < 200 || statusCode >= 300 {
+			groupErr = fmt.Errorf("error removing user '%s' from access group '%s': status code %d (trace %s)",
+				iamID, core.StringNilMapper(group.AccessGroupID), statusCode, core.StringNilMapper(group.Trace))
+		}
+		record(OffboardingActionRemoveFromAccessGroupConst, core.StringNilMapper(group.AccessGroupID), groupErr)
+	}
+}
+
+// deletePolicies deletes the access policies whose subject is the user and records the outcome for each policy.
+func (offboarder *UserOffboarder) deletePolicies(ctx context.Context, accountID string, iamID string, record func(kind string, target string, err error)) {
+	policyManagement := offboarder.PolicyManagement
+	options := policyManagement.NewListPoliciesOptions(accountID).
+		SetIamID(iamID).
+		SetType(iampolicymanagementv1.ListPoliciesOptionsTypeAccessConst)
+	// The policies are filtered client-side too, so that only the policies granted to the user directly are deleted.
+	policies, err := policyManagement.ListAllPolicies(ctx, options, iampolicymanagementv1.PolicyWithSubject(iamID))
+	if err != nil {
+		record(OffboardingActionDeletePolicyConst, "", fmt.Errorf("error listing the policies of user '%s': %w", iamID, err))
+		return
+	}
+	for _, policy := range policies {
+		policyID := core.StringNilMapper(policy.ID)
+		response, deleteErr := policyManagement.DeletePolicyWithContext(ctx, policyManagement.NewDeletePolicyOptions(policyID))
+		if deleteErr != nil && !isNotFoundResponse(response) {
+			deleteErr = fmt.Errorf("error deleting policy '%s': %w", policyID, deleteErr)
+		} else {
+			deleteErr = nil
+		}
+		record(OffboardingActionDeletePolicyConst, policyID, deleteErr)
+	}
+}
+
+// isNotFoundResponse returns true if a request failed because the resource does not exist.
+func isNotFoundResponse(response *core.DetailedResponse) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
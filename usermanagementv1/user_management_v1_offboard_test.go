@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usermanagementv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/usermanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UserManagementV1 user offboarding`, func() {
+	var testServer *httptest.Server
+	var offboarder *usermanagementv1.UserOffboarder
+	var requests []string
+	var userRemoved bool
+	BeforeEach(func() {
+		requests = nil
+		userRemoved = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.Path {
+			case "DELETE /v2/groups/_allgroups/members/IBMid-user":
+				Expect(req.URL.Query().Get("account_id")).To(Equal("acct"))
+				if userRemoved {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"errors": [{"message": "not a member"}]}`)
+					return
+				}
+				res.WriteHeader(207)
+				fmt.Fprint(res, `{"iam_id": "IBMid-user", "groups": [
+					{"access_group_id": "AccessGroupId-1", "status_code": 200},
+					{"access_group_id": "AccessGroupId-2", "status_code": 500, "trace": "trace-2"}]}`)
+			case "GET /v1/policies":
+				Expect(req.URL.Query().Get("iam_id")).To(Equal("IBMid-user"))
+				Expect(req.URL.Query().Get("type")).To(Equal("access"))
+				res.WriteHeader(200)
+				if userRemoved {
+					fmt.Fprint(res, `{"policies": []}`)
+					return
+				}
+				fmt.Fprint(res, `{"policies": [
+					{"id": "p1", "type": "access", "subjects": [{"attributes": [{"name": "iam_id", "value": "IBMid-user"}]}]},
+					{"id": "p2", "type": "access", "subjects": [{"attributes": [{"name": "access_group_id", "value": "AccessGroupId-1"}]}]},
+					{"id": "p3", "type": "access", "subjects": [{"attributes": [{"name": "iam_id", "value": "IBMid-user"}]}]}]}`)
+			case "DELETE /v1/policies/p1":
+				res.WriteHeader(204)
+			case "DELETE /v1/policies/p3":
+				res.WriteHeader(403)
+				fmt.Fprint(res, `{"errors": [{"message": "forbidden"}]}`)
+			case "DELETE /v2/accounts/acct/users/IBMid-user":
+				if userRemoved {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"errors": [{"message": "user not found"}]}`)
+					return
+				}
+				res.WriteHeader(204)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.String())
+			}
+		}))
+		userManagementService, err := usermanagementv1.NewUserManagementV1(&usermanagementv1.UserManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		common.EnableWorkflowIDs(userManagementService.Service)
+		accessGroupsService, err := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		policyManagementService, err := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		offboarder = usermanagementv1.NewUserOffboarder(userManagementService, accessGroupsService, policyManagementService)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Removes the user's memberships, policies and account access`, func() {
+		report, err := offboarder.OffboardUser(context.Background(), "acct", "IBMid-user")
+		Expect(err).To(BeNil())
+		Expect(report.WorkflowID).ToNot(BeEmpty())
+		Expect(requests).To(Equal([]string{
+			"DELETE /v2/groups/_allgroups/members/IBMid-user",
+			"GET /v1/policies",
+			"DELETE /v1/policies/p1",
+			"DELETE /v1/policies/p3",
+			"DELETE /v2/accounts/acct/users/IBMid-user",
+		}))
+
+		Expect(report.Actions).To(HaveLen(5))
+		Expect(report.Actions[0].Kind).To(Equal(usermanagementv1.OffboardingActionRemoveFromAccessGroupConst))
+		Expect(report.Actions[0].Target).To(Equal("AccessGroupId-1"))
+		Expect(report.Actions[2].Kind).To(Equal(usermanagementv1.OffboardingActionDeletePolicyConst))
+		Expect(report.Actions[4].Kind).To(Equal(usermanagementv1.OffboardingActionRemoveFromAccountConst))
+		Expect(report.Actions[4].Err).To(BeNil())
+
+		failed := report.Failed()
+		Expect(failed).To(HaveLen(2))
+		Expect(failed[0].Err.Error()).To(ContainSubstring("access group 'AccessGroupId-2': status code 500 (trace trace-2)"))
+		Expect(failed[1].Target).To(Equal("p3"))
+		Expect(failed[1].Err.Error()).To(ContainSubstring("forbidden"))
+	})
+	It(`Does nothing else for a user that was already offboarded`, func() {
+		userRemoved = true
+		report, err := offboarder.OffboardUser(context.Background(), "acct", "IBMid-user")
+		Expect(err).To(BeNil())
+		Expect(report.HasErrors()).To(BeFalse())
+		Expect(report.Actions).To(HaveLen(1))
+		Expect(report.Actions[0].Target).To(Equal("acct"))
+	})
+	It(`Skips the steps whose client is not set`, func() {
+		offboarder.AccessGroups = nil
+		offboarder.PolicyManagement = nil
+		report, err := offboarder.OffboardUser(context.Background(), "acct", "IBMid-user")
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal([]string{"DELETE /v2/accounts/acct/users/IBMid-user"}))
+		Expect(report.HasErrors()).To(BeFalse())
+	})
+	It(`Uses the account of the account context`, func() {
+		ctx := common.WithAccountContext(context.Background(), &common.AccountContext{AccountID: "acct"})
+		offboarder.AccessGroups = nil
+		offboarder.PolicyManagement = nil
+		report, err := offboarder.OffboardUser(ctx, "", "IBMid-user")
+		Expect(err).To(BeNil())
+		Expect(report.AccountID).To(Equal("acct"))
+		Expect(requests).To(Equal([]string{"DELETE /v2/accounts/acct/users/IBMid-user"}))
+
+		requests = nil
+		_, err = offboarder.OffboardUser(ctx, "other", "IBMid-user")
+		var mismatchErr *common.AccountMismatchError
+		Expect(errors.As(err, &mismatchErr)).To(BeTrue())
+		Expect(requests).To(BeEmpty())
+	})
+	It(`Rejects missing arguments`, func() {
+		_, err := offboarder.OffboardUser(context.Background(), "acct", "")
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,436 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/genoptions. DO NOT EDIT.
+
+package usermanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ListUsersOption : A functional option that sets a property of ListUsersOptions (see ListUsersWith).
+type ListUsersOption func(options *ListUsersOptions)
+
+// ListUsersAccountID sets the AccountID property of ListUsersOptions. The account ID.
+func ListUsersAccountID(accountID string) ListUsersOption {
+	return func(options *ListUsersOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// ListUsersState sets the State property of ListUsersOptions. The state of the user.
+func ListUsersState(state string) ListUsersOption {
+	return func(options *ListUsersOptions) {
+		options.State = core.StringPtr(state)
+	}
+}
+
+// ListUsersLimit sets the Limit property of ListUsersOptions. The number of results to be returned.
+func ListUsersLimit(limit int64) ListUsersOption {
+	return func(options *ListUsersOptions) {
+		options.Limit = core.Int64Ptr(limit)
+	}
+}
+
+// ListUsersStart sets the Start property of ListUsersOptions. An optional token that indicates the beginning of
+// the page of results to be returned.
+func ListUsersStart(start string) ListUsersOption {
+	return func(options *ListUsersOptions) {
+		options.Start = core.StringPtr(start)
+	}
+}
+
+// ListUsersHeader sets the Headers property of ListUsersOptions. Allows users to set headers on API requests.
+func ListUsersHeader(name string, value string) ListUsersOption {
+	return func(options *ListUsersOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewListUsersOptionsWith returns ListUsersOptions populated by applying "options" in order.
+func (*UserManagementV1) NewListUsersOptionsWith(options ...ListUsersOption) *ListUsersOptions {
+	listUsersOptions := &ListUsersOptions{}
+	for _, option := range options {
+		option(listUsersOptions)
+	}
+	return listUsersOptions
+}
+
+// ListUsersWith is an alternate form of the ListUsersWithContext method which takes functional options instead of
+// a ListUsersOptions struct. ListUsersAccountID is required.
+func (userManagement *UserManagementV1) ListUsersWith(ctx context.Context, options ...ListUsersOption) (result *UserList, response *core.DetailedResponse, err error) {
+	return userManagement.ListUsersWithContext(ctx, userManagement.NewListUsersOptionsWith(options...))
+}
+
+// InviteUsersOption : A functional option that sets a property of InviteUsersOptions (see InviteUsersWith).
+type InviteUsersOption func(options *InviteUsersOptions)
+
+// InviteUsersAccountID sets the AccountID property of InviteUsersOptions. The account ID.
+func InviteUsersAccountID(accountID string) InviteUsersOption {
+	return func(options *InviteUsersOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// InviteUsersUsers sets the Users property of InviteUsersOptions. A list of users to be invited.
+func InviteUsersUsers(users ...InviteUser) InviteUsersOption {
+	return func(options *InviteUsersOptions) {
+		options.Users = append(options.Users, users...)
+	}
+}
+
+// InviteUsersIamPolicy sets the IamPolicy property of InviteUsersOptions. A list of IAM policies.
+func InviteUsersIamPolicy(iamPolicy ...InviteUserIamPolicy) InviteUsersOption {
+	return func(options *InviteUsersOptions) {
+		options.IamPolicy = append(options.IamPolicy, iamPolicy...)
+	}
+}
+
+// InviteUsersAccessGroups sets the AccessGroups property of InviteUsersOptions. A list of access groups.
+func InviteUsersAccessGroups(accessGroups ...string) InviteUsersOption {
+	return func(options *InviteUsersOptions) {
+		options.AccessGroups = append(options.AccessGroups, accessGroups...)
+	}
+}
+
+// InviteUsersHeader sets the Headers property of InviteUsersOptions. Allows users to set headers on API requests.
+func InviteUsersHeader(name string, value string) InviteUsersOption {
+	return func(options *InviteUsersOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewInviteUsersOptionsWith returns InviteUsersOptions populated by applying "options" in order.
+func (*UserManagementV1) NewInviteUsersOptionsWith(options ...InviteUsersOption) *InviteUsersOptions {
+	inviteUsersOptions := &InviteUsersOptions{}
+	for _, option := range options {
+		option(inviteUsersOptions)
+	}
+	return inviteUsersOptions
+}
+
+// InviteUsersWith is an alternate form of the InviteUsersWithContext method which takes functional options instead
+// of an InviteUsersOptions struct. InviteUsersAccountID is required.
+func (userManagement *UserManagementV1) InviteUsersWith(ctx context.Context, options ...InviteUsersOption) (result *InvitedUserList, response *core.DetailedResponse, err error) {
+	return userManagement.InviteUsersWithContext(ctx, userManagement.NewInviteUsersOptionsWith(options...))
+}
+
+// GetUserProfileOption : A functional option that sets a property of GetUserProfileOptions (see GetUserProfileWith).
+type GetUserProfileOption func(options *GetUserProfileOptions)
+
+// GetUserProfileAccountID sets the AccountID property of GetUserProfileOptions. The account ID.
+func GetUserProfileAccountID(accountID string) GetUserProfileOption {
+	return func(options *GetUserProfileOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetUserProfileIamID sets the IamID property of GetUserProfileOptions. The user's IAM ID.
+func GetUserProfileIamID(iamID string) GetUserProfileOption {
+	return func(options *GetUserProfileOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// GetUserProfileHeader sets the Headers property of GetUserProfileOptions. Allows users to set headers on API
+// requests.
+func GetUserProfileHeader(name string, value string) GetUserProfileOption {
+	return func(options *GetUserProfileOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetUserProfileOptionsWith returns GetUserProfileOptions populated by applying "options" in order.
+func (*UserManagementV1) NewGetUserProfileOptionsWith(options ...GetUserProfileOption) *GetUserProfileOptions {
+	getUserProfileOptions := &GetUserProfileOptions{}
+	for _, option := range options {
+		option(getUserProfileOptions)
+	}
+	return getUserProfileOptions
+}
+
+// GetUserProfileWith is an alternate form of the GetUserProfileWithContext method which takes functional options
+// instead of a GetUserProfileOptions struct. GetUserProfileAccountID and GetUserProfileIamID are required.
+func (userManagement *UserManagementV1) GetUserProfileWith(ctx context.Context, options ...GetUserProfileOption) (result *UserProfile, response *core.DetailedResponse, err error) {
+	return userManagement.GetUserProfileWithContext(ctx, userManagement.NewGetUserProfileOptionsWith(options...))
+}
+
+// UpdateUserProfileOption : A functional option that sets a property of UpdateUserProfileOptions (see UpdateUserProfileWith).
+type UpdateUserProfileOption func(options *UpdateUserProfileOptions)
+
+// UpdateUserProfileAccountID sets the AccountID property of UpdateUserProfileOptions. The account ID.
+func UpdateUserProfileAccountID(accountID string) UpdateUserProfileOption {
+	return func(options *UpdateUserProfileOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// UpdateUserProfileIamID sets the IamID property of UpdateUserProfileOptions. The user's IAM ID.
+func UpdateUserProfileIamID(iamID string) UpdateUserProfileOption {
+	return func(options *UpdateUserProfileOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// UpdateUserProfileFirstname sets the Firstname property of UpdateUserProfileOptions. The first name of the user.
+func UpdateUserProfileFirstname(firstname string) UpdateUserProfileOption {
+	return func(options *UpdateUserProfileOptions) {
+		options.Firstname = core.StringPtr(firstname)
+	}
+}
+
+// UpdateUserProfileLastname sets the Lastname property of UpdateUserProfileOptions. The last name of the user.
+func UpdateUserProfileLastname(lastname string) UpdateUserProfileOption {
+	return func(options *UpdateUserProfileOptions) {
+		options.Lastname = core.StringPtr(lastname)
+	}
+}
+
+// UpdateUserProfileState sets the State property of UpdateUserProfileOptions. The state of the user.
+func UpdateUserProfileState(state string) UpdateUserProfileOption {
+	return func(options *UpdateUserProfileOptions) {
+		options.State = core.StringPtr(state)
+	}
+}
+
+// UpdateUserProfileEmail sets the Email property of UpdateUserProfileOptions. The email address of the user.
+func UpdateUserProfileEmail(email string) UpdateUserProfileOption {
+	return func(options *UpdateUserProfileOptions) {
+		options.Email = core.StringPtr(email)
+	}
+}
+
+// UpdateUserProfilePhonenumber sets the Phonenumber property of UpdateUserProfileOptions. The phone number of the
+// user.
+func UpdateUserProfilePhonenumber(phonenumber string) UpdateUserProfileOption {
+	return func(options *UpdateUserProfileOptions) {
+		options.Phonenumber = core.StringPtr(phonenumber)
+	}
+}
+
+// UpdateUserProfileAltphonenumber sets the Altphonenumber property of UpdateUserProfileOptions. The alternative
+// phone number of the user.
+func UpdateUserProfileAltphonenumber(altphonenumber string) UpdateUserProfileOption {
+	return func(options *UpdateUserProfileOptions) {
+		options.Altphonenumber = core.StringPtr(altphonenumber)
+	}
+}
+
+// UpdateUserProfilePhoto sets the Photo property of UpdateUserProfileOptions. A link to a photo of the user.
+func UpdateUserProfilePhoto(photo string) UpdateUserProfileOption {
+	return func(options *UpdateUserProfileOptions) {
+		options.Photo = core.StringPtr(photo)
+	}
+}
+
+// UpdateUserProfileHeader sets the Headers property of UpdateUserProfileOptions. Allows users to set headers on
+// API requests.
+func UpdateUserProfileHeader(name string, value string) UpdateUserProfileOption {
+	return func(options *UpdateUserProfileOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateUserProfileOptionsWith returns UpdateUserProfileOptions populated by applying "options" in order.
+func (*UserManagementV1) NewUpdateUserProfileOptionsWith(options ...UpdateUserProfileOption) *UpdateUserProfileOptions {
+	updateUserProfileOptions := &UpdateUserProfileOptions{}
+	for _, option := range options {
+		option(updateUserProfileOptions)
+	}
+	return updateUserProfileOptions
+}
+
+// UpdateUserProfileWith is an alternate form of the UpdateUserProfileWithContext method which takes functional
+// options instead of an UpdateUserProfileOptions struct. UpdateUserProfileAccountID and UpdateUserProfileIamID are
+// required.
+func (userManagement *UserManagementV1) UpdateUserProfileWith(ctx context.Context, options ...UpdateUserProfileOption) (response *core.DetailedResponse, err error) {
+	return userManagement.UpdateUserProfileWithContext(ctx, userManagement.NewUpdateUserProfileOptionsWith(options...))
+}
+
+// RemoveUserOption : A functional option that sets a property of RemoveUserOptions (see RemoveUserWith).
+type RemoveUserOption func(options *RemoveUserOptions)
+
+// RemoveUserAccountID sets the AccountID property of RemoveUserOptions. The account ID.
+func RemoveUserAccountID(accountID string) RemoveUserOption {
+	return func(options *RemoveUserOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// RemoveUserIamID sets the IamID property of RemoveUserOptions. The user's IAM ID.
+func RemoveUserIamID(iamID string) RemoveUserOption {
+	return func(options *RemoveUserOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// RemoveUserHeader sets the Headers property of RemoveUserOptions. Allows users to set headers on API requests.
+func RemoveUserHeader(name string, value string) RemoveUserOption {
+	return func(options *RemoveUserOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewRemoveUserOptionsWith returns RemoveUserOptions populated by applying "options" in order.
+func (*UserManagementV1) NewRemoveUserOptionsWith(options ...RemoveUserOption) *RemoveUserOptions {
+	removeUserOptions := &RemoveUserOptions{}
+	for _, option := range options {
+		option(removeUserOptions)
+	}
+	return removeUserOptions
+}
+
+// RemoveUserWith is an alternate form of the RemoveUserWithContext method which takes functional options instead
+// of a RemoveUserOptions struct. RemoveUserAccountID and RemoveUserIamID are required.
+func (userManagement *UserManagementV1) RemoveUserWith(ctx context.Context, options ...RemoveUserOption) (response *core.DetailedResponse, err error) {
+	return userManagement.RemoveUserWithContext(ctx, userManagement.NewRemoveUserOptionsWith(options...))
+}
+
+// GetUserSettingsOption : A functional option that sets a property of GetUserSettingsOptions (see GetUserSettingsWith).
+type GetUserSettingsOption func(options *GetUserSettingsOptions)
+
+// GetUserSettingsAccountID sets the AccountID property of GetUserSettingsOptions. The account ID.
+func GetUserSettingsAccountID(accountID string) GetUserSettingsOption {
+	return func(options *GetUserSettingsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// GetUserSettingsIamID sets the IamID property of GetUserSettingsOptions. The user's IAM ID.
+func GetUserSettingsIamID(iamID string) GetUserSettingsOption {
+	return func(options *GetUserSettingsOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// GetUserSettingsHeader sets the Headers property of GetUserSettingsOptions. Allows users to set headers on API
+// requests.
+func GetUserSettingsHeader(name string, value string) GetUserSettingsOption {
+	return func(options *GetUserSettingsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewGetUserSettingsOptionsWith returns GetUserSettingsOptions populated by applying "options" in order.
+func (*UserManagementV1) NewGetUserSettingsOptionsWith(options ...GetUserSettingsOption) *GetUserSettingsOptions {
+	getUserSettingsOptions := &GetUserSettingsOptions{}
+	for _, option := range options {
+		option(getUserSettingsOptions)
+	}
+	return getUserSettingsOptions
+}
+
+// GetUserSettingsWith is an alternate form of the GetUserSettingsWithContext method which takes functional options
+// instead of a GetUserSettingsOptions struct. GetUserSettingsAccountID and GetUserSettingsIamID are required.
+func (userManagement *UserManagementV1) GetUserSettingsWith(ctx context.Context, options ...GetUserSettingsOption) (result *UserSettings, response *core.DetailedResponse, err error) {
+	return userManagement.GetUserSettingsWithContext(ctx, userManagement.NewGetUserSettingsOptionsWith(options...))
+}
+
+// UpdateUserSettingsOption : A functional option that sets a property of UpdateUserSettingsOptions (see UpdateUserSettingsWith).
+type UpdateUserSettingsOption func(options *UpdateUserSettingsOptions)
+
+// UpdateUserSettingsAccountID sets the AccountID property of UpdateUserSettingsOptions. The account ID.
+func UpdateUserSettingsAccountID(accountID string) UpdateUserSettingsOption {
+	return func(options *UpdateUserSettingsOptions) {
+		options.AccountID = core.StringPtr(accountID)
+	}
+}
+
+// UpdateUserSettingsIamID sets the IamID property of UpdateUserSettingsOptions. The user's IAM ID.
+func UpdateUserSettingsIamID(iamID string) UpdateUserSettingsOption {
+	return func(options *UpdateUserSettingsOptions) {
+		options.IamID = core.StringPtr(iamID)
+	}
+}
+
+// UpdateUserSettingsLanguage sets the Language property of UpdateUserSettingsOptions. The console UI language.
+func UpdateUserSettingsLanguage(language string) UpdateUserSettingsOption {
+	return func(options *UpdateUserSettingsOptions) {
+		options.Language = core.StringPtr(language)
+	}
+}
+
+// UpdateUserSettingsNotificationLanguage sets the NotificationLanguage property of UpdateUserSettingsOptions. The
+// language for email and phone notifications.
+func UpdateUserSettingsNotificationLanguage(notificationLanguage string) UpdateUserSettingsOption {
+	return func(options *UpdateUserSettingsOptions) {
+		options.NotificationLanguage = core.StringPtr(notificationLanguage)
+	}
+}
+
+// UpdateUserSettingsAllowedIPAddresses sets the AllowedIPAddresses property of UpdateUserSettingsOptions. A
+// comma-separated list of IP addresses.
+func UpdateUserSettingsAllowedIPAddresses(allowedIPAddresses string) UpdateUserSettingsOption {
+	return func(options *UpdateUserSettingsOptions) {
+		options.AllowedIPAddresses = core.StringPtr(allowedIPAddresses)
+	}
+}
+
+// UpdateUserSettingsSelfManage sets the SelfManage property of UpdateUserSettingsOptions. Whether user managed
+// login is enabled.
+func UpdateUserSettingsSelfManage(selfManage bool) UpdateUserSettingsOption {
+	return func(options *UpdateUserSettingsOptions) {
+		options.SelfManage = core.BoolPtr(selfManage)
+	}
+}
+
+// UpdateUserSettingsHeader sets the Headers property of UpdateUserSettingsOptions. Allows users to set headers on
+// API requests.
+func UpdateUserSettingsHeader(name string, value string) UpdateUserSettingsOption {
+	return func(options *UpdateUserSettingsOptions) {
+		if options.Headers == nil {
+			options.Headers = map[string]string{}
+		}
+		options.Headers[name] = value
+	}
+}
+
+// NewUpdateUserSettingsOptionsWith returns UpdateUserSettingsOptions populated by applying "options" in order.
+func (*UserManagementV1) NewUpdateUserSettingsOptionsWith(options ...UpdateUserSettingsOption) *UpdateUserSettingsOptions {
+	updateUserSettingsOptions := &UpdateUserSettingsOptions{}
+	for _, option := range options {
+		option(updateUserSettingsOptions)
+	}
+	return updateUserSettingsOptions
+}
+
+// UpdateUserSettingsWith is an alternate form of the UpdateUserSettingsWithContext method which takes functional
+// options instead of an UpdateUserSettingsOptions struct. UpdateUserSettingsAccountID and UpdateUserSettingsIamID
+// are required.
+func (userManagement *UserManagementV1) UpdateUserSettingsWith(ctx context.Context, options ...UpdateUserSettingsOption) (response *core.DetailedResponse, err error) {
+	return userManagement.UpdateUserSettingsWithContext(ctx, userManagement.NewUpdateUserSettingsOptionsWith(options...))
+}
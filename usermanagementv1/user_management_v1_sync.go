@@ -0,0 +1,205 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usermanagementv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// AccountUsersSyncReport : The outcome of SyncAccountUsers.
+type AccountUsersSyncReport struct {
+	// The ID of the account.
+	AccountID string
+
+	// The users of the desired set that were already in the account.
+	Present []UserProfile
+
+	// The result of the invitation of each user of the desired set that was not in the account, in the order of the
+	// desired set. Invalid addresses are reported with an error and were not invited.
+	Invited []BulkInviteResult
+
+	// The users of the account that are not in the desired set. They were only removed if requested.
+	Extra []UserRemovalResult
+
+	// The workflow ID sent with every request of the synchronization (see common.EnsureWorkflowID).
+	WorkflowID string
+}
+
+// UserRemovalResult : A user of the account that is not in the desired set of SyncAccountUsers.
+type UserRemovalResult struct {
+	// The user, as listed.
+	User UserProfile
+
+	// Whether the user was removed from the account.
+	Removed bool
+
+	// The reason why the removal failed, or nil if it succeeded or was not requested.
+	Err error
+}
+
+// HasErrors returns true if the invitation or the removal of any user failed.
+func (report *AccountUsersSyncReport) HasErrors() bool {
+	for _, result := range report.Invited {
+		if result.Err != nil {
+			return true
+		}
+	}
+	for _, result := range report.Extra {
+		if result.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// SyncAccountUsers makes the users of an account match a desired set of email addresses (compared case-insensitively):
+// the users of the set that are not in the account are invited, without an account role, IAM policies or access
+// groups, as InviteUsersBulk does, and if "removeExtra" is true, the users of the account that are not in the set are
+// removed. Users that have been invited but have not accepted yet are in the account. Users without an email address
+// are never removed. Make sure that the desired set includes the user of the client, who is otherwise removed too.
+//
+// The invitation or removal of a user that fails does not stop the others: its error is recorded in the report, so
+// "err" is only returned if the users of the account cannot be listed or the context is done. In the latter case the
+// report is still returned with a *common.PartialError, and the users that were not invited or removed have the error
+// of the context. If "accountID" is empty, the account of the common.AccountContext of "ctx" is used.
+func (userManagement *UserManagementV1) SyncAccountUsers(ctx context.Context, accountID string, desiredEmails []string, removeExtra bool) (report *AccountUsersSyncReport, err error) {
+	accountID, err = common.ResolveAccountID(ctx, accountID)
+	if err != nil {
+		return
+	}
+	if accountID == "" {
+		err = fmt.Errorf("accountID cannot be empty")
+		return
+	}
+	ctx, workflowID := common.EnsureWorkflowIDFor(ctx, userManagement.Service)
+	defer func() { err = common.WrapWorkflowError(workflowID, err) }()
+
+	users, err := userManagement.listAllUsers(ctx, accountID)
+	if err != nil {
+		err = fmt.Errorf("error listing the users of account '%s': %w", accountID, err)
+		return
+	}
+	report = &AccountUsersSyncReport{AccountID: accountID, WorkflowID: workflowID}
+
+	current := make(map[string]UserProfile)
+	for _, user := range users {
+		if email := strings.ToLower(core.StringNilMapper(user.Email)); email != "" {
+			current[email] = user
+		}
+	}
+	desired := make(map[string]bool)
+	for _, email := range desiredEmails {
+		email = strings.TrimSpace(email)
+		if email == "" || desired[strings.ToLower(email)] {
+			continue
+		}
+		desired[strings.ToLower(email)] = true
+		if user, ok := current[strings.ToLower(email)]; ok {
+			report.Present = append(report.Present, user)
+			continue
+		}
+		report.Invited = append(report.Invited, BulkInviteResult{Email: email, Err: validateInviteEmail(email)})
+	}
+	for _, user := range users {
+		email := strings.ToLower(core.StringNilMapper(user.Email))
+		if email != "" && !desired[email] {
+			report.Extra = append(report.Extra, UserRemovalResult{User: user})
+		}
+	}
+
+	inviter := userInviter{userManagement: userManagement, accountID: accountID}
+	inviter.setDefaults()
+	var pending []*BulkInviteResult
+	for i := range report.Invited {
+		if report.Invited[i].Err == nil {
+			pending = append(pending, &report.Invited[i])
+		}
+	}
+	var removals []*UserRemovalResult
+	if removeExtra {
+		for i := range report.Extra {
+			removals = append(removals, &report.Extra[i])
+		}
+	}
+
+	var partialErr *common.PartialError
+	invitesStarted, removalsStarted := len(pending), 0
+	if errors.As(inviter.inviteAll(ctx, workflowID, pending), &partialErr) {
+		if started := partialErr.Processed * MaxUsersPerInviteRequest; started < invitesStarted {
+			invitesStarted = started
+		}
+	} else {
+		removeErr := common.ForEachConcurrently(ctx, len(removals), inviter.options.Concurrency, func(ctx context.Context, i int) {
+			removals[i].Err = common.WrapWorkflowError(workflowID, userManagement.removeUser(ctx, accountID, removals[i]))
+		})
+		removalsStarted = len(removals)
+		if errors.As(removeErr, &partialErr) {
+			removalsStarted = partialErr.Processed
+		}
+	}
+	if partialErr != nil {
+		for _, removal := range removals[removalsStarted:] {
+			removal.Err = common.WrapWorkflowError(workflowID, partialErr.Err)
+		}
+		err = &common.PartialError{
+			Processed: invitesStarted + removalsStarted,
+			Total:     len(pending) + len(removals),
+			Err:       partialErr.Err,
+		}
+	}
+	return
+}
+
+// listAllUsers returns every user of the account, retrieving all pages.
+func (userManagement *UserManagementV1) listAllUsers(ctx context.Context, accountID string) (users []UserProfile, err error) {
+	listUsersOptions := userManagement.NewListUsersOptions(accountID)
+	for {
+		var result *UserList
+		result, _, err = userManagement.ListUsersWithContext(ctx, listUsersOptions)
+		if err != nil {
+			return
+		}
+		users = append(users, result.Resources...)
+
+		var start *string
+		start, err = core.GetQueryParam(result.NextURL, "_start")
+		if err != nil {
+			return
+		}
+		if start == nil || len(result.Resources) == 0 {
+			return
+		}
+		listUsersOptions.Start = start
+	}
+}
+
+// removeUser removes the user of "removal" from the account and records it as removed if it succeeds.
+func (userManagement *UserManagementV1) removeUser(ctx context.Context, accountID string, removal *UserRemovalResult) error {
+	iamID := core.StringNilMapper(removal.User.IamID)
+	_, err := userManagement.RemoveUserWithContext(ctx, userManagement.NewRemoveUserOptions(accountID, iamID))
+	if err != nil {
+		return fmt.Errorf("error removing user '%s' from account '%s': %w", iamID, accountID, err)
+	}
+	removal.Removed = true
+	return nil
+}
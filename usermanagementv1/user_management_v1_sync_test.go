@@ -0,0 +1,163 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usermanagementv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/usermanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UserManagementV1 account user synchronization`, func() {
+	var testServer *httptest.Server
+	var userManagementService *usermanagementv1.UserManagementV1
+	var mutex sync.Mutex
+	var invited []string
+	var removed []string
+	BeforeEach(func() {
+		invited, removed = nil, nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.Path == "/v2/accounts/acct/users":
+				res.WriteHeader(200)
+				if req.URL.Query().Get("_start") == "" {
+					fmt.Fprint(res, `{"total_results": 4, "limit": 2, "next_url": "/v2/accounts/acct/users?_start=page2", "resources": [
+						{"iam_id": "IBMid-ann", "email": "Ann@example.com", "state": "ACTIVE"},
+						{"iam_id": "IBMid-bob", "email": "bob@example.com", "state": "PROCESSING"}]}`)
+				} else {
+					fmt.Fprint(res, `{"total_results": 4, "limit": 2, "resources": [
+						{"iam_id": "IBMid-old", "email": "old@example.com", "state": "ACTIVE"},
+						{"iam_id": "IBMid-svc", "state": "ACTIVE"}]}`)
+				}
+			case req.Method == "POST" && req.URL.Path == "/v2/accounts/acct/users":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				var resources []string
+				for _, user := range body["users"].([]interface{}) {
+					email := user.(map[string]interface{})["email"].(string)
+					mutex.Lock()
+					invited = append(invited, email)
+					mutex.Unlock()
+					resources = append(resources, fmt.Sprintf(`{"email": "%s", "id": "id-%s", "state": "PROCESSING"}`, email, email))
+				}
+				res.WriteHeader(202)
+				fmt.Fprintf(res, `{"resources": [%s]}`, strings.Join(resources, ","))
+			case req.Method == "DELETE":
+				mutex.Lock()
+				removed = append(removed, strings.TrimPrefix(req.URL.Path, "/v2/accounts/acct/users/"))
+				mutex.Unlock()
+				res.WriteHeader(204)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+			}
+		}))
+		var serviceErr error
+		userManagementService, serviceErr = usermanagementv1.NewUserManagementV1(&usermanagementv1.UserManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		common.EnableWorkflowIDs(userManagementService.Service)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invites the missing users and removes the extra ones`, func() {
+		desired := []string{"ann@example.com", "bob@EXAMPLE.com", "new@example.com", "NEW@example.com", "not an address"}
+		report, err := userManagementService.SyncAccountUsers(context.Background(), "acct", desired, true)
+		Expect(err).To(BeNil())
+		Expect(report.WorkflowID).ToNot(BeEmpty())
+
+		Expect(report.Present).To(HaveLen(2))
+		Expect(*report.Present[0].IamID).To(Equal("IBMid-ann"))
+		Expect(invited).To(Equal([]string{"new@example.com"}))
+		Expect(report.Invited).To(HaveLen(2))
+		Expect(*report.Invited[0].User.ID).To(Equal("id-new@example.com"))
+		Expect(report.Invited[1].Err.Error()).To(ContainSubstring("invalid email address"))
+		Expect(report.HasErrors()).To(BeTrue())
+
+		Expect(removed).To(Equal([]string{"IBMid-old"}))
+		Expect(report.Extra).To(HaveLen(1))
+		Expect(report.Extra[0].Removed).To(BeTrue())
+		Expect(report.Extra[0].Err).To(BeNil())
+	})
+	It(`Only reports the extra users unless asked to remove them`, func() {
+		report, err := userManagementService.SyncAccountUsers(context.Background(), "acct", []string{"ann@example.com"}, false)
+		Expect(err).To(BeNil())
+		Expect(invited).To(BeEmpty())
+		Expect(removed).To(BeEmpty())
+		Expect(report.Extra).To(HaveLen(2))
+		Expect(*report.Extra[1].User.IamID).To(Equal("IBMid-old"))
+		Expect(report.Extra[1].Removed).To(BeFalse())
+		Expect(report.HasErrors()).To(BeFalse())
+	})
+	It(`Returns the partial report when the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		userManagementService.Service.Client.Transport = cancelAfterListing{cancel: cancel}
+		report, err := userManagementService.SyncAccountUsers(ctx, "acct", []string{"new@example.com"}, true)
+		var partialErr *common.PartialError
+		Expect(errors.As(err, &partialErr)).To(BeTrue())
+		Expect(partialErr.Processed).To(Equal(0))
+		Expect(partialErr.Total).To(Equal(4))
+		Expect(invited).To(BeEmpty())
+		Expect(removed).To(BeEmpty())
+		Expect(report.Invited[0].Err).To(MatchError(context.Canceled))
+		Expect(report.Extra[0].Err).To(MatchError(context.Canceled))
+	})
+	It(`Returns the error of the listing`, func() {
+		_, err := userManagementService.SyncAccountUsers(context.Background(), "other", nil, true)
+		Expect(err).ToNot(BeNil())
+		_, err = userManagementService.SyncAccountUsers(context.Background(), "", nil, true)
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+// cancelAfterListing is a transport that cancels the context of the synchronization once the last page of users has
+// been listed. The response body is read first, so that the listing itself succeeds.
+type cancelAfterListing struct {
+	cancel context.CancelFunc
+}
+
+func (transport cancelAfterListing) RoundTrip(req *http.Request) (*http.Response, error) {
+	response, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil || req.Method != "GET" || req.URL.Query().Get("_start") == "" {
+		return response, err
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	transport.cancel()
+	return response, err
+}